@@ -171,6 +171,9 @@ type FQDNStatus struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:path=dns,scope=Namespaced
+// +kubebuilder:printcolumn:name="Portal",type=string,JSONPath=`.spec.portalRef`
+// +kubebuilder:printcolumn:name="Last Sync",type=date,JSONPath=`.status.lastReconcileTime`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // DNS is the Schema for the dns API
 type DNS struct {
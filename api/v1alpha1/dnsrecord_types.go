@@ -116,7 +116,7 @@ type EndpointStatus struct {
 // +kubebuilder:resource:path=dnsrecords,scope=Namespaced,shortName=dnsrec
 // +kubebuilder:printcolumn:name="Source",type=string,JSONPath=`.spec.sourceType`
 // +kubebuilder:printcolumn:name="Portal",type=string,JSONPath=`.spec.portalRef`
-// +kubebuilder:printcolumn:name="Endpoints",type=integer,JSONPath=`.status.endpoints`
+// +kubebuilder:printcolumn:name="Last Sync",type=date,JSONPath=`.status.lastReconcileTime`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // DNSRecord is the Schema for the dnsrecords API.
@@ -0,0 +1,90 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FQDNNoteSpec defines the desired state of FQDNNote
+type FQDNNoteSpec struct {
+	// portalRef is the name of the Portal the FQDN belongs to
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	PortalRef string `json:"portalRef"`
+
+	// fqdn is the fully qualified domain name the notes are attached to
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	FQDN string `json:"fqdn"`
+
+	// notes is the append-only list of notes, oldest first
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=200
+	Notes []FQDNNoteEntry `json:"notes,omitempty"`
+}
+
+// FQDNNoteEntry is a single note with its author and creation time
+type FQDNNoteEntry struct {
+	// author is who wrote the note
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Author string `json:"author"`
+
+	// text is the free-text content of the note
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=4096
+	Text string `json:"text"`
+
+	// createdAt is when the note was added
+	// +kubebuilder:validation:Required
+	CreatedAt metav1.Time `json:"createdAt"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Portal",type=string,JSONPath=`.spec.portalRef`
+// +kubebuilder:printcolumn:name="FQDN",type=string,JSONPath=`.spec.fqdn`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// FQDNNote is the Schema for the fqdnnotes API. It holds the notes of one
+// FQDN of a portal, written by the AddNote RPC.
+type FQDNNote struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of FQDNNote
+	// +required
+	Spec FQDNNoteSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// FQDNNoteList contains a list of FQDNNote
+type FQDNNoteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []FQDNNote `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FQDNNote{}, &FQDNNoteList{})
+}
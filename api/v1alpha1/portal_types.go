@@ -21,10 +21,12 @@ import (
 )
 
 // PortalSpec defines the desired state of Portal
+// +kubebuilder:validation:XValidation:rule="!(has(self.main) && self.main && has(self.remote))",message="spec.remote cannot be set when spec.main is true"
 type PortalSpec struct {
 	// title is the display title for this portal
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
 	Title string `json:"title"`
 
 	// main marks this portal as the default portal for unmatched FQDNs
@@ -33,6 +35,8 @@ type PortalSpec struct {
 
 	// subPath is the URL subpath for this portal (defaults to metadata.name)
 	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`
 	SubPath string `json:"subPath,omitempty"`
 
 	// remote configures this portal to fetch data from a remote SRE Portal instance.
@@ -46,6 +50,96 @@ type PortalSpec struct {
 	// All features default to true when not specified.
 	// +optional
 	Features *PortalFeatures `json:"features,omitempty"`
+
+	// sourcePriority overrides spec.sources.priority of every DNS resource
+	// referencing this portal: when several sources publish the same FQDN, the
+	// first listed source wins. Sources not enabled in a DNS resource are ignored.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:items:Enum=service;ingress;dnsendpoint;istio-gateway;istio-virtualservice;gateway-httproute;gateway-grpcroute;gateway-tlsroute;gateway-tcproute;gateway-udproute;contour-httpproxy;ambassador-host;crossplane-scaleway-record;demo
+	SourcePriority []string `json:"sourcePriority,omitempty"`
+
+	// templateRef names a portal template of the operator configuration
+	// (portal.templates). The defaulting webhook copies the template values
+	// into the fields of this spec that are left unset.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	TemplateRef string `json:"templateRef,omitempty"`
+
+	// links are external links (runbooks, dashboards, chat channels) shown in
+	// the portal menu.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=32
+	Links []PortalLink `json:"links,omitempty"`
+
+	// branding customizes how the portal is displayed.
+	// +optional
+	Branding *PortalBranding `json:"branding,omitempty"`
+
+	// contentRefs reference ConfigMaps of the portal namespace holding
+	// markdown content blocks (announcements, onboarding docs) served by
+	// GetPortalContent. Each key of a ConfigMap is a block. The ConfigMaps
+	// must be labelled sreportal.io/portal-content: "true".
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=16
+	ContentRefs []PortalContentRef `json:"contentRefs,omitempty"`
+
+	// allowedGroups are the OIDC groups whose users may see this portal and
+	// its FQDNs when OIDC login is enabled (auth.oidc). Anonymous users and
+	// users in none of them do not see the portal. Everyone sees it when
+	// empty.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MinLength=1
+	AllowedGroups []string `json:"allowedGroups,omitempty"`
+}
+
+// PortalContentRef references a ConfigMap of markdown content blocks.
+type PortalContentRef struct {
+	// name is the name of the ConfigMap.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// key restricts the reference to one key of the ConfigMap. Every key is
+	// a block when empty.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[-._a-zA-Z0-9]+$`
+	Key string `json:"key,omitempty"`
+}
+
+// PortalLink is an external link shown in the portal menu.
+type PortalLink struct {
+	// title is the link label.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	Title string `json:"title"`
+
+	// url is the link target.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://.*`
+	// +kubebuilder:validation:MaxLength=2048
+	URL string `json:"url"`
+}
+
+// PortalBranding customizes how a portal is displayed.
+type PortalBranding struct {
+	// logoURL is the URL of the logo shown in the portal menu.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(https?://|/).*`
+	// +kubebuilder:validation:MaxLength=2048
+	LogoURL string `json:"logoURL,omitempty"`
+
+	// color is the accent color of the portal, as "#rrggbb".
+	// +optional
+	// +kubebuilder:validation:Pattern=`^#[0-9a-fA-F]{6}$`
+	Color string `json:"color,omitempty"`
 }
 
 // PortalFeatures controls which features are enabled for a portal.
@@ -113,21 +207,103 @@ func (f *PortalFeatures) IsImageInventoryEnabled() bool {
 }
 
 // RemotePortalSpec defines the configuration for fetching data from a remote portal.
+// +kubebuilder:validation:XValidation:rule="has(self.url) != has(self.snapshot)",message="exactly one of url or snapshot must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.syncMode) || self.syncMode == 'Poll' || has(self.url)",message="syncMode Stream requires url"
 type RemotePortalSpec struct {
 	// url is the base URL of the remote SRE Portal instance.
-	// +kubebuilder:validation:Required
+	// Exactly one of url or snapshot must be set.
+	// +optional
 	// +kubebuilder:validation:Pattern=`^https?://.*`
-	URL string `json:"url"`
+	// +kubebuilder:validation:MaxLength=2048
+	URL string `json:"url,omitempty"`
+
+	// snapshot reads the remote FQDNs from a serialized ListFQDNsResponse
+	// instead of a live URL, for federation across networks with no direct
+	// connectivity. Only DNS is synced from a snapshot.
+	// +optional
+	Snapshot *RemoteSnapshotSource `json:"snapshot,omitempty"`
 
 	// portal is the name of the portal to target on the remote instance.
 	// If not set, the main portal of the remote instance will be used.
 	// +optional
+	// +kubebuilder:validation:MaxLength=253
 	Portal string `json:"portal,omitempty"`
 
 	// tls configures TLS settings for connecting to the remote portal.
 	// If not set, the default system TLS configuration is used.
 	// +optional
 	TLS *RemoteTLSConfig `json:"tls,omitempty"`
+
+	// auth configures the credentials sent with every request to the remote
+	// portal. A client certificate is configured with tls.certSecretRef.
+	// +optional
+	Auth *RemoteAuthConfig `json:"auth,omitempty"`
+
+	// syncMode selects how the remote FQDNs are synced: Poll fetches them all
+	// on every remote sync, Stream follows the StreamFQDNs RPC of the remote
+	// portal and applies each change as it happens, re-fetching everything
+	// only when the stream reconnects. Stream requires url.
+	// Defaults to Poll.
+	// +optional
+	SyncMode RemoteSyncMode `json:"syncMode,omitempty"`
+}
+
+// RemoteSyncMode is how the FQDNs of a remote portal are synced.
+// +kubebuilder:validation:Enum=Poll;Stream
+type RemoteSyncMode string
+
+const (
+	RemoteSyncPoll   RemoteSyncMode = "Poll"
+	RemoteSyncStream RemoteSyncMode = "Stream"
+)
+
+// IsStream reports whether the remote FQDNs are followed by streaming.
+func (r *RemotePortalSpec) IsStream() bool {
+	return r != nil && r.SyncMode == RemoteSyncStream && r.URL != ""
+}
+
+// IsSnapshot reports whether the remote portal is read from a snapshot
+// rather than a live URL.
+func (r *RemotePortalSpec) IsSnapshot() bool {
+	return r != nil && r.Snapshot != nil
+}
+
+// Location returns where the remote portal is read from: its URL, or the
+// snapshot as a file:// or oci:// URL.
+func (r *RemotePortalSpec) Location() string {
+	switch {
+	case r.IsSnapshot() && r.Snapshot.OCI != "":
+		return "oci://" + r.Snapshot.OCI
+	case r.IsSnapshot():
+		return "file://" + r.Snapshot.Path
+	default:
+		return r.URL
+	}
+}
+
+// RemoteSnapshotSource locates a serialized ListFQDNsResponse (protobuf JSON
+// or binary): a file on the operator filesystem, typically a mounted volume,
+// or an OCI artifact pushed by the snapshot publisher of the remote instance.
+// +kubebuilder:validation:XValidation:rule="has(self.path) != has(self.oci)",message="exactly one of path or oci must be set"
+type RemoteSnapshotSource struct {
+	// path is the absolute path of the snapshot file. It is re-read on every
+	// remote sync.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^/.*`
+	// +kubebuilder:validation:MaxLength=4096
+	Path string `json:"path,omitempty"`
+
+	// oci is the reference ("registry/repository:tag" or "@digest") of the
+	// snapshot artifact. It is pulled on every remote sync.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	OCI string `json:"oci,omitempty"`
+
+	// credentialsSecretRef references a Secret holding the registry
+	// credentials used to pull oci: a kubernetes.io/dockerconfigjson Secret,
+	// or "username" and "password" keys. Anonymous when unset.
+	// +optional
+	CredentialsSecretRef *SecretRef `json:"credentialsSecretRef,omitempty"`
 }
 
 // RemoteTLSConfig defines the TLS configuration for connecting to a remote portal.
@@ -149,11 +325,27 @@ type RemoteTLSConfig struct {
 	CertSecretRef *SecretRef `json:"certSecretRef,omitempty"`
 }
 
+// RemoteAuthConfig defines the credentials sent to a remote portal.
+// +kubebuilder:validation:XValidation:rule="!(has(self.bearerTokenSecretRef) && has(self.basicAuthSecretRef))",message="at most one of bearerTokenSecretRef or basicAuthSecretRef can be set"
+type RemoteAuthConfig struct {
+	// bearerTokenSecretRef references a Secret containing a bearer token, e.g.
+	// an API token of the remote instance. The Secret must contain the key "token".
+	// +optional
+	BearerTokenSecretRef *SecretRef `json:"bearerTokenSecretRef,omitempty"`
+
+	// basicAuthSecretRef references a Secret containing basic auth credentials,
+	// e.g. for a proxy in front of the remote instance. The Secret must contain
+	// the keys "username" and "password".
+	// +optional
+	BasicAuthSecretRef *SecretRef `json:"basicAuthSecretRef,omitempty"`
+}
+
 // SecretRef is a reference to a Kubernetes Secret in the same namespace.
 type SecretRef struct {
 	// name is the name of the Secret.
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
 	Name string `json:"name"`
 }
 
@@ -181,6 +373,11 @@ type RemoteSyncStatus struct {
 	// +optional
 	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
 
+	// lastSyncDuration is how long the last successful synchronization took.
+	// The next sync is scheduled relative to it to keep a stable cadence.
+	// +optional
+	LastSyncDuration *metav1.Duration `json:"lastSyncDuration,omitempty"`
+
 	// lastSyncError contains the error message from the last failed synchronization attempt.
 	// Empty if the last sync was successful.
 	// +optional
@@ -198,6 +395,40 @@ type RemoteSyncStatus struct {
 	// Used to compute effective features for remote portals (local AND remote).
 	// +optional
 	Features *PortalFeaturesStatus `json:"features,omitempty"`
+
+	// stream reports the health of the streaming connection to the remote
+	// portal. Only set when the remote portal is followed by streaming.
+	// +optional
+	Stream *RemoteStreamStatus `json:"stream,omitempty"`
+}
+
+// RemoteStreamState is the state of a supervised stream to a remote portal.
+// +kubebuilder:validation:Enum=Connecting;Connected;Reconnecting;Stopped
+type RemoteStreamState string
+
+const (
+	RemoteStreamConnecting   RemoteStreamState = "Connecting"
+	RemoteStreamConnected    RemoteStreamState = "Connected"
+	RemoteStreamReconnecting RemoteStreamState = "Reconnecting"
+	RemoteStreamStopped      RemoteStreamState = "Stopped"
+)
+
+// RemoteStreamStatus reports the health of a stream to a remote portal.
+type RemoteStreamStatus struct {
+	// state is the current state of the stream.
+	State RemoteStreamState `json:"state"`
+
+	// lastTransitionTime is when the stream entered its current state.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+
+	// reconnectAttempts is the number of failed connection attempts since
+	// the stream was last connected.
+	// +optional
+	ReconnectAttempts int32 `json:"reconnectAttempts,omitempty"`
+
+	// lastError is the error that ended the last stream session.
+	// +optional
+	LastError string `json:"lastError,omitempty"`
 }
 
 // PortalFeaturesStatus contains the observed feature flags from a remote portal.
@@ -227,8 +458,10 @@ type PortalFeaturesStatus struct {
 // +kubebuilder:resource:path=portals,scope=Namespaced
 // +kubebuilder:printcolumn:name="Title",type=string,JSONPath=`.spec.title`
 // +kubebuilder:printcolumn:name="Main",type=boolean,JSONPath=`.spec.main`
-// +kubebuilder:printcolumn:name="Remote URL",type=string,JSONPath=`.spec.remote.url`,priority=1
 // +kubebuilder:printcolumn:name="Ready",type=boolean,JSONPath=`.status.ready`
+// +kubebuilder:printcolumn:name="FQDNs",type=integer,JSONPath=`.status.remoteSync.fqdnCount`
+// +kubebuilder:printcolumn:name="Last Sync",type=date,JSONPath=`.status.remoteSync.lastSyncTime`
+// +kubebuilder:printcolumn:name="Remote URL",type=string,JSONPath=`.spec.remote.url`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Portal is the Schema for the portals API
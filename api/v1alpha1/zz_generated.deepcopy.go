@@ -626,6 +626,102 @@ func (in *FQDNGroupStatus) DeepCopy() *FQDNGroupStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FQDNNote) DeepCopyInto(out *FQDNNote) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FQDNNote.
+func (in *FQDNNote) DeepCopy() *FQDNNote {
+	if in == nil {
+		return nil
+	}
+	out := new(FQDNNote)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FQDNNote) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FQDNNoteEntry) DeepCopyInto(out *FQDNNoteEntry) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FQDNNoteEntry.
+func (in *FQDNNoteEntry) DeepCopy() *FQDNNoteEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(FQDNNoteEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FQDNNoteList) DeepCopyInto(out *FQDNNoteList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FQDNNote, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FQDNNoteList.
+func (in *FQDNNoteList) DeepCopy() *FQDNNoteList {
+	if in == nil {
+		return nil
+	}
+	out := new(FQDNNoteList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FQDNNoteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FQDNNoteSpec) DeepCopyInto(out *FQDNNoteSpec) {
+	*out = *in
+	if in.Notes != nil {
+		in, out := &in.Notes, &out.Notes
+		*out = make([]FQDNNoteEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FQDNNoteSpec.
+func (in *FQDNNoteSpec) DeepCopy() *FQDNNoteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FQDNNoteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FQDNStatus) DeepCopyInto(out *FQDNStatus) {
 	*out = *in
@@ -1694,6 +1790,36 @@ func (in *Portal) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortalBranding) DeepCopyInto(out *PortalBranding) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortalBranding.
+func (in *PortalBranding) DeepCopy() *PortalBranding {
+	if in == nil {
+		return nil
+	}
+	out := new(PortalBranding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortalContentRef) DeepCopyInto(out *PortalContentRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortalContentRef.
+func (in *PortalContentRef) DeepCopy() *PortalContentRef {
+	if in == nil {
+		return nil
+	}
+	out := new(PortalContentRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PortalFeatures) DeepCopyInto(out *PortalFeatures) {
 	*out = *in
@@ -1754,6 +1880,21 @@ func (in *PortalFeaturesStatus) DeepCopy() *PortalFeaturesStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortalLink) DeepCopyInto(out *PortalLink) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortalLink.
+func (in *PortalLink) DeepCopy() *PortalLink {
+	if in == nil {
+		return nil
+	}
+	out := new(PortalLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PortalList) DeepCopyInto(out *PortalList) {
 	*out = *in
@@ -1799,6 +1940,31 @@ func (in *PortalSpec) DeepCopyInto(out *PortalSpec) {
 		*out = new(PortalFeatures)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SourcePriority != nil {
+		in, out := &in.SourcePriority, &out.SourcePriority
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Links != nil {
+		in, out := &in.Links, &out.Links
+		*out = make([]PortalLink, len(*in))
+		copy(*out, *in)
+	}
+	if in.Branding != nil {
+		in, out := &in.Branding, &out.Branding
+		*out = new(PortalBranding)
+		**out = **in
+	}
+	if in.ContentRefs != nil {
+		in, out := &in.ContentRefs, &out.ContentRefs
+		*out = make([]PortalContentRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedGroups != nil {
+		in, out := &in.AllowedGroups, &out.AllowedGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortalSpec.
@@ -1957,14 +2123,49 @@ func (in *ReleaseStatus) DeepCopy() *ReleaseStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteAuthConfig) DeepCopyInto(out *RemoteAuthConfig) {
+	*out = *in
+	if in.BearerTokenSecretRef != nil {
+		in, out := &in.BearerTokenSecretRef, &out.BearerTokenSecretRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	if in.BasicAuthSecretRef != nil {
+		in, out := &in.BasicAuthSecretRef, &out.BasicAuthSecretRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteAuthConfig.
+func (in *RemoteAuthConfig) DeepCopy() *RemoteAuthConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteAuthConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemotePortalSpec) DeepCopyInto(out *RemotePortalSpec) {
 	*out = *in
+	if in.Snapshot != nil {
+		in, out := &in.Snapshot, &out.Snapshot
+		*out = new(RemoteSnapshotSource)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
 		*out = new(RemoteTLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(RemoteAuthConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemotePortalSpec.
@@ -1977,6 +2178,42 @@ func (in *RemotePortalSpec) DeepCopy() *RemotePortalSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteSnapshotSource) DeepCopyInto(out *RemoteSnapshotSource) {
+	*out = *in
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteSnapshotSource.
+func (in *RemoteSnapshotSource) DeepCopy() *RemoteSnapshotSource {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteSnapshotSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteStreamStatus) DeepCopyInto(out *RemoteStreamStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteStreamStatus.
+func (in *RemoteStreamStatus) DeepCopy() *RemoteStreamStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteStreamStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteSyncStatus) DeepCopyInto(out *RemoteSyncStatus) {
 	*out = *in
@@ -1984,11 +2221,21 @@ func (in *RemoteSyncStatus) DeepCopyInto(out *RemoteSyncStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.LastSyncDuration != nil {
+		in, out := &in.LastSyncDuration, &out.LastSyncDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	if in.Features != nil {
 		in, out := &in.Features, &out.Features
 		*out = new(PortalFeaturesStatus)
 		**out = **in
 	}
+	if in.Stream != nil {
+		in, out := &in.Stream, &out.Stream
+		*out = new(RemoteStreamStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteSyncStatus.
@@ -1,8 +1,10 @@
 package v1alpha2
 
+import "strings"
+
 // SourceType identifies an external-dns source kind referenced by DNSRecord.spec.sourceType
-// and by SourcesSpec.Priority.
-// +kubebuilder:validation:Enum=service;ingress;dnsendpoint;istio-gateway;istio-virtualservice;gateway-httproute;gateway-grpcroute;gateway-tlsroute;gateway-tcproute;gateway-udproute;crossplane-scaleway-record
+// and by SourcesSpec.Priority. The DNSRecords of an agent carry an agent
+// source type (see AgentSourceType), which is not a source kind.
 type SourceType string
 
 const (
@@ -16,9 +18,27 @@ const (
 	SourceTypeGatewayTLSRoute          SourceType = "gateway-tlsroute"
 	SourceTypeGatewayTCPRoute          SourceType = "gateway-tcproute"
 	SourceTypeGatewayUDPRoute          SourceType = "gateway-udproute"
+	SourceTypeContourHTTPProxy         SourceType = "contour-httpproxy"
+	SourceTypeAmbassadorHost           SourceType = "ambassador-host"
 	SourceTypeCrossplaneScalewayRecord SourceType = "crossplane-scaleway-record"
+	SourceTypeDemo                     SourceType = "demo"
 )
 
+// agentSourceTypePrefix prefixes the source type of the DNSRecord holding the
+// FQDNs pushed by an agent.
+const agentSourceTypePrefix = "agent:"
+
+// AgentSourceType returns the source type of the DNSRecord holding the FQDNs
+// pushed by agent.
+func AgentSourceType(agent string) SourceType {
+	return SourceType(agentSourceTypePrefix + agent)
+}
+
+// IsAgent reports whether t is the source type of an agent DNSRecord.
+func (t SourceType) IsAgent() bool {
+	return strings.HasPrefix(string(t), agentSourceTypePrefix)
+}
+
 // SyncStatus is the DNS-side resolution status of an FQDN.
 // +kubebuilder:validation:Enum=sync;notavailable;notsync;""
 type SyncStatus string
@@ -30,6 +50,41 @@ const (
 	SyncStatusNotSync      SyncStatus = "notsync"
 )
 
+// LookupFailure classifies why the DNS lookup of an FQDN failed.
+// +kubebuilder:validation:Enum=nxdomain;servfail;timeout;refused;error;""
+type LookupFailure string
+
+const (
+	LookupFailureNone     LookupFailure = ""
+	LookupFailureNXDomain LookupFailure = "nxdomain"
+	LookupFailureServFail LookupFailure = "servfail"
+	LookupFailureTimeout  LookupFailure = "timeout"
+	LookupFailureRefused  LookupFailure = "refused"
+	LookupFailureError    LookupFailure = "error"
+)
+
+// Availability is the outcome of the last connection probe of an FQDN.
+// +kubebuilder:validation:Enum=up;down;""
+type Availability string
+
+const (
+	AvailabilityUnknown Availability = ""
+	AvailabilityUp      Availability = "up"
+	AvailabilityDown    Availability = "down"
+)
+
+// HealthStatus summarises the last probe of an FQDN: healthy, degraded (HTTP
+// error status or invalid TLS certificate) or unhealthy (unreachable).
+// +kubebuilder:validation:Enum=healthy;degraded;unhealthy;""
+type HealthStatus string
+
+const (
+	HealthStatusUnknown   HealthStatus = ""
+	HealthStatusHealthy   HealthStatus = "healthy"
+	HealthStatusDegraded  HealthStatus = "degraded"
+	HealthStatusUnhealthy HealthStatus = "unhealthy"
+)
+
 // FQDNGroupSource indicates where an FQDN group came from.
 // +kubebuilder:validation:Enum=manual;external-dns;remote
 type FQDNGroupSource string
@@ -50,12 +105,49 @@ const (
 // CommonSourceSpec carries the fields shared by every external-dns source spec.
 // Embed it with json:",inline" so the CRD schema remains flat (no nesting).
 type CommonSourceSpec struct {
+	// enabled turns the source on.
 	// +kubebuilder:default=false
-	Enabled                  bool   `json:"enabled"`
-	Namespace                string `json:"namespace,omitempty"`
-	AnnotationFilter         string `json:"annotationFilter,omitempty"`
-	LabelFilter              string `json:"labelFilter,omitempty"`
-	FQDNTemplate             string `json:"fqdnTemplate,omitempty"`
-	CombineFQDNAndAnnotation bool   `json:"combineFqdnAndAnnotation,omitempty"`
-	IgnoreHostnameAnnotation bool   `json:"ignoreHostnameAnnotation,omitempty"`
+	Enabled bool `json:"enabled"`
+
+	// namespace restricts the source to one namespace. Every namespace is
+	// watched when empty, unless spec.defaults.namespace is set.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Namespace string `json:"namespace,omitempty"`
+
+	// annotationFilter is an annotation selector (e.g.
+	// "kubernetes.io/ingress.class=nginx") the source objects must match.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	AnnotationFilter string `json:"annotationFilter,omitempty"`
+
+	// labelFilter is a label selector (e.g. "team=sre,env!=dev") the source
+	// objects must match.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	LabelFilter string `json:"labelFilter,omitempty"`
+
+	// fqdnTemplate is a Go template rendering the FQDNs of the source objects
+	// that carry no hostname annotation, from their name and namespace.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	FQDNTemplate string `json:"fqdnTemplate,omitempty"`
+
+	// combineFqdnAndAnnotation publishes the FQDNs of fqdnTemplate in
+	// addition to the hostname annotation instead of only as a fallback.
+	// +optional
+	CombineFQDNAndAnnotation bool `json:"combineFqdnAndAnnotation,omitempty"`
+
+	// ignoreHostnameAnnotation ignores the hostname annotation, so only
+	// fqdnTemplate produces FQDNs.
+	// +optional
+	IgnoreHostnameAnnotation bool `json:"ignoreHostnameAnnotation,omitempty"`
+
+	// recordTypeFilter restricts the source to these record types, instead
+	// of spec.sources.recordTypeFilter.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:items:Enum=A;AAAA;CNAME;TXT;SRV;MX;NS;PTR;NAPTR
+	RecordTypeFilter []string `json:"recordTypeFilter,omitempty"`
 }
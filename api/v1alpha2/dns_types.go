@@ -8,127 +8,464 @@ import (
 // in this DNS CR. A source's own Namespace/LabelFilter, when non-empty,
 // overrides the corresponding default.
 type SourceFilterDefaults struct {
+	// namespace is the namespace of the sources that set none.
 	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
 	Namespace string `json:"namespace,omitempty"`
+	// labelFilter is the label selector of the sources that set none.
 	// +optional
+	// +kubebuilder:validation:MaxLength=1024
 	LabelFilter string `json:"labelFilter,omitempty"`
 }
 
+// SourcesSpec configures the source kinds the DNS resource collects FQDNs
+// from. A source is only collected when its enabled field is true.
 type SourcesSpec struct {
-	Service                  *ServiceSourceSpec                  `json:"service,omitempty"`
-	Ingress                  *IngressSourceSpec                  `json:"ingress,omitempty"`
-	DNSEndpoint              *DNSEndpointSourceSpec              `json:"dnsEndpoint,omitempty"`
-	IstioGateway             *IstioGatewaySourceSpec             `json:"istioGateway,omitempty"`
-	IstioVirtualService      *IstioVirtualServiceSourceSpec      `json:"istioVirtualService,omitempty"`
-	GatewayHTTPRoute         *GatewayRouteSourceSpec             `json:"gatewayHTTPRoute,omitempty"`
-	GatewayGRPCRoute         *GatewayRouteSourceSpec             `json:"gatewayGRPCRoute,omitempty"`
-	GatewayTLSRoute          *GatewayRouteSourceSpec             `json:"gatewayTLSRoute,omitempty"`
-	GatewayTCPRoute          *GatewayRouteSourceSpec             `json:"gatewayTCPRoute,omitempty"`
-	GatewayUDPRoute          *GatewayRouteSourceSpec             `json:"gatewayUDPRoute,omitempty"`
+	// service collects the FQDNs of Services.
+	// +optional
+	Service *ServiceSourceSpec `json:"service,omitempty"`
+	// ingress collects the FQDNs of Ingresses.
+	// +optional
+	Ingress *IngressSourceSpec `json:"ingress,omitempty"`
+	// dnsEndpoint collects the FQDNs of external-dns DNSEndpoints.
+	// +optional
+	DNSEndpoint *DNSEndpointSourceSpec `json:"dnsEndpoint,omitempty"`
+	// istioGateway collects the FQDNs of Istio Gateways.
+	// +optional
+	IstioGateway *IstioGatewaySourceSpec `json:"istioGateway,omitempty"`
+	// istioVirtualService collects the FQDNs of Istio VirtualServices.
+	// +optional
+	IstioVirtualService *IstioVirtualServiceSourceSpec `json:"istioVirtualService,omitempty"`
+	// gatewayHTTPRoute collects the FQDNs of Gateway API HTTPRoutes.
+	// +optional
+	GatewayHTTPRoute *GatewayRouteSourceSpec `json:"gatewayHTTPRoute,omitempty"`
+	// gatewayGRPCRoute collects the FQDNs of Gateway API GRPCRoutes.
+	// +optional
+	GatewayGRPCRoute *GatewayRouteSourceSpec `json:"gatewayGRPCRoute,omitempty"`
+	// gatewayTLSRoute collects the FQDNs of Gateway API TLSRoutes.
+	// +optional
+	GatewayTLSRoute *GatewayRouteSourceSpec `json:"gatewayTLSRoute,omitempty"`
+	// gatewayTCPRoute collects the FQDNs of Gateway API TCPRoutes.
+	// +optional
+	GatewayTCPRoute *GatewayRouteSourceSpec `json:"gatewayTCPRoute,omitempty"`
+	// gatewayUDPRoute collects the FQDNs of Gateway API UDPRoutes.
+	// +optional
+	GatewayUDPRoute *GatewayRouteSourceSpec `json:"gatewayUDPRoute,omitempty"`
+	// contourHTTPProxy collects the FQDNs of Contour HTTPProxies.
+	// +optional
+	ContourHTTPProxy *ContourHTTPProxySourceSpec `json:"contourHTTPProxy,omitempty"`
+	// ambassadorHost collects the FQDNs of Emissary-ingress (Ambassador)
+	// Hosts.
+	// +optional
+	AmbassadorHost *AmbassadorHostSourceSpec `json:"ambassadorHost,omitempty"`
+	// crossplaneScalewayRecord collects the FQDNs of Crossplane Scaleway
+	// domain Records.
+	// +optional
 	CrossplaneScalewayRecord *CrossplaneScalewayRecordSourceSpec `json:"crossplaneScalewayRecord,omitempty"`
+	// demo generates synthetic FQDNs.
 	// +optional
+	Demo *DemoSourceSpec `json:"demo,omitempty"`
+	// priority orders the source kinds: when several publish the same FQDN,
+	// the first listed kind wins. Overridden by the portal spec.sourcePriority.
+	// +optional
+	// +kubebuilder:validation:items:Enum=service;ingress;dnsendpoint;istio-gateway;istio-virtualservice;gateway-httproute;gateway-grpcroute;gateway-tlsroute;gateway-tcproute;gateway-udproute;contour-httpproxy;ambassador-host;crossplane-scaleway-record;demo
 	Priority []SourceType `json:"priority,omitempty"`
+	// recordTypeFilter restricts every source to these record types, unless
+	// the source sets its own recordTypeFilter. Every type is collected when
+	// empty.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:items:Enum=A;AAAA;CNAME;TXT;SRV;MX;NS;PTR;NAPTR
+	RecordTypeFilter []string `json:"recordTypeFilter,omitempty"`
 }
 
+// ServiceSourceSpec configures the Service source.
 type ServiceSourceSpec struct {
-	CommonSourceSpec  `json:",inline"`
-	PublishInternal   bool     `json:"publishInternal,omitempty"`
-	PublishHostIP     bool     `json:"publishHostIP,omitempty"`
+	CommonSourceSpec `json:",inline"`
+	// publishInternal publishes the cluster IP of ClusterIP Services.
+	// +optional
+	PublishInternal bool `json:"publishInternal,omitempty"`
+	// publishHostIP publishes the host IP of the pods of headless Services
+	// instead of the pod IP.
+	// +optional
+	PublishHostIP bool `json:"publishHostIP,omitempty"`
+	// serviceTypeFilter restricts the source to these Service types. Every
+	// type is collected when empty.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:items:Enum=ClusterIP;NodePort;LoadBalancer;ExternalName
 	ServiceTypeFilter []string `json:"serviceTypeFilter,omitempty"`
+	// externalName publishes ExternalName Services as CNAME FQDNs, even when
+	// they carry no external-dns hostname annotation.
+	// +optional
+	ExternalName *ExternalNameSpec `json:"externalName,omitempty"`
 }
 
+// ExternalNameSpec configures how ExternalName Services are published: each
+// one becomes a CNAME from <name>.<namespace>.svc.<clusterDomain> to its
+// spec.externalName. Services already published by external-dns are skipped.
+type ExternalNameSpec struct {
+	// enabled turns the publication of ExternalName Services on.
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled"`
+	// group is the group the CNAME FQDNs are displayed in, unless the Service
+	// carries a sreportal.io/groups annotation.
+	// +kubebuilder:default="External dependencies"
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	Group string `json:"group,omitempty"`
+	// clusterDomain is the cluster DNS domain the Service names live under.
+	// +kubebuilder:default="cluster.local"
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +optional
+	ClusterDomain string `json:"clusterDomain,omitempty"`
+}
+
+// IngressSourceSpec configures the Ingress source.
 type IngressSourceSpec struct {
-	CommonSourceSpec  `json:",inline"`
+	CommonSourceSpec `json:",inline"`
+	// ingressClassNames restricts the source to Ingresses of these classes.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:items:MaxLength=253
 	IngressClassNames []string `json:"ingressClassNames,omitempty"`
 }
 
+// DNSEndpointSourceSpec configures the external-dns DNSEndpoint source.
 type DNSEndpointSourceSpec struct {
+	// enabled turns the source on.
 	// +kubebuilder:default=false
-	Enabled     bool   `json:"enabled"`
-	Namespace   string `json:"namespace,omitempty"`
+	Enabled bool `json:"enabled"`
+	// namespace restricts the source to one namespace.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Namespace string `json:"namespace,omitempty"`
+	// labelFilter is a label selector the DNSEndpoints must match.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
 	LabelFilter string `json:"labelFilter,omitempty"`
+	// recordTypeFilter restricts the source to these record types, instead
+	// of spec.sources.recordTypeFilter.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:items:Enum=A;AAAA;CNAME;TXT;SRV;MX;NS;PTR;NAPTR
+	RecordTypeFilter []string `json:"recordTypeFilter,omitempty"`
 }
 
+// IstioGatewaySourceSpec configures the Istio Gateway source.
 type IstioGatewaySourceSpec struct {
 	CommonSourceSpec `json:",inline"`
 }
 
+// IstioVirtualServiceSourceSpec configures the Istio VirtualService source.
 type IstioVirtualServiceSourceSpec struct {
 	CommonSourceSpec `json:",inline"`
 }
 
+// GatewayRouteSourceSpec configures a Gateway API route source.
 type GatewayRouteSourceSpec struct {
-	CommonSourceSpec   `json:",inline"`
-	GatewayName        string `json:"gatewayName,omitempty"`
-	GatewayNamespace   string `json:"gatewayNamespace,omitempty"`
+	CommonSourceSpec `json:",inline"`
+	// gatewayName restricts the source to routes attached to this Gateway.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	GatewayName string `json:"gatewayName,omitempty"`
+	// gatewayNamespace restricts the source to routes attached to Gateways
+	// of this namespace.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	GatewayNamespace string `json:"gatewayNamespace,omitempty"`
+	// gatewayLabelFilter is a label selector the Gateways must match.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
 	GatewayLabelFilter string `json:"gatewayLabelFilter,omitempty"`
 }
 
+// ContourHTTPProxySourceSpec configures the Contour HTTPProxy source. The
+// FQDN of a root HTTPProxy is spec.virtualhost.fqdn; the paths of its routes
+// and of the HTTPProxies it includes are listed under it.
+type ContourHTTPProxySourceSpec struct {
+	CommonSourceSpec `json:",inline"`
+}
+
+// AmbassadorHostSourceSpec configures the Emissary-ingress (Ambassador) Host
+// source. The FQDN of a Host is spec.hostname; a Host is only collected when
+// its external-dns.ambassador-service annotation names the Service exposing
+// Emissary. Hosts have no fqdnTemplate support in external-dns.
+type AmbassadorHostSourceSpec struct {
+	// enabled turns the source on.
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled"`
+	// namespace restricts the source to one namespace. Every namespace is
+	// watched when empty, unless spec.defaults.namespace is set.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Namespace string `json:"namespace,omitempty"`
+	// annotationFilter is an annotation selector the Hosts must match.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	AnnotationFilter string `json:"annotationFilter,omitempty"`
+	// labelFilter is a label selector the Hosts must match.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	LabelFilter string `json:"labelFilter,omitempty"`
+	// recordTypeFilter restricts the source to these record types, instead
+	// of spec.sources.recordTypeFilter.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:items:Enum=A;AAAA;CNAME;TXT;SRV;MX;NS;PTR;NAPTR
+	RecordTypeFilter []string `json:"recordTypeFilter,omitempty"`
+}
+
+// CrossplaneScalewayRecordSourceSpec configures the Crossplane Scaleway
+// Record source.
 type CrossplaneScalewayRecordSourceSpec struct {
+	// enabled turns the source on.
 	// +kubebuilder:default=false
-	Enabled       bool   `json:"enabled"`
-	Namespace     string `json:"namespace,omitempty"`
-	LabelFilter   string `json:"labelFilter,omitempty"`
-	ClusterScoped bool   `json:"clusterScoped,omitempty"`
+	Enabled bool `json:"enabled"`
+	// namespace restricts the source to one namespace. Ignored when
+	// clusterScoped is true.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Namespace string `json:"namespace,omitempty"`
+	// labelFilter is a label selector the Records must match.
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	LabelFilter string `json:"labelFilter,omitempty"`
+	// clusterScoped reads the cluster-scoped Record kind instead of the
+	// namespaced one.
+	// +optional
+	ClusterScoped bool `json:"clusterScoped,omitempty"`
+	// recordTypeFilter restricts the source to these record types, instead
+	// of spec.sources.recordTypeFilter.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:items:Enum=A;AAAA;CNAME;TXT;SRV;MX;NS;PTR;NAPTR
+	RecordTypeFilter []string `json:"recordTypeFilter,omitempty"`
+}
+
+// DemoSourceSpec generates synthetic FQDNs, to evaluate the portal or load
+// test it without a populated cluster. No Kubernetes resource is read.
+type DemoSourceSpec struct {
+	// enabled turns the source on.
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled"`
+	// count is the number of FQDNs generated.
+	// +kubebuilder:default=50
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+	// +optional
+	Count int32 `json:"count,omitempty"`
+	// domain is the domain the FQDNs are generated under.
+	// +kubebuilder:default="demo.example.com"
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	Domain string `json:"domain,omitempty"`
+	// groups are the UI groups the FQDNs are spread across.
+	// +kubebuilder:default={"Frontend","Backend","Data"}
+	// +kubebuilder:validation:MaxItems=32
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+	// churnPercent is the share of the FQDNs replaced by new ones every
+	// reconciliation interval, to exercise updates end to end.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	ChurnPercent int32 `json:"churnPercent,omitempty"`
 }
 
 // GroupMappingSpec configures how FQDNs are organised into groups in the UI.
 type GroupMappingSpec struct {
+	// defaultGroup is the group of the FQDNs no other rule maps.
 	// +kubebuilder:default="Services"
 	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
 	DefaultGroup string `json:"defaultGroup"`
+	// labelKey is the endpoint label key whose value is the group name.
 	// +optional
+	// +kubebuilder:validation:MaxLength=317
 	LabelKey string `json:"labelKey,omitempty"`
+	// labelKeys are further label keys tried in order after labelKey; the
+	// first one present with a non-empty value wins.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=16
+	LabelKeys []GroupLabelKeySpec `json:"labelKeys,omitempty"`
+	// byNamespace maps a namespace to the group of the FQDNs it holds.
 	// +optional
 	ByNamespace map[string]string `json:"byNamespace,omitempty"`
 }
 
+// GroupLabelKeySpec is a label key used for grouping, with the transforms
+// applied to its value before it becomes a group name.
+type GroupLabelKeySpec struct {
+	// key is the endpoint label key.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=317
+	Key string `json:"key"`
+	// stripPrefix is removed from the start of the value when present.
+	// +optional
+	StripPrefix string `json:"stripPrefix,omitempty"`
+	// titleCase turns the value into space-separated capitalized words,
+	// splitting on "-", "_" and spaces.
+	// +optional
+	TitleCase bool `json:"titleCase,omitempty"`
+}
+
 // ReconciliationSpec controls timing of the source poll loop.
 type ReconciliationSpec struct {
+	// interval is the delay between two collections of the sources, as a
+	// Go duration (e.g. "5m").
 	// +kubebuilder:default="5m"
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
 	Interval metav1.Duration `json:"interval"`
+	// retryOnError is the delay before retrying a failed collection.
 	// +kubebuilder:default="30s"
-	RetryOnError    metav1.Duration `json:"retryOnError"`
-	DisableDNSCheck bool            `json:"disableDNSCheck,omitempty"`
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	RetryOnError metav1.Duration `json:"retryOnError"`
+	// disableDNSCheck turns off the DNS resolution check of the FQDNs.
+	// +optional
+	DisableDNSCheck bool `json:"disableDNSCheck,omitempty"`
+	// recordGC controls when the auto DNSRecord of a source kind that stopped
+	// producing endpoints is garbage collected.
+	// +optional
+	RecordGC RecordGCSpec `json:"recordGC,omitempty"`
+}
+
+// ResolutionSpec overrides the DNS resolution check of the FQDNs governed by a
+// DNS resource.
+type ResolutionSpec struct {
+	// interval is the delay between two DNS checks of an FQDN, as a Go
+	// duration (e.g. "1h"). Defaults to 24h; the webhook rejects values
+	// below one minute.
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// disabledGroups are the groups whose FQDNs are never resolved, e.g.
+	// names only resolvable from an air-gapped network. An FQDN listed in
+	// several groups is skipped when any of them is disabled.
+	// +listType=set
+	// +optional
+	DisabledGroups []string `json:"disabledGroups,omitempty"`
+}
+
+// RecordDeletionPolicy is what happens to an auto DNSRecord once its grace
+// period is over.
+// +kubebuilder:validation:Enum=Delete;Retain;Annotate
+type RecordDeletionPolicy string
+
+const (
+	// RecordDeletionPolicyDelete deletes the DNSRecord.
+	RecordDeletionPolicyDelete RecordDeletionPolicy = "Delete"
+	// RecordDeletionPolicyRetain keeps the DNSRecord, with its last entries,
+	// until it is deleted by hand or its kind produces endpoints again.
+	RecordDeletionPolicyRetain RecordDeletionPolicy = "Retain"
+	// RecordDeletionPolicyAnnotate keeps the DNSRecord like Retain and marks
+	// it with the sreportal.io/orphaned-since annotation, so orphans can be
+	// listed and deleted by hand.
+	RecordDeletionPolicyAnnotate RecordDeletionPolicy = "Annotate"
+)
+
+// DefaultRecordGCEmptyRuns is the grace period used when
+// recordGC.emptyRuns is unset.
+const DefaultRecordGCEmptyRuns = 3
+
+// RecordGCSpec configures the garbage collection of auto DNSRecords.
+type RecordGCSpec struct {
+	// emptyRuns is the number of consecutive reconciles in which a source
+	// kind must produce no endpoint before its DNSRecord is collected, so a
+	// transient empty result does not drop the record and its history.
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	EmptyRuns int32 `json:"emptyRuns,omitempty"`
+	// deletionPolicy is what happens once the grace period is over: Delete
+	// removes the DNSRecord, Retain keeps it and emits a warning event,
+	// Annotate also marks it with the sreportal.io/orphaned-since annotation.
+	// +kubebuilder:default=Delete
+	// +optional
+	DeletionPolicy RecordDeletionPolicy `json:"deletionPolicy,omitempty"`
+	// dryRun only reports, in the operator logs and as events, the DNSRecords
+	// the deletion policy would delete or annotate, and leaves them as they
+	// are. Empty runs are still counted. Use it to roll out source
+	// configuration changes safely.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
 }
 
 // DNSSpec defines the desired state of DNS (v1alpha2).
 // Multiple DNS CRs may reference the same Portal via spec.portalRef
 // (1 portal → N DNS CRs, e.g. per-team split).
 type DNSSpec struct {
+	// portalRef is the name of the Portal the FQDNs are shown in.
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.portalRef is immutable"
 	PortalRef string `json:"portalRef"`
 
+	// isRemote marks a DNS resource managed by the portal controller for a
+	// remote portal. The DNS controller skips it.
 	// +optional
 	IsRemote bool `json:"isRemote,omitempty"`
 
+	// defaults are the filters of the sources that set none.
 	// +optional
 	Defaults SourceFilterDefaults `json:"defaults,omitempty"`
 
+	// sources are the source kinds FQDNs are collected from.
 	// +optional
 	Sources SourcesSpec `json:"sources,omitempty"`
 
+	// groupMapping configures how FQDNs are organised into groups.
 	// +kubebuilder:default={defaultGroup:"Services"}
 	// +optional
 	GroupMapping GroupMappingSpec `json:"groupMapping,omitempty"`
 
+	// reconciliation controls the timing of the source collection.
 	// +kubebuilder:default={interval:"5m",retryOnError:"30s"}
 	// +optional
 	Reconciliation ReconciliationSpec `json:"reconciliation,omitempty"`
+
+	// resolution overrides the schedule of the DNS resolution check of the
+	// FQDNs. Ignored when reconciliation.disableDNSCheck is set.
+	// +optional
+	Resolution ResolutionSpec `json:"resolution,omitempty"`
 }
 
 // DNSStatus defines the observed state of DNS (v1alpha2).
 type DNSStatus struct {
+	// conditions represent the current state of the DNS resource. Ready
+	// rolls up SourcesReady and SourcesHealthy.
 	// +listType=map
 	// +listMapKey=type
 	// +optional
-	Conditions         []metav1.Condition `json:"conditions,omitempty"`
-	LastReconcileTime  *metav1.Time       `json:"lastReconcileTime,omitempty"`
-	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
-	ActiveSources      []string           `json:"activeSources,omitempty"`
-	NextReconcileTime  *metav1.Time       `json:"nextReconcileTime,omitempty"`
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// lastReconcileTime is the time of the last successful collection.
+	// +optional
+	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+
+	// observedGeneration is the generation of the spec last reconciled.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// activeSources are the source kinds collected.
+	// +optional
+	ActiveSources []string `json:"activeSources,omitempty"`
+
+	// nextReconcileTime is when the next collection is scheduled.
+	// +optional
+	NextReconcileTime *metav1.Time `json:"nextReconcileTime,omitempty"`
+
+	// fqdnCount is the number of distinct FQDNs of the DNSRecords owned by
+	// this DNS resource.
+	// +optional
+	FQDNCount int32 `json:"fqdnCount,omitempty"`
 
 	// skippedEntries lists the discovered entries dropped on the last reconcile
 	// because they failed DNSRecord validation (FQDN pattern or record-type
@@ -139,6 +476,15 @@ type DNSStatus struct {
 	// +optional
 	// +kubebuilder:validation:MaxItems=100
 	SkippedEntries []SkippedFQDNStatus `json:"skippedEntries,omitempty"`
+
+	// priorityConflicts lists the FQDNs produced by several source kinds on
+	// the last reconcile, of which only the highest-priority kind is kept (see
+	// spec.sources.priority). It explains targets missing from the produced
+	// DNSRecords. The list is a bounded sample; the full count is carried by
+	// the dns_source_priority_conflicts metric.
+	// +optional
+	// +kubebuilder:validation:MaxItems=100
+	PriorityConflicts []SourcePriorityConflictStatus `json:"priorityConflicts,omitempty"`
 }
 
 // SkippedFQDNStatus describes a single entry dropped during validation.
@@ -164,6 +510,30 @@ type SkippedFQDNStatus struct {
 	Reason string `json:"reason"`
 }
 
+// SourcePriorityConflictStatus describes an FQDN produced by several source
+// kinds, of which only the highest-priority one is kept.
+type SourcePriorityConflictStatus struct {
+	// fqdn is the contested fully qualified domain name (truncated to the DNS
+	// name length limit).
+	// +kubebuilder:validation:MaxLength=253
+	FQDN string `json:"fqdn"`
+
+	// winningSource is the source kind whose endpoints are kept for the FQDN.
+	WinningSource string `json:"winningSource"`
+
+	// losingSources are the source kinds whose endpoints for the FQDN were
+	// dropped.
+	// +kubebuilder:validation:MaxItems=16
+	LosingSources []string `json:"losingSources"`
+
+	// droppedTargets are the targets of the dropped endpoints that the winning
+	// source does not publish (bounded sample).
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:MaxLength=253
+	DroppedTargets []string `json:"droppedTargets,omitempty"`
+}
+
 // FQDNGroupStatus represents a group of FQDNs in the status
 type FQDNGroupStatus struct {
 	// name is the group name
@@ -218,6 +588,58 @@ type FQDNStatus struct {
 	// +optional
 	SyncStatus SyncStatus `json:"syncStatus,omitempty"`
 
+	// internalStatus is the syncStatus observed through the cluster resolver.
+	// Only set when split-horizon resolution is enabled.
+	// +optional
+	InternalStatus SyncStatus `json:"internalStatus,omitempty"`
+
+	// externalStatus is the syncStatus observed through the configured external
+	// resolver. Only set when split-horizon resolution is enabled.
+	// +optional
+	ExternalStatus SyncStatus `json:"externalStatus,omitempty"`
+
+	// lookupFailure classifies why the last DNS check failed: nxdomain (the
+	// record is missing), servfail, timeout or refused (the DNS servers did not
+	// answer properly), or error. Empty when the lookup answered.
+	// +optional
+	LookupFailure LookupFailure `json:"lookupFailure,omitempty"`
+
+	// availability is the outcome of the last connection probe: up or down.
+	// Empty when no probe is configured for the FQDN.
+	// +optional
+	Availability Availability `json:"availability,omitempty"`
+
+	// healthStatus summarises the last probe: healthy, degraded (HTTP status
+	// of 400 or more, or invalid TLS certificate) or unhealthy (unreachable).
+	// Empty when no probe is configured for the FQDN.
+	// +optional
+	HealthStatus HealthStatus `json:"healthStatus,omitempty"`
+
+	// lastProbeTime is when the probe that last changed the outcome ran. It is
+	// not refreshed while the outcome stays the same.
+	// +optional
+	LastProbeTime *metav1.Time `json:"lastProbeTime,omitempty"`
+
+	// httpStatusCode is the status code answered to the last HTTP(S) probe.
+	// +optional
+	HTTPStatusCode int32 `json:"httpStatusCode,omitempty"`
+
+	// probeLatency is the duration of the successful probe that last changed
+	// the outcome. The latency of every probe is exported as the
+	// sreportal_dns_probe_latency_seconds metric.
+	// +optional
+	ProbeLatency *metav1.Duration `json:"probeLatency,omitempty"`
+
+	// tlsValid reports whether the certificate presented to the last HTTPS
+	// probe was valid for the FQDN. Unset for other probes.
+	// +optional
+	TLSValid *bool `json:"tlsValid,omitempty"`
+
+	// tlsNotAfter is the expiry of the certificate presented to the last
+	// HTTPS probe. Unset for other probes.
+	// +optional
+	TLSNotAfter *metav1.Time `json:"tlsNotAfter,omitempty"`
+
 	// lastSeen is the timestamp when this FQDN was last observed
 	LastSeen metav1.Time `json:"lastSeen"`
 
@@ -225,6 +647,66 @@ type FQDNStatus struct {
 	// that produced this FQDN via external-dns. Not set for manual entries.
 	// +optional
 	OriginRef *OriginResourceRef `json:"originRef,omitempty"`
+
+	// ports are the ports exposed by the source Service (Service origins only)
+	// +optional
+	Ports []ServicePort `json:"ports,omitempty"`
+
+	// paths are the HTTP route paths served under this FQDN (Ingress and
+	// Istio VirtualService origins only)
+	// +optional
+	Paths []string `json:"paths,omitempty"`
+
+	// annotations are the exposed annotations of the origin resource
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// sourceType is the source kind of the DNSRecord that produced this FQDN.
+	// Empty for manual records.
+	// +optional
+	SourceType string `json:"sourceType,omitempty"`
+
+	// dnsRecordRef identifies the DNSRecord that produced this FQDN, i.e. the
+	// record kept after source priority resolution.
+	// +optional
+	DNSRecordRef *DNSRecordReference `json:"dnsRecordRef,omitempty"`
+
+	// lastReconcileTime is the last reconcile time of the DNSRecord that
+	// produced this FQDN.
+	// +optional
+	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+
+	// ownershipConflict is set while the targets of this FQDN oscillate
+	// between sets, a sign that several external-dns deployments manage it.
+	// +optional
+	OwnershipConflict *OwnershipConflict `json:"ownershipConflict,omitempty"`
+}
+
+// OwnershipConflict reports targets alternating between sets across
+// reconciles, typically because two external-dns deployments manage the same
+// record and each rewrites the targets of the other.
+type OwnershipConflict struct {
+	// targetSets are the distinct target sets the FQDN alternates between.
+	TargetSets []TargetSet `json:"targetSets"`
+
+	// detectedAt is when the oscillation was first detected.
+	DetectedAt metav1.Time `json:"detectedAt"`
+}
+
+// TargetSet is a sorted set of targets.
+type TargetSet struct {
+	// targets are the targets of the set
+	// +optional
+	Targets []string `json:"targets,omitempty"`
+}
+
+// DNSRecordReference identifies a DNSRecord.
+type DNSRecordReference struct {
+	// namespace is the namespace of the DNSRecord
+	Namespace string `json:"namespace"`
+
+	// name is the name of the DNSRecord
+	Name string `json:"name"`
 }
 
 // +kubebuilder:object:root=true
@@ -232,6 +714,10 @@ type FQDNStatus struct {
 // +kubebuilder:resource:path=dns,scope=Namespaced
 // +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Portal",type=string,JSONPath=`.spec.portalRef`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="FQDNs",type=integer,JSONPath=`.status.fqdnCount`
+// +kubebuilder:printcolumn:name="Last Sync",type=date,JSONPath=`.status.lastReconcileTime`
+// +kubebuilder:printcolumn:name="Sources",type=string,JSONPath=`.status.activeSources`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // DNS is the Schema for the dns API
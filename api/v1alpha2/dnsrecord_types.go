@@ -16,21 +16,27 @@ const (
 // DNSRecordSpec defines the desired state of DNSRecord (v1alpha2).
 // +kubebuilder:validation:XValidation:rule="self.origin == 'auto' ? has(self.sourceType) : !has(self.sourceType) && has(self.entries) && size(self.entries) > 0",message="auto records require sourceType; manual records require entries and no sourceType"
 type DNSRecordSpec struct {
+	// origin is auto for records produced by a DNS resource, manual for
+	// records whose entries are written by hand.
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:Enum=auto;manual
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.origin is immutable"
 	Origin DNSRecordOrigin `json:"origin"`
 
+	// portalRef is the name of the Portal the FQDNs are shown in.
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec.portalRef is immutable"
 	PortalRef string `json:"portalRef"`
 
-	// Required when origin=auto. Must be empty when origin=manual.
+	// sourceType is the source kind that produced the record. Required when
+	// origin=auto. Must be empty when origin=manual.
+	// "agent:<name>" marks the record holding the FQDNs pushed by an agent.
 	// +optional
+	// +kubebuilder:validation:Pattern=`^(service|ingress|dnsendpoint|istio-gateway|istio-virtualservice|gateway-httproute|gateway-grpcroute|gateway-tlsroute|gateway-tcproute|gateway-udproute|contour-httpproxy|ambassador-host|crossplane-scaleway-record|demo|agent:[a-z0-9]([-a-z0-9]*[a-z0-9])?)$`
 	SourceType SourceType `json:"sourceType,omitempty"`
 
-	// Endpoints projected for this DNSRecord.
+	// entries are the endpoints projected for this DNSRecord.
 	//
 	// For origin=manual: required, set by the user (at least one entry).
 	// For origin=auto: written exclusively by the operator's DNS controller
@@ -47,6 +53,7 @@ type DNSRecordSpec struct {
 
 // DNSRecordEntry is a single manual DNS entry.
 type DNSRecordEntry struct {
+	// fqdn is the fully qualified domain name.
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinLength=1
 	// Pattern MUST stay byte-identical to domaindns.FQDNPattern
@@ -56,6 +63,7 @@ type DNSRecordEntry struct {
 	// +kubebuilder:validation:Pattern=`^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z]{2,}$`
 	FQDN string `json:"fqdn"`
 
+	// group is the UI group of the entry. Superseded by groups.
 	// +optional
 	Group string `json:"group,omitempty"`
 
@@ -66,9 +74,11 @@ type DNSRecordEntry struct {
 	// +optional
 	Groups []string `json:"groups,omitempty"`
 
+	// description is shown next to the FQDN in the UI.
 	// +optional
 	Description string `json:"description,omitempty"`
 
+	// recordType is the DNS record type.
 	// Enum MUST stay in sync with domaindns.ValidRecordTypes
 	// (internal/domain/dns/fqdn.go): the DNS controller pre-filters auto entries
 	// with that set so an unsupported record type doesn't get the whole
@@ -78,6 +88,8 @@ type DNSRecordEntry struct {
 	// +optional
 	RecordType string `json:"recordType,omitempty"`
 
+	// targets are the record targets: addresses for A and AAAA records, a
+	// host name for CNAME records.
 	// +optional
 	Targets []string `json:"targets,omitempty"`
 
@@ -86,18 +98,82 @@ type DNSRecordEntry struct {
 	// Set by the DNS controller for origin=auto entries; empty for manual.
 	// +optional
 	OriginRef string `json:"originRef,omitempty"`
+
+	// ports are the ports exposed by the source Service. Set by the DNS
+	// controller for origin=auto entries produced by a Service.
+	// +optional
+	Ports []ServicePort `json:"ports,omitempty"`
+
+	// paths are the HTTP route paths served under this FQDN by the source
+	// Ingress or Istio VirtualService. Set by the DNS controller for
+	// origin=auto entries.
+	// +optional
+	Paths []string `json:"paths,omitempty"`
+
+	// annotations are the annotations of the origin resource allowlisted by
+	// the operator's dnsRecord.exposedAnnotations. Set by the DNS controller
+	// for origin=auto entries.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// probe selects the connection probe run against this FQDN, as
+	// <type>:<port> (e.g. "tcp:5432"). Set by the DNS controller for
+	// origin=auto entries from the sreportal.io/probe annotation; may be set
+	// directly on manual entries.
+	// +kubebuilder:validation:Pattern=`^tcp:[0-9]{1,5}$`
+	// +optional
+	Probe string `json:"probe,omitempty"`
+}
+
+// ServicePort is a port exposed by the Kubernetes Service an FQDN originates from.
+type ServicePort struct {
+	// name is the Service port name, or its appProtocol when unnamed
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// port is the port number exposed by the Service
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// protocol is the port protocol (TCP, UDP or SCTP)
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
 }
 
 // DNSRecordStatus defines the observed state of DNSRecord (v1alpha2).
 type DNSRecordStatus struct {
-	Endpoints         []EndpointStatus `json:"endpoints,omitempty"`
-	EndpointsHash     string           `json:"endpointsHash,omitempty"`
-	LastReconcileTime *metav1.Time     `json:"lastReconcileTime,omitempty"`
+	// endpoints are the endpoints materialised from spec.entries, with their
+	// resolution and probe results.
+	// +optional
+	Endpoints []EndpointStatus `json:"endpoints,omitempty"`
+
+	// endpointsHash is a digest of the endpoint data, used to skip status
+	// writes when the endpoints did not change.
+	// +optional
+	EndpointsHash string `json:"endpointsHash,omitempty"`
+
+	// lastReconcileTime is the time the endpoints were last materialised.
+	// +optional
+	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+
+	// conditions represent the current state of the DNSRecord resource.
 	// +listType=map
 	// +listMapKey=type
 	// +optional
-	Conditions         []metav1.Condition `json:"conditions,omitempty"`
-	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// observedGeneration is the generation of the spec last materialised.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// fqdnCount is the number of distinct FQDNs of the endpoints.
+	// +optional
+	FQDNCount int32 `json:"fqdnCount,omitempty"`
+
+	// endpointCount is the number of endpoints, one per FQDN and record type.
+	// +optional
+	EndpointCount int32 `json:"endpointCount,omitempty"`
 }
 
 // EndpointStatus represents a single DNS endpoint discovered from external-dns
@@ -129,9 +205,95 @@ type EndpointStatus struct {
 	// +optional
 	SyncStatus SyncStatus `json:"syncStatus,omitempty"`
 
+	// internalStatus is the syncStatus observed through the cluster resolver.
+	// Only set when split-horizon resolution is enabled.
+	// +optional
+	InternalStatus SyncStatus `json:"internalStatus,omitempty"`
+
+	// externalStatus is the syncStatus observed through the configured external
+	// resolver. Only set when split-horizon resolution is enabled.
+	// +optional
+	ExternalStatus SyncStatus `json:"externalStatus,omitempty"`
+
+	// lookupFailure classifies why the last DNS check failed: nxdomain (the
+	// record is missing), servfail, timeout or refused (the DNS servers did not
+	// answer properly), or error. Empty when the lookup answered.
+	// +optional
+	LookupFailure LookupFailure `json:"lookupFailure,omitempty"`
+
+	// servedTTL is the TTL in seconds the resolver served for the record when
+	// ttlDrift was detected. Only set while ttlDrift is.
+	// +optional
+	ServedTTL int64 `json:"servedTTL,omitempty"`
+
+	// ttlDrift reports that the TTL served at the last DNS check exceeded ttl
+	// by more than 10%: clients cache the record longer than declared, which
+	// slows failovers. Only checked when ttl is declared, the record is in sync
+	// and the resolver reports TTLs (the dns and doh resolver types). A
+	// recursive resolver counts its cached TTL down, so a served TTL below the
+	// declared one cannot be told from a drift and is not reported.
+	// +optional
+	TTLDrift bool `json:"ttlDrift,omitempty"`
+
+	// availability is the outcome of the last connection probe: up or down.
+	// Empty when no probe is configured for the endpoint.
+	// +optional
+	Availability Availability `json:"availability,omitempty"`
+
+	// healthStatus summarises the last probe: healthy, degraded (HTTP status
+	// of 400 or more, or invalid TLS certificate) or unhealthy (unreachable).
+	// Empty when no probe is configured for the endpoint.
+	// +optional
+	HealthStatus HealthStatus `json:"healthStatus,omitempty"`
+
+	// lastProbeTime is when the probe that last changed the outcome ran. It is
+	// not refreshed while the outcome stays the same.
+	// +optional
+	LastProbeTime *metav1.Time `json:"lastProbeTime,omitempty"`
+
+	// httpStatusCode is the status code answered to the last HTTP(S) probe.
+	// +optional
+	HTTPStatusCode int32 `json:"httpStatusCode,omitempty"`
+
+	// probeLatency is the duration of the successful probe that last changed
+	// the outcome. The latency of every probe is exported as the
+	// sreportal_dns_probe_latency_seconds metric.
+	// +optional
+	ProbeLatency *metav1.Duration `json:"probeLatency,omitempty"`
+
+	// tlsValid reports whether the certificate presented to the last HTTPS
+	// probe was valid for the endpoint. Unset for other probes.
+	// +optional
+	TLSValid *bool `json:"tlsValid,omitempty"`
+
+	// tlsNotAfter is the expiry of the certificate presented to the last
+	// HTTPS probe. Unset for other probes.
+	// +optional
+	TLSNotAfter *metav1.Time `json:"tlsNotAfter,omitempty"`
+
 	// lastSeen is the timestamp when this endpoint was last observed
 	// +kubebuilder:validation:Required
 	LastSeen metav1.Time `json:"lastSeen"`
+
+	// targetChanges are the last target sets DNS served for the endpoint,
+	// oldest first, kept to detect ownership conflicts.
+	// +optional
+	TargetChanges []TargetChange `json:"targetChanges,omitempty"`
+
+	// ownershipConflict is set while the targets oscillate between sets, a
+	// sign that several external-dns deployments manage the record.
+	// +optional
+	OwnershipConflict *OwnershipConflict `json:"ownershipConflict,omitempty"`
+}
+
+// TargetChange is a target set an endpoint switched to.
+type TargetChange struct {
+	// targets are the sorted targets of the endpoint after the change
+	// +optional
+	Targets []string `json:"targets,omitempty"`
+
+	// at is when the change was observed
+	At metav1.Time `json:"at"`
 }
 
 // +kubebuilder:object:root=true
@@ -140,8 +302,11 @@ type EndpointStatus struct {
 // +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Portal",type=string,JSONPath=`.spec.portalRef`
 // +kubebuilder:printcolumn:name="Origin",type=string,JSONPath=`.spec.origin`
+// +kubebuilder:printcolumn:name="Source",type=string,JSONPath=`.spec.sourceType`
+// +kubebuilder:printcolumn:name="FQDNs",type=integer,JSONPath=`.status.fqdnCount`
+// +kubebuilder:printcolumn:name="Endpoints",type=integer,JSONPath=`.status.endpointCount`,priority=1
+// +kubebuilder:printcolumn:name="Last Sync",type=date,JSONPath=`.status.lastReconcileTime`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
-// +kubebuilder:printcolumn:name="Endpoints",type=integer,JSONPath=`.status.endpoints`
 
 // DNSRecord is the Schema for the dnsrecords API
 type DNSRecord struct {
@@ -25,9 +25,34 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AmbassadorHostSourceSpec) DeepCopyInto(out *AmbassadorHostSourceSpec) {
+	*out = *in
+	if in.RecordTypeFilter != nil {
+		in, out := &in.RecordTypeFilter, &out.RecordTypeFilter
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AmbassadorHostSourceSpec.
+func (in *AmbassadorHostSourceSpec) DeepCopy() *AmbassadorHostSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AmbassadorHostSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonSourceSpec) DeepCopyInto(out *CommonSourceSpec) {
 	*out = *in
+	if in.RecordTypeFilter != nil {
+		in, out := &in.RecordTypeFilter, &out.RecordTypeFilter
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonSourceSpec.
@@ -40,9 +65,30 @@ func (in *CommonSourceSpec) DeepCopy() *CommonSourceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContourHTTPProxySourceSpec) DeepCopyInto(out *ContourHTTPProxySourceSpec) {
+	*out = *in
+	in.CommonSourceSpec.DeepCopyInto(&out.CommonSourceSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContourHTTPProxySourceSpec.
+func (in *ContourHTTPProxySourceSpec) DeepCopy() *ContourHTTPProxySourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ContourHTTPProxySourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CrossplaneScalewayRecordSourceSpec) DeepCopyInto(out *CrossplaneScalewayRecordSourceSpec) {
 	*out = *in
+	if in.RecordTypeFilter != nil {
+		in, out := &in.RecordTypeFilter, &out.RecordTypeFilter
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CrossplaneScalewayRecordSourceSpec.
@@ -85,6 +131,11 @@ func (in *DNS) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNSEndpointSourceSpec) DeepCopyInto(out *DNSEndpointSourceSpec) {
 	*out = *in
+	if in.RecordTypeFilter != nil {
+		in, out := &in.RecordTypeFilter, &out.RecordTypeFilter
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSEndpointSourceSpec.
@@ -169,6 +220,23 @@ func (in *DNSRecordEntry) DeepCopyInto(out *DNSRecordEntry) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]ServicePort, len(*in))
+		copy(*out, *in)
+	}
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordEntry.
@@ -213,6 +281,21 @@ func (in *DNSRecordList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecordReference) DeepCopyInto(out *DNSRecordReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordReference.
+func (in *DNSRecordReference) DeepCopy() *DNSRecordReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecordReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNSRecordSpec) DeepCopyInto(out *DNSRecordSpec) {
 	*out = *in
@@ -275,6 +358,7 @@ func (in *DNSSpec) DeepCopyInto(out *DNSSpec) {
 	in.Sources.DeepCopyInto(&out.Sources)
 	in.GroupMapping.DeepCopyInto(&out.GroupMapping)
 	out.Reconciliation = in.Reconciliation
+	in.Resolution.DeepCopyInto(&out.Resolution)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSpec.
@@ -315,6 +399,13 @@ func (in *DNSStatus) DeepCopyInto(out *DNSStatus) {
 		*out = make([]SkippedFQDNStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.PriorityConflicts != nil {
+		in, out := &in.PriorityConflicts, &out.PriorityConflicts
+		*out = make([]SourcePriorityConflictStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSStatus.
@@ -327,6 +418,26 @@ func (in *DNSStatus) DeepCopy() *DNSStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DemoSourceSpec) DeepCopyInto(out *DemoSourceSpec) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DemoSourceSpec.
+func (in *DemoSourceSpec) DeepCopy() *DemoSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DemoSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EndpointStatus) DeepCopyInto(out *EndpointStatus) {
 	*out = *in
@@ -342,7 +453,37 @@ func (in *EndpointStatus) DeepCopyInto(out *EndpointStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.LastProbeTime != nil {
+		in, out := &in.LastProbeTime, &out.LastProbeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ProbeLatency != nil {
+		in, out := &in.ProbeLatency, &out.ProbeLatency
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TLSValid != nil {
+		in, out := &in.TLSValid, &out.TLSValid
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLSNotAfter != nil {
+		in, out := &in.TLSNotAfter, &out.TLSNotAfter
+		*out = (*in).DeepCopy()
+	}
 	in.LastSeen.DeepCopyInto(&out.LastSeen)
+	if in.TargetChanges != nil {
+		in, out := &in.TargetChanges, &out.TargetChanges
+		*out = make([]TargetChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OwnershipConflict != nil {
+		in, out := &in.OwnershipConflict, &out.OwnershipConflict
+		*out = new(OwnershipConflict)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointStatus.
@@ -355,6 +496,21 @@ func (in *EndpointStatus) DeepCopy() *EndpointStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalNameSpec) DeepCopyInto(out *ExternalNameSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalNameSpec.
+func (in *ExternalNameSpec) DeepCopy() *ExternalNameSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalNameSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FQDNGroupStatus) DeepCopyInto(out *FQDNGroupStatus) {
 	*out = *in
@@ -385,12 +541,61 @@ func (in *FQDNStatus) DeepCopyInto(out *FQDNStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastProbeTime != nil {
+		in, out := &in.LastProbeTime, &out.LastProbeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ProbeLatency != nil {
+		in, out := &in.ProbeLatency, &out.ProbeLatency
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TLSValid != nil {
+		in, out := &in.TLSValid, &out.TLSValid
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLSNotAfter != nil {
+		in, out := &in.TLSNotAfter, &out.TLSNotAfter
+		*out = (*in).DeepCopy()
+	}
 	in.LastSeen.DeepCopyInto(&out.LastSeen)
 	if in.OriginRef != nil {
 		in, out := &in.OriginRef, &out.OriginRef
 		*out = new(OriginResourceRef)
 		**out = **in
 	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]ServicePort, len(*in))
+		copy(*out, *in)
+	}
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DNSRecordRef != nil {
+		in, out := &in.DNSRecordRef, &out.DNSRecordRef
+		*out = new(DNSRecordReference)
+		**out = **in
+	}
+	if in.LastReconcileTime != nil {
+		in, out := &in.LastReconcileTime, &out.LastReconcileTime
+		*out = (*in).DeepCopy()
+	}
+	if in.OwnershipConflict != nil {
+		in, out := &in.OwnershipConflict, &out.OwnershipConflict
+		*out = new(OwnershipConflict)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FQDNStatus.
@@ -406,7 +611,7 @@ func (in *FQDNStatus) DeepCopy() *FQDNStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayRouteSourceSpec) DeepCopyInto(out *GatewayRouteSourceSpec) {
 	*out = *in
-	out.CommonSourceSpec = in.CommonSourceSpec
+	in.CommonSourceSpec.DeepCopyInto(&out.CommonSourceSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayRouteSourceSpec.
@@ -419,9 +624,29 @@ func (in *GatewayRouteSourceSpec) DeepCopy() *GatewayRouteSourceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupLabelKeySpec) DeepCopyInto(out *GroupLabelKeySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupLabelKeySpec.
+func (in *GroupLabelKeySpec) DeepCopy() *GroupLabelKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupLabelKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupMappingSpec) DeepCopyInto(out *GroupMappingSpec) {
 	*out = *in
+	if in.LabelKeys != nil {
+		in, out := &in.LabelKeys, &out.LabelKeys
+		*out = make([]GroupLabelKeySpec, len(*in))
+		copy(*out, *in)
+	}
 	if in.ByNamespace != nil {
 		in, out := &in.ByNamespace, &out.ByNamespace
 		*out = make(map[string]string, len(*in))
@@ -444,7 +669,7 @@ func (in *GroupMappingSpec) DeepCopy() *GroupMappingSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressSourceSpec) DeepCopyInto(out *IngressSourceSpec) {
 	*out = *in
-	out.CommonSourceSpec = in.CommonSourceSpec
+	in.CommonSourceSpec.DeepCopyInto(&out.CommonSourceSpec)
 	if in.IngressClassNames != nil {
 		in, out := &in.IngressClassNames, &out.IngressClassNames
 		*out = make([]string, len(*in))
@@ -465,7 +690,7 @@ func (in *IngressSourceSpec) DeepCopy() *IngressSourceSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IstioGatewaySourceSpec) DeepCopyInto(out *IstioGatewaySourceSpec) {
 	*out = *in
-	out.CommonSourceSpec = in.CommonSourceSpec
+	in.CommonSourceSpec.DeepCopyInto(&out.CommonSourceSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioGatewaySourceSpec.
@@ -481,7 +706,7 @@ func (in *IstioGatewaySourceSpec) DeepCopy() *IstioGatewaySourceSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IstioVirtualServiceSourceSpec) DeepCopyInto(out *IstioVirtualServiceSourceSpec) {
 	*out = *in
-	out.CommonSourceSpec = in.CommonSourceSpec
+	in.CommonSourceSpec.DeepCopyInto(&out.CommonSourceSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioVirtualServiceSourceSpec.
@@ -509,11 +734,35 @@ func (in *OriginResourceRef) DeepCopy() *OriginResourceRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OwnershipConflict) DeepCopyInto(out *OwnershipConflict) {
+	*out = *in
+	if in.TargetSets != nil {
+		in, out := &in.TargetSets, &out.TargetSets
+		*out = make([]TargetSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.DetectedAt.DeepCopyInto(&out.DetectedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OwnershipConflict.
+func (in *OwnershipConflict) DeepCopy() *OwnershipConflict {
+	if in == nil {
+		return nil
+	}
+	out := new(OwnershipConflict)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReconciliationSpec) DeepCopyInto(out *ReconciliationSpec) {
 	*out = *in
 	out.Interval = in.Interval
 	out.RetryOnError = in.RetryOnError
+	out.RecordGC = in.RecordGC
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconciliationSpec.
@@ -526,15 +775,75 @@ func (in *ReconciliationSpec) DeepCopy() *ReconciliationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecordGCSpec) DeepCopyInto(out *RecordGCSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecordGCSpec.
+func (in *RecordGCSpec) DeepCopy() *RecordGCSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RecordGCSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolutionSpec) DeepCopyInto(out *ResolutionSpec) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DisabledGroups != nil {
+		in, out := &in.DisabledGroups, &out.DisabledGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolutionSpec.
+func (in *ResolutionSpec) DeepCopy() *ResolutionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolutionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServicePort) DeepCopyInto(out *ServicePort) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePort.
+func (in *ServicePort) DeepCopy() *ServicePort {
+	if in == nil {
+		return nil
+	}
+	out := new(ServicePort)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceSourceSpec) DeepCopyInto(out *ServiceSourceSpec) {
 	*out = *in
-	out.CommonSourceSpec = in.CommonSourceSpec
+	in.CommonSourceSpec.DeepCopyInto(&out.CommonSourceSpec)
 	if in.ServiceTypeFilter != nil {
 		in, out := &in.ServiceTypeFilter, &out.ServiceTypeFilter
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ExternalName != nil {
+		in, out := &in.ExternalName, &out.ExternalName
+		*out = new(ExternalNameSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceSourceSpec.
@@ -577,6 +886,31 @@ func (in *SourceFilterDefaults) DeepCopy() *SourceFilterDefaults {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourcePriorityConflictStatus) DeepCopyInto(out *SourcePriorityConflictStatus) {
+	*out = *in
+	if in.LosingSources != nil {
+		in, out := &in.LosingSources, &out.LosingSources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DroppedTargets != nil {
+		in, out := &in.DroppedTargets, &out.DroppedTargets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourcePriorityConflictStatus.
+func (in *SourcePriorityConflictStatus) DeepCopy() *SourcePriorityConflictStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SourcePriorityConflictStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SourcesSpec) DeepCopyInto(out *SourcesSpec) {
 	*out = *in
@@ -593,53 +927,73 @@ func (in *SourcesSpec) DeepCopyInto(out *SourcesSpec) {
 	if in.DNSEndpoint != nil {
 		in, out := &in.DNSEndpoint, &out.DNSEndpoint
 		*out = new(DNSEndpointSourceSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.IstioGateway != nil {
 		in, out := &in.IstioGateway, &out.IstioGateway
 		*out = new(IstioGatewaySourceSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.IstioVirtualService != nil {
 		in, out := &in.IstioVirtualService, &out.IstioVirtualService
 		*out = new(IstioVirtualServiceSourceSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.GatewayHTTPRoute != nil {
 		in, out := &in.GatewayHTTPRoute, &out.GatewayHTTPRoute
 		*out = new(GatewayRouteSourceSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.GatewayGRPCRoute != nil {
 		in, out := &in.GatewayGRPCRoute, &out.GatewayGRPCRoute
 		*out = new(GatewayRouteSourceSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.GatewayTLSRoute != nil {
 		in, out := &in.GatewayTLSRoute, &out.GatewayTLSRoute
 		*out = new(GatewayRouteSourceSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.GatewayTCPRoute != nil {
 		in, out := &in.GatewayTCPRoute, &out.GatewayTCPRoute
 		*out = new(GatewayRouteSourceSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.GatewayUDPRoute != nil {
 		in, out := &in.GatewayUDPRoute, &out.GatewayUDPRoute
 		*out = new(GatewayRouteSourceSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContourHTTPProxy != nil {
+		in, out := &in.ContourHTTPProxy, &out.ContourHTTPProxy
+		*out = new(ContourHTTPProxySourceSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AmbassadorHost != nil {
+		in, out := &in.AmbassadorHost, &out.AmbassadorHost
+		*out = new(AmbassadorHostSourceSpec)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.CrossplaneScalewayRecord != nil {
 		in, out := &in.CrossplaneScalewayRecord, &out.CrossplaneScalewayRecord
 		*out = new(CrossplaneScalewayRecordSourceSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Demo != nil {
+		in, out := &in.Demo, &out.Demo
+		*out = new(DemoSourceSpec)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Priority != nil {
 		in, out := &in.Priority, &out.Priority
 		*out = make([]SourceType, len(*in))
 		copy(*out, *in)
 	}
+	if in.RecordTypeFilter != nil {
+		in, out := &in.RecordTypeFilter, &out.RecordTypeFilter
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourcesSpec.
@@ -651,3 +1005,44 @@ func (in *SourcesSpec) DeepCopy() *SourcesSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetChange) DeepCopyInto(out *TargetChange) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.At.DeepCopyInto(&out.At)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetChange.
+func (in *TargetChange) DeepCopy() *TargetChange {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetSet) DeepCopyInto(out *TargetSet) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetSet.
+func (in *TargetSet) DeepCopy() *TargetSet {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetSet)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+
+	"github.com/golgoth31/sreportal/internal/agent"
+	"github.com/golgoth31/sreportal/internal/config"
+	sourcectrl "github.com/golgoth31/sreportal/internal/controller/source"
+	"github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1/sreportalv1connect"
+	"github.com/golgoth31/sreportal/internal/log"
+	readstoresource "github.com/golgoth31/sreportal/internal/readstore/source"
+)
+
+// Deployment modes (--mode).
+const (
+	modeOperator = "operator"
+	modeAgent    = "agent"
+)
+
+// agentAPIKeyEnv holds the API key the agent authenticates to the central
+// instance with (populated by a K8s Secret).
+const agentAPIKeyEnv = "AGENT_API_KEY"
+
+// runAgent runs the manager in agent mode: only the source collection and the
+// Publisher pushing the discovered FQDNs to the central instance. No
+// controller, webhook or web server is started and no resource is written.
+func runAgent(ctx context.Context, mgr ctrl.Manager, cfg config.AgentConfig,
+	sourceStore *readstoresource.Store, sourceReconciler *sourcectrl.SourceReconciler) error {
+	setupLog := log.Default().WithName("setup")
+
+	apiKey := os.Getenv(agentAPIKeyEnv)
+	if apiKey == "" {
+		return errors.New("agent: " + agentAPIKeyEnv + " env var is empty")
+	}
+	central := sreportalv1connect.NewDNSServiceClient(http.DefaultClient, cfg.CentralURL)
+
+	if err := mgr.Add(sourceReconciler); err != nil {
+		return fmt.Errorf("set up SourceReconciler: %w", err)
+	}
+	if err := mgr.Add(agent.NewPublisher(mgr.GetClient(), sourceStore, central, cfg, apiKey)); err != nil {
+		return fmt.Errorf("add agent publisher: %w", err)
+	}
+	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
+		return fmt.Errorf("set up health check: %w", err)
+	}
+	if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
+		return fmt.Errorf("set up ready check: %w", err)
+	}
+
+	setupLog.Info("starting agent", "name", cfg.Name, "portal", cfg.Portal,
+		"centralURL", cfg.CentralURL, "interval", cfg.Interval.Duration())
+	return mgr.Start(ctx)
+}
@@ -17,12 +17,16 @@ limitations under the License.
 package main
 
 import (
+	"cmp"
 	"context"
 	"crypto/tls"
+	"errors"
 	"flag"
 	"fmt"
 	"io/fs"
 	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -30,10 +34,13 @@ import (
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	ambassadorv2 "github.com/datawire/ambassador/pkg/api/getambassador.io/v2"
+	contourv1 "github.com/projectcontour/contour/apis/projectcontour/v1"
 	istionetworkingv1 "istio.io/client-go/pkg/apis/networking/v1"
 	istioclientset "istio.io/client-go/pkg/clientset/versioned"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/kubernetes"
@@ -55,17 +62,25 @@ import (
 	sreportal "github.com/golgoth31/sreportal"
 	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
 	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
+	"github.com/golgoth31/sreportal/internal/agent"
 	"github.com/golgoth31/sreportal/internal/alertmanagerclient"
+	"github.com/golgoth31/sreportal/internal/analytics"
 	"github.com/golgoth31/sreportal/internal/auth"
+	"github.com/golgoth31/sreportal/internal/cmdb"
 	"github.com/golgoth31/sreportal/internal/config"
 	alertmanagerctrl "github.com/golgoth31/sreportal/internal/controller/alertmanager"
+	autoportalctrl "github.com/golgoth31/sreportal/internal/controller/autoportal"
 	componentctrl "github.com/golgoth31/sreportal/internal/controller/component"
 	componentsctrl "github.com/golgoth31/sreportal/internal/controller/components"
+	consistencyctrl "github.com/golgoth31/sreportal/internal/controller/consistency"
 	dnsctrl "github.com/golgoth31/sreportal/internal/controller/dns"
 	dnschain "github.com/golgoth31/sreportal/internal/controller/dns/chain"
 	dnsrecordsctrl "github.com/golgoth31/sreportal/internal/controller/dnsrecords"
+	dnsrecordchain "github.com/golgoth31/sreportal/internal/controller/dnsrecords/chain"
 	dnsresolve "github.com/golgoth31/sreportal/internal/controller/dnsresolve"
 	emojictrl "github.com/golgoth31/sreportal/internal/controller/emoji"
+	externaldnsimport "github.com/golgoth31/sreportal/internal/controller/externaldnsimport"
 	imageinventoryctrl "github.com/golgoth31/sreportal/internal/controller/imageinventory"
 	imageregistryctrl "github.com/golgoth31/sreportal/internal/controller/imageregistry"
 	incidentctrl "github.com/golgoth31/sreportal/internal/controller/incident"
@@ -75,10 +90,35 @@ import (
 	portalctrl "github.com/golgoth31/sreportal/internal/controller/portal"
 	portalchain "github.com/golgoth31/sreportal/internal/controller/portal/chain"
 	portalfeatures "github.com/golgoth31/sreportal/internal/controller/portal/features"
+	probectrl "github.com/golgoth31/sreportal/internal/controller/probe"
 	releasectrl "github.com/golgoth31/sreportal/internal/controller/release"
+	scaleguardctrl "github.com/golgoth31/sreportal/internal/controller/scaleguard"
 	sourcectrl "github.com/golgoth31/sreportal/internal/controller/source"
+	"github.com/golgoth31/sreportal/internal/controller/statuscompaction"
+	"github.com/golgoth31/sreportal/internal/controller/storagemigration"
+	uniquenessctrl "github.com/golgoth31/sreportal/internal/controller/uniqueness"
+	"github.com/golgoth31/sreportal/internal/debugserver"
+	"github.com/golgoth31/sreportal/internal/diagnostics"
+	"github.com/golgoth31/sreportal/internal/digest"
+	domainalertmanager "github.com/golgoth31/sreportal/internal/domain/alertmanagerreadmodel"
+	domaincomponent "github.com/golgoth31/sreportal/internal/domain/component"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	domainimage "github.com/golgoth31/sreportal/internal/domain/image"
+	domainincident "github.com/golgoth31/sreportal/internal/domain/incident"
+	domainmaint "github.com/golgoth31/sreportal/internal/domain/maintenance"
+	domainnetpol "github.com/golgoth31/sreportal/internal/domain/netpol"
+	domainportal "github.com/golgoth31/sreportal/internal/domain/portal"
+	domainrelease "github.com/golgoth31/sreportal/internal/domain/release"
+	"github.com/golgoth31/sreportal/internal/export"
+	"github.com/golgoth31/sreportal/internal/federation"
+	"github.com/golgoth31/sreportal/internal/fqdnignore"
+	"github.com/golgoth31/sreportal/internal/fqdnnote"
+	"github.com/golgoth31/sreportal/internal/grpc"
 	"github.com/golgoth31/sreportal/internal/log"
+	"github.com/golgoth31/sreportal/internal/mainportal"
+	"github.com/golgoth31/sreportal/internal/manualdns"
 	"github.com/golgoth31/sreportal/internal/mcp"
+	"github.com/golgoth31/sreportal/internal/ocisnapshot"
 	alertmanagerreadstore "github.com/golgoth31/sreportal/internal/readstore/alertmanager"
 	componentreadstore "github.com/golgoth31/sreportal/internal/readstore/component"
 	dnsreadstore "github.com/golgoth31/sreportal/internal/readstore/dns"
@@ -93,7 +133,9 @@ import (
 	"github.com/golgoth31/sreportal/internal/registry"
 	releaseservice "github.com/golgoth31/sreportal/internal/release"
 	"github.com/golgoth31/sreportal/internal/remoteclient"
+	"github.com/golgoth31/sreportal/internal/scaleguard"
 	"github.com/golgoth31/sreportal/internal/slackclient"
+	"github.com/golgoth31/sreportal/internal/source"
 	"github.com/golgoth31/sreportal/internal/source/crossplanescalewayrecord"
 	"github.com/golgoth31/sreportal/internal/source/externaldns"
 	srcregistry "github.com/golgoth31/sreportal/internal/source/registry"
@@ -124,6 +166,8 @@ func init() {
 	utilruntime.Must(istionetworkingv1.AddToScheme(scheme)) // Istio Gateway, VirtualService
 	utilruntime.Must(gwapiv1.Install(scheme))               // Gateway API HTTPRoute, GRPCRoute
 	utilruntime.Must(gwapiv1alpha2.Install(scheme))         // Gateway API TCPRoute, TLSRoute, UDPRoute
+	utilruntime.Must(contourv1.AddToScheme(scheme))         // Contour HTTPProxy
+	utilruntime.Must(ambassadorv2.AddToScheme(scheme))      // Ambassador Host
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -134,6 +178,7 @@ func main() {
 	var webhookCertPath, webhookCertName, webhookCertKey string
 	var enableLeaderElection bool
 	var probeAddr string
+	var pprofAddr string
 	var webAddr string
 	var webRoot string
 	var devMode bool
@@ -141,12 +186,15 @@ func main() {
 	var enableHTTP2 bool
 	var configPath string
 	var portalNamespace string
+	var bootstrapDemo bool
 	var enableMCP bool
+	var mcpAllowWrites bool
 	var mcpTransport string
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
-	flag.StringVar(&webAddr, "web-bind-address", ":8090", "The address the web UI server binds to.")
+	flag.StringVar(&webAddr, "web-bind-address", ":8090", "The addresses the web UI server binds to, comma-separated: TCP addresses (e.g. 0.0.0.0:8090,[::]:8090 "+
+		"on a dual-stack cluster), or unix domain sockets such as unix:///var/run/sreportal.sock for an authenticating proxy sidecar.")
 	flag.StringVar(&webRoot, "web-root", "web/dist/web/browser",
 		"The path to the Angular dist directory (only used in dev mode).")
 	flag.BoolVar(&devMode, "dev-mode", false,
@@ -155,7 +203,12 @@ func main() {
 		"Path to the operator configuration file.")
 	flag.StringVar(&portalNamespace, "portal-namespace", "sreportal-system",
 		"The namespace where the main portal will be auto-created.")
-	flag.StringVar(&probeAddr, "health-probe-bind-address", ":9090", "The address the probe endpoint binds to.")
+	flag.BoolVar(&bootstrapDemo, "bootstrap-demo", false,
+		"Create a demo portal populated with sample FQDNs at startup, to evaluate sreportal. "+
+			"Turning it off again removes the demo resources.")
+	flag.StringVar(&probeAddr, "health-probe-bind-address", ":9090", "The addresses the probe endpoint binds to, comma-separated.")
+	flag.StringVar(&pprofAddr, "pprof-bind-address", "0", "The address serving net/http/pprof and expvar runtime "+
+		"counters (/debug/pprof/, /debug/vars). Leave as 0 to disable.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
@@ -174,21 +227,70 @@ func main() {
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
 	flag.BoolVar(&enableMCP, "enable-mcp", false,
 		"If set, the MCP (Model Context Protocol) server will be enabled for AI assistant integration.")
+	flag.BoolVar(&mcpAllowWrites, "mcp-allow-writes", false,
+		"If set, the DNS MCP server exposes tools adding, describing and deleting manual FQDNs.")
 	flag.StringVar(&mcpTransport, "mcp-transport", "streamable-http",
 		"The transport to use for the MCP server: 'stdio' or 'streamable-http'.")
+	var mcpMaxSessions int
+	flag.IntVar(&mcpMaxSessions, "mcp-max-sessions", 0,
+		"Maximum concurrent Streamable HTTP sessions per MCP server (0 for unlimited). "+
+			"Overrides mcp.sessions.maxSessions from the config file when set.")
+	var mcpSessionIdleTimeout time.Duration
+	flag.DurationVar(&mcpSessionIdleTimeout, "mcp-session-idle-timeout", 0,
+		"Close MCP sessions idle for that long (0 to keep them until the client closes them). "+
+			"Overrides mcp.sessions.idleTimeout from the config file when set.")
 	var corsAllowedOrigins string
 	flag.StringVar(&corsAllowedOrigins, "cors-allowed-origins", "",
 		"Comma-separated list of origins allowed for CORS requests (e.g. http://localhost:5173). "+
-			"Leave empty to disable CORS. In dev mode, http://localhost:5173 is added automatically.")
+			"Added to web.cors.allowedOrigins from the config file. In dev mode, http://localhost:5173 is added automatically.")
+	var federatedSearchConcurrency int
+	flag.IntVar(&federatedSearchConcurrency, "federated-search-concurrency", federation.DefaultConcurrency,
+		"Maximum number of remote portals queried in parallel by FederatedSearch.")
+	var federatedSearchTimeout time.Duration
+	flag.DurationVar(&federatedSearchTimeout, "federated-search-timeout", federation.DefaultSiteTimeout,
+		"Per-remote-portal deadline applied by FederatedSearch.")
+	var exportPath, exportFormat string
+	flag.StringVar(&exportPath, "export", "",
+		"If set, write the aggregated portal state (portals, groups, FQDNs, statuses) of the cluster "+
+			"to this file ('-' for stdout) and exit, without starting the manager.")
+	flag.StringVar(&exportFormat, "export-format", string(export.FormatJSON),
+		"The format of the --export bundle: 'json' or 'html' (a single self-contained page).")
+	var exportInterval time.Duration
+	flag.DurationVar(&exportInterval, "export-interval", 0,
+		"If set with --export, re-read the cluster and rewrite the bundle at this interval until the "+
+			"process is stopped, instead of exiting after a single pass. Requires a file path.")
+	var exportLastSeenAfter, exportLastSeenBefore string
+	flag.StringVar(&exportLastSeenAfter, "export-last-seen-after", "",
+		"Only export the FQDNs last seen at or after this time: an RFC 3339 timestamp, "+
+			"or a duration back from now such as '24h'.")
+	flag.StringVar(&exportLastSeenBefore, "export-last-seen-before", "",
+		"Only export the FQDNs last seen before this time: an RFC 3339 timestamp, "+
+			"or a duration back from now such as '720h' (e.g. to list stale records).")
+	var mode string
+	flag.StringVar(&mode, "mode", modeOperator,
+		"The deployment mode: 'operator' runs the full portal; 'agent' only collects the sources of the cluster "+
+			"and pushes the discovered FQDNs to a central instance (see agent in the configuration file).")
+	var logTapSize int
+	flag.IntVar(&logTapSize, "log-tap-size", 1000,
+		"The number of recent log records kept for the StreamLogs API (0 disables log streaming).")
 	var logCfg log.Config
 	logCfg.BindFlags(flag.CommandLine)
 	flag.Parse()
 
+	webAddrs := webserver.ParseAddresses(webAddr)
+	probeAddrs := webserver.ParseAddresses(probeAddr)
+	if len(probeAddrs) == 0 {
+		probeAddrs = []string{"0"}
+	}
+
 	if devMode && logCfg.Level == log.LevelInfoValue {
 		logCfg.Level = log.LevelDebugValue
 	}
 	logCfg.AddCaller = true
 	logCfg.DevMode = devMode
+	if logTapSize > 0 {
+		logCfg.Tap = log.NewTap(logTapSize)
+	}
 	if err := log.Init(logCfg); err != nil {
 		// Cannot use setupLog yet — fall back to stderr.
 		fmt.Fprintf(os.Stderr, "failed to initialise logger: %v\n", err)
@@ -209,17 +311,57 @@ func main() {
 	setupLog.Info("sreportal", "version", version.Version, "commit", version.Commit, "date", version.Date,
 		"podName", podName, "podNamespace", podNamespace, "portalNamespace", portalNamespace)
 
+	if exportPath != "" {
+		ctx := context.Background()
+		if exportInterval > 0 {
+			ctx = ctrl.SetupSignalHandler()
+		}
+		if err := runExport(ctx, exportPath, exportFormat, exportLastSeenAfter, exportLastSeenBefore,
+			exportInterval); err != nil {
+			setupLog.Error(err, "export failed", "path", exportPath)
+			os.Exit(1)
+		}
+		setupLog.Info("exported portal state", "path", exportPath, "format", exportFormat)
+		return
+	}
+
 	// Load operator configuration from file
 	operatorConfig, err := config.LoadFromFile(configPath)
 	if err != nil {
 		setupLog.Error(err, "failed to load configuration", "path", configPath)
 		os.Exit(1)
 	}
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "mcp-max-sessions":
+			operatorConfig.MCP.Sessions.MaxSessions = mcpMaxSessions
+		case "mcp-session-idle-timeout":
+			operatorConfig.MCP.Sessions.IdleTimeout = config.Duration(mcpSessionIdleTimeout)
+		}
+	})
+	if err := operatorConfig.Validate(); err != nil {
+		setupLog.Error(err, "invalid configuration", "path", configPath)
+		os.Exit(1)
+	}
 	setupLog.Info("loaded configuration", "path", configPath, "config", operatorConfig.LogSummary())
+	switch mode {
+	case modeOperator:
+	case modeAgent:
+		if err := operatorConfig.Agent.ValidateAgentMode(); err != nil {
+			setupLog.Error(err, "invalid configuration for agent mode", "path", configPath)
+			os.Exit(1)
+		}
+	default:
+		setupLog.Error(nil, "unknown mode", "mode", mode)
+		os.Exit(1)
+	}
 
 	// Build authentication chain from operator configuration.
 	// API key secret is read from an environment variable (populated by a K8s Secret).
-	var authChain *auth.Chain
+	var (
+		authChain    *auth.Chain
+		apiTokenAuth *auth.APITokenAuthenticator
+	)
 	if operatorConfig.Auth.Enabled() {
 		var authenticators []auth.Authenticator
 		if operatorConfig.Auth.APIKey != nil && operatorConfig.Auth.APIKey.Enabled {
@@ -230,9 +372,17 @@ func main() {
 				os.Exit(1)
 			}
 			authenticators = append(authenticators,
-				auth.NewAPIKeyAuthenticator(operatorConfig.Auth.APIKey.HeaderName, apiKey))
+				auth.NewAPIKeyAuthenticator(operatorConfig.Auth.APIKey.NameOrDefault(), operatorConfig.Auth.APIKey.HeaderName, apiKey))
 			setupLog.Info("auth: API key auth enabled",
-				"header", operatorConfig.Auth.APIKey.HeaderName)
+				"header", operatorConfig.Auth.APIKey.HeaderName, "name", operatorConfig.Auth.APIKey.NameOrDefault())
+		}
+		if t := operatorConfig.Auth.APITokens; t != nil && t.Enabled {
+			// The tokens are loaded once the manager client exists, then
+			// re-read by the API token reloader.
+			apiTokenAuth = auth.NewAPITokenAuthenticator()
+			authenticators = append(authenticators, apiTokenAuth)
+			setupLog.Info("auth: API token auth enabled", "secret", t.SecretName,
+				"requireForReads", t.RequireForReads)
 		}
 		if operatorConfig.Auth.JWT != nil && operatorConfig.Auth.JWT.Enabled {
 			jwtAuth, err := auth.NewJWTAuthenticator(context.Background(), *operatorConfig.Auth.JWT)
@@ -249,6 +399,43 @@ func main() {
 	} else {
 		setupLog.Warn("auth: authentication is DISABLED — write endpoints are unprotected")
 	}
+	var authorizer auth.Authorizer
+	if w := operatorConfig.Auth.AuthorizationWebhook; w != nil && w.Enabled {
+		authorizer = auth.NewWebhookAuthorizer(*w, nil)
+		setupLog.Info("auth: authorization webhook enabled", "url", w.URL, "failurePolicy", w.FailurePolicy)
+	}
+	// OIDC login of the web UI. The client secret and the session signing
+	// key are read from environment variables (populated by a K8s Secret).
+	var oidcLogin *auth.OIDCLogin
+	if o := operatorConfig.Auth.OIDC; o != nil && o.Enabled {
+		redirectURL := o.RedirectURL
+		if redirectURL == "" {
+			redirectURL = strings.TrimSuffix(operatorConfig.Web.PublicURL, "/") + auth.OIDCCallbackPath
+		}
+		var err error
+		oidcLogin, err = auth.NewOIDCLogin(context.Background(), *o, auth.OIDCClient{
+			RedirectURL:  redirectURL,
+			ClientSecret: os.Getenv("OIDC_CLIENT_SECRET"),
+			SessionKey:   []byte(os.Getenv("OIDC_SESSION_KEY")),
+		})
+		if err != nil {
+			setupLog.Error(err, "failed to initialize OIDC login")
+			os.Exit(1)
+		}
+		defer oidcLogin.Close()
+		if os.Getenv("OIDC_SESSION_KEY") == "" {
+			setupLog.Warn("auth: OIDC_SESSION_KEY env var is empty — sessions are lost on restart and not shared between replicas")
+		}
+		setupLog.Info("auth: OIDC login enabled", "issuer", o.IssuerURL, "redirectURL", redirectURL)
+	}
+	if operatorConfig.Agent.Ingest.Enabled && authChain == nil {
+		setupLog.Error(nil, "agent.ingest requires authentication: enable auth.apiKey, auth.jwt or auth.apiTokens")
+		os.Exit(1)
+	}
+	if enableMCP && mcpAllowWrites && authChain == nil {
+		setupLog.Error(nil, "--mcp-allow-writes requires authentication: enable auth.apiKey, auth.jwt or auth.apiTokens")
+		os.Exit(1)
+	}
 
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
@@ -327,13 +514,18 @@ func main() {
 		// of its RAM cost and we never read it. Strip Pods on the way into
 		// the cache (see cmd/pod_cache.go) so we keep fast cache-hit LISTs
 		// (controller-runtime/pkg/cache) without paying for full Pod objects.
+		// ConfigMaps are only read for Portal content blocks: cache just the
+		// labelled ones instead of every ConfigMap of the cluster.
 		Cache: cache.Options{
 			ByObject: map[client.Object]cache.ByObject{
 				&corev1.Pod{}: {Transform: stripPodForCache},
+				&corev1.ConfigMap{}: {Label: labels.SelectorFromSet(labels.Set{
+					adapter.PortalContentLabelKey: "true",
+				})},
 			},
 		},
 		WebhookServer:          webhookServer,
-		HealthProbeBindAddress: probeAddr,
+		HealthProbeBindAddress: probeAddrs[0],
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "198706f3.my.domain",
 		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
@@ -353,6 +545,22 @@ func main() {
 		os.Exit(1)
 	}
 
+	if apiTokenAuth != nil {
+		t := operatorConfig.Auth.APITokens
+		reloader := auth.NewAPITokenReloader(mgr.GetAPIReader(), portalNamespace, t.SecretName,
+			cmp.Or(t.ReloadInterval.Duration(), config.DefaultAPITokensReloadInterval), apiTokenAuth)
+		// A missing Secret is not fatal: the tokens are loaded once it is
+		// created.
+		if err := reloader.Reload(context.Background()); err != nil {
+			setupLog.Error(err, "auth: unable to load API tokens")
+		}
+		if err := mgr.Add(reloader); err != nil {
+			setupLog.Error(err, "unable to add API token reloader")
+			os.Exit(1)
+		}
+		setupLog.Info("auth: API tokens loaded", "tokens", apiTokenAuth.Len())
+	}
+
 	// Add field indexer for DNSRecord.spec.portalRef (v1alpha2 hub)
 	if err := mgr.GetFieldIndexer().IndexField(
 		context.Background(),
@@ -528,14 +736,103 @@ func main() {
 
 	// Create ReadStores: controllers write, gRPC/MCP read.
 	sourceStore := readstoresource.NewStore()
+	sourceHealth := readstoresource.NewHealthTracker()
 	// Native external-dns discovery (Provider) handles ingress, service,
-	// istio-gateway/virtualservice, gateway-api routes and DNSEndpoint. Only
-	// crossplane-scaleway-record, which has no native external-dns source, keeps
-	// a hand-rolled resolver.
+	// istio-gateway/virtualservice, gateway-api routes, Contour HTTPProxy,
+	// Ambassador Host and DNSEndpoint. Only crossplane-scaleway-record, which
+	// has no native external-dns source, keeps a hand-rolled resolver.
 	sourceRegistry := srcregistry.NewRegistry(
 		crossplanescalewayrecord.NewResolver(),
 	)
+
+	// Build a native external-dns source Provider for the kinds it handles
+	// (ingress, service, istio-gateway): full extraction from spec.rules/tls,
+	// every service type and gateway servers — the regression #274 dropped by
+	// replacing the external-dns sources with annotation-only resolvers. The
+	// external-dns library consumes a client-go clientset and an istio clientset
+	// (not the controller-runtime client).
+	kubeClientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		setupLog.Error(err, "unable to build kubernetes clientset for external-dns sources")
+		os.Exit(1)
+	}
+	istioClientset, err := istioclientset.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		setupLog.Error(err, "unable to build istio clientset for external-dns sources")
+		os.Exit(1)
+	}
+	sourceProvider := externaldns.NewProvider(kubeClientset, istioClientset, mgr.GetConfig())
+
+	var sourceFaults *sourcectrl.FaultInjector
+	if faultCfg := operatorConfig.FaultInjection.Sources; len(faultCfg) > 0 {
+		rules := make(map[srcregistry.SourceType]sourcectrl.FaultRule, len(faultCfg))
+		for kind, f := range faultCfg {
+			rules[srcregistry.SourceType(kind)] = sourcectrl.FaultRule{Failures: f.Failures, OutOf: f.OutOf}
+		}
+		sourceFaults = sourcectrl.NewFaultInjector(rules)
+		setupLog.Info("WARNING: source fault injection enabled, selected sources will fail on purpose",
+			"sources", faultCfg)
+	}
+
+	exposedAnnotations := operatorConfig.DNSRecord.ExposedAnnotations
+	// cmdbOnlyAnnotations are collected for the CMDB export only and stripped
+	// from the FQDNs served by the API and MCP.
+	var cmdbOnlyAnnotations []string
+	if operatorConfig.CMDB.Enabled {
+		// The CMDB export reads the owner and tier of FQDNs from their origin
+		// annotations.
+		for _, k := range operatorConfig.CMDB.AnnotationKeys() {
+			if !slices.Contains(exposedAnnotations, k) {
+				cmdbOnlyAnnotations = append(cmdbOnlyAnnotations, k)
+			}
+		}
+		exposedAnnotations = append(slices.Clone(exposedAnnotations), cmdbOnlyAnnotations...)
+	}
+
+	// The scale guard pauses discovery and rejects new FQDN streams past its
+	// hard limits. A zero interval disables it.
+	var scaleGuard *scaleguard.Guard
+	if operatorConfig.ScaleGuard.Interval.Duration() > 0 {
+		sg := operatorConfig.ScaleGuard
+		scaleGuard = scaleguard.New(map[scaleguard.Resource]scaleguard.Limit{
+			scaleguard.ResourceDNS:        scaleguard.Limit(sg.DNS),
+			scaleguard.ResourceDNSRecords: scaleguard.Limit(sg.DNSRecords),
+			scaleguard.ResourceFQDNs:      scaleguard.Limit(sg.FQDNs),
+			scaleguard.ResourceStreams:    scaleguard.Limit(sg.Streams),
+		})
+	}
+
+	sourceReconciler := &sourcectrl.SourceReconciler{
+		Client:   mgr.GetClient(),
+		Registry: sourceRegistry,
+		Store:    sourceStore,
+		Provider: sourceProvider,
+		Recorder: mgr.GetEventRecorder("source-controller"),
+		Interval: operatorConfig.Reconciliation.Interval.Duration(),
+		Faults:   sourceFaults,
+		Health:   sourceHealth,
+
+		ExposedAnnotations: exposedAnnotations,
+	}
+	if scaleGuard != nil {
+		sourceReconciler.Guard = scaleGuard
+	}
+	if mode == modeAgent {
+		if err := runAgent(ctx, mgr, operatorConfig.Agent, sourceStore, sourceReconciler); err != nil {
+			setupLog.Error(err, "problem running agent")
+			os.Exit(1)
+		}
+		return
+	}
+	if err := mgr.Add(sourceReconciler); err != nil {
+		setupLog.Error(err, "unable to set up SourceReconciler")
+		os.Exit(1)
+	}
+
 	fqdnStore := dnsreadstore.NewFQDNStore()
+	fqdnStore.SetTombstoneRetention(operatorConfig.DNSRecord.TombstoneRetention.Duration())
+	fqdnStore.SetTargetProviders(operatorConfig.DNSRecord.TargetProviders)
+	publicFQDNs := domaindns.WithoutAnnotations(fqdnStore, cmdbOnlyAnnotations)
 	portalStore := portalreadstore.NewPortalStore()
 	releaseStore := releasereadstore.NewReleaseStore()
 	alertmanagerStore := alertmanagerreadstore.NewAlertmanagerStore()
@@ -572,6 +869,21 @@ func main() {
 		sourceStore,
 		fqdnStore,
 	)
+	recordNamer, err := domaindns.NewRecordNamer(operatorConfig.DNSRecord.NameTemplate)
+	if err != nil {
+		setupLog.Error(err, "invalid dnsRecord.nameTemplate")
+		os.Exit(1)
+	}
+	dnsReconciler.SetRecordNamer(recordNamer)
+	dnsPipeline, err := dnschain.DefaultRegistry().ResolvePipeline(operatorConfig.DNSPipeline)
+	if err != nil {
+		setupLog.Error(err, "invalid dnsPipeline")
+		os.Exit(1)
+	}
+	dnsReconciler.SetPipeline(dnsPipeline)
+	setupLog.Info("DNS pipeline configured", "handlers", dnsPipeline.Handlers,
+		"resolution", dnsPipeline.Resolution, "probes", dnsPipeline.Probes)
+	dnsReconciler.SetEventRecorder(mgr.GetEventRecorder("dns-controller"))
 	if err := dnsReconciler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "DNS")
 		os.Exit(1)
@@ -582,43 +894,123 @@ func main() {
 		mgr.GetScheme(),
 	)
 	dnsRecordReconciler.SetFQDNWriter(fqdnStore)
-	dnsResolver := dnsresolve.New(mgr.GetClient(), dnschain.NewNetResolver())
-	dnsRecordReconciler.SetForcer(dnsResolver)
-	if err := mgr.Add(dnsResolver); err != nil {
-		setupLog.Error(err, "unable to add DNS resolve runnable")
+	dnsRecordReconciler.SetOriginCorrelation(mgr.GetAPIReader())
+	if pubCfg := operatorConfig.DNSEndpointPublisher; pubCfg.Enabled {
+		dnsRecordReconciler.SetDNSEndpointPublisher(pubCfg.Groups, pubCfg.Labels)
+		setupLog.Info("DNSEndpoint publisher enabled", "groups", pubCfg.Groups)
+	} else if err := mgr.Add(dnsrecordchain.NewUnpublishDNSEndpointsRunnable(mgr.GetClient(), mgr.GetAPIReader())); err != nil {
+		setupLog.Error(err, "unable to add DNSEndpoint cleanup")
 		os.Exit(1)
 	}
-	if err := dnsRecordReconciler.SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "DNSRecord")
+	primaryResolver, err := dnschain.NewResolver(operatorConfig.DNSResolution.Resolver)
+	if err != nil {
+		setupLog.Error(err, "invalid dnsResolution.resolver")
 		os.Exit(1)
 	}
-
-	// Build a native external-dns source Provider for the kinds it handles
-	// (ingress, service, istio-gateway): full extraction from spec.rules/tls,
-	// every service type and gateway servers — the regression #274 dropped by
-	// replacing the external-dns sources with annotation-only resolvers. The
-	// external-dns library consumes a client-go clientset and an istio clientset
-	// (not the controller-runtime client).
-	kubeClientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	var externalResolver dnschain.LookupResolver
+	if extCfg := operatorConfig.DNSResolution.ExternalResolverConfig(); extCfg.Type != "" {
+		externalResolver, err = dnschain.NewResolver(extCfg)
+		if err != nil {
+			setupLog.Error(err, "invalid dnsResolution.externalResolver")
+			os.Exit(1)
+		}
+	}
+	dnsResolver := dnsresolve.New(mgr.GetClient(), primaryResolver)
+	if externalResolver != nil {
+		dnsResolver.ExternalResolver = externalResolver
+		setupLog.Info("split-horizon DNS resolution enabled", "externalResolver", operatorConfig.DNSResolution.ExternalResolver)
+	}
+	if dnsPipeline.Resolution {
+		dnsRecordReconciler.SetForcer(dnsResolver)
+		if err := mgr.Add(dnsResolver); err != nil {
+			setupLog.Error(err, "unable to add DNS resolve runnable")
+			os.Exit(1)
+		}
+	}
+	groupProbes, err := probectrl.ParseGroupProbes(operatorConfig.Probes.Groups)
 	if err != nil {
-		setupLog.Error(err, "unable to build kubernetes clientset for external-dns sources")
+		setupLog.Error(err, "invalid probes.groups")
 		os.Exit(1)
 	}
-	istioClientset, err := istioclientset.NewForConfig(mgr.GetConfig())
-	if err != nil {
-		setupLog.Error(err, "unable to build istio clientset for external-dns sources")
+	if dnsPipeline.Probes {
+		if err := mgr.Add(probectrl.New(
+			mgr.GetClient(),
+			probectrl.NewTypeProber(),
+			operatorConfig.Probes.Interval.Duration(),
+			operatorConfig.Probes.Timeout.Duration(),
+			groupProbes,
+		)); err != nil {
+			setupLog.Error(err, "unable to add probe runnable")
+			os.Exit(1)
+		}
+	}
+	if scaleGuard != nil {
+		if err := mgr.Add(scaleguardctrl.New(mgr.GetClient(), fqdnStore, scaleGuard, operatorConfig.ScaleGuard.Interval.Duration())); err != nil {
+			setupLog.Error(err, "unable to add scale guard")
+			os.Exit(1)
+		}
+	}
+	if interval := operatorConfig.Consistency.Interval.Duration(); interval > 0 {
+		if err := mgr.Add(consistencyctrl.New(mgr.GetClient(), fqdnStore, interval)); err != nil {
+			setupLog.Error(err, "unable to add consistency checker")
+			os.Exit(1)
+		}
+	}
+	if interval := operatorConfig.Uniqueness.Interval.Duration(); interval > 0 {
+		recorder := mgr.GetEventRecorder("uniqueness-checker")
+		if err := mgr.Add(uniquenessctrl.New(mgr.GetClient(), fqdnStore, recorder, interval)); err != nil {
+			setupLog.Error(err, "unable to add uniqueness checker")
+			os.Exit(1)
+		}
+	}
+	if err := mgr.Add(statuscompaction.New(mgr.GetClient())); err != nil {
+		setupLog.Error(err, "unable to add DNSRecord status compaction")
 		os.Exit(1)
 	}
-	sourceProvider := externaldns.NewProvider(kubeClientset, istioClientset, mgr.GetConfig())
-
-	if err := mgr.Add(&sourcectrl.SourceReconciler{
-		Client:   mgr.GetClient(),
-		Registry: sourceRegistry,
-		Store:    sourceStore,
-		Provider: sourceProvider,
-		Interval: operatorConfig.Reconciliation.Interval.Duration(),
-	}); err != nil {
-		setupLog.Error(err, "unable to set up SourceReconciler")
+	if err := mgr.Add(storagemigration.New(mgr.GetClient())); err != nil {
+		setupLog.Error(err, "unable to add storage migration")
+		os.Exit(1)
+	}
+	if pprofAddr != "" && pprofAddr != "0" {
+		debugServer := debugserver.New(pprofAddr, ctrlmetrics.Registry, map[string]debugserver.CacheSizer{
+			"fqdns": func() int {
+				n, _ := fqdnStore.Count(context.Background(), domaindns.FQDNFilters{})
+				return n
+			},
+			"portals": func() int {
+				views, _ := portalStore.List(context.Background(), domainportal.PortalFilters{})
+				return len(views)
+			},
+			"images": func() int {
+				n, _ := imageStore.Count(context.Background(), domainimage.ImageFilters{})
+				return n
+			},
+			"sourceEndpoints": func() int {
+				n := 0
+				for _, kind := range sourceStore.Kinds() {
+					n += sourceStore.CountKind(kind)
+				}
+				return n
+			},
+		})
+		if err := mgr.Add(debugServer); err != nil {
+			setupLog.Error(err, "unable to add pprof server")
+			os.Exit(1)
+		}
+		setupLog.Info("pprof and expvar enabled", "address", pprofAddr)
+	}
+	if importCfg := operatorConfig.ExternalDNSImport; importCfg.Enabled {
+		txtResolver := primaryResolver
+		if externalResolver != nil {
+			txtResolver = externalResolver
+		}
+		if err := mgr.Add(externaldnsimport.New(mgr.GetClient(), mgr.GetAPIReader(), txtResolver, importCfg.TXTPrefix)); err != nil {
+			setupLog.Error(err, "unable to add external-dns importer")
+			os.Exit(1)
+		}
+	}
+	if err := dnsRecordReconciler.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DNSRecord")
 		os.Exit(1)
 	}
 
@@ -632,9 +1024,13 @@ func main() {
 		os.Exit(1)
 	}
 
+	// webhookChecker, set when the webhooks are enabled, lets the diagnostics
+	// check the webhook server.
+	var webhookChecker healthz.Checker
 	// nolint:goconst
 	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
-		if err := webhookv1alpha1.SetupDNSWebhookWithManager(mgr); err != nil {
+		webhookChecker = webhookServer.StartedChecker()
+		if err := webhookv1alpha1.SetupDNSWebhookWithManager(mgr, operatorConfig.Quota); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "DNS")
 			os.Exit(1)
 		}
@@ -649,7 +1045,7 @@ func main() {
 					"refusing to start with origin=auto admission open")
 			os.Exit(1)
 		}
-		if err := webhookv1alpha2.SetupDNSRecordWebhookWithManager(mgr, controllerSA); err != nil {
+		if err := webhookv1alpha2.SetupDNSRecordWebhookWithManager(mgr, controllerSA, operatorConfig.Quota); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "DNSRecord/v1alpha2")
 			os.Exit(1)
 		}
@@ -657,7 +1053,7 @@ func main() {
 			setupLog.Error(err, "unable to create webhook", "webhook", "DNSRecord/v1alpha1")
 			os.Exit(1)
 		}
-		if err := webhookv1alpha1.SetupPortalWebhookWithManager(mgr); err != nil {
+		if err := webhookv1alpha1.SetupPortalWebhookWithManager(mgr, operatorConfig.Portal, operatorConfig.Quota); err != nil {
 			setupLog.Error(err, "unable to create webhook", "webhook", "Portal")
 			os.Exit(1)
 		}
@@ -680,6 +1076,13 @@ func main() {
 	portalReconciler.SetPortalWriter(portalStore)
 	portalReconciler.SetFQDNWriter(fqdnStore)
 	portalReconciler.SetReleaseWriter(releaseStore)
+	portalReconciler.SetEventRecorder(mgr.GetEventRecorder("portal-controller"))
+	remoteStreams := portalchain.NewRemoteStreams(fqdnStore)
+	if err := mgr.Add(remoteStreams); err != nil {
+		setupLog.Error(err, "unable to add remote portal streams")
+		os.Exit(1)
+	}
+	portalReconciler.SetRemoteStreams(remoteStreams)
 	if err := portalReconciler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Portal")
 		os.Exit(1)
@@ -695,6 +1098,18 @@ func main() {
 		os.Exit(1)
 	}
 
+	if operatorConfig.AutoPortal.Enabled {
+		autoPortalReconciler, err := autoportalctrl.NewNamespaceReconciler(mgr.GetClient(), portalNamespace, operatorConfig.AutoPortal)
+		if err != nil {
+			setupLog.Error(err, "unable to create auto portal controller")
+			os.Exit(1)
+		}
+		if err := autoPortalReconciler.SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "AutoPortal")
+			os.Exit(1)
+		}
+	}
+
 	// Add runnable to ensure NetworkFlowDiscovery exists for the main portal
 	if err := mgr.Add(nfdchain.NewEnsureNFDRunnable(
 		mgr.GetClient(),
@@ -705,6 +1120,18 @@ func main() {
 		setupLog.Error(err, "unable to add NFD ensure runnable")
 		os.Exit(1)
 	}
+
+	// Add runnable to create (or, once disabled, remove) the demo portal
+	if err := mgr.Add(portalchain.NewEnsureDemoRunnable(
+		mgr.GetClient(),
+		mgr.GetCache(),
+		mgr.GetScheme(),
+		portalNamespace,
+		bootstrapDemo,
+	)); err != nil {
+		setupLog.Error(err, "unable to add demo bootstrap runnable")
+		os.Exit(1)
+	}
 	amClient := alertmanagerclient.NewClient()
 	amReconciler := alertmanagerctrl.NewAlertmanagerReconciler(
 		mgr.GetClient(),
@@ -777,6 +1204,9 @@ func main() {
 	}
 	// +kubebuilder:scaffold:builder
 
+	// The checks are kept to serve them on the extra probe addresses too.
+	healthChecks := map[string]healthz.Checker{"healthz": healthz.Ping}
+	readyChecks := map[string]healthz.Checker{"readyz": healthz.Ping}
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -805,25 +1235,152 @@ func main() {
 		os.Exit(1)
 	}
 
+	// With an OIDC login, the federated search and the MCP servers read
+	// through the portal visibility like the web services.
+	var searchFQDNs domaindns.FQDNReader = publicFQDNs
+	var searchPortals domainportal.PortalReader = portalStore
+	var visibility *auth.PortalVisibility
+	if oidcLogin != nil {
+		visibility = auth.NewPortalVisibility(portalStore)
+		searchFQDNs, searchPortals = visibility.FQDNs(publicFQDNs), visibility.Portals()
+	}
+
+	// Federated search reuses the portal reconciler's remote clients so remote
+	// portals configured with TLS are queried with the same credentials.
+	federatedSearcher := federation.NewSearcher(searchFQDNs, searchPortals,
+		func(p domainportal.PortalView) federation.RemoteSearcher {
+			return remoteCache.Lookup(p.Namespace + "/" + p.Name)
+		},
+		federation.WithConcurrency(federatedSearchConcurrency),
+		federation.WithSiteTimeout(federatedSearchTimeout),
+	)
+
+	sensitivePolicy := domaindns.NewSensitivePolicy(
+		operatorConfig.Security.SensitivePatterns,
+		operatorConfig.Security.HideSensitiveFromAnonymous,
+	)
+
+	if pubCfg := operatorConfig.SnapshotPublisher; pubCfg.Enabled {
+		// The published snapshot is what an anonymous ListFQDNs call returns:
+		// sensitive FQDNs stay hidden when the policy hides them.
+		snapshotDNS := grpc.NewDNSService(publicFQDNs, portalStore)
+		snapshotDNS.SetSensitivePolicy(sensitivePolicy, nil)
+		snapshotDNS.SetGroupSeparator(operatorConfig.Web.GroupSeparator)
+		publisher := ocisnapshot.NewPublisher(snapshotDNS, mgr.GetAPIReader(), portalNamespace, pubCfg)
+		if err := mgr.Add(publisher); err != nil {
+			setupLog.Error(err, "unable to add snapshot publisher")
+			os.Exit(1)
+		}
+		setupLog.Info("snapshot publisher enabled", "ref", publisher.Ref(),
+			"interval", pubCfg.Interval.Duration().String())
+	}
+
+	if cmdbCfg := operatorConfig.CMDB; cmdbCfg.Enabled {
+		exporter, err := cmdb.NewExporter(cmdbCfg, mgr.GetAPIReader(), portalNamespace)
+		if err != nil {
+			setupLog.Error(err, "unable to create CMDB exporter")
+			os.Exit(1)
+		}
+		if err := mgr.Add(cmdb.NewRunner(fqdnStore, mgr.GetClient(), exporter, cmdbCfg)); err != nil {
+			setupLog.Error(err, "unable to add CMDB export")
+			os.Exit(1)
+		}
+		setupLog.Info("CMDB export enabled", "exporter", exporter.Name(),
+			"interval", cmdbCfg.Interval.Duration().String())
+	}
+
+	if digestCfg := operatorConfig.Digest; digestCfg.Enabled {
+		notifiers := make(map[string]digest.Notifier, len(digestCfg.Notifiers))
+		for _, nc := range digestCfg.Notifiers {
+			n, err := digest.NewNotifier(nc, mgr.GetAPIReader(), portalNamespace)
+			if err != nil {
+				setupLog.Error(err, "unable to create digest notifier", "notifier", nc.Name)
+				os.Exit(1)
+			}
+			notifiers[nc.Name] = n
+		}
+		var certs digest.CertificateChecker
+		if certCfg := digestCfg.Certificates; certCfg.Enabled {
+			certs = digest.TLSChecker{Port: certCfg.Port, Timeout: certCfg.Timeout.Duration()}
+		}
+		runner, err := digest.NewRunner(fqdnStore, mgr.GetClient(), notifiers, certs, digestCfg)
+		if err != nil {
+			setupLog.Error(err, "unable to create digest runner")
+			os.Exit(1)
+		}
+		if err := mgr.Add(runner); err != nil {
+			setupLog.Error(err, "unable to add digest runner")
+			os.Exit(1)
+		}
+		setupLog.Info("portal digest enabled", "portals", len(digestCfg.Portals),
+			"certificates", digestCfg.Certificates.Enabled)
+	}
+
+	var agentIngester *agent.Ingester
+	if ingestCfg := operatorConfig.Agent.Ingest; ingestCfg.Enabled {
+		agentIngester = agent.NewIngester(mgr.GetClient(), ingestCfg)
+		if err := mgr.Add(agentIngester); err != nil {
+			setupLog.Error(err, "unable to add agent ingester")
+			os.Exit(1)
+		}
+		setupLog.Info("agent ingestion enabled", "agents", len(ingestCfg.Agents), "ttl", ingestCfg.TTL.Duration())
+	}
+
+	// Features reported to the web UI and MCP clients
+	capabilities := operatorConfig.Capabilities(dnsPipeline.Resolution, dnsPipeline.Probes)
+
+	// Diagnostics also report the collection health of the sources
+	diagnosticsRunner := diagnostics.NewRunner(mgr.GetClient(), kubeClientset.Discovery(), configPath, webhookChecker)
+	diagnosticsRunner.SetSourceHealth(sourceHealth)
+
+	// Write path of the manual DNS entries, shared by the web API and MCP
+	manualDNSService := manualdns.NewService(mgr.GetClient())
+
 	// Start the web server in a goroutine
 	webCfg := webserver.Config{
-		Address:             webAddr,
-		Gatherer:            ctrlmetrics.Registry,
-		ReleaseReader:       releaseStore,
-		ReleaseService:      releaseSvc,
-		ReleaseTTL:          releaseTTL,
-		ReleaseAllowedTypes: operatorConfig.Release.Types,
-		FQDNReader:          fqdnStore,
-		PortalReader:        portalStore,
-		AlertmanagerReader:  alertmanagerStore,
-		FlowGraphReader:     flowGraphStore,
-		ComponentReader:     componentStore,
-		MaintenanceReader:   maintenanceStore,
-		IncidentReader:      incidentStore,
-		ImageReader:         imageStore,
-		StatusPageService:   statuspagesvc.NewService(mgr.GetClient(), portalNamespace),
-		EmojiReader:         emojiStore,
-		AuthChain:           authChain,
+		Addresses:            webAddrs,
+		Gatherer:             ctrlmetrics.Registry,
+		ReleaseReader:        releaseStore,
+		ReleaseService:       releaseSvc,
+		ReleaseTTL:           releaseTTL,
+		ReleaseAllowedTypes:  operatorConfig.Release.Types,
+		FQDNReader:           publicFQDNs,
+		FQDNUniquenessReader: fqdnStore,
+		PortalReader:         portalStore,
+		FederatedSearcher:    federatedSearcher,
+		ManualDNSService:     manualDNSService,
+		NotesService:         fqdnnote.NewService(mgr.GetClient()),
+		MainPortalPromoter:   mainportal.NewService(mgr.GetClient()),
+		AgentIngester:        agentIngester,
+		SensitivePolicy:      sensitivePolicy,
+		AlertmanagerReader:   alertmanagerStore,
+		FlowGraphReader:      flowGraphStore,
+		ComponentReader:      componentStore,
+		MaintenanceReader:    maintenanceStore,
+		IncidentReader:       incidentStore,
+		ImageReader:          imageStore,
+		StatusPageService:    statuspagesvc.NewService(mgr.GetClient(), portalNamespace),
+		EmojiReader:          emojiStore,
+		AuthChain:            authChain,
+		Admins:               operatorConfig.Auth.Admins,
+		RequireAuthForReads:  operatorConfig.Auth.RequireForReads(),
+		Authorizer:           authorizer,
+		OIDC:                 oidcLogin,
+		LogTap:               logCfg.Tap,
+		Diagnostics:          diagnosticsRunner,
+		Capabilities:         capabilities,
+	}
+	if scaleGuard != nil {
+		webCfg.StreamLimiter = scaleGuard
+	}
+	// SetFQDNsIgnored patches cluster resources with the operator's RBAC on
+	// behalf of the caller: it is only served when callers are identified.
+	if authChain != nil || oidcLogin != nil {
+		webCfg.IgnoreService = fqdnignore.NewService(mgr.GetClient(), fqdnStore)
+	}
+	if analyticsCfg := operatorConfig.Analytics; analyticsCfg.Enabled {
+		webCfg.UsageRecorder = analytics.NewRecorder(analyticsCfg.MaxKeys, analyticsCfg.MaxClientEvents)
+		setupLog.Info("usage analytics enabled", "maxKeys", analyticsCfg.MaxKeys, "maxClientEvents", analyticsCfg.MaxClientEvents)
 	}
 	if devMode {
 		setupLog.Info("dev mode enabled: serving web UI from filesystem", "web-root", webRoot)
@@ -850,17 +1407,56 @@ func main() {
 	if devMode {
 		corsOrigins = append(corsOrigins, "http://localhost:5173")
 	}
-	webServer := webserver.New(webCfg, mgr.GetClient(), operatorConfig, corsOrigins)
+	webServer, err := webserver.New(webCfg, mgr.GetClient(), operatorConfig, corsOrigins)
+	if err != nil {
+		setupLog.Error(err, "unable to create web server")
+		os.Exit(1)
+	}
 
 	// Start MCP servers if enabled
+	var mcpServers []mcpSessionServer
 	if enableMCP {
-		dnsMcpServer := mcp.NewDNSServer(fqdnStore, portalStore)
-		alertsMcpServer := mcp.NewAlertsServer(alertmanagerStore)
+		// MCP clients carry no session: without a token, they see the
+		// public portals only.
+		dnsMcpServer := mcp.NewDNSServer(searchFQDNs, searchPortals)
+		dnsMcpServer.SetSensitivePolicy(sensitivePolicy)
+		dnsMcpServer.SetSourceEndpoints(sourceStore)
+		dnsMcpServer.SetCapabilities(capabilities, source.Capabilities(mgr.GetClient()))
+		if mcpAllowWrites {
+			dnsMcpServer.SetManualEntries(manualDNSService)
+			if authorizer != nil {
+				dnsMcpServer.SetAuthorizer(authorizer)
+			}
+			setupLog.Info("MCP manual FQDN write tools enabled")
+		}
+		var (
+			alertmanagers domainalertmanager.AlertmanagerReader = alertmanagerStore
+			releases      domainrelease.ReleaseReader           = releaseStore
+			flowGraphs    domainnetpol.FlowGraphReader          = flowGraphStore
+			components    domaincomponent.ComponentReader       = componentStore
+			maintenances  domainmaint.MaintenanceReader         = maintenanceStore
+			incidents     domainincident.IncidentReader         = incidentStore
+			images        domainimage.ImageReader               = imageStore
+		)
+		if visibility != nil {
+			alertmanagers = visibility.Alertmanagers(alertmanagers)
+			releases = visibility.Releases(releases)
+			flowGraphs = visibility.FlowGraphs(flowGraphs)
+			components = visibility.Components(components)
+			maintenances = visibility.Maintenances(maintenances)
+			incidents = visibility.Incidents(incidents)
+			images = visibility.Images(images)
+		}
+		alertsMcpServer := mcp.NewAlertsServer(alertmanagers)
 		metricsMcpServer := mcp.NewMetricsServer(ctrlmetrics.Registry)
-		releasesMcpServer := mcp.NewReleasesServer(releaseStore)
-		netpolMcpServer := mcp.NewNetpolServer(flowGraphStore)
-		statusMcpServer := mcp.NewStatusServer(componentStore, maintenanceStore, incidentStore)
-		imageMcpServer := mcp.NewImageServer(imageStore)
+		releasesMcpServer := mcp.NewReleasesServer(releases)
+		netpolMcpServer := mcp.NewNetpolServer(flowGraphs)
+		statusMcpServer := mcp.NewStatusServer(components, maintenances, incidents)
+		imageMcpServer := mcp.NewImageServer(images)
+		mcpServers = []mcpSessionServer{
+			dnsMcpServer, alertsMcpServer, metricsMcpServer, releasesMcpServer,
+			netpolMcpServer, statusMcpServer, imageMcpServer,
+		}
 
 		switch mcpTransport {
 		case "stdio":
@@ -881,8 +1477,22 @@ func main() {
 				"status", "/mcp/status",
 				"image", "/mcp/image",
 			)
-			webServer.MountHandler("/mcp", dnsMcpServer.Handler())
-			webServer.MountHandler("/mcp/dns", dnsMcpServer.Handler())
+			sessionLimits := mcp.SessionLimits{
+				MaxSessions: operatorConfig.MCP.Sessions.MaxSessions,
+				IdleTimeout: operatorConfig.MCP.Sessions.IdleTimeout.Duration(),
+			}
+			for _, srv := range mcpServers {
+				srv.SetSessionLimits(sessionLimits)
+			}
+			// The write tools edit the manual entries like BatchUpdateManualEntries:
+			// they are only served to authenticated clients, and so are the
+			// DNS tools reading FQDNs when auth.apiTokens.requireForReads is set.
+			dnsMcpHandler := dnsMcpServer.Handler()
+			if mcpAllowWrites || (authChain != nil && operatorConfig.Auth.RequireForReads()) {
+				dnsMcpHandler = auth.RequireAuth(authChain, dnsMcpHandler)
+			}
+			webServer.MountHandler("/mcp", dnsMcpHandler)
+			webServer.MountHandler("/mcp/dns", dnsMcpHandler)
 			webServer.MountHandler("/mcp/alerts", alertsMcpServer.Handler())
 			webServer.MountHandler("/mcp/metrics", metricsMcpServer.Handler())
 			webServer.MountHandler("/mcp/releases", releasesMcpServer.Handler())
@@ -895,8 +1505,26 @@ func main() {
 		}
 	}
 
+	// One readiness check per web listener, so that a replica missing one of
+	// its addresses is taken out of the endpoints.
+	for i, address := range webAddrs {
+		name := fmt.Sprintf("web-listener-%d", i)
+		readyChecks[name] = webServer.ListenerCheck(i)
+		if err := mgr.AddReadyzCheck(name, webServer.ListenerCheck(i)); err != nil {
+			setupLog.Error(err, "unable to set up web listener ready check", "address", address)
+			os.Exit(1)
+		}
+	}
+	// The manager binds the first probe address, the others are served aside.
+	if len(probeAddrs) > 1 {
+		if err := mgr.Add(webserver.NewProbeServer(probeAddrs[1:], healthChecks, readyChecks)); err != nil {
+			setupLog.Error(err, "unable to add probe server")
+			os.Exit(1)
+		}
+	}
+
 	go func() {
-		setupLog.Info("starting web server", "address", webAddr)
+		setupLog.Info("starting web server", "addresses", webAddrs)
 		if err := webServer.Start(); err != nil {
 			setupLog.Error(err, "web server failed, initiating shutdown")
 			cancel()
@@ -909,12 +1537,27 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Close MCP sessions first: their streams would otherwise hold the web
+	// server shutdown open.
+	for _, srv := range mcpServers {
+		if err := srv.Shutdown(context.Background()); err != nil {
+			setupLog.Error(err, "error closing MCP sessions")
+		}
+	}
+
 	// Gracefully shutdown web server
 	if err := webServer.Shutdown(context.Background()); err != nil {
 		setupLog.Error(err, "error shutting down web server")
 	}
 }
 
+// mcpSessionServer is an MCP server whose Streamable HTTP sessions are
+// bounded and closed on shutdown.
+type mcpSessionServer interface {
+	SetSessionLimits(mcp.SessionLimits)
+	Shutdown(context.Context) error
+}
+
 // stripPodForCache strips a Pod down to the fields the operator actually
 // reads, so the controller-runtime cache holds minimal Pod objects instead
 // of full ones.
@@ -966,3 +1609,95 @@ func stripContainers(in []corev1.Container) []corev1.Container {
 	}
 	return out
 }
+
+// runExport reads the portal state of the cluster targeted by the kubeconfig
+// and writes it to path ("-" for stdout) in the given format. With a non-zero
+// interval it repeats the pass until ctx is done, replacing the file
+// atomically each time. It only reads from the API server.
+func runExport(ctx context.Context, path, format, after, before string, interval time.Duration) error {
+	f, err := export.ParseFormat(format)
+	if err != nil {
+		return err
+	}
+	if interval > 0 && path == "-" {
+		return errors.New("--export-interval requires a file path, not stdout")
+	}
+	restCfg, err := ctrl.GetConfig()
+	if err != nil {
+		return fmt.Errorf("load kubeconfig: %w", err)
+	}
+	c, err := client.New(restCfg, client.Options{Scheme: scheme})
+	if err != nil {
+		return fmt.Errorf("create client: %w", err)
+	}
+	if err := exportOnce(ctx, c, path, f, after, before); err != nil || interval <= 0 {
+		return err
+	}
+
+	logger := log.Default().WithName("export")
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			// A failed pass keeps the previous bundle; the next tick retries.
+			if err := exportOnce(ctx, c, path, f, after, before); err != nil {
+				logger.Error(err, "export failed", "path", path)
+				continue
+			}
+			logger.Info("exported portal state", "path", path, "format", f)
+		}
+	}
+}
+
+// exportOnce collects the portal state and writes one bundle. Relative
+// last-seen bounds are resolved against the time of the pass.
+func exportOnce(ctx context.Context, c client.Reader, path string, f export.Format, after, before string) error {
+	now := time.Now()
+	var (
+		window domaindns.LastSeenWindow
+		err    error
+	)
+	if window.After, err = domaindns.ParseLastSeenBound(after, now); err != nil {
+		return fmt.Errorf("--export-last-seen-after: %w", err)
+	}
+	if window.Before, err = domaindns.ParseLastSeenBound(before, now); err != nil {
+		return fmt.Errorf("--export-last-seen-before: %w", err)
+	}
+	bundle, err := export.Collect(ctx, c, now, window)
+	if err != nil {
+		return err
+	}
+	bundle.Version = version.Version()
+
+	if path == "-" {
+		return export.Write(os.Stdout, bundle, f)
+	}
+	// Write to a sibling file and rename it, so readers of path never see a
+	// partial bundle.
+	out, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("create %s: %w", path, err)
+	}
+	if err := out.Chmod(0o644); err != nil {
+		_ = out.Close()
+		_ = os.Remove(out.Name())
+		return fmt.Errorf("create %s: %w", path, err)
+	}
+	if err := export.Write(out, bundle, f); err != nil {
+		_ = out.Close()
+		_ = os.Remove(out.Name())
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	if err := out.Close(); err != nil {
+		_ = os.Remove(out.Name())
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	if err := os.Rename(out.Name(), path); err != nil {
+		_ = os.Remove(out.Name())
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	return nil
+}
@@ -19,9 +19,10 @@ import (
 
 	v1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
 	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/controller/storagemigration"
 )
 
-const annotationV1Alpha1Groups = "sreportal.io/v1alpha1-groups"
+const annotationV1Alpha1Groups = storagemigration.V1Alpha1GroupsAnnotationKey
 
 func main() {
 	kubeconfig := flag.String("kubeconfig", "", "path to kubeconfig")
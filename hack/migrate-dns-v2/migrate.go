@@ -2,18 +2,13 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"reflect"
 
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	v1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
 	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/controller/storagemigration"
 )
 
 // Summary aggregates the outcome of a migration run so callers can decide
@@ -27,12 +22,10 @@ type Summary struct {
 }
 
 // Migrate converts the v1alpha1 groups annotation on every DNS CR into
-// origin=manual DNSRecord CRs. The v1alpha1 groups annotation is only stripped
-// from a DNS when *every* non-empty group successfully materialised — a
-// partial failure leaves the annotation in place so a retry can complete the
-// migration. When dryRun is true, Create calls use client.DryRunAll for
-// server-side validation without persisting and the annotation is never
-// removed.
+// origin=manual DNSRecord CRs (see storagemigration.MigrateV1Alpha1Groups,
+// which the operator also runs at startup). When dryRun is true, Create calls
+// use client.DryRunAll for server-side validation without persisting and the
+// annotation is never removed.
 func Migrate(ctx context.Context, c client.Client, dryRun bool) (Summary, error) {
 	var (
 		sum     Summary
@@ -46,124 +39,28 @@ func Migrate(ctx context.Context, c client.Client, dryRun bool) (Summary, error)
 	for i := range dnsList.Items {
 		sum.DNSProcessed++
 		dns := &dnsList.Items[i]
-		raw, ok := dns.Annotations[annotationV1Alpha1Groups]
-		if !ok || raw == "" {
+		if dns.Annotations[annotationV1Alpha1Groups] == "" {
 			sum.Skipped++
 			fmt.Printf("DNS %s/%s: no v1alpha1 groups annotation, skipping\n", dns.Namespace, dns.Name)
 			continue
 		}
-		var groups []v1alpha1.DNSGroup
-		if err := json.Unmarshal([]byte(raw), &groups); err != nil {
-			sum.Failures++
-			errAggr = append(errAggr, fmt.Errorf("DNS %s/%s: parse groups: %w", dns.Namespace, dns.Name, err))
-			continue
-		}
 
-		groupCount, perDNSCreated, perDNSFailures := 0, 0, 0
-		// slugOwner tracks which original group name first claimed a given
-		// record slug, so a collision between two distinct group names that
-		// normalise to the same slug fails loudly instead of silently
-		// overwriting one with the other on AlreadyExists.
-		slugOwner := map[string]string{}
-		for _, g := range groups {
-			if len(g.Entries) == 0 {
-				continue
-			}
-			groupCount++
-			recordName := dns.Name + "-manual-" + slug(g.Name)
-			if prev, claimed := slugOwner[recordName]; claimed && prev != g.Name {
-				sum.Failures++
-				perDNSFailures++
-				errAggr = append(errAggr, fmt.Errorf(
-					"DNS %s/%s: slug collision: groups %q and %q both map to record %q; rename one of them before re-running",
-					dns.Namespace, dns.Name, prev, g.Name, recordName))
-				continue
-			}
-			slugOwner[recordName] = g.Name
-			entries := make([]v1alpha2.DNSRecordEntry, 0, len(g.Entries))
-			for _, e := range g.Entries {
-				entries = append(entries, v1alpha2.DNSRecordEntry{
-					FQDN:        e.FQDN,
-					Group:       g.Name,
-					Description: e.Description,
-					RecordType:  "A",
-				})
-			}
-			record := &v1alpha2.DNSRecord{
-				ObjectMeta: metav1.ObjectMeta{Name: recordName, Namespace: dns.Namespace},
-				Spec: v1alpha2.DNSRecordSpec{
-					Origin:    v1alpha2.DNSRecordOriginManual,
-					PortalRef: dns.Spec.PortalRef,
-					Entries:   entries,
-				},
-			}
-			opts := []client.CreateOption{}
+		res, err := storagemigration.MigrateV1Alpha1Groups(ctx, c, dns, dryRun)
+		for _, name := range res.Created {
 			if dryRun {
-				opts = append(opts, client.DryRunAll)
-			}
-			if err := c.Create(ctx, record, opts...); err != nil {
-				if apierrors.IsAlreadyExists(err) {
-					// Idempotent re-run is only safe when the existing record
-					// carries the same entries we would have written. If the
-					// remote content differs, this is a slug collision with a
-					// pre-existing manual record — fail loudly so the operator
-					// can rename the group rather than lose data silently.
-					if dryRun {
-						sum.AlreadyExist++
-						perDNSCreated++
-						fmt.Printf("[dry-run] DNSRecord %s/%s already exists\n", dns.Namespace, recordName)
-						continue
-					}
-					var existing v1alpha2.DNSRecord
-					existingKey := types.NamespacedName{Namespace: dns.Namespace, Name: recordName}
-					if getErr := c.Get(ctx, existingKey, &existing); getErr != nil {
-						sum.Failures++
-						perDNSFailures++
-						errAggr = append(errAggr, fmt.Errorf(
-							"get existing %s/%s after AlreadyExists: %w",
-							dns.Namespace, recordName, getErr))
-						continue
-					}
-					if !sameEntries(existing.Spec.Entries, entries) {
-						sum.Failures++
-						perDNSFailures++
-						errAggr = append(errAggr, fmt.Errorf(
-							"DNSRecord %s/%s already exists with different entries; "+
-								"refusing to overwrite (rename group %q to avoid the collision)",
-							dns.Namespace, recordName, g.Name))
-						continue
-					}
-					sum.AlreadyExist++
-					// Count toward perDNSCreated so the annotation-strip gate
-					// (perDNSCreated == groupCount) succeeds on idempotent re-runs
-					// after a partial failure.
-					perDNSCreated++
-					fmt.Printf("DNSRecord %s/%s already exists with matching entries, leaving in place\n", dns.Namespace, recordName)
-					continue
-				}
-				sum.Failures++
-				perDNSFailures++
-				errAggr = append(errAggr, fmt.Errorf("create %s/%s: %w", dns.Namespace, recordName, err))
-				continue
-			}
-			perDNSCreated++
-			sum.Created++
-			if dryRun {
-				fmt.Printf("[dry-run] would create DNSRecord %s/%s (%d entries)\n", dns.Namespace, recordName, len(entries))
+				fmt.Printf("[dry-run] would create DNSRecord %s/%s\n", dns.Namespace, name)
 			} else {
-				fmt.Printf("created DNSRecord %s/%s\n", dns.Namespace, recordName)
+				fmt.Printf("created DNSRecord %s/%s\n", dns.Namespace, name)
 			}
 		}
-
-		// Only strip the annotation when every non-empty group succeeded so a
-		// retry can pick up any partial failures.
-		if !dryRun && perDNSFailures == 0 && perDNSCreated == groupCount && groupCount > 0 {
-			patch := client.MergeFrom(dns.DeepCopy())
-			delete(dns.Annotations, annotationV1Alpha1Groups)
-			if err := c.Patch(ctx, dns, patch); err != nil {
-				sum.Failures++
-				errAggr = append(errAggr, fmt.Errorf("remove annotation %s/%s: %w", dns.Namespace, dns.Name, err))
-			}
+		for _, name := range res.AlreadyExist {
+			fmt.Printf("DNSRecord %s/%s already exists with matching entries, leaving in place\n", dns.Namespace, name)
+		}
+		sum.Created += len(res.Created)
+		sum.AlreadyExist += len(res.AlreadyExist)
+		sum.Failures += res.Failures
+		if err != nil {
+			errAggr = append(errAggr, err)
 		}
 	}
 	if len(errAggr) > 0 {
@@ -171,27 +68,3 @@ func Migrate(ctx context.Context, c client.Client, dryRun bool) (Summary, error)
 	}
 	return sum, nil
 }
-
-// sameEntries reports whether two slices of DNSRecordEntry are equivalent
-// for migration-idempotence purposes. We compare on the fields the migrator
-// writes (FQDN, Group, Description, RecordType, Targets) and ignore any
-// fields the user might have added manually after a partial run.
-func sameEntries(existing, want []v1alpha2.DNSRecordEntry) bool {
-	if len(existing) != len(want) {
-		return false
-	}
-	idx := make(map[string]v1alpha2.DNSRecordEntry, len(want))
-	for _, e := range want {
-		idx[e.FQDN+"|"+e.RecordType] = e
-	}
-	for _, e := range existing {
-		w, ok := idx[e.FQDN+"|"+e.RecordType]
-		if !ok {
-			return false
-		}
-		if e.Group != w.Group || e.Description != w.Description || !reflect.DeepEqual(e.Targets, w.Targets) {
-			return false
-		}
-	}
-	return true
-}
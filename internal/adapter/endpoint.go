@@ -29,6 +29,7 @@ import (
 	"github.com/golgoth31/sreportal/internal/config"
 	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
 	"github.com/golgoth31/sreportal/internal/log"
+	"github.com/golgoth31/sreportal/pkg/inventory"
 )
 
 const (
@@ -80,6 +81,60 @@ const (
 	// created by the DNS reconciliation chain.
 	ManagedByDNSController = "dns-controller"
 
+	// ManagedByPortalController is the managed-by value for the shadow
+	// resources the Portal chain creates for remote portals.
+	ManagedByPortalController = "portal-controller"
+
+	// AppManagedByLabelKey is the Kubernetes recommended label identifying the
+	// tool managing a resource. Every resource the operator creates carries it
+	// with AppManagedByValue.
+	AppManagedByLabelKey = "app.kubernetes.io/managed-by"
+
+	// AppManagedByValue is the AppManagedByLabelKey value for resources
+	// created by sreportal.
+	AppManagedByValue = "sreportal"
+
+	// AutoPortalNamespaceLabelKey labels a Portal created automatically for a
+	// namespace; the value is the namespace name. Its DNS CR only discovers
+	// endpoints of that namespace.
+	AutoPortalNamespaceLabelKey = "sreportal.io/auto-portal-namespace"
+
+	// SourceTypeLabelKey labels auto-generated DNSRecords with the source kind
+	// they aggregate (e.g. "service").
+	SourceTypeLabelKey = "sreportal.io/source-type"
+
+	// AgentLabelKey labels the DNSRecord holding the FQDNs pushed by an agent
+	// with the agent name.
+	AgentLabelKey = "sreportal.io/agent"
+
+	// AgentLastPublishAnnotationKey stamps, in RFC 3339, the last push of the
+	// agent on its DNSRecord. The record is deleted once it is older than
+	// agent.ingest.ttl.
+	AgentLastPublishAnnotationKey = "sreportal.io/agent-last-publish"
+
+	// EmptyRunsAnnotationKey counts, on an auto-generated DNSRecord, the
+	// consecutive reconciles in which its source kind produced no endpoint.
+	// It is removed as soon as the kind produces again.
+	EmptyRunsAnnotationKey = "sreportal.io/empty-runs"
+
+	// OrphanedAnnotationKey marks, with the RFC 3339 time of the decision,
+	// an auto-generated DNSRecord kept by the Annotate deletion policy after
+	// its grace period. It is removed as soon as the kind produces again.
+	OrphanedAnnotationKey = "sreportal.io/orphaned-since"
+
+	// PublishAnnotationKey set to "false" on a manual DNSRecord keeps the
+	// DNSEndpoint publisher from rendering it into a DNSEndpoint CR.
+	PublishAnnotationKey = "sreportal.io/publish"
+
+	// DNSRecordLabelKey labels a published DNSEndpoint with the name of the
+	// DNSRecord it renders.
+	DNSRecordLabelKey = "sreportal.io/dnsrecord"
+
+	// PortalContentLabelKey set to "true" marks a ConfigMap as holding portal
+	// content blocks. Only labelled ConfigMaps are cached and served through
+	// spec.contentRefs.
+	PortalContentLabelKey = "sreportal.io/portal-content"
+
 	// annotationValueTrue is the canonical boolean-true value for
 	// sreportal annotations (e.g. sreportal.io/ignore: "true").
 	annotationValueTrue = "true"
@@ -100,6 +155,7 @@ var SreportalAnnotations = []string{
 	ComponentDescriptionAnnotationKey,
 	ComponentLinkAnnotationKey,
 	ComponentStatusAnnotationKey,
+	domaindns.ProbeAnnotationKey,
 }
 
 // ComponentAnnotations holds the component metadata extracted from annotations.
@@ -198,11 +254,15 @@ func strategyFromConfig(mapping *config.GroupMappingConfig) domaindns.GroupMappi
 	if mapping == nil {
 		return domaindns.GroupMappingStrategy{DefaultGroup: defaultGroupServices}
 	}
-	return domaindns.GroupMappingStrategy{
+	strategy := domaindns.GroupMappingStrategy{
 		DefaultGroup: mapping.DefaultGroup,
 		LabelKey:     mapping.LabelKey,
 		ByNamespace:  mapping.ByNamespace,
 	}
+	for _, k := range mapping.LabelKeys {
+		strategy.LabelKeys = append(strategy.LabelKeys, domaindns.GroupLabelKey(k))
+	}
+	return strategy
 }
 
 // EndpointsToGroups converts external-dns endpoints to DNS CR status groups.
@@ -405,7 +465,7 @@ func EndpointStatusToGroups(endpoints []sreportalv1alpha1.EndpointStatus, mappin
 			if idx, dup := seen[key]; dup {
 				// Merge targets from duplicate endpoint
 				existing := &groups[groupName].FQDNs[idx]
-				existing.Targets = mergeTargets(existing.Targets, ep.Targets)
+				existing.Targets = inventory.Union(existing.Targets, ep.Targets)
 				if ep.LastSeen.After(existing.LastSeen.Time) {
 					existing.LastSeen = ep.LastSeen
 				}
@@ -487,40 +547,17 @@ func ApplySourcePriority(endpointsBySource map[string][]sreportalv1alpha1.Endpoi
 // that a higher-priority source also discovers, even when the two sources publish
 // different record types (e.g. Service A vs Istio Gateway CNAME).
 func selectByPriority(endpointsBySource map[string][]sreportalv1alpha1.EndpointStatus, priority []string) []sreportalv1alpha1.EndpointStatus {
-	// Build rank map: lower index = higher priority (rank 0 beats rank 1).
-	rankOf := make(map[string]int, len(priority))
-	for i, src := range priority {
-		rankOf[src] = i
-	}
-	lowestRank := len(priority) // unlisted sources always lose to any listed source
-
-	// Sort source types so tie-breaking between equal-rank sources is alphabetical (deterministic).
+	// Phase 1: elect the winning source type per FQDN name.
+	namesBySource := make(map[string][]string, len(endpointsBySource))
 	srcTypes := make([]string, 0, len(endpointsBySource))
-	for srcType := range endpointsBySource {
+	for srcType, eps := range endpointsBySource {
 		srcTypes = append(srcTypes, srcType)
-	}
-	sort.Strings(srcTypes)
-
-	// Phase 1: elect the winning source type per FQDN name.
-	type sourceWinner struct {
-		srcType string
-		rank    int
-	}
-	winnerBySrc := make(map[string]sourceWinner) // DNSName → winning source
-
-	for _, srcType := range srcTypes {
-		srcRank, ok := rankOf[srcType]
-		if !ok {
-			srcRank = lowestRank
-		}
-		for _, ep := range endpointsBySource[srcType] {
-			existing, exists := winnerBySrc[ep.DNSName]
-			if !exists || srcRank < existing.rank {
-				winnerBySrc[ep.DNSName] = sourceWinner{srcType: srcType, rank: srcRank}
-			}
-			// equal rank: first-seen (alphabetically) wins — srcTypes is already sorted
+		for _, ep := range eps {
+			namesBySource[srcType] = append(namesBySource[srcType], ep.DNSName)
 		}
 	}
+	sort.Strings(srcTypes)
+	winnerBySrc := inventory.ElectSources(namesBySource, priority) // DNSName → winning source
 
 	// Phase 2: collect all endpoints from the winning source for each FQDN name.
 	// Intra-source duplicates (same FQDN+RecordType within the winning source) are merged.
@@ -532,13 +569,12 @@ func selectByPriority(endpointsBySource map[string][]sreportalv1alpha1.EndpointS
 
 	for _, srcType := range srcTypes {
 		for _, ep := range endpointsBySource[srcType] {
-			winner, ok := winnerBySrc[ep.DNSName]
-			if !ok || winner.srcType != srcType {
+			if winnerBySrc[ep.DNSName] != srcType {
 				continue // this source does not own this FQDN name
 			}
 			key := epKey{dnsName: ep.DNSName, recordType: ep.RecordType}
 			if existing, dup := winningEps[key]; dup {
-				existing.Targets = mergeTargets(existing.Targets, ep.Targets)
+				existing.Targets = inventory.Union(existing.Targets, ep.Targets)
 				winningEps[key] = existing
 			} else {
 				winningEps[key] = ep
@@ -568,11 +604,15 @@ func strategyFromV2Spec(mapping *v1alpha2.GroupMappingSpec) domaindns.GroupMappi
 	if mapping == nil {
 		return domaindns.GroupMappingStrategy{DefaultGroup: defaultGroupServices}
 	}
-	return domaindns.GroupMappingStrategy{
+	strategy := domaindns.GroupMappingStrategy{
 		DefaultGroup: mapping.DefaultGroup,
 		LabelKey:     mapping.LabelKey,
 		ByNamespace:  mapping.ByNamespace,
 	}
+	for _, k := range mapping.LabelKeys {
+		strategy.LabelKeys = append(strategy.LabelKeys, domaindns.GroupLabelKey(k))
+	}
+	return strategy
 }
 
 // originRefV2FromLabel parses an external-dns resource label into a v1alpha2.OriginResourceRef.
@@ -589,6 +629,29 @@ func originRefV2FromLabel(raw string) *v1alpha2.OriginResourceRef {
 	}
 }
 
+// FormatPortsV2 encodes v1alpha2 Service ports for domaindns.PortsLabelKey.
+func FormatPortsV2(ports []v1alpha2.ServicePort) string {
+	out := make([]domaindns.ServicePort, 0, len(ports))
+	for _, p := range ports {
+		out = append(out, domaindns.ServicePort{Name: p.Name, Port: p.Port, Protocol: p.Protocol})
+	}
+	return domaindns.FormatPorts(out)
+}
+
+// portsV2FromLabel decodes a domaindns.PortsLabelKey value into v1alpha2
+// Service ports. Returns nil when the label is absent or malformed.
+func portsV2FromLabel(raw string) []v1alpha2.ServicePort {
+	parsed := domaindns.ParsePorts(raw)
+	if len(parsed) == 0 {
+		return nil
+	}
+	ports := make([]v1alpha2.ServicePort, 0, len(parsed))
+	for _, p := range parsed {
+		ports = append(ports, v1alpha2.ServicePort{Name: p.Name, Port: p.Port, Protocol: p.Protocol})
+	}
+	return ports
+}
+
 // IsEndpointStatusV2Ignored returns true when a v1alpha2.EndpointStatus has the
 // sreportal.io/ignore label set to "true".
 func IsEndpointStatusV2Ignored(ep *v1alpha2.EndpointStatus) bool {
@@ -598,6 +661,19 @@ func IsEndpointStatusV2Ignored(ep *v1alpha2.EndpointStatus) bool {
 	return ep.Labels[IgnoreAnnotationKey] == annotationValueTrue
 }
 
+// CountFQDNsV2 returns the number of distinct FQDNs (case-insensitive) of
+// endpoints, leaving out the ignored ones.
+func CountFQDNsV2(endpoints []v1alpha2.EndpointStatus) int32 {
+	seen := make(map[string]struct{}, len(endpoints))
+	for i := range endpoints {
+		if IsEndpointStatusV2Ignored(&endpoints[i]) {
+			continue
+		}
+		seen[strings.ToLower(endpoints[i].DNSName)] = struct{}{}
+	}
+	return int32(len(seen)) //nolint:gosec // bounded by the endpoints of DNSRecords
+}
+
 // fqdnKeyV2 uniquely identifies an FQDN within a group for v1alpha2 dedup.
 type fqdnKeyV2 struct {
 	groupName  string
@@ -623,6 +699,9 @@ func EndpointStatusToGroupsV2(endpoints []v1alpha2.EndpointStatus, mapping *v1al
 		ns := extractNamespace(ep.Labels[endpoint.ResourceLabelKey])
 		// Parse once per endpoint (not per group): see EndpointStatusToGroups.
 		originRef := originRefV2FromLabel(ep.Labels[endpoint.ResourceLabelKey])
+		ports := portsV2FromLabel(ep.Labels[domaindns.PortsLabelKey])
+		paths := domaindns.ParsePaths(ep.Labels[domaindns.PathsLabelKey])
+		annotations := domaindns.ParseAnnotations(ep.Labels[domaindns.AnnotationsLabelKey])
 		groupNames := strategy.Resolve(ep.Labels, ns)
 
 		for _, groupName := range groupNames {
@@ -637,19 +716,36 @@ func EndpointStatusToGroupsV2(endpoints []v1alpha2.EndpointStatus, mapping *v1al
 			key := fqdnKeyV2{groupName: groupName, dnsName: ep.DNSName, recordType: ep.RecordType}
 			if idx, dup := seen[key]; dup {
 				existing := &groups[groupName].FQDNs[idx]
-				existing.Targets = mergeTargets(existing.Targets, ep.Targets)
+				existing.Targets = inventory.Union(existing.Targets, ep.Targets)
 				if ep.LastSeen.After(existing.LastSeen.Time) {
 					existing.LastSeen = ep.LastSeen
 				}
+				if existing.OwnershipConflict == nil {
+					existing.OwnershipConflict = ep.OwnershipConflict
+				}
 			} else {
 				seen[key] = len(groups[groupName].FQDNs)
 				groups[groupName].FQDNs = append(groups[groupName].FQDNs, v1alpha2.FQDNStatus{
-					FQDN:       ep.DNSName,
-					RecordType: ep.RecordType,
-					Targets:    ep.Targets,
-					SyncStatus: ep.SyncStatus,
-					LastSeen:   ep.LastSeen,
-					OriginRef:  originRef,
+					FQDN:              ep.DNSName,
+					RecordType:        ep.RecordType,
+					Targets:           ep.Targets,
+					SyncStatus:        ep.SyncStatus,
+					InternalStatus:    ep.InternalStatus,
+					ExternalStatus:    ep.ExternalStatus,
+					LookupFailure:     ep.LookupFailure,
+					Availability:      ep.Availability,
+					HealthStatus:      ep.HealthStatus,
+					LastProbeTime:     ep.LastProbeTime,
+					HTTPStatusCode:    ep.HTTPStatusCode,
+					ProbeLatency:      ep.ProbeLatency,
+					TLSValid:          ep.TLSValid,
+					TLSNotAfter:       ep.TLSNotAfter,
+					LastSeen:          ep.LastSeen,
+					OriginRef:         originRef,
+					Ports:             ports,
+					Paths:             paths,
+					Annotations:       annotations,
+					OwnershipConflict: ep.OwnershipConflict,
 				})
 			}
 		}
@@ -669,26 +765,25 @@ func EndpointStatusToGroupsV2(endpoints []v1alpha2.EndpointStatus, mapping *v1al
 	return result
 }
 
-// mergeTargets merges two target slices, deduplicating entries.
-// It always returns a new slice and never aliases the caller's backing array.
-func mergeTargets(existing, additional []string) []string {
-	if len(additional) == 0 {
-		return existing
-	}
-	set := make(map[string]struct{}, len(existing)+len(additional))
-	result := make([]string, 0, len(existing)+len(additional))
-	for _, t := range existing {
-		if _, seen := set[t]; !seen {
-			set[t] = struct{}{}
-			result = append(result, t)
-		}
-	}
-	for _, t := range additional {
-		if _, seen := set[t]; !seen {
-			set[t] = struct{}{}
-			result = append(result, t)
+// EndpointStatusGroupNamesV2 returns the groups ep is listed in under
+// mapping, resolved like EndpointStatusToGroupsV2 does.
+func EndpointStatusGroupNamesV2(ep *v1alpha2.EndpointStatus, mapping *v1alpha2.GroupMappingSpec) []string {
+	ns := extractNamespace(ep.Labels[endpoint.ResourceLabelKey])
+	return strategyFromV2Spec(mapping).Resolve(ep.Labels, ns)
+}
+
+// DNSRecordToGroupsV2 converts the endpoints of a v1alpha2.DNSRecord like
+// EndpointStatusToGroupsV2, and stamps every FQDN with the record it comes
+// from: its source type, reference and last reconcile time.
+func DNSRecordToGroupsV2(record *v1alpha2.DNSRecord, mapping *v1alpha2.GroupMappingSpec) []v1alpha2.FQDNGroupStatus {
+	groups := EndpointStatusToGroupsV2(record.Status.Endpoints, mapping)
+	for gi := range groups {
+		for fi := range groups[gi].FQDNs {
+			fqdn := &groups[gi].FQDNs[fi]
+			fqdn.SourceType = string(record.Spec.SourceType)
+			fqdn.DNSRecordRef = &v1alpha2.DNSRecordReference{Namespace: record.Namespace, Name: record.Name}
+			fqdn.LastReconcileTime = record.Status.LastReconcileTime
 		}
 	}
-	sort.Strings(result)
-	return result
+	return groups
 }
@@ -24,6 +24,7 @@ import (
 	"sigs.k8s.io/external-dns/endpoint"
 
 	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/api/v1alpha2"
 	"github.com/golgoth31/sreportal/internal/config"
 )
 
@@ -1090,3 +1091,19 @@ func newTestEndpointWithLabels(dnsName string, labels map[string]string) *endpoi
 		Labels:     labels,
 	}
 }
+
+var _ = Describe("CountFQDNsV2", func() {
+	It("counts distinct FQDNs case-insensitively and skips ignored endpoints", func() {
+		endpoints := []v1alpha2.EndpointStatus{
+			{DNSName: "api.example.com", RecordType: "A"},
+			{DNSName: "API.example.com", RecordType: "AAAA"},
+			{DNSName: "web.example.com"},
+			{DNSName: "hidden.example.com", Labels: map[string]string{IgnoreAnnotationKey: "true"}},
+		}
+		Expect(CountFQDNsV2(endpoints)).To(Equal(int32(2)))
+	})
+
+	It("returns zero without endpoints", func() {
+		Expect(CountFQDNsV2(nil)).To(BeZero())
+	})
+})
@@ -0,0 +1,48 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter
+
+import "sigs.k8s.io/controller-runtime/pkg/client"
+
+// SetStandardLabels stamps obj with the labels identifying a resource created
+// by sreportal for portal: AppManagedByLabelKey and PortalAnnotationKey.
+// Existing labels are kept.
+func SetStandardLabels(obj client.Object, portal string) {
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string, 2)
+	}
+	labels[AppManagedByLabelKey] = AppManagedByValue
+	labels[PortalAnnotationKey] = portal
+	obj.SetLabels(labels)
+}
+
+// StandardLabelSelector returns the labels set by SetStandardLabels, for use
+// as a client.MatchingLabels list option.
+func StandardLabelSelector(portal string) client.MatchingLabels {
+	return client.MatchingLabels{
+		AppManagedByLabelKey: AppManagedByValue,
+		PortalAnnotationKey:  portal,
+	}
+}
+
+// HasStandardLabels reports whether obj carries the labels set by
+// SetStandardLabels for portal.
+func HasStandardLabels(obj client.Object, portal string) bool {
+	labels := obj.GetLabels()
+	return labels[AppManagedByLabelKey] == AppManagedByValue && labels[PortalAnnotationKey] == portal
+}
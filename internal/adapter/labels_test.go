@@ -0,0 +1,49 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/adapter"
+)
+
+func TestSetStandardLabels_KeepsExistingLabels(t *testing.T) {
+	obj := &sreportalv1alpha1.Alertmanager{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"team": "sre"}}}
+
+	assert.False(t, adapter.HasStandardLabels(obj, "main"))
+	adapter.SetStandardLabels(obj, "main")
+
+	assert.True(t, adapter.HasStandardLabels(obj, "main"))
+	assert.False(t, adapter.HasStandardLabels(obj, "other"))
+	assert.Equal(t, "sre", obj.Labels["team"])
+	assert.Equal(t, "sreportal", obj.Labels["app.kubernetes.io/managed-by"])
+	assert.Equal(t, "main", obj.Labels["sreportal.io/portal"])
+}
+
+func TestStandardLabelSelector_MatchesSetLabels(t *testing.T) {
+	obj := &sreportalv1alpha1.Alertmanager{}
+	adapter.SetStandardLabels(obj, "main")
+
+	for k, v := range adapter.StandardLabelSelector("main") {
+		assert.Equal(t, v, obj.Labels[k], k)
+	}
+}
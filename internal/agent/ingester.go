@@ -0,0 +1,223 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
+	"github.com/golgoth31/sreportal/internal/config"
+	"github.com/golgoth31/sreportal/internal/log"
+	"github.com/golgoth31/sreportal/internal/metrics"
+)
+
+// recordNameInfix joins the portal and the agent name in the name of the
+// DNSRecord of an agent: "<portal>-agent-<agent>".
+const recordNameInfix = "-agent-"
+
+var (
+	ErrUnknownAgent     = errors.New("agent is not declared in agent.ingest.agents")
+	ErrPortalNotAllowed = errors.New("agent is not allowed to push to this portal")
+	ErrNoDNS            = errors.New("portal has no local DNS resource")
+)
+
+// RecordName returns the name of the DNSRecord holding the FQDNs agent pushed
+// to portal.
+func RecordName(portal, agent string) string {
+	return portal + recordNameInfix + agent
+}
+
+// Ingester writes the FQDNs pushed by agents to one auto DNSRecord per agent,
+// with the source type "agent:<name>", owned by a DNS resource of the portal.
+// From there the DNSRecord controller projects them like the FQDNs of a
+// local source, with DNS checks and probes. As a leader-elected runnable, it
+// deletes the DNSRecord of an agent that has not pushed for the TTL or is no
+// longer declared.
+type Ingester struct {
+	client client.Client
+	ttl    time.Duration
+	// agents maps each declared agent to the portal it may push to.
+	agents map[string]string
+	// identities maps the authenticated identity of each agent to its name.
+	identities map[string]string
+	now        func() time.Time
+}
+
+var _ manager.Runnable = (*Ingester)(nil)
+
+// NewIngester creates an Ingester accepting the agents declared in cfg.
+func NewIngester(c client.Client, cfg config.AgentIngestConfig) *Ingester {
+	agents := make(map[string]string, len(cfg.Agents))
+	identities := make(map[string]string, len(cfg.Agents))
+	for _, a := range cfg.Agents {
+		agents[a.Name] = a.Portal
+		identities[a.IdentityOrDefault()] = a.Name
+	}
+	return &Ingester{client: c, ttl: cfg.TTL.Duration(), agents: agents, identities: identities, now: time.Now}
+}
+
+// AgentOf returns the name of the agent pushing with the authenticated
+// identity.
+func (i *Ingester) AgentOf(identity string) (string, error) {
+	name, ok := i.identities[identity]
+	if identity == "" || !ok {
+		return "", fmt.Errorf("identity %q: %w", identity, ErrUnknownAgent)
+	}
+	return name, nil
+}
+
+// Authorize checks that agent is declared and allowed to push to portal.
+func (i *Ingester) Authorize(agent, portal string) error {
+	allowed, ok := i.agents[agent]
+	if !ok {
+		return fmt.Errorf("agent %q: %w", agent, ErrUnknownAgent)
+	}
+	if allowed != portal {
+		return fmt.Errorf("agent %q, portal %q: %w", agent, portal, ErrPortalNotAllowed)
+	}
+	return nil
+}
+
+// Publish replaces the entries of the DNSRecord of agent with entries. The
+// record lives in namespace, the namespace of portal, and is owned by the
+// first local DNS resource of portal by name.
+func (i *Ingester) Publish(ctx context.Context, agent, namespace, portal string, entries []sreportalv1alpha2.DNSRecordEntry) error {
+	owner, err := i.ownerDNS(ctx, namespace, portal)
+	if err != nil {
+		return err
+	}
+	now := i.now()
+	dr := &sreportalv1alpha2.DNSRecord{ObjectMeta: metav1.ObjectMeta{Name: RecordName(portal, agent), Namespace: namespace}}
+	_, err = controllerutil.CreateOrUpdate(ctx, i.client, dr, func() error {
+		if dr.Spec.Origin == "" {
+			dr.Spec.Origin = sreportalv1alpha2.DNSRecordOriginAuto
+		}
+		adapter.SetStandardLabels(dr, portal)
+		dr.Labels[adapter.AgentLabelKey] = agent
+		if dr.Annotations == nil {
+			dr.Annotations = map[string]string{}
+		}
+		dr.Annotations[adapter.AgentLastPublishAnnotationKey] = now.UTC().Format(time.RFC3339)
+		dr.Spec.PortalRef = portal
+		dr.Spec.SourceType = sreportalv1alpha2.AgentSourceType(agent)
+		dr.Spec.Entries = entries
+		return controllerutil.SetControllerReference(owner, dr, i.client.Scheme())
+	})
+	if err != nil {
+		return fmt.Errorf("write DNSRecord of agent %q: %w", agent, err)
+	}
+	metrics.AgentLastPublishTimestamp.WithLabelValues(agent).Set(float64(now.Unix()))
+	metrics.AgentFQDNs.WithLabelValues(agent).Set(float64(len(entries)))
+	return nil
+}
+
+// ownerDNS returns the first local DNS resource of portal in namespace, by
+// name.
+func (i *Ingester) ownerDNS(ctx context.Context, namespace, portal string) (*sreportalv1alpha2.DNS, error) {
+	var list sreportalv1alpha2.DNSList
+	if err := i.client.List(ctx, &list, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("list DNS: %w", err)
+	}
+	var owner *sreportalv1alpha2.DNS
+	for j := range list.Items {
+		dns := &list.Items[j]
+		if dns.Spec.PortalRef != portal || dns.Spec.IsRemote {
+			continue
+		}
+		if owner == nil || dns.Name < owner.Name {
+			owner = dns
+		}
+	}
+	if owner == nil {
+		return nil, fmt.Errorf("portal %q: %w", portal, ErrNoDNS)
+	}
+	return owner, nil
+}
+
+// Start implements manager.Runnable.
+func (i *Ingester) Start(ctx context.Context) error {
+	ticker := time.NewTicker(i.ttl / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := i.expire(ctx); err != nil {
+				log.FromContext(ctx).WithName("agent-ingester").Error(err, "failed to expire agent DNSRecords")
+			}
+		}
+	}
+}
+
+// expire deletes the DNSRecords of the agents that have not pushed for the
+// TTL, or are no longer allowed to push to their portal, and refreshes the
+// agent gauges from the records kept.
+func (i *Ingester) expire(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("agent-ingester")
+	var list sreportalv1alpha2.DNSRecordList
+	if err := i.client.List(ctx, &list, client.HasLabels{adapter.AgentLabelKey}); err != nil {
+		return fmt.Errorf("list agent DNSRecords: %w", err)
+	}
+	deadline := i.now().Add(-i.ttl)
+	kept := map[string]*sreportalv1alpha2.DNSRecord{}
+	var dropped []string
+	for j := range list.Items {
+		dr := &list.Items[j]
+		agent := dr.Labels[adapter.AgentLabelKey]
+		last, err := time.Parse(time.RFC3339, dr.Annotations[adapter.AgentLastPublishAnnotationKey])
+		reason := ""
+		switch {
+		case i.Authorize(agent, dr.Spec.PortalRef) != nil:
+			reason = "agent no longer allowed to push to the portal"
+		case err != nil || !last.After(deadline):
+			reason = "agent stopped pushing"
+		}
+		if reason == "" {
+			kept[agent] = dr
+			continue
+		}
+		if err := i.client.Delete(ctx, dr); client.IgnoreNotFound(err) != nil {
+			logger.Error(err, "failed to delete agent DNSRecord", "dnsRecord", dr.Name, "agent", agent)
+			continue
+		}
+		dropped = append(dropped, agent)
+		logger.Info("deleted agent DNSRecord", "dnsRecord", dr.Name, "agent", agent, "reason", reason, "lastPublish", last)
+	}
+	for _, agent := range dropped {
+		if _, ok := kept[agent]; !ok {
+			metrics.AgentLastPublishTimestamp.DeleteLabelValues(agent)
+			metrics.AgentFQDNs.DeleteLabelValues(agent)
+		}
+	}
+	for agent, dr := range kept {
+		if last, err := time.Parse(time.RFC3339, dr.Annotations[adapter.AgentLastPublishAnnotationKey]); err == nil {
+			metrics.AgentLastPublishTimestamp.WithLabelValues(agent).Set(float64(last.Unix()))
+		}
+		metrics.AgentFQDNs.WithLabelValues(agent).Set(float64(len(dr.Spec.Entries)))
+	}
+	return nil
+}
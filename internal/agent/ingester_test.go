@@ -0,0 +1,118 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agent
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
+	"github.com/golgoth31/sreportal/internal/config"
+)
+
+func newIngesterClient(t *testing.T) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&sreportalv1alpha2.DNS{
+			ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "sreportal-system", UID: "dns-uid"},
+			Spec:       sreportalv1alpha2.DNSSpec{PortalRef: "main"},
+		},
+	).Build()
+}
+
+func TestIngesterWritesAgentDNSRecord(t *testing.T) {
+	ctx := context.Background()
+	c := newIngesterClient(t)
+	i := NewIngester(c, config.AgentIngestConfig{
+		TTL:    config.Duration(10 * time.Minute),
+		Agents: []config.IngestAgentConfig{{Name: "edge-1", Portal: "main"}},
+	})
+	entries := []sreportalv1alpha2.DNSRecordEntry{{FQDN: "a.example.com", RecordType: "A", Targets: []string{"10.0.0.1"}}}
+
+	require.NoError(t, i.Publish(ctx, "edge-1", "sreportal-system", "main", entries))
+
+	var dr sreportalv1alpha2.DNSRecord
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: "sreportal-system", Name: "main-agent-edge-1"}, &dr))
+	assert.Equal(t, sreportalv1alpha2.DNSRecordOriginAuto, dr.Spec.Origin)
+	assert.Equal(t, sreportalv1alpha2.SourceType("agent:edge-1"), dr.Spec.SourceType)
+	assert.True(t, dr.Spec.SourceType.IsAgent())
+	assert.Equal(t, entries, dr.Spec.Entries)
+	assert.Equal(t, "edge-1", dr.Labels[adapter.AgentLabelKey])
+	require.Len(t, dr.OwnerReferences, 1)
+	assert.Equal(t, "main", dr.OwnerReferences[0].Name)
+
+	name, err := i.AgentOf("token:edge-1")
+	require.NoError(t, err)
+	assert.Equal(t, "edge-1", name)
+	_, err = i.AgentOf("token:edge-2")
+	assert.ErrorIs(t, err, ErrUnknownAgent)
+	_, err = i.AgentOf("")
+	assert.ErrorIs(t, err, ErrUnknownAgent)
+	assert.ErrorIs(t, i.Authorize("edge-2", "main"), ErrUnknownAgent)
+	assert.ErrorIs(t, i.Authorize("edge-1", "eu"), ErrPortalNotAllowed)
+	assert.ErrorIs(t, i.Publish(ctx, "edge-1", "sreportal-system", "eu", entries), ErrNoDNS)
+}
+
+func TestIngesterExpiresStaleAgents(t *testing.T) {
+	ctx := context.Background()
+	c := newIngesterClient(t)
+	i := NewIngester(c, config.AgentIngestConfig{
+		TTL: config.Duration(10 * time.Minute),
+		Agents: []config.IngestAgentConfig{
+			{Name: "edge-1", Portal: "main"},
+			{Name: "edge-2", Portal: "main"},
+			{Name: "edge-3", Portal: "main"},
+		},
+	})
+	now := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+	i.now = func() time.Time { return now }
+	entries := []sreportalv1alpha2.DNSRecordEntry{{FQDN: "a.example.com", RecordType: "A"}}
+
+	require.NoError(t, i.Publish(ctx, "edge-1", "sreportal-system", "main", entries))
+	require.NoError(t, i.Publish(ctx, "edge-3", "sreportal-system", "main", entries))
+	now = now.Add(6 * time.Minute)
+	require.NoError(t, i.Publish(ctx, "edge-2", "sreportal-system", "main", entries))
+	delete(i.agents, "edge-3")
+
+	now = now.Add(5 * time.Minute)
+	require.NoError(t, i.expire(ctx))
+
+	exists := func(agent string) bool {
+		err := c.Get(ctx, types.NamespacedName{Namespace: "sreportal-system", Name: RecordName("main", agent)}, &sreportalv1alpha2.DNSRecord{})
+		if apierrors.IsNotFound(err) {
+			return false
+		}
+		require.NoError(t, err)
+		return true
+	}
+	assert.False(t, exists("edge-1"), "edge-1 has not pushed for the TTL")
+	assert.True(t, exists("edge-2"))
+	assert.False(t, exists("edge-3"), "edge-3 is no longer declared")
+}
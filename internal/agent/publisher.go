@@ -0,0 +1,220 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/external-dns/endpoint"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
+	"github.com/golgoth31/sreportal/internal/config"
+	dnschain "github.com/golgoth31/sreportal/internal/controller/dns/chain"
+	domainsource "github.com/golgoth31/sreportal/internal/domain/source"
+	dnsv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	"github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1/sreportalv1connect"
+	"github.com/golgoth31/sreportal/internal/log"
+	"github.com/golgoth31/sreportal/internal/metrics"
+	"github.com/golgoth31/sreportal/internal/reconciler"
+	"github.com/golgoth31/sreportal/internal/source/demo"
+)
+
+// Handlers are the DNS chain handlers the Publisher runs: the discovery steps
+// of the DNS pipeline, up to the validation of the entries. The steps writing
+// resources are left to the central instance.
+var Handlers = []string{
+	dnschain.HandlerLookupSources,
+	dnschain.HandlerExternalNameServices,
+	dnschain.HandlerDemoSource,
+	dnschain.HandlerIntraDNSDedup,
+	dnschain.HandlerValidateEntries,
+}
+
+// errSourcesNotReady is returned while a source enabled by a DNS resource has
+// not been collected yet: pushing then would drop its FQDNs on the central
+// instance until the next push.
+var errSourcesNotReady = errors.New("sources not collected yet")
+
+// Publisher runs in agent mode. Every interval it projects the endpoints of
+// the local sources through the discovery steps of the DNS chain, for each
+// local DNS resource, and pushes the resulting FQDNs to the central instance
+// through PublishEndpoints. It reads resources only.
+type Publisher struct {
+	client     client.Client
+	source     domainsource.SourceEndpointReader
+	chain      *reconciler.Chain[*sreportalv1alpha2.DNS, dnschain.ChainData]
+	central    sreportalv1connect.DNSServiceClient
+	name       string
+	portal     string
+	headerName string
+	credential string
+	interval   time.Duration
+	timeout    time.Duration
+}
+
+var _ manager.Runnable = (*Publisher)(nil)
+
+// NewPublisher creates a Publisher pushing to central as configured by cfg,
+// authenticated by apiKey.
+func NewPublisher(c client.Client, source domainsource.SourceEndpointReader, central sreportalv1connect.DNSServiceClient, cfg config.AgentConfig, apiKey string) *Publisher {
+	handlers := dnschain.DefaultRegistry().Build(dnschain.Pipeline{Handlers: Handlers}, dnschain.Deps{Client: c, Source: source})
+	return &Publisher{
+		client:     c,
+		source:     source,
+		chain:      reconciler.NewChain("agent", handlers...),
+		central:    central,
+		name:       cfg.Name,
+		portal:     cfg.Portal,
+		headerName: cfg.HeaderName,
+		credential: credential(cfg.HeaderName, apiKey),
+		interval:   cfg.Interval.Duration(),
+		timeout:    cfg.Timeout.Duration(),
+	}
+}
+
+// credential returns the value of the headerName header carrying apiKey: a
+// bearer token in the Authorization header, the key itself in any other.
+func credential(headerName, apiKey string) string {
+	if strings.EqualFold(headerName, "Authorization") && !strings.HasPrefix(apiKey, "Bearer ") {
+		return "Bearer " + apiKey
+	}
+	return apiKey
+}
+
+// Start implements manager.Runnable.
+func (p *Publisher) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("agent")
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		count, err := p.publish(ctx)
+		switch {
+		case errors.Is(err, errSourcesNotReady):
+			logger.V(1).Info("skipping push", "reason", err.Error())
+		case err != nil:
+			metrics.AgentPublishTotal.WithLabelValues("error").Inc()
+			logger.Error(err, "failed to push FQDNs", "portal", p.portal)
+		default:
+			metrics.AgentPublishTotal.WithLabelValues("success").Inc()
+			logger.V(1).Info("pushed FQDNs", "portal", p.portal, "fqdnCount", count)
+		}
+	}
+}
+
+// publish collects the FQDNs of every local DNS resource and pushes them,
+// returning the number of FQDNs the central instance stored.
+func (p *Publisher) publish(ctx context.Context) (int, error) {
+	fqdns, err := p.collect(ctx)
+	if err != nil {
+		return 0, err
+	}
+	req := connect.NewRequest(&dnsv1.PublishEndpointsRequest{Agent: p.name, Portal: p.portal, Fqdns: fqdns})
+	req.Header().Set(p.headerName, p.credential)
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	resp, err := p.central.PublishEndpoints(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("publish endpoints: %w", err)
+	}
+	return int(resp.Msg.FqdnCount), nil
+}
+
+// collect runs the chain on every local DNS resource and converts the kept
+// endpoints to FQDNs, grouped by the group mapping of the resource.
+func (p *Publisher) collect(ctx context.Context) ([]*dnsv1.FQDN, error) {
+	var list sreportalv1alpha2.DNSList
+	if err := p.client.List(ctx, &list); err != nil {
+		return nil, fmt.Errorf("list DNS: %w", err)
+	}
+	var fqdns []*dnsv1.FQDN
+	for i := range list.Items {
+		dns := &list.Items[i]
+		if dns.Spec.IsRemote {
+			continue
+		}
+		rc := &reconciler.ReconcileContext[*sreportalv1alpha2.DNS, dnschain.ChainData]{Resource: dns}
+		if err := p.chain.Execute(ctx, rc); err != nil {
+			return nil, fmt.Errorf("DNS %s/%s: %w", dns.Namespace, dns.Name, err)
+		}
+		for _, kind := range rc.Data.PriorityOrder {
+			if kind != demo.SourceTypeDemo && !p.source.Ready(kind) {
+				return nil, fmt.Errorf("%w: %s", errSourcesNotReady, kind)
+			}
+		}
+		var endpoints []sreportalv1alpha2.EndpointStatus
+		for _, kind := range rc.Data.PriorityOrder {
+			endpoints = append(endpoints, endpointStatuses(rc.Data.KeptEndpointsByKind[kind])...)
+		}
+		fqdns = append(fqdns, groupsToFQDNs(adapter.EndpointStatusToGroupsV2(endpoints, &dns.Spec.GroupMapping))...)
+	}
+	return fqdns, nil
+}
+
+// endpointStatuses converts source endpoints to DNSRecord endpoint statuses,
+// keeping the labels the group mapping and the origin are derived from.
+func endpointStatuses(eps []*endpoint.Endpoint) []sreportalv1alpha2.EndpointStatus {
+	out := make([]sreportalv1alpha2.EndpointStatus, 0, len(eps))
+	for _, ep := range eps {
+		out = append(out, sreportalv1alpha2.EndpointStatus{
+			DNSName:    ep.DNSName,
+			RecordType: ep.RecordType,
+			Targets:    ep.Targets,
+			Labels:     ep.Labels,
+		})
+	}
+	return out
+}
+
+// groupsToFQDNs flattens groups to one FQDN per group membership; the
+// central instance merges the groups of an FQDN.
+func groupsToFQDNs(groups []sreportalv1alpha2.FQDNGroupStatus) []*dnsv1.FQDN {
+	var out []*dnsv1.FQDN
+	for _, g := range groups {
+		for _, f := range g.FQDNs {
+			fqdn := &dnsv1.FQDN{
+				Name:        f.FQDN,
+				Groups:      []string{g.Name},
+				Description: f.Description,
+				RecordType:  f.RecordType,
+				Targets:     f.Targets,
+			}
+			if f.OriginRef != nil {
+				fqdn.OriginRef = &dnsv1.OriginResourceRef{
+					Kind:      f.OriginRef.Kind,
+					Namespace: f.OriginRef.Namespace,
+					Name:      f.OriginRef.Name,
+				}
+			}
+			out = append(out, fqdn)
+		}
+	}
+	return out
+}
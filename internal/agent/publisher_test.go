@@ -0,0 +1,106 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agent
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/external-dns/endpoint"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/config"
+	domainsource "github.com/golgoth31/sreportal/internal/domain/source"
+	dnsv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	"github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1/sreportalv1connect"
+	rsource "github.com/golgoth31/sreportal/internal/readstore/source"
+	"github.com/golgoth31/sreportal/internal/source/externaldns"
+)
+
+// recordingCentral keeps the PublishEndpoints requests it receives.
+type recordingCentral struct {
+	sreportalv1connect.DNSServiceClient
+	requests []*connect.Request[dnsv1.PublishEndpointsRequest]
+}
+
+func (c *recordingCentral) PublishEndpoints(_ context.Context, req *connect.Request[dnsv1.PublishEndpointsRequest]) (*connect.Response[dnsv1.PublishEndpointsResponse], error) {
+	c.requests = append(c.requests, req)
+	return connect.NewResponse(&dnsv1.PublishEndpointsResponse{FqdnCount: int32(len(req.Msg.Fqdns))}), nil
+}
+
+func TestPublisherPushesDiscoveredFQDNs(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&sreportalv1alpha2.DNS{
+			ObjectMeta: metav1.ObjectMeta{Name: "edge", Namespace: "sreportal-system"},
+			Spec: sreportalv1alpha2.DNSSpec{
+				PortalRef:    "main",
+				GroupMapping: sreportalv1alpha2.GroupMappingSpec{DefaultGroup: "Edge"},
+				Sources: sreportalv1alpha2.SourcesSpec{
+					Service: &sreportalv1alpha2.ServiceSourceSpec{
+						CommonSourceSpec: sreportalv1alpha2.CommonSourceSpec{Enabled: true},
+					},
+				},
+			},
+		},
+	).Build()
+	store := rsource.NewStore()
+	central := &recordingCentral{}
+	cfg := config.AgentConfig{
+		Name: "edge-1", Portal: "main", HeaderName: "X-API-Key",
+		Interval: config.Duration(time.Minute), Timeout: config.Duration(time.Second),
+	}
+	p := NewPublisher(c, store, central, cfg, "secret")
+
+	_, err := p.publish(ctx)
+	require.ErrorIs(t, err, errSourcesNotReady)
+	assert.Empty(t, central.requests, "nothing is pushed before the sources are collected")
+
+	ep := endpoint.NewEndpoint("api.example.com", "A", "10.0.0.1")
+	ep.Labels[endpoint.ResourceLabelKey] = "service/default/api"
+	store.ReplaceKind(externaldns.KindService, []domainsource.EnrichedEndpoint{
+		{Endpoint: ep, Kind: externaldns.KindService, Namespace: "default", Name: "api"},
+		{Endpoint: endpoint.NewEndpoint("bad_name", "A", "10.0.0.2"), Kind: externaldns.KindService, Namespace: "default"},
+	})
+
+	count, err := p.publish(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Len(t, central.requests, 1)
+	req := central.requests[0]
+	assert.Equal(t, "secret", req.Header().Get("X-API-Key"))
+	assert.Equal(t, "edge-1", req.Msg.Agent)
+	assert.Equal(t, "main", req.Msg.Portal)
+	require.Len(t, req.Msg.Fqdns, 1, "invalid entries are dropped")
+	f := req.Msg.Fqdns[0]
+	assert.Equal(t, "api.example.com", f.Name)
+	assert.Equal(t, []string{"Edge"}, f.Groups)
+	assert.Equal(t, []string{"10.0.0.1"}, f.Targets)
+	require.NotNil(t, f.OriginRef)
+	assert.Equal(t, "api", f.OriginRef.Name)
+}
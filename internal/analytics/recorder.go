@@ -0,0 +1,260 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package analytics aggregates the opt-in usage counters of the web UI in
+// memory: page views per portal, searched FQDNs and clicked groups. No user,
+// address or session is recorded, and the counters restart from zero with
+// the operator. Client addresses are only held for the current minute, to
+// cap the events of each client.
+package analytics
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golgoth31/sreportal/internal/metrics"
+)
+
+// EventType is the kind of a usage event.
+type EventType string
+
+// Usage event types, also the "event" label of the usage metric.
+const (
+	EventPageView   EventType = "page_view"
+	EventSearch     EventType = "search"
+	EventGroupClick EventType = "group_click"
+)
+
+const (
+	// MaxValueLength is the maximum length of a search term or group name,
+	// in characters.
+	MaxValueLength = 253
+	// DefaultMaxKeys is the default number of distinct search terms and of
+	// distinct groups counted per portal.
+	DefaultMaxKeys = 1000
+	// DefaultMaxClientEvents is the default number of events a client may
+	// send per minute.
+	DefaultMaxClientEvents = 120
+	// clientWindow is the period the events of a client are capped over.
+	clientWindow = time.Minute
+	// maxClients bounds the clients tracked in a window; events of further
+	// clients are refused until the window ends.
+	maxClients = 10000
+)
+
+var (
+	ErrPortalRequired = errors.New("portal is required")
+	ErrValueRequired  = errors.New("value is required")
+	ErrValueTooLong   = fmt.Errorf("value exceeds %d characters", MaxValueLength)
+	ErrUnknownEvent   = errors.New("unknown event type")
+)
+
+// Counter is a counted search term or group.
+type Counter struct {
+	Value string
+	Count int64
+}
+
+// Stats are the usage counters of a portal.
+type Stats struct {
+	Portal      string
+	PageViews   int64
+	Searches    int64
+	GroupClicks int64
+	// TopSearches are the most searched terms, most searched first.
+	TopSearches []Counter
+	// TopGroups are the most clicked groups, most clicked first.
+	TopGroups []Counter
+}
+
+// Recorder aggregates usage events. It is safe for concurrent use.
+type Recorder struct {
+	mu      sync.Mutex
+	maxKeys int
+	since   time.Time
+	portals map[string]*portalUsage
+
+	maxClientEvents int
+	windowStart     time.Time
+	clientEvents    map[string]int
+}
+
+type portalUsage struct {
+	pageViews   int64
+	searches    int64
+	groupClicks int64
+	terms       map[string]int64
+	groups      map[string]int64
+}
+
+// NewRecorder creates a Recorder counting at most maxKeys distinct search
+// terms and maxKeys distinct groups per portal (DefaultMaxKeys when not
+// positive), and allowing maxClientEvents events per client and per minute
+// (DefaultMaxClientEvents when not positive).
+func NewRecorder(maxKeys, maxClientEvents int) *Recorder {
+	if maxKeys <= 0 {
+		maxKeys = DefaultMaxKeys
+	}
+	if maxClientEvents <= 0 {
+		maxClientEvents = DefaultMaxClientEvents
+	}
+	now := time.Now()
+	return &Recorder{
+		maxKeys:         maxKeys,
+		since:           now,
+		portals:         make(map[string]*portalUsage),
+		maxClientEvents: maxClientEvents,
+		windowStart:     now,
+		clientEvents:    make(map[string]int),
+	}
+}
+
+// Allow reports whether client, an address, may send one more event in the
+// current minute, and counts it.
+func (r *Recorder) Allow(client string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if now := time.Now(); now.Sub(r.windowStart) >= clientWindow {
+		r.windowStart = now
+		clear(r.clientEvents)
+	}
+	n, ok := r.clientEvents[client]
+	if (!ok && len(r.clientEvents) >= maxClients) || n >= r.maxClientEvents {
+		return false
+	}
+	r.clientEvents[client] = n + 1
+	return true
+}
+
+// Since returns when the recorder started counting.
+func (r *Recorder) Since() time.Time {
+	return r.since
+}
+
+// Record counts an event of portal. value is the search term of EventSearch
+// and the group of EventGroupClick; it is ignored for EventPageView. Search
+// terms are counted case-insensitively. listed reports whether value is an
+// FQDN or a group the portal lists: other values only count in the totals,
+// so that what users type never shows in the top searches.
+func (r *Recorder) Record(portal string, event EventType, value string, listed bool) error {
+	if portal == "" {
+		return ErrPortalRequired
+	}
+	value = strings.TrimSpace(value)
+	switch event {
+	case EventPageView:
+		value = ""
+	case EventSearch, EventGroupClick:
+		if value == "" {
+			return ErrValueRequired
+		}
+		if utf8.RuneCountInString(value) > MaxValueLength {
+			return ErrValueTooLong
+		}
+		if event == EventSearch {
+			value = strings.ToLower(value)
+		}
+	default:
+		return fmt.Errorf("%w: %q", ErrUnknownEvent, event)
+	}
+
+	r.mu.Lock()
+	u, ok := r.portals[portal]
+	if !ok {
+		u = &portalUsage{terms: make(map[string]int64), groups: make(map[string]int64)}
+		r.portals[portal] = u
+	}
+	switch event {
+	case EventPageView:
+		u.pageViews++
+	case EventSearch:
+		u.searches++
+		if listed {
+			r.increment(u.terms, value)
+		}
+	case EventGroupClick:
+		u.groupClicks++
+		if listed {
+			r.increment(u.groups, value)
+		}
+	}
+	r.mu.Unlock()
+
+	metrics.PortalUsageEventsTotal.WithLabelValues(portal, string(event)).Inc()
+	return nil
+}
+
+// increment counts key in counts. When counts is full, the keys seen once
+// are dropped to make room; a new key is ignored if none was.
+func (r *Recorder) increment(counts map[string]int64, key string) {
+	if _, ok := counts[key]; !ok && len(counts) >= r.maxKeys {
+		maps.DeleteFunc(counts, func(_ string, n int64) bool { return n <= 1 })
+		if len(counts) >= r.maxKeys {
+			return
+		}
+	}
+	counts[key]++
+}
+
+// Stats returns the counters of the portals in portals, sorted by name, with
+// at most limit top search terms and groups.
+func (r *Recorder) Stats(portals map[string]bool, limit int) []Stats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	names := slices.Sorted(maps.Keys(r.portals))
+	out := make([]Stats, 0, len(names))
+	for _, name := range names {
+		if !portals[name] {
+			continue
+		}
+		u := r.portals[name]
+		out = append(out, Stats{
+			Portal:      name,
+			PageViews:   u.pageViews,
+			Searches:    u.searches,
+			GroupClicks: u.groupClicks,
+			TopSearches: top(u.terms, limit),
+			TopGroups:   top(u.groups, limit),
+		})
+	}
+	return out
+}
+
+// top returns the limit highest counts, highest first, ties by value.
+func top(counts map[string]int64, limit int) []Counter {
+	out := make([]Counter, 0, len(counts))
+	for v, n := range counts {
+		out = append(out, Counter{Value: v, Count: n})
+	}
+	slices.SortFunc(out, func(a, b Counter) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Value, b.Value)
+	})
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package analytics_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golgoth31/sreportal/internal/analytics"
+)
+
+func TestRecorder_AggregatesPerPortal(t *testing.T) {
+	r := analytics.NewRecorder(0, 0)
+	require.NoError(t, r.Record("main", analytics.EventPageView, "ignored", true))
+	require.NoError(t, r.Record("main", analytics.EventPageView, "", true))
+	require.NoError(t, r.Record("main", analytics.EventSearch, "API.example.com", true))
+	require.NoError(t, r.Record("main", analytics.EventSearch, " api.example.com ", true))
+	require.NoError(t, r.Record("main", analytics.EventSearch, "grafana", true))
+	require.NoError(t, r.Record("main", analytics.EventSearch, "my password", false))
+	require.NoError(t, r.Record("main", analytics.EventGroupClick, "Platform", true))
+	require.NoError(t, r.Record("team-a", analytics.EventPageView, "", true))
+
+	stats := r.Stats(map[string]bool{"main": true, "team-a": true}, 1)
+	require.Len(t, stats, 2)
+	assert.Equal(t, analytics.Stats{
+		Portal:      "main",
+		PageViews:   2,
+		Searches:    4,
+		GroupClicks: 1,
+		TopSearches: []analytics.Counter{{Value: "api.example.com", Count: 2}},
+		TopGroups:   []analytics.Counter{{Value: "Platform", Count: 1}},
+	}, stats[0])
+	assert.Equal(t, "team-a", stats[1].Portal)
+
+	only := r.Stats(map[string]bool{"team-a": true}, 10)
+	require.Len(t, only, 1)
+	assert.Equal(t, int64(1), only[0].PageViews)
+}
+
+func TestRecorder_RejectsInvalidEvents(t *testing.T) {
+	r := analytics.NewRecorder(0, 0)
+	assert.ErrorIs(t, r.Record("", analytics.EventPageView, "", true), analytics.ErrPortalRequired)
+	assert.ErrorIs(t, r.Record("main", analytics.EventSearch, "  ", true), analytics.ErrValueRequired)
+	assert.ErrorIs(t, r.Record("main", analytics.EventType("download"), "x", true), analytics.ErrUnknownEvent)
+	assert.Empty(t, r.Stats(map[string]bool{"main": true}, 10))
+}
+
+func TestRecorder_BoundsDistinctKeys(t *testing.T) {
+	r := analytics.NewRecorder(2, 0)
+	require.NoError(t, r.Record("main", analytics.EventSearch, "a", true))
+	require.NoError(t, r.Record("main", analytics.EventSearch, "a", true))
+	require.NoError(t, r.Record("main", analytics.EventSearch, "b", true))
+	// Full: "b", seen once, makes room for "c".
+	require.NoError(t, r.Record("main", analytics.EventSearch, "c", true))
+
+	stats := r.Stats(map[string]bool{"main": true}, 0)
+	require.Len(t, stats, 1)
+	assert.Equal(t, []analytics.Counter{{Value: "a", Count: 2}, {Value: "c", Count: 1}}, stats[0].TopSearches)
+	assert.Equal(t, int64(4), stats[0].Searches)
+}
+
+func TestRecorder_CapsEventsPerClient(t *testing.T) {
+	r := analytics.NewRecorder(0, 2)
+	assert.True(t, r.Allow("10.0.0.1"))
+	assert.True(t, r.Allow("10.0.0.1"))
+	assert.False(t, r.Allow("10.0.0.1"))
+	assert.True(t, r.Allow("10.0.0.2"))
+}
@@ -25,20 +25,26 @@ import (
 
 const defaultAPIKeyHeader = "X-API-Key"
 
+// APIKeyIdentityPrefix prefixes the key name in the identity of the callers
+// authenticated by an API key, e.g. "apikey:default".
+const APIKeyIdentityPrefix = "apikey:"
+
 // APIKeyAuthenticator validates requests using a configurable header and a single secret key.
 type APIKeyAuthenticator struct {
+	name       string
 	headerName string
 	key        string
 }
 
 // NewAPIKeyAuthenticator creates an APIKeyAuthenticator.
+// name names the key in the identity of its callers.
 // headerName is the HTTP header to check (falls back to "X-API-Key" when empty).
 // key is the expected secret value.
-func NewAPIKeyAuthenticator(headerName, key string) *APIKeyAuthenticator {
+func NewAPIKeyAuthenticator(name, headerName, key string) *APIKeyAuthenticator {
 	if headerName == "" {
 		headerName = defaultAPIKeyHeader
 	}
-	return &APIKeyAuthenticator{headerName: headerName, key: key}
+	return &APIKeyAuthenticator{name: name, headerName: headerName, key: key}
 }
 
 // Authenticate checks the configured header against the expected key.
@@ -54,3 +60,12 @@ func (a *APIKeyAuthenticator) Authenticate(_ context.Context, headers http.Heade
 
 	return nil
 }
+
+// Identify authenticates the request and returns APIKeyIdentityPrefix
+// followed by the name of the key.
+func (a *APIKeyAuthenticator) Identify(ctx context.Context, headers http.Header) (string, error) {
+	if err := a.Authenticate(ctx, headers); err != nil {
+		return "", err
+	}
+	return APIKeyIdentityPrefix + a.name, nil
+}
@@ -35,33 +35,40 @@ func apiKeyHeader(header, key string) http.Header {
 }
 
 func TestAPIKey_ValidKey(t *testing.T) {
-	a := auth.NewAPIKeyAuthenticator("", "abc123")
+	a := auth.NewAPIKeyAuthenticator("ci", "", "abc123")
 	err := a.Authenticate(context.Background(), apiKeyHeader("X-API-Key", "abc123"))
 	require.NoError(t, err)
 }
 
 func TestAPIKey_CustomHeader(t *testing.T) {
-	a := auth.NewAPIKeyAuthenticator("X-Custom-Auth", "my-key")
+	a := auth.NewAPIKeyAuthenticator("ci", "X-Custom-Auth", "my-key")
 	err := a.Authenticate(context.Background(), apiKeyHeader("X-Custom-Auth", "my-key"))
 	require.NoError(t, err)
 }
 
 func TestAPIKey_InvalidKey(t *testing.T) {
-	a := auth.NewAPIKeyAuthenticator("", "abc123")
+	a := auth.NewAPIKeyAuthenticator("ci", "", "abc123")
 	err := a.Authenticate(context.Background(), apiKeyHeader("X-API-Key", "wrong"))
 	require.Error(t, err)
 	assert.True(t, errors.Is(err, auth.ErrInvalidCredentials))
 }
 
 func TestAPIKey_MissingHeader(t *testing.T) {
-	a := auth.NewAPIKeyAuthenticator("", "abc123")
+	a := auth.NewAPIKeyAuthenticator("ci", "", "abc123")
 	err := a.Authenticate(context.Background(), http.Header{})
 	require.Error(t, err)
 	assert.True(t, errors.Is(err, auth.ErrUnauthenticated))
 }
 
 func TestAPIKey_DefaultHeader(t *testing.T) {
-	a := auth.NewAPIKeyAuthenticator("", "secret")
+	a := auth.NewAPIKeyAuthenticator("ci", "", "secret")
 	err := a.Authenticate(context.Background(), apiKeyHeader("X-API-Key", "secret"))
 	require.NoError(t, err)
 }
+
+func TestAPIKey_IdentifyNamesTheKey(t *testing.T) {
+	a := auth.NewAPIKeyAuthenticator("ci", "", "secret")
+	id, err := a.Identify(context.Background(), apiKeyHeader("X-API-Key", "secret"))
+	require.NoError(t, err)
+	assert.Equal(t, "apikey:ci", id)
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"cmp"
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/golgoth31/sreportal/internal/log"
+)
+
+// APITokenIdentityPrefix prefixes the token name in the identity of the
+// callers authenticated by an API token, e.g. "token:ci".
+const APITokenIdentityPrefix = "token:"
+
+// apiToken is a named bearer token.
+type apiToken struct {
+	name  string
+	value []byte
+}
+
+// APITokenAuthenticator validates "Authorization: Bearer" tokens against a
+// set of named tokens that can be replaced at any time.
+type APITokenAuthenticator struct {
+	tokens atomic.Pointer[[]apiToken]
+}
+
+// NewAPITokenAuthenticator creates an APITokenAuthenticator accepting no
+// token until SetTokens is called.
+func NewAPITokenAuthenticator() *APITokenAuthenticator {
+	a := &APITokenAuthenticator{}
+	a.tokens.Store(&[]apiToken{})
+	return a
+}
+
+// SetTokens replaces the accepted tokens, keyed by name. Empty tokens are
+// ignored.
+func (a *APITokenAuthenticator) SetTokens(tokens map[string][]byte) {
+	list := make([]apiToken, 0, len(tokens))
+	for name, value := range tokens {
+		if len(value) == 0 {
+			continue
+		}
+		list = append(list, apiToken{name: name, value: value})
+	}
+	// A stable order keeps the comparisons of every request identical.
+	slices.SortFunc(list, func(x, y apiToken) int { return cmp.Compare(x.name, y.name) })
+	a.tokens.Store(&list)
+}
+
+// Len returns the number of accepted tokens.
+func (a *APITokenAuthenticator) Len() int {
+	return len(*a.tokens.Load())
+}
+
+// Authenticate checks the bearer token against the accepted tokens.
+func (a *APITokenAuthenticator) Authenticate(ctx context.Context, headers http.Header) error {
+	_, err := a.Identify(ctx, headers)
+	return err
+}
+
+// Identify authenticates the request like Authenticate and returns
+// APITokenIdentityPrefix followed by the name of the token.
+func (a *APITokenAuthenticator) Identify(_ context.Context, headers http.Header) (string, error) {
+	provided, err := extractBearerToken(headers)
+	if err != nil {
+		return "", fmt.Errorf("apitoken: %w", err)
+	}
+	// Every token is compared, so the time taken does not tell which one
+	// matched.
+	match := ""
+	for _, t := range *a.tokens.Load() {
+		if subtle.ConstantTimeCompare([]byte(provided), t.value) == 1 {
+			match = t.name
+		}
+	}
+	if match == "" {
+		return "", fmt.Errorf("apitoken: %w", ErrInvalidCredentials)
+	}
+	return APITokenIdentityPrefix + match, nil
+}
+
+// APITokenReloader periodically reads the tokens of an APITokenAuthenticator
+// from a Secret: each key names a token and its value is the token. The last
+// tokens read are kept while the Secret cannot be read; a deleted Secret
+// revokes every token. It runs on every replica.
+type APITokenReloader struct {
+	reader   client.Reader
+	key      client.ObjectKey
+	interval time.Duration
+	authn    *APITokenAuthenticator
+}
+
+// NewAPITokenReloader creates an APITokenReloader reading the Secret name of
+// namespace every interval.
+func NewAPITokenReloader(reader client.Reader, namespace, name string, interval time.Duration, authn *APITokenAuthenticator) *APITokenReloader {
+	return &APITokenReloader{
+		reader:   reader,
+		key:      client.ObjectKey{Namespace: namespace, Name: name},
+		interval: interval,
+		authn:    authn,
+	}
+}
+
+// Reload reads the Secret once and replaces the tokens.
+func (r *APITokenReloader) Reload(ctx context.Context) error {
+	var secret corev1.Secret
+	if err := r.reader.Get(ctx, r.key, &secret); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			r.authn.SetTokens(nil)
+		}
+		return fmt.Errorf("get secret %s: %w", r.key, err)
+	}
+	r.authn.SetTokens(secret.Data)
+	return nil
+}
+
+// Start implements manager.Runnable.
+func (r *APITokenReloader) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("apitokens")
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	// lastErr is the last reload error, logged once until the reload
+	// succeeds or fails differently: a missing Secret fails every tick.
+	var lastErr string
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			before := r.authn.Len()
+			if err := r.Reload(ctx); err != nil {
+				if err.Error() != lastErr {
+					logger.Error(err, "reload API tokens failed")
+					lastErr = err.Error()
+				}
+				continue
+			}
+			if after := r.authn.Len(); after != before || lastErr != "" {
+				logger.Info("API tokens reloaded", "tokens", after)
+			}
+			lastErr = ""
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable: every
+// replica authenticates requests.
+func (r *APITokenReloader) NeedLeaderElection() bool {
+	return false
+}
+
+var (
+	_ manager.Runnable               = (*APITokenReloader)(nil)
+	_ manager.LeaderElectionRunnable = (*APITokenReloader)(nil)
+)
@@ -0,0 +1,85 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/golgoth31/sreportal/internal/auth"
+)
+
+const tTokenSecret = "sreportal-api-tokens"
+
+func TestAPIToken_IdentifiesTokenName(t *testing.T) {
+	tokens := auth.NewAPITokenAuthenticator()
+	tokens.SetTokens(map[string][]byte{"ci": []byte("ci-token"), "remote": []byte("remote-token"), "empty": nil})
+	assert.Equal(t, 2, tokens.Len())
+
+	user, err := tokens.Identify(context.Background(), bearerHeader("remote-token"))
+	require.NoError(t, err)
+	assert.Equal(t, "token:remote", user)
+
+	_, err = tokens.Identify(context.Background(), bearerHeader("wrong"))
+	assert.True(t, errors.Is(err, auth.ErrInvalidCredentials))
+
+	err = tokens.Authenticate(context.Background(), http.Header{})
+	assert.True(t, errors.Is(err, auth.ErrUnauthenticated))
+}
+
+func TestAPIToken_NoTokenAcceptsNothing(t *testing.T) {
+	tokens := auth.NewAPITokenAuthenticator()
+	err := tokens.Authenticate(context.Background(), bearerHeader(""))
+	assert.True(t, errors.Is(err, auth.ErrInvalidCredentials))
+}
+
+func TestAPITokenReloader_ReloadsSecret(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: tTokenSecret, Namespace: "sreportal-system"},
+		Data:       map[string][]byte{"ci": []byte("ci-token")},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+	tokens := auth.NewAPITokenAuthenticator()
+	r := auth.NewAPITokenReloader(c, "sreportal-system", tTokenSecret, 0, tokens)
+	ctx := context.Background()
+
+	require.NoError(t, r.Reload(ctx))
+	require.NoError(t, tokens.Authenticate(ctx, bearerHeader("ci-token")))
+
+	// Rotating the token revokes the previous one.
+	secret.Data = map[string][]byte{"ci": []byte("ci-token-2")}
+	require.NoError(t, c.Update(ctx, secret))
+	require.NoError(t, r.Reload(ctx))
+	require.Error(t, tokens.Authenticate(ctx, bearerHeader("ci-token")))
+	require.NoError(t, tokens.Authenticate(ctx, bearerHeader("ci-token-2")))
+
+	// Deleting the Secret revokes every token.
+	require.NoError(t, c.Delete(ctx, secret))
+	require.Error(t, r.Reload(ctx))
+	assert.Zero(t, tokens.Len())
+}
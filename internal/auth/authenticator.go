@@ -28,6 +28,14 @@ type Authenticator interface {
 	Authenticate(ctx context.Context, headers http.Header) error
 }
 
+// Identifier is implemented by the authenticators that can name the
+// authenticated caller.
+type Identifier interface {
+	// Identify authenticates the request like Authenticate and returns the
+	// caller identity.
+	Identify(ctx context.Context, headers http.Header) (string, error)
+}
+
 // Chain tries each authenticator in order. If any one succeeds, the request
 // is considered authenticated. If all fail, the last error is returned.
 type Chain struct {
@@ -41,18 +49,34 @@ func NewChain(authenticators ...Authenticator) *Chain {
 
 // Authenticate tries each authenticator. Returns nil on the first success.
 func (c *Chain) Authenticate(ctx context.Context, headers http.Header) error {
+	_, err := c.Identify(ctx, headers)
+	return err
+}
+
+// Identify tries each authenticator and returns the identity given by the
+// first one that succeeds. Authenticators that are not an Identifier give an
+// empty identity.
+func (c *Chain) Identify(ctx context.Context, headers http.Header) (string, error) {
 	if len(c.authenticators) == 0 {
-		return ErrNoAuthMethod
+		return "", ErrNoAuthMethod
 	}
 
 	var errs []string
 	for _, a := range c.authenticators {
-		if err := a.Authenticate(ctx, headers); err == nil {
-			return nil
+		var (
+			user string
+			err  error
+		)
+		if id, ok := a.(Identifier); ok {
+			user, err = id.Identify(ctx, headers)
 		} else {
-			errs = append(errs, err.Error())
+			err = a.Authenticate(ctx, headers)
+		}
+		if err == nil {
+			return user, nil
 		}
+		errs = append(errs, err.Error())
 	}
 
-	return fmt.Errorf("%w: %s", ErrUnauthenticated, strings.Join(errs, "; "))
+	return "", fmt.Errorf("%w: %s", ErrUnauthenticated, strings.Join(errs, "; "))
 }
@@ -0,0 +1,192 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	domainportal "github.com/golgoth31/sreportal/internal/domain/portal"
+)
+
+// Verbs of an authorization request.
+const (
+	VerbRead  = "read"
+	VerbWrite = "write"
+	VerbAdmin = "admin"
+)
+
+// ErrPermissionDenied is returned when the authorizer denies a request.
+var ErrPermissionDenied = errors.New("permission denied")
+
+// AuthorizationRequest is the (user, portal, verb) tuple submitted to an
+// Authorizer. User is empty for anonymous callers and Portal for calls that
+// do not target a portal.
+type AuthorizationRequest struct {
+	User   string `json:"user"`
+	Portal string `json:"portal"`
+	Verb   string `json:"verb"`
+}
+
+// Authorizer decides whether a request is allowed.
+type Authorizer interface {
+	// Authorize returns nil when the request is allowed, an error wrapping
+	// ErrPermissionDenied when it is denied, and any other error when no
+	// decision could be made.
+	Authorize(ctx context.Context, req AuthorizationRequest) error
+}
+
+// portalFields are the request message fields naming the targeted portal.
+var portalFields = []protoreflect.Name{"portal", "portal_ref"}
+
+// AuthzInterceptor returns a Connect interceptor that submits every call to
+// authz. The user is the identity given by chain (empty when the call is not
+// authenticated or chain is nil), the portal is read from the "portal" or
+// "portal_ref" field of the request, and the verb is VerbAdmin for
+// AdminProcedures, VerbWrite for WriteProcedures, VerbRead otherwise. Streaming calls are authorized on
+// their first request message.
+//
+// An empty portal field targets every portal: the call is submitted once per
+// portal listed by portals, and denied unless all of them are allowed. When
+// there is no portal, it is submitted once with the empty portal.
+func AuthzInterceptor(chain *Chain, authz Authorizer, portals domainportal.PortalReader) connect.Interceptor {
+	return &authzInterceptor{chain: chain, authz: authz, portals: portals}
+}
+
+type authzInterceptor struct {
+	chain   *Chain
+	authz   Authorizer
+	portals domainportal.PortalReader
+}
+
+func (i *authzInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := i.authorize(ctx, req.Spec().Procedure, req.Header(), req.Any()); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *authzInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *authzInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(ctx, &authzStreamConn{StreamingHandlerConn: conn, ctx: ctx, interceptor: i})
+	}
+}
+
+func (i *authzInterceptor) authorize(ctx context.Context, procedure string, headers http.Header, msg any) error {
+	portal, scoped := portalOf(msg)
+	req := AuthorizationRequest{Verb: VerbRead, Portal: portal}
+	switch {
+	case AdminProcedures[procedure]:
+		req.Verb = VerbAdmin
+	case WriteProcedures[procedure]:
+		req.Verb = VerbWrite
+	}
+	if i.chain != nil {
+		// An unauthenticated caller is authorized as anonymous; write
+		// procedures still require authentication from AuthInterceptor.
+		req.User, _ = i.chain.Identify(ctx, headers)
+	}
+
+	var err error
+	if scoped && portal == "" {
+		err = i.authorizeAll(ctx, req)
+	} else {
+		err = i.authz.Authorize(ctx, req)
+	}
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, ErrPermissionDenied):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	default:
+		return connect.NewError(connect.CodeUnavailable, fmt.Errorf("authorization: %w", err))
+	}
+}
+
+// authorizeAll submits req once per portal, so that a call targeting every
+// portal is only allowed to the callers allowed on all of them. Without any
+// portal, req is submitted once with an empty portal.
+func (i *authzInterceptor) authorizeAll(ctx context.Context, req AuthorizationRequest) error {
+	if i.portals == nil {
+		return fmt.Errorf("%w: portal is required", ErrPermissionDenied)
+	}
+	portals, err := i.portals.List(ctx, domainportal.PortalFilters{})
+	if err != nil {
+		return err
+	}
+	if len(portals) == 0 {
+		return i.authz.Authorize(ctx, req)
+	}
+	for _, p := range portals {
+		req.Portal = p.Name
+		if err := i.authz.Authorize(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// authzStreamConn authorizes a streaming call on its first received message.
+type authzStreamConn struct {
+	connect.StreamingHandlerConn
+	ctx         context.Context
+	interceptor *authzInterceptor
+	once        sync.Once
+	err         error
+}
+
+func (c *authzStreamConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	c.once.Do(func() {
+		c.err = c.interceptor.authorize(c.ctx, c.Spec().Procedure, c.RequestHeader(), msg)
+	})
+	return c.err
+}
+
+// portalOf returns the portal named by msg and whether msg has a portal
+// field at all: an empty portal field targets every portal, while a message
+// without one does not target a portal.
+func portalOf(msg any) (string, bool) {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return "", false
+	}
+	r := m.ProtoReflect()
+	fields := r.Descriptor().Fields()
+	for _, name := range portalFields {
+		fd := fields.ByName(name)
+		if fd != nil && fd.Kind() == protoreflect.StringKind && !fd.IsList() {
+			return r.Get(fd).String(), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/golgoth31/sreportal/internal/auth"
+	"github.com/golgoth31/sreportal/internal/config"
+	domainportal "github.com/golgoth31/sreportal/internal/domain/portal"
+	svcgrpc "github.com/golgoth31/sreportal/internal/grpc"
+	portalv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	"github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1/sreportalv1connect"
+	"github.com/golgoth31/sreportal/internal/log"
+)
+
+// recordingAuthorizer allows the requests of allowed users and records them.
+type recordingAuthorizer struct {
+	mu       sync.Mutex
+	allowed  map[string]bool
+	requests []auth.AuthorizationRequest
+}
+
+func (a *recordingAuthorizer) Authorize(_ context.Context, req auth.AuthorizationRequest) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.requests = append(a.requests, req)
+	if a.allowed[req.User] {
+		return nil
+	}
+	return auth.ErrPermissionDenied
+}
+
+func TestAuthzInterceptor_SubmitsUserAndVerb(t *testing.T) {
+	chain := auth.NewChain(auth.NewAPIKeyAuthenticator("ci", "", "my-secret-key"))
+	authz := &recordingAuthorizer{allowed: map[string]bool{"apikey:ci": true}}
+	portals := staticPortals{{Name: "main"}}
+	client := setupReleaseServerWith(t, auth.AuthzInterceptor(chain, authz, portals), auth.AuthInterceptor(chain))
+
+	req := connect.NewRequest(&portalv1.ReleaseEntry{
+		Type:    tKindDeployment,
+		Version: tVerV1,
+		Origin:  "ci",
+		Date:    timestamppb.New(time.Now()),
+	})
+	req.Header().Set("X-API-Key", "my-secret-key")
+	_, err := client.AddRelease(context.Background(), req)
+	require.NoError(t, err)
+
+	_, err = client.ListReleaseDays(context.Background(), connect.NewRequest(&portalv1.ListReleaseDaysRequest{}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	assert.Equal(t, []auth.AuthorizationRequest{
+		{User: "apikey:ci", Portal: "main", Verb: auth.VerbWrite},
+		{User: "", Portal: "main", Verb: auth.VerbRead},
+	}, authz.requests)
+}
+
+func TestAuthzInterceptor_SubmitsAdminVerb(t *testing.T) {
+	chain := auth.NewChain(auth.NewAPIKeyAuthenticator("ci", "", "my-secret-key"))
+	authz := &recordingAuthorizer{}
+	mux := http.NewServeMux()
+	mux.Handle(sreportalv1connect.NewLogServiceHandler(
+		svcgrpc.NewLogService(log.NewTap(10), chain, []string{"apikey:ci"}),
+		connect.WithInterceptors(auth.AuthzInterceptor(chain, authz, staticPortals{{Name: "main"}}))))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	client := sreportalv1connect.NewLogServiceClient(server.Client(), server.URL)
+
+	req := connect.NewRequest(&portalv1.StreamLogsRequest{})
+	req.Header().Set("X-API-Key", "my-secret-key")
+	stream, err := client.StreamLogs(context.Background(), req)
+	require.NoError(t, err)
+	defer func() { _ = stream.Close() }()
+
+	assert.False(t, stream.Receive())
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(stream.Err()))
+	assert.Equal(t, []auth.AuthorizationRequest{{User: "apikey:ci", Portal: "main", Verb: auth.VerbAdmin}}, authz.requests)
+}
+
+func TestAuthzInterceptor_ReadsPortalFromRequest(t *testing.T) {
+	authz := &recordingAuthorizer{allowed: map[string]bool{"": true}}
+	next := func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+		return connect.NewResponse(&portalv1.ListFQDNsResponse{}), nil
+	}
+
+	_, err := auth.AuthzInterceptor(nil, authz, nil).WrapUnary(next)(context.Background(),
+		connect.NewRequest(&portalv1.ListFQDNsRequest{Portal: "team-a"}))
+	require.NoError(t, err)
+	assert.Equal(t, []auth.AuthorizationRequest{{Portal: "team-a", Verb: auth.VerbRead}}, authz.requests)
+}
+
+// portalAuthorizer allows the requests naming one of the allowed portals.
+type portalAuthorizer map[string]bool
+
+func (a portalAuthorizer) Authorize(_ context.Context, req auth.AuthorizationRequest) error {
+	if a[req.Portal] {
+		return nil
+	}
+	return auth.ErrPermissionDenied
+}
+
+func TestAuthzInterceptor_EmptyPortalTargetsEveryPortal(t *testing.T) {
+	next := func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+		return connect.NewResponse(&portalv1.ListFQDNsResponse{}), nil
+	}
+	portals := staticPortals{{Name: "main"}, {Name: "team-a"}}
+
+	tests := []struct {
+		name    string
+		authz   auth.Authorizer
+		portals domainportal.PortalReader
+		want    connect.Code
+	}{
+		{name: "every portal allowed", authz: portalAuthorizer{"main": true, "team-a": true}, portals: portals},
+		{name: "one portal denied", authz: portalAuthorizer{"main": true}, portals: portals, want: connect.CodePermissionDenied},
+		{name: "no portal reader", authz: portalAuthorizer{"": true}, want: connect.CodePermissionDenied},
+		{name: "no portal, allowed", authz: portalAuthorizer{"": true}, portals: staticPortals{}},
+		{name: "no portal, denied", authz: portalAuthorizer{}, portals: staticPortals{}, want: connect.CodePermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := auth.AuthzInterceptor(nil, tt.authz, tt.portals).WrapUnary(next)(context.Background(),
+				connect.NewRequest(&portalv1.ListFQDNsRequest{}))
+			if tt.want == 0 {
+				require.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.want, connect.CodeOf(err))
+		})
+	}
+}
+
+func TestWebhookAuthorizer_CachesDecisions(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		var body struct {
+			Input auth.AuthorizationRequest `json:"input"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"allowed": body.Input.Portal == "team-a",
+			"reason":  "portal reserved to team-a",
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	authz := auth.NewWebhookAuthorizer(config.AuthorizationWebhookConfig{Enabled: true, URL: server.URL}, nil)
+	ctx := context.Background()
+	allowed := auth.AuthorizationRequest{User: "alice", Portal: "team-a", Verb: auth.VerbRead}
+
+	require.NoError(t, authz.Authorize(ctx, allowed))
+	require.NoError(t, authz.Authorize(ctx, allowed))
+	err := authz.Authorize(ctx, auth.AuthorizationRequest{User: "alice", Portal: "team-b", Verb: auth.VerbRead})
+	require.ErrorIs(t, err, auth.ErrPermissionDenied)
+	assert.Contains(t, err.Error(), "portal reserved to team-a")
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestWebhookAuthorizer_AcceptsOPAResult(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"result": true}`))
+	}))
+	t.Cleanup(server.Close)
+
+	authz := auth.NewWebhookAuthorizer(config.AuthorizationWebhookConfig{Enabled: true, URL: server.URL}, nil)
+	require.NoError(t, authz.Authorize(context.Background(), auth.AuthorizationRequest{User: "bob", Verb: auth.VerbWrite}))
+}
+
+func TestWebhookAuthorizer_FailurePolicy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(server.Close)
+	req := auth.AuthorizationRequest{User: "bob", Verb: auth.VerbRead}
+
+	deny := auth.NewWebhookAuthorizer(config.AuthorizationWebhookConfig{Enabled: true, URL: server.URL}, nil)
+	err := deny.Authorize(context.Background(), req)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, auth.ErrPermissionDenied)
+
+	allow := auth.NewWebhookAuthorizer(config.AuthorizationWebhookConfig{
+		Enabled: true, URL: server.URL, FailurePolicy: config.AuthorizationFailurePolicyAllow,
+	}, nil)
+	require.NoError(t, allow.Authorize(context.Background(), req))
+}
@@ -18,39 +18,128 @@ package auth
 
 import (
 	"context"
+	"net/http"
 
 	"connectrpc.com/connect"
 )
 
 // WriteProcedures lists the Connect procedures that require authentication.
 var WriteProcedures = map[string]bool{
-	"/sreportal.v1.ReleaseService/AddRelease":       true,
-	"/sreportal.v1.StatusService/CreateComponent":   true,
-	"/sreportal.v1.StatusService/UpdateComponent":   true,
-	"/sreportal.v1.StatusService/DeleteComponent":   true,
-	"/sreportal.v1.StatusService/CreateMaintenance": true,
-	"/sreportal.v1.StatusService/UpdateMaintenance": true,
-	"/sreportal.v1.StatusService/DeleteMaintenance": true,
-	"/sreportal.v1.StatusService/CreateIncident":    true,
-	"/sreportal.v1.StatusService/UpdateIncident":    true,
-	"/sreportal.v1.StatusService/DeleteIncident":    true,
+	"/sreportal.v1.ReleaseService/AddRelease":           true,
+	"/sreportal.v1.DNSService/BatchUpdateManualEntries": true,
+	"/sreportal.v1.DNSService/SetFQDNsIgnored":          true,
+	"/sreportal.v1.DNSService/PublishEndpoints":         true,
+	"/sreportal.v1.DNSService/AddNote":                  true,
+	"/sreportal.v1.StatusService/CreateComponent":       true,
+	"/sreportal.v1.StatusService/UpdateComponent":       true,
+	"/sreportal.v1.StatusService/DeleteComponent":       true,
+	"/sreportal.v1.StatusService/CreateMaintenance":     true,
+	"/sreportal.v1.StatusService/UpdateMaintenance":     true,
+	"/sreportal.v1.StatusService/DeleteMaintenance":     true,
+	"/sreportal.v1.StatusService/CreateIncident":        true,
+	"/sreportal.v1.StatusService/UpdateIncident":        true,
+	"/sreportal.v1.StatusService/DeleteIncident":        true,
+	"/sreportal.v1.PortalService/PromoteToMain":         true,
+	// Diagnostics expose the operator RBAC and configuration errors.
+	"/sreportal.v1.DiagnosticsService/RunDiagnostics": true,
+}
+
+// AdminProcedures lists the Connect procedures reserved to the callers with
+// the admin role (auth.admins).
+var AdminProcedures = map[string]bool{
+	"/sreportal.v1.LogService/StreamLogs": true,
 }
 
 // AuthInterceptor returns a Connect unary interceptor that enforces authentication
-// on write procedures. Unprotected procedures pass through without auth checks.
+// on write procedures, and stores the caller identity in their context (see
+// IdentityFromContext). Unprotected procedures pass through without auth checks.
+// Like RequireAuthInterceptor, it accepts web UI users logged in with OIDC
+// without other credentials.
 func AuthInterceptor(chain *Chain) connect.UnaryInterceptorFunc {
 	return func(next connect.UnaryFunc) connect.UnaryFunc {
 		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			if !WriteProcedures[req.Spec().Procedure] {
+			if !WriteProcedures[req.Spec().Procedure] || PrincipalFromContext(ctx) != nil {
 				return next(ctx, req)
 			}
 
 			headers := req.Header()
-			if err := chain.Authenticate(ctx, headers); err != nil {
+			id, err := chain.Identify(ctx, headers)
+			if err != nil {
 				return nil, connect.NewError(connect.CodeUnauthenticated, err)
 			}
 
-			return next(ctx, req)
+			return next(WithIdentity(ctx, id), req)
+		}
+	}
+}
+
+// RequireAuthInterceptor returns a Connect interceptor authenticating every
+// call of the handlers it is installed on, reads and streams included. Web UI
+// users logged in with OIDC are accepted without other credentials.
+func RequireAuthInterceptor(chain *Chain) connect.Interceptor {
+	return &requireAuthInterceptor{chain: chain}
+}
+
+type requireAuthInterceptor struct {
+	chain *Chain
+}
+
+// authenticate authenticates the call and returns ctx carrying the caller
+// identity.
+func (i *requireAuthInterceptor) authenticate(ctx context.Context, headers http.Header) (context.Context, error) {
+	if PrincipalFromContext(ctx) != nil {
+		return ctx, nil
+	}
+	id, err := i.chain.Identify(ctx, headers)
+	if err != nil {
+		return ctx, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	return WithIdentity(ctx, id), nil
+}
+
+// WrapUnary implements connect.Interceptor.
+func (i *requireAuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, err := i.authenticate(ctx, req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient implements connect.Interceptor; clients are not
+// affected.
+func (i *requireAuthInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler implements connect.Interceptor.
+func (i *requireAuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := i.authenticate(ctx, conn.RequestHeader())
+		if err != nil {
+			return err
 		}
+		return next(ctx, conn)
 	}
 }
+
+// RequireAuth returns an http.Handler authenticating every request before
+// passing it to next with the caller identity in its context. It is the
+// counterpart of RequireAuthInterceptor for the handlers mounted outside of
+// Connect, such as the MCP servers.
+func RequireAuth(chain *Chain, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		if PrincipalFromContext(ctx) == nil {
+			id, err := chain.Identify(ctx, r.Header)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+			ctx = WithIdentity(ctx, id)
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
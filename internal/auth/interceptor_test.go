@@ -41,6 +41,15 @@ import (
 )
 
 func setupReleaseServer(t *testing.T, chain *auth.Chain) sreportalv1connect.ReleaseServiceClient {
+	t.Helper()
+	var interceptors []connect.Interceptor
+	if chain != nil {
+		interceptors = append(interceptors, auth.AuthInterceptor(chain))
+	}
+	return setupReleaseServerWith(t, interceptors...)
+}
+
+func setupReleaseServerWith(t *testing.T, interceptors ...connect.Interceptor) sreportalv1connect.ReleaseServiceClient {
 	t.Helper()
 	scheme := runtime.NewScheme()
 	_ = sreportalv1alpha1.AddToScheme(scheme)
@@ -54,8 +63,8 @@ func setupReleaseServer(t *testing.T, chain *auth.Chain) sreportalv1connect.Rele
 	grpcSvc := svcgrpc.NewReleaseService(reader, svc, 30*24*time.Hour, nil, nil)
 
 	var opts []connect.HandlerOption
-	if chain != nil {
-		opts = append(opts, connect.WithInterceptors(auth.AuthInterceptor(chain)))
+	if len(interceptors) > 0 {
+		opts = append(opts, connect.WithInterceptors(interceptors...))
 	}
 
 	mux := http.NewServeMux()
@@ -71,7 +80,7 @@ func setupReleaseServer(t *testing.T, chain *auth.Chain) sreportalv1connect.Rele
 
 func TestInterceptor_ProtectedEndpoint_NoAuth_Rejected(t *testing.T) {
 	chain := auth.NewChain(
-		auth.NewAPIKeyAuthenticator("", "my-secret-key"),
+		auth.NewAPIKeyAuthenticator("ci", "", "my-secret-key"),
 	)
 	client := setupReleaseServer(t, chain)
 
@@ -88,7 +97,7 @@ func TestInterceptor_ProtectedEndpoint_NoAuth_Rejected(t *testing.T) {
 
 func TestInterceptor_ProtectedEndpoint_ValidAuth_Passes(t *testing.T) {
 	chain := auth.NewChain(
-		auth.NewAPIKeyAuthenticator("", "my-secret-key"),
+		auth.NewAPIKeyAuthenticator("ci", "", "my-secret-key"),
 	)
 	client := setupReleaseServer(t, chain)
 
@@ -107,7 +116,7 @@ func TestInterceptor_ProtectedEndpoint_ValidAuth_Passes(t *testing.T) {
 
 func TestInterceptor_ReadEndpoint_NoAuth_Passes(t *testing.T) {
 	chain := auth.NewChain(
-		auth.NewAPIKeyAuthenticator("", "my-secret-key"),
+		auth.NewAPIKeyAuthenticator("ci", "", "my-secret-key"),
 	)
 	client := setupReleaseServer(t, chain)
 
@@ -134,7 +143,7 @@ func TestInterceptor_NoChain_NoProcedureProtection(t *testing.T) {
 
 func TestInterceptor_APIKey_OnProtectedEndpoint(t *testing.T) {
 	chain := auth.NewChain(
-		auth.NewAPIKeyAuthenticator("X-Custom-Auth", "my-secret-key"),
+		auth.NewAPIKeyAuthenticator("ci", "X-Custom-Auth", "my-secret-key"),
 	)
 	client := setupReleaseServer(t, chain)
 
@@ -154,7 +163,7 @@ func TestInterceptor_APIKey_OnProtectedEndpoint(t *testing.T) {
 func TestInterceptor_ListReleases_NoAuth_Passes(t *testing.T) {
 	// Verify ListReleases (also a read endpoint) passes without auth
 	chain := auth.NewChain(
-		auth.NewAPIKeyAuthenticator("", "my-secret-key"),
+		auth.NewAPIKeyAuthenticator("ci", "", "my-secret-key"),
 	)
 	client := setupReleaseServer(t, chain)
 
@@ -164,3 +173,92 @@ func TestInterceptor_ListReleases_NoAuth_Passes(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, resp.Msg)
 }
+
+func TestRequireAuthInterceptor_ReadEndpoint(t *testing.T) {
+	tokens := auth.NewAPITokenAuthenticator()
+	tokens.SetTokens(map[string][]byte{"remote": []byte("remote-token")})
+	client := setupReleaseServerWith(t, auth.RequireAuthInterceptor(auth.NewChain(tokens)))
+
+	_, err := client.ListReleaseDays(context.Background(), connect.NewRequest(&releasev1.ListReleaseDaysRequest{}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+	req := connect.NewRequest(&releasev1.ListReleaseDaysRequest{})
+	req.Header().Set("Authorization", "Bearer remote-token")
+	_, err = client.ListReleaseDays(context.Background(), req)
+	require.NoError(t, err)
+}
+
+func TestRequireAuthInterceptor_AcceptsOIDCPrincipal(t *testing.T) {
+	interceptor := auth.RequireAuthInterceptor(auth.NewChain(auth.NewAPITokenAuthenticator()))
+	next := func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+		return connect.NewResponse(&releasev1.ListReleaseDaysResponse{}), nil
+	}
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "alice"})
+
+	_, err := interceptor.WrapUnary(next)(ctx, connect.NewRequest(&releasev1.ListReleaseDaysRequest{}))
+	require.NoError(t, err)
+}
+
+func TestAuthInterceptor_AcceptsOIDCPrincipalOnWrites(t *testing.T) {
+	// Stands for the OIDC middleware of the web server.
+	var withPrincipal connect.UnaryInterceptorFunc = func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			return next(auth.WithPrincipal(ctx, &auth.Principal{Subject: "alice"}), req)
+		}
+	}
+	chain := auth.NewChain(auth.NewAPIKeyAuthenticator("ci", "", "my-secret-key"))
+	client := setupReleaseServerWith(t, withPrincipal, auth.AuthInterceptor(chain))
+
+	resp, err := client.AddRelease(context.Background(), connect.NewRequest(&releasev1.ReleaseEntry{
+		Type:    tKindDeployment,
+		Version: tVerV1,
+		Origin:  "ci",
+		Date:    timestamppb.New(time.Now()),
+	}))
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.Msg.Day)
+}
+
+func TestRequireAuthInterceptor_StoresIdentity(t *testing.T) {
+	tokens := auth.NewAPITokenAuthenticator()
+	tokens.SetTokens(map[string][]byte{"edge-1": []byte("edge-token")})
+	interceptor := auth.RequireAuthInterceptor(auth.NewChain(tokens))
+	var identity string
+	next := func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+		identity = auth.IdentityFromContext(ctx)
+		return connect.NewResponse(&releasev1.ListReleaseDaysResponse{}), nil
+	}
+
+	req := connect.NewRequest(&releasev1.ListReleaseDaysRequest{})
+	req.Header().Set("Authorization", "Bearer edge-token")
+	_, err := interceptor.WrapUnary(next)(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, "token:edge-1", identity)
+
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "alice"})
+	_, err = interceptor.WrapUnary(next)(ctx, connect.NewRequest(&releasev1.ListReleaseDaysRequest{}))
+	require.NoError(t, err)
+	assert.Equal(t, "alice", identity)
+}
+
+func TestRequireAuth_HTTPHandler(t *testing.T) {
+	tokens := auth.NewAPITokenAuthenticator()
+	tokens.SetTokens(map[string][]byte{"assistant": []byte("assistant-token")})
+	var identity string
+	handler := auth.RequireAuth(auth.NewChain(tokens), http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		identity = auth.IdentityFromContext(r.Context())
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/mcp", nil))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Empty(t, identity)
+
+	req := httptest.NewRequest(http.MethodPost, "/mcp", nil)
+	req.Header.Set("Authorization", "Bearer assistant-token")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "token:assistant", identity)
+}
@@ -68,21 +68,29 @@ func NewJWTAuthenticatorWithKeyfunc(cfg config.JWTAuthConfig, kf keyfunc.Keyfunc
 }
 
 // Authenticate extracts a Bearer token and validates it against configured issuers.
-func (a *JWTAuthenticator) Authenticate(_ context.Context, headers http.Header) error {
+func (a *JWTAuthenticator) Authenticate(ctx context.Context, headers http.Header) error {
+	_, err := a.Identify(ctx, headers)
+	return err
+}
+
+// Identify authenticates the request like Authenticate and returns the "sub"
+// claim of the token.
+func (a *JWTAuthenticator) Identify(_ context.Context, headers http.Header) (string, error) {
 	tokenStr, err := extractBearerToken(headers)
 	if err != nil {
-		return err
+		return "", fmt.Errorf("jwt: %w", err)
 	}
 
 	var lastErr error
 	for _, iss := range a.issuers {
-		lastErr = a.validateToken(tokenStr, iss)
+		var subject string
+		subject, lastErr = a.validateToken(tokenStr, iss)
 		if lastErr == nil {
-			return nil
+			return subject, nil
 		}
 	}
 
-	return lastErr
+	return "", lastErr
 }
 
 // Close stops background JWKS refresh goroutines.
@@ -92,18 +100,19 @@ func (a *JWTAuthenticator) Close() {
 	}
 }
 
+// extractBearerToken returns the token of the Authorization header.
 func extractBearerToken(headers http.Header) (string, error) {
 	authHeader := headers.Get("Authorization")
 	if authHeader == "" {
-		return "", fmt.Errorf("jwt: %w", ErrUnauthenticated)
+		return "", ErrUnauthenticated
 	}
 	if !strings.HasPrefix(authHeader, "Bearer ") {
-		return "", fmt.Errorf("jwt: %w: not a Bearer scheme", ErrUnauthenticated)
+		return "", fmt.Errorf("%w: not a Bearer scheme", ErrUnauthenticated)
 	}
 	return authHeader[len("Bearer "):], nil
 }
 
-func (a *JWTAuthenticator) validateToken(tokenStr string, iss issuerProvider) error {
+func (a *JWTAuthenticator) validateToken(tokenStr string, iss issuerProvider) (string, error) {
 	parserOpts := []jwt.ParserOption{
 		jwt.WithIssuer(iss.cfg.IssuerURL),
 		jwt.WithExpirationRequired(),
@@ -114,18 +123,19 @@ func (a *JWTAuthenticator) validateToken(tokenStr string, iss issuerProvider) er
 
 	token, err := jwt.Parse(tokenStr, iss.jwks.KeyfuncCtx(context.Background()), parserOpts...)
 	if err != nil {
-		return fmt.Errorf("jwt: %w: %w", ErrInvalidToken, err)
+		return "", fmt.Errorf("jwt: %w: %w", ErrInvalidToken, err)
 	}
 
 	if !token.Valid {
-		return fmt.Errorf("jwt: %w", ErrInvalidToken)
+		return "", fmt.Errorf("jwt: %w", ErrInvalidToken)
 	}
 
 	if err := a.validateClaims(token, iss.cfg); err != nil {
-		return err
+		return "", err
 	}
 
-	return nil
+	subject, _ := token.Claims.GetSubject()
+	return subject, nil
 }
 
 func (a *JWTAuthenticator) validateClaims(token *jwt.Token, cfg config.JWTIssuerConfig) error {
@@ -0,0 +1,495 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"cmp"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/MicahParks/keyfunc/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+
+	"github.com/golgoth31/sreportal/internal/config"
+)
+
+// Paths of the OIDC login endpoints served by the web server.
+const (
+	OIDCLoginPath    = "/auth/login"
+	OIDCCallbackPath = "/auth/callback"
+	OIDCLogoutPath   = "/auth/logout"
+	OIDCMePath       = "/auth/me"
+)
+
+const (
+	// SessionCookieName is the cookie holding the signed login session.
+	SessionCookieName = "sreportal_session"
+	// oidcStateCookieName holds the signed state of a login in progress.
+	oidcStateCookieName = "sreportal_oidc_state"
+	// oidcStateTTL bounds the time a user may take to log in at the provider.
+	oidcStateTTL = 10 * time.Minute
+	// maxSessionCookieBytes keeps the session cookie under the 4KB browsers
+	// accept.
+	maxSessionCookieBytes = 3800
+	// minSessionKeyBytes is the shortest accepted session signing key.
+	minSessionKeyBytes = 32
+	// maxDiscoveryBytes bounds the discovery document read.
+	maxDiscoveryBytes = 1 << 20
+)
+
+// errInvalidSession is returned when a signed cookie is malformed, forged or
+// expired.
+var errInvalidSession = errors.New("invalid session")
+
+// Principal is a user logged in through OIDC.
+type Principal struct {
+	Subject string    `json:"sub"`
+	Name    string    `json:"name,omitempty"`
+	Groups  []string  `json:"groups,omitempty"`
+	Expires time.Time `json:"exp"`
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying p.
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the principal carried by ctx, or nil for
+// anonymous requests.
+func PrincipalFromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
+
+type identityKey struct{}
+
+// WithIdentity returns a copy of ctx carrying id, the identity of a caller
+// authenticated by a Chain.
+func WithIdentity(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// IdentityFromContext returns the identity of the authenticated caller of
+// ctx: the one stored by WithIdentity, else the subject of the OIDC
+// principal. It is empty for anonymous requests, and for callers
+// authenticated by an authenticator that names no one.
+func IdentityFromContext(ctx context.Context) string {
+	if id, _ := ctx.Value(identityKey{}).(string); id != "" {
+		return id
+	}
+	if p := PrincipalFromContext(ctx); p != nil {
+		return p.Subject
+	}
+	return ""
+}
+
+// OIDCEndpoints are the provider endpoints read from its discovery document.
+type OIDCEndpoints struct {
+	Issuer   string `json:"issuer"`
+	AuthURL  string `json:"authorization_endpoint"`
+	TokenURL string `json:"token_endpoint"`
+	JWKSURL  string `json:"jwks_uri"`
+}
+
+// DiscoverOIDC reads the discovery document of issuer and checks it names
+// issuer.
+func DiscoverOIDC(ctx context.Context, httpClient *http.Client, issuer string) (OIDCEndpoints, error) {
+	wellKnown := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, wellKnown, nil)
+	if err != nil {
+		return OIDCEndpoints{}, fmt.Errorf("build discovery request: %w", err)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return OIDCEndpoints{}, fmt.Errorf("fetch %s: %w", wellKnown, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return OIDCEndpoints{}, fmt.Errorf("fetch %s: HTTP %d", wellKnown, resp.StatusCode)
+	}
+
+	var ep OIDCEndpoints
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxDiscoveryBytes)).Decode(&ep); err != nil {
+		return OIDCEndpoints{}, fmt.Errorf("decode discovery document: %w", err)
+	}
+	if strings.TrimSuffix(ep.Issuer, "/") != strings.TrimSuffix(issuer, "/") {
+		return OIDCEndpoints{}, fmt.Errorf("discovery document names issuer %q, want %q", ep.Issuer, issuer)
+	}
+	if ep.AuthURL == "" || ep.TokenURL == "" || ep.JWKSURL == "" {
+		return OIDCEndpoints{}, errors.New("discovery document lacks an authorization, token or JWKS endpoint")
+	}
+	return ep, nil
+}
+
+// OIDCClient holds the settings of an OIDCLogin that are not read from the
+// operator configuration.
+type OIDCClient struct {
+	// RedirectURL is the callback URL registered at the provider.
+	RedirectURL string
+	// ClientSecret authenticates the client at the token endpoint.
+	ClientSecret string
+	// SessionKey signs the session cookies; a random key is used when empty.
+	SessionKey []byte
+	// HTTPClient calls the provider (http.DefaultClient when nil).
+	HTTPClient *http.Client
+}
+
+// OIDCLogin serves the OIDC authorization code flow of the web UI and keeps
+// the logged-in user in a signed session cookie. The groups of the user are
+// read from the ID token and stored in the session, so they are only
+// refreshed on the next login.
+type OIDCLogin struct {
+	oauth       oauth2.Config
+	issuer      string
+	jwks        keyfunc.Keyfunc
+	groupsClaim string
+	sessionTTL  time.Duration
+	key         []byte
+	secure      bool
+	httpClient  *http.Client
+	cancel      context.CancelFunc
+}
+
+// NewOIDCLogin discovers the provider of cfg and fetches its JWKS. The parent
+// context controls the lifetime of the background JWKS refresh.
+func NewOIDCLogin(ctx context.Context, cfg config.OIDCAuthConfig, client OIDCClient) (*OIDCLogin, error) {
+	httpClient := client.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	ep, err := DiscoverOIDC(ctx, httpClient, cfg.IssuerURL)
+	if err != nil {
+		return nil, fmt.Errorf("discover OIDC issuer %q: %w", cfg.IssuerURL, err)
+	}
+	jwksCtx, cancel := context.WithCancel(ctx)
+	jwks, err := keyfunc.NewDefaultCtx(jwksCtx, []string{ep.JWKSURL})
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("init JWKS of OIDC issuer %q: %w", cfg.IssuerURL, err)
+	}
+	l, err := NewOIDCLoginWithKeyfunc(cfg, ep, client, jwks)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	l.cancel = cancel
+	return l, nil
+}
+
+// NewOIDCLoginWithKeyfunc creates an OIDCLogin for known endpoints and a
+// pre-built keyfunc (useful for testing).
+func NewOIDCLoginWithKeyfunc(cfg config.OIDCAuthConfig, ep OIDCEndpoints, client OIDCClient, kf keyfunc.Keyfunc) (*OIDCLogin, error) {
+	key := client.SessionKey
+	switch {
+	case len(key) == 0:
+		key = make([]byte, minSessionKeyBytes)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("generate session key: %w", err)
+		}
+	case len(key) < minSessionKeyBytes:
+		return nil, fmt.Errorf("session key must be at least %d bytes", minSessionKeyBytes)
+	}
+	scopes := cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = config.DefaultOIDCScopes
+	}
+	groupsClaim := cfg.GroupsClaim
+	if groupsClaim == "" {
+		groupsClaim = config.DefaultOIDCGroupsClaim
+	}
+	ttl := cfg.SessionTTL.Duration()
+	if ttl <= 0 {
+		ttl = config.DefaultOIDCSessionTTL
+	}
+	httpClient := client.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &OIDCLogin{
+		oauth: oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: client.ClientSecret,
+			RedirectURL:  client.RedirectURL,
+			Endpoint:     oauth2.Endpoint{AuthURL: ep.AuthURL, TokenURL: ep.TokenURL},
+			Scopes:       append([]string{"openid"}, scopes...),
+		},
+		issuer:      ep.Issuer,
+		jwks:        kf,
+		groupsClaim: groupsClaim,
+		sessionTTL:  ttl,
+		key:         key,
+		secure:      strings.HasPrefix(client.RedirectURL, "https://"),
+		httpClient:  httpClient,
+	}, nil
+}
+
+// Close stops the background JWKS refresh.
+func (l *OIDCLogin) Close() {
+	if l.cancel != nil {
+		l.cancel()
+	}
+}
+
+// loginState is the signed content of the state cookie.
+type loginState struct {
+	State    string    `json:"state"`
+	Nonce    string    `json:"nonce"`
+	Verifier string    `json:"verifier"`
+	ReturnTo string    `json:"returnTo,omitempty"`
+	Expires  time.Time `json:"exp"`
+}
+
+// Login redirects to the provider. The "redirect" query parameter is the
+// local path the user is sent back to once logged in.
+func (l *OIDCLogin) Login(w http.ResponseWriter, r *http.Request) {
+	st := loginState{
+		State:    randomToken(),
+		Nonce:    randomToken(),
+		Verifier: oauth2.GenerateVerifier(),
+		ReturnTo: safeReturnTo(r.URL.Query().Get("redirect")),
+		Expires:  time.Now().Add(oidcStateTTL),
+	}
+	value, err := l.seal(st)
+	if err != nil {
+		http.Error(w, "login failed", http.StatusInternalServerError)
+		return
+	}
+	l.setCookie(w, oidcStateCookieName, "/auth", value, oidcStateTTL)
+	http.Redirect(w, r, l.oauth.AuthCodeURL(st.State,
+		oauth2.SetAuthURLParam("nonce", st.Nonce),
+		oauth2.S256ChallengeOption(st.Verifier),
+	), http.StatusFound)
+}
+
+// Callback completes the login: it exchanges the code, verifies the ID
+// token and stores the session cookie.
+func (l *OIDCLogin) Callback(w http.ResponseWriter, r *http.Request) {
+	var st loginState
+	c, err := r.Cookie(oidcStateCookieName)
+	if err != nil || l.open(c.Value, &st) != nil {
+		http.Error(w, "login expired, retry", http.StatusBadRequest)
+		return
+	}
+	l.setCookie(w, oidcStateCookieName, "/auth", "", -1)
+	q := r.URL.Query()
+	if e := q.Get("error"); e != "" {
+		http.Error(w, "login refused by the provider: "+e, http.StatusUnauthorized)
+		return
+	}
+	if !hmac.Equal([]byte(q.Get("state")), []byte(st.State)) {
+		http.Error(w, "login state mismatch", http.StatusBadRequest)
+		return
+	}
+
+	ctx := context.WithValue(r.Context(), oauth2.HTTPClient, l.httpClient)
+	token, err := l.oauth.Exchange(ctx, q.Get("code"), oauth2.VerifierOption(st.Verifier))
+	if err != nil {
+		http.Error(w, "code exchange failed", http.StatusUnauthorized)
+		return
+	}
+	rawID, _ := token.Extra("id_token").(string)
+	p, err := l.verifyIDToken(rawID, st.Nonce)
+	if err != nil {
+		http.Error(w, "invalid ID token", http.StatusUnauthorized)
+		return
+	}
+	value, err := l.seal(p)
+	if err != nil || len(value) > maxSessionCookieBytes {
+		http.Error(w, "session too large: the user is in too many groups", http.StatusInternalServerError)
+		return
+	}
+	l.setCookie(w, SessionCookieName, "/", value, l.sessionTTL)
+	http.Redirect(w, r, cmp.Or(st.ReturnTo, "/"), http.StatusFound)
+}
+
+// Logout clears the session cookie and redirects to the UI.
+func (l *OIDCLogin) Logout(w http.ResponseWriter, r *http.Request) {
+	l.setCookie(w, SessionCookieName, "/", "", -1)
+	http.Redirect(w, r, "/", http.StatusFound)
+}
+
+// meResponse is the body served by Me.
+type meResponse struct {
+	Authenticated bool     `json:"authenticated"`
+	Name          string   `json:"name,omitempty"`
+	Groups        []string `json:"groups,omitempty"`
+}
+
+// Me serves the logged-in user as JSON, {"authenticated": false} for
+// anonymous requests.
+func (l *OIDCLogin) Me(w http.ResponseWriter, r *http.Request) {
+	var resp meResponse
+	if p, ok := l.Session(r); ok {
+		resp = meResponse{Authenticated: true, Name: p.Name, Groups: p.Groups}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// Session returns the principal of the session cookie of r, if valid.
+func (l *OIDCLogin) Session(r *http.Request) (*Principal, bool) {
+	c, err := r.Cookie(SessionCookieName)
+	if err != nil {
+		return nil, false
+	}
+	var p Principal
+	if err := l.open(c.Value, &p); err != nil {
+		return nil, false
+	}
+	return &p, true
+}
+
+// Middleware stores the principal of the session cookie, if any, in the
+// request context (see PrincipalFromContext).
+func (l *OIDCLogin) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if p, ok := l.Session(r); ok {
+			r = r.WithContext(WithPrincipal(r.Context(), p))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// verifyIDToken validates raw against the provider keys, issuer, client ID
+// and nonce, and returns the principal it names.
+func (l *OIDCLogin) verifyIDToken(raw, nonce string) (*Principal, error) {
+	if raw == "" {
+		return nil, fmt.Errorf("oidc: %w: no ID token", ErrInvalidToken)
+	}
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(raw, claims, l.jwks.Keyfunc,
+		jwt.WithIssuer(l.issuer),
+		jwt.WithAudience(l.oauth.ClientID),
+		jwt.WithExpirationRequired(),
+	); err != nil {
+		return nil, fmt.Errorf("oidc: %w: %w", ErrInvalidToken, err)
+	}
+	if got, _ := claims["nonce"].(string); !hmac.Equal([]byte(got), []byte(nonce)) {
+		return nil, fmt.Errorf("oidc: %w: nonce mismatch", ErrInvalidToken)
+	}
+	sub, _ := claims.GetSubject()
+	if sub == "" {
+		return nil, fmt.Errorf("oidc: %w: no subject", ErrInvalidToken)
+	}
+	p := &Principal{Subject: sub, Name: sub, Expires: time.Now().Add(l.sessionTTL)}
+	for _, k := range []string{"name", "preferred_username", "email"} {
+		if v, _ := claims[k].(string); v != "" {
+			p.Name = v
+			break
+		}
+	}
+	switch g := claims[l.groupsClaim].(type) {
+	case string:
+		p.Groups = []string{g}
+	case []any:
+		for _, v := range g {
+			if s, ok := v.(string); ok {
+				p.Groups = append(p.Groups, s)
+			}
+		}
+	}
+	return p, nil
+}
+
+// seal encodes v as a signed cookie value: base64(JSON) "." base64(HMAC).
+func (l *OIDCLogin) seal(v any) (string, error) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	enc := base64.RawURLEncoding.EncodeToString(payload)
+	return enc + "." + base64.RawURLEncoding.EncodeToString(l.sign(enc)), nil
+}
+
+// open verifies a value sealed by seal and decodes it into v, which must
+// carry an "exp" time still in the future.
+func (l *OIDCLogin) open(value string, v any) error {
+	enc, sig, ok := strings.Cut(value, ".")
+	if !ok {
+		return errInvalidSession
+	}
+	got, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(got, l.sign(enc)) {
+		return errInvalidSession
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(enc)
+	if err != nil {
+		return errInvalidSession
+	}
+	var exp struct {
+		Expires time.Time `json:"exp"`
+	}
+	if json.Unmarshal(payload, &exp) != nil || time.Now().After(exp.Expires) {
+		return errInvalidSession
+	}
+	if err := json.Unmarshal(payload, v); err != nil {
+		return errInvalidSession
+	}
+	return nil
+}
+
+func (l *OIDCLogin) sign(data string) []byte {
+	mac := hmac.New(sha256.New, l.key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// setCookie sets an HTTP-only cookie; a negative maxAge deletes it.
+func (l *OIDCLogin) setCookie(w http.ResponseWriter, name, path, value string, maxAge time.Duration) {
+	c := &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     path,
+		HttpOnly: true,
+		Secure:   l.secure,
+		SameSite: http.SameSiteLaxMode,
+		MaxAge:   int(maxAge.Seconds()),
+	}
+	if maxAge < 0 {
+		c.MaxAge = -1
+	}
+	http.SetCookie(w, c)
+}
+
+// safeReturnTo returns raw when it is a local absolute path, "" otherwise, so
+// the login cannot redirect to another site.
+func safeReturnTo(raw string) string {
+	if !strings.HasPrefix(raw, "/") || strings.HasPrefix(raw, "//") || strings.HasPrefix(raw, "/\\") {
+		return ""
+	}
+	return raw
+}
+
+func randomToken() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth_test
+
+import (
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golgoth31/sreportal/internal/auth"
+	"github.com/golgoth31/sreportal/internal/config"
+)
+
+const tClientID = "sreportal-ui"
+
+// oidcProvider is a token endpoint answering every code with an ID token
+// carrying the claims of idClaims and the nonce of the last login.
+type oidcProvider struct {
+	key      *rsa.PrivateKey
+	idClaims jwt.MapClaims
+	nonce    string
+}
+
+func (p *oidcProvider) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	claims := jwt.MapClaims{
+		tClaimIss: tIssuerURL,
+		"aud":     tClientID,
+		tClaimExp: time.Now().Add(time.Hour).Unix(),
+		"nonce":   p.nonce,
+	}
+	for k, v := range p.idClaims {
+		claims[k] = v
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	idToken, _ := token.SignedString(p.key)
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"access_token": "access",
+		"token_type":   "Bearer",
+		"id_token":     idToken,
+	})
+}
+
+func newTestOIDCLogin(t *testing.T, claims jwt.MapClaims) (*auth.OIDCLogin, *oidcProvider) {
+	t.Helper()
+	key := mustGenerateKey(t)
+	provider := &oidcProvider{key: key, idClaims: claims}
+	srv := httptest.NewServer(provider)
+	t.Cleanup(srv.Close)
+
+	l, err := auth.NewOIDCLoginWithKeyfunc(
+		config.OIDCAuthConfig{Enabled: true, IssuerURL: tIssuerURL, ClientID: tClientID},
+		auth.OIDCEndpoints{Issuer: tIssuerURL, AuthURL: tIssuerURL + "authorize", TokenURL: srv.URL, JWKSURL: tIssuerURL + "keys"},
+		auth.OIDCClient{RedirectURL: "https://portal.example.com/auth/callback", ClientSecret: "secret"},
+		&staticKeyfunc{key: &key.PublicKey},
+	)
+	require.NoError(t, err)
+	return l, provider
+}
+
+// login runs the login flow up to the callback and returns its response.
+func login(t *testing.T, l *auth.OIDCLogin, provider *oidcProvider, redirect string) *http.Response {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	l.Login(rec, httptest.NewRequest(http.MethodGet, auth.OIDCLoginPath+"?redirect="+url.QueryEscape(redirect), nil))
+	require.Equal(t, http.StatusFound, rec.Code)
+	authURL, err := url.Parse(rec.Header().Get("Location"))
+	require.NoError(t, err)
+	q := authURL.Query()
+	assert.Equal(t, tClientID, q.Get("client_id"))
+	assert.Equal(t, "S256", q.Get("code_challenge_method"))
+	provider.nonce = q.Get("nonce")
+
+	req := httptest.NewRequest(http.MethodGet, auth.OIDCCallbackPath+"?code=abc&state="+url.QueryEscape(q.Get("state")), nil)
+	for _, c := range rec.Result().Cookies() {
+		req.AddCookie(c)
+	}
+	rec = httptest.NewRecorder()
+	l.Callback(rec, req)
+	return rec.Result()
+}
+
+func sessionCookie(resp *http.Response) *http.Cookie {
+	for _, c := range resp.Cookies() {
+		if c.Name == auth.SessionCookieName && c.Value != "" {
+			return c
+		}
+	}
+	return nil
+}
+
+func TestOIDCLogin_LoginStoresSession(t *testing.T) {
+	l, provider := newTestOIDCLogin(t, jwt.MapClaims{
+		"sub":                "u-1",
+		"preferred_username": "alice",
+		"groups":             []any{"sre", "platform"},
+	})
+
+	resp := login(t, l, provider, "/portal/team")
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, "/portal/team", resp.Header.Get("Location"))
+	cookie := sessionCookie(resp)
+	require.NotNil(t, cookie)
+	assert.True(t, cookie.HttpOnly)
+	assert.True(t, cookie.Secure, "the redirect URL is https")
+
+	req := httptest.NewRequest(http.MethodGet, auth.OIDCMePath, nil)
+	req.AddCookie(cookie)
+	rec := httptest.NewRecorder()
+	l.Me(rec, req)
+	assert.JSONEq(t, `{"authenticated":true,"name":"alice","groups":["sre","platform"]}`, rec.Body.String())
+
+	var seen *auth.Principal
+	l.Middleware(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		seen = auth.PrincipalFromContext(r.Context())
+	})).ServeHTTP(httptest.NewRecorder(), req)
+	require.NotNil(t, seen)
+	assert.Equal(t, "u-1", seen.Subject)
+	assert.Equal(t, []string{"sre", "platform"}, seen.Groups)
+}
+
+func TestOIDCLogin_RejectsForeignRedirect(t *testing.T) {
+	l, provider := newTestOIDCLogin(t, jwt.MapClaims{"sub": "u-1"})
+
+	resp := login(t, l, provider, "//evil.example.com")
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, "/", resp.Header.Get("Location"))
+}
+
+func TestOIDCLogin_NonceMismatch(t *testing.T) {
+	l, provider := newTestOIDCLogin(t, jwt.MapClaims{"sub": "u-1", "nonce": "replayed"})
+
+	resp := login(t, l, provider, "/")
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Nil(t, sessionCookie(resp))
+}
+
+func TestOIDCLogin_CallbackWithoutState(t *testing.T) {
+	l, _ := newTestOIDCLogin(t, nil)
+
+	rec := httptest.NewRecorder()
+	l.Callback(rec, httptest.NewRequest(http.MethodGet, auth.OIDCCallbackPath+"?code=abc&state=x", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestOIDCLogin_TamperedSession(t *testing.T) {
+	l, provider := newTestOIDCLogin(t, jwt.MapClaims{"sub": "u-1", "groups": []any{"dev"}})
+	cookie := sessionCookie(login(t, l, provider, "/"))
+	require.NotNil(t, cookie)
+
+	cookie.Value = "x" + cookie.Value
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.AddCookie(cookie)
+	_, ok := l.Session(req)
+	assert.False(t, ok)
+
+	other, _ := newTestOIDCLogin(t, nil)
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	req.AddCookie(sessionCookie(login(t, l, provider, "/")))
+	_, ok = other.Session(req)
+	assert.False(t, ok, "a session signed with another key is rejected")
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	domainportal "github.com/golgoth31/sreportal/internal/domain/portal"
+)
+
+// PortalVisibility restricts the portals, and the FQDNs they list, to the
+// ones the principal of the request context may see (PortalView.VisibleTo).
+// Anonymous requests only see the portals without allowed groups.
+type PortalVisibility struct {
+	portals domainportal.PortalReader
+}
+
+// NewPortalVisibility creates a PortalVisibility reading the allowed groups
+// of the portals from portals.
+func NewPortalVisibility(portals domainportal.PortalReader) *PortalVisibility {
+	return &PortalVisibility{portals: portals}
+}
+
+// Portals returns a PortalReader listing only the visible portals.
+func (v *PortalVisibility) Portals() domainportal.PortalReader {
+	return visiblePortals{v: v}
+}
+
+// FQDNs returns a FQDNReader listing only the FQDNs of a visible portal,
+// with their hidden portals removed.
+func (v *PortalVisibility) FQDNs(r domaindns.FQDNReader) domaindns.FQDNReader {
+	return visibleFQDNs{FQDNReader: r, v: v}
+}
+
+// Authorize implements Authorizer: it denies the calls naming a hidden portal.
+// AuthzInterceptor submits a portal-scoped call with an empty portal once per
+// portal, so an empty portal only reaches Authorize for the calls targeting
+// no portal, such as listing portals, or when there is no portal at all:
+// their readers drop the hidden portals.
+func (v *PortalVisibility) Authorize(ctx context.Context, req AuthorizationRequest) error {
+	if req.Portal == "" {
+		return nil
+	}
+	hidden, err := v.hidden(ctx)
+	if err != nil {
+		return err
+	}
+	if hidden[req.Portal] {
+		return fmt.Errorf("%w: portal %q", ErrPermissionDenied, req.Portal)
+	}
+	return nil
+}
+
+// hidden returns the names of the portals the principal of ctx may not see.
+func (v *PortalVisibility) hidden(ctx context.Context) (map[string]bool, error) {
+	all, err := v.portals.List(ctx, domainportal.PortalFilters{})
+	if err != nil {
+		return nil, err
+	}
+	groups := principalGroups(ctx)
+	hidden := map[string]bool{}
+	for _, p := range all {
+		if !p.VisibleTo(groups) {
+			hidden[p.Name] = true
+		}
+	}
+	return hidden, nil
+}
+
+func principalGroups(ctx context.Context) []string {
+	if p := PrincipalFromContext(ctx); p != nil {
+		return p.Groups
+	}
+	return nil
+}
+
+type visiblePortals struct {
+	v *PortalVisibility
+}
+
+func (r visiblePortals) List(ctx context.Context, filters domainportal.PortalFilters) ([]domainportal.PortalView, error) {
+	views, err := r.v.portals.List(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	groups := principalGroups(ctx)
+	return slices.DeleteFunc(views, func(p domainportal.PortalView) bool { return !p.VisibleTo(groups) }), nil
+}
+
+func (r visiblePortals) Subscribe() <-chan struct{} {
+	return r.v.portals.Subscribe()
+}
+
+type visibleFQDNs struct {
+	domaindns.FQDNReader
+	v *PortalVisibility
+}
+
+func (r visibleFQDNs) List(ctx context.Context, filters domaindns.FQDNFilters) ([]domaindns.FQDNView, error) {
+	hidden, err := r.v.hidden(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hidden[filters.Portal] {
+		return nil, nil
+	}
+	views, err := r.FQDNReader.List(ctx, filters)
+	if err != nil || len(hidden) == 0 {
+		return views, err
+	}
+	out := make([]domaindns.FQDNView, 0, len(views))
+	for _, view := range views {
+		if restrictPortals(&view, hidden) {
+			out = append(out, view)
+		}
+	}
+	return out, nil
+}
+
+func (r visibleFQDNs) Get(ctx context.Context, name, recordType string) (domaindns.FQDNView, error) {
+	view, err := r.FQDNReader.Get(ctx, name, recordType)
+	if err != nil {
+		return view, err
+	}
+	hidden, err := r.v.hidden(ctx)
+	if err != nil {
+		return domaindns.FQDNView{}, err
+	}
+	if !restrictPortals(&view, hidden) {
+		return domaindns.FQDNView{}, domaindns.ErrFQDNNotFound
+	}
+	return view, nil
+}
+
+func (r visibleFQDNs) Count(ctx context.Context, filters domaindns.FQDNFilters) (int, error) {
+	hidden, err := r.v.hidden(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(hidden) == 0 {
+		return r.FQDNReader.Count(ctx, filters)
+	}
+	views, err := r.List(ctx, filters)
+	return len(views), err
+}
+
+// restrictPortals removes the hidden portals of view and reports whether one
+// is left. A view listed in no portal is kept.
+func restrictPortals(view *domaindns.FQDNView, hidden map[string]bool) bool {
+	if len(view.Portals) == 0 {
+		return true
+	}
+	visible := slices.DeleteFunc(slices.Clone(view.Portals), func(p string) bool { return hidden[p] })
+	if len(visible) == 0 {
+		return false
+	}
+	view.Portals = visible
+	return true
+}
+
+// AllOf returns an Authorizer allowing a request only when every authorizer
+// allows it, asked in order.
+func AllOf(authorizers ...Authorizer) Authorizer {
+	return allOf(authorizers)
+}
+
+type allOf []Authorizer
+
+func (a allOf) Authorize(ctx context.Context, req AuthorizationRequest) error {
+	for _, authz := range a {
+		if err := authz.Authorize(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"slices"
+
+	domainalertmanager "github.com/golgoth31/sreportal/internal/domain/alertmanagerreadmodel"
+	domaincomponent "github.com/golgoth31/sreportal/internal/domain/component"
+	domainimage "github.com/golgoth31/sreportal/internal/domain/image"
+	domainincident "github.com/golgoth31/sreportal/internal/domain/incident"
+	domainmaint "github.com/golgoth31/sreportal/internal/domain/maintenance"
+	domainnetpol "github.com/golgoth31/sreportal/internal/domain/netpol"
+	domainportal "github.com/golgoth31/sreportal/internal/domain/portal"
+	domainrelease "github.com/golgoth31/sreportal/internal/domain/release"
+)
+
+// The readers below list only the data of the portals the principal of the
+// request context may see: a hidden portal lists nothing, and a query on
+// every portal (empty portal) drops the data of the hidden ones.
+
+// Alertmanagers returns an AlertmanagerReader listing only the Alertmanagers
+// of a visible portal.
+func (v *PortalVisibility) Alertmanagers(r domainalertmanager.AlertmanagerReader) domainalertmanager.AlertmanagerReader {
+	return visibleAlertmanagers{AlertmanagerReader: r, v: v}
+}
+
+// Images returns an ImageReader listing only the images of a visible portal.
+func (v *PortalVisibility) Images(r domainimage.ImageReader) domainimage.ImageReader {
+	return visibleImages{ImageReader: r, v: v}
+}
+
+// Releases returns a ReleaseReader listing only the releases of a visible
+// portal.
+func (v *PortalVisibility) Releases(r domainrelease.ReleaseReader) domainrelease.ReleaseReader {
+	return visibleReleases{ReleaseReader: r, v: v}
+}
+
+// FlowGraphs returns a FlowGraphReader listing only the network flows of a
+// visible portal.
+func (v *PortalVisibility) FlowGraphs(r domainnetpol.FlowGraphReader) domainnetpol.FlowGraphReader {
+	return visibleFlowGraphs{FlowGraphReader: r, v: v}
+}
+
+// Components returns a ComponentReader listing only the components of a
+// visible portal.
+func (v *PortalVisibility) Components(r domaincomponent.ComponentReader) domaincomponent.ComponentReader {
+	return visibleComponents{ComponentReader: r, v: v}
+}
+
+// Maintenances returns a MaintenanceReader listing only the maintenances of a
+// visible portal.
+func (v *PortalVisibility) Maintenances(r domainmaint.MaintenanceReader) domainmaint.MaintenanceReader {
+	return visibleMaintenances{MaintenanceReader: r, v: v}
+}
+
+// Incidents returns an IncidentReader listing only the incidents of a visible
+// portal.
+func (v *PortalVisibility) Incidents(r domainincident.IncidentReader) domainincident.IncidentReader {
+	return visibleIncidents{IncidentReader: r, v: v}
+}
+
+// visiblePortalNames returns the names of the portals the principal of ctx
+// may see, and whether some are hidden.
+func (v *PortalVisibility) visiblePortalNames(ctx context.Context) ([]string, bool, error) {
+	hidden, err := v.hidden(ctx)
+	if err != nil || len(hidden) == 0 {
+		return nil, false, err
+	}
+	views, err := v.Portals().List(ctx, domainportal.PortalFilters{})
+	if err != nil {
+		return nil, false, err
+	}
+	names := make([]string, 0, len(views))
+	for _, p := range views {
+		if !slices.Contains(names, p.Name) {
+			names = append(names, p.Name)
+		}
+	}
+	return names, true, nil
+}
+
+// filterByPortal lists with list and drops the items of a hidden portal, as
+// given by portalOf.
+func filterByPortal[T any](
+	ctx context.Context, v *PortalVisibility, portal string,
+	list func() ([]T, error), portalOf func(T) string,
+) ([]T, error) {
+	hidden, err := v.hidden(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hidden[portal] {
+		return nil, nil
+	}
+	items, err := list()
+	if err != nil || len(hidden) == 0 {
+		return items, err
+	}
+	return slices.DeleteFunc(items, func(item T) bool { return hidden[portalOf(item)] }), nil
+}
+
+type visibleAlertmanagers struct {
+	domainalertmanager.AlertmanagerReader
+	v *PortalVisibility
+}
+
+func (r visibleAlertmanagers) List(ctx context.Context, filters domainalertmanager.AlertmanagerFilters) ([]domainalertmanager.AlertmanagerView, error) {
+	return filterByPortal(ctx, r.v, filters.Portal,
+		func() ([]domainalertmanager.AlertmanagerView, error) { return r.AlertmanagerReader.List(ctx, filters) },
+		func(am domainalertmanager.AlertmanagerView) string { return am.PortalRef })
+}
+
+type visibleImages struct {
+	domainimage.ImageReader
+	v *PortalVisibility
+}
+
+func (r visibleImages) List(ctx context.Context, filters domainimage.ImageFilters) ([]domainimage.ImageView, error) {
+	return filterByPortal(ctx, r.v, filters.Portal,
+		func() ([]domainimage.ImageView, error) { return r.ImageReader.List(ctx, filters) },
+		func(img domainimage.ImageView) string { return img.PortalRef })
+}
+
+func (r visibleImages) Count(ctx context.Context, filters domainimage.ImageFilters) (int, error) {
+	hidden, err := r.v.hidden(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(hidden) == 0 {
+		return r.ImageReader.Count(ctx, filters)
+	}
+	views, err := r.List(ctx, filters)
+	return len(views), err
+}
+
+type visibleReleases struct {
+	domainrelease.ReleaseReader
+	v *PortalVisibility
+}
+
+func (r visibleReleases) ListEntries(ctx context.Context, day, portal string) ([]domainrelease.EntryView, error) {
+	return filterByPortal(ctx, r.v, portal,
+		func() ([]domainrelease.EntryView, error) { return r.ReleaseReader.ListEntries(ctx, day, portal) },
+		func(e domainrelease.EntryView) string { return e.PortalRef })
+}
+
+func (r visibleReleases) ListDays(ctx context.Context, portal string) ([]string, error) {
+	if portal != "" {
+		hidden, err := r.v.hidden(ctx)
+		if err != nil || hidden[portal] {
+			return nil, err
+		}
+		return r.ReleaseReader.ListDays(ctx, portal)
+	}
+	names, restricted, err := r.v.visiblePortalNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !restricted {
+		return r.ReleaseReader.ListDays(ctx, portal)
+	}
+	var days []string
+	for _, name := range names {
+		d, err := r.ReleaseReader.ListDays(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		days = append(days, d...)
+	}
+	slices.Sort(days)
+	return slices.Compact(days), nil
+}
+
+type visibleFlowGraphs struct {
+	domainnetpol.FlowGraphReader
+	v *PortalVisibility
+}
+
+// perVisiblePortal lists with list for filters, or once per visible portal
+// when filters target every portal and some are hidden.
+func perVisiblePortal[T any](
+	ctx context.Context, v *PortalVisibility, filters domainnetpol.FlowGraphFilters,
+	list func(domainnetpol.FlowGraphFilters) ([]T, error),
+) ([]T, error) {
+	if filters.Portal != "" {
+		hidden, err := v.hidden(ctx)
+		if err != nil || hidden[filters.Portal] {
+			return nil, err
+		}
+		return list(filters)
+	}
+	names, restricted, err := v.visiblePortalNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !restricted {
+		return list(filters)
+	}
+	var out []T
+	for _, name := range names {
+		filters.Portal = name
+		items, err := list(filters)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, items...)
+	}
+	return out, nil
+}
+
+func (r visibleFlowGraphs) ListNodes(ctx context.Context, filters domainnetpol.FlowGraphFilters) ([]domainnetpol.FlowNode, error) {
+	nodes, err := perVisiblePortal(ctx, r.v, filters, func(f domainnetpol.FlowGraphFilters) ([]domainnetpol.FlowNode, error) {
+		return r.FlowGraphReader.ListNodes(ctx, f)
+	})
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool, len(nodes))
+	return slices.DeleteFunc(nodes, func(n domainnetpol.FlowNode) bool {
+		dup := seen[n.ID]
+		seen[n.ID] = true
+		return dup
+	}), nil
+}
+
+func (r visibleFlowGraphs) ListEdges(ctx context.Context, filters domainnetpol.FlowGraphFilters) ([]domainnetpol.FlowEdge, error) {
+	edges, err := perVisiblePortal(ctx, r.v, filters, func(f domainnetpol.FlowGraphFilters) ([]domainnetpol.FlowEdge, error) {
+		return r.FlowGraphReader.ListEdges(ctx, f)
+	})
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[domainnetpol.FlowEdge]bool, len(edges))
+	return slices.DeleteFunc(edges, func(e domainnetpol.FlowEdge) bool {
+		key := domainnetpol.FlowEdge{From: e.From, To: e.To, EdgeType: e.EdgeType}
+		dup := seen[key]
+		seen[key] = true
+		return dup
+	}), nil
+}
+
+type visibleComponents struct {
+	domaincomponent.ComponentReader
+	v *PortalVisibility
+}
+
+func (r visibleComponents) List(ctx context.Context, opts domaincomponent.ListOptions) ([]domaincomponent.ComponentView, error) {
+	return filterByPortal(ctx, r.v, opts.PortalRef,
+		func() ([]domaincomponent.ComponentView, error) { return r.ComponentReader.List(ctx, opts) },
+		func(c domaincomponent.ComponentView) string { return c.PortalRef })
+}
+
+type visibleMaintenances struct {
+	domainmaint.MaintenanceReader
+	v *PortalVisibility
+}
+
+func (r visibleMaintenances) List(ctx context.Context, opts domainmaint.ListOptions) ([]domainmaint.MaintenanceView, error) {
+	return filterByPortal(ctx, r.v, opts.PortalRef,
+		func() ([]domainmaint.MaintenanceView, error) { return r.MaintenanceReader.List(ctx, opts) },
+		func(m domainmaint.MaintenanceView) string { return m.PortalRef })
+}
+
+type visibleIncidents struct {
+	domainincident.IncidentReader
+	v *PortalVisibility
+}
+
+func (r visibleIncidents) List(ctx context.Context, opts domainincident.ListOptions) ([]domainincident.IncidentView, error) {
+	return filterByPortal(ctx, r.v, opts.PortalRef,
+		func() ([]domainincident.IncidentView, error) { return r.IncidentReader.List(ctx, opts) },
+		func(i domainincident.IncidentView) string { return i.PortalRef })
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golgoth31/sreportal/internal/auth"
+	domainalertmanager "github.com/golgoth31/sreportal/internal/domain/alertmanagerreadmodel"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	domainimage "github.com/golgoth31/sreportal/internal/domain/image"
+	domainportal "github.com/golgoth31/sreportal/internal/domain/portal"
+)
+
+type staticPortals []domainportal.PortalView
+
+func (s staticPortals) List(context.Context, domainportal.PortalFilters) ([]domainportal.PortalView, error) {
+	return append([]domainportal.PortalView(nil), s...), nil
+}
+
+func (s staticPortals) Subscribe() <-chan struct{} { return make(chan struct{}) }
+
+type staticFQDNs []domaindns.FQDNView
+
+func (s staticFQDNs) List(_ context.Context, f domaindns.FQDNFilters) ([]domaindns.FQDNView, error) {
+	var out []domaindns.FQDNView
+	for _, v := range s {
+		if f.Portal == "" || v.FirstPortal() == f.Portal {
+			out = append(out, v)
+		}
+	}
+	return out, nil
+}
+
+func (s staticFQDNs) Get(_ context.Context, name, recordType string) (domaindns.FQDNView, error) {
+	for _, v := range s {
+		if v.Name == name && v.RecordType == recordType {
+			return v, nil
+		}
+	}
+	return domaindns.FQDNView{}, domaindns.ErrFQDNNotFound
+}
+
+func (s staticFQDNs) Count(ctx context.Context, f domaindns.FQDNFilters) (int, error) {
+	views, err := s.List(ctx, f)
+	return len(views), err
+}
+
+func (s staticFQDNs) Subscribe() <-chan struct{} { return make(chan struct{}) }
+
+func newTestVisibility() (*auth.PortalVisibility, domaindns.FQDNReader) {
+	v := auth.NewPortalVisibility(staticPortals{
+		{Name: "main"},
+		{Name: "team", AllowedGroups: []string{"sre"}},
+	})
+	return v, v.FQDNs(staticFQDNs{
+		{Name: "www.example.com", RecordType: "A", Portals: []string{"main"}},
+		{Name: "db.example.com", RecordType: "A", Portals: []string{"team"}},
+		{Name: "api.example.com", RecordType: "A", Portals: []string{"team", "main"}},
+	})
+}
+
+func withGroups(groups ...string) context.Context {
+	return auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "u-1", Groups: groups})
+}
+
+func TestPortalVisibility_AnonymousSeesPublicPortals(t *testing.T) {
+	v, fqdns := newTestVisibility()
+	ctx := context.Background()
+
+	portals, err := v.Portals().List(ctx, domainportal.PortalFilters{})
+	require.NoError(t, err)
+	require.Len(t, portals, 1)
+	assert.Equal(t, "main", portals[0].Name)
+
+	views, err := fqdns.List(ctx, domaindns.FQDNFilters{})
+	require.NoError(t, err)
+	require.Len(t, views, 2)
+	assert.Equal(t, "www.example.com", views[0].Name)
+	assert.Equal(t, []string{"main"}, views[1].Portals, "hidden portals are removed from the view")
+
+	views, err = fqdns.List(ctx, domaindns.FQDNFilters{Portal: "team"})
+	require.NoError(t, err)
+	assert.Empty(t, views)
+
+	n, err := fqdns.Count(ctx, domaindns.FQDNFilters{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	_, err = fqdns.Get(ctx, "db.example.com", "A")
+	require.ErrorIs(t, err, domaindns.ErrFQDNNotFound)
+
+	err = v.Authorize(ctx, auth.AuthorizationRequest{Portal: "team", Verb: auth.VerbRead})
+	require.ErrorIs(t, err, auth.ErrPermissionDenied)
+	require.NoError(t, v.Authorize(ctx, auth.AuthorizationRequest{Portal: "main", Verb: auth.VerbRead}))
+}
+
+func TestPortalVisibility_GroupMemberSeesRestrictedPortal(t *testing.T) {
+	v, fqdns := newTestVisibility()
+	ctx := withGroups("dev", "sre")
+
+	portals, err := v.Portals().List(ctx, domainportal.PortalFilters{})
+	require.NoError(t, err)
+	assert.Len(t, portals, 2)
+
+	views, err := fqdns.List(ctx, domaindns.FQDNFilters{Portal: "team"})
+	require.NoError(t, err)
+	require.Len(t, views, 2)
+
+	view, err := fqdns.Get(ctx, "api.example.com", "A")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"team", "main"}, view.Portals)
+
+	require.NoError(t, v.Authorize(ctx, auth.AuthorizationRequest{Portal: "team", Verb: auth.VerbRead}))
+	require.ErrorIs(t, v.Authorize(withGroups("dev"), auth.AuthorizationRequest{Portal: "team"}), auth.ErrPermissionDenied)
+}
+
+type staticAlertmanagers []domainalertmanager.AlertmanagerView
+
+func (s staticAlertmanagers) List(_ context.Context, f domainalertmanager.AlertmanagerFilters) ([]domainalertmanager.AlertmanagerView, error) {
+	var out []domainalertmanager.AlertmanagerView
+	for _, v := range s {
+		if f.Portal == "" || v.PortalRef == f.Portal {
+			out = append(out, v)
+		}
+	}
+	return out, nil
+}
+
+func (s staticAlertmanagers) Subscribe() <-chan struct{} { return make(chan struct{}) }
+
+type staticImages []domainimage.ImageView
+
+func (s staticImages) List(_ context.Context, f domainimage.ImageFilters) ([]domainimage.ImageView, error) {
+	var out []domainimage.ImageView
+	for _, v := range s {
+		if f.Portal == "" || v.PortalRef == f.Portal {
+			out = append(out, v)
+		}
+	}
+	return out, nil
+}
+
+func (s staticImages) Count(ctx context.Context, f domainimage.ImageFilters) (int, error) {
+	views, err := s.List(ctx, f)
+	return len(views), err
+}
+
+func (s staticImages) Subscribe() <-chan struct{} { return make(chan struct{}) }
+
+func TestPortalVisibility_ReadersDropHiddenPortals(t *testing.T) {
+	v, _ := newTestVisibility()
+	alertmanagers := v.Alertmanagers(staticAlertmanagers{
+		{Name: "am-main", PortalRef: "main"},
+		{Name: "am-team", PortalRef: "team"},
+	})
+	images := v.Images(staticImages{
+		{PortalRef: "main", Repository: "web"},
+		{PortalRef: "team", Repository: "db"},
+	})
+	ctx := context.Background()
+
+	ams, err := alertmanagers.List(ctx, domainalertmanager.AlertmanagerFilters{})
+	require.NoError(t, err)
+	require.Len(t, ams, 1, "an empty portal drops the hidden portals")
+	assert.Equal(t, "am-main", ams[0].Name)
+	ams, err = alertmanagers.List(ctx, domainalertmanager.AlertmanagerFilters{Portal: "team"})
+	require.NoError(t, err)
+	assert.Empty(t, ams)
+
+	imgs, err := images.List(ctx, domainimage.ImageFilters{})
+	require.NoError(t, err)
+	require.Len(t, imgs, 1)
+	assert.Equal(t, "web", imgs[0].Repository)
+	n, err := images.Count(ctx, domainimage.ImageFilters{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	ams, err = alertmanagers.List(withGroups("sre"), domainalertmanager.AlertmanagerFilters{})
+	require.NoError(t, err)
+	assert.Len(t, ams, 2)
+	n, err = images.Count(withGroups("sre"), domainimage.ImageFilters{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+}
+
+func TestAllOf_StopsAtFirstDenial(t *testing.T) {
+	v, _ := newTestVisibility()
+	rec := &recordingAuthorizer{allowed: map[string]bool{"": true}}
+	authz := auth.AllOf(v, rec)
+
+	require.ErrorIs(t, authz.Authorize(context.Background(), auth.AuthorizationRequest{Portal: "team"}), auth.ErrPermissionDenied)
+	assert.Empty(t, rec.requests)
+	require.NoError(t, authz.Authorize(context.Background(), auth.AuthorizationRequest{Portal: "main"}))
+	assert.Len(t, rec.requests, 1)
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golgoth31/sreportal/internal/config"
+)
+
+const (
+	defaultAuthzTimeout  = 2 * time.Second
+	defaultAuthzCacheTTL = time.Minute
+	// maxAuthzCacheEntries bounds the decision cache; it is flushed when full.
+	maxAuthzCacheEntries = 10000
+	// maxAuthzResponseBytes bounds the webhook response body read.
+	maxAuthzResponseBytes = 64 << 10
+)
+
+// WebhookAuthorizer delegates authorization decisions to an HTTP endpoint.
+//
+// It POSTs {"input": {"user", "portal", "verb"}} and accepts either
+// {"allowed": bool, "reason": string}, or the same object or a bare boolean
+// under "result", so an OPA data API endpoint can be used as is. Decisions
+// are cached per (user, portal, verb) for the configured TTL. A webhook error
+// denies the request unless the failure policy is Allow; errors are not
+// cached.
+type WebhookAuthorizer struct {
+	url      string
+	client   *http.Client
+	ttl      time.Duration
+	failOpen bool
+	mu       sync.Mutex
+	cache    map[AuthorizationRequest]cachedDecision
+}
+
+type cachedDecision struct {
+	reason  string
+	allowed bool
+	expires time.Time
+}
+
+type webhookDecision struct {
+	Allowed bool   `json:"allowed"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+type webhookResponse struct {
+	webhookDecision
+	Result json.RawMessage `json:"result,omitempty"`
+}
+
+// NewWebhookAuthorizer creates a WebhookAuthorizer from cfg. A nil
+// httpClient uses a client bounded by cfg.Timeout.
+func NewWebhookAuthorizer(cfg config.AuthorizationWebhookConfig, httpClient *http.Client) *WebhookAuthorizer {
+	timeout := cfg.Timeout.Duration()
+	if timeout <= 0 {
+		timeout = defaultAuthzTimeout
+	}
+	ttl := cfg.CacheTTL.Duration()
+	if ttl <= 0 {
+		ttl = defaultAuthzCacheTTL
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: timeout}
+	}
+	return &WebhookAuthorizer{
+		url:      cfg.URL,
+		client:   httpClient,
+		ttl:      ttl,
+		failOpen: cfg.FailurePolicy == config.AuthorizationFailurePolicyAllow,
+		cache:    make(map[AuthorizationRequest]cachedDecision),
+	}
+}
+
+// Authorize implements Authorizer.
+func (a *WebhookAuthorizer) Authorize(ctx context.Context, req AuthorizationRequest) error {
+	now := time.Now()
+	a.mu.Lock()
+	d, ok := a.cache[req]
+	a.mu.Unlock()
+	if !ok || now.After(d.expires) {
+		decision, err := a.call(ctx, req)
+		if err != nil {
+			if a.failOpen {
+				return nil
+			}
+			return err
+		}
+		d = cachedDecision{allowed: decision.Allowed, reason: decision.Reason, expires: now.Add(a.ttl)}
+		a.mu.Lock()
+		if len(a.cache) >= maxAuthzCacheEntries {
+			clear(a.cache)
+		}
+		a.cache[req] = d
+		a.mu.Unlock()
+	}
+
+	if d.allowed {
+		return nil
+	}
+	if d.reason != "" {
+		return fmt.Errorf("%w: %s", ErrPermissionDenied, d.reason)
+	}
+	return ErrPermissionDenied
+}
+
+func (a *WebhookAuthorizer) call(ctx context.Context, req AuthorizationRequest) (webhookDecision, error) {
+	body, err := json.Marshal(map[string]AuthorizationRequest{"input": req})
+	if err != nil {
+		return webhookDecision{}, fmt.Errorf("marshal authorization request: %w", err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, a.url, bytes.NewReader(body))
+	if err != nil {
+		return webhookDecision{}, fmt.Errorf("build authorization request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.client.Do(httpReq)
+	if err != nil {
+		return webhookDecision{}, fmt.Errorf("call authorization webhook: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return webhookDecision{}, fmt.Errorf("authorization webhook returned HTTP %d", resp.StatusCode)
+	}
+
+	var out webhookResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxAuthzResponseBytes)).Decode(&out); err != nil {
+		return webhookDecision{}, fmt.Errorf("decode authorization response: %w", err)
+	}
+	if len(out.Result) == 0 {
+		return out.webhookDecision, nil
+	}
+	var allowed bool
+	if err := json.Unmarshal(out.Result, &allowed); err == nil {
+		return webhookDecision{Allowed: allowed}, nil
+	}
+	var result webhookDecision
+	if err := json.Unmarshal(out.Result, &result); err != nil {
+		return webhookDecision{}, fmt.Errorf("decode authorization result: %w", err)
+	}
+	return result, nil
+}
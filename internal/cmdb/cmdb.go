@@ -0,0 +1,149 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cmdb exports the FQDN inventory to a configuration management
+// database. The Runner computes, on each run, the FQDNs added, changed or
+// removed since the previous export and hands them to an Exporter.
+package cmdb
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/golgoth31/sreportal/internal/config"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+// Item is the CMDB view of one FQDN. FQDNs of several portals or record
+// types sharing a name are merged into one Item.
+type Item struct {
+	Name        string
+	Description string
+	RecordTypes []string
+	Targets     []string
+	Portals     []string
+	Groups      []string
+	SourceTypes []string
+	Owner       string
+	Tier        string
+}
+
+// Delta lists the changes an export must apply to the CMDB.
+type Delta struct {
+	Added   []Item
+	Updated []Item
+	Removed []Item
+}
+
+// Empty reports whether the delta has no change.
+func (d Delta) Empty() bool {
+	return len(d.Added) == 0 && len(d.Updated) == 0 && len(d.Removed) == 0
+}
+
+// Exporter writes a Delta to a CMDB. Export must be idempotent: after a
+// restart, every FQDN is exported again as added.
+type Exporter interface {
+	// Name identifies the exporter in logs and conditions.
+	Name() string
+	// Export applies delta to the CMDB. On error the whole delta is exported
+	// again on the next run.
+	Export(ctx context.Context, delta Delta) error
+}
+
+// NewExporter creates the exporter selected by cfg.Exporter. Credentials are
+// read from Secrets of namespace through reader.
+func NewExporter(cfg config.CMDBConfig, reader client.Reader, namespace string) (Exporter, error) {
+	switch cfg.Exporter {
+	case config.CMDBExporterServiceNow:
+		return NewServiceNowExporter(cfg.ServiceNow, reader, namespace), nil
+	default:
+		return nil, fmt.Errorf("unknown CMDB exporter %q", cfg.Exporter)
+	}
+}
+
+// Items merges views into Items keyed by name, taking the owner and tier
+// from the ownerKey and tierKey origin annotations.
+func Items(views []domaindns.FQDNView, ownerKey, tierKey string) map[string]Item {
+	items := make(map[string]Item)
+	for _, v := range views {
+		item := items[v.Name]
+		item.Name = v.Name
+		if item.Description == "" {
+			item.Description = v.Description
+		}
+		if item.Owner == "" {
+			item.Owner = v.Annotations[ownerKey]
+		}
+		if item.Tier == "" {
+			item.Tier = v.Annotations[tierKey]
+		}
+		item.RecordTypes = appendSorted(item.RecordTypes, v.RecordType)
+		item.Targets = appendSorted(item.Targets, v.Targets...)
+		item.Portals = appendSorted(item.Portals, v.Portals...)
+		item.Groups = appendSorted(item.Groups, v.Groups...)
+		item.SourceTypes = appendSorted(item.SourceTypes, v.SourceType)
+		items[v.Name] = item
+	}
+	return items
+}
+
+// appendSorted adds the non-empty values missing from s, keeping s sorted.
+func appendSorted(s []string, values ...string) []string {
+	for _, v := range values {
+		if v == "" || slices.Contains(s, v) {
+			continue
+		}
+		s = append(s, v)
+	}
+	sort.Strings(s)
+	return s
+}
+
+// Diff returns the changes turning prev into next, each list sorted by name.
+func Diff(prev, next map[string]Item) Delta {
+	var d Delta
+	for _, name := range slices.Sorted(maps.Keys(next)) {
+		item := next[name]
+		old, ok := prev[name]
+		switch {
+		case !ok:
+			d.Added = append(d.Added, item)
+		case !equalItems(old, item):
+			d.Updated = append(d.Updated, item)
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(prev)) {
+		if _, ok := next[name]; !ok {
+			d.Removed = append(d.Removed, prev[name])
+		}
+	}
+	return d
+}
+
+func equalItems(a, b Item) bool {
+	return a.Name == b.Name && a.Description == b.Description &&
+		a.Owner == b.Owner && a.Tier == b.Tier &&
+		slices.Equal(a.RecordTypes, b.RecordTypes) &&
+		slices.Equal(a.Targets, b.Targets) &&
+		slices.Equal(a.Portals, b.Portals) &&
+		slices.Equal(a.Groups, b.Groups) &&
+		slices.Equal(a.SourceTypes, b.SourceTypes)
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmdb
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/config"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	dnsreadstore "github.com/golgoth31/sreportal/internal/readstore/dns"
+)
+
+const (
+	ownerKey = "example.com/owner"
+	tierKey  = "example.com/tier"
+)
+
+// recordingExporter keeps the deltas it is given and fails while err is set.
+type recordingExporter struct {
+	deltas []Delta
+	err    error
+}
+
+func (e *recordingExporter) Name() string { return "recording" }
+
+func (e *recordingExporter) Export(_ context.Context, delta Delta) error {
+	if e.err != nil {
+		return e.err
+	}
+	e.deltas = append(e.deltas, delta)
+	return nil
+}
+
+func TestItemsMergesViewsByName(t *testing.T) {
+	items := Items([]domaindns.FQDNView{
+		{Name: "api.example.com", RecordType: "A", Targets: []string{"10.0.0.2"}, Portals: []string{"main"},
+			SourceType: "service", Annotations: map[string]string{ownerKey: "team-a"}},
+		{Name: "api.example.com", RecordType: "AAAA", Targets: []string{"::1"}, Portals: []string{"team"},
+			SourceType: "service", Annotations: map[string]string{tierKey: "gold"}},
+		{Name: "web.example.com", RecordType: "CNAME", Targets: []string{"lb.example.com"}, Portals: []string{"main"}},
+	}, ownerKey, tierKey)
+
+	require.Len(t, items, 2)
+	assert.Equal(t, Item{
+		Name:        "api.example.com",
+		RecordTypes: []string{"A", "AAAA"},
+		Targets:     []string{"10.0.0.2", "::1"},
+		Portals:     []string{"main", "team"},
+		SourceTypes: []string{"service"},
+		Owner:       "team-a",
+		Tier:        "gold",
+	}, items["api.example.com"])
+	assert.Empty(t, items["web.example.com"].Owner)
+}
+
+func TestDiff(t *testing.T) {
+	prev := map[string]Item{
+		"a.example.com": {Name: "a.example.com", Targets: []string{"10.0.0.1"}},
+		"b.example.com": {Name: "b.example.com", Targets: []string{"10.0.0.2"}},
+		"c.example.com": {Name: "c.example.com", Targets: []string{"10.0.0.3"}},
+	}
+	next := map[string]Item{
+		"a.example.com": {Name: "a.example.com", Targets: []string{"10.0.0.1"}},
+		"b.example.com": {Name: "b.example.com", Targets: []string{"10.0.0.20"}},
+		"d.example.com": {Name: "d.example.com", Targets: []string{"10.0.0.4"}},
+	}
+
+	d := Diff(prev, next)
+
+	assert.Equal(t, []Item{next["d.example.com"]}, d.Added)
+	assert.Equal(t, []Item{next["b.example.com"]}, d.Updated)
+	assert.Equal(t, []Item{prev["c.example.com"]}, d.Removed)
+	assert.True(t, Diff(next, next).Empty())
+}
+
+func newRunnerFixture(t *testing.T, portals ...*sreportalv1alpha1.Portal) (*dnsreadstore.FQDNStore, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha1.AddToScheme(scheme))
+	b := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&sreportalv1alpha1.Portal{})
+	for _, p := range portals {
+		b = b.WithObjects(p)
+	}
+	return dnsreadstore.NewFQDNStore(), b.Build()
+}
+
+func portal(name string, remote bool) *sreportalv1alpha1.Portal {
+	p := &sreportalv1alpha1.Portal{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "sreportal-system"},
+		Spec:       sreportalv1alpha1.PortalSpec{Title: name},
+	}
+	if remote {
+		p.Spec.Remote = &sreportalv1alpha1.RemotePortalSpec{URL: "https://remote.example.com"}
+	}
+	return p
+}
+
+func exportedCondition(t *testing.T, c client.Client, name string) *metav1.Condition {
+	t.Helper()
+	var p sreportalv1alpha1.Portal
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: "sreportal-system", Name: name}, &p))
+	return meta.FindStatusCondition(p.Status.Conditions, ConditionTypeExported)
+}
+
+func TestRunnerExportsDeltasOfLocalPortals(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.CMDBConfig{OwnerAnnotation: ownerKey, TierAnnotation: tierKey}
+	store, c := newRunnerFixture(t, portal("main", false), portal("remote", true))
+	require.NoError(t, store.Replace(ctx, "ns/main-service", "main", []domaindns.FQDNView{
+		{Name: "api.example.com", RecordType: "A", Targets: []string{"10.0.0.1"}, Portals: []string{"main"},
+			Annotations: map[string]string{ownerKey: "team-a"}},
+	}))
+	require.NoError(t, store.Replace(ctx, "ns/remote", "remote", []domaindns.FQDNView{
+		{Name: "far.example.com", RecordType: "A", Targets: []string{"10.1.0.1"}, Portals: []string{"remote"}},
+	}))
+	exporter := &recordingExporter{}
+	r := NewRunner(store, c, exporter, cfg)
+
+	require.NoError(t, r.run(ctx))
+	require.Len(t, exporter.deltas, 1)
+	require.Len(t, exporter.deltas[0].Added, 1)
+	assert.Equal(t, "api.example.com", exporter.deltas[0].Added[0].Name)
+	assert.Equal(t, "team-a", exporter.deltas[0].Added[0].Owner)
+	cond := exportedCondition(t, c, "main")
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Contains(t, cond.Message, "1 added, 0 updated, 0 removed")
+	assert.Nil(t, exportedCondition(t, c, "remote"), "remote portals are not exported")
+
+	// Nothing changed: no export.
+	require.NoError(t, r.run(ctx))
+	assert.Len(t, exporter.deltas, 1)
+
+	require.NoError(t, store.Delete(ctx, "ns/main-service"))
+	require.NoError(t, r.run(ctx))
+	require.Len(t, exporter.deltas, 2)
+	assert.Empty(t, exporter.deltas[1].Added)
+	require.Len(t, exporter.deltas[1].Removed, 1)
+	assert.Equal(t, "api.example.com", exporter.deltas[1].Removed[0].Name)
+}
+
+func TestRunnerReportsFailureAndRetriesDelta(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.CMDBConfig{Portals: []string{"main"}}
+	store, c := newRunnerFixture(t, portal("main", false))
+	require.NoError(t, store.Replace(ctx, "ns/main-service", "main", []domaindns.FQDNView{
+		{Name: "api.example.com", RecordType: "A", Targets: []string{"10.0.0.1"}, Portals: []string{"main"}},
+	}))
+	exporter := &recordingExporter{err: errors.New("instance unreachable")}
+	r := NewRunner(store, c, exporter, cfg)
+
+	require.Error(t, r.run(ctx))
+	cond := exportedCondition(t, c, "main")
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, reasonExportFailed, cond.Reason)
+	assert.Contains(t, cond.Message, "instance unreachable")
+
+	exporter.err = nil
+	require.NoError(t, r.run(ctx))
+	require.Len(t, exporter.deltas, 1)
+	assert.Len(t, exporter.deltas[0].Added, 1, "the failed delta is exported again")
+	assert.Equal(t, metav1.ConditionTrue, exportedCondition(t, c, "main").Status)
+}
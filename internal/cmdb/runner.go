@@ -0,0 +1,200 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmdb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/config"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	"github.com/golgoth31/sreportal/internal/log"
+	"github.com/golgoth31/sreportal/internal/metrics"
+)
+
+const (
+	// ConditionTypeExported is the Portal condition reporting the outcome
+	// of the last CMDB export of its FQDNs.
+	ConditionTypeExported = "CMDBExported"
+
+	reasonExportSucceeded = "CMDBExportSucceeded"
+	reasonExportFailed    = "CMDBExportFailed"
+)
+
+// Runner periodically exports the FQDNs of the selected portals through an
+// Exporter and reports the outcome on those portals. It runs on the leader
+// only.
+type Runner struct {
+	fqdns    domaindns.FQDNReader
+	client   client.Client
+	exporter Exporter
+	cfg      config.CMDBConfig
+
+	// last is the inventory as of the last successful export.
+	last map[string]Item
+}
+
+var _ manager.Runnable = (*Runner)(nil)
+
+// NewRunner creates a Runner exporting the FQDNs of fqdns through exporter.
+func NewRunner(fqdns domaindns.FQDNReader, c client.Client, exporter Exporter, cfg config.CMDBConfig) *Runner {
+	return &Runner{fqdns: fqdns, client: c, exporter: exporter, cfg: cfg}
+}
+
+// Start implements manager.Runnable. It exports once right away, then every
+// interval.
+func (r *Runner) Start(ctx context.Context) error {
+	ticker := time.NewTicker(r.cfg.Interval.Duration())
+	defer ticker.Stop()
+	for {
+		if err := r.run(ctx); err != nil {
+			log.FromContext(ctx).WithName("cmdb-export").Error(err, "failed to export FQDN inventory",
+				"exporter", r.exporter.Name())
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// run exports the changes since the last successful export and sets the
+// CMDBExported condition of the exported portals.
+func (r *Runner) run(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("cmdb-export")
+
+	portals, err := r.portals(ctx)
+	if err != nil {
+		metrics.PortalCMDBExportTotal.WithLabelValues("error").Inc()
+		return err
+	}
+	names := make([]string, 0, len(portals))
+	for i := range portals {
+		names = append(names, portals[i].Name)
+	}
+
+	views, err := r.fqdns.List(ctx, domaindns.FQDNFilters{})
+	if err != nil {
+		metrics.PortalCMDBExportTotal.WithLabelValues("error").Inc()
+		return fmt.Errorf("list FQDNs: %w", err)
+	}
+	next := Items(selectPortals(views, names), r.cfg.OwnerAnnotation, r.cfg.TierAnnotation)
+	delta := Diff(r.last, next)
+
+	if delta.Empty() && r.last != nil {
+		metrics.PortalCMDBExportTotal.WithLabelValues("unchanged").Inc()
+		r.report(ctx, portals, nil, len(next), delta)
+		return nil
+	}
+
+	if err := r.exporter.Export(ctx, delta); err != nil {
+		metrics.PortalCMDBExportTotal.WithLabelValues("error").Inc()
+		r.report(ctx, portals, err, len(next), delta)
+		return fmt.Errorf("export to %s: %w", r.exporter.Name(), err)
+	}
+	r.last = next
+	metrics.PortalCMDBExportTotal.WithLabelValues("exported").Inc()
+	metrics.PortalCMDBExportChangesTotal.WithLabelValues("added").Add(float64(len(delta.Added)))
+	metrics.PortalCMDBExportChangesTotal.WithLabelValues("updated").Add(float64(len(delta.Updated)))
+	metrics.PortalCMDBExportChangesTotal.WithLabelValues("removed").Add(float64(len(delta.Removed)))
+	logger.Info("exported FQDN inventory", "exporter", r.exporter.Name(), "portals", names,
+		"fqdnCount", len(next), "added", len(delta.Added), "updated", len(delta.Updated), "removed", len(delta.Removed))
+	r.report(ctx, portals, nil, len(next), delta)
+	return nil
+}
+
+// portals returns the Portals to export: those of cfg.Portals, or every
+// local portal when the list is empty.
+func (r *Runner) portals(ctx context.Context) ([]sreportalv1alpha1.Portal, error) {
+	var list sreportalv1alpha1.PortalList
+	if err := r.client.List(ctx, &list); err != nil {
+		return nil, fmt.Errorf("list portals: %w", err)
+	}
+	var selected []sreportalv1alpha1.Portal
+	for _, p := range list.Items {
+		if len(r.cfg.Portals) > 0 {
+			if slices.Contains(r.cfg.Portals, p.Name) {
+				selected = append(selected, p)
+			}
+			continue
+		}
+		if p.Spec.Remote == nil {
+			selected = append(selected, p)
+		}
+	}
+	return selected, nil
+}
+
+// selectPortals keeps the views of portals, restricting their Portals to
+// those names.
+func selectPortals(views []domaindns.FQDNView, portals []string) []domaindns.FQDNView {
+	out := make([]domaindns.FQDNView, 0, len(views))
+	for _, v := range views {
+		var kept []string
+		for _, p := range v.Portals {
+			if slices.Contains(portals, p) {
+				kept = append(kept, p)
+			}
+		}
+		if len(kept) == 0 {
+			continue
+		}
+		v.Portals = kept
+		out = append(out, v)
+	}
+	return out
+}
+
+// report sets the CMDBExported condition of portals. An unchanged run keeps
+// the message of the last export, which holds its delta.
+func (r *Runner) report(ctx context.Context, portals []sreportalv1alpha1.Portal, exportErr error, count int, delta Delta) {
+	cond := metav1.Condition{
+		Type:   ConditionTypeExported,
+		Status: metav1.ConditionTrue,
+		Reason: reasonExportSucceeded,
+		Message: fmt.Sprintf("%d FQDNs exported to %s (last run: %d added, %d updated, %d removed)",
+			count, r.exporter.Name(), len(delta.Added), len(delta.Updated), len(delta.Removed)),
+	}
+	if exportErr != nil {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = reasonExportFailed
+		cond.Message = fmt.Sprintf("export to %s failed: %v", r.exporter.Name(), exportErr)
+	}
+
+	for i := range portals {
+		portal := &portals[i]
+		if delta.Empty() && exportErr == nil && meta.IsStatusConditionTrue(portal.Status.Conditions, ConditionTypeExported) {
+			continue
+		}
+		base := portal.DeepCopy()
+		if !meta.SetStatusCondition(&portal.Status.Conditions, cond) {
+			continue
+		}
+		if err := r.client.Status().Patch(ctx, portal, client.MergeFrom(base)); err != nil {
+			log.FromContext(ctx).WithName("cmdb-export").Error(err, "failed to patch Portal status", "portal", portal.Name)
+		}
+	}
+}
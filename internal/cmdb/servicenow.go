@@ -0,0 +1,226 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmdb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/golgoth31/sreportal/internal/config"
+)
+
+// maxErrorBody bounds the part of an error response quoted in errors.
+const maxErrorBody = 512
+
+// ServiceNowExporter writes FQDNs to a ServiceNow CMDB table through the
+// Table API, one record per FQDN matched on its "name" field. Owner, tier and
+// discovery metadata go to "u_" custom columns, which ServiceNow ignores when
+// the table does not define them.
+type ServiceNowExporter struct {
+	cfg        config.ServiceNowConfig
+	reader     client.Reader
+	namespace  string
+	httpClient *http.Client
+}
+
+var _ Exporter = (*ServiceNowExporter)(nil)
+
+// NewServiceNowExporter creates an exporter reading its credentials from the
+// cfg.CredentialsSecret Secret of namespace.
+func NewServiceNowExporter(cfg config.ServiceNowConfig, reader client.Reader, namespace string) *ServiceNowExporter {
+	return &ServiceNowExporter{
+		cfg:        cfg,
+		reader:     reader,
+		namespace:  namespace,
+		httpClient: &http.Client{Timeout: cfg.Timeout.Duration()},
+	}
+}
+
+// Name implements Exporter.
+func (e *ServiceNowExporter) Name() string {
+	return config.CMDBExporterServiceNow
+}
+
+// Export implements Exporter. A failed record does not stop the export of
+// the others; the errors are joined.
+func (e *ServiceNowExporter) Export(ctx context.Context, delta Delta) error {
+	if delta.Empty() {
+		return nil
+	}
+	user, pass, err := e.credentials(ctx)
+	if err != nil {
+		return err
+	}
+	s := serviceNowSession{exporter: e, user: user, pass: pass}
+
+	var errs []error
+	for _, item := range slices.Concat(delta.Added, delta.Updated) {
+		if err := s.upsert(ctx, item); err != nil {
+			errs = append(errs, fmt.Errorf("upsert %s: %w", item.Name, err))
+		}
+	}
+	for _, item := range delta.Removed {
+		if err := s.remove(ctx, item.Name); err != nil {
+			errs = append(errs, fmt.Errorf("delete %s: %w", item.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// credentials reads the "username" and "password" keys of the credentials
+// Secret.
+func (e *ServiceNowExporter) credentials(ctx context.Context) (string, string, error) {
+	var secret corev1.Secret
+	key := client.ObjectKey{Namespace: e.namespace, Name: e.cfg.CredentialsSecret}
+	if err := e.reader.Get(ctx, key, &secret); err != nil {
+		return "", "", fmt.Errorf("get credentials secret %s: %w", key, err)
+	}
+	user, pass := secret.Data["username"], secret.Data["password"]
+	if len(user) == 0 || len(pass) == 0 {
+		return "", "", fmt.Errorf("secret %s has no username and password keys", key)
+	}
+	return string(user), string(pass), nil
+}
+
+// serviceNowSession issues the Table API calls of one export.
+type serviceNowSession struct {
+	exporter   *ServiceNowExporter
+	user, pass string
+}
+
+// upsert updates the record named item.Name, or creates it.
+func (s serviceNowSession) upsert(ctx context.Context, item Item) error {
+	sysID, err := s.lookup(ctx, item.Name)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(serviceNowRecord(item))
+	if err != nil {
+		return fmt.Errorf("encode record: %w", err)
+	}
+	if sysID == "" {
+		return s.do(ctx, http.MethodPost, s.tableURL(""), body, nil)
+	}
+	return s.do(ctx, http.MethodPatch, s.tableURL(sysID), body, nil)
+}
+
+// remove deletes the record named name; a missing record is not an error.
+func (s serviceNowSession) remove(ctx context.Context, name string) error {
+	sysID, err := s.lookup(ctx, name)
+	if err != nil || sysID == "" {
+		return err
+	}
+	return s.do(ctx, http.MethodDelete, s.tableURL(sysID), nil, nil)
+}
+
+// lookup returns the sys_id of the record named name, or "" when there is
+// none.
+func (s serviceNowSession) lookup(ctx context.Context, name string) (string, error) {
+	q := url.Values{
+		"sysparm_query":  {"name=" + escapeQueryValue(name)},
+		"sysparm_fields": {"sys_id"},
+		"sysparm_limit":  {"1"},
+	}
+	var resp struct {
+		Result []struct {
+			SysID string `json:"sys_id"`
+		} `json:"result"`
+	}
+	if err := s.do(ctx, http.MethodGet, s.tableURL("")+"?"+q.Encode(), nil, &resp); err != nil {
+		return "", err
+	}
+	if len(resp.Result) == 0 {
+		return "", nil
+	}
+	return resp.Result[0].SysID, nil
+}
+
+// escapeQueryValue escapes the caret of an encoded query value, so a name
+// cannot add conditions (e.g. "^OR") to the lookup.
+func escapeQueryValue(v string) string {
+	return strings.ReplaceAll(v, "^", "^^")
+}
+
+func (s serviceNowSession) tableURL(sysID string) string {
+	u := strings.TrimSuffix(s.exporter.cfg.URL, "/") + "/api/now/table/" + url.PathEscape(s.exporter.cfg.Table)
+	if sysID != "" {
+		u += "/" + url.PathEscape(sysID)
+	}
+	return u
+}
+
+// do sends a Table API request and decodes its JSON response into out when
+// out is not nil.
+func (s serviceNowSession) do(ctx context.Context, method, target string, body []byte, out any) error {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, reqBody)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.SetBasicAuth(s.user, s.pass)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.exporter.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return fmt.Errorf("%s %s: unexpected status %d: %s", method, req.URL.Path, resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
+
+// serviceNowRecord maps item to the fields of a CMDB record.
+func serviceNowRecord(item Item) map[string]string {
+	return map[string]string{
+		"name":              item.Name,
+		"short_description": item.Description,
+		"u_record_type":     strings.Join(item.RecordTypes, ","),
+		"u_targets":         strings.Join(item.Targets, ","),
+		"u_portals":         strings.Join(item.Portals, ","),
+		"u_groups":          strings.Join(item.Groups, ","),
+		"u_source":          strings.Join(item.SourceTypes, ","),
+		"u_owner":           item.Owner,
+		"u_tier":            item.Tier,
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmdb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/golgoth31/sreportal/internal/config"
+)
+
+// fakeTable is a ServiceNow Table API serving one table in memory.
+type fakeTable struct {
+	mu      sync.Mutex
+	records map[string]map[string]string // sys_id -> fields
+	nextID  int
+	calls   []string
+}
+
+func (f *fakeTable) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, r.Method)
+	if user, pass, ok := r.BasicAuth(); !ok || user != "svc" || pass != "secret" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	const base = "/api/now/table/cmdb_ci_dns_name"
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == base:
+		result := []map[string]string{}
+		for id, rec := range f.records {
+			if "name="+strings.ReplaceAll(rec["name"], "^", "^^") == r.URL.Query().Get("sysparm_query") {
+				result = append(result, map[string]string{"sys_id": id})
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"result": result})
+	case r.Method == http.MethodPost && r.URL.Path == base:
+		var rec map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&rec)
+		f.nextID++
+		f.records[fmt.Sprintf("id%d", f.nextID)] = rec
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodPatch:
+		id := r.URL.Path[len(base)+1:]
+		var rec map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&rec)
+		f.records[id] = rec
+	case r.Method == http.MethodDelete:
+		delete(f.records, r.URL.Path[len(base)+1:])
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func newServiceNowExporter(t *testing.T, url string) *ServiceNowExporter {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	reader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "servicenow", Namespace: "sreportal-system"},
+		Data:       map[string][]byte{"username": []byte("svc"), "password": []byte("secret")},
+	}).Build()
+	return NewServiceNowExporter(config.ServiceNowConfig{
+		URL:               url,
+		Table:             "cmdb_ci_dns_name",
+		CredentialsSecret: "servicenow",
+		Timeout:           config.Duration(5 * time.Second),
+	}, reader, "sreportal-system")
+}
+
+func TestServiceNowExporterAppliesDelta(t *testing.T) {
+	table := &fakeTable{records: map[string]map[string]string{
+		"old":  {"name": "old.example.com"},
+		"kept": {"name": "api.example.com", "u_owner": "team-a"},
+	}}
+	srv := httptest.NewServer(table)
+	defer srv.Close()
+	e := newServiceNowExporter(t, srv.URL)
+
+	err := e.Export(context.Background(), Delta{
+		Added:   []Item{{Name: "new.example.com", RecordTypes: []string{"A"}, Targets: []string{"10.0.0.1", "10.0.0.2"}, Tier: "gold"}},
+		Updated: []Item{{Name: "api.example.com", Owner: "team-b"}},
+		Removed: []Item{{Name: "old.example.com"}, {Name: "missing.example.com"}},
+	})
+	require.NoError(t, err)
+
+	names := map[string]map[string]string{}
+	for _, rec := range table.records {
+		names[rec["name"]] = rec
+	}
+	assert.Len(t, names, 2)
+	assert.NotContains(t, names, "old.example.com")
+	assert.Equal(t, "10.0.0.1,10.0.0.2", names["new.example.com"]["u_targets"])
+	assert.Equal(t, "gold", names["new.example.com"]["u_tier"])
+	assert.Equal(t, "team-b", table.records["kept"]["u_owner"])
+}
+
+func TestServiceNowExporterEscapesLookupQuery(t *testing.T) {
+	var queries []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			queries = append(queries, r.URL.Query().Get("sysparm_query"))
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"result": []any{}})
+	}))
+	defer srv.Close()
+
+	err := newServiceNowExporter(t, srv.URL).Export(context.Background(),
+		Delta{Removed: []Item{{Name: "a.example.com^ORname!=x"}}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"name=a.example.com^^ORname!=x"}, queries)
+}
+
+func TestServiceNowExporterReportsHTTPErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"error":{"message":"ACL denied"}}`))
+	}))
+	defer srv.Close()
+	e := newServiceNowExporter(t, srv.URL)
+
+	err := e.Export(context.Background(), Delta{Added: []Item{{Name: "a.example.com"}}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "a.example.com")
+	assert.Contains(t, err.Error(), "403")
+	assert.Contains(t, err.Error(), "ACL denied")
+}
+
+func TestServiceNowExporterSkipsEmptyDelta(t *testing.T) {
+	table := &fakeTable{records: map[string]map[string]string{}}
+	srv := httptest.NewServer(table)
+	defer srv.Close()
+
+	require.NoError(t, newServiceNowExporter(t, srv.URL).Export(context.Background(), Delta{}))
+	assert.Empty(t, table.calls)
+}
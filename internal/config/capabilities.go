@@ -0,0 +1,141 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+)
+
+// Feature names reported by Capabilities.
+const (
+	FeatureProbing      = "probing"
+	FeatureCertificates = "certificates"
+	FeatureDNSCheck     = "dnsCheck"
+	FeatureAuth         = "auth"
+	FeatureAnalytics    = "analytics"
+)
+
+// Capabilities tells clients which sources and features this instance runs,
+// so they can hide the views that would stay empty.
+type Capabilities struct {
+	Sources  []SourceCapability
+	Features []FeatureCapability
+}
+
+// SourceCapability reports whether a discovery source is enabled.
+type SourceCapability struct {
+	// Name is the key of the source in DNS.spec.sources
+	// (e.g. "service", "gatewayHTTPRoute").
+	Name    string
+	Enabled bool
+}
+
+// FeatureCapability reports whether a feature is active, with a summary of
+// its configuration. The summary never holds addresses or credentials.
+type FeatureCapability struct {
+	Name    string
+	Enabled bool
+	Config  map[string]string
+}
+
+// SourceCapabilitiesFunc returns the current source capabilities.
+type SourceCapabilitiesFunc func(ctx context.Context) ([]SourceCapability, error)
+
+// SourceCapabilities reports the discovery sources, enabled when at least
+// one of specs enables them.
+func SourceCapabilities(specs []*sreportalv1alpha2.SourcesSpec) []SourceCapability {
+	sources := []SourceCapability{
+		{Name: "service"}, {Name: "ingress"}, {Name: "dnsEndpoint"}, {Name: "istioGateway"},
+		{Name: "istioVirtualService"}, {Name: "gatewayHTTPRoute"}, {Name: "gatewayGRPCRoute"},
+		{Name: "gatewayTLSRoute"}, {Name: "gatewayTCPRoute"}, {Name: "gatewayUDPRoute"},
+		{Name: "contourHTTPProxy"}, {Name: "ambassadorHost"}, {Name: "crossplaneScalewayRecord"}, {Name: "demo"},
+	}
+	for _, s := range specs {
+		enabled := []bool{
+			s.Service != nil && s.Service.Enabled,
+			s.Ingress != nil && s.Ingress.Enabled,
+			s.DNSEndpoint != nil && s.DNSEndpoint.Enabled,
+			s.IstioGateway != nil && s.IstioGateway.Enabled,
+			s.IstioVirtualService != nil && s.IstioVirtualService.Enabled,
+			s.GatewayHTTPRoute != nil && s.GatewayHTTPRoute.Enabled,
+			s.GatewayGRPCRoute != nil && s.GatewayGRPCRoute.Enabled,
+			s.GatewayTLSRoute != nil && s.GatewayTLSRoute.Enabled,
+			s.GatewayTCPRoute != nil && s.GatewayTCPRoute.Enabled,
+			s.GatewayUDPRoute != nil && s.GatewayUDPRoute.Enabled,
+			s.ContourHTTPProxy != nil && s.ContourHTTPProxy.Enabled,
+			s.AmbassadorHost != nil && s.AmbassadorHost.Enabled,
+			s.CrossplaneScalewayRecord != nil && s.CrossplaneScalewayRecord.Enabled,
+			s.Demo != nil && s.Demo.Enabled,
+		}
+		for i, e := range enabled {
+			sources[i].Enabled = sources[i].Enabled || e
+		}
+	}
+	return sources
+}
+
+// Capabilities summarises the features enabled by c. resolution and probes
+// report whether the DNS pipeline runs the resolution and probe steps, which
+// dnsPipeline.disabled can turn off. Sources are left empty: they come from
+// the DNS resources, see SourceCapabilities.
+func (c *OperatorConfig) Capabilities(resolution, probes bool) Capabilities {
+	resolverType := c.DNSResolution.Resolver.Type
+	if resolverType == "" {
+		resolverType = ResolverTypeSystem
+	}
+	var authMethods []string
+	if c.Auth.APIKey != nil && c.Auth.APIKey.Enabled {
+		authMethods = append(authMethods, "apiKey")
+	}
+	if c.Auth.JWT != nil && c.Auth.JWT.Enabled {
+		authMethods = append(authMethods, "jwt")
+	}
+	if c.Auth.APITokens != nil && c.Auth.APITokens.Enabled {
+		authMethods = append(authMethods, "apiTokens")
+	}
+	certs := c.Digest.Certificates
+
+	features := []FeatureCapability{
+		{Name: FeatureProbing, Enabled: probes, Config: map[string]string{
+			"interval": c.Probes.Interval.Duration().String(),
+			"timeout":  c.Probes.Timeout.Duration().String(),
+			"groups":   strconv.Itoa(len(c.Probes.Groups)),
+		}},
+		{Name: FeatureCertificates, Enabled: c.Digest.Enabled && certs.Enabled, Config: map[string]string{
+			"port":       strconv.Itoa(certs.Port),
+			"warnWithin": certs.WarnWithin.Duration().String(),
+		}},
+		{Name: FeatureDNSCheck, Enabled: resolution, Config: map[string]string{
+			"resolver":     resolverType,
+			"splitHorizon": strconv.FormatBool(c.DNSResolution.ExternalResolver != ""),
+		}},
+		{Name: FeatureAuth, Enabled: c.Auth.Enabled(), Config: map[string]string{
+			"methods":              strings.Join(authMethods, ","),
+			"authorizationWebhook": strconv.FormatBool(c.Auth.AuthorizationWebhook != nil && c.Auth.AuthorizationWebhook.Enabled),
+			"oidc":                 strconv.FormatBool(c.Auth.OIDC != nil && c.Auth.OIDC.Enabled),
+			"requireForReads":      strconv.FormatBool(c.Auth.RequireForReads()),
+		}},
+		{Name: FeatureAnalytics, Enabled: c.Analytics.Enabled, Config: map[string]string{
+			"maxKeys": strconv.Itoa(c.Analytics.MaxKeys),
+		}},
+	}
+	return Capabilities{Features: features}
+}
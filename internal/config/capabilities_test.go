@@ -0,0 +1,75 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+)
+
+func TestCapabilities(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Auth.JWT = &JWTAuthConfig{Enabled: true}
+	cfg.DNSResolution.ExternalResolver = "1.1.1.1"
+
+	caps := cfg.Capabilities(true, false)
+
+	if len(caps.Sources) != 0 {
+		t.Errorf("Sources = %+v, expected none from the configuration", caps.Sources)
+	}
+
+	features := map[string]FeatureCapability{}
+	for _, f := range caps.Features {
+		features[f.Name] = f
+	}
+	if f := features[FeatureProbing]; f.Enabled {
+		t.Errorf("probing enabled, expected disabled by the pipeline")
+	}
+	if f := features[FeatureCertificates]; f.Enabled {
+		t.Errorf("certificates enabled, expected disabled by default")
+	}
+	want := map[string]string{"resolver": ResolverTypeSystem, "splitHorizon": "true"}
+	if f := features[FeatureDNSCheck]; !f.Enabled || !reflect.DeepEqual(f.Config, want) {
+		t.Errorf("dnsCheck = %+v, expected enabled with %v", f, want)
+	}
+	if f := features[FeatureAuth]; !f.Enabled || f.Config["methods"] != "jwt" {
+		t.Errorf("auth = %+v, expected enabled with methods jwt", f)
+	}
+}
+
+func TestSourceCapabilities(t *testing.T) {
+	sources := SourceCapabilities([]*sreportalv1alpha2.SourcesSpec{
+		{Service: &sreportalv1alpha2.ServiceSourceSpec{CommonSourceSpec: sreportalv1alpha2.CommonSourceSpec{Enabled: true}}},
+		{
+			Ingress: &sreportalv1alpha2.IngressSourceSpec{},
+			Demo:    &sreportalv1alpha2.DemoSourceSpec{Enabled: true},
+		},
+	})
+
+	enabled := map[string]bool{}
+	for _, s := range sources {
+		enabled[s.Name] = s.Enabled
+	}
+	if len(sources) != 14 {
+		t.Errorf("len(sources) = %d, expected 14", len(sources))
+	}
+	if !enabled["service"] || !enabled["demo"] || enabled["ingress"] || enabled["gatewayHTTPRoute"] {
+		t.Errorf("sources = %+v, expected service and demo enabled", sources)
+	}
+}
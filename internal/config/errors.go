@@ -22,6 +22,106 @@ var (
 	// ErrInvalidInterval is returned when the reconciliation interval is not positive.
 	ErrInvalidInterval = errors.New("reconciliation interval must be positive")
 
+	// ErrInvalidTimeout is returned when a timeout is not positive.
+	ErrInvalidTimeout = errors.New("timeout must be positive")
+
+	// ErrInvalidJitter is returned when a jitter factor is outside [0, 1].
+	ErrInvalidJitter = errors.New("jitter must be between 0 and 1")
+
+	// ErrInvalidConcurrency is returned when a concurrency limit is not positive.
+	ErrInvalidConcurrency = errors.New("concurrency must be positive")
+
 	// ErrEmptyDefaultGroup is returned when the group mapping default group is empty.
 	ErrEmptyDefaultGroup = errors.New("group mapping defaultGroup must not be empty")
+
+	// ErrInvalidResolverAddress is returned when a DNS resolver address is not
+	// a valid "host" or "host:port".
+	ErrInvalidResolverAddress = errors.New("resolver address must be host or host:port")
+
+	// ErrInvalidFaultInjection is returned when a fault injection rule does not
+	// satisfy 0 <= failures <= outOf with outOf >= 1.
+	ErrInvalidFaultInjection = errors.New("fault injection requires 0 <= failures <= outOf and outOf >= 1")
+
+	// ErrInvalidCORS is returned when the web CORS configuration is rejected.
+	ErrInvalidCORS = errors.New("invalid CORS configuration")
+
+	// ErrInvalidWebStreams is returned when the web streams configuration is
+	// rejected.
+	ErrInvalidWebStreams = errors.New("invalid web streams configuration")
+
+	// ErrInvalidWebRPC is returned when an RPC timeout is negative or keyed
+	// by a malformed procedure name.
+	ErrInvalidWebRPC = errors.New("invalid web RPC configuration")
+
+	// ErrInvalidPublicURL is returned when the web public URL is not an
+	// absolute http(s) URL.
+	ErrInvalidPublicURL = errors.New("public URL must be an absolute http(s) URL without query")
+
+	// ErrInvalidSecurityHeader is returned when a web security header setting is rejected.
+	ErrInvalidSecurityHeader = errors.New("invalid security header configuration")
+
+	// ErrInvalidMCPSessions is returned when an MCP session limit is negative.
+	ErrInvalidMCPSessions = errors.New("MCP session limits must not be negative")
+
+	// ErrInvalidSnapshotPublisher is returned when an enabled snapshot
+	// publisher has no repository or tag.
+	ErrInvalidSnapshotPublisher = errors.New("snapshot publisher requires a repository and a tag")
+
+	// ErrInvalidAutoPortal is returned when automatic portals are enabled
+	// with an empty or malformed namespace selector.
+	ErrInvalidAutoPortal = errors.New("auto portal requires a non-empty namespace label selector")
+
+	// ErrInvalidCMDB is returned when an enabled CMDB export has an unknown
+	// exporter or an incomplete exporter configuration.
+	ErrInvalidCMDB = errors.New("invalid CMDB export configuration")
+
+	// ErrInvalidDigest is returned when an enabled digest has an incomplete
+	// notifier, or a portal without notifiers or referring to an unknown one.
+	ErrInvalidDigest = errors.New("invalid digest configuration")
+
+	// ErrInvalidDNSPipeline is returned when the DNS pipeline configuration
+	// names an unknown, duplicate or required handler, or orders handlers
+	// before the ones producing their input.
+	ErrInvalidDNSPipeline = errors.New("invalid DNS pipeline configuration")
+
+	// ErrInvalidAuthorizationWebhook is returned when an enabled authorization
+	// webhook has no http(s) URL, an unknown failure policy or a negative
+	// timeout or cache TTL.
+	ErrInvalidAuthorizationWebhook = errors.New("invalid authorization webhook configuration")
+
+	// ErrInvalidAPITokens is returned when enabled API tokens name no Secret
+	// or have a negative reload interval.
+	ErrInvalidAPITokens = errors.New("invalid API tokens configuration")
+
+	// ErrInvalidOIDC is returned when an enabled OIDC login has no http(s)
+	// issuer URL, no client ID, no redirect URL to use or a negative session
+	// TTL.
+	ErrInvalidOIDC = errors.New("invalid OIDC configuration")
+
+	// ErrInvalidAnalytics is returned when an analytics limit is negative.
+	ErrInvalidAnalytics = errors.New("analytics limits must not be negative")
+
+	// ErrInvalidAgent is returned in agent mode when the agent name is not a
+	// DNS label, or the portal, central URL or API key header is missing.
+	ErrInvalidAgent = errors.New("invalid agent configuration")
+
+	// ErrInvalidScaleGuard is returned when a scale guard limit is negative
+	// or its soft limit is above its hard limit.
+	ErrInvalidScaleGuard = errors.New("invalid scale guard limit")
+
+	// ErrInvalidQuota is returned when a quota limit is negative.
+	ErrInvalidQuota = errors.New("quota limits must not be negative")
+
+	// ErrInvalidPortalTemplate is returned when a portal template has no or a
+	// duplicate name, a link without title or with an invalid URL, an invalid
+	// logo URL, or an invalid source label filter.
+	ErrInvalidPortalTemplate = errors.New("invalid portal template")
+
+	// ErrInvalidPortalSelector is returned when the namespace label selector
+	// of a portal policy does not parse.
+	ErrInvalidPortalSelector = errors.New("invalid portal namespace label selector")
+
+	// ErrInvalidGroupLabelKey is returned when a group mapping label key is
+	// not a valid Kubernetes label key.
+	ErrInvalidGroupLabelKey = errors.New("invalid group mapping label key")
 )
@@ -54,11 +54,63 @@ func LoadFromFile(path string) (*OperatorConfig, error) {
 // LogConfig returns a summary of the configuration for logging purposes.
 func (c *OperatorConfig) LogSummary() map[string]any {
 	summary := map[string]any{
-		"reconciliation.interval":        c.Reconciliation.Interval.Duration().String(),
-		"reconciliation.retryOnError":    c.Reconciliation.RetryOnError.Duration().String(),
-		"reconciliation.disableDNSCheck": c.Reconciliation.DisableDNSCheck,
-		"groupMapping.defaultGroup":      c.GroupMapping.DefaultGroup,
-		"sources.priority":               c.Sources.Priority,
+		"reconciliation.interval":             c.Reconciliation.Interval.Duration().String(),
+		"reconciliation.retryOnError":         c.Reconciliation.RetryOnError.Duration().String(),
+		"reconciliation.disableDNSCheck":      c.Reconciliation.DisableDNSCheck,
+		"remoteSync.interval":                 c.RemoteSync.Interval.Duration().String(),
+		"remoteSync.jitter":                   c.RemoteSync.Jitter,
+		"remoteSync.maxConcurrent":            c.RemoteSync.MaxConcurrent,
+		"portal.disableDNSAutoCreate":         c.Portal.DisableDNSAutoCreate,
+		"portal.templates":                    len(c.Portal.Templates),
+		"dnsRecord.nameTemplate":              c.DNSRecord.NameTemplate,
+		"dnsRecord.exposedAnnotations":        c.DNSRecord.ExposedAnnotations,
+		"dnsRecord.tombstoneRetention":        c.DNSRecord.TombstoneRetention.Duration().String(),
+		"dnsRecord.targetProviders":           c.DNSRecord.TargetProviders,
+		"dnsResolution.resolver.type":         c.DNSResolution.Resolver.Type,
+		"dnsResolution.externalResolver":      c.DNSResolution.ExternalResolver,
+		"probes.interval":                     c.Probes.Interval.Duration().String(),
+		"probes.timeout":                      c.Probes.Timeout.Duration().String(),
+		"probes.groups":                       c.Probes.Groups,
+		"consistency.interval":                c.Consistency.Interval.Duration().String(),
+		"uniqueness.interval":                 c.Uniqueness.Interval.Duration().String(),
+		"scaleGuard.interval":                 c.ScaleGuard.Interval.Duration().String(),
+		"scaleGuard.fqdns.hard":               c.ScaleGuard.FQDNs.Hard,
+		"scaleGuard.streams.hard":             c.ScaleGuard.Streams.Hard,
+		"quota.maxManualGroups":               c.Quota.MaxManualGroups,
+		"quota.maxManualEntries":              c.Quota.MaxManualEntries,
+		"quota.maxPortalsPerNamespace":        c.Quota.MaxPortalsPerNamespace,
+		"externalDNSImport.enabled":           c.ExternalDNSImport.Enabled,
+		"snapshotPublisher.enabled":           c.SnapshotPublisher.Enabled,
+		"snapshotPublisher.repository":        c.SnapshotPublisher.Repository,
+		"autoPortal.enabled":                  c.AutoPortal.Enabled,
+		"autoPortal.selector":                 c.AutoPortal.Selector,
+		"cmdb.enabled":                        c.CMDB.Enabled,
+		"cmdb.exporter":                       c.CMDB.Exporter,
+		"digest.enabled":                      c.Digest.Enabled,
+		"digest.portals":                      len(c.Digest.Portals),
+		"digest.certificates.enabled":         c.Digest.Certificates.Enabled,
+		"agent.name":                          c.Agent.Name,
+		"agent.centralURL":                    c.Agent.CentralURL,
+		"agent.ingest.enabled":                c.Agent.Ingest.Enabled,
+		"agent.ingest.agents":                 len(c.Agent.Ingest.Agents),
+		"faultInjection.sources":              len(c.FaultInjection.Sources),
+		"groupMapping.defaultGroup":           c.GroupMapping.DefaultGroup,
+		"groupMapping.labelKeys":              len(c.GroupMapping.LabelKeys),
+		"sources.priority":                    c.Sources.Priority,
+		"security.sensitivePatterns":          c.Security.SensitivePatterns,
+		"security.hideSensitiveFromAnonymous": c.Security.HideSensitiveFromAnonymous,
+		"web.cors.allowedOrigins":             c.Web.CORS.AllowedOrigins,
+		"web.groupSeparator":                  c.Web.GroupSeparator,
+		"web.cors.allowCredentials":           c.Web.CORS.AllowCredentials,
+		"web.securityHeaders.csp":             c.Web.SecurityHeaders.ContentSecurityPolicy,
+		"web.securityHeaders.hstsMaxAge":      c.Web.SecurityHeaders.HSTS.MaxAge.Duration().String(),
+		"web.streams.sendTimeout":             c.Web.Streams.SendTimeout.Duration().String(),
+		"web.streams.heartbeatInterval":       c.Web.Streams.HeartbeatInterval.Duration().String(),
+		"web.rpc.timeout":                     c.Web.RPC.Timeout.Duration().String(),
+		"web.publicURL":                       c.Web.PublicURL,
+		"mcp.sessions.maxSessions":            c.MCP.Sessions.MaxSessions,
+		"mcp.sessions.idleTimeout":            c.MCP.Sessions.IdleTimeout.Duration().String(),
+		"analytics.enabled":                   c.Analytics.Enabled,
 	}
 
 	if c.Sources.Service != nil {
@@ -146,9 +198,24 @@ func (c *OperatorConfig) LogSummary() map[string]any {
 		summary["sources.gatewayUDPRoute"] = nil
 	}
 
+	if c.Sources.ContourHTTPProxy != nil {
+		summary["sources.contourHTTPProxy.enabled"] = c.Sources.ContourHTTPProxy.Enabled
+		summary["sources.contourHTTPProxy.namespace"] = c.Sources.ContourHTTPProxy.Namespace
+	} else {
+		summary["sources.contourHTTPProxy"] = nil
+	}
+
+	if c.Sources.AmbassadorHost != nil {
+		summary["sources.ambassadorHost.enabled"] = c.Sources.AmbassadorHost.Enabled
+		summary["sources.ambassadorHost.namespace"] = c.Sources.AmbassadorHost.Namespace
+	} else {
+		summary["sources.ambassadorHost"] = nil
+	}
+
 	if c.Auth.Enabled() {
 		if c.Auth.APIKey != nil {
 			summary["auth.apiKey.headerName"] = c.Auth.APIKey.HeaderName
+			summary["auth.apiKey.name"] = c.Auth.APIKey.NameOrDefault()
 		}
 		if c.Auth.JWT != nil {
 			summary["auth.jwt.issuers"] = len(c.Auth.JWT.Issuers)
@@ -156,6 +223,14 @@ func (c *OperatorConfig) LogSummary() map[string]any {
 	} else {
 		summary["auth"] = "disabled"
 	}
+	if w := c.Auth.AuthorizationWebhook; w != nil && w.Enabled {
+		summary["auth.authorizationWebhook.url"] = w.URL
+		summary["auth.authorizationWebhook.failurePolicy"] = w.FailurePolicy
+	}
+	if o := c.Auth.OIDC; o != nil && o.Enabled {
+		summary["auth.oidc.issuerURL"] = o.IssuerURL
+		summary["auth.oidc.clientID"] = o.ClientID
+	}
 
 	return summary
 }
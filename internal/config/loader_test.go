@@ -17,10 +17,15 @@ limitations under the License.
 package config
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/golgoth31/sreportal/internal/source/fqdntemplate"
 )
 
 func TestLoadFromFile(t *testing.T) {
@@ -185,3 +190,1336 @@ func TestLoadFromFile_ActualTestConfig(t *testing.T) {
 		t.Error("Sources.Service.Enabled is false in test_config.yaml")
 	}
 }
+
+func TestLoadFromFile_RemoteSync(t *testing.T) {
+	content := `
+remoteSync:
+  interval: 2m
+  jitter: 0.25
+  maxConcurrent: 4
+`
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write temp config: %v", err)
+	}
+
+	cfg, err := LoadFromFile(configPath)
+	if err != nil {
+		t.Fatalf("LoadFromFile failed: %v", err)
+	}
+
+	if cfg.RemoteSync.Interval.Duration() != 2*time.Minute {
+		t.Errorf("RemoteSync.Interval = %v, expected 2m", cfg.RemoteSync.Interval.Duration())
+	}
+	if cfg.RemoteSync.Jitter != 0.25 {
+		t.Errorf("RemoteSync.Jitter = %v, expected 0.25", cfg.RemoteSync.Jitter)
+	}
+	if cfg.RemoteSync.MaxConcurrent != 4 {
+		t.Errorf("RemoteSync.MaxConcurrent = %d, expected 4", cfg.RemoteSync.MaxConcurrent)
+	}
+}
+
+func TestLoadFromFile_RemoteSyncInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{"jitter above one", "remoteSync:\n  jitter: 1.5\n", ErrInvalidJitter},
+		{"negative concurrency", "remoteSync:\n  maxConcurrent: -1\n", ErrInvalidConcurrency},
+		{"negative interval", "remoteSync:\n  interval: -1m\n", ErrInvalidInterval},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			_, err := LoadFromFile(configPath)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_Security(t *testing.T) {
+	content := `
+security:
+  sensitivePatterns:
+    - admin.
+    - grafana.
+  hideSensitiveFromAnonymous: true
+`
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write temp config: %v", err)
+	}
+
+	cfg, err := LoadFromFile(configPath)
+	if err != nil {
+		t.Fatalf("LoadFromFile failed: %v", err)
+	}
+
+	if len(cfg.Security.SensitivePatterns) != 2 || cfg.Security.SensitivePatterns[1] != "grafana." {
+		t.Errorf("Security.SensitivePatterns = %v, expected [admin. grafana.]", cfg.Security.SensitivePatterns)
+	}
+	if !cfg.Security.HideSensitiveFromAnonymous {
+		t.Error("Security.HideSensitiveFromAnonymous is false, expected true")
+	}
+}
+
+func TestLoadFromFile_PortalDisableDNSAutoCreate(t *testing.T) {
+	content := `
+portal:
+  disableDNSAutoCreate: true
+`
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write temp config: %v", err)
+	}
+
+	cfg, err := LoadFromFile(configPath)
+	if err != nil {
+		t.Fatalf("LoadFromFile failed: %v", err)
+	}
+
+	if !cfg.Portal.DisableDNSAutoCreate {
+		t.Error("Portal.DisableDNSAutoCreate is false, expected true")
+	}
+}
+
+func TestLoadFromFile_PortalTemplates(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{"valid", "portal:\n  templates:\n  - name: team\n    features:\n      releases: false\n    links:\n    - title: Runbooks\n      url: https://runbooks.example.com\n    branding:\n      color: \"#336699\"\n", nil},
+		{"missing name", "portal:\n  templates:\n  - sourcePriority: [ingress]\n", ErrInvalidPortalTemplate},
+		{"duplicate name", "portal:\n  templates:\n  - name: team\n  - name: team\n", ErrInvalidPortalTemplate},
+		{"link without url", "portal:\n  templates:\n  - name: team\n    links:\n    - title: Runbooks\n", ErrInvalidPortalTemplate},
+		{"link with relative url", "portal:\n  templates:\n  - name: team\n    links:\n    - title: Runbooks\n      url: runbooks.example.com\n", ErrInvalidPortalTemplate},
+		{"link with javascript url", "portal:\n  templates:\n  - name: team\n    links:\n    - title: Runbooks\n      url: javascript:alert(1)\n", ErrInvalidPortalTemplate},
+		{"invalid label filter", "portal:\n  templates:\n  - name: team\n    sourceFilters:\n      labelFilter: \"a in (\"\n", ErrInvalidPortalTemplate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			tmpl, ok := cfg.Portal.Template("team")
+			if !ok {
+				t.Fatal("template \"team\" not found")
+			}
+			if tmpl.Features.Releases == nil || *tmpl.Features.Releases {
+				t.Errorf("Features.Releases = %v, expected false", tmpl.Features.Releases)
+			}
+			if len(tmpl.Links) != 1 || tmpl.Branding.Color != "#336699" {
+				t.Errorf("template = %+v", tmpl)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_DNSResolution(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		wantAddr string
+		wantErr  error
+	}{
+		{"host defaults to port 53", "dnsResolution:\n  externalResolver: 1.1.1.1\n", "1.1.1.1:53", nil},
+		{"host and port", "dnsResolution:\n  externalResolver: dns.example.com:5353\n", "dns.example.com:5353", nil},
+		{"unset", "", "", nil},
+		{"empty port", "dnsResolution:\n  externalResolver: 'dns.example.com:'\n", "", ErrInvalidResolverAddress},
+		{"DoH URL has no address", "dnsResolution:\n  externalResolver: https://dns.example.com/dns-query\n", "", nil},
+		{"DoH URL without host", "dnsResolution:\n  externalResolver: 'https://'\n", "", ErrInvalidResolverAddress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			if got := cfg.DNSResolution.ExternalResolverAddr(); got != tt.wantAddr {
+				t.Errorf("ExternalResolverAddr() = %q, expected %q", got, tt.wantAddr)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_DNSResolutionResolver(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    ResolverConfig
+		wantErr error
+	}{
+		{"default", "", ResolverConfig{}, nil},
+		{"system", "dnsResolution:\n  resolver:\n    type: system\n", ResolverConfig{Type: ResolverTypeSystem}, nil},
+		{"dns servers", "dnsResolution:\n  resolver:\n    type: dns\n    servers: [10.0.0.53, 'dns.example.com:5353']\n",
+			ResolverConfig{Type: ResolverTypeDNS, Servers: []string{"10.0.0.53", "dns.example.com:5353"}}, nil},
+		{"dns without servers", "dnsResolution:\n  resolver:\n    type: dns\n", ResolverConfig{}, ErrInvalidResolverAddress},
+		{"doh", "dnsResolution:\n  resolver:\n    type: doh\n    url: https://dns.example.com/dns-query\n    timeout: 3s\n",
+			ResolverConfig{Type: ResolverTypeDoH, URL: "https://dns.example.com/dns-query", Timeout: Duration(3 * time.Second)}, nil},
+		{"doh without url", "dnsResolution:\n  resolver:\n    type: doh\n", ResolverConfig{}, ErrInvalidResolverAddress},
+		{"negative timeout", "dnsResolution:\n  resolver:\n    type: doh\n    url: https://dns.example.com/dns-query\n    timeout: -1s\n",
+			ResolverConfig{}, ErrInvalidInterval},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			if !reflect.DeepEqual(cfg.DNSResolution.Resolver, tt.want) {
+				t.Errorf("DNSResolution.Resolver = %+v, expected %+v", cfg.DNSResolution.Resolver, tt.want)
+			}
+		})
+	}
+}
+
+func TestDNSResolutionConfig_ExternalResolverConfig(t *testing.T) {
+	tests := []struct {
+		external string
+		want     ResolverConfig
+	}{
+		{"", ResolverConfig{}},
+		{"1.1.1.1", ResolverConfig{Type: ResolverTypeDNS, Servers: []string{"1.1.1.1"}}},
+		{"https://dns.example.com/dns-query", ResolverConfig{Type: ResolverTypeDoH, URL: "https://dns.example.com/dns-query"}},
+	}
+
+	for _, tt := range tests {
+		got := DNSResolutionConfig{ExternalResolver: tt.external}.ExternalResolverConfig()
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ExternalResolverConfig(%q) = %+v, expected %+v", tt.external, got, tt.want)
+		}
+	}
+}
+
+func TestLoadFromFile_WebCORS(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{"origins with credentials", "web:\n  cors:\n    allowedOrigins: [https://ui.example.com]\n    allowCredentials: true\n    maxAge: 10m\n", nil},
+		{"wildcard without credentials", "web:\n  cors:\n    allowedOrigins: ['*']\n", nil},
+		{"wildcard with credentials", "web:\n  cors:\n    allowedOrigins: ['*']\n    allowCredentials: true\n", ErrInvalidCORS},
+		{"origin without scheme", "web:\n  cors:\n    allowedOrigins: [ui.example.com]\n", ErrInvalidCORS},
+		{"origin with path", "web:\n  cors:\n    allowedOrigins: [https://ui.example.com/app]\n", ErrInvalidCORS},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			_, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_WebSecurityHeaders(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{"defaults", "", nil},
+		{"locked down", "web:\n  securityHeaders:\n    contentSecurityPolicy: \"default-src 'self'\"\n    frameOptions: DENY\n    referrerPolicy: no-referrer\n    hsts:\n      maxAge: 8760h\n      includeSubDomains: true\n      preload: true\n", nil},
+		{"header disabled", "web:\n  securityHeaders:\n    frameOptions: \"\"\n", nil},
+		{"unknown frame option", "web:\n  securityHeaders:\n    frameOptions: ALLOW-FROM https://example.com\n", ErrInvalidSecurityHeader},
+		{"unknown referrer policy", "web:\n  securityHeaders:\n    referrerPolicy: nope\n", ErrInvalidSecurityHeader},
+		{"preload without subdomains", "web:\n  securityHeaders:\n    hsts:\n      maxAge: 8760h\n      preload: true\n", ErrInvalidSecurityHeader},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			_, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestDefaultConfig_WebSecurityHeaders(t *testing.T) {
+	cfg := DefaultConfig()
+
+	if cfg.Web.SecurityHeaders.FrameOptions != "SAMEORIGIN" {
+		t.Errorf("FrameOptions = %q, expected SAMEORIGIN", cfg.Web.SecurityHeaders.FrameOptions)
+	}
+	if cfg.Web.SecurityHeaders.ReferrerPolicy != "strict-origin-when-cross-origin" {
+		t.Errorf("ReferrerPolicy = %q, expected strict-origin-when-cross-origin", cfg.Web.SecurityHeaders.ReferrerPolicy)
+	}
+	if cfg.Web.SecurityHeaders.HSTS.MaxAge != 0 {
+		t.Errorf("HSTS.MaxAge = %v, expected 0", cfg.Web.SecurityHeaders.HSTS.MaxAge)
+	}
+}
+
+func TestLoadFromFile_WebGroupSeparator(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"default", "", "/"},
+		{"other web settings keep the default", "web:\n  cors:\n    allowedOrigins: [https://ui.example.com]\n", "/"},
+		{"custom", "web:\n  groupSeparator: \"::\"\n", "::"},
+		{"flat", "web:\n  groupSeparator: \"\"\n", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			if cfg.Web.GroupSeparator != tt.want {
+				t.Errorf("Web.GroupSeparator = %q, expected %q", cfg.Web.GroupSeparator, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_FQDNTemplate(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"valid", "sources:\n  service:\n    enabled: true\n    fqdnTemplate: \"{{.Name}}.{{.Namespace}}.example.com\"\n", ""},
+		{"parse error", "sources:\n  ingress:\n    enabled: true\n    fqdnTemplate: \"{{.Name\"\n", "sources.ingress.fqdnTemplate"},
+		{"label template", "sources:\n  gatewayHTTPRoute:\n    enabled: true\n    fqdnTemplate: \"{{.Labels.team}}.example.com\"\n", ""},
+		{"garbage hostname", "sources:\n  gatewayHTTPRoute:\n    enabled: true\n    fqdnTemplate: \"{{.Name}}_{{.Labels.team}}.example.com\"\n", "sources.gatewayHTTPRoute.fqdnTemplate"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			_, err := LoadFromFile(configPath)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("LoadFromFile failed: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, fqdntemplate.ErrInvalidTemplate) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("LoadFromFile error = %v, expected %s: %v", err, tt.wantErr, fqdntemplate.ErrInvalidTemplate)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_GroupLabelKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    int
+		wantErr error
+	}{
+		{"none", "", 0, nil},
+		{"ordered keys", "groupMapping:\n  defaultGroup: Services\n  labelKeys:\n    - key: team\n      stripPrefix: team-\n      titleCase: true\n    - key: app.kubernetes.io/part-of\n", 2, nil},
+		{"empty key", "groupMapping:\n  defaultGroup: Services\n  labelKeys:\n    - stripPrefix: team-\n", 0, ErrInvalidGroupLabelKey},
+		{"invalid key", "groupMapping:\n  defaultGroup: Services\n  labelKeys:\n    - key: \"not a label\"\n", 0, ErrInvalidGroupLabelKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			if len(cfg.GroupMapping.LabelKeys) != tt.want {
+				t.Errorf("len(GroupMapping.LabelKeys) = %d, expected %d", len(cfg.GroupMapping.LabelKeys), tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_WebStreams(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    time.Duration
+		wantErr error
+	}{
+		{"default", "", 30 * time.Second, nil},
+		{"custom", "web:\n  streams:\n    sendTimeout: 2m\n", 2 * time.Minute, nil},
+		{"disabled", "web:\n  streams:\n    sendTimeout: 0s\n", 0, nil},
+		{"negative", "web:\n  streams:\n    sendTimeout: -1s\n", 0, ErrInvalidWebStreams},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			if cfg.Web.Streams.SendTimeout.Duration() != tt.want {
+				t.Errorf("Web.Streams.SendTimeout = %v, expected %v", cfg.Web.Streams.SendTimeout.Duration(), tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_WebStreamsHeartbeat(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    time.Duration
+		wantErr error
+	}{
+		{"default", "", 30 * time.Second, nil},
+		{"custom", "web:\n  streams:\n    heartbeatInterval: 10s\n", 10 * time.Second, nil},
+		{"disabled", "web:\n  streams:\n    heartbeatInterval: 0s\n", 0, nil},
+		{"negative", "web:\n  streams:\n    heartbeatInterval: -1s\n", 0, ErrInvalidWebStreams},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			if cfg.Web.Streams.HeartbeatInterval.Duration() != tt.want {
+				t.Errorf("Web.Streams.HeartbeatInterval = %v, expected %v", cfg.Web.Streams.HeartbeatInterval.Duration(), tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_Consistency(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    time.Duration
+		wantErr error
+	}{
+		{"default", "", 10 * time.Minute, nil},
+		{"custom", "consistency:\n  interval: 30m\n", 30 * time.Minute, nil},
+		{"disabled", "consistency:\n  interval: 0s\n", 0, nil},
+		{"negative", "consistency:\n  interval: -1m\n", 0, ErrInvalidInterval},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			if cfg.Consistency.Interval.Duration() != tt.want {
+				t.Errorf("Consistency.Interval = %v, expected %v", cfg.Consistency.Interval.Duration(), tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_Probes(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{"default", "", nil},
+		{"zero interval", "probes:\n  interval: 0s\n", ErrInvalidInterval},
+		{"zero timeout", "probes:\n  timeout: 0s\n", ErrInvalidTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			_, err := LoadFromFile(configPath)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_Uniqueness(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    time.Duration
+		wantErr error
+	}{
+		{"default", "", time.Hour, nil},
+		{"custom", "uniqueness:\n  interval: 15m\n", 15 * time.Minute, nil},
+		{"disabled", "uniqueness:\n  interval: 0s\n", 0, nil},
+		{"negative", "uniqueness:\n  interval: -1m\n", 0, ErrInvalidInterval},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			if cfg.Uniqueness.Interval.Duration() != tt.want {
+				t.Errorf("Uniqueness.Interval = %v, expected %v", cfg.Uniqueness.Interval.Duration(), tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_FaultInjection(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    map[string]SourceFaultConfig
+		wantErr error
+	}{
+		{"default", "", nil, nil},
+		{
+			"valid",
+			"faultInjection:\n  sources:\n    ingress:\n      failures: 1\n      outOf: 3\n",
+			map[string]SourceFaultConfig{"ingress": {Failures: 1, OutOf: 3}},
+			nil,
+		},
+		{"zero outOf", "faultInjection:\n  sources:\n    ingress:\n      failures: 0\n      outOf: 0\n", nil, ErrInvalidFaultInjection},
+		{"failures above outOf", "faultInjection:\n  sources:\n    ingress:\n      failures: 4\n      outOf: 3\n", nil, ErrInvalidFaultInjection},
+		{"negative failures", "faultInjection:\n  sources:\n    ingress:\n      failures: -1\n      outOf: 3\n", nil, ErrInvalidFaultInjection},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			if !reflect.DeepEqual(cfg.FaultInjection.Sources, tt.want) {
+				t.Errorf("FaultInjection.Sources = %v, expected %v", cfg.FaultInjection.Sources, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_MCPSessions(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    MCPSessionsConfig
+		wantErr error
+	}{
+		{"default", "", MCPSessionsConfig{MaxSessions: 100, IdleTimeout: Duration(30 * time.Minute)}, nil},
+		{"partial keeps the other default", "mcp:\n  sessions:\n    maxSessions: 10\n",
+			MCPSessionsConfig{MaxSessions: 10, IdleTimeout: Duration(30 * time.Minute)}, nil},
+		{"unlimited", "mcp:\n  sessions:\n    maxSessions: 0\n    idleTimeout: 0s\n", MCPSessionsConfig{}, nil},
+		{"negative max", "mcp:\n  sessions:\n    maxSessions: -1\n", MCPSessionsConfig{}, ErrInvalidMCPSessions},
+		{"negative timeout", "mcp:\n  sessions:\n    idleTimeout: -1m\n", MCPSessionsConfig{}, ErrInvalidMCPSessions},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			if cfg.MCP.Sessions != tt.want {
+				t.Errorf("MCP.Sessions = %+v, expected %+v", cfg.MCP.Sessions, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_ScaleGuard(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    ScaleGuardConfig
+		wantErr error
+	}{
+		{"default", "", ScaleGuardConfig{}, nil},
+		{
+			"limits",
+			"scaleGuard:\n  interval: 30s\n  fqdns:\n    soft: 40000\n    hard: 50000\n  streams:\n    hard: 200\n",
+			ScaleGuardConfig{
+				Interval: Duration(30 * time.Second),
+				FQDNs:    ScaleLimit{Soft: 40000, Hard: 50000},
+				Streams:  ScaleLimit{Hard: 200},
+			},
+			nil,
+		},
+		{"soft above hard", "scaleGuard:\n  dnsRecords:\n    soft: 10\n    hard: 5\n", ScaleGuardConfig{}, ErrInvalidScaleGuard},
+		{"negative limit", "scaleGuard:\n  dns:\n    hard: -1\n", ScaleGuardConfig{}, ErrInvalidScaleGuard},
+		{"negative interval", "scaleGuard:\n  interval: -1s\n", ScaleGuardConfig{}, ErrInvalidInterval},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			if cfg.ScaleGuard != tt.want {
+				t.Errorf("ScaleGuard = %+v, expected %+v", cfg.ScaleGuard, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_Quota(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    QuotaConfig
+		wantErr error
+	}{
+		{"default", "", QuotaConfig{}, nil},
+		{
+			"limits",
+			"quota:\n  maxManualGroups: 20\n  maxManualEntries: 500\n  maxPortalsPerNamespace: 5\n",
+			QuotaConfig{MaxManualGroups: 20, MaxManualEntries: 500, MaxPortalsPerNamespace: 5},
+			nil,
+		},
+		{"negative limit", "quota:\n  maxManualEntries: -1\n", QuotaConfig{}, ErrInvalidQuota},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			if cfg.Quota != tt.want {
+				t.Errorf("Quota = %+v, expected %+v", cfg.Quota, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_SnapshotPublisher(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    SnapshotPublisherConfig
+		wantErr error
+	}{
+		{"default", "", SnapshotPublisherConfig{Tag: "latest", Interval: Duration(5 * time.Minute)}, nil},
+		{"enabled", "snapshotPublisher:\n  enabled: true\n  repository: registry.example.com/sreportal/site-a\n  portal: main\n  credentialsSecret: registry-creds\n",
+			SnapshotPublisherConfig{Enabled: true, Repository: "registry.example.com/sreportal/site-a", Tag: "latest",
+				Portal: "main", Interval: Duration(5 * time.Minute), CredentialsSecret: "registry-creds"}, nil},
+		{"disabled without repository", "snapshotPublisher:\n  tag: v1\n",
+			SnapshotPublisherConfig{Tag: "v1", Interval: Duration(5 * time.Minute)}, nil},
+		{"enabled without repository", "snapshotPublisher:\n  enabled: true\n", SnapshotPublisherConfig{}, ErrInvalidSnapshotPublisher},
+		{"empty tag", "snapshotPublisher:\n  enabled: true\n  repository: r.example.com/s\n  tag: \"\"\n",
+			SnapshotPublisherConfig{}, ErrInvalidSnapshotPublisher},
+		{"zero interval", "snapshotPublisher:\n  enabled: true\n  repository: r.example.com/s\n  interval: 0s\n",
+			SnapshotPublisherConfig{}, ErrInvalidInterval},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			if cfg.SnapshotPublisher != tt.want {
+				t.Errorf("SnapshotPublisher = %+v, expected %+v", cfg.SnapshotPublisher, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_AutoPortal(t *testing.T) {
+	defaults := AutoPortalConfig{Selector: "sreportal.io/auto-portal=true", TitleAnnotation: "sreportal.io/portal-title"}
+	tests := []struct {
+		name    string
+		content string
+		want    AutoPortalConfig
+		wantErr error
+	}{
+		{"default", "", defaults, nil},
+		{"enabled", "autoPortal:\n  enabled: true\n", AutoPortalConfig{Enabled: true,
+			Selector: defaults.Selector, TitleAnnotation: defaults.TitleAnnotation}, nil},
+		{"custom selector", "autoPortal:\n  enabled: true\n  selector: team in (a,b)\n  titleAnnotation: example.com/title\n",
+			AutoPortalConfig{Enabled: true, Selector: "team in (a,b)", TitleAnnotation: "example.com/title"}, nil},
+		{"disabled with empty selector", "autoPortal:\n  selector: \"\"\n",
+			AutoPortalConfig{TitleAnnotation: defaults.TitleAnnotation}, nil},
+		{"empty selector", "autoPortal:\n  enabled: true\n  selector: \"\"\n", AutoPortalConfig{}, ErrInvalidAutoPortal},
+		{"malformed selector", "autoPortal:\n  enabled: true\n  selector: \"a b\"\n", AutoPortalConfig{}, ErrInvalidAutoPortal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			if cfg.AutoPortal != tt.want {
+				t.Errorf("AutoPortal = %+v, expected %+v", cfg.AutoPortal, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_PortalInsecureSkipVerifyDenySelector(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+		wantErr error
+	}{
+		{"default", "", "environment=production", nil},
+		{"custom selector", "portal:\n  insecureSkipVerifyDenySelector: tier in (prod,critical)\n", "tier in (prod,critical)", nil},
+		{"disabled", "portal:\n  insecureSkipVerifyDenySelector: \"\"\n", "", nil},
+		{"malformed selector", "portal:\n  insecureSkipVerifyDenySelector: \"a b\"\n", "", ErrInvalidPortalSelector},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			if got := cfg.Portal.InsecureSkipVerifyDenySelector; got != tt.want {
+				t.Errorf("InsecureSkipVerifyDenySelector = %q, expected %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_CMDB(t *testing.T) {
+	const enabled = "cmdb:\n  enabled: true\n  serviceNow:\n    url: https://example.service-now.com\n    credentialsSecret: servicenow\n"
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{"default", "", nil},
+		{"enabled", enabled, nil},
+		{"unknown exporter", enabled + "  exporter: jira\n", ErrInvalidCMDB},
+		{"missing url", "cmdb:\n  enabled: true\n  serviceNow:\n    credentialsSecret: servicenow\n", ErrInvalidCMDB},
+		{"relative url", "cmdb:\n  enabled: true\n  serviceNow:\n    url: example.service-now.com\n    credentialsSecret: servicenow\n", ErrInvalidCMDB},
+		{"missing credentials", "cmdb:\n  enabled: true\n  serviceNow:\n    url: https://example.service-now.com\n", ErrInvalidCMDB},
+		{"zero interval", enabled + "  interval: 0s\n", ErrInvalidInterval},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			if cfg.CMDB.Exporter != CMDBExporterServiceNow {
+				t.Errorf("CMDB.Exporter = %q, expected %q", cfg.CMDB.Exporter, CMDBExporterServiceNow)
+			}
+			if cfg.CMDB.ServiceNow.Table != "cmdb_ci_dns_name" {
+				t.Errorf("CMDB.ServiceNow.Table = %q, expected cmdb_ci_dns_name", cfg.CMDB.ServiceNow.Table)
+			}
+			if got := cfg.CMDB.AnnotationKeys(); len(got) != 2 || got[0] != "sreportal.io/owner" || got[1] != "sreportal.io/tier" {
+				t.Errorf("CMDB.AnnotationKeys() = %v, expected the owner and tier defaults", got)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_Digest(t *testing.T) {
+	const slack = "digest:\n  enabled: true\n  notifiers:\n    - name: sre\n      type: slack\n      slack:\n        webhookSecret: digest-slack\n"
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{"default", "", nil},
+		{"enabled", slack + "  portals:\n    - portal: main\n      notifiers: [sre]\n", nil},
+		{"email", "digest:\n  enabled: true\n  notifiers:\n    - name: mail\n      type: email\n      email:\n        host: smtp.example.com\n        from: sreportal@example.com\n        to: [sre@example.com]\n", nil},
+		{"unknown notifier type", "digest:\n  enabled: true\n  notifiers:\n    - name: sre\n      type: teams\n", ErrInvalidDigest},
+		{"missing webhook secret", "digest:\n  enabled: true\n  notifiers:\n    - name: sre\n      type: slack\n", ErrInvalidDigest},
+		{"email without recipients", "digest:\n  enabled: true\n  notifiers:\n    - name: mail\n      type: email\n      email:\n        host: smtp.example.com\n        from: sreportal@example.com\n", ErrInvalidDigest},
+		{"duplicate notifier", slack + "    - name: sre\n      type: slack\n      slack:\n        webhookSecret: other\n", ErrInvalidDigest},
+		{"unknown portal notifier", slack + "  portals:\n    - portal: main\n      notifiers: [ops]\n", ErrInvalidDigest},
+		{"portal without notifier", slack + "  portals:\n    - portal: main\n", ErrInvalidDigest},
+		{"duplicate portal", slack + "  portals:\n    - portal: main\n      notifiers: [sre]\n    - portal: main\n      notifiers: [sre]\n", ErrInvalidDigest},
+		{"zero certificate timeout", slack + "  certificates:\n    enabled: true\n    timeout: 0s\n", ErrInvalidTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			if cfg.Digest.MaxItems != 50 {
+				t.Errorf("Digest.MaxItems = %d, expected 50", cfg.Digest.MaxItems)
+			}
+			if cfg.Digest.Certificates.WarnWithin.Duration() != 21*24*time.Hour {
+				t.Errorf("Digest.Certificates.WarnWithin = %v, expected 504h", cfg.Digest.Certificates.WarnWithin.Duration())
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_DNSPipeline(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{"default", "", nil},
+		{"disabled steps", "dnsPipeline:\n  disabled: [externalNameServices, resolution]\n", nil},
+		{"ordered handlers", "dnsPipeline:\n  handlers: [lookupSources, intraDNSDedup, upsertDNSRecords]\n", nil},
+		{"duplicate handler", "dnsPipeline:\n  handlers: [lookupSources, lookupSources]\n", ErrInvalidDNSPipeline},
+		{"empty disabled name", "dnsPipeline:\n  disabled: [\"\"]\n", ErrInvalidDNSPipeline},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			_, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_Agent(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		wantErr       error
+		wantAgentMode error
+	}{
+		{"default", "", nil, ErrInvalidAgent},
+		{"agent", "agent:\n  name: edge-1\n  portal: main\n  centralURL: https://sreportal.example.com\n", nil, nil},
+		{"invalid name", "agent:\n  name: Edge_1\n  portal: main\n  centralURL: https://sreportal.example.com\n", nil, ErrInvalidAgent},
+		{"invalid url", "agent:\n  name: edge-1\n  portal: main\n  centralURL: sreportal.example.com\n", nil, ErrInvalidAgent},
+		{"ingest", "agent:\n  ingest:\n    enabled: true\n    ttl: 5m\n    agents:\n      - name: edge-1\n        portal: main\n", nil, ErrInvalidAgent},
+		{"ingest without agents", "agent:\n  ingest:\n    enabled: true\n", ErrInvalidAgent, nil},
+		{"ingest duplicate agent", "agent:\n  ingest:\n    enabled: true\n    agents:\n      - name: edge-1\n        portal: main\n      - name: edge-1\n        portal: eu\n", ErrInvalidAgent, nil},
+		{"ingest duplicate identity", "agent:\n  ingest:\n    enabled: true\n    agents:\n      - name: edge-1\n        portal: main\n      - name: edge-2\n        portal: eu\n        identity: token:edge-1\n", ErrInvalidAgent, nil},
+		{"ingest agent without portal", "agent:\n  ingest:\n    enabled: true\n    agents:\n      - name: edge-1\n", ErrInvalidAgent, nil},
+		{"zero interval", "agent:\n  interval: 0s\n", ErrInvalidInterval, nil},
+		{"zero ingest ttl", "agent:\n  ingest:\n    enabled: true\n    ttl: 0s\n", ErrInvalidInterval, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			if err := cfg.Agent.ValidateAgentMode(); !errors.Is(err, tt.wantAgentMode) {
+				t.Errorf("ValidateAgentMode error = %v, expected %v", err, tt.wantAgentMode)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_TombstoneRetention(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    time.Duration
+		wantErr error
+	}{
+		{"default", "", 24 * time.Hour, nil},
+		{"custom", "dnsRecord:\n  tombstoneRetention: 2h\n", 2 * time.Hour, nil},
+		{"disabled", "dnsRecord:\n  tombstoneRetention: 0s\n", 0, nil},
+		{"negative", "dnsRecord:\n  tombstoneRetention: -1h\n", 0, ErrInvalidInterval},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			if cfg.DNSRecord.TombstoneRetention.Duration() != tt.want {
+				t.Errorf("DNSRecord.TombstoneRetention = %v, expected %v", cfg.DNSRecord.TombstoneRetention.Duration(), tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_AuthorizationWebhook(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{"default", "", nil},
+		{"enabled", "auth:\n  authorizationWebhook:\n    enabled: true\n    url: https://opa.example.com/v1/data/sreportal/allow\n    cacheTTL: 30s\n    failurePolicy: Allow\n", nil},
+		{"invalid url", "auth:\n  authorizationWebhook:\n    enabled: true\n    url: opa.example.com\n", ErrInvalidAuthorizationWebhook},
+		{"invalid failure policy", "auth:\n  authorizationWebhook:\n    enabled: true\n    url: https://opa.example.com\n    failurePolicy: Ignore\n", ErrInvalidAuthorizationWebhook},
+		{"disabled invalid url", "auth:\n  authorizationWebhook:\n    url: opa.example.com\n", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			_, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_OIDC(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{"enabled", "auth:\n  oidc:\n    enabled: true\n    issuerURL: https://idp.example.com\n    clientID: sreportal\n    redirectURL: https://portal.example.com/auth/callback\n", nil},
+		{"redirect from public URL", "web:\n  publicURL: https://portal.example.com\nauth:\n  oidc:\n    enabled: true\n    issuerURL: https://idp.example.com\n    clientID: sreportal\n", nil},
+		{"no redirect URL", "auth:\n  oidc:\n    enabled: true\n    issuerURL: https://idp.example.com\n    clientID: sreportal\n", ErrInvalidOIDC},
+		{"invalid issuer", "auth:\n  oidc:\n    enabled: true\n    issuerURL: idp.example.com\n    clientID: sreportal\n    redirectURL: https://portal.example.com/auth/callback\n", ErrInvalidOIDC},
+		{"missing client ID", "auth:\n  oidc:\n    enabled: true\n    issuerURL: https://idp.example.com\n    redirectURL: https://portal.example.com/auth/callback\n", ErrInvalidOIDC},
+		{"disabled incomplete", "auth:\n  oidc:\n    issuerURL: idp.example.com\n", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			_, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_APITokens(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{"enabled", "auth:\n  apiTokens:\n    enabled: true\n    secretName: sreportal-api-tokens\n    reloadInterval: 1m\n", nil},
+		{"missing secret", "auth:\n  apiTokens:\n    enabled: true\n", ErrInvalidAPITokens},
+		{"negative interval", "auth:\n  apiTokens:\n    enabled: true\n    secretName: tokens\n    reloadInterval: -1s\n", ErrInvalidAPITokens},
+		{"disabled incomplete", "auth:\n  apiTokens:\n    requireForReads: true\n", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			if cfg.Auth.RequireForReads() {
+				t.Errorf("RequireForReads() = true, expected false")
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_WebRPC(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    time.Duration
+		wantErr error
+	}{
+		{"default", "", 30 * time.Second, nil},
+		{"override", "web:\n  rpc:\n    timeout: 10s\n    timeouts:\n      /sreportal.v1.DNSService/ListFQDNs: 1m\n", time.Minute, nil},
+		{"negative", "web:\n  rpc:\n    timeout: -1s\n", 0, ErrInvalidWebRPC},
+		{"malformed procedure", "web:\n  rpc:\n    timeouts:\n      ListFQDNs: 1m\n", 0, ErrInvalidWebRPC},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			if got := cfg.Web.RPC.TimeoutFor("/sreportal.v1.DNSService/ListFQDNs"); got != tt.want {
+				t.Errorf("Web.RPC.TimeoutFor(ListFQDNs) = %v, expected %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_Analytics(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		wantEnabled bool
+		wantMaxKeys int
+		wantErr     error
+	}{
+		{"default", "", false, 1000, nil},
+		{"enabled", "analytics:\n  enabled: true\n  maxKeys: 50\n", true, 50, nil},
+		{"negative maxKeys", "analytics:\n  enabled: true\n  maxKeys: -1\n", false, 0, ErrInvalidAnalytics},
+		{"negative maxClientEvents", "analytics:\n  enabled: true\n  maxClientEvents: -1\n", false, 0, ErrInvalidAnalytics},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			if cfg.Analytics.Enabled != tt.wantEnabled || cfg.Analytics.MaxKeys != tt.wantMaxKeys {
+				t.Errorf("Analytics = %+v, expected enabled=%v maxKeys=%d", cfg.Analytics, tt.wantEnabled, tt.wantMaxKeys)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile_WebPublicURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+		wantErr error
+	}{
+		{"default", "", "", nil},
+		{"https", "web:\n  publicURL: https://sreportal.example.com/portal\n", "https://sreportal.example.com/portal", nil},
+		{"relative", "web:\n  publicURL: /portal\n", "", ErrInvalidPublicURL},
+		{"query", "web:\n  publicURL: https://sreportal.example.com/?a=b\n", "", ErrInvalidPublicURL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(configPath, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write temp config: %v", err)
+			}
+
+			cfg, err := LoadFromFile(configPath)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadFromFile error = %v, expected %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadFromFile failed: %v", err)
+			}
+			if cfg.Web.PublicURL != tt.want {
+				t.Errorf("Web.PublicURL = %q, expected %q", cfg.Web.PublicURL, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_RejectsRecordNameTemplateWithoutSourceType(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.DNSRecord.NameTemplate = "{{ .DNS }}"
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected a name template ignoring .SourceType to be rejected")
+	}
+}
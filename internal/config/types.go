@@ -19,7 +19,17 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
 	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	"github.com/golgoth31/sreportal/internal/source/fqdntemplate"
 )
 
 // Duration is a wrapper around time.Duration that supports YAML/JSON unmarshaling from strings.
@@ -60,15 +70,70 @@ type OperatorConfig struct {
 	Sources        SourcesConfig        `json:"sources" yaml:"sources"`
 	GroupMapping   GroupMappingConfig   `json:"groupMapping" yaml:"groupMapping"`
 	Reconciliation ReconciliationConfig `json:"reconciliation" yaml:"reconciliation"`
+	RemoteSync     RemoteSyncConfig     `json:"remoteSync,omitempty" yaml:"remoteSync,omitempty"`
+	Portal         PortalConfig         `json:"portal,omitempty" yaml:"portal,omitempty"`
+	DNSRecord      DNSRecordConfig      `json:"dnsRecord,omitempty" yaml:"dnsRecord,omitempty"`
+	DNSResolution  DNSResolutionConfig  `json:"dnsResolution,omitempty" yaml:"dnsResolution,omitempty"`
+	Probes         ProbesConfig         `json:"probes,omitempty" yaml:"probes,omitempty"`
+	Consistency    ConsistencyConfig    `json:"consistency,omitempty" yaml:"consistency,omitempty"`
+	Uniqueness     UniquenessConfig     `json:"uniqueness,omitempty" yaml:"uniqueness,omitempty"`
+	DNSPipeline    DNSPipelineConfig    `json:"dnsPipeline,omitempty" yaml:"dnsPipeline,omitempty"`
+	// ScaleGuard bounds the number of DNS CRs, DNSRecords, FQDNs and FQDN
+	// streams served by one operator pod.
+	ScaleGuard ScaleGuardConfig `json:"scaleGuard,omitempty" yaml:"scaleGuard,omitempty"`
+	// Quota bounds the manual groups and entries and the portals a tenant
+	// can create, enforced by the validating webhooks.
+	Quota QuotaConfig `json:"quota,omitempty" yaml:"quota,omitempty"`
+	// ExternalDNSImport pre-populates portals from existing external-dns data.
+	ExternalDNSImport ExternalDNSImportConfig `json:"externalDNSImport,omitempty" yaml:"externalDNSImport,omitempty"`
+	// DNSEndpointPublisher renders manual DNS entries into external-dns
+	// DNSEndpoint CRs, so external-dns provisions them.
+	DNSEndpointPublisher DNSEndpointPublisherConfig `json:"dnsEndpointPublisher,omitempty" yaml:"dnsEndpointPublisher,omitempty"`
+	// SnapshotPublisher pushes the FQDN snapshot to an OCI registry, for
+	// remote portals that cannot reach this instance.
+	SnapshotPublisher SnapshotPublisherConfig `json:"snapshotPublisher,omitempty" yaml:"snapshotPublisher,omitempty"`
+	// AutoPortal creates a Portal for each namespace selected by a label.
+	AutoPortal AutoPortalConfig `json:"autoPortal,omitempty" yaml:"autoPortal,omitempty"`
+	// CMDB periodically pushes the FQDN inventory to a configuration
+	// management database.
+	CMDB CMDBConfig `json:"cmdb,omitempty" yaml:"cmdb,omitempty"`
+	// Digest sends a scheduled digest of each selected portal through
+	// notifiers (Slack, email).
+	Digest DigestConfig `json:"digest,omitempty" yaml:"digest,omitempty"`
+	// Agent configures the agent mode (--mode=agent), which pushes the FQDNs
+	// discovered in this cluster to a central instance, and the ingestion of
+	// the FQDNs agents push.
+	Agent AgentConfig `json:"agent,omitempty" yaml:"agent,omitempty"`
+	// FaultInjection makes selected sources fail on purpose, to rehearse
+	// partial discovery outages.
+	FaultInjection FaultInjectionConfig `json:"faultInjection,omitempty" yaml:"faultInjection,omitempty"`
 	Release        ReleaseConfig        `json:"release,omitempty" yaml:"release,omitempty"`
 	Auth           AuthConfig           `json:"auth,omitempty" yaml:"auth,omitempty"`
-	Emoji          *EmojiConfig         `json:"emoji,omitempty" yaml:"emoji,omitempty"`
+	Security       SecurityConfig       `json:"security,omitempty" yaml:"security,omitempty"`
+	Web            WebConfig            `json:"web,omitempty" yaml:"web,omitempty"`
+	MCP            MCPConfig            `json:"mcp,omitempty" yaml:"mcp,omitempty"`
+	// Analytics counts portal page views, searches and group clicks sent by
+	// the web UI. Disabled by default.
+	Analytics AnalyticsConfig `json:"analytics,omitempty" yaml:"analytics,omitempty"`
+	Emoji     *EmojiConfig    `json:"emoji,omitempty" yaml:"emoji,omitempty"`
 }
 
 // AuthConfig configures authentication for write endpoints.
 type AuthConfig struct {
 	APIKey *APIKeyAuthConfig `json:"apiKey,omitempty" yaml:"apiKey,omitempty"`
 	JWT    *JWTAuthConfig    `json:"jwt,omitempty" yaml:"jwt,omitempty"`
+	// APITokens authenticates bearer tokens listed in a Secret, re-read
+	// periodically so that tokens are added and revoked without a restart.
+	APITokens *APITokensAuthConfig `json:"apiTokens,omitempty" yaml:"apiTokens,omitempty"`
+	// AuthorizationWebhook delegates the decision to let a user run a verb
+	// on a portal to an external policy engine (OPA, custom service).
+	AuthorizationWebhook *AuthorizationWebhookConfig `json:"authorizationWebhook,omitempty" yaml:"authorizationWebhook,omitempty"`
+	// OIDC lets users log in to the web UI, and restricts the portals they
+	// see to the ones allowing one of their groups (Portal spec.allowedGroups).
+	OIDC *OIDCAuthConfig `json:"oidc,omitempty" yaml:"oidc,omitempty"`
+	// Admins are the authenticated identities with the admin role, the only
+	// ones allowed to stream the operator logs.
+	Admins []string `json:"admins,omitempty" yaml:"admins,omitempty"`
 }
 
 // Enabled returns true if at least one authentication method is enabled.
@@ -79,9 +144,18 @@ func (c *AuthConfig) Enabled() bool {
 	if c.JWT != nil && c.JWT.Enabled {
 		return true
 	}
+	if c.APITokens != nil && c.APITokens.Enabled {
+		return true
+	}
 	return false
 }
 
+// RequireForReads reports whether every DNSService and PortalService call,
+// reads included, must be authenticated.
+func (c *AuthConfig) RequireForReads() bool {
+	return c.APITokens != nil && c.APITokens.Enabled && c.APITokens.RequireForReads
+}
+
 // APIKeyAuthConfig configures header-based API key authentication.
 // The actual key value is read from the HEADER_API_KEY environment variable.
 type APIKeyAuthConfig struct {
@@ -89,6 +163,39 @@ type APIKeyAuthConfig struct {
 	Enabled bool `json:"enabled" yaml:"enabled"`
 	// HeaderName is the HTTP header to check (default: "X-API-Key").
 	HeaderName string `json:"headerName,omitempty" yaml:"headerName,omitempty"`
+	// Name names the key in the identity of its callers, "apikey:<name>"
+	// (default: "default").
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+// NameOrDefault returns Name, or "default" when unset.
+func (c *APIKeyAuthConfig) NameOrDefault() string {
+	if c.Name != "" {
+		return c.Name
+	}
+	return "default"
+}
+
+// DefaultAPITokensReloadInterval is the default time between two reads of
+// the API tokens Secret.
+const DefaultAPITokensReloadInterval = 30 * time.Second
+
+// APITokensAuthConfig configures bearer API token authentication. Each key of
+// the Secret names a token and its value is the token, sent as
+// "Authorization: Bearer <token>".
+type APITokensAuthConfig struct {
+	// Enabled controls whether API token authentication is active.
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// SecretName names the Secret of the operator namespace holding the
+	// tokens.
+	SecretName string `json:"secretName" yaml:"secretName"`
+	// ReloadInterval is the time between two reads of the Secret (default
+	// 30s).
+	ReloadInterval Duration `json:"reloadInterval,omitempty" yaml:"reloadInterval,omitempty"`
+	// RequireForReads requires authentication on every DNSService and
+	// PortalService call, reads and streams included, instead of the write
+	// procedures only. Web UI users logged in with OIDC are accepted too.
+	RequireForReads bool `json:"requireForReads,omitempty" yaml:"requireForReads,omitempty"`
 }
 
 // JWTAuthConfig configures JWT Bearer token authentication.
@@ -98,6 +205,66 @@ type JWTAuthConfig struct {
 	Issuers []JWTIssuerConfig `json:"issuers" yaml:"issuers"`
 }
 
+// Failure policies of the authorization webhook.
+const (
+	AuthorizationFailurePolicyDeny  = "Deny"
+	AuthorizationFailurePolicyAllow = "Allow"
+)
+
+// AuthorizationWebhookConfig configures the external authorization hook.
+// Every Connect call is checked against URL with the (user, portal, verb) of
+// the call; decisions are cached for CacheTTL.
+type AuthorizationWebhookConfig struct {
+	// Enabled controls whether the webhook is called.
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// URL is the HTTP(S) endpoint the decision requests are POSTed to.
+	URL string `json:"url" yaml:"url"`
+	// Timeout bounds a single decision request. Zero uses 2s.
+	Timeout Duration `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	// CacheTTL is how long a decision is reused for the same user, portal
+	// and verb. Zero uses 1m.
+	CacheTTL Duration `json:"cacheTTL,omitempty" yaml:"cacheTTL,omitempty"`
+	// FailurePolicy decides calls the webhook could not answer: "Deny"
+	// (default) or "Allow".
+	FailurePolicy string `json:"failurePolicy,omitempty" yaml:"failurePolicy,omitempty"`
+}
+
+// OIDCAuthConfig configures the OIDC login of the web UI (authorization code
+// flow). The client secret is read from the OIDC_CLIENT_SECRET environment
+// variable, and the key signing the session cookies from OIDC_SESSION_KEY
+// (random when unset: sessions are then lost on restart).
+type OIDCAuthConfig struct {
+	// Enabled controls whether the login endpoints are served and portal
+	// visibility is enforced.
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// IssuerURL is the OIDC issuer; its discovery document is read from
+	// <issuerURL>/.well-known/openid-configuration.
+	IssuerURL string `json:"issuerURL" yaml:"issuerURL"`
+	// ClientID is the client registered at the provider.
+	ClientID string `json:"clientID" yaml:"clientID"`
+	// RedirectURL is the callback URL registered at the provider. Defaults
+	// to web.publicURL followed by /auth/callback.
+	RedirectURL string `json:"redirectURL,omitempty" yaml:"redirectURL,omitempty"`
+	// Scopes are requested in addition to "openid" (default: profile, email
+	// and groups).
+	Scopes []string `json:"scopes,omitempty" yaml:"scopes,omitempty"`
+	// GroupsClaim is the ID token claim listing the groups of the user
+	// (default: "groups").
+	GroupsClaim string `json:"groupsClaim,omitempty" yaml:"groupsClaim,omitempty"`
+	// SessionTTL bounds a login session (default: 8h).
+	SessionTTL Duration `json:"sessionTTL,omitempty" yaml:"sessionTTL,omitempty"`
+}
+
+// Defaults of OIDCAuthConfig.
+const (
+	DefaultOIDCGroupsClaim = "groups"
+	DefaultOIDCSessionTTL  = 8 * time.Hour
+)
+
+// DefaultOIDCScopes are the scopes requested besides "openid" when
+// OIDCAuthConfig.Scopes is empty.
+var DefaultOIDCScopes = []string{"profile", "email", "groups"}
+
 // JWTIssuerConfig configures a single JWT issuer.
 type JWTIssuerConfig struct {
 	Name           string            `json:"name" yaml:"name"`
@@ -150,17 +317,63 @@ type SourcesConfig struct {
 	GatewayTLSRoute          *GatewayRouteConfig             `json:"gatewayTLSRoute,omitempty" yaml:"gatewayTLSRoute,omitempty"`
 	GatewayTCPRoute          *GatewayRouteConfig             `json:"gatewayTCPRoute,omitempty" yaml:"gatewayTCPRoute,omitempty"`
 	GatewayUDPRoute          *GatewayRouteConfig             `json:"gatewayUDPRoute,omitempty" yaml:"gatewayUDPRoute,omitempty"`
+	ContourHTTPProxy         *ContourHTTPProxyConfig         `json:"contourHTTPProxy,omitempty" yaml:"contourHTTPProxy,omitempty"`
+	AmbassadorHost           *AmbassadorHostConfig           `json:"ambassadorHost,omitempty" yaml:"ambassadorHost,omitempty"`
 	CrossplaneScalewayRecord *CrossplaneScalewayRecordConfig `json:"crossplaneScalewayRecord,omitempty" yaml:"crossplaneScalewayRecord,omitempty"`
+	Demo                     *DemoConfig                     `json:"demo,omitempty" yaml:"demo,omitempty"`
 	// Priority defines the preferred order of source types when the same FQDN+RecordType
 	// is discovered by multiple sources. Sources listed earlier take precedence over later ones.
 	// When a source is not listed, it receives the lowest priority. When empty, targets from
 	// all sources are merged (backward-compatible default).
 	// Valid values: "service", "ingress", "dnsendpoint", "istio-gateway", "istio-virtualservice",
 	// "gateway-httproute", "gateway-grpcroute", "gateway-tlsroute", "gateway-tcproute", "gateway-udproute",
-	// "crossplane-scaleway-record".
+	// "contour-httpproxy", "ambassador-host", "crossplane-scaleway-record", "demo".
 	Priority []string `json:"priority,omitempty" yaml:"priority,omitempty"`
 }
 
+// validate compiles every fqdnTemplate and renders it against a sample object
+// of its source kind.
+func (c SourcesConfig) validate() error {
+	type source struct{ kind, tmpl string }
+	var sources []source
+	if c.Service != nil {
+		sources = append(sources, source{"service", c.Service.FQDNTemplate})
+	}
+	if c.Ingress != nil {
+		sources = append(sources, source{"ingress", c.Ingress.FQDNTemplate})
+	}
+	if c.IstioGateway != nil {
+		sources = append(sources, source{"istioGateway", c.IstioGateway.FQDNTemplate})
+	}
+	if c.IstioVirtualService != nil {
+		sources = append(sources, source{"istioVirtualService", c.IstioVirtualService.FQDNTemplate})
+	}
+	routes := []struct {
+		kind  string
+		route *GatewayRouteConfig
+	}{
+		{"gatewayHTTPRoute", c.GatewayHTTPRoute},
+		{"gatewayGRPCRoute", c.GatewayGRPCRoute},
+		{"gatewayTLSRoute", c.GatewayTLSRoute},
+		{"gatewayTCPRoute", c.GatewayTCPRoute},
+		{"gatewayUDPRoute", c.GatewayUDPRoute},
+	}
+	for _, r := range routes {
+		if r.route != nil {
+			sources = append(sources, source{r.kind, r.route.FQDNTemplate})
+		}
+	}
+	if c.ContourHTTPProxy != nil {
+		sources = append(sources, source{"contourHTTPProxy", c.ContourHTTPProxy.FQDNTemplate})
+	}
+	for _, src := range sources {
+		if err := fqdntemplate.Check(src.kind, src.tmpl); err != nil {
+			return fmt.Errorf("%s.fqdnTemplate: %w", src.kind, err)
+		}
+	}
+	return nil
+}
+
 // ServiceConfig maps to source.Config fields for Kubernetes Services.
 type ServiceConfig struct {
 	// Enabled controls whether Service source is active.
@@ -278,6 +491,36 @@ type GatewayRouteConfig struct {
 	GatewayLabelFilter string `json:"gatewayLabelFilter,omitempty" yaml:"gatewayLabelFilter,omitempty"`
 }
 
+// ContourHTTPProxyConfig configures the Contour HTTPProxy source.
+type ContourHTTPProxyConfig struct {
+	// Enabled controls whether Contour HTTPProxy source is active.
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// Namespace restricts watching to a specific namespace. Empty means all namespaces.
+	Namespace string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	// AnnotationFilter filters HTTPProxies by annotation.
+	AnnotationFilter string `json:"annotationFilter,omitempty" yaml:"annotationFilter,omitempty"`
+	// LabelFilter filters HTTPProxies by label selector.
+	LabelFilter string `json:"labelFilter,omitempty" yaml:"labelFilter,omitempty"`
+	// FQDNTemplate is a Go template for generating hostnames.
+	FQDNTemplate string `json:"fqdnTemplate,omitempty" yaml:"fqdnTemplate,omitempty"`
+	// CombineFQDNAndAnnotation combines template and annotation hostnames.
+	CombineFQDNAndAnnotation bool `json:"combineFqdnAndAnnotation,omitempty" yaml:"combineFqdnAndAnnotation,omitempty"`
+	// IgnoreHostnameAnnotation ignores hostname annotations.
+	IgnoreHostnameAnnotation bool `json:"ignoreHostnameAnnotation,omitempty" yaml:"ignoreHostnameAnnotation,omitempty"`
+}
+
+// AmbassadorHostConfig configures the Emissary-ingress (Ambassador) Host source.
+type AmbassadorHostConfig struct {
+	// Enabled controls whether Ambassador Host source is active.
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// Namespace restricts watching to a specific namespace. Empty means all namespaces.
+	Namespace string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	// AnnotationFilter filters Hosts by annotation.
+	AnnotationFilter string `json:"annotationFilter,omitempty" yaml:"annotationFilter,omitempty"`
+	// LabelFilter filters Hosts by label selector.
+	LabelFilter string `json:"labelFilter,omitempty" yaml:"labelFilter,omitempty"`
+}
+
 // CrossplaneScalewayRecordConfig configures the Crossplane Scaleway DNS Record source.
 type CrossplaneScalewayRecordConfig struct {
 	// Enabled controls whether Crossplane Scaleway Record source is active.
@@ -290,16 +533,67 @@ type CrossplaneScalewayRecordConfig struct {
 	ClusterScoped bool `json:"clusterScoped,omitempty" yaml:"clusterScoped,omitempty"`
 }
 
+// DemoConfig configures the demo source, which generates synthetic FQDNs.
+type DemoConfig struct {
+	// Enabled controls whether the demo source is active.
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// Count is the number of FQDNs generated.
+	Count int32 `json:"count,omitempty" yaml:"count,omitempty"`
+	// Domain is the domain the FQDNs are generated under.
+	Domain string `json:"domain,omitempty" yaml:"domain,omitempty"`
+	// Groups are the UI groups the FQDNs are spread across.
+	Groups []string `json:"groups,omitempty" yaml:"groups,omitempty"`
+	// ChurnPercent is the share of the FQDNs replaced every reconciliation interval.
+	ChurnPercent int32 `json:"churnPercent,omitempty" yaml:"churnPercent,omitempty"`
+}
+
 // GroupMappingConfig configures how FQDNs are organized into groups for the UI.
 type GroupMappingConfig struct {
 	// DefaultGroup is the group name for FQDNs that don't match any mapping rules.
 	DefaultGroup string `json:"defaultGroup" yaml:"defaultGroup"`
 	// LabelKey is the endpoint label key to use for grouping (e.g., "sreportal.io/group").
 	LabelKey string `json:"labelKey,omitempty" yaml:"labelKey,omitempty"`
+	// LabelKeys are further label keys tried in order after LabelKey; the
+	// first one present with a non-empty value wins.
+	LabelKeys []GroupLabelKeyConfig `json:"labelKeys,omitempty" yaml:"labelKeys,omitempty"`
 	// ByNamespace maps Kubernetes namespaces to group names.
 	ByNamespace map[string]string `json:"byNamespace,omitempty" yaml:"byNamespace,omitempty"`
 }
 
+// GroupLabelKeyConfig is a grouping label key with the transforms applied to
+// its value.
+type GroupLabelKeyConfig struct {
+	// Key is the endpoint label key.
+	Key string `json:"key" yaml:"key"`
+	// StripPrefix is removed from the start of the value when present.
+	StripPrefix string `json:"stripPrefix,omitempty" yaml:"stripPrefix,omitempty"`
+	// TitleCase turns the value into space-separated capitalized words.
+	TitleCase bool `json:"titleCase,omitempty" yaml:"titleCase,omitempty"`
+}
+
+func (c *GroupMappingConfig) validate() error {
+	if c.DefaultGroup == "" {
+		return fmt.Errorf("defaultGroup: %w", ErrEmptyDefaultGroup)
+	}
+	for i, k := range c.LabelKeys {
+		if errs := validation.IsQualifiedName(k.Key); len(errs) > 0 {
+			return fmt.Errorf("labelKeys[%d].key: %w: %q: %s", i, ErrInvalidGroupLabelKey, k.Key, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+// SecurityConfig configures security posture checks on exposed FQDNs.
+type SecurityConfig struct {
+	// SensitivePatterns flags matching FQDNs as sensitive (admin consoles,
+	// dashboards, ...). A pattern ending with "." (e.g. "grafana.") matches any
+	// FQDN label starting with it; other patterns match as substrings.
+	SensitivePatterns []string `json:"sensitivePatterns,omitempty" yaml:"sensitivePatterns,omitempty"`
+	// HideSensitiveFromAnonymous removes sensitive FQDNs from the responses
+	// served to unauthenticated callers.
+	HideSensitiveFromAnonymous bool `json:"hideSensitiveFromAnonymous,omitempty" yaml:"hideSensitiveFromAnonymous,omitempty"`
+}
+
 // ReconciliationConfig controls reconciliation timing.
 type ReconciliationConfig struct {
 	// Interval is the time between full reconciliations.
@@ -313,6 +607,1028 @@ type ReconciliationConfig struct {
 	DisableDNSCheck bool `json:"disableDNSCheck,omitempty" yaml:"disableDNSCheck,omitempty"`
 }
 
+// RemoteSyncConfig controls how the Portal controller schedules syncs with
+// remote portals.
+type RemoteSyncConfig struct {
+	// Interval is the target time between two syncs of the same remote portal.
+	// The duration of the last sync is subtracted so the cadence stays stable
+	// regardless of remote latency.
+	Interval Duration `json:"interval,omitempty" yaml:"interval,omitempty"`
+	// Jitter is the fraction (0-1) of each requeue delay randomly added to it so
+	// remote portals drift apart instead of all fetching at the same instant.
+	Jitter float64 `json:"jitter,omitempty" yaml:"jitter,omitempty"`
+	// MaxConcurrent is the maximum number of portals reconciled in parallel,
+	// bounding the number of simultaneous remote fetches.
+	MaxConcurrent int `json:"maxConcurrent,omitempty" yaml:"maxConcurrent,omitempty"`
+}
+
+// PortalConfig controls how the Portal controller manages the resources it
+// derives from a Portal.
+type PortalConfig struct {
+	// DisableDNSAutoCreate stops the controller from creating a DNS CR for the
+	// main local portal. Pre-existing DNS CRs (e.g. managed via GitOps) are
+	// still adopted, but never backfilled. A single portal can opt out with
+	// the sreportal.io/dns-auto-create: "false" annotation instead.
+	DisableDNSAutoCreate bool `json:"disableDNSAutoCreate,omitempty" yaml:"disableDNSAutoCreate,omitempty"`
+	// Templates are named sets of Portal spec defaults. A Portal naming one
+	// in spec.templateRef gets the template values for the fields it leaves
+	// unset, stamped by the defaulting webhook.
+	Templates []PortalTemplateConfig `json:"templates,omitempty" yaml:"templates,omitempty"`
+	// InsecureSkipVerifyDenySelector is the label selector of the namespaces
+	// whose remote portals may not disable TLS verification with
+	// spec.remote.tls.insecureSkipVerify (default "environment=production").
+	// Empty allows it in every namespace.
+	InsecureSkipVerifyDenySelector string `json:"insecureSkipVerifyDenySelector,omitempty" yaml:"insecureSkipVerifyDenySelector,omitempty"`
+}
+
+// Template returns the portal template named name.
+func (c PortalConfig) Template(name string) (PortalTemplateConfig, bool) {
+	for _, t := range c.Templates {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return PortalTemplateConfig{}, false
+}
+
+func (c PortalConfig) validate() error {
+	seen := make(map[string]bool, len(c.Templates))
+	for i, t := range c.Templates {
+		if t.Name == "" || seen[t.Name] {
+			return fmt.Errorf("templates[%d].name: %w", i, ErrInvalidPortalTemplate)
+		}
+		seen[t.Name] = true
+		for j, l := range t.Links {
+			if l.Title == "" || !validLinkURL(l.URL) {
+				return fmt.Errorf("templates[%d].links[%d]: %w", i, j, ErrInvalidPortalTemplate)
+			}
+		}
+		if logo := t.Branding.LogoURL; logo != "" && !strings.HasPrefix(logo, "/") && !validLinkURL(logo) {
+			return fmt.Errorf("templates[%d].branding.logoURL: %w", i, ErrInvalidPortalTemplate)
+		}
+		if _, err := labels.Parse(t.SourceFilters.LabelFilter); err != nil {
+			return fmt.Errorf("templates[%d].sourceFilters.labelFilter: %w: %w", i, ErrInvalidPortalTemplate, err)
+		}
+	}
+	if _, err := labels.Parse(c.InsecureSkipVerifyDenySelector); err != nil {
+		return fmt.Errorf("insecureSkipVerifyDenySelector: %w: %w", ErrInvalidPortalSelector, err)
+	}
+	return nil
+}
+
+// PortalTemplateConfig holds the Portal spec defaults of a template. Every
+// field is optional; a field a Portal sets itself always wins.
+type PortalTemplateConfig struct {
+	// Name is referenced by Portal spec.templateRef.
+	Name string `json:"name" yaml:"name"`
+	// Features are the default feature toggles.
+	Features PortalTemplateFeatures `json:"features,omitempty" yaml:"features,omitempty"`
+	// SourcePriority is the default source priority of the portal.
+	SourcePriority []string `json:"sourcePriority,omitempty" yaml:"sourcePriority,omitempty"`
+	// Links are the default links shown in the portal menu.
+	Links []PortalLinkConfig `json:"links,omitempty" yaml:"links,omitempty"`
+	// Branding is the default branding of the portal.
+	Branding PortalBrandingConfig `json:"branding,omitempty" yaml:"branding,omitempty"`
+	// Groups are the default FQDN groups of the DNS CR created for the portal.
+	Groups PortalTemplateGroups `json:"groups,omitempty" yaml:"groups,omitempty"`
+	// SourceFilters are the default source filters of the DNS CR created for
+	// the portal.
+	SourceFilters PortalTemplateSourceFilters `json:"sourceFilters,omitempty" yaml:"sourceFilters,omitempty"`
+}
+
+// DefinesDNS reports whether the template sets defaults of the portal DNS CR,
+// in which case the Portal controller creates one for the portals using it.
+func (t PortalTemplateConfig) DefinesDNS() bool {
+	return t.Groups.Default != "" || len(t.Groups.ByNamespace) > 0 ||
+		t.SourceFilters != (PortalTemplateSourceFilters{})
+}
+
+// PortalTemplateGroups are the default FQDN groups of a portal template.
+type PortalTemplateGroups struct {
+	// Default is the group of the FQDNs no other rule maps.
+	Default string `json:"default,omitempty" yaml:"default,omitempty"`
+	// ByNamespace maps a namespace to the group of the FQDNs it holds.
+	ByNamespace map[string]string `json:"byNamespace,omitempty" yaml:"byNamespace,omitempty"`
+}
+
+// PortalTemplateSourceFilters are the default source filters of a portal
+// template, applied to every source that sets none.
+type PortalTemplateSourceFilters struct {
+	// Namespace is the namespace of the sources.
+	Namespace string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	// LabelFilter is the label selector of the sources.
+	LabelFilter string `json:"labelFilter,omitempty" yaml:"labelFilter,omitempty"`
+}
+
+// PortalTemplateFeatures are the feature toggles of a portal template; nil
+// leaves the toggle to the Portal (enabled by default).
+type PortalTemplateFeatures struct {
+	DNS            *bool `json:"dns,omitempty" yaml:"dns,omitempty"`
+	Releases       *bool `json:"releases,omitempty" yaml:"releases,omitempty"`
+	NetworkPolicy  *bool `json:"networkPolicy,omitempty" yaml:"networkPolicy,omitempty"`
+	Alerts         *bool `json:"alerts,omitempty" yaml:"alerts,omitempty"`
+	StatusPage     *bool `json:"statusPage,omitempty" yaml:"statusPage,omitempty"`
+	ImageInventory *bool `json:"imageInventory,omitempty" yaml:"imageInventory,omitempty"`
+}
+
+// PortalLinkConfig is a link of a portal template.
+type PortalLinkConfig struct {
+	Title string `json:"title" yaml:"title"`
+	URL   string `json:"url" yaml:"url"`
+}
+
+// PortalBrandingConfig is the branding of a portal template.
+type PortalBrandingConfig struct {
+	// LogoURL is the URL of the logo shown in the portal menu.
+	LogoURL string `json:"logoURL,omitempty" yaml:"logoURL,omitempty"`
+	// Color is the accent color of the portal, as "#rrggbb".
+	Color string `json:"color,omitempty" yaml:"color,omitempty"`
+}
+
+// DNSRecordConfig controls the DNSRecords generated by the DNS controller.
+type DNSRecordConfig struct {
+	// NameTemplate is a Go template naming the auto-generated DNSRecords, with
+	// the fields .DNS, .Portal, .Namespace and .SourceType, which it must use.
+	// Empty keeps the default "{{ .DNS }}-{{ .SourceType }}". Rendered names
+	// longer than 253 characters are truncated and suffixed with a hash of the
+	// full name.
+	NameTemplate string `json:"nameTemplate,omitempty" yaml:"nameTemplate,omitempty"`
+	// ExposedAnnotations lists the annotation keys of the origin resources
+	// (Service, Ingress, ...) copied onto their FQDNs and returned by the API,
+	// e.g. an owner or a cost center for inventory tooling.
+	ExposedAnnotations []string `json:"exposedAnnotations,omitempty" yaml:"exposedAnnotations,omitempty"`
+	// TombstoneRetention is how long an FQDN that disappeared from every
+	// DNSRecord stays listed as removed (default 24h). 0 drops it at once.
+	TombstoneRetention Duration `json:"tombstoneRetention,omitempty" yaml:"tombstoneRetention,omitempty"`
+	// TargetProviders hints, for each public IP target of an FQDN, the cloud
+	// or hosting provider whose ranges contain it (e.g. "aws", AS16509), from
+	// static ranges embedded in the binary.
+	TargetProviders bool `json:"targetProviders,omitempty" yaml:"targetProviders,omitempty"`
+}
+
+// DNSResolutionConfig controls the asynchronous DNS resolution of FQDNs.
+type DNSResolutionConfig struct {
+	// Resolver selects how FQDNs are resolved for sync checks. The system
+	// resolver is used by default.
+	Resolver ResolverConfig `json:"resolver,omitempty" yaml:"resolver,omitempty"`
+	// ExternalResolver is the address ("host" or "host:port", port 53 by
+	// default) of a DNS server queried in addition to the cluster resolver,
+	// or the https:// URL of a DNS-over-HTTPS endpoint.
+	// When set, every FQDN is resolved through both, and the two results are
+	// stored as internalStatus/externalStatus so split-horizon drift between
+	// the in-cluster and public views is visible.
+	ExternalResolver string `json:"externalResolver,omitempty" yaml:"externalResolver,omitempty"`
+}
+
+// Resolver types selectable in ResolverConfig.Type.
+const (
+	// ResolverTypeSystem resolves through the system resolver (/etc/resolv.conf).
+	ResolverTypeSystem = "system"
+	// ResolverTypeDNS sends plain DNS queries to the configured servers.
+	ResolverTypeDNS = "dns"
+	// ResolverTypeDoH sends DNS-over-HTTPS (RFC 8484) queries to the configured URL.
+	ResolverTypeDoH = "doh"
+)
+
+// ResolverConfig selects and configures a DNS resolver.
+type ResolverConfig struct {
+	// Type is the resolver type: "system" (default), "dns" or "doh".
+	Type string `json:"type,omitempty" yaml:"type,omitempty"`
+	// Servers are the DNS servers ("host" or "host:port", port 53 by default)
+	// queried by the "dns" type, in order: the next one is tried when a
+	// server does not answer.
+	Servers []string `json:"servers,omitempty" yaml:"servers,omitempty"`
+	// URL is the DNS-over-HTTPS endpoint queried by the "doh" type, e.g.
+	// https://cloudflare-dns.com/dns-query.
+	URL string `json:"url,omitempty" yaml:"url,omitempty"`
+	// Timeout bounds a single DNS-over-HTTPS request. Zero uses 5s.
+	Timeout Duration `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+}
+
+// ServerAddrs returns Servers as "host:port" addresses.
+func (c ResolverConfig) ServerAddrs() []string {
+	addrs := make([]string, 0, len(c.Servers))
+	for _, s := range c.Servers {
+		addrs = append(addrs, withDNSPort(s))
+	}
+	return addrs
+}
+
+func (c ResolverConfig) validate() error {
+	switch c.Type {
+	case "", ResolverTypeSystem:
+	case ResolverTypeDNS:
+		if len(c.Servers) == 0 {
+			return fmt.Errorf("servers: %w", ErrInvalidResolverAddress)
+		}
+		for _, addr := range c.ServerAddrs() {
+			if host, port, err := net.SplitHostPort(addr); err != nil || host == "" || port == "" {
+				return fmt.Errorf("servers %q: %w", addr, ErrInvalidResolverAddress)
+			}
+		}
+	case ResolverTypeDoH:
+		if !validDoHURL(c.URL) {
+			return fmt.Errorf("url %q: %w", c.URL, ErrInvalidResolverAddress)
+		}
+	default:
+		// Other types may be registered by the resolver factory; it rejects
+		// the unknown ones.
+	}
+	if c.Timeout.Duration() < 0 {
+		return fmt.Errorf("timeout: %w", ErrInvalidInterval)
+	}
+	return nil
+}
+
+// validDoHURL reports whether raw is an absolute http(s) URL.
+func validDoHURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "https" || u.Scheme == "http") && u.Host != ""
+}
+
+// ExternalResolverConfig returns ExternalResolver as a ResolverConfig: a "doh"
+// resolver for an https:// URL, a "dns" resolver otherwise. Type is empty
+// when no external resolver is configured.
+func (c DNSResolutionConfig) ExternalResolverConfig() ResolverConfig {
+	switch {
+	case c.ExternalResolver == "":
+		return ResolverConfig{}
+	case strings.HasPrefix(c.ExternalResolver, "https://"):
+		return ResolverConfig{Type: ResolverTypeDoH, URL: c.ExternalResolver, Timeout: c.Resolver.Timeout}
+	default:
+		return ResolverConfig{Type: ResolverTypeDNS, Servers: []string{c.ExternalResolver}}
+	}
+}
+
+// ExternalResolverAddr returns ExternalResolver as a "host:port" address,
+// or "" when no external resolver is configured or it is a DNS-over-HTTPS URL.
+func (c DNSResolutionConfig) ExternalResolverAddr() string {
+	if c.ExternalResolver == "" || strings.HasPrefix(c.ExternalResolver, "https://") {
+		return ""
+	}
+	return withDNSPort(c.ExternalResolver)
+}
+
+// withDNSPort appends the DNS port 53 to addr when it has none.
+func withDNSPort(addr string) string {
+	if _, _, err := net.SplitHostPort(addr); err == nil {
+		return addr
+	}
+	return net.JoinHostPort(addr, "53")
+}
+
+// ProbesConfig controls the connection probes run against FQDNs. A probe is
+// selected per FQDN by the sreportal.io/probe annotation (e.g. "tcp:5432") on
+// the source resource, or per group here.
+type ProbesConfig struct {
+	// Interval is the time between two probes of the same FQDN.
+	Interval Duration `json:"interval,omitempty" yaml:"interval,omitempty"`
+	// Timeout bounds a single probe.
+	Timeout Duration `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	// Groups maps a group name to the probe ("<type>:<port>") run against the
+	// FQDNs of that group that carry no sreportal.io/probe annotation.
+	Groups map[string]string `json:"groups,omitempty" yaml:"groups,omitempty"`
+}
+
+// ConsistencyConfig controls the background consistency checker, which
+// cross-validates DNSRecords, their status and the FQDN read store.
+type ConsistencyConfig struct {
+	// Interval is the time between two checks. Zero disables the checker.
+	Interval Duration `json:"interval,omitempty" yaml:"interval,omitempty"`
+}
+
+// UniquenessConfig controls the periodic FQDN uniqueness check, which warns
+// the DNS CRs owning FQDNs listed in several portals or with conflicting
+// targets.
+type UniquenessConfig struct {
+	// Interval is the time between two checks. Zero disables the Warning
+	// Events; the GetUniquenessReport RPC stays available.
+	Interval Duration `json:"interval,omitempty" yaml:"interval,omitempty"`
+}
+
+// ScaleGuardConfig sets the soft and hard limits of the scale guard. Past a
+// soft limit the operator only warns; past a hard limit on DNS CRs,
+// DNSRecords or FQDNs it pauses source discovery, and past the hard limit on
+// streams it rejects new StreamFQDNs calls.
+type ScaleGuardConfig struct {
+	// Interval is the time between two counts of the DNS CRs, DNSRecords and
+	// FQDNs. Zero, the default, disables the guard.
+	Interval   Duration   `json:"interval,omitempty" yaml:"interval,omitempty"`
+	DNS        ScaleLimit `json:"dns,omitempty" yaml:"dns,omitempty"`
+	DNSRecords ScaleLimit `json:"dnsRecords,omitempty" yaml:"dnsRecords,omitempty"`
+	FQDNs      ScaleLimit `json:"fqdns,omitempty" yaml:"fqdns,omitempty"`
+	Streams    ScaleLimit `json:"streams,omitempty" yaml:"streams,omitempty"`
+}
+
+// ScaleLimit is a pair of thresholds on a count. Zero disables a threshold.
+type ScaleLimit struct {
+	Soft int `json:"soft,omitempty" yaml:"soft,omitempty"`
+	Hard int `json:"hard,omitempty" yaml:"hard,omitempty"`
+}
+
+func (l ScaleLimit) validate() error {
+	if l.Soft < 0 || l.Hard < 0 {
+		return fmt.Errorf("%w: limits must not be negative", ErrInvalidScaleGuard)
+	}
+	if l.Soft > 0 && l.Hard > 0 && l.Soft > l.Hard {
+		return fmt.Errorf("%w: soft limit %d above hard limit %d", ErrInvalidScaleGuard, l.Soft, l.Hard)
+	}
+	return nil
+}
+
+func (c ScaleGuardConfig) validate() error {
+	if c.Interval.Duration() < 0 {
+		return fmt.Errorf("interval: %w", ErrInvalidInterval)
+	}
+	if err := c.DNS.validate(); err != nil {
+		return fmt.Errorf("dns: %w", err)
+	}
+	if err := c.DNSRecords.validate(); err != nil {
+		return fmt.Errorf("dnsRecords: %w", err)
+	}
+	if err := c.FQDNs.validate(); err != nil {
+		return fmt.Errorf("fqdns: %w", err)
+	}
+	if err := c.Streams.validate(); err != nil {
+		return fmt.Errorf("streams: %w", err)
+	}
+	return nil
+}
+
+// QuotaConfig sets the limits the validating webhooks enforce on the objects
+// users create, so that runaway automation cannot flood a shared portal. Zero
+// disables a limit. An update is only rejected when it adds to a count that
+// is over its limit, so existing objects above a lowered limit can still be
+// trimmed.
+type QuotaConfig struct {
+	// MaxManualGroups bounds the groups of the manual entries of a portal:
+	// the distinct groups of its manual DNSRecords, or the spec.groups of a
+	// v1alpha1 DNS resource.
+	MaxManualGroups int `json:"maxManualGroups,omitempty" yaml:"maxManualGroups,omitempty"`
+	// MaxManualEntries bounds the manual entries of a portal, summed over its
+	// manual DNSRecords, or over the spec.groups of a v1alpha1 DNS resource.
+	MaxManualEntries int `json:"maxManualEntries,omitempty" yaml:"maxManualEntries,omitempty"`
+	// MaxPortalsPerNamespace bounds the Portals of a namespace.
+	MaxPortalsPerNamespace int `json:"maxPortalsPerNamespace,omitempty" yaml:"maxPortalsPerNamespace,omitempty"`
+}
+
+func (c QuotaConfig) validate() error {
+	if c.MaxManualGroups < 0 || c.MaxManualEntries < 0 || c.MaxPortalsPerNamespace < 0 {
+		return ErrInvalidQuota
+	}
+	return nil
+}
+
+// DNSPipelineConfig selects the steps of the DNS pipeline: the handlers of
+// the DNS controller chain and their order, and the background resolution and
+// probe steps. Names are checked against the handler registry at startup.
+type DNSPipelineConfig struct {
+	// Handlers is the ordered list of DNS chain handler names. Empty runs
+	// every handler in the default order.
+	Handlers []string `json:"handlers,omitempty" yaml:"handlers,omitempty"`
+	// Disabled lists the DNS chain handlers removed from Handlers (or from
+	// the default order), and the background steps turned off ("resolution",
+	// "probes").
+	Disabled []string `json:"disabled,omitempty" yaml:"disabled,omitempty"`
+}
+
+func (c DNSPipelineConfig) validate() error {
+	if err := uniqueNames(c.Handlers); err != nil {
+		return fmt.Errorf("handlers%w", err)
+	}
+	if err := uniqueNames(c.Disabled); err != nil {
+		return fmt.Errorf("disabled%w", err)
+	}
+	return nil
+}
+
+// uniqueNames rejects an empty or duplicate name of a DNS pipeline list.
+func uniqueNames(names []string) error {
+	seen := make(map[string]bool, len(names))
+	for i, name := range names {
+		if name == "" || seen[name] {
+			return fmt.Errorf("[%d]: %w: empty or duplicate name %q", i, ErrInvalidDNSPipeline, name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// ExternalDNSImportConfig controls the one-time import, per portal, of the
+// DNSEndpoint CRs external-dns already manages into a manual DNSRecord.
+type ExternalDNSImportConfig struct {
+	// Enabled turns the import on.
+	Enabled bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	// TXTPrefix is the --txt-prefix external-dns runs with, used to find the
+	// TXT ownership record of each imported FQDN.
+	TXTPrefix string `json:"txtPrefix,omitempty" yaml:"txtPrefix,omitempty"`
+}
+
+// DNSEndpointPublisherConfig controls the rendering of manual DNSRecords
+// into external-dns DNSEndpoint CRs. Each manual DNSRecord gets a DNSEndpoint
+// of the same name holding its entries that have targets.
+type DNSEndpointPublisherConfig struct {
+	// Enabled turns the publisher on.
+	Enabled bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	// Groups restricts publication to the entries of these groups; empty
+	// publishes every entry.
+	Groups []string `json:"groups,omitempty" yaml:"groups,omitempty"`
+	// Labels are added to every published DNSEndpoint, e.g. to match the
+	// --label-filter of the external-dns instance that should pick them up.
+	Labels map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+}
+
+// SnapshotPublisherConfig controls the periodic publication of the FQDN
+// snapshot (a serialized ListFQDNsResponse) as an OCI artifact, imported by
+// remote portals through spec.remote.snapshot.oci.
+type SnapshotPublisherConfig struct {
+	// Enabled turns the publisher on.
+	Enabled bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	// Repository is the OCI repository the snapshot is pushed to, e.g.
+	// "registry.example.com/sreportal/site-a".
+	Repository string `json:"repository,omitempty" yaml:"repository,omitempty"`
+	// Tag is the tag the snapshot is pushed under (default "latest").
+	Tag string `json:"tag,omitempty" yaml:"tag,omitempty"`
+	// Portal restricts the snapshot to the FQDNs of one portal; empty
+	// publishes every portal.
+	Portal string `json:"portal,omitempty" yaml:"portal,omitempty"`
+	// Interval is the time between two publications (default 5m). An
+	// unchanged snapshot is not pushed again.
+	Interval Duration `json:"interval,omitempty" yaml:"interval,omitempty"`
+	// CredentialsSecret names a Secret of the operator namespace holding the
+	// registry credentials: a kubernetes.io/dockerconfigjson Secret, or
+	// "username" and "password" keys. Empty uses anonymous access.
+	CredentialsSecret string `json:"credentialsSecret,omitempty" yaml:"credentialsSecret,omitempty"`
+}
+
+func (c SnapshotPublisherConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Repository == "" {
+		return fmt.Errorf("repository: %w", ErrInvalidSnapshotPublisher)
+	}
+	if c.Tag == "" {
+		return fmt.Errorf("tag: %w", ErrInvalidSnapshotPublisher)
+	}
+	if c.Interval.Duration() <= 0 {
+		return fmt.Errorf("interval: %w", ErrInvalidInterval)
+	}
+	return nil
+}
+
+// AutoPortalConfig controls the automatic creation of a Portal per
+// namespace. A namespace matching Selector gets a portal named after it,
+// whose DNS CR only discovers endpoints of that namespace.
+type AutoPortalConfig struct {
+	// Enabled turns automatic portals on.
+	Enabled bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	// Selector is the label selector namespaces must match (default
+	// "sreportal.io/auto-portal=true").
+	Selector string `json:"selector,omitempty" yaml:"selector,omitempty"`
+	// TitleAnnotation is the namespace annotation holding the portal title
+	// (default "sreportal.io/portal-title"). The namespace name is used when
+	// the annotation is absent.
+	TitleAnnotation string `json:"titleAnnotation,omitempty" yaml:"titleAnnotation,omitempty"`
+}
+
+func (c AutoPortalConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	sel, err := labels.Parse(c.Selector)
+	if err != nil {
+		return fmt.Errorf("selector: %w: %w", ErrInvalidAutoPortal, err)
+	}
+	if sel.Empty() {
+		return fmt.Errorf("selector: %w", ErrInvalidAutoPortal)
+	}
+	return nil
+}
+
+// CMDBExporterServiceNow is the CMDBConfig.Exporter pushing to the ServiceNow
+// Table API.
+const CMDBExporterServiceNow = "servicenow"
+
+// CMDBConfig controls the periodic export of the FQDN inventory to a CMDB.
+// Every run sends only the FQDNs added, changed or removed since the previous
+// one.
+type CMDBConfig struct {
+	// Enabled turns the export on.
+	Enabled bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	// Exporter selects the CMDB implementation (default "servicenow").
+	Exporter string `json:"exporter,omitempty" yaml:"exporter,omitempty"`
+	// Interval is the time between two exports (default 15m).
+	Interval Duration `json:"interval,omitempty" yaml:"interval,omitempty"`
+	// Portals restricts the export to these portals; empty exports every
+	// local portal.
+	Portals []string `json:"portals,omitempty" yaml:"portals,omitempty"`
+	// OwnerAnnotation is the origin resource annotation holding the owner of
+	// an FQDN (default "sreportal.io/owner").
+	OwnerAnnotation string `json:"ownerAnnotation,omitempty" yaml:"ownerAnnotation,omitempty"`
+	// TierAnnotation is the origin resource annotation holding the service
+	// tier of an FQDN (default "sreportal.io/tier").
+	TierAnnotation string `json:"tierAnnotation,omitempty" yaml:"tierAnnotation,omitempty"`
+	// ServiceNow configures the "servicenow" exporter.
+	ServiceNow ServiceNowConfig `json:"serviceNow,omitempty" yaml:"serviceNow,omitempty"`
+}
+
+// ServiceNowConfig configures the export to a ServiceNow instance.
+type ServiceNowConfig struct {
+	// URL is the base URL of the instance, e.g.
+	// "https://example.service-now.com".
+	URL string `json:"url,omitempty" yaml:"url,omitempty"`
+	// Table is the CMDB table FQDNs are written to (default
+	// "cmdb_ci_dns_name").
+	Table string `json:"table,omitempty" yaml:"table,omitempty"`
+	// CredentialsSecret names a Secret of the operator namespace holding the
+	// "username" and "password" of the integration user.
+	CredentialsSecret string `json:"credentialsSecret,omitempty" yaml:"credentialsSecret,omitempty"`
+	// Timeout bounds each request to the instance (default 30s).
+	Timeout Duration `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+}
+
+// AnnotationKeys returns the origin annotations the export reads.
+func (c CMDBConfig) AnnotationKeys() []string {
+	var keys []string
+	for _, k := range []string{c.OwnerAnnotation, c.TierAnnotation} {
+		if k != "" {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+func (c CMDBConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Interval.Duration() <= 0 {
+		return fmt.Errorf("interval: %w", ErrInvalidInterval)
+	}
+	if c.Exporter != CMDBExporterServiceNow {
+		return fmt.Errorf("exporter: %w: %q", ErrInvalidCMDB, c.Exporter)
+	}
+	if err := c.ServiceNow.validate(); err != nil {
+		return fmt.Errorf("serviceNow.%w", err)
+	}
+	return nil
+}
+
+func (c ServiceNowConfig) validate() error {
+	u, err := url.Parse(c.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("url: %w", ErrInvalidCMDB)
+	}
+	if c.Table == "" {
+		return fmt.Errorf("table: %w", ErrInvalidCMDB)
+	}
+	if c.CredentialsSecret == "" {
+		return fmt.Errorf("credentialsSecret: %w", ErrInvalidCMDB)
+	}
+	if c.Timeout.Duration() <= 0 {
+		return fmt.Errorf("timeout: %w", ErrInvalidTimeout)
+	}
+	return nil
+}
+
+// Digest notifier types (DigestNotifierConfig.Type).
+const (
+	// DigestNotifierSlack posts the digest to a Slack incoming webhook.
+	DigestNotifierSlack = "slack"
+	// DigestNotifierEmail mails the digest through an SMTP relay.
+	DigestNotifierEmail = "email"
+)
+
+// DigestConfig controls the scheduled portal digest: a summary of the FQDNs
+// added and removed since the previous digest, of the FQDNs drifting from
+// their expected state and of the certificates about to expire, delivered
+// through notifiers.
+type DigestConfig struct {
+	// Enabled turns the digest on.
+	Enabled bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	// Notifiers are the delivery channels portals refer to by name.
+	Notifiers []DigestNotifierConfig `json:"notifiers,omitempty" yaml:"notifiers,omitempty"`
+	// Portals lists the portals that get a digest, each with its schedule.
+	Portals []DigestPortalConfig `json:"portals,omitempty" yaml:"portals,omitempty"`
+	// Certificates configures the certificate expiry check of the digest.
+	Certificates DigestCertificatesConfig `json:"certificates,omitempty" yaml:"certificates,omitempty"`
+	// MaxItems bounds the FQDNs listed in each section of a digest (default
+	// 50); the others are only counted.
+	MaxItems int `json:"maxItems,omitempty" yaml:"maxItems,omitempty"`
+}
+
+// DigestNotifierConfig is a named delivery channel of the digest.
+type DigestNotifierConfig struct {
+	// Name identifies the notifier in DigestPortalConfig.Notifiers.
+	Name string `json:"name" yaml:"name"`
+	// Type is DigestNotifierSlack or DigestNotifierEmail.
+	Type string `json:"type" yaml:"type"`
+	// Slack configures a "slack" notifier.
+	Slack SlackNotifierConfig `json:"slack,omitempty" yaml:"slack,omitempty"`
+	// Email configures an "email" notifier.
+	Email EmailNotifierConfig `json:"email,omitempty" yaml:"email,omitempty"`
+}
+
+// SlackNotifierConfig configures the delivery to a Slack incoming webhook.
+type SlackNotifierConfig struct {
+	// WebhookSecret names a Secret of the operator namespace whose "url" key
+	// holds the incoming webhook URL.
+	WebhookSecret string `json:"webhookSecret,omitempty" yaml:"webhookSecret,omitempty"`
+}
+
+// EmailNotifierConfig configures the delivery through an SMTP relay.
+type EmailNotifierConfig struct {
+	// Host is the SMTP relay host.
+	Host string `json:"host,omitempty" yaml:"host,omitempty"`
+	// Port is the SMTP relay port (default 587).
+	Port int `json:"port,omitempty" yaml:"port,omitempty"`
+	// From is the sender address.
+	From string `json:"from,omitempty" yaml:"from,omitempty"`
+	// To are the recipient addresses.
+	To []string `json:"to,omitempty" yaml:"to,omitempty"`
+	// CredentialsSecret optionally names a Secret of the operator namespace
+	// holding the "username" and "password" used to authenticate to the
+	// relay.
+	CredentialsSecret string `json:"credentialsSecret,omitempty" yaml:"credentialsSecret,omitempty"`
+}
+
+// DigestPortalConfig schedules the digest of one portal.
+type DigestPortalConfig struct {
+	// Portal is the name of the portal.
+	Portal string `json:"portal" yaml:"portal"`
+	// Schedule is the weekly delivery time, "<weekday> <HH:MM>" in UTC
+	// (default "Mon 09:00").
+	Schedule string `json:"schedule,omitempty" yaml:"schedule,omitempty"`
+	// Notifiers names the notifiers the digest is delivered through.
+	Notifiers []string `json:"notifiers" yaml:"notifiers"`
+}
+
+// DigestCertificatesConfig controls the TLS certificate check of the digest,
+// which connects to each FQDN of the portal to read the expiry of the
+// certificate it serves.
+type DigestCertificatesConfig struct {
+	// Enabled turns the check on.
+	Enabled bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	// Port is the TLS port connected to (default 443).
+	Port int `json:"port,omitempty" yaml:"port,omitempty"`
+	// WarnWithin lists the certificates expiring within this duration
+	// (default 504h, 21 days).
+	WarnWithin Duration `json:"warnWithin,omitempty" yaml:"warnWithin,omitempty"`
+	// Timeout bounds each connection (default 5s).
+	Timeout Duration `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+}
+
+func (c DigestConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.MaxItems < 1 {
+		return fmt.Errorf("maxItems: %w", ErrInvalidDigest)
+	}
+	notifiers := make(map[string]bool, len(c.Notifiers))
+	for i, n := range c.Notifiers {
+		if n.Name == "" || notifiers[n.Name] {
+			return fmt.Errorf("notifiers[%d].name: %w: %q", i, ErrInvalidDigest, n.Name)
+		}
+		notifiers[n.Name] = true
+		if err := n.validate(); err != nil {
+			return fmt.Errorf("notifiers[%d].%w", i, err)
+		}
+	}
+	portals := make(map[string]bool, len(c.Portals))
+	for i, p := range c.Portals {
+		if p.Portal == "" || portals[p.Portal] {
+			return fmt.Errorf("portals[%d].portal: %w: %q", i, ErrInvalidDigest, p.Portal)
+		}
+		portals[p.Portal] = true
+		if len(p.Notifiers) == 0 {
+			return fmt.Errorf("portals[%d].notifiers: %w", i, ErrInvalidDigest)
+		}
+		for _, name := range p.Notifiers {
+			if !notifiers[name] {
+				return fmt.Errorf("portals[%d].notifiers: %w: unknown notifier %q", i, ErrInvalidDigest, name)
+			}
+		}
+	}
+	if c.Certificates.Enabled {
+		if c.Certificates.Port < 1 || c.Certificates.Port > 65535 {
+			return fmt.Errorf("certificates.port: %w", ErrInvalidDigest)
+		}
+		if c.Certificates.WarnWithin.Duration() <= 0 {
+			return fmt.Errorf("certificates.warnWithin: %w", ErrInvalidInterval)
+		}
+		if c.Certificates.Timeout.Duration() <= 0 {
+			return fmt.Errorf("certificates.timeout: %w", ErrInvalidTimeout)
+		}
+	}
+	return nil
+}
+
+func (c DigestNotifierConfig) validate() error {
+	switch c.Type {
+	case DigestNotifierSlack:
+		if c.Slack.WebhookSecret == "" {
+			return fmt.Errorf("slack.webhookSecret: %w", ErrInvalidDigest)
+		}
+	case DigestNotifierEmail:
+		if c.Email.Host == "" {
+			return fmt.Errorf("email.host: %w", ErrInvalidDigest)
+		}
+		if c.Email.Port < 0 || c.Email.Port > 65535 {
+			return fmt.Errorf("email.port: %w", ErrInvalidDigest)
+		}
+		if c.Email.From == "" {
+			return fmt.Errorf("email.from: %w", ErrInvalidDigest)
+		}
+		if len(c.Email.To) == 0 {
+			return fmt.Errorf("email.to: %w", ErrInvalidDigest)
+		}
+	default:
+		return fmt.Errorf("type: %w: %q", ErrInvalidDigest, c.Type)
+	}
+	return nil
+}
+
+// AgentConfig configures an instance started with --mode=agent, which only
+// collects the sources of its cluster and pushes the discovered FQDNs to a
+// central instance through PublishEndpoints, and the central side receiving
+// them.
+type AgentConfig struct {
+	// Name identifies the agent on the central instance, as a DNS label.
+	// Required in agent mode.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+	// Portal is the central portal the FQDNs are shown in. Required in agent
+	// mode.
+	Portal string `json:"portal,omitempty" yaml:"portal,omitempty"`
+	// CentralURL is the base URL of the central web server, e.g.
+	// "https://sreportal.example.com". Required in agent mode.
+	CentralURL string `json:"centralURL,omitempty" yaml:"centralURL,omitempty"`
+	// HeaderName is the header the API key (AGENT_API_KEY env var) is sent
+	// in (default "Authorization", as a bearer API token). Set it to the
+	// central auth.apiKey.headerName to authenticate with the API key.
+	HeaderName string `json:"headerName,omitempty" yaml:"headerName,omitempty"`
+	// Interval is the time between two pushes (default 1m).
+	Interval Duration `json:"interval,omitempty" yaml:"interval,omitempty"`
+	// Timeout bounds each push (default 30s).
+	Timeout Duration `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	// Ingest configures the central side.
+	Ingest AgentIngestConfig `json:"ingest,omitempty" yaml:"ingest,omitempty"`
+}
+
+// AgentIngestConfig controls whether this instance accepts the FQDNs pushed
+// by agents.
+type AgentIngestConfig struct {
+	// Enabled turns PublishEndpoints on. It requires authentication.
+	Enabled bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	// TTL deletes the DNSRecord of an agent that has not pushed for that long
+	// (default 10m).
+	TTL Duration `json:"ttl,omitempty" yaml:"ttl,omitempty"`
+	// Agents are the agents allowed to push, each to a single portal. A push
+	// from an agent not listed here is rejected.
+	Agents []IngestAgentConfig `json:"agents,omitempty" yaml:"agents,omitempty"`
+}
+
+// IngestAgentConfig declares an agent allowed to push to the central
+// instance.
+type IngestAgentConfig struct {
+	// Name is the agent name (agent.name of the agent), as a DNS label.
+	Name string `json:"name" yaml:"name"`
+	// Portal is the only portal the agent may push to.
+	Portal string `json:"portal" yaml:"portal"`
+	// Identity is the authenticated identity the agent pushes with: the
+	// agent name of a push is derived from it. Defaults to the API token
+	// named after the agent, "token:<name>".
+	Identity string `json:"identity,omitempty" yaml:"identity,omitempty"`
+}
+
+// IdentityOrDefault returns Identity, or the identity of the API token named
+// after the agent when unset.
+func (a IngestAgentConfig) IdentityOrDefault() string {
+	if a.Identity != "" {
+		return a.Identity
+	}
+	return "token:" + a.Name
+}
+
+func (c AgentConfig) validate() error {
+	if c.Interval.Duration() <= 0 {
+		return fmt.Errorf("interval: %w", ErrInvalidInterval)
+	}
+	if c.Timeout.Duration() <= 0 {
+		return fmt.Errorf("timeout: %w", ErrInvalidTimeout)
+	}
+	if !c.Ingest.Enabled {
+		return nil
+	}
+	if c.Ingest.TTL.Duration() <= 0 {
+		return fmt.Errorf("ingest.ttl: %w", ErrInvalidInterval)
+	}
+	if len(c.Ingest.Agents) == 0 {
+		return fmt.Errorf("ingest.agents: %w: at least one agent is required", ErrInvalidAgent)
+	}
+	seen := make(map[string]bool, len(c.Ingest.Agents))
+	identities := make(map[string]bool, len(c.Ingest.Agents))
+	for i, a := range c.Ingest.Agents {
+		if errs := validation.IsDNS1123Label(a.Name); len(errs) > 0 {
+			return fmt.Errorf("ingest.agents[%d].name: %w: %q", i, ErrInvalidAgent, a.Name)
+		}
+		if seen[a.Name] {
+			return fmt.Errorf("ingest.agents[%d].name: %w: duplicate %q", i, ErrInvalidAgent, a.Name)
+		}
+		seen[a.Name] = true
+		id := a.IdentityOrDefault()
+		if identities[id] {
+			return fmt.Errorf("ingest.agents[%d].identity: %w: duplicate %q", i, ErrInvalidAgent, id)
+		}
+		identities[id] = true
+		if a.Portal == "" {
+			return fmt.Errorf("ingest.agents[%d].portal: %w", i, ErrInvalidAgent)
+		}
+	}
+	return nil
+}
+
+// ValidateAgentMode checks the fields required to run in agent mode.
+func (c AgentConfig) ValidateAgentMode() error {
+	if errs := validation.IsDNS1123Label(c.Name); len(errs) > 0 {
+		return fmt.Errorf("name: %w: %q", ErrInvalidAgent, c.Name)
+	}
+	if c.Portal == "" {
+		return fmt.Errorf("portal: %w", ErrInvalidAgent)
+	}
+	u, err := url.Parse(c.CentralURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("centralURL: %w", ErrInvalidAgent)
+	}
+	if c.HeaderName == "" {
+		return fmt.Errorf("headerName: %w", ErrInvalidAgent)
+	}
+	return nil
+}
+
+// FaultInjectionConfig makes selected source kinds fail a share of their
+// collections. It is meant to rehearse alerts and UI behaviour under a partial
+// discovery outage, never for production use.
+type FaultInjectionConfig struct {
+	// Sources maps a source kind (service, ingress, ...) to its fault rule.
+	Sources map[string]SourceFaultConfig `json:"sources,omitempty" yaml:"sources,omitempty"`
+}
+
+// SourceFaultConfig makes a source kind fail Failures collections out of
+// every OutOf.
+type SourceFaultConfig struct {
+	Failures int `json:"failures" yaml:"failures"`
+	OutOf    int `json:"outOf" yaml:"outOf"`
+}
+
+func (c *FaultInjectionConfig) validate() error {
+	for kind, f := range c.Sources {
+		if f.OutOf < 1 || f.Failures < 0 || f.Failures > f.OutOf {
+			return fmt.Errorf("sources.%s: %w", kind, ErrInvalidFaultInjection)
+		}
+	}
+	return nil
+}
+
+// MCPConfig configures the MCP servers.
+type MCPConfig struct {
+	// Sessions bounds the Streamable HTTP sessions of each MCP server.
+	Sessions MCPSessionsConfig `json:"sessions,omitempty" yaml:"sessions,omitempty"`
+}
+
+// MCPSessionsConfig bounds the Streamable HTTP sessions of each MCP server.
+// Zero values disable the matching limit.
+type MCPSessionsConfig struct {
+	// MaxSessions caps the concurrent sessions per MCP server.
+	MaxSessions int `json:"maxSessions,omitempty" yaml:"maxSessions,omitempty"`
+	// IdleTimeout closes sessions that sent no request for that long.
+	IdleTimeout Duration `json:"idleTimeout,omitempty" yaml:"idleTimeout,omitempty"`
+}
+
+// AnalyticsConfig configures the opt-in usage analytics. Counters are kept in
+// memory, are not tied to a user, address or session, and restart from zero
+// with the operator.
+type AnalyticsConfig struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// MaxKeys caps the distinct search terms and the distinct groups counted
+	// per portal.
+	MaxKeys int `json:"maxKeys,omitempty" yaml:"maxKeys,omitempty"`
+	// MaxClientEvents caps the events accepted from a client address per
+	// minute.
+	MaxClientEvents int `json:"maxClientEvents,omitempty" yaml:"maxClientEvents,omitempty"`
+}
+
+// WebConfig configures the HTTP server serving the web UI and the Connect API.
+type WebConfig struct {
+	CORS            CORSConfig            `json:"cors,omitempty" yaml:"cors,omitempty"`
+	SecurityHeaders SecurityHeadersConfig `json:"securityHeaders,omitempty" yaml:"securityHeaders,omitempty"`
+	// GroupSeparator splits group names into a hierarchy served to the UI,
+	// e.g. "Platform/Networking" nests under "Platform" (default "/"). An
+	// empty value keeps every group at the top level.
+	GroupSeparator string `json:"groupSeparator" yaml:"groupSeparator"`
+	// Streams configures the long-lived FQDN streams.
+	Streams WebStreamsConfig `json:"streams,omitempty" yaml:"streams,omitempty"`
+	// RPC configures the interceptors shared by every Connect handler.
+	RPC WebRPCConfig `json:"rpc,omitempty" yaml:"rpc,omitempty"`
+	// PublicURL is the external URL of the web UI (e.g.
+	// https://sreportal.example.com), used to build share links. When empty,
+	// links are built from the Origin header of the request.
+	PublicURL string `json:"publicURL,omitempty" yaml:"publicURL,omitempty"`
+}
+
+// WebRPCConfig configures the interceptors shared by every Connect handler.
+type WebRPCConfig struct {
+	// Timeout bounds a unary call. Zero leaves calls unbounded. Streaming
+	// calls are never bounded.
+	Timeout Duration `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	// Timeouts overrides Timeout per procedure, keyed by the full procedure
+	// name (e.g. "/sreportal.v1.DNSService/ListFQDNs").
+	Timeouts map[string]Duration `json:"timeouts,omitempty" yaml:"timeouts,omitempty"`
+}
+
+// TimeoutFor returns the timeout of procedure.
+func (c WebRPCConfig) TimeoutFor(procedure string) time.Duration {
+	if d, ok := c.Timeouts[procedure]; ok {
+		return d.Duration()
+	}
+	return c.Timeout.Duration()
+}
+
+func (c WebRPCConfig) validate() error {
+	if c.Timeout.Duration() < 0 {
+		return fmt.Errorf("timeout: %w", ErrInvalidWebRPC)
+	}
+	for procedure, d := range c.Timeouts {
+		if !strings.HasPrefix(procedure, "/") || d.Duration() < 0 {
+			return fmt.Errorf("timeouts[%s]: %w", procedure, ErrInvalidWebRPC)
+		}
+	}
+	return nil
+}
+
+// WebStreamsConfig configures the long-lived FQDN streams.
+type WebStreamsConfig struct {
+	// SendTimeout disconnects a subscriber that does not read an update
+	// within that long, releasing the state held for it. Zero never
+	// disconnects.
+	SendTimeout Duration `json:"sendTimeout,omitempty" yaml:"sendTimeout,omitempty"`
+	// HeartbeatInterval is how long a subscriber may go without a message
+	// before it is sent a heartbeat, so that proxies do not close idle
+	// streams and subscribers that stopped reading are detected while no
+	// FQDN changes. Zero sends no heartbeat.
+	HeartbeatInterval Duration `json:"heartbeatInterval,omitempty" yaml:"heartbeatInterval,omitempty"`
+}
+
+// SecurityHeadersConfig sets the security headers added to every web server
+// response. An empty value omits the corresponding header.
+type SecurityHeadersConfig struct {
+	// ContentSecurityPolicy is the Content-Security-Policy header value.
+	ContentSecurityPolicy string `json:"contentSecurityPolicy,omitempty" yaml:"contentSecurityPolicy,omitempty"`
+	// CSPReportOnly sends the policy as Content-Security-Policy-Report-Only,
+	// to test a policy without enforcing it.
+	CSPReportOnly bool `json:"cspReportOnly,omitempty" yaml:"cspReportOnly,omitempty"`
+	// FrameOptions is the X-Frame-Options header value: DENY or SAMEORIGIN.
+	FrameOptions string `json:"frameOptions,omitempty" yaml:"frameOptions,omitempty"`
+	// ReferrerPolicy is the Referrer-Policy header value.
+	ReferrerPolicy string `json:"referrerPolicy,omitempty" yaml:"referrerPolicy,omitempty"`
+	// HSTS configures Strict-Transport-Security, sent on HTTPS requests only.
+	HSTS HSTSConfig `json:"hsts,omitempty" yaml:"hsts,omitempty"`
+}
+
+// HSTSConfig configures the Strict-Transport-Security header.
+type HSTSConfig struct {
+	// MaxAge is how long browsers must only use HTTPS; zero disables HSTS.
+	MaxAge Duration `json:"maxAge,omitempty" yaml:"maxAge,omitempty"`
+	// IncludeSubDomains extends the policy to every subdomain.
+	IncludeSubDomains bool `json:"includeSubDomains,omitempty" yaml:"includeSubDomains,omitempty"`
+	// Preload allows the host to be added to browser HSTS preload lists.
+	// It requires IncludeSubDomains.
+	Preload bool `json:"preload,omitempty" yaml:"preload,omitempty"`
+}
+
+// CORSConfig controls cross-origin access to the web server, e.g. for a UI
+// served from a separate origin. CORS is disabled when no origin is allowed.
+type CORSConfig struct {
+	// AllowedOrigins lists the origins ("scheme://host[:port]", or "*") allowed
+	// to call the API. Origins given with --cors-allowed-origins are added.
+	AllowedOrigins []string `json:"allowedOrigins,omitempty" yaml:"allowedOrigins,omitempty"`
+	// AllowedMethods overrides the methods allowed in preflight responses
+	// (default: GET, POST, OPTIONS).
+	AllowedMethods []string `json:"allowedMethods,omitempty" yaml:"allowedMethods,omitempty"`
+	// AllowedHeaders overrides the request headers allowed in preflight
+	// responses (default: the Connect, gRPC-Web and authentication headers).
+	AllowedHeaders []string `json:"allowedHeaders,omitempty" yaml:"allowedHeaders,omitempty"`
+	// ExposedHeaders overrides the response headers readable by the browser
+	// (default: the gRPC status headers).
+	ExposedHeaders []string `json:"exposedHeaders,omitempty" yaml:"exposedHeaders,omitempty"`
+	// AllowCredentials lets browsers send cookies and Authorization headers.
+	// It cannot be combined with the "*" origin.
+	AllowCredentials bool `json:"allowCredentials,omitempty" yaml:"allowCredentials,omitempty"`
+	// MaxAge is how long browsers may cache a preflight response.
+	MaxAge Duration `json:"maxAge,omitempty" yaml:"maxAge,omitempty"`
+}
+
 // DefaultConfig returns a default configuration.
 func DefaultConfig() *OperatorConfig {
 	return &OperatorConfig{
@@ -324,9 +1640,88 @@ func DefaultConfig() *OperatorConfig {
 			Interval:     Duration(5 * time.Minute),
 			RetryOnError: Duration(30 * time.Second),
 		},
+		RemoteSync: RemoteSyncConfig{
+			Interval:      Duration(5 * time.Minute),
+			Jitter:        0.1,
+			MaxConcurrent: 2,
+		},
 		Release: ReleaseConfig{
 			TTL: Duration(30 * 24 * time.Hour),
 		},
+		Probes: ProbesConfig{
+			Interval: Duration(time.Minute),
+			Timeout:  Duration(2 * time.Second),
+		},
+		Consistency: ConsistencyConfig{
+			Interval: Duration(10 * time.Minute),
+		},
+		Uniqueness: UniquenessConfig{
+			Interval: Duration(time.Hour),
+		},
+		SnapshotPublisher: SnapshotPublisherConfig{
+			Tag:      "latest",
+			Interval: Duration(5 * time.Minute),
+		},
+		Portal: PortalConfig{
+			InsecureSkipVerifyDenySelector: "environment=production",
+		},
+		AutoPortal: AutoPortalConfig{
+			Selector:        "sreportal.io/auto-portal=true",
+			TitleAnnotation: "sreportal.io/portal-title",
+		},
+		DNSRecord: DNSRecordConfig{
+			TombstoneRetention: Duration(24 * time.Hour),
+		},
+		CMDB: CMDBConfig{
+			Exporter:        CMDBExporterServiceNow,
+			Interval:        Duration(15 * time.Minute),
+			OwnerAnnotation: "sreportal.io/owner",
+			TierAnnotation:  "sreportal.io/tier",
+			ServiceNow: ServiceNowConfig{
+				Table:   "cmdb_ci_dns_name",
+				Timeout: Duration(30 * time.Second),
+			},
+		},
+		Digest: DigestConfig{
+			MaxItems: 50,
+			Certificates: DigestCertificatesConfig{
+				Port:       443,
+				WarnWithin: Duration(21 * 24 * time.Hour),
+				Timeout:    Duration(5 * time.Second),
+			},
+		},
+		Agent: AgentConfig{
+			HeaderName: "Authorization",
+			Interval:   Duration(time.Minute),
+			Timeout:    Duration(30 * time.Second),
+			Ingest: AgentIngestConfig{
+				TTL: Duration(10 * time.Minute),
+			},
+		},
+		Web: WebConfig{
+			SecurityHeaders: SecurityHeadersConfig{
+				FrameOptions:   "SAMEORIGIN",
+				ReferrerPolicy: "strict-origin-when-cross-origin",
+			},
+			GroupSeparator: "/",
+			Streams: WebStreamsConfig{
+				SendTimeout:       Duration(30 * time.Second),
+				HeartbeatInterval: Duration(30 * time.Second),
+			},
+			RPC: WebRPCConfig{
+				Timeout: Duration(30 * time.Second),
+			},
+		},
+		MCP: MCPConfig{
+			Sessions: MCPSessionsConfig{
+				MaxSessions: 100,
+				IdleTimeout: Duration(30 * time.Minute),
+			},
+		},
+		Analytics: AnalyticsConfig{
+			MaxKeys:         1000,
+			MaxClientEvents: 120,
+		},
 	}
 }
 
@@ -335,12 +1730,192 @@ func (c *OperatorConfig) Validate() error {
 	if c.Reconciliation.Interval.Duration() <= 0 {
 		return fmt.Errorf("reconciliation.interval: %w", ErrInvalidInterval)
 	}
-	if c.GroupMapping.DefaultGroup == "" {
-		return fmt.Errorf("groupMapping.defaultGroup: %w", ErrEmptyDefaultGroup)
+	if c.RemoteSync.Interval.Duration() <= 0 {
+		return fmt.Errorf("remoteSync.interval: %w", ErrInvalidInterval)
+	}
+	if c.RemoteSync.Jitter < 0 || c.RemoteSync.Jitter > 1 {
+		return fmt.Errorf("remoteSync.jitter: %w", ErrInvalidJitter)
+	}
+	if c.RemoteSync.MaxConcurrent < 1 {
+		return fmt.Errorf("remoteSync.maxConcurrent: %w", ErrInvalidConcurrency)
+	}
+	if err := c.GroupMapping.validate(); err != nil {
+		return fmt.Errorf("groupMapping.%w", err)
+	}
+	if c.Probes.Interval.Duration() <= 0 {
+		return fmt.Errorf("probes.interval: %w", ErrInvalidInterval)
+	}
+	if c.Probes.Timeout.Duration() <= 0 {
+		return fmt.Errorf("probes.timeout: %w", ErrInvalidTimeout)
+	}
+	if c.Consistency.Interval.Duration() < 0 {
+		return fmt.Errorf("consistency.interval: %w", ErrInvalidInterval)
+	}
+	if c.Uniqueness.Interval.Duration() < 0 {
+		return fmt.Errorf("uniqueness.interval: %w", ErrInvalidInterval)
+	}
+	if _, err := domaindns.NewRecordNamer(c.DNSRecord.NameTemplate); err != nil {
+		return fmt.Errorf("dnsRecord.nameTemplate: %w", err)
+	}
+	if c.DNSRecord.TombstoneRetention.Duration() < 0 {
+		return fmt.Errorf("dnsRecord.tombstoneRetention: %w", ErrInvalidInterval)
+	}
+	if err := c.Sources.validate(); err != nil {
+		return fmt.Errorf("sources.%w", err)
+	}
+	if err := c.Portal.validate(); err != nil {
+		return fmt.Errorf("portal.%w", err)
+	}
+	if err := c.SnapshotPublisher.validate(); err != nil {
+		return fmt.Errorf("snapshotPublisher.%w", err)
+	}
+	if err := c.AutoPortal.validate(); err != nil {
+		return fmt.Errorf("autoPortal.%w", err)
+	}
+	if err := c.CMDB.validate(); err != nil {
+		return fmt.Errorf("cmdb.%w", err)
+	}
+	if err := c.Digest.validate(); err != nil {
+		return fmt.Errorf("digest.%w", err)
+	}
+	if err := c.Agent.validate(); err != nil {
+		return fmt.Errorf("agent.%w", err)
+	}
+	if err := c.FaultInjection.validate(); err != nil {
+		return fmt.Errorf("faultInjection.%w", err)
+	}
+	if err := c.DNSResolution.validate(); err != nil {
+		return fmt.Errorf("dnsResolution.%w", err)
+	}
+	if err := c.DNSPipeline.validate(); err != nil {
+		return fmt.Errorf("dnsPipeline.%w", err)
+	}
+	if err := c.ScaleGuard.validate(); err != nil {
+		return fmt.Errorf("scaleGuard.%w", err)
+	}
+	if err := c.Quota.validate(); err != nil {
+		return fmt.Errorf("quota: %w", err)
 	}
 	if err := c.Auth.validate(); err != nil {
 		return fmt.Errorf("auth: %w", err)
 	}
+	if err := c.Web.CORS.validate(); err != nil {
+		return fmt.Errorf("web.cors: %w", err)
+	}
+	if err := c.Web.SecurityHeaders.validate(); err != nil {
+		return fmt.Errorf("web.securityHeaders: %w", err)
+	}
+	if c.Web.Streams.SendTimeout.Duration() < 0 {
+		return fmt.Errorf("web.streams.sendTimeout: %w", ErrInvalidWebStreams)
+	}
+	if c.Web.Streams.HeartbeatInterval.Duration() < 0 {
+		return fmt.Errorf("web.streams.heartbeatInterval: %w", ErrInvalidWebStreams)
+	}
+	if err := c.Web.RPC.validate(); err != nil {
+		return fmt.Errorf("web.rpc: %w", err)
+	}
+	if c.Web.PublicURL != "" && !validPublicURL(c.Web.PublicURL) {
+		return fmt.Errorf("web.publicURL %q: %w", c.Web.PublicURL, ErrInvalidPublicURL)
+	}
+	if o := c.Auth.OIDC; o != nil && o.Enabled && o.RedirectURL == "" && c.Web.PublicURL == "" {
+		return fmt.Errorf("auth: oidc: %w: redirectURL is required when web.publicURL is unset", ErrInvalidOIDC)
+	}
+	if c.MCP.Sessions.MaxSessions < 0 {
+		return fmt.Errorf("mcp.sessions.maxSessions: %w", ErrInvalidMCPSessions)
+	}
+	if c.MCP.Sessions.IdleTimeout.Duration() < 0 {
+		return fmt.Errorf("mcp.sessions.idleTimeout: %w", ErrInvalidMCPSessions)
+	}
+	if c.Analytics.MaxKeys < 0 {
+		return fmt.Errorf("analytics.maxKeys: %w", ErrInvalidAnalytics)
+	}
+	if c.Analytics.MaxClientEvents < 0 {
+		return fmt.Errorf("analytics.maxClientEvents: %w", ErrInvalidAnalytics)
+	}
+	return nil
+}
+
+// referrerPolicies are the Referrer-Policy values defined by the W3C spec.
+var referrerPolicies = []string{
+	"no-referrer",
+	"no-referrer-when-downgrade",
+	"origin",
+	"origin-when-cross-origin",
+	"same-origin",
+	"strict-origin",
+	"strict-origin-when-cross-origin",
+	"unsafe-url",
+}
+
+func (c SecurityHeadersConfig) validate() error {
+	switch c.FrameOptions {
+	case "", "DENY", "SAMEORIGIN":
+	default:
+		return fmt.Errorf("frameOptions %q: %w", c.FrameOptions, ErrInvalidSecurityHeader)
+	}
+	if c.ReferrerPolicy != "" && !slices.Contains(referrerPolicies, c.ReferrerPolicy) {
+		return fmt.Errorf("referrerPolicy %q: %w", c.ReferrerPolicy, ErrInvalidSecurityHeader)
+	}
+	if c.HSTS.MaxAge.Duration() < 0 {
+		return fmt.Errorf("hsts.maxAge: %w", ErrInvalidSecurityHeader)
+	}
+	if c.HSTS.Preload && !c.HSTS.IncludeSubDomains {
+		return fmt.Errorf("hsts.preload requires hsts.includeSubDomains: %w", ErrInvalidSecurityHeader)
+	}
+	return nil
+}
+
+func (c CORSConfig) validate() error {
+	if c.MaxAge.Duration() < 0 {
+		return fmt.Errorf("maxAge: %w", ErrInvalidCORS)
+	}
+	for _, origin := range c.AllowedOrigins {
+		if origin == "*" {
+			if c.AllowCredentials {
+				return fmt.Errorf("allowCredentials with the \"*\" origin: %w", ErrInvalidCORS)
+			}
+			continue
+		}
+		u, err := url.Parse(origin)
+		if err != nil || u.Scheme == "" || u.Host == "" || (u.Path != "" && u.Path != "/") {
+			return fmt.Errorf("origin %q: %w", origin, ErrInvalidCORS)
+		}
+	}
+	return nil
+}
+
+// validLinkURL reports whether raw is an absolute http(s) URL.
+func validLinkURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// validPublicURL reports whether raw is an absolute http(s) URL without query
+// or fragment.
+func validPublicURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" &&
+		u.RawQuery == "" && u.Fragment == ""
+}
+
+func (c DNSResolutionConfig) validate() error {
+	if err := c.Resolver.validate(); err != nil {
+		return fmt.Errorf("resolver.%w", err)
+	}
+	if strings.HasPrefix(c.ExternalResolver, "https://") {
+		if !validDoHURL(c.ExternalResolver) {
+			return fmt.Errorf("externalResolver %q: %w", c.ExternalResolver, ErrInvalidResolverAddress)
+		}
+		return nil
+	}
+	addr := c.ExternalResolverAddr()
+	if addr == "" {
+		return nil
+	}
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil || host == "" || port == "" {
+		return fmt.Errorf("externalResolver %q: %w", c.ExternalResolver, ErrInvalidResolverAddress)
+	}
 	return nil
 }
 
@@ -358,5 +1933,41 @@ func (c *AuthConfig) validate() error {
 			}
 		}
 	}
+	if t := c.APITokens; t != nil && t.Enabled {
+		if t.SecretName == "" {
+			return fmt.Errorf("apiTokens: %w: secretName is required", ErrInvalidAPITokens)
+		}
+		if t.ReloadInterval.Duration() < 0 {
+			return fmt.Errorf("apiTokens: %w: reloadInterval must not be negative", ErrInvalidAPITokens)
+		}
+	}
+	if o := c.OIDC; o != nil && o.Enabled {
+		if !validPublicURL(o.IssuerURL) {
+			return fmt.Errorf("oidc: %w: issuerURL %q is not an http(s) URL", ErrInvalidOIDC, o.IssuerURL)
+		}
+		if o.ClientID == "" {
+			return fmt.Errorf("oidc: %w: clientID is required", ErrInvalidOIDC)
+		}
+		if o.RedirectURL != "" && !validPublicURL(o.RedirectURL) {
+			return fmt.Errorf("oidc: %w: redirectURL %q is not an http(s) URL", ErrInvalidOIDC, o.RedirectURL)
+		}
+		if o.SessionTTL.Duration() < 0 {
+			return fmt.Errorf("oidc: %w: sessionTTL must not be negative", ErrInvalidOIDC)
+		}
+	}
+	if w := c.AuthorizationWebhook; w != nil && w.Enabled {
+		u, err := url.Parse(w.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%w: url %q is not an http(s) URL", ErrInvalidAuthorizationWebhook, w.URL)
+		}
+		switch w.FailurePolicy {
+		case "", AuthorizationFailurePolicyDeny, AuthorizationFailurePolicyAllow:
+		default:
+			return fmt.Errorf("%w: failurePolicy %q is not Deny or Allow", ErrInvalidAuthorizationWebhook, w.FailurePolicy)
+		}
+		if w.Timeout.Duration() < 0 || w.CacheTTL.Duration() < 0 {
+			return fmt.Errorf("%w: timeout and cacheTTL must not be negative", ErrInvalidAuthorizationWebhook)
+		}
+	}
 	return nil
 }
@@ -0,0 +1,167 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package autoportal creates a Portal for every namespace selected by the
+// autoPortal operator configuration.
+package autoportal
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/adapter"
+	"github.com/golgoth31/sreportal/internal/config"
+	"github.com/golgoth31/sreportal/internal/log"
+)
+
+// NamespaceReconciler keeps one Portal per selected namespace. The portal is
+// named after the namespace, created in the operator namespace and labelled
+// with adapter.AutoPortalNamespaceLabelKey; the Portal controller then gives it
+// a DNS CR discovering only that namespace. The portal is deleted when the
+// namespace stops matching the selector or goes away.
+//
+// A Portal of the same name not created by this reconciler is never touched.
+type NamespaceReconciler struct {
+	client.Client
+	namespace       string
+	selector        labels.Selector
+	titleAnnotation string
+}
+
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+// NewNamespaceReconciler returns a NamespaceReconciler creating portals in
+// namespace. cfg must have been validated.
+func NewNamespaceReconciler(c client.Client, namespace string, cfg config.AutoPortalConfig) (*NamespaceReconciler, error) {
+	selector, err := labels.Parse(cfg.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("parse auto portal selector: %w", err)
+	}
+	return &NamespaceReconciler{
+		Client:          c,
+		namespace:       namespace,
+		selector:        selector,
+		titleAnnotation: cfg.TitleAnnotation,
+	}, nil
+}
+
+// Reconcile creates, updates or deletes the portal of a namespace.
+func (r *NamespaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx).WithName("auto-portal")
+
+	var ns corev1.Namespace
+	if err := r.Get(ctx, types.NamespacedName{Name: req.Name}, &ns); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, r.deletePortal(ctx, req.Name)
+	}
+
+	if !ns.DeletionTimestamp.IsZero() || !r.selector.Matches(labels.Set(ns.Labels)) {
+		return ctrl.Result{}, r.deletePortal(ctx, ns.Name)
+	}
+
+	title := ns.Annotations[r.titleAnnotation]
+	if title == "" {
+		title = ns.Name
+	}
+
+	var portal sreportalv1alpha1.Portal
+	err := r.Get(ctx, types.NamespacedName{Name: ns.Name, Namespace: r.namespace}, &portal)
+	switch {
+	case apierrors.IsNotFound(err):
+		portal = sreportalv1alpha1.Portal{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ns.Name,
+				Namespace: r.namespace,
+				Labels:    map[string]string{adapter.AutoPortalNamespaceLabelKey: ns.Name},
+			},
+			Spec: sreportalv1alpha1.PortalSpec{Title: title},
+		}
+		adapter.SetStandardLabels(&portal, ns.Name)
+		if err := r.Create(ctx, &portal); err != nil {
+			return ctrl.Result{}, fmt.Errorf("create portal for namespace %q: %w", ns.Name, err)
+		}
+		logger.Info("created portal for namespace", "namespace", ns.Name, "title", title)
+		return ctrl.Result{}, nil
+	case err != nil:
+		return ctrl.Result{}, err
+	}
+
+	if portal.Labels[adapter.AutoPortalNamespaceLabelKey] != ns.Name {
+		logger.Info("portal name already taken, skipping namespace", "namespace", ns.Name, "portal", portal.Name)
+		return ctrl.Result{}, nil
+	}
+	if portal.Spec.Title == title {
+		return ctrl.Result{}, nil
+	}
+	base := portal.DeepCopy()
+	portal.Spec.Title = title
+	if err := r.Patch(ctx, &portal, client.MergeFrom(base)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("update portal title for namespace %q: %w", ns.Name, err)
+	}
+	logger.Info("updated portal title", "namespace", ns.Name, "title", title)
+	return ctrl.Result{}, nil
+}
+
+// deletePortal removes the automatic portal of a namespace, if any. Its DNS CR
+// follows through its owner reference.
+func (r *NamespaceReconciler) deletePortal(ctx context.Context, namespace string) error {
+	var list sreportalv1alpha1.PortalList
+	if err := r.List(ctx, &list,
+		client.InNamespace(r.namespace),
+		client.MatchingLabels{adapter.AutoPortalNamespaceLabelKey: namespace},
+	); err != nil {
+		return fmt.Errorf("list portals of namespace %q: %w", namespace, err)
+	}
+	for i := range list.Items {
+		if err := r.Delete(ctx, &list.Items[i]); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("delete portal %q: %w", list.Items[i].Name, err)
+		}
+		log.FromContext(ctx).WithName("auto-portal").Info("deleted portal of namespace",
+			"namespace", namespace, "portal", list.Items[i].Name)
+	}
+	return nil
+}
+
+// SetupWithManager registers the controller. Automatic portals are watched too,
+// so a portal deleted by hand is recreated and one left behind by a namespace
+// deleted while the operator was down is cleaned up at startup.
+func (r *NamespaceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Namespace{}).
+		Watches(&sreportalv1alpha1.Portal{}, handler.EnqueueRequestsFromMapFunc(
+			func(_ context.Context, obj client.Object) []reconcile.Request {
+				ns := obj.GetLabels()[adapter.AutoPortalNamespaceLabelKey]
+				if ns == "" || obj.GetNamespace() != r.namespace {
+					return nil
+				}
+				return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: ns}}}
+			})).
+		Named("autoportal").
+		Complete(r)
+}
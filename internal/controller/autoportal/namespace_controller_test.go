@@ -0,0 +1,160 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoportal_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/config"
+	"github.com/golgoth31/sreportal/internal/controller/autoportal"
+)
+
+const portalNamespace = "sreportal-system"
+
+func newReconciler(t *testing.T, objs ...client.Object) (*autoportal.NamespaceReconciler, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, sreportalv1alpha1.AddToScheme(scheme))
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	r, err := autoportal.NewNamespaceReconciler(cli, portalNamespace, config.DefaultConfig().AutoPortal)
+	require.NoError(t, err)
+	return r, cli
+}
+
+func namespace(name string, labels, annotations map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels, Annotations: annotations}}
+}
+
+func reconcileNamespace(t *testing.T, r *autoportal.NamespaceReconciler, name string) {
+	t.Helper()
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: name}})
+	require.NoError(t, err)
+}
+
+func getPortal(t *testing.T, cli client.Client, name string) (*sreportalv1alpha1.Portal, error) {
+	t.Helper()
+	var p sreportalv1alpha1.Portal
+	err := cli.Get(context.Background(), types.NamespacedName{Name: name, Namespace: portalNamespace}, &p)
+	return &p, err
+}
+
+func TestReconcileCreatesPortalForSelectedNamespace(t *testing.T) {
+	r, cli := newReconciler(t, namespace("team-a",
+		map[string]string{"sreportal.io/auto-portal": "true"},
+		map[string]string{"sreportal.io/portal-title": "Team A"}))
+
+	reconcileNamespace(t, r, "team-a")
+
+	p, err := getPortal(t, cli, "team-a")
+	require.NoError(t, err)
+	require.Equal(t, "Team A", p.Spec.Title)
+	require.False(t, p.Spec.Main)
+	require.Equal(t, "team-a", p.Labels["sreportal.io/auto-portal-namespace"])
+}
+
+func TestReconcileDefaultsTitleToNamespaceName(t *testing.T) {
+	r, cli := newReconciler(t, namespace("team-a", map[string]string{"sreportal.io/auto-portal": "true"}, nil))
+
+	reconcileNamespace(t, r, "team-a")
+
+	p, err := getPortal(t, cli, "team-a")
+	require.NoError(t, err)
+	require.Equal(t, "team-a", p.Spec.Title)
+}
+
+func TestReconcileUpdatesTitle(t *testing.T) {
+	ns := namespace("team-a", map[string]string{"sreportal.io/auto-portal": "true"}, nil)
+	r, cli := newReconciler(t, ns)
+	reconcileNamespace(t, r, "team-a")
+
+	ns.Annotations = map[string]string{"sreportal.io/portal-title": "Team A"}
+	require.NoError(t, cli.Update(context.Background(), ns))
+	reconcileNamespace(t, r, "team-a")
+
+	p, err := getPortal(t, cli, "team-a")
+	require.NoError(t, err)
+	require.Equal(t, "Team A", p.Spec.Title)
+}
+
+func TestReconcileIgnoresUnselectedNamespace(t *testing.T) {
+	r, cli := newReconciler(t, namespace("team-a", nil, nil))
+
+	reconcileNamespace(t, r, "team-a")
+
+	_, err := getPortal(t, cli, "team-a")
+	require.True(t, apierrors.IsNotFound(err))
+}
+
+func TestReconcileDeletesPortalWhenNamespaceLeaves(t *testing.T) {
+	ns := namespace("team-a", map[string]string{"sreportal.io/auto-portal": "true"}, nil)
+	r, cli := newReconciler(t, ns)
+	reconcileNamespace(t, r, "team-a")
+
+	ns.Labels = nil
+	require.NoError(t, cli.Update(context.Background(), ns))
+	reconcileNamespace(t, r, "team-a")
+
+	_, err := getPortal(t, cli, "team-a")
+	require.True(t, apierrors.IsNotFound(err))
+}
+
+func TestReconcileDeletesPortalOfMissingNamespace(t *testing.T) {
+	stale := &sreportalv1alpha1.Portal{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gone",
+			Namespace: portalNamespace,
+			Labels:    map[string]string{"sreportal.io/auto-portal-namespace": "gone"},
+		},
+		Spec: sreportalv1alpha1.PortalSpec{Title: "gone"},
+	}
+	r, cli := newReconciler(t, stale)
+
+	reconcileNamespace(t, r, "gone")
+
+	_, err := getPortal(t, cli, "gone")
+	require.True(t, apierrors.IsNotFound(err))
+}
+
+func TestReconcileLeavesUnmanagedPortalAlone(t *testing.T) {
+	manual := &sreportalv1alpha1.Portal{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a", Namespace: portalNamespace},
+		Spec:       sreportalv1alpha1.PortalSpec{Title: "Handmade"},
+	}
+	r, cli := newReconciler(t, manual, namespace("team-a",
+		map[string]string{"sreportal.io/auto-portal": "true"},
+		map[string]string{"sreportal.io/portal-title": "Team A"}))
+
+	reconcileNamespace(t, r, "team-a")
+
+	p, err := getPortal(t, cli, "team-a")
+	require.NoError(t, err)
+	require.Equal(t, "Handmade", p.Spec.Title)
+	require.Empty(t, p.Labels["sreportal.io/auto-portal-namespace"])
+}
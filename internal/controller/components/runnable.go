@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -36,11 +37,13 @@ import (
 	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
 	"github.com/golgoth31/sreportal/internal/adapter"
 	domainsource "github.com/golgoth31/sreportal/internal/domain/source"
+	"github.com/golgoth31/sreportal/internal/mainportal"
 	"github.com/golgoth31/sreportal/internal/statuspage"
 )
 
 // portalIndex is a pre-computed lookup structure built from the portal list.
 type portalIndex struct {
+	// Main is the elected main portal, nil when no portal is Local.
 	Main   *sreportalv1alpha1.Portal
 	ByName map[string]*sreportalv1alpha1.Portal
 	Local  []*sreportalv1alpha1.Portal
@@ -246,10 +249,8 @@ func (r *Reconciler) buildPortalIndex(ctx context.Context) (*portalIndex, error)
 			continue
 		}
 		idx.Local = append(idx.Local, p)
-		if p.Spec.Main {
-			idx.Main = p
-		}
 	}
+	idx.Main = mainportal.Elect(idx.Local)
 
 	return idx, nil
 }
@@ -301,19 +302,26 @@ func (r *Reconciler) reconcileComponent(
 		return fmt.Errorf("get component %q: %w", name, err)
 	}
 
+	base := existing.DeepCopy()
+
 	// Update metadata from annotation, but never overwrite spec.status.
 	existing.Spec.DisplayName = req.DisplayName
 	existing.Spec.Group = req.Group
 	existing.Spec.Description = req.Description
 	existing.Spec.Link = req.Link
 
-	// Ensure labels are set.
+	// Ensure labels are set, adopting components created before they existed.
 	if existing.Labels == nil {
 		existing.Labels = make(map[string]string)
 	}
 	existing.Labels[adapter.ManagedByLabelKey] = adapter.ManagedBySourceController
 	existing.Labels[adapter.PortalAnnotationKey] = portal.Name
 
+	// Skip the write when nothing changed to avoid resourceVersion churn on
+	// every tick.
+	if equality.Semantic.DeepEqual(base, &existing) {
+		return nil
+	}
 	if err := r.Client.Update(ctx, &existing); err != nil {
 		return fmt.Errorf("update component %q: %w", name, err)
 	}
@@ -0,0 +1,178 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package consistency cross-validates the DNS aggregation pipeline: the
+// DNSRecord spec.entries written by the DNS controller, the status endpoints
+// materialised from them, and the FQDN read store projected from the status.
+package consistency
+
+import (
+	"slices"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
+)
+
+// Check names a kind of discrepancy.
+type Check string
+
+const (
+	// CheckStatusMissing is a spec entry not materialised into the status.
+	CheckStatusMissing Check = "status_missing"
+	// CheckStatusStale is a status endpoint no spec entry backs any more.
+	CheckStatusStale Check = "status_stale"
+	// CheckStoreMissing is a status endpoint absent from the read store.
+	CheckStoreMissing Check = "store_missing"
+	// CheckStoreStale is a read store FQDN absent from the record status.
+	CheckStoreStale Check = "store_stale"
+	// CheckStoreOrphan is a read store record without DNSRecord or remote DNS.
+	CheckStoreOrphan Check = "store_orphan"
+	// CheckRecordOrphan is an auto DNSRecord whose owning DNS CR is gone.
+	CheckRecordOrphan Check = "record_orphan"
+)
+
+// Checks lists every check, in reporting order.
+var Checks = []Check{
+	CheckStatusMissing,
+	CheckStatusStale,
+	CheckStoreMissing,
+	CheckStoreStale,
+	CheckStoreOrphan,
+	CheckRecordOrphan,
+}
+
+// Mismatch is a single discrepancy.
+type Mismatch struct {
+	Check Check
+	// Record is the DNSRecord ("namespace/name") or read store record key.
+	Record string
+	// FQDN is the "<name>/<recordType>" key involved; empty for orphans.
+	FQDN string
+	// Owner is the DNS CR owning the record, when known.
+	Owner types.NamespacedName
+}
+
+func (m Mismatch) key() string {
+	return string(m.Check) + "|" + m.Record + "|" + m.FQDN
+}
+
+// String renders the mismatch for condition messages and logs.
+func (m Mismatch) String() string {
+	if m.FQDN == "" {
+		return string(m.Check) + " " + m.Record
+	}
+	return string(m.Check) + " " + m.Record + ": " + m.FQDN
+}
+
+// Find returns the discrepancies between records, dnsList and the read store
+// index (record key to "<name>/<recordType>" keys, see
+// FQDNStore.RecordFQDNs). Records whose status has not caught up with their
+// generation are still being reconciled and are only checked for orphaning.
+func Find(records []v1alpha2.DNSRecord, dnsList []v1alpha2.DNS, index map[string][]string) []Mismatch {
+	var out []Mismatch
+
+	dnsByKey := make(map[types.NamespacedName]*v1alpha2.DNS, len(dnsList))
+	for i := range dnsList {
+		dnsByKey[types.NamespacedName{Namespace: dnsList[i].Namespace, Name: dnsList[i].Name}] = &dnsList[i]
+	}
+
+	known := make(map[string]bool, len(records)+len(dnsList))
+	for i := range dnsList {
+		if dnsList[i].Spec.IsRemote {
+			known[dnsList[i].Namespace+"/"+dnsList[i].Name] = true
+		}
+	}
+
+	for i := range records {
+		rec := &records[i]
+		recordKey := rec.Namespace + "/" + rec.Name
+		known[recordKey] = true
+		owner, owned := ownerDNS(rec)
+
+		if owned {
+			if _, ok := dnsByKey[owner]; !ok && rec.Spec.Origin == v1alpha2.DNSRecordOriginAuto {
+				out = append(out, Mismatch{Check: CheckRecordOrphan, Record: recordKey, Owner: owner})
+			}
+		}
+		if rec.Status.ObservedGeneration != rec.Generation {
+			continue
+		}
+
+		spec := make(map[string]bool, len(rec.Spec.Entries))
+		for _, e := range rec.Spec.Entries {
+			spec[fqdnKey(e.FQDN, e.RecordType)] = true
+		}
+		status := make(map[string]bool, len(rec.Status.Endpoints))
+		visible := make(map[string]bool, len(rec.Status.Endpoints))
+		for j := range rec.Status.Endpoints {
+			ep := &rec.Status.Endpoints[j]
+			k := fqdnKey(ep.DNSName, ep.RecordType)
+			status[k] = true
+			if !adapter.IsEndpointStatusV2Ignored(ep) {
+				visible[k] = true
+			}
+		}
+		stored := make(map[string]bool)
+		for _, k := range index[recordKey] {
+			stored[k] = true
+		}
+
+		add := func(check Check, keys map[string]bool, against map[string]bool) {
+			for _, k := range sortedKeys(keys) {
+				if !against[k] {
+					out = append(out, Mismatch{Check: check, Record: recordKey, FQDN: k, Owner: owner})
+				}
+			}
+		}
+		add(CheckStatusMissing, spec, status)
+		add(CheckStatusStale, status, spec)
+		add(CheckStoreMissing, visible, stored)
+		add(CheckStoreStale, stored, visible)
+	}
+
+	for _, recordKey := range sortedKeys(index) {
+		if !known[recordKey] {
+			out = append(out, Mismatch{Check: CheckStoreOrphan, Record: recordKey})
+		}
+	}
+	return out
+}
+
+// ownerDNS returns the DNS CR controlling rec, if any.
+func ownerDNS(rec *v1alpha2.DNSRecord) (types.NamespacedName, bool) {
+	for _, ref := range rec.OwnerReferences {
+		if ref.Kind == "DNS" {
+			return types.NamespacedName{Namespace: rec.Namespace, Name: ref.Name}, true
+		}
+	}
+	return types.NamespacedName{}, false
+}
+
+// fqdnKey builds the key FQDNStore.RecordFQDNs reports.
+func fqdnKey(name, recordType string) string {
+	return name + "/" + recordType
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consistency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+)
+
+const (
+	tNS      = "ns"
+	fqdnAPI  = "api.example.com"
+	fqdnWeb  = "web.example.com"
+	fqdnGone = "gone.example.com"
+)
+
+func record(name string, owner string, entries []string, status []string) v1alpha2.DNSRecord {
+	rec := v1alpha2.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: tNS, Generation: 2},
+		Spec:       v1alpha2.DNSRecordSpec{Origin: v1alpha2.DNSRecordOriginAuto},
+		Status:     v1alpha2.DNSRecordStatus{ObservedGeneration: 2},
+	}
+	if owner != "" {
+		rec.OwnerReferences = []metav1.OwnerReference{{Kind: "DNS", Name: owner}}
+	}
+	for _, e := range entries {
+		rec.Spec.Entries = append(rec.Spec.Entries, v1alpha2.DNSRecordEntry{FQDN: e, RecordType: "A"})
+	}
+	for _, s := range status {
+		rec.Status.Endpoints = append(rec.Status.Endpoints, v1alpha2.EndpointStatus{DNSName: s, RecordType: "A"})
+	}
+	return rec
+}
+
+func dnsCR(name string, remote bool) v1alpha2.DNS {
+	return v1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: tNS},
+		Spec:       v1alpha2.DNSSpec{PortalRef: "main", IsRemote: remote},
+	}
+}
+
+func TestFind_Consistent(t *testing.T) {
+	records := []v1alpha2.DNSRecord{record("r", "d", []string{fqdnAPI}, []string{fqdnAPI})}
+	index := map[string][]string{tNS + "/r": {fqdnAPI + "/A"}, tNS + "/remote": {fqdnWeb + "/A"}}
+
+	require.Empty(t, Find(records, []v1alpha2.DNS{dnsCR("d", false), dnsCR("remote", true)}, index))
+}
+
+func TestFind_ReportsEachCheck(t *testing.T) {
+	records := []v1alpha2.DNSRecord{
+		record("r", "d", []string{fqdnAPI, fqdnWeb}, []string{fqdnAPI, fqdnGone}),
+		record("orphan", "deleted", nil, nil),
+	}
+	index := map[string][]string{
+		tNS + "/r":       {fqdnWeb + "/A"},
+		tNS + "/removed": {fqdnAPI + "/A"},
+	}
+
+	got := Find(records, []v1alpha2.DNS{dnsCR("d", false)}, index)
+
+	owner := types.NamespacedName{Namespace: tNS, Name: "d"}
+	require.Equal(t, []Mismatch{
+		{Check: CheckStatusMissing, Record: tNS + "/r", FQDN: fqdnWeb + "/A", Owner: owner},
+		{Check: CheckStatusStale, Record: tNS + "/r", FQDN: fqdnGone + "/A", Owner: owner},
+		{Check: CheckStoreMissing, Record: tNS + "/r", FQDN: fqdnAPI + "/A", Owner: owner},
+		{Check: CheckStoreMissing, Record: tNS + "/r", FQDN: fqdnGone + "/A", Owner: owner},
+		{Check: CheckStoreStale, Record: tNS + "/r", FQDN: fqdnWeb + "/A", Owner: owner},
+		{Check: CheckRecordOrphan, Record: tNS + "/orphan", Owner: types.NamespacedName{Namespace: tNS, Name: "deleted"}},
+		{Check: CheckStoreOrphan, Record: tNS + "/removed"},
+	}, got)
+}
+
+func TestFind_SkipsRecordsBeingReconciled(t *testing.T) {
+	rec := record("r", "d", []string{fqdnAPI}, nil)
+	rec.Generation = 3
+
+	require.Empty(t, Find([]v1alpha2.DNSRecord{rec}, []v1alpha2.DNS{dnsCR("d", false)}, nil))
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consistency
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/metrics"
+)
+
+const (
+	// ConditionTypeConsistent is set on each local DNS CR with the outcome of
+	// the last check of the DNSRecords it owns.
+	ConditionTypeConsistent = "Consistent"
+	// ReasonConsistent means no persistent discrepancy was found.
+	ReasonConsistent = "Consistent"
+	// ReasonInconsistent means at least one persistent discrepancy was found.
+	ReasonInconsistent = "Inconsistent"
+
+	// maxConditionSamples bounds the mismatches listed in a condition message.
+	maxConditionSamples = 5
+)
+
+// RecordIndex exposes the per-record content of the FQDN read store.
+type RecordIndex interface {
+	RecordFQDNs() map[string][]string
+}
+
+// Runnable periodically cross-validates DNSRecords, their status and the FQDN
+// read store. A discrepancy is reported only when two consecutive checks find
+// it, so the lag of an in-flight reconcile is not mistaken for a bug. Results
+// go to the dns_consistency_mismatches metric and the Consistent condition of
+// the owning DNS CRs; nothing is repaired.
+type Runnable struct {
+	Client   client.Client
+	Index    RecordIndex
+	Interval time.Duration
+
+	previous map[string]bool
+}
+
+// New creates a Runnable checking every interval.
+func New(c client.Client, index RecordIndex, interval time.Duration) *Runnable {
+	return &Runnable{Client: c, Index: index, Interval: interval}
+}
+
+// Start implements manager.Runnable.
+func (r *Runnable) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("consistency")
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := r.tick(ctx); err != nil {
+				logger.Error(err, "consistency check failed")
+			}
+		}
+	}
+}
+
+var _ manager.Runnable = (*Runnable)(nil)
+
+// tick runs one check and publishes its persistent mismatches.
+func (r *Runnable) tick(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("consistency")
+
+	var records v1alpha2.DNSRecordList
+	if err := r.Client.List(ctx, &records); err != nil {
+		return fmt.Errorf("list DNSRecords: %w", err)
+	}
+	var dnsList v1alpha2.DNSList
+	if err := r.Client.List(ctx, &dnsList); err != nil {
+		return fmt.Errorf("list DNS: %w", err)
+	}
+
+	found := Find(records.Items, dnsList.Items, r.Index.RecordFQDNs())
+	persistent := r.persistent(found)
+
+	counts := make(map[Check]int, len(Checks))
+	byOwner := map[types.NamespacedName][]Mismatch{}
+	for _, m := range persistent {
+		counts[m.Check]++
+		if m.Owner.Name != "" {
+			byOwner[m.Owner] = append(byOwner[m.Owner], m)
+		}
+		logger.Info("DNS aggregation discrepancy", "check", m.Check, "record", m.Record, "fqdn", m.FQDN)
+	}
+	for _, c := range Checks {
+		metrics.DNSConsistencyMismatches.WithLabelValues(string(c)).Set(float64(counts[c]))
+	}
+	metrics.DNSConsistencyLastCheck.SetToCurrentTime()
+
+	for i := range dnsList.Items {
+		dns := &dnsList.Items[i]
+		if dns.Spec.IsRemote {
+			continue
+		}
+		key := types.NamespacedName{Namespace: dns.Namespace, Name: dns.Name}
+		if err := r.setCondition(ctx, dns, byOwner[key]); err != nil {
+			logger.Error(err, "update Consistent condition failed", "dns", key)
+		}
+	}
+	return nil
+}
+
+// persistent returns the mismatches of found that the previous check also
+// found, and remembers found for the next check.
+func (r *Runnable) persistent(found []Mismatch) []Mismatch {
+	current := make(map[string]bool, len(found))
+	var out []Mismatch
+	for _, m := range found {
+		k := m.key()
+		current[k] = true
+		if r.previous[k] {
+			out = append(out, m)
+		}
+	}
+	r.previous = current
+	return out
+}
+
+// setCondition records mismatches as the Consistent condition of dns,
+// patching the status only when the condition changed.
+func (r *Runnable) setCondition(ctx context.Context, dns *v1alpha2.DNS, mismatches []Mismatch) error {
+	cond := metav1.Condition{
+		Type:    ConditionTypeConsistent,
+		Status:  metav1.ConditionTrue,
+		Reason:  ReasonConsistent,
+		Message: "DNSRecords, their status and the FQDN read store agree",
+	}
+	if len(mismatches) > 0 {
+		samples := make([]string, 0, maxConditionSamples)
+		for _, m := range mismatches[:min(len(mismatches), maxConditionSamples)] {
+			samples = append(samples, m.String())
+		}
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = ReasonInconsistent
+		cond.Message = fmt.Sprintf("%d discrepancies: %s", len(mismatches), strings.Join(samples, "; "))
+	}
+
+	base := dns.DeepCopy()
+	if !meta.SetStatusCondition(&dns.Status.Conditions, cond) {
+		return nil
+	}
+	return r.Client.Status().Patch(ctx, dns, client.MergeFrom(base))
+}
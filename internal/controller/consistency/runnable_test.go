@@ -0,0 +1,82 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consistency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/metrics"
+)
+
+// staticIndex is a RecordIndex returning a fixed content.
+type staticIndex map[string][]string
+
+func (s staticIndex) RecordFQDNs() map[string][]string { return s }
+
+func newTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha2.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).
+		WithStatusSubresource(&v1alpha2.DNS{}).
+		WithObjects(objs...).Build()
+}
+
+func consistentCondition(t *testing.T, c client.Client) *metav1.Condition {
+	t.Helper()
+	var dns v1alpha2.DNS
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: tNS, Name: "d"}, &dns))
+	return meta.FindStatusCondition(dns.Status.Conditions, ConditionTypeConsistent)
+}
+
+func TestRunnable_ReportsPersistentMismatchesOnly(t *testing.T) {
+	d := dnsCR("d", false)
+	rec := record("r", "d", []string{fqdnAPI}, []string{fqdnAPI})
+	c := newTestClient(t, &d, &rec)
+	r := New(c, staticIndex{}, 0)
+	ctx := context.Background()
+
+	// First sighting: could be a reconcile in flight, not reported yet.
+	require.NoError(t, r.tick(ctx))
+	require.Equal(t, metav1.ConditionTrue, consistentCondition(t, c).Status)
+	require.Zero(t, testutil.ToFloat64(metrics.DNSConsistencyMismatches.WithLabelValues(string(CheckStoreMissing))))
+
+	// Still there on the next check: reported.
+	require.NoError(t, r.tick(ctx))
+	cond := consistentCondition(t, c)
+	require.Equal(t, metav1.ConditionFalse, cond.Status)
+	require.Equal(t, ReasonInconsistent, cond.Reason)
+	require.Contains(t, cond.Message, "store_missing ns/r: api.example.com/A")
+	require.Equal(t, float64(1), testutil.ToFloat64(metrics.DNSConsistencyMismatches.WithLabelValues(string(CheckStoreMissing))))
+
+	// Fixed: back to consistent.
+	r.Index = staticIndex{tNS + "/r": {fqdnAPI + "/A"}}
+	require.NoError(t, r.tick(ctx))
+	require.Equal(t, metav1.ConditionTrue, consistentCondition(t, c).Status)
+	require.Zero(t, testutil.ToFloat64(metrics.DNSConsistencyMismatches.WithLabelValues(string(CheckStoreMissing))))
+}
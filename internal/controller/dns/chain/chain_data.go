@@ -17,6 +17,8 @@ limitations under the License.
 package dns
 
 import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/external-dns/endpoint"
 
 	"github.com/golgoth31/sreportal/internal/source/registry"
@@ -42,11 +44,27 @@ type ChainData struct {
 	// priority-deduped subset that UpsertDNSRecordsHandler will project.
 	KeptEndpointsByKind map[registry.SourceType][]*endpoint.Endpoint
 
-	// PriorityOrder is the iteration order across kinds (from
-	// spec.sources.priority + spec.sources.* enabled fallback). Provided to
+	// PriorityConflicts is populated by IntraDNSDedupHandler with the FQDNs
+	// produced by several kinds, sorted by FQDN. Surfaced on DNS status and in
+	// metrics so targets dropped by source priority can be explained.
+	PriorityConflicts []PriorityConflict
+
+	// PriorityOrder is the iteration order across kinds (from the Portal's
+	// spec.sourcePriority or spec.sources.priority + spec.sources.* enabled
+	// fallback). Provided to
 	// downstream handlers so they don't recompute it.
 	PriorityOrder []registry.SourceType
 
+	// PortalPriority is populated by LoadPortalHandler with the referenced
+	// Portal's spec.sourcePriority. When non-empty it replaces
+	// spec.sources.priority in PriorityOrder.
+	PortalPriority []registry.SourceType
+
+	// ExcludedNamespaces is populated by LoadPortalHandler for the main
+	// portal with the namespaces routed to an automatic namespace portal.
+	// LookupSourcesHandler drops the endpoints of these namespaces.
+	ExcludedNamespaces map[string]bool
+
 	// PortalDisabled is set to true when the Portal exists but has DNS
 	// feature disabled — controllers use this to choose between cleanup and
 	// production paths.
@@ -60,11 +78,28 @@ type ChainData struct {
 	// good persisted records until the sources catch up.
 	PreserveKinds map[registry.SourceType]bool
 
+	// UpsertedRecords holds the names of the DNSRecords written by
+	// UpsertDNSRecordsHandler on this reconcile. Any other auto DNSRecord
+	// owned by the DNS CR is a garbage-collection candidate.
+	UpsertedRecords map[string]bool
+
 	// SkippedEntries is populated by ValidateEntriesHandler with the endpoints
 	// dropped before projection because their FQDN failed the DNSRecord
 	// validation pattern. Surfaced on DNS status and in metrics so a single bad
 	// FQDN no longer aborts the whole reconcile silently.
 	SkippedEntries []SkippedEntry
+
+	// Recorder emits Kubernetes Events on the DNS CR (optional, populated by
+	// Reconcile before chain execution).
+	Recorder events.EventRecorder
+}
+
+// Event emits an Event regarding obj when a Recorder is configured.
+func (d *ChainData) Event(obj runtime.Object, eventtype, reason, action, note string, args ...any) {
+	if d.Recorder == nil {
+		return
+	}
+	d.Recorder.Eventf(obj, nil, eventtype, reason, action, note, args...)
 }
 
 // SkippedEntry records a single endpoint dropped during validation.
@@ -78,3 +113,17 @@ type SkippedEntry struct {
 	// Kind is the source kind that produced the entry.
 	Kind registry.SourceType
 }
+
+// PriorityConflict records an FQDN produced by several source kinds, of
+// which only the highest-priority one is kept.
+type PriorityConflict struct {
+	// FQDN is the contested fully qualified domain name.
+	FQDN string
+	// Winner is the kind whose endpoints are kept.
+	Winner registry.SourceType
+	// Losers are the kinds whose endpoints were dropped, in priority order.
+	Losers []registry.SourceType
+	// DroppedTargets are the targets of the dropped endpoints the winner does
+	// not publish, sorted.
+	DroppedTargets []string
+}
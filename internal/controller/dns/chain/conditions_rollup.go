@@ -0,0 +1,227 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
+	"github.com/golgoth31/sreportal/internal/reconciler"
+	"github.com/golgoth31/sreportal/internal/source/registry"
+)
+
+const (
+	// ConditionSourcesHealthy rolls up the DNSRecords owned by a DNS CR.
+	ConditionSourcesHealthy = "SourcesHealthy"
+	// ConditionResolutionHealthy rolls up the syncStatus of every endpoint of
+	// the DNSRecords owned by a DNS CR.
+	ConditionResolutionHealthy = "ResolutionHealthy"
+)
+
+// ConditionsRollupHandler sets the SourcesHealthy and ResolutionHealthy
+// conditions on the DNS CR from the DNSRecords it owns, with counts in their
+// messages so `kubectl describe dns` gives the health picture at a glance. It
+// then rolls them up with SourcesReady into the Ready condition and counts the
+// FQDNs of the owned DNSRecords.
+//
+// DNSRecord status-only changes do not enqueue the DNS CR, so the rollup
+// catches up with resolution results on the next periodic reconcile.
+type ConditionsRollupHandler struct {
+	Client client.Client
+}
+
+// DataKeys implements reconciler.DataAccessor.
+func (*ConditionsRollupHandler) DataKeys() (reads, writes []string) {
+	return []string{"PreserveKinds"}, nil
+}
+
+// Handle implements reconciler.Handler.
+func (h *ConditionsRollupHandler) Handle(ctx context.Context, rc *reconciler.ReconcileContext[*sreportalv1alpha2.DNS, ChainData]) error {
+	dns := rc.Resource
+
+	var list sreportalv1alpha2.DNSRecordList
+	if err := h.Client.List(ctx, &list, client.InNamespace(dns.Namespace)); err != nil {
+		return fmt.Errorf("list DNSRecords: %w", err)
+	}
+	var owned []sreportalv1alpha2.DNSRecord
+	for i := range list.Items {
+		if ownedBy(&list.Items[i], dns) {
+			owned = append(owned, list.Items[i])
+		}
+	}
+
+	SetCondition(dns, sourcesHealthyCondition(owned, rc.Data.PreserveKinds))
+	SetCondition(dns, resolutionHealthyCondition(owned))
+	SetCondition(dns, readyCondition(dns))
+
+	var endpoints []sreportalv1alpha2.EndpointStatus
+	for i := range owned {
+		endpoints = append(endpoints, owned[i].Status.Endpoints...)
+	}
+	dns.Status.FQDNCount = adapter.CountFQDNsV2(endpoints)
+	return nil
+}
+
+// readyCondition is False when SourcesReady or SourcesHealthy is False,
+// Unknown when either is Unknown, True otherwise. It backs the Ready column
+// of `kubectl get dns`.
+func readyCondition(dns *sreportalv1alpha2.DNS) metav1.Condition {
+	var unknown *metav1.Condition
+	for _, t := range []string{sreportalv1alpha2.ConditionSourcesReady, ConditionSourcesHealthy} {
+		c := meta.FindStatusCondition(dns.Status.Conditions, t)
+		switch {
+		case c == nil || c.Status == metav1.ConditionTrue:
+			continue
+		case c.Status == metav1.ConditionFalse:
+			return notReady(c)
+		case unknown == nil:
+			unknown = c
+		}
+	}
+	if unknown != nil {
+		return notReady(unknown)
+	}
+	return metav1.Condition{
+		Type:    sreportalv1alpha2.ConditionReady,
+		Status:  metav1.ConditionTrue,
+		Reason:  "Ready",
+		Message: "sources are producing and every DNSRecord is up to date",
+	}
+}
+
+// notReady mirrors c, a non-True condition, as the Ready condition.
+func notReady(c *metav1.Condition) metav1.Condition {
+	return metav1.Condition{
+		Type:    sreportalv1alpha2.ConditionReady,
+		Status:  c.Status,
+		Reason:  c.Reason,
+		Message: c.Type + ": " + c.Message,
+	}
+}
+
+// sourcesHealthyCondition is True when every owned DNSRecord has materialised
+// its current spec and every enabled source has completed a collection.
+func sourcesHealthyCondition(records []sreportalv1alpha2.DNSRecord, waiting map[registry.SourceType]bool) metav1.Condition {
+	var stale []string
+	for i := range records {
+		r := &records[i]
+		if r.Status.LastReconcileTime == nil || r.Status.ObservedGeneration != r.Generation {
+			stale = append(stale, r.Name)
+		}
+	}
+	var kinds []string
+	for kind, w := range waiting {
+		if w {
+			kinds = append(kinds, string(kind))
+		}
+	}
+	slices.Sort(kinds)
+
+	upToDate := len(records) - len(stale)
+	message := fmt.Sprintf("%d/%d DNSRecords up to date", upToDate, len(records))
+	switch {
+	case len(stale) > 0:
+		slices.Sort(stale)
+		return metav1.Condition{
+			Type:    ConditionSourcesHealthy,
+			Status:  metav1.ConditionFalse,
+			Reason:  "DNSRecordsPending",
+			Message: message + "; pending: " + strings.Join(stale, ", ") + waitingSuffix(kinds),
+		}
+	case len(kinds) > 0:
+		return metav1.Condition{
+			Type:    ConditionSourcesHealthy,
+			Status:  metav1.ConditionFalse,
+			Reason:  "SourcesNotSynced",
+			Message: message + waitingSuffix(kinds),
+		}
+	case len(records) == 0:
+		return metav1.Condition{
+			Type:    ConditionSourcesHealthy,
+			Status:  metav1.ConditionUnknown,
+			Reason:  "NoDNSRecords",
+			Message: "no source produced endpoints",
+		}
+	default:
+		return metav1.Condition{
+			Type:    ConditionSourcesHealthy,
+			Status:  metav1.ConditionTrue,
+			Reason:  "AllDNSRecordsUpToDate",
+			Message: message,
+		}
+	}
+}
+
+func waitingSuffix(kinds []string) string {
+	if len(kinds) == 0 {
+		return ""
+	}
+	return "; waiting for the first collection of: " + strings.Join(kinds, ", ")
+}
+
+// resolutionHealthyCondition is False when any checked endpoint does not
+// resolve, Unknown while no endpoint has been checked yet.
+func resolutionHealthyCondition(records []sreportalv1alpha2.DNSRecord) metav1.Condition {
+	var total, inSync, notSync, notAvailable int
+	for i := range records {
+		for _, ep := range records[i].Status.Endpoints {
+			total++
+			switch ep.SyncStatus {
+			case sreportalv1alpha2.SyncStatusSync:
+				inSync++
+			case sreportalv1alpha2.SyncStatusNotSync:
+				notSync++
+			case sreportalv1alpha2.SyncStatusNotAvailable:
+				notAvailable++
+			}
+		}
+	}
+	checked := inSync + notSync + notAvailable
+	if checked == 0 {
+		return metav1.Condition{
+			Type:    ConditionResolutionHealthy,
+			Status:  metav1.ConditionUnknown,
+			Reason:  "NotChecked",
+			Message: fmt.Sprintf("0/%d FQDNs checked", total),
+		}
+	}
+
+	message := fmt.Sprintf("%d/%d checked FQDNs not available (%d%%), %d not in sync, %d in sync, %d unchecked",
+		notAvailable, checked, notAvailable*100/checked, notSync, inSync, total-checked)
+	if notAvailable > 0 {
+		return metav1.Condition{
+			Type:    ConditionResolutionHealthy,
+			Status:  metav1.ConditionFalse,
+			Reason:  "FQDNsNotAvailable",
+			Message: message,
+		}
+	}
+	return metav1.Condition{
+		Type:    ConditionResolutionHealthy,
+		Status:  metav1.ConditionTrue,
+		Reason:  "FQDNsResolve",
+		Message: message,
+	}
+}
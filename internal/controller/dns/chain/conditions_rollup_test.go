@@ -0,0 +1,139 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	dnschain "github.com/golgoth31/sreportal/internal/controller/dns/chain"
+	"github.com/golgoth31/sreportal/internal/reconciler"
+	"github.com/golgoth31/sreportal/internal/source/externaldns"
+	"github.com/golgoth31/sreportal/internal/source/registry"
+)
+
+func rollupRecord(name string, owner *sreportalv1alpha2.DNS, upToDate bool, statuses ...sreportalv1alpha2.SyncStatus) *sreportalv1alpha2.DNSRecord {
+	r := &sreportalv1alpha2.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name, Namespace: owner.Namespace,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: sreportalv1alpha2.GroupVersion.String(), Kind: "DNS", Name: owner.Name, UID: owner.UID,
+			}},
+		},
+	}
+	if upToDate {
+		now := metav1.Now()
+		r.Status.LastReconcileTime = &now
+	}
+	for _, s := range statuses {
+		r.Status.Endpoints = append(r.Status.Endpoints, sreportalv1alpha2.EndpointStatus{DNSName: name + ".example.com", SyncStatus: s})
+	}
+	return r
+}
+
+func runRollup(t *testing.T, dns *sreportalv1alpha2.DNS, data dnschain.ChainData, objs ...client.Object) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	h := &dnschain.ConditionsRollupHandler{Client: c}
+	rc := &reconciler.ReconcileContext[*sreportalv1alpha2.DNS, dnschain.ChainData]{Resource: dns, Data: data}
+	require.NoError(t, h.Handle(context.Background(), rc))
+}
+
+func TestConditionsRollup_Healthy(t *testing.T) {
+	dns := &sreportalv1alpha2.DNS{ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: "n", UID: "u1"}}
+	other := &sreportalv1alpha2.DNS{ObjectMeta: metav1.ObjectMeta{Name: "o", Namespace: "n", UID: "u2"}}
+
+	runRollup(t, dns, dnschain.ChainData{},
+		rollupRecord("d-service", dns, true, sreportalv1alpha2.SyncStatusSync, sreportalv1alpha2.SyncStatusSync),
+		rollupRecord("d-ingress", dns, true, sreportalv1alpha2.SyncStatusNotSync, sreportalv1alpha2.SyncStatusUnknown),
+		rollupRecord("o-service", other, false, sreportalv1alpha2.SyncStatusNotAvailable),
+	)
+
+	sources := findCondition(dns, dnschain.ConditionSourcesHealthy)
+	require.NotNil(t, sources)
+	require.Equal(t, metav1.ConditionTrue, sources.Status)
+	require.Equal(t, "2/2 DNSRecords up to date", sources.Message)
+
+	resolution := findCondition(dns, dnschain.ConditionResolutionHealthy)
+	require.NotNil(t, resolution)
+	require.Equal(t, metav1.ConditionTrue, resolution.Status)
+	require.Equal(t, "0/3 checked FQDNs not available (0%), 1 not in sync, 2 in sync, 1 unchecked", resolution.Message)
+
+	require.Equal(t, metav1.ConditionTrue, conditionStatus(dns, sreportalv1alpha2.ConditionReady))
+	require.Equal(t, int32(2), dns.Status.FQDNCount)
+}
+
+func TestConditionsRollup_Unhealthy(t *testing.T) {
+	dns := &sreportalv1alpha2.DNS{ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: "n", UID: "u1"}}
+
+	runRollup(t, dns,
+		dnschain.ChainData{PreserveKinds: map[registry.SourceType]bool{externaldns.KindIngress: true}},
+		rollupRecord("d-service", dns, true, sreportalv1alpha2.SyncStatusSync, sreportalv1alpha2.SyncStatusNotAvailable),
+		rollupRecord("d-manual", dns, false),
+	)
+
+	sources := findCondition(dns, dnschain.ConditionSourcesHealthy)
+	require.NotNil(t, sources)
+	require.Equal(t, metav1.ConditionFalse, sources.Status)
+	require.Equal(t, "DNSRecordsPending", sources.Reason)
+	require.Equal(t, "1/2 DNSRecords up to date; pending: d-manual; waiting for the first collection of: ingress", sources.Message)
+
+	resolution := findCondition(dns, dnschain.ConditionResolutionHealthy)
+	require.NotNil(t, resolution)
+	require.Equal(t, metav1.ConditionFalse, resolution.Status)
+	require.Equal(t, "FQDNsNotAvailable", resolution.Reason)
+	require.Equal(t, "1/2 checked FQDNs not available (50%), 0 not in sync, 1 in sync, 0 unchecked", resolution.Message)
+
+	ready := findCondition(dns, sreportalv1alpha2.ConditionReady)
+	require.NotNil(t, ready)
+	require.Equal(t, metav1.ConditionFalse, ready.Status)
+	require.Equal(t, "DNSRecordsPending", ready.Reason)
+}
+
+func TestConditionsRollup_ReadyFollowsSourcesReady(t *testing.T) {
+	dns := &sreportalv1alpha2.DNS{ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: "n", UID: "u1"}}
+	dnschain.SetCondition(dns, metav1.Condition{
+		Type:   sreportalv1alpha2.ConditionSourcesReady,
+		Status: metav1.ConditionUnknown,
+		Reason: "NoSourcesEnabled",
+	})
+
+	runRollup(t, dns, dnschain.ChainData{}, rollupRecord("d-service", dns, true))
+
+	ready := findCondition(dns, sreportalv1alpha2.ConditionReady)
+	require.NotNil(t, ready)
+	require.Equal(t, metav1.ConditionUnknown, ready.Status)
+	require.Equal(t, "NoSourcesEnabled", ready.Reason)
+}
+
+func TestConditionsRollup_NoRecords(t *testing.T) {
+	dns := &sreportalv1alpha2.DNS{ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: "n", UID: "u1"}}
+
+	runRollup(t, dns, dnschain.ChainData{})
+
+	require.Equal(t, metav1.ConditionUnknown, conditionStatus(dns, dnschain.ConditionSourcesHealthy))
+	require.Equal(t, metav1.ConditionUnknown, conditionStatus(dns, dnschain.ConditionResolutionHealthy))
+}
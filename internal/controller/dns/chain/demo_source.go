@@ -0,0 +1,68 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"time"
+
+	"sigs.k8s.io/external-dns/endpoint"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/reconciler"
+	"github.com/golgoth31/sreportal/internal/source/demo"
+	"github.com/golgoth31/sreportal/internal/source/registry"
+)
+
+// DemoSourceHandler publishes the synthetic FQDNs of spec.sources.demo. The
+// demo source reads no Kubernetes resource, so rather than being collected
+// into the source store, its endpoints are generated here for each DNS, after
+// LookupSourcesHandler has placed the kind in the priority order. The generation advances every reconciliation interval,
+// which is what drives the configured churn.
+type DemoSourceHandler struct {
+	// Now returns the current time. Nil uses time.Now.
+	Now func() time.Time
+}
+
+// DataKeys implements reconciler.DataAccessor.
+func (*DemoSourceHandler) DataKeys() (reads, writes []string) {
+	return nil, []string{"EndpointsByKind"}
+}
+
+// Handle implements reconciler.Handler.
+func (h *DemoSourceHandler) Handle(_ context.Context, rc *reconciler.ReconcileContext[*sreportalv1alpha2.DNS, ChainData]) error {
+	dns := rc.Resource
+	spec := dns.Spec.Sources.Demo
+	if spec == nil || !spec.Enabled {
+		return nil
+	}
+
+	now := time.Now
+	if h.Now != nil {
+		now = h.Now
+	}
+	var generation int64
+	if interval := dns.Spec.Reconciliation.Interval.Duration; interval > 0 {
+		generation = now().UnixNano() / int64(interval)
+	}
+
+	if rc.Data.EndpointsByKind == nil {
+		rc.Data.EndpointsByKind = map[registry.SourceType][]*endpoint.Endpoint{}
+	}
+	rc.Data.EndpointsByKind[demo.SourceTypeDemo] = demo.Endpoints(spec, dns.Namespace+"/"+dns.Name, generation)
+	return nil
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/external-dns/endpoint"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	dnschain "github.com/golgoth31/sreportal/internal/controller/dns/chain"
+	"github.com/golgoth31/sreportal/internal/reconciler"
+	"github.com/golgoth31/sreportal/internal/source/demo"
+	"github.com/golgoth31/sreportal/internal/source/externaldns"
+	"github.com/golgoth31/sreportal/internal/source/registry"
+)
+
+func newDemoContext(spec *sreportalv1alpha2.DemoSourceSpec) *reconciler.ReconcileContext[*sreportalv1alpha2.DNS, dnschain.ChainData] {
+	return &reconciler.ReconcileContext[*sreportalv1alpha2.DNS, dnschain.ChainData]{
+		Resource: &sreportalv1alpha2.DNS{
+			ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: tNS1},
+			Spec: sreportalv1alpha2.DNSSpec{
+				Sources: sreportalv1alpha2.SourcesSpec{
+					Service: &sreportalv1alpha2.ServiceSourceSpec{CommonSourceSpec: sreportalv1alpha2.CommonSourceSpec{Enabled: true}},
+					Demo:    spec,
+				},
+				Reconciliation: sreportalv1alpha2.ReconciliationSpec{Interval: metav1.Duration{Duration: time.Minute}},
+			},
+		},
+		Data: dnschain.ChainData{
+			EndpointsByKind: map[registry.SourceType][]*endpoint.Endpoint{externaldns.KindService: nil},
+		},
+	}
+}
+
+func TestDemoSourceHandler_Disabled(t *testing.T) {
+	rc := newDemoContext(&sreportalv1alpha2.DemoSourceSpec{Enabled: false})
+
+	require.NoError(t, (&dnschain.DemoSourceHandler{}).Handle(context.Background(), rc))
+
+	require.NotContains(t, rc.Data.EndpointsByKind, demo.SourceTypeDemo)
+}
+
+func TestDemoSourceHandler_PublishesAndChurns(t *testing.T) {
+	now := time.Unix(3600, 0)
+	h := &dnschain.DemoSourceHandler{Now: func() time.Time { return now }}
+	spec := &sreportalv1alpha2.DemoSourceSpec{Enabled: true, Count: 10, ChurnPercent: 50}
+
+	rc := newDemoContext(spec)
+	require.NoError(t, h.Handle(context.Background(), rc))
+	first := rc.Data.EndpointsByKind[demo.SourceTypeDemo]
+	require.Len(t, first, 10)
+	require.Contains(t, rc.Data.EndpointsByKind, externaldns.KindService)
+
+	// Same interval: same FQDNs.
+	now = now.Add(30 * time.Second)
+	rc = newDemoContext(spec)
+	require.NoError(t, h.Handle(context.Background(), rc))
+	require.Equal(t, first, rc.Data.EndpointsByKind[demo.SourceTypeDemo])
+
+	// Next interval: half of them replaced.
+	now = now.Add(time.Minute)
+	rc = newDemoContext(spec)
+	require.NoError(t, h.Handle(context.Background(), rc))
+	next := rc.Data.EndpointsByKind[demo.SourceTypeDemo]
+	require.Equal(t, first[5].DNSName, next[0].DNSName)
+}
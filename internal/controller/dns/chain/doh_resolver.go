@@ -0,0 +1,257 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+const (
+	// dohContentType is the media type of RFC 8484 requests and responses.
+	dohContentType = "application/dns-message"
+	// defaultDoHTimeout bounds a single DNS-over-HTTPS request.
+	defaultDoHTimeout = 5 * time.Second
+	// maxDNSMessageSize is the largest DNS message accepted from the server.
+	maxDNSMessageSize = 65535
+	// maxCNAMEChain bounds the CNAME chain followed by LookupCNAME.
+	maxCNAMEChain = 10
+)
+
+// Compile-time checks that DoHResolver implements domaindns.Resolver and
+// domaindns.TTLResolver.
+var (
+	_ domaindns.Resolver    = (*DoHResolver)(nil)
+	_ domaindns.TTLResolver = (*DoHResolver)(nil)
+)
+
+// DoHResolver resolves names through a DNS-over-HTTPS (RFC 8484) endpoint,
+// for environments where port 53 egress is blocked. The endpoint must be a
+// recursive resolver: CNAME chains are expected in the answer.
+type DoHResolver struct {
+	url    string
+	client *http.Client
+}
+
+// NewDoHResolver creates a DoHResolver posting queries to url. A zero timeout
+// uses 5s.
+func NewDoHResolver(url string, timeout time.Duration) *DoHResolver {
+	if timeout <= 0 {
+		timeout = defaultDoHTimeout
+	}
+	return &DoHResolver{url: url, client: &http.Client{Timeout: timeout}}
+}
+
+// LookupHost resolves a hostname to its IPv4 and IPv6 addresses.
+func (r *DoHResolver) LookupHost(ctx context.Context, fqdn string) ([]string, error) {
+	var addrs []string
+	for _, qtype := range []dnsmessage.Type{dnsmessage.TypeA, dnsmessage.TypeAAAA} {
+		msg, err := r.query(ctx, fqdn, qtype)
+		if err != nil {
+			return nil, err
+		}
+		for _, ans := range msg.Answers {
+			switch body := ans.Body.(type) {
+			case *dnsmessage.AResource:
+				addrs = append(addrs, net.IP(body.A[:]).String())
+			case *dnsmessage.AAAAResource:
+				addrs = append(addrs, net.IP(body.AAAA[:]).String())
+			}
+		}
+	}
+	if len(addrs) == 0 {
+		return nil, notFound(fqdn)
+	}
+	return addrs, nil
+}
+
+// LookupCNAME returns the canonical name of fqdn, following the CNAME chain
+// returned by the server. Like net.Resolver.LookupCNAME, a name without CNAME
+// is its own canonical name, and the result carries a trailing dot.
+func (r *DoHResolver) LookupCNAME(ctx context.Context, fqdn string) (string, error) {
+	msg, err := r.query(ctx, fqdn, dnsmessage.TypeA)
+	if err != nil {
+		return "", err
+	}
+	if len(msg.Answers) == 0 {
+		return "", notFound(fqdn)
+	}
+	canonical := dotted(fqdn)
+	for range maxCNAMEChain {
+		next := ""
+		for _, ans := range msg.Answers {
+			body, ok := ans.Body.(*dnsmessage.CNAMEResource)
+			if ok && strings.EqualFold(ans.Header.Name.String(), canonical) {
+				next = body.CNAME.String()
+				break
+			}
+		}
+		if next == "" {
+			break
+		}
+		canonical = next
+	}
+	return canonical, nil
+}
+
+// LookupTXT returns the TXT records of the given name, the strings of each
+// record concatenated as net.Resolver.LookupTXT does.
+func (r *DoHResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	msg, err := r.query(ctx, name, dnsmessage.TypeTXT)
+	if err != nil {
+		return nil, err
+	}
+	var txts []string
+	for _, ans := range msg.Answers {
+		if body, ok := ans.Body.(*dnsmessage.TXTResource); ok {
+			txts = append(txts, strings.Join(body.TXT, ""))
+		}
+	}
+	if len(txts) == 0 {
+		return nil, notFound(name)
+	}
+	return txts, nil
+}
+
+// LookupTTL returns the TTL served for the recordType record of fqdn.
+func (r *DoHResolver) LookupTTL(ctx context.Context, fqdn, recordType string) (uint32, error) {
+	qtype, ok := ttlQueryType(recordType)
+	if !ok {
+		return 0, domaindns.ErrTTLUnavailable
+	}
+	msg, err := r.query(ctx, fqdn, qtype)
+	if err != nil {
+		return 0, err
+	}
+	return answerTTL(msg, fqdn, qtype)
+}
+
+// query sends a single recursive query for name and returns the parsed
+// response. NXDOMAIN is returned as a not-found *net.DNSError; other error
+// codes as a temporary one.
+func (r *DoHResolver) query(ctx context.Context, name string, qtype dnsmessage.Type) (*dnsmessage.Message, error) {
+	// RFC 8484 §4.1: the ID should be 0 so responses are cache friendly.
+	packed, err := packQuery(name, qtype, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, r.url, bytes.NewReader(packed))
+	if err != nil {
+		return nil, fmt.Errorf("build DoH request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", dohContentType)
+	httpReq.Header.Set("Accept", dohContentType)
+
+	resp, err := r.client.Do(httpReq)
+	if err != nil {
+		return nil, &net.DNSError{Err: err.Error(), Name: name, Server: r.url, IsTemporary: true}
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, &net.DNSError{Err: "DoH server returned " + resp.Status, Name: name, Server: r.url, IsTemporary: true}
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxDNSMessageSize))
+	if err != nil {
+		return nil, &net.DNSError{Err: err.Error(), Name: name, Server: r.url, IsTemporary: true}
+	}
+	return unpackResponse(body, name, r.url)
+}
+
+// packQuery returns the wire form of a recursive query for name.
+func packQuery(name string, qtype dnsmessage.Type, id uint16) ([]byte, error) {
+	qname, err := dnsmessage.NewName(dotted(name))
+	if err != nil {
+		return nil, &net.DNSError{Err: err.Error(), Name: name}
+	}
+	req := dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: id, RecursionDesired: true},
+		Questions: []dnsmessage.Question{{Name: qname, Type: qtype, Class: dnsmessage.ClassINET}},
+	}
+	packed, err := req.Pack()
+	if err != nil {
+		return nil, fmt.Errorf("pack DNS query for %s: %w", name, err)
+	}
+	return packed, nil
+}
+
+// unpackResponse parses the response of server to a query for name.
+// NXDOMAIN is returned as a not-found *net.DNSError; other error codes as a
+// temporary one.
+func unpackResponse(body []byte, name, server string) (*dnsmessage.Message, error) {
+	var msg dnsmessage.Message
+	if err := msg.Unpack(body); err != nil {
+		return nil, &net.DNSError{Err: "invalid DNS response: " + err.Error(), Name: name, Server: server}
+	}
+	switch msg.RCode {
+	case dnsmessage.RCodeSuccess:
+		return &msg, nil
+	case dnsmessage.RCodeNameError:
+		return nil, notFound(name)
+	default:
+		return nil, &net.DNSError{Err: "server answered " + msg.RCode.String(), Name: name, Server: server, IsTemporary: true}
+	}
+}
+
+// ttlQueryType returns the query type whose answer carries the TTL of a
+// recordType record. Only the record types checked by CheckFQDN are queried.
+func ttlQueryType(recordType string) (dnsmessage.Type, bool) {
+	switch strings.ToUpper(recordType) {
+	case "A":
+		return dnsmessage.TypeA, true
+	case "AAAA":
+		return dnsmessage.TypeAAAA, true
+	case "CNAME":
+		return dnsmessage.TypeCNAME, true
+	default:
+		return 0, false
+	}
+}
+
+// answerTTL returns the TTL of the first qtype answer owned by name.
+func answerTTL(msg *dnsmessage.Message, name string, qtype dnsmessage.Type) (uint32, error) {
+	owner := dotted(name)
+	for _, ans := range msg.Answers {
+		if ans.Header.Type == qtype && strings.EqualFold(ans.Header.Name.String(), owner) {
+			return ans.Header.TTL, nil
+		}
+	}
+	return 0, notFound(name)
+}
+
+// notFound returns the error net.Resolver reports for a name without records.
+func notFound(name string) error {
+	return &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+// dotted returns name with a trailing dot.
+func dotted(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
@@ -0,0 +1,313 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/golgoth31/sreportal/internal/config"
+	dnschain "github.com/golgoth31/sreportal/internal/controller/dns/chain"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+// dohZone is the content served by newDoHServer, keyed by dotted name.
+type dohZone struct {
+	a     map[string][4]byte
+	aaaa  map[string][16]byte
+	cname map[string]string
+	txt   map[string][]string
+	// ttl is the TTL of every answer.
+	ttl uint32
+}
+
+func mustName(t *testing.T, name string) dnsmessage.Name {
+	t.Helper()
+	n, err := dnsmessage.NewName(name)
+	require.NoError(t, err)
+	return n
+}
+
+// newDoHServer serves zone over RFC 8484 POST requests.
+func newDoHServer(t *testing.T, zone dohZone) *httptest.Server {
+	t.Helper()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/dns-message", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/dns-message")
+		_, _ = w.Write(zone.answer(t, body))
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+// answer returns the packed response to the packed query, following CNAMEs
+// the way a recursive resolver does.
+func (zone dohZone) answer(t *testing.T, query []byte) []byte {
+	t.Helper()
+	var req dnsmessage.Message
+	require.NoError(t, req.Unpack(query))
+	q := req.Questions[0]
+
+	resp := dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: req.ID, Response: true, RecursionAvailable: true},
+		Questions: req.Questions,
+	}
+	name := q.Name.String()
+	found := false
+	for range 5 {
+		target, ok := zone.cname[name]
+		if !ok {
+			break
+		}
+		found = true
+		resp.Answers = append(resp.Answers, dnsmessage.Resource{
+			Header: dnsmessage.ResourceHeader{Name: mustName(t, name), Type: dnsmessage.TypeCNAME, Class: dnsmessage.ClassINET, TTL: zone.ttl},
+			Body:   &dnsmessage.CNAMEResource{CNAME: mustName(t, target)},
+		})
+		name = target
+	}
+	hdr := dnsmessage.ResourceHeader{Name: mustName(t, name), Type: q.Type, Class: dnsmessage.ClassINET, TTL: zone.ttl}
+	if a, ok := zone.a[name]; ok {
+		found = true
+		if q.Type == dnsmessage.TypeA {
+			resp.Answers = append(resp.Answers, dnsmessage.Resource{Header: hdr, Body: &dnsmessage.AResource{A: a}})
+		}
+	}
+	if aaaa, ok := zone.aaaa[name]; ok {
+		found = true
+		if q.Type == dnsmessage.TypeAAAA {
+			resp.Answers = append(resp.Answers, dnsmessage.Resource{Header: hdr, Body: &dnsmessage.AAAAResource{AAAA: aaaa}})
+		}
+	}
+	if txt, ok := zone.txt[name]; ok {
+		found = true
+		if q.Type == dnsmessage.TypeTXT {
+			resp.Answers = append(resp.Answers, dnsmessage.Resource{Header: hdr, Body: &dnsmessage.TXTResource{TXT: txt}})
+		}
+	}
+	if !found {
+		resp.RCode = dnsmessage.RCodeNameError
+	}
+
+	packed, err := resp.Pack()
+	require.NoError(t, err)
+	return packed
+}
+
+// newUDPServer serves zone over UDP and returns its address.
+func newUDPServer(t *testing.T, zone dohZone) string {
+	t.Helper()
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			_, _ = conn.WriteTo(zone.answer(t, buf[:n]), addr)
+		}
+	}()
+	return conn.LocalAddr().String()
+}
+
+func testZone() dohZone {
+	return dohZone{
+		a:     map[string][4]byte{"api.example.com.": {10, 0, 0, 1}, "lb.example.net.": {10, 0, 0, 2}},
+		aaaa:  map[string][16]byte{"api.example.com.": {0x20, 0x01, 0x0d, 0xb8, 15: 1}},
+		cname: map[string]string{"www.example.com.": "edge.example.com.", "edge.example.com.": "lb.example.net."},
+		txt:   map[string][]string{"a-api.example.com.": {"heritage=external-dns,", "external-dns/owner=default"}},
+		ttl:   300,
+	}
+}
+
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
+
+func TestDoHResolver_LookupHost(t *testing.T) {
+	ts := newDoHServer(t, testZone())
+	r := dnschain.NewDoHResolver(ts.URL, 0)
+
+	addrs, err := r.LookupHost(context.Background(), "api.example.com")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"10.0.0.1", "2001:db8::1"}, addrs)
+
+	addrs, err = r.LookupHost(context.Background(), "www.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.2"}, addrs)
+
+	_, err = r.LookupHost(context.Background(), "missing.example.com")
+	assert.True(t, isNotFound(err), "got %v", err)
+}
+
+func TestDoHResolver_LookupCNAME(t *testing.T) {
+	ts := newDoHServer(t, testZone())
+	r := dnschain.NewDoHResolver(ts.URL, 0)
+
+	cname, err := r.LookupCNAME(context.Background(), "www.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "lb.example.net.", cname)
+
+	cname, err = r.LookupCNAME(context.Background(), "api.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "api.example.com.", cname, "a name without CNAME is its own canonical name")
+
+	_, err = r.LookupCNAME(context.Background(), "missing.example.com")
+	assert.True(t, isNotFound(err), "got %v", err)
+}
+
+func TestDoHResolver_LookupTXT(t *testing.T) {
+	ts := newDoHServer(t, testZone())
+	r := dnschain.NewDoHResolver(ts.URL, 0)
+
+	txts, err := r.LookupTXT(context.Background(), "a-api.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"heritage=external-dns,external-dns/owner=default"}, txts)
+}
+
+func TestDoHResolver_LookupTTL(t *testing.T) {
+	ts := newDoHServer(t, testZone())
+	r := dnschain.NewDoHResolver(ts.URL, 0)
+
+	ttl, err := r.LookupTTL(context.Background(), "api.example.com", "AAAA")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(300), ttl)
+
+	ttl, err = r.LookupTTL(context.Background(), "www.example.com", "CNAME")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(300), ttl)
+
+	_, err = r.LookupTTL(context.Background(), "www.example.com", "A")
+	assert.True(t, isNotFound(err), "the A record is owned by the CNAME target, got %v", err)
+
+	_, err = r.LookupTTL(context.Background(), "api.example.com", "TXT")
+	assert.ErrorIs(t, err, domaindns.ErrTTLUnavailable)
+}
+
+func TestNetResolver_LookupTTL(t *testing.T) {
+	r := dnschain.NewNetResolverFor(newUDPServer(t, testZone()))
+
+	ttl, err := r.LookupTTL(context.Background(), "api.example.com", "A")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(300), ttl)
+
+	_, err = r.LookupTTL(context.Background(), "missing.example.com", "A")
+	assert.True(t, isNotFound(err), "got %v", err)
+
+	_, err = dnschain.NewNetResolver().LookupTTL(context.Background(), "api.example.com", "A")
+	assert.ErrorIs(t, err, domaindns.ErrTTLUnavailable, "the system resolver hides TTLs")
+}
+
+func TestDoHResolver_ServerErrorIsTemporary(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	t.Cleanup(ts.Close)
+
+	_, err := dnschain.NewDoHResolver(ts.URL, 0).LookupHost(context.Background(), "api.example.com")
+
+	var dnsErr *net.DNSError
+	require.ErrorAs(t, err, &dnsErr)
+	assert.True(t, dnsErr.IsTemporary)
+	assert.False(t, dnsErr.IsNotFound)
+}
+
+// stubResolver answers every lookup with the same result.
+type stubResolver struct {
+	addrs []string
+	err   error
+	calls int
+}
+
+func (s *stubResolver) LookupHost(context.Context, string) ([]string, error) {
+	s.calls++
+	return s.addrs, s.err
+}
+
+func (s *stubResolver) LookupCNAME(context.Context, string) (string, error) {
+	s.calls++
+	return "", s.err
+}
+
+func (s *stubResolver) LookupTXT(context.Context, string) ([]string, error) {
+	s.calls++
+	return nil, s.err
+}
+
+func TestFallbackResolver(t *testing.T) {
+	down := &stubResolver{err: &net.DNSError{Err: "i/o timeout", IsTimeout: true}}
+	up := &stubResolver{addrs: []string{"10.0.0.1"}}
+	last := &stubResolver{addrs: []string{"10.0.0.9"}}
+
+	addrs, err := dnschain.FallbackResolver{down, up, last}.LookupHost(context.Background(), "api.example.com")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.1"}, addrs)
+	assert.Zero(t, last.calls)
+
+	missing := &stubResolver{err: &net.DNSError{Err: "no such host", IsNotFound: true}}
+	_, err = dnschain.FallbackResolver{missing, up}.LookupHost(context.Background(), "missing.example.com")
+	assert.True(t, isNotFound(err), "a not-found answer is final")
+}
+
+func TestNewResolver(t *testing.T) {
+	r, err := dnschain.NewResolver(config.ResolverConfig{})
+	require.NoError(t, err)
+	assert.IsType(t, &dnschain.NetResolver{}, r)
+
+	r, err = dnschain.NewResolver(config.ResolverConfig{Type: config.ResolverTypeDNS, Servers: []string{"10.0.0.53", "10.0.0.54"}})
+	require.NoError(t, err)
+	assert.IsType(t, dnschain.FallbackResolver{}, r)
+
+	r, err = dnschain.NewResolver(config.ResolverConfig{Type: config.ResolverTypeDoH, URL: "https://dns.example.com/dns-query"})
+	require.NoError(t, err)
+	assert.IsType(t, &dnschain.DoHResolver{}, r)
+
+	_, err = dnschain.NewResolver(config.ResolverConfig{Type: "carrier-pigeon"})
+	assert.Error(t, err)
+}
+
+func TestRegisterResolver(t *testing.T) {
+	stub := &stubResolver{addrs: []string{"10.0.0.1"}}
+	dnschain.RegisterResolver("test-stub", func(config.ResolverConfig) (dnschain.LookupResolver, error) {
+		return stub, nil
+	})
+
+	r, err := dnschain.NewResolver(config.ResolverConfig{Type: "test-stub"})
+
+	require.NoError(t, err)
+	assert.Same(t, stub, r)
+	assert.Panics(t, func() {
+		dnschain.RegisterResolver(config.ResolverTypeDoH, nil)
+	})
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/external-dns/endpoint"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	"github.com/golgoth31/sreportal/internal/reconciler"
+	"github.com/golgoth31/sreportal/internal/source/externaldns"
+	"github.com/golgoth31/sreportal/internal/source/registry"
+)
+
+const (
+	// defaultExternalNameGroup is the group of ExternalName CNAMEs when
+	// spec.sources.service.externalName.group is empty.
+	defaultExternalNameGroup = "External dependencies"
+	// defaultClusterDomain is the cluster DNS domain when
+	// spec.sources.service.externalName.clusterDomain is empty.
+	defaultClusterDomain = "cluster.local"
+)
+
+// ExternalNameServicesHandler publishes the ExternalName Services matching the
+// service source filters as CNAME endpoints, from
+// <name>.<namespace>.svc.<clusterDomain> to spec.externalName. It runs after
+// LookupSourcesHandler and appends to the service kind, skipping the Services
+// external-dns already produced an endpoint for, so an ExternalName Service
+// shows up in the portal without any external-dns annotation.
+type ExternalNameServicesHandler struct {
+	Client client.Reader
+}
+
+// DataKeys implements reconciler.DataAccessor.
+func (*ExternalNameServicesHandler) DataKeys() (reads, writes []string) {
+	return []string{"PreserveKinds", "EndpointsByKind"}, []string{"EndpointsByKind"}
+}
+
+// Handle implements reconciler.Handler.
+func (h *ExternalNameServicesHandler) Handle(ctx context.Context, rc *reconciler.ReconcileContext[*sreportalv1alpha2.DNS, ChainData]) error {
+	dns := rc.Resource
+	svcSpec := dns.Spec.Sources.Service
+	if h.Client == nil || svcSpec == nil || !svcSpec.Enabled || svcSpec.ExternalName == nil || !svcSpec.ExternalName.Enabled {
+		return nil
+	}
+	// Until the service source has synced, the record of the kind is
+	// preserved as is: adding the CNAMEs alone would overwrite it, and the
+	// Services external-dns publishes are not known yet.
+	if rc.Data.PreserveKinds[externaldns.KindService] {
+		return nil
+	}
+
+	ns, lbl := effectiveFilter(dns, externaldns.KindService)
+	opts := []client.ListOption{client.InNamespace(ns)}
+	if lbl != "" {
+		sel, err := labels.Parse(lbl)
+		if err != nil {
+			return fmt.Errorf("parse service labelFilter %q: %w", lbl, err)
+		}
+		opts = append(opts, client.MatchingLabelsSelector{Selector: sel})
+	}
+	var services corev1.ServiceList
+	if err := h.Client.List(ctx, &services, opts...); err != nil {
+		return fmt.Errorf("list ExternalName services: %w", err)
+	}
+
+	eps := rc.Data.EndpointsByKind[externaldns.KindService]
+	published := make(map[string]bool, len(eps))
+	for _, ep := range eps {
+		published[ep.Labels[endpoint.ResourceLabelKey]] = true
+	}
+
+	for i := range services.Items {
+		if ep := externalNameEndpoint(&services.Items[i], svcSpec.ExternalName, published); ep != nil {
+			eps = append(eps, ep)
+		}
+	}
+	if rc.Data.EndpointsByKind == nil {
+		rc.Data.EndpointsByKind = map[registry.SourceType][]*endpoint.Endpoint{}
+	}
+	rc.Data.EndpointsByKind[externaldns.KindService] = eps
+	return nil
+}
+
+// externalNameEndpoint returns the CNAME endpoint of svc, or nil when svc is
+// not an ExternalName Service or is already in published.
+func externalNameEndpoint(svc *corev1.Service, spec *sreportalv1alpha2.ExternalNameSpec, published map[string]bool) *endpoint.Endpoint {
+	if svc.Spec.Type != corev1.ServiceTypeExternalName || svc.Spec.ExternalName == "" {
+		return nil
+	}
+	ref := fmt.Sprintf("%s/%s/%s", externaldns.KindService, svc.Namespace, svc.Name)
+	if published[ref] {
+		return nil
+	}
+
+	domain := firstNonEmpty(spec.ClusterDomain, defaultClusterDomain)
+	ep := endpoint.NewEndpoint(
+		fmt.Sprintf("%s.%s.svc.%s", svc.Name, svc.Namespace, domain),
+		endpoint.RecordTypeCNAME,
+		svc.Spec.ExternalName,
+	)
+	ep.Labels[endpoint.ResourceLabelKey] = ref
+	adapter.EnrichEndpointLabels(ep, svc.GetAnnotations())
+	if ep.Labels[domaindns.GroupsAnnotationKey] == "" {
+		ep.Labels[domaindns.GroupsAnnotationKey] = firstNonEmpty(spec.Group, defaultExternalNameGroup)
+	}
+	return ep
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/external-dns/endpoint"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	dnschain "github.com/golgoth31/sreportal/internal/controller/dns/chain"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	"github.com/golgoth31/sreportal/internal/reconciler"
+	"github.com/golgoth31/sreportal/internal/source/externaldns"
+	"github.com/golgoth31/sreportal/internal/source/registry"
+)
+
+func externalNameService(name, externalName string, annotations map[string]string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: tNS1, Annotations: annotations},
+		Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeExternalName, ExternalName: externalName},
+	}
+}
+
+func newExternalNameContext(spec *sreportalv1alpha2.ExternalNameSpec, eps ...*endpoint.Endpoint) *reconciler.ReconcileContext[*sreportalv1alpha2.DNS, dnschain.ChainData] {
+	return &reconciler.ReconcileContext[*sreportalv1alpha2.DNS, dnschain.ChainData]{
+		Resource: &sreportalv1alpha2.DNS{
+			ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: tNS1},
+			Spec: sreportalv1alpha2.DNSSpec{Sources: sreportalv1alpha2.SourcesSpec{
+				Service: &sreportalv1alpha2.ServiceSourceSpec{
+					CommonSourceSpec: sreportalv1alpha2.CommonSourceSpec{Enabled: true},
+					ExternalName:     spec,
+				},
+			}},
+		},
+		Data: dnschain.ChainData{
+			EndpointsByKind: map[registry.SourceType][]*endpoint.Endpoint{externaldns.KindService: eps},
+		},
+	}
+}
+
+func newExternalNameClient(t *testing.T, objs ...client.Object) client.Reader {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func TestExternalNameServicesHandler_PublishesCNAME(t *testing.T) {
+	c := newExternalNameClient(t,
+		externalNameService("db", "db.rds.amazonaws.com", nil),
+		externalNameService("search", "search.example.net", map[string]string{domaindns.GroupsAnnotationKey: "Search"}),
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: tNS1},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP},
+		},
+	)
+	rc := newExternalNameContext(&sreportalv1alpha2.ExternalNameSpec{Enabled: true, Group: "Deps"})
+
+	require.NoError(t, (&dnschain.ExternalNameServicesHandler{Client: c}).Handle(context.Background(), rc))
+
+	eps := rc.Data.EndpointsByKind[externaldns.KindService]
+	require.Len(t, eps, 2)
+	byName := map[string]*endpoint.Endpoint{}
+	for _, ep := range eps {
+		byName[ep.DNSName] = ep
+	}
+	db := byName["db."+tNS1+".svc.cluster.local"]
+	require.NotNil(t, db)
+	require.Equal(t, endpoint.RecordTypeCNAME, db.RecordType)
+	require.Equal(t, endpoint.Targets{"db.rds.amazonaws.com"}, db.Targets)
+	require.Equal(t, "Deps", db.Labels[domaindns.GroupsAnnotationKey])
+	require.Equal(t, "service/"+tNS1+"/db", db.Labels[endpoint.ResourceLabelKey])
+
+	search := byName["search."+tNS1+".svc.cluster.local"]
+	require.NotNil(t, search)
+	require.Equal(t, "Search", search.Labels[domaindns.GroupsAnnotationKey])
+}
+
+func TestExternalNameServicesHandler_SkipsServicesPublishedByExternalDNS(t *testing.T) {
+	c := newExternalNameClient(t, externalNameService("db", "db.rds.amazonaws.com", nil))
+	published := endpoint.NewEndpoint("db.example.com", endpoint.RecordTypeCNAME, "db.rds.amazonaws.com")
+	published.Labels[endpoint.ResourceLabelKey] = "service/" + tNS1 + "/db"
+	rc := newExternalNameContext(&sreportalv1alpha2.ExternalNameSpec{Enabled: true, ClusterDomain: "corp.local"}, published)
+
+	require.NoError(t, (&dnschain.ExternalNameServicesHandler{Client: c}).Handle(context.Background(), rc))
+
+	require.Equal(t, []*endpoint.Endpoint{published}, rc.Data.EndpointsByKind[externaldns.KindService])
+}
+
+func TestExternalNameServicesHandler_Disabled(t *testing.T) {
+	c := newExternalNameClient(t, externalNameService("db", "db.rds.amazonaws.com", nil))
+	rc := newExternalNameContext(nil)
+
+	require.NoError(t, (&dnschain.ExternalNameServicesHandler{Client: c}).Handle(context.Background(), rc))
+
+	require.Empty(t, rc.Data.EndpointsByKind[externaldns.KindService])
+}
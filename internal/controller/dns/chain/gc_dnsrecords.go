@@ -0,0 +1,208 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
+	"github.com/golgoth31/sreportal/internal/reconciler"
+	"github.com/golgoth31/sreportal/internal/source/registry"
+)
+
+// GarbageCollectDNSRecordsHandler collects the auto DNSRecords owned by the
+// DNS CR that UpsertDNSRecordsHandler did not write on this reconcile.
+//
+// A record whose kind still produces endpoints was created under a previous
+// naming template and is deleted at once. A record whose kind produced
+// nothing only goes once the kind stayed empty for
+// spec.reconciliation.recordGC.emptyRuns consecutive reconciles, counted in
+// the adapter.EmptyRunsAnnotationKey annotation, so one transient empty
+// collection does not drop the record and its status history. The
+// deletionPolicy then decides between deleting, retaining and annotating it.
+// With dryRun, what the policy would delete or annotate is only reported.
+type GarbageCollectDNSRecordsHandler struct {
+	Client client.Client
+}
+
+// DataKeys implements reconciler.DataAccessor.
+func (*GarbageCollectDNSRecordsHandler) DataKeys() (reads, writes []string) {
+	return []string{"UpsertedRecords", "KeptEndpointsByKind", "PreserveKinds"}, nil
+}
+
+// Handle implements reconciler.Handler.
+func (h *GarbageCollectDNSRecordsHandler) Handle(ctx context.Context, rc *reconciler.ReconcileContext[*sreportalv1alpha2.DNS, ChainData]) error {
+	dns := rc.Resource
+	emptyRuns, policy := recordGCSettings(dns.Spec.Reconciliation.RecordGC)
+	dryRun := dns.Spec.Reconciliation.RecordGC.DryRun
+
+	var existing sreportalv1alpha2.DNSRecordList
+	if err := h.Client.List(ctx, &existing, client.InNamespace(dns.Namespace)); err != nil {
+		return err
+	}
+	for i := range existing.Items {
+		dr := &existing.Items[i]
+		if !ownedBy(dr, dns) || dr.Spec.Origin != sreportalv1alpha2.DNSRecordOriginAuto {
+			continue
+		}
+		if rc.Data.UpsertedRecords[dr.Name] {
+			continue
+		}
+		// The records of agents are written and expired by the agent ingester.
+		if dr.Spec.SourceType.IsAgent() {
+			continue
+		}
+		kind := registry.SourceType(dr.Spec.SourceType)
+		// A record of a producing kind under another name was created with a
+		// previous naming template: the renamed record replaces it.
+		if len(rc.Data.KeptEndpointsByKind[kind]) > 0 {
+			if dryRun {
+				reportDryRun(ctx, rc, dr, "deleted", "%s DNSRecord renamed by the naming template", kind)
+				continue
+			}
+			if err := h.delete(ctx, rc, dr, "%s DNSRecord renamed by the naming template", kind); err != nil {
+				return err
+			}
+			continue
+		}
+		// Don't count a kind whose source hasn't synced yet: its absence is
+		// "not ready", not "authoritatively empty".
+		if rc.Data.PreserveKinds[kind] {
+			continue
+		}
+		if err := h.collect(ctx, rc, dr, kind, emptyRuns, policy, dryRun); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collect counts one more empty run on dr and applies policy once the grace
+// period is over, or only reports it in dry run.
+func (h *GarbageCollectDNSRecordsHandler) collect(
+	ctx context.Context,
+	rc *reconciler.ReconcileContext[*sreportalv1alpha2.DNS, ChainData],
+	dr *sreportalv1alpha2.DNSRecord,
+	kind registry.SourceType,
+	emptyRuns int,
+	policy sreportalv1alpha2.RecordDeletionPolicy,
+	dryRun bool,
+) error {
+	previous, _ := strconv.Atoi(dr.Annotations[adapter.EmptyRunsAnnotationKey])
+	runs := previous + 1
+	if runs >= emptyRuns && policy == sreportalv1alpha2.RecordDeletionPolicyDelete && !dryRun {
+		return h.delete(ctx, rc, dr, "%s no longer produces endpoints", kind)
+	}
+	// The annotation, not the counter, tells whether an orphan is annotated:
+	// a record past the grace period under dryRun or Retain is annotated
+	// once the policy becomes Annotate.
+	_, orphaned := dr.Annotations[adapter.OrphanedAnnotationKey]
+	annotate := runs >= emptyRuns && policy == sreportalv1alpha2.RecordDeletionPolicyAnnotate && !dryRun && !orphaned
+	if previous >= emptyRuns && !annotate {
+		return nil // retained, annotated or dry run, already reported
+	}
+
+	base := dr.DeepCopy()
+	if dr.Annotations == nil {
+		dr.Annotations = map[string]string{}
+	}
+	dr.Annotations[adapter.EmptyRunsAnnotationKey] = strconv.Itoa(runs)
+	if annotate {
+		dr.Annotations[adapter.OrphanedAnnotationKey] = time.Now().UTC().Format(time.RFC3339)
+	}
+	if err := h.Client.Patch(ctx, dr, client.MergeFrom(base)); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("count empty run on DNSRecord %s: %w", dr.Name, err)
+	}
+	switch {
+	case runs < emptyRuns:
+		log.FromContext(ctx).V(1).Info("source kind produced no endpoints, DNSRecord kept during its grace period",
+			"dnsRecord", dr.Name, "kind", kind, "emptyRuns", runs, "threshold", emptyRuns)
+	case dryRun && policy == sreportalv1alpha2.RecordDeletionPolicyDelete:
+		reportDryRun(ctx, rc, dr, "deleted", "%s no longer produces endpoints", kind)
+	case dryRun && policy == sreportalv1alpha2.RecordDeletionPolicyAnnotate:
+		reportDryRun(ctx, rc, dr, "annotated", "%s no longer produces endpoints", kind)
+	case annotate:
+		rc.Data.Event(rc.Resource, corev1.EventTypeWarning, "DNSRecordOrphaned", "AnnotateDNSRecord",
+			"annotated orphaned DNSRecord %s: %s produced no endpoints for %d reconciles and the deletion policy is Annotate",
+			dr.Name, kind, runs)
+	case policy == sreportalv1alpha2.RecordDeletionPolicyRetain:
+		rc.Data.Event(rc.Resource, corev1.EventTypeWarning, "DNSRecordRetained", "RetainDNSRecord",
+			"retained DNSRecord %s: %s produced no endpoints for %d reconciles and the deletion policy is Retain",
+			dr.Name, kind, runs)
+	}
+	return nil
+}
+
+// reportDryRun logs and records as an event that dr would have been deleted
+// or annotated (action) for reason, had recordGC.dryRun been off.
+func reportDryRun(
+	ctx context.Context,
+	rc *reconciler.ReconcileContext[*sreportalv1alpha2.DNS, ChainData],
+	dr *sreportalv1alpha2.DNSRecord,
+	action string,
+	reason string,
+	kind registry.SourceType,
+) {
+	log.FromContext(ctx).Info("dry run: DNSRecord would be "+action,
+		"dnsRecord", dr.Name, "kind", kind, "reason", fmt.Sprintf(reason, kind))
+	rc.Data.Event(rc.Resource, corev1.EventTypeNormal, "DNSRecordDryRun", "CollectDNSRecord",
+		"dry run: DNSRecord %s would be "+action+": "+reason, dr.Name, kind)
+}
+
+func (h *GarbageCollectDNSRecordsHandler) delete(
+	ctx context.Context,
+	rc *reconciler.ReconcileContext[*sreportalv1alpha2.DNS, ChainData],
+	dr *sreportalv1alpha2.DNSRecord,
+	reason string,
+	kind registry.SourceType,
+) error {
+	if err := h.Client.Delete(ctx, dr); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	rc.Data.Event(rc.Resource, corev1.EventTypeNormal, "DNSRecordDeleted", "DeleteDNSRecord",
+		"deleted DNSRecord %s: "+reason, dr.Name, kind)
+	return nil
+}
+
+// recordGCSettings returns the grace period and deletion policy of spec,
+// falling back to the defaults for unset fields.
+func recordGCSettings(spec sreportalv1alpha2.RecordGCSpec) (int, sreportalv1alpha2.RecordDeletionPolicy) {
+	emptyRuns := int(spec.EmptyRuns)
+	if emptyRuns < 1 {
+		emptyRuns = sreportalv1alpha2.DefaultRecordGCEmptyRuns
+	}
+	policy := spec.DeletionPolicy
+	if policy == "" {
+		policy = sreportalv1alpha2.RecordDeletionPolicyDelete
+	}
+	return emptyRuns, policy
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/external-dns/endpoint"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
+	dnschain "github.com/golgoth31/sreportal/internal/controller/dns/chain"
+	"github.com/golgoth31/sreportal/internal/reconciler"
+	"github.com/golgoth31/sreportal/internal/source/externaldns"
+	"github.com/golgoth31/sreportal/internal/source/registry"
+)
+
+// gcFixture builds a DNS CR with the given GC settings owning an auto
+// ingress DNSRecord, and a client holding both.
+func gcFixture(t *testing.T, gc sreportalv1alpha2.RecordGCSpec) (*sreportalv1alpha2.DNS, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
+
+	dns := &sreportalv1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: upsertTestNS1, UID: "u1"},
+		Spec: sreportalv1alpha2.DNSSpec{
+			PortalRef:      "p",
+			Reconciliation: sreportalv1alpha2.ReconciliationSpec{RecordGC: gc},
+		},
+	}
+	record := &sreportalv1alpha2.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: upsertTestRecordIng, Namespace: upsertTestNS1,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: sreportalv1alpha2.GroupVersion.String(),
+				Kind:       "DNS",
+				Name:       dns.Name,
+				UID:        dns.UID,
+				Controller: ptr.To(true), //nolint:modernize // new(bool) yields false, not true
+			}},
+		},
+		Spec: sreportalv1alpha2.DNSRecordSpec{
+			Origin:     sreportalv1alpha2.DNSRecordOriginAuto,
+			SourceType: sreportalv1alpha2.SourceType(externaldns.KindIngress),
+			PortalRef:  "p",
+			Entries:    []sreportalv1alpha2.DNSRecordEntry{{FQDN: "a.example.com", RecordType: "A", Targets: []string{upsertTestTargetA}}},
+		},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&sreportalv1alpha2.DNSRecord{}).
+		WithObjects(dns, record).
+		Build()
+	return dns, c
+}
+
+// runGC runs the upsert then the garbage collector once, with ingress
+// producing the given endpoints.
+func runGC(t *testing.T, c client.Client, dns *sreportalv1alpha2.DNS, recorder events.EventRecorder, ingress ...*endpoint.Endpoint) {
+	t.Helper()
+	rc := &reconciler.ReconcileContext[*sreportalv1alpha2.DNS, dnschain.ChainData]{
+		Resource: dns,
+		Data: dnschain.ChainData{
+			Recorder:            recorder,
+			KeptEndpointsByKind: map[registry.SourceType][]*endpoint.Endpoint{externaldns.KindIngress: ingress},
+		},
+	}
+	require.NoError(t, (&dnschain.UpsertDNSRecordsHandler{Client: c}).Handle(context.Background(), rc))
+	require.NoError(t, (&dnschain.GarbageCollectDNSRecordsHandler{Client: c}).Handle(context.Background(), rc))
+}
+
+func getIngressRecord(t *testing.T, c client.Client) (*sreportalv1alpha2.DNSRecord, error) {
+	t.Helper()
+	var dr sreportalv1alpha2.DNSRecord
+	err := c.Get(context.Background(), types.NamespacedName{Namespace: upsertTestNS1, Name: upsertTestRecordIng}, &dr)
+	return &dr, err
+}
+
+// TestGarbageCollectDNSRecords_DefaultGracePeriod verifies an empty kind's
+// record survives two empty runs and is deleted on the third.
+func TestGarbageCollectDNSRecords_DefaultGracePeriod(t *testing.T) {
+	dns, c := gcFixture(t, sreportalv1alpha2.RecordGCSpec{})
+
+	for run := 1; run < sreportalv1alpha2.DefaultRecordGCEmptyRuns; run++ {
+		runGC(t, c, dns, nil)
+		dr, err := getIngressRecord(t, c)
+		require.NoError(t, err, "record must survive empty run %d", run)
+		require.Equal(t, []string{"1", "2"}[run-1], dr.Annotations[adapter.EmptyRunsAnnotationKey])
+		require.Len(t, dr.Spec.Entries, 1, "entries are kept during the grace period")
+	}
+
+	runGC(t, c, dns, nil)
+	_, err := getIngressRecord(t, c)
+	require.True(t, apierrors.IsNotFound(err), "record must be deleted after the grace period, got err=%v", err)
+}
+
+// TestGarbageCollectDNSRecords_ProducingResetsCount verifies endpoints
+// coming back restart the grace period.
+func TestGarbageCollectDNSRecords_ProducingResetsCount(t *testing.T) {
+	dns, c := gcFixture(t, sreportalv1alpha2.RecordGCSpec{EmptyRuns: 2})
+
+	runGC(t, c, dns, nil)
+	dr, err := getIngressRecord(t, c)
+	require.NoError(t, err)
+	require.Equal(t, "1", dr.Annotations[adapter.EmptyRunsAnnotationKey])
+
+	runGC(t, c, dns, nil, endpoint.NewEndpoint("a.example.com", "A", upsertTestTargetA))
+	dr, err = getIngressRecord(t, c)
+	require.NoError(t, err)
+	require.NotContains(t, dr.Annotations, adapter.EmptyRunsAnnotationKey)
+
+	runGC(t, c, dns, nil)
+	_, err = getIngressRecord(t, c)
+	require.NoError(t, err, "one empty run after producing again must not delete the record")
+}
+
+// TestGarbageCollectDNSRecords_RetainPolicy verifies Retain keeps the record
+// past the grace period and warns once.
+func TestGarbageCollectDNSRecords_RetainPolicy(t *testing.T) {
+	dns, c := gcFixture(t, sreportalv1alpha2.RecordGCSpec{
+		EmptyRuns:      1,
+		DeletionPolicy: sreportalv1alpha2.RecordDeletionPolicyRetain,
+	})
+	recorder := events.NewFakeRecorder(4)
+
+	runGC(t, c, dns, recorder)
+	runGC(t, c, dns, recorder)
+
+	dr, err := getIngressRecord(t, c)
+	require.NoError(t, err, "Retain must keep the record")
+	require.Equal(t, "1", dr.Annotations[adapter.EmptyRunsAnnotationKey])
+	require.Len(t, recorder.Events, 1, "the retained record is reported once")
+	require.Contains(t, <-recorder.Events, "Warning DNSRecordRetained retained DNSRecord d-ingress")
+}
+
+// TestGarbageCollectDNSRecords_SkipsAgentRecords verifies the records of
+// agents, which no source kind produces, are never collected.
+func TestGarbageCollectDNSRecords_SkipsAgentRecords(t *testing.T) {
+	dns, c := gcFixture(t, sreportalv1alpha2.RecordGCSpec{EmptyRuns: 1})
+	dr, err := getIngressRecord(t, c)
+	require.NoError(t, err)
+	require.NoError(t, c.Delete(context.Background(), dr))
+	dr.ResourceVersion = ""
+	dr.Spec.SourceType = sreportalv1alpha2.AgentSourceType("edge-1")
+	require.NoError(t, c.Create(context.Background(), dr))
+
+	runGC(t, c, dns, nil)
+
+	dr, err = getIngressRecord(t, c)
+	require.NoError(t, err, "the agent record must be kept")
+	require.NotContains(t, dr.Annotations, adapter.EmptyRunsAnnotationKey)
+}
+
+// TestGarbageCollectDNSRecords_AnnotatePolicy verifies Annotate keeps the
+// record past the grace period, marks it once and unmarks it when the kind
+// produces again.
+func TestGarbageCollectDNSRecords_AnnotatePolicy(t *testing.T) {
+	dns, c := gcFixture(t, sreportalv1alpha2.RecordGCSpec{
+		EmptyRuns:      1,
+		DeletionPolicy: sreportalv1alpha2.RecordDeletionPolicyAnnotate,
+	})
+	recorder := events.NewFakeRecorder(4)
+
+	runGC(t, c, dns, recorder)
+	runGC(t, c, dns, recorder)
+
+	dr, err := getIngressRecord(t, c)
+	require.NoError(t, err, "Annotate must keep the record")
+	require.NotEmpty(t, dr.Annotations[adapter.OrphanedAnnotationKey])
+	require.Len(t, recorder.Events, 1, "the annotated record is reported once")
+	require.Contains(t, <-recorder.Events, "Warning DNSRecordOrphaned annotated orphaned DNSRecord d-ingress")
+
+	runGC(t, c, dns, recorder, endpoint.NewEndpoint("a.example.com", "A", upsertTestTargetA))
+	dr, err = getIngressRecord(t, c)
+	require.NoError(t, err)
+	require.NotContains(t, dr.Annotations, adapter.OrphanedAnnotationKey)
+}
+
+// TestGarbageCollectDNSRecords_DryRun verifies dry run reports the record
+// the Delete policy would delete, once, without deleting it.
+func TestGarbageCollectDNSRecords_DryRun(t *testing.T) {
+	dns, c := gcFixture(t, sreportalv1alpha2.RecordGCSpec{EmptyRuns: 1, DryRun: true})
+	recorder := events.NewFakeRecorder(4)
+
+	runGC(t, c, dns, recorder)
+	runGC(t, c, dns, recorder)
+
+	dr, err := getIngressRecord(t, c)
+	require.NoError(t, err, "dry run must keep the record")
+	require.Equal(t, "1", dr.Annotations[adapter.EmptyRunsAnnotationKey])
+	require.NotContains(t, dr.Annotations, adapter.OrphanedAnnotationKey)
+	require.Len(t, recorder.Events, 1, "the record is reported once")
+	require.Contains(t, <-recorder.Events, "Normal DNSRecordDryRun dry run: DNSRecord d-ingress would be deleted")
+}
+
+// TestGarbageCollectDNSRecords_DryRunThenAnnotate verifies a record past the
+// grace period under dry run is annotated once dry run is turned off.
+func TestGarbageCollectDNSRecords_DryRunThenAnnotate(t *testing.T) {
+	dns, c := gcFixture(t, sreportalv1alpha2.RecordGCSpec{
+		EmptyRuns:      1,
+		DeletionPolicy: sreportalv1alpha2.RecordDeletionPolicyAnnotate,
+		DryRun:         true,
+	})
+	recorder := events.NewFakeRecorder(4)
+
+	runGC(t, c, dns, recorder)
+	runGC(t, c, dns, recorder)
+	dr, err := getIngressRecord(t, c)
+	require.NoError(t, err)
+	require.NotContains(t, dr.Annotations, adapter.OrphanedAnnotationKey)
+	require.Contains(t, <-recorder.Events, "Normal DNSRecordDryRun dry run: DNSRecord d-ingress would be annotated")
+
+	dns.Spec.Reconciliation.RecordGC.DryRun = false
+	runGC(t, c, dns, recorder)
+	runGC(t, c, dns, recorder)
+
+	dr, err = getIngressRecord(t, c)
+	require.NoError(t, err)
+	require.NotEmpty(t, dr.Annotations[adapter.OrphanedAnnotationKey])
+	require.Len(t, recorder.Events, 1, "the annotated record is reported once")
+	require.Contains(t, <-recorder.Events, "Warning DNSRecordOrphaned annotated orphaned DNSRecord d-ingress")
+}
@@ -18,6 +18,8 @@ package dns
 
 import (
 	"context"
+	"maps"
+	"slices"
 
 	"sigs.k8s.io/external-dns/endpoint"
 
@@ -28,9 +30,15 @@ import (
 
 // IntraDNSDedupHandler enforces source priority at the FQDN level: the first
 // (highest-priority) kind to produce a given FQDN owns it, and lower-priority
-// kinds contribute nothing for that name.
+// kinds contribute nothing for that name. Every such conflict is recorded in
+// ChainData.PriorityConflicts.
 type IntraDNSDedupHandler struct{}
 
+// DataKeys implements reconciler.DataAccessor.
+func (*IntraDNSDedupHandler) DataKeys() (reads, writes []string) {
+	return []string{"EndpointsByKind", "PriorityOrder"}, []string{"KeptEndpointsByKind", "PriorityConflicts"}
+}
+
 // Handle implements reconciler.Handler.
 //
 // Ownership is keyed on the FQDN (DNSName) alone, not (name, recordType): once
@@ -45,6 +53,10 @@ type IntraDNSDedupHandler struct{}
 // compared against the claiming kind, not re-checked per record type.
 func (*IntraDNSDedupHandler) Handle(_ context.Context, rc *reconciler.ReconcileContext[*sreportalv1alpha2.DNS, ChainData]) error {
 	ownerByName := map[string]registry.SourceType{}
+	// Targets published by the owning kind, to tell which dropped targets
+	// actually went missing.
+	ownerTargets := map[string]map[string]bool{}
+	conflicts := map[string]*PriorityConflict{}
 	kept := make(map[registry.SourceType][]*endpoint.Endpoint, len(rc.Data.EndpointsByKind))
 	for _, kind := range rc.Data.PriorityOrder {
 		eps := rc.Data.EndpointsByKind[kind]
@@ -53,15 +65,46 @@ func (*IntraDNSDedupHandler) Handle(_ context.Context, rc *reconciler.ReconcileC
 			owner, claimed := ownerByName[e.DNSName]
 			if claimed && owner != kind {
 				// Owned by a higher-priority kind — drop regardless of record type.
+				recordConflict(conflicts, e, owner, kind, ownerTargets[e.DNSName])
 				continue
 			}
 			if !claimed {
 				ownerByName[e.DNSName] = kind
+				ownerTargets[e.DNSName] = map[string]bool{}
+			}
+			for _, t := range e.Targets {
+				ownerTargets[e.DNSName][t] = true
 			}
 			out = append(out, e)
 		}
 		kept[kind] = out
 	}
 	rc.Data.KeptEndpointsByKind = kept
+
+	rc.Data.PriorityConflicts = make([]PriorityConflict, 0, len(conflicts))
+	for _, name := range slices.Sorted(maps.Keys(conflicts)) {
+		c := conflicts[name]
+		slices.Sort(c.DroppedTargets)
+		rc.Data.PriorityConflicts = append(rc.Data.PriorityConflicts, *c)
+	}
 	return nil
 }
+
+// recordConflict adds the endpoint e of the losing kind loser to the conflict
+// of its FQDN. Kinds are visited in priority order, so Losers stays in that
+// order.
+func recordConflict(conflicts map[string]*PriorityConflict, e *endpoint.Endpoint, winner, loser registry.SourceType, winnerTargets map[string]bool) {
+	c, ok := conflicts[e.DNSName]
+	if !ok {
+		c = &PriorityConflict{FQDN: e.DNSName, Winner: winner}
+		conflicts[e.DNSName] = c
+	}
+	if !slices.Contains(c.Losers, loser) {
+		c.Losers = append(c.Losers, loser)
+	}
+	for _, t := range e.Targets {
+		if !winnerTargets[t] && !slices.Contains(c.DroppedTargets, t) {
+			c.DroppedTargets = append(c.DroppedTargets, t)
+		}
+	}
+}
@@ -95,3 +95,30 @@ func TestIntraDNSDedup_KeepsMultiRecordTypeFromWinner(t *testing.T) {
 		"winning kind keeps both A and AAAA for the same FQDN")
 	require.Empty(t, rc.Data.KeptEndpointsByKind[externaldns.KindService])
 }
+
+// TestIntraDNSDedup_RecordsPriorityConflicts verifies every dropped FQDN is
+// recorded with its winning and losing kinds, and only the targets the winner
+// does not publish count as dropped.
+func TestIntraDNSDedup_RecordsPriorityConflicts(t *testing.T) {
+	h := &dnschain.IntraDNSDedupHandler{}
+	rc := &reconciler.ReconcileContext[*sreportalv1alpha2.DNS, dnschain.ChainData]{
+		Data: dnschain.ChainData{
+			PriorityOrder: []registry.SourceType{externaldns.KindIngress, externaldns.KindService, externaldns.KindDNSEndpoint},
+			EndpointsByKind: map[registry.SourceType][]*endpoint.Endpoint{
+				externaldns.KindIngress: {endpoint.NewEndpoint("app.example.com", "A", "1.1.1.1")},
+				externaldns.KindService: {
+					endpoint.NewEndpoint("app.example.com", "A", "1.1.1.1", "9.9.9.9"),
+					endpoint.NewEndpoint("only-service.example.com", "A", "3.3.3.3"),
+				},
+				externaldns.KindDNSEndpoint: {endpoint.NewEndpoint("app.example.com", "CNAME", "lb.example.net")},
+			},
+		},
+	}
+	require.NoError(t, h.Handle(context.Background(), rc))
+	require.Equal(t, []dnschain.PriorityConflict{{
+		FQDN:           "app.example.com",
+		Winner:         externaldns.KindIngress,
+		Losers:         []registry.SourceType{externaldns.KindService, externaldns.KindDNSEndpoint},
+		DroppedTargets: []string{"9.9.9.9", "lb.example.net"},
+	}}, rc.Data.PriorityConflicts)
+}
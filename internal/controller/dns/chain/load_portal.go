@@ -0,0 +1,88 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
+	"github.com/golgoth31/sreportal/internal/reconciler"
+	"github.com/golgoth31/sreportal/internal/source/registry"
+)
+
+// LoadPortalHandler reads the Portal referenced by the DNS CR and exposes its
+// spec.sourcePriority override as ChainData.PortalPriority. A missing Portal
+// is not an error: the DNS CR then falls back to its own priority list. For
+// the main portal it also collects the namespaces that have an automatic
+// portal into ChainData.ExcludedNamespaces, so their endpoints are only shown
+// on that portal.
+type LoadPortalHandler struct {
+	Client client.Reader
+}
+
+// DataKeys implements reconciler.DataAccessor.
+func (*LoadPortalHandler) DataKeys() (reads, writes []string) {
+	return nil, []string{"PortalPriority", "ExcludedNamespaces"}
+}
+
+// Handle implements reconciler.Handler.
+func (h *LoadPortalHandler) Handle(ctx context.Context, rc *reconciler.ReconcileContext[*sreportalv1alpha2.DNS, ChainData]) error {
+	dns := rc.Resource
+	if h.Client == nil || dns.Spec.PortalRef == "" {
+		return nil
+	}
+
+	var portal sreportalv1alpha1.Portal
+	key := types.NamespacedName{Namespace: dns.Namespace, Name: dns.Spec.PortalRef}
+	if err := h.Client.Get(ctx, key, &portal); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("get portal %s: %w", key, err)
+	}
+
+	rc.Data.PortalPriority = make([]registry.SourceType, 0, len(portal.Spec.SourcePriority))
+	for _, k := range portal.Spec.SourcePriority {
+		rc.Data.PortalPriority = append(rc.Data.PortalPriority, registry.SourceType(k))
+	}
+
+	if !portal.Spec.Main {
+		return nil
+	}
+	var autoPortals sreportalv1alpha1.PortalList
+	if err := h.Client.List(ctx, &autoPortals, client.HasLabels{adapter.AutoPortalNamespaceLabelKey}); err != nil {
+		return fmt.Errorf("list automatic portals: %w", err)
+	}
+	for i := range autoPortals.Items {
+		ns := autoPortals.Items[i].Labels[adapter.AutoPortalNamespaceLabelKey]
+		if ns == "" {
+			continue
+		}
+		if rc.Data.ExcludedNamespaces == nil {
+			rc.Data.ExcludedNamespaces = make(map[string]bool, len(autoPortals.Items))
+		}
+		rc.Data.ExcludedNamespaces[ns] = true
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	dnschain "github.com/golgoth31/sreportal/internal/controller/dns/chain"
+	"github.com/golgoth31/sreportal/internal/reconciler"
+	"github.com/golgoth31/sreportal/internal/source/externaldns"
+	"github.com/golgoth31/sreportal/internal/source/registry"
+)
+
+func newLoadPortalContext(portalRef string) *reconciler.ReconcileContext[*sreportalv1alpha2.DNS, dnschain.ChainData] {
+	return &reconciler.ReconcileContext[*sreportalv1alpha2.DNS, dnschain.ChainData]{
+		Resource: &sreportalv1alpha2.DNS{
+			ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: tNS1},
+			Spec:       sreportalv1alpha2.DNSSpec{PortalRef: portalRef},
+		},
+	}
+}
+
+func TestLoadPortalHandler_ReadsSourcePriority(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&sreportalv1alpha1.Portal{
+		ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: tNS1},
+		Spec: sreportalv1alpha1.PortalSpec{
+			Title:          "P",
+			SourcePriority: []string{"ingress", "service"},
+		},
+	}).Build()
+
+	rc := newLoadPortalContext("p")
+	require.NoError(t, (&dnschain.LoadPortalHandler{Client: c}).Handle(context.Background(), rc))
+	require.Equal(t,
+		[]registry.SourceType{externaldns.KindIngress, externaldns.KindService},
+		rc.Data.PortalPriority,
+	)
+}
+
+func TestLoadPortalHandler_MissingPortalIsNotAnError(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	rc := newLoadPortalContext("missing")
+	require.NoError(t, (&dnschain.LoadPortalHandler{Client: c}).Handle(context.Background(), rc))
+	require.Empty(t, rc.Data.PortalPriority)
+}
+
+func TestLoadPortalHandler_MainPortalExcludesAutomaticPortalNamespaces(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&sreportalv1alpha1.Portal{
+			ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: tNS1},
+			Spec:       sreportalv1alpha1.PortalSpec{Title: "Main", Main: true},
+		},
+		&sreportalv1alpha1.Portal{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "team-a", Namespace: tNS1,
+				Labels: map[string]string{"sreportal.io/auto-portal-namespace": "team-a"},
+			},
+			Spec: sreportalv1alpha1.PortalSpec{Title: "Team A"},
+		},
+	).Build()
+
+	rc := newLoadPortalContext("main")
+	require.NoError(t, (&dnschain.LoadPortalHandler{Client: c}).Handle(context.Background(), rc))
+	require.Equal(t, map[string]bool{"team-a": true}, rc.Data.ExcludedNamespaces)
+
+	// The automatic portal itself shows its namespace.
+	rc = newLoadPortalContext("team-a")
+	require.NoError(t, (&dnschain.LoadPortalHandler{Client: c}).Handle(context.Background(), rc))
+	require.Empty(t, rc.Data.ExcludedNamespaces)
+}
@@ -28,13 +28,16 @@ import (
 	"github.com/golgoth31/sreportal/internal/reconciler"
 	sourcepkg "github.com/golgoth31/sreportal/internal/source"
 	"github.com/golgoth31/sreportal/internal/source/crossplanescalewayrecord"
+	"github.com/golgoth31/sreportal/internal/source/demo"
 	"github.com/golgoth31/sreportal/internal/source/externaldns"
 	"github.com/golgoth31/sreportal/internal/source/registry"
 )
 
 // LookupSourcesHandler queries the SourceEndpointStore for each enabled kind
 // in the DNS CR, applying the effective (namespace, labelFilter) computed
-// from spec.sources.<k> ∪ spec.defaults. The result is stored in
+// from spec.sources.<k> ∪ spec.defaults, then keeps the record types of the
+// effective recordTypeFilter (see effectiveRecordTypes). Endpoints of the
+// namespaces in ChainData.ExcludedNamespaces are dropped. The result is stored in
 // ChainData.EndpointsByKind keyed by SourceType, and ChainData.PriorityOrder
 // carries the iteration order downstream handlers must respect.
 type LookupSourcesHandler struct {
@@ -47,6 +50,11 @@ type LookupSourcesHandler struct {
 // indistinguishable from an intentional empty config.
 var ErrNilSourceReader = errors.New("LookupSourcesHandler: Source reader is nil (wiring bug)")
 
+// DataKeys implements reconciler.DataAccessor.
+func (*LookupSourcesHandler) DataKeys() (reads, writes []string) {
+	return []string{"PortalPriority", "ExcludedNamespaces"}, []string{"EndpointsByKind", "PriorityOrder", "PreserveKinds"}
+}
+
 // Handle implements reconciler.Handler.
 func (h *LookupSourcesHandler) Handle(_ context.Context, rc *reconciler.ReconcileContext[*sreportalv1alpha2.DNS, ChainData]) error {
 	if h.Source == nil {
@@ -56,10 +64,15 @@ func (h *LookupSourcesHandler) Handle(_ context.Context, rc *reconciler.Reconcil
 	dns := rc.Resource
 	enabled := sourcepkg.EnabledKindsFromSpec(&dns.Spec.Sources)
 	rc.Data.EndpointsByKind = make(map[registry.SourceType][]*endpoint.Endpoint, len(enabled))
-	rc.Data.PriorityOrder = orderedKinds(dns, enabled)
+	rc.Data.PriorityOrder = orderedKinds(effectivePriority(dns, rc.Data.PortalPriority), enabled)
 	rc.Data.PreserveKinds = make(map[registry.SourceType]bool, len(enabled))
 
 	for _, kind := range rc.Data.PriorityOrder {
+		// Demo endpoints are not collected into the store; DemoSourceHandler
+		// generates them.
+		if kind == demo.SourceTypeDemo {
+			continue
+		}
 		// A kind whose source has not synced yet (store not ready) must not have
 		// its existing DNSRecords purged downstream — its empty lookup means
 		// "not ready", not "empty". See ChainData.PreserveKinds.
@@ -71,8 +84,15 @@ func (h *LookupSourcesHandler) Handle(_ context.Context, rc *reconciler.Reconcil
 		if err != nil {
 			return err
 		}
+		recordTypes := effectiveRecordTypes(dns, kind)
 		eps := make([]*endpoint.Endpoint, 0, len(entries))
 		for _, e := range entries {
+			if len(recordTypes) > 0 && !slices.Contains(recordTypes, e.Endpoint.RecordType) {
+				continue
+			}
+			if rc.Data.ExcludedNamespaces[e.Namespace] {
+				continue
+			}
 			eps = append(eps, e.Endpoint)
 		}
 		rc.Data.EndpointsByKind[kind] = eps
@@ -89,6 +109,16 @@ func effectiveFilter(dns *sreportalv1alpha2.DNS, kind registry.SourceType) (stri
 	return ns, lbl
 }
 
+// effectiveRecordTypes returns the record types to keep for a given kind:
+// spec.sources.<k>.recordTypeFilter when set, spec.sources.recordTypeFilter
+// otherwise. Empty keeps every type.
+func effectiveRecordTypes(dns *sreportalv1alpha2.DNS, kind registry.SourceType) []string {
+	if src := perKindCommonSpec(&dns.Spec.Sources, kind); len(src.RecordTypeFilter) > 0 {
+		return src.RecordTypeFilter
+	}
+	return dns.Spec.Sources.RecordTypeFilter
+}
+
 func firstNonEmpty(a, b string) string {
 	if a != "" {
 		return a
@@ -97,8 +127,8 @@ func firstNonEmpty(a, b string) string {
 }
 
 // perKindCommonSpec returns the CommonSourceSpec carried by the per-kind
-// typed pointer in SourcesSpec. DNSEndpoint and CrossplaneScalewayRecord do
-// not embed CommonSourceSpec — synthesise an equivalent view so the lookup
+// typed pointer in SourcesSpec. DNSEndpoint, AmbassadorHost and
+// CrossplaneScalewayRecord do not embed CommonSourceSpec — synthesise an equivalent view so the lookup
 // path stays uniform.
 func perKindCommonSpec(s *sreportalv1alpha2.SourcesSpec, kind registry.SourceType) sreportalv1alpha2.CommonSourceSpec {
 	switch kind {
@@ -113,9 +143,10 @@ func perKindCommonSpec(s *sreportalv1alpha2.SourcesSpec, kind registry.SourceTyp
 	case externaldns.KindDNSEndpoint:
 		if s.DNSEndpoint != nil {
 			return sreportalv1alpha2.CommonSourceSpec{
-				Enabled:     s.DNSEndpoint.Enabled,
-				Namespace:   s.DNSEndpoint.Namespace,
-				LabelFilter: s.DNSEndpoint.LabelFilter,
+				Enabled:          s.DNSEndpoint.Enabled,
+				Namespace:        s.DNSEndpoint.Namespace,
+				LabelFilter:      s.DNSEndpoint.LabelFilter,
+				RecordTypeFilter: s.DNSEndpoint.RecordTypeFilter,
 			}
 		}
 	case externaldns.KindIstioGateway:
@@ -146,25 +177,53 @@ func perKindCommonSpec(s *sreportalv1alpha2.SourcesSpec, kind registry.SourceTyp
 		if s.GatewayUDPRoute != nil {
 			return s.GatewayUDPRoute.CommonSourceSpec
 		}
+	case externaldns.KindContourHTTPProxy:
+		if s.ContourHTTPProxy != nil {
+			return s.ContourHTTPProxy.CommonSourceSpec
+		}
+	case externaldns.KindAmbassadorHost:
+		if s.AmbassadorHost != nil {
+			return sreportalv1alpha2.CommonSourceSpec{
+				Enabled:          s.AmbassadorHost.Enabled,
+				Namespace:        s.AmbassadorHost.Namespace,
+				AnnotationFilter: s.AmbassadorHost.AnnotationFilter,
+				LabelFilter:      s.AmbassadorHost.LabelFilter,
+				RecordTypeFilter: s.AmbassadorHost.RecordTypeFilter,
+			}
+		}
 	case crossplanescalewayrecord.SourceTypeCrossplaneScalewayRecord:
 		if s.CrossplaneScalewayRecord != nil {
 			return sreportalv1alpha2.CommonSourceSpec{
-				Enabled:     s.CrossplaneScalewayRecord.Enabled,
-				Namespace:   s.CrossplaneScalewayRecord.Namespace,
-				LabelFilter: s.CrossplaneScalewayRecord.LabelFilter,
+				Enabled:          s.CrossplaneScalewayRecord.Enabled,
+				Namespace:        s.CrossplaneScalewayRecord.Namespace,
+				LabelFilter:      s.CrossplaneScalewayRecord.LabelFilter,
+				RecordTypeFilter: s.CrossplaneScalewayRecord.RecordTypeFilter,
 			}
 		}
 	}
 	return sreportalv1alpha2.CommonSourceSpec{}
 }
 
-// orderedKinds returns enabled kinds in spec.sources.priority order, with
-// any leftover enabled kinds appended in deterministic SourceType order.
-func orderedKinds(dns *sreportalv1alpha2.DNS, enabled map[registry.SourceType]bool) []registry.SourceType {
+// effectivePriority returns the priority list to honour: the Portal's
+// spec.sourcePriority when set, spec.sources.priority otherwise.
+func effectivePriority(dns *sreportalv1alpha2.DNS, portalPriority []registry.SourceType) []registry.SourceType {
+	if len(portalPriority) > 0 {
+		return portalPriority
+	}
+	out := make([]registry.SourceType, 0, len(dns.Spec.Sources.Priority))
+	for _, k := range dns.Spec.Sources.Priority {
+		out = append(out, registry.SourceType(k))
+	}
+	return out
+}
+
+// orderedKinds returns enabled kinds in priority order, with any leftover
+// enabled kinds appended in deterministic SourceType order. Priority entries
+// for kinds that are not enabled are ignored.
+func orderedKinds(priority []registry.SourceType, enabled map[registry.SourceType]bool) []registry.SourceType {
 	out := make([]registry.SourceType, 0, len(enabled))
 	seen := map[registry.SourceType]bool{}
-	for _, k := range dns.Spec.Sources.Priority {
-		st := registry.SourceType(k)
+	for _, st := range priority {
 		if enabled[st] && !seen[st] {
 			out = append(out, st)
 			seen[st] = true
@@ -30,6 +30,7 @@ import (
 	rsource "github.com/golgoth31/sreportal/internal/readstore/source"
 	"github.com/golgoth31/sreportal/internal/reconciler"
 	"github.com/golgoth31/sreportal/internal/source/crossplanescalewayrecord"
+	"github.com/golgoth31/sreportal/internal/source/demo"
 	"github.com/golgoth31/sreportal/internal/source/externaldns"
 	"github.com/golgoth31/sreportal/internal/source/registry"
 )
@@ -68,6 +69,33 @@ func TestLookupSourcesHandler_FiltersByNamespaceAndLabel(t *testing.T) {
 	require.Equal(t, "a.example.com", got[0].DNSName)
 }
 
+func TestLookupSourcesHandler_DropsExcludedNamespaces(t *testing.T) {
+	store := rsource.NewStore()
+	store.ReplaceKind(externaldns.KindService, []domainsource.EnrichedEndpoint{
+		{Endpoint: endpoint.NewEndpoint("a.example.com", "A", "1.1.1.1"), Kind: externaldns.KindService, Namespace: tNS1},
+		{Endpoint: endpoint.NewEndpoint("team.example.com", "A", "2.2.2.2"), Kind: externaldns.KindService, Namespace: "team-a"},
+	})
+
+	h := &dnschain.LookupSourcesHandler{Source: store}
+	rc := &reconciler.ReconcileContext[*sreportalv1alpha2.DNS, dnschain.ChainData]{
+		Resource: &sreportalv1alpha2.DNS{
+			ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: tNS1},
+			Spec: sreportalv1alpha2.DNSSpec{
+				Sources: sreportalv1alpha2.SourcesSpec{
+					Service: &sreportalv1alpha2.ServiceSourceSpec{
+						CommonSourceSpec: sreportalv1alpha2.CommonSourceSpec{Enabled: true},
+					},
+				},
+			},
+		},
+		Data: dnschain.ChainData{ExcludedNamespaces: map[string]bool{"team-a": true}},
+	}
+	require.NoError(t, h.Handle(context.Background(), rc))
+	got := rc.Data.EndpointsByKind[externaldns.KindService]
+	require.Len(t, got, 1)
+	require.Equal(t, "a.example.com", got[0].DNSName)
+}
+
 func TestLookupSourcesHandler_PerKindOverridesDefaults(t *testing.T) {
 	store := rsource.NewStore()
 	store.ReplaceKind(externaldns.KindIngress, []domainsource.EnrichedEndpoint{
@@ -96,6 +124,41 @@ func TestLookupSourcesHandler_PerKindOverridesDefaults(t *testing.T) {
 	require.Equal(t, "ing.example.com", got[0].DNSName)
 }
 
+func TestLookupSourcesHandler_FiltersRecordTypes(t *testing.T) {
+	store := rsource.NewStore()
+	store.ReplaceKind(externaldns.KindService, []domainsource.EnrichedEndpoint{
+		{Endpoint: endpoint.NewEndpoint("svc.example.com", "A", "1.1.1.1"), Kind: externaldns.KindService, Namespace: tNS1},
+		{Endpoint: endpoint.NewEndpoint("svc.example.com", "TXT", "owner=x"), Kind: externaldns.KindService, Namespace: tNS1},
+	})
+	store.ReplaceKind(externaldns.KindDNSEndpoint, []domainsource.EnrichedEndpoint{
+		{Endpoint: endpoint.NewEndpoint("ep.example.com", "A", "2.2.2.2"), Kind: externaldns.KindDNSEndpoint, Namespace: tNS1},
+		{Endpoint: endpoint.NewEndpoint("ep.example.com", "TXT", "owner=x"), Kind: externaldns.KindDNSEndpoint, Namespace: tNS1},
+	})
+
+	h := &dnschain.LookupSourcesHandler{Source: store}
+	rc := &reconciler.ReconcileContext[*sreportalv1alpha2.DNS, dnschain.ChainData]{
+		Resource: &sreportalv1alpha2.DNS{
+			ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: tNS1},
+			Spec: sreportalv1alpha2.DNSSpec{
+				Sources: sreportalv1alpha2.SourcesSpec{
+					Service:          &sreportalv1alpha2.ServiceSourceSpec{CommonSourceSpec: sreportalv1alpha2.CommonSourceSpec{Enabled: true}},
+					DNSEndpoint:      &sreportalv1alpha2.DNSEndpointSourceSpec{Enabled: true, RecordTypeFilter: []string{"TXT"}},
+					RecordTypeFilter: []string{"A", "AAAA"},
+				},
+			},
+		},
+		Data: dnschain.ChainData{},
+	}
+	require.NoError(t, h.Handle(context.Background(), rc))
+
+	svc := rc.Data.EndpointsByKind[externaldns.KindService]
+	require.Len(t, svc, 1, "the global filter applies")
+	require.Equal(t, "A", svc[0].RecordType)
+	ep := rc.Data.EndpointsByKind[externaldns.KindDNSEndpoint]
+	require.Len(t, ep, 1, "the per-source filter overrides the global one")
+	require.Equal(t, "TXT", ep[0].RecordType)
+}
+
 func TestLookupSourcesHandler_PriorityOrder(t *testing.T) {
 	store := rsource.NewStore()
 	store.ReplaceKind(externaldns.KindService, []domainsource.EnrichedEndpoint{
@@ -226,6 +289,26 @@ func TestLookupSourcesHandler_CrossplaneScalewayRecord(t *testing.T) {
 	require.Contains(t, rc.Data.PriorityOrder, crossplanescalewayrecord.SourceTypeCrossplaneScalewayRecord)
 }
 
+func TestLookupSourcesHandler_DemoIsOrderedButNotLookedUp(t *testing.T) {
+	h := &dnschain.LookupSourcesHandler{Source: rsource.NewStore()}
+	rc := &reconciler.ReconcileContext[*sreportalv1alpha2.DNS, dnschain.ChainData]{
+		Resource: &sreportalv1alpha2.DNS{
+			ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: tNS1},
+			Spec: sreportalv1alpha2.DNSSpec{
+				Sources: sreportalv1alpha2.SourcesSpec{
+					Demo:     &sreportalv1alpha2.DemoSourceSpec{Enabled: true},
+					Priority: []sreportalv1alpha2.SourceType{sreportalv1alpha2.SourceTypeDemo},
+				},
+			},
+		},
+		Data: dnschain.ChainData{},
+	}
+	require.NoError(t, h.Handle(context.Background(), rc))
+	require.Equal(t, []registry.SourceType{demo.SourceTypeDemo}, rc.Data.PriorityOrder)
+	require.NotContains(t, rc.Data.EndpointsByKind, demo.SourceTypeDemo)
+	require.False(t, rc.Data.PreserveKinds[demo.SourceTypeDemo])
+}
+
 func TestLookupSourcesHandler_InvalidLabelSelectorReturnsError(t *testing.T) {
 	store := rsource.NewStore()
 	store.ReplaceKind(externaldns.KindService, []domainsource.EnrichedEndpoint{
@@ -249,3 +332,33 @@ func TestLookupSourcesHandler_InvalidLabelSelectorReturnsError(t *testing.T) {
 	}
 	require.Error(t, h.Handle(context.Background(), rc))
 }
+
+func TestLookupSourcesHandler_PortalPriorityOverridesDNSPriority(t *testing.T) {
+	store := rsource.NewStore()
+
+	h := &dnschain.LookupSourcesHandler{Source: store}
+	rc := &reconciler.ReconcileContext[*sreportalv1alpha2.DNS, dnschain.ChainData]{
+		Resource: &sreportalv1alpha2.DNS{
+			ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: "x"},
+			Spec: sreportalv1alpha2.DNSSpec{
+				Sources: sreportalv1alpha2.SourcesSpec{
+					Service: &sreportalv1alpha2.ServiceSourceSpec{CommonSourceSpec: sreportalv1alpha2.CommonSourceSpec{Enabled: true}},
+					Ingress: &sreportalv1alpha2.IngressSourceSpec{CommonSourceSpec: sreportalv1alpha2.CommonSourceSpec{Enabled: true}},
+					Priority: []sreportalv1alpha2.SourceType{
+						sreportalv1alpha2.SourceTypeService,
+						sreportalv1alpha2.SourceTypeIngress,
+					},
+				},
+			},
+		},
+		Data: dnschain.ChainData{
+			// gateway-httproute is not enabled on the DNS CR and must be ignored.
+			PortalPriority: []registry.SourceType{externaldns.KindGatewayHTTPRoute, externaldns.KindIngress},
+		},
+	}
+	require.NoError(t, h.Handle(context.Background(), rc))
+	require.Equal(t,
+		[]registry.SourceType{externaldns.KindIngress, externaldns.KindService},
+		rc.Data.PriorityOrder,
+	)
+}
@@ -18,17 +18,29 @@ package dns
 
 import (
 	"context"
+	"errors"
+	"math/rand/v2"
 	"net"
+	"time"
 
 	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
 )
 
-// Compile-time check that NetResolver implements domaindns.Resolver.
-var _ domaindns.Resolver = (*NetResolver)(nil)
+// defaultTTLQueryTimeout bounds a TTL query sent without a context deadline.
+const defaultTTLQueryTimeout = 5 * time.Second
+
+// Compile-time checks that NetResolver implements domaindns.Resolver and
+// domaindns.TTLResolver.
+var (
+	_ domaindns.Resolver    = (*NetResolver)(nil)
+	_ domaindns.TTLResolver = (*NetResolver)(nil)
+)
 
 // NetResolver adapts net.Resolver to the domain Resolver interface.
 type NetResolver struct {
 	resolver *net.Resolver
+	// addr is the DNS server queried for TTLs; empty for the system resolver.
+	addr string
 }
 
 // NewNetResolver creates a NetResolver using the default system DNS resolver.
@@ -36,6 +48,18 @@ func NewNetResolver() *NetResolver {
 	return &NetResolver{resolver: net.DefaultResolver}
 }
 
+// NewNetResolverFor creates a NetResolver that sends every query to the DNS
+// server at addr ("host:port") instead of the system resolver.
+func NewNetResolverFor(addr string) *NetResolver {
+	return &NetResolver{addr: addr, resolver: &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, network, addr)
+		},
+	}}
+}
+
 // LookupHost resolves a hostname to a list of IP addresses.
 func (r *NetResolver) LookupHost(ctx context.Context, fqdn string) ([]string, error) {
 	return r.resolver.LookupHost(ctx, fqdn)
@@ -45,3 +69,60 @@ func (r *NetResolver) LookupHost(ctx context.Context, fqdn string) ([]string, er
 func (r *NetResolver) LookupCNAME(ctx context.Context, fqdn string) (string, error) {
 	return r.resolver.LookupCNAME(ctx, fqdn)
 }
+
+// LookupTXT returns the TXT records of the given name.
+func (r *NetResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	return r.resolver.LookupTXT(ctx, name)
+}
+
+// LookupTTL returns the TTL served for the recordType record of fqdn. The
+// net.Resolver does not expose TTLs, so the query is sent over UDP to the
+// configured server; the system resolver returns domaindns.ErrTTLUnavailable.
+func (r *NetResolver) LookupTTL(ctx context.Context, fqdn, recordType string) (uint32, error) {
+	qtype, ok := ttlQueryType(recordType)
+	if r.addr == "" || !ok {
+		return 0, domaindns.ErrTTLUnavailable
+	}
+	id := uint16(rand.Uint32())
+	packed, err := packQuery(fqdn, qtype, id)
+	if err != nil {
+		return 0, err
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "udp", r.addr)
+	if err != nil {
+		return 0, &net.DNSError{Err: err.Error(), Name: fqdn, Server: r.addr, IsTemporary: true}
+	}
+	defer func() { _ = conn.Close() }()
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(defaultTTLQueryTimeout)
+	}
+	_ = conn.SetDeadline(deadline)
+
+	if _, err := conn.Write(packed); err != nil {
+		return 0, &net.DNSError{Err: err.Error(), Name: fqdn, Server: r.addr, IsTemporary: true}
+	}
+	buf := make([]byte, maxDNSMessageSize)
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			return 0, &net.DNSError{Err: err.Error(), Name: fqdn, Server: r.addr, IsTimeout: isTimeout(err), IsTemporary: true}
+		}
+		msg, err := unpackResponse(buf[:n], fqdn, r.addr)
+		if err != nil {
+			return 0, err
+		}
+		if msg.ID != id {
+			continue // stray answer to an earlier query
+		}
+		return answerTTL(msg, fqdn, qtype)
+	}
+}
+
+// isTimeout reports whether err is a network timeout.
+func isTimeout(err error) bool {
+	var ne net.Error
+	return errors.As(err, &ne) && ne.Timeout()
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"fmt"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/config"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	domainsource "github.com/golgoth31/sreportal/internal/domain/source"
+	"github.com/golgoth31/sreportal/internal/reconciler"
+)
+
+// Names of the DNS chain handlers, as used in the dnsPipeline configuration.
+const (
+	HandlerLoadPortal           = "loadPortal"
+	HandlerLookupSources        = "lookupSources"
+	HandlerExternalNameServices = "externalNameServices"
+	HandlerDemoSource           = "demoSource"
+	HandlerIntraDNSDedup        = "intraDNSDedup"
+	HandlerValidateEntries      = "validateEntries"
+	HandlerUpsertDNSRecords     = "upsertDNSRecords"
+	HandlerGarbageCollect       = "garbageCollectDNSRecords"
+	HandlerSourcesStatus        = "sourcesStatus"
+	HandlerConditionsRollup     = "conditionsRollup"
+)
+
+// Names of the DNS pipeline steps that run outside the DNS chain, as
+// background runnables over the DNSRecords. They can only be disabled.
+const (
+	StepResolution = "resolution"
+	StepProbes     = "probes"
+)
+
+// DefaultPipeline is the order the DNS chain handlers run in when the
+// configuration sets none.
+var DefaultPipeline = []string{
+	HandlerLoadPortal,
+	HandlerLookupSources,
+	HandlerExternalNameServices,
+	HandlerDemoSource,
+	HandlerIntraDNSDedup,
+	HandlerValidateEntries,
+	HandlerUpsertDNSRecords,
+	HandlerGarbageCollect,
+	HandlerSourcesStatus,
+	HandlerConditionsRollup,
+}
+
+// requiredHandlers cannot be disabled: without them the chain produces no
+// endpoint, keeps none, or writes none.
+var requiredHandlers = []string{HandlerLookupSources, HandlerIntraDNSDedup, HandlerUpsertDNSRecords}
+
+// Handler is a DNS chain handler.
+type Handler = reconciler.Handler[*sreportalv1alpha2.DNS, ChainData]
+
+// Deps are the dependencies the DNS chain handlers are built from.
+type Deps struct {
+	Client    client.Client
+	Source    domainsource.SourceEndpointReader
+	Conflicts domaindns.FQDNConflictReader
+	// Upsert is shared with the reconciler, which configures it after the
+	// chain is built (e.g. its record namer).
+	Upsert *UpsertDNSRecordsHandler
+}
+
+// Registry maps a handler name to the function building it.
+type Registry map[string]func(Deps) Handler
+
+// DefaultRegistry returns the registry of every DNS chain handler.
+func DefaultRegistry() Registry {
+	return Registry{
+		HandlerLoadPortal:           func(d Deps) Handler { return &LoadPortalHandler{Client: d.Client} },
+		HandlerLookupSources:        func(d Deps) Handler { return &LookupSourcesHandler{Source: d.Source} },
+		HandlerExternalNameServices: func(d Deps) Handler { return &ExternalNameServicesHandler{Client: d.Client} },
+		HandlerDemoSource:           func(Deps) Handler { return &DemoSourceHandler{} },
+		HandlerIntraDNSDedup:        func(Deps) Handler { return &IntraDNSDedupHandler{} },
+		HandlerValidateEntries:      func(Deps) Handler { return &ValidateEntriesHandler{} },
+		HandlerUpsertDNSRecords:     func(d Deps) Handler { return d.Upsert },
+		HandlerGarbageCollect:       func(d Deps) Handler { return &GarbageCollectDNSRecordsHandler{Client: d.Client} },
+		HandlerSourcesStatus:        func(d Deps) Handler { return &SourcesStatusHandler{Conflicts: d.Conflicts} },
+		HandlerConditionsRollup:     func(d Deps) Handler { return &ConditionsRollupHandler{Client: d.Client} },
+	}
+}
+
+// Pipeline is the DNS pipeline resolved from the dnsPipeline configuration.
+type Pipeline struct {
+	// Handlers are the names of the DNS chain handlers, in order.
+	Handlers []string
+	// Resolution and Probes report whether the steps run outside the chain
+	// are enabled.
+	Resolution bool
+	Probes     bool
+}
+
+// ResolvePipeline checks cfg against the registry and returns the resulting
+// pipeline. Every ChainData key a handler reads (as declared by
+// reconciler.DataAccessor) must be written by an earlier handler, unless no
+// handler of the pipeline writes it.
+func (r Registry) ResolvePipeline(cfg config.DNSPipelineConfig) (Pipeline, error) {
+	names := cfg.Handlers
+	if len(names) == 0 {
+		names = DefaultPipeline
+	}
+	for _, name := range names {
+		if _, ok := r[name]; !ok {
+			return Pipeline{}, fmt.Errorf("%w: unknown handler %q", config.ErrInvalidDNSPipeline, name)
+		}
+	}
+	p := Pipeline{Resolution: true, Probes: true}
+	for _, name := range cfg.Disabled {
+		switch {
+		case name == StepResolution:
+			p.Resolution = false
+		case name == StepProbes:
+			p.Probes = false
+		case slices.Contains(requiredHandlers, name):
+			return Pipeline{}, fmt.Errorf("%w: handler %q cannot be disabled", config.ErrInvalidDNSPipeline, name)
+		default:
+			if _, ok := r[name]; !ok {
+				return Pipeline{}, fmt.Errorf("%w: unknown handler %q", config.ErrInvalidDNSPipeline, name)
+			}
+		}
+	}
+	for _, name := range names {
+		if !slices.Contains(cfg.Disabled, name) {
+			p.Handlers = append(p.Handlers, name)
+		}
+	}
+	for _, name := range requiredHandlers {
+		if !slices.Contains(p.Handlers, name) {
+			return Pipeline{}, fmt.Errorf("%w: required handler %q is missing", config.ErrInvalidDNSPipeline, name)
+		}
+	}
+	if err := r.checkOrder(p.Handlers); err != nil {
+		return Pipeline{}, err
+	}
+	return p, nil
+}
+
+// checkOrder rejects a handler reading a key that handlers of the pipeline
+// write, but none before it.
+func (r Registry) checkOrder(names []string) error {
+	keys := make([][2][]string, len(names))
+	for i, name := range names {
+		if a, ok := r[name](Deps{}).(reconciler.DataAccessor); ok {
+			keys[i][0], keys[i][1] = a.DataKeys()
+		}
+	}
+	writtenBefore := func(key string, end int) bool {
+		for j := range end {
+			if slices.Contains(keys[j][1], key) {
+				return true
+			}
+		}
+		return false
+	}
+	for i, name := range names {
+		for _, key := range keys[i][0] {
+			for j := i + 1; j < len(names); j++ {
+				if slices.Contains(keys[j][1], key) && !writtenBefore(key, i) {
+					return fmt.Errorf("%w: handler %q reads %s before handler %q writes it",
+						config.ErrInvalidDNSPipeline, name, key, names[j])
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Build returns the handlers of p built from deps.
+func (r Registry) Build(p Pipeline, deps Deps) []Handler {
+	handlers := make([]Handler, 0, len(p.Handlers))
+	for _, name := range p.Handlers {
+		handlers = append(handlers, r[name](deps))
+	}
+	return handlers
+}
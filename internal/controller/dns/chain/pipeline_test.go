@@ -0,0 +1,86 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golgoth31/sreportal/internal/config"
+	dnschain "github.com/golgoth31/sreportal/internal/controller/dns/chain"
+)
+
+func TestResolvePipeline_Default(t *testing.T) {
+	p, err := dnschain.DefaultRegistry().ResolvePipeline(config.DNSPipelineConfig{})
+
+	require.NoError(t, err)
+	assert.Equal(t, dnschain.DefaultPipeline, p.Handlers)
+	assert.True(t, p.Resolution)
+	assert.True(t, p.Probes)
+}
+
+func TestResolvePipeline_Disabled(t *testing.T) {
+	p, err := dnschain.DefaultRegistry().ResolvePipeline(config.DNSPipelineConfig{
+		Disabled: []string{dnschain.HandlerExternalNameServices, dnschain.HandlerDemoSource, dnschain.StepResolution},
+	})
+
+	require.NoError(t, err)
+	assert.NotContains(t, p.Handlers, dnschain.HandlerExternalNameServices)
+	assert.NotContains(t, p.Handlers, dnschain.HandlerDemoSource)
+	assert.Len(t, p.Handlers, len(dnschain.DefaultPipeline)-2)
+	assert.False(t, p.Resolution)
+	assert.True(t, p.Probes)
+}
+
+func TestResolvePipeline_Rejected(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.DNSPipelineConfig
+	}{
+		{"unknown handler", config.DNSPipelineConfig{Handlers: []string{"lookupSources", "resolveLive"}}},
+		{"unknown disabled", config.DNSPipelineConfig{Disabled: []string{"certificates"}}},
+		{"required disabled", config.DNSPipelineConfig{Disabled: []string{dnschain.HandlerIntraDNSDedup}}},
+		{"required missing", config.DNSPipelineConfig{Handlers: []string{dnschain.HandlerLookupSources, dnschain.HandlerIntraDNSDedup}}},
+		{"input produced later", config.DNSPipelineConfig{Handlers: []string{
+			dnschain.HandlerLookupSources, dnschain.HandlerIntraDNSDedup,
+			dnschain.HandlerGarbageCollect, dnschain.HandlerUpsertDNSRecords,
+		}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := dnschain.DefaultRegistry().ResolvePipeline(tt.cfg)
+			require.ErrorIs(t, err, config.ErrInvalidDNSPipeline)
+		})
+	}
+}
+
+func TestRegistryBuild_SharesUpsertHandler(t *testing.T) {
+	upsert := &dnschain.UpsertDNSRecordsHandler{}
+	p, err := dnschain.DefaultRegistry().ResolvePipeline(config.DNSPipelineConfig{
+		Handlers: []string{dnschain.HandlerLookupSources, dnschain.HandlerIntraDNSDedup, dnschain.HandlerUpsertDNSRecords},
+	})
+	require.NoError(t, err)
+
+	handlers := dnschain.DefaultRegistry().Build(p, dnschain.Deps{Upsert: upsert})
+
+	require.Len(t, handlers, 3)
+	assert.IsType(t, &dnschain.LookupSourcesHandler{}, handlers[0])
+	assert.IsType(t, &dnschain.IntraDNSDedupHandler{}, handlers[1])
+	assert.Same(t, upsert, handlers[2])
+}
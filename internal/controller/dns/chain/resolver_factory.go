@@ -0,0 +1,148 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/golgoth31/sreportal/internal/config"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+// LookupResolver is a domain Resolver that also looks up TXT records, as
+// needed by the external-dns import.
+type LookupResolver interface {
+	domaindns.Resolver
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// ResolverFactory builds a LookupResolver from its configuration.
+type ResolverFactory func(cfg config.ResolverConfig) (LookupResolver, error)
+
+var (
+	resolverFactoriesMu sync.RWMutex
+	resolverFactories   = map[string]ResolverFactory{
+		config.ResolverTypeSystem: func(config.ResolverConfig) (LookupResolver, error) {
+			return NewNetResolver(), nil
+		},
+		config.ResolverTypeDNS: func(cfg config.ResolverConfig) (LookupResolver, error) {
+			addrs := cfg.ServerAddrs()
+			if len(addrs) == 0 {
+				return nil, errors.New("dns resolver requires at least one server")
+			}
+			resolvers := make([]LookupResolver, 0, len(addrs))
+			for _, addr := range addrs {
+				resolvers = append(resolvers, NewNetResolverFor(addr))
+			}
+			if len(resolvers) == 1 {
+				return resolvers[0], nil
+			}
+			return FallbackResolver(resolvers), nil
+		},
+		config.ResolverTypeDoH: func(cfg config.ResolverConfig) (LookupResolver, error) {
+			if cfg.URL == "" {
+				return nil, errors.New("doh resolver requires a url")
+			}
+			return NewDoHResolver(cfg.URL, cfg.Timeout.Duration()), nil
+		},
+	}
+)
+
+// RegisterResolver makes a resolver type selectable through
+// dnsResolution.resolver.type. It panics when the type is already registered.
+func RegisterResolver(resolverType string, factory ResolverFactory) {
+	resolverFactoriesMu.Lock()
+	defer resolverFactoriesMu.Unlock()
+	if _, ok := resolverFactories[resolverType]; ok {
+		panic("duplicate resolver registered for type " + resolverType)
+	}
+	resolverFactories[resolverType] = factory
+}
+
+// NewResolver builds the resolver selected by cfg. An empty type selects the
+// system resolver.
+func NewResolver(cfg config.ResolverConfig) (LookupResolver, error) {
+	resolverType := cfg.Type
+	if resolverType == "" {
+		resolverType = config.ResolverTypeSystem
+	}
+	resolverFactoriesMu.RLock()
+	factory, ok := resolverFactories[resolverType]
+	resolverFactoriesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown resolver type %q", resolverType)
+	}
+	return factory(cfg)
+}
+
+// FallbackResolver queries its resolvers in order and returns the first
+// answer. The next resolver is only tried when a lookup fails for another
+// reason than the name not existing, i.e. the server did not answer.
+type FallbackResolver []LookupResolver
+
+// Compile-time checks that FallbackResolver implements LookupResolver and
+// domaindns.TTLResolver.
+var (
+	_ LookupResolver        = FallbackResolver(nil)
+	_ domaindns.TTLResolver = FallbackResolver(nil)
+)
+
+// LookupHost implements domaindns.Resolver.
+func (f FallbackResolver) LookupHost(ctx context.Context, fqdn string) ([]string, error) {
+	return fallback(f, func(r LookupResolver) ([]string, error) { return r.LookupHost(ctx, fqdn) })
+}
+
+// LookupCNAME implements domaindns.Resolver.
+func (f FallbackResolver) LookupCNAME(ctx context.Context, fqdn string) (string, error) {
+	return fallback(f, func(r LookupResolver) (string, error) { return r.LookupCNAME(ctx, fqdn) })
+}
+
+// LookupTXT implements LookupResolver.
+func (f FallbackResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	return fallback(f, func(r LookupResolver) ([]string, error) { return r.LookupTXT(ctx, name) })
+}
+
+// LookupTTL implements domaindns.TTLResolver. Resolvers that cannot report
+// TTLs are skipped like unanswering ones.
+func (f FallbackResolver) LookupTTL(ctx context.Context, fqdn, recordType string) (uint32, error) {
+	return fallback(f, func(r LookupResolver) (uint32, error) {
+		tr, ok := r.(domaindns.TTLResolver)
+		if !ok {
+			return 0, domaindns.ErrTTLUnavailable
+		}
+		return tr.LookupTTL(ctx, fqdn, recordType)
+	})
+}
+
+func fallback[T any](resolvers []LookupResolver, lookup func(LookupResolver) (T, error)) (T, error) {
+	var (
+		res T
+		err error
+	)
+	for _, r := range resolvers {
+		res, err = lookup(r)
+		var dnsErr *net.DNSError
+		if err == nil || (errors.As(err, &dnsErr) && dnsErr.IsNotFound) {
+			return res, err
+		}
+	}
+	return res, err
+}
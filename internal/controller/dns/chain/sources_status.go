@@ -24,6 +24,7 @@ import (
 
 	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
 	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	"github.com/golgoth31/sreportal/internal/metrics"
 	"github.com/golgoth31/sreportal/internal/reconciler"
 )
 
@@ -33,6 +34,11 @@ type SourcesStatusHandler struct {
 	Conflicts domaindns.FQDNConflictReader
 }
 
+// DataKeys implements reconciler.DataAccessor.
+func (*SourcesStatusHandler) DataKeys() (reads, writes []string) {
+	return []string{"PriorityOrder", "SkippedEntries", "PriorityConflicts"}, nil
+}
+
 // Handle implements reconciler.Handler.
 func (h *SourcesStatusHandler) Handle(_ context.Context, rc *reconciler.ReconcileContext[*sreportalv1alpha2.DNS, ChainData]) error {
 	dns := rc.Resource
@@ -77,7 +83,13 @@ func (h *SourcesStatusHandler) Handle(_ context.Context, rc *reconciler.Reconcil
 		})
 	}
 
+	dns.Status.ActiveSources = nil
+	for _, kind := range rc.Data.PriorityOrder {
+		dns.Status.ActiveSources = append(dns.Status.ActiveSources, string(kind))
+	}
+
 	projectSkippedEntries(dns, rc.Data.SkippedEntries)
+	projectPriorityConflicts(dns, rc.Data.PriorityConflicts)
 	return nil
 }
 
@@ -97,6 +109,14 @@ const (
 	// +kubebuilder:validation:MaxLength marker on SkippedFQDNStatus.RecordType.
 	// A dropped entry's record type is source-controlled and unbounded.
 	maxSkippedRecordTypeLen = 16
+	// maxPriorityConflictsStatus bounds how many priority conflicts are
+	// mirrored onto the DNS status, matching the +kubebuilder:validation:MaxItems
+	// marker on DNSStatus.PriorityConflicts.
+	maxPriorityConflictsStatus = 100
+	// maxPriorityConflictItems bounds the losing kinds and dropped targets of
+	// each mirrored conflict, matching the MaxItems markers on
+	// SourcePriorityConflictStatus.
+	maxPriorityConflictItems = 16
 )
 
 // truncateRunes returns s limited to max Unicode code points, on a rune
@@ -151,6 +171,45 @@ func projectSkippedEntries(dns *sreportalv1alpha2.DNS, skipped []SkippedEntry) {
 	})
 }
 
+// projectPriorityConflicts mirrors a bounded sample of the source priority
+// conflicts onto the DNS status and sets the source_priority_conflicts gauge
+// from the full list. conflicts is already sorted by IntraDNSDedupHandler.
+func projectPriorityConflicts(dns *sreportalv1alpha2.DNS, conflicts []PriorityConflict) {
+	metrics.DeleteDNSSourcePriorityConflictSeries(dns.Namespace, dns.Name)
+	for _, c := range conflicts {
+		for _, loser := range c.Losers {
+			metrics.DNSSourcePriorityConflicts.WithLabelValues(dns.Namespace, dns.Name, string(c.Winner), string(loser)).Inc()
+		}
+	}
+
+	if len(conflicts) == 0 {
+		dns.Status.PriorityConflicts = nil
+		return
+	}
+	sample := conflicts
+	if len(sample) > maxPriorityConflictsStatus {
+		sample = sample[:maxPriorityConflictsStatus]
+	}
+	out := make([]sreportalv1alpha2.SourcePriorityConflictStatus, 0, len(sample))
+	for _, c := range sample {
+		losers := make([]string, 0, min(len(c.Losers), maxPriorityConflictItems))
+		for _, l := range c.Losers[:min(len(c.Losers), maxPriorityConflictItems)] {
+			losers = append(losers, string(l))
+		}
+		var targets []string
+		for _, t := range c.DroppedTargets[:min(len(c.DroppedTargets), maxPriorityConflictItems)] {
+			targets = append(targets, truncateRunes(t, maxSkippedFQDNLen))
+		}
+		out = append(out, sreportalv1alpha2.SourcePriorityConflictStatus{
+			FQDN:           truncateRunes(c.FQDN, maxSkippedFQDNLen),
+			WinningSource:  string(c.Winner),
+			LosingSources:  losers,
+			DroppedTargets: targets,
+		})
+	}
+	dns.Status.PriorityConflicts = out
+}
+
 func plural(n int) string {
 	if n == 1 {
 		return "y was"
@@ -18,16 +18,19 @@ package dns_test
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"testing"
 	"unicode/utf8"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
 	dnschain "github.com/golgoth31/sreportal/internal/controller/dns/chain"
 	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	"github.com/golgoth31/sreportal/internal/metrics"
 	"github.com/golgoth31/sreportal/internal/reconciler"
 	"github.com/golgoth31/sreportal/internal/source/externaldns"
 	"github.com/golgoth31/sreportal/internal/source/registry"
@@ -52,6 +55,7 @@ func TestSourcesStatus_NoConflicts(t *testing.T) {
 	require.NoError(t, h.Handle(context.Background(), rc))
 	require.Equal(t, metav1.ConditionTrue, conditionStatus(dns, "SourcesReady"))
 	require.Equal(t, metav1.ConditionFalse, conditionStatus(dns, "TargetsConflict"))
+	require.Equal(t, []string{"service"}, dns.Status.ActiveSources)
 }
 
 func TestSourcesStatus_WithConflicts(t *testing.T) {
@@ -244,3 +248,52 @@ func TestSourcesStatus_SkippedEntriesTruncated(t *testing.T) {
 	require.True(t, utf8.ValidString(got.FQDN))
 	require.Equal(t, 16, len(got.RecordType))
 }
+
+func TestSourcesStatus_PriorityConflictsProjected(t *testing.T) {
+	dns := &sreportalv1alpha2.DNS{ObjectMeta: metav1.ObjectMeta{Name: "priority-conflicts", Namespace: "n"}}
+	h := &dnschain.SourcesStatusHandler{Conflicts: fakeConflicts{}}
+	data := chainDataWithEnabledKind()
+	data.PriorityConflicts = []dnschain.PriorityConflict{
+		{FQDN: "a.example.com", Winner: externaldns.KindIngress,
+			Losers: []registry.SourceType{externaldns.KindService}, DroppedTargets: []string{"9.9.9.9"}},
+		{FQDN: "b.example.com", Winner: externaldns.KindIngress,
+			Losers: []registry.SourceType{externaldns.KindService}},
+	}
+	rc := &reconciler.ReconcileContext[*sreportalv1alpha2.DNS, dnschain.ChainData]{Resource: dns, Data: data}
+	require.NoError(t, h.Handle(context.Background(), rc))
+
+	require.Equal(t, []sreportalv1alpha2.SourcePriorityConflictStatus{
+		{FQDN: "a.example.com", WinningSource: "ingress", LosingSources: []string{"service"}, DroppedTargets: []string{"9.9.9.9"}},
+		{FQDN: "b.example.com", WinningSource: "ingress", LosingSources: []string{"service"}},
+	}, dns.Status.PriorityConflicts)
+	require.Equal(t, float64(2), testutil.ToFloat64(metrics.DNSSourcePriorityConflicts.WithLabelValues("n", "priority-conflicts", "ingress", "service")))
+
+	// A resolved conflict clears the status and the gauge.
+	rc.Data.PriorityConflicts = nil
+	require.NoError(t, h.Handle(context.Background(), rc))
+	require.Empty(t, dns.Status.PriorityConflicts)
+	require.Equal(t, float64(0), testutil.ToFloat64(metrics.DNSSourcePriorityConflicts.WithLabelValues("n", "priority-conflicts", "ingress", "service")))
+}
+
+func TestSourcesStatus_PriorityConflictsBounded(t *testing.T) {
+	dns := &sreportalv1alpha2.DNS{ObjectMeta: metav1.ObjectMeta{Name: "priority-bounded", Namespace: "n"}}
+	data := chainDataWithEnabledKind()
+	targets := make([]string, 0, 40)
+	for i := range 40 {
+		targets = append(targets, fmt.Sprintf("10.0.0.%d", i))
+	}
+	for i := range 150 {
+		data.PriorityConflicts = append(data.PriorityConflicts, dnschain.PriorityConflict{
+			FQDN: fmt.Sprintf("h%03d.example.com", i), Winner: externaldns.KindIngress,
+			Losers: []registry.SourceType{externaldns.KindService}, DroppedTargets: targets,
+		})
+	}
+	h := &dnschain.SourcesStatusHandler{Conflicts: fakeConflicts{}}
+	rc := &reconciler.ReconcileContext[*sreportalv1alpha2.DNS, dnschain.ChainData]{Resource: dns, Data: data}
+	require.NoError(t, h.Handle(context.Background(), rc))
+
+	// Status list and targets are capped, but the gauge counts every conflict.
+	require.Len(t, dns.Status.PriorityConflicts, 100)
+	require.Len(t, dns.Status.PriorityConflicts[0].DroppedTargets, 16)
+	require.Equal(t, float64(150), testutil.ToFloat64(metrics.DNSSourcePriorityConflicts.WithLabelValues("n", "priority-bounded", "ingress", "service")))
+}
@@ -21,84 +21,92 @@ import (
 	"fmt"
 	"sort"
 
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/external-dns/endpoint"
 
 	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
 	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
 	"github.com/golgoth31/sreportal/internal/reconciler"
 	"github.com/golgoth31/sreportal/internal/source/registry"
 )
 
 // UpsertDNSRecordsHandler creates or updates one auto-origin DNSRecord per kind
-// that produced at least one endpoint, owned by the DNS CR. It also deletes any
-// previously-created auto DNSRecord whose kind is no longer producing.
+// that produced at least one endpoint, owned by the DNS CR. Records it no
+// longer writes are left to GarbageCollectDNSRecordsHandler.
 type UpsertDNSRecordsHandler struct {
 	Client client.Client
+	// Namer renders DNSRecord names. Nil keeps the default "<dns>-<kind>".
+	Namer *domaindns.RecordNamer
+}
+
+// DataKeys implements reconciler.DataAccessor.
+func (*UpsertDNSRecordsHandler) DataKeys() (reads, writes []string) {
+	return []string{"KeptEndpointsByKind"}, []string{"UpsertedRecords"}
 }
 
 // Handle implements reconciler.Handler.
 func (h *UpsertDNSRecordsHandler) Handle(ctx context.Context, rc *reconciler.ReconcileContext[*sreportalv1alpha2.DNS, ChainData]) error {
 	dns := rc.Resource
 	desiredKinds := map[registry.SourceType]bool{}
+	desiredNames := map[string]bool{}
 
 	for kind, eps := range rc.Data.KeptEndpointsByKind {
 		if len(eps) == 0 {
 			continue
 		}
 		desiredKinds[kind] = true
-		if err := h.upsertOne(ctx, dns, kind, eps); err != nil {
+		name, op, err := h.upsertOne(ctx, dns, kind, eps)
+		if err != nil {
 			return err
 		}
-	}
-
-	var existing sreportalv1alpha2.DNSRecordList
-	if err := h.Client.List(ctx, &existing, client.InNamespace(dns.Namespace)); err != nil {
-		return err
-	}
-	for i := range existing.Items {
-		dr := &existing.Items[i]
-		if !ownedBy(dr, dns) || dr.Spec.Origin != sreportalv1alpha2.DNSRecordOriginAuto {
-			continue
-		}
-		kind := registry.SourceType(dr.Spec.SourceType)
-		if desiredKinds[kind] {
-			continue
-		}
-		// Don't purge a kind whose source hasn't synced yet: its absence from
-		// desiredKinds is "not ready", not "authoritatively empty". Once the
-		// source produces a collection the kind leaves PreserveKinds and a
-		// genuinely-empty kind's stale record is reclaimed on a later cycle.
-		if rc.Data.PreserveKinds[kind] {
-			continue
-		}
-		if err := h.Client.Delete(ctx, dr); err != nil && !apierrors.IsNotFound(err) {
-			return err
+		desiredNames[name] = true
+		if op == controllerutil.OperationResultCreated || op == controllerutil.OperationResultUpdated {
+			rc.Data.Event(dns, corev1.EventTypeNormal, "Aggregated", "UpsertDNSRecord",
+				"%s DNSRecord %s with %d endpoints from %s", op, name, len(eps), kind)
 		}
 	}
+
+	rc.Data.UpsertedRecords = desiredNames
 	return nil
 }
 
-func (h *UpsertDNSRecordsHandler) upsertOne(ctx context.Context, dns *sreportalv1alpha2.DNS, kind registry.SourceType, eps []*endpoint.Endpoint) error {
-	name := fmt.Sprintf("%s-%s", dns.Name, string(kind))
+// upsertOne creates or updates the kind's DNSRecord and returns its name and
+// the write performed.
+func (h *UpsertDNSRecordsHandler) upsertOne(ctx context.Context, dns *sreportalv1alpha2.DNS, kind registry.SourceType, eps []*endpoint.Endpoint) (string, controllerutil.OperationResult, error) {
+	name, err := h.Namer.Name(domaindns.RecordNameData{
+		DNS:        dns.Name,
+		Portal:     dns.Spec.PortalRef,
+		Namespace:  dns.Namespace,
+		SourceType: string(kind),
+	})
+	if err != nil {
+		return "", controllerutil.OperationResultNone, fmt.Errorf("name DNSRecord for %s: %w", kind, err)
+	}
 	dr := &sreportalv1alpha2.DNSRecord{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: dns.Namespace}}
 	desiredEntries := endpointsToEntries(eps)
 
-	if _, err := controllerutil.CreateOrUpdate(ctx, h.Client, dr, func() error {
+	op, err := controllerutil.CreateOrUpdate(ctx, h.Client, dr, func() error {
 		if dr.Spec.Origin == "" {
 			dr.Spec.Origin = sreportalv1alpha2.DNSRecordOriginAuto
 		}
+		adapter.SetStandardLabels(dr, dns.Spec.PortalRef)
+		dr.Labels[adapter.SourceTypeLabelKey] = string(kind)
 		dr.Spec.PortalRef = dns.Spec.PortalRef
 		dr.Spec.SourceType = sreportalv1alpha2.SourceType(kind)
 		dr.Spec.Entries = desiredEntries
+		// The kind produces again: restart its garbage-collection grace period.
+		delete(dr.Annotations, adapter.EmptyRunsAnnotationKey)
+		delete(dr.Annotations, adapter.OrphanedAnnotationKey)
 		return controllerutil.SetControllerReference(dns, dr, h.Client.Scheme())
-	}); err != nil {
-		return err
+	})
+	if err != nil {
+		return "", op, err
 	}
-	return nil
+	return name, op, nil
 }
 
 // endpointsToEntries converts external-dns endpoints into the manifest-shape
@@ -142,6 +150,21 @@ func endpointsToEntries(eps []*endpoint.Endpoint) []sreportalv1alpha2.DNSRecordE
 			if r, rok := e.Labels[endpoint.ResourceLabelKey]; rok {
 				entry.OriginRef = r
 			}
+			// Carry the source Service ports, route paths and exposed
+			// annotations (folded onto the endpoint labels by the source cycle)
+			// so the FQDN card and the API can return them.
+			entry.Paths = domaindns.ParsePaths(e.Labels[domaindns.PathsLabelKey])
+			entry.Annotations = domaindns.ParseAnnotations(e.Labels[domaindns.AnnotationsLabelKey])
+			// Carry the sreportal.io/probe annotation; a malformed value is
+			// dropped rather than failing admission of the whole DNSRecord.
+			if p, err := domaindns.ParseProbe(e.Labels[domaindns.ProbeAnnotationKey]); err == nil {
+				entry.Probe = p.String()
+			}
+			for _, p := range domaindns.ParsePorts(e.Labels[domaindns.PortsLabelKey]) {
+				entry.Ports = append(entry.Ports, sreportalv1alpha2.ServicePort{
+					Name: p.Name, Port: p.Port, Protocol: p.Protocol,
+				})
+			}
 			byKey[k] = entry
 		}
 		entry.Targets = append(entry.Targets, e.Targets...)
@@ -26,12 +26,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/external-dns/endpoint"
 
 	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
 	dnschain "github.com/golgoth31/sreportal/internal/controller/dns/chain"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
 	"github.com/golgoth31/sreportal/internal/reconciler"
 	"github.com/golgoth31/sreportal/internal/source/externaldns"
 	"github.com/golgoth31/sreportal/internal/source/registry"
@@ -44,13 +46,19 @@ const (
 	upsertTestTargetA   = "1.1.1.1"
 )
 
+// TestUpsertDNSRecords_CreatesAndDeletes verifies the upsert creates the
+// producing kind's DNSRecord and, with a one-run grace period, the garbage
+// collector deletes the record of the kind that stopped producing.
 func TestUpsertDNSRecords_CreatesAndDeletes(t *testing.T) {
 	scheme := runtime.NewScheme()
 	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
 
 	dns := &sreportalv1alpha2.DNS{
 		ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: upsertTestNS1, UID: "u1"},
-		Spec:       sreportalv1alpha2.DNSSpec{PortalRef: "p"},
+		Spec: sreportalv1alpha2.DNSSpec{
+			PortalRef:      "p",
+			Reconciliation: sreportalv1alpha2.ReconciliationSpec{RecordGC: sreportalv1alpha2.RecordGCSpec{EmptyRuns: 1}},
+		},
 	}
 	existing := &sreportalv1alpha2.DNSRecord{
 		ObjectMeta: metav1.ObjectMeta{
@@ -85,12 +93,18 @@ func TestUpsertDNSRecords_CreatesAndDeletes(t *testing.T) {
 		},
 	}
 	require.NoError(t, h.Handle(context.Background(), rc))
+	require.NoError(t, (&dnschain.GarbageCollectDNSRecordsHandler{Client: c}).Handle(context.Background(), rc))
 
 	var created sreportalv1alpha2.DNSRecord
 	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: upsertTestNS1, Name: upsertTestRecord}, &created))
 	require.Equal(t, sreportalv1alpha2.DNSRecordOriginAuto, created.Spec.Origin)
 	require.Equal(t, string(externaldns.KindService), string(created.Spec.SourceType))
 	require.Equal(t, "p", created.Spec.PortalRef)
+	require.Equal(t, map[string]string{
+		"app.kubernetes.io/managed-by": "sreportal",
+		"sreportal.io/portal":          "p",
+		"sreportal.io/source-type":     string(externaldns.KindService),
+	}, created.Labels)
 	require.Len(t, created.Spec.Entries, 1)
 	require.Equal(t, "a.example.com", created.Spec.Entries[0].FQDN)
 	require.Equal(t, "A", created.Spec.Entries[0].RecordType)
@@ -147,6 +161,7 @@ func TestUpsertDNSRecords_PreservesNotReadyKind(t *testing.T) {
 		},
 	}
 	require.NoError(t, h.Handle(context.Background(), rc))
+	require.NoError(t, (&dnschain.GarbageCollectDNSRecordsHandler{Client: c}).Handle(context.Background(), rc))
 
 	var still sreportalv1alpha2.DNSRecord
 	require.NoError(t, c.Get(context.Background(),
@@ -470,6 +485,92 @@ func TestUpsertDNSRecordsHandler_PropagatesOriginRef(t *testing.T) {
 	require.Equal(t, "service/ns1/budget-controls", created.Spec.Entries[0].OriginRef)
 }
 
+// TestUpsertDNSRecordsHandler_PropagatesPortsAndPaths verifies that the
+// Service ports and route paths folded onto the endpoint labels by the source
+// cycle are carried into the projected DNSRecordEntry.
+func TestUpsertDNSRecordsHandler_PropagatesPortsAndPaths(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
+
+	dns := &sreportalv1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: upsertTestNS1, UID: "u1"},
+		Spec:       sreportalv1alpha2.DNSSpec{PortalRef: "p"},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&sreportalv1alpha2.DNSRecord{}).
+		WithObjects(dns).
+		Build()
+
+	ep := endpoint.NewEndpoint("a.example.com", "A", upsertTestTargetA).
+		WithLabel(domaindns.PortsLabelKey, "https:443/TCP,grpc:8443/TCP").
+		WithLabel(domaindns.PathsLabelKey, "/,/api").
+		WithLabel(domaindns.AnnotationsLabelKey, `{"example.com/owner":"team-a"}`)
+
+	h := &dnschain.UpsertDNSRecordsHandler{Client: c}
+	rc := &reconciler.ReconcileContext[*sreportalv1alpha2.DNS, dnschain.ChainData]{
+		Resource: dns,
+		Data: dnschain.ChainData{
+			KeptEndpointsByKind: map[registry.SourceType][]*endpoint.Endpoint{
+				externaldns.KindService: {ep},
+			},
+		},
+	}
+	require.NoError(t, h.Handle(context.Background(), rc))
+
+	var created sreportalv1alpha2.DNSRecord
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: upsertTestNS1, Name: upsertTestRecord}, &created))
+	require.Len(t, created.Spec.Entries, 1)
+	require.Equal(t, []sreportalv1alpha2.ServicePort{
+		{Name: "https", Port: 443, Protocol: "TCP"},
+		{Name: "grpc", Port: 8443, Protocol: "TCP"},
+	}, created.Spec.Entries[0].Ports)
+	require.Equal(t, []string{"/", "/api"}, created.Spec.Entries[0].Paths)
+	require.Equal(t, map[string]string{"example.com/owner": "team-a"}, created.Spec.Entries[0].Annotations)
+}
+
+// TestUpsertDNSRecordsHandler_PropagatesProbe verifies the sreportal.io/probe
+// annotation is carried into the projected DNSRecordEntry, and a malformed
+// value is dropped instead of failing the whole DNSRecord.
+func TestUpsertDNSRecordsHandler_PropagatesProbe(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
+
+	dns := &sreportalv1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: upsertTestNS1, UID: "u1"},
+		Spec:       sreportalv1alpha2.DNSSpec{PortalRef: "p"},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&sreportalv1alpha2.DNSRecord{}).
+		WithObjects(dns).
+		Build()
+
+	h := &dnschain.UpsertDNSRecordsHandler{Client: c}
+	rc := &reconciler.ReconcileContext[*sreportalv1alpha2.DNS, dnschain.ChainData]{
+		Resource: dns,
+		Data: dnschain.ChainData{
+			KeptEndpointsByKind: map[registry.SourceType][]*endpoint.Endpoint{
+				externaldns.KindService: {
+					endpoint.NewEndpoint("db.example.com", "A", upsertTestTargetA).
+						WithLabel(domaindns.ProbeAnnotationKey, "tcp:5432"),
+					endpoint.NewEndpoint("bad.example.com", "A", upsertTestTargetA).
+						WithLabel(domaindns.ProbeAnnotationKey, "postgres"),
+				},
+			},
+		},
+	}
+	require.NoError(t, h.Handle(context.Background(), rc))
+
+	var created sreportalv1alpha2.DNSRecord
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: upsertTestNS1, Name: upsertTestRecord}, &created))
+	probes := map[string]string{}
+	for _, e := range created.Spec.Entries {
+		probes[e.FQDN] = e.Probe
+	}
+	require.Equal(t, map[string]string{"db.example.com": "tcp:5432", "bad.example.com": ""}, probes)
+}
+
 // TestUpsertDNSRecordsHandler_OriginRefFollowsPriority verifies the OriginRef
 // carried into spec.entries is the one of the source that wins source priority:
 // IntraDNSDedup keeps the higher-priority kind's endpoint (with its resource
@@ -528,3 +629,95 @@ func TestUpsertDNSRecordsHandler_OriginRefFollowsPriority(t *testing.T) {
 		require.True(t, apierrors.IsNotFound(err))
 	}
 }
+
+// TestUpsertDNSRecords_NameTemplate verifies records are named from the
+// configured template and that a record left by the previous naming is
+// replaced rather than kept alongside the renamed one.
+func TestUpsertDNSRecords_NameTemplate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
+
+	dns := &sreportalv1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: upsertTestNS1, UID: "u1"},
+		Spec:       sreportalv1alpha2.DNSSpec{PortalRef: "p"},
+	}
+	previous := &sreportalv1alpha2.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: upsertTestRecord, Namespace: upsertTestNS1,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: sreportalv1alpha2.GroupVersion.String(),
+				Kind:       "DNS",
+				Name:       dns.Name,
+				UID:        dns.UID,
+				Controller: ptr.To(true), //nolint:modernize // new(bool) yields false, not true
+			}},
+		},
+		Spec: sreportalv1alpha2.DNSRecordSpec{
+			Origin:     sreportalv1alpha2.DNSRecordOriginAuto,
+			SourceType: sreportalv1alpha2.SourceType(externaldns.KindService),
+			PortalRef:  "p",
+		},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&sreportalv1alpha2.DNSRecord{}).
+		WithObjects(dns, previous).
+		Build()
+
+	namer, err := domaindns.NewRecordNamer("{{ .Portal }}-{{ .SourceType }}-records")
+	require.NoError(t, err)
+	h := &dnschain.UpsertDNSRecordsHandler{Client: c, Namer: namer}
+	rc := &reconciler.ReconcileContext[*sreportalv1alpha2.DNS, dnschain.ChainData]{
+		Resource: dns,
+		Data: dnschain.ChainData{
+			KeptEndpointsByKind: map[registry.SourceType][]*endpoint.Endpoint{
+				externaldns.KindService: {endpoint.NewEndpoint("a.example.com", "A", upsertTestTargetA)},
+			},
+		},
+	}
+	require.NoError(t, h.Handle(context.Background(), rc))
+	require.NoError(t, (&dnschain.GarbageCollectDNSRecordsHandler{Client: c}).Handle(context.Background(), rc))
+
+	var list sreportalv1alpha2.DNSRecordList
+	require.NoError(t, c.List(context.Background(), &list))
+	require.Len(t, list.Items, 1)
+	require.Equal(t, "p-service-records", list.Items[0].Name)
+}
+
+// TestUpsertDNSRecords_EmitsEvents verifies a Normal event is recorded when a
+// DNSRecord is written and none when it is already up to date.
+func TestUpsertDNSRecords_EmitsEvents(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
+
+	dns := &sreportalv1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: upsertTestNS1, UID: "u1"},
+		Spec:       sreportalv1alpha2.DNSSpec{PortalRef: "p"},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&sreportalv1alpha2.DNSRecord{}).
+		WithObjects(dns).
+		Build()
+
+	recorder := events.NewFakeRecorder(4)
+	h := &dnschain.UpsertDNSRecordsHandler{Client: c}
+	newRC := func() *reconciler.ReconcileContext[*sreportalv1alpha2.DNS, dnschain.ChainData] {
+		return &reconciler.ReconcileContext[*sreportalv1alpha2.DNS, dnschain.ChainData]{
+			Resource: dns,
+			Data: dnschain.ChainData{
+				Recorder: recorder,
+				KeptEndpointsByKind: map[registry.SourceType][]*endpoint.Endpoint{
+					externaldns.KindService: {endpoint.NewEndpoint("a.example.com", "A", upsertTestTargetA)},
+				},
+			},
+		}
+	}
+
+	require.NoError(t, h.Handle(context.Background(), newRC()))
+	require.Len(t, recorder.Events, 1)
+	require.Contains(t, <-recorder.Events, "Normal Aggregated created DNSRecord d-service with 1 endpoints from service")
+
+	require.NoError(t, h.Handle(context.Background(), newRC()))
+	require.Empty(t, recorder.Events, "an unchanged DNSRecord must not emit an event")
+}
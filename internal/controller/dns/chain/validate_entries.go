@@ -60,6 +60,11 @@ const (
 // condition surfaces the problem).
 type ValidateEntriesHandler struct{}
 
+// DataKeys implements reconciler.DataAccessor.
+func (*ValidateEntriesHandler) DataKeys() (reads, writes []string) {
+	return []string{"KeptEndpointsByKind"}, []string{"KeptEndpointsByKind", "SkippedEntries", "PreserveKinds"}
+}
+
 // Handle implements reconciler.Handler.
 func (*ValidateEntriesHandler) Handle(ctx context.Context, rc *reconciler.ReconcileContext[*sreportalv1alpha2.DNS, ChainData]) error {
 	ns, name := rc.Resource.Namespace, rc.Resource.Name
@@ -21,10 +21,12 @@ import (
 	"errors"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,6 +35,7 @@ import (
 
 	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
 	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
 	dnschain "github.com/golgoth31/sreportal/internal/controller/dns/chain"
 	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
 	domainsource "github.com/golgoth31/sreportal/internal/domain/source"
@@ -56,6 +59,8 @@ type DNSReconciler struct {
 	Scheme       *runtime.Scheme
 	SourceReader domainsource.SourceEndpointReader
 	Conflicts    domaindns.FQDNConflictReader
+	upsert       *dnschain.UpsertDNSRecordsHandler
+	recorder     events.EventRecorder
 	chain        *reconciler.Chain[*v1alpha2.DNS, dnschain.ChainData]
 }
 
@@ -71,18 +76,38 @@ func NewDNSReconciler(
 		Scheme:       scheme,
 		SourceReader: sourceReader,
 		Conflicts:    conflicts,
+		upsert:       &dnschain.UpsertDNSRecordsHandler{Client: c},
 	}
-	r.chain = reconciler.NewChain[*v1alpha2.DNS, dnschain.ChainData](
-		"dns",
-		&dnschain.LookupSourcesHandler{Source: sourceReader},
-		&dnschain.IntraDNSDedupHandler{},
-		&dnschain.ValidateEntriesHandler{},
-		&dnschain.UpsertDNSRecordsHandler{Client: c},
-		&dnschain.SourcesStatusHandler{Conflicts: conflicts},
-	)
+	r.SetPipeline(dnschain.Pipeline{Handlers: dnschain.DefaultPipeline})
 	return r
 }
 
+// SetPipeline rebuilds the handler chain from the handlers of p, resolved
+// from the dnsPipeline configuration. Must be called before the manager
+// starts.
+func (r *DNSReconciler) SetPipeline(p dnschain.Pipeline) {
+	handlers := dnschain.DefaultRegistry().Build(p, dnschain.Deps{
+		Client:    r.Client,
+		Source:    r.SourceReader,
+		Conflicts: r.Conflicts,
+		Upsert:    r.upsert,
+	})
+	r.chain = reconciler.NewChain("dns", handlers...)
+}
+
+// SetRecordNamer configures how auto-generated DNSRecords are named. Must be
+// called before the manager starts.
+func (r *DNSReconciler) SetRecordNamer(namer *domaindns.RecordNamer) {
+	r.upsert.Namer = namer
+}
+
+// SetEventRecorder sets the optional recorder used to emit Kubernetes Events
+// on DNS CRs.
+func (r *DNSReconciler) SetEventRecorder(recorder events.EventRecorder) {
+	r.recorder = recorder
+}
+
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups=sreportal.io,resources=dns,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=sreportal.io,resources=dns/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=sreportal.io,resources=dns/finalizers,verbs=update
@@ -117,12 +142,14 @@ func (r *DNSReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 
 	rc := &reconciler.ReconcileContext[*v1alpha2.DNS, dnschain.ChainData]{
 		Resource: &resource,
+		Data:     dnschain.ChainData{Recorder: r.recorder},
 	}
 
 	if err := r.chain.Execute(ctx, rc); err != nil {
 		logger.Error(err, "reconciliation failed")
-		// Surface the chain failure on SourcesReady so the DNS CR no longer
-		// advertises a stale True condition while the controller is broken.
+		rc.Data.Event(&resource, corev1.EventTypeWarning, "ReconcileFailed", "Reconcile", "%v", err)
+		// Surface the chain failure on SourcesReady and Ready so the DNS CR no
+		// longer advertises a stale True condition while the controller is broken.
 		// Best-effort: ignore the patch error (we'll already return the chain
 		// error and re-run).
 		dnschain.SetCondition(&resource, metav1.Condition{
@@ -131,6 +158,12 @@ func (r *DNSReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 			Reason:  "ReconcileFailed",
 			Message: err.Error(),
 		})
+		dnschain.SetCondition(&resource, metav1.Condition{
+			Type:    v1alpha2.ConditionReady,
+			Status:  metav1.ConditionFalse,
+			Reason:  "ReconcileFailed",
+			Message: err.Error(),
+		})
 		if patchErr := r.Status().Update(ctx, &resource); patchErr != nil {
 			logger.V(1).Info("failed to persist SourcesReady=False after chain error", "patchError", patchErr)
 		}
@@ -225,12 +258,33 @@ func (r *DNSReconciler) enqueueDNSForRecord(_ context.Context, obj client.Object
 }
 
 // enqueueDNSForPortal enqueues every DNS in the Portal's namespace that
-// references it via spec.portalRef.
+// references it via spec.portalRef. A change of an automatic namespace portal
+// also enqueues the DNS of the main portal, which leaves that namespace out.
 func (r *DNSReconciler) enqueueDNSForPortal(ctx context.Context, obj client.Object) []ctrl.Request {
 	portal, ok := obj.(*sreportalv1alpha1.Portal)
 	if !ok {
 		return nil
 	}
+	reqs := r.dnsRequestsForPortal(ctx, portal)
+	if _, auto := portal.Labels[adapter.AutoPortalNamespaceLabelKey]; !auto {
+		return reqs
+	}
+	var portals sreportalv1alpha1.PortalList
+	if err := r.List(ctx, &portals); err != nil {
+		log.FromContext(ctx).Error(err, "list Portals for automatic portal watch", "portal", portal.Name)
+		return reqs
+	}
+	for i := range portals.Items {
+		if portals.Items[i].Spec.Main {
+			reqs = append(reqs, r.dnsRequestsForPortal(ctx, &portals.Items[i])...)
+		}
+	}
+	return reqs
+}
+
+// dnsRequestsForPortal returns a request for every DNS in the Portal's
+// namespace that references it via spec.portalRef.
+func (r *DNSReconciler) dnsRequestsForPortal(ctx context.Context, portal *sreportalv1alpha1.Portal) []ctrl.Request {
 	var list v1alpha2.DNSList
 	if err := r.List(ctx, &list, client.InNamespace(portal.Namespace)); err != nil {
 		log.FromContext(ctx).Error(err, "list DNS for Portal watch", "portal", portal.Name)
@@ -31,4 +31,7 @@ type ChainData struct {
 	// Used by the project store handler to annotate the read store so that
 	// per-DNS conflict reporting can scope events to a specific DNS owner.
 	OwnerDNSName string
+	// OriginCauses maps the "kind/namespace/name" origin of endpoints that do
+	// not resolve to a short cause summary (see CorrelateOriginHandler).
+	OriginCauses map[string]string
 }
@@ -0,0 +1,207 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/external-dns/endpoint"
+
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	"github.com/golgoth31/sreportal/internal/reconciler"
+)
+
+// Event field selectors, served by the API server for core/v1 Events.
+const (
+	EventFieldInvolvedKind = "involvedObject.kind"
+	EventFieldInvolvedName = "involvedObject.name"
+	EventFieldType         = "type"
+)
+
+// CorrelateOriginHandler explains why endpoints do not resolve: for each
+// notavailable endpoint whose origin is a Service or an Ingress, it reads the
+// resource's load balancer status and recent Warning Events and records a
+// short cause summary in rc.Data.OriginCauses.
+//
+// Correlation is best effort: read errors are logged and leave the cause
+// empty. The origin resources are read from the cache. Events are listed
+// through an uncached reader, so they are not watched, and only when the
+// origin changed since they were last listed.
+type CorrelateOriginHandler struct {
+	reader client.Reader
+	events client.Reader
+	now    func() time.Time
+
+	mu sync.Mutex
+	// origins caches the state of the origins by "kind/namespace/name".
+	origins map[string]cachedOrigin
+}
+
+// cachedOrigin is the state of an origin at a resource version.
+type cachedOrigin struct {
+	resourceVersion string
+	state           domaindns.OriginState
+	readAt          time.Time
+}
+
+// NewCorrelateOriginHandler creates a CorrelateOriginHandler reading the
+// origin resources with r, a cached reader, and their Events with events.
+func NewCorrelateOriginHandler(r, events client.Reader) *CorrelateOriginHandler {
+	return &CorrelateOriginHandler{reader: r, events: events, now: time.Now, origins: make(map[string]cachedOrigin)}
+}
+
+// Handle implements reconciler.Handler.
+func (h *CorrelateOriginHandler) Handle(ctx context.Context, rc *reconciler.ReconcileContext[*v1alpha2.DNSRecord, ChainData]) error {
+	logger := log.FromContext(ctx).WithName("correlate-origin")
+	for _, ep := range rc.Resource.Status.Endpoints {
+		if ep.SyncStatus != v1alpha2.SyncStatusNotAvailable {
+			continue
+		}
+		ref, err := domaindns.ParseResourceRef(ep.Labels[endpoint.ResourceLabelKey])
+		if err != nil {
+			continue
+		}
+		key := ref.String()
+		if _, done := rc.Data.OriginCauses[key]; done {
+			continue
+		}
+		state, ok, err := h.originState(ctx, ref)
+		if err != nil {
+			logger.V(1).Info("origin correlation failed", "origin", key, "error", err.Error())
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if rc.Data.OriginCauses == nil {
+			rc.Data.OriginCauses = make(map[string]string)
+		}
+		rc.Data.OriginCauses[key] = domaindns.SummarizeOriginCause(ref, state, h.now())
+	}
+	return nil
+}
+
+// originState reads the state of ref. ok is false for kinds without a known
+// failure signal.
+func (h *CorrelateOriginHandler) originState(ctx context.Context, ref domaindns.ResourceRef) (domaindns.OriginState, bool, error) {
+	var (
+		obj  client.Object
+		kind string
+	)
+	switch strings.ToLower(ref.Kind()) {
+	case "service":
+		obj, kind = &corev1.Service{}, "Service"
+	case "ingress":
+		obj, kind = &networkingv1.Ingress{}, "Ingress"
+	default:
+		return domaindns.OriginState{}, false, nil
+	}
+
+	key := client.ObjectKey{Namespace: ref.Namespace(), Name: ref.Name()}
+	if err := h.reader.Get(ctx, key, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return domaindns.OriginState{Missing: true}, true, nil
+		}
+		return domaindns.OriginState{}, false, fmt.Errorf("get %s %s: %w", kind, key, err)
+	}
+
+	cacheKey := ref.String()
+	if cached, ok := h.cached(cacheKey, obj.GetResourceVersion()); ok {
+		return cached, true, nil
+	}
+
+	var state domaindns.OriginState
+	switch o := obj.(type) {
+	case *corev1.Service:
+		state.NoLoadBalancer = o.Spec.Type == corev1.ServiceTypeLoadBalancer && len(o.Status.LoadBalancer.Ingress) == 0
+	case *networkingv1.Ingress:
+		state.NoLoadBalancer = len(o.Status.LoadBalancer.Ingress) == 0
+	}
+
+	var events corev1.EventList
+	if err := h.events.List(ctx, &events,
+		client.InNamespace(ref.Namespace()),
+		client.MatchingFields{
+			EventFieldInvolvedKind: kind,
+			EventFieldInvolvedName: ref.Name(),
+			EventFieldType:         corev1.EventTypeWarning,
+		},
+	); err != nil {
+		return domaindns.OriginState{}, false, fmt.Errorf("list events of %s %s: %w", kind, key, err)
+	}
+	for i := range events.Items {
+		e := &events.Items[i]
+		state.Events = append(state.Events, domaindns.OriginEvent{
+			Reason:   e.Reason,
+			Message:  e.Message,
+			LastSeen: eventLastSeen(e),
+		})
+	}
+	h.store(cacheKey, obj.GetResourceVersion(), state)
+	return state, true, nil
+}
+
+// cached returns the state of the origin key read at resourceVersion.
+func (h *CorrelateOriginHandler) cached(key, resourceVersion string) (domaindns.OriginState, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	c, ok := h.origins[key]
+	if !ok || c.resourceVersion != resourceVersion {
+		return domaindns.OriginState{}, false
+	}
+	return c.state, true
+}
+
+// store caches the state of the origin key read at resourceVersion, and
+// drops the origins read longer than domaindns.OriginCauseWindow ago: their
+// Events no longer count.
+func (h *CorrelateOriginHandler) store(key, resourceVersion string, state domaindns.OriginState) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	now := h.now()
+	for k, c := range h.origins {
+		if now.Sub(c.readAt) > domaindns.OriginCauseWindow {
+			delete(h.origins, k)
+		}
+	}
+	h.origins[key] = cachedOrigin{resourceVersion: resourceVersion, state: state, readAt: now}
+}
+
+// eventLastSeen returns the last time e was observed, whichever of the
+// legacy and events.k8s.io timestamps its emitter filled.
+func eventLastSeen(e *corev1.Event) time.Time {
+	switch {
+	case e.Series != nil && !e.Series.LastObservedTime.IsZero():
+		return e.Series.LastObservedTime.Time
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	default:
+		return e.CreationTimestamp.Time
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chain_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/external-dns/endpoint"
+
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/controller/dnsrecords/chain"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	dnsstore "github.com/golgoth31/sreportal/internal/readstore/dns"
+	"github.com/golgoth31/sreportal/internal/reconciler"
+)
+
+// newFakeClientWithEventIndexes builds a fake client serving the Event field
+// selectors the API server supports natively.
+func newFakeClientWithEventIndexes(scheme *runtime.Scheme, objs ...client.Object) client.Client {
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithIndex(&corev1.Event{}, chain.EventFieldInvolvedKind, func(o client.Object) []string {
+			return []string{o.(*corev1.Event).InvolvedObject.Kind}
+		}).
+		WithIndex(&corev1.Event{}, chain.EventFieldInvolvedName, func(o client.Object) []string {
+			return []string{o.(*corev1.Event).InvolvedObject.Name}
+		}).
+		WithIndex(&corev1.Event{}, chain.EventFieldType, func(o client.Object) []string {
+			return []string{o.(*corev1.Event).Type}
+		}).
+		Build()
+}
+
+func TestCorrelateOriginHandler_ExplainsUnresolvedEndpoints(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha2.AddToScheme(scheme)
+
+	ingress := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: tNsDefault}}
+	warning := &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "web.1", Namespace: tNsDefault},
+		InvolvedObject: corev1.ObjectReference{Kind: "Ingress", Name: "web", Namespace: tNsDefault},
+		Type:           corev1.EventTypeWarning,
+		Reason:         "SyncLoadBalancerFailed",
+		Message:        "quota exceeded",
+		LastTimestamp:  metav1.Now(),
+	}
+	normal := &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "web.2", Namespace: tNsDefault},
+		InvolvedObject: corev1.ObjectReference{Kind: "Ingress", Name: "web", Namespace: tNsDefault},
+		Type:           corev1.EventTypeNormal,
+		Reason:         "Sync",
+		LastTimestamp:  metav1.Now(),
+	}
+	c := newFakeClientWithEventIndexes(scheme, ingress, warning, normal)
+
+	origin := func(ref string) map[string]string {
+		return map[string]string{endpoint.ResourceLabelKey: ref}
+	}
+	record := &v1alpha2.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{Name: "main-ingress", Namespace: tNsDefault},
+		Spec:       v1alpha2.DNSRecordSpec{PortalRef: tPortalMain, SourceType: "ingress"},
+		Status: v1alpha2.DNSRecordStatus{Endpoints: []v1alpha2.EndpointStatus{
+			{DNSName: "web.example.com", RecordType: "A", SyncStatus: v1alpha2.SyncStatusNotAvailable,
+				Labels: origin("ingress/" + tNsDefault + "/web")},
+			{DNSName: "gone.example.com", RecordType: "A", SyncStatus: v1alpha2.SyncStatusNotAvailable,
+				Labels: origin("service/" + tNsDefault + "/gone")},
+			{DNSName: "ok.example.com", RecordType: "A", SyncStatus: v1alpha2.SyncStatusSync,
+				Labels: origin("service/" + tNsDefault + "/ok")},
+		}},
+	}
+	rc := &reconciler.ReconcileContext[*v1alpha2.DNSRecord, chain.ChainData]{
+		Resource: record,
+		Data:     chain.ChainData{ResourceKey: tNsDefault + "/main-ingress"},
+	}
+
+	g.Expect(chain.NewCorrelateOriginHandler(c, c).Handle(ctx, rc)).To(Succeed())
+	g.Expect(rc.Data.OriginCauses).To(Equal(map[string]string{
+		"ingress/default/web":  "ingress has no load balancer address; SyncLoadBalancerFailed: quota exceeded",
+		"service/default/gone": "service default/gone no longer exists",
+	}))
+
+	store := dnsstore.NewFQDNStore()
+	g.Expect(chain.NewProjectStoreHandler(store).Handle(ctx, rc)).To(Succeed())
+	views, err := store.List(ctx, domaindns.FQDNFilters{Search: "web.example.com"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(views).To(HaveLen(1))
+	g.Expect(views[0].OriginCause).To(HavePrefix("ingress has no load balancer address"))
+}
+
+// countingReader counts the List calls it forwards.
+type countingReader struct {
+	client.Reader
+	lists int
+}
+
+func (r *countingReader) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	r.lists++
+	return r.Reader.List(ctx, list, opts...)
+}
+
+func TestCorrelateOriginHandler_ListsEventsOnOriginChange(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha2.AddToScheme(scheme)
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "lb", Namespace: tNsDefault},
+		Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+	}
+	c := newFakeClientWithEventIndexes(scheme, svc)
+	events := &countingReader{Reader: c}
+	h := chain.NewCorrelateOriginHandler(c, events)
+
+	handle := func() map[string]string {
+		rc := &reconciler.ReconcileContext[*v1alpha2.DNSRecord, chain.ChainData]{
+			Resource: &v1alpha2.DNSRecord{
+				ObjectMeta: metav1.ObjectMeta{Name: "main-service", Namespace: tNsDefault},
+				Status: v1alpha2.DNSRecordStatus{Endpoints: []v1alpha2.EndpointStatus{
+					{DNSName: "lb.example.com", RecordType: "A", SyncStatus: v1alpha2.SyncStatusNotAvailable,
+						Labels: map[string]string{endpoint.ResourceLabelKey: "service/" + tNsDefault + "/lb"}},
+				}},
+			},
+		}
+		g.Expect(h.Handle(ctx, rc)).To(Succeed())
+		return rc.Data.OriginCauses
+	}
+
+	g.Expect(handle()).To(HaveKeyWithValue("service/default/lb", "service has no load balancer address"))
+	g.Expect(handle()).To(HaveKey("service/default/lb"))
+	g.Expect(events.lists).To(Equal(1))
+
+	// A status change of the origin lists its Events again.
+	svc.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: "203.0.113.7"}}
+	g.Expect(c.Status().Update(ctx, svc)).To(Succeed())
+	g.Expect(handle()).NotTo(HaveKeyWithValue("service/default/lb", "service has no load balancer address"))
+	g.Expect(events.lists).To(Equal(2))
+}
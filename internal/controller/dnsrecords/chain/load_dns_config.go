@@ -71,21 +71,36 @@ func (h *LoadDNSConfigHandler) Handle(ctx context.Context, rc *reconciler.Reconc
 	return nil
 }
 
-// DNSCheckDisabled reports whether the DNS CR governing record has DNS
-// resolution disabled (spec.reconciliation.disableDNSCheck), mirroring the DNS
-// selection used by LoadDNSConfigHandler. Returns false when no DNS matches or
-// on a list error (fail open to resolution). Used by the async dnsresolve
-// Runnable, which doesn't run the chain.
-func DNSCheckDisabled(ctx context.Context, c client.Client, record *v1alpha2.DNSRecord) bool {
+// GoverningDNS returns the DNS CR governing record, mirroring the DNS
+// selection used by LoadDNSConfigHandler. Returns nil when no DNS matches or
+// on a list error. Used by the async runnables, which don't run the chain.
+func GoverningDNS(ctx context.Context, c client.Client, record *v1alpha2.DNSRecord) *v1alpha2.DNS {
 	if record.Spec.PortalRef == "" {
-		return false
+		return nil
 	}
 	var list v1alpha2.DNSList
 	if err := c.List(ctx, &list,
 		client.InNamespace(record.Namespace),
 		client.MatchingFields{portalfeatures.FieldIndexPortalRef: record.Spec.PortalRef},
-	); err != nil || len(list.Items) == 0 {
-		return false
+	); err != nil {
+		return nil
+	}
+	return SelectGoverningDNS(list.Items, record)
+}
+
+// SelectGoverningDNS picks the DNS CR governing record among items, with the
+// same rules as GoverningDNS. Items outside the record's namespace or portal
+// are ignored, so callers may pass an unindexed list. Returns nil when no
+// item matches.
+func SelectGoverningDNS(items []v1alpha2.DNS, record *v1alpha2.DNSRecord) *v1alpha2.DNS {
+	var candidates []v1alpha2.DNS
+	for i := range items {
+		if items[i].Namespace == record.Namespace && items[i].Spec.PortalRef == record.Spec.PortalRef {
+			candidates = append(candidates, items[i])
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
 	}
 	owner := ""
 	for _, or := range record.OwnerReferences {
@@ -94,7 +109,7 @@ func DNSCheckDisabled(ctx context.Context, c client.Client, record *v1alpha2.DNS
 			break
 		}
 	}
-	return selectDNS(list.Items, owner).Spec.Reconciliation.DisableDNSCheck
+	return selectDNS(candidates, owner)
 }
 
 // selectDNS deterministically picks one DNS from a non-empty list. If ownerName
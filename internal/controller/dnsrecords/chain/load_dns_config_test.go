@@ -233,3 +233,25 @@ func TestLoadDNSConfigHandler_MultipleDNS_NoOwner_LowestName(t *testing.T) {
 	g.Expect(rc.Data.GroupMapping).NotTo(BeNil())
 	g.Expect(rc.Data.GroupMapping.DefaultGroup).To(Equal("GroupA"))
 }
+
+func TestSelectGoverningDNS_IgnoresOtherPortalsAndNamespaces(t *testing.T) {
+	g := NewWithT(t)
+
+	items := []v1alpha2.DNS{
+		{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "other"}, Spec: v1alpha2.DNSSpec{PortalRef: "main"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: tNsDefault}, Spec: v1alpha2.DNSSpec{PortalRef: "other"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: tNsDefault}, Spec: v1alpha2.DNSSpec{PortalRef: "main"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: tNsDefault}, Spec: v1alpha2.DNSSpec{PortalRef: "main"}},
+	}
+	record := &v1alpha2.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{Name: "r", Namespace: tNsDefault},
+		Spec:       v1alpha2.DNSRecordSpec{Origin: v1alpha2.DNSRecordOriginManual, PortalRef: "main"},
+	}
+
+	got := chain.SelectGoverningDNS(items, record)
+	g.Expect(got).NotTo(BeNil())
+	g.Expect(got.Name).To(Equal("c"))
+
+	record.Spec.PortalRef = "missing"
+	g.Expect(chain.SelectGoverningDNS(items, record)).To(BeNil())
+}
@@ -22,8 +22,13 @@ import (
 // their entries from the DNS controller writing spec.entries; manual records
 // have entries set by the user.
 //
+// It also tracks the target changes of each endpoint across reconciles and
+// flags an ownership conflict while its targets oscillate between sets (see
+// domaindns.AlternatingTargets).
+//
 // The handler persists status changes itself via Status().Patch when the
-// endpoints hash or observedGeneration moves. Downstream handlers
+// endpoints hash, observedGeneration, the counters or the tracked target
+// changes move. Downstream handlers
 // (ResolveDNS, ProjectStore) can short-circuit without losing the
 // materialisation step.
 type MaterialiseEntriesHandler struct {
@@ -44,13 +49,15 @@ func (h *MaterialiseEntriesHandler) Handle(ctx context.Context, rc *reconciler.R
 	record := rc.Resource
 	base := record.DeepCopy()
 
-	// Preserve the last-known SyncStatus per (DNSName, RecordType): DNS
-	// resolution runs asynchronously in the dnsresolve Runnable, not here, so
-	// rebuilding endpoints must not blank a status the Runnable already set
+	// Preserve the last-known SyncStatus (split-horizon Internal/External
+	// status and probe outcome) per (DNSName, RecordType): DNS
+	// resolution and probing run asynchronously in the dnsresolve and probe
+	// Runnables, not here, so rebuilding endpoints must not blank a status
+	// they already set
 	// (otherwise every reconcile would briefly wipe the UI's sync state).
-	prevSync := make(map[string]v1alpha2.SyncStatus, len(record.Status.Endpoints))
+	prevSync := make(map[string]v1alpha2.EndpointStatus, len(record.Status.Endpoints))
 	for _, ep := range record.Status.Endpoints {
-		prevSync[ep.DNSName+"|"+ep.RecordType] = ep.SyncStatus
+		prevSync[ep.DNSName+"|"+ep.RecordType] = ep
 	}
 
 	now := metav1.Now()
@@ -84,14 +91,55 @@ func (h *MaterialiseEntriesHandler) Handle(ctx context.Context, rc *reconciler.R
 			}
 			labels[endpoint.ResourceLabelKey] = e.OriginRef
 		}
+		// Re-inject the source Service ports, route paths and exposed
+		// annotations so the adapter can derive FQDNStatus.Ports,
+		// FQDNStatus.Paths and FQDNStatus.Annotations.
+		if len(e.Ports) > 0 {
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			labels[domaindns.PortsLabelKey] = adapter.FormatPortsV2(e.Ports)
+		}
+		if paths := domaindns.FormatPaths(e.Paths); paths != "" {
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			labels[domaindns.PathsLabelKey] = paths
+		}
+		if anns := domaindns.FormatAnnotations(e.Annotations); anns != "" {
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			labels[domaindns.AnnotationsLabelKey] = anns
+		}
+		// Re-inject the probe so the probe runnable finds it on the status.
+		if e.Probe != "" {
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			labels[domaindns.ProbeAnnotationKey] = e.Probe
+		}
 
+		prev := prevSync[e.FQDN+"|"+rt]
 		endpoints = append(endpoints, v1alpha2.EndpointStatus{
-			DNSName:    e.FQDN,
-			RecordType: rt,
-			Targets:    e.Targets,
-			Labels:     labels,
-			LastSeen:   now,
-			SyncStatus: prevSync[e.FQDN+"|"+rt],
+			DNSName:           e.FQDN,
+			RecordType:        rt,
+			Targets:           e.Targets,
+			Labels:            labels,
+			LastSeen:          now,
+			SyncStatus:        prev.SyncStatus,
+			InternalStatus:    prev.InternalStatus,
+			ExternalStatus:    prev.ExternalStatus,
+			LookupFailure:     prev.LookupFailure,
+			Availability:      prev.Availability,
+			HealthStatus:      prev.HealthStatus,
+			LastProbeTime:     prev.LastProbeTime,
+			HTTPStatusCode:    prev.HTTPStatusCode,
+			ProbeLatency:      prev.ProbeLatency,
+			TLSValid:          prev.TLSValid,
+			TLSNotAfter:       prev.TLSNotAfter,
+			TargetChanges:     prev.TargetChanges,
+			OwnershipConflict: prev.OwnershipConflict,
 		})
 	}
 
@@ -103,12 +151,16 @@ func (h *MaterialiseEntriesHandler) Handle(ctx context.Context, rc *reconciler.R
 		record.Status.EndpointsHash = adapter.EndpointStatusHashV2(endpoints)
 	}
 	record.Status.ObservedGeneration = record.Generation
+	record.Status.FQDNCount = adapter.CountFQDNsV2(endpoints)
+	record.Status.EndpointCount = int32(len(endpoints))
 
 	if h.client == nil {
 		return nil
 	}
 	if base.Status.EndpointsHash == record.Status.EndpointsHash &&
-		base.Status.ObservedGeneration == record.Status.ObservedGeneration {
+		base.Status.ObservedGeneration == record.Status.ObservedGeneration &&
+		base.Status.FQDNCount == record.Status.FQDNCount &&
+		base.Status.EndpointCount == record.Status.EndpointCount {
 		return nil
 	}
 	if err := h.client.Status().Patch(ctx, record, client.MergeFrom(base)); err != nil {
@@ -50,6 +50,7 @@ func TestMaterialiseEntriesHandler_ConvertEntriesToEndpoints(t *testing.T) {
 	g.Expect(record.Status.Endpoints[0].Labels["sreportal.io/group"]).To(Equal(tGroupAPIs))
 	g.Expect(record.Status.Endpoints[2].Labels).To(BeNil())
 	g.Expect(record.Status.EndpointsHash).NotTo(BeEmpty())
+	g.Expect(record.Status.FQDNCount).To(Equal(int32(3)))
 }
 
 func TestMaterialiseEntriesHandler_MaterialisesForAuto(t *testing.T) {
@@ -73,6 +74,38 @@ func TestMaterialiseEntriesHandler_MaterialisesForAuto(t *testing.T) {
 	g.Expect(record.Status.EndpointsHash).NotTo(BeEmpty())
 }
 
+func TestMaterialiseEntriesHandler_StampsCounts(t *testing.T) {
+	g := NewWithT(t)
+	h := chain.NewMaterialiseEntriesHandler(nil)
+
+	auto := &v1alpha2.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{Name: "auto", Namespace: tNsDefault},
+		Spec: v1alpha2.DNSRecordSpec{
+			Origin:     v1alpha2.DNSRecordOriginAuto,
+			SourceType: tSrcService,
+			PortalRef:  tPortalMain,
+			Entries: []v1alpha2.DNSRecordEntry{
+				{FQDN: tFQDNA, RecordType: "A", Targets: []string{tIP1234}},
+				{FQDN: tFQDNA, RecordType: "AAAA", Targets: []string{"::1"}},
+			},
+		},
+	}
+	g.Expect(h.Handle(context.Background(), &reconciler.ReconcileContext[*v1alpha2.DNSRecord, chain.ChainData]{Resource: auto})).To(Succeed())
+	g.Expect(auto.Status.EndpointCount).To(Equal(int32(2)))
+	g.Expect(auto.Status.FQDNCount).To(Equal(int32(1)))
+
+	manual := &v1alpha2.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{Name: "manual", Namespace: tNsDefault},
+		Spec: v1alpha2.DNSRecordSpec{
+			Origin:    v1alpha2.DNSRecordOriginManual,
+			PortalRef: tPortalMain,
+			Entries:   []v1alpha2.DNSRecordEntry{{FQDN: tFQDNA, Targets: []string{tIP1234}}},
+		},
+	}
+	g.Expect(h.Handle(context.Background(), &reconciler.ReconcileContext[*v1alpha2.DNSRecord, chain.ChainData]{Resource: manual})).To(Succeed())
+	g.Expect(manual.Status.EndpointCount).To(Equal(int32(1)))
+}
+
 func TestMaterialiseEntriesHandler_EmptyEntries(t *testing.T) {
 	g := NewWithT(t)
 	record := &v1alpha2.DNSRecord{
@@ -208,6 +241,7 @@ func TestMaterialiseEntriesHandler_ReinjectsOriginRef(t *testing.T) {
 	g.Expect(withOrigin.Status.Endpoints).To(HaveLen(1))
 	g.Expect(withOrigin.Status.Endpoints[0].Labels["resource"]).To(Equal("service/ns1/budget-controls"))
 	g.Expect(withOrigin.Status.Endpoints[0].Labels["sreportal.io/group"]).To(Equal(tGroupAPIs))
+	g.Expect(withOrigin.Status.Endpoints[0].Labels).NotTo(HaveKey("sreportal.io/ports"))
 	hashWithOrigin := withOrigin.Status.EndpointsHash
 
 	// Same entry without OriginRef: the hash must be identical (resource label
@@ -228,3 +262,61 @@ func TestMaterialiseEntriesHandler_ReinjectsOriginRef(t *testing.T) {
 	g.Expect(noOrigin.Status.Endpoints[0].Labels).NotTo(HaveKey("resource"))
 	g.Expect(noOrigin.Status.EndpointsHash).To(Equal(hashWithOrigin), "OriginRef must not affect the endpoints hash")
 }
+
+// TestMaterialiseEntriesHandler_ReinjectsPortsAndPaths verifies that an
+// entry's Service ports and route paths are re-injected into the status
+// endpoint labels so the adapter can derive them downstream.
+func TestMaterialiseEntriesHandler_ReinjectsPortsAndPaths(t *testing.T) {
+	g := NewWithT(t)
+
+	record := &v1alpha2.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{Name: "auto-ports", Namespace: tNsDefault},
+		Spec: v1alpha2.DNSRecordSpec{
+			Origin:     v1alpha2.DNSRecordOriginAuto,
+			SourceType: tSrcService,
+			PortalRef:  tPortalMain,
+			Entries: []v1alpha2.DNSRecordEntry{
+				{FQDN: tFQDNA, RecordType: "A", Targets: []string{tIP1234}, Ports: []v1alpha2.ServicePort{
+					{Name: "https", Port: 443, Protocol: "TCP"},
+				}, Paths: []string{"/api", "/"}, Annotations: map[string]string{"example.com/owner": "team-a"}},
+			},
+		},
+	}
+	rc := &reconciler.ReconcileContext[*v1alpha2.DNSRecord, chain.ChainData]{Resource: record}
+	g.Expect(chain.NewMaterialiseEntriesHandler(nil).Handle(context.Background(), rc)).To(Succeed())
+	g.Expect(record.Status.Endpoints).To(HaveLen(1))
+	g.Expect(record.Status.Endpoints[0].Labels["sreportal.io/ports"]).To(Equal("https:443/TCP"))
+	g.Expect(record.Status.Endpoints[0].Labels["sreportal.io/paths"]).To(Equal("/,/api"))
+	g.Expect(record.Status.Endpoints[0].Labels["sreportal.io/annotations"]).To(Equal(`{"example.com/owner":"team-a"}`))
+}
+
+func TestMaterialiseEntriesHandler_PreservesTrackedTargets(t *testing.T) {
+	g := NewWithT(t)
+	changes := []v1alpha2.TargetChange{
+		{Targets: []string{tIP1234}, At: metav1.Now()},
+		{Targets: []string{"5.6.7.8"}, At: metav1.Now()},
+	}
+	conflict := &v1alpha2.OwnershipConflict{DetectedAt: metav1.Now(), TargetSets: []v1alpha2.TargetSet{
+		{Targets: []string{tIP1234}}, {Targets: []string{"5.6.7.8"}},
+	}}
+	record := &v1alpha2.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{Name: "flap", Namespace: tNsDefault},
+		Spec: v1alpha2.DNSRecordSpec{
+			Origin:    v1alpha2.DNSRecordOriginManual,
+			PortalRef: tPortalMain,
+			Entries:   []v1alpha2.DNSRecordEntry{{FQDN: tFQDNA, RecordType: "A", Targets: []string{"9.9.9.9"}}},
+		},
+		Status: v1alpha2.DNSRecordStatus{Endpoints: []v1alpha2.EndpointStatus{
+			{DNSName: tFQDNA, RecordType: "A", Targets: []string{tIP1234}, TargetChanges: changes, OwnershipConflict: conflict},
+		}},
+	}
+	rc := &reconciler.ReconcileContext[*v1alpha2.DNSRecord, chain.ChainData]{Resource: record}
+
+	g.Expect(chain.NewMaterialiseEntriesHandler(nil).Handle(context.Background(), rc)).To(Succeed())
+
+	// The served targets are tracked by the resolver: a change of the
+	// declared targets leaves their history as it is.
+	g.Expect(record.Status.Endpoints).To(HaveLen(1))
+	g.Expect(record.Status.Endpoints[0].TargetChanges).To(Equal(changes))
+	g.Expect(record.Status.Endpoints[0].OwnershipConflict).To(Equal(conflict))
+}
@@ -45,6 +45,11 @@ func (h *ProjectStoreHandler) Handle(ctx context.Context, rc *reconciler.Reconci
 		return nil
 	}
 	views := DNSRecordToFQDNViews(rc.Resource, rc.Data.GroupMapping)
+	for i := range views {
+		if views[i].OriginRef != nil && views[i].SyncStatus == string(domaindns.SyncStatusNotAvailable) {
+			views[i].OriginCause = rc.Data.OriginCauses[views[i].OriginRef.String()]
+		}
+	}
 	if err := h.fqdnWriter.Replace(ctx, rc.Data.ResourceKey, rc.Resource.Spec.PortalRef, views); err != nil {
 		return fmt.Errorf("project store: %w", err)
 	}
@@ -79,7 +84,7 @@ func DNSRecordToFQDNViews(
 		source = domaindns.SourceManual
 	}
 
-	groups := adapter.EndpointStatusToGroupsV2(record.Status.Endpoints, groupMapping)
+	groups := adapter.DNSRecordToGroupsV2(record, groupMapping)
 
 	seen := make(map[string]*domaindns.FQDNView)
 
@@ -92,17 +97,50 @@ func DNSRecordToFQDNViews(
 				}
 			} else {
 				view := domaindns.FQDNView{
-					Name:        fqdn.FQDN,
-					Source:      source,
-					SourceType:  string(record.Spec.SourceType),
-					Groups:      []string{group.Name},
-					Description: fqdn.Description,
-					RecordType:  fqdn.RecordType,
-					Targets:     fqdn.Targets,
-					LastSeen:    fqdn.LastSeen.Time,
-					Portals:     []string{record.Spec.PortalRef},
-					Namespace:   record.Namespace,
-					SyncStatus:  string(fqdn.SyncStatus),
+					Name:               fqdn.FQDN,
+					Source:             source,
+					SourceType:         fqdn.SourceType,
+					Groups:             []string{group.Name},
+					Description:        fqdn.Description,
+					RecordType:         fqdn.RecordType,
+					Targets:            fqdn.Targets,
+					LastSeen:           fqdn.LastSeen.Time,
+					Portals:            []string{record.Spec.PortalRef},
+					Namespace:          record.Namespace,
+					SyncStatus:         string(fqdn.SyncStatus),
+					Paths:              fqdn.Paths,
+					Annotations:        fqdn.Annotations,
+					InternalSyncStatus: string(fqdn.InternalStatus),
+					ExternalSyncStatus: string(fqdn.ExternalStatus),
+					LookupFailure:      string(fqdn.LookupFailure),
+					Availability:       string(fqdn.Availability),
+					HealthStatus:       string(fqdn.HealthStatus),
+					HTTPStatusCode:     int(fqdn.HTTPStatusCode),
+					TLSValid:           fqdn.TLSValid,
+				}
+				if fqdn.LastProbeTime != nil {
+					view.LastProbeTime = fqdn.LastProbeTime.Time
+				}
+				if fqdn.TLSNotAfter != nil {
+					view.TLSNotAfter = fqdn.TLSNotAfter.Time
+				}
+				if fqdn.ProbeLatency != nil {
+					view.ProbeLatency = fqdn.ProbeLatency.Duration
+				}
+				if fqdn.DNSRecordRef != nil {
+					view.DNSRecord = &domaindns.RecordRef{Namespace: fqdn.DNSRecordRef.Namespace, Name: fqdn.DNSRecordRef.Name}
+				}
+				if fqdn.LastReconcileTime != nil {
+					view.LastReconciled = fqdn.LastReconcileTime.Time
+				}
+				if c := fqdn.OwnershipConflict; c != nil {
+					view.OwnershipConflict = &domaindns.OwnershipConflict{DetectedAt: c.DetectedAt.Time}
+					for _, set := range c.TargetSets {
+						view.OwnershipConflict.TargetSets = append(view.OwnershipConflict.TargetSets, set.Targets)
+					}
+				}
+				for _, p := range fqdn.Ports {
+					view.Ports = append(view.Ports, domaindns.ServicePort{Name: p.Name, Port: p.Port, Protocol: p.Protocol})
 				}
 				if fqdn.OriginRef != nil {
 					raw := fqdn.OriginRef.Kind + "/" + fqdn.OriginRef.Namespace + "/" + fqdn.OriginRef.Name
@@ -18,6 +18,7 @@ package chain
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -43,7 +44,8 @@ func TestChain(t *testing.T) {
 
 var _ = Describe("DNSRecordToFQDNViews", func() {
 	Context("with endpoints", func() {
-		It("should convert endpoints to FQDNViews with PortalRef and SourceType", func() {
+		It("should convert endpoints to FQDNViews with PortalRef, SourceType and the record", func() {
+			reconciled := metav1.NewTime(metav1.Now().Truncate(time.Second))
 			record := &v1alpha2.DNSRecord{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "my-portal-service",
@@ -69,6 +71,7 @@ var _ = Describe("DNSRecordToFQDNViews", func() {
 							LastSeen:   metav1.Now(),
 						},
 					},
+					LastReconcileTime: &reconciled,
 				},
 			}
 
@@ -80,6 +83,8 @@ var _ = Describe("DNSRecordToFQDNViews", func() {
 				Expect(v.Namespace).To(Equal("test-ns"))
 				Expect(v.Source).To(Equal(domaindns.SourceExternalDNS))
 				Expect(v.SourceType).To(Equal(tSrcService))
+				Expect(v.DNSRecord).To(Equal(&domaindns.RecordRef{Namespace: "test-ns", Name: "my-portal-service"}))
+				Expect(v.LastReconciled).To(Equal(reconciled.Time))
 			}
 		})
 	})
@@ -137,6 +142,44 @@ var _ = Describe("DNSRecordToFQDNViews", func() {
 		})
 	})
 
+	Context("with Service ports and route paths on endpoints", func() {
+		It("should propagate ports and paths to FQDNView", func() {
+			record := &v1alpha2.DNSRecord{
+				ObjectMeta: metav1.ObjectMeta{Name: "ports-record", Namespace: tNsDefault},
+				Spec: v1alpha2.DNSRecordSpec{
+					Origin:     v1alpha2.DNSRecordOriginAuto,
+					SourceType: tSrcService,
+					PortalRef:  tPortalMain,
+				},
+				Status: v1alpha2.DNSRecordStatus{
+					Endpoints: []v1alpha2.EndpointStatus{
+						{
+							DNSName:    "svc.example.com",
+							RecordType: "A",
+							Targets:    []string{"10.0.0.1"},
+							LastSeen:   metav1.Now(),
+							Labels: map[string]string{
+								domaindns.PortsLabelKey:       "https:443/TCP,grpc:8443/TCP",
+								domaindns.PathsLabelKey:       "/,/api",
+								domaindns.AnnotationsLabelKey: `{"example.com/owner":"team-a"}`,
+							},
+						},
+					},
+				},
+			}
+
+			views := DNSRecordToFQDNViews(record, nil)
+
+			Expect(views).To(HaveLen(1))
+			Expect(views[0].Ports).To(Equal([]domaindns.ServicePort{
+				{Name: "https", Port: 443, Protocol: "TCP"},
+				{Name: "grpc", Port: 8443, Protocol: "TCP"},
+			}))
+			Expect(views[0].Paths).To(Equal([]string{"/", "/api"}))
+			Expect(views[0].Annotations).To(Equal(map[string]string{"example.com/owner": "team-a"}))
+		})
+	})
+
 	Context("with group mapping config", func() {
 		It("should apply group mapping from config", func() {
 			record := &v1alpha2.DNSRecord{
@@ -0,0 +1,178 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chain
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	externaldnsv1alpha1 "sigs.k8s.io/external-dns/apis/v1alpha1"
+	"sigs.k8s.io/external-dns/endpoint"
+
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
+	"github.com/golgoth31/sreportal/internal/log"
+	"github.com/golgoth31/sreportal/internal/reconciler"
+)
+
+// PublishDNSEndpointHandler renders the entries of a manual DNSRecord into an
+// external-dns DNSEndpoint of the same name, so a record added through the
+// portal is provisioned into real DNS by external-dns. Only entries with
+// targets are published, optionally restricted to a set of groups.
+//
+// The DNSEndpoint is controlled by the DNSRecord and labelled as managed by
+// sreportal; a DNSEndpoint of the same name that is not both is never touched.
+// When nothing is left to publish, the DNSEndpoint is deleted.
+type PublishDNSEndpointHandler struct {
+	client client.Client
+	groups []string
+	labels map[string]string
+}
+
+// NewPublishDNSEndpointHandler returns a PublishDNSEndpointHandler. An empty
+// groups publishes every entry; labels are added to every DNSEndpoint.
+func NewPublishDNSEndpointHandler(c client.Client, groups []string, labels map[string]string) *PublishDNSEndpointHandler {
+	return &PublishDNSEndpointHandler{client: c, groups: groups, labels: labels}
+}
+
+// Handle implements reconciler.Handler.
+func (h *PublishDNSEndpointHandler) Handle(ctx context.Context, rc *reconciler.ReconcileContext[*v1alpha2.DNSRecord, ChainData]) error {
+	record := rc.Resource
+	if record.Spec.Origin != v1alpha2.DNSRecordOriginManual {
+		return nil
+	}
+
+	var endpoints []*endpoint.Endpoint
+	if record.Annotations[adapter.PublishAnnotationKey] != "false" {
+		endpoints = h.endpoints(record)
+	}
+
+	var err error
+	if len(endpoints) == 0 {
+		err = h.unpublish(ctx, record)
+	} else {
+		err = h.publish(ctx, record, endpoints)
+	}
+	if meta.IsNoMatchError(err) {
+		log.FromContext(ctx).Debug("DNSEndpoint CRD not installed, skipping publication", "record", rc.Data.ResourceKey)
+		return nil
+	}
+	return err
+}
+
+// endpoints returns the external-dns endpoints of the record entries to
+// publish, in spec order.
+func (h *PublishDNSEndpointHandler) endpoints(record *v1alpha2.DNSRecord) []*endpoint.Endpoint {
+	var eps []*endpoint.Endpoint
+	for _, e := range record.Spec.Entries {
+		if len(e.Targets) == 0 || !h.selected(e) {
+			continue
+		}
+		rt := e.RecordType
+		if rt == "" {
+			rt = endpoint.RecordTypeA
+		}
+		eps = append(eps, endpoint.NewEndpoint(e.FQDN, rt, e.Targets...))
+	}
+	return eps
+}
+
+// selected reports whether the entry belongs to one of the published groups.
+func (h *PublishDNSEndpointHandler) selected(e v1alpha2.DNSRecordEntry) bool {
+	if len(h.groups) == 0 {
+		return true
+	}
+	if e.Group != "" && slices.Contains(h.groups, e.Group) {
+		return true
+	}
+	for _, g := range e.Groups {
+		if slices.Contains(h.groups, g) {
+			return true
+		}
+	}
+	return false
+}
+
+// publish creates or updates the record's DNSEndpoint.
+func (h *PublishDNSEndpointHandler) publish(ctx context.Context, record *v1alpha2.DNSRecord, endpoints []*endpoint.Endpoint) error {
+	de := &externaldnsv1alpha1.DNSEndpoint{ObjectMeta: metav1.ObjectMeta{Name: record.Name, Namespace: record.Namespace}}
+	if err := h.client.Get(ctx, client.ObjectKeyFromObject(de), de); err == nil {
+		if !publishedFrom(de, record) {
+			log.FromContext(ctx).Warn("DNSEndpoint not managed by sreportal, skipping publication",
+				"dnsendpoint", de.Namespace+"/"+de.Name)
+			return nil
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("get DNSEndpoint: %w", err)
+	}
+
+	op, err := controllerutil.CreateOrUpdate(ctx, h.client, de, func() error {
+		labels := de.GetLabels()
+		if labels == nil {
+			labels = make(map[string]string, len(h.labels)+3)
+		}
+		maps.Copy(labels, h.labels)
+		de.SetLabels(labels)
+		adapter.SetStandardLabels(de, record.Spec.PortalRef)
+		de.Labels[adapter.DNSRecordLabelKey] = record.Name
+		de.Spec.Endpoints = endpoints
+		return controllerutil.SetControllerReference(record, de, h.client.Scheme())
+	})
+	if err != nil {
+		return fmt.Errorf("publish DNSEndpoint: %w", err)
+	}
+	if op != controllerutil.OperationResultNone {
+		log.FromContext(ctx).Info("published DNSEndpoint", "dnsendpoint", de.Namespace+"/"+de.Name,
+			"operation", op, "endpoints", len(endpoints))
+	}
+	return nil
+}
+
+// unpublish deletes the record's DNSEndpoint, if it is managed by sreportal.
+func (h *PublishDNSEndpointHandler) unpublish(ctx context.Context, record *v1alpha2.DNSRecord) error {
+	var de externaldnsv1alpha1.DNSEndpoint
+	if err := h.client.Get(ctx, client.ObjectKeyFromObject(record), &de); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("get DNSEndpoint: %w", err)
+	}
+	if !publishedFrom(&de, record) {
+		return nil
+	}
+	if err := h.client.Delete(ctx, &de); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("delete DNSEndpoint: %w", err)
+	}
+	log.FromContext(ctx).Info("unpublished DNSEndpoint", "dnsendpoint", de.Namespace+"/"+de.Name)
+	return nil
+}
+
+// publishedFrom reports whether de was published from record: it carries the
+// sreportal managed-by label and is controlled by the record. The same check
+// guards the update and the deletion, so a DNSEndpoint is only ever touched by
+// the record that created it.
+func publishedFrom(de *externaldnsv1alpha1.DNSEndpoint, record *v1alpha2.DNSRecord) bool {
+	return de.Labels[adapter.AppManagedByLabelKey] == adapter.AppManagedByValue &&
+		metav1.IsControlledBy(de, record)
+}
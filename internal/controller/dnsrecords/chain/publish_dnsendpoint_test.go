@@ -0,0 +1,170 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chain_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	externaldnsv1alpha1 "sigs.k8s.io/external-dns/apis/v1alpha1"
+
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
+	"github.com/golgoth31/sreportal/internal/controller/dnsrecords/chain"
+	"github.com/golgoth31/sreportal/internal/reconciler"
+)
+
+func newPublishScheme(g *WithT) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	g.Expect(v1alpha2.AddToScheme(scheme)).To(Succeed())
+	g.Expect(externaldnsv1alpha1.AddToScheme(scheme)).To(Succeed())
+	return scheme
+}
+
+func manualRecord(entries ...v1alpha2.DNSRecordEntry) *v1alpha2.DNSRecord {
+	return &v1alpha2.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{Name: "manual", Namespace: tNsDefault, UID: "uid-manual"},
+		Spec: v1alpha2.DNSRecordSpec{
+			Origin:    v1alpha2.DNSRecordOriginManual,
+			PortalRef: tPortalMain,
+			Entries:   entries,
+		},
+	}
+}
+
+func publish(g *WithT, c client.Client, record *v1alpha2.DNSRecord, groups []string) {
+	rc := &reconciler.ReconcileContext[*v1alpha2.DNSRecord, chain.ChainData]{
+		Resource: record,
+		Data:     chain.ChainData{ResourceKey: tNsDefault + "/" + record.Name},
+	}
+	h := chain.NewPublishDNSEndpointHandler(c, groups, map[string]string{"team": "sre"})
+	g.Expect(h.Handle(context.Background(), rc)).To(Succeed())
+}
+
+func TestPublishDNSEndpointHandler_PublishesEntriesWithTargets(t *testing.T) {
+	g := NewWithT(t)
+	record := manualRecord(
+		v1alpha2.DNSRecordEntry{FQDN: tFQDNA, Group: tGroupAPIs, Targets: []string{tIP1234}},
+		v1alpha2.DNSRecordEntry{FQDN: "b.example.com", Group: tGroupAPIs, RecordType: "CNAME", Targets: []string{tFQDNA}},
+		v1alpha2.DNSRecordEntry{FQDN: "doc.example.com", Group: tGroupAPIs},
+		v1alpha2.DNSRecordEntry{FQDN: "other.example.com", Group: "Other", Targets: []string{tIP1234}},
+	)
+	c := fake.NewClientBuilder().WithScheme(newPublishScheme(g)).WithObjects(record).Build()
+
+	publish(g, c, record, []string{tGroupAPIs})
+
+	var de externaldnsv1alpha1.DNSEndpoint
+	g.Expect(c.Get(context.Background(), client.ObjectKeyFromObject(record), &de)).To(Succeed())
+	g.Expect(de.Labels).To(HaveKeyWithValue(adapter.AppManagedByLabelKey, adapter.AppManagedByValue))
+	g.Expect(de.Labels).To(HaveKeyWithValue(adapter.PortalAnnotationKey, tPortalMain))
+	g.Expect(de.Labels).To(HaveKeyWithValue(adapter.DNSRecordLabelKey, "manual"))
+	g.Expect(de.Labels).To(HaveKeyWithValue("team", "sre"))
+	g.Expect(de.OwnerReferences).To(HaveLen(1))
+	g.Expect(de.OwnerReferences[0].Name).To(Equal("manual"))
+	g.Expect(de.Spec.Endpoints).To(HaveLen(2))
+	g.Expect(de.Spec.Endpoints[0].DNSName).To(Equal(tFQDNA))
+	g.Expect(de.Spec.Endpoints[0].RecordType).To(Equal("A"))
+	g.Expect(de.Spec.Endpoints[1].DNSName).To(Equal("b.example.com"))
+	g.Expect(de.Spec.Endpoints[1].RecordType).To(Equal("CNAME"))
+}
+
+func TestPublishDNSEndpointHandler_DeletesWhenNothingLeft(t *testing.T) {
+	g := NewWithT(t)
+	record := manualRecord(v1alpha2.DNSRecordEntry{FQDN: tFQDNA, Targets: []string{tIP1234}})
+	c := fake.NewClientBuilder().WithScheme(newPublishScheme(g)).WithObjects(record).Build()
+	publish(g, c, record, nil)
+
+	record.Annotations = map[string]string{adapter.PublishAnnotationKey: "false"}
+	publish(g, c, record, nil)
+
+	var de externaldnsv1alpha1.DNSEndpoint
+	err := c.Get(context.Background(), client.ObjectKeyFromObject(record), &de)
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+}
+
+func TestPublishDNSEndpointHandler_LeavesForeignDNSEndpoint(t *testing.T) {
+	g := NewWithT(t)
+	record := manualRecord(v1alpha2.DNSRecordEntry{FQDN: tFQDNA, Targets: []string{tIP1234}})
+	foreign := &externaldnsv1alpha1.DNSEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: "manual", Namespace: tNsDefault},
+	}
+	c := fake.NewClientBuilder().WithScheme(newPublishScheme(g)).WithObjects(record, foreign).Build()
+
+	publish(g, c, record, nil)
+
+	var de externaldnsv1alpha1.DNSEndpoint
+	g.Expect(c.Get(context.Background(), client.ObjectKeyFromObject(record), &de)).To(Succeed())
+	g.Expect(de.Spec.Endpoints).To(BeEmpty())
+	g.Expect(de.OwnerReferences).To(BeEmpty())
+}
+
+func TestPublishDNSEndpointHandler_IgnoresAutoRecords(t *testing.T) {
+	g := NewWithT(t)
+	record := manualRecord(v1alpha2.DNSRecordEntry{FQDN: tFQDNA, Targets: []string{tIP1234}})
+	record.Spec.Origin = v1alpha2.DNSRecordOriginAuto
+	c := fake.NewClientBuilder().WithScheme(newPublishScheme(g)).WithObjects(record).Build()
+
+	publish(g, c, record, nil)
+
+	var list externaldnsv1alpha1.DNSEndpointList
+	g.Expect(c.List(context.Background(), &list)).To(Succeed())
+	g.Expect(list.Items).To(BeEmpty())
+}
+
+func TestPublishDNSEndpointHandler_LeavesDNSEndpointNotControlledByRecord(t *testing.T) {
+	g := NewWithT(t)
+	record := manualRecord(v1alpha2.DNSRecordEntry{FQDN: tFQDNA, Targets: []string{tIP1234}})
+	record.Annotations = map[string]string{adapter.PublishAnnotationKey: "false"}
+	labelled := &externaldnsv1alpha1.DNSEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: "manual", Namespace: tNsDefault},
+	}
+	adapter.SetStandardLabels(labelled, tPortalMain)
+	c := fake.NewClientBuilder().WithScheme(newPublishScheme(g)).WithObjects(record, labelled).Build()
+
+	// Neither the deletion nor the update touch it.
+	publish(g, c, record, nil)
+	record.Annotations = nil
+	publish(g, c, record, nil)
+
+	var de externaldnsv1alpha1.DNSEndpoint
+	g.Expect(c.Get(context.Background(), client.ObjectKeyFromObject(record), &de)).To(Succeed())
+	g.Expect(de.Spec.Endpoints).To(BeEmpty())
+	g.Expect(de.OwnerReferences).To(BeEmpty())
+}
+
+func TestUnpublishDNSEndpointsRunnable_DeletesPublishedDNSEndpoints(t *testing.T) {
+	g := NewWithT(t)
+	record := manualRecord(v1alpha2.DNSRecordEntry{FQDN: tFQDNA, Targets: []string{tIP1234}})
+	foreign := &externaldnsv1alpha1.DNSEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: "foreign", Namespace: tNsDefault},
+	}
+	c := fake.NewClientBuilder().WithScheme(newPublishScheme(g)).WithObjects(record, foreign).Build()
+	publish(g, c, record, nil)
+
+	g.Expect(chain.NewUnpublishDNSEndpointsRunnable(c, c).Start(context.Background())).To(Succeed())
+
+	var list externaldnsv1alpha1.DNSEndpointList
+	g.Expect(c.List(context.Background(), &list)).To(Succeed())
+	g.Expect(list.Items).To(HaveLen(1))
+	g.Expect(list.Items[0].Name).To(Equal("foreign"))
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	externaldnsv1alpha1 "sigs.k8s.io/external-dns/apis/v1alpha1"
+
+	"github.com/golgoth31/sreportal/internal/adapter"
+)
+
+// UnpublishDNSEndpointsRunnable is a manager.Runnable that, at startup when the
+// DNSEndpoint publisher is disabled, deletes the DNSEndpoints it published
+// earlier, so turning the publisher off also withdraws the records from DNS.
+// It lists through an uncached reader to avoid starting a DNSEndpoint informer.
+type UnpublishDNSEndpointsRunnable struct {
+	client client.Client
+	reader client.Reader
+}
+
+// NewUnpublishDNSEndpointsRunnable creates a new UnpublishDNSEndpointsRunnable.
+func NewUnpublishDNSEndpointsRunnable(c client.Client, reader client.Reader) *UnpublishDNSEndpointsRunnable {
+	return &UnpublishDNSEndpointsRunnable{client: c, reader: reader}
+}
+
+// Start implements manager.Runnable. It runs once at startup.
+func (r *UnpublishDNSEndpointsRunnable) Start(ctx context.Context) error {
+	log := ctrl.Log.WithName("unpublish-dnsendpoints")
+
+	var list externaldnsv1alpha1.DNSEndpointList
+	if err := r.reader.List(ctx, &list,
+		client.MatchingLabels{adapter.AppManagedByLabelKey: adapter.AppManagedByValue},
+		client.HasLabels{adapter.DNSRecordLabelKey},
+	); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+		return fmt.Errorf("list published DNSEndpoints: %w", err)
+	}
+	for i := range list.Items {
+		if err := r.client.Delete(ctx, &list.Items[i]); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("delete DNSEndpoint %s/%s: %w", list.Items[i].Namespace, list.Items[i].Name, err)
+		}
+	}
+	if len(list.Items) > 0 {
+		log.Info("publisher disabled, removed published DNSEndpoints", "count", len(list.Items))
+	}
+	return nil
+}
+
+// NeedLeaderElection returns true so this only runs on the leader.
+func (r *UnpublishDNSEndpointsRunnable) NeedLeaderElection() bool {
+	return true
+}
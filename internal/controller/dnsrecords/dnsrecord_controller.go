@@ -18,6 +18,7 @@ package dnsrecords
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -57,7 +58,11 @@ type DNSRecordReconciler struct {
 	Scheme     *runtime.Scheme
 	fqdnWriter domaindns.FQDNWriter
 	forcer     Forcer
-	chain      *reconciler.Chain[*v1alpha2.DNSRecord, dnsrecordchain.ChainData]
+	publisher  *dnsrecordchain.PublishDNSEndpointHandler
+	// originEvents, when set, lists the Events of the origin resources of
+	// endpoints that do not resolve to explain the failure.
+	originEvents client.Reader
+	chain        *reconciler.Chain[*v1alpha2.DNSRecord, dnsrecordchain.ChainData]
 }
 
 // NewDNSRecordReconciler creates a new DNSRecordReconciler.
@@ -84,19 +89,44 @@ func (r *DNSRecordReconciler) SetFQDNWriter(w domaindns.FQDNWriter) {
 // re-resolution on spec changes.
 func (r *DNSRecordReconciler) SetForcer(f Forcer) { r.forcer = f }
 
+// SetOriginCorrelation enables the correlation of endpoints that do not
+// resolve with the state and recent Warning Events of their origin Service or
+// Ingress, the Events being listed with events (an uncached reader), and
+// rebuilds the chain.
+func (r *DNSRecordReconciler) SetOriginCorrelation(events client.Reader) {
+	r.originEvents = events
+	r.rebuildChain()
+}
+
+// SetDNSEndpointPublisher enables the publication of manual DNSRecords as
+// external-dns DNSEndpoint CRs, restricted to groups (all when empty) and
+// labelled with labels, and rebuilds the chain.
+func (r *DNSRecordReconciler) SetDNSEndpointPublisher(groups []string, labels map[string]string) {
+	r.publisher = dnsrecordchain.NewPublishDNSEndpointHandler(r.Client, groups, labels)
+	r.rebuildChain()
+}
+
 func (r *DNSRecordReconciler) rebuildChain() {
-	r.chain = reconciler.NewChain(
-		"dnsrecord",
+	handlers := []reconciler.Handler[*v1alpha2.DNSRecord, dnsrecordchain.ChainData]{
 		dnsrecordchain.NewLoadDNSConfigHandler(r.Client),
 		dnsrecordchain.NewMaterialiseEntriesHandler(r.Client),
-		dnsrecordchain.NewProjectStoreHandler(r.fqdnWriter),
-	)
+	}
+	if r.originEvents != nil {
+		handlers = append(handlers, dnsrecordchain.NewCorrelateOriginHandler(r.Client, r.originEvents))
+	}
+	handlers = append(handlers, dnsrecordchain.NewProjectStoreHandler(r.fqdnWriter))
+	if r.publisher != nil {
+		handlers = append(handlers, r.publisher)
+	}
+	r.chain = reconciler.NewChain("dnsrecord", handlers...)
 }
 
 // +kubebuilder:rbac:groups=sreportal.io,resources=dnsrecords,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=list
 // +kubebuilder:rbac:groups=sreportal.io,resources=dnsrecords/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=sreportal.io,resources=portals,verbs=get;list;watch
 // +kubebuilder:rbac:groups=sreportal.io,resources=dns,verbs=get;list;watch
+// +kubebuilder:rbac:groups=externaldns.k8s.io,resources=dnsendpoints,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile loads the DNSRecord, handles the not-found / feature-disabled
 // short-circuits inline, then runs the chain to load DNS config, materialise
@@ -241,18 +271,43 @@ func syncStatusChangedPredicate() predicate.Predicate {
 	}
 }
 
-// syncStatusDiffers reports whether any endpoint's SyncStatus differs between
-// the two slices, keyed by (DNSName, RecordType) so reordering is ignored.
+// syncStatusDiffers reports whether any endpoint's SyncStatus (split-horizon
+// Internal/External status, lookup failure class or probe Availability, health,
+// HTTP status, TLS validity, certificate expiry and ownership conflict)
+// differs between the two slices, keyed by (DNSName, RecordType) so
+// reordering is ignored.
 func syncStatusDiffers(before, after []v1alpha2.EndpointStatus) bool {
 	if len(before) != len(after) {
 		return true
 	}
-	prev := make(map[string]v1alpha2.SyncStatus, len(before))
+	type statuses struct {
+		sync, internal, external v1alpha2.SyncStatus
+		lookupFailure            v1alpha2.LookupFailure
+		availability             v1alpha2.Availability
+		health                   v1alpha2.HealthStatus
+		httpStatusCode           int32
+		tlsValid                 string
+		tlsNotAfter              int64
+		ownershipConflict        bool
+	}
+	key := func(ep v1alpha2.EndpointStatus) statuses {
+		tls := ""
+		if ep.TLSValid != nil {
+			tls = strconv.FormatBool(*ep.TLSValid)
+		}
+		var notAfter int64
+		if ep.TLSNotAfter != nil {
+			notAfter = ep.TLSNotAfter.Unix()
+		}
+		return statuses{ep.SyncStatus, ep.InternalStatus, ep.ExternalStatus, ep.LookupFailure, ep.Availability,
+			ep.HealthStatus, ep.HTTPStatusCode, tls, notAfter, ep.OwnershipConflict != nil}
+	}
+	prev := make(map[string]statuses, len(before))
 	for _, ep := range before {
-		prev[ep.DNSName+"|"+ep.RecordType] = ep.SyncStatus
+		prev[ep.DNSName+"|"+ep.RecordType] = key(ep)
 	}
 	for _, ep := range after {
-		if prev[ep.DNSName+"|"+ep.RecordType] != ep.SyncStatus {
+		if prev[ep.DNSName+"|"+ep.RecordType] != key(ep) {
 			return true
 		}
 	}
@@ -18,25 +18,38 @@ package dnsresolve
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
 	dnschain "github.com/golgoth31/sreportal/internal/controller/dnsrecords/chain"
 	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	"github.com/golgoth31/sreportal/internal/metrics"
 )
 
 const (
-	lookupTimeout   = 2 * time.Second
-	maxConcurrent   = 10
-	resolveInterval = 24 * time.Hour
-	schedTick       = 1 * time.Minute
-	forceDebounce   = 5 * time.Second
+	lookupTimeout = 2 * time.Second
+	// maxConcurrent bounds the endpoint checks in flight across all records.
+	maxConcurrent = 10
+	// maxConcurrentRecords bounds the records resolved (and patched) at once.
+	maxConcurrentRecords = 4
+	resolveInterval      = 24 * time.Hour
+	// transientRetryInterval is when an endpoint whose lookup still failed
+	// transiently (SERVFAIL, timeout) after its retries is checked again,
+	// instead of waiting a full resolveInterval.
+	transientRetryInterval = 10 * time.Minute
+	schedTick              = 1 * time.Minute
+	forceDebounce          = 5 * time.Second
 )
 
 // Runnable resolves DNSRecord endpoints out-of-band (off the reconcile hot
@@ -47,11 +60,18 @@ const (
 type Runnable struct {
 	Client   client.Client
 	Resolver domaindns.Resolver
+	// ExternalResolver, when set, enables split-horizon resolution: each FQDN
+	// is also resolved through it and both views are recorded on the endpoint
+	// (InternalStatus from Resolver, ExternalStatus from ExternalResolver).
+	ExternalResolver domaindns.Resolver
 
 	sched   *scheduler
 	mu      sync.Mutex
 	forced  map[string]struct{}
 	forceCh chan struct{}
+	// lookups is the semaphore shared by every record's checks, so resolving
+	// records in parallel keeps at most maxConcurrent checks in flight.
+	lookups chan struct{}
 }
 
 // New creates a Runnable with an initialised scheduler.
@@ -62,6 +82,7 @@ func New(c client.Client, resolver domaindns.Resolver) *Runnable {
 		sched:    newScheduler(resolveInterval, time.Now, time.Now().UnixNano()),
 		forced:   map[string]struct{}{},
 		forceCh:  make(chan struct{}, 1),
+		lookups:  make(chan struct{}, maxConcurrent),
 	}
 }
 
@@ -87,6 +108,22 @@ func (r *Runnable) tick(ctx context.Context) error {
 	if err := r.Client.List(ctx, &list); err != nil {
 		return err
 	}
+	var dnsList v1alpha2.DNSList
+	if err := r.Client.List(ctx, &dnsList); err != nil {
+		return err
+	}
+	governing := make(map[string]*v1alpha2.DNS, len(list.Items))
+	intervals := map[string]time.Duration{}
+	for i := range list.Items {
+		rk := list.Items[i].Namespace + "/" + list.Items[i].Name
+		dns := dnschain.SelectGoverningDNS(dnsList.Items, &list.Items[i])
+		governing[rk] = dns
+		if dns != nil && dns.Spec.Resolution.Interval != nil {
+			intervals[rk] = dns.Spec.Resolution.Interval.Duration
+		}
+	}
+	// Intervals first, so the keys synced below are spread over their own.
+	r.sched.SetIntervals(intervals)
 	r.sched.Sync(listKeys(list.Items))
 
 	present := make(map[string]struct{}, len(list.Items))
@@ -126,30 +163,61 @@ func (r *Runnable) tick(ctx context.Context) error {
 	for _, k := range due {
 		byRecord[k.RecordKey] = append(byRecord[k.RecordKey], k)
 	}
+	// Records are resolved in parallel; their checks share the lookups
+	// semaphore, so a large record does not hold back the others.
+	recordSlots := make(chan struct{}, maxConcurrentRecords)
+	var wg sync.WaitGroup
 	for rk, keys := range byRecord {
 		rec := recordFromList(list.Items, rk)
 		if rec == nil {
 			logger.V(1).Info("due key has no matching record; skipping", "record", rk)
 			continue
 		}
-		// Honour spec.reconciliation.disableDNSCheck on the governing DNS CR
-		// (operators with no outbound DNS). Reschedule so we don't re-list every
-		// tick; a config change re-enqueues via the reconcile Force path.
-		if dnschain.DNSCheckDisabled(ctx, r.Client, rec) {
-			for _, k := range keys {
-				r.sched.Reschedule(k)
-			}
-			continue
-		}
-		if err := r.resolveRecord(ctx, rec, keys); err != nil {
-			logger.Error(err, "resolve record failed", "record", rk)
-			continue // schedule preserved -> retried next tick
-		}
+		dns := governing[rk]
+		recordSlots <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-recordSlots }()
+			r.resolveDue(ctx, rec, dns, keys)
+		})
+	}
+	wg.Wait()
+	return nil
+}
+
+// resolveDue resolves the due keys of one record, governed by dns (nil when
+// none), and reschedules them: endpoints whose lookup failed transiently, or
+// whose served targets just changed or oscillate, come back after
+// transientRetryInterval, the others after the full interval.
+func (r *Runnable) resolveDue(ctx context.Context, rec *v1alpha2.DNSRecord, dns *v1alpha2.DNS, keys []FQDNKey) {
+	logger := log.FromContext(ctx).WithName("dnsresolve")
+	rk := rec.Namespace + "/" + rec.Name
+	// Honour spec.reconciliation.disableDNSCheck on the governing DNS CR
+	// (operators with no outbound DNS). Reschedule so we don't re-list every
+	// tick; a config change re-enqueues via the reconcile Force path.
+	if dns != nil && dns.Spec.Reconciliation.DisableDNSCheck {
 		for _, k := range keys {
 			r.sched.Reschedule(k)
 		}
+		return
+	}
+	start := time.Now()
+	if err := r.resolveRecord(ctx, rec, keys, skippedEndpoints(rec, dns)); err != nil {
+		logger.Error(err, "resolve record failed", "record", rk)
+		return // schedule preserved -> retried next tick
+	}
+	transient := map[FQDNKey]bool{}
+	for _, ep := range rec.Status.Endpoints {
+		if domaindns.LookupFailure(ep.LookupFailure).Transient() || targetsUnsettled(ep, start) {
+			transient[FQDNKey{RecordKey: rk, DNSName: ep.DNSName, RecordType: ep.RecordType}] = true
+		}
+	}
+	for _, k := range keys {
+		if transient[k] {
+			r.sched.RescheduleIn(k, transientRetryInterval)
+			continue
+		}
+		r.sched.Reschedule(k)
 	}
-	return nil
 }
 
 // Start implements manager.Runnable: a steady tick (resolveInterval is spread
@@ -181,6 +249,22 @@ func (r *Runnable) Start(ctx context.Context) error {
 	}
 }
 
+// skippedEndpoints returns the indices of the endpoints of rec listed in a
+// group of dns spec.resolution.disabledGroups.
+func skippedEndpoints(rec *v1alpha2.DNSRecord, dns *v1alpha2.DNS) map[int]bool {
+	if dns == nil || len(dns.Spec.Resolution.DisabledGroups) == 0 {
+		return nil
+	}
+	skipped := map[int]bool{}
+	for i := range rec.Status.Endpoints {
+		groups := adapter.EndpointStatusGroupNamesV2(&rec.Status.Endpoints[i], &dns.Spec.GroupMapping)
+		if slices.ContainsFunc(groups, func(g string) bool { return slices.Contains(dns.Spec.Resolution.DisabledGroups, g) }) {
+			skipped[i] = true
+		}
+	}
+	return skipped
+}
+
 func listKeys(records []v1alpha2.DNSRecord) []FQDNKey {
 	var out []FQDNKey
 	for i := range records {
@@ -204,11 +288,14 @@ func recordFromList(records []v1alpha2.DNSRecord, recordKey string) *v1alpha2.DN
 var _ manager.Runnable = (*Runnable)(nil)
 
 // resolveRecord resolves the requested keys of rec (in parallel, bounded),
-// writes SyncStatus onto rec.Status.Endpoints (matched by DNSName+RecordType),
-// and patches the status subresource. A real change in SyncStatus re-triggers
+// writes SyncStatus, the TTL drift (and, with an ExternalResolver,
+// InternalStatus and ExternalStatus) onto rec.Status.Endpoints (matched by
+// DNSName+RecordType),
+// and patches the status subresource. The endpoints at the skipped indices
+// are not resolved: their previous result is cleared instead. A real change in SyncStatus re-triggers
 // the DNSRecord reconcile (via the SyncStatus predicate), which re-projects to
 // the read store; an unchanged result yields a no-op patch (no reconcile).
-func (r *Runnable) resolveRecord(ctx context.Context, rec *v1alpha2.DNSRecord, keys []FQDNKey) error {
+func (r *Runnable) resolveRecord(ctx context.Context, rec *v1alpha2.DNSRecord, keys []FQDNKey, skipped map[int]bool) error {
 	logger := log.FromContext(ctx).WithName("dnsresolve")
 	want := make(map[FQDNKey]struct{}, len(keys))
 	for _, k := range keys {
@@ -228,31 +315,42 @@ func (r *Runnable) resolveRecord(ctx context.Context, rec *v1alpha2.DNSRecord, k
 	}
 
 	base := rec.DeepCopy()
+	indices = slices.DeleteFunc(indices, func(i int) bool {
+		if !skipped[i] {
+			return false
+		}
+		ep := &rec.Status.Endpoints[i]
+		ep.SyncStatus, ep.LookupFailure, ep.InternalStatus, ep.ExternalStatus = "", "", "", ""
+		ep.ServedTTL, ep.TTLDrift = 0, false
+		return true
+	})
 
-	// Resolve in parallel with a bounded worker pool. Each goroutine writes only
-	// its own endpoint index, so concurrent writes to the slice are race-free.
-	idxCh := make(chan int, len(indices))
-	for _, i := range indices {
-		idxCh <- i
-	}
-	close(idxCh)
-	workers := min(maxConcurrent, len(indices))
+	// Resolve in parallel, bounded by the lookups semaphore shared with the
+	// other records. Each goroutine writes only its own endpoint index, so
+	// concurrent writes to the slice are race-free.
+	lookups := r.lookupSlots()
 	var wg sync.WaitGroup
-	for range workers {
+	for _, i := range indices {
+		lookups <- struct{}{}
 		wg.Go(func() {
-			for i := range idxCh {
-				ep := &rec.Status.Endpoints[i]
-				lc, cancel := context.WithTimeout(ctx, lookupTimeout)
-				res := domaindns.CheckFQDN(lc, r.Resolver, ep.DNSName, ep.RecordType, ep.Targets)
-				cancel()
-				ep.SyncStatus = v1alpha2.SyncStatus(res.Status)
-				if res.Err != nil {
-					// NotAvailable collapses timeout/NXDOMAIN/network; the underlying
-					// error distinguishes a missing record from a DNS outage.
-					logger.V(1).Info("DNS resolution failed",
-						"fqdn", ep.DNSName, "recordType", ep.RecordType,
-						"status", string(res.Status), "err", res.Err.Error())
-				}
+			defer func() { <-lookups }()
+			ep := &rec.Status.Endpoints[i]
+			res := r.check(ctx, r.Resolver, ep)
+			ep.SyncStatus = v1alpha2.SyncStatus(res.Status)
+			ep.LookupFailure = v1alpha2.LookupFailure(res.Failure)
+			r.checkTTL(ctx, ep, res)
+			r.trackTargets(ctx, ep, res)
+			ep.InternalStatus, ep.ExternalStatus = "", ""
+			if r.ExternalResolver != nil {
+				ext := r.check(ctx, r.ExternalResolver, ep)
+				ep.InternalStatus = ep.SyncStatus
+				ep.ExternalStatus = v1alpha2.SyncStatus(ext.Status)
+			}
+			if res.Err != nil || res.Failure != domaindns.LookupFailureNone {
+				metrics.DNSLookupFailuresTotal.WithLabelValues(string(res.Failure), ep.RecordType).Inc()
+				logger.V(1).Info("DNS resolution failed",
+					"fqdn", ep.DNSName, "recordType", ep.RecordType,
+					"status", string(res.Status), "failure", string(res.Failure), "err", errString(res.Err))
 			}
 		})
 	}
@@ -263,3 +361,122 @@ func (r *Runnable) resolveRecord(ctx context.Context, rec *v1alpha2.DNSRecord, k
 	}
 	return nil
 }
+
+// check resolves a single endpoint through resolver, retrying transient
+// failures under the retry policy of its record type; each attempt is bounded
+// by lookupTimeout.
+func (r *Runnable) check(ctx context.Context, resolver domaindns.Resolver, ep *v1alpha2.EndpointStatus) *domaindns.CheckResult {
+	return domaindns.CheckFQDNWithRetry(ctx, resolver, ep.DNSName, ep.RecordType, ep.Targets, lookupTimeout)
+}
+
+// checkTTL records on ep whether the TTL served for it drifted from the
+// declared one, and the served TTL while it does. It only runs for in-sync
+// endpoints with a declared TTL, through a Resolver that reports TTLs; the
+// previous result is cleared otherwise, or when the TTL lookup fails.
+//
+// A recursive resolver counts the TTL of its cached answer down, so the
+// served TTL changes on almost every check: it is only written when a drift
+// starts, keeping the status unchanged, and its patch a no-op, while the
+// drift lasts. For the same reason only a served TTL above the declared one
+// can be detected.
+func (r *Runnable) checkTTL(ctx context.Context, ep *v1alpha2.EndpointStatus, res *domaindns.CheckResult) {
+	wasDrifting := ep.TTLDrift
+	previous := ep.ServedTTL
+	ep.ServedTTL, ep.TTLDrift = 0, false
+	tr, ok := r.Resolver.(domaindns.TTLResolver)
+	if !ok || ep.TTL <= 0 || res.Status != domaindns.SyncStatusSync {
+		return
+	}
+	lookupCtx, cancel := context.WithTimeout(ctx, lookupTimeout)
+	defer cancel()
+	served, err := tr.LookupTTL(lookupCtx, ep.DNSName, ep.RecordType)
+	if err != nil {
+		if !errors.Is(err, domaindns.ErrTTLUnavailable) {
+			log.FromContext(ctx).WithName("dnsresolve").V(1).Info("TTL lookup failed",
+				"fqdn", ep.DNSName, "recordType", ep.RecordType, "err", err.Error())
+		}
+		return
+	}
+	if !domaindns.TTLDrifted(ep.TTL, int64(served)) {
+		return
+	}
+	ep.TTLDrift = true
+	if wasDrifting && previous > 0 {
+		ep.ServedTTL = previous
+		return
+	}
+	ep.ServedTTL = int64(served)
+	metrics.DNSTTLDriftsTotal.WithLabelValues(ep.RecordType).Inc()
+	log.FromContext(ctx).WithName("dnsresolve").Info("served TTL exceeds declared TTL",
+		"fqdn", ep.DNSName, "recordType", ep.RecordType, "declared", ep.TTL, "served", ep.ServedTTL)
+}
+
+// trackTargets records the targets served for ep on its target changes, and
+// flags an ownership conflict when they oscillate between sets. A lookup
+// without answers leaves the history as it is.
+func (r *Runnable) trackTargets(ctx context.Context, ep *v1alpha2.EndpointStatus, res *domaindns.CheckResult) {
+	if res.Status == domaindns.SyncStatusNotAvailable || len(res.ResolvedTargets) == 0 {
+		return
+	}
+	served := make([]string, 0, len(res.ResolvedTargets))
+	for _, t := range res.ResolvedTargets {
+		served = append(served, strings.ToLower(strings.TrimSuffix(t, ".")))
+	}
+	now := metav1.Now()
+	history := make([]domaindns.TargetChange, 0, len(ep.TargetChanges))
+	for _, c := range ep.TargetChanges {
+		history = append(history, domaindns.TargetChange{Targets: c.Targets, At: c.At.Time})
+	}
+	history = domaindns.ObserveTargets(history, served, now.Time)
+	ep.TargetChanges = make([]v1alpha2.TargetChange, 0, len(history))
+	for _, c := range history {
+		ep.TargetChanges = append(ep.TargetChanges, v1alpha2.TargetChange{Targets: c.Targets, At: metav1.NewTime(c.At)})
+	}
+
+	sets := domaindns.AlternatingTargets(history)
+	if sets == nil {
+		ep.OwnershipConflict = nil
+		return
+	}
+	conflict := &v1alpha2.OwnershipConflict{DetectedAt: now}
+	if ep.OwnershipConflict != nil {
+		conflict.DetectedAt = ep.OwnershipConflict.DetectedAt
+	} else {
+		metrics.DNSOwnershipConflictsTotal.WithLabelValues(ep.RecordType).Inc()
+		log.FromContext(ctx).WithName("dnsresolve").Info("served targets oscillate between sets, several external-dns deployments may manage the record",
+			"fqdn", ep.DNSName, "recordType", ep.RecordType, "targetSets", sets)
+	}
+	for _, set := range sets {
+		conflict.TargetSets = append(conflict.TargetSets, v1alpha2.TargetSet{Targets: set})
+	}
+	ep.OwnershipConflict = conflict
+}
+
+// targetsUnsettled reports whether the served targets of ep changed since
+// start, or oscillate: it is resolved again soon, so that an oscillation is
+// observed within domaindns.TargetChangeWindow.
+func targetsUnsettled(ep v1alpha2.EndpointStatus, start time.Time) bool {
+	if ep.OwnershipConflict != nil {
+		return true
+	}
+	n := len(ep.TargetChanges)
+	return n > 1 && !ep.TargetChanges[n-1].At.Time.Before(start.Truncate(time.Second))
+}
+
+// lookupSlots returns the lookups semaphore, creating it for a Runnable that
+// was not built with New.
+func (r *Runnable) lookupSlots() chan struct{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.lookups == nil {
+		r.lookups = make(chan struct{}, maxConcurrent)
+	}
+	return r.lookups
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
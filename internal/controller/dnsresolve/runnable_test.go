@@ -18,7 +18,9 @@ package dnsresolve
 
 import (
 	"context"
+	"net"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -41,6 +43,20 @@ type stubResolver struct{ addrs []string }
 func (s stubResolver) LookupHost(context.Context, string) ([]string, error) { return s.addrs, nil }
 func (s stubResolver) LookupCNAME(context.Context, string) (string, error)  { return "", nil }
 
+// failingResolver fails every lookup with err.
+type failingResolver struct{ err error }
+
+func (f failingResolver) LookupHost(context.Context, string) ([]string, error) { return nil, f.err }
+func (f failingResolver) LookupCNAME(context.Context, string) (string, error)  { return "", f.err }
+
+// ttlResolver resolves like stubResolver and serves every record with ttl.
+type ttlResolver struct {
+	stubResolver
+	ttl uint32
+}
+
+func (s ttlResolver) LookupTTL(context.Context, string, string) (uint32, error) { return s.ttl, nil }
+
 func recordWithEndpoint() *v1alpha2.DNSRecord {
 	return &v1alpha2.DNSRecord{
 		ObjectMeta: metav1.ObjectMeta{Name: "r", Namespace: "ns"},
@@ -69,13 +85,118 @@ func TestResolveRecord_SetsSyncStatus(t *testing.T) {
 	r := &Runnable{Client: c, Resolver: stubResolver{addrs: []string{testTargetIP}}}
 	require.NoError(t, r.resolveRecord(context.Background(), rec, []FQDNKey{
 		{RecordKey: "ns/r", DNSName: testFQDN, RecordType: "A"},
-	}))
+	}, nil))
 
 	var got v1alpha2.DNSRecord
 	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(rec), &got))
 	require.Equal(t, v1alpha2.SyncStatus(domaindns.SyncStatusSync), got.Status.Endpoints[0].SyncStatus)
 }
 
+// TestResolveRecord_SplitHorizon verifies that with an ExternalResolver both
+// views are recorded, SyncStatus keeps reflecting the cluster view, and a
+// drift between them is visible.
+func TestResolveRecord_SplitHorizon(t *testing.T) {
+	rec := recordWithEndpoint()
+	c := newTestClient(t, rec)
+
+	r := &Runnable{
+		Client:           c,
+		Resolver:         stubResolver{addrs: []string{testTargetIP}},
+		ExternalResolver: stubResolver{addrs: []string{"203.0.113.7"}},
+	}
+	require.NoError(t, r.resolveRecord(context.Background(), rec, []FQDNKey{
+		{RecordKey: "ns/r", DNSName: testFQDN, RecordType: "A"},
+	}, nil))
+
+	var got v1alpha2.DNSRecord
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(rec), &got))
+	ep := got.Status.Endpoints[0]
+	require.Equal(t, v1alpha2.SyncStatus(domaindns.SyncStatusSync), ep.SyncStatus)
+	require.Equal(t, v1alpha2.SyncStatus(domaindns.SyncStatusSync), ep.InternalStatus)
+	require.Equal(t, v1alpha2.SyncStatus(domaindns.SyncStatusNotSync), ep.ExternalStatus)
+}
+
+// TestResolveRecord_TTLDrift verifies a served TTL exceeding the declared one
+// is flagged, and only recorded then.
+func TestResolveRecord_TTLDrift(t *testing.T) {
+	tests := []struct {
+		name      string
+		declared  int64
+		served    uint32
+		wantTTL   int64
+		wantDrift bool
+	}{
+		{name: "matches", declared: 300, served: 280},
+		{name: "drifts", declared: 60, served: 3600, wantTTL: 3600, wantDrift: true},
+		{name: "no declared TTL", declared: 0, served: 3600},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := recordWithEndpoint()
+			rec.Status.Endpoints[0].TTL = tt.declared
+			c := newTestClient(t, rec)
+
+			r := &Runnable{Client: c, Resolver: ttlResolver{stubResolver: stubResolver{addrs: []string{testTargetIP}}, ttl: tt.served}}
+			require.NoError(t, r.resolveRecord(context.Background(), rec, []FQDNKey{
+				{RecordKey: "ns/r", DNSName: testFQDN, RecordType: "A"},
+			}, nil))
+
+			var got v1alpha2.DNSRecord
+			require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(rec), &got))
+			require.Equal(t, tt.wantTTL, got.Status.Endpoints[0].ServedTTL)
+			require.Equal(t, tt.wantDrift, got.Status.Endpoints[0].TTLDrift)
+		})
+	}
+}
+
+// TestResolveRecord_TTLDriftKeepsTheServedTTL verifies the served TTL counted
+// down by a recursive resolver does not rewrite the status while the drift
+// lasts.
+func TestResolveRecord_TTLDriftKeepsTheServedTTL(t *testing.T) {
+	rec := recordWithEndpoint()
+	rec.Status.Endpoints[0].TTL = 60
+	c := newTestClient(t, rec)
+	keys := []FQDNKey{{RecordKey: "ns/r", DNSName: testFQDN, RecordType: "A"}}
+
+	var got v1alpha2.DNSRecord
+	for _, served := range []uint32{3600, 3590} {
+		r := &Runnable{Client: c, Resolver: ttlResolver{stubResolver: stubResolver{addrs: []string{testTargetIP}}, ttl: served}}
+		require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(rec), &got))
+		require.NoError(t, r.resolveRecord(context.Background(), &got, keys, nil))
+	}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(rec), &got))
+	require.True(t, got.Status.Endpoints[0].TTLDrift)
+	require.Equal(t, int64(3600), got.Status.Endpoints[0].ServedTTL, "the TTL served when the drift started is kept")
+}
+
+// TestResolveRecord_OwnershipConflict verifies the served targets are
+// tracked, whatever the declared ones, and an A→B→A→B oscillation is
+// reported as an ownership conflict.
+func TestResolveRecord_OwnershipConflict(t *testing.T) {
+	rec := recordWithEndpoint()
+	c := newTestClient(t, rec)
+	r := &Runnable{Client: c}
+	keys := []FQDNKey{{RecordKey: "ns/r", DNSName: testFQDN, RecordType: "A"}}
+
+	for i, served := range []string{testTargetIP, "10.0.0.2", testTargetIP, "10.0.0.2"} {
+		r.Resolver = stubResolver{addrs: []string{served}}
+		require.NoError(t, r.resolveRecord(context.Background(), rec, keys, nil))
+		require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(rec), rec))
+		ep := rec.Status.Endpoints[0]
+		require.Len(t, ep.TargetChanges, i+1)
+		require.Equal(t, []string{served}, ep.TargetChanges[i].Targets)
+		if i < 3 {
+			require.Nil(t, ep.OwnershipConflict)
+		}
+	}
+
+	conflict := rec.Status.Endpoints[0].OwnershipConflict
+	require.NotNil(t, conflict)
+	require.Equal(t, []v1alpha2.TargetSet{
+		{Targets: []string{testTargetIP}}, {Targets: []string{"10.0.0.2"}},
+	}, conflict.TargetSets)
+}
+
 // TestRunnable_ForceThenTickResolves verifies a forced record is resolved on the
 // next tick and its status patched.
 func TestRunnable_ForceThenTickResolves(t *testing.T) {
@@ -143,3 +264,81 @@ func TestRunnable_HonorsDisableDNSCheck(t *testing.T) {
 	require.Empty(t, string(got.Status.Endpoints[0].SyncStatus),
 		"resolution must be skipped when disableDNSCheck is set")
 }
+
+// TestRunnable_SkipsDisabledGroups verifies that the endpoints of a group
+// listed in spec.resolution.disabledGroups are not resolved, their previous
+// result is cleared, and the record follows spec.resolution.interval.
+func TestRunnable_SkipsDisabledGroups(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha2.AddToScheme(scheme))
+
+	dns := &v1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: "ns"},
+		Spec: v1alpha2.DNSSpec{
+			PortalRef:    "p",
+			GroupMapping: v1alpha2.GroupMappingSpec{DefaultGroup: "airgap"},
+			Resolution: v1alpha2.ResolutionSpec{
+				Interval:       &metav1.Duration{Duration: time.Hour},
+				DisabledGroups: []string{"airgap"},
+			},
+		},
+	}
+	rec := recordWithEndpoint()
+	rec.Status.Endpoints[0].SyncStatus = v1alpha2.SyncStatus(domaindns.SyncStatusNotSync)
+	rec.Status.Endpoints[0].LookupFailure = v1alpha2.LookupFailureServFail
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithStatusSubresource(&v1alpha2.DNSRecord{}).
+		WithObjects(dns, rec).Build()
+	r := New(c, stubResolver{addrs: []string{testTargetIP}})
+
+	r.Force("ns/r")
+	require.NoError(t, r.tick(context.Background()))
+
+	var got v1alpha2.DNSRecord
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(rec), &got))
+	ep := got.Status.Endpoints[0]
+	require.Empty(t, string(ep.SyncStatus), "a disabled group must not be resolved")
+	require.Empty(t, string(ep.LookupFailure))
+
+	k := FQDNKey{RecordKey: "ns/r", DNSName: testFQDN, RecordType: "A"}
+	require.Empty(t, r.sched.Due(time.Now().Add(59*time.Minute)))
+	require.Equal(t, []FQDNKey{k}, r.sched.Due(time.Now().Add(time.Hour+time.Minute)))
+}
+
+// TestRunnable_TransientFailureRetriedSooner verifies that a SERVFAIL is stored
+// as the endpoint's lookupFailure and that the endpoint is rescheduled after
+// transientRetryInterval instead of the full resolveInterval.
+func TestRunnable_TransientFailureRetriedSooner(t *testing.T) {
+	rec := recordWithEndpoint()
+	c := newTestClient(t, rec)
+	r := New(c, failingResolver{err: &net.DNSError{Err: "server misbehaving", IsTemporary: true}})
+
+	r.Force("ns/r")
+	require.NoError(t, r.tick(context.Background()))
+
+	var got v1alpha2.DNSRecord
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(rec), &got))
+	ep := got.Status.Endpoints[0]
+	require.Equal(t, v1alpha2.SyncStatus(domaindns.SyncStatusNotAvailable), ep.SyncStatus)
+	require.Equal(t, v1alpha2.LookupFailureServFail, ep.LookupFailure)
+
+	k := FQDNKey{RecordKey: "ns/r", DNSName: testFQDN, RecordType: "A"}
+	require.Empty(t, r.sched.Due(time.Now().Add(transientRetryInterval-time.Minute)))
+	require.Equal(t, []FQDNKey{k}, r.sched.Due(time.Now().Add(transientRetryInterval)))
+}
+
+// TestRunnable_NXDomainNotRetriedSooner verifies that a missing record waits
+// for the full interval: retrying it would not change the answer.
+func TestRunnable_NXDomainNotRetriedSooner(t *testing.T) {
+	rec := recordWithEndpoint()
+	c := newTestClient(t, rec)
+	r := New(c, failingResolver{err: &net.DNSError{Err: "no such host", IsNotFound: true}})
+
+	r.Force("ns/r")
+	require.NoError(t, r.tick(context.Background()))
+
+	var got v1alpha2.DNSRecord
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(rec), &got))
+	require.Equal(t, v1alpha2.LookupFailureNXDomain, got.Status.Endpoints[0].LookupFailure)
+	require.Empty(t, r.sched.Due(time.Now().Add(transientRetryInterval)))
+}
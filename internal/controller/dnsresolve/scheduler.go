@@ -43,6 +43,9 @@ type scheduler struct {
 	now      func() time.Time
 	rng      *rand.Rand
 	next     map[FQDNKey]time.Time
+	// intervals overrides interval per record key (spec.resolution.interval
+	// of the governing DNS CR).
+	intervals map[string]time.Duration
 }
 
 func newScheduler(interval time.Duration, now func() time.Time, seed int64) *scheduler { //nolint:unparam // interval is a deliberate knob (tests + future config); prod passes resolveInterval
@@ -54,8 +57,37 @@ func newScheduler(interval time.Duration, now func() time.Time, seed int64) *sch
 	}
 }
 
+// SetIntervals replaces the per-record interval overrides. Keys of a record
+// whose interval shrank and that are due later than now+interval are spread
+// again across (now, now+interval], so a shorter interval applies at once.
+func (s *scheduler) SetIntervals(intervals map[string]time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.intervals = intervals
+	now := s.now()
+	for k, n := range s.next {
+		interval := s.intervalLocked(k.RecordKey)
+		if n.After(now.Add(interval)) {
+			s.next[k] = now.Add(s.jitterLocked(interval))
+		}
+	}
+}
+
+// intervalLocked returns the interval of a record.
+func (s *scheduler) intervalLocked(recordKey string) time.Duration {
+	if d, ok := s.intervals[recordKey]; ok && d > 0 {
+		return d
+	}
+	return s.interval
+}
+
+// jitterLocked returns a random delay in [1, interval].
+func (s *scheduler) jitterLocked(interval time.Duration) time.Duration {
+	return time.Duration(1 + s.rng.Int63n(int64(interval)))
+}
+
 // Sync reconciles tracked keys with the desired set: new keys get a jittered
-// nextCheck in (now, now+interval]; removed keys are dropped.
+// nextCheck in (now, now+interval of their record]; removed keys are dropped.
 func (s *scheduler) Sync(keys []FQDNKey) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -65,8 +97,7 @@ func (s *scheduler) Sync(keys []FQDNKey) {
 		desired[k] = struct{}{}
 		if _, ok := s.next[k]; !ok {
 			// jitter in [1, interval] — strictly after now, at most now+interval
-			jitter := time.Duration(1 + s.rng.Int63n(int64(s.interval)))
-			s.next[k] = now.Add(jitter)
+			s.next[k] = now.Add(s.jitterLocked(s.intervalLocked(k.RecordKey)))
 		}
 	}
 	for k := range s.next {
@@ -89,13 +120,24 @@ func (s *scheduler) Due(t time.Time) []FQDNKey {
 	return out
 }
 
-// Reschedule pushes a key's next check to now+interval+1ns (strictly after
-// now+interval so that Due(now+interval) does not return this key).
+// Reschedule pushes a key's next check to now+interval+1ns, with the interval
+// of its record (strictly after now+interval so that Due(now+interval) does
+// not return this key).
 func (s *scheduler) Reschedule(k FQDNKey) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if _, ok := s.next[k]; ok {
-		s.next[k] = s.now().Add(s.interval + time.Nanosecond)
+		s.next[k] = s.now().Add(s.intervalLocked(k.RecordKey) + time.Nanosecond)
+	}
+}
+
+// RescheduleIn sets a key's next check to now+d. It is used to come back to
+// an endpoint sooner than the interval after a transient lookup failure.
+func (s *scheduler) RescheduleIn(k FQDNKey, d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.next[k]; ok {
+		s.next[k] = s.now().Add(d)
 	}
 }
 
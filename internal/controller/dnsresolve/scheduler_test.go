@@ -55,6 +55,42 @@ func TestScheduler_RescheduleMovesNextOut(t *testing.T) {
 	}
 }
 
+func TestScheduler_SetIntervalsOverridesRecordInterval(t *testing.T) {
+	base := time.Unix(1_000_000, 0)
+	s := newScheduler(24*time.Hour, func() time.Time { return base }, 1)
+	fast := tk("ns/fast", "a.example.com")
+	slow := tk("ns/slow", "b.example.com")
+	s.Sync([]FQDNKey{fast, slow})
+
+	// Keys already scheduled beyond the shorter interval are pulled in.
+	s.SetIntervals(map[string]time.Duration{"ns/fast": time.Hour})
+	if due := s.Due(base.Add(time.Hour)); len(due) != 1 || due[0] != fast {
+		t.Fatalf("expected only the overridden key due by +1h, got %v", due)
+	}
+
+	s.Reschedule(fast)
+	if due := s.Due(base.Add(time.Hour)); len(due) != 0 {
+		t.Fatalf("rescheduled key must not be due before base+1h, got %v", due)
+	}
+	if due := s.Due(base.Add(2 * time.Hour)); len(due) != 1 {
+		t.Fatalf("rescheduled key must be due by base+2h, got %v", due)
+	}
+}
+
+func TestScheduler_RescheduleInSetsNextCheck(t *testing.T) {
+	base := time.Unix(1_000_000, 0)
+	s := newScheduler(24*time.Hour, func() time.Time { return base }, 1)
+	k := tk("ns/r", "a.example.com")
+	s.Sync([]FQDNKey{k})
+	s.RescheduleIn(k, 10*time.Minute)
+	if due := s.Due(base.Add(9 * time.Minute)); len(due) != 0 {
+		t.Fatalf("key must not be due before base+10m, got %d", len(due))
+	}
+	if due := s.Due(base.Add(10 * time.Minute)); len(due) != 1 {
+		t.Fatalf("key must be due at base+10m, got %d", len(due))
+	}
+}
+
 func TestScheduler_ForceRecordMakesAllRecordKeysDue(t *testing.T) {
 	base := time.Unix(1_000_000, 0)
 	s := newScheduler(24*time.Hour, func() time.Time { return base }, 1)
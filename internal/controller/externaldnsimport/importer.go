@@ -0,0 +1,278 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externaldnsimport
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	externaldnsv1alpha1 "sigs.k8s.io/external-dns/apis/v1alpha1"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	"github.com/golgoth31/sreportal/internal/mainportal"
+)
+
+const (
+	// ImportedAnnotationKey marks a Portal whose external-dns import ran, with
+	// the time of the import. Remove it to run the import again.
+	ImportedAnnotationKey = "sreportal.io/external-dns-imported"
+
+	// recordNameSuffix is appended to the portal name to name the manual
+	// DNSRecord holding the imported entries.
+	recordNameSuffix = "-external-dns-import"
+
+	defaultInterval = time.Minute
+)
+
+// +kubebuilder:rbac:groups=sreportal.io,resources=portals,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=sreportal.io,resources=dnsrecords,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=externaldns.k8s.io,resources=dnsendpoints,verbs=get;list;watch
+
+// Importer pre-populates each local portal, once, with the FQDNs of the
+// DNSEndpoint CRs external-dns already manages, to ease adoption in clusters
+// where external-dns has run for a long time. The entries are written to a
+// manual DNSRecord, so their groups and descriptions can be edited afterwards;
+// the TXT ownership record external-dns keeps for each FQDN, when found, is
+// used to describe where the FQDN comes from.
+//
+// A portal is imported on the first check after it appears, then flagged with
+// the ImportedAnnotationKey annotation and left alone.
+type Importer struct {
+	Client client.Client
+	// Reader lists DNSEndpoints without starting an informer for a one-off read.
+	Reader client.Reader
+	// Resolver looks up the external-dns TXT ownership records. Nil skips them.
+	Resolver TXTResolver
+	// TXTPrefix is the --txt-prefix external-dns runs with.
+	TXTPrefix string
+	Interval  time.Duration
+}
+
+// New creates an Importer checking for portals to import every minute.
+func New(c client.Client, reader client.Reader, resolver TXTResolver, txtPrefix string) *Importer {
+	return &Importer{Client: c, Reader: reader, Resolver: resolver, TXTPrefix: txtPrefix, Interval: defaultInterval}
+}
+
+var _ manager.Runnable = (*Importer)(nil)
+
+// Start implements manager.Runnable.
+func (i *Importer) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("external-dns-import")
+	ctx = log.IntoContext(ctx, logger)
+
+	ticker := time.NewTicker(i.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := i.tick(ctx); err != nil {
+			logger.Error(err, "external-dns import failed")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (i *Importer) tick(ctx context.Context) error {
+	var portals sreportalv1alpha1.PortalList
+	if err := i.Client.List(ctx, &portals); err != nil {
+		return fmt.Errorf("list portals: %w", err)
+	}
+
+	var pending, all []*sreportalv1alpha1.Portal
+	known := make(map[string]bool, len(portals.Items))
+	for idx := range portals.Items {
+		p := &portals.Items[idx]
+		known[p.Name] = true
+		all = append(all, p)
+		if p.Spec.Remote != nil || p.Annotations[ImportedAnnotationKey] != "" {
+			continue
+		}
+		pending = append(pending, p)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	mainPortal := ""
+	if p := mainportal.Elect(all); p != nil {
+		mainPortal = p.Name
+	}
+
+	var endpoints externaldnsv1alpha1.DNSEndpointList
+	if err := i.Reader.List(ctx, &endpoints); err != nil && !meta.IsNoMatchError(err) {
+		return fmt.Errorf("list dnsendpoints: %w", err)
+	}
+	entries := i.entriesByPortal(ctx, endpoints.Items, known, mainPortal)
+
+	for _, p := range pending {
+		if err := i.importPortal(ctx, p, entries[p.Name]); err != nil {
+			return fmt.Errorf("import portal %s/%s: %w", p.Namespace, p.Name, err)
+		}
+	}
+	return nil
+}
+
+// importPortal writes the portal's imported entries, if any, then flags the
+// portal as imported. An existing import record is left untouched: it may
+// have been edited since.
+func (i *Importer) importPortal(ctx context.Context, p *sreportalv1alpha1.Portal, entries []v1alpha2.DNSRecordEntry) error {
+	logger := log.FromContext(ctx)
+
+	if len(entries) > 0 {
+		record := &v1alpha2.DNSRecord{
+			ObjectMeta: metav1.ObjectMeta{Name: p.Name + recordNameSuffix, Namespace: p.Namespace},
+			Spec: v1alpha2.DNSRecordSpec{
+				Origin:    v1alpha2.DNSRecordOriginManual,
+				PortalRef: p.Name,
+				Entries:   entries,
+			},
+		}
+		adapter.SetStandardLabels(record, p.Name)
+		// The entries come from DNSEndpoints external-dns already manages:
+		// publishing them back would duplicate them.
+		record.Annotations = map[string]string{adapter.PublishAnnotationKey: "false"}
+		if err := i.Client.Create(ctx, record); err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("create dnsrecord: %w", err)
+		}
+	}
+
+	patch := client.MergeFrom(p.DeepCopy())
+	if p.Annotations == nil {
+		p.Annotations = map[string]string{}
+	}
+	p.Annotations[ImportedAnnotationKey] = time.Now().UTC().Format(time.RFC3339)
+	if err := i.Client.Patch(ctx, p, patch); err != nil {
+		return fmt.Errorf("flag portal: %w", err)
+	}
+
+	logger.Info("imported external-dns entries", "portal", p.Namespace+"/"+p.Name, "entries", len(entries))
+	return nil
+}
+
+// entriesByPortal converts DNSEndpoint endpoints into DNSRecord entries, keyed
+// by the portal they are routed to: the portal named by the sreportal.io/portal
+// annotation when it exists, else the main portal. Endpoints the DNSRecord
+// CRD would reject are skipped.
+func (i *Importer) entriesByPortal(ctx context.Context, items []externaldnsv1alpha1.DNSEndpoint, known map[string]bool, mainPortal string) map[string][]v1alpha2.DNSRecordEntry {
+	byPortal := make(map[string]map[string]*v1alpha2.DNSRecordEntry)
+
+	for _, item := range items {
+		// Published from a manual DNSRecord: its entries are already listed.
+		if _, published := item.Labels[adapter.DNSRecordLabelKey]; published {
+			continue
+		}
+		portal := item.Annotations[adapter.PortalAnnotationKey]
+		if !known[portal] {
+			portal = mainPortal
+		}
+		if portal == "" {
+			continue
+		}
+		if byPortal[portal] == nil {
+			byPortal[portal] = make(map[string]*v1alpha2.DNSRecordEntry)
+		}
+
+		for _, ep := range item.Spec.Endpoints {
+			if ep == nil {
+				continue
+			}
+			name := strings.ToLower(strings.TrimSuffix(ep.DNSName, "."))
+			recordType := ep.RecordType
+			if recordType == "" {
+				recordType = "A"
+			}
+			if !domaindns.ValidFQDN(name) || !domaindns.ValidRecordType(recordType) {
+				continue
+			}
+
+			key := name + "/" + recordType
+			if existing, ok := byPortal[portal][key]; ok {
+				for _, t := range ep.Targets {
+					if !slices.Contains(existing.Targets, t) {
+						existing.Targets = append(existing.Targets, t)
+					}
+				}
+				continue
+			}
+			byPortal[portal][key] = i.entry(ctx, &item, name, recordType, ep.Targets)
+		}
+	}
+
+	out := make(map[string][]v1alpha2.DNSRecordEntry, len(byPortal))
+	for portal, entries := range byPortal {
+		list := make([]v1alpha2.DNSRecordEntry, 0, len(entries))
+		for _, e := range entries {
+			slices.Sort(e.Targets)
+			list = append(list, *e)
+		}
+		slices.SortFunc(list, func(a, b v1alpha2.DNSRecordEntry) int {
+			if c := strings.Compare(a.FQDN, b.FQDN); c != 0 {
+				return c
+			}
+			return strings.Compare(a.RecordType, b.RecordType)
+		})
+		out[portal] = list
+	}
+	return out
+}
+
+// entry builds the imported entry of an endpoint. Its groups come from the
+// sreportal.io/groups annotation of the DNSEndpoint, else from the namespace
+// of the resource external-dns records as the owner, else from the
+// namespace of the DNSEndpoint.
+func (i *Importer) entry(ctx context.Context, item *externaldnsv1alpha1.DNSEndpoint, name, recordType string, targets []string) *v1alpha2.DNSRecordEntry {
+	e := &v1alpha2.DNSRecordEntry{
+		FQDN:        name,
+		RecordType:  recordType,
+		Targets:     slices.Clone(targets),
+		Groups:      domaindns.SplitGroups(item.Annotations[adapter.GroupsAnnotationKey]),
+		Description: fmt.Sprintf("Imported from DNSEndpoint %s/%s", item.Namespace, item.Name),
+	}
+
+	var ownership Ownership
+	found := false
+	if i.Resolver != nil {
+		ownership, found = lookupOwnership(ctx, i.Resolver, i.TXTPrefix, name, recordType)
+	}
+	if found {
+		e.Description += fmt.Sprintf(", managed by external-dns owner %q", ownership.Owner)
+		if ref, err := domaindns.ParseResourceRef(ownership.Resource); err == nil {
+			e.Description += " for " + ownership.Resource
+			if len(e.Groups) == 0 {
+				e.Groups = []string{ref.Namespace()}
+			}
+		}
+	}
+	if len(e.Groups) == 0 {
+		e.Groups = []string{item.Namespace}
+	}
+	return e
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externaldnsimport
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	externaldnsv1alpha1 "sigs.k8s.io/external-dns/apis/v1alpha1"
+	"sigs.k8s.io/external-dns/endpoint"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
+)
+
+const testNS = "sreportal-system"
+
+// staticTXT is a TXTResolver answering from a fixed map.
+type staticTXT map[string][]string
+
+func (s staticTXT) LookupTXT(_ context.Context, name string) ([]string, error) {
+	if v, ok := s[name]; ok {
+		return v, nil
+	}
+	return nil, errors.New("no such host")
+}
+
+func newTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha1.AddToScheme(scheme))
+	require.NoError(t, v1alpha2.AddToScheme(scheme))
+	require.NoError(t, externaldnsv1alpha1.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func portal(name string, main bool) *sreportalv1alpha1.Portal {
+	return &sreportalv1alpha1.Portal{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNS},
+		Spec:       sreportalv1alpha1.PortalSpec{Title: name, Main: main},
+	}
+}
+
+func dnsEndpoint(ns, name string, annotations map[string]string, eps ...*endpoint.Endpoint) *externaldnsv1alpha1.DNSEndpoint {
+	return &externaldnsv1alpha1.DNSEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns, Annotations: annotations},
+		Spec:       externaldnsv1alpha1.DNSEndpointSpec{Endpoints: eps},
+	}
+}
+
+func TestImporter_ImportsEachPortalOnce(t *testing.T) {
+	c := newTestClient(t,
+		portal("main", true),
+		portal("team-b", false),
+		dnsEndpoint("team-a", "api", nil,
+			endpoint.NewEndpoint("api.example.com.", "A", "10.0.0.2"),
+			endpoint.NewEndpoint("api.example.com", "A", "10.0.0.1"),
+			endpoint.NewEndpoint("*.example.com", "A", "10.0.0.3"),
+		),
+		dnsEndpoint("team-a", "legacy", map[string]string{adapter.GroupsAnnotationKey: "Legacy, APIs"},
+			endpoint.NewEndpoint("legacy.example.com", "CNAME", "lb.example.com"),
+		),
+		dnsEndpoint("team-c", "app", map[string]string{adapter.PortalAnnotationKey: "unknown"},
+			endpoint.NewEndpoint("app.example.com", "A", "10.0.2.1"),
+		),
+		dnsEndpoint("team-b", "web", map[string]string{adapter.PortalAnnotationKey: "team-b"},
+			endpoint.NewEndpoint("web.example.com", "A", "10.0.1.1"),
+		),
+	)
+	resolver := staticTXT{
+		"a-api.example.com": {"heritage=external-dns,external-dns/owner=prod,external-dns/resource=ingress/apps/api"},
+	}
+	imp := New(c, c, resolver, "")
+	ctx := context.Background()
+
+	require.NoError(t, imp.tick(ctx))
+
+	var mainRecord v1alpha2.DNSRecord
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: testNS, Name: "main" + recordNameSuffix}, &mainRecord))
+	require.Equal(t, v1alpha2.DNSRecordOriginManual, mainRecord.Spec.Origin)
+	require.Equal(t, "main", mainRecord.Spec.PortalRef)
+	require.Equal(t, []v1alpha2.DNSRecordEntry{
+		{
+			FQDN:        "api.example.com",
+			RecordType:  "A",
+			Targets:     []string{"10.0.0.1", "10.0.0.2"},
+			Groups:      []string{"apps"},
+			Description: `Imported from DNSEndpoint team-a/api, managed by external-dns owner "prod" for ingress/apps/api`,
+		},
+		{
+			FQDN:        "app.example.com",
+			RecordType:  "A",
+			Targets:     []string{"10.0.2.1"},
+			Groups:      []string{"team-c"},
+			Description: "Imported from DNSEndpoint team-c/app",
+		},
+		{
+			FQDN:        "legacy.example.com",
+			RecordType:  "CNAME",
+			Targets:     []string{"lb.example.com"},
+			Groups:      []string{"Legacy", "APIs"},
+			Description: "Imported from DNSEndpoint team-a/legacy",
+		},
+	}, mainRecord.Spec.Entries)
+
+	var teamRecord v1alpha2.DNSRecord
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: testNS, Name: "team-b" + recordNameSuffix}, &teamRecord))
+	require.Len(t, teamRecord.Spec.Entries, 1)
+	require.Equal(t, []string{"team-b"}, teamRecord.Spec.Entries[0].Groups)
+
+	var p sreportalv1alpha1.Portal
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: testNS, Name: "main"}, &p))
+	require.NotEmpty(t, p.Annotations[ImportedAnnotationKey])
+
+	// Edits made after the import are kept: the portal is not imported again.
+	mainRecord.Spec.Entries[0].Description = "Public API"
+	require.NoError(t, c.Update(ctx, &mainRecord))
+	require.NoError(t, imp.tick(ctx))
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: testNS, Name: "main" + recordNameSuffix}, &mainRecord))
+	require.Equal(t, "Public API", mainRecord.Spec.Entries[0].Description)
+}
+
+func TestImporter_SkipsRemotePortals(t *testing.T) {
+	remote := portal("remote", false)
+	remote.Spec.Remote = &sreportalv1alpha1.RemotePortalSpec{URL: "https://remote.example.com"}
+	c := newTestClient(t, portal("main", true), remote,
+		dnsEndpoint("team-a", "api", map[string]string{adapter.PortalAnnotationKey: "remote"},
+			endpoint.NewEndpoint("api.example.com", "A", "10.0.0.1"),
+		),
+	)
+	ctx := context.Background()
+
+	require.NoError(t, New(c, c, nil, "").tick(ctx))
+
+	var records v1alpha2.DNSRecordList
+	require.NoError(t, c.List(ctx, &records))
+	require.Empty(t, records.Items)
+
+	var p sreportalv1alpha1.Portal
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: testNS, Name: "remote"}, &p))
+	require.Empty(t, p.Annotations[ImportedAnnotationKey])
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: testNS, Name: "main"}, &p))
+	require.NotEmpty(t, p.Annotations[ImportedAnnotationKey])
+}
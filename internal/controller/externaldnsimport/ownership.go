@@ -0,0 +1,101 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externaldnsimport
+
+import (
+	"context"
+	"strings"
+)
+
+const (
+	heritageKey = "heritage"
+	heritage    = "external-dns"
+	ownerKey    = "external-dns/owner"
+	resourceKey = "external-dns/resource"
+
+	// recordTypeTemplate is the placeholder external-dns substitutes with the
+	// lowercased record type in --txt-prefix.
+	recordTypeTemplate = "%{record_type}"
+)
+
+// TXTResolver looks up the TXT records of a name.
+type TXTResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// Ownership is the content of an external-dns TXT registry record.
+type Ownership struct {
+	// Owner is the --txt-owner-id of the external-dns instance managing the record.
+	Owner string
+	// Resource is the source resource, in "kind/namespace/name" form. Empty
+	// for records written by external-dns versions predating it.
+	Resource string
+}
+
+// ParseOwnership decodes an external-dns TXT registry value such as
+// "heritage=external-dns,external-dns/owner=default,external-dns/resource=ingress/ns/name".
+// It reports false when the value was not written by external-dns.
+func ParseOwnership(txt string) (Ownership, bool) {
+	txt = strings.Trim(txt, `"`)
+	var (
+		o     Ownership
+		found bool
+	)
+	for kv := range strings.SplitSeq(txt, ",") {
+		key, value, ok := strings.Cut(kv, "=")
+		if !ok {
+			continue
+		}
+		switch strings.TrimSpace(key) {
+		case heritageKey:
+			found = strings.TrimSpace(value) == heritage
+		case ownerKey:
+			o.Owner = strings.TrimSpace(value)
+		case resourceKey:
+			o.Resource = strings.TrimSpace(value)
+		}
+	}
+	return o, found
+}
+
+// ownershipNames returns the names external-dns may have written the TXT
+// registry record of an endpoint under: the record-type-aware name used since
+// external-dns v0.12 first, then the legacy one.
+func ownershipNames(prefix, dnsName, recordType string) []string {
+	rt := strings.ToLower(recordType)
+	if strings.Contains(prefix, recordTypeTemplate) {
+		return []string{strings.ReplaceAll(prefix, recordTypeTemplate, rt) + dnsName}
+	}
+	return []string{prefix + rt + "-" + dnsName, prefix + dnsName}
+}
+
+// lookupOwnership returns the external-dns ownership of an endpoint, if its
+// TXT registry record can be found.
+func lookupOwnership(ctx context.Context, r TXTResolver, prefix, dnsName, recordType string) (Ownership, bool) {
+	for _, name := range ownershipNames(prefix, dnsName, recordType) {
+		values, err := r.LookupTXT(ctx, name)
+		if err != nil {
+			continue
+		}
+		for _, v := range values {
+			if o, ok := ParseOwnership(v); ok {
+				return o, true
+			}
+		}
+	}
+	return Ownership{}, false
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externaldnsimport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOwnership(t *testing.T) {
+	tests := []struct {
+		name   string
+		txt    string
+		want   Ownership
+		wantOK bool
+	}{
+		{
+			name:   "current format",
+			txt:    `"heritage=external-dns,external-dns/owner=prod,external-dns/resource=ingress/team-a/api"`,
+			want:   Ownership{Owner: "prod", Resource: "ingress/team-a/api"},
+			wantOK: true,
+		},
+		{
+			name:   "without resource",
+			txt:    "heritage=external-dns,external-dns/owner=default",
+			want:   Ownership{Owner: "default"},
+			wantOK: true,
+		},
+		{name: "foreign record", txt: "v=spf1 include:_spf.example.com ~all"},
+		{name: "other heritage", txt: "heritage=other,external-dns/owner=default"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseOwnership(tt.txt)
+			require.Equal(t, tt.wantOK, ok)
+			if ok {
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestOwnershipNames(t *testing.T) {
+	require.Equal(t, []string{"a-api.example.com", "api.example.com"}, ownershipNames("", "api.example.com", "A"))
+	require.Equal(t, []string{"txt.cname-api.example.com", "txt.api.example.com"}, ownershipNames("txt.", "api.example.com", "CNAME"))
+	require.Equal(t, []string{"aaaa-reg.api.example.com"}, ownershipNames("%{record_type}-reg.", "api.example.com", "AAAA"))
+}
@@ -27,7 +27,6 @@ import (
 	"github.com/golgoth31/sreportal/internal/log"
 	"github.com/golgoth31/sreportal/internal/reconciler"
 	"github.com/golgoth31/sreportal/internal/remoteclient"
-	"github.com/golgoth31/sreportal/internal/tlsutil"
 )
 
 // FetchRemoteGraphHandler fetches network flow data from a remote SRE Portal
@@ -88,29 +87,8 @@ func (h *FetchRemoteGraphHandler) Handle(ctx context.Context, rc *reconciler.Rec
 	return nil
 }
 
-// remoteClientFor returns a cached remoteclient configured with TLS from the Portal spec.
+// remoteClientFor returns a cached remoteclient configured with the TLS
+// settings and credentials of the Portal spec.
 func (h *FetchRemoteGraphHandler) remoteClientFor(ctx context.Context, portal *sreportalv1alpha1.Portal) (*remoteclient.Client, error) {
-	if portal.Spec.Remote.TLS == nil {
-		return h.remoteClientCache.Fallback(), nil
-	}
-
-	key := portal.Namespace + "/" + portal.Name
-	versions, err := tlsutil.SecretVersions(ctx, h.k8sReader, portal.Namespace, portal.Spec.Remote.TLS)
-	if err != nil {
-		return nil, fmt.Errorf("read TLS secret versions: %w", err)
-	}
-
-	if cached := h.remoteClientCache.Get(key, versions); cached != nil {
-		return cached, nil
-	}
-
-	tlsConfig, err := tlsutil.BuildTLSConfig(ctx, h.k8sReader, portal.Namespace, portal.Spec.Remote.TLS)
-	if err != nil {
-		return nil, fmt.Errorf("build TLS config: %w", err)
-	}
-
-	c := remoteclient.NewClient(remoteclient.WithTLSConfig(tlsConfig))
-	h.remoteClientCache.Put(key, versions, c)
-
-	return c, nil
+	return remoteclient.ForPortal(ctx, h.k8sReader, h.remoteClientCache, portal)
 }
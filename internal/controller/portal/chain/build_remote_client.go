@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -30,14 +31,13 @@ import (
 	"github.com/golgoth31/sreportal/internal/log"
 	"github.com/golgoth31/sreportal/internal/reconciler"
 	"github.com/golgoth31/sreportal/internal/remoteclient"
-	"github.com/golgoth31/sreportal/internal/tlsutil"
 )
 
 // DefaultRemoteSyncInterval is the default interval for syncing remote portals.
 const DefaultRemoteSyncInterval = 5 * time.Minute
 
 // BuildRemoteClientHandler builds a cached remote client for remote portals.
-// No-op for local portals.
+// No-op for local and snapshot portals.
 type BuildRemoteClientHandler struct {
 	client client.Client
 	cache  *remoteclient.Cache
@@ -51,7 +51,8 @@ func NewBuildRemoteClientHandler(c client.Client, cache *remoteclient.Cache) *Bu
 // Handle implements reconciler.Handler.
 func (h *BuildRemoteClientHandler) Handle(ctx context.Context, rc *reconciler.ReconcileContext[*sreportalv1alpha1.Portal, ChainData]) error {
 	portal := rc.Resource
-	if portal.Spec.Remote == nil {
+	// Snapshot remotes are read from a file: there is no endpoint to call.
+	if portal.Spec.Remote == nil || portal.Spec.Remote.IsSnapshot() {
 		return nil
 	}
 
@@ -60,6 +61,7 @@ func (h *BuildRemoteClientHandler) Handle(ctx context.Context, rc *reconciler.Re
 	remoteClient, err := h.remoteClientFor(ctx, portal)
 	if err != nil {
 		logger.Error(err, "failed to build remote client", "url", portal.Spec.Remote.URL)
+		rc.Data.Event(portal, corev1.EventTypeWarning, "RemoteSyncFailed", "Sync", "failed to build remote client: %v", err)
 
 		base := portal.DeepCopy()
 		portal.Status.Ready = false
@@ -71,8 +73,8 @@ func (h *BuildRemoteClientHandler) Handle(ctx context.Context, rc *reconciler.Re
 		meta.SetStatusCondition(&portal.Status.Conditions, metav1.Condition{
 			Type:               conditionTypeReady,
 			Status:             metav1.ConditionFalse,
-			Reason:             "TLSConfigFailed",
-			Message:            "Failed to build TLS configuration: " + err.Error(),
+			Reason:             "RemoteClientFailed",
+			Message:            "Failed to build remote client: " + err.Error(),
 			LastTransitionTime: metav1.Now(),
 		})
 
@@ -80,7 +82,7 @@ func (h *BuildRemoteClientHandler) Handle(ctx context.Context, rc *reconciler.Re
 			return fmt.Errorf("patch Portal status: %w", patchErr)
 		}
 
-		rc.Result = ctrl.Result{RequeueAfter: DefaultRemoteSyncInterval}
+		rc.Result = ctrl.Result{RequeueAfter: rc.Data.NextRemoteSync()}
 		return nil
 	}
 
@@ -88,30 +90,8 @@ func (h *BuildRemoteClientHandler) Handle(ctx context.Context, rc *reconciler.Re
 	return nil
 }
 
-// remoteClientFor returns a cached remote client for the given portal.
+// remoteClientFor returns a cached remoteclient configured with the TLS
+// settings and credentials of the Portal spec.
 func (h *BuildRemoteClientHandler) remoteClientFor(ctx context.Context, portal *sreportalv1alpha1.Portal) (*remoteclient.Client, error) {
-	remote := portal.Spec.Remote
-	if remote.TLS == nil {
-		return h.cache.Fallback(), nil
-	}
-
-	key := portal.Namespace + "/" + portal.Name
-	versions, err := tlsutil.SecretVersions(ctx, h.client, portal.Namespace, remote.TLS)
-	if err != nil {
-		return nil, fmt.Errorf("read TLS secret versions: %w", err)
-	}
-
-	if cached := h.cache.Get(key, versions); cached != nil {
-		return cached, nil
-	}
-
-	tlsConfig, err := tlsutil.BuildTLSConfig(ctx, h.client, portal.Namespace, remote.TLS)
-	if err != nil {
-		return nil, fmt.Errorf("build TLS config: %w", err)
-	}
-
-	c := remoteclient.NewClient(remoteclient.WithTLSConfig(tlsConfig))
-	h.cache.Put(key, versions, c)
-
-	return c, nil
+	return remoteclient.ForPortal(ctx, h.client, h.cache, portal)
 }
@@ -0,0 +1,64 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chain_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/controller/portal/chain"
+	"github.com/golgoth31/sreportal/internal/reconciler"
+	"github.com/golgoth31/sreportal/internal/remoteclient"
+)
+
+func TestBuildRemoteClientHandler_EmitsWarningOnTLSFailure(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha1.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+	portal := &sreportalv1alpha1.Portal{
+		ObjectMeta: metav1.ObjectMeta{Name: "remote-tls", Namespace: nsDefault},
+		Spec: sreportalv1alpha1.PortalSpec{
+			Title: "Remote TLS",
+			Remote: &sreportalv1alpha1.RemotePortalSpec{
+				URL:    remoteURL,
+				Portal: tPortalMain,
+				TLS:    &sreportalv1alpha1.RemoteTLSConfig{CASecretRef: &sreportalv1alpha1.SecretRef{Name: "missing"}},
+			},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(portal).WithStatusSubresource(portal).Build()
+	h := chain.NewBuildRemoteClientHandler(cli, remoteclient.NewCache())
+	recorder := events.NewFakeRecorder(2)
+
+	rc := &reconciler.ReconcileContext[*sreportalv1alpha1.Portal, chain.ChainData]{
+		Resource: portal,
+		Data:     chain.ChainData{Recorder: recorder},
+	}
+	require.NoError(t, h.Handle(context.Background(), rc))
+
+	require.Nil(t, rc.Data.RemoteClient)
+	require.Len(t, recorder.Events, 1)
+	require.Contains(t, <-recorder.Events, "Warning RemoteSyncFailed failed to build remote client")
+}
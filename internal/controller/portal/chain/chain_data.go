@@ -17,8 +17,15 @@ limitations under the License.
 package chain
 
 import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
 	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
 	domainnetpol "github.com/golgoth31/sreportal/internal/domain/netpol"
+	domainportal "github.com/golgoth31/sreportal/internal/domain/portal"
 	domainrelease "github.com/golgoth31/sreportal/internal/domain/release"
 	"github.com/golgoth31/sreportal/internal/remoteclient"
 )
@@ -26,6 +33,10 @@ import (
 // conditionTypeReady is the standard condition Type used for Portal status conditions.
 const conditionTypeReady = "Ready"
 
+// conditionTypeRemoteSynced reports, on the DNS CR of a remote portal, the
+// outcome of the last sync with the FQDN count.
+const conditionTypeRemoteSynced = "RemoteSynced"
+
 // ChainData holds typed shared state between Portal reconciliation handlers.
 type ChainData struct {
 	// Writers (optional, populated by Reconcile before chain execution)
@@ -33,7 +44,45 @@ type ChainData struct {
 	ReleaseWriter   domainrelease.ReleaseWriter
 	FlowGraphWriter domainnetpol.FlowGraphWriter
 
+	// RemoteStreams follows the remote portals in Stream sync mode (optional,
+	// populated by Reconcile before chain execution). Without it they are
+	// polled.
+	RemoteStreams *RemoteStreams
+
+	// Recorder emits Kubernetes Events on the Portal (optional, populated by
+	// Reconcile before chain execution).
+	Recorder events.EventRecorder
+
+	// Remote sync scheduling (populated by Reconcile before chain execution)
+	RemoteSync    RemoteSyncSchedule
+	SyncStartedAt time.Time
+
 	// Runtime state (populated by handlers during the chain)
 	RemoteClient *remoteclient.Client
 	FetchResult  *remoteclient.FetchResult
+	Content      []domainportal.ContentBlock
+}
+
+// Event emits an Event regarding obj when a Recorder is configured.
+func (d *ChainData) Event(obj runtime.Object, eventtype, reason, action, note string, args ...any) {
+	if d.Recorder == nil {
+		return
+	}
+	d.Recorder.Eventf(obj, nil, eventtype, reason, action, note, args...)
+}
+
+// streaming reports whether the FQDNs of the remote portal are followed by
+// RemoteStreams rather than fetched on every remote sync.
+func (d *ChainData) streaming(portal *sreportalv1alpha1.Portal) bool {
+	return d.RemoteStreams != nil && portal.Spec.Remote.IsStream()
+}
+
+// NextRemoteSync returns the requeue delay of a remote portal, accounting for
+// the time spent since SyncStartedAt.
+func (d *ChainData) NextRemoteSync() time.Duration {
+	var elapsed time.Duration
+	if !d.SyncStartedAt.IsZero() {
+		elapsed = time.Since(d.SyncStartedAt)
+	}
+	return d.RemoteSync.Next(elapsed)
 }
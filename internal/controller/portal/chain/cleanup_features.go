@@ -86,6 +86,12 @@ func (h *CleanupDisabledFeaturesHandler) Handle(ctx context.Context, rc *reconci
 func (h *CleanupDisabledFeaturesHandler) cleanupDNSData(ctx context.Context, portal *sreportalv1alpha1.Portal, data *ChainData) error {
 	logger := log.FromContext(ctx)
 
+	// Stopped first, so that the stream does not project again what is
+	// deleted below.
+	if data.RemoteStreams != nil {
+		data.RemoteStreams.Stop(portal.Namespace + "/" + portal.Name)
+	}
+
 	if data.FQDNWriter == nil {
 		return nil
 	}
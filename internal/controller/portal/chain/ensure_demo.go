@@ -0,0 +1,227 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
+)
+
+const (
+	// DemoPortalName is the name of the portal created by --bootstrap-demo.
+	DemoPortalName = "demo"
+	// DemoPortalTitle is the display title of the demo portal.
+	DemoPortalTitle = "Demo Portal"
+
+	// BootstrapLabelKey marks the resources created by --bootstrap-demo, so
+	// they are the only ones removed once the flag is turned off.
+	BootstrapLabelKey = "sreportal.io/bootstrap"
+	// bootstrapDemoValue is the BootstrapLabelKey value of the demo resources.
+	bootstrapDemoValue = "demo"
+)
+
+// demoManualEntries are the hand-written FQDNs of the demo portal, shown next
+// to the FQDNs generated by its demo source.
+var demoManualEntries = []sreportalv1alpha2.DNSRecordEntry{
+	{FQDN: "grafana.demo.example.com", Groups: []string{"Observability"}, Description: "Dashboards", RecordType: "A", Targets: []string{"192.0.2.10"}},
+	{FQDN: "prometheus.demo.example.com", Groups: []string{"Observability"}, Description: "Metrics", RecordType: "A", Targets: []string{"192.0.2.11"}},
+	{FQDN: "argocd.demo.example.com", Groups: []string{"Delivery"}, Description: "GitOps deployments", RecordType: "CNAME", Targets: []string{"ingress.demo.example.com"}},
+	{FQDN: "vault.demo.example.com", Groups: []string{"Security"}, Description: "Secrets", RecordType: "A", Targets: []string{"192.0.2.12"}},
+}
+
+// EnsureDemoRunnable is a manager.Runnable that, at startup, creates a demo
+// portal populated with sample data when enabled, and removes it when not:
+//   - a Portal named DemoPortalName
+//   - a DNS CR for it with the demo source enabled, owned by the portal
+//   - a manual DNSRecord carrying demoManualEntries
+//
+// Every resource carries BootstrapLabelKey. Existing resources are left
+// untouched, so edits made while evaluating survive restarts, and only
+// labelled resources are deleted: a user portal named "demo" is never removed,
+// nor filled with demo data.
+type EnsureDemoRunnable struct {
+	client      client.Client
+	cacheReader cache.Cache
+	scheme      *runtime.Scheme
+	namespace   string
+	enabled     bool
+}
+
+// NewEnsureDemoRunnable creates a new EnsureDemoRunnable.
+func NewEnsureDemoRunnable(c client.Client, cacheReader cache.Cache, scheme *runtime.Scheme, namespace string, enabled bool) *EnsureDemoRunnable {
+	return &EnsureDemoRunnable{
+		client:      c,
+		cacheReader: cacheReader,
+		scheme:      scheme,
+		namespace:   namespace,
+		enabled:     enabled,
+	}
+}
+
+// Start implements manager.Runnable. It runs once at startup.
+func (r *EnsureDemoRunnable) Start(ctx context.Context) error {
+	log := ctrl.Log.WithName("ensure-demo")
+
+	if !r.cacheReader.WaitForCacheSync(ctx) {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return fmt.Errorf("cache sync failed - ensure CRDs are installed (run: make install)")
+	}
+
+	if !r.enabled {
+		removed, err := r.cleanup(ctx)
+		if err != nil {
+			return err
+		}
+		if removed > 0 {
+			log.Info("removed demo bootstrap resources", "count", removed, "namespace", r.namespace)
+		}
+		return nil
+	}
+
+	bootstrapped, err := r.ensure(ctx)
+	if err != nil {
+		return err
+	}
+	if !bootstrapped {
+		log.Info("skipping demo bootstrap: a portal not created by --bootstrap-demo already has its name",
+			"name", DemoPortalName, "namespace", r.namespace)
+		return nil
+	}
+	log.Info("demo portal bootstrapped", "name", DemoPortalName, "namespace", r.namespace)
+	return nil
+}
+
+// NeedLeaderElection returns true so this only runs on the leader.
+func (r *EnsureDemoRunnable) NeedLeaderElection() bool {
+	return true
+}
+
+// ensure creates the demo resources that do not exist yet. It reports false,
+// creating nothing else, when a portal named DemoPortalName exists without
+// BootstrapLabelKey: the demo data must not end up in a user portal.
+func (r *EnsureDemoRunnable) ensure(ctx context.Context) (bool, error) {
+	portal := &sreportalv1alpha1.Portal{
+		ObjectMeta: metav1.ObjectMeta{Name: DemoPortalName, Namespace: r.namespace},
+		Spec:       sreportalv1alpha1.PortalSpec{Title: DemoPortalTitle},
+	}
+	if err := r.create(ctx, portal); err != nil {
+		return false, err
+	}
+	// The portal may predate this run: own the DNS CR by the stored object.
+	if err := r.client.Get(ctx, client.ObjectKeyFromObject(portal), portal); err != nil {
+		return false, fmt.Errorf("get demo portal: %w", err)
+	}
+	if portal.Labels[BootstrapLabelKey] != bootstrapDemoValue {
+		return false, nil
+	}
+
+	dns := &sreportalv1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        DemoPortalName,
+			Namespace:   r.namespace,
+			Annotations: map[string]string{annotationSourcesMigrated: sourcesMigratedValue},
+		},
+		Spec: sreportalv1alpha2.DNSSpec{
+			PortalRef: DemoPortalName,
+			Sources: sreportalv1alpha2.SourcesSpec{
+				Demo: &sreportalv1alpha2.DemoSourceSpec{Enabled: true},
+			},
+		},
+	}
+	if err := controllerutil.SetControllerReference(portal, dns, r.scheme); err != nil {
+		return false, fmt.Errorf("set controller reference on DNS %q: %w", dns.Name, err)
+	}
+	if err := r.create(ctx, dns); err != nil {
+		return false, err
+	}
+
+	record := &sreportalv1alpha2.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{Name: DemoPortalName + "-manual", Namespace: r.namespace},
+		Spec: sreportalv1alpha2.DNSRecordSpec{
+			Origin:    sreportalv1alpha2.DNSRecordOriginManual,
+			PortalRef: DemoPortalName,
+			Entries:   demoManualEntries,
+		},
+	}
+	return true, r.create(ctx, record)
+}
+
+// create labels obj as a demo resource and creates it, tolerating an
+// existing object.
+func (r *EnsureDemoRunnable) create(ctx context.Context, obj client.Object) error {
+	adapter.SetStandardLabels(obj, DemoPortalName)
+	labels := obj.GetLabels()
+	labels[BootstrapLabelKey] = bootstrapDemoValue
+	obj.SetLabels(labels)
+	if err := r.client.Create(ctx, obj); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("create %T %q: %w", obj, obj.GetName(), err)
+	}
+	return nil
+}
+
+// cleanup deletes the resources labelled as demo resources and returns how
+// many it deleted. The DNSRecords of the DNS CR go with it (owner reference).
+func (r *EnsureDemoRunnable) cleanup(ctx context.Context) (int, error) {
+	opts := []client.ListOption{
+		client.InNamespace(r.namespace),
+		client.MatchingLabels{BootstrapLabelKey: bootstrapDemoValue},
+	}
+	var objs []client.Object
+
+	var records sreportalv1alpha2.DNSRecordList
+	if err := r.client.List(ctx, &records, opts...); err != nil {
+		return 0, fmt.Errorf("list demo DNSRecords: %w", err)
+	}
+	for i := range records.Items {
+		objs = append(objs, &records.Items[i])
+	}
+	var dnsList sreportalv1alpha2.DNSList
+	if err := r.client.List(ctx, &dnsList, opts...); err != nil {
+		return 0, fmt.Errorf("list demo DNS: %w", err)
+	}
+	for i := range dnsList.Items {
+		objs = append(objs, &dnsList.Items[i])
+	}
+	var portals sreportalv1alpha1.PortalList
+	if err := r.client.List(ctx, &portals, opts...); err != nil {
+		return 0, fmt.Errorf("list demo portals: %w", err)
+	}
+	for i := range portals.Items {
+		objs = append(objs, &portals.Items[i])
+	}
+
+	for _, obj := range objs {
+		if err := r.client.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return 0, fmt.Errorf("delete %T %q: %w", obj, obj.GetName(), err)
+		}
+	}
+	return len(objs), nil
+}
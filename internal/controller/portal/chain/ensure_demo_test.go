@@ -0,0 +1,94 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chain_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/controller/portal/chain"
+)
+
+// syncedCache is a cache.Cache whose informers are always synced.
+type syncedCache struct{ cache.Cache }
+
+func (syncedCache) WaitForCacheSync(context.Context) bool { return true }
+
+func TestEnsureDemo_CreatesThenCleansUp(t *testing.T) {
+	ctx := context.Background()
+	userPortal := &sreportalv1alpha1.Portal{
+		ObjectMeta: metav1.ObjectMeta{Name: "team", Namespace: nsDefault},
+		Spec:       sreportalv1alpha1.PortalSpec{Title: "Team"},
+	}
+	scheme, cli := newDNSSchemeAndClient(t, userPortal)
+
+	// Enabled twice: the second run finds every resource and is a no-op.
+	for range 2 {
+		require.NoError(t, chain.NewEnsureDemoRunnable(cli, syncedCache{}, scheme, nsDefault, true).Start(ctx))
+	}
+
+	var portal sreportalv1alpha1.Portal
+	require.NoError(t, cli.Get(ctx, types.NamespacedName{Name: chain.DemoPortalName, Namespace: nsDefault}, &portal))
+	require.Equal(t, "demo", portal.Labels[chain.BootstrapLabelKey])
+	var dns sreportalv1alpha2.DNS
+	require.NoError(t, cli.Get(ctx, types.NamespacedName{Name: chain.DemoPortalName, Namespace: nsDefault}, &dns))
+	require.True(t, dns.Spec.Sources.Demo.Enabled)
+	require.Equal(t, portal.Name, metav1.GetControllerOf(&dns).Name)
+	var record sreportalv1alpha2.DNSRecord
+	require.NoError(t, cli.Get(ctx, types.NamespacedName{Name: "demo-manual", Namespace: nsDefault}, &record))
+	require.Equal(t, sreportalv1alpha2.DNSRecordOriginManual, record.Spec.Origin)
+	require.NotEmpty(t, record.Spec.Entries)
+
+	require.NoError(t, chain.NewEnsureDemoRunnable(cli, syncedCache{}, scheme, nsDefault, false).Start(ctx))
+
+	var portals sreportalv1alpha1.PortalList
+	require.NoError(t, cli.List(ctx, &portals))
+	require.Len(t, portals.Items, 1)
+	require.Equal(t, "team", portals.Items[0].Name)
+	var records sreportalv1alpha2.DNSRecordList
+	require.NoError(t, cli.List(ctx, &records))
+	require.Empty(t, records.Items)
+}
+
+func TestEnsureDemo_SkipsUserPortalNamedDemo(t *testing.T) {
+	ctx := context.Background()
+	userPortal := &sreportalv1alpha1.Portal{
+		ObjectMeta: metav1.ObjectMeta{Name: chain.DemoPortalName, Namespace: nsDefault},
+		Spec:       sreportalv1alpha1.PortalSpec{Title: "Team demo"},
+	}
+	scheme, cli := newDNSSchemeAndClient(t, userPortal)
+
+	require.NoError(t, chain.NewEnsureDemoRunnable(cli, syncedCache{}, scheme, nsDefault, true).Start(ctx))
+
+	var portal sreportalv1alpha1.Portal
+	require.NoError(t, cli.Get(ctx, types.NamespacedName{Name: chain.DemoPortalName, Namespace: nsDefault}, &portal))
+	require.Equal(t, "Team demo", portal.Spec.Title)
+	require.NotContains(t, portal.Labels, chain.BootstrapLabelKey)
+	var dnsList sreportalv1alpha2.DNSList
+	require.NoError(t, cli.List(ctx, &dnsList))
+	require.Empty(t, dnsList.Items, "no demo DNS CR in a user portal")
+	var records sreportalv1alpha2.DNSRecordList
+	require.NoError(t, cli.List(ctx, &records))
+	require.Empty(t, records.Items, "no demo FQDNs in a user portal")
+}
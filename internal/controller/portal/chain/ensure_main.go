@@ -27,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/adapter"
 )
 
 const (
@@ -37,7 +38,8 @@ const (
 )
 
 // EnsureMainPortalRunnable creates a manager.Runnable that ensures a main portal
-// exists at startup. If no portal has spec.main=true, it creates one.
+// exists at startup. If no portal of any namespace has spec.main=true, it
+// creates one in the operator namespace.
 type EnsureMainPortalRunnable struct {
 	client      client.Client
 	cacheReader cache.Cache
@@ -70,9 +72,10 @@ func (r *EnsureMainPortalRunnable) Start(ctx context.Context) error {
 	}
 	log.Info("cache synced successfully")
 
-	// List all portals
+	// List the portals of every namespace: PromoteToMain may have moved the
+	// main flag to another namespace, and the webhook rejects a second main.
 	var portalList sreportalv1alpha1.PortalList
-	if err := r.client.List(ctx, &portalList, client.InNamespace(r.namespace)); err != nil {
+	if err := r.client.List(ctx, &portalList); err != nil {
 		log.Error(err, "failed to list portals")
 		return err
 	}
@@ -96,6 +99,7 @@ func (r *EnsureMainPortalRunnable) Start(ctx context.Context) error {
 			Main:  true,
 		},
 	}
+	adapter.SetStandardLabels(mainPortal, MainPortalName)
 
 	if err := r.client.Create(ctx, mainPortal); err != nil {
 		if apierrors.IsAlreadyExists(err) {
@@ -19,6 +19,7 @@ package chain
 import (
 	"context"
 	"fmt"
+	"maps"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -30,6 +31,7 @@ import (
 
 	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
 	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
 	"github.com/golgoth31/sreportal/internal/config"
 	portalfeatures "github.com/golgoth31/sreportal/internal/controller/portal/features"
 	"github.com/golgoth31/sreportal/internal/log"
@@ -46,6 +48,9 @@ const (
 	annotationSourcesMigrated = "sreportal.io/sources-migrated"
 	// sourcesMigratedValue is the marker annotation's set value.
 	sourcesMigratedValue = "true"
+	// annotationDNSAutoCreate on a Portal set to "false" opts that portal out of
+	// DNS CR auto-creation, overriding the operator-wide default.
+	annotationDNSAutoCreate = "sreportal.io/dns-auto-create"
 )
 
 // EnsureMainDNSHandler ensures the main (local) portal owns a DNS CR carrying
@@ -59,12 +64,22 @@ const (
 //   - DNS CR exists, no marker, empty -> backfill sources, mark (migration)
 //   - DNS CR exists, no marker, set   -> just mark (user configured pre-upgrade)
 //   - DNS CR exists, marker present   -> no-op (CR is the source of truth)
+//
+// A portal whose template (spec.templateRef) sets default groups or source
+// filters gets a DNS CR too, seeded with those defaults.
+//
+// A pre-existing DNS CR without a controller is adopted: the portal becomes its
+// controller owner so it is garbage-collected with the portal. When
+// auto-creation is disabled (operator config or per-portal annotation), the
+// handler neither creates nor modifies the DNS CR, leaving it to GitOps.
 type EnsureMainDNSHandler struct {
 	client         client.Client
 	scheme         *runtime.Scheme
+	autoCreate     bool
 	sources        sreportalv1alpha2.SourcesSpec
 	groupMapping   sreportalv1alpha2.GroupMappingSpec
 	reconciliation sreportalv1alpha2.ReconciliationSpec
+	portalConfig   config.PortalConfig
 }
 
 // NewEnsureMainDNSHandler resolves the desired DNS source configuration from the
@@ -80,22 +95,29 @@ func NewEnsureMainDNSHandler(c client.Client, scheme *runtime.Scheme, cfg *confi
 			"dropped legacy spec.sources.priority entries for disabled or unknown sources",
 			"dropped", droppedPriority)
 	}
-	return &EnsureMainDNSHandler{
+	h := &EnsureMainDNSHandler{
 		client:         c,
 		scheme:         scheme,
+		autoCreate:     cfg == nil || !cfg.Portal.DisableDNSAutoCreate,
 		sources:        sources,
 		groupMapping:   groupMapping,
 		reconciliation: reconciliation,
 	}
+	if cfg != nil {
+		h.portalConfig = cfg.Portal
+	}
+	return h
 }
 
 // Handle implements reconciler.Handler.
 func (h *EnsureMainDNSHandler) Handle(ctx context.Context, rc *reconciler.ReconcileContext[*sreportalv1alpha1.Portal, ChainData]) error {
 	portal := rc.Resource
 
-	// Only the main, local portal owns the discovery DNS CR. Remote DNS CRs are
-	// managed by SyncRemoteDNSHandler and must not be touched here.
-	if portal.Spec.Remote != nil || !portal.Spec.Main || !portal.Spec.Features.IsDNSEnabled() {
+	// Only the main, local portal, the automatic namespace portals and the
+	// portals of a template with DNS defaults own a discovery DNS CR. Remote
+	// DNS CRs are managed by SyncRemoteDNSHandler and must not be touched here.
+	_, templated := h.dnsTemplate(portal)
+	if portal.Spec.Remote != nil || (!portal.Spec.Main && autoPortalNamespace(portal) == "" && !templated) || !portal.Spec.Features.IsDNSEnabled() {
 		return nil
 	}
 
@@ -106,22 +128,42 @@ func (h *EnsureMainDNSHandler) Handle(ctx context.Context, rc *reconciler.Reconc
 		return fmt.Errorf("find local DNS for portal %q: %w", portal.Name, err)
 	}
 
+	// Auto-creation disabled: the user creates and configures the DNS CR
+	// (e.g. via GitOps), the portal only adopts it.
+	autoCreate := h.autoCreateEnabled(portal)
 	if existing == nil {
+		if !autoCreate {
+			logger.V(1).Info("DNS auto-creation disabled, leaving DNS CR creation to the user", "portal", portal.Name)
+			return nil
+		}
 		return h.createMainDNS(ctx, portal)
 	}
 
-	// Already migrated: the CR owns its configuration, leave it alone.
-	if existing.Annotations[annotationSourcesMigrated] == sourcesMigratedValue {
+	base := existing.DeepCopy()
+	adopted, err := h.adopt(portal, existing)
+	if err != nil {
+		return err
+	}
+
+	// Already migrated or user-managed: the CR owns its configuration, leave
+	// it alone.
+	if existing.Annotations[annotationSourcesMigrated] == sourcesMigratedValue || !autoCreate {
+		if !adopted {
+			return nil
+		}
+		if err := h.client.Patch(ctx, existing, client.MergeFrom(base)); err != nil {
+			return fmt.Errorf("adopt DNS %q: %w", existing.Name, err)
+		}
+		logger.Info("adopted existing DNS CR", "name", existing.Name)
 		return nil
 	}
 
-	base := existing.DeepCopy()
 	// Backfill only when sources were never configured (e.g. a CR freshly
 	// converted from v1alpha1, whose sources are zero). Never clobber a config
 	// the user set before the upgrade.
 	if sourcesEmpty(existing.Spec.Sources) {
+		existing.Spec.Defaults, existing.Spec.GroupMapping = h.dnsDefaults(portal)
 		existing.Spec.Sources = h.sources
-		existing.Spec.GroupMapping = h.groupMapping
 		existing.Spec.Reconciliation = h.reconciliation
 		logger.Info("backfilled DNS sources from legacy config/defaults", "name", existing.Name)
 	}
@@ -135,6 +177,84 @@ func (h *EnsureMainDNSHandler) Handle(ctx context.Context, rc *reconciler.Reconc
 	return nil
 }
 
+// autoCreateEnabled reports whether the handler manages the portal's DNS CR:
+// the operator-wide setting, unless the portal opts out via annotation. An
+// automatic namespace portal exists only to show its namespace, and a portal
+// whose template sets DNS defaults asked for a DNS CR, so both ignore the
+// operator-wide setting.
+func (h *EnsureMainDNSHandler) autoCreateEnabled(portal *sreportalv1alpha1.Portal) bool {
+	if portal.Annotations[annotationDNSAutoCreate] == "false" {
+		return false
+	}
+	_, templated := h.dnsTemplate(portal)
+	return h.autoCreate || autoPortalNamespace(portal) != "" || templated
+}
+
+// dnsTemplate returns the portal template of portal when it sets defaults of
+// the portal DNS CR.
+func (h *EnsureMainDNSHandler) dnsTemplate(portal *sreportalv1alpha1.Portal) (config.PortalTemplateConfig, bool) {
+	if portal.Spec.TemplateRef == "" {
+		return config.PortalTemplateConfig{}, false
+	}
+	tmpl, ok := h.portalConfig.Template(portal.Spec.TemplateRef)
+	if !ok || !tmpl.DefinesDNS() {
+		return config.PortalTemplateConfig{}, false
+	}
+	return tmpl, true
+}
+
+// dnsDefaults returns the source filter defaults and the group mapping of the
+// DNS CR seeded for portal: the sources of an automatic namespace portal
+// default to that namespace, and a portal template overrides the defaults it
+// sets.
+func (h *EnsureMainDNSHandler) dnsDefaults(portal *sreportalv1alpha1.Portal) (sreportalv1alpha2.SourceFilterDefaults, sreportalv1alpha2.GroupMappingSpec) {
+	defaults := sreportalv1alpha2.SourceFilterDefaults{Namespace: autoPortalNamespace(portal)}
+	groupMapping := *h.groupMapping.DeepCopy()
+	tmpl, ok := h.dnsTemplate(portal)
+	if !ok {
+		return defaults, groupMapping
+	}
+	if tmpl.SourceFilters.Namespace != "" {
+		defaults.Namespace = tmpl.SourceFilters.Namespace
+	}
+	defaults.LabelFilter = tmpl.SourceFilters.LabelFilter
+	if tmpl.Groups.Default != "" {
+		groupMapping.DefaultGroup = tmpl.Groups.Default
+	}
+	if len(tmpl.Groups.ByNamespace) > 0 {
+		if groupMapping.ByNamespace == nil {
+			groupMapping.ByNamespace = make(map[string]string, len(tmpl.Groups.ByNamespace))
+		}
+		maps.Copy(groupMapping.ByNamespace, tmpl.Groups.ByNamespace)
+	}
+	return defaults, groupMapping
+}
+
+// autoPortalNamespace returns the namespace an automatic portal was created
+// for, or "" for any other portal.
+func autoPortalNamespace(portal *sreportalv1alpha1.Portal) string {
+	return portal.Labels[adapter.AutoPortalNamespaceLabelKey]
+}
+
+// adopt makes the portal the controller owner of a DNS CR that has none, so a
+// CR created before the portal (or by hand) follows the portal's lifecycle,
+// and stamps the standard labels on a CR the portal controls. It reports
+// whether dns was modified. A CR controlled by another object is left alone.
+func (h *EnsureMainDNSHandler) adopt(portal *sreportalv1alpha1.Portal, dns *sreportalv1alpha2.DNS) (bool, error) {
+	changed := false
+	if metav1.GetControllerOf(dns) == nil {
+		if err := controllerutil.SetControllerReference(portal, dns, h.scheme); err != nil {
+			return false, fmt.Errorf("set controller reference on DNS %q: %w", dns.Name, err)
+		}
+		changed = true
+	}
+	if metav1.IsControlledBy(dns, portal) && !adapter.HasStandardLabels(dns, portal.Name) {
+		adapter.SetStandardLabels(dns, portal.Name)
+		changed = true
+	}
+	return changed, nil
+}
+
 // findLocalDNS returns the local (non-remote) DNS CR for the portal, chosen
 // deterministically by lowest name when several exist, or nil if none.
 func (h *EnsureMainDNSHandler) findLocalDNS(ctx context.Context, portal *sreportalv1alpha1.Portal) (*sreportalv1alpha2.DNS, error) {
@@ -157,10 +277,13 @@ func (h *EnsureMainDNSHandler) findLocalDNS(ctx context.Context, portal *sreport
 	return picked, nil
 }
 
-// createMainDNS creates the main portal's DNS CR, seeded and marked, owned by
-// the portal for cascade deletion.
+// createMainDNS creates the portal's DNS CR, seeded and marked, owned by the
+// portal for cascade deletion. The DNS CR of an automatic namespace portal
+// defaults every source to that namespace; a portal template sets its
+// defaults and groups (see dnsDefaults).
 func (h *EnsureMainDNSHandler) createMainDNS(ctx context.Context, portal *sreportalv1alpha1.Portal) error {
 	logger := log.FromContext(ctx).WithName("ensure-main-dns")
+	defaults, groupMapping := h.dnsDefaults(portal)
 	dns := &sreportalv1alpha2.DNS{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        portal.Name,
@@ -169,11 +292,13 @@ func (h *EnsureMainDNSHandler) createMainDNS(ctx context.Context, portal *srepor
 		},
 		Spec: sreportalv1alpha2.DNSSpec{
 			PortalRef:      portal.Name,
+			Defaults:       defaults,
 			Sources:        h.sources,
-			GroupMapping:   h.groupMapping,
+			GroupMapping:   groupMapping,
 			Reconciliation: h.reconciliation,
 		},
 	}
+	adapter.SetStandardLabels(dns, portal.Name)
 	if err := controllerutil.SetControllerReference(portal, dns, h.scheme); err != nil {
 		return fmt.Errorf("set controller reference on DNS %q: %w", dns.Name, err)
 	}
@@ -184,7 +309,7 @@ func (h *EnsureMainDNSHandler) createMainDNS(ctx context.Context, portal *srepor
 		}
 		return fmt.Errorf("create DNS %q: %w", dns.Name, err)
 	}
-	logger.Info("created main portal DNS CR", "name", dns.Name)
+	logger.Info("created portal DNS CR", "name", dns.Name)
 	return nil
 }
 
@@ -201,6 +326,8 @@ func sourcesEmpty(s sreportalv1alpha2.SourcesSpec) bool {
 		s.GatewayTLSRoute == nil &&
 		s.GatewayTCPRoute == nil &&
 		s.GatewayUDPRoute == nil &&
+		s.ContourHTTPProxy == nil &&
+		s.AmbassadorHost == nil &&
 		s.CrossplaneScalewayRecord == nil &&
 		len(s.Priority) == 0
 }
@@ -242,7 +369,10 @@ func hasLegacySources(s *config.SourcesConfig) bool {
 		s.GatewayTLSRoute != nil ||
 		s.GatewayTCPRoute != nil ||
 		s.GatewayUDPRoute != nil ||
+		s.ContourHTTPProxy != nil ||
+		s.AmbassadorHost != nil ||
 		s.CrossplaneScalewayRecord != nil ||
+		s.Demo != nil ||
 		len(s.Priority) > 0
 }
 
@@ -290,6 +420,19 @@ func mapLegacySources(s *config.SourcesConfig) (sreportalv1alpha2.SourcesSpec, [
 	out.GatewayTLSRoute = mapGatewayRoute(s.GatewayTLSRoute)
 	out.GatewayTCPRoute = mapGatewayRoute(s.GatewayTCPRoute)
 	out.GatewayUDPRoute = mapGatewayRoute(s.GatewayUDPRoute)
+	if c := s.ContourHTTPProxy; c != nil {
+		out.ContourHTTPProxy = &sreportalv1alpha2.ContourHTTPProxySourceSpec{
+			CommonSourceSpec: common(c.Enabled, c.Namespace, c.AnnotationFilter, c.LabelFilter, c.FQDNTemplate, c.CombineFQDNAndAnnotation, c.IgnoreHostnameAnnotation),
+		}
+	}
+	if c := s.AmbassadorHost; c != nil {
+		out.AmbassadorHost = &sreportalv1alpha2.AmbassadorHostSourceSpec{
+			Enabled:          c.Enabled,
+			Namespace:        c.Namespace,
+			AnnotationFilter: c.AnnotationFilter,
+			LabelFilter:      c.LabelFilter,
+		}
+	}
 	if c := s.CrossplaneScalewayRecord; c != nil {
 		out.CrossplaneScalewayRecord = &sreportalv1alpha2.CrossplaneScalewayRecordSourceSpec{
 			Enabled:       c.Enabled,
@@ -298,6 +441,15 @@ func mapLegacySources(s *config.SourcesConfig) (sreportalv1alpha2.SourcesSpec, [
 			ClusterScoped: c.ClusterScoped,
 		}
 	}
+	if c := s.Demo; c != nil {
+		out.Demo = &sreportalv1alpha2.DemoSourceSpec{
+			Enabled:      c.Enabled,
+			Count:        c.Count,
+			Domain:       c.Domain,
+			Groups:       c.Groups,
+			ChurnPercent: c.ChurnPercent,
+		}
+	}
 	var dropped []string
 	if len(s.Priority) > 0 {
 		// Keep only priority entries whose source is actually enabled: legacy
@@ -346,11 +498,15 @@ func mapLegacyGroupMapping(g *config.GroupMappingConfig) sreportalv1alpha2.Group
 	if defaultGroup == "" {
 		defaultGroup = "Services" // CRD requires a non-empty default group
 	}
-	return sreportalv1alpha2.GroupMappingSpec{
+	spec := sreportalv1alpha2.GroupMappingSpec{
 		DefaultGroup: defaultGroup,
 		LabelKey:     g.LabelKey,
 		ByNamespace:  g.ByNamespace,
 	}
+	for _, k := range g.LabelKeys {
+		spec.LabelKeys = append(spec.LabelKeys, sreportalv1alpha2.GroupLabelKeySpec(k))
+	}
+	return spec
 }
 
 func mapLegacyReconciliation(r *config.ReconciliationConfig) sreportalv1alpha2.ReconciliationSpec {
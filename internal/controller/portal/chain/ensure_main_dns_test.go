@@ -89,6 +89,8 @@ func TestEnsureMainDNS_CreatesWithDefaults(t *testing.T) {
 	require.Equal(t, "true", dns.Annotations[sourcesMigratedAnnotation])
 	require.Len(t, dns.OwnerReferences, 1)
 	require.Equal(t, tPortalMain, dns.OwnerReferences[0].Name)
+	require.Equal(t, "sreportal", dns.Labels["app.kubernetes.io/managed-by"])
+	require.Equal(t, tPortalMain, dns.Labels["sreportal.io/portal"])
 }
 
 // Migration: operator loaded legacy sources -> those go in verbatim, not defaults.
@@ -136,6 +138,24 @@ func TestEnsureMainDNS_FiltersDisabledSourcesFromPriority(t *testing.T) {
 		"disabled dnsendpoint must be filtered from priority, enabled order preserved")
 }
 
+func TestEnsureMainDNS_MapsLegacyDemoSource(t *testing.T) {
+	scheme, cli := newDNSSchemeAndClient(t)
+	cfg := &config.OperatorConfig{
+		Sources: config.SourcesConfig{
+			Demo:     &config.DemoConfig{Enabled: true, Count: 200, ChurnPercent: 5},
+			Priority: []string{"demo"},
+		},
+	}
+	h := chain.NewEnsureMainDNSHandler(cli, scheme, cfg)
+
+	handle(t, h, mainPortal())
+
+	var dns sreportalv1alpha2.DNS
+	require.NoError(t, cli.Get(context.Background(), types.NamespacedName{Name: tPortalMain, Namespace: nsDefault}, &dns))
+	require.Equal(t, &sreportalv1alpha2.DemoSourceSpec{Enabled: true, Count: 200, ChurnPercent: 5}, dns.Spec.Sources.Demo)
+	require.Equal(t, []sreportalv1alpha2.SourceType{sreportalv1alpha2.SourceTypeDemo}, dns.Spec.Sources.Priority)
+}
+
 // When every priority entry references a disabled or unknown source, the
 // resulting priority is empty (still webhook-valid) — the DNS is created, not
 // rejected. Guards the boundary where filtering goes from "trim" to "empty".
@@ -213,6 +233,71 @@ func TestEnsureMainDNS_SkipsNonMainPortal(t *testing.T) {
 	require.Empty(t, list.Items)
 }
 
+// An automatic namespace portal gets a DNS CR scoped to its namespace, even
+// when auto-creation is disabled operator-wide.
+func TestEnsureMainDNS_CreatesNamespaceScopedDNSForAutoPortal(t *testing.T) {
+	scheme, cli := newDNSSchemeAndClient(t)
+	cfg := config.DefaultConfig()
+	cfg.Portal.DisableDNSAutoCreate = true
+	h := chain.NewEnsureMainDNSHandler(cli, scheme, cfg)
+
+	portal := mainPortal()
+	portal.Name = "team-a"
+	portal.Spec.Main = false
+	portal.Labels = map[string]string{"sreportal.io/auto-portal-namespace": "team-a"}
+	handle(t, h, portal)
+
+	var dns sreportalv1alpha2.DNS
+	require.NoError(t, cli.Get(context.Background(), types.NamespacedName{Name: "team-a", Namespace: nsDefault}, &dns))
+	require.Equal(t, "team-a", dns.Spec.PortalRef)
+	require.Equal(t, "team-a", dns.Spec.Defaults.Namespace)
+	require.NotNil(t, dns.Spec.Sources.Service)
+}
+
+// A portal whose template sets groups and source filters gets a DNS CR seeded
+// with them.
+func TestEnsureMainDNS_CreatesDNSFromPortalTemplate(t *testing.T) {
+	scheme, cli := newDNSSchemeAndClient(t)
+	cfg := config.DefaultConfig()
+	cfg.Portal.Templates = []config.PortalTemplateConfig{{
+		Name:          "team",
+		Groups:        config.PortalTemplateGroups{Default: "Payments", ByNamespace: map[string]string{"payments-db": "Databases"}},
+		SourceFilters: config.PortalTemplateSourceFilters{Namespace: "payments", LabelFilter: "team=payments"},
+	}}
+	h := chain.NewEnsureMainDNSHandler(cli, scheme, cfg)
+
+	portal := mainPortal()
+	portal.Name = "payments"
+	portal.Spec.Main = false
+	portal.Spec.TemplateRef = "team"
+	handle(t, h, portal)
+
+	var dns sreportalv1alpha2.DNS
+	require.NoError(t, cli.Get(context.Background(), types.NamespacedName{Name: "payments", Namespace: nsDefault}, &dns))
+	require.Equal(t, "payments", dns.Spec.PortalRef)
+	require.Equal(t, sreportalv1alpha2.SourceFilterDefaults{Namespace: "payments", LabelFilter: "team=payments"}, dns.Spec.Defaults)
+	require.Equal(t, "Payments", dns.Spec.GroupMapping.DefaultGroup)
+	require.Equal(t, "Databases", dns.Spec.GroupMapping.ByNamespace["payments-db"])
+	require.NotNil(t, dns.Spec.Sources.Service)
+}
+
+// A template without DNS defaults leaves a non-main portal without DNS CR.
+func TestEnsureMainDNS_SkipsPortalTemplateWithoutDNSDefaults(t *testing.T) {
+	scheme, cli := newDNSSchemeAndClient(t)
+	cfg := config.DefaultConfig()
+	cfg.Portal.Templates = []config.PortalTemplateConfig{{Name: "team", SourcePriority: []string{"ingress"}}}
+	h := chain.NewEnsureMainDNSHandler(cli, scheme, cfg)
+
+	portal := mainPortal()
+	portal.Spec.Main = false
+	portal.Spec.TemplateRef = "team"
+	handle(t, h, portal)
+
+	var list sreportalv1alpha2.DNSList
+	require.NoError(t, cli.List(context.Background(), &list))
+	require.Empty(t, list.Items)
+}
+
 func TestEnsureMainDNS_SkipsRemotePortal(t *testing.T) {
 	scheme, cli := newDNSSchemeAndClient(t)
 	h := chain.NewEnsureMainDNSHandler(cli, scheme, nil)
@@ -225,3 +310,94 @@ func TestEnsureMainDNS_SkipsRemotePortal(t *testing.T) {
 	require.NoError(t, cli.List(context.Background(), &list))
 	require.Empty(t, list.Items)
 }
+
+func TestEnsureMainDNS_AutoCreateDisabledByConfig(t *testing.T) {
+	scheme, cli := newDNSSchemeAndClient(t)
+	cfg := config.DefaultConfig()
+	cfg.Portal.DisableDNSAutoCreate = true
+	h := chain.NewEnsureMainDNSHandler(cli, scheme, cfg)
+
+	handle(t, h, mainPortal())
+
+	var list sreportalv1alpha2.DNSList
+	require.NoError(t, cli.List(context.Background(), &list))
+	require.Empty(t, list.Items)
+}
+
+func TestEnsureMainDNS_AutoCreateDisabledByAnnotation(t *testing.T) {
+	gitops := &sreportalv1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: "gitops-dns", Namespace: nsDefault},
+		Spec:       sreportalv1alpha2.DNSSpec{PortalRef: tPortalMain},
+	}
+	scheme, cli := newDNSSchemeAndClient(t, gitops)
+	h := chain.NewEnsureMainDNSHandler(cli, scheme, nil)
+
+	portal := mainPortal()
+	portal.Annotations = map[string]string{"sreportal.io/dns-auto-create": "false"}
+	handle(t, h, portal)
+
+	var dns sreportalv1alpha2.DNS
+	require.NoError(t, cli.Get(context.Background(), types.NamespacedName{Name: "gitops-dns", Namespace: nsDefault}, &dns))
+	require.Nil(t, dns.Spec.Sources.Service, "user-managed CR must not be backfilled")
+	require.Empty(t, dns.Annotations[sourcesMigratedAnnotation])
+	owner := metav1.GetControllerOf(&dns)
+	require.NotNil(t, owner, "user-managed CR is still adopted")
+	require.Equal(t, tPortalMain, owner.Name)
+}
+
+// With auto-creation disabled, a DNS CR created by the user (e.g. via GitOps)
+// is adopted as is.
+func TestEnsureMainDNS_AutoCreateDisabledAdoptsExistingDNS(t *testing.T) {
+	gitops := &sreportalv1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: "gitops-dns", Namespace: nsDefault},
+		Spec:       sreportalv1alpha2.DNSSpec{PortalRef: tPortalMain},
+	}
+	scheme, cli := newDNSSchemeAndClient(t, gitops)
+	cfg := config.DefaultConfig()
+	cfg.Portal.DisableDNSAutoCreate = true
+	h := chain.NewEnsureMainDNSHandler(cli, scheme, cfg)
+
+	handle(t, h, mainPortal())
+
+	var dns sreportalv1alpha2.DNS
+	require.NoError(t, cli.Get(context.Background(), types.NamespacedName{Name: "gitops-dns", Namespace: nsDefault}, &dns))
+	owner := metav1.GetControllerOf(&dns)
+	require.NotNil(t, owner)
+	require.Equal(t, tPortalMain, owner.Name)
+	require.Equal(t, tPortalMain, dns.Labels["sreportal.io/portal"])
+	require.Nil(t, dns.Spec.Sources.Service, "user-managed CR must not be backfilled")
+	require.Empty(t, dns.Annotations[sourcesMigratedAnnotation])
+
+	var list sreportalv1alpha2.DNSList
+	require.NoError(t, cli.List(context.Background(), &list))
+	require.Len(t, list.Items, 1)
+}
+
+// A pre-existing, already-configured DNS CR without an owner is adopted by the
+// portal instead of being duplicated.
+func TestEnsureMainDNS_AdoptsOrphanDNS(t *testing.T) {
+	orphan := &sreportalv1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "pre-existing",
+			Namespace:   nsDefault,
+			Annotations: map[string]string{sourcesMigratedAnnotation: "true"},
+		},
+		Spec: sreportalv1alpha2.DNSSpec{PortalRef: tPortalMain},
+	}
+	scheme, cli := newDNSSchemeAndClient(t, orphan)
+	h := chain.NewEnsureMainDNSHandler(cli, scheme, nil)
+
+	handle(t, h, mainPortal())
+
+	var dns sreportalv1alpha2.DNS
+	require.NoError(t, cli.Get(context.Background(), types.NamespacedName{Name: "pre-existing", Namespace: nsDefault}, &dns))
+	owner := metav1.GetControllerOf(&dns)
+	require.NotNil(t, owner)
+	require.Equal(t, tPortalMain, owner.Name)
+	require.Equal(t, tPortalMain, dns.Labels["sreportal.io/portal"])
+	require.Nil(t, dns.Spec.Sources.Service, "adoption must not touch a migrated CR's sources")
+
+	var list sreportalv1alpha2.DNSList
+	require.NoError(t, cli.List(context.Background(), &list))
+	require.Len(t, list.Items, 1)
+}
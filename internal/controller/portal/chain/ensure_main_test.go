@@ -0,0 +1,55 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chain_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/controller/portal/chain"
+)
+
+func TestEnsureMainPortal_KeepsMainOfAnotherNamespace(t *testing.T) {
+	ctx := context.Background()
+	promoted := &sreportalv1alpha1.Portal{
+		ObjectMeta: metav1.ObjectMeta{Name: "team", Namespace: "team-ns"},
+		Spec:       sreportalv1alpha1.PortalSpec{Title: "Team", Main: true},
+	}
+	_, cli := newDNSSchemeAndClient(t, promoted)
+
+	require.NoError(t, chain.NewEnsureMainPortalRunnable(cli, syncedCache{}, nsDefault).Start(ctx))
+
+	err := cli.Get(ctx, types.NamespacedName{Name: chain.MainPortalName, Namespace: nsDefault}, &sreportalv1alpha1.Portal{})
+	require.True(t, apierrors.IsNotFound(err), "no second main portal must be created, got %v", err)
+}
+
+func TestEnsureMainPortal_CreatesMainWhenNone(t *testing.T) {
+	ctx := context.Background()
+	_, cli := newDNSSchemeAndClient(t)
+
+	require.NoError(t, chain.NewEnsureMainPortalRunnable(cli, syncedCache{}, nsDefault).Start(ctx))
+
+	var created sreportalv1alpha1.Portal
+	require.NoError(t, cli.Get(ctx, types.NamespacedName{Name: chain.MainPortalName, Namespace: nsDefault}, &created))
+	require.True(t, created.Spec.Main)
+}
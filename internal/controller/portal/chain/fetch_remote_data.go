@@ -19,7 +19,9 @@ package chain
 import (
 	"context"
 	"fmt"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -28,11 +30,13 @@ import (
 	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
 	"github.com/golgoth31/sreportal/internal/log"
 	"github.com/golgoth31/sreportal/internal/metrics"
+	"github.com/golgoth31/sreportal/internal/ocisnapshot"
 	"github.com/golgoth31/sreportal/internal/reconciler"
+	"github.com/golgoth31/sreportal/internal/remoteclient"
 )
 
-// FetchRemoteDataHandler fetches FQDNs and portal info from the remote portal.
-// No-op for local portals.
+// FetchRemoteDataHandler fetches FQDNs and portal info from the remote portal,
+// or reads them from its snapshot file or OCI artifact. No-op for local portals.
 type FetchRemoteDataHandler struct {
 	client client.Client
 }
@@ -48,17 +52,36 @@ func (h *FetchRemoteDataHandler) Handle(ctx context.Context, rc *reconciler.Reco
 	if portal.Spec.Remote == nil {
 		return nil
 	}
-	if rc.Data.RemoteClient == nil {
+	remote := portal.Spec.Remote
+
+	var (
+		result *remoteclient.FetchResult
+		err    error
+		reason = "RemoteFetchFailed"
+	)
+	switch {
+	case remote.IsSnapshot():
+		result, err = h.readSnapshot(ctx, portal)
+		reason = "RemoteSnapshotFailed"
+	case rc.Data.RemoteClient == nil:
 		return nil
+	case rc.Data.streaming(portal):
+		// The FQDNs are followed by the stream: only the portal info is
+		// fetched.
+		result, err = rc.Data.RemoteClient.FetchPortalInfo(ctx, remote.URL, remote.Portal)
+		if err == nil {
+			result.FQDNCount = rc.Data.RemoteStreams.Count(portal.Namespace + "/" + portal.Name)
+		}
+	default:
+		result, err = rc.Data.RemoteClient.FetchFQDNs(ctx, remote.URL, remote.Portal)
 	}
 
 	remoteLog := log.Default().WithName("portal").WithName("remote")
-	remote := portal.Spec.Remote
 
-	result, err := rc.Data.RemoteClient.FetchFQDNs(ctx, remote.URL, remote.Portal)
 	if err != nil {
 		metrics.PortalRemoteSyncErrorsTotal.WithLabelValues(portal.Name).Inc()
-		remoteLog.Warn("failed to fetch FQDNs from remote portal", "name", portal.Name, "namespace", portal.Namespace, "url", remote.URL, "remotePortal", remote.Portal, "error", err.Error())
+		remoteLog.Warn("failed to fetch FQDNs from remote portal", "name", portal.Name, "namespace", portal.Namespace, "url", remote.Location(), "remotePortal", remote.Portal, "error", err.Error())
+		rc.Data.Event(portal, corev1.EventTypeWarning, "RemoteSyncFailed", "Sync", "fetching FQDNs from %s failed: %v", remote.Location(), err)
 
 		base := portal.DeepCopy()
 		portal.Status.Ready = false
@@ -70,7 +93,7 @@ func (h *FetchRemoteDataHandler) Handle(ctx context.Context, rc *reconciler.Reco
 		meta.SetStatusCondition(&portal.Status.Conditions, metav1.Condition{
 			Type:               conditionTypeReady,
 			Status:             metav1.ConditionFalse,
-			Reason:             "RemoteFetchFailed",
+			Reason:             reason,
 			Message:            "Failed to fetch data from remote portal: " + err.Error(),
 			LastTransitionTime: metav1.Now(),
 		})
@@ -78,11 +101,44 @@ func (h *FetchRemoteDataHandler) Handle(ctx context.Context, rc *reconciler.Reco
 		if patchErr := h.client.Status().Patch(ctx, portal, client.MergeFrom(base)); patchErr != nil {
 			return fmt.Errorf("patch Portal status: %w", patchErr)
 		}
+		// A stream reports its own state on the DNS resource.
+		if portal.Spec.Features.IsDNSEnabled() && !rc.Data.streaming(portal) {
+			markRemoteDNSNotSynced(ctx, h.client, portal, reason, err.Error())
+		}
 
-		rc.Result = ctrl.Result{RequeueAfter: DefaultRemoteSyncInterval}
+		rc.Result = ctrl.Result{RequeueAfter: rc.Data.NextRemoteSync()}
 		return nil
 	}
 
 	rc.Data.FetchResult = result
 	return nil
 }
+
+// snapshotPullTimeout bounds the pull of an OCI snapshot artifact.
+const snapshotPullTimeout = 30 * time.Second
+
+// readSnapshot reads the snapshot of a snapshot remote, from its file or its
+// OCI artifact.
+func (h *FetchRemoteDataHandler) readSnapshot(ctx context.Context, portal *sreportalv1alpha1.Portal) (*remoteclient.FetchResult, error) {
+	remote := portal.Spec.Remote
+	src := remote.Snapshot
+	if src.OCI == "" {
+		return remoteclient.ReadSnapshot(src.Path, remote.Portal)
+	}
+
+	secretName := ""
+	if src.CredentialsSecretRef != nil {
+		secretName = src.CredentialsSecretRef.Name
+	}
+	auth, err := ocisnapshot.Auth(ctx, h.client, portal.Namespace, secretName, src.OCI)
+	if err != nil {
+		return nil, err
+	}
+	pullCtx, cancel := context.WithTimeout(ctx, snapshotPullTimeout)
+	defer cancel()
+	data, err := ocisnapshot.Pull(pullCtx, src.OCI, auth)
+	if err != nil {
+		return nil, err
+	}
+	return remoteclient.DecodeSnapshot(data, remote.Portal)
+}
@@ -18,6 +18,8 @@ package chain_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -54,3 +56,31 @@ func TestFetchRemoteDataHandlerGuardsNilRemoteClient(t *testing.T) {
 	require.Equal(t, ctrl.Result{}, rc.Result, "no RequeueAfter should be set when RemoteClient is nil")
 	require.Nil(t, rc.Data.FetchResult, "FetchResult should remain nil when RemoteClient is nil")
 }
+
+func TestFetchRemoteDataHandlerReadsSnapshot(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha1.AddToScheme(scheme))
+	cli := fake.NewClientBuilder().WithScheme(scheme).Build()
+	h := chain.NewFetchRemoteDataHandler(cli)
+
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	require.NoError(t, os.WriteFile(path,
+		[]byte(`{"fqdns":[{"name":"app.example.com","recordType":"A","groups":["apps"],"portals":["main"]}]}`), 0o600))
+
+	portal := &sreportalv1alpha1.Portal{
+		ObjectMeta: metav1.ObjectMeta{Name: "remote-portal", Namespace: nsDefault},
+		Spec: sreportalv1alpha1.PortalSpec{
+			Title: "Remote",
+			Remote: &sreportalv1alpha1.RemotePortalSpec{
+				Portal:   tPortalMain,
+				Snapshot: &sreportalv1alpha1.RemoteSnapshotSource{Path: path},
+			},
+		},
+	}
+	rc := &reconciler.ReconcileContext[*sreportalv1alpha1.Portal, chain.ChainData]{Resource: portal}
+
+	require.NoError(t, h.Handle(context.Background(), rc))
+	require.NotNil(t, rc.Data.FetchResult)
+	require.Equal(t, 1, rc.Data.FetchResult.FQDNCount)
+	require.Equal(t, "apps", rc.Data.FetchResult.Groups[0].Name)
+}
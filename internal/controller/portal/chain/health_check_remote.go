@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -59,6 +60,7 @@ func (h *HealthCheckRemoteHandler) Handle(ctx context.Context, rc *reconciler.Re
 	if err != nil {
 		metrics.PortalRemoteSyncErrorsTotal.WithLabelValues(portal.Name).Inc()
 		remoteLog.Error(err, "remote portal health check failed", "name", portal.Name, "namespace", portal.Namespace, "url", remote.URL, "error", err.Error())
+		rc.Data.Event(portal, corev1.EventTypeWarning, "RemoteSyncFailed", "Sync", "health check of %s failed: %v", remote.URL, err)
 
 		base := portal.DeepCopy()
 		portal.Status.Ready = false
@@ -79,7 +81,7 @@ func (h *HealthCheckRemoteHandler) Handle(ctx context.Context, rc *reconciler.Re
 			return fmt.Errorf("patch Portal status: %w", patchErr)
 		}
 
-		rc.Result = ctrl.Result{RequeueAfter: DefaultRemoteSyncInterval}
+		rc.Result = ctrl.Result{RequeueAfter: rc.Data.NextRemoteSync()}
 		return nil
 	}
 
@@ -0,0 +1,81 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chain
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/adapter"
+	domainportal "github.com/golgoth31/sreportal/internal/domain/portal"
+	"github.com/golgoth31/sreportal/internal/reconciler"
+)
+
+// LoadContentHandler reads the markdown content blocks of spec.contentRefs
+// into ChainData.Content. A missing ConfigMap or key is reported as a Warning
+// Event and skipped, so one broken reference does not hide the other blocks.
+type LoadContentHandler struct {
+	client client.Client
+}
+
+// NewLoadContentHandler creates a new LoadContentHandler.
+func NewLoadContentHandler(c client.Client) *LoadContentHandler {
+	return &LoadContentHandler{client: c}
+}
+
+// Handle implements reconciler.Handler.
+func (h *LoadContentHandler) Handle(ctx context.Context, rc *reconciler.ReconcileContext[*sreportalv1alpha1.Portal, ChainData]) error {
+	portal := rc.Resource
+	for _, ref := range portal.Spec.ContentRefs {
+		var cm corev1.ConfigMap
+		if err := h.client.Get(ctx, client.ObjectKey{Namespace: portal.Namespace, Name: ref.Name}, &cm); err != nil {
+			if errors.IsNotFound(err) {
+				// Unlabelled ConfigMaps are not cached: they read as not found.
+				rc.Data.Event(portal, corev1.EventTypeWarning, "ContentNotFound", "LoadContent",
+					"ConfigMap %s not found or not labelled %s=true", ref.Name, adapter.PortalContentLabelKey)
+				continue
+			}
+			return fmt.Errorf("get content ConfigMap %s: %w", ref.Name, err)
+		}
+
+		keys := []string{ref.Key}
+		if ref.Key == "" {
+			keys = slices.Sorted(maps.Keys(cm.Data))
+		}
+		for _, key := range keys {
+			markdown, ok := cm.Data[key]
+			if !ok {
+				rc.Data.Event(portal, corev1.EventTypeWarning, "ContentNotFound", "LoadContent",
+					"ConfigMap %s has no key %s", ref.Name, key)
+				continue
+			}
+			rc.Data.Content = append(rc.Data.Content, domainportal.ContentBlock{
+				ConfigMap: ref.Name,
+				Key:       key,
+				Markdown:  markdown,
+			})
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chain_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/controller/portal/chain"
+	domainportal "github.com/golgoth31/sreportal/internal/domain/portal"
+	"github.com/golgoth31/sreportal/internal/reconciler"
+)
+
+func TestLoadContentHandler_LoadsBlocksAndWarnsOnMissingRefs(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha1.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "docs", Namespace: nsDefault},
+		Data:       map[string]string{"onboarding.md": "# Onboarding", "announce.md": "Maintenance tonight"},
+	}
+	portal := &sreportalv1alpha1.Portal{
+		ObjectMeta: metav1.ObjectMeta{Name: tPortalMain, Namespace: nsDefault},
+		Spec: sreportalv1alpha1.PortalSpec{
+			Title: "Main",
+			ContentRefs: []sreportalv1alpha1.PortalContentRef{
+				{Name: "docs"},
+				{Name: "docs", Key: "missing.md"},
+				{Name: "absent"},
+			},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(portal, cm).Build()
+	h := chain.NewLoadContentHandler(cli)
+	recorder := events.NewFakeRecorder(2)
+
+	rc := &reconciler.ReconcileContext[*sreportalv1alpha1.Portal, chain.ChainData]{
+		Resource: portal,
+		Data:     chain.ChainData{Recorder: recorder},
+	}
+	require.NoError(t, h.Handle(context.Background(), rc))
+
+	require.Equal(t, []domainportal.ContentBlock{
+		{ConfigMap: "docs", Key: "announce.md", Markdown: "Maintenance tonight"},
+		{ConfigMap: "docs", Key: "onboarding.md", Markdown: "# Onboarding"},
+	}, rc.Data.Content)
+	require.Len(t, recorder.Events, 2)
+	require.Contains(t, <-recorder.Events, "Warning ContentNotFound ConfigMap docs has no key missing.md")
+	require.Contains(t, <-recorder.Events, "Warning ContentNotFound ConfigMap absent not found")
+}
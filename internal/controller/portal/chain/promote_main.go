@@ -0,0 +1,76 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/mainportal"
+	"github.com/golgoth31/sreportal/internal/reconciler"
+)
+
+// PromoteMainHandler promotes the portal to main when it carries the
+// mainportal.PromoteAnnotationKey annotation set to "true". The promotion
+// removes the annotation, so it runs once.
+type PromoteMainHandler struct {
+	client   client.Client
+	promoter *mainportal.Service
+}
+
+// NewPromoteMainHandler creates a new PromoteMainHandler.
+func NewPromoteMainHandler(c client.Client) *PromoteMainHandler {
+	return &PromoteMainHandler{client: c, promoter: mainportal.NewService(c)}
+}
+
+// Handle implements reconciler.Handler.
+func (h *PromoteMainHandler) Handle(ctx context.Context, rc *reconciler.ReconcileContext[*sreportalv1alpha1.Portal, ChainData]) error {
+	portal := rc.Resource
+	if !strings.EqualFold(portal.Annotations[mainportal.PromoteAnnotationKey], "true") {
+		return nil
+	}
+
+	key := client.ObjectKeyFromObject(portal)
+	demoted, err := h.promoter.Promote(ctx, key)
+	switch {
+	case errors.Is(err, mainportal.ErrRemotePortal):
+		// Retrying cannot succeed: drop the annotation and report it.
+		rc.Data.Event(portal, corev1.EventTypeWarning, "PromotionFailed", "PromoteToMain", "%v", err)
+		base := portal.DeepCopy()
+		delete(portal.Annotations, mainportal.PromoteAnnotationKey)
+		if err := h.client.Patch(ctx, portal, client.MergeFrom(base)); err != nil {
+			return fmt.Errorf("remove %s annotation: %w", mainportal.PromoteAnnotationKey, err)
+		}
+		return nil
+	case err != nil:
+		return fmt.Errorf("promote portal to main: %w", err)
+	}
+
+	note := "portal promoted to main"
+	if len(demoted) > 0 {
+		note += ", replacing " + strings.Join(demoted, ", ")
+	}
+	rc.Data.Event(portal, corev1.EventTypeNormal, "PromotedToMain", "PromoteToMain", "%s", note)
+	// Later handlers see the promoted portal.
+	return h.client.Get(ctx, key, portal)
+}
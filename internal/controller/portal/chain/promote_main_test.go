@@ -0,0 +1,87 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chain_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/controller/portal/chain"
+	"github.com/golgoth31/sreportal/internal/mainportal"
+	"github.com/golgoth31/sreportal/internal/reconciler"
+)
+
+func TestPromoteMainHandler(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha1.AddToScheme(scheme))
+	team := &sreportalv1alpha1.Portal{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "team", Namespace: nsDefault,
+			Annotations: map[string]string{mainportal.PromoteAnnotationKey: "true"},
+		},
+		Spec: sreportalv1alpha1.PortalSpec{Title: "Team"},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(mainPortal(), team).Build()
+	recorder := events.NewFakeRecorder(1)
+
+	rc := &reconciler.ReconcileContext[*sreportalv1alpha1.Portal, chain.ChainData]{
+		Resource: team,
+		Data:     chain.ChainData{Recorder: recorder},
+	}
+	require.NoError(t, chain.NewPromoteMainHandler(cli).Handle(context.Background(), rc))
+
+	require.True(t, rc.Resource.Spec.Main)
+	require.NotContains(t, rc.Resource.Annotations, mainportal.PromoteAnnotationKey)
+	var previous sreportalv1alpha1.Portal
+	require.NoError(t, cli.Get(context.Background(), types.NamespacedName{Name: tPortalMain, Namespace: nsDefault}, &previous))
+	require.False(t, previous.Spec.Main)
+	require.Contains(t, <-recorder.Events, "Normal PromotedToMain portal promoted to main, replacing default/main")
+}
+
+func TestPromoteMainHandler_DropsAnnotationOfRemotePortal(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha1.AddToScheme(scheme))
+	remote := &sreportalv1alpha1.Portal{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "remote", Namespace: nsDefault,
+			Annotations: map[string]string{mainportal.PromoteAnnotationKey: "true"},
+		},
+		Spec: sreportalv1alpha1.PortalSpec{Title: "Remote", Remote: &sreportalv1alpha1.RemotePortalSpec{URL: "https://remote.example.com"}},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(mainPortal(), remote).Build()
+	recorder := events.NewFakeRecorder(1)
+
+	rc := &reconciler.ReconcileContext[*sreportalv1alpha1.Portal, chain.ChainData]{
+		Resource: remote,
+		Data:     chain.ChainData{Recorder: recorder},
+	}
+	require.NoError(t, chain.NewPromoteMainHandler(cli).Handle(context.Background(), rc))
+
+	var got sreportalv1alpha1.Portal
+	require.NoError(t, cli.Get(context.Background(), types.NamespacedName{Name: "remote", Namespace: nsDefault}, &got))
+	require.False(t, got.Spec.Main)
+	require.NotContains(t, got.Annotations, mainportal.PromoteAnnotationKey)
+	require.Contains(t, <-recorder.Events, "Warning PromotionFailed")
+}
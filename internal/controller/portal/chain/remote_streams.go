@@ -0,0 +1,346 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chain
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	"github.com/golgoth31/sreportal/internal/log"
+	"github.com/golgoth31/sreportal/internal/remoteclient"
+)
+
+// remoteStreamRetryAfter is how long a stream stopped by an error that
+// reconnecting cannot fix (e.g. a remote without StreamFQDNs) stays stopped
+// before a reconcile of its Portal opens it again.
+const remoteStreamRetryAfter = remoteclient.DefaultStreamMaxBackoff
+
+// remoteStreamProjectDelay is how long the changes streamed after the
+// initial state are gathered before being projected.
+const remoteStreamProjectDelay = 100 * time.Millisecond
+
+// RemoteStreams follows the FQDNs of the remote portals in Stream sync mode.
+// Each portal gets one StreamFQDNs session, kept open by a StreamSupervisor,
+// whose changes are projected into the FQDN read store as they arrive, a
+// burst at a time: the full state is only re-read when the session
+// reconnects.
+//
+// It is a manager Runnable: the streams stop with the manager. Each health
+// transition is sent on Events so the Portal reconciler records it.
+type RemoteStreams struct {
+	writer         domaindns.FQDNWriter
+	supervisorOpts []remoteclient.SupervisorOption
+	events         chan event.GenericEvent
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	streams map[string]*remoteStream // by Portal key ("namespace/name")
+}
+
+// remoteStream is the stream of one remote portal.
+type remoteStream struct {
+	client       *remoteclient.Client
+	url          string
+	remotePortal string
+
+	supervisor *remoteclient.StreamSupervisor
+	cancel     context.CancelFunc
+	done       chan struct{}
+
+	mu    sync.Mutex
+	count int
+}
+
+// NewRemoteStreams creates a new RemoteStreams projecting into writer. The
+// supervisor options apply to every stream.
+func NewRemoteStreams(writer domaindns.FQDNWriter, opts ...remoteclient.SupervisorOption) *RemoteStreams {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &RemoteStreams{
+		writer:         writer,
+		supervisorOpts: opts,
+		events:         make(chan event.GenericEvent, 64),
+		ctx:            ctx,
+		cancel:         cancel,
+		streams:        map[string]*remoteStream{},
+	}
+}
+
+// Start implements manager.Runnable. It blocks until ctx is done, then stops
+// every stream.
+func (s *RemoteStreams) Start(ctx context.Context) error {
+	<-ctx.Done()
+	s.cancel()
+	s.mu.Lock()
+	streams := slices.Collect(maps.Values(s.streams))
+	s.mu.Unlock()
+	for _, st := range streams {
+		<-st.done
+	}
+	return nil
+}
+
+// Events returns the channel on which the Portal of a stream is sent on each
+// health transition.
+func (s *RemoteStreams) Events() <-chan event.GenericEvent {
+	return s.events
+}
+
+// Follow ensures the FQDNs of portal are streamed from its remote with c. The
+// stream is reopened when the remote URL, the remote portal or the client
+// (rebuilt when its Secrets change) differ from the running one, and when it
+// stopped on an error longer than remoteStreamRetryAfter ago.
+func (s *RemoteStreams) Follow(portal *sreportalv1alpha1.Portal, c *remoteclient.Client) {
+	namespace, name := portal.Namespace, portal.Name
+	key := namespace + "/" + name
+	remote := portal.Spec.Remote
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if st, ok := s.streams[key]; ok {
+		if st.client == c && st.url == remote.URL && st.remotePortal == remote.Portal && !st.expired() {
+			return
+		}
+		st.stop()
+	}
+
+	st := &remoteStream{
+		client:       c,
+		url:          remote.URL,
+		remotePortal: remote.Portal,
+		done:         make(chan struct{}),
+	}
+	st.supervisor = remoteclient.NewStreamSupervisor(append(slices.Clone(s.supervisorOpts),
+		remoteclient.WithOnTransition(func(remoteclient.StreamHealth) { s.notify(namespace, name) }))...)
+	ctx, cancel := context.WithCancel(s.ctx)
+	st.cancel = cancel
+	s.streams[key] = st
+
+	go s.run(ctx, st, namespace, name)
+}
+
+// Stop stops the stream of the Portal key ("namespace/name"), if any, and
+// waits for it to end: the read store is no longer written once it returns.
+func (s *RemoteStreams) Stop(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if st, ok := s.streams[key]; ok {
+		st.stop()
+		delete(s.streams, key)
+	}
+}
+
+// Health returns the health of the stream of the Portal key
+// ("namespace/name"), and false when the portal is not followed.
+func (s *RemoteStreams) Health(key string) (remoteclient.StreamHealth, bool) {
+	s.mu.Lock()
+	st, ok := s.streams[key]
+	s.mu.Unlock()
+	if !ok {
+		return remoteclient.StreamHealth{}, false
+	}
+	health := st.supervisor.Health()
+	if health.State == "" {
+		// Follow returned before the stream goroutine ran.
+		health.State = sreportalv1alpha1.RemoteStreamConnecting
+		health.Since = time.Now()
+	}
+	return health, true
+}
+
+// Count returns the number of FQDNs projected by the stream of the Portal
+// key ("namespace/name").
+func (s *RemoteStreams) Count(key string) int {
+	s.mu.Lock()
+	st, ok := s.streams[key]
+	s.mu.Unlock()
+	if !ok {
+		return 0
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return st.count
+}
+
+// notify sends the Portal to the reconciler, dropping the event when the
+// channel is full: a reconcile of the Portal is already pending then.
+func (s *RemoteStreams) notify(namespace, name string) {
+	portal := &sreportalv1alpha1.Portal{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+	select {
+	case s.events <- event.GenericEvent{Object: portal}:
+	default:
+	}
+}
+
+// run supervises the stream until ctx is done or it stops on an error.
+func (s *RemoteStreams) run(ctx context.Context, st *remoteStream, namespace, portalName string) {
+	defer close(st.done)
+
+	logger := log.Default().WithName("portal").WithName("remote")
+	dnsKey := namespace + "/" + RemoteDNSName(portalName)
+
+	proj := &remoteProjection{write: func(views []domaindns.FQDNView) {
+		if err := s.writer.Replace(ctx, dnsKey, portalName, views); err != nil {
+			logger.Warn("failed to project streamed remote FQDNs", "portal", portalName, "namespace", namespace, "error", err.Error())
+		}
+	}}
+	defer proj.stop()
+	err := st.supervisor.Run(ctx, func(ctx context.Context, _ string, progress func(string)) error {
+		// Every session starts with the full state: it replaces the views
+		// of the previous one once complete, dropping the FQDNs deleted
+		// while disconnected.
+		initial := map[string]domaindns.FQDNView{}
+		synced := false
+		return st.client.StreamFQDNs(ctx, st.url, st.remotePortal, func(e remoteclient.FQDNEvent) error {
+			if e.Type == remoteclient.FQDNsSynced {
+				if synced {
+					return nil
+				}
+				synced = true
+				proj.replace(initial)
+				st.setCount(len(initial))
+				progress("")
+				return nil
+			}
+			e.View.Portals = []string{portalName}
+			e.View.Namespace = namespace
+			key := e.View.Name + "/" + e.View.RecordType
+			view := &e.View
+			if e.Type == remoteclient.FQDNDeleted {
+				view = nil
+			}
+			if !synced {
+				if view == nil {
+					delete(initial, key)
+				} else {
+					initial[key] = *view
+				}
+				return nil
+			}
+			st.setCount(proj.update(key, view))
+			progress("")
+			return nil
+		})
+	})
+	if err != nil {
+		logger.Warn("stream to remote portal stopped", "portal", portalName, "namespace", namespace, "url", st.url, "error", err.Error())
+	}
+}
+
+func (st *remoteStream) setCount(n int) {
+	st.mu.Lock()
+	st.count = n
+	st.mu.Unlock()
+}
+
+// remoteProjection holds the FQDNs of a stream and projects them into the
+// FQDN read store. The changes of one tick of the remote store arrive in a
+// burst: they are gathered for remoteStreamProjectDelay and projected with a
+// single write.
+type remoteProjection struct {
+	write func([]domaindns.FQDNView)
+
+	mu    sync.Mutex
+	views map[string]domaindns.FQDNView
+	timer *time.Timer // pending projection, nil when none
+
+	// writeMu orders the writes, so that the last state projected wins.
+	writeMu sync.Mutex
+	stopped bool
+}
+
+// replace sets the full state of the stream and projects it at once.
+func (p *remoteProjection) replace(views map[string]domaindns.FQDNView) {
+	p.mu.Lock()
+	p.views = views
+	p.mu.Unlock()
+	p.flush()
+}
+
+// update sets the view of key, or deletes it when view is nil, schedules the
+// projection and returns the number of views.
+func (p *remoteProjection) update(key string, view *domaindns.FQDNView) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if view == nil {
+		delete(p.views, key)
+	} else {
+		p.views[key] = *view
+	}
+	if p.timer == nil {
+		p.timer = time.AfterFunc(remoteStreamProjectDelay, p.flush)
+	}
+	return len(p.views)
+}
+
+// flush projects the current state unless the projection is stopped.
+func (p *remoteProjection) flush() {
+	p.writeMu.Lock()
+	defer p.writeMu.Unlock()
+	if p.stopped {
+		return
+	}
+	p.mu.Lock()
+	if p.timer != nil {
+		p.timer.Stop()
+		p.timer = nil
+	}
+	views := slices.Collect(maps.Values(p.views))
+	p.mu.Unlock()
+	p.write(views)
+}
+
+// stop drops the pending projection and prevents the later ones: the stream
+// no longer owns the FQDNs of its portal.
+func (p *remoteProjection) stop() {
+	p.writeMu.Lock()
+	defer p.writeMu.Unlock()
+	p.stopped = true
+	p.mu.Lock()
+	if p.timer != nil {
+		p.timer.Stop()
+		p.timer = nil
+	}
+	p.mu.Unlock()
+}
+
+// stop cancels the stream and waits for it to end.
+func (st *remoteStream) stop() {
+	st.cancel()
+	<-st.done
+}
+
+// expired reports whether the stream stopped on an error long enough ago to
+// be opened again.
+func (st *remoteStream) expired() bool {
+	select {
+	case <-st.done:
+		return time.Since(st.supervisor.Health().Since) >= remoteStreamRetryAfter
+	default:
+		return false
+	}
+}
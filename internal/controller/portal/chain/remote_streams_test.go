@@ -0,0 +1,316 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chain_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/controller/portal/chain"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	sreportalv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	"github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1/sreportalv1connect"
+	dnsreadstore "github.com/golgoth31/sreportal/internal/readstore/dns"
+	"github.com/golgoth31/sreportal/internal/reconciler"
+	"github.com/golgoth31/sreportal/internal/remoteclient"
+
+	"github.com/stretchr/testify/require"
+)
+
+// scriptedStreamHandler serves one scripted session per StreamFQDNs call.
+// Every session but the last ends after its updates, making the client
+// reconnect; the last one stays open, streaming what is pushed to live.
+type scriptedStreamHandler struct {
+	sreportalv1connect.UnimplementedDNSServiceHandler
+
+	mu       sync.Mutex
+	sessions [][]*sreportalv1.StreamFQDNsResponse
+	calls    int
+	live     chan *sreportalv1.StreamFQDNsResponse
+}
+
+func (h *scriptedStreamHandler) StreamFQDNs(
+	ctx context.Context,
+	_ *connect.Request[sreportalv1.StreamFQDNsRequest],
+	stream *connect.ServerStream[sreportalv1.StreamFQDNsResponse],
+) error {
+	h.mu.Lock()
+	i := min(h.calls, len(h.sessions)-1)
+	h.calls++
+	last := i == len(h.sessions)-1
+	h.mu.Unlock()
+
+	for _, u := range h.sessions[i] {
+		if err := stream.Send(u); err != nil {
+			return err
+		}
+	}
+	if !last {
+		return nil
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case u := <-h.live:
+			if err := stream.Send(u); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (h *scriptedStreamHandler) sessionCount() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.calls
+}
+
+func streamedFQDN(t sreportalv1.UpdateType, name string, targets ...string) *sreportalv1.StreamFQDNsResponse {
+	return &sreportalv1.StreamFQDNsResponse{Type: t, Fqdn: &sreportalv1.FQDN{Name: name, RecordType: "A", Targets: targets}}
+}
+
+var streamSynced = &sreportalv1.StreamFQDNsResponse{Type: sreportalv1.UpdateType_UPDATE_TYPE_SYNCED}
+
+// countingWriter counts the Replace calls of the FQDNWriter it wraps.
+type countingWriter struct {
+	domaindns.FQDNWriter
+	replaces atomic.Int32
+}
+
+func (w *countingWriter) Replace(ctx context.Context, recordKey, portalRef string, fqdns []domaindns.FQDNView) error {
+	w.replaces.Add(1)
+	return w.FQDNWriter.Replace(ctx, recordKey, portalRef, fqdns)
+}
+
+func newRemoteStreamsFixture(t *testing.T, h *scriptedStreamHandler) (*chain.RemoteStreams, *dnsreadstore.FQDNStore, *sreportalv1alpha1.Portal) {
+	t.Helper()
+	return newRemoteStreamsFixtureWriting(t, h, nil)
+}
+
+// newRemoteStreamsFixtureWriting is newRemoteStreamsFixture projecting
+// through writer, when set, instead of the store directly.
+func newRemoteStreamsFixtureWriting(t *testing.T, h *scriptedStreamHandler, writer *countingWriter) (*chain.RemoteStreams, *dnsreadstore.FQDNStore, *sreportalv1alpha1.Portal) {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.Handle(sreportalv1connect.NewDNSServiceHandler(h))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	store := dnsreadstore.NewFQDNStore()
+	var projected domaindns.FQDNWriter = store
+	if writer != nil {
+		writer.FQDNWriter = store
+		projected = writer
+	}
+	streams := chain.NewRemoteStreams(projected,
+		remoteclient.WithStreamBackoff(time.Millisecond, time.Millisecond),
+		remoteclient.WithStreamJitter(0))
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = streams.Start(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	portal := &sreportalv1alpha1.Portal{
+		ObjectMeta: metav1.ObjectMeta{Name: "remote-a", Namespace: nsDefault, UID: "uid-a"},
+		Spec: sreportalv1alpha1.PortalSpec{
+			Title: "Remote A",
+			Remote: &sreportalv1alpha1.RemotePortalSpec{
+				URL: server.URL, Portal: tPortalMain, SyncMode: sreportalv1alpha1.RemoteSyncStream,
+			},
+		},
+	}
+	return streams, store, portal
+}
+
+// streamedNames returns the targets of the FQDNs projected for portal, by name.
+func streamedNames(t *testing.T, store *dnsreadstore.FQDNStore, portal string) map[string][]string {
+	t.Helper()
+	views, err := store.List(context.Background(), domaindns.FQDNFilters{Portal: portal})
+	require.NoError(t, err)
+	out := make(map[string][]string, len(views))
+	for _, v := range views {
+		out[v.Name] = v.Targets
+	}
+	return out
+}
+
+func TestRemoteStreamsAppliesChangesIncrementally(t *testing.T) {
+	h := &scriptedStreamHandler{
+		sessions: [][]*sreportalv1.StreamFQDNsResponse{{
+			streamedFQDN(sreportalv1.UpdateType_UPDATE_TYPE_ADDED, "a.example.com", "10.0.0.1"),
+			streamedFQDN(sreportalv1.UpdateType_UPDATE_TYPE_ADDED, "b.example.com", "10.0.0.2"),
+			streamSynced,
+		}},
+		live: make(chan *sreportalv1.StreamFQDNsResponse),
+	}
+	streams, store, portal := newRemoteStreamsFixture(t, h)
+	key := portal.Namespace + "/" + portal.Name
+
+	streams.Follow(portal, remoteclient.NewClient())
+
+	require.Eventually(t, func() bool { return len(streamedNames(t, store, portal.Name)) == 2 }, 5*time.Second, 10*time.Millisecond)
+	health, ok := streams.Health(key)
+	require.True(t, ok)
+	require.Equal(t, sreportalv1alpha1.RemoteStreamConnected, health.State)
+	require.Equal(t, 2, streams.Count(key))
+	select {
+	case e := <-streams.Events():
+		require.Equal(t, portal.Name, e.Object.GetName())
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event on stream transition")
+	}
+
+	h.live <- streamedFQDN(sreportalv1.UpdateType_UPDATE_TYPE_MODIFIED, "a.example.com", "10.0.0.9")
+	h.live <- streamedFQDN(sreportalv1.UpdateType_UPDATE_TYPE_DELETED, "b.example.com")
+	h.live <- streamedFQDN(sreportalv1.UpdateType_UPDATE_TYPE_ADDED, "c.example.com", "10.0.0.3")
+
+	require.Eventually(t, func() bool {
+		names := streamedNames(t, store, portal.Name)
+		return len(names) == 2 && names["c.example.com"] != nil && names["a.example.com"][0] == "10.0.0.9"
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, 1, h.sessionCount(), "incremental changes must not reopen the stream")
+}
+
+func TestRemoteStreamsCoalescesBursts(t *testing.T) {
+	h := &scriptedStreamHandler{
+		sessions: [][]*sreportalv1.StreamFQDNsResponse{{
+			streamedFQDN(sreportalv1.UpdateType_UPDATE_TYPE_ADDED, "a.example.com", "10.0.0.1"),
+			streamSynced,
+		}},
+		live: make(chan *sreportalv1.StreamFQDNsResponse),
+	}
+	writer := &countingWriter{}
+	streams, store, portal := newRemoteStreamsFixtureWriting(t, h, writer)
+
+	streams.Follow(portal, remoteclient.NewClient())
+	require.Eventually(t, func() bool { return len(streamedNames(t, store, portal.Name)) == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, int32(1), writer.replaces.Load(), "the initial state is projected at once")
+
+	for _, name := range []string{"b.example.com", "c.example.com", "d.example.com", "e.example.com"} {
+		h.live <- streamedFQDN(sreportalv1.UpdateType_UPDATE_TYPE_ADDED, name, "10.0.0.2")
+	}
+	require.Eventually(t, func() bool { return len(streamedNames(t, store, portal.Name)) == 5 }, 5*time.Second, 10*time.Millisecond)
+	require.Less(t, writer.replaces.Load(), int32(5), "a burst of changes is not projected once per change")
+}
+
+func TestRemoteStreamsResyncsOnReconnect(t *testing.T) {
+	h := &scriptedStreamHandler{
+		sessions: [][]*sreportalv1.StreamFQDNsResponse{
+			{
+				streamedFQDN(sreportalv1.UpdateType_UPDATE_TYPE_ADDED, "a.example.com", "10.0.0.1"),
+				streamedFQDN(sreportalv1.UpdateType_UPDATE_TYPE_ADDED, "b.example.com", "10.0.0.2"),
+				streamSynced,
+			},
+			// b.example.com was deleted while disconnected.
+			{
+				streamedFQDN(sreportalv1.UpdateType_UPDATE_TYPE_ADDED, "a.example.com", "10.0.0.1"),
+				streamSynced,
+			},
+		},
+		live: make(chan *sreportalv1.StreamFQDNsResponse),
+	}
+	streams, store, portal := newRemoteStreamsFixture(t, h)
+
+	streams.Follow(portal, remoteclient.NewClient())
+
+	require.Eventually(t, func() bool {
+		names := streamedNames(t, store, portal.Name)
+		return h.sessionCount() == 2 && len(names) == 1 && names["a.example.com"] != nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestRemoteStreamsStop(t *testing.T) {
+	h := &scriptedStreamHandler{
+		sessions: [][]*sreportalv1.StreamFQDNsResponse{{streamSynced}},
+		live:     make(chan *sreportalv1.StreamFQDNsResponse),
+	}
+	streams, _, portal := newRemoteStreamsFixture(t, h)
+	key := portal.Namespace + "/" + portal.Name
+	c := remoteclient.NewClient()
+
+	streams.Follow(portal, c)
+	streams.Follow(portal, c)
+	streams.Stop(key)
+
+	_, ok := streams.Health(key)
+	require.False(t, ok)
+	require.LessOrEqual(t, h.sessionCount(), 1, "following twice with the same client must not reopen the stream")
+}
+
+func TestSyncRemoteDNSFollowsStreamInStreamMode(t *testing.T) {
+	h := &scriptedStreamHandler{
+		sessions: [][]*sreportalv1.StreamFQDNsResponse{{
+			streamedFQDN(sreportalv1.UpdateType_UPDATE_TYPE_ADDED, "a.example.com", "10.0.0.1"),
+			streamSynced,
+		}},
+		live: make(chan *sreportalv1.StreamFQDNsResponse),
+	}
+	streams, store, portal := newRemoteStreamsFixture(t, h)
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha1.AddToScheme(scheme))
+	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(portal).
+		WithStatusSubresource(&sreportalv1alpha2.DNS{}).Build()
+	handler := chain.NewSyncRemoteDNSHandler(cli, scheme)
+
+	rc := &reconciler.ReconcileContext[*sreportalv1alpha1.Portal, chain.ChainData]{
+		Resource: portal,
+		Data: chain.ChainData{
+			FQDNWriter:    store,
+			RemoteStreams: streams,
+			RemoteClient:  remoteclient.NewClient(),
+		},
+	}
+	require.NoError(t, handler.Handle(context.Background(), rc))
+	require.Eventually(t, func() bool {
+		health, _ := streams.Health(portal.Namespace + "/" + portal.Name)
+		return health.State == sreportalv1alpha1.RemoteStreamConnected
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, handler.Handle(context.Background(), rc))
+
+	cond := meta.FindStatusCondition(portal.Status.Conditions, "DNSSynced")
+	require.NotNil(t, cond)
+	require.Equal(t, "RemoteStreamConnected", cond.Reason)
+	var dns sreportalv1alpha2.DNS
+	require.NoError(t, cli.Get(context.Background(), types.NamespacedName{Namespace: nsDefault, Name: chain.RemoteDNSName(portal.Name)}, &dns))
+	synced := meta.FindStatusCondition(dns.Status.Conditions, "RemoteSynced")
+	require.NotNil(t, synced)
+	require.Equal(t, metav1.ConditionTrue, synced.Status)
+	require.Equal(t, "1 FQDNs followed from "+portal.Spec.Remote.URL, synced.Message)
+}
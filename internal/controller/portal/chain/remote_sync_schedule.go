@@ -0,0 +1,54 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chain
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// DefaultRemoteSyncJitter is the default fraction of the sync interval randomly
+// added to each remote portal requeue.
+const DefaultRemoteSyncJitter = 0.1
+
+// RemoteSyncSchedule decides when a remote portal is synced next. It keeps a
+// stable cadence by subtracting the time the current sync took, and adds jitter
+// so remote portals drift apart instead of hitting a central portal together.
+// The zero value uses DefaultRemoteSyncInterval without jitter.
+type RemoteSyncSchedule struct {
+	// Interval is the target time between two syncs of the same portal.
+	Interval time.Duration
+	// Jitter is the fraction (0-1) of the computed delay randomly added to it.
+	Jitter float64
+}
+
+// Next returns the requeue delay for a sync that took elapsed. The delay never
+// drops below a tenth of the interval, so a remote slower than the interval
+// cannot make the controller fetch in a tight loop.
+func (s RemoteSyncSchedule) Next(elapsed time.Duration) time.Duration {
+	interval := s.Interval
+	if interval <= 0 {
+		interval = DefaultRemoteSyncInterval
+	}
+
+	delay := max(interval-elapsed, interval/10)
+	if s.Jitter > 0 {
+		delay = wait.Jitter(delay, s.Jitter)
+	}
+	return delay
+}
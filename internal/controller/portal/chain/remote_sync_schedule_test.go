@@ -0,0 +1,58 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golgoth31/sreportal/internal/controller/portal/chain"
+)
+
+func TestRemoteSyncSchedule_ZeroValueUsesDefaultInterval(t *testing.T) {
+	assert.Equal(t, chain.DefaultRemoteSyncInterval, chain.RemoteSyncSchedule{}.Next(0))
+}
+
+func TestRemoteSyncSchedule_SubtractsElapsed(t *testing.T) {
+	s := chain.RemoteSyncSchedule{Interval: time.Minute}
+
+	assert.Equal(t, 45*time.Second, s.Next(15*time.Second))
+}
+
+func TestRemoteSyncSchedule_FloorsSlowSyncs(t *testing.T) {
+	s := chain.RemoteSyncSchedule{Interval: time.Minute}
+
+	assert.Equal(t, 6*time.Second, s.Next(2*time.Minute))
+}
+
+func TestRemoteSyncSchedule_JitterStaysInBounds(t *testing.T) {
+	s := chain.RemoteSyncSchedule{Interval: time.Minute, Jitter: 0.5}
+
+	for range 100 {
+		d := s.Next(0)
+		assert.GreaterOrEqual(t, d, time.Minute)
+		assert.LessOrEqual(t, d, 90*time.Second)
+	}
+}
+
+func TestChainData_NextRemoteSync_ZeroStartIgnoresElapsed(t *testing.T) {
+	d := chain.ChainData{RemoteSync: chain.RemoteSyncSchedule{Interval: time.Minute}}
+
+	assert.Equal(t, time.Minute, d.NextRemoteSync())
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -29,6 +30,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/adapter"
 	alertmanagerctrl "github.com/golgoth31/sreportal/internal/controller/alertmanager/chain"
 	"github.com/golgoth31/sreportal/internal/log"
 	"github.com/golgoth31/sreportal/internal/reconciler"
@@ -37,7 +39,7 @@ import (
 
 // SyncRemoteAlertmanagerHandler discovers alertmanagers on the remote portal and creates
 // one local Alertmanager CR per remote alertmanager.
-// No-op for local portals or when alerts feature is disabled.
+// No-op for local and snapshot portals or when alerts feature is disabled.
 type SyncRemoteAlertmanagerHandler struct {
 	client client.Client
 	scheme *runtime.Scheme
@@ -51,7 +53,8 @@ func NewSyncRemoteAlertmanagerHandler(c client.Client, scheme *runtime.Scheme) *
 // Handle implements reconciler.Handler.
 func (h *SyncRemoteAlertmanagerHandler) Handle(ctx context.Context, rc *reconciler.ReconcileContext[*sreportalv1alpha1.Portal, ChainData]) error {
 	portal := rc.Resource
-	if portal.Spec.Remote == nil {
+	// A snapshot only carries FQDNs.
+	if portal.Spec.Remote == nil || portal.Spec.Remote.IsSnapshot() {
 		return nil
 	}
 
@@ -62,7 +65,7 @@ func (h *SyncRemoteAlertmanagerHandler) Handle(ctx context.Context, rc *reconcil
 		return nil
 	}
 
-	if err := h.reconcileRemoteAlertmanager(ctx, portal, rc.Data.RemoteClient); err != nil {
+	if err := h.reconcileRemoteAlertmanager(ctx, &rc.Data, portal); err != nil {
 		remoteLog.Error(err, "failed to reconcile Alertmanager for remote portal")
 		meta.SetStatusCondition(&portal.Status.Conditions, metav1.Condition{
 			Type:               "AlertsSynced",
@@ -84,10 +87,10 @@ func (h *SyncRemoteAlertmanagerHandler) Handle(ctx context.Context, rc *reconcil
 	return nil
 }
 
-func (h *SyncRemoteAlertmanagerHandler) reconcileRemoteAlertmanager(ctx context.Context, portal *sreportalv1alpha1.Portal, remoteClient *remoteclient.Client) error {
+func (h *SyncRemoteAlertmanagerHandler) reconcileRemoteAlertmanager(ctx context.Context, data *ChainData, portal *sreportalv1alpha1.Portal) error {
 	logger := log.FromContext(ctx)
 
-	remoteAMs, err := remoteClient.DiscoverAlertmanagers(ctx, portal.Spec.Remote.URL, portal.Spec.Remote.Portal)
+	remoteAMs, err := data.RemoteClient.DiscoverAlertmanagers(ctx, portal.Spec.Remote.URL, portal.Spec.Remote.Portal)
 	if err != nil {
 		return fmt.Errorf("discover remote alertmanagers: %w", err)
 	}
@@ -105,7 +108,7 @@ func (h *SyncRemoteAlertmanagerHandler) reconcileRemoteAlertmanager(ctx context.
 		}
 	}
 
-	if err := h.cleanupOrphanedAlertmanagers(ctx, portal, expectedNames); err != nil {
+	if err := h.cleanupOrphanedAlertmanagers(ctx, data, portal, expectedNames); err != nil {
 		return fmt.Errorf("cleanup orphaned alertmanagers: %w", err)
 	}
 
@@ -147,9 +150,11 @@ func (h *SyncRemoteAlertmanagerHandler) ensureAlertmanagerCR(
 		}
 	}
 
+	base := am.DeepCopy()
 	if err := controllerutil.SetControllerReference(portal, am, h.scheme); err != nil {
 		return fmt.Errorf("set controller reference: %w", err)
 	}
+	setManagedBy(am, portal.Name)
 
 	if isNew {
 		if err := h.client.Create(ctx, am); err != nil {
@@ -164,21 +169,22 @@ func (h *SyncRemoteAlertmanagerHandler) ensureAlertmanagerCR(
 	am.Spec.URL.Remote = remoteAM.RemoteURL
 	am.Spec.IsRemote = true
 
-	if am.Labels == nil {
-		am.Labels = make(map[string]string)
-	}
 	am.Labels[alertmanagerctrl.LabelRemoteAlertmanagerName] = remoteAM.Name
 
-	if err := h.client.Update(ctx, am); err != nil {
+	updated, err := updateIfChanged(ctx, h.client, base, am)
+	if err != nil {
 		return fmt.Errorf("update Alertmanager: %w", err)
 	}
-	logger.V(1).Info("updated Alertmanager CR for remote alertmanager", "alertmanager", amName, "remoteAM", remoteAM.Name)
+	if updated {
+		logger.V(1).Info("updated Alertmanager CR for remote alertmanager", "alertmanager", amName, "remoteAM", remoteAM.Name)
+	}
 
 	return nil
 }
 
 func (h *SyncRemoteAlertmanagerHandler) cleanupOrphanedAlertmanagers(
 	ctx context.Context,
+	data *ChainData,
 	portal *sreportalv1alpha1.Portal,
 	expectedNames map[string]struct{},
 ) error {
@@ -191,7 +197,9 @@ func (h *SyncRemoteAlertmanagerHandler) cleanupOrphanedAlertmanagers(
 
 	for i := range amList.Items {
 		am := &amList.Items[i]
-		if !am.Spec.IsRemote || am.Spec.PortalRef != portal.Name {
+		// Shadows are identified by their labels; those created before the
+		// labels existed are recognised by their spec.
+		if !adapter.HasStandardLabels(am, portal.Name) && (!am.Spec.IsRemote || am.Spec.PortalRef != portal.Name) {
 			continue
 		}
 
@@ -211,10 +219,14 @@ func (h *SyncRemoteAlertmanagerHandler) cleanupOrphanedAlertmanagers(
 		}
 
 		logger.Info("deleting orphaned Alertmanager CR", "alertmanager", am.Name)
-		if err := h.client.Delete(ctx, am); err != nil && !errors.IsNotFound(err) {
-			logger.Error(err, "failed to delete orphaned Alertmanager, continuing", "alertmanager", am.Name)
+		if err := h.client.Delete(ctx, am); err != nil {
+			if !errors.IsNotFound(err) {
+				logger.Error(err, "failed to delete orphaned Alertmanager, continuing", "alertmanager", am.Name)
+			}
 			continue
 		}
+		data.Event(portal, corev1.EventTypeNormal, "OrphanDeleted", "Delete",
+			"deleted Alertmanager %s: no longer exposed by the remote portal", am.Name)
 	}
 
 	return nil
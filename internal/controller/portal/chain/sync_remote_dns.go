@@ -18,10 +18,17 @@ package chain
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"maps"
+	"reflect"
 	"slices"
+	"strings"
+	"sync"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -40,30 +47,57 @@ import (
 
 // SyncRemoteDNSHandler creates or updates a DNS CR with FQDNs fetched from a remote portal.
 // No-op for local portals or when DNS feature is disabled.
+//
+// Updates are differential: FQDNs whose content did not change keep the view
+// projected by the previous sync, and when the whole content hash is unchanged
+// neither the read store nor the DNS status is written.
 type SyncRemoteDNSHandler struct {
 	client client.Client
 	scheme *runtime.Scheme
+
+	mu     sync.Mutex
+	synced map[string]remoteDNSState // by DNS key ("namespace/name")
+}
+
+// remoteDNSState is what the last sync of a remote DNS projected into the
+// read store. It lives in memory like the store itself, so a restart starts
+// from a full projection.
+type remoteDNSState struct {
+	hash  string
+	views map[string]domaindns.FQDNView // by "name/recordType"
 }
 
 // NewSyncRemoteDNSHandler creates a new SyncRemoteDNSHandler.
 func NewSyncRemoteDNSHandler(c client.Client, scheme *runtime.Scheme) *SyncRemoteDNSHandler {
-	return &SyncRemoteDNSHandler{client: c, scheme: scheme}
+	return &SyncRemoteDNSHandler{client: c, scheme: scheme, synced: map[string]remoteDNSState{}}
 }
 
 // Handle implements reconciler.Handler.
 func (h *SyncRemoteDNSHandler) Handle(ctx context.Context, rc *reconciler.ReconcileContext[*sreportalv1alpha1.Portal, ChainData]) error {
 	portal := rc.Resource
 	if portal.Spec.Remote == nil {
+		h.forget(portal.Namespace + "/" + RemoteDNSName(portal.Name))
+		stopRemoteStream(&rc.Data, portal)
 		return nil
 	}
 
 	remoteLog := log.Default().WithName("portal").WithName("remote")
 
 	if !portal.Spec.Features.IsDNSEnabled() {
+		// The disabled-features cleanup empties the read store: the next
+		// sync after re-enabling must project everything again.
+		h.forget(portal.Namespace + "/" + RemoteDNSName(portal.Name))
 		remoteLog.V(1).Info("DNS feature disabled, skipping remote DNS sync", "portal", portal.Name)
 		return nil
 	}
 
+	if rc.Data.streaming(portal) && rc.Data.RemoteClient != nil {
+		h.handleStream(ctx, portal, &rc.Data)
+		return nil
+	}
+	// Back to Poll: the stream must no longer write what the sync projects.
+	stopRemoteStream(&rc.Data, portal)
+
 	if err := h.reconcileRemoteDNS(ctx, portal, rc.Data.FetchResult, &rc.Data); err != nil {
 		remoteLog.Warn("failed to reconcile DNS for remote portal", "name", portal.Name, "namespace", portal.Namespace, "error", err.Error())
 		meta.SetStatusCondition(&portal.Status.Conditions, metav1.Condition{
@@ -73,6 +107,7 @@ func (h *SyncRemoteDNSHandler) Handle(ctx context.Context, rc *reconciler.Reconc
 			Message:            fmt.Sprintf("Failed to sync DNS from remote portal: %v", err),
 			LastTransitionTime: metav1.Now(),
 		})
+		markRemoteDNSNotSynced(ctx, h.client, portal, "RemoteSyncFailed", err.Error())
 	} else {
 		meta.SetStatusCondition(&portal.Status.Conditions, metav1.Condition{
 			Type:               "DNSSynced",
@@ -86,19 +121,186 @@ func (h *SyncRemoteDNSHandler) Handle(ctx context.Context, rc *reconciler.Reconc
 	return nil
 }
 
+// handleStream follows the FQDNs of a portal in Stream sync mode and reports
+// the state of its stream in the DNSSynced condition.
+func (h *SyncRemoteDNSHandler) handleStream(ctx context.Context, portal *sreportalv1alpha1.Portal, data *ChainData) {
+	health, err := h.followRemoteDNS(ctx, portal, data)
+	condition := metav1.Condition{
+		Type:               "DNSSynced",
+		Status:             metav1.ConditionTrue,
+		Reason:             "RemoteStreamConnected",
+		Message:            fmt.Sprintf("Following %d FQDNs from remote portal", data.RemoteStreams.Count(portal.Namespace+"/"+portal.Name)),
+		LastTransitionTime: metav1.Now(),
+	}
+	switch {
+	case err != nil:
+		log.Default().WithName("portal").WithName("remote").Warn("failed to reconcile DNS for remote portal", "name", portal.Name, "namespace", portal.Namespace, "error", err.Error())
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "DNSSyncFailed"
+		condition.Message = fmt.Sprintf("Failed to sync DNS from remote portal: %v", err)
+	case health.State != sreportalv1alpha1.RemoteStreamConnected:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "RemoteStream" + string(health.State)
+		condition.Message = remoteStreamMessage(health)
+	}
+	meta.SetStatusCondition(&portal.Status.Conditions, condition)
+}
+
+// followRemoteDNS ensures the DNS CR of a portal in Stream sync mode and the
+// stream projecting its FQDNs, and records the stream state on the DNS CR.
+func (h *SyncRemoteDNSHandler) followRemoteDNS(ctx context.Context, portal *sreportalv1alpha1.Portal, data *ChainData) (remoteclient.StreamHealth, error) {
+	dns, err := h.ensureRemoteDNS(ctx, portal)
+	if err != nil {
+		return remoteclient.StreamHealth{}, err
+	}
+	// The stream replaces the projection of the last poll: a switch back to
+	// Poll must project everything again.
+	h.forget(dns.Namespace + "/" + dns.Name)
+
+	key := portal.Namespace + "/" + portal.Name
+	data.RemoteStreams.Follow(portal, data.RemoteClient)
+	health, _ := data.RemoteStreams.Health(key)
+	count := data.RemoteStreams.Count(key)
+
+	base := dns.DeepCopy()
+	now := metav1.Now()
+	if health.State == sreportalv1alpha1.RemoteStreamConnected {
+		meta.SetStatusCondition(&dns.Status.Conditions, metav1.Condition{
+			Type:               conditionTypeReady,
+			Status:             metav1.ConditionTrue,
+			Reason:             "RemoteStreamConnected",
+			Message:            fmt.Sprintf("Following %d FQDNs from remote portal", count),
+			LastTransitionTime: now,
+		})
+		meta.SetStatusCondition(&dns.Status.Conditions, metav1.Condition{
+			Type:               conditionTypeRemoteSynced,
+			Status:             metav1.ConditionTrue,
+			Reason:             "RemoteStreamConnected",
+			Message:            fmt.Sprintf("%d FQDNs followed from %s", count, portal.Spec.Remote.Location()),
+			LastTransitionTime: now,
+		})
+	} else {
+		meta.SetStatusCondition(&dns.Status.Conditions, metav1.Condition{
+			Type:               conditionTypeRemoteSynced,
+			Status:             metav1.ConditionFalse,
+			Reason:             "RemoteStream" + string(health.State),
+			Message:            remoteStreamMessage(health),
+			LastTransitionTime: now,
+		})
+	}
+	if equality.Semantic.DeepEqual(base.Status, dns.Status) {
+		return health, nil
+	}
+	dns.Status.LastReconcileTime = &now
+	if err := h.client.Status().Patch(ctx, dns, client.MergeFrom(base)); err != nil {
+		return health, fmt.Errorf("patch DNS status: %w", err)
+	}
+	return health, nil
+}
+
+// remoteStreamMessage describes a stream that is not connected.
+func remoteStreamMessage(health remoteclient.StreamHealth) string {
+	message := "Stream to remote portal is " + strings.ToLower(string(health.State))
+	if health.LastError != "" {
+		message += ": " + health.LastError
+	}
+	return message
+}
+
+// stopRemoteStream stops the stream of a portal no longer in Stream sync mode.
+func stopRemoteStream(data *ChainData, portal *sreportalv1alpha1.Portal) {
+	if data.RemoteStreams != nil {
+		data.RemoteStreams.Stop(portal.Namespace + "/" + portal.Name)
+	}
+}
+
 func (h *SyncRemoteDNSHandler) reconcileRemoteDNS(ctx context.Context, portal *sreportalv1alpha1.Portal, result *remoteclient.FetchResult, data *ChainData) error {
 	logger := log.FromContext(ctx)
 
+	dns, err := h.ensureRemoteDNS(ctx, portal)
+	if err != nil {
+		return err
+	}
+	dnsName := dns.Name
+	resourceKey := dns.Namespace + "/" + dnsName
+
+	prev := h.state(resourceKey)
+	next, diff := mergeRemoteViews(prev, fqdnViewsFromRemoteGroups(result.Groups, dns.Spec.PortalRef, dns.Namespace))
+	message := fmt.Sprintf("Successfully synced %d FQDNs from remote portal", result.FQDNCount)
+
+	if next.hash == prev.hash && readyWithMessage(dns.Status.Conditions, message) &&
+		meta.IsStatusConditionTrue(dns.Status.Conditions, conditionTypeRemoteSynced) {
+		logger.V(1).Info("remote FQDNs unchanged", "dns", dnsName, "portal", portal.Name, "fqdnCount", result.FQDNCount)
+		return nil
+	}
+
+	dnsBase := dns.DeepCopy()
+	now := metav1.Now()
+	dns.Status.LastReconcileTime = &now
+	meta.SetStatusCondition(&dns.Status.Conditions, metav1.Condition{
+		Type:               conditionTypeReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             "RemoteSyncSuccess",
+		Message:            message,
+		LastTransitionTime: now,
+	})
+	meta.SetStatusCondition(&dns.Status.Conditions, metav1.Condition{
+		Type:               conditionTypeRemoteSynced,
+		Status:             metav1.ConditionTrue,
+		Reason:             "RemoteSyncSuccess",
+		Message:            fmt.Sprintf("%d FQDNs in %d groups synced from %s", result.FQDNCount, len(result.Groups), portal.Spec.Remote.Location()),
+		LastTransitionTime: now,
+	})
+
+	if err := h.client.Status().Patch(ctx, dns, client.MergeFrom(dnsBase)); err != nil {
+		return fmt.Errorf("patch DNS status: %w", err)
+	}
+
+	// Project remote FQDNs directly into the FQDN read store. The read store
+	// (in-memory) is the source of truth for the API/UI; the DNS CR no longer
+	// materialises grouped FQDNs in its status.
+	// Unchanged FQDNs are passed back as previously projected; the write is
+	// skipped entirely when nothing changed since the last sync.
+	switch {
+	case next.hash == prev.hash:
+	case data.FQDNWriter == nil:
+		h.remember(resourceKey, next)
+	default:
+		views := slices.Collect(maps.Values(next.views))
+		if err := data.FQDNWriter.Replace(ctx, resourceKey, dns.Spec.PortalRef, views); err != nil {
+			logger.Error(err, "failed to update FQDN read store for remote DNS")
+		} else {
+			h.remember(resourceKey, next)
+		}
+	}
+
+	logger.Info("synced remote FQDNs",
+		"dns", dnsName,
+		"portal", portal.Name,
+		"fqdnCount", result.FQDNCount,
+		"groupCount", len(result.Groups),
+		"added", diff.added,
+		"updated", diff.updated,
+		"removed", diff.removed)
+
+	return nil
+}
+
+// ensureRemoteDNS creates or updates the DNS CR of a remote portal.
+func (h *SyncRemoteDNSHandler) ensureRemoteDNS(ctx context.Context, portal *sreportalv1alpha1.Portal) (*sreportalv1alpha2.DNS, error) {
+	logger := log.FromContext(ctx)
+
 	dnsName := RemoteDNSName(portal.Name)
 	dns := &sreportalv1alpha2.DNS{}
 
 	err := h.client.Get(ctx, types.NamespacedName{Name: dnsName, Namespace: portal.Namespace}, dns)
 	if err != nil && !errors.IsNotFound(err) {
-		return fmt.Errorf("failed to get DNS: %w", err)
+		return nil, fmt.Errorf("failed to get DNS: %w", err)
 	}
 
 	isNew := errors.IsNotFound(err)
 	if isNew {
+		h.forget(portal.Namespace + "/" + dnsName)
 		dns = &sreportalv1alpha2.DNS{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      dnsName,
@@ -116,56 +318,25 @@ func (h *SyncRemoteDNSHandler) reconcileRemoteDNS(ctx context.Context, portal *s
 		}
 	}
 
+	base := dns.DeepCopy()
 	if err := controllerutil.SetControllerReference(portal, dns, h.scheme); err != nil {
-		return fmt.Errorf("failed to set controller reference: %w", err)
+		return nil, fmt.Errorf("failed to set controller reference: %w", err)
 	}
+	setManagedBy(dns, portal.Name)
 
 	if isNew {
 		if err := h.client.Create(ctx, dns); err != nil {
-			return fmt.Errorf("failed to create DNS: %w", err)
+			return nil, fmt.Errorf("failed to create DNS: %w", err)
 		}
 		logger.Info("created DNS CR for remote portal", "dns", dnsName, "portal", portal.Name)
 	} else {
 		dns.Spec.PortalRef = portal.Name
 		dns.Spec.IsRemote = true
-		if err := h.client.Update(ctx, dns); err != nil {
-			return fmt.Errorf("failed to update DNS: %w", err)
-		}
-	}
-
-	dnsBase := dns.DeepCopy()
-	now := metav1.Now()
-	dns.Status.LastReconcileTime = &now
-	meta.SetStatusCondition(&dns.Status.Conditions, metav1.Condition{
-		Type:               conditionTypeReady,
-		Status:             metav1.ConditionTrue,
-		Reason:             "RemoteSyncSuccess",
-		Message:            fmt.Sprintf("Successfully synced %d FQDNs from remote portal", result.FQDNCount),
-		LastTransitionTime: now,
-	})
-
-	if err := h.client.Status().Patch(ctx, dns, client.MergeFrom(dnsBase)); err != nil {
-		return fmt.Errorf("patch DNS status: %w", err)
-	}
-
-	// Project remote FQDNs directly into the FQDN read store. The read store
-	// (in-memory) is the source of truth for the API/UI; the DNS CR no longer
-	// materialises grouped FQDNs in its status.
-	if data.FQDNWriter != nil {
-		resourceKey := dns.Namespace + "/" + dns.Name
-		views := fqdnViewsFromRemoteGroups(result.Groups, dns.Spec.PortalRef, dns.Namespace)
-		if err := data.FQDNWriter.Replace(ctx, resourceKey, dns.Spec.PortalRef, views); err != nil {
-			logger.Error(err, "failed to update FQDN read store for remote DNS")
+		if _, err := updateIfChanged(ctx, h.client, base, dns); err != nil {
+			return nil, fmt.Errorf("failed to update DNS: %w", err)
 		}
 	}
-
-	logger.Info("synced remote FQDNs",
-		"dns", dnsName,
-		"portal", portal.Name,
-		"fqdnCount", result.FQDNCount,
-		"groupCount", len(result.Groups))
-
-	return nil
+	return dns, nil
 }
 
 // fqdnViewsFromRemoteGroups builds a deduplicated slice of FQDNViews from the
@@ -213,7 +384,115 @@ func fqdnViewsFromRemoteGroups(groups []sreportalv1alpha1.FQDNGroupStatus, porta
 	return views
 }
 
+// markRemoteDNSNotSynced sets RemoteSynced=False on the DNS CR of a remote
+// portal, so `kubectl describe dns` shows why its FQDNs went stale.
+// Best-effort: the failure is already reported on the Portal.
+func markRemoteDNSNotSynced(ctx context.Context, c client.Client, portal *sreportalv1alpha1.Portal, reason, message string) {
+	dns := &sreportalv1alpha2.DNS{}
+	if err := c.Get(ctx, types.NamespacedName{Name: RemoteDNSName(portal.Name), Namespace: portal.Namespace}, dns); err != nil {
+		return
+	}
+	base := dns.DeepCopy()
+	meta.SetStatusCondition(&dns.Status.Conditions, metav1.Condition{
+		Type:               conditionTypeRemoteSynced,
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+	})
+	if err := c.Status().Patch(ctx, dns, client.MergeFrom(base)); err != nil {
+		log.FromContext(ctx).V(1).Info("failed to set RemoteSynced=False on remote DNS", "dns", dns.Name, "error", err.Error())
+	}
+}
+
 // RemoteDNSName returns the name of the DNS CR for a remote portal.
 func RemoteDNSName(portalName string) string {
 	return fmt.Sprintf("remote-%s", portalName)
 }
+
+func (h *SyncRemoteDNSHandler) state(key string) remoteDNSState {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.synced[key]
+}
+
+func (h *SyncRemoteDNSHandler) remember(key string, st remoteDNSState) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.synced[key] = st
+}
+
+func (h *SyncRemoteDNSHandler) forget(key string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.synced, key)
+}
+
+// readyWithMessage reports whether conditions hold a true Ready condition
+// with the given message.
+func readyWithMessage(conditions []metav1.Condition, message string) bool {
+	c := meta.FindStatusCondition(conditions, conditionTypeReady)
+	return c != nil && c.Status == metav1.ConditionTrue && c.Message == message
+}
+
+// remoteViewsDiff counts the FQDNs a sync adds, updates and removes.
+type remoteViewsDiff struct {
+	added, updated, removed int
+}
+
+// mergeRemoteViews merges the views fetched from a remote portal into the
+// state of the previous sync. A view whose content is unchanged is kept as
+// previously projected, so its LastSeen does not move when the remote does not
+// report one; only added and changed views are taken from incoming. The
+// returned state hash covers the merged views.
+func mergeRemoteViews(prev remoteDNSState, incoming []domaindns.FQDNView) (remoteDNSState, remoteViewsDiff) {
+	var diff remoteViewsDiff
+	next := remoteDNSState{views: make(map[string]domaindns.FQDNView, len(incoming))}
+	for _, v := range incoming {
+		key := v.Name + "/" + v.RecordType
+		old, ok := prev.views[key]
+		switch {
+		case !ok:
+			diff.added++
+			next.views[key] = v
+		case sameRemoteView(old, v):
+			next.views[key] = old
+		default:
+			diff.updated++
+			next.views[key] = v
+		}
+	}
+	for key := range prev.views {
+		if _, ok := next.views[key]; !ok {
+			diff.removed++
+		}
+	}
+	next.hash = hashRemoteViews(next.views)
+	return next, diff
+}
+
+// sameRemoteView compares two remote views, ignoring LastSeen.
+func sameRemoteView(a, b domaindns.FQDNView) bool {
+	a.LastSeen, b.LastSeen = time.Time{}, time.Time{}
+	return reflect.DeepEqual(a, b)
+}
+
+// hashRemoteViews returns a content hash of views, independent of map order.
+func hashRemoteViews(views map[string]domaindns.FQDNView) string {
+	h := sha256.New()
+	for _, k := range slices.Sorted(maps.Keys(views)) {
+		v := views[k]
+		var origin, record string
+		if v.OriginRef != nil {
+			origin = fmt.Sprintf("%+v", *v.OriginRef)
+		}
+		if v.DNSRecord != nil {
+			record = fmt.Sprintf("%+v", *v.DNSRecord)
+		}
+		v.OriginRef, v.DNSRecord = nil, nil
+		v.LastSeen = v.LastSeen.Round(0).UTC()
+		v.LastReconciled = v.LastReconciled.Round(0).UTC()
+		_, _ = fmt.Fprintf(h, "%s\x00%+v\x00%s\x00%s\n", k, v, origin, record)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chain_test
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/controller/portal/chain"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	dnsreadstore "github.com/golgoth31/sreportal/internal/readstore/dns"
+	"github.com/golgoth31/sreportal/internal/reconciler"
+	"github.com/golgoth31/sreportal/internal/remoteclient"
+
+	"github.com/stretchr/testify/require"
+)
+
+// countingFQDNWriter counts the Replace calls reaching the store.
+type countingFQDNWriter struct {
+	*dnsreadstore.FQDNStore
+	replaces int
+}
+
+func (w *countingFQDNWriter) Replace(ctx context.Context, recordKey, portalRef string, fqdns []domaindns.FQDNView) error {
+	w.replaces++
+	return w.FQDNStore.Replace(ctx, recordKey, portalRef, fqdns)
+}
+
+func newSyncRemoteDNSFixture(t *testing.T) (*chain.SyncRemoteDNSHandler, *sreportalv1alpha1.Portal, *countingFQDNWriter) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha1.AddToScheme(scheme))
+	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
+
+	portal := &sreportalv1alpha1.Portal{
+		ObjectMeta: metav1.ObjectMeta{Name: "remote-a", Namespace: nsDefault, UID: "uid-a"},
+		Spec: sreportalv1alpha1.PortalSpec{
+			Title:  "Remote A",
+			Remote: &sreportalv1alpha1.RemotePortalSpec{URL: remoteURL, Portal: tPortalMain},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(portal).
+		WithStatusSubresource(&sreportalv1alpha2.DNS{}).Build()
+	return chain.NewSyncRemoteDNSHandler(cli, scheme), portal, &countingFQDNWriter{FQDNStore: dnsreadstore.NewFQDNStore()}
+}
+
+func syncRemoteDNS(t *testing.T, h *chain.SyncRemoteDNSHandler, portal *sreportalv1alpha1.Portal, w domaindns.FQDNWriter, groups []sreportalv1alpha1.FQDNGroupStatus) {
+	t.Helper()
+	count := 0
+	for _, g := range groups {
+		count += len(g.FQDNs)
+	}
+	rc := &reconciler.ReconcileContext[*sreportalv1alpha1.Portal, chain.ChainData]{
+		Resource: portal,
+		Data: chain.ChainData{
+			FQDNWriter:  w,
+			FetchResult: &remoteclient.FetchResult{Groups: groups, FQDNCount: count},
+		},
+	}
+	require.NoError(t, h.Handle(context.Background(), rc))
+}
+
+func remoteGroup(name string, fqdns ...sreportalv1alpha1.FQDNStatus) sreportalv1alpha1.FQDNGroupStatus {
+	return sreportalv1alpha1.FQDNGroupStatus{Name: name, Source: "manual", FQDNs: fqdns}
+}
+
+func TestSyncRemoteDNSSkipsUnchangedContent(t *testing.T) {
+	h, portal, w := newSyncRemoteDNSFixture(t)
+	groups := []sreportalv1alpha1.FQDNGroupStatus{
+		remoteGroup("web",
+			sreportalv1alpha1.FQDNStatus{FQDN: "a.example.com", RecordType: "A", Targets: []string{"10.0.0.1"}},
+			sreportalv1alpha1.FQDNStatus{FQDN: "b.example.com", RecordType: "A", Targets: []string{"10.0.0.2"}},
+		),
+	}
+
+	syncRemoteDNS(t, h, portal, w, groups)
+	syncRemoteDNS(t, h, portal, w, groups)
+
+	require.Equal(t, 1, w.replaces)
+	views, err := w.List(context.Background(), domaindns.FQDNFilters{Portal: portal.Name})
+	require.NoError(t, err)
+	require.Len(t, views, 2)
+}
+
+func TestSyncRemoteDNSKeepsUnchangedEntriesAndRewritesChangedOnes(t *testing.T) {
+	h, portal, w := newSyncRemoteDNSFixture(t)
+	// The remote reports no LastSeen: the view of an unchanged FQDN must keep
+	// the time of the sync that first projected it.
+	syncRemoteDNS(t, h, portal, w, []sreportalv1alpha1.FQDNGroupStatus{
+		remoteGroup("web",
+			sreportalv1alpha1.FQDNStatus{FQDN: "a.example.com", RecordType: "A", Targets: []string{"10.0.0.1"}},
+			sreportalv1alpha1.FQDNStatus{FQDN: "b.example.com", RecordType: "A", Targets: []string{"10.0.0.2"}},
+		),
+	})
+	before := viewsByName(t, w, portal.Name)
+
+	syncRemoteDNS(t, h, portal, w, []sreportalv1alpha1.FQDNGroupStatus{
+		remoteGroup("web",
+			sreportalv1alpha1.FQDNStatus{FQDN: "a.example.com", RecordType: "A", Targets: []string{"10.0.0.1"}},
+			sreportalv1alpha1.FQDNStatus{FQDN: "b.example.com", RecordType: "A", Targets: []string{"10.0.0.3"}},
+			sreportalv1alpha1.FQDNStatus{FQDN: "c.example.com", RecordType: "CNAME", Targets: []string{"a.example.com"}},
+		),
+	})
+	after := viewsByName(t, w, portal.Name)
+
+	require.Equal(t, 2, w.replaces)
+	require.Len(t, after, 3)
+	require.Equal(t, before["a.example.com"].LastSeen, after["a.example.com"].LastSeen)
+	require.Equal(t, []string{"10.0.0.3"}, after["b.example.com"].Targets)
+	require.Contains(t, after, "c.example.com")
+}
+
+func TestSyncRemoteDNSRemovesEntriesGoneFromRemote(t *testing.T) {
+	h, portal, w := newSyncRemoteDNSFixture(t)
+	syncRemoteDNS(t, h, portal, w, []sreportalv1alpha1.FQDNGroupStatus{
+		remoteGroup("web",
+			sreportalv1alpha1.FQDNStatus{FQDN: "a.example.com", RecordType: "A", Targets: []string{"10.0.0.1"}},
+			sreportalv1alpha1.FQDNStatus{FQDN: "b.example.com", RecordType: "A", Targets: []string{"10.0.0.2"}},
+		),
+	})
+	syncRemoteDNS(t, h, portal, w, []sreportalv1alpha1.FQDNGroupStatus{
+		remoteGroup("web",
+			sreportalv1alpha1.FQDNStatus{FQDN: "a.example.com", RecordType: "A", Targets: []string{"10.0.0.1"}},
+		),
+	})
+
+	after := viewsByName(t, w, portal.Name)
+	require.Len(t, after, 1)
+	require.Contains(t, after, "a.example.com")
+}
+
+func viewsByName(t *testing.T, w *countingFQDNWriter, portalName string) map[string]domaindns.FQDNView {
+	t.Helper()
+	views, err := w.List(context.Background(), domaindns.FQDNFilters{Portal: portalName})
+	require.NoError(t, err)
+	out := make(map[string]domaindns.FQDNView, len(views))
+	for _, v := range views {
+		out[v.Name] = v
+	}
+	return out
+}
+
+func TestSyncRemoteDNSSetsRemoteSyncedCondition(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha1.AddToScheme(scheme))
+	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
+	portal := &sreportalv1alpha1.Portal{
+		ObjectMeta: metav1.ObjectMeta{Name: "remote-a", Namespace: nsDefault, UID: "uid-a"},
+		Spec: sreportalv1alpha1.PortalSpec{
+			Title:  "Remote A",
+			Remote: &sreportalv1alpha1.RemotePortalSpec{URL: remoteURL, Portal: tPortalMain},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(portal).
+		WithStatusSubresource(&sreportalv1alpha2.DNS{}).Build()
+	h := chain.NewSyncRemoteDNSHandler(cli, scheme)
+
+	syncRemoteDNS(t, h, portal, dnsreadstore.NewFQDNStore(), []sreportalv1alpha1.FQDNGroupStatus{
+		remoteGroup("web", sreportalv1alpha1.FQDNStatus{FQDN: "a.example.com", RecordType: "A", Targets: []string{"10.0.0.1"}}),
+	})
+
+	var dns sreportalv1alpha2.DNS
+	require.NoError(t, cli.Get(context.Background(), types.NamespacedName{Namespace: nsDefault, Name: chain.RemoteDNSName(portal.Name)}, &dns))
+	cond := meta.FindStatusCondition(dns.Status.Conditions, "RemoteSynced")
+	require.NotNil(t, cond)
+	require.Equal(t, metav1.ConditionTrue, cond.Status)
+	require.Equal(t, "1 FQDNs in 1 groups synced from "+remoteURL, cond.Message)
+}
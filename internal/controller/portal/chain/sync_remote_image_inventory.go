@@ -37,7 +37,7 @@ import (
 // ImageInventory CR (`remote-<portal>`) with IsRemote=true for each remote
 // portal, so the ImageInventory controller can fetch the remote portal's
 // image data and project it into the local readstore.
-// No-op for local portals or when the imageInventory feature is disabled.
+// No-op for local and snapshot portals or when the imageInventory feature is disabled.
 type SyncRemoteImageInventoryHandler struct {
 	client client.Client
 	scheme *runtime.Scheme
@@ -51,7 +51,8 @@ func NewSyncRemoteImageInventoryHandler(c client.Client, scheme *runtime.Scheme)
 // Handle implements reconciler.Handler.
 func (h *SyncRemoteImageInventoryHandler) Handle(ctx context.Context, rc *reconciler.ReconcileContext[*sreportalv1alpha1.Portal, ChainData]) error {
 	portal := rc.Resource
-	if portal.Spec.Remote == nil {
+	// A snapshot only carries FQDNs.
+	if portal.Spec.Remote == nil || portal.Spec.Remote.IsSnapshot() {
 		return nil
 	}
 
@@ -109,9 +110,11 @@ func (h *SyncRemoteImageInventoryHandler) reconcileRemoteImageInventory(ctx cont
 		}
 	}
 
+	base := inv.DeepCopy()
 	if err := controllerutil.SetControllerReference(portal, inv, h.scheme); err != nil {
 		return fmt.Errorf("set controller reference: %w", err)
 	}
+	setManagedBy(inv, portal.Name)
 
 	if isNew {
 		if err := h.client.Create(ctx, inv); err != nil {
@@ -122,10 +125,13 @@ func (h *SyncRemoteImageInventoryHandler) reconcileRemoteImageInventory(ctx cont
 		inv.Spec.PortalRef = portal.Name
 		inv.Spec.IsRemote = true
 
-		if err := h.client.Update(ctx, inv); err != nil {
+		updated, err := updateIfChanged(ctx, h.client, base, inv)
+		if err != nil {
 			return fmt.Errorf("update ImageInventory: %w", err)
 		}
-		logger.V(1).Info("updated ImageInventory CR for remote portal", "imageInventory", invName)
+		if updated {
+			logger.V(1).Info("updated ImageInventory CR for remote portal", "imageInventory", invName)
+		}
 	}
 
 	return nil
@@ -121,6 +121,32 @@ func TestSyncRemoteImageInventoryUpdatesExistingShadowCR(t *testing.T) {
 	}, got))
 	require.True(t, got.Spec.IsRemote)
 	require.Equal(t, portal.Name, got.Spec.PortalRef)
+	require.Equal(t, "portal-controller", got.Labels["sreportal.io/managed-by"], "pre-existing CR must be adopted")
+}
+
+func TestSyncRemoteImageInventorySkipsUpdateWhenUnchanged(t *testing.T) {
+	scheme := newSchemeForSyncRemoteImageInventoryTest(t)
+	portal := &sreportalv1alpha1.Portal{
+		ObjectMeta: metav1.ObjectMeta{Name: "remote-stable", Namespace: nsDefault, UID: "uid-stable"},
+		Spec: sreportalv1alpha1.PortalSpec{
+			Title:  "Remote Stable",
+			Remote: &sreportalv1alpha1.RemotePortalSpec{URL: remoteURL, Portal: tPortalMain},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(portal).Build()
+	h := chain.NewSyncRemoteImageInventoryHandler(cli, scheme)
+	key := types.NamespacedName{Name: chain.RemoteImageInventoryName(portal.Name), Namespace: portal.Namespace}
+
+	rc := &reconciler.ReconcileContext[*sreportalv1alpha1.Portal, chain.ChainData]{Resource: portal}
+	require.NoError(t, h.Handle(context.Background(), rc))
+	first := &sreportalv1alpha1.ImageInventory{}
+	require.NoError(t, cli.Get(context.Background(), key, first))
+
+	require.NoError(t, h.Handle(context.Background(), rc))
+	second := &sreportalv1alpha1.ImageInventory{}
+	require.NoError(t, cli.Get(context.Background(), key, second))
+
+	require.Equal(t, first.ResourceVersion, second.ResourceVersion, "unchanged CR must not be rewritten")
 }
 
 func TestSyncRemoteImageInventoryNoOpWhenFeatureDisabled(t *testing.T) {
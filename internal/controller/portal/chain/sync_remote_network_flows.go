@@ -35,7 +35,7 @@ import (
 
 // SyncRemoteNetworkFlowsHandler creates or updates a NetworkFlowDiscovery CR with
 // isRemote=true for remote portals.
-// No-op for local portals or when networkPolicy feature is disabled.
+// No-op for local and snapshot portals or when networkPolicy feature is disabled.
 type SyncRemoteNetworkFlowsHandler struct {
 	client client.Client
 	scheme *runtime.Scheme
@@ -49,7 +49,8 @@ func NewSyncRemoteNetworkFlowsHandler(c client.Client, scheme *runtime.Scheme) *
 // Handle implements reconciler.Handler.
 func (h *SyncRemoteNetworkFlowsHandler) Handle(ctx context.Context, rc *reconciler.ReconcileContext[*sreportalv1alpha1.Portal, ChainData]) error {
 	portal := rc.Resource
-	if portal.Spec.Remote == nil {
+	// A snapshot only carries FQDNs.
+	if portal.Spec.Remote == nil || portal.Spec.Remote.IsSnapshot() {
 		return nil
 	}
 
@@ -107,9 +108,11 @@ func (h *SyncRemoteNetworkFlowsHandler) reconcileRemoteNetworkFlows(ctx context.
 		}
 	}
 
+	base := nfd.DeepCopy()
 	if err := controllerutil.SetControllerReference(portal, nfd, h.scheme); err != nil {
 		return fmt.Errorf("set controller reference: %w", err)
 	}
+	setManagedBy(nfd, portal.Name)
 
 	if isNew {
 		if err := h.client.Create(ctx, nfd); err != nil {
@@ -119,7 +122,7 @@ func (h *SyncRemoteNetworkFlowsHandler) reconcileRemoteNetworkFlows(ctx context.
 	} else {
 		nfd.Spec.PortalRef = portal.Name
 		nfd.Spec.IsRemote = true
-		if err := h.client.Update(ctx, nfd); err != nil {
+		if _, err := updateIfChanged(ctx, h.client, base, nfd); err != nil {
 			return fmt.Errorf("update NetworkFlowDiscovery: %w", err)
 		}
 	}
@@ -0,0 +1,45 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chain
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/golgoth31/sreportal/internal/adapter"
+)
+
+// setManagedBy stamps obj with the portal controller's managed-by label and
+// the standard sreportal labels, so shadow resources created before the labels
+// existed are adopted for cleanup.
+func setManagedBy(obj client.Object, portal string) {
+	adapter.SetStandardLabels(obj, portal)
+	labels := obj.GetLabels()
+	labels[adapter.ManagedByLabelKey] = adapter.ManagedByPortalController
+}
+
+// updateIfChanged writes obj only when it differs from base, the copy taken
+// before mutation, sparing the API server (and watchers) a resourceVersion
+// bump on every sync. It reports whether an update was sent.
+func updateIfChanged(ctx context.Context, c client.Client, base, obj client.Object) (bool, error) {
+	if equality.Semantic.DeepEqual(base, obj) {
+		return false, nil
+	}
+	return true, c.Update(ctx, obj)
+}
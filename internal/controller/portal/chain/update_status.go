@@ -19,7 +19,9 @@ package chain
 import (
 	"context"
 	"fmt"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -89,32 +91,58 @@ func (h *UpdateStatusHandler) handleRemote(ctx context.Context, rc *reconciler.R
 		portal.Status.RemoteSync = &sreportalv1alpha1.RemoteSyncStatus{}
 	}
 	portal.Status.RemoteSync.LastSyncTime = &now
+	if !rc.Data.SyncStartedAt.IsZero() {
+		portal.Status.RemoteSync.LastSyncDuration = &metav1.Duration{Duration: time.Since(rc.Data.SyncStartedAt)}
+	}
 	portal.Status.RemoteSync.LastSyncError = ""
 	portal.Status.RemoteSync.RemoteTitle = result.RemoteTitle
 	portal.Status.RemoteSync.FQDNCount = result.FQDNCount
 	portal.Status.RemoteSync.Features = result.RemoteFeatures
+	portal.Status.RemoteSync.Stream = nil
 
-	meta.SetStatusCondition(&portal.Status.Conditions, metav1.Condition{
+	ready := metav1.Condition{
 		Type:               conditionTypeReady,
 		Status:             metav1.ConditionTrue,
 		Reason:             "RemoteSyncSuccess",
 		Message:            "Successfully synced with remote portal",
 		LastTransitionTime: metav1.Now(),
-	})
+	}
+	if rc.Data.streaming(portal) {
+		if health, ok := rc.Data.RemoteStreams.Health(portal.Namespace + "/" + portal.Name); ok {
+			portal.Status.RemoteSync.Stream = health.Status()
+			if health.State != sreportalv1alpha1.RemoteStreamConnected {
+				portal.Status.Ready = false
+				ready.Status = metav1.ConditionFalse
+				ready.Reason = "RemoteStream" + string(health.State)
+				ready.Message = remoteStreamMessage(health)
+			}
+		}
+	}
+	meta.SetStatusCondition(&portal.Status.Conditions, ready)
 
 	if err := h.client.Status().Patch(ctx, portal, client.MergeFrom(base)); err != nil {
 		return fmt.Errorf("patch Portal status: %w", err)
 	}
 
+	// Only announce the transition to synced (first sync or recovery), not
+	// every periodic sync.
+	if !base.Status.Ready && portal.Status.Ready {
+		rc.Data.Event(portal, corev1.EventTypeNormal, "RemoteSynced", "Sync",
+			"synced %d FQDNs from remote portal %s", result.FQDNCount, portal.Spec.Remote.Location())
+	}
+
 	metrics.PortalRemoteFQDNsSynced.WithLabelValues(portal.Name).Set(float64(result.FQDNCount))
+	if d := portal.Status.RemoteSync.LastSyncDuration; d != nil {
+		metrics.PortalRemoteSyncDuration.WithLabelValues(portal.Name).Set(d.Seconds())
+	}
 
 	remoteLog.Info("remote portal sync successful",
-		"url", portal.Spec.Remote.URL,
+		"url", portal.Spec.Remote.Location(),
 		"remotePortal", portal.Spec.Remote.Portal,
 		"fqdnCount", result.FQDNCount,
 		"groupCount", len(result.Groups),
 		"remoteTitle", result.RemoteTitle)
 
-	rc.Result = ctrl.Result{RequeueAfter: DefaultRemoteSyncInterval}
+	rc.Result = ctrl.Result{RequeueAfter: rc.Data.NextRemoteSync()}
 	return nil
 }
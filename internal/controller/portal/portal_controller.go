@@ -18,11 +18,18 @@ package portal
 
 import (
 	"context"
+	"slices"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
 	"github.com/golgoth31/sreportal/internal/config"
@@ -46,6 +53,10 @@ type PortalReconciler struct {
 	fqdnWriter      domaindns.FQDNWriter
 	releaseWriter   domainrelease.ReleaseWriter
 	flowGraphWriter domainnetpol.FlowGraphWriter
+	recorder        events.EventRecorder
+	remoteStreams   *portalchain.RemoteStreams
+	remoteSync      portalchain.RemoteSyncSchedule
+	maxConcurrent   int
 }
 
 // SetPortalWriter sets the optional PortalWriter used to push read models into the ReadStore.
@@ -68,13 +79,26 @@ func (r *PortalReconciler) SetFlowGraphWriter(w domainnetpol.FlowGraphWriter) {
 	r.flowGraphWriter = w
 }
 
+// SetEventRecorder sets the optional recorder used to emit Kubernetes Events on Portals.
+func (r *PortalReconciler) SetEventRecorder(recorder events.EventRecorder) {
+	r.recorder = recorder
+}
+
+// SetRemoteStreams sets the optional RemoteStreams following the remote portals
+// in Stream sync mode. Without it they are polled.
+func (r *PortalReconciler) SetRemoteStreams(streams *portalchain.RemoteStreams) {
+	r.remoteStreams = streams
+}
+
 // NewPortalReconciler creates a new PortalReconciler with the handler chain.
 // operatorConfig is the (optional) legacy operator configuration; its source
 // settings seed the main portal's DNS CR on first reconcile, falling back to
 // built-in defaults when absent.
 func NewPortalReconciler(c client.Client, scheme *runtime.Scheme, cache *remoteclient.Cache, operatorConfig *config.OperatorConfig) *PortalReconciler {
 	handlers := []reconciler.Handler[*sreportalv1alpha1.Portal, portalchain.ChainData]{
+		portalchain.NewPromoteMainHandler(c),
 		portalchain.NewCleanupDisabledFeaturesHandler(c),
+		portalchain.NewLoadContentHandler(c),
 		portalchain.NewEnsureLocalResourcesHandler(c, scheme),
 		portalchain.NewEnsureMainDNSHandler(c, scheme, operatorConfig),
 		portalchain.NewBuildRemoteClientHandler(c, cache),
@@ -87,19 +111,33 @@ func NewPortalReconciler(c client.Client, scheme *runtime.Scheme, cache *remotec
 		portalchain.NewUpdateStatusHandler(c),
 	}
 
-	return &PortalReconciler{
+	r := &PortalReconciler{
 		Client: c,
 		Scheme: scheme,
 		chain:  reconciler.NewChain("portal", handlers...),
+		remoteSync: portalchain.RemoteSyncSchedule{
+			Interval: portalchain.DefaultRemoteSyncInterval,
+			Jitter:   portalchain.DefaultRemoteSyncJitter,
+		},
+	}
+	if operatorConfig != nil {
+		r.remoteSync = portalchain.RemoteSyncSchedule{
+			Interval: operatorConfig.RemoteSync.Interval.Duration(),
+			Jitter:   operatorConfig.RemoteSync.Jitter,
+		}
+		r.maxConcurrent = operatorConfig.RemoteSync.MaxConcurrent
 	}
+	return r
 }
 
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups=sreportal.io,resources=imageinventories,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=sreportal.io,resources=releases,verbs=list
 // +kubebuilder:rbac:groups=sreportal.io,resources=portals,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=sreportal.io,resources=portals/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=sreportal.io,resources=portals/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
 
 // Reconcile updates the Portal status conditions.
 func (r *PortalReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -109,6 +147,9 @@ func (r *PortalReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	var portal sreportalv1alpha1.Portal
 	if err := r.Get(ctx, req.NamespacedName, &portal); err != nil {
 		if client.IgnoreNotFound(err) == nil {
+			if r.remoteStreams != nil {
+				r.remoteStreams.Stop(req.Namespace + "/" + req.Name)
+			}
 			if r.portalWriter != nil {
 				if delErr := r.portalWriter.Delete(ctx, req.Namespace+"/"+req.Name); delErr != nil {
 					logger.Error(delErr, "failed to delete portal view from read store")
@@ -135,12 +176,17 @@ func (r *PortalReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 			FQDNWriter:      r.fqdnWriter,
 			ReleaseWriter:   r.releaseWriter,
 			FlowGraphWriter: r.flowGraphWriter,
+			RemoteStreams:   r.remoteStreams,
+			Recorder:        r.recorder,
+			RemoteSync:      r.remoteSync,
+			SyncStartedAt:   start,
 		},
 	}
 
 	// Execute handler chain
 	if err := r.chain.Execute(ctx, rc); err != nil {
 		logger.Error(err, "reconciliation failed")
+		rc.Data.Event(&portal, corev1.EventTypeWarning, "ReconcileFailed", "Reconcile", "%v", err)
 		metrics.ReconcileTotal.WithLabelValues("portal", "error").Inc()
 		metrics.ReconcileDuration.WithLabelValues("portal", "").Observe(time.Since(start).Seconds())
 		return ctrl.Result{}, err
@@ -149,7 +195,9 @@ func (r *PortalReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	// Push portal view into the ReadStore
 	if r.portalWriter != nil {
 		resourceKey := portal.Namespace + "/" + portal.Name
-		if wErr := r.portalWriter.Replace(ctx, resourceKey, portalToView(&portal)); wErr != nil {
+		view := portalToView(&portal)
+		view.Content = rc.Data.Content
+		if wErr := r.portalWriter.Replace(ctx, resourceKey, view); wErr != nil {
 			logger.Error(wErr, "failed to replace portal view in read store", "key", resourceKey)
 			metrics.ReadstoreWriterErrors.WithLabelValues("portal", "replace").Inc()
 		}
@@ -197,8 +245,16 @@ func portalToView(p *sreportalv1alpha1.Portal) domainportal.PortalView {
 			ImageInventory: p.Spec.Features.IsImageInventoryEnabled(),
 		},
 	}
+	view.AllowedGroups = p.Spec.AllowedGroups
+	for _, l := range p.Spec.Links {
+		view.Links = append(view.Links, domainportal.PortalLink{Title: l.Title, URL: l.URL})
+	}
+	if b := p.Spec.Branding; b != nil {
+		view.Branding = &domainportal.PortalBranding{LogoURL: b.LogoURL, Color: b.Color}
+	}
 	if p.Spec.Remote != nil {
 		view.URL = p.Spec.Remote.URL
+		view.RemotePortal = p.Spec.Remote.Portal
 	}
 	if p.Status.RemoteSync != nil {
 		rs := &domainportal.RemoteSyncView{
@@ -234,8 +290,36 @@ func portalToView(p *sreportalv1alpha1.Portal) domainportal.PortalView {
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *PortalReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&sreportalv1alpha1.Portal{}).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.enqueuePortalsForConfigMap))
+	if r.remoteStreams != nil {
+		// Each stream transition refreshes the status of its Portal.
+		b = b.WatchesRawSource(source.Channel(r.remoteStreams.Events(), &handler.EnqueueRequestForObject{}))
+	}
+	return b.
 		Named("portal").
+		WithOptions(controller.Options{MaxConcurrentReconciles: max(r.maxConcurrent, 1)}).
 		Complete(r)
 }
+
+// enqueuePortalsForConfigMap enqueues every Portal of the ConfigMap namespace
+// that references it in spec.contentRefs.
+func (r *PortalReconciler) enqueuePortalsForConfigMap(ctx context.Context, obj client.Object) []ctrl.Request {
+	var list sreportalv1alpha1.PortalList
+	if err := r.List(ctx, &list, client.InNamespace(obj.GetNamespace())); err != nil {
+		log.FromContext(ctx).Error(err, "list Portals for ConfigMap watch", "configmap", obj.GetName())
+		return nil
+	}
+	var reqs []ctrl.Request
+	for i := range list.Items {
+		if slices.ContainsFunc(list.Items[i].Spec.ContentRefs, func(ref sreportalv1alpha1.PortalContentRef) bool {
+			return ref.Name == obj.GetName()
+		}) {
+			reqs = append(reqs, ctrl.Request{NamespacedName: types.NamespacedName{
+				Namespace: list.Items[i].Namespace, Name: list.Items[i].Name,
+			}})
+		}
+	}
+	return reqs
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+// Compile-time check that HTTPProber implements domaindns.Prober.
+var _ domaindns.Prober = (*HTTPProber)(nil)
+
+// HTTPProber sends a GET request to scheme://host:port/path and records the
+// status code and latency. Redirects are not followed. Over HTTPS the
+// certificate is verified separately from the request so that an invalid one
+// still yields a status code: the FQDN is then reported degraded rather than
+// unreachable.
+type HTTPProber struct {
+	// Roots verifies HTTPS certificates; the system pool when nil.
+	Roots *x509.CertPool
+}
+
+// NewHTTPProber creates an HTTPProber verifying against the system roots.
+func NewHTTPProber() *HTTPProber {
+	return &HTTPProber{}
+}
+
+// Probe implements domaindns.Prober.
+func (p *HTTPProber) Probe(ctx context.Context, host string, pr domaindns.Probe) (domaindns.ProbeResult, error) {
+	if !pr.IsHTTP() {
+		return domaindns.ProbeResult{}, fmt.Errorf("unsupported probe type %q", pr.Type)
+	}
+	path := pr.Path
+	if path == "" {
+		path = "/"
+	}
+	url := string(pr.Type) + "://" + net.JoinHostPort(host, strconv.Itoa(int(pr.Port))) + path
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return domaindns.ProbeResult{}, err
+	}
+
+	var (
+		tlsValid    atomic.Bool
+		tlsNotAfter atomic.Int64
+	)
+	transport := &http.Transport{
+		DisableKeepAlives: true,
+		TLSClientConfig: &tls.Config{
+			ServerName: host,
+			// Verification happens in VerifyConnection, which records the
+			// outcome instead of failing the handshake.
+			InsecureSkipVerify: true,
+			VerifyConnection: func(cs tls.ConnectionState) error {
+				tlsValid.Store(p.verify(cs) == nil)
+				if len(cs.PeerCertificates) > 0 {
+					tlsNotAfter.Store(cs.PeerCertificates[0].NotAfter.Unix())
+				}
+				return nil
+			},
+		},
+	}
+	defer transport.CloseIdleConnections()
+	c := &http.Client{
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	start := time.Now()
+	resp, err := c.Do(req)
+	if err != nil {
+		return domaindns.ProbeResult{}, err
+	}
+	res := domaindns.ProbeResult{Latency: time.Since(start), StatusCode: resp.StatusCode}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	_ = resp.Body.Close()
+	if pr.Type == domaindns.ProbeTypeHTTPS {
+		valid := tlsValid.Load()
+		res.TLSValid = &valid
+		if notAfter := tlsNotAfter.Load(); notAfter != 0 {
+			res.TLSNotAfter = time.Unix(notAfter, 0)
+		}
+	}
+	return res, nil
+}
+
+// verify checks the peer certificate chain and host name of a TLS connection.
+func (p *HTTPProber) verify(cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		return fmt.Errorf("no peer certificate")
+	}
+	opts := x509.VerifyOptions{
+		Roots:         p.Roots,
+		DNSName:       cs.ServerName,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err := cs.PeerCertificates[0].Verify(opts)
+	return err
+}
+
+// TypeProber dispatches each probe to the Prober registered for its type.
+type TypeProber map[domaindns.ProbeType]domaindns.Prober
+
+// Compile-time check that TypeProber implements domaindns.Prober.
+var _ domaindns.Prober = TypeProber(nil)
+
+// NewTypeProber returns the TypeProber running every supported probe type.
+func NewTypeProber() TypeProber {
+	h := NewHTTPProber()
+	return TypeProber{
+		domaindns.ProbeTypeTCP:   NewTCPProber(),
+		domaindns.ProbeTypeHTTP:  h,
+		domaindns.ProbeTypeHTTPS: h,
+	}
+}
+
+// Probe implements domaindns.Prober.
+func (t TypeProber) Probe(ctx context.Context, host string, pr domaindns.Probe) (domaindns.ProbeResult, error) {
+	p, ok := t[pr.Type]
+	if !ok {
+		return domaindns.ProbeResult{}, fmt.Errorf("unsupported probe type %q", pr.Type)
+	}
+	return p.Probe(ctx, host, pr)
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package probe
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+func serverPort(t *testing.T, srv *httptest.Server) int32 {
+	t.Helper()
+	return int32(srv.Listener.Addr().(*net.TCPAddr).Port)
+}
+
+func TestHTTPProber(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/healthz":
+			w.WriteHeader(http.StatusOK)
+		case "/moved":
+			http.Redirect(w, r, "/healthz", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+	port := serverPort(t, srv)
+	p := NewHTTPProber()
+
+	res, err := p.Probe(context.Background(), "127.0.0.1", domaindns.Probe{Type: domaindns.ProbeTypeHTTP, Port: port, Path: "/healthz"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Nil(t, res.TLSValid)
+	require.Equal(t, domaindns.HealthStatusHealthy, res.Health())
+
+	res, err = p.Probe(context.Background(), "127.0.0.1", domaindns.Probe{Type: domaindns.ProbeTypeHTTP, Port: port, Path: "/moved"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusFound, res.StatusCode, "redirects are not followed")
+
+	res, err = p.Probe(context.Background(), "127.0.0.1", domaindns.Probe{Type: domaindns.ProbeTypeHTTP, Port: port})
+	require.NoError(t, err)
+	require.Equal(t, domaindns.HealthStatusDegraded, res.Health())
+
+	srv.Close()
+	_, err = p.Probe(context.Background(), "127.0.0.1", domaindns.Probe{Type: domaindns.ProbeTypeHTTP, Port: port})
+	require.Error(t, err)
+}
+
+func TestHTTPProber_TLSValidity(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+	probe := domaindns.Probe{Type: domaindns.ProbeTypeHTTPS, Port: serverPort(t, srv)}
+
+	// The test certificate is not trusted by the system roots: the endpoint
+	// answers but is degraded.
+	res, err := NewHTTPProber().Probe(context.Background(), "127.0.0.1", probe)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.NotNil(t, res.TLSValid)
+	require.False(t, *res.TLSValid)
+	require.Equal(t, domaindns.HealthStatusDegraded, res.Health())
+
+	trusted := &HTTPProber{Roots: srv.Client().Transport.(*http.Transport).TLSClientConfig.RootCAs}
+	res, err = trusted.Probe(context.Background(), "127.0.0.1", probe)
+	require.NoError(t, err)
+	require.True(t, *res.TLSValid)
+	require.Equal(t, domaindns.HealthStatusHealthy, res.Health())
+}
+
+func TestTypeProber_Dispatches(t *testing.T) {
+	_, err := TypeProber{}.Probe(context.Background(), "127.0.0.1", domaindns.Probe{Type: domaindns.ProbeTypeTCP, Port: 1})
+	require.ErrorContains(t, err, "unsupported probe type")
+}
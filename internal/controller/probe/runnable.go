@@ -0,0 +1,247 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package probe
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
+	dnschain "github.com/golgoth31/sreportal/internal/controller/dnsrecords/chain"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	"github.com/golgoth31/sreportal/internal/metrics"
+)
+
+const maxConcurrent = 10
+
+// Runnable probes the DNSRecord endpoints that carry a connection probe (the
+// sreportal.io/probe annotation, or the probe configured for one of their
+// groups) and writes the outcome onto each endpoint's status (Availability,
+// HealthStatus and the HTTP(S) details). Like the dnsresolve Runnable it never
+// touches the FQDN read store: a health change re-triggers the DNSRecord
+// reconcile, which re-projects it.
+type Runnable struct {
+	Client   client.Client
+	Prober   domaindns.Prober
+	Interval time.Duration
+	Timeout  time.Duration
+	// Groups maps a UI group name to the probe run against its FQDNs when they
+	// carry no sreportal.io/probe annotation.
+	Groups map[string]domaindns.Probe
+}
+
+// New creates a Runnable probing every interval, each probe bounded by timeout.
+func New(c client.Client, prober domaindns.Prober, interval, timeout time.Duration, groups map[string]domaindns.Probe) *Runnable {
+	return &Runnable{Client: c, Prober: prober, Interval: interval, Timeout: timeout, Groups: groups}
+}
+
+// ParseGroupProbes decodes the per-group probe configuration (group name to
+// "<type>:<port>").
+func ParseGroupProbes(groups map[string]string) (map[string]domaindns.Probe, error) {
+	out := make(map[string]domaindns.Probe, len(groups))
+	for group, spec := range groups {
+		p, err := domaindns.ParseProbe(spec)
+		if err != nil {
+			return nil, fmt.Errorf("group %q: %w", group, err)
+		}
+		out[group] = p
+	}
+	return out, nil
+}
+
+// Start implements manager.Runnable.
+func (r *Runnable) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("probe")
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := r.tick(ctx); err != nil {
+				logger.Error(err, "probe tick failed")
+			}
+		}
+	}
+}
+
+var _ manager.Runnable = (*Runnable)(nil)
+
+// tick probes every DNSRecord once.
+func (r *Runnable) tick(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("probe")
+	var list v1alpha2.DNSRecordList
+	if err := r.Client.List(ctx, &list); err != nil {
+		return err
+	}
+	for i := range list.Items {
+		if err := r.probeRecord(ctx, &list.Items[i]); err != nil {
+			logger.Error(err, "probe record failed", "record", list.Items[i].Namespace+"/"+list.Items[i].Name)
+		}
+	}
+	return nil
+}
+
+// probeRecord probes the endpoints of rec that have a probe, clears the
+// probe outcome of those that no longer have one, and patches the status
+// subresource when an outcome changed. lastProbeTime and probeLatency move on
+// every probe: they are only written along with such a change, the latency
+// of every probe being exported as a metric.
+func (r *Runnable) probeRecord(ctx context.Context, rec *v1alpha2.DNSRecord) error {
+	probes := r.probesFor(ctx, rec)
+	base := rec.DeepCopy()
+	now := metav1.Now()
+
+	for i := range rec.Status.Endpoints {
+		if _, ok := probes[i]; !ok {
+			clearProbeOutcome(&rec.Status.Endpoints[i])
+		}
+	}
+
+	// Probe in parallel with a bounded worker pool. Each goroutine writes only
+	// its own endpoint index, so concurrent writes to the slice are race-free.
+	idxCh := make(chan int, len(probes))
+	for i := range probes {
+		idxCh <- i
+	}
+	close(idxCh)
+	var wg sync.WaitGroup
+	for range min(maxConcurrent, len(probes)) {
+		wg.Go(func() {
+			for i := range idxCh {
+				ep := &rec.Status.Endpoints[i]
+				pc, cancel := context.WithTimeout(ctx, r.Timeout)
+				res, err := r.Prober.Probe(pc, ep.DNSName, probes[i])
+				cancel()
+				if err != nil {
+					log.FromContext(ctx).WithName("probe").V(1).Info("probe failed",
+						"fqdn", ep.DNSName, "probe", probes[i].String(), "err", err.Error())
+				}
+				if err == nil {
+					metrics.DNSProbeLatency.WithLabelValues(string(probes[i].Type)).Observe(res.Latency.Seconds())
+				}
+				setProbeOutcome(ep, res, err, now)
+			}
+		})
+	}
+	wg.Wait()
+
+	if !outcomesChanged(base.Status.Endpoints, rec.Status.Endpoints) {
+		return nil
+	}
+	if err := r.Client.Status().Patch(ctx, rec, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("patch DNSRecord status: %w", err)
+	}
+	return nil
+}
+
+// setProbeOutcome records the result of a probe on ep. A reachable endpoint
+// is up even when degraded.
+func setProbeOutcome(ep *v1alpha2.EndpointStatus, res domaindns.ProbeResult, err error, now metav1.Time) {
+	ep.LastProbeTime = &now
+	ep.HTTPStatusCode = 0
+	ep.ProbeLatency = nil
+	ep.TLSValid = nil
+	ep.TLSNotAfter = nil
+	if err != nil {
+		ep.Availability = v1alpha2.AvailabilityDown
+		ep.HealthStatus = v1alpha2.HealthStatusUnhealthy
+		return
+	}
+	ep.Availability = v1alpha2.AvailabilityUp
+	ep.HealthStatus = v1alpha2.HealthStatus(res.Health())
+	ep.HTTPStatusCode = int32(res.StatusCode)
+	ep.ProbeLatency = &metav1.Duration{Duration: res.Latency}
+	ep.TLSValid = res.TLSValid
+	if !res.TLSNotAfter.IsZero() {
+		ep.TLSNotAfter = &metav1.Time{Time: res.TLSNotAfter}
+	}
+}
+
+// outcomesChanged reports whether the probe outcome of an endpoint differs
+// between before and after, ignoring lastProbeTime and probeLatency.
+func outcomesChanged(before, after []v1alpha2.EndpointStatus) bool {
+	if len(before) != len(after) {
+		return true
+	}
+	for i := range after {
+		b, a := before[i], after[i]
+		b.LastProbeTime, a.LastProbeTime = nil, nil
+		b.ProbeLatency, a.ProbeLatency = nil, nil
+		if !equality.Semantic.DeepEqual(b, a) {
+			return true
+		}
+	}
+	return false
+}
+
+// clearProbeOutcome removes the probe outcome of an endpoint without probe.
+func clearProbeOutcome(ep *v1alpha2.EndpointStatus) {
+	ep.Availability = v1alpha2.AvailabilityUnknown
+	ep.HealthStatus = v1alpha2.HealthStatusUnknown
+	ep.LastProbeTime = nil
+	ep.HTTPStatusCode = 0
+	ep.ProbeLatency = nil
+	ep.TLSValid = nil
+	ep.TLSNotAfter = nil
+}
+
+// probesFor returns the probe of each endpoint of rec, keyed by endpoint
+// index. The sreportal.io/probe annotation wins; otherwise the first of the
+// endpoint's groups (as projected with the governing DNS group mapping) with a
+// configured probe applies.
+func (r *Runnable) probesFor(ctx context.Context, rec *v1alpha2.DNSRecord) map[int]domaindns.Probe {
+	var groupsByKey map[string][]string
+	if len(r.Groups) > 0 {
+		var mapping *v1alpha2.GroupMappingSpec
+		if dns := dnschain.GoverningDNS(ctx, r.Client, rec); dns != nil {
+			mapping = &dns.Spec.GroupMapping
+		}
+		groupsByKey = map[string][]string{}
+		for _, g := range adapter.EndpointStatusToGroupsV2(rec.Status.Endpoints, mapping) {
+			for _, f := range g.FQDNs {
+				key := f.FQDN + "|" + f.RecordType
+				groupsByKey[key] = append(groupsByKey[key], g.Name)
+			}
+		}
+	}
+
+	out := make(map[int]domaindns.Probe)
+	for i, ep := range rec.Status.Endpoints {
+		if p, err := domaindns.ParseProbe(ep.Labels[domaindns.ProbeAnnotationKey]); err == nil {
+			out[i] = p
+			continue
+		}
+		for _, g := range groupsByKey[ep.DNSName+"|"+ep.RecordType] {
+			if p, ok := r.Groups[g]; ok {
+				out[i] = p
+				break
+			}
+		}
+	}
+	return out
+}
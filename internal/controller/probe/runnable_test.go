@@ -0,0 +1,168 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package probe
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/external-dns/endpoint"
+
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	portalfeatures "github.com/golgoth31/sreportal/internal/controller/portal/features"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+const (
+	fqdnDB     = "db.example.com"
+	fqdnBroker = "broker.example.com"
+	fqdnWeb    = "web.example.com"
+)
+
+// stubProber reports the hosts listed in down as unreachable and answers the
+// others with their status in codes (200 by default).
+type stubProber struct {
+	down  map[string]bool
+	codes map[string]int
+}
+
+func (s stubProber) Probe(_ context.Context, host string, p domaindns.Probe) (domaindns.ProbeResult, error) {
+	if s.down[host] {
+		return domaindns.ProbeResult{}, errors.New("connection refused")
+	}
+	res := domaindns.ProbeResult{Latency: 5 * time.Millisecond}
+	if p.IsHTTP() {
+		res.StatusCode = 200
+		if c, ok := s.codes[host]; ok {
+			res.StatusCode = c
+		}
+	}
+	return res, nil
+}
+
+func newTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha2.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).
+		WithStatusSubresource(&v1alpha2.DNSRecord{}).
+		WithObjects(objs...).
+		WithIndex(&v1alpha2.DNS{}, portalfeatures.FieldIndexPortalRef, func(o client.Object) []string {
+			return []string{o.(*v1alpha2.DNS).Spec.PortalRef}
+		}).Build()
+}
+
+func TestRunnable_ProbesAnnotatedAndGroupEndpoints(t *testing.T) {
+	dns := &v1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: "ns"},
+		Spec: v1alpha2.DNSSpec{
+			PortalRef:    "p",
+			GroupMapping: v1alpha2.GroupMappingSpec{DefaultGroup: "Services", ByNamespace: map[string]string{"data": "Databases"}},
+		},
+	}
+	rec := &v1alpha2.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{Name: "r", Namespace: "ns"},
+		Spec:       v1alpha2.DNSRecordSpec{PortalRef: "p", SourceType: "service"},
+		Status: v1alpha2.DNSRecordStatus{Endpoints: []v1alpha2.EndpointStatus{
+			{DNSName: fqdnDB, RecordType: "A", Targets: []string{"10.0.0.1"}, LastSeen: metav1.Now(),
+				Labels: map[string]string{endpoint.ResourceLabelKey: "service/data/db"}},
+			{DNSName: fqdnBroker, RecordType: "A", Targets: []string{"10.0.0.2"}, LastSeen: metav1.Now(),
+				Labels: map[string]string{domaindns.ProbeAnnotationKey: "tcp:9092"}},
+			{DNSName: fqdnWeb, RecordType: "A", Targets: []string{"10.0.0.3"}, LastSeen: metav1.Now(),
+				Availability: v1alpha2.AvailabilityUp},
+		}},
+	}
+	c := newTestClient(t, dns, rec)
+	groups, err := ParseGroupProbes(map[string]string{"Databases": "tcp:5432"})
+	require.NoError(t, err)
+	r := New(c, stubProber{down: map[string]bool{fqdnDB: true}}, time.Minute, time.Second, groups)
+
+	require.NoError(t, r.tick(context.Background()))
+
+	var got v1alpha2.DNSRecord
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(rec), &got))
+	byName := map[string]v1alpha2.Availability{}
+	for _, ep := range got.Status.Endpoints {
+		byName[ep.DNSName] = ep.Availability
+	}
+	require.Equal(t, v1alpha2.AvailabilityDown, byName[fqdnDB], "group probe applies")
+	require.Equal(t, v1alpha2.AvailabilityUp, byName[fqdnBroker], "annotation probe applies")
+	require.Equal(t, v1alpha2.AvailabilityUnknown, byName[fqdnWeb], "availability cleared when no probe applies")
+}
+
+func TestRunnable_RecordsHTTPHealth(t *testing.T) {
+	rec := &v1alpha2.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{Name: "r", Namespace: "ns"},
+		Spec:       v1alpha2.DNSRecordSpec{PortalRef: "p", SourceType: "ingress"},
+		Status: v1alpha2.DNSRecordStatus{Endpoints: []v1alpha2.EndpointStatus{
+			{DNSName: fqdnWeb, RecordType: "A", Targets: []string{"10.0.0.3"}, LastSeen: metav1.Now(),
+				Labels: map[string]string{domaindns.ProbeAnnotationKey: "http:80/healthz"}},
+			{DNSName: fqdnDB, RecordType: "A", Targets: []string{"10.0.0.1"}, LastSeen: metav1.Now(),
+				Labels: map[string]string{domaindns.ProbeAnnotationKey: "http:80"}},
+			{DNSName: fqdnBroker, RecordType: "A", Targets: []string{"10.0.0.2"}, LastSeen: metav1.Now(),
+				Labels: map[string]string{domaindns.ProbeAnnotationKey: "tcp:9092"}},
+		}},
+	}
+	c := newTestClient(t, rec)
+	prober := stubProber{down: map[string]bool{fqdnBroker: true}, codes: map[string]int{fqdnDB: 503}}
+	r := New(c, prober, time.Minute, time.Second, nil)
+
+	require.NoError(t, r.tick(context.Background()))
+
+	var got v1alpha2.DNSRecord
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(rec), &got))
+	web, db, broker := got.Status.Endpoints[0], got.Status.Endpoints[1], got.Status.Endpoints[2]
+
+	require.Equal(t, v1alpha2.HealthStatusHealthy, web.HealthStatus)
+	require.Equal(t, int32(200), web.HTTPStatusCode)
+	require.NotNil(t, web.LastProbeTime)
+	require.Equal(t, 5*time.Millisecond, web.ProbeLatency.Duration)
+
+	require.Equal(t, v1alpha2.HealthStatusDegraded, db.HealthStatus)
+	require.Equal(t, v1alpha2.AvailabilityUp, db.Availability, "a degraded endpoint is reachable")
+	require.Equal(t, int32(503), db.HTTPStatusCode)
+
+	require.Equal(t, v1alpha2.HealthStatusUnhealthy, broker.HealthStatus)
+	require.Equal(t, v1alpha2.AvailabilityDown, broker.Availability)
+	require.Nil(t, broker.ProbeLatency)
+
+	// An unchanged outcome does not patch the status, although the probe
+	// time moved.
+	require.NoError(t, r.tick(context.Background()))
+	var again v1alpha2.DNSRecord
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(rec), &again))
+	require.Equal(t, got.ResourceVersion, again.ResourceVersion)
+
+	// A health transition does.
+	r.Prober = stubProber{down: map[string]bool{fqdnBroker: true, fqdnWeb: true}, codes: map[string]int{fqdnDB: 503}}
+	require.NoError(t, r.tick(context.Background()))
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(rec), &again))
+	require.NotEqual(t, got.ResourceVersion, again.ResourceVersion)
+	require.Equal(t, v1alpha2.AvailabilityDown, again.Status.Endpoints[0].Availability)
+}
+
+func TestParseGroupProbes_Invalid(t *testing.T) {
+	_, err := ParseGroupProbes(map[string]string{"Databases": "postgres"})
+	require.ErrorIs(t, err, domaindns.ErrInvalidProbe)
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package probe
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+// Compile-time check that TCPProber implements domaindns.Prober.
+var _ domaindns.Prober = (*TCPProber)(nil)
+
+// TCPProber checks that a TCP connection to host:port can be opened. Suited to
+// non-HTTP endpoints such as databases or message brokers.
+type TCPProber struct {
+	dialer net.Dialer
+}
+
+// NewTCPProber creates a TCPProber.
+func NewTCPProber() *TCPProber {
+	return &TCPProber{}
+}
+
+// Probe implements domaindns.Prober.
+func (p *TCPProber) Probe(ctx context.Context, host string, pr domaindns.Probe) (domaindns.ProbeResult, error) {
+	if pr.Type != domaindns.ProbeTypeTCP {
+		return domaindns.ProbeResult{}, fmt.Errorf("unsupported probe type %q", pr.Type)
+	}
+	start := time.Now()
+	conn, err := p.dialer.DialContext(ctx, "tcp", net.JoinHostPort(host, strconv.Itoa(int(pr.Port))))
+	if err != nil {
+		return domaindns.ProbeResult{}, err
+	}
+	res := domaindns.ProbeResult{Latency: time.Since(start)}
+	return res, conn.Close()
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package probe
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+func TestTCPProber(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := int32(ln.Addr().(*net.TCPAddr).Port)
+
+	p := NewTCPProber()
+	res, err := p.Probe(context.Background(), "127.0.0.1", domaindns.Probe{Type: domaindns.ProbeTypeTCP, Port: port})
+	require.NoError(t, err)
+	require.Equal(t, domaindns.HealthStatusHealthy, res.Health())
+
+	require.NoError(t, ln.Close())
+	_, err = p.Probe(context.Background(), "127.0.0.1", domaindns.Probe{Type: domaindns.ProbeTypeTCP, Port: port})
+	require.Error(t, err)
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package scaleguard periodically counts the DNS CRs, DNSRecords and FQDNs
+// for the scale guard and reports its state on the DNS CRs.
+package scaleguard
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	"github.com/golgoth31/sreportal/internal/scaleguard"
+)
+
+const (
+	// ConditionTypeWithinScaleLimits is set on each local DNS CR with the
+	// state of the scale guard.
+	ConditionTypeWithinScaleLimits = "WithinScaleLimits"
+	// ReasonWithinLimits means every count is below its soft limit.
+	ReasonWithinLimits = "WithinLimits"
+	// ReasonSoftLimitReached means a count reached its soft limit, or the
+	// streams their hard limit: discovery still runs.
+	ReasonSoftLimitReached = "SoftLimitReached"
+	// ReasonDiscoveryPaused means a count reached its hard limit and source
+	// discovery is paused.
+	ReasonDiscoveryPaused = "DiscoveryPaused"
+)
+
+// FQDNCounter counts the FQDNs of the read store.
+type FQDNCounter interface {
+	Count(ctx context.Context, f domaindns.FQDNFilters) (int, error)
+}
+
+// Runnable periodically counts the DNS CRs, DNSRecords and FQDNs into the
+// scale guard, and mirrors its state on the WithinScaleLimits condition of
+// the local DNS CRs. The open streams are counted by the guard itself.
+type Runnable struct {
+	Client   client.Client
+	FQDNs    FQDNCounter
+	Guard    *scaleguard.Guard
+	Interval time.Duration
+}
+
+// New creates a Runnable counting every interval.
+func New(c client.Client, fqdns FQDNCounter, guard *scaleguard.Guard, interval time.Duration) *Runnable {
+	return &Runnable{Client: c, FQDNs: fqdns, Guard: guard, Interval: interval}
+}
+
+// Start implements manager.Runnable.
+func (r *Runnable) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("scaleguard")
+	if err := r.tick(ctx); err != nil {
+		logger.Error(err, "scale guard count failed")
+	}
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := r.tick(ctx); err != nil {
+				logger.Error(err, "scale guard count failed")
+			}
+		}
+	}
+}
+
+var _ manager.Runnable = (*Runnable)(nil)
+
+// tick counts the guarded resources and publishes the guard state.
+func (r *Runnable) tick(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("scaleguard")
+
+	var dnsList v1alpha2.DNSList
+	if err := r.Client.List(ctx, &dnsList); err != nil {
+		return fmt.Errorf("list DNS: %w", err)
+	}
+	var records v1alpha2.DNSRecordList
+	if err := r.Client.List(ctx, &records); err != nil {
+		return fmt.Errorf("list DNSRecords: %w", err)
+	}
+	fqdns, err := r.FQDNs.Count(ctx, domaindns.FQDNFilters{})
+	if err != nil {
+		return fmt.Errorf("count FQDNs: %w", err)
+	}
+
+	wasPaused := r.Guard.DiscoveryPaused()
+	r.Guard.Observe(scaleguard.ResourceDNS, len(dnsList.Items))
+	r.Guard.Observe(scaleguard.ResourceDNSRecords, len(records.Items))
+	r.Guard.Observe(scaleguard.ResourceFQDNs, fqdns)
+	if paused := r.Guard.DiscoveryPaused(); paused != wasPaused {
+		logger.Info("scale guard changed source discovery", "paused", paused,
+			"dns", len(dnsList.Items), "dnsRecords", len(records.Items), "fqdns", fqdns)
+	}
+
+	cond := Condition(r.Guard.Usage())
+	for i := range dnsList.Items {
+		dns := &dnsList.Items[i]
+		if dns.Spec.IsRemote {
+			continue
+		}
+		base := dns.DeepCopy()
+		if !meta.SetStatusCondition(&dns.Status.Conditions, cond) {
+			continue
+		}
+		if err := r.Client.Status().Patch(ctx, dns, client.MergeFrom(base)); err != nil {
+			logger.Error(err, "update WithinScaleLimits condition failed", "dns", client.ObjectKeyFromObject(dns))
+		}
+	}
+	return nil
+}
+
+// Condition returns the WithinScaleLimits condition matching usage.
+func Condition(usage []scaleguard.Usage) metav1.Condition {
+	hard := describe(usage, func(u scaleguard.Usage) bool {
+		return u.Level() == scaleguard.LevelHard && u.Resource != scaleguard.ResourceStreams
+	})
+	if hard != "" {
+		return metav1.Condition{
+			Type:    ConditionTypeWithinScaleLimits,
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonDiscoveryPaused,
+			Message: "hard limit reached, source discovery paused: " + hard,
+		}
+	}
+	soft := describe(usage, func(u scaleguard.Usage) bool { return u.Level() != scaleguard.LevelOK })
+	if soft != "" {
+		return metav1.Condition{
+			Type:    ConditionTypeWithinScaleLimits,
+			Status:  metav1.ConditionTrue,
+			Reason:  ReasonSoftLimitReached,
+			Message: "limit reached, discovery running: " + soft,
+		}
+	}
+	return metav1.Condition{
+		Type:    ConditionTypeWithinScaleLimits,
+		Status:  metav1.ConditionTrue,
+		Reason:  ReasonWithinLimits,
+		Message: "every count is below its limits",
+	}
+}
+
+// describe lists the usages selected by keep as "resource count/limit".
+func describe(usage []scaleguard.Usage, keep func(scaleguard.Usage) bool) string {
+	var parts []string
+	for _, u := range usage {
+		if !keep(u) {
+			continue
+		}
+		limit := u.Limit.Hard
+		if u.Level() == scaleguard.LevelSoft {
+			limit = u.Limit.Soft
+		}
+		parts = append(parts, fmt.Sprintf("%s %d/%d", u.Resource, u.Count, limit))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaleguard
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	"github.com/golgoth31/sreportal/internal/scaleguard"
+)
+
+const tNS = "sreportal-system"
+
+// fixedCounter reports a fixed number of FQDNs.
+type fixedCounter int
+
+func (c fixedCounter) Count(context.Context, domaindns.FQDNFilters) (int, error) { return int(c), nil }
+
+// syntheticLoad builds a client holding dnsCount local DNS CRs, plus a remote
+// one, and recordCount DNSRecords spread over them.
+func syntheticLoad(t *testing.T, dnsCount, recordCount int) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha2.AddToScheme(scheme))
+	objs := make([]client.Object, 0, dnsCount+recordCount+1)
+	for i := range dnsCount {
+		objs = append(objs, &v1alpha2.DNS{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("dns-%d", i), Namespace: tNS}})
+	}
+	objs = append(objs, &v1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: "remote", Namespace: tNS},
+		Spec:       v1alpha2.DNSSpec{IsRemote: true},
+	})
+	for i := range recordCount {
+		objs = append(objs, &v1alpha2.DNSRecord{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("record-%d", i), Namespace: tNS}})
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).
+		WithStatusSubresource(&v1alpha2.DNS{}).
+		WithObjects(objs...).Build()
+}
+
+func condition(t *testing.T, c client.Client, name string) *metav1.Condition {
+	t.Helper()
+	var dns v1alpha2.DNS
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Namespace: tNS, Name: name}, &dns))
+	return meta.FindStatusCondition(dns.Status.Conditions, ConditionTypeWithinScaleLimits)
+}
+
+func TestRunnable_PausesDiscoveryUnderSyntheticLoad(t *testing.T) {
+	c := syntheticLoad(t, 20, 500)
+	guard := scaleguard.New(map[scaleguard.Resource]scaleguard.Limit{
+		scaleguard.ResourceDNSRecords: {Soft: 400, Hard: 1000},
+		scaleguard.ResourceFQDNs:      {Soft: 5000, Hard: 10000},
+	})
+	r := New(c, fixedCounter(2000), guard, 0)
+	ctx := context.Background()
+
+	// DNSRecords past the soft limit: warned, discovery still runs.
+	require.NoError(t, r.tick(ctx))
+	assert.False(t, guard.DiscoveryPaused())
+	cond := condition(t, c, "dns-0")
+	require.NotNil(t, cond)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, ReasonSoftLimitReached, cond.Reason)
+	assert.Contains(t, cond.Message, "dnsrecords 500/400")
+	assert.Nil(t, condition(t, c, "remote"), "remote DNS CRs are not reported on")
+
+	// FQDNs past the hard limit: discovery paused.
+	r.FQDNs = fixedCounter(12000)
+	require.NoError(t, r.tick(ctx))
+	assert.True(t, guard.DiscoveryPaused())
+	cond = condition(t, c, "dns-19")
+	assert.Equal(t, metav1.ConditionFalse, cond.Status)
+	assert.Equal(t, ReasonDiscoveryPaused, cond.Reason)
+	assert.Contains(t, cond.Message, "fqdns 12000/10000")
+
+	// Back under the limits: discovery resumes.
+	r.FQDNs = fixedCounter(100)
+	require.NoError(t, r.tick(ctx))
+	assert.False(t, guard.DiscoveryPaused())
+	assert.Equal(t, ReasonSoftLimitReached, condition(t, c, "dns-0").Reason)
+}
+
+func TestCondition_WithinLimits(t *testing.T) {
+	cond := Condition([]scaleguard.Usage{
+		{Resource: scaleguard.ResourceFQDNs, Count: 10, Limit: scaleguard.Limit{Soft: 100, Hard: 200}},
+		{Resource: scaleguard.ResourceStreams, Count: 3},
+	})
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, ReasonWithinLimits, cond.Reason)
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"sigs.k8s.io/external-dns/endpoint"
+
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+// enrichAnnotationsLabel records the annotations of the origin resource listed
+// in exposed on the endpoint's labels, encoded for
+// domaindns.AnnotationsLabelKey.
+func enrichAnnotationsLabel(ep *endpoint.Endpoint, annotations map[string]string, exposed []string) {
+	encoded := domaindns.FormatAnnotations(domaindns.SelectAnnotations(annotations, exposed))
+	if encoded == "" {
+		return
+	}
+	if ep.Labels == nil {
+		ep.Labels = endpoint.NewLabels()
+	}
+	ep.Labels[domaindns.AnnotationsLabelKey] = encoded
+}
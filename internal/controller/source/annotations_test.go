@@ -0,0 +1,75 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	rsource "github.com/golgoth31/sreportal/internal/readstore/source"
+	cprec "github.com/golgoth31/sreportal/internal/source/crossplanescalewayrecord"
+	"github.com/golgoth31/sreportal/internal/source/registry"
+)
+
+// TestCycle_RecordsExposedAnnotationsOnEndpoint verifies the source cycle
+// encodes only the allowlisted annotations of the origin resource onto the
+// endpoint labels.
+func TestCycle_RecordsExposedAnnotationsOnEndpoint(t *testing.T) {
+	kind := cprec.SourceTypeCrossplaneScalewayRecord
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	dns := &sreportalv1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: "team-a"},
+		Spec: sreportalv1alpha2.DNSSpec{
+			PortalRef: "team-a",
+			Sources: sreportalv1alpha2.SourcesSpec{
+				CrossplaneScalewayRecord: &sreportalv1alpha2.CrossplaneScalewayRecordSourceSpec{Enabled: true},
+			},
+		},
+	}
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{
+		Name:      "echo",
+		Namespace: "team-a",
+		Annotations: map[string]string{
+			"example.com/owner":       "team-a",
+			"example.com/cost-center": "42",
+			"example.com/internal":    "secret",
+		},
+	}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(dns, svc).Build()
+	store := rsource.NewStore()
+
+	_ = cycle(context.Background(), c, registry.NewRegistry(echoResolver{}), nil, store, nil, nil, nil,
+		[]string{"example.com/owner", "example.com/cost-center"}, nil)
+
+	got, err := store.Lookup(kind, "team-a", "")
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t,
+		map[string]string{"example.com/owner": "team-a", "example.com/cost-center": "42"},
+		domaindns.ParseAnnotations(got[0].Endpoint.Labels[domaindns.AnnotationsLabelKey]))
+}
@@ -21,10 +21,13 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/external-dns/endpoint"
@@ -34,6 +37,7 @@ import (
 	domainsource "github.com/golgoth31/sreportal/internal/domain/source"
 	"github.com/golgoth31/sreportal/internal/metrics"
 	sourcepkg "github.com/golgoth31/sreportal/internal/source"
+	"github.com/golgoth31/sreportal/internal/source/demo"
 	"github.com/golgoth31/sreportal/internal/source/externaldns"
 	"github.com/golgoth31/sreportal/internal/source/registry"
 )
@@ -56,6 +60,27 @@ func Cycle(
 	provider *externaldns.Provider,
 	store domainsource.SourceEndpointWriter,
 	prev map[registry.SourceType]bool,
+) map[registry.SourceType]bool {
+	return cycle(ctx, c, reg, provider, store, prev, nil, nil, nil, nil)
+}
+
+// cycle implements Cycle. When recorder is non-nil, a kind whose collection
+// fails is reported as a Warning Event on every local DNS CR enabling it.
+// When faults is non-nil, the collections it selects fail without running.
+// The origin annotations listed in exposed are carried onto the endpoints.
+// When health is non-nil, the outcome of each collection is recorded there;
+// unlike the store, it may be shared with concurrent readers and writers.
+func cycle(
+	ctx context.Context,
+	c client.Client,
+	reg *registry.Registry,
+	provider *externaldns.Provider,
+	store domainsource.SourceEndpointWriter,
+	prev map[registry.SourceType]bool,
+	recorder events.EventRecorder,
+	faults *FaultInjector,
+	exposed []string,
+	health domainsource.SourceHealthWriter,
 ) map[registry.SourceType]bool {
 	logger := log.FromContext(ctx).WithName("source.cycle")
 
@@ -71,9 +96,27 @@ func Cycle(
 	}
 
 	for kind := range enabled {
+		// Demo endpoints read no resource: the DNS chain generates them.
+		if kind == demo.SourceTypeDemo {
+			continue
+		}
+		start := time.Now()
+		if faults.Fail(kind) {
+			logger.Info("injected source fault; preserving previous state", "kind", kind)
+			metrics.SourceErrorsTotal.WithLabelValues(string(kind)).Inc()
+			metrics.SourceFaultsInjected.WithLabelValues(string(kind)).Inc()
+			recordSourceFailure(health, recorder, dnsList, kind, ErrInjectedFault)
+			continue
+		}
 		// Native external-dns path for the kinds the provider handles.
 		if provider != nil && externaldns.Handles(kind) {
-			collectNativeInto(ctx, c, provider, store, kind, effCfgs[kind], logger)
+			applied, err := collectNativeInto(ctx, c, provider, store, kind, effCfgs[kind], exposed, logger)
+			switch {
+			case err != nil:
+				recordSourceFailure(health, recorder, dnsList, kind, err)
+			case applied:
+				recordSourceSuccess(health, kind, time.Since(start))
+			}
 			continue
 		}
 
@@ -95,6 +138,7 @@ func Cycle(
 			}
 			logger.Error(err, "list failed; preserving previous state", "kind", kind)
 			metrics.SourceErrorsTotal.WithLabelValues(string(kind)).Inc()
+			recordSourceFailure(health, recorder, dnsList, kind, err)
 			continue
 		}
 		items, skipped := extractItems(list)
@@ -136,6 +180,12 @@ func Cycle(
 				// resolved (owned here, not yet shared via the store), so
 				// mutating it is safe.
 				adapter.EnrichEndpointLabels(ep, obj.GetAnnotations())
+				enrichPortsLabel(ep, servicePorts(obj))
+				enrichPathsLabel(ep, routePaths(obj))
+				enrichAnnotationsLabel(ep, obj.GetAnnotations(), exposed)
+			}
+			eps = append(eps, annotationEndpoints(kind, obj, eps)...)
+			for _, ep := range eps {
 				entries = append(entries, domainsource.EnrichedEndpoint{
 					Endpoint:          ep,
 					Kind:              kind,
@@ -154,11 +204,13 @@ func Cycle(
 		if len(items) > 0 && resolveErrs == len(items) {
 			logger.Error(nil, "all objects failed to resolve; preserving previous state", "kind", kind, "items", len(items))
 			metrics.SourceKindActive.WithLabelValues(string(kind)).Set(1)
+			recordSourceFailure(health, recorder, dnsList, kind, fmt.Errorf("all %d objects failed to resolve", len(items)))
 			continue
 		}
 		store.ReplaceKind(kind, entries)
 		metrics.SourceEndpointsCollected.WithLabelValues(string(kind)).Set(float64(len(entries)))
 		metrics.SourceKindActive.WithLabelValues(string(kind)).Set(1)
+		recordSourceSuccess(health, kind, time.Since(start))
 	}
 
 	for k := range prev {
@@ -171,13 +223,19 @@ func Cycle(
 			}
 			metrics.SourceEndpointsCollected.DeleteLabelValues(string(k))
 			metrics.SourceKindActive.WithLabelValues(string(k)).Set(0)
+			metrics.SourceConsecutiveFailures.DeleteLabelValues(string(k))
+			if health != nil {
+				health.ForgetSourceHealth(k)
+			}
 		}
 	}
 	return enabled
 }
 
 // collectNativeInto discovers a kind via the external-dns source library and
-// applies it to the store under the producer's safety invariants:
+// applies it to the store under the producer's safety invariants, returning
+// whether the collection was applied and the collection failure, if any (a
+// not-yet-synced source is not one):
 //   - §1 conditional replace: on any collection error (including a not-yet-synced
 //     informer or an absent CRD) the previous good state is preserved.
 //   - §3 anti-collapse: a fresh empty result never overwrites a non-empty cache;
@@ -189,39 +247,83 @@ func collectNativeInto(
 	store domainsource.SourceEndpointWriter,
 	kind registry.SourceType,
 	cfg *externaldns.EffectiveConfig,
+	exposed []string,
 	logger logr.Logger,
-) {
+) (bool, error) {
 	if cfg == nil {
 		// Enabled but no effective config derived — a wiring/logic bug (the kind
 		// is in `enabled` but BuildEffectiveConfigs produced nothing). Surface it
 		// loudly; preserve the previous good state.
 		logger.Error(nil, "no effective config for native kind; preserving previous state", "kind", kind)
 		metrics.SourceErrorsTotal.WithLabelValues(string(kind)).Inc()
-		return
+		return false, errors.New("no effective source configuration")
 	}
-	entries, err := collectNative(ctx, c, provider, kind, cfg)
+	entries, err := collectNative(ctx, c, provider, kind, cfg, exposed)
 	if err != nil {
 		if errors.Is(err, externaldns.ErrSourceNotReady) {
 			// Normal during the initial cache sync — not a failure. Preserve the
 			// previous good state and retry next cycle; don't count it as an error.
 			logger.Info("source not ready yet (cache syncing); preserving previous state", "kind", kind)
-			return
+			return false, nil
 		}
 		logger.Error(err, "native source collection failed; preserving previous state", "kind", kind)
 		metrics.SourceErrorsTotal.WithLabelValues(string(kind)).Inc()
-		return
+		return false, err
 	}
 	if len(entries) == 0 && store.CountKind(kind) > 0 {
 		logger.Error(nil, "drop guard: refusing to replace non-empty cache with empty collection; preserving previous state",
 			"kind", kind, "prev", store.CountKind(kind))
 		metrics.SourceDropGuardTriggered.WithLabelValues(string(kind)).Inc()
 		metrics.SourceKindActive.WithLabelValues(string(kind)).Set(1)
-		return
+		return false, nil
 	}
 	store.ReplaceKind(kind, entries)
 	metrics.SourceEndpointsCollected.WithLabelValues(string(kind)).Set(float64(len(entries)))
 	metrics.SourceKindActive.WithLabelValues(string(kind)).Set(1)
-	metrics.SourceLastSuccessfulSync.WithLabelValues(string(kind)).SetToCurrentTime()
+	return true, nil
+}
+
+// recordSourceSuccess records a collection of kind applied to the store,
+// which took took.
+func recordSourceSuccess(health domainsource.SourceHealthWriter, kind registry.SourceType, took time.Duration) {
+	now := time.Now()
+	metrics.SourceLastSuccessfulSync.WithLabelValues(string(kind)).Set(float64(now.Unix()))
+	metrics.SourceConsecutiveFailures.WithLabelValues(string(kind)).Set(0)
+	metrics.SourceLastCollectionDuration.WithLabelValues(string(kind)).Set(took.Seconds())
+	if health != nil {
+		health.RecordSuccess(kind, now)
+	}
+}
+
+// recordSourceFailure records a failed collection of kind and reports it on
+// the DNS CRs enabling it.
+func recordSourceFailure(
+	health domainsource.SourceHealthWriter,
+	recorder events.EventRecorder,
+	dnsList []sreportalv1alpha2.DNS,
+	kind registry.SourceType,
+	err error,
+) {
+	if health != nil {
+		h := health.RecordFailure(kind, err, time.Now())
+		metrics.SourceConsecutiveFailures.WithLabelValues(string(kind)).Set(float64(h.Failures))
+	}
+	reportSourceFailure(recorder, dnsList, kind, err)
+}
+
+// reportSourceFailure emits a Warning Event on each DNS CR enabling kind, so
+// `kubectl describe dns` shows why its entries stopped updating.
+func reportSourceFailure(recorder events.EventRecorder, dnsList []sreportalv1alpha2.DNS, kind registry.SourceType, err error) {
+	if recorder == nil {
+		return
+	}
+	for i := range dnsList {
+		if !sourcepkg.EnabledKindsFromSpec(&dnsList[i].Spec.Sources)[kind] {
+			continue
+		}
+		recorder.Eventf(&dnsList[i], nil, corev1.EventTypeWarning, "SourceFailed", "Collect",
+			"%s source collection failed, keeping previous endpoints: %v", kind, err)
+	}
 }
 
 // listLocalDNS returns the non-remote DNS CRs that drive cluster-wide discovery.
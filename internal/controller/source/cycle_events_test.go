@@ -0,0 +1,55 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/source/externaldns"
+)
+
+func TestReportSourceFailure_TargetsDNSEnablingKind(t *testing.T) {
+	dnsList := []sreportalv1alpha2.DNS{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "with-ingress", Namespace: "ns"},
+			Spec: sreportalv1alpha2.DNSSpec{Sources: sreportalv1alpha2.SourcesSpec{
+				Ingress: &sreportalv1alpha2.IngressSourceSpec{CommonSourceSpec: sreportalv1alpha2.CommonSourceSpec{Enabled: true}},
+			}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "without-ingress", Namespace: "ns"},
+		},
+	}
+	recorder := events.NewFakeRecorder(4)
+
+	reportSourceFailure(recorder, dnsList, externaldns.KindIngress, errors.New("boom"))
+
+	require.Len(t, recorder.Events, 1)
+	require.Contains(t, <-recorder.Events, "Warning SourceFailed ingress source collection failed")
+}
+
+func TestReportSourceFailure_NilRecorder(t *testing.T) {
+	require.NotPanics(t, func() {
+		reportSourceFailure(nil, nil, externaldns.KindIngress, errors.New("boom"))
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
@@ -277,3 +278,65 @@ func TestCycle_AllObjectsFailResolve(t *testing.T) {
 	errCount := testutil.ToFloat64(metrics.SourceErrorsTotal.WithLabelValues(string(crossKind)))
 	require.Equal(t, float64(2), errCount)
 }
+
+// pausedGuard pauses discovery.
+type pausedGuard struct{}
+
+func (pausedGuard) DiscoveryPaused() bool { return true }
+
+// TestSourceReconciler_PausedDiscoveryOnlyRemoves verifies that a paused
+// discovery still applies the collections removing endpoints, so that the
+// scale guard counts can drop back under their limit, but not the ones
+// adding endpoints.
+func TestSourceReconciler_PausedDiscoveryOnlyRemoves(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	run := func(store *rsource.Store, services ...string) []string {
+		objs := []client.Object{crossDNS("d", tTeamA)}
+		for _, name := range services {
+			objs = append(objs, &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: tTeamA}})
+		}
+		r := &srccontrol.SourceReconciler{
+			Client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+			Registry: registry.NewRegistry(&fakeResolver{}),
+			Store:    store,
+			Interval: time.Minute,
+			Guard:    pausedGuard{},
+		}
+		require.NoError(t, r.Start(ctx))
+		got, err := store.Lookup(crossKind, tTeamA, "")
+		require.NoError(t, err)
+		var names []string
+		for _, e := range got {
+			names = append(names, e.Name)
+		}
+		return names
+	}
+
+	store := rsource.NewStore()
+	store.ReplaceKind(crossKind, []domainsource.EnrichedEndpoint{
+		{Kind: crossKind, Namespace: tTeamA, Name: "a", Endpoint: endpoint.NewEndpoint("a.example.com", endpoint.RecordTypeA, tLBIP)},
+		{Kind: crossKind, Namespace: tTeamA, Name: "b", Endpoint: endpoint.NewEndpoint("b.example.com", endpoint.RecordTypeA, tLBIP)},
+	})
+
+	require.Equal(t, []string{"a"}, run(store, "a"), "a removed source is removed while paused")
+	require.Equal(t, []string{"a"}, run(store, "a", "b", "c"), "new sources are not added while paused")
+
+	// A collection replacing a known endpoint by a new one only removes it.
+	store.ReplaceKind(crossKind, []domainsource.EnrichedEndpoint{
+		{Kind: crossKind, Namespace: tTeamA, Name: "a", Endpoint: endpoint.NewEndpoint("a.example.com", endpoint.RecordTypeA, "10.9.9.9")},
+		{Kind: crossKind, Namespace: tTeamA, Name: "b", Endpoint: endpoint.NewEndpoint("b.example.com", endpoint.RecordTypeA, tLBIP)},
+	})
+	require.Equal(t, []string{"a"}, run(store, "a", "c"), "new sources are not added in place of removed ones")
+	got, err := store.Lookup(crossKind, tTeamA, "")
+	require.NoError(t, err)
+	require.Equal(t, endpoint.Targets{tLBIP}, got[0].Endpoint.Targets, "known endpoints are updated while paused")
+
+	empty := rsource.NewStore()
+	require.Empty(t, run(empty, "a"), "a kind never collected stays unset while paused")
+	require.False(t, empty.Ready(crossKind))
+}
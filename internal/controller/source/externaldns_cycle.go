@@ -20,6 +20,8 @@ import (
 	"context"
 	"strings"
 
+	ambassadorv2 "github.com/datawire/ambassador/pkg/api/getambassador.io/v2"
+	contourv1 "github.com/projectcontour/contour/apis/projectcontour/v1"
 	istionetworkingv1 "istio.io/client-go/pkg/apis/networking/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
@@ -46,8 +48,10 @@ import (
 // metadata, so we recover provenance from the "resource" label external-dns
 // stamps ("<kind>/<namespace>/<name>") and re-fetch the object from the
 // controller-runtime cache to obtain SourceLabels (read-side labelFilter) and
-// SourceAnnotations (sreportal.io/groups enrichment, OriginRef). A failed
-// re-fetch never drops the endpoint — it is kept without group metadata (§6).
+// SourceAnnotations (sreportal.io/groups enrichment, OriginRef), plus the
+// exposed ports of Services and the route paths of Ingresses, Istio
+// VirtualServices and Contour HTTPProxies. A failed re-fetch never drops the endpoint — it is
+// kept without group metadata (§6).
 //
 // ctx must be the long-lived manager context: the Provider's informers live for
 // its lifetime.
@@ -57,6 +61,7 @@ func collectNative(
 	p *externaldns.Provider,
 	kind registry.SourceType,
 	cfg *externaldns.EffectiveConfig,
+	exposed []string,
 ) ([]domainsource.EnrichedEndpoint, error) {
 	logger := log.FromContext(ctx).WithName("source.cycle.externaldns")
 
@@ -68,6 +73,8 @@ func collectNative(
 	type sourceMeta struct {
 		labels map[string]string
 		anns   map[string]string
+		ports  string
+		paths  map[string][]string
 		ok     bool
 	}
 	metaCache := map[string]sourceMeta{}
@@ -97,7 +104,13 @@ func collectNative(
 						"kind", kind, "namespace", ns, "name", name, "err", gerr.Error())
 					metrics.SourceEnrichmentFailures.WithLabelValues(string(kind), "fetch").Inc()
 				} else {
-					m = sourceMeta{labels: obj.GetLabels(), anns: obj.GetAnnotations(), ok: true}
+					m = sourceMeta{
+						labels: obj.GetLabels(),
+						anns:   obj.GetAnnotations(),
+						ports:  servicePorts(obj),
+						paths:  routePaths(obj),
+						ok:     true,
+					}
 				}
 			}
 			metaCache[key] = m
@@ -108,6 +121,9 @@ func collectNative(
 			// onto the endpoint labels. ep is freshly returned by external-dns and
 			// owned here (not yet shared via the store), so mutation is safe.
 			adapter.EnrichEndpointLabels(ep, m.anns)
+			enrichPortsLabel(ep, m.ports)
+			enrichPathsLabel(ep, m.paths)
+			enrichAnnotationsLabel(ep, m.anns, exposed)
 		}
 
 		entries = append(entries, domainsource.EnrichedEndpoint{
@@ -119,7 +135,7 @@ func collectNative(
 			SourceAnnotations: m.anns,
 		})
 	}
-	return entries, nil
+	return appendAnnotatedEntries(ctx, c, kind, cfg, entries), nil
 }
 
 // parseResourceRef splits the external-dns "resource" label
@@ -135,6 +151,38 @@ func parseResourceRef(ref string) (namespace, name string) {
 	return parts[1], parts[2]
 }
 
+// newNativeObjectList returns a fresh empty typed list for listing a
+// natively-handled kind's source objects from the cache.
+func newNativeObjectList(kind registry.SourceType) client.ObjectList {
+	switch kind {
+	case externaldns.KindService:
+		return &corev1.ServiceList{}
+	case externaldns.KindIngress:
+		return &networkingv1.IngressList{}
+	case externaldns.KindIstioGateway:
+		return &istionetworkingv1.GatewayList{}
+	case externaldns.KindIstioVirtualService:
+		return &istionetworkingv1.VirtualServiceList{}
+	case externaldns.KindGatewayHTTPRoute:
+		return &gwapiv1.HTTPRouteList{}
+	case externaldns.KindGatewayGRPCRoute:
+		return &gwapiv1.GRPCRouteList{}
+	case externaldns.KindGatewayTCPRoute:
+		return &gwapiv1alpha2.TCPRouteList{}
+	case externaldns.KindGatewayTLSRoute:
+		return &gwapiv1alpha2.TLSRouteList{}
+	case externaldns.KindGatewayUDPRoute:
+		return &gwapiv1alpha2.UDPRouteList{}
+	case externaldns.KindContourHTTPProxy:
+		return &contourv1.HTTPProxyList{}
+	case externaldns.KindAmbassadorHost:
+		return &ambassadorv2.HostList{}
+	case externaldns.KindDNSEndpoint:
+		return &externaldnsv1alpha1.DNSEndpointList{}
+	}
+	return nil
+}
+
 // newNativeObject returns a fresh empty typed object for re-fetching a
 // natively-handled kind's source object from the cache.
 func newNativeObject(kind registry.SourceType) client.Object {
@@ -157,6 +205,10 @@ func newNativeObject(kind registry.SourceType) client.Object {
 		return &gwapiv1alpha2.TLSRoute{}
 	case externaldns.KindGatewayUDPRoute:
 		return &gwapiv1alpha2.UDPRoute{}
+	case externaldns.KindContourHTTPProxy:
+		return &contourv1.HTTPProxy{}
+	case externaldns.KindAmbassadorHost:
+		return &ambassadorv2.Host{}
 	case externaldns.KindDNSEndpoint:
 		return &externaldnsv1alpha1.DNSEndpoint{}
 	}
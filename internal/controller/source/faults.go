@@ -0,0 +1,69 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/golgoth31/sreportal/internal/source/registry"
+)
+
+// ErrInjectedFault is the collection failure reported for a kind the
+// FaultInjector made fail.
+var ErrInjectedFault = errors.New("injected source fault")
+
+// FaultRule makes a kind fail Failures collections out of every OutOf.
+type FaultRule struct {
+	Failures int
+	OutOf    int
+}
+
+// FaultInjector makes selected source kinds fail a share of their
+// collections, so operators can rehearse alerts and UI behaviour under a
+// partial discovery outage. A failed collection goes through the same path as
+// a real one: the previous endpoints are kept, the error metric is
+// incremented and a SourceFailed Event is emitted.
+type FaultInjector struct {
+	mu     sync.Mutex
+	rules  map[registry.SourceType]FaultRule
+	counts map[registry.SourceType]int
+}
+
+// NewFaultInjector returns a FaultInjector applying rules, keyed by kind.
+func NewFaultInjector(rules map[registry.SourceType]FaultRule) *FaultInjector {
+	return &FaultInjector{rules: rules, counts: make(map[registry.SourceType]int, len(rules))}
+}
+
+// Fail reports whether the current collection of kind must fail. Within each
+// window of OutOf collections, the first Failures ones fail. A nil
+// FaultInjector never fails.
+func (f *FaultInjector) Fail(kind registry.SourceType) bool {
+	if f == nil {
+		return false
+	}
+	rule, ok := f.rules[kind]
+	if !ok || rule.Failures <= 0 || rule.OutOf <= 0 {
+		return false
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n := f.counts[kind]
+	f.counts[kind] = (n + 1) % rule.OutOf
+	return n < rule.Failures
+}
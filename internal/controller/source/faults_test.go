@@ -0,0 +1,125 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/external-dns/endpoint"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	rsource "github.com/golgoth31/sreportal/internal/readstore/source"
+	cprec "github.com/golgoth31/sreportal/internal/source/crossplanescalewayrecord"
+	"github.com/golgoth31/sreportal/internal/source/externaldns"
+	"github.com/golgoth31/sreportal/internal/source/registry"
+)
+
+func TestFaultInjector_FailsFirstFailuresOfEachWindow(t *testing.T) {
+	f := NewFaultInjector(map[registry.SourceType]FaultRule{
+		externaldns.KindIngress: {Failures: 2, OutOf: 3},
+	})
+
+	var got []bool
+	for range 6 {
+		got = append(got, f.Fail(externaldns.KindIngress))
+	}
+
+	require.Equal(t, []bool{true, true, false, true, true, false}, got)
+	require.False(t, f.Fail(externaldns.KindService), "kinds without a rule never fail")
+}
+
+func TestFaultInjector_NilNeverFails(t *testing.T) {
+	var f *FaultInjector
+	require.False(t, f.Fail(externaldns.KindIngress))
+}
+
+// echoResolver resolves every Service to "<name>.example.com" under the
+// crossplane kind.
+type echoResolver struct{}
+
+func (echoResolver) Type() registry.SourceType {
+	return cprec.SourceTypeCrossplaneScalewayRecord
+}
+func (echoResolver) ObjectList() client.ObjectList { return &corev1.ServiceList{} }
+func (echoResolver) ResolveObject(_ context.Context, obj client.Object) ([]*endpoint.Endpoint, error) {
+	return []*endpoint.Endpoint{
+		endpoint.NewEndpoint(obj.GetName()+".example.com", endpoint.RecordTypeA, "1.2.3.4"),
+	}, nil
+}
+
+func TestCycle_InjectedFaultPreservesPreviousState(t *testing.T) {
+	kind := cprec.SourceTypeCrossplaneScalewayRecord
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	dns := &sreportalv1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: "team-a"},
+		Spec: sreportalv1alpha2.DNSSpec{
+			PortalRef: "team-a",
+			Sources: sreportalv1alpha2.SourcesSpec{
+				CrossplaneScalewayRecord: &sreportalv1alpha2.CrossplaneScalewayRecordSourceSpec{Enabled: true},
+			},
+		},
+	}
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "echo", Namespace: "team-a"}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(dns, svc).Build()
+	reg := registry.NewRegistry(echoResolver{})
+	store := rsource.NewStore()
+	recorder := events.NewFakeRecorder(4)
+	faults := NewFaultInjector(map[registry.SourceType]FaultRule{kind: {Failures: 1, OutOf: 2}})
+	health := rsource.NewHealthTracker()
+	ctx := context.Background()
+
+	// First collection fails: nothing collected yet, the failure is reported.
+	prev := cycle(ctx, c, reg, nil, store, nil, recorder, faults, nil, health)
+	got, err := store.Lookup(kind, "team-a", "")
+	require.NoError(t, err)
+	require.Empty(t, got)
+	require.Len(t, recorder.Events, 1)
+	require.Contains(t, <-recorder.Events, "Warning SourceFailed")
+	h, ok := health.SourceHealth(kind)
+	require.True(t, ok)
+	require.Equal(t, 1, h.Failures)
+	require.Equal(t, ErrInjectedFault.Error(), h.LastError)
+	require.True(t, h.LastSuccess.IsZero())
+
+	// Second collection succeeds.
+	prev = cycle(ctx, c, reg, nil, store, prev, recorder, faults, nil, health)
+	got, err = store.Lookup(kind, "team-a", "")
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	h, _ = health.SourceHealth(kind)
+	require.False(t, h.Failing())
+	require.False(t, h.LastSuccess.IsZero())
+
+	// Third collection fails again and keeps the previous endpoints.
+	require.NoError(t, c.Delete(ctx, svc))
+	_ = cycle(ctx, c, reg, nil, store, prev, recorder, faults, nil, health)
+	got, err = store.Lookup(kind, "team-a", "")
+	require.NoError(t, err)
+	require.Len(t, got, 1, "an injected fault must preserve the previous endpoints")
+	require.Len(t, recorder.Events, 1)
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/external-dns/endpoint"
+
+	"github.com/golgoth31/sreportal/internal/adapter"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	domainsource "github.com/golgoth31/sreportal/internal/domain/source"
+	"github.com/golgoth31/sreportal/internal/source/externaldns"
+	"github.com/golgoth31/sreportal/internal/source/registry"
+)
+
+// annotationEndpoints returns an endpoint per hostname of obj's
+// sreportal.io/fqdns annotation that own (the endpoints already produced for
+// obj) does not publish. Each hostname reuses the record types and targets of
+// own so it resolves like the resource's other FQDNs; a resource without any
+// endpoint yields target-less A endpoints. The endpoints carry the resource
+// label, the sreportal annotation labels and the ports and paths labels of
+// obj; the exposed origin annotations are not recorded on them.
+func annotationEndpoints(kind registry.SourceType, obj client.Object, own []*endpoint.Endpoint) []*endpoint.Endpoint {
+	hostnames := domaindns.ParseFQDNsAnnotation(obj.GetAnnotations()[domaindns.FQDNsAnnotationKey])
+	if len(hostnames) == 0 {
+		return nil
+	}
+
+	published := make(map[string]bool, len(own))
+	targetsByType := map[string][]string{}
+	for _, ep := range own {
+		published[ep.DNSName] = true
+		targetsByType[ep.RecordType] = append(targetsByType[ep.RecordType], ep.Targets...)
+	}
+	if len(targetsByType) == 0 {
+		targetsByType[endpoint.RecordTypeA] = nil
+	}
+	recordTypes := slices.Sorted(func(yield func(string) bool) {
+		for rt := range targetsByType {
+			if !yield(rt) {
+				return
+			}
+		}
+	})
+
+	var out []*endpoint.Endpoint
+	for _, h := range hostnames {
+		if published[h] {
+			continue
+		}
+		for _, rt := range recordTypes {
+			targets := slices.Compact(slices.Sorted(slices.Values(targetsByType[rt])))
+			ep := endpoint.NewEndpoint(h, rt, targets...)
+			ep.Labels[endpoint.ResourceLabelKey] = fmt.Sprintf("%s/%s/%s", kind, obj.GetNamespace(), obj.GetName())
+			adapter.EnrichEndpointLabels(ep, obj.GetAnnotations())
+			enrichPortsLabel(ep, servicePorts(obj))
+			enrichPathsLabel(ep, routePaths(obj))
+			out = append(out, ep)
+		}
+	}
+	return out
+}
+
+// appendAnnotatedEntries lists the objects of a natively-handled kind and
+// appends the endpoints of their sreportal.io/fqdns annotation to entries.
+// Objects external-dns derives no hostname from are covered too, but objects
+// outside the namespace, label or annotation filter of cfg are skipped like
+// on the external-dns path. A failed list keeps entries as they are.
+func appendAnnotatedEntries(
+	ctx context.Context,
+	c client.Client,
+	kind registry.SourceType,
+	cfg *externaldns.EffectiveConfig,
+	entries []domainsource.EnrichedEndpoint,
+) []domainsource.EnrichedEndpoint {
+	list := newNativeObjectList(kind)
+	if list == nil {
+		return entries
+	}
+	if err := c.List(ctx, list); err != nil {
+		log.FromContext(ctx).WithName("source.cycle.fqdns").V(1).Info(
+			"listing objects for the sreportal.io/fqdns annotation failed; skipping it", "kind", kind, "err", err.Error())
+		return entries
+	}
+	items, _ := extractItems(list)
+
+	own := map[string][]*endpoint.Endpoint{}
+	for _, e := range entries {
+		key := e.Namespace + "/" + e.Name
+		own[key] = append(own[key], e.Endpoint)
+	}
+	for _, obj := range items {
+		if cfg != nil && !cfg.Selects(obj.GetNamespace(), obj.GetLabels(), obj.GetAnnotations()) {
+			continue
+		}
+		eps := annotationEndpoints(kind, obj, own[obj.GetNamespace()+"/"+obj.GetName()])
+		for _, ep := range eps {
+			entries = append(entries, domainsource.EnrichedEndpoint{
+				Endpoint:          ep,
+				Kind:              kind,
+				Namespace:         obj.GetNamespace(),
+				Name:              obj.GetName(),
+				SourceLabels:      obj.GetLabels(),
+				SourceAnnotations: obj.GetAnnotations(),
+			})
+		}
+	}
+	return entries
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/external-dns/endpoint"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	srccontrol "github.com/golgoth31/sreportal/internal/controller/source"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	rsource "github.com/golgoth31/sreportal/internal/readstore/source"
+	"github.com/golgoth31/sreportal/internal/source/externaldns"
+	"github.com/golgoth31/sreportal/internal/source/registry"
+)
+
+// TestCycle_FQDNsAnnotationOnNativeKind verifies the sreportal.io/fqdns
+// annotation adds its hostnames next to the ones external-dns derives, reusing
+// the resource's targets, and publishes an Ingress external-dns ignores.
+func TestCycle_FQDNsAnnotationOnNativeKind(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
+	require.NoError(t, networkingv1.AddToScheme(scheme))
+
+	withRules := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "app", Namespace: tNsDefault,
+			Annotations: map[string]string{domaindns.FQDNsAnnotationKey: "app.example.com, Alias.example.com."},
+		},
+		Spec: networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{Host: "app.example.com"}}},
+		Status: networkingv1.IngressStatus{LoadBalancer: networkingv1.IngressLoadBalancerStatus{
+			Ingress: []networkingv1.IngressLoadBalancerIngress{{IP: tLBIP}},
+		}},
+	}
+	noRules := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "adhoc", Namespace: tNsDefault,
+			Annotations: map[string]string{domaindns.FQDNsAnnotationKey: "adhoc.example.com"},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ingressDNS(), withRules, noRules).Build()
+	provider := externaldns.NewProvider(kubefake.NewSimpleClientset(withRules, noRules), nil, nil)
+	store := rsource.NewStore()
+
+	_ = srccontrol.Cycle(context.Background(), c, registry.NewRegistry(), provider, store, nil)
+
+	got, err := store.Lookup(externaldns.KindIngress, tNsDefault, "")
+	require.NoError(t, err)
+	byName := map[string]*endpoint.Endpoint{}
+	for _, e := range got {
+		byName[e.Endpoint.DNSName] = e.Endpoint
+	}
+	require.Len(t, byName, 3)
+	require.Equal(t, endpoint.Targets{tLBIP}, byName["alias.example.com"].Targets)
+	require.Equal(t, "ingress/default/app", byName["alias.example.com"].Labels[endpoint.ResourceLabelKey])
+	require.Equal(t, endpoint.RecordTypeA, byName["adhoc.example.com"].RecordType)
+	require.Empty(t, byName["adhoc.example.com"].Targets)
+}
+
+// TestCycle_FQDNsAnnotationHonoursSourceFilters verifies objects outside the
+// label filter of the source publish no FQDN through the annotation either.
+func TestCycle_FQDNsAnnotationHonoursSourceFilters(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
+	require.NoError(t, networkingv1.AddToScheme(scheme))
+
+	dns := ingressDNS()
+	dns.Spec.Sources.Ingress.LabelFilter = "team=a"
+	selected := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{
+		Name: "selected", Namespace: tNsDefault, Labels: map[string]string{"team": "a"},
+		Annotations: map[string]string{domaindns.FQDNsAnnotationKey: "selected.example.com"},
+	}}
+	filtered := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{
+		Name: "filtered", Namespace: tNsDefault, Labels: map[string]string{"team": "b"},
+		Annotations: map[string]string{domaindns.FQDNsAnnotationKey: "filtered.example.com"},
+	}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(dns, selected, filtered).Build()
+	provider := externaldns.NewProvider(kubefake.NewSimpleClientset(selected, filtered), nil, nil)
+	store := rsource.NewStore()
+
+	_ = srccontrol.Cycle(context.Background(), c, registry.NewRegistry(), provider, store, nil)
+
+	got, err := store.Lookup(externaldns.KindIngress, tNsDefault, "")
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, "selected.example.com", got[0].Endpoint.DNSName)
+}
+
+// TestCycle_FQDNsAnnotationOnResolverKind verifies the annotation is honoured
+// on the resolver path too.
+func TestCycle_FQDNsAnnotationOnResolverKind(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{
+		Name: "echo", Namespace: tTeamA,
+		Annotations: map[string]string{domaindns.FQDNsAnnotationKey: "echo.internal.example.com"},
+	}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(crossDNS("d", tTeamA), svc).Build()
+	store := rsource.NewStore()
+
+	_ = srccontrol.Cycle(context.Background(), c, registry.NewRegistry(&fakeResolver{}), nil, store, nil)
+
+	got, err := store.Lookup(crossKind, tTeamA, "")
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	names := []string{got[0].Endpoint.DNSName, got[1].Endpoint.DNSName}
+	require.ElementsMatch(t, []string{"echo.example.com", "echo.internal.example.com"}, names)
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	contourv1 "github.com/projectcontour/contour/apis/projectcontour/v1"
+	istionetworkingv1 "istio.io/client-go/pkg/apis/networking/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/external-dns/endpoint"
+
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+// routePaths returns the HTTP route paths declared by obj, keyed by the host
+// they are served under. The "" key holds paths served under every host of
+// the object: Ingress rules without a host, every VirtualService route
+// (routes apply to all spec.hosts) and every HTTPProxy route. Returns nil for objects without routes.
+//
+// Only literal paths are kept: Ingress paths, VirtualService exact and
+// prefix URI matches, and HTTPProxy prefix and exact conditions. Regex
+// matches are not representable as a path.
+//
+// The paths of an HTTPProxy are those of its routes and the prefixes its
+// includes are mounted under: the included HTTPProxies have no FQDN of their
+// own and are served under the FQDN of the root.
+func routePaths(obj client.Object) map[string][]string {
+	switch o := obj.(type) {
+	case *networkingv1.Ingress:
+		var byHost map[string][]string
+		for _, rule := range o.Spec.Rules {
+			if rule.HTTP == nil {
+				continue
+			}
+			for _, p := range rule.HTTP.Paths {
+				path := p.Path
+				if path == "" {
+					path = "/"
+				}
+				if byHost == nil {
+					byHost = map[string][]string{}
+				}
+				byHost[rule.Host] = append(byHost[rule.Host], path)
+			}
+		}
+		return byHost
+	case *istionetworkingv1.VirtualService:
+		var paths []string
+		for _, route := range o.Spec.GetHttp() {
+			for _, m := range route.GetMatch() {
+				if uri := m.GetUri(); uri.GetPrefix() != "" {
+					paths = append(paths, uri.GetPrefix())
+				} else if uri.GetExact() != "" {
+					paths = append(paths, uri.GetExact())
+				}
+			}
+		}
+		if len(paths) == 0 {
+			return nil
+		}
+		return map[string][]string{"": paths}
+	case *contourv1.HTTPProxy:
+		var paths []string
+		for _, route := range o.Spec.Routes {
+			if path := conditionsPath(route.Conditions); path != "" {
+				paths = append(paths, path)
+			}
+		}
+		for _, include := range o.Spec.Includes {
+			if path := conditionsPath(include.Conditions); path != "" {
+				paths = append(paths, path)
+			}
+		}
+		if len(paths) == 0 {
+			return nil
+		}
+		return map[string][]string{"": paths}
+	}
+	return nil
+}
+
+// conditionsPath returns the path matched by Contour match conditions: their
+// exact or prefix condition, "/" when they do not match on the path, and ""
+// when they only match a regex.
+func conditionsPath(conditions []contourv1.MatchCondition) string {
+	regex := false
+	for _, c := range conditions {
+		switch {
+		case c.Exact != "":
+			return c.Exact
+		case c.Prefix != "":
+			return c.Prefix
+		case c.Regex != "":
+			regex = true
+		}
+	}
+	if regex {
+		return ""
+	}
+	return "/"
+}
+
+// enrichPathsLabel records the route paths served under the endpoint's
+// hostname on its labels, encoded for domaindns.PathsLabelKey.
+func enrichPathsLabel(ep *endpoint.Endpoint, byHost map[string][]string) {
+	if len(byHost) == 0 {
+		return
+	}
+	paths := domaindns.FormatPaths(append(append([]string(nil), byHost[ep.DNSName]...), byHost[""]...))
+	if paths == "" {
+		return
+	}
+	if ep.Labels == nil {
+		ep.Labels = endpoint.NewLabels()
+	}
+	ep.Labels[domaindns.PathsLabelKey] = paths
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"testing"
+
+	contourv1 "github.com/projectcontour/contour/apis/projectcontour/v1"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRoutePaths_HTTPProxy verifies an HTTPProxy lists the paths of its
+// routes and the prefixes of its includes, skipping regex-only conditions.
+func TestRoutePaths_HTTPProxy(t *testing.T) {
+	proxy := &contourv1.HTTPProxy{Spec: contourv1.HTTPProxySpec{
+		VirtualHost: &contourv1.VirtualHost{Fqdn: "app.example.com"},
+		Routes: []contourv1.Route{
+			{Conditions: []contourv1.MatchCondition{{Prefix: "/api"}}},
+			{Conditions: []contourv1.MatchCondition{{Exact: "/healthz"}}},
+			{Conditions: []contourv1.MatchCondition{{Regex: "/v[0-9]+/.*"}}},
+			{},
+		},
+		Includes: []contourv1.Include{
+			{Name: "blog", Conditions: []contourv1.MatchCondition{{Prefix: "/blog"}}},
+		},
+	}}
+
+	require.Equal(t, map[string][]string{"": {"/api", "/healthz", "/", "/blog"}}, routePaths(proxy))
+	require.Nil(t, routePaths(&contourv1.HTTPProxy{}))
+}
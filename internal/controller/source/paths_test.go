@@ -0,0 +1,83 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	srccontrol "github.com/golgoth31/sreportal/internal/controller/source"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	rsource "github.com/golgoth31/sreportal/internal/readstore/source"
+	"github.com/golgoth31/sreportal/internal/source/externaldns"
+	"github.com/golgoth31/sreportal/internal/source/registry"
+)
+
+// TestCycle_RecordsIngressPathsPerHost verifies the native ingress path
+// records, for each discovered host, the paths of its own rule plus those of
+// host-less rules.
+func TestCycle_RecordsIngressPathsPerHost(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
+	require.NoError(t, networkingv1.AddToScheme(scheme))
+
+	httpRule := func(host string, paths ...string) networkingv1.IngressRule {
+		rule := networkingv1.IngressRule{Host: host, IngressRuleValue: networkingv1.IngressRuleValue{
+			HTTP: &networkingv1.HTTPIngressRuleValue{},
+		}}
+		for _, p := range paths {
+			rule.HTTP.Paths = append(rule.HTTP.Paths, networkingv1.HTTPIngressPath{Path: p})
+		}
+		return rule
+	}
+	ing := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: tNsDefault},
+		Spec: networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{
+			httpRule("app.example.com", "/api", "/static"),
+			httpRule("admin.example.com", ""),
+			httpRule("", "/healthz"),
+		}},
+		Status: networkingv1.IngressStatus{LoadBalancer: networkingv1.IngressLoadBalancerStatus{
+			Ingress: []networkingv1.IngressLoadBalancerIngress{{IP: tLBIP}},
+		}},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ingressDNS(), ing).Build()
+	provider := externaldns.NewProvider(kubefake.NewSimpleClientset(ing), nil, nil)
+	store := rsource.NewStore()
+
+	_ = srccontrol.Cycle(context.Background(), c, registry.NewRegistry(), provider, store, nil)
+
+	got, err := store.Lookup(externaldns.KindIngress, tNsDefault, "")
+	require.NoError(t, err)
+	paths := map[string]string{}
+	for _, e := range got {
+		paths[e.Endpoint.DNSName] = e.Endpoint.Labels[domaindns.PathsLabelKey]
+	}
+	require.Equal(t, map[string]string{
+		"app.example.com":   "/api,/healthz,/static",
+		"admin.example.com": "/,/healthz",
+	}, paths)
+}
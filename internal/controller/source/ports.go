@@ -0,0 +1,60 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/external-dns/endpoint"
+
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+// servicePorts returns the ports exposed by obj when it is a Service, encoded
+// for domaindns.PortsLabelKey. Unnamed ports fall back to their appProtocol.
+// Returns "" for any other object or a Service without ports.
+func servicePorts(obj client.Object) string {
+	svc, ok := obj.(*corev1.Service)
+	if !ok || len(svc.Spec.Ports) == 0 {
+		return ""
+	}
+	ports := make([]domaindns.ServicePort, 0, len(svc.Spec.Ports))
+	for _, p := range svc.Spec.Ports {
+		name := p.Name
+		if name == "" && p.AppProtocol != nil {
+			name = *p.AppProtocol
+		}
+		protocol := p.Protocol
+		if protocol == "" {
+			protocol = corev1.ProtocolTCP
+		}
+		ports = append(ports, domaindns.ServicePort{Name: name, Port: p.Port, Protocol: string(protocol)})
+	}
+	return domaindns.FormatPorts(ports)
+}
+
+// enrichPortsLabel records the encoded Service ports on the endpoint labels so
+// they ride along to spec.entries like the sreportal.io/groups annotation.
+func enrichPortsLabel(ep *endpoint.Endpoint, ports string) {
+	if ports == "" {
+		return
+	}
+	if ep.Labels == nil {
+		ep.Labels = endpoint.NewLabels()
+	}
+	ep.Labels[domaindns.PortsLabelKey] = ports
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	srccontrol "github.com/golgoth31/sreportal/internal/controller/source"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	rsource "github.com/golgoth31/sreportal/internal/readstore/source"
+	"github.com/golgoth31/sreportal/internal/source/registry"
+)
+
+// TestCycle_RecordsServicePortsOnEndpoint verifies the source cycle encodes
+// the ports of a Service onto the endpoint labels, falling back to the
+// appProtocol for unnamed ports and defaulting the protocol to TCP.
+func TestCycle_RecordsServicePortsOnEndpoint(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "portsvc", Namespace: tTeamA},
+		Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{
+			{Name: "https", Port: 443, Protocol: corev1.ProtocolTCP},
+			{Port: 8443, AppProtocol: ptr.To("grpc")},
+		}},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(crossDNS("d", tTeamA), svc).Build()
+	reg := registry.NewRegistry(&fakeResolver{})
+	store := rsource.NewStore()
+
+	_ = srccontrol.Cycle(context.Background(), c, reg, nil, store, nil)
+
+	got, err := store.Lookup(crossKind, tTeamA, "")
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t,
+		[]domaindns.ServicePort{
+			{Name: "https", Port: 443, Protocol: "TCP"},
+			{Name: "grpc", Port: 8443, Protocol: "TCP"},
+		},
+		domaindns.ParsePorts(got[0].Endpoint.Labels[domaindns.PortsLabelKey]))
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"time"
 
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -42,16 +43,43 @@ type SourceReconciler struct {
 	// back to the registered resolvers for every kind.
 	Provider *externaldns.Provider
 
+	// Recorder, when set, reports failing source kinds as Warning Events on
+	// the DNS CRs enabling them.
+	Recorder events.EventRecorder
+
+	// Faults, when set, makes selected kinds fail a share of their
+	// collections (fault injection).
+	Faults *FaultInjector
+
+	// ExposedAnnotations lists the origin annotation keys carried onto the
+	// endpoints, down to the FQDNs returned by the API.
+	ExposedAnnotations []string
+
+	// Guard, when set, pauses discovery while it reports a hard scale limit
+	// reached: cycles only apply the collections that do not add endpoints,
+	// so that the counts can drop back under the limit and discovery resume.
+	Guard DiscoveryGuard
+
+	// Health, when set, records the outcome of each collection per kind
+	// (consecutive failures, last error, last success) for the readers that
+	// report source health, such as the diagnostics.
+	Health domainsource.SourceHealthWriter
+
 	previousKinds map[registry.SourceType]bool
 }
 
+// DiscoveryGuard tells whether source discovery must pause.
+type DiscoveryGuard interface {
+	DiscoveryPaused() bool
+}
+
 var _ manager.Runnable = (*SourceReconciler)(nil)
 
 // Start runs the producer loop until ctx is cancelled. Each tick rebuilds the
 // kind-set from non-remote DNS CRs and refreshes the SourceEndpointStore.
 func (r *SourceReconciler) Start(ctx context.Context) error {
 	logger := log.FromContext(ctx).WithName("source.reconciler")
-	r.previousKinds = Cycle(ctx, r.Client, r.Registry, r.Provider, r.Store, r.previousKinds)
+	r.runCycle(ctx)
 	t := time.NewTicker(r.Interval)
 	defer t.Stop()
 	for {
@@ -59,8 +87,79 @@ func (r *SourceReconciler) Start(ctx context.Context) error {
 		case <-ctx.Done():
 			return nil
 		case <-t.C:
-			r.previousKinds = Cycle(ctx, r.Client, r.Registry, r.Provider, r.Store, r.previousKinds)
-			logger.V(2).Info("cycle complete", "kinds", len(r.previousKinds))
+			if r.runCycle(ctx) {
+				logger.V(2).Info("cycle complete", "kinds", len(r.previousKinds))
+			}
 		}
 	}
 }
+
+// runCycle runs one producer cycle, restricted to the collections that do
+// not add endpoints while the guard paused discovery, and reports whether
+// discovery ran unrestricted.
+func (r *SourceReconciler) runCycle(ctx context.Context) bool {
+	store := r.Store
+	paused := r.Guard != nil && r.Guard.DiscoveryPaused()
+	if paused {
+		logger := log.FromContext(ctx).WithName("source.reconciler")
+		current, ok := r.Store.(shrinkableStore)
+		if !ok {
+			logger.Info("discovery paused by the scale guard")
+			return false
+		}
+		logger.Info("discovery paused by the scale guard; only removing or updating endpoints")
+		store = shrinkOnlyWriter{shrinkableStore: current}
+	}
+	r.previousKinds = cycle(ctx, r.Client, r.Registry, r.Provider, store, r.previousKinds, r.Recorder, r.Faults, r.ExposedAnnotations, r.Health)
+	return !paused
+}
+
+// shrinkableStore is a store whose current entries shrinkOnlyWriter can read.
+type shrinkableStore interface {
+	domainsource.SourceEndpointWriter
+	domainsource.SourceEndpointReader
+}
+
+// shrinkOnlyWriter applies only the part of a collection known to the store:
+// the endpoints it no longer lists are removed and the ones it still lists
+// updated, but new endpoints are dropped. A kind never collected is left
+// unset.
+type shrinkOnlyWriter struct {
+	shrinkableStore
+}
+
+func (w shrinkOnlyWriter) ReplaceKind(kind registry.SourceType, entries []domainsource.EnrichedEndpoint) {
+	if !w.Ready(kind) {
+		return
+	}
+	current, err := w.Lookup(kind, "", "")
+	if err != nil {
+		return
+	}
+	known := make(map[entryKey]bool, len(current))
+	for _, e := range current {
+		known[keyOf(e)] = true
+	}
+	kept := make([]domainsource.EnrichedEndpoint, 0, len(entries))
+	for _, e := range entries {
+		if known[keyOf(e)] {
+			kept = append(kept, e)
+		}
+	}
+	w.shrinkableStore.ReplaceKind(kind, kept)
+}
+
+// entryKey identifies an endpoint of a kind across collections: its source
+// object and record.
+type entryKey struct {
+	namespace, name               string
+	dnsName, recordType, setIdent string
+}
+
+func keyOf(e domainsource.EnrichedEndpoint) entryKey {
+	k := entryKey{namespace: e.Namespace, name: e.Name}
+	if e.Endpoint != nil {
+		k.dnsName, k.recordType, k.setIdent = e.Endpoint.DNSName, e.Endpoint.RecordType, e.Endpoint.SetIdentifier
+	}
+	return k
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statuscompaction
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
+)
+
+const (
+	// FormatVersionAnnotationKey records the status format a DNSRecord was
+	// compacted to. Records already at CurrentFormatVersion are left alone.
+	FormatVersionAnnotationKey = "sreportal.io/status-format-version"
+	// CurrentFormatVersion is the deduplicated status format: one endpoint
+	// per (dnsName, recordType).
+	CurrentFormatVersion = "2"
+)
+
+// +kubebuilder:rbac:groups=sreportal.io,resources=dnsrecords,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=sreportal.io,resources=dnsrecords/status,verbs=get;patch
+
+// Compactor rewrites, once at startup, the DNSRecord statuses written by
+// older operator versions, which could list the same FQDN several times, into
+// the deduplicated format. Each record is then flagged with the
+// FormatVersionAnnotationKey annotation so later startups skip it. A record
+// that fails to compact is logged and retried on the next startup.
+type Compactor struct {
+	Client client.Client
+}
+
+// New creates a Compactor.
+func New(c client.Client) *Compactor {
+	return &Compactor{Client: c}
+}
+
+var _ manager.Runnable = (*Compactor)(nil)
+
+// Start implements manager.Runnable. It runs a single pass and returns.
+func (c *Compactor) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("status-compaction")
+	ctx = log.IntoContext(ctx, logger)
+
+	compacted, err := c.run(ctx)
+	if err != nil {
+		logger.Error(err, "DNSRecord status compaction failed")
+		return nil
+	}
+	if compacted > 0 {
+		logger.Info("compacted legacy DNSRecord statuses", "records", compacted)
+	}
+	return nil
+}
+
+// run compacts every DNSRecord not yet at CurrentFormatVersion and returns
+// how many had duplicates removed.
+func (c *Compactor) run(ctx context.Context) (int, error) {
+	logger := log.FromContext(ctx)
+
+	var records v1alpha2.DNSRecordList
+	if err := c.Client.List(ctx, &records); err != nil {
+		return 0, fmt.Errorf("list DNSRecords: %w", err)
+	}
+
+	compacted := 0
+	for i := range records.Items {
+		rec := &records.Items[i]
+		if rec.Annotations[FormatVersionAnnotationKey] == CurrentFormatVersion {
+			continue
+		}
+		changed, err := c.compact(ctx, rec)
+		if err != nil {
+			logger.Error(err, "compact DNSRecord status", "record", rec.Namespace+"/"+rec.Name)
+			continue
+		}
+		if changed {
+			compacted++
+		}
+	}
+	return compacted, nil
+}
+
+// compact rewrites the status of rec when it holds duplicates, then flags rec
+// with the current format version.
+func (c *Compactor) compact(ctx context.Context, rec *v1alpha2.DNSRecord) (bool, error) {
+	endpoints, changed := CompactEndpoints(rec.Status.Endpoints)
+	if changed {
+		base := rec.DeepCopy()
+		rec.Status.Endpoints = endpoints
+		if len(endpoints) == 0 {
+			rec.Status.EndpointsHash = ""
+		} else {
+			rec.Status.EndpointsHash = adapter.EndpointStatusHashV2(endpoints)
+		}
+		if err := c.Client.Status().Patch(ctx, rec, client.MergeFrom(base)); err != nil {
+			return false, fmt.Errorf("patch status: %w", err)
+		}
+	}
+
+	base := rec.DeepCopy()
+	if rec.Annotations == nil {
+		rec.Annotations = map[string]string{}
+	}
+	rec.Annotations[FormatVersionAnnotationKey] = CurrentFormatVersion
+	if err := c.Client.Patch(ctx, rec, client.MergeFrom(base)); err != nil {
+		return false, fmt.Errorf("flag format version: %w", err)
+	}
+	return changed, nil
+}
+
+// CompactEndpoints merges the endpoints sharing a (dnsName, recordType) pair
+// into the first one: targets are unioned and sorted, labels unioned with the
+// first value winning, lastSeen set to the latest, and the first non-empty
+// sync, split-horizon and availability status kept. It reports whether any
+// duplicate was found. Endpoints keep their first-seen order.
+func CompactEndpoints(endpoints []v1alpha2.EndpointStatus) ([]v1alpha2.EndpointStatus, bool) {
+	type key struct{ name, recordType string }
+
+	index := make(map[key]int, len(endpoints))
+	out := make([]v1alpha2.EndpointStatus, 0, len(endpoints))
+	changed := false
+	for _, ep := range endpoints {
+		k := key{ep.DNSName, ep.RecordType}
+		i, seen := index[k]
+		if !seen {
+			index[k] = len(out)
+			out = append(out, *ep.DeepCopy())
+			continue
+		}
+		changed = true
+		merge(&out[i], ep)
+	}
+	if !changed {
+		return endpoints, false
+	}
+	return out, true
+}
+
+// merge folds dup into dst.
+func merge(dst *v1alpha2.EndpointStatus, dup v1alpha2.EndpointStatus) {
+	targets := slices.Clone(dst.Targets)
+	for _, t := range dup.Targets {
+		if !slices.Contains(targets, t) {
+			targets = append(targets, t)
+		}
+	}
+	sort.Strings(targets)
+	dst.Targets = targets
+
+	for k, v := range dup.Labels {
+		if _, ok := dst.Labels[k]; ok {
+			continue
+		}
+		if dst.Labels == nil {
+			dst.Labels = map[string]string{}
+		}
+		dst.Labels[k] = v
+	}
+	if dst.TTL == 0 {
+		dst.TTL = dup.TTL
+	}
+	if dup.LastSeen.After(dst.LastSeen.Time) {
+		dst.LastSeen = dup.LastSeen
+	}
+	if dst.SyncStatus == "" {
+		dst.SyncStatus = dup.SyncStatus
+	}
+	if dst.InternalStatus == "" {
+		dst.InternalStatus = dup.InternalStatus
+	}
+	if dst.ExternalStatus == "" {
+		dst.ExternalStatus = dup.ExternalStatus
+	}
+	if dst.LookupFailure == "" {
+		dst.LookupFailure = dup.LookupFailure
+	}
+	if dst.Availability == "" {
+		dst.Availability = dup.Availability
+	}
+	if dst.HealthStatus == "" {
+		dst.HealthStatus = dup.HealthStatus
+		dst.LastProbeTime = dup.LastProbeTime
+		dst.HTTPStatusCode = dup.HTTPStatusCode
+		dst.ProbeLatency = dup.ProbeLatency
+		dst.TLSValid = dup.TLSValid
+		dst.TLSNotAfter = dup.TLSNotAfter
+	}
+	if dst.OwnershipConflict == nil {
+		dst.OwnershipConflict = dup.OwnershipConflict
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statuscompaction
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
+)
+
+const (
+	tNS     = "ns"
+	tAPI    = "api.example.com"
+	tWeb    = "web.example.com"
+	tRecord = "r"
+)
+
+func legacyEndpoints() []v1alpha2.EndpointStatus {
+	older := metav1.NewTime(time.Unix(1000, 0))
+	newer := metav1.NewTime(time.Unix(2000, 0))
+	return []v1alpha2.EndpointStatus{
+		{DNSName: tAPI, RecordType: "A", Targets: []string{"10.0.0.2"}, LastSeen: older,
+			Labels: map[string]string{"sreportal.io/group": "apps"}},
+		{DNSName: tWeb, RecordType: "A", Targets: []string{"10.0.0.9"}, LastSeen: older},
+		{DNSName: tAPI, RecordType: "A", Targets: []string{"10.0.0.1", "10.0.0.2"}, LastSeen: newer,
+			SyncStatus: v1alpha2.SyncStatusSync, Labels: map[string]string{"sreportal.io/group": "other", "x": "y"}},
+		{DNSName: tAPI, RecordType: "AAAA", Targets: []string{"::1"}, LastSeen: older},
+	}
+}
+
+func TestCompactEndpoints_MergesDuplicates(t *testing.T) {
+	out, changed := CompactEndpoints(legacyEndpoints())
+
+	require.True(t, changed)
+	require.Len(t, out, 3)
+	api := out[0]
+	assert.Equal(t, tAPI, api.DNSName)
+	assert.Equal(t, []string{"10.0.0.1", "10.0.0.2"}, api.Targets)
+	assert.Equal(t, map[string]string{"sreportal.io/group": "apps", "x": "y"}, api.Labels)
+	assert.Equal(t, int64(2000), api.LastSeen.Unix())
+	assert.Equal(t, v1alpha2.SyncStatusSync, api.SyncStatus)
+	assert.Equal(t, tWeb, out[1].DNSName)
+	assert.Equal(t, "AAAA", out[2].RecordType)
+}
+
+func TestCompactEndpoints_NoDuplicates_Unchanged(t *testing.T) {
+	in := legacyEndpoints()[:2]
+
+	out, changed := CompactEndpoints(in)
+
+	assert.False(t, changed)
+	assert.Equal(t, in, out)
+}
+
+func newTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha2.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).
+		WithStatusSubresource(&v1alpha2.DNSRecord{}).
+		WithObjects(objs...).Build()
+}
+
+func record(name string, annotations map[string]string) *v1alpha2.DNSRecord {
+	return &v1alpha2.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: tNS, Annotations: annotations},
+		Spec:       v1alpha2.DNSRecordSpec{Origin: v1alpha2.DNSRecordOriginAuto, PortalRef: "main"},
+		Status:     v1alpha2.DNSRecordStatus{Endpoints: legacyEndpoints(), EndpointsHash: "legacy"},
+	}
+}
+
+func getRecord(t *testing.T, c client.Client, name string) *v1alpha2.DNSRecord {
+	t.Helper()
+	var rec v1alpha2.DNSRecord
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: tNS, Name: name}, &rec))
+	return &rec
+}
+
+func TestCompactor_RewritesLegacyStatusAndFlagsRecord(t *testing.T) {
+	c := newTestClient(t, record(tRecord, nil))
+
+	compacted, err := New(c).run(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, compacted)
+	rec := getRecord(t, c, tRecord)
+	assert.Len(t, rec.Status.Endpoints, 3)
+	assert.Equal(t, adapter.EndpointStatusHashV2(rec.Status.Endpoints), rec.Status.EndpointsHash)
+	assert.Equal(t, CurrentFormatVersion, rec.Annotations[FormatVersionAnnotationKey])
+}
+
+func TestCompactor_SkipsRecordsAtCurrentVersion(t *testing.T) {
+	c := newTestClient(t, record(tRecord, map[string]string{FormatVersionAnnotationKey: CurrentFormatVersion}))
+
+	compacted, err := New(c).run(context.Background())
+
+	require.NoError(t, err)
+	assert.Zero(t, compacted)
+	assert.Len(t, getRecord(t, c, tRecord).Status.Endpoints, 4)
+}
+
+func TestCompactor_FlagsCompactRecords(t *testing.T) {
+	rec := record(tRecord, nil)
+	rec.Status.Endpoints = legacyEndpoints()[:2]
+	c := newTestClient(t, rec)
+
+	compacted, err := New(c).run(context.Background())
+
+	require.NoError(t, err)
+	assert.Zero(t, compacted)
+	got := getRecord(t, c, tRecord)
+	assert.Equal(t, "legacy", got.Status.EndpointsHash)
+	assert.Equal(t, CurrentFormatVersion, got.Annotations[FormatVersionAnnotationKey])
+}
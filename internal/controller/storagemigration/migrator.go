@@ -0,0 +1,181 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package storagemigration upgrades, once at startup, the objects written by
+// older operator versions, so that an upgrade needs no manual patching.
+package storagemigration
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+)
+
+const (
+	// MigratedVersionAnnotationKey records the migration version an object
+	// was upgraded to. Objects already at CurrentVersion are left alone.
+	MigratedVersionAnnotationKey = "sreportal.io/migrated-version"
+	// CurrentVersion is bumped whenever a migration step is added below.
+	//
+	//   - "1": DNS, DNSRecord and Portal objects rewritten in their storage
+	//     version; the v1alpha1 spec.groups of a DNS (the
+	//     V1Alpha1GroupsAnnotationKey annotation) turned into manual
+	//     DNSRecords.
+	CurrentVersion = "1"
+)
+
+// +kubebuilder:rbac:groups=sreportal.io,resources=dns,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=sreportal.io,resources=dnsrecords,verbs=get;list;watch;create;patch
+// +kubebuilder:rbac:groups=sreportal.io,resources=portals,verbs=get;list;watch;patch
+
+// Summary counts the objects upgraded by a run, per kind.
+type Summary struct {
+	DNS        int
+	DNSRecords int
+	Portals    int
+	// Failed counts the objects left at their previous version, retried on
+	// the next startup.
+	Failed int
+}
+
+// Migrator walks the DNS, DNSRecord and Portal objects once at startup and
+// upgrades those not yet at CurrentVersion. Writing an object back through the
+// operator's client rewrites it in the storage version of its CRD, so objects
+// last written as v1alpha1 no longer depend on the conversion webhook; each is
+// then flagged with MigratedVersionAnnotationKey. An object that fails to
+// migrate is logged, left unflagged and retried on the next startup.
+type Migrator struct {
+	Client client.Client
+}
+
+// New creates a Migrator.
+func New(c client.Client) *Migrator {
+	return &Migrator{Client: c}
+}
+
+var _ manager.Runnable = (*Migrator)(nil)
+
+// Start implements manager.Runnable. It runs a single pass and returns.
+func (m *Migrator) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("storage-migration")
+	ctx = log.IntoContext(ctx, logger)
+
+	sum, err := m.run(ctx)
+	if err != nil {
+		logger.Error(err, "storage migration failed")
+		return nil
+	}
+	if sum.DNS+sum.DNSRecords+sum.Portals+sum.Failed > 0 {
+		logger.Info("migrated objects to the current version", "version", CurrentVersion,
+			"dns", sum.DNS, "dnsRecords", sum.DNSRecords, "portals", sum.Portals, "failed", sum.Failed)
+	}
+	return nil
+}
+
+// run upgrades every object not yet at CurrentVersion. DNS objects go first:
+// their migration creates DNSRecords, which are then already current.
+func (m *Migrator) run(ctx context.Context) (Summary, error) {
+	logger := log.FromContext(ctx)
+	var sum Summary
+
+	var dnsList v1alpha2.DNSList
+	if err := m.Client.List(ctx, &dnsList); err != nil {
+		return sum, fmt.Errorf("list DNS: %w", err)
+	}
+	for i := range dnsList.Items {
+		dns := &dnsList.Items[i]
+		if current(dns) {
+			continue
+		}
+		if err := m.migrateDNS(ctx, dns); err != nil {
+			logger.Error(err, "migrate DNS", "dns", dns.Namespace+"/"+dns.Name)
+			sum.Failed++
+			continue
+		}
+		sum.DNS++
+	}
+
+	var records v1alpha2.DNSRecordList
+	if err := m.Client.List(ctx, &records); err != nil {
+		return sum, fmt.Errorf("list DNSRecords: %w", err)
+	}
+	for i := range records.Items {
+		rec := &records.Items[i]
+		if current(rec) {
+			continue
+		}
+		if err := m.stamp(ctx, rec); err != nil {
+			logger.Error(err, "migrate DNSRecord", "record", rec.Namespace+"/"+rec.Name)
+			sum.Failed++
+			continue
+		}
+		sum.DNSRecords++
+	}
+
+	var portals sreportalv1alpha1.PortalList
+	if err := m.Client.List(ctx, &portals); err != nil {
+		return sum, fmt.Errorf("list Portals: %w", err)
+	}
+	for i := range portals.Items {
+		portal := &portals.Items[i]
+		if current(portal) {
+			continue
+		}
+		if err := m.stamp(ctx, portal); err != nil {
+			logger.Error(err, "migrate Portal", "portal", portal.Namespace+"/"+portal.Name)
+			sum.Failed++
+			continue
+		}
+		sum.Portals++
+	}
+	return sum, nil
+}
+
+// migrateDNS turns the v1alpha1 groups of dns into manual DNSRecords, then
+// flags it.
+func (m *Migrator) migrateDNS(ctx context.Context, dns *v1alpha2.DNS) error {
+	if _, err := MigrateV1Alpha1Groups(ctx, m.Client, dns, false); err != nil {
+		return err
+	}
+	return m.stamp(ctx, dns)
+}
+
+// stamp flags obj with CurrentVersion. The patch makes the API server
+// re-encode the whole object in the storage version.
+func (m *Migrator) stamp(ctx context.Context, obj client.Object) error {
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[MigratedVersionAnnotationKey] = CurrentVersion
+	obj.SetAnnotations(annotations)
+	if err := m.Client.Patch(ctx, obj, patch); err != nil {
+		return fmt.Errorf("patch: %w", err)
+	}
+	return nil
+}
+
+// current reports whether obj is already at CurrentVersion.
+func current(obj client.Object) bool {
+	return obj.GetAnnotations()[MigratedVersionAnnotationKey] == CurrentVersion
+}
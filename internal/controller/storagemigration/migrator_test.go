@@ -0,0 +1,133 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagemigration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+)
+
+const (
+	tNS     = "ns"
+	tPortal = "main"
+)
+
+func newTestClient(t *testing.T, funcs interceptor.Funcs, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha1.AddToScheme(scheme))
+	require.NoError(t, v1alpha2.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).
+		WithInterceptorFuncs(funcs).Build()
+}
+
+func dnsWithGroups(name, groupsJSON string) *v1alpha2.DNS {
+	dns := &v1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: tNS},
+		Spec:       v1alpha2.DNSSpec{PortalRef: tPortal},
+	}
+	if groupsJSON != "" {
+		dns.Annotations = map[string]string{V1Alpha1GroupsAnnotationKey: groupsJSON}
+	}
+	return dns
+}
+
+func migratedVersion(t *testing.T, c client.Client, obj client.Object) string {
+	t.Helper()
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(obj), obj))
+	return obj.GetAnnotations()[MigratedVersionAnnotationKey]
+}
+
+func TestMigrator_UpgradesEveryKind(t *testing.T) {
+	dns := dnsWithGroups("d", `[{"name":"Apps","entries":[{"fqdn":"a.example.com","description":"A"}]}]`)
+	rec := &v1alpha2.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{Name: "r", Namespace: tNS},
+		Spec:       v1alpha2.DNSRecordSpec{Origin: v1alpha2.DNSRecordOriginAuto, PortalRef: tPortal, SourceType: "service"},
+	}
+	portal := &sreportalv1alpha1.Portal{
+		ObjectMeta: metav1.ObjectMeta{Name: tPortal, Namespace: tNS},
+		Spec:       sreportalv1alpha1.PortalSpec{Title: "Main"},
+	}
+	c := newTestClient(t, interceptor.Funcs{}, dns, rec, portal)
+
+	sum, err := New(c).run(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, Summary{DNS: 1, DNSRecords: 2, Portals: 1}, sum, "the DNSRecord created from the groups is stamped too")
+	assert.Equal(t, CurrentVersion, migratedVersion(t, c, &v1alpha2.DNS{ObjectMeta: dns.ObjectMeta}))
+	assert.Equal(t, CurrentVersion, migratedVersion(t, c, &v1alpha2.DNSRecord{ObjectMeta: rec.ObjectMeta}))
+	assert.Equal(t, CurrentVersion, migratedVersion(t, c, &sreportalv1alpha1.Portal{ObjectMeta: portal.ObjectMeta}))
+
+	var got v1alpha2.DNS
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(dns), &got))
+	assert.NotContains(t, got.Annotations, V1Alpha1GroupsAnnotationKey)
+	var manual v1alpha2.DNSRecord
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: tNS, Name: "d-manual-apps"}, &manual))
+	assert.Equal(t, v1alpha2.DNSRecordOriginManual, manual.Spec.Origin)
+	assert.Equal(t, []v1alpha2.DNSRecordEntry{{FQDN: "a.example.com", Group: "Apps", Description: "A", RecordType: "A"}}, manual.Spec.Entries)
+
+	sum, err = New(c).run(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, sum, "objects at the current version are skipped")
+}
+
+func TestMigrator_LeavesFailedDNSUnflagged(t *testing.T) {
+	dns := dnsWithGroups("d", `[{"name":"Apps","entries":[{"fqdn":"a.example.com"}]}]`)
+	c := newTestClient(t, interceptor.Funcs{
+		Create: func(context.Context, client.WithWatch, client.Object, ...client.CreateOption) error {
+			return errors.New("boom")
+		},
+	}, dns)
+
+	sum, err := New(c).run(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, Summary{Failed: 1}, sum)
+	var got v1alpha2.DNS
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(dns), &got))
+	assert.Empty(t, got.Annotations[MigratedVersionAnnotationKey], "retried on the next startup")
+	assert.Contains(t, got.Annotations, V1Alpha1GroupsAnnotationKey)
+}
+
+func TestSlug(t *testing.T) {
+	const defaultSlug = "default"
+	cases := map[string]string{
+		"":          defaultSlug,
+		"Apps":      "apps",
+		"My Group":  "my-group",
+		"-leading":  "leading",
+		"trailing-": "trailing",
+		"___":       defaultSlug,
+		"A B C":     "a-b-c",
+	}
+	for in, want := range cases {
+		assert.Equal(t, want, slug(in), in)
+	}
+}
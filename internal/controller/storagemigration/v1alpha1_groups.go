@@ -0,0 +1,210 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagemigration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+)
+
+// V1Alpha1GroupsAnnotationKey holds, on a DNS written through v1alpha1, the
+// spec.groups that v1alpha2 no longer has. The conversion webhook sets it.
+const V1Alpha1GroupsAnnotationKey = "sreportal.io/v1alpha1-groups"
+
+// GroupsResult is the outcome of MigrateV1Alpha1Groups for one DNS.
+type GroupsResult struct {
+	// Created lists the DNSRecords created (validated only, in dry-run).
+	Created []string
+	// AlreadyExist lists the DNSRecords that already held the group entries.
+	AlreadyExist []string
+	// Failures counts the groups that could not be migrated, plus a failed
+	// annotation removal.
+	Failures int
+	// Stripped is set when the annotation was removed from the DNS.
+	Stripped bool
+}
+
+// MigrateV1Alpha1Groups converts the v1alpha1 groups annotation of dns into
+// one origin=manual DNSRecord per non-empty group, named
+// <dns>-manual-<group slug>. The annotation is only stripped when *every*
+// non-empty group materialised — a partial failure leaves it in place so a
+// retry can complete the migration. When dryRun is true, Create calls use
+// client.DryRunAll for server-side validation without persisting and the
+// annotation is never removed. A DNS without the annotation is left alone.
+func MigrateV1Alpha1Groups(ctx context.Context, c client.Client, dns *v1alpha2.DNS, dryRun bool) (GroupsResult, error) {
+	var res GroupsResult
+	raw := dns.Annotations[V1Alpha1GroupsAnnotationKey]
+	if raw == "" {
+		return res, nil
+	}
+	var groups []v1alpha1.DNSGroup
+	if err := json.Unmarshal([]byte(raw), &groups); err != nil {
+		res.Failures++
+		return res, fmt.Errorf("DNS %s/%s: parse groups: %w", dns.Namespace, dns.Name, err)
+	}
+
+	var errs []error
+	groupCount := 0
+	// slugOwner tracks which original group name first claimed a given
+	// record slug, so a collision between two distinct group names that
+	// normalise to the same slug fails loudly instead of silently
+	// overwriting one with the other on AlreadyExists.
+	slugOwner := map[string]string{}
+	for _, g := range groups {
+		if len(g.Entries) == 0 {
+			continue
+		}
+		groupCount++
+		recordName := dns.Name + "-manual-" + slug(g.Name)
+		if prev, claimed := slugOwner[recordName]; claimed && prev != g.Name {
+			res.Failures++
+			errs = append(errs, fmt.Errorf(
+				"DNS %s/%s: slug collision: groups %q and %q both map to record %q; rename one of them before re-running",
+				dns.Namespace, dns.Name, prev, g.Name, recordName))
+			continue
+		}
+		slugOwner[recordName] = g.Name
+		entries := make([]v1alpha2.DNSRecordEntry, 0, len(g.Entries))
+		for _, e := range g.Entries {
+			entries = append(entries, v1alpha2.DNSRecordEntry{
+				FQDN:        e.FQDN,
+				Group:       g.Name,
+				Description: e.Description,
+				RecordType:  "A",
+			})
+		}
+		record := &v1alpha2.DNSRecord{
+			ObjectMeta: metav1.ObjectMeta{Name: recordName, Namespace: dns.Namespace},
+			Spec: v1alpha2.DNSRecordSpec{
+				Origin:    v1alpha2.DNSRecordOriginManual,
+				PortalRef: dns.Spec.PortalRef,
+				Entries:   entries,
+			},
+		}
+		opts := []client.CreateOption{}
+		if dryRun {
+			opts = append(opts, client.DryRunAll)
+		}
+		err := c.Create(ctx, record, opts...)
+		switch {
+		case err == nil:
+			res.Created = append(res.Created, recordName)
+		case !apierrors.IsAlreadyExists(err):
+			res.Failures++
+			errs = append(errs, fmt.Errorf("create %s/%s: %w", dns.Namespace, recordName, err))
+		case dryRun:
+			res.AlreadyExist = append(res.AlreadyExist, recordName)
+		default:
+			// Idempotent re-run is only safe when the existing record carries
+			// the same entries we would have written. If the content differs,
+			// this is a slug collision with a pre-existing manual record —
+			// fail loudly so the group can be renamed rather than lose data
+			// silently.
+			var existing v1alpha2.DNSRecord
+			key := types.NamespacedName{Namespace: dns.Namespace, Name: recordName}
+			if getErr := c.Get(ctx, key, &existing); getErr != nil {
+				res.Failures++
+				errs = append(errs, fmt.Errorf("get existing %s/%s after AlreadyExists: %w", dns.Namespace, recordName, getErr))
+				continue
+			}
+			if !sameEntries(existing.Spec.Entries, entries) {
+				res.Failures++
+				errs = append(errs, fmt.Errorf(
+					"DNSRecord %s/%s already exists with different entries; "+
+						"refusing to overwrite (rename group %q to avoid the collision)",
+					dns.Namespace, recordName, g.Name))
+				continue
+			}
+			res.AlreadyExist = append(res.AlreadyExist, recordName)
+		}
+	}
+
+	// Only strip the annotation when every non-empty group succeeded so a
+	// retry can pick up any partial failures.
+	if !dryRun && res.Failures == 0 && groupCount > 0 && len(res.Created)+len(res.AlreadyExist) == groupCount {
+		patch := client.MergeFrom(dns.DeepCopy())
+		delete(dns.Annotations, V1Alpha1GroupsAnnotationKey)
+		if err := c.Patch(ctx, dns, patch); err != nil {
+			res.Failures++
+			errs = append(errs, fmt.Errorf("remove annotation %s/%s: %w", dns.Namespace, dns.Name, err))
+		} else {
+			res.Stripped = true
+		}
+	}
+	return res, errors.Join(errs...)
+}
+
+// sameEntries reports whether two slices of DNSRecordEntry are equivalent
+// for migration-idempotence purposes. We compare on the fields the migration
+// writes (FQDN, Group, Description, RecordType, Targets) and ignore any
+// fields the user might have added manually after a partial run.
+func sameEntries(existing, want []v1alpha2.DNSRecordEntry) bool {
+	if len(existing) != len(want) {
+		return false
+	}
+	idx := make(map[string]v1alpha2.DNSRecordEntry, len(want))
+	for _, e := range want {
+		idx[e.FQDN+"|"+e.RecordType] = e
+	}
+	for _, e := range existing {
+		w, ok := idx[e.FQDN+"|"+e.RecordType]
+		if !ok {
+			return false
+		}
+		if e.Group != w.Group || e.Description != w.Description || !reflect.DeepEqual(e.Targets, w.Targets) {
+			return false
+		}
+	}
+	return true
+}
+
+// slug normalises a free-form string into a Kubernetes-name-safe value:
+// lowercase ASCII letters and digits, all other characters collapsed to '-',
+// then trimmed of leading/trailing dashes. Empty results fall back to
+// "default" so the caller always gets a non-empty segment.
+func slug(s string) string {
+	result := make([]byte, 0, len(s))
+	for _, c := range s {
+		switch {
+		case c >= 'a' && c <= 'z':
+			result = append(result, byte(c))
+		case c >= '0' && c <= '9':
+			result = append(result, byte(c))
+		case c >= 'A' && c <= 'Z':
+			result = append(result, byte(c+32))
+		default:
+			result = append(result, '-')
+		}
+	}
+	out := strings.Trim(string(result), "-")
+	if out == "" {
+		return "default"
+	}
+	return out
+}
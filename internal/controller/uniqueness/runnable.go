@@ -0,0 +1,150 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package uniqueness periodically reports the FQDNs listed in several portals,
+// or whose DNSRecords disagree on the targets, on the DNS CRs owning them.
+package uniqueness
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	"github.com/golgoth31/sreportal/internal/metrics"
+)
+
+const (
+	// ReasonFQDNNotUnique is the reason of the Warning Event emitted on a DNS
+	// CR owning FQDNs that are not unique.
+	ReasonFQDNNotUnique = "FQDNNotUnique"
+
+	// maxEventSamples bounds the issues listed in an Event message.
+	maxEventSamples = 5
+)
+
+// firstCheckDelay delays the first check after Start, so that the DNSRecords
+// have been projected into the read store, without waiting a whole interval.
+var firstCheckDelay = time.Minute
+
+// severities lists every severity, for the metric.
+var severities = []domaindns.UniquenessSeverity{
+	domaindns.UniquenessSeverityHigh,
+	domaindns.UniquenessSeverityMedium,
+	domaindns.UniquenessSeverityLow,
+}
+
+// Runnable periodically reads the uniqueness report of the FQDN read store,
+// exports it as the dns_uniqueness_issues metric and emits a Warning Event on
+// each local DNS CR owning a DNSRecord involved in an issue. Nothing is
+// repaired.
+type Runnable struct {
+	Client   client.Client
+	Report   domaindns.FQDNUniquenessReader
+	Recorder events.EventRecorder
+	Interval time.Duration
+}
+
+// New creates a Runnable checking every interval.
+func New(c client.Client, report domaindns.FQDNUniquenessReader, recorder events.EventRecorder, interval time.Duration) *Runnable {
+	return &Runnable{Client: c, Report: report, Recorder: recorder, Interval: interval}
+}
+
+// Start implements manager.Runnable. The first check runs shortly after
+// Start, then every interval.
+func (r *Runnable) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("uniqueness")
+	timer := time.NewTimer(min(firstCheckDelay, r.Interval))
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timer.C:
+			if err := r.tick(ctx); err != nil {
+				logger.Error(err, "uniqueness check failed")
+			}
+			timer.Reset(r.Interval)
+		}
+	}
+}
+
+var _ manager.Runnable = (*Runnable)(nil)
+
+// tick runs one check and reports its issues.
+func (r *Runnable) tick(ctx context.Context) error {
+	issues := r.Report.UniquenessReport()
+
+	counts := make(map[domaindns.UniquenessSeverity]int, len(severities))
+	byOwner := map[types.NamespacedName][]domaindns.UniquenessIssue{}
+	for _, issue := range issues {
+		counts[issue.Severity]++
+		seen := map[types.NamespacedName]bool{}
+		for _, c := range issue.Contributions {
+			owner := types.NamespacedName{Namespace: c.Owner.Namespace, Name: c.Owner.Name}
+			if owner.Name == "" || seen[owner] {
+				continue
+			}
+			seen[owner] = true
+			byOwner[owner] = append(byOwner[owner], issue)
+		}
+	}
+	for _, s := range severities {
+		metrics.DNSUniquenessIssues.WithLabelValues(string(s)).Set(float64(counts[s]))
+	}
+	if r.Recorder == nil || len(byOwner) == 0 {
+		return nil
+	}
+
+	var dnsList v1alpha2.DNSList
+	if err := r.Client.List(ctx, &dnsList); err != nil {
+		return fmt.Errorf("list DNS: %w", err)
+	}
+	for i := range dnsList.Items {
+		dns := &dnsList.Items[i]
+		if dns.Spec.IsRemote {
+			continue
+		}
+		owned := byOwner[types.NamespacedName{Namespace: dns.Namespace, Name: dns.Name}]
+		if len(owned) == 0 {
+			continue
+		}
+		r.Recorder.Eventf(dns, nil, corev1.EventTypeWarning, ReasonFQDNNotUnique, "CheckUniqueness",
+			"%d FQDNs are not unique across portals and sources: %s", len(owned), summarize(owned))
+	}
+	return nil
+}
+
+// summarize renders the first issues for an Event message, highest severity
+// first.
+func summarize(issues []domaindns.UniquenessIssue) string {
+	samples := make([]string, 0, maxEventSamples)
+	for _, issue := range issues[:min(len(issues), maxEventSamples)] {
+		samples = append(samples, fmt.Sprintf("%s/%s (%s, portals %s, sources %s)",
+			issue.Name, issue.RecordType, issue.Severity,
+			strings.Join(issue.Portals, ","), strings.Join(issue.Sources, ",")))
+	}
+	return strings.Join(samples, "; ")
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package uniqueness
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	"github.com/golgoth31/sreportal/internal/metrics"
+)
+
+const tNS = "ns"
+
+// staticReport is a FQDNUniquenessReader returning a fixed report.
+type staticReport []domaindns.UniquenessIssue
+
+func (s staticReport) UniquenessReport() []domaindns.UniquenessIssue { return s }
+
+func dnsCR(name string, remote bool) *v1alpha2.DNS {
+	return &v1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: tNS},
+		Spec:       v1alpha2.DNSSpec{PortalRef: "main", IsRemote: remote},
+	}
+}
+
+func TestRunnable_WarnsOwningDNS(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha2.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(dnsCR("a", false), dnsCR("b", false), dnsCR("remote", true)).Build()
+	recorder := events.NewFakeRecorder(10)
+
+	report := staticReport{{
+		Name:       "web.example.com",
+		RecordType: "A",
+		Severity:   domaindns.UniquenessSeverityHigh,
+		Portals:    []string{"main", "team"},
+		Sources:    []string{"ingress", "service"},
+		Contributions: []domaindns.FQDNContribution{
+			{Record: domaindns.RecordRef{Namespace: tNS, Name: "a-ing"}, Owner: domaindns.RecordRef{Namespace: tNS, Name: "a"}},
+			{Record: domaindns.RecordRef{Namespace: tNS, Name: "a-svc"}, Owner: domaindns.RecordRef{Namespace: tNS, Name: "a"}},
+			{Record: domaindns.RecordRef{Namespace: tNS, Name: "remote"}, Owner: domaindns.RecordRef{Namespace: tNS, Name: "remote"}},
+		},
+	}}
+	r := New(c, report, recorder, 0)
+	require.NoError(t, r.tick(context.Background()))
+
+	require.Len(t, recorder.Events, 1, "one Event per owning local DNS")
+	require.Equal(t,
+		"Warning FQDNNotUnique 1 FQDNs are not unique across portals and sources: web.example.com/A (high, portals main,team, sources ingress,service)",
+		<-recorder.Events)
+	require.Equal(t, float64(1), testutil.ToFloat64(metrics.DNSUniquenessIssues.WithLabelValues("high")))
+	require.Zero(t, testutil.ToFloat64(metrics.DNSUniquenessIssues.WithLabelValues("low")))
+
+	r.Report = staticReport{}
+	require.NoError(t, r.tick(context.Background()))
+	require.Empty(t, recorder.Events)
+	require.Zero(t, testutil.ToFloat64(metrics.DNSUniquenessIssues.WithLabelValues("high")))
+}
+
+func TestRunnable_ChecksBeforeTheFirstInterval(t *testing.T) {
+	delay := firstCheckDelay
+	firstCheckDelay = time.Millisecond
+	t.Cleanup(func() { firstCheckDelay = delay })
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha2.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(dnsCR("a", false)).Build()
+	recorder := events.NewFakeRecorder(10)
+	report := staticReport{{
+		Name: "web.example.com", RecordType: "A", Severity: domaindns.UniquenessSeverityLow,
+		Contributions: []domaindns.FQDNContribution{
+			{Record: domaindns.RecordRef{Namespace: tNS, Name: "a-ing"}, Owner: domaindns.RecordRef{Namespace: tNS, Name: "a"}},
+		},
+	}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = New(c, report, recorder, time.Hour).Start(ctx) }()
+
+	select {
+	case <-recorder.Events:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no check before the first interval")
+	}
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package debugserver serves the Go profiler (net/http/pprof) and expvar
+// runtime counters on a dedicated address, so performance issues can be
+// profiled in production without rebuilding the operator.
+package debugserver
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	// VarName is the expvar variable holding the operator Stats.
+	VarName = "sreportal"
+
+	readHeaderTimeout = 10 * time.Second
+	shutdownTimeout   = 5 * time.Second
+
+	// Metric families read from the gatherer.
+	metricStreamsOpen      = "sreportal_stream_open"
+	metricStreamTopics     = "sreportal_stream_topics"
+	metricWorkQueueDepth   = "workqueue_depth"
+	metricWorkQueueRetries = "workqueue_retries_total"
+	metricWorkQueueLongest = "workqueue_longest_running_processor_seconds"
+	labelWorkQueueName     = "name"
+)
+
+// published is the server whose Stats back the expvar variable. expvar
+// variables are process-wide and cannot be unpublished.
+var published atomic.Pointer[Server]
+
+func init() {
+	expvar.Publish(VarName, expvar.Func(func() any {
+		if s := published.Load(); s != nil {
+			return s.Stats()
+		}
+		return nil
+	}))
+}
+
+// CacheSizer returns the number of items held by an in-memory cache.
+type CacheSizer func() int
+
+// Stats are the runtime counters published under VarName.
+type Stats struct {
+	// Caches maps each in-memory read store to its item count.
+	Caches map[string]int `json:"caches"`
+	// Streams counts the open FQDN streams and their shared topics.
+	Streams StreamStats `json:"streams"`
+	// WorkQueues maps each controller work queue to its counters.
+	WorkQueues map[string]WorkQueueStats `json:"workqueues"`
+}
+
+// StreamStats counts the FQDN streams.
+type StreamStats struct {
+	Open   int `json:"open"`
+	Topics int `json:"topics"`
+}
+
+// WorkQueueStats are the counters of a controller work queue.
+type WorkQueueStats struct {
+	Depth                   int     `json:"depth"`
+	Retries                 int     `json:"retries"`
+	LongestRunningProcessor float64 `json:"longest_running_processor_seconds"`
+}
+
+// Server serves /debug/pprof/ and /debug/vars on Addr. It runs on every
+// replica, leader or not.
+type Server struct {
+	Addr string
+	// Gatherer provides the stream and work queue metrics.
+	Gatherer prometheus.Gatherer
+	// Caches are the read stores reported in Stats.
+	Caches map[string]CacheSizer
+}
+
+// New creates a Server listening on addr.
+func New(addr string, gatherer prometheus.Gatherer, caches map[string]CacheSizer) *Server {
+	return &Server{Addr: addr, Gatherer: gatherer, Caches: caches}
+}
+
+// Handler returns the pprof and expvar routes.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+	return mux
+}
+
+// Start implements manager.Runnable: it serves until ctx is cancelled.
+func (s *Server) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("debugserver")
+	published.Store(s)
+
+	ln, err := net.Listen("tcp", s.Addr)
+	if err != nil {
+		return err
+	}
+	srv := &http.Server{Handler: s.Handler(), ReadHeaderTimeout: readHeaderTimeout}
+
+	errCh := make(chan error, 1)
+	go func() {
+		logger.Info("serving pprof and expvar", "address", ln.Addr().String())
+		errCh <- srv.Serve(ln)
+	}()
+
+	select {
+	case err := <-errCh:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		return srv.Shutdown(shutdownCtx)
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable: every
+// replica can be profiled.
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+var (
+	_ manager.Runnable               = (*Server)(nil)
+	_ manager.LeaderElectionRunnable = (*Server)(nil)
+)
+
+// Stats returns the current counters. Metrics the gatherer fails to provide
+// are left at zero.
+func (s *Server) Stats() Stats {
+	stats := Stats{
+		Caches:     make(map[string]int, len(s.Caches)),
+		WorkQueues: map[string]WorkQueueStats{},
+	}
+	for name, size := range s.Caches {
+		stats.Caches[name] = size()
+	}
+	if s.Gatherer == nil {
+		return stats
+	}
+	families, err := s.Gatherer.Gather()
+	if err != nil && len(families) == 0 {
+		return stats
+	}
+
+	for _, mf := range families {
+		switch mf.GetName() {
+		case metricStreamsOpen:
+			stats.Streams.Open = int(sum(mf))
+		case metricStreamTopics:
+			stats.Streams.Topics = int(sum(mf))
+		case metricWorkQueueDepth, metricWorkQueueRetries, metricWorkQueueLongest:
+			for _, m := range mf.GetMetric() {
+				name := label(m, labelWorkQueueName)
+				q := stats.WorkQueues[name]
+				switch mf.GetName() {
+				case metricWorkQueueDepth:
+					q.Depth = int(value(m))
+				case metricWorkQueueRetries:
+					q.Retries = int(value(m))
+				default:
+					q.LongestRunningProcessor = value(m)
+				}
+				stats.WorkQueues[name] = q
+			}
+		}
+	}
+	return stats
+}
+
+func sum(mf *dto.MetricFamily) float64 {
+	var total float64
+	for _, m := range mf.GetMetric() {
+		total += value(m)
+	}
+	return total
+}
+
+func value(m *dto.Metric) float64 {
+	switch {
+	case m.GetGauge() != nil:
+		return m.GetGauge().GetValue()
+	case m.GetCounter() != nil:
+		return m.GetCounter().GetValue()
+	default:
+		return 0
+	}
+}
+
+func label(m *dto.Metric, name string) string {
+	for _, l := range m.GetLabel() {
+		if l.GetName() == name {
+			return l.GetValue()
+		}
+	}
+	return ""
+}
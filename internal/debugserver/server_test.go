@@ -0,0 +1,93 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debugserver
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRegistry(t *testing.T) *prometheus.Registry {
+	t.Helper()
+	reg := prometheus.NewRegistry()
+	open := prometheus.NewGauge(prometheus.GaugeOpts{Name: metricStreamsOpen})
+	open.Set(3)
+	depth := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: metricWorkQueueDepth}, []string{labelWorkQueueName})
+	depth.WithLabelValues("dns").Set(7)
+	retries := prometheus.NewCounterVec(prometheus.CounterOpts{Name: metricWorkQueueRetries}, []string{labelWorkQueueName})
+	retries.WithLabelValues("dns").Add(2)
+	reg.MustRegister(open, depth, retries)
+	return reg
+}
+
+func TestServer_Stats(t *testing.T) {
+	s := New(":0", testRegistry(t), map[string]CacheSizer{"fqdns": func() int { return 42 }})
+
+	stats := s.Stats()
+	assert.Equal(t, map[string]int{"fqdns": 42}, stats.Caches)
+	assert.Equal(t, StreamStats{Open: 3}, stats.Streams)
+	assert.Equal(t, map[string]WorkQueueStats{"dns": {Depth: 7, Retries: 2}}, stats.WorkQueues)
+}
+
+func TestServer_ServesPprofAndVars(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	s := New(addr, testRegistry(t), nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- s.Start(ctx) }()
+
+	get := func(path string) *http.Response {
+		var resp *http.Response
+		require.Eventually(t, func() bool {
+			var err error
+			resp, err = http.Get("http://" + addr + path)
+			return err == nil
+		}, 5*time.Second, 20*time.Millisecond)
+		return resp
+	}
+
+	resp := get("/debug/pprof/")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	_ = resp.Body.Close()
+
+	resp = get("/debug/vars")
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	require.NoError(t, err)
+	var vars map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(body, &vars))
+	assert.Contains(t, vars, "memstats")
+	var stats Stats
+	require.NoError(t, json.Unmarshal(vars[VarName], &stats))
+	assert.Equal(t, 3, stats.Streams.Open)
+
+	cancel()
+	require.NoError(t, <-done)
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package diagnostics checks the health of the operator installation: API
+// server connectivity, RBAC of the enabled sources, CRD availability, webhook
+// reachability and configuration validity.
+package diagnostics
+
+import "time"
+
+// Status is the outcome of a check.
+type Status string
+
+const (
+	StatusOK      Status = "ok"
+	StatusWarning Status = "warning"
+	StatusFailed  Status = "failed"
+	// StatusSkipped marks a check that does not apply (e.g. a disabled
+	// source) or could not run.
+	StatusSkipped Status = "skipped"
+)
+
+// severity orders the statuses from the least to the most severe.
+func (s Status) severity() int {
+	switch s {
+	case StatusOK:
+		return 1
+	case StatusWarning:
+		return 2
+	case StatusFailed:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Category groups the checks of a report.
+type Category string
+
+const (
+	CategoryConnectivity Category = "connectivity"
+	CategoryRBAC         Category = "rbac"
+	CategoryCRD          Category = "crd"
+	CategoryWebhook      Category = "webhook"
+	CategoryConfig       Category = "config"
+	CategorySource       Category = "source"
+)
+
+// Check is the outcome of one diagnostic check.
+type Check struct {
+	Category Category
+	// Name identifies the check within its category (e.g. "services").
+	Name    string
+	Status  Status
+	Message string
+}
+
+// Report is the outcome of a diagnostics run.
+type Report struct {
+	Time   time.Time
+	Checks []Check
+}
+
+// Status returns the most severe status of the checks, ok when every check
+// passed or was skipped.
+func (r Report) Status() Status {
+	worst := StatusOK
+	for _, c := range r.Checks {
+		if c.Status.severity() > worst.severity() {
+			worst = c.Status
+		}
+	}
+	return worst
+}
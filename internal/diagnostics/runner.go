@@ -0,0 +1,414 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnostics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/config"
+	domainsource "github.com/golgoth31/sreportal/internal/domain/source"
+	sourcepkg "github.com/golgoth31/sreportal/internal/source"
+	"github.com/golgoth31/sreportal/internal/source/crossplanescalewayrecord"
+	"github.com/golgoth31/sreportal/internal/source/externaldns"
+	"github.com/golgoth31/sreportal/internal/source/registry"
+)
+
+// Discovery is the part of the Kubernetes discovery client the checks use.
+type Discovery interface {
+	ServerVersion() (*version.Info, error)
+	ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error)
+}
+
+// requirement is a resource a source kind reads.
+type requirement struct {
+	gvr   schema.GroupVersionResource
+	verbs []string
+	// crd marks the resources served by a CRD, whose presence is checked.
+	crd bool
+}
+
+var listWatch = []string{"list", "watch"}
+
+func builtin(group, version, resource string) requirement {
+	return requirement{gvr: schema.GroupVersionResource{Group: group, Version: version, Resource: resource}, verbs: listWatch}
+}
+
+func crd(group, version, resource string, verbs ...string) requirement {
+	if len(verbs) == 0 {
+		verbs = listWatch
+	}
+	return requirement{gvr: schema.GroupVersionResource{Group: group, Version: version, Resource: resource}, verbs: verbs, crd: true}
+}
+
+// sourceRequirements lists, per source kind, the resources its informers (or
+// its resolver) read. Keep in sync with the kubebuilder RBAC markers.
+var sourceRequirements = map[registry.SourceType][]requirement{
+	externaldns.KindService: {
+		builtin("", "v1", "services"),
+		builtin("", "v1", "pods"),
+		builtin("", "v1", "nodes"),
+		builtin("discovery.k8s.io", "v1", "endpointslices"),
+	},
+	externaldns.KindIngress: {
+		builtin("networking.k8s.io", "v1", "ingresses"),
+	},
+	externaldns.KindDNSEndpoint: {
+		crd("externaldns.k8s.io", "v1alpha1", "dnsendpoints"),
+	},
+	externaldns.KindIstioGateway: {
+		crd("networking.istio.io", "v1", "gateways"),
+		builtin("", "v1", "services"),
+		builtin("networking.k8s.io", "v1", "ingresses"),
+	},
+	externaldns.KindIstioVirtualService: {
+		crd("networking.istio.io", "v1", "virtualservices"),
+		crd("networking.istio.io", "v1", "gateways"),
+		builtin("", "v1", "services"),
+		builtin("networking.k8s.io", "v1", "ingresses"),
+	},
+	externaldns.KindGatewayHTTPRoute: gatewayRoute("v1", "httproutes"),
+	externaldns.KindGatewayGRPCRoute: gatewayRoute("v1", "grpcroutes"),
+	externaldns.KindGatewayTLSRoute:  gatewayRoute("v1alpha2", "tlsroutes"),
+	externaldns.KindGatewayTCPRoute:  gatewayRoute("v1alpha2", "tcproutes"),
+	externaldns.KindGatewayUDPRoute:  gatewayRoute("v1alpha2", "udproutes"),
+	externaldns.KindContourHTTPProxy: {
+		crd("projectcontour.io", "v1", "httpproxies"),
+	},
+	externaldns.KindAmbassadorHost: {
+		crd("getambassador.io", "v2", "hosts"),
+		builtin("", "v1", "services"),
+	},
+	crossplanescalewayrecord.SourceTypeCrossplaneScalewayRecord: {
+		crd("domain.scaleway.upbound.io", "v1alpha1", "records", "list"),
+	},
+}
+
+func gatewayRoute(version, resource string) []requirement {
+	return []requirement{
+		crd("gateway.networking.k8s.io", version, resource),
+		crd("gateway.networking.k8s.io", "v1", "gateways"),
+		builtin("", "v1", "namespaces"),
+	}
+}
+
+// Runner runs the diagnostics against the cluster the operator runs in.
+type Runner struct {
+	client     client.Client
+	discovery  Discovery
+	configPath string
+	webhook    healthz.Checker
+	health     domainsource.SourceHealthReader
+	now        func() time.Time
+}
+
+// NewRunner creates a Runner. configPath is the operator configuration file,
+// reloaded and validated on each run. webhook checks the webhook server; nil
+// when the webhooks are disabled.
+func NewRunner(c client.Client, d Discovery, configPath string, webhook healthz.Checker) *Runner {
+	return &Runner{client: c, discovery: d, configPath: configPath, webhook: webhook, now: time.Now}
+}
+
+// SetSourceHealth adds one check per collected source kind, failing while
+// its collections fail.
+func (r *Runner) SetSourceHealth(h domainsource.SourceHealthReader) {
+	r.health = h
+}
+
+// Run runs every check and returns the report. A failing check never stops
+// the run: the checks that depend on the API server are skipped when it is
+// unreachable.
+func (r *Runner) Run(ctx context.Context) Report {
+	report := Report{Time: r.now()}
+	add := func(c ...Check) { report.Checks = append(report.Checks, c...) }
+
+	info, err := r.discovery.ServerVersion()
+	if err != nil {
+		add(Check{Category: CategoryConnectivity, Name: "api server", Status: StatusFailed, Message: err.Error()})
+		add(Check{Category: CategoryRBAC, Name: "sources", Status: StatusSkipped, Message: "API server unreachable"})
+		add(Check{Category: CategoryCRD, Name: "sources", Status: StatusSkipped, Message: "API server unreachable"})
+	} else {
+		add(Check{Category: CategoryConnectivity, Name: "api server", Status: StatusOK, Message: "Kubernetes " + info.GitVersion})
+		enabled, check := r.enabledKinds(ctx)
+		add(check)
+		add(r.rbacChecks(ctx, enabled)...)
+		add(r.crdChecks(enabled)...)
+	}
+	add(r.sourceHealthChecks()...)
+	add(r.webhookCheck(ctx))
+	add(r.configCheck())
+	return report
+}
+
+// enabledKinds returns the source kinds enabled by the local DNS resources.
+func (r *Runner) enabledKinds(ctx context.Context) (map[registry.SourceType]bool, Check) {
+	check := Check{Category: CategoryConnectivity, Name: "dns resources"}
+	var list sreportalv1alpha2.DNSList
+	if err := r.client.List(ctx, &list); err != nil {
+		check.Status, check.Message = StatusFailed, fmt.Sprintf("list DNS resources: %v", err)
+		return nil, check
+	}
+	enabled := map[registry.SourceType]bool{}
+	local := 0
+	for i := range list.Items {
+		if list.Items[i].Spec.IsRemote {
+			continue
+		}
+		local++
+		for kind := range sourcepkg.EnabledKindsFromSpec(&list.Items[i].Spec.Sources) {
+			enabled[kind] = true
+		}
+	}
+	kinds := make([]string, 0, len(enabled))
+	for _, kind := range sortedKinds(enabled) {
+		kinds = append(kinds, string(kind))
+	}
+	check.Status = StatusOK
+	check.Message = fmt.Sprintf("%d local DNS resources, enabled sources: %s", local, orNone(kinds))
+	if len(kinds) == 0 {
+		check.Status = StatusWarning
+	}
+	return enabled, check
+}
+
+// rbacChecks verifies the operator may read the resources of the enabled
+// sources, one check per resource.
+func (r *Runner) rbacChecks(ctx context.Context, enabled map[registry.SourceType]bool) []Check {
+	type need struct {
+		req     requirement
+		sources []string
+	}
+	needs := map[schema.GroupVersionResource]*need{}
+	var order []schema.GroupVersionResource
+	for _, kind := range sortedKinds(enabled) {
+		for _, req := range sourceRequirements[kind] {
+			n, ok := needs[req.gvr]
+			if !ok {
+				n = &need{req: req}
+				needs[req.gvr] = n
+				order = append(order, req.gvr)
+			}
+			n.sources = append(n.sources, string(kind))
+		}
+	}
+	slices.SortFunc(order, func(a, b schema.GroupVersionResource) int {
+		return strings.Compare(resourceName(a), resourceName(b))
+	})
+
+	checks := make([]Check, 0, len(order))
+	for _, gvr := range order {
+		n := needs[gvr]
+		check := Check{Category: CategoryRBAC, Name: resourceName(gvr), Status: StatusOK}
+		var denied []string
+		for _, verb := range n.req.verbs {
+			allowed, err := r.allowed(ctx, gvr, verb)
+			if err != nil {
+				check.Status, check.Message = StatusFailed, fmt.Sprintf("review %s access: %v", verb, err)
+				break
+			}
+			if !allowed {
+				denied = append(denied, verb)
+			}
+		}
+		if check.Status == StatusOK && len(denied) > 0 {
+			check.Status = StatusFailed
+			check.Message = fmt.Sprintf("missing verbs %s, needed by sources %s",
+				strings.Join(denied, ", "), strings.Join(n.sources, ", "))
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+// allowed asks the API server whether the operator may perform verb on gvr
+// in every namespace.
+func (r *Runner) allowed(ctx context.Context, gvr schema.GroupVersionResource, verb string) (bool, error) {
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Group:    gvr.Group,
+				Version:  gvr.Version,
+				Resource: gvr.Resource,
+				Verb:     verb,
+			},
+		},
+	}
+	if err := r.client.Create(ctx, review); err != nil {
+		return false, err
+	}
+	return review.Status.Allowed, nil
+}
+
+// crdChecks verifies the resources served by a CRD are available, one check
+// per known resource. A missing CRD fails only when a source needs it.
+func (r *Runner) crdChecks(enabled map[registry.SourceType]bool) []Check {
+	sources := map[schema.GroupVersionResource][]string{}
+	var order []schema.GroupVersionResource
+	for kind, reqs := range sourceRequirements {
+		for _, req := range reqs {
+			if !req.crd {
+				continue
+			}
+			if _, ok := sources[req.gvr]; !ok {
+				sources[req.gvr] = nil
+				order = append(order, req.gvr)
+			}
+			if enabled[kind] && !slices.Contains(sources[req.gvr], string(kind)) {
+				sources[req.gvr] = append(sources[req.gvr], string(kind))
+			}
+		}
+	}
+	slices.SortFunc(order, func(a, b schema.GroupVersionResource) int {
+		return strings.Compare(resourceName(a), resourceName(b))
+	})
+
+	served := map[string]*metav1.APIResourceList{}
+	checks := make([]Check, 0, len(order))
+	for _, gvr := range order {
+		check := Check{Category: CategoryCRD, Name: resourceName(gvr)}
+		gv := gvr.GroupVersion().String()
+		list, ok := served[gv]
+		if !ok {
+			var err error
+			list, err = r.discovery.ServerResourcesForGroupVersion(gv)
+			if err != nil && !apierrors.IsNotFound(err) {
+				check.Status, check.Message = StatusFailed, fmt.Sprintf("discover %s: %v", gv, err)
+				checks = append(checks, check)
+				continue
+			}
+			served[gv] = list
+		}
+		needed := sources[gvr]
+		slices.Sort(needed)
+		switch {
+		case list != nil && slices.ContainsFunc(list.APIResources, func(res metav1.APIResource) bool {
+			return res.Name == gvr.Resource
+		}):
+			check.Status, check.Message = StatusOK, gv+" served"
+		case len(needed) > 0:
+			check.Status = StatusFailed
+			check.Message = fmt.Sprintf("%s not served, needed by sources %s: install the CRD or disable the sources",
+				gv, strings.Join(needed, ", "))
+		default:
+			check.Status, check.Message = StatusSkipped, gv+" not served, no enabled source needs it"
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+// sourceHealthChecks reports the collection health of each source kind.
+func (r *Runner) sourceHealthChecks() []Check {
+	if r.health == nil {
+		return nil
+	}
+	list := r.health.ListSourceHealth()
+	checks := make([]Check, 0, len(list))
+	for _, h := range list {
+		check := Check{Category: CategorySource, Name: string(h.Kind), Status: StatusOK}
+		switch {
+		case h.Failing() && h.LastSuccess.IsZero():
+			check.Status = StatusFailed
+			check.Message = fmt.Sprintf("%d failed collections, never collected: %s", h.Failures, h.LastError)
+		case h.Failing():
+			check.Status = StatusFailed
+			check.Message = fmt.Sprintf("%d failed collections since %s: %s",
+				h.Failures, h.LastSuccess.UTC().Format(time.RFC3339), h.LastError)
+		default:
+			check.Message = "last collected " + h.LastSuccess.UTC().Format(time.RFC3339)
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+// webhookCheck verifies the webhook server accepts connections.
+func (r *Runner) webhookCheck(ctx context.Context) Check {
+	check := Check{Category: CategoryWebhook, Name: "webhook server"}
+	if r.webhook == nil {
+		check.Status, check.Message = StatusSkipped, "webhooks disabled"
+		return check
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/", nil)
+	if err != nil {
+		check.Status, check.Message = StatusFailed, err.Error()
+		return check
+	}
+	if err := r.webhook(req); err != nil {
+		check.Status, check.Message = StatusFailed, err.Error()
+		return check
+	}
+	check.Status = StatusOK
+	return check
+}
+
+// configCheck reloads and validates the configuration file, so a ConfigMap
+// change that would stop the operator from restarting shows up here.
+func (r *Runner) configCheck() Check {
+	check := Check{Category: CategoryConfig, Name: "config file"}
+	if _, err := os.Stat(r.configPath); errors.Is(err, fs.ErrNotExist) {
+		check.Status, check.Message = StatusWarning, r.configPath+" not found, running with the default configuration"
+		return check
+	}
+	if _, err := config.LoadFromFile(r.configPath); err != nil {
+		check.Status, check.Message = StatusFailed, err.Error()
+		return check
+	}
+	check.Status, check.Message = StatusOK, r.configPath
+	return check
+}
+
+// resourceName formats gvr as resource.group, the resource alone for the
+// core group.
+func resourceName(gvr schema.GroupVersionResource) string {
+	if gvr.Group == "" {
+		return gvr.Resource
+	}
+	return gvr.Resource + "." + gvr.Group
+}
+
+func sortedKinds(enabled map[registry.SourceType]bool) []registry.SourceType {
+	kinds := make([]registry.SourceType, 0, len(enabled))
+	for kind := range enabled {
+		kinds = append(kinds, kind)
+	}
+	slices.Sort(kinds)
+	return kinds
+}
+
+func orNone(s []string) string {
+	if len(s) == 0 {
+		return "none"
+	}
+	return strings.Join(s, ", ")
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnostics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	readstoresource "github.com/golgoth31/sreportal/internal/readstore/source"
+	"github.com/golgoth31/sreportal/internal/source/externaldns"
+)
+
+// stubDiscovery serves the resources of the group versions it lists.
+type stubDiscovery struct {
+	err       error
+	resources map[string][]string
+}
+
+func (d stubDiscovery) ServerVersion() (*version.Info, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	return &version.Info{GitVersion: "v1.33.1"}, nil
+}
+
+func (d stubDiscovery) ServerResourcesForGroupVersion(gv string) (*metav1.APIResourceList, error) {
+	names, ok := d.resources[gv]
+	if !ok {
+		return nil, apierrors.NewNotFound(schema.GroupResource{}, gv)
+	}
+	list := &metav1.APIResourceList{GroupVersion: gv}
+	for _, n := range names {
+		list.APIResources = append(list.APIResources, metav1.APIResource{Name: n})
+	}
+	return list, nil
+}
+
+// newClient returns a client holding dns and answering the access reviews
+// from allowed, keyed by "verb resource".
+func newClient(t *testing.T, allowed map[string]bool, dns ...*sreportalv1alpha2.DNS) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
+	b := fake.NewClientBuilder().WithScheme(scheme)
+	for _, d := range dns {
+		b = b.WithObjects(d)
+	}
+	return b.WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+			review := obj.(*authorizationv1.SelfSubjectAccessReview)
+			attrs := review.Spec.ResourceAttributes
+			review.Status.Allowed = allowed[attrs.Verb+" "+attrs.Resource]
+			return nil
+		},
+	}).Build()
+}
+
+var enabled = sreportalv1alpha2.CommonSourceSpec{Enabled: true}
+
+func newDNS(name string, sources sreportalv1alpha2.SourcesSpec) *sreportalv1alpha2.DNS {
+	return &sreportalv1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "sreportal-system"},
+		Spec:       sreportalv1alpha2.DNSSpec{Sources: sources},
+	}
+}
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func findCheck(t *testing.T, r Report, category Category, name string) Check {
+	t.Helper()
+	for _, c := range r.Checks {
+		if c.Category == category && c.Name == name {
+			return c
+		}
+	}
+	t.Fatalf("no %s check %q in %+v", category, name, r.Checks)
+	return Check{}
+}
+
+func TestRun_Healthy(t *testing.T) {
+	c := newClient(t, map[string]bool{
+		"list services": true, "watch services": true,
+		"list pods": true, "watch pods": true,
+		"list nodes": true, "watch nodes": true,
+		"list endpointslices": true, "watch endpointslices": true,
+	}, newDNS("main", sreportalv1alpha2.SourcesSpec{Service: &sreportalv1alpha2.ServiceSourceSpec{CommonSourceSpec: enabled}}))
+	webhook := func(*http.Request) error { return nil }
+	r := NewRunner(c, stubDiscovery{}, writeConfig(t, "{}\n"), webhook)
+
+	report := r.Run(context.Background())
+
+	assert.Equal(t, StatusOK, report.Status())
+	assert.Equal(t, "Kubernetes v1.33.1", findCheck(t, report, CategoryConnectivity, "api server").Message)
+	assert.Equal(t, "1 local DNS resources, enabled sources: service",
+		findCheck(t, report, CategoryConnectivity, "dns resources").Message)
+	assert.Equal(t, StatusOK, findCheck(t, report, CategoryRBAC, "endpointslices.discovery.k8s.io").Status)
+	assert.Equal(t, StatusSkipped, findCheck(t, report, CategoryCRD, "dnsendpoints.externaldns.k8s.io").Status)
+	assert.Equal(t, StatusOK, findCheck(t, report, CategoryWebhook, "webhook server").Status)
+	assert.Equal(t, StatusOK, findCheck(t, report, CategoryConfig, "config file").Status)
+}
+
+func TestRun_ReportsMissingPermissionsAndCRDs(t *testing.T) {
+	c := newClient(t, map[string]bool{
+		"list ingresses": true,
+		"list services":  true, "watch services": true,
+		"list gateways": true, "watch gateways": true,
+	}, newDNS("main", sreportalv1alpha2.SourcesSpec{
+		Ingress:      &sreportalv1alpha2.IngressSourceSpec{CommonSourceSpec: enabled},
+		IstioGateway: &sreportalv1alpha2.IstioGatewaySourceSpec{CommonSourceSpec: enabled},
+	}))
+	d := stubDiscovery{resources: map[string][]string{"externaldns.k8s.io/v1alpha1": {"dnsendpoints"}}}
+	r := NewRunner(c, d, filepath.Join(t.TempDir(), "missing.yaml"), nil)
+
+	report := r.Run(context.Background())
+
+	assert.Equal(t, StatusFailed, report.Status())
+	ingresses := findCheck(t, report, CategoryRBAC, "ingresses.networking.k8s.io")
+	assert.Equal(t, StatusFailed, ingresses.Status)
+	assert.Equal(t, "missing verbs watch, needed by sources ingress, istio-gateway", ingresses.Message)
+	assert.Equal(t, StatusOK, findCheck(t, report, CategoryRBAC, "services").Status)
+
+	gateways := findCheck(t, report, CategoryCRD, "gateways.networking.istio.io")
+	assert.Equal(t, StatusFailed, gateways.Status)
+	assert.Contains(t, gateways.Message, "needed by sources istio-gateway")
+	assert.Equal(t, StatusSkipped, findCheck(t, report, CategoryCRD, "virtualservices.networking.istio.io").Status)
+	assert.Equal(t, StatusOK, findCheck(t, report, CategoryCRD, "dnsendpoints.externaldns.k8s.io").Status)
+
+	assert.Equal(t, StatusSkipped, findCheck(t, report, CategoryWebhook, "webhook server").Status)
+	assert.Equal(t, StatusWarning, findCheck(t, report, CategoryConfig, "config file").Status)
+}
+
+func TestRun_APIServerUnreachable(t *testing.T) {
+	webhook := func(*http.Request) error { return errors.New("connection refused") }
+	r := NewRunner(newClient(t, nil), stubDiscovery{err: errors.New("dial tcp: timeout")},
+		writeConfig(t, "reconciliation:\n  interval: nope\n"), webhook)
+
+	report := r.Run(context.Background())
+
+	assert.Equal(t, StatusFailed, report.Status())
+	assert.Equal(t, StatusFailed, findCheck(t, report, CategoryConnectivity, "api server").Status)
+	assert.Equal(t, StatusSkipped, findCheck(t, report, CategoryRBAC, "sources").Status)
+	assert.Equal(t, StatusSkipped, findCheck(t, report, CategoryCRD, "sources").Status)
+	assert.Equal(t, "connection refused", findCheck(t, report, CategoryWebhook, "webhook server").Message)
+	assert.Equal(t, StatusFailed, findCheck(t, report, CategoryConfig, "config file").Status)
+}
+
+func TestRun_ReportsSourceHealth(t *testing.T) {
+	health := readstoresource.NewHealthTracker()
+	at := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	health.RecordSuccess(externaldns.KindService, at)
+	health.RecordSuccess(externaldns.KindIngress, at)
+	health.RecordFailure(externaldns.KindIngress, errors.New("forbidden"), at.Add(time.Minute))
+	health.RecordFailure(externaldns.KindDNSEndpoint, errors.New("no matches for kind"), at)
+	r := NewRunner(newClient(t, nil), stubDiscovery{}, writeConfig(t, "{}\n"), nil)
+	r.SetSourceHealth(health)
+
+	report := r.Run(context.Background())
+
+	service := findCheck(t, report, CategorySource, string(externaldns.KindService))
+	assert.Equal(t, StatusOK, service.Status)
+	assert.Equal(t, "last collected 2026-05-01T12:00:00Z", service.Message)
+	ingress := findCheck(t, report, CategorySource, string(externaldns.KindIngress))
+	assert.Equal(t, StatusFailed, ingress.Status)
+	assert.Equal(t, "1 failed collections since 2026-05-01T12:00:00Z: forbidden", ingress.Message)
+	assert.Equal(t, "1 failed collections, never collected: no matches for kind",
+		findCheck(t, report, CategorySource, string(externaldns.KindDNSEndpoint)).Message)
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package digest
+
+import (
+	"cmp"
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	"github.com/golgoth31/sreportal/internal/log"
+)
+
+const maxConcurrentChecks = 10
+
+// CertificateChecker returns the expiry of the TLS certificate served for an
+// FQDN.
+type CertificateChecker interface {
+	NotAfter(ctx context.Context, fqdn string) (time.Time, error)
+}
+
+// TLSChecker reads the leaf certificate served on Port of an FQDN.
+type TLSChecker struct {
+	Port    int
+	Timeout time.Duration
+}
+
+var _ CertificateChecker = TLSChecker{}
+
+// NotAfter implements CertificateChecker. The chain is not verified: an
+// untrusted or mismatched certificate still has an expiry worth reporting.
+func (c TLSChecker) NotAfter(ctx context.Context, fqdn string) (time.Time, error) {
+	d := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: c.Timeout},
+		Config: &tls.Config{
+			ServerName:         fqdn,
+			InsecureSkipVerify: true, //nolint:gosec // only the expiry of the leaf is read
+		},
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+	defer cancel()
+	conn, err := d.DialContext(ctx, "tcp", net.JoinHostPort(fqdn, strconv.Itoa(c.Port)))
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer func() { _ = conn.Close() }()
+	certs := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return time.Time{}, errors.New("no peer certificate")
+	}
+	return certs[0].NotAfter, nil
+}
+
+// expiring checks the certificates of the live FQDNs of views and returns
+// those expiring before deadline, soonest first. FQDNs that cannot be
+// reached over TLS are skipped.
+func expiring(ctx context.Context, checker CertificateChecker, views []domaindns.FQDNView, deadline time.Time) []Certificate {
+	seen := make(map[string]bool, len(views))
+	var names []string
+	for _, v := range views {
+		if v.RemovedAt.IsZero() && !seen[v.Name] {
+			seen[v.Name] = true
+			names = append(names, v.Name)
+		}
+	}
+
+	var (
+		mu  sync.Mutex
+		out []Certificate
+		wg  sync.WaitGroup
+	)
+	nameCh := make(chan string, len(names))
+	for _, n := range names {
+		nameCh <- n
+	}
+	close(nameCh)
+	for range min(maxConcurrentChecks, len(names)) {
+		wg.Go(func() {
+			for name := range nameCh {
+				notAfter, err := checker.NotAfter(ctx, name)
+				if err != nil {
+					log.FromContext(ctx).WithName("digest").V(1).Info("certificate check failed",
+						"fqdn", name, "err", err.Error())
+					continue
+				}
+				if notAfter.Before(deadline) {
+					mu.Lock()
+					out = append(out, Certificate{Name: name, NotAfter: notAfter})
+					mu.Unlock()
+				}
+			}
+		})
+	}
+	wg.Wait()
+
+	slices.SortFunc(out, func(a, b Certificate) int {
+		return cmp.Or(a.NotAfter.Compare(b.NotAfter), cmp.Compare(a.Name, b.Name))
+	})
+	return out
+}
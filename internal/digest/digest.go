@@ -0,0 +1,284 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package digest renders a weekly digest of each selected portal (FQDNs
+// added and removed since the previous digest, FQDNs drifting from their
+// expected state, certificates about to expire) and delivers it through
+// Notifiers on a per-portal schedule.
+package digest
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+// Entry identifies an FQDN of a digest.
+type Entry struct {
+	Name       string
+	RecordType string
+}
+
+func (e Entry) String() string {
+	if e.RecordType == "" {
+		return e.Name
+	}
+	return e.Name + " (" + e.RecordType + ")"
+}
+
+// Inventory is the set of FQDNs of a portal at a point in time.
+type Inventory map[Entry]struct{}
+
+// NewInventory returns the live FQDNs of views.
+func NewInventory(views []domaindns.FQDNView) Inventory {
+	inv := make(Inventory, len(views))
+	for _, v := range views {
+		if !v.RemovedAt.IsZero() {
+			continue
+		}
+		inv[Entry{Name: v.Name, RecordType: v.RecordType}] = struct{}{}
+	}
+	return inv
+}
+
+// Drift is an FQDN whose health badge is not healthy.
+type Drift struct {
+	Entry
+	Status       domaindns.OverallStatus
+	SyncStatus   string
+	Availability string
+}
+
+// Certificate is the TLS certificate served for an FQDN.
+type Certificate struct {
+	Name     string
+	NotAfter time.Time
+}
+
+// Digest summarizes a portal over a period.
+type Digest struct {
+	Portal string
+	Title  string
+	From   time.Time
+	To     time.Time
+	// Total is the number of live FQDNs at the end of the period.
+	Total int
+	// Statuses counts the live FQDNs by health badge.
+	Statuses map[domaindns.OverallStatus]int
+	Added    []Entry
+	Removed  []Entry
+	Drifting []Drift
+	// CertificatesChecked is false when the certificate check is disabled.
+	CertificatesChecked bool
+	// Expiring lists the certificates expiring soon, soonest first.
+	Expiring []Certificate
+}
+
+// Build returns the digest of portal from prev, the inventory at the start
+// of the period, to views, its FQDNs at the end. Each list is sorted.
+func Build(portal, title string, from, to time.Time, prev Inventory, views []domaindns.FQDNView) Digest {
+	d := Digest{
+		Portal:   portal,
+		Title:    title,
+		From:     from,
+		To:       to,
+		Statuses: map[domaindns.OverallStatus]int{},
+	}
+	next := NewInventory(views)
+	d.Total = len(next)
+	for e := range next {
+		if _, ok := prev[e]; !ok {
+			d.Added = append(d.Added, e)
+		}
+	}
+	for e := range prev {
+		if _, ok := next[e]; !ok {
+			d.Removed = append(d.Removed, e)
+		}
+	}
+	slices.SortFunc(d.Added, compareEntries)
+	slices.SortFunc(d.Removed, compareEntries)
+
+	for _, v := range views {
+		if !v.RemovedAt.IsZero() {
+			continue
+		}
+		status := v.OverallStatus
+		if status == "" {
+			status = domaindns.OverallStatusUnknown
+		}
+		d.Statuses[status]++
+		if status == domaindns.OverallStatusWarning || status == domaindns.OverallStatusCritical {
+			d.Drifting = append(d.Drifting, Drift{
+				Entry:        Entry{Name: v.Name, RecordType: v.RecordType},
+				Status:       status,
+				SyncStatus:   v.SyncStatus,
+				Availability: v.Availability,
+			})
+		}
+	}
+	// Critical first, then by name.
+	slices.SortFunc(d.Drifting, func(a, b Drift) int {
+		if a.Status != b.Status {
+			if a.Status == domaindns.OverallStatusCritical {
+				return -1
+			}
+			return 1
+		}
+		return compareEntries(a.Entry, b.Entry)
+	})
+	return d
+}
+
+func compareEntries(a, b Entry) int {
+	return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.RecordType, b.RecordType))
+}
+
+// statusOrder is the order health badges are counted in the digest.
+var statusOrder = []domaindns.OverallStatus{
+	domaindns.OverallStatusHealthy,
+	domaindns.OverallStatusWarning,
+	domaindns.OverallStatusCritical,
+	domaindns.OverallStatusUnknown,
+}
+
+// Render returns the subject and the plain-text body of d, listing at most
+// maxItems FQDNs per section.
+func Render(d Digest, maxItems int) (string, string) {
+	name := d.Portal
+	if d.Title != "" && d.Title != d.Portal {
+		name = fmt.Sprintf("%s (%s)", d.Title, d.Portal)
+	}
+	subject := fmt.Sprintf("[sreportal] Digest of portal %s", name)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Digest of portal %s, %s to %s UTC\n\n", name,
+		d.From.UTC().Format("2006-01-02 15:04"), d.To.UTC().Format("2006-01-02 15:04"))
+
+	counts := make([]string, 0, len(statusOrder))
+	for _, s := range statusOrder {
+		if n := d.Statuses[s]; n > 0 {
+			counts = append(counts, fmt.Sprintf("%d %s", n, s))
+		}
+	}
+	fmt.Fprintf(&b, "%d FQDNs", d.Total)
+	if len(counts) > 0 {
+		fmt.Fprintf(&b, ": %s", strings.Join(counts, ", "))
+	}
+	b.WriteString("\n")
+
+	writeSection(&b, "New FQDNs", d.Added, maxItems, Entry.String)
+	writeSection(&b, "Removed FQDNs", d.Removed, maxItems, Entry.String)
+	writeSection(&b, "Drifting FQDNs", d.Drifting, maxItems, func(f Drift) string {
+		details := []string{string(f.Status)}
+		if f.SyncStatus != "" {
+			details = append(details, "dns "+f.SyncStatus)
+		}
+		if f.Availability != "" {
+			details = append(details, "probe "+f.Availability)
+		}
+		return fmt.Sprintf("%s: %s", f.Entry, strings.Join(details, ", "))
+	})
+	if !d.CertificatesChecked {
+		b.WriteString("\nExpiring certificates: not checked\n")
+	} else {
+		writeSection(&b, "Expiring certificates", d.Expiring, maxItems, func(c Certificate) string {
+			days := int(c.NotAfter.Sub(d.To).Hours() / 24)
+			if days < 0 {
+				return fmt.Sprintf("%s: expired on %s", c.Name, c.NotAfter.UTC().Format(time.DateOnly))
+			}
+			return fmt.Sprintf("%s: expires on %s (in %d days)", c.Name, c.NotAfter.UTC().Format(time.DateOnly), days)
+		})
+	}
+	return subject, b.String()
+}
+
+// writeSection writes a titled list of at most maxItems items, counting the
+// others.
+func writeSection[T any](b *strings.Builder, title string, items []T, maxItems int, format func(T) string) {
+	if len(items) == 0 {
+		fmt.Fprintf(b, "\n%s: none\n", title)
+		return
+	}
+	fmt.Fprintf(b, "\n%s (%d):\n", title, len(items))
+	for i, item := range items {
+		if i == maxItems {
+			fmt.Fprintf(b, "  ... and %d more\n", len(items)-maxItems)
+			break
+		}
+		fmt.Fprintf(b, "  - %s\n", format(item))
+	}
+}
+
+// Schedule is a weekly delivery time, in UTC.
+type Schedule struct {
+	Weekday time.Weekday
+	Hour    int
+	Minute  int
+}
+
+// DefaultSchedule is the schedule of portals that set none.
+var DefaultSchedule = Schedule{Weekday: time.Monday, Hour: 9}
+
+var weekdays = func() map[string]time.Weekday {
+	m := map[string]time.Weekday{}
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		name := strings.ToLower(d.String())
+		m[name] = d
+		m[name[:3]] = d
+	}
+	return m
+}()
+
+// ParseSchedule parses "<weekday> <HH:MM>", e.g. "Mon 09:00" or
+// "friday 17:30". An empty string is DefaultSchedule.
+func ParseSchedule(s string) (Schedule, error) {
+	if strings.TrimSpace(s) == "" {
+		return DefaultSchedule, nil
+	}
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return Schedule{}, fmt.Errorf("schedule %q: expected \"<weekday> <HH:MM>\"", s)
+	}
+	day, ok := weekdays[strings.ToLower(fields[0])]
+	if !ok {
+		return Schedule{}, fmt.Errorf("schedule %q: unknown weekday %q", s, fields[0])
+	}
+	t, err := time.Parse("15:04", fields[1])
+	if err != nil {
+		return Schedule{}, fmt.Errorf("schedule %q: invalid time %q: %w", s, fields[1], err)
+	}
+	return Schedule{Weekday: day, Hour: t.Hour(), Minute: t.Minute()}, nil
+}
+
+// Last returns the latest delivery time at or before now.
+func (s Schedule) Last(now time.Time) time.Time {
+	now = now.UTC()
+	at := time.Date(now.Year(), now.Month(), now.Day(), s.Hour, s.Minute, 0, 0, time.UTC)
+	at = at.AddDate(0, 0, -((int(now.Weekday()) - int(s.Weekday) + 7) % 7))
+	if at.After(now) {
+		at = at.AddDate(0, 0, -7)
+	}
+	return at
+}
+
+func (s Schedule) String() string {
+	return fmt.Sprintf("%s %02d:%02d", s.Weekday.String()[:3], s.Hour, s.Minute)
+}
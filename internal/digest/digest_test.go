@@ -0,0 +1,130 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package digest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+func TestBuild(t *testing.T) {
+	from := time.Date(2026, 10, 5, 9, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	prev := Inventory{
+		{Name: "api.example.com", RecordType: "A"}: {},
+		{Name: "old.example.com", RecordType: "A"}: {},
+	}
+	views := []domaindns.FQDNView{
+		{Name: "api.example.com", RecordType: "A", OverallStatus: domaindns.OverallStatusHealthy},
+		{Name: "web.example.com", RecordType: "CNAME", OverallStatus: domaindns.OverallStatusWarning, SyncStatus: "notsync"},
+		{Name: "db.example.com", RecordType: "A", OverallStatus: domaindns.OverallStatusCritical, Availability: "down"},
+		{Name: "gone.example.com", RecordType: "A", OverallStatus: domaindns.OverallStatusRemoved, RemovedAt: to},
+	}
+
+	d := Build("main", "Main", from, to, prev, views)
+
+	assert.Equal(t, 3, d.Total)
+	assert.Equal(t, []Entry{{Name: "db.example.com", RecordType: "A"}, {Name: "web.example.com", RecordType: "CNAME"}}, d.Added)
+	assert.Equal(t, []Entry{{Name: "old.example.com", RecordType: "A"}}, d.Removed)
+	require.Len(t, d.Drifting, 2)
+	assert.Equal(t, "db.example.com", d.Drifting[0].Name, "critical FQDNs come first")
+	assert.Equal(t, "web.example.com", d.Drifting[1].Name)
+	assert.Equal(t, map[domaindns.OverallStatus]int{
+		domaindns.OverallStatusHealthy:  1,
+		domaindns.OverallStatusWarning:  1,
+		domaindns.OverallStatusCritical: 1,
+	}, d.Statuses)
+}
+
+func TestRender(t *testing.T) {
+	from := time.Date(2026, 10, 5, 9, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	d := Digest{
+		Portal:   "main",
+		Title:    "Main",
+		From:     from,
+		To:       to,
+		Total:    3,
+		Statuses: map[domaindns.OverallStatus]int{domaindns.OverallStatusHealthy: 2, domaindns.OverallStatusCritical: 1},
+		Added: []Entry{
+			{Name: "a.example.com", RecordType: "A"},
+			{Name: "b.example.com", RecordType: "A"},
+			{Name: "c.example.com", RecordType: "A"},
+		},
+		Drifting: []Drift{{Entry: Entry{Name: "a.example.com", RecordType: "A"},
+			Status: domaindns.OverallStatusCritical, SyncStatus: "notavailable", Availability: "down"}},
+		CertificatesChecked: true,
+		Expiring:            []Certificate{{Name: "a.example.com", NotAfter: to.AddDate(0, 0, 4)}},
+	}
+
+	subject, body := Render(d, 2)
+
+	assert.Equal(t, "[sreportal] Digest of portal Main (main)", subject)
+	assert.Contains(t, body, "Digest of portal Main (main), 2026-10-05 09:00 to 2026-10-12 09:00 UTC")
+	assert.Contains(t, body, "3 FQDNs: 2 healthy, 1 critical")
+	assert.Contains(t, body, "New FQDNs (3):\n  - a.example.com (A)\n  - b.example.com (A)\n  ... and 1 more\n")
+	assert.Contains(t, body, "Removed FQDNs: none")
+	assert.Contains(t, body, "  - a.example.com (A): critical, dns notavailable, probe down")
+	assert.Contains(t, body, "  - a.example.com: expires on 2026-10-16 (in 4 days)")
+
+	d.CertificatesChecked = false
+	_, body = Render(d, 2)
+	assert.Contains(t, body, "Expiring certificates: not checked")
+}
+
+func TestParseSchedule(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Schedule
+		wantErr bool
+	}{
+		{"", DefaultSchedule, false},
+		{"Mon 09:00", Schedule{Weekday: time.Monday, Hour: 9}, false},
+		{"friday 17:30", Schedule{Weekday: time.Friday, Hour: 17, Minute: 30}, false},
+		{"SUN 00:05", Schedule{Weekday: time.Sunday, Minute: 5}, false},
+		{"Mon", Schedule{}, true},
+		{"Someday 09:00", Schedule{}, true},
+		{"Mon 25:00", Schedule{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseSchedule(tt.in)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestScheduleLast(t *testing.T) {
+	s := Schedule{Weekday: time.Monday, Hour: 9}
+	monday := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, monday, s.Last(monday), "at the delivery time")
+	assert.Equal(t, monday, s.Last(monday.Add(3*24*time.Hour)), "later in the week")
+	assert.Equal(t, monday.AddDate(0, 0, -7), s.Last(monday.Add(-time.Minute)), "just before the delivery time")
+	assert.Equal(t, monday, s.Last(monday.Add(2*time.Hour).In(time.FixedZone("UTC+10", 10*3600))),
+		"the schedule is in UTC")
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package digest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/golgoth31/sreportal/internal/config"
+)
+
+const (
+	// defaultSMTPPort is the port of email notifiers that set none
+	// (submission with STARTTLS).
+	defaultSMTPPort = 587
+	// maxErrorBody bounds the part of an error response quoted in errors.
+	maxErrorBody  = 512
+	notifyTimeout = 30 * time.Second
+)
+
+// Notifier delivers a rendered digest.
+type Notifier interface {
+	// Name identifies the notifier in logs and configuration.
+	Name() string
+	// Notify delivers the digest with the given subject and plain-text body.
+	Notify(ctx context.Context, subject, body string) error
+}
+
+// NewNotifier creates the notifier configured by cfg. Secrets are read from
+// namespace through reader on each delivery, so rotated credentials apply
+// without a restart.
+func NewNotifier(cfg config.DigestNotifierConfig, reader client.Reader, namespace string) (Notifier, error) {
+	switch cfg.Type {
+	case config.DigestNotifierSlack:
+		return &SlackNotifier{
+			name:       cfg.Name,
+			cfg:        cfg.Slack,
+			reader:     reader,
+			namespace:  namespace,
+			httpClient: &http.Client{Timeout: notifyTimeout},
+		}, nil
+	case config.DigestNotifierEmail:
+		return &EmailNotifier{name: cfg.Name, cfg: cfg.Email, reader: reader, namespace: namespace}, nil
+	default:
+		return nil, fmt.Errorf("unknown digest notifier type %q", cfg.Type)
+	}
+}
+
+// secretValues returns the values of keys in the Secret name of namespace,
+// failing when one is missing.
+func secretValues(ctx context.Context, reader client.Reader, namespace, name string, keys ...string) ([]string, error) {
+	var secret corev1.Secret
+	key := client.ObjectKey{Namespace: namespace, Name: name}
+	if err := reader.Get(ctx, key, &secret); err != nil {
+		return nil, fmt.Errorf("get secret %s: %w", key, err)
+	}
+	values := make([]string, 0, len(keys))
+	for _, k := range keys {
+		v := secret.Data[k]
+		if len(v) == 0 {
+			return nil, fmt.Errorf("secret %s has no %q key", key, k)
+		}
+		values = append(values, string(v))
+	}
+	return values, nil
+}
+
+// SlackNotifier posts the digest to a Slack incoming webhook.
+type SlackNotifier struct {
+	name       string
+	cfg        config.SlackNotifierConfig
+	reader     client.Reader
+	namespace  string
+	httpClient *http.Client
+}
+
+var _ Notifier = (*SlackNotifier)(nil)
+
+// Name implements Notifier.
+func (n *SlackNotifier) Name() string { return n.name }
+
+// Notify implements Notifier. The body is sent as a preformatted block so
+// its alignment survives.
+func (n *SlackNotifier) Notify(ctx context.Context, subject, body string) error {
+	values, err := secretValues(ctx, n.reader, n.namespace, n.cfg.WebhookSecret, "url")
+	if err != nil {
+		return err
+	}
+	payload, err := json.Marshal(map[string]string{
+		"text": fmt.Sprintf("*%s*\n```\n%s```", subject, body),
+	})
+	if err != nil {
+		return fmt.Errorf("encode slack message: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, values[0], bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("build slack request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("post to slack: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return fmt.Errorf("post to slack: status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// EmailNotifier mails the digest through an SMTP relay, upgrading to TLS
+// when the relay offers STARTTLS. Credentials are only sent over TLS (or to
+// localhost).
+type EmailNotifier struct {
+	name      string
+	cfg       config.EmailNotifierConfig
+	reader    client.Reader
+	namespace string
+}
+
+var _ Notifier = (*EmailNotifier)(nil)
+
+// Name implements Notifier.
+func (n *EmailNotifier) Name() string { return n.name }
+
+// Notify implements Notifier.
+func (n *EmailNotifier) Notify(ctx context.Context, subject, body string) error {
+	var auth smtp.Auth
+	if n.cfg.CredentialsSecret != "" {
+		values, err := secretValues(ctx, n.reader, n.namespace, n.cfg.CredentialsSecret, "username", "password")
+		if err != nil {
+			return err
+		}
+		auth = smtp.PlainAuth("", values[0], values[1], n.cfg.Host)
+	}
+	port := n.cfg.Port
+	if port == 0 {
+		port = defaultSMTPPort
+	}
+	addr := net.JoinHostPort(n.cfg.Host, strconv.Itoa(port))
+	if err := n.send(ctx, addr, auth, emailMessage(n.cfg.From, n.cfg.To, subject, body, time.Now())); err != nil {
+		return fmt.Errorf("send mail through %s: %w", addr, err)
+	}
+	return nil
+}
+
+// send is smtp.SendMail bounded by ctx and notifyTimeout.
+func (n *EmailNotifier) send(ctx context.Context, addr string, auth smtp.Auth, msg []byte) error {
+	conn, err := (&net.Dialer{Timeout: notifyTimeout}).DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	if err := conn.SetDeadline(time.Now().Add(notifyTimeout)); err != nil {
+		_ = conn.Close()
+		return err
+	}
+	c, err := smtp.NewClient(conn, n.cfg.Host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer func() { _ = c.Close() }()
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: n.cfg.Host, MinVersion: tls.VersionTLS12}); err != nil {
+			return err
+		}
+	}
+	if auth != nil {
+		if err := c.Auth(auth); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(n.cfg.From); err != nil {
+		return err
+	}
+	for _, rcpt := range n.cfg.To {
+		if err := c.Rcpt(rcpt); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// emailMessage returns the RFC 5322 plain-text message of a digest. Line
+// breaks are stripped from header values.
+func emailMessage(from string, to []string, subject, body string, date time.Time) []byte {
+	header := strings.NewReplacer("\r", "", "\n", " ")
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", header.Replace(from))
+	fmt.Fprintf(&b, "To: %s\r\n", header.Replace(strings.Join(to, ", ")))
+	fmt.Fprintf(&b, "Subject: %s\r\n", header.Replace(subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	b.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	return []byte(b.String())
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package digest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/golgoth31/sreportal/internal/config"
+)
+
+func TestSlackNotifier(t *testing.T) {
+	var got string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		got = string(body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	_, c := newFixture(t, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "digest-slack", Namespace: tNamespace},
+		Data:       map[string][]byte{"url": []byte(srv.URL)},
+	})
+	n, err := NewNotifier(config.DigestNotifierConfig{
+		Name:  "sre",
+		Type:  config.DigestNotifierSlack,
+		Slack: config.SlackNotifierConfig{WebhookSecret: "digest-slack"},
+	}, c, tNamespace)
+	require.NoError(t, err)
+
+	require.NoError(t, n.Notify(context.Background(), "Digest", "3 FQDNs\n"))
+	assert.JSONEq(t, `{"text":"*Digest*\n`+"```"+`\n3 FQDNs\n`+"```"+`"}`, got)
+
+	n, err = NewNotifier(config.DigestNotifierConfig{
+		Name:  "sre",
+		Type:  config.DigestNotifierSlack,
+		Slack: config.SlackNotifierConfig{WebhookSecret: "missing"},
+	}, c, tNamespace)
+	require.NoError(t, err)
+	require.Error(t, n.Notify(context.Background(), "Digest", "3 FQDNs\n"))
+}
+
+func TestEmailMessage(t *testing.T) {
+	date := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+	msg := string(emailMessage("sreportal@example.com", []string{"a@example.com", "b@example.com"},
+		"Digest\r\nBcc: evil@example.com", "line 1\nline 2\n", date))
+
+	assert.Contains(t, msg, "To: a@example.com, b@example.com\r\n")
+	assert.Contains(t, msg, "Subject: Digest Bcc: evil@example.com\r\n", "line breaks are stripped from headers")
+	assert.Contains(t, msg, "Date: Mon, 12 Oct 2026 09:00:00 +0000\r\n")
+	assert.Contains(t, msg, "\r\n\r\nline 1\r\nline 2\r\n")
+}
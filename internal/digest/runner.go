@@ -0,0 +1,246 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package digest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/config"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	"github.com/golgoth31/sreportal/internal/log"
+	"github.com/golgoth31/sreportal/internal/metrics"
+)
+
+// tickInterval is how often the Runner checks whether a digest is due.
+const tickInterval = time.Minute
+
+// retryMinBackoff is the delay before the first retry of a failed delivery,
+// doubled after each failure up to retryMaxBackoff.
+const (
+	retryMinBackoff = time.Minute
+	retryMaxBackoff = time.Hour
+)
+
+// portalState is the schedule and the baseline of the digest of a portal.
+type portalState struct {
+	name      string
+	schedule  Schedule
+	notifiers []Notifier
+
+	// last is the last delivery time handled, delivered or not.
+	last time.Time
+	// since and inventory are the start and the FQDNs of the period covered
+	// by the next digest; inventory is nil until the first snapshot.
+	since     time.Time
+	inventory Inventory
+	// pending is the delivery to retry, nil when the last one succeeded.
+	pending *delivery
+}
+
+// delivery is a rendered digest and the notifiers it is still to be sent
+// through. A failed delivery is retried as is, through the notifiers that
+// failed only, until it succeeds or the next delivery time supersedes it.
+type delivery struct {
+	digest        Digest
+	subject, body string
+	notifiers     []Notifier
+	// inventory is the baseline of the next digest once delivered.
+	inventory Inventory
+	// retryAt and backoff schedule the next attempt after a failure.
+	retryAt time.Time
+	backoff time.Duration
+}
+
+// Runner delivers the digest of each configured portal at its scheduled
+// time. The FQDNs added and removed are computed against an in-memory
+// baseline taken at startup and after each delivery, so the first digest
+// after a restart covers the period since the restart, and a delivery time
+// missed while the operator was down is not caught up. It runs on the leader
+// only.
+type Runner struct {
+	fqdns      domaindns.FQDNReader
+	client     client.Client
+	certs      CertificateChecker
+	warnWithin time.Duration
+	maxItems   int
+	portals    []*portalState
+	now        func() time.Time
+}
+
+var _ manager.Runnable = (*Runner)(nil)
+
+// NewRunner creates a Runner for the portals of cfg, delivering through
+// notifiers (keyed by name). certs may be nil to skip the certificate check.
+func NewRunner(fqdns domaindns.FQDNReader, c client.Client, notifiers map[string]Notifier, certs CertificateChecker, cfg config.DigestConfig) (*Runner, error) {
+	r := &Runner{
+		fqdns:      fqdns,
+		client:     c,
+		certs:      certs,
+		warnWithin: cfg.Certificates.WarnWithin.Duration(),
+		maxItems:   cfg.MaxItems,
+		now:        time.Now,
+	}
+	for _, p := range cfg.Portals {
+		schedule, err := ParseSchedule(p.Schedule)
+		if err != nil {
+			return nil, fmt.Errorf("portal %q: %w", p.Portal, err)
+		}
+		state := &portalState{name: p.Portal, schedule: schedule}
+		for _, name := range p.Notifiers {
+			n, ok := notifiers[name]
+			if !ok {
+				return nil, fmt.Errorf("portal %q: unknown notifier %q", p.Portal, name)
+			}
+			state.notifiers = append(state.notifiers, n)
+		}
+		r.portals = append(r.portals, state)
+	}
+	return r, nil
+}
+
+// Start implements manager.Runnable.
+func (r *Runner) Start(ctx context.Context) error {
+	now := r.now()
+	for _, p := range r.portals {
+		p.last = p.schedule.Last(now)
+	}
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+	for {
+		r.run(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// run takes the missing baselines and delivers the digests that are due.
+func (r *Runner) run(ctx context.Context) {
+	logger := log.FromContext(ctx).WithName("digest")
+	now := r.now()
+	for _, p := range r.portals {
+		if p.inventory == nil {
+			views, err := r.fqdns.List(ctx, domaindns.FQDNFilters{Portal: p.name})
+			if err != nil {
+				logger.Error(err, "failed to list FQDNs", "portal", p.name)
+				continue
+			}
+			p.since, p.inventory = now, NewInventory(views)
+			continue
+		}
+		var d *delivery
+		if due := p.schedule.Last(now); due.After(p.last) {
+			// A new delivery time supersedes a pending retry: the new digest
+			// covers its period too, the baseline having not moved.
+			p.last, p.pending = due, nil
+			built, err := r.build(ctx, p, now)
+			if err != nil {
+				metrics.PortalDigestTotal.WithLabelValues("error").Inc()
+				logger.Error(err, "failed to build digest", "portal", p.name)
+				continue
+			}
+			d = built
+		} else if p.pending != nil && !now.Before(p.pending.retryAt) {
+			d = p.pending
+		} else {
+			continue
+		}
+		if err := r.send(ctx, p, d); err != nil {
+			metrics.PortalDigestTotal.WithLabelValues("error").Inc()
+			d.backoff = min(max(d.backoff*2, retryMinBackoff), retryMaxBackoff)
+			d.retryAt = now.Add(d.backoff)
+			p.pending = d
+			logger.Error(err, "failed to deliver digest", "portal", p.name, "retryIn", d.backoff)
+			continue
+		}
+		p.pending = nil
+		metrics.PortalDigestTotal.WithLabelValues("delivered").Inc()
+	}
+}
+
+// build renders the digest of p up to now, to be sent through every notifier
+// of p.
+func (r *Runner) build(ctx context.Context, p *portalState, now time.Time) (*delivery, error) {
+	title, err := r.portalTitle(ctx, p.name)
+	if err != nil {
+		return nil, err
+	}
+	views, err := r.fqdns.List(ctx, domaindns.FQDNFilters{Portal: p.name})
+	if err != nil {
+		return nil, fmt.Errorf("list FQDNs: %w", err)
+	}
+	d := Build(p.name, title, p.since, now, p.inventory, views)
+	if r.certs != nil {
+		d.CertificatesChecked = true
+		d.Expiring = expiring(ctx, r.certs, views, now.Add(r.warnWithin))
+	}
+	subject, body := Render(d, r.maxItems)
+	return &delivery{
+		digest:    d,
+		subject:   subject,
+		body:      body,
+		notifiers: p.notifiers,
+		inventory: NewInventory(views),
+	}, nil
+}
+
+// send sends d through its notifiers, keeping in d those that failed. The
+// baseline of p moves to the end of the digest only when every notifier
+// succeeded, so a delivery that keeps failing is covered by the next digest.
+func (r *Runner) send(ctx context.Context, p *portalState, d *delivery) error {
+	var (
+		failed []Notifier
+		errs   []error
+	)
+	for _, n := range d.notifiers {
+		if err := n.Notify(ctx, d.subject, d.body); err != nil {
+			failed = append(failed, n)
+			errs = append(errs, fmt.Errorf("notifier %s: %w", n.Name(), err))
+		}
+	}
+	d.notifiers = failed
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+	p.since, p.inventory = d.digest.To, d.inventory
+	log.FromContext(ctx).WithName("digest").Info("delivered digest", "portal", p.name, "fqdnCount", d.digest.Total,
+		"added", len(d.digest.Added), "removed", len(d.digest.Removed), "drifting", len(d.digest.Drifting), "expiring", len(d.digest.Expiring))
+	return nil
+}
+
+// portalTitle returns the title of the portal named name.
+func (r *Runner) portalTitle(ctx context.Context, name string) (string, error) {
+	var list sreportalv1alpha1.PortalList
+	if err := r.client.List(ctx, &list); err != nil {
+		return "", fmt.Errorf("list portals: %w", err)
+	}
+	for _, p := range list.Items {
+		if p.Name == name {
+			return p.Spec.Title, nil
+		}
+	}
+	return "", fmt.Errorf("portal %q not found", name)
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package digest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/config"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	dnsreadstore "github.com/golgoth31/sreportal/internal/readstore/dns"
+)
+
+const tNamespace = "sreportal-system"
+
+// recordingNotifier keeps the digests it is given and fails while err is set.
+type recordingNotifier struct {
+	bodies []string
+	err    error
+}
+
+func (n *recordingNotifier) Name() string { return "recording" }
+
+func (n *recordingNotifier) Notify(_ context.Context, _, body string) error {
+	if n.err != nil {
+		return n.err
+	}
+	n.bodies = append(n.bodies, body)
+	return nil
+}
+
+// fixedChecker returns the expiry of each FQDN from a map.
+type fixedChecker map[string]time.Time
+
+func (c fixedChecker) NotAfter(_ context.Context, fqdn string) (time.Time, error) {
+	if t, ok := c[fqdn]; ok {
+		return t, nil
+	}
+	return time.Time{}, errors.New("connection refused")
+}
+
+func newFixture(t *testing.T, objs ...client.Object) (*dnsreadstore.FQDNStore, client.Client) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha1.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+	objs = append(objs, &sreportalv1alpha1.Portal{
+		ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: tNamespace},
+		Spec:       sreportalv1alpha1.PortalSpec{Title: "Main"},
+	})
+	return dnsreadstore.NewFQDNStore(), fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func replace(t *testing.T, store *dnsreadstore.FQDNStore, names ...string) {
+	t.Helper()
+	views := make([]domaindns.FQDNView, 0, len(names))
+	for _, n := range names {
+		views = append(views, domaindns.FQDNView{Name: n, RecordType: "A", Targets: []string{"10.0.0.1"}, Portals: []string{"main"}})
+	}
+	require.NoError(t, store.Replace(context.Background(), "ns/main-service", "main", views))
+}
+
+func TestRunnerDeliversOnSchedule(t *testing.T) {
+	ctx := context.Background()
+	store, c := newFixture(t)
+	replace(t, store, "api.example.com", "old.example.com")
+	notifier := &recordingNotifier{}
+	cfg := config.DigestConfig{
+		MaxItems:     50,
+		Portals:      []config.DigestPortalConfig{{Portal: "main", Schedule: "Mon 09:00", Notifiers: []string{"sre"}}},
+		Certificates: config.DigestCertificatesConfig{WarnWithin: config.Duration(21 * 24 * time.Hour)},
+	}
+	certs := fixedChecker{"api.example.com": time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)}
+	r, err := NewRunner(store, c, map[string]Notifier{"sre": notifier}, certs, cfg)
+	require.NoError(t, err)
+
+	now := time.Date(2026, 10, 12, 8, 0, 0, 0, time.UTC) // Monday, before the delivery time
+	r.now = func() time.Time { return now }
+	for _, p := range r.portals {
+		p.last = p.schedule.Last(now)
+	}
+
+	r.run(ctx) // baseline
+	assert.Empty(t, notifier.bodies)
+
+	replace(t, store, "api.example.com", "new.example.com")
+	now = now.Add(30 * time.Minute)
+	r.run(ctx)
+	assert.Empty(t, notifier.bodies, "not due yet")
+
+	now = now.Add(31 * time.Minute)
+	r.run(ctx)
+	require.Len(t, notifier.bodies, 1)
+	body := notifier.bodies[0]
+	assert.Contains(t, body, "Digest of portal Main (main), 2026-10-12 08:00 to 2026-10-12 09:01 UTC")
+	assert.Contains(t, body, "New FQDNs (1):\n  - new.example.com (A)")
+	assert.Contains(t, body, "Removed FQDNs (1):\n  - old.example.com (A)")
+	assert.Contains(t, body, "  - api.example.com: expires on 2026-10-20 (in 7 days)")
+
+	now = now.Add(time.Hour)
+	r.run(ctx)
+	assert.Len(t, notifier.bodies, 1, "delivered once per week")
+}
+
+func TestRunnerKeepsBaselineOnFailure(t *testing.T) {
+	ctx := context.Background()
+	store, c := newFixture(t)
+	replace(t, store, "api.example.com")
+	notifier := &recordingNotifier{err: errors.New("webhook unreachable")}
+	cfg := config.DigestConfig{
+		MaxItems: 50,
+		Portals:  []config.DigestPortalConfig{{Portal: "main", Notifiers: []string{"sre"}}},
+	}
+	r, err := NewRunner(store, c, map[string]Notifier{"sre": notifier}, nil, cfg)
+	require.NoError(t, err)
+
+	now := time.Date(2026, 10, 12, 8, 0, 0, 0, time.UTC)
+	r.now = func() time.Time { return now }
+	for _, p := range r.portals {
+		p.last = p.schedule.Last(now)
+	}
+	r.run(ctx)
+
+	replace(t, store, "api.example.com", "new.example.com")
+	now = now.Add(2 * time.Hour)
+	r.run(ctx)
+	assert.Empty(t, notifier.bodies)
+
+	notifier.err = nil
+	now = now.AddDate(0, 0, 7)
+	r.run(ctx)
+	require.Len(t, notifier.bodies, 1)
+	assert.Contains(t, notifier.bodies[0], "2026-10-12 08:00 to 2026-10-19 10:00 UTC", "the failed period is covered")
+	assert.Contains(t, notifier.bodies[0], "New FQDNs (1):\n  - new.example.com (A)")
+	assert.Contains(t, notifier.bodies[0], "Expiring certificates: not checked")
+}
+
+func TestRunnerRetriesFailedDeliveryWithBackoff(t *testing.T) {
+	ctx := context.Background()
+	store, c := newFixture(t)
+	replace(t, store, "api.example.com")
+	failing := &recordingNotifier{err: errors.New("webhook unreachable")}
+	working := &recordingNotifier{}
+	cfg := config.DigestConfig{
+		MaxItems: 50,
+		Portals:  []config.DigestPortalConfig{{Portal: "main", Notifiers: []string{"failing", "working"}}},
+	}
+	r, err := NewRunner(store, c, map[string]Notifier{"failing": failing, "working": working}, nil, cfg)
+	require.NoError(t, err)
+
+	now := time.Date(2026, 10, 12, 8, 0, 0, 0, time.UTC)
+	r.now = func() time.Time { return now }
+	for _, p := range r.portals {
+		p.last = p.schedule.Last(now)
+	}
+	r.run(ctx)
+
+	replace(t, store, "api.example.com", "new.example.com")
+	now = now.Add(2 * time.Hour)
+	r.run(ctx)
+	require.Len(t, working.bodies, 1)
+	assert.Empty(t, failing.bodies)
+
+	failing.err = nil
+	now = now.Add(30 * time.Second)
+	r.run(ctx)
+	assert.Empty(t, failing.bodies, "retried only after the backoff")
+
+	replace(t, store, "api.example.com", "new.example.com", "later.example.com")
+	now = now.Add(time.Minute)
+	r.run(ctx)
+	require.Len(t, failing.bodies, 1)
+	assert.Equal(t, working.bodies[0], failing.bodies[0], "the failed digest is resent as is")
+	assert.Len(t, working.bodies, 1, "the notifiers that succeeded are not sent it again")
+
+	now = now.Add(time.Hour)
+	r.run(ctx)
+	assert.Len(t, failing.bodies, 1, "nothing left to retry")
+}
+
+func TestNewRunnerRejectsInvalidSchedule(t *testing.T) {
+	store, c := newFixture(t)
+	cfg := config.DigestConfig{
+		Portals: []config.DigestPortalConfig{{Portal: "main", Schedule: "weekly", Notifiers: []string{"sre"}}},
+	}
+	_, err := NewRunner(store, c, map[string]Notifier{"sre": &recordingNotifier{}}, nil, cfg)
+	require.Error(t, err)
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+)
+
+// AnnotationsLabelKey is the endpoint label carrying the exposed annotations
+// of the origin resource through the pipeline, encoded by FormatAnnotations.
+const AnnotationsLabelKey = "sreportal.io/annotations"
+
+// SelectAnnotations returns the annotations whose key is listed in keys.
+// Returns nil when none is present.
+func SelectAnnotations(annotations map[string]string, keys []string) map[string]string {
+	var out map[string]string
+	for _, k := range keys {
+		v, ok := annotations[k]
+		if !ok {
+			continue
+		}
+		if out == nil {
+			out = make(map[string]string, len(keys))
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// FormatAnnotations encodes annotations as a JSON object with sorted keys.
+// Returns "" when annotations is empty.
+func FormatAnnotations(annotations map[string]string) string {
+	if len(annotations) == 0 {
+		return ""
+	}
+	b, err := json.Marshal(annotations)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// ParseAnnotations decodes a FormatAnnotations value. Returns nil when s is
+// empty or malformed.
+func ParseAnnotations(s string) map[string]string {
+	if s == "" {
+		return nil
+	}
+	var out map[string]string
+	if err := json.Unmarshal([]byte(s), &out); err != nil || len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// WithoutAnnotations returns a FQDNReader serving the views of r without the
+// origin annotations listed in keys. It keeps annotations collected for an
+// internal consumer, such as the CMDB export, out of the API. Returns r
+// unchanged when keys is empty.
+func WithoutAnnotations(r FQDNReader, keys []string) FQDNReader {
+	if len(keys) == 0 {
+		return r
+	}
+	return withoutAnnotations{FQDNReader: r, keys: keys}
+}
+
+type withoutAnnotations struct {
+	FQDNReader
+	keys []string
+}
+
+func (r withoutAnnotations) List(ctx context.Context, filters FQDNFilters) ([]FQDNView, error) {
+	views, err := r.FQDNReader.List(ctx, filters)
+	for i := range views {
+		views[i].Annotations = r.strip(views[i].Annotations)
+	}
+	return views, err
+}
+
+func (r withoutAnnotations) Get(ctx context.Context, name, recordType string) (FQDNView, error) {
+	view, err := r.FQDNReader.Get(ctx, name, recordType)
+	view.Annotations = r.strip(view.Annotations)
+	return view, err
+}
+
+// strip returns a copy of annotations without r.keys, nil when none is left.
+func (r withoutAnnotations) strip(annotations map[string]string) map[string]string {
+	var out map[string]string
+	for k, v := range annotations {
+		if slices.Contains(r.keys, k) {
+			continue
+		}
+		if out == nil {
+			out = make(map[string]string, len(annotations))
+		}
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+func TestSelectAnnotations_KeepsAllowlistedKeys(t *testing.T) {
+	anns := map[string]string{"example.com/owner": "team-a", "example.com/cost-center": "42", "other": "x"}
+	assert.Equal(t, map[string]string{"example.com/owner": "team-a", "example.com/cost-center": "42"},
+		dns.SelectAnnotations(anns, []string{"example.com/owner", "example.com/cost-center", "missing"}))
+	assert.Nil(t, dns.SelectAnnotations(anns, []string{"missing"}))
+	assert.Nil(t, dns.SelectAnnotations(anns, nil))
+}
+
+func TestParseAnnotations_RoundTrip(t *testing.T) {
+	anns := map[string]string{"example.com/owner": "team-a, team-b", "b": ""}
+	assert.Equal(t, anns, dns.ParseAnnotations(dns.FormatAnnotations(anns)))
+	assert.Empty(t, dns.FormatAnnotations(nil))
+	assert.Nil(t, dns.ParseAnnotations(""))
+	assert.Nil(t, dns.ParseAnnotations("not json"))
+}
+
+// staticReader serves a fixed list of views.
+type staticReader struct {
+	dns.FQDNReader
+	views []dns.FQDNView
+}
+
+func (r staticReader) List(context.Context, dns.FQDNFilters) ([]dns.FQDNView, error) {
+	return append([]dns.FQDNView(nil), r.views...), nil
+}
+
+func (r staticReader) Get(context.Context, string, string) (dns.FQDNView, error) {
+	return r.views[0], nil
+}
+
+func TestWithoutAnnotations_StripsKeys(t *testing.T) {
+	anns := map[string]string{"example.com/owner": "team-a", "cmdb/tier": "gold"}
+	r := dns.WithoutAnnotations(staticReader{views: []dns.FQDNView{
+		{Name: "a.example.com", Annotations: anns},
+		{Name: "b.example.com", Annotations: map[string]string{"cmdb/tier": "silver"}},
+	}}, []string{"cmdb/tier"})
+
+	views, err := r.List(context.Background(), dns.FQDNFilters{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"example.com/owner": "team-a"}, views[0].Annotations)
+	assert.Nil(t, views[1].Annotations)
+	assert.Equal(t, "gold", anns["cmdb/tier"], "the source views are not modified")
+
+	view, err := r.Get(context.Background(), "a.example.com", "A")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"example.com/owner": "team-a"}, view.Annotations)
+}
@@ -4,3 +4,14 @@ import "errors"
 
 // ErrFQDNNotFound is returned when a requested FQDN does not exist in the store.
 var ErrFQDNNotFound = errors.New("fqdn not found")
+
+// ErrInvalidRecordNameTemplate is returned when a DNSRecord naming template
+// cannot be parsed or does not render a valid object name.
+var ErrInvalidRecordNameTemplate = errors.New("invalid DNSRecord name template")
+
+// ErrInvalidProbe is returned when a probe specification cannot be parsed.
+var ErrInvalidProbe = errors.New("invalid probe: expected <type>:<port>[/path], e.g. tcp:5432 or https:443/healthz")
+
+// ErrInvalidLastSeenWindow is returned when a LastSeen window ends before it
+// starts.
+var ErrInvalidLastSeenWindow = errors.New("invalid last seen window: after must be before before")
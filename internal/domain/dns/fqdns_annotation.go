@@ -0,0 +1,45 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"slices"
+	"strings"
+)
+
+// FQDNsAnnotationKey is the annotation listing hostnames (comma-separated)
+// published for the annotated resource as they are, bypassing the
+// external-dns hostname and template logic.
+const FQDNsAnnotationKey = "sreportal.io/fqdns"
+
+// ParseFQDNsAnnotation decodes a FQDNsAnnotationKey value into lowercased
+// hostnames without trailing dot, sorted and deduplicated. Returns nil when s
+// holds no hostname.
+func ParseFQDNsAnnotation(s string) []string {
+	var out []string
+	for h := range strings.SplitSeq(s, ",") {
+		h = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(h)), ".")
+		if h != "" {
+			out = append(out, h)
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	slices.Sort(out)
+	return slices.Compact(out)
+}
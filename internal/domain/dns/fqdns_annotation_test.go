@@ -0,0 +1,37 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+func TestParseFQDNsAnnotation_NormalizesHostnames(t *testing.T) {
+	assert.Equal(t,
+		[]string{"api.example.com", "www.example.com"},
+		dns.ParseFQDNsAnnotation(" WWW.example.com., api.example.com,,www.example.com "),
+	)
+}
+
+func TestParseFQDNsAnnotation_Empty(t *testing.T) {
+	assert.Nil(t, dns.ParseFQDNsAnnotation(""))
+	assert.Nil(t, dns.ParseFQDNsAnnotation(" , "))
+}
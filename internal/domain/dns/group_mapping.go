@@ -16,7 +16,11 @@ limitations under the License.
 
 package dns
 
-import "strings"
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
 
 // GroupsAnnotationKey is the protocol annotation used to assign an endpoint to one or
 // more groups. Multiple groups are expressed as a comma-separated list.
@@ -27,7 +31,7 @@ const GroupsAnnotationKey = "sreportal.io/groups"
 // and namespace. Rules are evaluated in priority order:
 //
 //  1. sreportal.io/groups annotation — comma-separated, yields multiple groups
-//  2. Configured LabelKey label — yields a single group
+//  2. Configured LabelKey label, then LabelKeys in order — yields a single group
 //  3. ByNamespace mapping — yields a single group
 //  4. DefaultGroup fallback — yields a single group
 //
@@ -38,10 +42,52 @@ type GroupMappingStrategy struct {
 	DefaultGroup string
 	// LabelKey is the endpoint label key whose value is used as the group name.
 	LabelKey string
+	// LabelKeys are further label keys tried in order after LabelKey; the
+	// first one present with a non-empty transformed value wins.
+	LabelKeys []GroupLabelKey
 	// ByNamespace maps a Kubernetes namespace to a group name.
 	ByNamespace map[string]string
 }
 
+// GroupLabelKey is a label key used for grouping, with the transforms applied
+// to its value before it becomes a group name.
+type GroupLabelKey struct {
+	// Key is the endpoint label key.
+	Key string
+	// StripPrefix is removed from the start of the value when present
+	// (e.g. "team-" turns "team-payments" into "payments").
+	StripPrefix string
+	// TitleCase turns the value into space-separated capitalized words
+	// (e.g. "payments_api" into "Payments Api").
+	TitleCase bool
+}
+
+// Group returns the group name k derives from labels, or "" when the label is
+// absent or its transformed value is empty.
+func (k GroupLabelKey) Group(labels map[string]string) string {
+	val := labels[k.Key]
+	if k.StripPrefix != "" {
+		val = strings.TrimPrefix(val, k.StripPrefix)
+	}
+	if k.TitleCase {
+		val = titleCase(val)
+	}
+	return strings.TrimSpace(val)
+}
+
+// titleCase splits s on '-', '_' and whitespace and joins the words back with
+// spaces, each starting with an upper-case letter.
+func titleCase(s string) string {
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return r == '-' || r == '_' || unicode.IsSpace(r)
+	})
+	for i, w := range words {
+		r, size := utf8.DecodeRuneInString(w)
+		words[i] = string(unicode.ToUpper(r)) + w[size:]
+	}
+	return strings.Join(words, " ")
+}
+
 // SplitGroups parses a comma-separated sreportal.io/groups value into trimmed,
 // non-empty group names. Returns nil when the input is empty or whitespace-only.
 func SplitGroups(csv string) []string {
@@ -69,12 +115,17 @@ func (s GroupMappingStrategy) Resolve(labels map[string]string, namespace string
 		return groups
 	}
 
-	// 2. Configured label key.
+	// 2. Configured label keys, the legacy LabelKey first.
 	if s.LabelKey != "" {
 		if val := labels[s.LabelKey]; val != "" {
 			return []string{val}
 		}
 	}
+	for _, k := range s.LabelKeys {
+		if group := k.Group(labels); group != "" {
+			return []string{group}
+		}
+	}
 
 	// 3. Namespace mapping.
 	if namespace != "" && len(s.ByNamespace) > 0 {
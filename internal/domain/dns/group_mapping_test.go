@@ -150,6 +150,51 @@ func TestGroupMappingStrategy_Resolve(t *testing.T) {
 			namespace: nsProd,
 			want:      []string{"Prod"},
 		},
+		{
+			name: "first present label key wins",
+			strategy: dns.GroupMappingStrategy{DefaultGroup: groupDefault, LabelKeys: []dns.GroupLabelKey{
+				{Key: "team"}, {Key: "owner"},
+			}},
+			labels:    map[string]string{"owner": "Owners", "team": "Team"},
+			namespace: nsProd,
+			want:      []string{"Team"},
+		},
+		{
+			name: "later label key used when earlier ones are absent",
+			strategy: dns.GroupMappingStrategy{DefaultGroup: groupDefault, LabelKeys: []dns.GroupLabelKey{
+				{Key: "team"}, {Key: "owner"},
+			}},
+			labels:    map[string]string{"owner": "Owners"},
+			namespace: nsProd,
+			want:      []string{"Owners"},
+		},
+		{
+			name: "legacy label key takes priority over label keys",
+			strategy: dns.GroupMappingStrategy{DefaultGroup: groupDefault, LabelKey: labelKeyGroup, LabelKeys: []dns.GroupLabelKey{
+				{Key: "team"},
+			}},
+			labels:    map[string]string{"team": "Team", labelKeyGroup: groupFromLabel},
+			namespace: "",
+			want:      []string{groupFromLabel},
+		},
+		{
+			name: "prefix stripped and title-cased",
+			strategy: dns.GroupMappingStrategy{DefaultGroup: groupDefault, LabelKeys: []dns.GroupLabelKey{
+				{Key: "team", StripPrefix: "team-", TitleCase: true},
+			}},
+			labels:    map[string]string{"team": "team-payments_api"},
+			namespace: "",
+			want:      []string{"Payments Api"},
+		},
+		{
+			name: "value empty after prefix strip falls through",
+			strategy: dns.GroupMappingStrategy{DefaultGroup: groupDefault, ByNamespace: map[string]string{nsProd: "Prod"}, LabelKeys: []dns.GroupLabelKey{
+				{Key: "team", StripPrefix: "team-"},
+			}},
+			labels:    map[string]string{"team": "team-"},
+			namespace: nsProd,
+			want:      []string{"Prod"},
+		},
 	}
 
 	for _, tc := range cases {
@@ -0,0 +1,105 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"sort"
+	"strings"
+)
+
+// DefaultGroupSeparator splits a group name into its hierarchy levels, e.g.
+// "Platform/Networking" is the "Networking" child of "Platform".
+const DefaultGroupSeparator = "/"
+
+// GroupNode is a level of the group hierarchy.
+type GroupNode struct {
+	Name      string // last segment of Path
+	Path      string // full group name, segments joined by the separator
+	FQDNCount int    // distinct FQDNs in the group or any of its descendants
+	Children  []GroupNode
+}
+
+// SplitGroup returns the hierarchy levels of a group name. Segments are
+// trimmed and empty ones dropped, so "Platform / Networking" and
+// "Platform//Networking" both give [Platform Networking]. An empty separator
+// keeps the name flat.
+func SplitGroup(name, separator string) []string {
+	if separator == "" {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return nil
+		}
+		return []string{name}
+	}
+	var out []string
+	for _, seg := range strings.Split(name, separator) {
+		if seg = strings.TrimSpace(seg); seg != "" {
+			out = append(out, seg)
+		}
+	}
+	return out
+}
+
+// BuildGroupTree returns the group hierarchy of views, sorted by name at every
+// level. An FQDN listed in several groups of the same subtree is counted once
+// in each common ancestor.
+func BuildGroupTree(views []FQDNView, separator string) []GroupNode {
+	type node struct {
+		name, path string
+		fqdns      map[int]struct{}
+		children   map[string]*node
+	}
+	root := &node{children: map[string]*node{}}
+
+	for i, v := range views {
+		for _, g := range v.Groups {
+			cur := root
+			for _, seg := range SplitGroup(g, separator) {
+				child, ok := cur.children[seg]
+				if !ok {
+					path := seg
+					if cur.path != "" {
+						path = cur.path + separator + seg
+					}
+					child = &node{name: seg, path: path, fqdns: map[int]struct{}{}, children: map[string]*node{}}
+					cur.children[seg] = child
+				}
+				child.fqdns[i] = struct{}{}
+				cur = child
+			}
+		}
+	}
+
+	var build func(n *node) []GroupNode
+	build = func(n *node) []GroupNode {
+		if len(n.children) == 0 {
+			return nil
+		}
+		out := make([]GroupNode, 0, len(n.children))
+		for _, c := range n.children {
+			out = append(out, GroupNode{
+				Name:      c.name,
+				Path:      c.path,
+				FQDNCount: len(c.fqdns),
+				Children:  build(c),
+			})
+		}
+		sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+		return out
+	}
+	return build(root)
+}
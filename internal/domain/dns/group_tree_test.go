@@ -0,0 +1,71 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitGroup(t *testing.T) {
+	tests := []struct {
+		name      string
+		group     string
+		separator string
+		want      []string
+	}{
+		{"flat", "Platform", "/", []string{"Platform"}},
+		{"nested", "Platform/Networking", "/", []string{"Platform", "Networking"}},
+		{"spaces and empty segments", " Platform / /Networking/", "/", []string{"Platform", "Networking"}},
+		{"custom separator", "Platform::Networking", "::", []string{"Platform", "Networking"}},
+		{"no separator", "Platform/Networking", "", []string{"Platform/Networking"}},
+		{"empty", " ", "/", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SplitGroup(tt.group, tt.separator))
+		})
+	}
+}
+
+func TestBuildGroupTree(t *testing.T) {
+	views := []FQDNView{
+		{Name: "lb.example.com", Groups: []string{"Platform/Networking"}},
+		{Name: "dns.example.com", Groups: []string{"Platform/Networking", "Platform/DNS"}},
+		{Name: "grafana.example.com", Groups: []string{"Platform"}},
+		{Name: "shop.example.com", Groups: []string{"Apps"}},
+	}
+
+	got := BuildGroupTree(views, "/")
+
+	assert.Equal(t, []GroupNode{
+		{Name: "Apps", Path: "Apps", FQDNCount: 1},
+		{Name: "Platform", Path: "Platform", FQDNCount: 3, Children: []GroupNode{
+			{Name: "DNS", Path: "Platform/DNS", FQDNCount: 1},
+			{Name: "Networking", Path: "Platform/Networking", FQDNCount: 2},
+		}},
+	}, got)
+}
+
+func TestBuildGroupTree_NoSeparatorIsFlat(t *testing.T) {
+	views := []FQDNView{{Name: "lb.example.com", Groups: []string{"Platform/Networking"}}}
+
+	assert.Equal(t, []GroupNode{
+		{Name: "Platform/Networking", Path: "Platform/Networking", FQDNCount: 1},
+	}, BuildGroupTree(views, ""))
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"time"
+)
+
+// LookupFailure classifies why a DNS lookup did not return an answer. An
+// NXDOMAIN means the record is missing; the other classes point at the DNS
+// servers rather than at the record.
+type LookupFailure string
+
+const (
+	// LookupFailureNone means the lookup answered.
+	LookupFailureNone LookupFailure = ""
+	// LookupFailureNXDomain means the name does not exist (or has no address).
+	LookupFailureNXDomain LookupFailure = "nxdomain"
+	// LookupFailureServFail means the server failed to answer (SERVFAIL),
+	// usually an upstream DNS issue.
+	LookupFailureServFail LookupFailure = "servfail"
+	// LookupFailureTimeout means no answer came back in time.
+	LookupFailureTimeout LookupFailure = "timeout"
+	// LookupFailureRefused means the server refused the query or the
+	// connection.
+	LookupFailureRefused LookupFailure = "refused"
+	// LookupFailureError is any other lookup error.
+	LookupFailureError LookupFailure = "error"
+)
+
+// Transient reports whether a lookup failing this way is worth retrying:
+// SERVFAIL and timeouts are usually short-lived upstream issues.
+func (f LookupFailure) Transient() bool {
+	return f == LookupFailureServFail || f == LookupFailureTimeout
+}
+
+// ClassifyLookupError returns the failure class of a lookup error. It
+// understands the *net.DNSError returned by net.Resolver, whose SERVFAIL
+// answers are temporary "server misbehaving" errors while REFUSED and the
+// other error codes are permanent ones.
+func ClassifyLookupError(err error) LookupFailure {
+	if err == nil {
+		return LookupFailureNone
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return LookupFailureTimeout
+	}
+	var dnsErr *net.DNSError
+	if !errors.As(err, &dnsErr) {
+		return LookupFailureError
+	}
+	msg := strings.ToLower(dnsErr.Err)
+	switch {
+	case dnsErr.IsNotFound:
+		return LookupFailureNXDomain
+	case dnsErr.IsTimeout:
+		return LookupFailureTimeout
+	case strings.Contains(msg, "refused"):
+		return LookupFailureRefused
+	case strings.Contains(msg, "servfail"), strings.Contains(msg, "serverfailure"):
+		return LookupFailureServFail
+	case strings.Contains(msg, "server misbehaving"):
+		if dnsErr.IsTemporary {
+			return LookupFailureServFail
+		}
+		return LookupFailureRefused
+	default:
+		return LookupFailureError
+	}
+}
+
+// RetryPolicy is how many times a check is attempted when its lookups fail
+// transiently, and how long to wait between attempts.
+type RetryPolicy struct {
+	Attempts int
+	Backoff  time.Duration
+}
+
+// RetryPolicyFor returns the retry policy of a record type. A/AAAA checks
+// query both address families, so a hiccup on either fails the lookup: they
+// get the most attempts. CNAME checks are a single query. Existence-only
+// checks (other types, manual entries) are informative and retried once.
+func RetryPolicyFor(recordType string) RetryPolicy {
+	switch strings.ToUpper(recordType) {
+	case "A", "AAAA":
+		return RetryPolicy{Attempts: 3, Backoff: 200 * time.Millisecond}
+	case "CNAME":
+		return RetryPolicy{Attempts: 2, Backoff: 200 * time.Millisecond}
+	default:
+		return RetryPolicy{Attempts: 2, Backoff: 500 * time.Millisecond}
+	}
+}
+
+// CheckFQDNWithRetry runs CheckFQDN under the retry policy of recordType,
+// retrying while the lookup fails transiently. Each attempt is bounded by
+// attemptTimeout. The last result is returned.
+func CheckFQDNWithRetry(ctx context.Context, r Resolver, fqdn, recordType string, targets []string, attemptTimeout time.Duration) *CheckResult {
+	policy := RetryPolicyFor(recordType)
+	var res *CheckResult
+	for attempt := range max(policy.Attempts, 1) {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return res
+			case <-time.After(policy.Backoff):
+			}
+		}
+		lc, cancel := context.WithTimeout(ctx, attemptTimeout)
+		res = CheckFQDN(lc, r, fqdn, recordType, targets)
+		cancel()
+		if !res.Failure.Transient() {
+			return res
+		}
+	}
+	return res
+}
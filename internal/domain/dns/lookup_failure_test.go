@@ -0,0 +1,124 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golgoth31/sreportal/internal/domain/dns"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyLookupError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want dns.LookupFailure
+	}{
+		{name: "nil", err: nil, want: dns.LookupFailureNone},
+		{name: "nxdomain", err: &net.DNSError{Err: "no such host", IsNotFound: true}, want: dns.LookupFailureNXDomain},
+		{name: "timeout", err: &net.DNSError{Err: "i/o timeout", IsTimeout: true}, want: dns.LookupFailureTimeout},
+		{name: "deadline exceeded", err: fmt.Errorf("lookup: %w", context.DeadlineExceeded), want: dns.LookupFailureTimeout},
+		{name: "servfail", err: &net.DNSError{Err: "server misbehaving", IsTemporary: true}, want: dns.LookupFailureServFail},
+		{name: "refused", err: &net.DNSError{Err: "server misbehaving"}, want: dns.LookupFailureRefused},
+		{name: "connection refused", err: &net.DNSError{Err: "read udp: connection refused"}, want: dns.LookupFailureRefused},
+		{name: "other dns error", err: &net.DNSError{Err: "cannot unmarshal DNS message"}, want: dns.LookupFailureError},
+		{name: "non dns error", err: errors.New("boom"), want: dns.LookupFailureError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, dns.ClassifyLookupError(tt.err))
+		})
+	}
+}
+
+func TestLookupFailure_Transient(t *testing.T) {
+	assert.True(t, dns.LookupFailureServFail.Transient())
+	assert.True(t, dns.LookupFailureTimeout.Transient())
+	assert.False(t, dns.LookupFailureNXDomain.Transient())
+	assert.False(t, dns.LookupFailureRefused.Transient())
+	assert.False(t, dns.LookupFailureNone.Transient())
+}
+
+func TestRetryPolicyFor(t *testing.T) {
+	assert.Equal(t, 3, dns.RetryPolicyFor("A").Attempts)
+	assert.Equal(t, 3, dns.RetryPolicyFor("aaaa").Attempts)
+	assert.Equal(t, 2, dns.RetryPolicyFor("CNAME").Attempts)
+	assert.Equal(t, 2, dns.RetryPolicyFor("TXT").Attempts)
+}
+
+// countingResolver fails its lookups with errs in order, then answers addrs
+// (LookupHost) or the first of addrs (LookupCNAME).
+type countingResolver struct {
+	errs  []error
+	addrs []string
+	calls int
+}
+
+func (r *countingResolver) LookupHost(context.Context, string) ([]string, error) {
+	r.calls++
+	if r.calls <= len(r.errs) {
+		return nil, r.errs[r.calls-1]
+	}
+	return r.addrs, nil
+}
+
+func (r *countingResolver) LookupCNAME(ctx context.Context, fqdn string) (string, error) {
+	addrs, err := r.LookupHost(ctx, fqdn)
+	if err != nil || len(addrs) == 0 {
+		return "", err
+	}
+	return addrs[0], nil
+}
+
+func TestCheckFQDNWithRetry_RetriesTransientFailures(t *testing.T) {
+	servfail := &net.DNSError{Err: "server misbehaving", IsTemporary: true}
+	r := &countingResolver{errs: []error{servfail}, addrs: []string{"1.2.3.4"}}
+
+	res := dns.CheckFQDNWithRetry(context.Background(), r, "a.example.com", "A", []string{"1.2.3.4"}, time.Second)
+
+	assert.Equal(t, dns.SyncStatusSync, res.Status)
+	assert.Equal(t, dns.LookupFailureNone, res.Failure)
+	assert.Equal(t, 2, r.calls)
+}
+
+func TestCheckFQDNWithRetry_DoesNotRetryNXDomain(t *testing.T) {
+	nx := &net.DNSError{Err: "no such host", IsNotFound: true}
+	r := &countingResolver{errs: []error{nx, nx, nx}}
+
+	res := dns.CheckFQDNWithRetry(context.Background(), r, "a.example.com", "A", []string{"1.2.3.4"}, time.Second)
+
+	assert.Equal(t, dns.SyncStatusNotAvailable, res.Status)
+	assert.Equal(t, dns.LookupFailureNXDomain, res.Failure)
+	assert.Equal(t, 1, r.calls)
+}
+
+func TestCheckFQDNWithRetry_KeepsLastTransientFailure(t *testing.T) {
+	servfail := &net.DNSError{Err: "server misbehaving", IsTemporary: true}
+	r := &countingResolver{errs: []error{servfail, servfail}}
+
+	res := dns.CheckFQDNWithRetry(context.Background(), r, "a.example.com", "CNAME", nil, time.Second)
+
+	assert.Equal(t, dns.SyncStatusNotAvailable, res.Status)
+	assert.Equal(t, dns.LookupFailureServFail, res.Failure)
+	assert.Equal(t, 2, r.calls)
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"strings"
+	"time"
+)
+
+// OriginCauseWindow is how far back the Events of an origin resource are
+// considered when explaining why its FQDN does not resolve.
+const OriginCauseWindow = time.Hour
+
+// maxOriginCauseLen bounds the cause summary, which is shown inline in the UI.
+const maxOriginCauseLen = 200
+
+// OriginEvent is a Warning Kubernetes Event of the origin resource of an FQDN.
+type OriginEvent struct {
+	Reason   string
+	Message  string
+	LastSeen time.Time
+}
+
+// OriginState is what is observed on the origin resource of an FQDN that does
+// not resolve, used to explain the failure.
+type OriginState struct {
+	// Missing is set when the origin resource no longer exists.
+	Missing bool
+	// NoLoadBalancer is set when the resource waits for a load balancer
+	// address (LoadBalancer Service or Ingress with an empty status).
+	NoLoadBalancer bool
+	// Events are the recent Warning Events of the resource, in any order.
+	Events []OriginEvent
+}
+
+// SummarizeOriginCause returns a short cause summary of ref's state, such as
+// "ingress has no load balancer address; SyncLoadBalancerFailed: ...", using
+// the most recent Event seen within OriginCauseWindow of now. It returns ""
+// when nothing explains the failure.
+func SummarizeOriginCause(ref ResourceRef, state OriginState, now time.Time) string {
+	kind := strings.ToLower(ref.Kind())
+	if state.Missing {
+		return kind + " " + ref.Namespace() + "/" + ref.Name() + " no longer exists"
+	}
+
+	var parts []string
+	if state.NoLoadBalancer {
+		parts = append(parts, kind+" has no load balancer address")
+	}
+	var latest *OriginEvent
+	for i := range state.Events {
+		e := &state.Events[i]
+		if now.Sub(e.LastSeen) > OriginCauseWindow {
+			continue
+		}
+		if latest == nil || e.LastSeen.After(latest.LastSeen) {
+			latest = e
+		}
+	}
+	if latest != nil {
+		parts = append(parts, latest.Reason+": "+strings.Join(strings.Fields(latest.Message), " "))
+	}
+
+	cause := strings.Join(parts, "; ")
+	if r := []rune(cause); len(r) > maxOriginCauseLen {
+		cause = string(r[:maxOriginCauseLen-1]) + "…"
+	}
+	return cause
+}
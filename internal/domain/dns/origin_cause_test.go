@@ -0,0 +1,74 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+func TestSummarizeOriginCause(t *testing.T) {
+	ref, err := dns.ParseResourceRef("ingress/" + nsProd + "/web")
+	require.NoError(t, err)
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		state dns.OriginState
+		want  string
+	}{
+		{name: "nothing observed", state: dns.OriginState{}, want: ""},
+		{name: "missing", state: dns.OriginState{Missing: true, NoLoadBalancer: true}, want: "ingress prod/web no longer exists"},
+		{name: "no load balancer", state: dns.OriginState{NoLoadBalancer: true}, want: "ingress has no load balancer address"},
+		{
+			name: "latest recent event",
+			state: dns.OriginState{NoLoadBalancer: true, Events: []dns.OriginEvent{
+				{Reason: "Old", Message: "too old", LastSeen: now.Add(-2 * time.Hour)},
+				{Reason: "Sync", Message: "synced", LastSeen: now.Add(-30 * time.Minute)},
+				{Reason: "SyncLoadBalancerFailed", Message: "quota\n exceeded", LastSeen: now.Add(-time.Minute)},
+			}},
+			want: "ingress has no load balancer address; SyncLoadBalancerFailed: quota exceeded",
+		},
+		{
+			name:  "only stale events",
+			state: dns.OriginState{Events: []dns.OriginEvent{{Reason: "Old", LastSeen: now.Add(-2 * time.Hour)}}},
+			want:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, dns.SummarizeOriginCause(ref, tt.state, now))
+		})
+	}
+}
+
+func TestSummarizeOriginCause_Truncates(t *testing.T) {
+	ref, err := dns.ParseResourceRef(resourceService + "/" + nsProd + "/api")
+	require.NoError(t, err)
+	now := time.Now()
+	state := dns.OriginState{Events: []dns.OriginEvent{{Reason: "Failed", Message: strings.Repeat("é", 500), LastSeen: now}}}
+
+	cause := dns.SummarizeOriginCause(ref, state, now)
+	assert.Len(t, []rune(cause), 200)
+	assert.True(t, strings.HasSuffix(cause, "…"))
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import "time"
+
+// CertExpiryWarning is how long before the expiry of its certificate an FQDN
+// turns to warning.
+const CertExpiryWarning = 14 * 24 * time.Hour
+
+// OverallStatus is the single health badge of an FQDN, combining its DNS sync
+// status and the outcome of its connection probe.
+type OverallStatus string
+
+const (
+	// OverallStatusUnknown means no signal is available: the FQDN is neither
+	// resolved nor probed.
+	OverallStatusUnknown OverallStatus = "unknown"
+	// OverallStatusHealthy means every available signal is good.
+	OverallStatusHealthy OverallStatus = "healthy"
+	// OverallStatusWarning means the FQDN is served, but not as expected.
+	OverallStatusWarning OverallStatus = "warning"
+	// OverallStatusCritical means the FQDN is not served.
+	OverallStatusCritical OverallStatus = "critical"
+	// OverallStatusRemoved marks the tombstone of an FQDN that disappeared
+	// from its sources. It is never computed, only set by the read store.
+	OverallStatusRemoved OverallStatus = "removed"
+)
+
+// ComputeOverallStatus returns the overall status of v. The first matching
+// rule wins:
+//
+//  1. critical: the probe failed, or the FQDN does not resolve (notavailable),
+//     or the certificate seen by the last HTTPS probe has expired;
+//  2. warning: the FQDN resolves to other targets (notsync), or resolves
+//     differently, or not as expected, through the cluster or the external
+//     resolver (split-horizon drift), or its targets oscillate between sets
+//     (ownership conflict), or the probe reached it degraded (HTTP error
+//     status or invalid TLS certificate), or its certificate expires within
+//     CertExpiryWarning;
+//  3. healthy: the FQDN is in sync or the probe succeeded;
+//  4. unknown: otherwise.
+func ComputeOverallStatus(v *FQDNView) OverallStatus {
+	sync := SyncStatus(v.SyncStatus)
+	internal := SyncStatus(v.InternalSyncStatus)
+	external := SyncStatus(v.ExternalSyncStatus)
+	certLeft := time.Until(v.TLSNotAfter)
+
+	switch {
+	case Availability(v.Availability) == AvailabilityDown, sync == SyncStatusNotAvailable,
+		!v.TLSNotAfter.IsZero() && certLeft <= 0:
+		return OverallStatusCritical
+	case sync == SyncStatusNotSync,
+		internal != external,
+		internal != "" && internal != SyncStatusSync,
+		v.OwnershipConflict != nil,
+		HealthStatus(v.HealthStatus) == HealthStatusDegraded,
+		!v.TLSNotAfter.IsZero() && certLeft < CertExpiryWarning:
+		return OverallStatusWarning
+	case sync == SyncStatusSync, Availability(v.Availability) == AvailabilityUp:
+		return OverallStatusHealthy
+	default:
+		return OverallStatusUnknown
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeOverallStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		view FQDNView
+		want OverallStatus
+	}{
+		{"no signal", FQDNView{}, OverallStatusUnknown},
+		{"in sync", FQDNView{SyncStatus: "sync"}, OverallStatusHealthy},
+		{"probe up only", FQDNView{Availability: "up"}, OverallStatusHealthy},
+		{"in sync and probe up", FQDNView{SyncStatus: "sync", Availability: "up"}, OverallStatusHealthy},
+		{"other targets", FQDNView{SyncStatus: "notsync", Availability: "up"}, OverallStatusWarning},
+		{"probe degraded", FQDNView{SyncStatus: "sync", Availability: "up", HealthStatus: "degraded"}, OverallStatusWarning},
+		{
+			"split-horizon drift",
+			FQDNView{SyncStatus: "sync", InternalSyncStatus: "sync", ExternalSyncStatus: "notsync"},
+			OverallStatusWarning,
+		},
+		{
+			"split-horizon both wrong",
+			FQDNView{SyncStatus: "sync", InternalSyncStatus: "notsync", ExternalSyncStatus: "notsync"},
+			OverallStatusWarning,
+		},
+		{
+			"ownership conflict",
+			FQDNView{SyncStatus: "sync", OwnershipConflict: &OwnershipConflict{TargetSets: [][]string{{"10.0.0.1"}, {"10.0.0.2"}}}},
+			OverallStatusWarning,
+		},
+		{"not resolvable", FQDNView{SyncStatus: "notavailable", Availability: "up"}, OverallStatusCritical},
+		{"probe down wins over sync", FQDNView{SyncStatus: "sync", Availability: "down"}, OverallStatusCritical},
+		{"probe down wins over drift", FQDNView{SyncStatus: "notsync", Availability: "down"}, OverallStatusCritical},
+		{"certificate valid", FQDNView{SyncStatus: "sync", TLSNotAfter: time.Now().Add(60 * 24 * time.Hour)}, OverallStatusHealthy},
+		{"certificate expiring", FQDNView{SyncStatus: "sync", TLSNotAfter: time.Now().Add(24 * time.Hour)}, OverallStatusWarning},
+		{"certificate expired", FQDNView{SyncStatus: "sync", TLSNotAfter: time.Now().Add(-time.Hour)}, OverallStatusCritical},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ComputeOverallStatus(&tt.view))
+		})
+	}
+}
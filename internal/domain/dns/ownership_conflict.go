@@ -0,0 +1,96 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"slices"
+	"time"
+)
+
+const (
+	// TargetChangeWindow is how long a target change counts towards an
+	// ownership conflict.
+	TargetChangeWindow = time.Hour
+	// maxTargetChanges bounds the target changes kept per endpoint.
+	maxTargetChanges = 6
+	// minTargetReturns is how many times the targets must go back to the set
+	// they held two changes earlier for an ownership conflict: A→B→A→B.
+	minTargetReturns = 2
+)
+
+// TargetChange is a target set an endpoint switched to.
+type TargetChange struct {
+	Targets []string // sorted
+	At      time.Time
+}
+
+// OwnershipConflict reports the target sets an FQDN alternates between.
+type OwnershipConflict struct {
+	TargetSets [][]string
+	DetectedAt time.Time
+}
+
+// ObserveTargets returns history with targets observed at now: a new change
+// is appended when they differ from the latest one, changes older than
+// TargetChangeWindow are dropped (the latest one is always kept) and at most
+// the last few changes are retained. history is not modified.
+func ObserveTargets(history []TargetChange, targets []string, now time.Time) []TargetChange {
+	sorted := slices.Sorted(slices.Values(targets))
+	out := make([]TargetChange, 0, len(history)+1)
+	for _, c := range history {
+		if now.Sub(c.At) <= TargetChangeWindow {
+			out = append(out, c)
+		}
+	}
+	switch {
+	case len(history) > 0 && slices.Equal(history[len(history)-1].Targets, sorted):
+		if len(out) == 0 || !slices.Equal(out[len(out)-1].Targets, sorted) {
+			out = append(out, history[len(history)-1])
+		}
+	default:
+		out = append(out, TargetChange{Targets: sorted, At: now})
+	}
+	if len(out) > maxTargetChanges {
+		out = out[len(out)-maxTargetChanges:]
+	}
+	return out
+}
+
+// AlternatingTargets returns the distinct target sets history oscillates
+// between, in the order they were first seen, or nil when the targets did
+// not go back to an earlier set often enough for an ownership conflict.
+// Two external-dns deployments managing the same record produce this
+// pattern, each one rewriting the targets of the other.
+func AlternatingTargets(history []TargetChange) [][]string {
+	returns := 0
+	var sets [][]string
+	for i := 2; i < len(history); i++ {
+		if !slices.Equal(history[i].Targets, history[i-2].Targets) {
+			continue
+		}
+		returns++
+		for _, c := range history[i-2 : i] {
+			if !slices.ContainsFunc(sets, func(s []string) bool { return slices.Equal(s, c.Targets) }) {
+				sets = append(sets, c.Targets)
+			}
+		}
+	}
+	if returns < minTargetReturns {
+		return nil
+	}
+	return sets
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+func TestObserveTargets(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	history := dns.ObserveTargets(nil, []string{ip2, ip1}, t0)
+	require.Equal(t, []dns.TargetChange{{Targets: []string{ip1, ip2}, At: t0}}, history)
+
+	// Same set in another order: no change recorded.
+	same := dns.ObserveTargets(history, []string{ip1, ip2}, t0.Add(time.Minute))
+	assert.Equal(t, history, same)
+
+	changed := dns.ObserveTargets(history, []string{ip1}, t0.Add(2*time.Minute))
+	require.Len(t, changed, 2)
+	assert.Equal(t, []string{ip1}, changed[1].Targets)
+	assert.Len(t, history, 1, "input history must not be modified")
+
+	// Changes past the window are dropped, the latest one is kept.
+	late := dns.ObserveTargets(changed, []string{ip1}, t0.Add(2*time.Minute+2*dns.TargetChangeWindow))
+	assert.Equal(t, changed[1:], late)
+}
+
+func TestObserveTargets_BoundsHistory(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var history []dns.TargetChange
+	for i := range 20 {
+		history = dns.ObserveTargets(history, []string{[]string{ip1, ip2, "10.0.0.3"}[i%3]}, t0.Add(time.Duration(i)*time.Second))
+	}
+	assert.Len(t, history, 6)
+	assert.Equal(t, t0.Add(19*time.Second), history[len(history)-1].At)
+}
+
+func TestAlternatingTargets(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	observe := func(sets ...string) []dns.TargetChange {
+		var history []dns.TargetChange
+		for i, s := range sets {
+			history = dns.ObserveTargets(history, []string{s}, t0.Add(time.Duration(i)*time.Minute))
+		}
+		return history
+	}
+
+	tests := []struct {
+		name    string
+		history []dns.TargetChange
+		want    [][]string
+	}{
+		{name: "stable", history: observe(ip1, ip1, ip1), want: nil},
+		{name: "single rollback", history: observe(ip1, ip2, ip1), want: nil},
+		{name: "rollout", history: observe(ip1, ip2, "10.0.0.3", "10.0.0.4"), want: nil},
+		{name: "flapping", history: observe(ip1, ip2, ip1, ip2), want: [][]string{{ip1}, {ip2}}},
+		{name: "flapping after a rollout", history: observe("10.0.0.3", ip1, ip2, ip1, ip2, ip1), want: [][]string{{ip1}, {ip2}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, dns.AlternatingTargets(tt.history))
+		})
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"slices"
+	"strings"
+)
+
+// PathsLabelKey is the endpoint label carrying the HTTP route paths served
+// under a hostname through the pipeline, encoded by FormatPaths.
+const PathsLabelKey = "sreportal.io/paths"
+
+// NormalizePaths returns the non-empty paths sorted and deduplicated. Paths
+// containing a comma cannot be encoded in PathsLabelKey and are dropped.
+// Returns nil when no path remains.
+func NormalizePaths(paths []string) []string {
+	out := make([]string, 0, len(paths))
+	for _, p := range paths {
+		if p = strings.TrimSpace(p); p != "" && !strings.Contains(p, ",") {
+			out = append(out, p)
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	slices.Sort(out)
+	return slices.Compact(out)
+}
+
+// FormatPaths encodes paths as a sorted, comma-separated list
+// (e.g. "/,/api,/static"). Returns "" when no path remains after
+// normalisation.
+func FormatPaths(paths []string) string {
+	return strings.Join(NormalizePaths(paths), ",")
+}
+
+// ParsePaths decodes a FormatPaths value. Returns nil when s holds no path.
+func ParsePaths(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return NormalizePaths(strings.Split(s, ","))
+}
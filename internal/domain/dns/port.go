@@ -0,0 +1,79 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"strconv"
+	"strings"
+)
+
+// PortsLabelKey is the endpoint label carrying the ports exposed by the source
+// Service through the pipeline, encoded by FormatPorts.
+const PortsLabelKey = "sreportal.io/ports"
+
+// ServicePort is a port exposed by the Service an FQDN originates from.
+type ServicePort struct {
+	// Name is the Service port name (or appProtocol when the port is unnamed),
+	// typically the application protocol: "https", "grpc", ...
+	Name     string
+	Port     int32
+	Protocol string // TCP, UDP or SCTP
+}
+
+// String renders the port for display: "https 443", or "443/UDP" when unnamed.
+func (p ServicePort) String() string {
+	port := strconv.Itoa(int(p.Port))
+	if p.Name != "" {
+		return p.Name + " " + port
+	}
+	if p.Protocol != "" {
+		return port + "/" + p.Protocol
+	}
+	return port
+}
+
+// FormatPorts encodes ports as a comma-separated list of name:port/protocol
+// (e.g. "https:443/TCP,grpc:8443/TCP"). Returns "" when ports is empty.
+func FormatPorts(ports []ServicePort) string {
+	parts := make([]string, 0, len(ports))
+	for _, p := range ports {
+		parts = append(parts, p.Name+":"+strconv.Itoa(int(p.Port))+"/"+p.Protocol)
+	}
+	return strings.Join(parts, ",")
+}
+
+// ParsePorts decodes a FormatPorts value. Malformed elements are skipped;
+// returns nil when no port could be parsed.
+func ParsePorts(s string) []ServicePort {
+	if s == "" {
+		return nil
+	}
+	var ports []ServicePort
+	for part := range strings.SplitSeq(s, ",") {
+		name, rest, ok := strings.Cut(strings.TrimSpace(part), ":")
+		if !ok {
+			continue
+		}
+		portStr, protocol, _ := strings.Cut(rest, "/")
+		port, err := strconv.ParseInt(portStr, 10, 32)
+		if err != nil || port <= 0 {
+			continue
+		}
+		ports = append(ports, ServicePort{Name: name, Port: int32(port), Protocol: protocol})
+	}
+	return ports
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+func TestFormatParsePorts_RoundTrip(t *testing.T) {
+	ports := []dns.ServicePort{
+		{Name: "https", Port: 443, Protocol: "TCP"},
+		{Port: 53, Protocol: "UDP"},
+	}
+
+	encoded := dns.FormatPorts(ports)
+
+	assert.Equal(t, "https:443/TCP,:53/UDP", encoded)
+	assert.Equal(t, ports, dns.ParsePorts(encoded))
+}
+
+func TestParsePorts_SkipsMalformed(t *testing.T) {
+	assert.Nil(t, dns.ParsePorts(""))
+	assert.Nil(t, dns.ParsePorts("https,grpc:abc/TCP,:0/TCP"))
+	assert.Equal(t,
+		[]dns.ServicePort{{Name: "grpc", Port: 8443, Protocol: "TCP"}},
+		dns.ParsePorts("bogus, grpc:8443/TCP"))
+}
+
+func TestServicePort_String(t *testing.T) {
+	assert.Equal(t, "https 443", dns.ServicePort{Name: "https", Port: 443, Protocol: "TCP"}.String())
+	assert.Equal(t, "53/UDP", dns.ServicePort{Port: 53, Protocol: "UDP"}.String())
+	assert.Equal(t, "8080", dns.ServicePort{Port: 8080}.String())
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ProbeAnnotationKey is the annotation (carried as an endpoint label through the
+// pipeline) selecting the connection probe of an FQDN, e.g. "tcp:5432" or
+// "https:443/healthz".
+const ProbeAnnotationKey = "sreportal.io/probe"
+
+// ProbeType is the kind of connection probe run against an FQDN.
+type ProbeType string
+
+const (
+	// ProbeTypeTCP opens a TCP connection to the FQDN on the probe port.
+	ProbeTypeTCP ProbeType = "tcp"
+	// ProbeTypeHTTP sends a GET request to the probe port and path.
+	ProbeTypeHTTP ProbeType = "http"
+	// ProbeTypeHTTPS sends a GET request over TLS and records whether the
+	// certificate is valid for the FQDN.
+	ProbeTypeHTTPS ProbeType = "https"
+)
+
+// Availability is the outcome of the last connection probe of an FQDN.
+type Availability string
+
+const (
+	// AvailabilityUp indicates the last probe succeeded.
+	AvailabilityUp Availability = "up"
+	// AvailabilityDown indicates the last probe failed.
+	AvailabilityDown Availability = "down"
+)
+
+// HealthStatus summarises the outcome of the last probe of an FQDN.
+type HealthStatus string
+
+const (
+	// HealthStatusHealthy indicates the FQDN answered as expected.
+	HealthStatusHealthy HealthStatus = "healthy"
+	// HealthStatusDegraded indicates the FQDN answered, but with an HTTP error
+	// status or an invalid TLS certificate.
+	HealthStatusDegraded HealthStatus = "degraded"
+	// HealthStatusUnhealthy indicates the FQDN could not be reached.
+	HealthStatusUnhealthy HealthStatus = "unhealthy"
+)
+
+// Probe describes how an FQDN is probed.
+type Probe struct {
+	Type ProbeType
+	Port int32
+	// Path is the request path of HTTP(S) probes, "/" when empty.
+	Path string
+}
+
+// IsHTTP reports whether the probe sends an HTTP request.
+func (p Probe) IsHTTP() bool {
+	return p.Type == ProbeTypeHTTP || p.Type == ProbeTypeHTTPS
+}
+
+// String renders the probe in its annotation form ("tcp:5432",
+// "https:443/healthz").
+func (p Probe) String() string {
+	return string(p.Type) + ":" + strconv.Itoa(int(p.Port)) + p.Path
+}
+
+// ParseProbe decodes a probe specification such as "tcp:5432" or
+// "https:443/healthz". Only HTTP(S) probes accept a path.
+func ParseProbe(s string) (Probe, error) {
+	typ, rest, ok := strings.Cut(strings.TrimSpace(s), ":")
+	if !ok {
+		return Probe{}, fmt.Errorf("%q: %w", s, ErrInvalidProbe)
+	}
+	portStr, path := rest, ""
+	if i := strings.IndexByte(rest, '/'); i >= 0 {
+		portStr, path = rest[:i], rest[i:]
+	}
+	port, err := strconv.ParseInt(portStr, 10, 32)
+	if err != nil || port < 1 || port > 65535 {
+		return Probe{}, fmt.Errorf("%q: %w", s, ErrInvalidProbe)
+	}
+	switch t := ProbeType(strings.ToLower(typ)); t {
+	case ProbeTypeTCP:
+		if path != "" {
+			return Probe{}, fmt.Errorf("%q: tcp probes take no path: %w", s, ErrInvalidProbe)
+		}
+		return Probe{Type: t, Port: int32(port)}, nil
+	case ProbeTypeHTTP, ProbeTypeHTTPS:
+		return Probe{Type: t, Port: int32(port), Path: path}, nil
+	default:
+		return Probe{}, fmt.Errorf("%q: unsupported type %q: %w", s, typ, ErrInvalidProbe)
+	}
+}
+
+// ProbeResult is what a successful probe observed. StatusCode is only set by
+// HTTP(S) probes, TLSValid and TLSNotAfter by HTTPS ones.
+type ProbeResult struct {
+	Latency     time.Duration
+	StatusCode  int
+	TLSValid    *bool
+	TLSNotAfter time.Time
+}
+
+// Health classifies the result of a probe that reached the host: an HTTP
+// status of 400 or more, or an invalid certificate, is degraded.
+func (r ProbeResult) Health() HealthStatus {
+	if r.StatusCode >= 400 || (r.TLSValid != nil && !*r.TLSValid) {
+		return HealthStatusDegraded
+	}
+	return HealthStatusHealthy
+}
+
+// Prober runs a probe against a host, returning an error when it is
+// unreachable.
+type Prober interface {
+	Probe(ctx context.Context, host string, p Probe) (ProbeResult, error)
+}
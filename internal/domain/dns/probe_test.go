@@ -0,0 +1,62 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+func TestParseProbe(t *testing.T) {
+	p, err := dns.ParseProbe("tcp:5432")
+	require.NoError(t, err)
+	assert.Equal(t, dns.Probe{Type: dns.ProbeTypeTCP, Port: 5432}, p)
+	assert.Equal(t, "tcp:5432", p.String())
+
+	p, err = dns.ParseProbe(" TCP:9092 ")
+	require.NoError(t, err)
+	assert.Equal(t, dns.Probe{Type: dns.ProbeTypeTCP, Port: 9092}, p)
+
+	p, err = dns.ParseProbe("https:443/healthz")
+	require.NoError(t, err)
+	assert.Equal(t, dns.Probe{Type: dns.ProbeTypeHTTPS, Port: 443, Path: "/healthz"}, p)
+	assert.Equal(t, "https:443/healthz", p.String())
+	assert.True(t, p.IsHTTP())
+
+	p, err = dns.ParseProbe("http:8080")
+	require.NoError(t, err)
+	assert.Equal(t, dns.Probe{Type: dns.ProbeTypeHTTP, Port: 8080}, p)
+}
+
+func TestParseProbe_Invalid(t *testing.T) {
+	for _, s := range []string{"", "tcp", "tcp:", "tcp:0", "tcp:70000", "tcp:abc", "udp:53", "tcp:5432/x", "https:/healthz"} {
+		_, err := dns.ParseProbe(s)
+		assert.ErrorIs(t, err, dns.ErrInvalidProbe, s)
+	}
+}
+
+func TestProbeResult_Health(t *testing.T) {
+	valid, invalid := true, false
+	assert.Equal(t, dns.HealthStatusHealthy, dns.ProbeResult{}.Health())
+	assert.Equal(t, dns.HealthStatusHealthy, dns.ProbeResult{StatusCode: 302, TLSValid: &valid}.Health())
+	assert.Equal(t, dns.HealthStatusDegraded, dns.ProbeResult{StatusCode: 503}.Health())
+	assert.Equal(t, dns.HealthStatusDegraded, dns.ProbeResult{StatusCode: 200, TLSValid: &invalid}.Health())
+}
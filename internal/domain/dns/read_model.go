@@ -1,22 +1,74 @@
 package dns
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 // FQDNView is the read-side projection of an FQDN, pre-aggregated by controllers.
 // Unlike FQDN (write model), it carries portal context and group membership.
 type FQDNView struct {
-	Name        string
-	Source      Source
-	SourceType  string // external-dns source type (e.g. "service", "ingress", "dnsendpoint")
-	Groups      []string
-	Description string
-	RecordType  string
-	Targets     []string
-	LastSeen    time.Time
-	Portals     []string // multiple portals possible after dedup
-	Namespace   string   // DNS CR namespace
-	OriginRef   *ResourceRef
-	SyncStatus  string
+	ID                 string // StableID of the primary contributor's portal, Name and RecordType, set on aggregation
+	Name               string
+	Source             Source
+	SourceType         string // external-dns source type (e.g. "service", "ingress", "dnsendpoint")
+	Groups             []string
+	Description        string
+	RecordType         string
+	Targets            []string
+	LastSeen           time.Time
+	Portals            []string // multiple portals possible after dedup
+	Namespace          string   // DNS CR namespace
+	OriginRef          *ResourceRef
+	SyncStatus         string
+	InternalSyncStatus string             // SyncStatus via the cluster resolver (split-horizon resolution only)
+	ExternalSyncStatus string             // SyncStatus via the external resolver (split-horizon resolution only)
+	LookupFailure      string             // failure class of the last DNS check (see LookupFailure), empty when it answered
+	Availability       string             // outcome of the last connection probe ("up", "down"), empty when not probed
+	HealthStatus       string             // health of the last probe (see HealthStatus), empty when not probed
+	LastProbeTime      time.Time          // when the last probe ran, zero when not probed
+	HTTPStatusCode     int                // status answered to the last HTTP(S) probe, 0 otherwise
+	ProbeLatency       time.Duration      // duration of the last successful probe
+	TLSValid           *bool              // certificate validity seen by the last HTTPS probe, nil otherwise
+	TLSNotAfter        time.Time          // expiry of the certificate seen by the last HTTPS probe, zero otherwise
+	OriginCause        string             // cause summary from the origin resource (see SummarizeOriginCause), set while SyncStatus is notavailable
+	TargetScope        TargetScope        // most exposed scope among Targets, computed on aggregation
+	TargetProviders    []TargetProvider   // providers of the public Targets, computed on aggregation when enabled (see LookupTargetProvider)
+	OverallStatus      OverallStatus      // health badge, computed on aggregation (see ComputeOverallStatus)
+	Ports              []ServicePort      // ports of the source Service (Service origins only)
+	Paths              []string           // HTTP route paths served under Name (Ingress/VirtualService origins only)
+	DNSRecord          *RecordRef         // DNSRecord the view is taken from (the primary contributor after dedup)
+	ShadowedManual     *RecordRef         // manual DNSRecord whose entry duplicates this discovered FQDN and is overridden by it
+	OwnershipConflict  *OwnershipConflict // set while the targets oscillate between sets (see AlternatingTargets)
+	LastReconciled     time.Time          // last reconcile time of DNSRecord, zero when unknown
+	Annotations        map[string]string  // exposed annotations of the origin resource (dnsRecord.exposedAnnotations)
+	RemovedAt          time.Time          // when the FQDN disappeared from every DNSRecord, zero while it is live (tombstones only)
+	RemovalReason      RemovalReason      // why the FQDN disappeared (tombstones only)
+}
+
+// RemovalReason explains why an FQDN disappeared from every DNSRecord.
+type RemovalReason string
+
+const (
+	// RemovalReasonRecordDeleted means the DNSRecord listing the FQDN was
+	// deleted, e.g. with its DNS resource or portal, or when its source kind
+	// was disabled.
+	RemovalReasonRecordDeleted RemovalReason = "record_deleted"
+	// RemovalReasonNoLongerReported means the DNSRecord still exists but no
+	// longer lists the FQDN: its source stopped reporting it, e.g. the origin
+	// resource was deleted or filtered out, or the entry was removed.
+	RemovalReasonNoLongerReported RemovalReason = "no_longer_reported"
+)
+
+// RecordRef identifies a DNSRecord.
+type RecordRef struct {
+	Namespace string
+	Name      string
+}
+
+// String returns the "namespace/name" form of the reference.
+func (r RecordRef) String() string {
+	return r.Namespace + "/" + r.Name
 }
 
 // FirstPortal returns the first portal in the view, or "" if none.
@@ -35,4 +87,61 @@ type FQDNFilters struct {
 	Namespace string
 	Source    string
 	Search    string // substring match on Name (case-insensitive)
+	// TargetScope keeps only FQDNs with this aggregated scope (empty for all)
+	TargetScope TargetScope
+	// IncludeRemoved also returns the tombstones of FQDNs that recently
+	// disappeared (OverallStatusRemoved, RemovedAt set)
+	IncludeRemoved bool
+	// LastSeen keeps only FQDNs last seen within the window (zero for all)
+	LastSeen LastSeenWindow
+}
+
+// LastSeenWindow bounds the LastSeen time of FQDNs, to find recently active
+// records (After) or stale ones (Before). A zero bound is open.
+type LastSeenWindow struct {
+	After  time.Time // inclusive
+	Before time.Time // exclusive
+}
+
+// IsZero reports whether neither bound is set.
+func (w LastSeenWindow) IsZero() bool {
+	return w.After.IsZero() && w.Before.IsZero()
+}
+
+// Validate returns ErrInvalidLastSeenWindow when both bounds are set and the
+// window is empty.
+func (w LastSeenWindow) Validate() error {
+	if !w.After.IsZero() && !w.Before.IsZero() && !w.After.Before(w.Before) {
+		return ErrInvalidLastSeenWindow
+	}
+	return nil
+}
+
+// Contains reports whether lastSeen falls within the window. An unknown
+// (zero) lastSeen is only within an open window.
+func (w LastSeenWindow) Contains(lastSeen time.Time) bool {
+	if w.IsZero() {
+		return true
+	}
+	if lastSeen.IsZero() {
+		return false
+	}
+	return (w.After.IsZero() || !lastSeen.Before(w.After)) &&
+		(w.Before.IsZero() || lastSeen.Before(w.Before))
+}
+
+// ParseLastSeenBound parses a LastSeenWindow bound: an RFC 3339 timestamp, or
+// a duration back from now ("24h"). An empty value is an open bound.
+func ParseLastSeenBound(raw string, now time.Time) (time.Time, error) {
+	if raw == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return t, nil
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil || d < 0 {
+		return time.Time{}, fmt.Errorf("%q is neither an RFC 3339 timestamp nor a positive duration", raw)
+	}
+	return now.Add(-d), nil
 }
@@ -0,0 +1,60 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+func TestLastSeenWindow(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	w := dns.LastSeenWindow{After: now.Add(-time.Hour), Before: now}
+
+	require.NoError(t, w.Validate())
+	assert.True(t, w.Contains(now.Add(-time.Hour)), "after is inclusive")
+	assert.False(t, w.Contains(now), "before is exclusive")
+	assert.False(t, w.Contains(time.Time{}), "unknown last seen")
+	assert.True(t, dns.LastSeenWindow{}.Contains(time.Time{}), "open window")
+	assert.ErrorIs(t, dns.LastSeenWindow{After: now, Before: now}.Validate(), dns.ErrInvalidLastSeenWindow)
+}
+
+func TestParseLastSeenBound(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	got, err := dns.ParseLastSeenBound("", now)
+	require.NoError(t, err)
+	assert.True(t, got.IsZero())
+
+	got, err = dns.ParseLastSeenBound("2026-04-01T00:00:00Z", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), got)
+
+	got, err = dns.ParseLastSeenBound("24h", now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(-24*time.Hour), got)
+
+	_, err = dns.ParseLastSeenBound("-1h", now)
+	require.Error(t, err)
+	_, err = dns.ParseLastSeenBound("yesterday", now)
+	require.Error(t, err)
+}
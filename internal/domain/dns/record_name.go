@@ -0,0 +1,118 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// DefaultRecordNameTemplate reproduces the historical "<dns>-<sourceType>"
+// DNSRecord naming.
+const DefaultRecordNameTemplate = "{{ .DNS }}-{{ .SourceType }}"
+
+// recordNameHashLen is the number of hex characters of the name digest kept
+// when a rendered name is truncated.
+const recordNameHashLen = 8
+
+// RecordNameData is the data available to a DNSRecord naming template.
+type RecordNameData struct {
+	// DNS is the name of the owning DNS CR.
+	DNS string
+	// Portal is the portal the DNS CR belongs to.
+	Portal string
+	// Namespace is the namespace of the DNS CR.
+	Namespace string
+	// SourceType is the source kind the DNSRecord aggregates (e.g. "service").
+	SourceType string
+}
+
+// RecordNamer renders the names of auto-generated DNSRecords. Names longer
+// than the Kubernetes object name limit are truncated and suffixed with a hash
+// of the full rendered name, so distinct long names stay distinct.
+type RecordNamer struct {
+	tmpl *template.Template
+}
+
+// NewRecordNamer parses text as a Go template over RecordNameData. An empty
+// text selects DefaultRecordNameTemplate. The template is rendered with
+// sample data so unknown fields, templates yielding invalid names and
+// templates ignoring .SourceType (whose DNSRecords of different sources would
+// overwrite each other) are rejected at startup rather than on the first
+// reconcile.
+func NewRecordNamer(text string) (*RecordNamer, error) {
+	if strings.TrimSpace(text) == "" {
+		text = DefaultRecordNameTemplate
+	}
+	tmpl, err := template.New("dnsrecord-name").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidRecordNameTemplate, err)
+	}
+	n := &RecordNamer{tmpl: tmpl}
+	sample := RecordNameData{DNS: "dns", Portal: "portal", Namespace: "default", SourceType: "service"}
+	first, err := n.Name(sample)
+	if err != nil {
+		return nil, err
+	}
+	sample.SourceType = "ingress"
+	second, err := n.Name(sample)
+	if err != nil {
+		return nil, err
+	}
+	if first == second {
+		return nil, fmt.Errorf("%w: the name must depend on .SourceType", ErrInvalidRecordNameTemplate)
+	}
+	return n, nil
+}
+
+// Name renders the DNSRecord name for d. A nil RecordNamer uses
+// DefaultRecordNameTemplate.
+func (n *RecordNamer) Name(d RecordNameData) (string, error) {
+	var name string
+	if n == nil {
+		name = d.DNS + "-" + d.SourceType
+	} else {
+		var b strings.Builder
+		if err := n.tmpl.Execute(&b, d); err != nil {
+			return "", fmt.Errorf("%w: %w", ErrInvalidRecordNameTemplate, err)
+		}
+		name = strings.ToLower(strings.TrimSpace(b.String()))
+	}
+	name = truncateRecordName(name)
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return "", fmt.Errorf("%w: rendered name %q: %s", ErrInvalidRecordNameTemplate, name, strings.Join(errs, "; "))
+	}
+	return name, nil
+}
+
+// truncateRecordName shortens name to the DNS-1123 subdomain limit, replacing
+// the tail with "-<hash>" where hash is derived from the full name. Trailing
+// separators left by the cut are trimmed so the result stays valid.
+func truncateRecordName(name string) string {
+	if len(name) <= validation.DNS1123SubdomainMaxLength {
+		return name
+	}
+	sum := sha256.Sum256([]byte(name))
+	suffix := "-" + hex.EncodeToString(sum[:])[:recordNameHashLen]
+	head := strings.TrimRight(name[:validation.DNS1123SubdomainMaxLength-len(suffix)], "-.")
+	return head + suffix
+}
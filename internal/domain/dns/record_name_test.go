@@ -0,0 +1,70 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+func TestRecordNamer_DefaultMatchesHistoricalNaming(t *testing.T) {
+	n, err := dns.NewRecordNamer("")
+	require.NoError(t, err)
+	name, err := n.Name(dns.RecordNameData{DNS: "main", Portal: "main", SourceType: "service"})
+	require.NoError(t, err)
+	assert.Equal(t, "main-service", name)
+
+	var nilNamer *dns.RecordNamer
+	name, err = nilNamer.Name(dns.RecordNameData{DNS: "main", SourceType: "ingress"})
+	require.NoError(t, err)
+	assert.Equal(t, "main-ingress", name)
+}
+
+func TestRecordNamer_CustomTemplate(t *testing.T) {
+	n, err := dns.NewRecordNamer("sreportal-{{ .Portal }}-{{ .SourceType }}")
+	require.NoError(t, err)
+	name, err := n.Name(dns.RecordNameData{DNS: "d", Portal: "Team-A", SourceType: "gateway-httproute"})
+	require.NoError(t, err)
+	assert.Equal(t, "sreportal-team-a-gateway-httproute", name)
+}
+
+func TestNewRecordNamer_RejectsInvalidTemplates(t *testing.T) {
+	for _, tmpl := range []string{"{{ .DNS", "{{ .Unknown }}", "{{ .DNS }}_{{ .SourceType }}", "{{ .DNS }}-{{ .Portal }}"} {
+		_, err := dns.NewRecordNamer(tmpl)
+		assert.ErrorIs(t, err, dns.ErrInvalidRecordNameTemplate, tmpl)
+	}
+}
+
+func TestRecordNamer_TruncatesLongNamesWithHash(t *testing.T) {
+	n, err := dns.NewRecordNamer("")
+	require.NoError(t, err)
+	long := strings.Repeat("a", 250)
+
+	a, err := n.Name(dns.RecordNameData{DNS: long, SourceType: "service"})
+	require.NoError(t, err)
+	b, err := n.Name(dns.RecordNameData{DNS: long, SourceType: "ingress"})
+	require.NoError(t, err)
+
+	assert.Len(t, a, 253)
+	assert.Len(t, b, 253)
+	assert.NotEqual(t, a, b, "distinct long names must stay distinct")
+}
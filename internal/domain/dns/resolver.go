@@ -22,18 +22,20 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/golgoth31/sreportal/pkg/inventory"
 )
 
 // SyncStatus represents the DNS resolution status of an FQDN.
-type SyncStatus string
+type SyncStatus = inventory.ResolutionStatus
 
 const (
 	// SyncStatusSync indicates the FQDN resolves to the expected type and targets.
-	SyncStatusSync SyncStatus = "sync"
+	SyncStatusSync = inventory.ResolutionSync
 	// SyncStatusNotAvailable indicates the FQDN does not exist in DNS.
-	SyncStatusNotAvailable SyncStatus = "notavailable"
+	SyncStatusNotAvailable = inventory.ResolutionNotAvailable
 	// SyncStatusNotSync indicates the FQDN exists but resolves to different targets.
-	SyncStatusNotSync SyncStatus = "notsync"
+	SyncStatusNotSync = inventory.ResolutionNotSync
 )
 
 // Resolver abstracts DNS lookups for testability.
@@ -47,6 +49,8 @@ type CheckResult struct {
 	Status          SyncStatus
 	ResolvedTargets []string
 	Err             error
+	// Failure classifies Err when the FQDN is not available; empty otherwise.
+	Failure LookupFailure
 }
 
 // CheckFQDN verifies whether an FQDN resolves correctly in DNS.
@@ -67,7 +71,7 @@ func CheckFQDN(ctx context.Context, r Resolver, fqdn, recordType string, targets
 func checkHostRecord(ctx context.Context, r Resolver, fqdn string, expectedTargets []string) *CheckResult {
 	addrs, err := r.LookupHost(ctx, fqdn)
 	if err != nil {
-		return &CheckResult{Status: SyncStatusNotAvailable, Err: err}
+		return notAvailable(err)
 	}
 
 	if targetsMatch(expectedTargets, addrs) {
@@ -80,7 +84,7 @@ func checkHostRecord(ctx context.Context, r Resolver, fqdn string, expectedTarge
 func checkCNAMERecord(ctx context.Context, r Resolver, fqdn string, expectedTargets []string) *CheckResult {
 	cname, err := r.LookupCNAME(ctx, fqdn)
 	if err != nil {
-		return &CheckResult{Status: SyncStatusNotAvailable, Err: err}
+		return notAvailable(err)
 	}
 
 	// net.LookupCNAME always returns a fully-qualified name with a trailing dot
@@ -96,13 +100,21 @@ func checkCNAMERecord(ctx context.Context, r Resolver, fqdn string, expectedTarg
 
 func checkExistence(ctx context.Context, r Resolver, fqdn string) *CheckResult {
 	addrs, err := r.LookupHost(ctx, fqdn)
-	if err != nil || len(addrs) == 0 {
-		return &CheckResult{Status: SyncStatusNotAvailable, Err: err}
+	if err != nil {
+		return notAvailable(err)
+	}
+	if len(addrs) == 0 {
+		return &CheckResult{Status: SyncStatusNotAvailable, Failure: LookupFailureNXDomain}
 	}
 
 	return &CheckResult{Status: SyncStatusSync, ResolvedTargets: addrs}
 }
 
+// notAvailable returns the result of a failed lookup, with its failure class.
+func notAvailable(err error) *CheckResult {
+	return &CheckResult{Status: SyncStatusNotAvailable, Err: err, Failure: ClassifyLookupError(err)}
+}
+
 // targetsMatch compares two string slices after sorting and normalizing.
 func targetsMatch(expected, actual []string) bool {
 	if len(expected) != len(actual) {
@@ -69,5 +69,8 @@ func (r ResourceRef) Namespace() string { return r.namespace }
 // Name returns the Kubernetes resource name.
 func (r ResourceRef) Name() string { return r.name }
 
+// String returns the "kind/namespace/name" form of the reference.
+func (r ResourceRef) String() string { return r.kind + "/" + r.namespace + "/" + r.name }
+
 // IsZero reports whether the ResourceRef is the zero value (unparsed).
 func (r ResourceRef) IsZero() bool { return r.kind == "" }
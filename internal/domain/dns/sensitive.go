@@ -0,0 +1,74 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import "strings"
+
+// SensitivePolicy flags FQDNs whose name matches an operator-configured
+// pattern (admin consoles, dashboards, ...). A nil policy flags nothing.
+//
+// A pattern ending with "." (e.g. "grafana.") matches any FQDN having a label
+// starting with it ("grafana.example.com", "eu.grafana.example.com"). Any other
+// pattern matches as a case-insensitive substring of the FQDN.
+type SensitivePolicy struct {
+	patterns []string
+	// HideFromAnonymous removes sensitive FQDNs from unauthenticated reads.
+	HideFromAnonymous bool
+}
+
+// NewSensitivePolicy creates a SensitivePolicy from patterns. Empty patterns
+// are ignored; it returns nil when no pattern remains.
+func NewSensitivePolicy(patterns []string, hideFromAnonymous bool) *SensitivePolicy {
+	p := &SensitivePolicy{HideFromAnonymous: hideFromAnonymous}
+	for _, pattern := range patterns {
+		if pattern = strings.ToLower(strings.TrimSpace(pattern)); pattern != "" {
+			p.patterns = append(p.patterns, pattern)
+		}
+	}
+	if len(p.patterns) == 0 {
+		return nil
+	}
+	return p
+}
+
+// IsSensitive reports whether name matches one of the policy patterns.
+func (p *SensitivePolicy) IsSensitive(name string) bool {
+	if p == nil {
+		return false
+	}
+	name = strings.ToLower(name)
+	for _, pattern := range p.patterns {
+		if strings.HasSuffix(pattern, ".") {
+			if strings.HasPrefix(name, pattern) || strings.Contains(name, "."+pattern) {
+				return true
+			}
+			continue
+		}
+		if strings.Contains(name, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// Visible reports whether the FQDN name may be returned to a caller.
+func (p *SensitivePolicy) Visible(name string, authenticated bool) bool {
+	if p == nil || authenticated || !p.HideFromAnonymous {
+		return true
+	}
+	return !p.IsSensitive(name)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+func TestSensitivePolicy_IsSensitive(t *testing.T) {
+	p := dns.NewSensitivePolicy([]string{"admin.", " Grafana. ", "kibana", ""}, false)
+
+	assert.True(t, p.IsSensitive("admin.example.com"))
+	assert.True(t, p.IsSensitive("eu.grafana.example.com"))
+	assert.True(t, p.IsSensitive("GRAFANA.example.com"))
+	assert.True(t, p.IsSensitive("logs-kibana.example.com"))
+	assert.False(t, p.IsSensitive("sysadmin.example.com"))
+	assert.False(t, p.IsSensitive("api.example.com"))
+}
+
+func TestSensitivePolicy_NilWhenNoPatterns(t *testing.T) {
+	p := dns.NewSensitivePolicy([]string{"", "  "}, true)
+
+	assert.Nil(t, p)
+	assert.False(t, p.IsSensitive("admin.example.com"))
+	assert.True(t, p.Visible("admin.example.com", false))
+}
+
+func TestSensitivePolicy_Visible(t *testing.T) {
+	shown := dns.NewSensitivePolicy([]string{"admin."}, false)
+	hidden := dns.NewSensitivePolicy([]string{"admin."}, true)
+
+	assert.True(t, shown.Visible("admin.example.com", false))
+	assert.False(t, hidden.Visible("admin.example.com", false))
+	assert.True(t, hidden.Visible("admin.example.com", true))
+	assert.True(t, hidden.Visible("api.example.com", false))
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strings"
+)
+
+// stableIDPrefix marks FQDN IDs, so they are recognisable in external tools.
+const stableIDPrefix = "fqdn-"
+
+// StableID returns the deterministic identifier of the FQDN name with
+// recordType in portal. It depends on nothing else, so it survives group
+// renames and DNS or DNSRecord renames, and lets external reconciliation
+// tools (Terraform, Crossplane) track an entry across them. The name is
+// compared case-insensitively and without its trailing dot. An FQDN exposed
+// by several portals takes the ID of the first of them in sorted order (see
+// StableIDOf).
+func StableID(portal, name, recordType string) string {
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+	sum := sha256.Sum256([]byte(portal + "\x00" + name + "\x00" + strings.ToUpper(recordType)))
+	return stableIDPrefix + hex.EncodeToString(sum[:12])
+}
+
+// StableIDOf returns the StableID of the FQDN name with recordType exposed by
+// portals, whatever their order. Returns "" when portals is empty.
+func StableIDOf(portals []string, name, recordType string) string {
+	if len(portals) == 0 {
+		return ""
+	}
+	return StableID(slices.Min(portals), name, recordType)
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+func TestStableID(t *testing.T) {
+	id := dns.StableID("main", "api.example.com", "A")
+
+	assert.Regexp(t, `^fqdn-[0-9a-f]{24}$`, id)
+	assert.Equal(t, id, dns.StableID("main", "API.example.com.", "a"), "name case, trailing dot and record type case are ignored")
+	assert.NotEqual(t, id, dns.StableID("team", "api.example.com", "A"))
+	assert.NotEqual(t, id, dns.StableID("main", "api.example.com", "AAAA"))
+	assert.NotEqual(t, id, dns.StableID("main", "web.example.com", "A"))
+}
+
+func TestStableIDOf_IgnoresPortalOrder(t *testing.T) {
+	id := dns.StableIDOf([]string{"team", "main"}, "api.example.com", "A")
+
+	assert.Equal(t, dns.StableID("main", "api.example.com", "A"), id)
+	assert.Equal(t, id, dns.StableIDOf([]string{"main", "team"}, "api.example.com", "A"))
+	assert.Empty(t, dns.StableIDOf(nil, "api.example.com", "A"))
+}
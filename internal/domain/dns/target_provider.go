@@ -0,0 +1,107 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	_ "embed"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// TargetProvider is the cloud or hosting provider announcing a public target,
+// from static ranges: a hint, not an authoritative ASN lookup.
+type TargetProvider struct {
+	// Target is the IP address.
+	Target string
+	// Provider is the short provider name, e.g. "aws", "gcp" or "cloudflare".
+	Provider string
+	// ASN is the autonomous system announcing the provider range.
+	ASN uint32
+}
+
+//go:embed target_provider_ranges.txt
+var targetProviderRanges string
+
+// providerRange is a prefix of a provider.
+type providerRange struct {
+	prefix   netip.Prefix
+	provider string
+	asn      uint32
+}
+
+// providerRanges is sorted from the most to the least specific prefix, so the
+// first match is the longest one.
+var providerRanges = mustParseProviderRanges(targetProviderRanges)
+
+// mustParseProviderRanges parses the "<provider> <ASN> <prefix>" lines of the
+// embedded ranges, skipping blank lines and comments.
+func mustParseProviderRanges(data string) []providerRange {
+	var out []providerRange
+	for line := range strings.Lines(data) {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			panic(fmt.Sprintf("target provider ranges: malformed line %q", line))
+		}
+		asn, err := strconv.ParseUint(fields[1], 10, 32)
+		if err != nil {
+			panic(fmt.Sprintf("target provider ranges: ASN of %q: %v", line, err))
+		}
+		out = append(out, providerRange{
+			prefix:   netip.MustParsePrefix(fields[2]).Masked(),
+			provider: fields[0],
+			asn:      uint32(asn),
+		})
+	}
+	slices.SortStableFunc(out, func(a, b providerRange) int {
+		return b.prefix.Bits() - a.prefix.Bits()
+	})
+	return out
+}
+
+// LookupTargetProvider returns the provider of a public IP target. Private
+// addresses, hostnames and addresses outside the known ranges have none.
+func LookupTargetProvider(target string) (TargetProvider, bool) {
+	if ClassifyTarget(target) != TargetScopePublic {
+		return TargetProvider{}, false
+	}
+	addr := netip.MustParseAddr(strings.TrimSpace(target)).Unmap()
+	for _, r := range providerRanges {
+		if r.prefix.Contains(addr) {
+			return TargetProvider{Target: target, Provider: r.provider, ASN: r.asn}, true
+		}
+	}
+	return TargetProvider{}, false
+}
+
+// TargetProviders returns the provider of each target that has one, in the
+// order of targets.
+func TargetProviders(targets []string) []TargetProvider {
+	var out []TargetProvider
+	for _, t := range targets {
+		if p, ok := LookupTargetProvider(t); ok {
+			out = append(out, p)
+		}
+	}
+	return out
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+func TestLookupTargetProvider(t *testing.T) {
+	tests := []struct {
+		target   string
+		provider string
+		asn      uint32
+	}{
+		{"52.10.20.30", "aws", 16509},
+		{"2600:1f18::1", "aws", 16509},
+		{"35.190.1.1", "gcp", 396982},
+		{"40.70.1.1", "azure", 8075},
+		{"104.16.132.229", "cloudflare", 13335},
+		{"2606:4700::6810:84e5", "cloudflare", 13335},
+		{"::ffff:163.172.1.1", "scaleway", 12876},
+		{"8.8.8.8", "", 0},
+		{ip1, "", 0},
+		{fqdnAlias, "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			got, ok := dns.LookupTargetProvider(tt.target)
+			assert.Equal(t, tt.provider != "", ok)
+			assert.Equal(t, tt.provider, got.Provider)
+			assert.Equal(t, tt.asn, got.ASN)
+		})
+	}
+}
+
+func TestTargetProviders_KeepsTargetOrder(t *testing.T) {
+	got := dns.TargetProviders([]string{"104.16.0.1", ip1, "52.0.0.1"})
+
+	assert.Equal(t, []dns.TargetProvider{
+		{Target: "104.16.0.1", Provider: "cloudflare", ASN: 13335},
+		{Target: "52.0.0.1", Provider: "aws", ASN: 16509},
+	}, got)
+	assert.Empty(t, dns.TargetProviders([]string{fqdnAlias}))
+}
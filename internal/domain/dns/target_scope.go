@@ -0,0 +1,91 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"net/netip"
+	"strings"
+)
+
+// TargetScope classifies how reachable an FQDN's targets are.
+type TargetScope string
+
+const (
+	// TargetScopeUnknown is used when no target is an IP address (e.g. CNAME).
+	TargetScopeUnknown TargetScope = ""
+	// TargetScopePublic is a globally routable address.
+	TargetScopePublic TargetScope = "public"
+	// TargetScopePrivate is an RFC1918, IPv6 unique-local or loopback address.
+	TargetScopePrivate TargetScope = "private"
+	// TargetScopeCGNAT is an RFC6598 shared address (100.64.0.0/10).
+	TargetScopeCGNAT TargetScope = "cgnat"
+	// TargetScopeLinkLocal is an IPv4 or IPv6 link-local address.
+	TargetScopeLinkLocal TargetScope = "link-local"
+)
+
+var cgnatPrefix = netip.MustParsePrefix("100.64.0.0/10")
+
+// scopeRank orders scopes from least to most exposed; the most exposed target
+// wins when aggregating an FQDN.
+var scopeRank = map[TargetScope]int{
+	TargetScopeUnknown:   0,
+	TargetScopeLinkLocal: 1,
+	TargetScopePrivate:   2,
+	TargetScopeCGNAT:     3,
+	TargetScopePublic:    4,
+}
+
+// Valid reports whether s is one of the known scopes (including unknown).
+func (s TargetScope) Valid() bool {
+	_, ok := scopeRank[s]
+	return ok
+}
+
+// ClassifyTarget returns the scope of a single target. Targets that are not
+// IP addresses (hostnames) are TargetScopeUnknown.
+func ClassifyTarget(target string) TargetScope {
+	addr, err := netip.ParseAddr(strings.TrimSpace(target))
+	if err != nil {
+		return TargetScopeUnknown
+	}
+	addr = addr.Unmap()
+
+	switch {
+	case addr.IsLinkLocalUnicast():
+		return TargetScopeLinkLocal
+	case addr.IsPrivate(), addr.IsLoopback():
+		return TargetScopePrivate
+	case cgnatPrefix.Contains(addr):
+		return TargetScopeCGNAT
+	case addr.IsGlobalUnicast():
+		return TargetScopePublic
+	default:
+		return TargetScopeUnknown
+	}
+}
+
+// ClassifyTargets returns the most exposed scope among targets, so a single
+// public address marks the whole FQDN as public.
+func ClassifyTargets(targets []string) TargetScope {
+	scope := TargetScopeUnknown
+	for _, t := range targets {
+		if s := ClassifyTarget(t); scopeRank[s] > scopeRank[scope] {
+			scope = s
+		}
+	}
+	return scope
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+func TestClassifyTarget(t *testing.T) {
+	tests := []struct {
+		target string
+		want   dns.TargetScope
+	}{
+		{ip1, dns.TargetScopePrivate},
+		{"172.16.4.2", dns.TargetScopePrivate},
+		{"192.168.1.10", dns.TargetScopePrivate},
+		{"127.0.0.1", dns.TargetScopePrivate},
+		{"fd00::1", dns.TargetScopePrivate},
+		{"100.64.1.1", dns.TargetScopeCGNAT},
+		{"169.254.169.254", dns.TargetScopeLinkLocal},
+		{"fe80::1", dns.TargetScopeLinkLocal},
+		{"8.8.8.8", dns.TargetScopePublic},
+		{"2001:4860:4860::8888", dns.TargetScopePublic},
+		{"::ffff:10.0.0.1", dns.TargetScopePrivate},
+		{"lb.example.com", dns.TargetScopeUnknown},
+		{"", dns.TargetScopeUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			assert.Equal(t, tt.want, dns.ClassifyTarget(tt.target))
+		})
+	}
+}
+
+func TestClassifyTargets_MostExposedWins(t *testing.T) {
+	assert.Equal(t, dns.TargetScopePublic, dns.ClassifyTargets([]string{ip1, "8.8.8.8"}))
+	assert.Equal(t, dns.TargetScopeCGNAT, dns.ClassifyTargets([]string{ip1, "100.64.0.1"}))
+	assert.Equal(t, dns.TargetScopePrivate, dns.ClassifyTargets([]string{"fe80::1", ip2}))
+	assert.Equal(t, dns.TargetScopeUnknown, dns.ClassifyTargets([]string{fqdnAlias}))
+	assert.Equal(t, dns.TargetScopeUnknown, dns.ClassifyTargets(nil))
+}
+
+func TestTargetScope_Valid(t *testing.T) {
+	assert.True(t, dns.TargetScopePublic.Valid())
+	assert.True(t, dns.TargetScopeUnknown.Valid())
+	assert.False(t, dns.TargetScope("internet").Valid())
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"context"
+	"errors"
+)
+
+// ttlDriftTolerance is the share of the declared TTL a served TTL may exceed
+// it by before the record is flagged as drifting.
+const ttlDriftTolerance = 10 // percent
+
+// ErrTTLUnavailable is returned by TTLResolver.LookupTTL when the resolver
+// cannot report the TTL of the record (e.g. the system resolver, which hides
+// it, or a record type it does not query).
+var ErrTTLUnavailable = errors.New("served TTL unavailable")
+
+// TTLResolver is implemented by the resolvers that can report the TTL served
+// for a record, in seconds. It is optional: drift detection is skipped for
+// resolvers that do not implement it.
+type TTLResolver interface {
+	LookupTTL(ctx context.Context, fqdn, recordType string) (uint32, error)
+}
+
+// TTLDrifted reports whether a served TTL differs significantly from the
+// declared one, i.e. exceeds it by more than 10%: clients then cache the
+// record longer than declared, which slows failovers. A lower served TTL is
+// not flagged, as a recursive resolver counts the TTL of its cached answer
+// down to zero. A declared TTL of zero or less means none is declared.
+func TTLDrifted(declared, served int64) bool {
+	if declared <= 0 {
+		return false
+	}
+	return served*100 > declared*(100+ttlDriftTolerance)
+}
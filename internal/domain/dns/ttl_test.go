@@ -0,0 +1,46 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+func TestTTLDrifted(t *testing.T) {
+	tests := []struct {
+		name     string
+		declared int64
+		served   int64
+		want     bool
+	}{
+		{name: "no declared TTL", declared: 0, served: 3600, want: false},
+		{name: "equal", declared: 300, served: 300, want: false},
+		{name: "cache countdown", declared: 300, served: 12, want: false},
+		{name: "within tolerance", declared: 300, served: 330, want: false},
+		{name: "beyond tolerance", declared: 300, served: 331, want: true},
+		{name: "much longer", declared: 60, served: 3600, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, dns.TTLDrifted(tt.declared, tt.served))
+		})
+	}
+}
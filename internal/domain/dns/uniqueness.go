@@ -0,0 +1,178 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// UniquenessSeverity ranks an FQDN uniqueness issue.
+type UniquenessSeverity string
+
+const (
+	// UniquenessSeverityHigh marks discovered sources disagreeing on the
+	// targets: the DNS answer depends on which external-dns wins.
+	UniquenessSeverityHigh UniquenessSeverity = "high"
+	// UniquenessSeverityMedium marks a manual entry disagreeing on the
+	// targets, usually left behind after the FQDN moved.
+	UniquenessSeverityMedium UniquenessSeverity = "medium"
+	// UniquenessSeverityLow marks an FQDN listed with the same targets in
+	// several portals.
+	UniquenessSeverityLow UniquenessSeverity = "low"
+)
+
+// rank orders severities, highest first.
+func (s UniquenessSeverity) rank() int {
+	switch s {
+	case UniquenessSeverityHigh:
+		return 3
+	case UniquenessSeverityMedium:
+		return 2
+	case UniquenessSeverityLow:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// FQDNContribution is the entry a single DNSRecord contributes for an
+// (FQDN, record type).
+type FQDNContribution struct {
+	Name       string
+	RecordType string
+	Record     RecordRef
+	Portal     string
+	Source     Source
+	SourceType string
+	Targets    []string
+	// Owner is the DNS CR owning the record, zero when unknown.
+	Owner RecordRef
+}
+
+// SourceLabel returns the source type of a discovered contribution, or its
+// source when it has none (manual entries).
+func (c FQDNContribution) SourceLabel() string {
+	if c.SourceType != "" {
+		return c.SourceType
+	}
+	return string(c.Source)
+}
+
+// UniquenessIssue is an (FQDN, record type) listed in several portals, or
+// whose contributing DNSRecords disagree on the targets.
+type UniquenessIssue struct {
+	Name       string
+	RecordType string
+	Severity   UniquenessSeverity
+	// Portals and Sources are sorted and distinct.
+	Portals []string
+	Sources []string
+	// Contributions are sorted by record.
+	Contributions []FQDNContribution
+}
+
+// FQDNUniquenessReader reports the FQDNs that are not unique across portals
+// and sources.
+type FQDNUniquenessReader interface {
+	// UniquenessReport returns the issues, sorted by FindUniquenessIssues.
+	UniquenessReport() []UniquenessIssue
+}
+
+// FindUniquenessIssues groups contributions by (FQDN, record type) and returns
+// those listed in more than one portal or whose targets differ, highest
+// severity first, then by name and record type.
+func FindUniquenessIssues(contributions []FQDNContribution) []UniquenessIssue {
+	type key struct{ name, recordType string }
+	byKey := map[key][]FQDNContribution{}
+	for _, c := range contributions {
+		k := key{name: c.Name, recordType: c.RecordType}
+		byKey[k] = append(byKey[k], c)
+	}
+
+	var out []UniquenessIssue
+	for k, group := range byKey {
+		if len(group) < 2 {
+			continue
+		}
+		portals := map[string]struct{}{}
+		sources := map[string]struct{}{}
+		targets := map[string]struct{}{}
+		discovered := map[string]struct{}{}
+		for _, c := range group {
+			portals[c.Portal] = struct{}{}
+			sources[c.SourceLabel()] = struct{}{}
+			fp := targetsFingerprint(c.Targets)
+			targets[fp] = struct{}{}
+			if c.Source != SourceManual {
+				discovered[fp] = struct{}{}
+			}
+		}
+
+		var severity UniquenessSeverity
+		switch {
+		case len(discovered) > 1:
+			severity = UniquenessSeverityHigh
+		case len(targets) > 1:
+			severity = UniquenessSeverityMedium
+		case len(portals) > 1:
+			severity = UniquenessSeverityLow
+		default:
+			continue
+		}
+
+		slices.SortFunc(group, func(a, b FQDNContribution) int {
+			return cmp.Compare(a.Record.String(), b.Record.String())
+		})
+		out = append(out, UniquenessIssue{
+			Name:          k.name,
+			RecordType:    k.recordType,
+			Severity:      severity,
+			Portals:       sortedSet(portals),
+			Sources:       sortedSet(sources),
+			Contributions: group,
+		})
+	}
+
+	slices.SortFunc(out, func(a, b UniquenessIssue) int {
+		if c := cmp.Compare(b.Severity.rank(), a.Severity.rank()); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.Name, b.Name); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.RecordType, b.RecordType)
+	})
+	return out
+}
+
+// targetsFingerprint returns an order-insensitive key of targets.
+func targetsFingerprint(targets []string) string {
+	sorted := slices.Clone(targets)
+	slices.Sort(sorted)
+	return strings.Join(sorted, "\x00")
+}
+
+func sortedSet(set map[string]struct{}) []string {
+	out := make([]string, 0, len(set))
+	for v := range set {
+		out = append(out, v)
+	}
+	slices.Sort(out)
+	return out
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+func contribution(name, record, portal string, source dns.Source, sourceType string, targets ...string) dns.FQDNContribution {
+	return dns.FQDNContribution{
+		Name:       name,
+		RecordType: "A",
+		Record:     dns.RecordRef{Namespace: nsProd, Name: record},
+		Portal:     portal,
+		Source:     source,
+		SourceType: sourceType,
+		Targets:    targets,
+	}
+}
+
+func TestFindUniquenessIssues(t *testing.T) {
+	issues := dns.FindUniquenessIssues([]dns.FQDNContribution{
+		// Unique FQDN: no issue.
+		contribution("solo.example.com", "a-svc", "main", dns.SourceExternalDNS, resourceService, ip1),
+		// Same FQDN and targets in one portal from two records: no issue.
+		contribution("dup.example.com", "a-svc", "main", dns.SourceExternalDNS, resourceService, ip1, ip2),
+		contribution("dup.example.com", "b-svc", "main", dns.SourceExternalDNS, resourceService, ip2, ip1),
+		// Same targets in two portals: low.
+		contribution("shared.example.com", "a-svc", "main", dns.SourceExternalDNS, resourceService, ip1),
+		contribution("shared.example.com", "team-svc", "team", dns.SourceExternalDNS, resourceService, ip1),
+		// A manual entry disagrees: medium.
+		contribution("moved.example.com", "a-svc", "main", dns.SourceExternalDNS, resourceService, ip1),
+		contribution("moved.example.com", "main-manual", "main", dns.SourceManual, "", ip2),
+		// Two discovered sources disagree: high.
+		contribution("web.example.com", "a-ing", "main", dns.SourceExternalDNS, "ingress", ip1),
+		contribution("web.example.com", "a-svc", "team", dns.SourceExternalDNS, resourceService, ip2),
+	})
+
+	require.Len(t, issues, 3)
+	assert.Equal(t, "web.example.com", issues[0].Name)
+	assert.Equal(t, dns.UniquenessSeverityHigh, issues[0].Severity)
+	assert.Equal(t, []string{"main", "team"}, issues[0].Portals)
+	assert.Equal(t, []string{"ingress", resourceService}, issues[0].Sources)
+	require.Len(t, issues[0].Contributions, 2)
+	assert.Equal(t, "a-ing", issues[0].Contributions[0].Record.Name)
+
+	assert.Equal(t, "moved.example.com", issues[1].Name)
+	assert.Equal(t, dns.UniquenessSeverityMedium, issues[1].Severity)
+	assert.Equal(t, []string{"manual", resourceService}, issues[1].Sources)
+
+	assert.Equal(t, "shared.example.com", issues[2].Name)
+	assert.Equal(t, dns.UniquenessSeverityLow, issues[2].Severity)
+}
+
+func TestFindUniquenessIssues_SeparatesRecordTypes(t *testing.T) {
+	a := contribution("web.example.com", "a-svc", "main", dns.SourceExternalDNS, resourceService, ip1)
+	aaaa := contribution("web.example.com", "b-svc", "team", dns.SourceExternalDNS, resourceService, "::1")
+	aaaa.RecordType = "AAAA"
+
+	assert.Empty(t, dns.FindUniquenessIssues([]dns.FQDNContribution{a, aaaa}))
+}
@@ -1,5 +1,7 @@
 package portal
 
+import "slices"
+
 // PortalFeatures contains the feature toggles for a portal.
 type PortalFeatures struct {
 	DNS            bool
@@ -12,16 +14,50 @@ type PortalFeatures struct {
 
 // PortalView is the read-side projection of a Portal, pre-aggregated by the controller.
 type PortalView struct {
-	Name       string
-	Title      string
-	Main       bool
-	SubPath    string
-	Namespace  string
-	Ready      bool
-	IsRemote   bool
-	URL        string          // Remote URL, empty for local portals
-	RemoteSync *RemoteSyncView // Non-nil only for remote portals with sync status
-	Features   PortalFeatures
+	Name         string
+	Title        string
+	Main         bool
+	SubPath      string
+	Namespace    string
+	Ready        bool
+	IsRemote     bool
+	URL          string          // Remote URL, empty for local portals
+	RemotePortal string          // Portal name targeted on the remote instance, empty for the remote main portal
+	RemoteSync   *RemoteSyncView // Non-nil only for remote portals with sync status
+	Features     PortalFeatures
+	Links        []PortalLink
+	Branding     *PortalBranding // Nil when the portal has no branding
+	Content      []ContentBlock  // Markdown blocks read from spec.contentRefs
+	// AllowedGroups are the OIDC groups allowed to see the portal, empty for everyone
+	AllowedGroups []string
+}
+
+// VisibleTo reports whether a user member of groups may see the portal:
+// always when AllowedGroups is empty, otherwise when groups lists one of them.
+func (v PortalView) VisibleTo(groups []string) bool {
+	if len(v.AllowedGroups) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(groups, func(g string) bool { return slices.Contains(v.AllowedGroups, g) })
+}
+
+// ContentBlock is a markdown content block read from a ConfigMap key.
+type ContentBlock struct {
+	ConfigMap string
+	Key       string
+	Markdown  string
+}
+
+// PortalLink is an external link shown in the portal menu.
+type PortalLink struct {
+	Title string
+	URL   string
+}
+
+// PortalBranding customizes how a portal is displayed.
+type PortalBranding struct {
+	LogoURL string
+	Color   string
 }
 
 // RemoteSyncView captures the last remote sync state.
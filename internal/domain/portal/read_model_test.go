@@ -0,0 +1,19 @@
+package portal_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golgoth31/sreportal/internal/domain/portal"
+)
+
+func TestPortalView_VisibleTo(t *testing.T) {
+	open := portal.PortalView{Name: "main"}
+	assert.True(t, open.VisibleTo(nil), "a portal without allowedGroups is public")
+
+	restricted := portal.PortalView{Name: "team", AllowedGroups: []string{"sre", "platform"}}
+	assert.False(t, restricted.VisibleTo(nil))
+	assert.False(t, restricted.VisibleTo([]string{"dev"}))
+	assert.True(t, restricted.VisibleTo([]string{"dev", "platform"}))
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"time"
+
+	"github.com/golgoth31/sreportal/internal/source/registry"
+)
+
+// SourceHealth is the collection health of a source kind.
+type SourceHealth struct {
+	Kind registry.SourceType
+	// Failures counts the collections that failed since the last success.
+	Failures int
+	// LastError is the error of the last failed collection, kept after a
+	// success so the last cause stays visible.
+	LastError   string
+	LastFailure time.Time
+	// LastSuccess is zero until a collection of the kind succeeds.
+	LastSuccess time.Time
+}
+
+// Failing reports whether the last collection of the kind failed.
+func (h SourceHealth) Failing() bool {
+	return h.Failures > 0
+}
+
+// SourceHealthReader is the read-side contract of the source health tracker,
+// safe for concurrent use.
+type SourceHealthReader interface {
+	// SourceHealth returns the health of kind; false when the kind has not
+	// been collected since it was enabled.
+	SourceHealth(kind registry.SourceType) (SourceHealth, bool)
+	// ListSourceHealth returns the health of every tracked kind, sorted by
+	// kind.
+	ListSourceHealth() []SourceHealth
+}
+
+// SourceHealthWriter is the write-side contract of the source health
+// tracker, used by the SourceReconciler after each collection. It is safe for
+// concurrent use, so kinds can be collected in parallel.
+type SourceHealthWriter interface {
+	// RecordSuccess resets the failures of kind and sets its last success.
+	RecordSuccess(kind registry.SourceType, at time.Time)
+	// RecordFailure counts a failed collection of kind and returns its
+	// updated health.
+	RecordFailure(kind registry.SourceType, err error, at time.Time) SourceHealth
+	// ForgetSourceHealth drops kind, when it is no longer enabled.
+	ForgetSourceHealth(kind registry.SourceType)
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package export builds a self-contained snapshot of the aggregated portal
+// state (portals, groups, FQDNs and their statuses) for offline review, e.g.
+// audits of air-gapped clusters.
+package export
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/controller/dnsrecords/chain"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	dnsreadstore "github.com/golgoth31/sreportal/internal/readstore/dns"
+	"github.com/golgoth31/sreportal/pkg/inventory"
+)
+
+// Bundle is the exported portal state.
+type Bundle struct {
+	GeneratedAt time.Time `json:"generatedAt"`
+	Version     string    `json:"version,omitempty"`
+	Portals     []Portal  `json:"portals"`
+}
+
+// Portal is a portal and the FQDNs it exposes, grouped.
+type Portal struct {
+	Name      string  `json:"name"`
+	Namespace string  `json:"namespace"`
+	Title     string  `json:"title"`
+	Main      bool    `json:"main,omitempty"`
+	Ready     bool    `json:"ready"`
+	Remote    bool    `json:"remote,omitempty"`
+	URL       string  `json:"url,omitempty"`
+	Groups    []Group `json:"groups"`
+}
+
+// FQDNCount returns the number of distinct FQDNs of the portal.
+func (p Portal) FQDNCount() int {
+	seen := make(map[string]struct{})
+	for _, g := range p.Groups {
+		for _, f := range g.FQDNs {
+			seen[f.Name+"/"+f.RecordType] = struct{}{}
+		}
+	}
+	return len(seen)
+}
+
+// Group is a named set of FQDNs.
+type Group struct {
+	Name  string `json:"name"`
+	FQDNs []FQDN `json:"fqdns"`
+}
+
+// FQDN is a DNS name with its targets and statuses.
+type FQDN struct {
+	ID                 string    `json:"id"`
+	Name               string    `json:"name"`
+	RecordType         string    `json:"recordType"`
+	Targets            []string  `json:"targets,omitempty"`
+	Source             string    `json:"source"`
+	SourceType         string    `json:"sourceType,omitempty"`
+	Description        string    `json:"description,omitempty"`
+	SyncStatus         string    `json:"syncStatus,omitempty"`
+	InternalSyncStatus string    `json:"internalSyncStatus,omitempty"`
+	ExternalSyncStatus string    `json:"externalSyncStatus,omitempty"`
+	Availability       string    `json:"availability,omitempty"`
+	TargetScope        string    `json:"targetScope,omitempty"`
+	OverallStatus      string    `json:"overallStatus,omitempty"`
+	LastSeen           time.Time `json:"lastSeen,omitzero"`
+	DNSRecord          string    `json:"dnsRecord,omitempty"`
+}
+
+// Collect reads Portals, DNS and DNSRecords from c and aggregates them the
+// way the operator's read store does: every DNSRecord is projected with the
+// group mapping of its governing DNS CR, then deduplicated across records.
+// Only FQDNs last seen within window are exported (all for a zero window).
+// Portals are keyed by namespace and name, so portals of the same name in
+// different namespaces keep their own FQDNs. Nothing is written to the cluster.
+func Collect(ctx context.Context, c client.Reader, now time.Time, window domaindns.LastSeenWindow) (*Bundle, error) {
+	if err := window.Validate(); err != nil {
+		return nil, err
+	}
+	var portals sreportalv1alpha1.PortalList
+	if err := c.List(ctx, &portals); err != nil {
+		return nil, fmt.Errorf("list portals: %w", err)
+	}
+	var dnsList v1alpha2.DNSList
+	if err := c.List(ctx, &dnsList); err != nil {
+		return nil, fmt.Errorf("list DNS: %w", err)
+	}
+	var records v1alpha2.DNSRecordList
+	if err := c.List(ctx, &records); err != nil {
+		return nil, fmt.Errorf("list DNSRecords: %w", err)
+	}
+
+	store := dnsreadstore.NewFQDNStore()
+	for i := range records.Items {
+		record := &records.Items[i]
+		dns := chain.SelectGoverningDNS(dnsList.Items, record)
+		if dns == nil {
+			// Mirrors LoadDNSConfigHandler: records without a DNS CR are
+			// not projected.
+			continue
+		}
+		views := chain.DNSRecordToFQDNViews(record, &dns.Spec.GroupMapping)
+		portalKey := record.Namespace + "/" + record.Spec.PortalRef
+		if err := store.Replace(ctx, record.Namespace+"/"+record.Name, portalKey, views); err != nil {
+			return nil, fmt.Errorf("project DNSRecord %s/%s: %w", record.Namespace, record.Name, err)
+		}
+	}
+
+	bundle := &Bundle{GeneratedAt: now.UTC(), Portals: make([]Portal, 0, len(portals.Items))}
+	for i := range portals.Items {
+		p := &portals.Items[i]
+		portal := Portal{
+			Name:      p.Name,
+			Namespace: p.Namespace,
+			Title:     p.Spec.Title,
+			Main:      p.Spec.Main,
+			Ready:     p.Status.Ready,
+			Remote:    p.Spec.Remote != nil,
+			Groups:    []Group{},
+		}
+		if p.Spec.Remote != nil {
+			portal.URL = p.Spec.Remote.URL
+		}
+		views, err := store.List(ctx, domaindns.FQDNFilters{Portal: p.Namespace + "/" + p.Name, LastSeen: window})
+		if err != nil {
+			return nil, fmt.Errorf("list FQDNs of portal %s/%s: %w", p.Namespace, p.Name, err)
+		}
+		portal.Groups = groupViews(views)
+		bundle.Portals = append(bundle.Portals, portal)
+	}
+	sort.Slice(bundle.Portals, func(i, j int) bool {
+		a, b := bundle.Portals[i], bundle.Portals[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+	return bundle, nil
+}
+
+// groupViews buckets views by group, groups and FQDNs sorted by name. A view
+// belonging to several groups is listed in each of them. IDs are derived from
+// the bare portal names, as in the API.
+func groupViews(views []domaindns.FQDNView) []Group {
+	byGroup := inventory.GroupBy(views, func(v domaindns.FQDNView) []string { return v.Groups }, "")
+	groups := make([]Group, 0, len(byGroup))
+	for _, g := range byGroup {
+		fqdns := make([]FQDN, 0, len(g.Members))
+		for i := range g.Members {
+			f := toFQDN(&g.Members[i])
+			f.ID = domaindns.StableIDOf(barePortalNames(g.Members[i].Portals), f.Name, f.RecordType)
+			fqdns = append(fqdns, f)
+		}
+		slices.SortFunc(fqdns, func(a, b FQDN) int {
+			return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.RecordType, b.RecordType))
+		})
+		groups = append(groups, Group{Name: g.Name, FQDNs: fqdns})
+	}
+	return groups
+}
+
+// barePortalNames strips the namespace of the "namespace/name" portal keys
+// Collect stores views under.
+func barePortalNames(keys []string) []string {
+	names := make([]string, 0, len(keys))
+	for _, k := range keys {
+		_, name, _ := strings.Cut(k, "/")
+		names = append(names, name)
+	}
+	return names
+}
+
+func toFQDN(v *domaindns.FQDNView) FQDN {
+	f := FQDN{
+		ID:                 v.ID,
+		Name:               v.Name,
+		RecordType:         v.RecordType,
+		Targets:            v.Targets,
+		Source:             string(v.Source),
+		SourceType:         v.SourceType,
+		Description:        v.Description,
+		SyncStatus:         v.SyncStatus,
+		InternalSyncStatus: v.InternalSyncStatus,
+		ExternalSyncStatus: v.ExternalSyncStatus,
+		Availability:       v.Availability,
+		TargetScope:        string(v.TargetScope),
+		OverallStatus:      string(v.OverallStatus),
+		LastSeen:           v.LastSeen,
+	}
+	if v.DNSRecord != nil {
+		f.DNSRecord = v.DNSRecord.String()
+	}
+	return f
+}
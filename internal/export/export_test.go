@@ -0,0 +1,222 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+const (
+	tNS  = "sreportal-system"
+	tAPI = "api.example.com"
+	tWeb = "web.example.com"
+)
+
+func newTestClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha1.AddToScheme(scheme))
+	require.NoError(t, v1alpha2.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func fixtures() []client.Object {
+	return []client.Object{
+		&sreportalv1alpha1.Portal{
+			ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: tNS},
+			Spec:       sreportalv1alpha1.PortalSpec{Title: "Main <portal>", Main: true},
+			Status:     sreportalv1alpha1.PortalStatus{Ready: true},
+		},
+		&sreportalv1alpha1.Portal{
+			ObjectMeta: metav1.ObjectMeta{Name: "remote", Namespace: tNS},
+			Spec: sreportalv1alpha1.PortalSpec{
+				Title:  "Remote",
+				Remote: &sreportalv1alpha1.RemotePortalSpec{URL: "https://remote.example.com"},
+			},
+		},
+		&v1alpha2.DNS{
+			ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: tNS},
+			Spec: v1alpha2.DNSSpec{
+				PortalRef:    "main",
+				GroupMapping: v1alpha2.GroupMappingSpec{DefaultGroup: "Services", LabelKey: "sreportal.io/group"},
+			},
+		},
+		&v1alpha2.DNSRecord{
+			ObjectMeta: metav1.ObjectMeta{Name: "main-ingress", Namespace: tNS},
+			Spec:       v1alpha2.DNSRecordSpec{Origin: v1alpha2.DNSRecordOriginAuto, PortalRef: "main"},
+			Status: v1alpha2.DNSRecordStatus{Endpoints: []v1alpha2.EndpointStatus{
+				{DNSName: tWeb, RecordType: "A", Targets: []string{"10.0.0.2"}, SyncStatus: v1alpha2.SyncStatusSync},
+				{DNSName: tAPI, RecordType: "A", Targets: []string{"10.0.0.1"}, SyncStatus: v1alpha2.SyncStatusNotAvailable,
+					Labels: map[string]string{"sreportal.io/group": "APIs"}},
+			}},
+		},
+		// No DNS CR governs this portal, so the record is not projected.
+		&v1alpha2.DNSRecord{
+			ObjectMeta: metav1.ObjectMeta{Name: "orphan", Namespace: tNS},
+			Spec:       v1alpha2.DNSRecordSpec{Origin: v1alpha2.DNSRecordOriginAuto, PortalRef: "other"},
+			Status: v1alpha2.DNSRecordStatus{Endpoints: []v1alpha2.EndpointStatus{
+				{DNSName: "orphan.example.com", RecordType: "A", Targets: []string{"10.0.0.3"}},
+			}},
+		},
+	}
+}
+
+func TestCollect(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	b, err := Collect(context.Background(), newTestClient(t, fixtures()...), now, domaindns.LastSeenWindow{})
+	require.NoError(t, err)
+
+	assert.Equal(t, now, b.GeneratedAt)
+	require.Len(t, b.Portals, 2)
+
+	main := b.Portals[0]
+	assert.Equal(t, "main", main.Name)
+	assert.True(t, main.Main)
+	assert.True(t, main.Ready)
+	assert.Equal(t, 2, main.FQDNCount())
+	require.Len(t, main.Groups, 2)
+	assert.Equal(t, "APIs", main.Groups[0].Name)
+	require.Len(t, main.Groups[0].FQDNs, 1)
+	api := main.Groups[0].FQDNs[0]
+	assert.Equal(t, tAPI, api.Name)
+	assert.Equal(t, []string{"10.0.0.1"}, api.Targets)
+	assert.Equal(t, "critical", api.OverallStatus)
+	assert.Equal(t, tNS+"/main-ingress", api.DNSRecord)
+	assert.Equal(t, "Services", main.Groups[1].Name)
+	assert.Equal(t, tWeb, main.Groups[1].FQDNs[0].Name)
+	assert.Equal(t, "healthy", main.Groups[1].FQDNs[0].OverallStatus)
+
+	remote := b.Portals[1]
+	assert.True(t, remote.Remote)
+	assert.Equal(t, "https://remote.example.com", remote.URL)
+	assert.Empty(t, remote.Groups)
+}
+
+func TestCollect_SamePortalNameInTwoNamespaces(t *testing.T) {
+	objs := fixtures()
+	objs = append(objs,
+		&sreportalv1alpha1.Portal{
+			ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "team-b"},
+			Spec:       sreportalv1alpha1.PortalSpec{Title: "Team B"},
+		},
+		&v1alpha2.DNS{
+			ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "team-b"},
+			Spec:       v1alpha2.DNSSpec{PortalRef: "main"},
+		},
+		&v1alpha2.DNSRecord{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-b-ingress", Namespace: "team-b"},
+			Spec:       v1alpha2.DNSRecordSpec{Origin: v1alpha2.DNSRecordOriginAuto, PortalRef: "main"},
+			Status: v1alpha2.DNSRecordStatus{Endpoints: []v1alpha2.EndpointStatus{
+				{DNSName: "b.example.com", RecordType: "A", Targets: []string{"10.0.1.1"}},
+			}},
+		},
+	)
+
+	b, err := Collect(context.Background(), newTestClient(t, objs...), time.Now(), domaindns.LastSeenWindow{})
+	require.NoError(t, err)
+	require.Len(t, b.Portals, 3)
+
+	assert.Equal(t, tNS, b.Portals[0].Namespace)
+	assert.Equal(t, 2, b.Portals[0].FQDNCount())
+	teamB := b.Portals[2]
+	assert.Equal(t, "team-b", teamB.Namespace)
+	require.Equal(t, 1, teamB.FQDNCount())
+	f := teamB.Groups[0].FQDNs[0]
+	assert.Equal(t, "b.example.com", f.Name)
+	assert.Equal(t, domaindns.StableID("main", "b.example.com", "A"), f.ID)
+}
+
+func TestCollect_LastSeenWindow(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	objs := fixtures()
+	record := objs[3].(*v1alpha2.DNSRecord)
+	record.Status.Endpoints[0].LastSeen = metav1.NewTime(now)
+	record.Status.Endpoints[1].LastSeen = metav1.NewTime(now.Add(-60 * 24 * time.Hour))
+
+	b, err := Collect(context.Background(), newTestClient(t, objs...), now,
+		domaindns.LastSeenWindow{Before: now.Add(-30 * 24 * time.Hour)})
+	require.NoError(t, err)
+	require.Len(t, b.Portals[0].Groups, 1)
+	assert.Equal(t, tAPI, b.Portals[0].Groups[0].FQDNs[0].Name)
+
+	_, err = Collect(context.Background(), newTestClient(t, objs...), now,
+		domaindns.LastSeenWindow{After: now, Before: now})
+	assert.ErrorIs(t, err, domaindns.ErrInvalidLastSeenWindow)
+}
+
+func TestWrite_JSON(t *testing.T) {
+	b, err := Collect(context.Background(), newTestClient(t, fixtures()...), time.Now(), domaindns.LastSeenWindow{})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, b, FormatJSON))
+
+	var got Bundle
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+	assert.Len(t, got.Portals, 2)
+	assert.Equal(t, b.Portals[0].Groups, got.Portals[0].Groups)
+}
+
+func TestWrite_HTML(t *testing.T) {
+	b, err := Collect(context.Background(), newTestClient(t, fixtures()...), time.Now(), domaindns.LastSeenWindow{})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, b, FormatHTML))
+
+	html := buf.String()
+	assert.Contains(t, html, "Main &lt;portal&gt;")
+	assert.Contains(t, html, tAPI)
+	assert.Contains(t, html, `class="status critical"`)
+	assert.NotContains(t, html, "orphan.example.com")
+
+	// The bundle is embedded as JSON for machine consumption.
+	start := strings.Index(html, `id="sreportal-export">`)
+	require.GreaterOrEqual(t, start, 0)
+	raw := html[start+len(`id="sreportal-export">`):]
+	raw = raw[:strings.Index(raw, "</script>")]
+	var got Bundle
+	require.NoError(t, json.Unmarshal([]byte(raw), &got))
+	assert.Len(t, got.Portals, 2)
+}
+
+func TestParseFormat(t *testing.T) {
+	f, err := ParseFormat("html")
+	require.NoError(t, err)
+	assert.Equal(t, FormatHTML, f)
+
+	_, err = ParseFormat("yaml")
+	assert.True(t, errors.Is(err, ErrUnknownFormat))
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+)
+
+// Format is the encoding of an exported bundle.
+type Format string
+
+const (
+	// FormatJSON writes the bundle as indented JSON.
+	FormatJSON Format = "json"
+	// FormatHTML writes a single HTML page, with no external assets, that
+	// renders the bundle and embeds its JSON form.
+	FormatHTML Format = "html"
+)
+
+// ErrUnknownFormat is returned for an export format other than json or html.
+var ErrUnknownFormat = errors.New("unknown export format")
+
+// ParseFormat parses an export format name.
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(s); f {
+	case FormatJSON, FormatHTML:
+		return f, nil
+	default:
+		return "", fmt.Errorf("%w %q (want %q or %q)", ErrUnknownFormat, s, FormatJSON, FormatHTML)
+	}
+}
+
+//go:embed bundle.html.tmpl
+var htmlSource string
+
+var htmlTemplate = template.Must(template.New("bundle").Parse(htmlSource))
+
+// Write encodes b to w in format f.
+func Write(w io.Writer, b *Bundle, f Format) error {
+	switch f {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(b)
+	case FormatHTML:
+		return htmlTemplate.Execute(w, b)
+	default:
+		return fmt.Errorf("%w %q", ErrUnknownFormat, f)
+	}
+}
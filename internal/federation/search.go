@@ -0,0 +1,220 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package federation fans read queries out to the remote portals known to this
+// instance and merges their answers with the local ReadStores.
+package federation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	domainportal "github.com/golgoth31/sreportal/internal/domain/portal"
+)
+
+// LocalSite is the site label attached to results served by this instance.
+const LocalSite = "local"
+
+// DefaultConcurrency is the default number of remote portals queried in parallel.
+const DefaultConcurrency = 4
+
+// DefaultSiteTimeout is the default deadline applied to each remote portal query.
+const DefaultSiteTimeout = 5 * time.Second
+
+// sourceRemote is the source of FQDNs mirrored locally from a remote portal.
+// They are excluded from the local answer: the remote site answers for them.
+const sourceRemote domaindns.Source = "remote"
+
+// RemoteSearcher searches FQDNs on a single remote portal.
+type RemoteSearcher interface {
+	SearchFQDNs(ctx context.Context, baseURL, portalName, search, source string) ([]domaindns.FQDNView, error)
+}
+
+// SearcherFor returns the RemoteSearcher to use for the given remote portal.
+type SearcherFor func(portal domainportal.PortalView) RemoteSearcher
+
+// Result is an FQDN together with every site it was found on.
+type Result struct {
+	FQDN  domaindns.FQDNView
+	Sites []string
+}
+
+// SiteError reports a remote portal that could not be searched.
+type SiteError struct {
+	Site string
+	Err  error
+}
+
+// Searcher runs federated FQDN searches across the local ReadStore and every
+// remote portal. Remote portals are queried concurrently (bounded) with a
+// per-site deadline; a failing site never fails the whole search.
+type Searcher struct {
+	fqdnReader   domaindns.FQDNReader
+	portalReader domainportal.PortalReader
+	searcherFor  SearcherFor
+	concurrency  int
+	siteTimeout  time.Duration
+}
+
+// Option configures a Searcher.
+type Option func(*Searcher)
+
+// WithConcurrency sets the maximum number of remote portals queried in parallel.
+func WithConcurrency(n int) Option {
+	return func(s *Searcher) {
+		if n > 0 {
+			s.concurrency = n
+		}
+	}
+}
+
+// WithSiteTimeout sets the deadline applied to each remote portal query.
+func WithSiteTimeout(d time.Duration) Option {
+	return func(s *Searcher) {
+		if d > 0 {
+			s.siteTimeout = d
+		}
+	}
+}
+
+// NewSearcher creates a new federated Searcher.
+func NewSearcher(
+	fqdnReader domaindns.FQDNReader,
+	portalReader domainportal.PortalReader,
+	searcherFor SearcherFor,
+	opts ...Option,
+) *Searcher {
+	s := &Searcher{
+		fqdnReader:   fqdnReader,
+		portalReader: portalReader,
+		searcherFor:  searcherFor,
+		concurrency:  DefaultConcurrency,
+		siteTimeout:  DefaultSiteTimeout,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+type siteAnswer struct {
+	site  string
+	views []domaindns.FQDNView
+	err   error
+}
+
+// Search returns the FQDNs matching search (and source, when non-empty) on
+// every site, deduplicated by name and record type. Results are sorted by name
+// then record type; site errors are sorted by site.
+func (s *Searcher) Search(ctx context.Context, search, source string) ([]Result, []SiteError, error) {
+	local, err := s.fqdnReader.List(ctx, domaindns.FQDNFilters{Search: search, Source: source})
+	if err != nil {
+		return nil, nil, fmt.Errorf("search local FQDNs: %w", err)
+	}
+	local = slices.DeleteFunc(local, func(v domaindns.FQDNView) bool {
+		return v.Source == sourceRemote
+	})
+
+	portals, err := s.portalReader.List(ctx, domainportal.PortalFilters{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("list portals: %w", err)
+	}
+
+	remotes := make([]domainportal.PortalView, 0, len(portals))
+	for _, p := range portals {
+		if p.IsRemote && p.URL != "" && p.Features.DNS {
+			remotes = append(remotes, p)
+		}
+	}
+
+	answers := make([]siteAnswer, len(remotes))
+	sem := make(chan struct{}, s.concurrency)
+	var wg sync.WaitGroup
+	for i, p := range remotes {
+		wg.Go(func() {
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				answers[i] = siteAnswer{site: p.Name, err: ctx.Err()}
+				return
+			}
+
+			siteCtx, cancel := context.WithTimeout(ctx, s.siteTimeout)
+			defer cancel()
+
+			views, err := s.searcherFor(p).SearchFQDNs(siteCtx, p.URL, p.RemotePortal, search, source)
+			answers[i] = siteAnswer{site: p.Name, views: views, err: err}
+		})
+	}
+	wg.Wait()
+
+	results, siteErrors := merge(local, answers)
+	return results, siteErrors, nil
+}
+
+// merge deduplicates local and remote answers by (name, record type). The
+// first occurrence wins for the FQDN payload; sites are accumulated.
+func merge(local []domaindns.FQDNView, answers []siteAnswer) ([]Result, []SiteError) {
+	type key struct{ name, recordType string }
+
+	index := make(map[key]int)
+	var results []Result
+	add := func(site string, views []domaindns.FQDNView) {
+		for _, v := range views {
+			k := key{name: v.Name, recordType: v.RecordType}
+			if i, ok := index[k]; ok {
+				if !slices.Contains(results[i].Sites, site) {
+					results[i].Sites = append(results[i].Sites, site)
+				}
+				continue
+			}
+			index[k] = len(results)
+			results = append(results, Result{FQDN: v, Sites: []string{site}})
+		}
+	}
+
+	add(LocalSite, local)
+
+	var siteErrors []SiteError
+	for _, a := range answers {
+		if a.err != nil {
+			siteErrors = append(siteErrors, SiteError{Site: a.site, Err: a.err})
+			continue
+		}
+		add(a.site, a.views)
+	}
+
+	for i := range results {
+		sort.Strings(results[i].Sites)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].FQDN.Name != results[j].FQDN.Name {
+			return results[i].FQDN.Name < results[j].FQDN.Name
+		}
+		return results[i].FQDN.RecordType < results[j].FQDN.RecordType
+	})
+	sort.Slice(siteErrors, func(i, j int) bool {
+		return siteErrors[i].Site < siteErrors[j].Site
+	})
+
+	return results, siteErrors
+}
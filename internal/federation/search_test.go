@@ -0,0 +1,195 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package federation_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	domainportal "github.com/golgoth31/sreportal/internal/domain/portal"
+	"github.com/golgoth31/sreportal/internal/federation"
+	dnsstore "github.com/golgoth31/sreportal/internal/readstore/dns"
+	portalstore "github.com/golgoth31/sreportal/internal/readstore/portal"
+)
+
+const (
+	tFQDNAPI  = "api.example.com"
+	tSiteEU   = "eu"
+	tSiteUS   = "us"
+	tRecordA  = "A"
+	tNsRemote = "remote"
+)
+
+type fakeRemote struct {
+	views []domaindns.FQDNView
+	err   error
+	delay time.Duration
+	calls *atomic.Int32
+	// inflight/maxInflight track concurrency across all fakes sharing them.
+	inflight    *atomic.Int32
+	maxInflight *atomic.Int32
+}
+
+func (f *fakeRemote) SearchFQDNs(ctx context.Context, _, _, _, _ string) ([]domaindns.FQDNView, error) {
+	if f.calls != nil {
+		f.calls.Add(1)
+	}
+	if f.inflight != nil {
+		n := f.inflight.Add(1)
+		defer f.inflight.Add(-1)
+		for {
+			cur := f.maxInflight.Load()
+			if n <= cur || f.maxInflight.CompareAndSwap(cur, n) {
+				break
+			}
+		}
+	}
+	if f.delay > 0 {
+		select {
+		case <-time.After(f.delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return f.views, f.err
+}
+
+func remotePortal(name string) domainportal.PortalView {
+	return domainportal.PortalView{
+		Name:      name,
+		Namespace: tNsRemote,
+		IsRemote:  true,
+		URL:       "https://" + name + ".example.com",
+		Features:  domainportal.PortalFeatures{DNS: true},
+	}
+}
+
+func seed(t *testing.T, local []domaindns.FQDNView, portals ...domainportal.PortalView) (*dnsstore.FQDNStore, *portalstore.PortalStore) {
+	t.Helper()
+	ctx := context.Background()
+
+	fqdns := dnsstore.NewFQDNStore()
+	if len(local) > 0 {
+		require.NoError(t, fqdns.Replace(ctx, "default/local", "main", local))
+	}
+
+	ps := portalstore.NewPortalStore()
+	require.NoError(t, ps.Replace(ctx, "default/main", domainportal.PortalView{Name: "main", Namespace: "default", Main: true}))
+	for _, p := range portals {
+		require.NoError(t, ps.Replace(ctx, p.Namespace+"/"+p.Name, p))
+	}
+	return fqdns, ps
+}
+
+func TestSearch_MergesAndLabelsSites(t *testing.T) {
+	fqdns, portals := seed(t,
+		[]domaindns.FQDNView{{Name: tFQDNAPI, RecordType: tRecordA, Source: domaindns.SourceManual}},
+		remotePortal(tSiteEU), remotePortal(tSiteUS),
+	)
+
+	remotes := map[string]federation.RemoteSearcher{
+		tSiteEU: &fakeRemote{views: []domaindns.FQDNView{
+			{Name: tFQDNAPI, RecordType: tRecordA},
+			{Name: "api.eu.example.com", RecordType: tRecordA},
+		}},
+		tSiteUS: &fakeRemote{views: []domaindns.FQDNView{{Name: tFQDNAPI, RecordType: tRecordA}}},
+	}
+	s := federation.NewSearcher(fqdns, portals, func(p domainportal.PortalView) federation.RemoteSearcher {
+		return remotes[p.Name]
+	})
+
+	results, siteErrs, err := s.Search(context.Background(), "api", "")
+	require.NoError(t, err)
+	assert.Empty(t, siteErrs)
+	require.Len(t, results, 2)
+
+	assert.Equal(t, "api.eu.example.com", results[0].FQDN.Name)
+	assert.Equal(t, []string{tSiteEU}, results[0].Sites)
+	assert.Equal(t, tFQDNAPI, results[1].FQDN.Name)
+	assert.Equal(t, []string{tSiteEU, federation.LocalSite, tSiteUS}, results[1].Sites)
+}
+
+func TestSearch_SiteFailureIsReportedNotFatal(t *testing.T) {
+	fqdns, portals := seed(t,
+		[]domaindns.FQDNView{{Name: tFQDNAPI, RecordType: tRecordA, Source: domaindns.SourceManual}},
+		remotePortal(tSiteEU), remotePortal(tSiteUS),
+	)
+
+	remotes := map[string]federation.RemoteSearcher{
+		tSiteEU: &fakeRemote{err: errors.New("connection refused")},
+		tSiteUS: &fakeRemote{delay: time.Second},
+	}
+	s := federation.NewSearcher(fqdns, portals, func(p domainportal.PortalView) federation.RemoteSearcher {
+		return remotes[p.Name]
+	}, federation.WithSiteTimeout(20*time.Millisecond))
+
+	results, siteErrs, err := s.Search(context.Background(), "api", "")
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, []string{federation.LocalSite}, results[0].Sites)
+
+	require.Len(t, siteErrs, 2)
+	assert.Equal(t, tSiteEU, siteErrs[0].Site)
+	assert.Equal(t, tSiteUS, siteErrs[1].Site)
+	assert.ErrorIs(t, siteErrs[1].Err, context.DeadlineExceeded)
+}
+
+func TestSearch_SkipsMirroredRemoteFQDNsAndDisabledPortals(t *testing.T) {
+	disabled := remotePortal(tSiteUS)
+	disabled.Features.DNS = false
+
+	fqdns, portals := seed(t,
+		[]domaindns.FQDNView{{Name: tFQDNAPI, RecordType: tRecordA, Source: "remote"}},
+		disabled,
+	)
+
+	calls := &atomic.Int32{}
+	s := federation.NewSearcher(fqdns, portals, func(domainportal.PortalView) federation.RemoteSearcher {
+		return &fakeRemote{calls: calls}
+	})
+
+	results, siteErrs, err := s.Search(context.Background(), "api", "")
+	require.NoError(t, err)
+	assert.Empty(t, results)
+	assert.Empty(t, siteErrs)
+	assert.Zero(t, calls.Load())
+}
+
+func TestSearch_BoundsConcurrency(t *testing.T) {
+	var remotes []domainportal.PortalView
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		remotes = append(remotes, remotePortal(name))
+	}
+	fqdns, portals := seed(t, nil, remotes...)
+
+	inflight, maxInflight := &atomic.Int32{}, &atomic.Int32{}
+	s := federation.NewSearcher(fqdns, portals, func(domainportal.PortalView) federation.RemoteSearcher {
+		return &fakeRemote{delay: 20 * time.Millisecond, inflight: inflight, maxInflight: maxInflight}
+	}, federation.WithConcurrency(2))
+
+	_, siteErrs, err := s.Search(context.Background(), "api", "")
+	require.NoError(t, err)
+	assert.Empty(t, siteErrs)
+	assert.LessOrEqual(t, maxInflight.Load(), int32(2))
+}
@@ -0,0 +1,346 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fqdnignore sets and clears the sreportal.io/ignore annotation of
+// the Kubernetes resources FQDNs are discovered from, so that noisy FQDNs can
+// be dropped from the portals without editing their manifests.
+package fqdnignore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/golgoth31/sreportal/internal/adapter"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+// MaxTargets is the maximum number of targets of a single call.
+const MaxTargets = 500
+
+var (
+	ErrPortalRequired  = errors.New("portal is required")
+	ErrNoTargets       = errors.New("at least one target is required")
+	ErrTooManyTargets  = fmt.Errorf("at most %d targets are allowed", MaxTargets)
+	ErrTargetRequired  = errors.New("target needs an fqdn or a resource")
+	ErrInvalidResource = errors.New("invalid resource")
+	ErrUnsupportedKind = errors.New("resource kind cannot be annotated")
+	ErrNoOrigin        = errors.New("fqdn has no origin resource")
+	ErrFQDNNotFound    = errors.New("fqdn not found in portal")
+	ErrNotAnOrigin     = errors.New("resource is not the origin of an fqdn in portal")
+	ErrDenied          = errors.New("caller may not patch the resource")
+	ErrNoIdentity      = errors.New("caller has no identity to check the permissions of")
+)
+
+// +kubebuilder:rbac:groups="",resources=services,verbs=patch
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=patch
+// +kubebuilder:rbac:groups=networking.istio.io,resources=gateways;virtualservices,verbs=patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes;grpcroutes;tcproutes;tlsroutes;udproutes,verbs=patch
+// +kubebuilder:rbac:groups=projectcontour.io,resources=httpproxies,verbs=patch
+// +kubebuilder:rbac:groups=getambassador.io,resources=hosts,verbs=patch
+// +kubebuilder:rbac:groups=externaldns.k8s.io,resources=dnsendpoints,verbs=patch
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+
+// originKind is a resource kind external-dns names in its resource label.
+type originKind struct {
+	gvk      schema.GroupVersionKind
+	resource string
+}
+
+// originKinds maps the kind of the external-dns resource label to the
+// annotated resource. Keep in sync with the kubebuilder RBAC markers.
+var originKinds = map[string]originKind{
+	"service":        {schema.GroupVersionKind{Version: "v1", Kind: "Service"}, "services"},
+	"ingress":        {schema.GroupVersionKind{Group: "networking.k8s.io", Version: "v1", Kind: "Ingress"}, "ingresses"},
+	"crd":            {schema.GroupVersionKind{Group: "externaldns.k8s.io", Version: "v1alpha1", Kind: "DNSEndpoint"}, "dnsendpoints"},
+	"gateway":        {schema.GroupVersionKind{Group: "networking.istio.io", Version: "v1", Kind: "Gateway"}, "gateways"},
+	"virtualservice": {schema.GroupVersionKind{Group: "networking.istio.io", Version: "v1", Kind: "VirtualService"}, "virtualservices"},
+	"httproute":      {schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "HTTPRoute"}, "httproutes"},
+	"grpcroute":      {schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "GRPCRoute"}, "grpcroutes"},
+	"tlsroute":       {schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1alpha2", Kind: "TLSRoute"}, "tlsroutes"},
+	"tcproute":       {schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1alpha2", Kind: "TCPRoute"}, "tcproutes"},
+	"udproute":       {schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1alpha2", Kind: "UDPRoute"}, "udproutes"},
+	"httpproxy":      {schema.GroupVersionKind{Group: "projectcontour.io", Version: "v1", Kind: "HTTPProxy"}, "httpproxies"},
+	"host":           {schema.GroupVersionKind{Group: "getambassador.io", Version: "v2", Kind: "Host"}, "hosts"},
+}
+
+// Target names the resources to update: the origin resources of an FQDN of
+// the portal, or a single resource.
+type Target struct {
+	FQDN string
+	// RecordType restricts FQDN to one record type; empty matches all.
+	RecordType string
+	// Resource is a "kind/namespace/name" origin reference, used instead of
+	// FQDN. To be ignored, it must be the origin of an FQDN of the portal,
+	// removed ones included; any resource can be un-ignored, since the FQDNs
+	// of an ignored resource are dropped once no longer retained.
+	Resource string
+}
+
+// SetInput holds the ignore state to set on a list of targets.
+type SetInput struct {
+	// Portal is the portal the FQDNs are looked up in.
+	Portal  string
+	Targets []Target
+	// Ignored sets the annotation to "true"; false removes it.
+	Ignored bool
+	// DryRun validates the patches with the API server without persisting
+	// them.
+	DryRun bool
+	// User and Groups identify the caller, who must be allowed to patch each
+	// resource. User is required.
+	User   string
+	Groups []string
+}
+
+// Status is the outcome of a Result.
+type Status int
+
+const (
+	// StatusUpdated means the annotation was updated, or would be on a dry
+	// run.
+	StatusUpdated Status = iota + 1
+	// StatusUnchanged means the annotation already had the requested state.
+	StatusUnchanged
+	// StatusDenied means the caller or the operator may not patch the
+	// resource.
+	StatusDenied
+	// StatusNotFound means the FQDN is not in the portal or the resource does
+	// not exist.
+	StatusNotFound
+	// StatusUnsupported means the FQDN has no origin resource or its kind
+	// cannot be annotated.
+	StatusUnsupported
+	// StatusFailed means the API server rejected the patch.
+	StatusFailed
+)
+
+// Result is the outcome of a target on one of its origin resources.
+type Result struct {
+	FQDN       string
+	RecordType string
+	// Resource is the "kind/namespace/name" origin reference, empty when the
+	// FQDN has none.
+	Resource string
+	Status   Status
+	Err      error
+}
+
+// Service patches the ignore annotation of origin resources via the K8s API.
+type Service struct {
+	client client.Client
+	reader domaindns.FQDNReader
+}
+
+// NewService creates a new ignore Service resolving FQDNs with reader.
+func NewService(c client.Client, reader domaindns.FQDNReader) *Service {
+	return &Service{client: c, reader: reader}
+}
+
+// Set applies the ignore state to the origin resources of every target. A
+// resource shared by several targets is patched once. Per-resource failures
+// are reported in the results; the error is only set for invalid input or
+// when the FQDNs cannot be read.
+func (s *Service) Set(ctx context.Context, in SetInput) ([]Result, error) {
+	switch {
+	case in.Portal == "":
+		return nil, ErrPortalRequired
+	case len(in.Targets) == 0:
+		return nil, ErrNoTargets
+	case len(in.Targets) > MaxTargets:
+		return nil, ErrTooManyTargets
+	case in.User == "":
+		return nil, ErrNoIdentity
+	}
+
+	done := map[string]Result{}
+	var results []Result
+	for i, t := range in.Targets {
+		refs, res, err := s.resolve(ctx, in.Portal, t, in.Ignored)
+		if err != nil {
+			return nil, fmt.Errorf("target %d: %w", i, err)
+		}
+		if res != nil {
+			results = append(results, *res)
+			continue
+		}
+		for _, ref := range refs {
+			r, ok := done[ref.String()]
+			if !ok {
+				r = s.apply(ctx, ref, in)
+				done[ref.String()] = r
+			}
+			r.FQDN, r.RecordType = t.FQDN, t.RecordType
+			results = append(results, r)
+		}
+	}
+	return results, nil
+}
+
+// resolve returns the origin resources of t, or the result reporting why it
+// has none.
+func (s *Service) resolve(ctx context.Context, portal string, t Target, ignore bool) ([]domaindns.ResourceRef, *Result, error) {
+	if t.Resource != "" {
+		ref, err := domaindns.ParseResourceRef(t.Resource)
+		if err != nil {
+			return nil, &Result{Resource: t.Resource, Status: StatusUnsupported, Err: fmt.Errorf("%w: %w", ErrInvalidResource, err)}, nil
+		}
+		// Un-ignoring is left to the access review of the caller: the
+		// resource is no longer the origin of any FQDN once its tombstones
+		// expire.
+		if !ignore {
+			return []domaindns.ResourceRef{ref}, nil, nil
+		}
+		views, err := s.reader.List(ctx, domaindns.FQDNFilters{Portal: portal, IncludeRemoved: true})
+		if err != nil {
+			return nil, nil, fmt.Errorf("list FQDNs: %w", err)
+		}
+		if !slices.ContainsFunc(views, func(v domaindns.FQDNView) bool {
+			return v.OriginRef != nil && strings.EqualFold(v.OriginRef.String(), ref.String())
+		}) {
+			return nil, &Result{Resource: t.Resource, Status: StatusNotFound, Err: ErrNotAnOrigin}, nil
+		}
+		return []domaindns.ResourceRef{ref}, nil, nil
+	}
+	fqdn := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(t.FQDN)), ".")
+	if fqdn == "" {
+		return nil, nil, ErrTargetRequired
+	}
+
+	views, err := s.reader.List(ctx, domaindns.FQDNFilters{Portal: portal, Search: fqdn, IncludeRemoved: true})
+	if err != nil {
+		return nil, nil, fmt.Errorf("list FQDNs: %w", err)
+	}
+	var (
+		refs  []domaindns.ResourceRef
+		found bool
+	)
+	for _, v := range views {
+		if v.Name != fqdn || (t.RecordType != "" && !strings.EqualFold(v.RecordType, t.RecordType)) {
+			continue
+		}
+		found = true
+		if v.OriginRef != nil && !slices.Contains(refs, *v.OriginRef) {
+			refs = append(refs, *v.OriginRef)
+		}
+	}
+	switch {
+	case !found:
+		return nil, &Result{FQDN: t.FQDN, RecordType: t.RecordType, Status: StatusNotFound, Err: ErrFQDNNotFound}, nil
+	case len(refs) == 0:
+		return nil, &Result{FQDN: t.FQDN, RecordType: t.RecordType, Status: StatusUnsupported, Err: ErrNoOrigin}, nil
+	}
+	return refs, nil, nil
+}
+
+// apply checks the caller may patch ref, then patches its ignore annotation
+// unless it already has the requested state.
+func (s *Service) apply(ctx context.Context, ref domaindns.ResourceRef, in SetInput) Result {
+	res := Result{Resource: ref.String()}
+	kind, ok := originKinds[strings.ToLower(ref.Kind())]
+	if !ok {
+		res.Status, res.Err = StatusUnsupported, fmt.Errorf("%w: %q", ErrUnsupportedKind, ref.Kind())
+		return res
+	}
+
+	allowed, err := s.allowed(ctx, in.User, in.Groups, kind, ref)
+	if err != nil {
+		res.Status, res.Err = StatusFailed, fmt.Errorf("access review: %w", err)
+		return res
+	}
+	if !allowed {
+		res.Status, res.Err = StatusDenied, ErrDenied
+		return res
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(kind.gvk)
+	if err := s.client.Get(ctx, types.NamespacedName{Namespace: ref.Namespace(), Name: ref.Name()}, obj); err != nil {
+		res.Status, res.Err = statusOf(err), err
+		return res
+	}
+	if (obj.GetAnnotations()[adapter.IgnoreAnnotationKey] == "true") == in.Ignored {
+		res.Status = StatusUnchanged
+		return res
+	}
+
+	var value any // nil removes the annotation
+	if in.Ignored {
+		value = "true"
+	}
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]any{adapter.IgnoreAnnotationKey: value},
+		},
+	})
+	if err != nil {
+		res.Status, res.Err = StatusFailed, err
+		return res
+	}
+	var opts []client.PatchOption
+	if in.DryRun {
+		opts = append(opts, client.DryRunAll)
+	}
+	if err := s.client.Patch(ctx, obj, client.RawPatch(types.MergePatchType, patch), opts...); err != nil {
+		res.Status, res.Err = statusOf(err), err
+		return res
+	}
+	res.Status = StatusUpdated
+	return res
+}
+
+// allowed asks the API server whether user may patch the resource.
+func (s *Service) allowed(ctx context.Context, user string, groups []string, kind originKind, ref domaindns.ResourceRef) (bool, error) {
+	review := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user,
+			Groups: groups,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: ref.Namespace(),
+				Verb:      "patch",
+				Group:     kind.gvk.Group,
+				Version:   kind.gvk.Version,
+				Resource:  kind.resource,
+				Name:      ref.Name(),
+			},
+		},
+	}
+	if err := s.client.Create(ctx, review); err != nil {
+		return false, err
+	}
+	return review.Status.Allowed, nil
+}
+
+func statusOf(err error) Status {
+	switch {
+	case apierrors.IsNotFound(err):
+		return StatusNotFound
+	case apierrors.IsForbidden(err):
+		return StatusDenied
+	default:
+		return StatusFailed
+	}
+}
@@ -0,0 +1,281 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fqdnignore_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	"github.com/golgoth31/sreportal/internal/fqdnignore"
+)
+
+const (
+	tNamespace = "default"
+	tPortal    = "main"
+	tFQDN      = "api.example.com"
+	tResource  = "service/default/api"
+	tUser      = "alice"
+)
+
+// stubReader returns its views for every List call.
+type stubReader struct {
+	domaindns.FQDNReader
+	views []domaindns.FQDNView
+}
+
+func (r stubReader) List(context.Context, domaindns.FQDNFilters) ([]domaindns.FQDNView, error) {
+	return r.views, nil
+}
+
+func view(t *testing.T, name, recordType, origin string) domaindns.FQDNView {
+	t.Helper()
+	v := domaindns.FQDNView{Name: name, RecordType: recordType, Portals: []string{tPortal}}
+	if origin != "" {
+		ref, err := domaindns.ParseResourceRef(origin)
+		require.NoError(t, err)
+		v.OriginRef = &ref
+	}
+	return v
+}
+
+func newClient(t *testing.T, funcs interceptor.Funcs, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).WithInterceptorFuncs(funcs).Build()
+}
+
+// allowReviews allows every SubjectAccessReview.
+var allowReviews = interceptor.Funcs{
+	Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+		if review, ok := obj.(*authorizationv1.SubjectAccessReview); ok {
+			review.Status.Allowed = true
+			return nil
+		}
+		return c.Create(ctx, obj, opts...)
+	},
+}
+
+func service(annotations map[string]string) *corev1.Service {
+	return &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: tNamespace, Annotations: annotations}}
+}
+
+func annotations(t *testing.T, c client.Client) map[string]string {
+	t.Helper()
+	var svc corev1.Service
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: "api", Namespace: tNamespace}, &svc))
+	return svc.Annotations
+}
+
+func TestSet_AnnotatesTheOriginResource(t *testing.T) {
+	c := newClient(t, allowReviews, service(map[string]string{"team": "core"}))
+	reader := stubReader{views: []domaindns.FQDNView{
+		view(t, tFQDN, "A", tResource),
+		view(t, tFQDN, "AAAA", tResource),
+		view(t, "other.example.com", "A", "ingress/default/other"),
+	}}
+	svc := fqdnignore.NewService(c, reader)
+
+	results, err := svc.Set(context.Background(), fqdnignore.SetInput{
+		Portal:  tPortal,
+		Targets: []fqdnignore.Target{{FQDN: "API.example.com."}},
+		Ignored: true,
+		User:    tUser,
+	})
+	require.NoError(t, err)
+	// Both record types share the Service, which is patched once.
+	require.Len(t, results, 1)
+	assert.Equal(t, tResource, results[0].Resource)
+	assert.Equal(t, fqdnignore.StatusUpdated, results[0].Status)
+	assert.Equal(t, map[string]string{"team": "core", "sreportal.io/ignore": "true"}, annotations(t, c))
+
+	results, err = svc.Set(context.Background(), fqdnignore.SetInput{
+		Portal:  tPortal,
+		Targets: []fqdnignore.Target{{FQDN: tFQDN}},
+		Ignored: true,
+		User:    tUser,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, fqdnignore.StatusUnchanged, results[0].Status)
+
+	// The FQDN is gone from the portal once ignored: it is restored by resource.
+	results, err = svc.Set(context.Background(), fqdnignore.SetInput{
+		Portal:  tPortal,
+		Targets: []fqdnignore.Target{{Resource: tResource}},
+		User:    tUser,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, fqdnignore.StatusUpdated, results[0].Status)
+	assert.Equal(t, map[string]string{"team": "core"}, annotations(t, c))
+}
+
+func TestSet_DryRunDoesNotPersist(t *testing.T) {
+	c := newClient(t, allowReviews, service(nil))
+	svc := fqdnignore.NewService(c, stubReader{views: []domaindns.FQDNView{view(t, tFQDN, "A", tResource)}})
+
+	results, err := svc.Set(context.Background(), fqdnignore.SetInput{
+		Portal:  tPortal,
+		Targets: []fqdnignore.Target{{FQDN: tFQDN}},
+		Ignored: true,
+		DryRun:  true,
+		User:    tUser,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, fqdnignore.StatusUpdated, results[0].Status)
+	assert.Empty(t, annotations(t, c))
+}
+
+func TestSet_ChecksTheCallerPermission(t *testing.T) {
+	var reviews []authorizationv1.SubjectAccessReviewSpec
+	funcs := interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			review, ok := obj.(*authorizationv1.SubjectAccessReview)
+			if !ok {
+				return c.Create(ctx, obj, opts...)
+			}
+			reviews = append(reviews, review.Spec)
+			review.Status.Allowed = review.Spec.User == "alice"
+			return nil
+		},
+	}
+	c := newClient(t, funcs, service(nil))
+	svc := fqdnignore.NewService(c, stubReader{views: []domaindns.FQDNView{view(t, tFQDN, "A", tResource)}})
+
+	results, err := svc.Set(context.Background(), fqdnignore.SetInput{
+		Portal:  tPortal,
+		Targets: []fqdnignore.Target{{FQDN: tFQDN}},
+		Ignored: true,
+		User:    "bob",
+		Groups:  []string{"viewers"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, fqdnignore.StatusDenied, results[0].Status)
+	assert.ErrorIs(t, results[0].Err, fqdnignore.ErrDenied)
+	assert.Empty(t, annotations(t, c))
+
+	require.Len(t, reviews, 1)
+	assert.Equal(t, []string{"viewers"}, reviews[0].Groups)
+	assert.Equal(t, &authorizationv1.ResourceAttributes{
+		Namespace: tNamespace, Verb: "patch", Version: "v1", Resource: "services", Name: "api",
+	}, reviews[0].ResourceAttributes)
+
+	results, err = svc.Set(context.Background(), fqdnignore.SetInput{
+		Portal:  tPortal,
+		Targets: []fqdnignore.Target{{FQDN: tFQDN}},
+		Ignored: true,
+		User:    "alice",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, fqdnignore.StatusUpdated, results[0].Status)
+}
+
+func TestSet_ReportsUnresolvableTargets(t *testing.T) {
+	c := newClient(t, allowReviews)
+	reader := stubReader{views: []domaindns.FQDNView{
+		view(t, "manual.example.com", "A", ""),
+		view(t, "record.example.com", "A", "record/default/r"),
+		view(t, tFQDN, "A", tResource),
+	}}
+	svc := fqdnignore.NewService(c, reader)
+
+	results, err := svc.Set(context.Background(), fqdnignore.SetInput{
+		Portal: tPortal,
+		Targets: []fqdnignore.Target{
+			{FQDN: "missing.example.com"},
+			{FQDN: tFQDN, RecordType: "CNAME"},
+			{FQDN: "manual.example.com"},
+			{FQDN: "record.example.com"},
+			{FQDN: tFQDN},
+			{Resource: "not-a-ref"},
+			{Resource: "service/kube-system/kube-dns"},
+		},
+		Ignored: true,
+		User:    tUser,
+	})
+	require.NoError(t, err)
+	statuses := make([]fqdnignore.Status, 0, len(results))
+	for _, r := range results {
+		statuses = append(statuses, r.Status)
+	}
+	assert.Equal(t, []fqdnignore.Status{
+		fqdnignore.StatusNotFound,
+		fqdnignore.StatusNotFound,
+		fqdnignore.StatusUnsupported,
+		fqdnignore.StatusUnsupported,
+		fqdnignore.StatusNotFound, // the Service does not exist
+		fqdnignore.StatusUnsupported,
+		fqdnignore.StatusNotFound,
+	}, statuses)
+	assert.ErrorIs(t, results[2].Err, fqdnignore.ErrNoOrigin)
+	assert.ErrorIs(t, results[3].Err, fqdnignore.ErrUnsupportedKind)
+	assert.ErrorIs(t, results[6].Err, fqdnignore.ErrNotAnOrigin, "only the origins of the portal FQDNs can be patched")
+}
+
+func TestSet_UnignoresResourceWithoutFQDN(t *testing.T) {
+	// The FQDNs of the ignored Service are no longer listed, even removed.
+	c := newClient(t, allowReviews, service(map[string]string{"sreportal.io/ignore": "true"}))
+	svc := fqdnignore.NewService(c, stubReader{})
+
+	results, err := svc.Set(context.Background(), fqdnignore.SetInput{
+		Portal:  tPortal,
+		Targets: []fqdnignore.Target{{Resource: tResource}},
+		Ignored: true,
+		User:    tUser,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.ErrorIs(t, results[0].Err, fqdnignore.ErrNotAnOrigin, "only origins can be ignored")
+
+	results, err = svc.Set(context.Background(), fqdnignore.SetInput{
+		Portal:  tPortal,
+		Targets: []fqdnignore.Target{{Resource: tResource}},
+		User:    tUser,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, fqdnignore.StatusUpdated, results[0].Status)
+	assert.NotContains(t, annotations(t, c), "sreportal.io/ignore")
+}
+
+func TestSet_ValidatesInput(t *testing.T) {
+	svc := fqdnignore.NewService(newClient(t, allowReviews), stubReader{})
+	ctx := context.Background()
+
+	_, err := svc.Set(ctx, fqdnignore.SetInput{Targets: []fqdnignore.Target{{FQDN: tFQDN}}})
+	assert.ErrorIs(t, err, fqdnignore.ErrPortalRequired)
+	_, err = svc.Set(ctx, fqdnignore.SetInput{Portal: tPortal})
+	assert.ErrorIs(t, err, fqdnignore.ErrNoTargets)
+	_, err = svc.Set(ctx, fqdnignore.SetInput{Portal: tPortal, Targets: make([]fqdnignore.Target, fqdnignore.MaxTargets+1)})
+	assert.ErrorIs(t, err, fqdnignore.ErrTooManyTargets)
+	_, err = svc.Set(ctx, fqdnignore.SetInput{Portal: tPortal, Targets: []fqdnignore.Target{{FQDN: tFQDN}}})
+	assert.ErrorIs(t, err, fqdnignore.ErrNoIdentity)
+	_, err = svc.Set(ctx, fqdnignore.SetInput{Portal: tPortal, Targets: []fqdnignore.Target{{FQDN: " "}}, User: tUser})
+	assert.ErrorIs(t, err, fqdnignore.ErrTargetRequired)
+}
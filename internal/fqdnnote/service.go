@@ -0,0 +1,198 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fqdnnote stores free-text notes attached to an FQDN of a portal,
+// such as on-call handover context. Notes are appended to an FQDNNote CR in
+// the portal namespace and never edited, which keeps an audit trail of who
+// wrote what and when.
+package fqdnnote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/adapter"
+	"github.com/golgoth31/sreportal/internal/statuspage"
+)
+
+const (
+	maxRetries = 5
+
+	// MaxNotes is the maximum number of notes of an FQDN, matching the
+	// MaxItems validation of spec.notes.
+	MaxNotes = 200
+
+	// MaxTextLength is the maximum length of a note, in characters.
+	MaxTextLength = 4096
+)
+
+var (
+	ErrPortalRequired     = errors.New("portal is required")
+	ErrFQDNRequired       = errors.New("fqdn is required")
+	ErrAuthorRequired     = errors.New("author is required")
+	ErrTextRequired       = errors.New("text is required")
+	ErrTextTooLong        = fmt.Errorf("text exceeds %d characters", MaxTextLength)
+	ErrTooManyNotes       = fmt.Errorf("fqdn already has %d notes", MaxNotes)
+	ErrMaxRetriesExceeded = errors.New("max retries exceeded")
+)
+
+// Note is a single note of an FQDN.
+type Note struct {
+	Author    string
+	Text      string
+	CreatedAt time.Time
+}
+
+// AddInput holds a note to append to an FQDN.
+type AddInput struct {
+	// Namespace is the portal namespace, where the FQDNNote lives.
+	Namespace string
+	// Portal is the portal the FQDN belongs to.
+	Portal string
+	FQDN   string
+	Author string
+	Text   string
+}
+
+// +kubebuilder:rbac:groups=sreportal.io,resources=fqdnnotes,verbs=get;list;watch;create;update
+
+// Service reads and appends FQDN notes via the K8s API.
+type Service struct {
+	client client.Client
+	now    func() time.Time
+}
+
+// NewService creates a new FQDN notes Service.
+func NewService(c client.Client) *Service {
+	return &Service{client: c, now: time.Now}
+}
+
+// Add appends a note to the FQDNNote of the FQDN, creating it on the first
+// note. It returns the stored note and the number of notes of the FQDN.
+func (s *Service) Add(ctx context.Context, in AddInput) (Note, int, error) {
+	fqdn := normalizeFQDN(in.FQDN)
+	author := strings.TrimSpace(in.Author)
+	text := strings.TrimSpace(in.Text)
+	switch {
+	case in.Portal == "":
+		return Note{}, 0, ErrPortalRequired
+	case fqdn == "":
+		return Note{}, 0, ErrFQDNRequired
+	case author == "":
+		return Note{}, 0, ErrAuthorRequired
+	case text == "":
+		return Note{}, 0, ErrTextRequired
+	case utf8.RuneCountInString(text) > MaxTextLength:
+		return Note{}, 0, ErrTextTooLong
+	}
+
+	entry := sreportalv1alpha1.FQDNNoteEntry{
+		Author:    author,
+		Text:      text,
+		CreatedAt: metav1.NewTime(s.now().UTC().Truncate(time.Second)),
+	}
+	nn := types.NamespacedName{Name: CRName(in.Portal, fqdn), Namespace: in.Namespace}
+
+	for attempt := range maxRetries {
+		var cr sreportalv1alpha1.FQDNNote
+		err := s.client.Get(ctx, nn, &cr)
+		switch {
+		case apierrors.IsNotFound(err):
+			cr = sreportalv1alpha1.FQDNNote{
+				ObjectMeta: metav1.ObjectMeta{Name: nn.Name, Namespace: nn.Namespace},
+				Spec: sreportalv1alpha1.FQDNNoteSpec{
+					PortalRef: in.Portal,
+					FQDN:      fqdn,
+					Notes:     []sreportalv1alpha1.FQDNNoteEntry{entry},
+				},
+			}
+			adapter.SetStandardLabels(&cr, in.Portal)
+			if err := s.client.Create(ctx, &cr); err != nil {
+				if apierrors.IsAlreadyExists(err) && attempt < maxRetries-1 {
+					continue // Race: another request created it, retry as append
+				}
+				return Note{}, 0, fmt.Errorf("create FQDNNote: %w", err)
+			}
+		case err != nil:
+			return Note{}, 0, fmt.Errorf("get FQDNNote: %w", err)
+		default:
+			if len(cr.Spec.Notes) >= MaxNotes {
+				return Note{}, 0, ErrTooManyNotes
+			}
+			cr.Spec.Notes = append(cr.Spec.Notes, entry)
+			if err := s.client.Update(ctx, &cr); err != nil {
+				if apierrors.IsConflict(err) && attempt < maxRetries-1 {
+					continue
+				}
+				return Note{}, 0, fmt.Errorf("update FQDNNote: %w", err)
+			}
+		}
+		return toNote(entry), len(cr.Spec.Notes), nil
+	}
+
+	return Note{}, 0, fmt.Errorf("add note to %s: %w", fqdn, ErrMaxRetriesExceeded)
+}
+
+// List returns the notes of an FQDN, oldest first. An FQDN without notes
+// returns an empty list.
+func (s *Service) List(ctx context.Context, namespace, portal, fqdn string) ([]Note, error) {
+	fqdn = normalizeFQDN(fqdn)
+	if portal == "" {
+		return nil, ErrPortalRequired
+	}
+	if fqdn == "" {
+		return nil, ErrFQDNRequired
+	}
+
+	var cr sreportalv1alpha1.FQDNNote
+	nn := types.NamespacedName{Name: CRName(portal, fqdn), Namespace: namespace}
+	if err := s.client.Get(ctx, nn, &cr); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get FQDNNote: %w", err)
+	}
+
+	notes := make([]Note, 0, len(cr.Spec.Notes))
+	for _, e := range cr.Spec.Notes {
+		notes = append(notes, toNote(e))
+	}
+	return notes, nil
+}
+
+// CRName returns the name of the FQDNNote holding the notes of fqdn in
+// portal. FQDNs are case-insensitive, so fqdn must be normalized first.
+func CRName(portal, fqdn string) string {
+	return statuspage.GenerateCRName(portal, fqdn)
+}
+
+func normalizeFQDN(fqdn string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(fqdn)), ".")
+}
+
+func toNote(e sreportalv1alpha1.FQDNNoteEntry) Note {
+	return Note{Author: e.Author, Text: e.Text, CreatedAt: e.CreatedAt.Time}
+}
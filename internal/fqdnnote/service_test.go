@@ -0,0 +1,119 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fqdnnote_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/fqdnnote"
+)
+
+const (
+	tNamespace = "default"
+	tPortal    = "main"
+	tFQDN      = "api.example.com"
+)
+
+func newClient(t *testing.T) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha1.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).Build()
+}
+
+func add(t *testing.T, svc *fqdnnote.Service, fqdn, author, text string) (fqdnnote.Note, int) {
+	t.Helper()
+	note, count, err := svc.Add(context.Background(), fqdnnote.AddInput{
+		Namespace: tNamespace, Portal: tPortal, FQDN: fqdn, Author: author, Text: text,
+	})
+	require.NoError(t, err)
+	return note, count
+}
+
+func TestAdd_AppendsNotesToASingleCR(t *testing.T) {
+	c := newClient(t)
+	svc := fqdnnote.NewService(c)
+
+	first, count := add(t, svc, tFQDN, "alice", "cert renewal pending")
+	assert.Equal(t, 1, count)
+	assert.Equal(t, "alice", first.Author)
+	assert.False(t, first.CreatedAt.IsZero())
+
+	// FQDNs are case-insensitive and the trailing dot is ignored.
+	_, count = add(t, svc, "API.example.com.", "bob", "  renewed, watch the error rate  ")
+	assert.Equal(t, 2, count)
+
+	var cr sreportalv1alpha1.FQDNNote
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{
+		Name: fqdnnote.CRName(tPortal, tFQDN), Namespace: tNamespace,
+	}, &cr))
+	assert.Equal(t, tPortal, cr.Spec.PortalRef)
+	assert.Equal(t, tFQDN, cr.Spec.FQDN)
+	assert.Equal(t, tPortal, cr.Labels["sreportal.io/portal"])
+
+	notes, err := svc.List(context.Background(), tNamespace, tPortal, tFQDN)
+	require.NoError(t, err)
+	require.Len(t, notes, 2)
+	assert.Equal(t, "cert renewal pending", notes[0].Text)
+	assert.Equal(t, "bob", notes[1].Author)
+	assert.Equal(t, "renewed, watch the error rate", notes[1].Text)
+}
+
+func TestList_UnknownFQDNReturnsNoNotes(t *testing.T) {
+	svc := fqdnnote.NewService(newClient(t))
+
+	notes, err := svc.List(context.Background(), tNamespace, tPortal, tFQDN)
+	require.NoError(t, err)
+	assert.Empty(t, notes)
+}
+
+func TestAdd_RejectsInvalidInput(t *testing.T) {
+	svc := fqdnnote.NewService(newClient(t))
+	valid := fqdnnote.AddInput{Namespace: tNamespace, Portal: tPortal, FQDN: tFQDN, Author: "alice", Text: "note"}
+
+	tests := []struct {
+		name   string
+		mutate func(*fqdnnote.AddInput)
+		want   error
+	}{
+		{"missing portal", func(in *fqdnnote.AddInput) { in.Portal = "" }, fqdnnote.ErrPortalRequired},
+		{"missing fqdn", func(in *fqdnnote.AddInput) { in.FQDN = " " }, fqdnnote.ErrFQDNRequired},
+		{"missing author", func(in *fqdnnote.AddInput) { in.Author = "" }, fqdnnote.ErrAuthorRequired},
+		{"blank text", func(in *fqdnnote.AddInput) { in.Text = "  " }, fqdnnote.ErrTextRequired},
+		{"text too long", func(in *fqdnnote.AddInput) {
+			in.Text = strings.Repeat("x", fqdnnote.MaxTextLength+1)
+		}, fqdnnote.ErrTextTooLong},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			in := valid
+			tc.mutate(&in)
+			_, _, err := svc.Add(context.Background(), in)
+			require.ErrorIs(t, err, tc.want)
+		})
+	}
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/golgoth31/sreportal/internal/analytics"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	domainportal "github.com/golgoth31/sreportal/internal/domain/portal"
+	portalv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	"github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1/sreportalv1connect"
+)
+
+// defaultUsageStatsLimit is the number of top searches and groups returned
+// when the request sets no limit.
+const defaultUsageStatsLimit = 10
+
+// AnalyticsService implements the AnalyticsServiceHandler interface
+type AnalyticsService struct {
+	sreportalv1connect.UnimplementedAnalyticsServiceHandler
+	recorder *analytics.Recorder
+	catalog  *usageCatalog
+}
+
+// NewAnalyticsService creates a new AnalyticsService. A nil recorder means
+// analytics are disabled: both RPCs return CodeUnimplemented. Events are only
+// counted for the portals listed by portalReader, so beacons cannot grow the
+// counters with arbitrary portal names, and search terms and groups are only
+// counted by name when they are FQDNs and groups listed by fqdnReader.
+func NewAnalyticsService(recorder *analytics.Recorder, portalReader domainportal.PortalReader, fqdnReader domaindns.FQDNReader) *AnalyticsService {
+	return &AnalyticsService{
+		recorder: recorder,
+		catalog:  &usageCatalog{portals: portalReader, fqdns: fqdnReader, groupSep: domaindns.DefaultGroupSeparator},
+	}
+}
+
+// SetGroupSeparator sets the separator splitting group names into the group
+// hierarchy, whose levels count as listed groups.
+func (s *AnalyticsService) SetGroupSeparator(sep string) {
+	s.catalog.groupSep = sep
+}
+
+// RecordUsage counts a usage event
+func (s *AnalyticsService) RecordUsage(
+	ctx context.Context,
+	req *connect.Request[portalv1.RecordUsageRequest],
+) (*connect.Response[portalv1.RecordUsageResponse], error) {
+	if s.recorder == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("analytics are not enabled"))
+	}
+	event, err := usageEventFromProto(req.Msg.Type)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.Portal == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, analytics.ErrPortalRequired)
+	}
+	if !s.recorder.Allow(clientAddress(req.Peer().Addr)) {
+		return nil, connect.NewError(connect.CodeResourceExhausted, errors.New("too many usage events"))
+	}
+	known, err := s.catalog.portalNames(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !known[req.Msg.Portal] {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("portal %q not found", req.Msg.Portal))
+	}
+	listed, err := s.catalog.listed(ctx, req.Msg.Portal, event, req.Msg.Value)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err := s.recorder.Record(req.Msg.Portal, event, req.Msg.Value, listed); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return connect.NewResponse(&portalv1.RecordUsageResponse{}), nil
+}
+
+// GetUsageStats returns the usage counters of a portal, or of every listed
+// portal
+func (s *AnalyticsService) GetUsageStats(
+	ctx context.Context,
+	req *connect.Request[portalv1.GetUsageStatsRequest],
+) (*connect.Response[portalv1.GetUsageStatsResponse], error) {
+	if s.recorder == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("analytics are not enabled"))
+	}
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = defaultUsageStatsLimit
+	}
+	portals, err := s.catalog.portalNames(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if req.Msg.Portal != "" {
+		portals = map[string]bool{req.Msg.Portal: portals[req.Msg.Portal]}
+	}
+
+	stats := s.recorder.Stats(portals, limit)
+	resp := &portalv1.GetUsageStatsResponse{
+		Portals: make([]*portalv1.PortalUsage, 0, len(stats)),
+		Since:   timestamppb.New(s.recorder.Since()),
+	}
+	for _, st := range stats {
+		resp.Portals = append(resp.Portals, &portalv1.PortalUsage{
+			Portal:      st.Portal,
+			PageViews:   st.PageViews,
+			Searches:    st.Searches,
+			GroupClicks: st.GroupClicks,
+			TopSearches: usageCountersToProto(st.TopSearches),
+			TopGroups:   usageCountersToProto(st.TopGroups),
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// clientAddress returns the host of addr, or addr when it has no port.
+func clientAddress(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// usageCatalog caches the portals, and the FQDNs and groups they list, that
+// usage events are checked against. Each set is rebuilt on the first event
+// after its read store changed.
+type usageCatalog struct {
+	portals  domainportal.PortalReader
+	fqdns    domaindns.FQDNReader
+	groupSep string
+
+	mu           sync.Mutex
+	portalsStale <-chan struct{}
+	names        map[string]bool
+	fqdnsStale   <-chan struct{}
+	entries      map[string]*portalEntries
+}
+
+// portalEntries are the FQDNs and groups, with their hierarchy levels, of a
+// portal.
+type portalEntries struct {
+	fqdns  map[string]bool
+	groups map[string]bool
+}
+
+// portalNames returns the names of the portals. The map must not be modified.
+func (c *usageCatalog) portalNames(ctx context.Context) (map[string]bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.names != nil && !storeChanged(c.portalsStale) {
+		return c.names, nil
+	}
+	if c.portals == nil {
+		return nil, errors.New("no portal reader")
+	}
+	stale := c.portals.Subscribe()
+	portals, err := c.portals.List(ctx, domainportal.PortalFilters{})
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool, len(portals))
+	for _, p := range portals {
+		names[p.Name] = true
+	}
+	c.names, c.portalsStale = names, stale
+	return names, nil
+}
+
+// listed reports whether value is an FQDN of portal for a search, or one of
+// its groups or group levels for a group click.
+func (c *usageCatalog) listed(ctx context.Context, portal string, event analytics.EventType, value string) (bool, error) {
+	value = strings.TrimSpace(value)
+	if c.fqdns == nil || value == "" || event == analytics.EventPageView {
+		return false, nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil || storeChanged(c.fqdnsStale) {
+		stale := c.fqdns.Subscribe()
+		views, err := c.fqdns.List(ctx, domaindns.FQDNFilters{})
+		if err != nil {
+			return false, err
+		}
+		entries := map[string]*portalEntries{}
+		for _, v := range views {
+			for _, p := range v.Portals {
+				e := entries[p]
+				if e == nil {
+					e = &portalEntries{fqdns: map[string]bool{}, groups: map[string]bool{}}
+					entries[p] = e
+				}
+				e.fqdns[v.Name] = true
+				for _, g := range v.Groups {
+					e.groups[g] = true
+					for _, level := range domaindns.SplitGroup(g, c.groupSep) {
+						e.groups[level] = true
+					}
+				}
+			}
+		}
+		c.entries, c.fqdnsStale = entries, stale
+	}
+	e := c.entries[portal]
+	if e == nil {
+		return false, nil
+	}
+	if event == analytics.EventSearch {
+		return e.fqdns[strings.ToLower(value)], nil
+	}
+	return e.groups[value], nil
+}
+
+// storeChanged reports whether the store notification ch was sent.
+func storeChanged(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
+
+func usageEventFromProto(t portalv1.UsageEventType) (analytics.EventType, error) {
+	switch t {
+	case portalv1.UsageEventType_USAGE_EVENT_TYPE_PAGE_VIEW:
+		return analytics.EventPageView, nil
+	case portalv1.UsageEventType_USAGE_EVENT_TYPE_SEARCH:
+		return analytics.EventSearch, nil
+	case portalv1.UsageEventType_USAGE_EVENT_TYPE_GROUP_CLICK:
+		return analytics.EventGroupClick, nil
+	default:
+		return "", fmt.Errorf("%w: %s", analytics.ErrUnknownEvent, t)
+	}
+}
+
+func usageCountersToProto(counters []analytics.Counter) []*portalv1.UsageCounter {
+	out := make([]*portalv1.UsageCounter, 0, len(counters))
+	for _, c := range counters {
+		out = append(out, &portalv1.UsageCounter{Value: c.Value, Count: c.Count})
+	}
+	return out
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc_test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golgoth31/sreportal/internal/analytics"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	domainportal "github.com/golgoth31/sreportal/internal/domain/portal"
+	svcgrpc "github.com/golgoth31/sreportal/internal/grpc"
+	portalv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	dnsstore "github.com/golgoth31/sreportal/internal/readstore/dns"
+	portalstore "github.com/golgoth31/sreportal/internal/readstore/portal"
+)
+
+func newAnalyticsService(t *testing.T, recorder *analytics.Recorder) *svcgrpc.AnalyticsService {
+	t.Helper()
+	ctx := context.Background()
+	pstore := portalstore.NewPortalStore()
+	require.NoError(t, pstore.Replace(ctx, "main", domainportal.PortalView{Name: "main", Main: true}))
+	require.NoError(t, pstore.Replace(ctx, "team-a", domainportal.PortalView{Name: "team-a"}))
+	fstore := dnsstore.NewFQDNStore()
+	require.NoError(t, fstore.Replace(ctx, "default/main", "main", []domaindns.FQDNView{
+		{Name: "api.example.com", RecordType: "A", Groups: []string{"Platform/Networking"}, Portals: []string{"main"}},
+	}))
+	return svcgrpc.NewAnalyticsService(recorder, pstore, fstore)
+}
+
+func recordUsage(svc *svcgrpc.AnalyticsService, portal string, typ portalv1.UsageEventType, value string) error {
+	_, err := svc.RecordUsage(context.Background(), connect.NewRequest(&portalv1.RecordUsageRequest{
+		Portal: portal, Type: typ, Value: value,
+	}))
+	return err
+}
+
+func TestAnalytics_RecordsAndReturnsStats(t *testing.T) {
+	svc := newAnalyticsService(t, analytics.NewRecorder(0, 0))
+
+	require.NoError(t, recordUsage(svc, "main", portalv1.UsageEventType_USAGE_EVENT_TYPE_PAGE_VIEW, ""))
+	require.NoError(t, recordUsage(svc, "main", portalv1.UsageEventType_USAGE_EVENT_TYPE_SEARCH, "API.example.com"))
+	require.NoError(t, recordUsage(svc, "main", portalv1.UsageEventType_USAGE_EVENT_TYPE_SEARCH, "typed by a user"))
+	require.NoError(t, recordUsage(svc, "main", portalv1.UsageEventType_USAGE_EVENT_TYPE_GROUP_CLICK, "Platform"))
+	require.NoError(t, recordUsage(svc, "main", portalv1.UsageEventType_USAGE_EVENT_TYPE_GROUP_CLICK, "Unknown"))
+	require.NoError(t, recordUsage(svc, "team-a", portalv1.UsageEventType_USAGE_EVENT_TYPE_SEARCH, "api.example.com"))
+
+	resp, err := svc.GetUsageStats(context.Background(), connect.NewRequest(&portalv1.GetUsageStatsRequest{Portal: "main"}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Portals, 1)
+	usage := resp.Msg.Portals[0]
+	assert.Equal(t, "main", usage.Portal)
+	assert.Equal(t, int64(1), usage.PageViews)
+	assert.Equal(t, int64(2), usage.Searches)
+	assert.Equal(t, int64(2), usage.GroupClicks)
+	// Only the FQDNs and groups the portal lists are counted by name.
+	require.Len(t, usage.TopSearches, 1)
+	assert.Equal(t, "api.example.com", usage.TopSearches[0].Value)
+	require.Len(t, usage.TopGroups, 1)
+	assert.Equal(t, "Platform", usage.TopGroups[0].Value)
+	assert.NotNil(t, resp.Msg.Since)
+}
+
+func TestAnalytics_RejectsInvalidEvents(t *testing.T) {
+	svc := newAnalyticsService(t, analytics.NewRecorder(0, 0))
+
+	err := recordUsage(svc, "unknown", portalv1.UsageEventType_USAGE_EVENT_TYPE_PAGE_VIEW, "")
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	err = recordUsage(svc, "main", portalv1.UsageEventType_USAGE_EVENT_TYPE_UNSPECIFIED, "")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	err = recordUsage(svc, "main", portalv1.UsageEventType_USAGE_EVENT_TYPE_SEARCH, "")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestAnalytics_DisabledReturnsUnimplemented(t *testing.T) {
+	svc := newAnalyticsService(t, nil)
+
+	err := recordUsage(svc, "main", portalv1.UsageEventType_USAGE_EVENT_TYPE_PAGE_VIEW, "")
+	assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+	_, err = svc.GetUsageStats(context.Background(), connect.NewRequest(&portalv1.GetUsageStatsRequest{}))
+	assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+}
+
+func TestAnalytics_StatsOfExistingPortals(t *testing.T) {
+	ctx := context.Background()
+	recorder := analytics.NewRecorder(0, 0)
+	require.NoError(t, recorder.Record("deleted", analytics.EventPageView, "", false))
+	svc := newAnalyticsService(t, recorder)
+	require.NoError(t, recordUsage(svc, "team-a", portalv1.UsageEventType_USAGE_EVENT_TYPE_PAGE_VIEW, ""))
+
+	resp, err := svc.GetUsageStats(ctx, connect.NewRequest(&portalv1.GetUsageStatsRequest{}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Portals, 1)
+	assert.Equal(t, "team-a", resp.Msg.Portals[0].Portal)
+}
+
+func TestAnalytics_CapsEventsPerClient(t *testing.T) {
+	svc := newAnalyticsService(t, analytics.NewRecorder(0, 1))
+
+	require.NoError(t, recordUsage(svc, "main", portalv1.UsageEventType_USAGE_EVENT_TYPE_PAGE_VIEW, ""))
+	err := recordUsage(svc, "main", portalv1.UsageEventType_USAGE_EVENT_TYPE_PAGE_VIEW, "")
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+}
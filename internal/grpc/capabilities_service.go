@@ -0,0 +1,64 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	"github.com/golgoth31/sreportal/internal/config"
+	portalv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	"github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1/sreportalv1connect"
+)
+
+// CapabilitiesService implements the CapabilitiesServiceHandler interface
+type CapabilitiesService struct {
+	sreportalv1connect.UnimplementedCapabilitiesServiceHandler
+	features []*portalv1.FeatureCapability
+	sources  config.SourceCapabilitiesFunc
+}
+
+// NewCapabilitiesService creates a new CapabilitiesService serving the
+// features of caps, fixed at startup, and the sources reported by sources on
+// every call.
+func NewCapabilitiesService(caps config.Capabilities, sources config.SourceCapabilitiesFunc) *CapabilitiesService {
+	features := make([]*portalv1.FeatureCapability, 0, len(caps.Features))
+	for _, f := range caps.Features {
+		features = append(features, &portalv1.FeatureCapability{Name: f.Name, Enabled: f.Enabled, Config: f.Config})
+	}
+	return &CapabilitiesService{features: features, sources: sources}
+}
+
+// GetCapabilities returns the enabled sources and the active features
+func (s *CapabilitiesService) GetCapabilities(
+	ctx context.Context,
+	_ *connect.Request[portalv1.GetCapabilitiesRequest],
+) (*connect.Response[portalv1.GetCapabilitiesResponse], error) {
+	sources, err := s.sources(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	resp := &portalv1.GetCapabilitiesResponse{
+		Sources:  make([]*portalv1.SourceCapability, 0, len(sources)),
+		Features: s.features,
+	}
+	for _, src := range sources {
+		resp.Sources = append(resp.Sources, &portalv1.SourceCapability{Name: src.Name, Enabled: src.Enabled})
+	}
+	return connect.NewResponse(resp), nil
+}
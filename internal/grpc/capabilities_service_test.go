@@ -0,0 +1,62 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golgoth31/sreportal/internal/config"
+	svcgrpc "github.com/golgoth31/sreportal/internal/grpc"
+	portalv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+)
+
+func TestGetCapabilities_ReturnsSourcesAndFeatures(t *testing.T) {
+	svc := svcgrpc.NewCapabilitiesService(config.Capabilities{
+		Features: []config.FeatureCapability{
+			{Name: config.FeatureProbing, Enabled: true, Config: map[string]string{"interval": "1m0s"}},
+		},
+	}, func(context.Context) ([]config.SourceCapability, error) {
+		return []config.SourceCapability{{Name: "service", Enabled: true}, {Name: "ingress"}}, nil
+	})
+
+	resp, err := svc.GetCapabilities(context.Background(), connect.NewRequest(&portalv1.GetCapabilitiesRequest{}))
+	require.NoError(t, err)
+
+	require.Len(t, resp.Msg.Sources, 2)
+	assert.Equal(t, "service", resp.Msg.Sources[0].Name)
+	assert.True(t, resp.Msg.Sources[0].Enabled)
+	assert.False(t, resp.Msg.Sources[1].Enabled)
+	require.Len(t, resp.Msg.Features, 1)
+	assert.Equal(t, config.FeatureProbing, resp.Msg.Features[0].Name)
+	assert.Equal(t, map[string]string{"interval": "1m0s"}, resp.Msg.Features[0].Config)
+}
+
+func TestGetCapabilities_SourcesError(t *testing.T) {
+	svc := svcgrpc.NewCapabilitiesService(config.Capabilities{}, func(context.Context) ([]config.SourceCapability, error) {
+		return nil, errors.New("cache not synced")
+	})
+
+	_, err := svc.GetCapabilities(context.Background(), connect.NewRequest(&portalv1.GetCapabilitiesRequest{}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+}
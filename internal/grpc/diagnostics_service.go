@@ -0,0 +1,84 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/golgoth31/sreportal/internal/diagnostics"
+	diagnosticsv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	"github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1/sreportalv1connect"
+)
+
+// DiagnosticsRunner runs the operator self-diagnostics.
+type DiagnosticsRunner interface {
+	Run(ctx context.Context) diagnostics.Report
+}
+
+// DiagnosticsService implements the DiagnosticsServiceHandler interface.
+type DiagnosticsService struct {
+	sreportalv1connect.UnimplementedDiagnosticsServiceHandler
+	runner DiagnosticsRunner
+}
+
+// NewDiagnosticsService creates a new DiagnosticsService.
+func NewDiagnosticsService(runner DiagnosticsRunner) *DiagnosticsService {
+	return &DiagnosticsService{runner: runner}
+}
+
+// RunDiagnostics runs the checks and returns the report. Failing checks are
+// part of the report, not an error.
+func (s *DiagnosticsService) RunDiagnostics(
+	ctx context.Context,
+	_ *connect.Request[diagnosticsv1.RunDiagnosticsRequest],
+) (*connect.Response[diagnosticsv1.RunDiagnosticsResponse], error) {
+	report := s.runner.Run(ctx)
+
+	checks := make([]*diagnosticsv1.DiagnosticCheck, 0, len(report.Checks))
+	for _, c := range report.Checks {
+		checks = append(checks, &diagnosticsv1.DiagnosticCheck{
+			Category: string(c.Category),
+			Name:     c.Name,
+			Status:   diagnosticStatusToProto(c.Status),
+			Message:  c.Message,
+		})
+	}
+
+	return connect.NewResponse(&diagnosticsv1.RunDiagnosticsResponse{
+		Time:   timestamppb.New(report.Time),
+		Status: diagnosticStatusToProto(report.Status()),
+		Checks: checks,
+	}), nil
+}
+
+func diagnosticStatusToProto(s diagnostics.Status) diagnosticsv1.DiagnosticStatus {
+	switch s {
+	case diagnostics.StatusOK:
+		return diagnosticsv1.DiagnosticStatus_DIAGNOSTIC_STATUS_OK
+	case diagnostics.StatusWarning:
+		return diagnosticsv1.DiagnosticStatus_DIAGNOSTIC_STATUS_WARNING
+	case diagnostics.StatusFailed:
+		return diagnosticsv1.DiagnosticStatus_DIAGNOSTIC_STATUS_FAILED
+	case diagnostics.StatusSkipped:
+		return diagnosticsv1.DiagnosticStatus_DIAGNOSTIC_STATUS_SKIPPED
+	default:
+		return diagnosticsv1.DiagnosticStatus_DIAGNOSTIC_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golgoth31/sreportal/internal/diagnostics"
+	svcgrpc "github.com/golgoth31/sreportal/internal/grpc"
+	diagnosticsv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+)
+
+type fixedDiagnostics diagnostics.Report
+
+func (f fixedDiagnostics) Run(context.Context) diagnostics.Report { return diagnostics.Report(f) }
+
+func TestRunDiagnostics_ReturnsReport(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	svc := svcgrpc.NewDiagnosticsService(fixedDiagnostics{Time: now, Checks: []diagnostics.Check{
+		{Category: diagnostics.CategoryConnectivity, Name: "api server", Status: diagnostics.StatusOK, Message: "Kubernetes v1.33.1"},
+		{Category: diagnostics.CategoryRBAC, Name: "ingresses.networking.k8s.io", Status: diagnostics.StatusFailed, Message: "missing verbs watch"},
+		{Category: diagnostics.CategoryWebhook, Name: "webhook server", Status: diagnostics.StatusSkipped},
+	}})
+
+	resp, err := svc.RunDiagnostics(context.Background(), connect.NewRequest(&diagnosticsv1.RunDiagnosticsRequest{}))
+	require.NoError(t, err)
+
+	assert.Equal(t, now, resp.Msg.Time.AsTime())
+	assert.Equal(t, diagnosticsv1.DiagnosticStatus_DIAGNOSTIC_STATUS_FAILED, resp.Msg.Status)
+	require.Len(t, resp.Msg.Checks, 3)
+	assert.Equal(t, "rbac", resp.Msg.Checks[1].Category)
+	assert.Equal(t, "ingresses.networking.k8s.io", resp.Msg.Checks[1].Name)
+	assert.Equal(t, diagnosticsv1.DiagnosticStatus_DIAGNOSTIC_STATUS_FAILED, resp.Msg.Checks[1].Status)
+	assert.Equal(t, "missing verbs watch", resp.Msg.Checks[1].Message)
+	assert.Equal(t, diagnosticsv1.DiagnosticStatus_DIAGNOSTIC_STATUS_SKIPPED, resp.Msg.Checks[2].Status)
+}
@@ -19,15 +19,36 @@ package grpc
 import (
 	"context"
 	"encoding/base64"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/agent"
+	"github.com/golgoth31/sreportal/internal/auth"
 	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
 	domainportal "github.com/golgoth31/sreportal/internal/domain/portal"
+	"github.com/golgoth31/sreportal/internal/federation"
+	"github.com/golgoth31/sreportal/internal/fqdnignore"
+	"github.com/golgoth31/sreportal/internal/fqdnnote"
 	dnsv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
 	"github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1/sreportalv1connect"
+	"github.com/golgoth31/sreportal/internal/manualdns"
+	"github.com/golgoth31/sreportal/internal/metrics"
+	"github.com/golgoth31/sreportal/internal/qrcode"
+	"github.com/golgoth31/sreportal/internal/sharelink"
+	"github.com/golgoth31/sreportal/pkg/inventory"
 )
 
 // DNSService implements the DNSServiceHandler interface.
@@ -38,11 +59,117 @@ type DNSService struct {
 	sreportalv1connect.UnimplementedDNSServiceHandler
 	reader       domaindns.FQDNReader
 	portalReader domainportal.PortalReader
+	federated    *federation.Searcher
+	sensitive    *domaindns.SensitivePolicy
+	authChain    *auth.Chain
+	groupSep     string
+	manual       *manualdns.Service
+	ignore       *fqdnignore.Service
+	notes        *fqdnnote.Service
+	uniqueness   domaindns.FQDNUniquenessReader
+	agents       *agent.Ingester
+	streams      StreamLimiter
+	sendTimeout  time.Duration
+	heartbeat    time.Duration
+	publicURL    string
+	snapshot     *fqdnSnapshot
+	topics       *fqdnTopics
+}
+
+// StreamLimiter admits the long-lived FQDN streams.
+type StreamLimiter interface {
+	// AcquireStream admits a new stream, or reports false when too many
+	// are open. release must be called when the stream ends.
+	AcquireStream() (release func(), ok bool)
 }
 
 // NewDNSService creates a new DNSService backed by a FQDNReader.
 func NewDNSService(reader domaindns.FQDNReader, portalReader domainportal.PortalReader) *DNSService {
-	return &DNSService{reader: reader, portalReader: portalReader}
+	return &DNSService{
+		reader:       reader,
+		portalReader: portalReader,
+		groupSep:     domaindns.DefaultGroupSeparator,
+		snapshot:     &fqdnSnapshot{},
+		topics:       &fqdnTopics{},
+	}
+}
+
+// SetGroupSeparator sets the separator splitting group names into the group
+// hierarchy returned by ListFQDNs. An empty separator keeps groups flat.
+func (s *DNSService) SetGroupSeparator(sep string) {
+	s.groupSep = sep
+}
+
+// SetPublicURL sets the external URL of the web UI that GetShareLink builds
+// links from. Without it links are built from the Origin header of the
+// request.
+func (s *DNSService) SetPublicURL(u string) {
+	s.publicURL = u
+}
+
+// SetFederatedSearcher enables FederatedSearch. Without it the RPC returns
+// CodeUnimplemented.
+func (s *DNSService) SetFederatedSearcher(searcher *federation.Searcher) {
+	s.federated = searcher
+}
+
+// SetManualEntriesWriter enables BatchUpdateManualEntries. Without it the RPC
+// returns CodeUnimplemented.
+func (s *DNSService) SetManualEntriesWriter(w *manualdns.Service) {
+	s.manual = w
+}
+
+// SetIgnoreWriter enables SetFQDNsIgnored. Without it the RPC returns
+// CodeUnimplemented.
+func (s *DNSService) SetIgnoreWriter(w *fqdnignore.Service) {
+	s.ignore = w
+}
+
+// SetNotesService enables AddNote and ListNotes. Without it the RPCs return
+// CodeUnimplemented.
+func (s *DNSService) SetNotesService(n *fqdnnote.Service) {
+	s.notes = n
+}
+
+// SetUniquenessReader enables GetUniquenessReport. Without it the RPC returns
+// CodeUnimplemented.
+func (s *DNSService) SetUniquenessReader(r domaindns.FQDNUniquenessReader) {
+	s.uniqueness = r
+}
+
+// SetAgentIngester enables PublishEndpoints. Without it the RPC returns
+// CodeUnimplemented.
+func (s *DNSService) SetAgentIngester(i *agent.Ingester) {
+	s.agents = i
+}
+
+// SetStreamLimiter bounds the concurrent StreamFQDNs calls: the calls it
+// refuses fail with CodeResourceExhausted.
+func (s *DNSService) SetStreamLimiter(l StreamLimiter) {
+	s.streams = l
+}
+
+// SetStreamSendTimeout disconnects the StreamFQDNs subscribers that do not
+// read an update within timeout. It requires the handler to be wrapped with
+// WithSendDeadlines; zero never disconnects.
+func (s *DNSService) SetStreamSendTimeout(timeout time.Duration) {
+	s.sendTimeout = timeout
+}
+
+// SetStreamHeartbeatInterval sends a heartbeat to the StreamFQDNs subscribers
+// that received nothing for interval, so that proxies keep idle streams open
+// and the send timeout also catches subscribers that stopped reading while no
+// FQDN changes. Zero sends no heartbeat.
+func (s *DNSService) SetStreamHeartbeatInterval(interval time.Duration) {
+	s.heartbeat = interval
+}
+
+// SetSensitivePolicy flags FQDNs matching the policy as sensitive. When the
+// policy hides them from anonymous callers, requests not accepted by chain (or
+// every request when chain is nil) never see them.
+func (s *DNSService) SetSensitivePolicy(policy *domaindns.SensitivePolicy, chain *auth.Chain) {
+	s.sensitive = policy
+	s.authChain = chain
 }
 
 // ListFQDNs returns all aggregated FQDNs with optional filters and cursor-based pagination.
@@ -56,21 +183,37 @@ func (s *DNSService) ListFQDNs(
 		return connect.NewResponse(&dnsv1.ListFQDNsResponse{}), nil
 	}
 
+	targetScope := domaindns.TargetScope(req.Msg.TargetScope)
+	if !targetScope.Valid() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown target_scope %q", req.Msg.TargetScope))
+	}
+	if err := validateFQDNView(req.Msg.View); err != nil {
+		return nil, err
+	}
+	window, err := lastSeenWindow(req.Msg.LastSeenAfter, req.Msg.LastSeenBefore)
+	if err != nil {
+		return nil, err
+	}
+
 	filters := domaindns.FQDNFilters{
-		Portal:    req.Msg.Portal,
-		Namespace: req.Msg.Namespace,
-		Source:    req.Msg.Source,
-		Search:    req.Msg.Search,
+		Portal:         req.Msg.Portal,
+		Namespace:      req.Msg.Namespace,
+		Source:         req.Msg.Source,
+		Search:         req.Msg.Search,
+		TargetScope:    targetScope,
+		IncludeRemoved: req.Msg.IncludeRemoved,
+		LastSeen:       window,
 	}
 
 	views, err := s.reader.List(ctx, filters)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	views = s.filterSensitive(views, s.authenticated(ctx, req.Header()))
 
 	fqdns := make([]*dnsv1.FQDN, 0, len(views))
 	for _, v := range views {
-		fqdns = append(fqdns, fqdnViewToProto(v))
+		fqdns = append(fqdns, applyFQDNView(s.toProto(v), req.Msg.View))
 	}
 
 	// Pagination: page_size=0 means return all (backward-compatible default).
@@ -94,11 +237,13 @@ func (s *DNSService) ListFQDNs(
 		Fqdns:         fqdns,
 		NextPageToken: nextPageToken,
 		TotalSize:     totalSize,
+		Groups:        groupTreeToProto(domaindns.BuildGroupTree(views, s.groupSep)),
 	}), nil
 }
 
 // StreamFQDNs streams FQDN updates in real-time using the ReadStore's
-// Subscribe() notification channel instead of polling.
+// Subscribe() notification channel instead of polling. The updates are
+// computed by a topic shared with the other streams using the same filters.
 func (s *DNSService) StreamFQDNs(
 	ctx context.Context,
 	req *connect.Request[dnsv1.StreamFQDNsRequest],
@@ -109,43 +254,122 @@ func (s *DNSService) StreamFQDNs(
 	} else if !enabled {
 		return nil
 	}
+	if err := validateFQDNView(req.Msg.View); err != nil {
+		return err
+	}
+	window, err := lastSeenWindow(req.Msg.LastSeenAfter, req.Msg.LastSeenBefore)
+	if err != nil {
+		return err
+	}
+	if s.streams != nil {
+		release, ok := s.streams.AcquireStream()
+		if !ok {
+			return connect.NewError(connect.CodeResourceExhausted, errors.New("too many open FQDN streams, retry later"))
+		}
+		defer release()
+	}
+	metrics.StreamsOpen.Inc()
+	defer metrics.StreamsOpen.Dec()
+	view := req.Msg.View
 
 	filters := domaindns.FQDNFilters{
-		Portal:    req.Msg.Portal,
-		Namespace: req.Msg.Namespace,
-		Source:    req.Msg.Source,
-		Search:    req.Msg.Search,
+		Portal:         req.Msg.Portal,
+		Namespace:      req.Msg.Namespace,
+		Source:         req.Msg.Source,
+		Search:         req.Msg.Search,
+		TargetScope:    domaindns.TargetScope(req.Msg.TargetScope),
+		IncludeRemoved: req.Msg.IncludeRemoved,
+		LastSeen:       window,
+	}
+
+	// Authentication is checked once: the stream keeps the caller's visibility.
+	authenticated := s.authenticated(ctx, req.Header())
+	var groups []string
+	if p := auth.PrincipalFromContext(ctx); p != nil {
+		groups = p.Groups
+	}
+
+	// Streams with the same filters, view and visibility share a topic,
+	// which lists and diffs the FQDNs once per store change for all of them.
+	// The topic lists as a principal holding the caller's groups, which
+	// decide the portals a visibility-restricted reader returns.
+	key := topicKey{filters: filters, basic: view == dnsv1.FQDNView_FQDN_VIEW_BASIC, authenticated: authenticated, groups: groupsKey(groups)}
+	var principal *auth.Principal
+	if len(groups) > 0 {
+		principal = &auth.Principal{Groups: slices.Clone(groups)}
+	}
+	topic, detach := s.topics.attach(key, s.reader.Subscribe, func(ctx context.Context, gen <-chan struct{}) ([]*dnsv1.FQDN, error) {
+		if principal != nil {
+			ctx = auth.WithPrincipal(ctx, principal)
+		}
+		views, err := s.reader.List(ctx, filters)
+		if err != nil {
+			return nil, err
+		}
+		views = s.filterSensitive(views, authenticated)
+		fqdns := make([]*dnsv1.FQDN, len(views))
+		for i, v := range views {
+			fqdns[i] = s.snapshot.proto(gen, v, view, s.toProto)
+		}
+		return fqdns, nil
+	})
+	defer detach()
+
+	deadline := newSendDeadline(ctx, s.sendTimeout)
+	send := func(update *dnsv1.StreamFQDNsResponse) error {
+		deadline.arm()
+		err := stream.Send(update)
+		if deadline.expired(err) {
+			metrics.StreamEvictionsTotal.Inc()
+			return connect.NewError(connect.CodeResourceExhausted, errors.New("stream subscriber stopped reading updates"))
+		}
+		return err
 	}
 
 	// Send initial state.
-	views, err := s.reader.List(ctx, filters)
+	current, err := topic.first(ctx)
 	if err != nil {
-		return err
+		return nil
 	}
-	for _, v := range views {
-		if err := stream.Send(&dnsv1.StreamFQDNsResponse{
-			Type: dnsv1.UpdateType_UPDATE_TYPE_ADDED,
-			Fqdn: fqdnViewToProto(v),
-		}); err != nil {
+	if current.err != nil {
+		return current.err
+	}
+	for _, fqdn := range current.fqdns {
+		if err := send(&dnsv1.StreamFQDNsResponse{Type: dnsv1.UpdateType_UPDATE_TYPE_ADDED, Fqdn: fqdn}); err != nil {
 			return err
 		}
 	}
+	// Followers of remote portals drop the FQDNs missing from the initial
+	// state once it is complete.
+	if err := send(&dnsv1.StreamFQDNsResponse{Type: dnsv1.UpdateType_UPDATE_TYPE_SYNCED}); err != nil {
+		return err
+	}
+	deadline.disarm()
 
-	// Build previous-state map for diffing.
-	previousFQDNs := make(map[string]*dnsv1.FQDN, len(views))
-	for _, v := range views {
-		proto := fqdnViewToProto(v)
-		previousFQDNs[proto.Name+"/"+proto.RecordType] = proto
+	// Heartbeats are only sent after an interval without any message.
+	var heartbeat <-chan time.Time
+	resetHeartbeat := func() {}
+	if s.heartbeat > 0 {
+		ticker := time.NewTicker(s.heartbeat)
+		defer ticker.Stop()
+		heartbeat = ticker.C
+		resetHeartbeat = func() { ticker.Reset(s.heartbeat) }
 	}
 
-	// Wait for store notifications and diff.
+	// Wait for the topic versions and send their changes.
+	var state subscriberState
+	defer state.release()
 	for {
-		updateCh := s.reader.Subscribe()
-
 		select {
 		case <-ctx.Done():
 			return nil
-		case <-updateCh:
+		case <-heartbeat:
+			if err := send(&dnsv1.StreamFQDNsResponse{Type: dnsv1.UpdateType_UPDATE_TYPE_HEARTBEAT}); err != nil {
+				return err
+			}
+			deadline.disarm()
+			continue
+		case <-current.next:
 		}
 
 		// Re-check feature gate: if disabled mid-stream, close gracefully.
@@ -155,53 +379,731 @@ func (s *DNSService) StreamFQDNs(
 			return nil
 		}
 
-		views, err = s.reader.List(ctx, filters)
+		latest := topic.current()
+		if latest.err != nil {
+			return latest.err
+		}
+		state.hold(current, latest)
+		updates := latest.since(current)
+		for _, update := range updates {
+			if err := send(update); err != nil {
+				return err
+			}
+		}
+		deadline.disarm()
+		if len(updates) > 0 {
+			resetHeartbeat()
+		}
+		current = latest
+		state.hold(current, topic.current())
+	}
+}
+
+// FederatedSearch searches FQDNs locally and on every remote portal, merging
+// results found on several sites. Sites that fail or time out are reported in
+// the response errors instead of failing the call.
+func (s *DNSService) FederatedSearch(
+	ctx context.Context,
+	req *connect.Request[dnsv1.FederatedSearchRequest],
+) (*connect.Response[dnsv1.FederatedSearchResponse], error) {
+	if s.federated == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("federated search is not enabled"))
+	}
+	if strings.TrimSpace(req.Msg.Search) == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("search is required"))
+	}
+
+	results, siteErrors, err := s.federated.Search(ctx, req.Msg.Search, req.Msg.Source)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	authenticated := s.authenticated(ctx, req.Header())
+	resp := &dnsv1.FederatedSearchResponse{
+		Results: make([]*dnsv1.FederatedFQDN, 0, len(results)),
+	}
+	for _, r := range results {
+		if !s.sensitive.Visible(r.FQDN.Name, authenticated) {
+			continue
+		}
+		resp.Results = append(resp.Results, &dnsv1.FederatedFQDN{
+			Fqdn:  s.toProto(r.FQDN),
+			Sites: r.Sites,
+		})
+	}
+	for _, e := range siteErrors {
+		resp.Errors = append(resp.Errors, &dnsv1.FederatedSiteError{
+			Site:  e.Site,
+			Error: e.Err.Error(),
+		})
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// BatchUpdateManualEntries applies a batch of edits to the manual entries of a
+// local portal in a single DNSRecord write.
+func (s *DNSService) BatchUpdateManualEntries(
+	ctx context.Context,
+	req *connect.Request[dnsv1.BatchUpdateManualEntriesRequest],
+) (*connect.Response[dnsv1.BatchUpdateManualEntriesResponse], error) {
+	if s.manual == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("manual entries editing is not enabled"))
+	}
+	if req.Msg.Portal == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, manualdns.ErrPortalRequired)
+	}
+	portal, err := s.portalNamed(ctx, req.Msg.Portal)
+	if err != nil {
+		return nil, err
+	}
+	if portal.IsRemote {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("portal %q is remote", portal.Name))
+	}
+	if !portal.Features.DNS {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("dns feature is disabled for portal %q", portal.Name))
+	}
+
+	in := manualdns.BatchInput{
+		Namespace:       portal.Namespace,
+		Portal:          portal.Name,
+		Record:          req.Msg.DnsRecord,
+		ResourceVersion: req.Msg.ResourceVersion,
+		Operations:      make([]manualdns.Operation, 0, len(req.Msg.Operations)),
+	}
+	for i, op := range req.Msg.Operations {
+		t, err := manualEntryOperationTypeFromProto(op.Type)
 		if err != nil {
-			return err
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("operation %d: %w", i, err))
 		}
+		in.Operations = append(in.Operations, manualdns.Operation{Type: t, Entry: manualEntryFromProto(op.Entry)})
+	}
 
-		currentFQDNs := make(map[string]*dnsv1.FQDN, len(views))
-		for _, v := range views {
-			fqdn := fqdnViewToProto(v)
-			key := fqdn.Name + "/" + fqdn.RecordType
-			currentFQDNs[key] = fqdn
+	res, err := s.manual.BatchUpdate(ctx, in)
+	if err != nil {
+		return nil, manualEntriesConnectError(err)
+	}
+	return connect.NewResponse(&dnsv1.BatchUpdateManualEntriesResponse{
+		DnsRecord:       res.Record,
+		ResourceVersion: res.ResourceVersion,
+		EntryCount:      int32(res.EntryCount),
+	}), nil
+}
 
-			prev, exists := previousFQDNs[key]
-			if !exists {
-				if err := stream.Send(&dnsv1.StreamFQDNsResponse{
-					Type: dnsv1.UpdateType_UPDATE_TYPE_ADDED,
-					Fqdn: fqdn,
-				}); err != nil {
-					return err
-				}
-			} else if !fqdnEqual(prev, fqdn) {
-				if err := stream.Send(&dnsv1.StreamFQDNsResponse{
-					Type: dnsv1.UpdateType_UPDATE_TYPE_MODIFIED,
-					Fqdn: fqdn,
-				}); err != nil {
-					return err
-				}
-			}
+// SetFQDNsIgnored sets or clears the ignore annotation of the origin
+// resources of FQDNs of a local portal. The caller must be authenticated and
+// allowed to patch each resource in Kubernetes.
+func (s *DNSService) SetFQDNsIgnored(
+	ctx context.Context,
+	req *connect.Request[dnsv1.SetFQDNsIgnoredRequest],
+) (*connect.Response[dnsv1.SetFQDNsIgnoredResponse], error) {
+	if s.ignore == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ignore management is not enabled"))
+	}
+	if req.Msg.Portal == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fqdnignore.ErrPortalRequired)
+	}
+	user := auth.IdentityFromContext(ctx)
+	if user == "" {
+		return nil, connect.NewError(connect.CodePermissionDenied, fqdnignore.ErrNoIdentity)
+	}
+	portal, err := s.portalNamed(ctx, req.Msg.Portal)
+	if err != nil {
+		return nil, err
+	}
+	if portal.IsRemote {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("portal %q is remote", portal.Name))
+	}
+	if !portal.Features.DNS {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("dns feature is disabled for portal %q", portal.Name))
+	}
+
+	in := fqdnignore.SetInput{
+		Portal:  portal.Name,
+		Targets: make([]fqdnignore.Target, 0, len(req.Msg.Targets)),
+		Ignored: req.Msg.Ignored,
+		DryRun:  req.Msg.DryRun,
+		User:    user,
+	}
+	for _, t := range req.Msg.Targets {
+		in.Targets = append(in.Targets, fqdnignore.Target{FQDN: t.Fqdn, RecordType: t.RecordType, Resource: t.Resource})
+	}
+	if p := auth.PrincipalFromContext(ctx); p != nil && p.Subject == user {
+		in.Groups = p.Groups
+	}
+
+	results, err := s.ignore.Set(ctx, in)
+	if err != nil {
+		if errors.Is(err, fqdnignore.ErrPortalRequired) ||
+			errors.Is(err, fqdnignore.ErrNoTargets) ||
+			errors.Is(err, fqdnignore.ErrTooManyTargets) ||
+			errors.Is(err, fqdnignore.ErrTargetRequired) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
 		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	resp := &dnsv1.SetFQDNsIgnoredResponse{
+		Results: make([]*dnsv1.IgnoreResult, 0, len(results)),
+		DryRun:  req.Msg.DryRun,
+	}
+	for _, r := range results {
+		resp.Results = append(resp.Results, ignoreResultToProto(r))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// PublishEndpoints replaces the FQDNs an agent discovered for a local portal,
+// held in the DNSRecord of the agent. The agent is the one declared with the
+// authenticated identity of the caller; the agent of the request, when set,
+// must match it.
+func (s *DNSService) PublishEndpoints(
+	ctx context.Context,
+	req *connect.Request[dnsv1.PublishEndpointsRequest],
+) (*connect.Response[dnsv1.PublishEndpointsResponse], error) {
+	if s.agents == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("agent ingestion is not enabled"))
+	}
+	if req.Msg.Portal == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("portal is required"))
+	}
+	name, err := s.agents.AgentOf(auth.IdentityFromContext(ctx))
+	if err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	if req.Msg.Agent != "" && req.Msg.Agent != name {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("agent %q does not match the authenticated agent %q", req.Msg.Agent, name))
+	}
+	if err := s.agents.Authorize(name, req.Msg.Portal); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	portal, err := s.portalNamed(ctx, req.Msg.Portal)
+	if err != nil {
+		return nil, err
+	}
+	if portal.IsRemote {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("portal %q is remote", portal.Name))
+	}
+	if !portal.Features.DNS {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("dns feature is disabled for portal %q", portal.Name))
+	}
+
+	entries, err := entriesFromAgent(req.Msg.Fqdns)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := s.agents.Publish(ctx, name, portal.Namespace, portal.Name, entries); err != nil {
+		if errors.Is(err, agent.ErrNoDNS) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&dnsv1.PublishEndpointsResponse{FqdnCount: int32(len(entries))}), nil
+}
 
-		for key, fqdn := range previousFQDNs {
-			if _, exists := currentFQDNs[key]; !exists {
-				if err := stream.Send(&dnsv1.StreamFQDNsResponse{
-					Type: dnsv1.UpdateType_UPDATE_TYPE_DELETED,
-					Fqdn: fqdn,
-				}); err != nil {
-					return err
+// AddNote appends a note to an FQDN of a portal.
+func (s *DNSService) AddNote(
+	ctx context.Context,
+	req *connect.Request[dnsv1.AddNoteRequest],
+) (*connect.Response[dnsv1.AddNoteResponse], error) {
+	if s.notes == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fqdn notes are not enabled"))
+	}
+	if req.Msg.Portal == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fqdnnote.ErrPortalRequired)
+	}
+	portal, err := s.portalNamed(ctx, req.Msg.Portal)
+	if err != nil {
+		return nil, err
+	}
+	if fqdn := strings.ToLower(strings.TrimSpace(req.Msg.Fqdn)); fqdn != "" {
+		if err := s.requireFQDN(ctx, portal.Name, fqdn); err != nil {
+			return nil, err
+		}
+	}
+	// The authenticated identity is the author; the client only names it
+	// when authentication is disabled.
+	author := auth.IdentityFromContext(ctx)
+	if author == "" {
+		author = req.Msg.Author
+	}
+
+	note, count, err := s.notes.Add(ctx, fqdnnote.AddInput{
+		Namespace: portal.Namespace,
+		Portal:    portal.Name,
+		FQDN:      req.Msg.Fqdn,
+		Author:    author,
+		Text:      req.Msg.Text,
+	})
+	if err != nil {
+		return nil, notesConnectError(err)
+	}
+	return connect.NewResponse(&dnsv1.AddNoteResponse{
+		Note:      noteToProto(note),
+		NoteCount: int32(count),
+	}), nil
+}
+
+// ListNotes returns the notes of an FQDN of a portal, oldest first.
+func (s *DNSService) ListNotes(
+	ctx context.Context,
+	req *connect.Request[dnsv1.ListNotesRequest],
+) (*connect.Response[dnsv1.ListNotesResponse], error) {
+	if s.notes == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fqdn notes are not enabled"))
+	}
+	if req.Msg.Portal == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fqdnnote.ErrPortalRequired)
+	}
+	portal, err := s.portalNamed(ctx, req.Msg.Portal)
+	if err != nil {
+		return nil, err
+	}
+
+	notes, err := s.notes.List(ctx, portal.Namespace, portal.Name, req.Msg.Fqdn)
+	if err != nil {
+		return nil, notesConnectError(err)
+	}
+	out := make([]*dnsv1.FQDNNote, 0, len(notes))
+	for _, n := range notes {
+		out = append(out, noteToProto(n))
+	}
+	return connect.NewResponse(&dnsv1.ListNotesResponse{Notes: out}), nil
+}
+
+// shareLinkQRScale is the size in pixels of a QR code module.
+const shareLinkQRScale = 8
+
+// GetShareLink returns a stable link to an FQDN of a portal, and optionally
+// its QR code.
+func (s *DNSService) GetShareLink(
+	ctx context.Context,
+	req *connect.Request[dnsv1.GetShareLinkRequest],
+) (*connect.Response[dnsv1.GetShareLinkResponse], error) {
+	if req.Msg.Portal == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("portal is required"))
+	}
+	fqdn := strings.ToLower(strings.TrimSpace(req.Msg.Fqdn))
+	if fqdn == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("fqdn is required"))
+	}
+	portal, err := s.portalNamed(ctx, req.Msg.Portal)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireFQDN(ctx, portal.Name, fqdn); err != nil {
+		return nil, err
+	}
+
+	base := s.publicURL
+	if base == "" {
+		base = requestOrigin(req.Header())
+	}
+	resp := &dnsv1.GetShareLinkResponse{
+		Url:  sharelink.URL(base, portal.Name, fqdn),
+		Path: sharelink.Path(portal.Name, fqdn),
+	}
+	if req.Msg.QrCode {
+		code, err := qrcode.Encode(resp.Url)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("qr code: %w", err))
+		}
+		if resp.QrCodePng, err = code.PNG(shareLinkQRScale); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// GetUniquenessReport lists the FQDNs listed in several portals, or whose
+// contributing DNSRecords disagree on the targets.
+func (s *DNSService) GetUniquenessReport(
+	ctx context.Context,
+	req *connect.Request[dnsv1.GetUniquenessReportRequest],
+) (*connect.Response[dnsv1.GetUniquenessReportResponse], error) {
+	if s.uniqueness == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fqdn uniqueness report is not enabled"))
+	}
+	authenticated := s.authenticated(ctx, req.Header())
+	visible, err := s.visiblePortals(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dnsv1.GetUniquenessReportResponse{}
+	for _, issue := range s.uniqueness.UniquenessReport() {
+		if req.Msg.Portal != "" && !slices.Contains(issue.Portals, req.Msg.Portal) {
+			continue
+		}
+		if !s.sensitive.Visible(issue.Name, authenticated) {
+			continue
+		}
+		if visible != nil && !restrictIssue(&issue, visible) {
+			continue
+		}
+		resp.Issues = append(resp.Issues, uniquenessIssueToProto(issue))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// visiblePortals returns the names of the portals listed by the portal
+// reader, which hides the portals the caller may not see, or nil without a
+// portal reader.
+func (s *DNSService) visiblePortals(ctx context.Context) (map[string]bool, error) {
+	if s.portalReader == nil {
+		return nil, nil
+	}
+	portals, err := s.portalReader.List(ctx, domainportal.PortalFilters{})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	visible := make(map[string]bool, len(portals))
+	for _, p := range portals {
+		visible[p.Name] = true
+	}
+	return visible, nil
+}
+
+// restrictIssue removes the portals, contributions and sources of issue that
+// are not visible and reports whether a visible portal is left.
+func restrictIssue(issue *domaindns.UniquenessIssue, visible map[string]bool) bool {
+	issue.Portals = slices.DeleteFunc(slices.Clone(issue.Portals), func(p string) bool { return !visible[p] })
+	if len(issue.Portals) == 0 {
+		return false
+	}
+	issue.Contributions = slices.DeleteFunc(slices.Clone(issue.Contributions), func(c domaindns.FQDNContribution) bool {
+		return !visible[c.Portal]
+	})
+	issue.Sources = issue.Sources[:0:0]
+	for _, c := range issue.Contributions {
+		issue.Sources = append(issue.Sources, c.SourceLabel())
+	}
+	slices.Sort(issue.Sources)
+	issue.Sources = slices.Compact(issue.Sources)
+	return true
+}
+
+func uniquenessIssueToProto(issue domaindns.UniquenessIssue) *dnsv1.UniquenessIssue {
+	out := &dnsv1.UniquenessIssue{
+		Name:       issue.Name,
+		RecordType: issue.RecordType,
+		Severity:   string(issue.Severity),
+		Portals:    issue.Portals,
+		Sources:    issue.Sources,
+	}
+	for _, c := range issue.Contributions {
+		out.Contributions = append(out.Contributions, &dnsv1.FQDNContribution{
+			DnsRecord:  &dnsv1.DNSRecordRef{Namespace: c.Record.Namespace, Name: c.Record.Name},
+			Portal:     c.Portal,
+			Source:     string(c.Source),
+			SourceType: c.SourceType,
+			Targets:    c.Targets,
+		})
+	}
+	return out
+}
+
+// requireFQDN returns a NotFound error unless portal lists fqdn, which must
+// be lowercase.
+func (s *DNSService) requireFQDN(ctx context.Context, portal, fqdn string) error {
+	views, err := s.reader.List(ctx, domaindns.FQDNFilters{Portal: portal, Search: fqdn})
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if !slices.ContainsFunc(views, func(v domaindns.FQDNView) bool { return v.Name == fqdn }) {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("fqdn %q not found in portal %q", fqdn, portal))
+	}
+	return nil
+}
+
+// requestOrigin returns the scheme and host of the Origin header, or "" when
+// it is missing or malformed.
+func requestOrigin(h http.Header) string {
+	u, err := url.Parse(h.Get("Origin"))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ""
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+func noteToProto(n fqdnnote.Note) *dnsv1.FQDNNote {
+	return &dnsv1.FQDNNote{
+		Author:    n.Author,
+		Text:      n.Text,
+		CreatedAt: timestamppb.New(n.CreatedAt),
+	}
+}
+
+func notesConnectError(err error) *connect.Error {
+	switch {
+	case errors.Is(err, fqdnnote.ErrPortalRequired),
+		errors.Is(err, fqdnnote.ErrFQDNRequired),
+		errors.Is(err, fqdnnote.ErrAuthorRequired),
+		errors.Is(err, fqdnnote.ErrTextRequired),
+		errors.Is(err, fqdnnote.ErrTextTooLong),
+		apierrors.IsInvalid(err):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, fqdnnote.ErrTooManyNotes):
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// entriesFromAgent converts the FQDNs pushed by an agent to DNSRecord
+// entries, merging the groups and targets of an FQDN sent several times.
+// Entries are sorted by FQDN and record type, so an unchanged push leaves
+// the DNSRecord spec untouched.
+func entriesFromAgent(fqdns []*dnsv1.FQDN) ([]v1alpha2.DNSRecordEntry, error) {
+	endpoints := make([]inventory.Endpoint, 0, len(fqdns))
+	origins := make(map[string]string, len(fqdns))
+	for _, f := range fqdns {
+		if !domaindns.ValidFQDN(f.GetName()) {
+			return nil, fmt.Errorf("invalid fqdn %q", f.GetName())
+		}
+		recordType := f.GetRecordType()
+		if recordType == "" {
+			recordType = "A"
+		}
+		if !domaindns.ValidRecordType(recordType) {
+			return nil, fmt.Errorf("fqdn %q: unsupported record type %q", f.GetName(), recordType)
+		}
+		e := inventory.Endpoint{
+			Name:        f.GetName(),
+			RecordType:  recordType,
+			Description: f.GetDescription(),
+			Targets:     f.GetTargets(),
+			Groups:      f.GetGroups(),
+		}
+		// The origin, like the description, comes from the first occurrence.
+		if _, seen := origins[e.Key()]; !seen {
+			origins[e.Key()] = ""
+			if o := f.GetOriginRef(); o != nil {
+				raw := o.GetKind() + "/" + o.GetNamespace() + "/" + o.GetName()
+				if _, err := domaindns.ParseResourceRef(raw); err == nil {
+					origins[e.Key()] = raw
 				}
 			}
 		}
+		endpoints = append(endpoints, e)
+	}
+
+	merged := inventory.Merge(endpoints)
+	entries := make([]v1alpha2.DNSRecordEntry, 0, len(merged))
+	for _, e := range merged {
+		entries = append(entries, v1alpha2.DNSRecordEntry{
+			FQDN:        e.Name,
+			Description: e.Description,
+			RecordType:  e.RecordType,
+			Targets:     e.Targets,
+			Groups:      e.Groups,
+			OriginRef:   origins[e.Key()],
+		})
+	}
+	return entries, nil
+}
+
+// portalNamed returns the portal named name, or a CodeNotFound error.
+func (s *DNSService) portalNamed(ctx context.Context, name string) (domainportal.PortalView, error) {
+	if s.portalReader == nil {
+		return domainportal.PortalView{}, connect.NewError(connect.CodeInternal, errors.New("no portal reader"))
+	}
+	portals, err := s.portalReader.List(ctx, domainportal.PortalFilters{})
+	if err != nil {
+		return domainportal.PortalView{}, connect.NewError(connect.CodeInternal, err)
+	}
+	for _, p := range portals {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+	return domainportal.PortalView{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("portal %q not found", name))
+}
+
+func manualEntryOperationTypeFromProto(t dnsv1.ManualEntryOperationType) (manualdns.OperationType, error) {
+	switch t {
+	case dnsv1.ManualEntryOperationType_MANUAL_ENTRY_OPERATION_TYPE_ADD:
+		return manualdns.OperationAdd, nil
+	case dnsv1.ManualEntryOperationType_MANUAL_ENTRY_OPERATION_TYPE_UPDATE:
+		return manualdns.OperationUpdate, nil
+	case dnsv1.ManualEntryOperationType_MANUAL_ENTRY_OPERATION_TYPE_DELETE:
+		return manualdns.OperationDelete, nil
+	default:
+		return 0, fmt.Errorf("%w: type %s", manualdns.ErrInvalidOperation, t)
+	}
+}
+
+func manualEntryFromProto(e *dnsv1.ManualEntry) v1alpha2.DNSRecordEntry {
+	return v1alpha2.DNSRecordEntry{
+		FQDN:        e.GetFqdn(),
+		RecordType:  e.GetRecordType(),
+		Targets:     e.GetTargets(),
+		Group:       e.GetGroup(),
+		Groups:      e.GetGroups(),
+		Description: e.GetDescription(),
+	}
+}
+
+// manualEntriesConnectError maps manualdns errors to Connect codes.
+func ignoreResultToProto(r fqdnignore.Result) *dnsv1.IgnoreResult {
+	out := &dnsv1.IgnoreResult{
+		Fqdn:       r.FQDN,
+		RecordType: r.RecordType,
+		Resource:   r.Resource,
+	}
+	switch r.Status {
+	case fqdnignore.StatusUpdated:
+		out.Status = dnsv1.IgnoreResultStatus_IGNORE_RESULT_STATUS_UPDATED
+	case fqdnignore.StatusUnchanged:
+		out.Status = dnsv1.IgnoreResultStatus_IGNORE_RESULT_STATUS_UNCHANGED
+	case fqdnignore.StatusDenied:
+		out.Status = dnsv1.IgnoreResultStatus_IGNORE_RESULT_STATUS_DENIED
+	case fqdnignore.StatusNotFound:
+		out.Status = dnsv1.IgnoreResultStatus_IGNORE_RESULT_STATUS_NOT_FOUND
+	case fqdnignore.StatusUnsupported:
+		out.Status = dnsv1.IgnoreResultStatus_IGNORE_RESULT_STATUS_UNSUPPORTED
+	case fqdnignore.StatusFailed:
+		out.Status = dnsv1.IgnoreResultStatus_IGNORE_RESULT_STATUS_FAILED
+	}
+	if r.Err != nil {
+		out.Error = r.Err.Error()
+	}
+	return out
+}
 
-		previousFQDNs = currentFQDNs
+func manualEntriesConnectError(err error) *connect.Error {
+	switch {
+	case errors.Is(err, manualdns.ErrPortalRequired),
+		errors.Is(err, manualdns.ErrNoOperations),
+		errors.Is(err, manualdns.ErrInvalidOperation),
+		errors.Is(err, manualdns.ErrFQDNRequired),
+		apierrors.IsInvalid(err):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, manualdns.ErrEntryExists):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, manualdns.ErrEntryNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, manualdns.ErrNotManual):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, manualdns.ErrConflict):
+		return connect.NewError(connect.CodeAborted, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
 	}
 }
 
+// authenticated reports whether the caller may see sensitive FQDNs, i.e. the
+// policy does not hide them or the headers are accepted by the auth chain.
+// Without a chain no caller is authenticated.
+func (s *DNSService) authenticated(ctx context.Context, headers http.Header) bool {
+	if s.sensitive == nil || !s.sensitive.HideFromAnonymous {
+		return true
+	}
+	return s.authChain != nil && s.authChain.Authenticate(ctx, headers) == nil
+}
+
+// filterSensitive drops the sensitive FQDNs hidden from the caller.
+func (s *DNSService) filterSensitive(views []domaindns.FQDNView, authenticated bool) []domaindns.FQDNView {
+	if authenticated {
+		return views
+	}
+	return slices.DeleteFunc(views, func(v domaindns.FQDNView) bool {
+		return !s.sensitive.Visible(v.Name, false)
+	})
+}
+
+// toProto converts an FQDNView to proto and flags it against the sensitive policy.
+func (s *DNSService) toProto(v domaindns.FQDNView) *dnsv1.FQDN {
+	f := fqdnViewToProto(v)
+	f.Sensitive = s.sensitive.IsSensitive(v.Name)
+	return f
+}
+
+// validateFQDNView rejects FQDNView values unknown to this server.
+func validateFQDNView(view dnsv1.FQDNView) error {
+	if _, ok := dnsv1.FQDNView_name[int32(view)]; !ok {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown view %d", view))
+	}
+	return nil
+}
+
+// lastSeenWindow converts the last_seen_after/last_seen_before bounds of a
+// request, rejecting an empty window.
+func lastSeenWindow(after, before *timestamppb.Timestamp) (domaindns.LastSeenWindow, error) {
+	var w domaindns.LastSeenWindow
+	if after != nil {
+		w.After = after.AsTime()
+	}
+	if before != nil {
+		w.Before = before.AsTime()
+	}
+	if err := w.Validate(); err != nil {
+		return w, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return w, nil
+}
+
+// applyFQDNView trims f to the fields of view. FULL and UNSPECIFIED return f
+// unchanged.
+func applyFQDNView(f *dnsv1.FQDN, view dnsv1.FQDNView) *dnsv1.FQDN {
+	if view != dnsv1.FQDNView_FQDN_VIEW_BASIC {
+		return f
+	}
+	return &dnsv1.FQDN{
+		Name:          f.Name,
+		Source:        f.Source,
+		Groups:        f.Groups,
+		Description:   f.Description,
+		RecordType:    f.RecordType,
+		Portals:       f.Portals,
+		SyncStatus:    f.SyncStatus,
+		TargetScope:   f.TargetScope,
+		Sensitive:     f.Sensitive,
+		OverallStatus: f.OverallStatus,
+		RemovedAt:     f.RemovedAt,
+		RemovalReason: f.RemovalReason,
+	}
+}
+
+// overallStatusToProto converts a domain OverallStatus to its proto enum. An
+// unset status (view not aggregated by the store) is UNSPECIFIED.
+func overallStatusToProto(s domaindns.OverallStatus) dnsv1.OverallStatus {
+	switch s {
+	case domaindns.OverallStatusUnknown:
+		return dnsv1.OverallStatus_OVERALL_STATUS_UNKNOWN
+	case domaindns.OverallStatusHealthy:
+		return dnsv1.OverallStatus_OVERALL_STATUS_HEALTHY
+	case domaindns.OverallStatusWarning:
+		return dnsv1.OverallStatus_OVERALL_STATUS_WARNING
+	case domaindns.OverallStatusCritical:
+		return dnsv1.OverallStatus_OVERALL_STATUS_CRITICAL
+	case domaindns.OverallStatusRemoved:
+		return dnsv1.OverallStatus_OVERALL_STATUS_REMOVED
+	default:
+		return dnsv1.OverallStatus_OVERALL_STATUS_UNSPECIFIED
+	}
+}
+
+// groupTreeToProto converts a group hierarchy to its proto representation.
+func groupTreeToProto(nodes []domaindns.GroupNode) []*dnsv1.Group {
+	if len(nodes) == 0 {
+		return nil
+	}
+	out := make([]*dnsv1.Group, 0, len(nodes))
+	for _, n := range nodes {
+		out = append(out, &dnsv1.Group{
+			Name:      n.Name,
+			Path:      n.Path,
+			FqdnCount: int32(n.FQDNCount),
+			Children:  groupTreeToProto(n.Children),
+		})
+	}
+	return out
+}
+
 // fqdnViewToProto converts a domain FQDNView to its proto representation.
 func fqdnViewToProto(v domaindns.FQDNView) *dnsv1.FQDN {
 	f := &dnsv1.FQDN{
+		Id:                   v.ID,
 		Name:                 v.Name,
 		Source:               string(v.Source),
 		Groups:               v.Groups,
@@ -212,7 +1114,49 @@ func fqdnViewToProto(v domaindns.FQDNView) *dnsv1.FQDN {
 		DnsResourceName:      v.FirstPortal(),
 		DnsResourceNamespace: v.Namespace,
 		SyncStatus:           v.SyncStatus,
+		InternalSyncStatus:   v.InternalSyncStatus,
+		ExternalSyncStatus:   v.ExternalSyncStatus,
+		LookupFailure:        v.LookupFailure,
+		OriginCause:          v.OriginCause,
+		Availability:         v.Availability,
+		HealthStatus:         v.HealthStatus,
+		HttpStatusCode:       int32(v.HTTPStatusCode),
+		ProbeLatencyMs:       v.ProbeLatency.Milliseconds(),
+		TlsValid:             v.TLSValid,
 		Portals:              v.Portals,
+		TargetScope:          string(v.TargetScope),
+		Paths:                v.Paths,
+		SourceType:           v.SourceType,
+		Annotations:          v.Annotations,
+		OverallStatus:        overallStatusToProto(v.OverallStatus),
+	}
+	if v.DNSRecord != nil {
+		f.DnsRecordRef = &dnsv1.DNSRecordRef{Namespace: v.DNSRecord.Namespace, Name: v.DNSRecord.Name}
+	}
+	if v.ShadowedManual != nil {
+		f.ShadowedManual = &dnsv1.DNSRecordRef{Namespace: v.ShadowedManual.Namespace, Name: v.ShadowedManual.Name}
+	}
+	if c := v.OwnershipConflict; c != nil {
+		f.OwnershipConflict = &dnsv1.OwnershipConflict{DetectedAt: timestamppb.New(c.DetectedAt)}
+		for _, set := range c.TargetSets {
+			f.OwnershipConflict.TargetSets = append(f.OwnershipConflict.TargetSets, &dnsv1.TargetSet{Targets: set})
+		}
+	}
+	for _, p := range v.TargetProviders {
+		f.TargetProviders = append(f.TargetProviders, &dnsv1.TargetProvider{Target: p.Target, Provider: p.Provider, Asn: p.ASN})
+	}
+	if !v.LastReconciled.IsZero() {
+		f.LastReconciled = timestamppb.New(v.LastReconciled)
+	}
+	if !v.RemovedAt.IsZero() {
+		f.RemovedAt = timestamppb.New(v.RemovedAt)
+		f.RemovalReason = string(v.RemovalReason)
+	}
+	if !v.LastProbeTime.IsZero() {
+		f.LastProbeTime = timestamppb.New(v.LastProbeTime)
+	}
+	for _, p := range v.Ports {
+		f.Ports = append(f.Ports, &dnsv1.ServicePort{Name: p.Name, Port: p.Port, Protocol: p.Protocol})
 	}
 	if v.OriginRef != nil {
 		f.OriginRef = &dnsv1.OriginResourceRef{
@@ -224,12 +1168,34 @@ func fqdnViewToProto(v domaindns.FQDNView) *dnsv1.FQDN {
 	return f
 }
 
-// fqdnEqual compares two FQDNs for equality (excluding LastSeen).
+// fqdnEqual compares two FQDNs for equality (excluding LastSeen,
+// LastProbeTime and ProbeLatencyMs, which move on every probe).
 func fqdnEqual(a, b *dnsv1.FQDN) bool {
-	if a.Name != b.Name || a.Source != b.Source || a.Description != b.Description {
+	if a.Id != b.Id || a.Name != b.Name || a.Source != b.Source || a.Description != b.Description {
+		return false
+	}
+	if a.RecordType != b.RecordType || a.SyncStatus != b.SyncStatus || a.TargetScope != b.TargetScope {
+		return false
+	}
+	if a.InternalSyncStatus != b.InternalSyncStatus || a.ExternalSyncStatus != b.ExternalSyncStatus || a.Availability != b.Availability {
 		return false
 	}
-	if a.RecordType != b.RecordType || a.SyncStatus != b.SyncStatus {
+	if a.LookupFailure != b.LookupFailure || a.OriginCause != b.OriginCause {
+		return false
+	}
+	if a.HealthStatus != b.HealthStatus || a.HttpStatusCode != b.HttpStatusCode || a.GetTlsValid() != b.GetTlsValid() || (a.TlsValid == nil) != (b.TlsValid == nil) {
+		return false
+	}
+	if a.Sensitive != b.Sensitive || a.OverallStatus != b.OverallStatus || a.SourceType != b.SourceType {
+		return false
+	}
+	if !proto.Equal(a.DnsRecordRef, b.DnsRecordRef) || !proto.Equal(a.RemovedAt, b.RemovedAt) || a.RemovalReason != b.RemovalReason {
+		return false
+	}
+	if !proto.Equal(a.ShadowedManual, b.ShadowedManual) || !proto.Equal(a.OwnershipConflict, b.OwnershipConflict) {
+		return false
+	}
+	if !slices.EqualFunc(a.TargetProviders, b.TargetProviders, func(x, y *dnsv1.TargetProvider) bool { return proto.Equal(x, y) }) {
 		return false
 	}
 	if len(a.Groups) != len(b.Groups) {
@@ -256,6 +1222,20 @@ func fqdnEqual(a, b *dnsv1.FQDN) bool {
 			return false
 		}
 	}
+	if !slices.Equal(a.Paths, b.Paths) {
+		return false
+	}
+	if !maps.Equal(a.Annotations, b.Annotations) {
+		return false
+	}
+	if len(a.Ports) != len(b.Ports) {
+		return false
+	}
+	for i, p := range a.Ports {
+		if !proto.Equal(p, b.Ports[i]) {
+			return false
+		}
+	}
 	return true
 }
 
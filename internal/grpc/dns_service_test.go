@@ -17,18 +17,51 @@ limitations under the License.
 package grpc_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/external-dns/endpoint"
 
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/agent"
+	"github.com/golgoth31/sreportal/internal/auth"
+	"github.com/golgoth31/sreportal/internal/config"
 	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	domainportal "github.com/golgoth31/sreportal/internal/domain/portal"
+	domainsource "github.com/golgoth31/sreportal/internal/domain/source"
+	"github.com/golgoth31/sreportal/internal/federation"
+	"github.com/golgoth31/sreportal/internal/fqdnignore"
+	"github.com/golgoth31/sreportal/internal/fqdnnote"
 	svcgrpc "github.com/golgoth31/sreportal/internal/grpc"
 	dnsv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	"github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1/sreportalv1connect"
+	"github.com/golgoth31/sreportal/internal/manualdns"
+	"github.com/golgoth31/sreportal/internal/metrics"
 	dnsstore "github.com/golgoth31/sreportal/internal/readstore/dns"
+	portalstore "github.com/golgoth31/sreportal/internal/readstore/portal"
+	rsource "github.com/golgoth31/sreportal/internal/readstore/source"
+	"github.com/golgoth31/sreportal/internal/source/externaldns"
 )
 
 func seedFQDNStore(t *testing.T) *dnsstore.FQDNStore {
@@ -38,6 +71,7 @@ func seedFQDNStore(t *testing.T) *dnsstore.FQDNStore {
 
 	now := time.Now()
 	ref, _ := domaindns.ParseResourceRef("service/production/api-svc")
+	tlsValid := true
 
 	err := store.Replace(ctx, "default/test-dns", tPortalMain, []domaindns.FQDNView{
 		{
@@ -45,13 +79,21 @@ func seedFQDNStore(t *testing.T) *dnsstore.FQDNStore {
 			Groups: []string{"Services"}, RecordType: "A",
 			Targets: []string{"10.0.0.1"}, LastSeen: now,
 			Portals: []string{tPortalMain}, Namespace: tNsDefault, SyncStatus: "synced",
-			OriginRef: &ref,
+			OriginRef:      &ref,
+			Ports:          []domaindns.ServicePort{{Name: "https", Port: 443, Protocol: "TCP"}},
+			Paths:          []string{"/", "/api"},
+			Annotations:    map[string]string{"example.com/owner": "team-a"},
+			SourceType:     "service",
+			DNSRecord:      &domaindns.RecordRef{Namespace: tNsDefault, Name: "main-service"},
+			LastReconciled: now,
 		},
 		{
 			Name: "web.example.com", Source: domaindns.SourceExternalDNS,
 			Groups: []string{"Services"}, RecordType: "A",
 			Targets: []string{"10.0.0.2"}, LastSeen: now,
 			Portals: []string{tPortalMain}, Namespace: tNsDefault,
+			Availability: "up", HealthStatus: "healthy", LastProbeTime: now,
+			HTTPStatusCode: 200, ProbeLatency: 42 * time.Millisecond, TLSValid: &tlsValid,
 		},
 		{
 			Name: tFQDNInternal, Source: domaindns.SourceManual,
@@ -133,6 +175,104 @@ func TestListFQDNs_OriginRef_IsPopulated(t *testing.T) {
 	assert.Equal(t, "api-svc", apiFQDN.OriginRef.Name)
 }
 
+func TestListFQDNs_PortsAndPaths_ArePopulated(t *testing.T) {
+	svc := svcgrpc.NewDNSService(seedFQDNStore(t), nil)
+
+	resp, err := svc.ListFQDNs(
+		context.Background(),
+		connect.NewRequest(&dnsv1.ListFQDNsRequest{Search: tFQDNAPI}),
+	)
+
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Fqdns, 1)
+	require.Len(t, resp.Msg.Fqdns[0].Ports, 1)
+	assert.Equal(t, "https", resp.Msg.Fqdns[0].Ports[0].Name)
+	assert.Equal(t, int32(443), resp.Msg.Fqdns[0].Ports[0].Port)
+	assert.Equal(t, "TCP", resp.Msg.Fqdns[0].Ports[0].Protocol)
+	assert.Equal(t, []string{"/", "/api"}, resp.Msg.Fqdns[0].Paths)
+	assert.Equal(t, map[string]string{"example.com/owner": "team-a"}, resp.Msg.Fqdns[0].Annotations)
+	assert.Equal(t, domaindns.StableID(tPortalMain, tFQDNAPI, "A"), resp.Msg.Fqdns[0].Id)
+}
+
+func TestListFQDNs_OverallStatus_IsPopulated(t *testing.T) {
+	svc := svcgrpc.NewDNSService(seedFQDNStore(t), nil)
+
+	resp, err := svc.ListFQDNs(
+		context.Background(),
+		connect.NewRequest(&dnsv1.ListFQDNsRequest{}),
+	)
+
+	require.NoError(t, err)
+	for _, f := range resp.Msg.Fqdns {
+		assert.NotEqual(t, dnsv1.OverallStatus_OVERALL_STATUS_UNSPECIFIED, f.OverallStatus, f.Name)
+		if f.Name == tFQDNInternal {
+			assert.Equal(t, dnsv1.OverallStatus_OVERALL_STATUS_UNKNOWN, f.OverallStatus, "no sync status nor probe")
+		}
+	}
+}
+
+func TestListFQDNs_ProbeHealth_IsPopulated(t *testing.T) {
+	svc := svcgrpc.NewDNSService(seedFQDNStore(t), nil)
+
+	resp, err := svc.ListFQDNs(
+		context.Background(),
+		connect.NewRequest(&dnsv1.ListFQDNsRequest{Search: "web.example.com"}),
+	)
+
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Fqdns, 1)
+	f := resp.Msg.Fqdns[0]
+	assert.Equal(t, "healthy", f.HealthStatus)
+	assert.Equal(t, int32(200), f.HttpStatusCode)
+	assert.Equal(t, int64(42), f.ProbeLatencyMs)
+	assert.True(t, f.GetTlsValid())
+	assert.NotNil(t, f.LastProbeTime)
+}
+
+func TestListFQDNs_IncludeRemoved_ReturnsTombstones(t *testing.T) {
+	ctx := context.Background()
+	store := dnsstore.NewFQDNStore()
+	store.SetTombstoneRetention(time.Hour)
+	require.NoError(t, store.Replace(ctx, "default/gone", tPortalMain, []domaindns.FQDNView{
+		{Name: "gone.example.com", Source: domaindns.SourceExternalDNS, RecordType: "A", Targets: []string{"10.0.0.9"}},
+	}))
+	require.NoError(t, store.Delete(ctx, "default/gone"))
+	svc := svcgrpc.NewDNSService(store, nil)
+
+	resp, err := svc.ListFQDNs(ctx, connect.NewRequest(&dnsv1.ListFQDNsRequest{}))
+	require.NoError(t, err)
+	assert.Empty(t, resp.Msg.Fqdns)
+
+	resp, err = svc.ListFQDNs(ctx, connect.NewRequest(&dnsv1.ListFQDNsRequest{IncludeRemoved: true}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Fqdns, 1)
+	assert.Equal(t, dnsv1.OverallStatus_OVERALL_STATUS_REMOVED, resp.Msg.Fqdns[0].OverallStatus)
+	assert.NotNil(t, resp.Msg.Fqdns[0].RemovedAt)
+}
+
+func TestListFQDNs_DNSRecord_IsPopulated(t *testing.T) {
+	svc := svcgrpc.NewDNSService(seedFQDNStore(t), nil)
+
+	resp, err := svc.ListFQDNs(
+		context.Background(),
+		connect.NewRequest(&dnsv1.ListFQDNsRequest{}),
+	)
+
+	require.NoError(t, err)
+	for _, f := range resp.Msg.Fqdns {
+		if f.Name != tFQDNAPI {
+			assert.Nil(t, f.DnsRecordRef, "%s has no DNSRecord", f.Name)
+			assert.Nil(t, f.LastReconciled, "%s has no reconcile time", f.Name)
+			continue
+		}
+		assert.Equal(t, "service", f.SourceType)
+		require.NotNil(t, f.DnsRecordRef)
+		assert.Equal(t, tNsDefault, f.DnsRecordRef.Namespace)
+		assert.Equal(t, "main-service", f.DnsRecordRef.Name)
+		require.NotNil(t, f.LastReconciled)
+	}
+}
+
 func TestListFQDNs_OriginRef_IsNil_ForManualEntries(t *testing.T) {
 	store := seedFQDNStore(t)
 	svc := svcgrpc.NewDNSService(store, nil)
@@ -241,3 +381,876 @@ func TestListFQDNs_TotalSize_ReflectsFullCount(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, int32(3), resp.Msg.TotalSize)
 }
+
+func TestFederatedSearch_UnimplementedWithoutSearcher(t *testing.T) {
+	svc := svcgrpc.NewDNSService(seedFQDNStore(t), nil)
+
+	_, err := svc.FederatedSearch(
+		context.Background(),
+		connect.NewRequest(&dnsv1.FederatedSearchRequest{Search: "api"}),
+	)
+
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+}
+
+func TestFederatedSearch_RequiresSearch(t *testing.T) {
+	store := seedFQDNStore(t)
+	svc := svcgrpc.NewDNSService(store, nil)
+	svc.SetFederatedSearcher(federation.NewSearcher(store, portalstore.NewPortalStore(), nil))
+
+	_, err := svc.FederatedSearch(
+		context.Background(),
+		connect.NewRequest(&dnsv1.FederatedSearchRequest{Search: "  "}),
+	)
+
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestFederatedSearch_ReturnsLocalResultsWithSiteLabel(t *testing.T) {
+	store := seedFQDNStore(t)
+	svc := svcgrpc.NewDNSService(store, nil)
+	svc.SetFederatedSearcher(federation.NewSearcher(store, portalstore.NewPortalStore(), nil))
+
+	resp, err := svc.FederatedSearch(
+		context.Background(),
+		connect.NewRequest(&dnsv1.FederatedSearchRequest{Search: "api"}),
+	)
+
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Results, 1)
+	assert.Equal(t, tFQDNAPI, resp.Msg.Results[0].Fqdn.Name)
+	assert.Equal(t, []string{federation.LocalSite}, resp.Msg.Results[0].Sites)
+	assert.Empty(t, resp.Msg.Errors)
+}
+
+func TestListFQDNs_FiltersByTargetScope(t *testing.T) {
+	store := dnsstore.NewFQDNStore()
+	require.NoError(t, store.Replace(context.Background(), "default/test-dns", tPortalMain, []domaindns.FQDNView{
+		{Name: tFQDNAPI, RecordType: "A", Targets: []string{"203.0.113.10"}, Portals: []string{tPortalMain}},
+		{Name: tFQDNInternal, RecordType: "A", Targets: []string{"10.0.0.3"}, Portals: []string{tPortalMain}},
+	}))
+	svc := svcgrpc.NewDNSService(store, nil)
+
+	resp, err := svc.ListFQDNs(
+		context.Background(),
+		connect.NewRequest(&dnsv1.ListFQDNsRequest{TargetScope: "public"}),
+	)
+
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Fqdns, 1)
+	assert.Equal(t, tFQDNAPI, resp.Msg.Fqdns[0].Name)
+	assert.Equal(t, "public", resp.Msg.Fqdns[0].TargetScope)
+}
+
+func TestListFQDNs_RejectsUnknownTargetScope(t *testing.T) {
+	svc := svcgrpc.NewDNSService(seedFQDNStore(t), nil)
+
+	_, err := svc.ListFQDNs(
+		context.Background(),
+		connect.NewRequest(&dnsv1.ListFQDNsRequest{TargetScope: "internet"}),
+	)
+
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestListFQDNs_FiltersByLastSeen(t *testing.T) {
+	now := time.Now()
+	store := dnsstore.NewFQDNStore()
+	require.NoError(t, store.Replace(context.Background(), "default/test-dns", tPortalMain, []domaindns.FQDNView{
+		{Name: tFQDNAPI, RecordType: "A", LastSeen: now, Portals: []string{tPortalMain}},
+		{Name: tFQDNInternal, RecordType: "A", LastSeen: now.Add(-90 * 24 * time.Hour), Portals: []string{tPortalMain}},
+	}))
+	svc := svcgrpc.NewDNSService(store, nil)
+
+	resp, err := svc.ListFQDNs(
+		context.Background(),
+		connect.NewRequest(&dnsv1.ListFQDNsRequest{LastSeenBefore: timestamppb.New(now.Add(-30 * 24 * time.Hour))}),
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Fqdns, 1)
+	assert.Equal(t, tFQDNInternal, resp.Msg.Fqdns[0].Name)
+
+	_, err = svc.ListFQDNs(
+		context.Background(),
+		connect.NewRequest(&dnsv1.ListFQDNsRequest{
+			LastSeenAfter:  timestamppb.New(now),
+			LastSeenBefore: timestamppb.New(now.Add(-time.Hour)),
+		}),
+	)
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestListFQDNs_FlagsSensitiveFQDNs(t *testing.T) {
+	svc := svcgrpc.NewDNSService(seedFQDNStore(t), nil)
+	svc.SetSensitivePolicy(domaindns.NewSensitivePolicy([]string{"internal."}, false), nil)
+
+	resp, err := svc.ListFQDNs(context.Background(), connect.NewRequest(&dnsv1.ListFQDNsRequest{}))
+
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Fqdns, 3)
+	for _, f := range resp.Msg.Fqdns {
+		assert.Equal(t, f.Name == tFQDNInternal, f.Sensitive, f.Name)
+	}
+}
+
+func TestListFQDNs_HidesSensitiveFromAnonymous(t *testing.T) {
+	svc := svcgrpc.NewDNSService(seedFQDNStore(t), nil)
+	chain := auth.NewChain(auth.NewAPIKeyAuthenticator("ci", "X-API-Key", "secret"))
+	svc.SetSensitivePolicy(domaindns.NewSensitivePolicy([]string{"internal."}, true), chain)
+
+	anonymous, err := svc.ListFQDNs(context.Background(), connect.NewRequest(&dnsv1.ListFQDNsRequest{}))
+	require.NoError(t, err)
+	assert.Len(t, anonymous.Msg.Fqdns, 2)
+	assert.Equal(t, int32(2), anonymous.Msg.TotalSize)
+	for _, f := range anonymous.Msg.Fqdns {
+		assert.NotEqual(t, tFQDNInternal, f.Name)
+	}
+
+	req := connect.NewRequest(&dnsv1.ListFQDNsRequest{})
+	req.Header().Set("X-API-Key", "secret")
+	authenticated, err := svc.ListFQDNs(context.Background(), req)
+	require.NoError(t, err)
+	assert.Len(t, authenticated.Msg.Fqdns, 3)
+}
+
+func TestListFQDNs_Groups_AreNested(t *testing.T) {
+	store := dnsstore.NewFQDNStore()
+	require.NoError(t, store.Replace(context.Background(), "default/nested", tPortalMain, []domaindns.FQDNView{
+		{Name: "lb.example.com", RecordType: "A", Groups: []string{"Platform/Networking"}, Portals: []string{tPortalMain}},
+		{Name: "grafana.example.com", RecordType: "A", Groups: []string{"Platform"}, Portals: []string{tPortalMain}},
+	}))
+	svc := svcgrpc.NewDNSService(store, nil)
+
+	resp, err := svc.ListFQDNs(context.Background(), connect.NewRequest(&dnsv1.ListFQDNsRequest{PageSize: 1}))
+
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Fqdns, 1, "groups cover every match, not only the page")
+	require.Len(t, resp.Msg.Groups, 1)
+	platform := resp.Msg.Groups[0]
+	assert.Equal(t, "Platform", platform.Path)
+	assert.Equal(t, int32(2), platform.FqdnCount)
+	require.Len(t, platform.Children, 1)
+	assert.Equal(t, "Networking", platform.Children[0].Name)
+	assert.Equal(t, "Platform/Networking", platform.Children[0].Path)
+	assert.Equal(t, int32(1), platform.Children[0].FqdnCount)
+
+	svc.SetGroupSeparator("")
+	resp, err = svc.ListFQDNs(context.Background(), connect.NewRequest(&dnsv1.ListFQDNsRequest{}))
+
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Groups, 2, "an empty separator keeps groups flat")
+	assert.Empty(t, resp.Msg.Groups[0].Children)
+}
+
+func TestListFQDNs_BasicView_OmitsDetailFields(t *testing.T) {
+	svc := svcgrpc.NewDNSService(seedFQDNStore(t), nil)
+
+	resp, err := svc.ListFQDNs(
+		context.Background(),
+		connect.NewRequest(&dnsv1.ListFQDNsRequest{View: dnsv1.FQDNView_FQDN_VIEW_BASIC}),
+	)
+
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Msg.Fqdns)
+	for _, f := range resp.Msg.Fqdns {
+		assert.NotEmpty(t, f.Name)
+		assert.NotEmpty(t, f.Groups, f.Name)
+		assert.NotEqual(t, dnsv1.OverallStatus_OVERALL_STATUS_UNSPECIFIED, f.OverallStatus, f.Name)
+		assert.Empty(t, f.Targets, f.Name)
+		assert.Nil(t, f.LastSeen, f.Name)
+		assert.Nil(t, f.OriginRef, f.Name)
+		assert.Nil(t, f.DnsRecordRef, f.Name)
+		assert.Empty(t, f.SourceType, f.Name)
+	}
+}
+
+func TestListFQDNs_DefaultView_IsFull(t *testing.T) {
+	svc := svcgrpc.NewDNSService(seedFQDNStore(t), nil)
+
+	resp, err := svc.ListFQDNs(
+		context.Background(),
+		connect.NewRequest(&dnsv1.ListFQDNsRequest{}),
+	)
+
+	require.NoError(t, err)
+	for _, f := range resp.Msg.Fqdns {
+		if f.Name == tFQDNAPI {
+			assert.NotEmpty(t, f.Targets)
+			assert.NotNil(t, f.DnsRecordRef)
+			return
+		}
+	}
+	t.Fatalf("%s not listed", tFQDNAPI)
+}
+
+func TestListFQDNs_RejectsUnknownView(t *testing.T) {
+	svc := svcgrpc.NewDNSService(seedFQDNStore(t), nil)
+
+	_, err := svc.ListFQDNs(
+		context.Background(),
+		connect.NewRequest(&dnsv1.ListFQDNsRequest{View: dnsv1.FQDNView(42)}),
+	)
+
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func manualEntriesService(t *testing.T, portals ...domainportal.PortalView) *svcgrpc.DNSService {
+	t.Helper()
+	ctx := context.Background()
+	pstore := portalstore.NewPortalStore()
+	for _, p := range portals {
+		require.NoError(t, pstore.Replace(ctx, p.Name, p))
+	}
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	svc := svcgrpc.NewDNSService(dnsstore.NewFQDNStore(), pstore)
+	svc.SetManualEntriesWriter(manualdns.NewService(k8sClient))
+	return svc
+}
+
+func addManualEntry(fqdn string) *dnsv1.ManualEntryOperation {
+	return &dnsv1.ManualEntryOperation{
+		Type:  dnsv1.ManualEntryOperationType_MANUAL_ENTRY_OPERATION_TYPE_ADD,
+		Entry: &dnsv1.ManualEntry{Fqdn: fqdn, Targets: []string{"10.0.0.1"}},
+	}
+}
+
+func TestBatchUpdateManualEntries_UnimplementedWithoutWriter(t *testing.T) {
+	svc := svcgrpc.NewDNSService(seedFQDNStore(t), nil)
+
+	_, err := svc.BatchUpdateManualEntries(context.Background(),
+		connect.NewRequest(&dnsv1.BatchUpdateManualEntriesRequest{Portal: tPortalMain}))
+
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+}
+
+func TestBatchUpdateManualEntries_RejectsRemotePortal(t *testing.T) {
+	svc := manualEntriesService(t, domainportal.PortalView{
+		Name: tPortalMain, Namespace: tNsDefault, IsRemote: true,
+		Features: domainportal.PortalFeatures{DNS: true},
+	})
+
+	_, err := svc.BatchUpdateManualEntries(context.Background(),
+		connect.NewRequest(&dnsv1.BatchUpdateManualEntriesRequest{
+			Portal:     tPortalMain,
+			Operations: []*dnsv1.ManualEntryOperation{addManualEntry(tFQDNAPI)},
+		}))
+
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+}
+
+func TestBatchUpdateManualEntries_AppliesBatchAndMapsErrors(t *testing.T) {
+	svc := manualEntriesService(t, domainportal.PortalView{
+		Name: tPortalMain, Namespace: tNsDefault,
+		Features: domainportal.PortalFeatures{DNS: true},
+	})
+	ctx := context.Background()
+
+	resp, err := svc.BatchUpdateManualEntries(ctx, connect.NewRequest(&dnsv1.BatchUpdateManualEntriesRequest{
+		Portal:     tPortalMain,
+		Operations: []*dnsv1.ManualEntryOperation{addManualEntry(tFQDNAPI), addManualEntry(tFQDNInternal)},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, tPortalMain+"-manual", resp.Msg.DnsRecord)
+	assert.Equal(t, int32(2), resp.Msg.EntryCount)
+
+	_, err = svc.BatchUpdateManualEntries(ctx, connect.NewRequest(&dnsv1.BatchUpdateManualEntriesRequest{
+		Portal:     tPortalMain,
+		Operations: []*dnsv1.ManualEntryOperation{addManualEntry(tFQDNAPI)},
+	}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+	_, err = svc.BatchUpdateManualEntries(ctx, connect.NewRequest(&dnsv1.BatchUpdateManualEntriesRequest{
+		Portal:          tPortalMain,
+		ResourceVersion: "999",
+		Operations:      []*dnsv1.ManualEntryOperation{addManualEntry("new.example.com")},
+	}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeAborted, connect.CodeOf(err))
+}
+
+func TestSetFQDNsIgnored_UnimplementedWithoutWriter(t *testing.T) {
+	svc := svcgrpc.NewDNSService(seedFQDNStore(t), nil)
+
+	_, err := svc.SetFQDNsIgnored(context.Background(),
+		connect.NewRequest(&dnsv1.SetFQDNsIgnoredRequest{Portal: tPortalMain}))
+
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+}
+
+func TestSetFQDNsIgnored_ReportsPerTargetResults(t *testing.T) {
+	ctx := context.Background()
+	pstore := portalstore.NewPortalStore()
+	require.NoError(t, pstore.Replace(ctx, tPortalMain, domainportal.PortalView{
+		Name: tPortalMain, Namespace: tNsDefault,
+		Features: domainportal.PortalFeatures{DNS: true},
+	}))
+	fqdnStore := dnsstore.NewFQDNStore()
+	svc := svcgrpc.NewDNSService(fqdnStore, pstore)
+	svc.SetIgnoreWriter(fqdnignore.NewService(fake.NewClientBuilder().Build(), fqdnStore))
+
+	_, err := svc.SetFQDNsIgnored(ctx, connect.NewRequest(&dnsv1.SetFQDNsIgnoredRequest{
+		Portal:  tPortalMain,
+		Targets: []*dnsv1.IgnoreTarget{{Fqdn: tFQDNAPI}},
+	}))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err), "anonymous callers are denied")
+
+	ctx = auth.WithIdentity(ctx, "token:ci")
+	_, err = svc.SetFQDNsIgnored(ctx, connect.NewRequest(&dnsv1.SetFQDNsIgnoredRequest{Portal: tPortalMain}))
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	resp, err := svc.SetFQDNsIgnored(ctx, connect.NewRequest(&dnsv1.SetFQDNsIgnoredRequest{
+		Portal:  tPortalMain,
+		Targets: []*dnsv1.IgnoreTarget{{Fqdn: tFQDNAPI}},
+		Ignored: true,
+		DryRun:  true,
+	}))
+	require.NoError(t, err)
+	assert.True(t, resp.Msg.DryRun)
+	require.Len(t, resp.Msg.Results, 1)
+	assert.Equal(t, tFQDNAPI, resp.Msg.Results[0].Fqdn)
+	assert.Equal(t, dnsv1.IgnoreResultStatus_IGNORE_RESULT_STATUS_NOT_FOUND, resp.Msg.Results[0].Status)
+	assert.NotEmpty(t, resp.Msg.Results[0].Error)
+}
+
+func TestAddNote_UnimplementedWithoutService(t *testing.T) {
+	svc := svcgrpc.NewDNSService(seedFQDNStore(t), nil)
+
+	_, err := svc.AddNote(context.Background(),
+		connect.NewRequest(&dnsv1.AddNoteRequest{Portal: tPortalMain, Fqdn: tFQDNAPI}))
+
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+}
+
+func TestAddNote_AppendsAndListsNotes(t *testing.T) {
+	ctx := context.Background()
+	pstore := portalstore.NewPortalStore()
+	require.NoError(t, pstore.Replace(ctx, tPortalMain, domainportal.PortalView{Name: tPortalMain, Namespace: tNsDefault}))
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha1.AddToScheme(scheme))
+	svc := svcgrpc.NewDNSService(seedFQDNStore(t), pstore)
+	svc.SetNotesService(fqdnnote.NewService(fake.NewClientBuilder().WithScheme(scheme).Build()))
+
+	resp, err := svc.AddNote(ctx, connect.NewRequest(&dnsv1.AddNoteRequest{
+		Portal: tPortalMain, Fqdn: tFQDNAPI, Author: "alice", Text: "failover in progress",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), resp.Msg.NoteCount)
+	assert.Equal(t, "alice", resp.Msg.Note.Author)
+
+	// The authenticated identity wins over the author named by the client.
+	resp, err = svc.AddNote(auth.WithIdentity(ctx, "token:ci"), connect.NewRequest(&dnsv1.AddNoteRequest{
+		Portal: tPortalMain, Fqdn: "API.example.com", Author: "alice", Text: "failover done",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), resp.Msg.NoteCount)
+	assert.Equal(t, "token:ci", resp.Msg.Note.Author)
+	assert.NotNil(t, resp.Msg.Note.CreatedAt)
+
+	list, err := svc.ListNotes(ctx, connect.NewRequest(&dnsv1.ListNotesRequest{Portal: tPortalMain, Fqdn: tFQDNAPI}))
+	require.NoError(t, err)
+	require.Len(t, list.Msg.Notes, 2)
+	assert.Equal(t, "failover in progress", list.Msg.Notes[0].Text)
+
+	_, err = svc.AddNote(ctx, connect.NewRequest(&dnsv1.AddNoteRequest{Portal: tPortalMain, Fqdn: tFQDNAPI, Text: "no author"}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = svc.AddNote(ctx, connect.NewRequest(&dnsv1.AddNoteRequest{
+		Portal: tPortalMain, Fqdn: "unknown.example.com", Author: "alice", Text: "typo",
+	}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	_, err = svc.ListNotes(ctx, connect.NewRequest(&dnsv1.ListNotesRequest{Portal: "unknown", Fqdn: tFQDNAPI}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestPublishEndpoints_UnimplementedWithoutIngester(t *testing.T) {
+	svc := svcgrpc.NewDNSService(seedFQDNStore(t), nil)
+
+	_, err := svc.PublishEndpoints(context.Background(),
+		connect.NewRequest(&dnsv1.PublishEndpointsRequest{Agent: "edge-1", Portal: tPortalMain}))
+
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+}
+
+// agentIngestService returns a DNSService accepting pushes from agent
+// "edge-1" to the main portal, which has a DNS resource, and the client the
+// agent DNSRecords are written with.
+func agentIngestService(t *testing.T, portals ...domainportal.PortalView) (*svcgrpc.DNSService, client.Client) {
+	t.Helper()
+	ctx := context.Background()
+	pstore := portalstore.NewPortalStore()
+	for _, p := range portals {
+		require.NoError(t, pstore.Replace(ctx, p.Name, p))
+	}
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&sreportalv1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: tPortalMain, Namespace: tNsDefault},
+		Spec:       sreportalv1alpha2.DNSSpec{PortalRef: tPortalMain},
+	}).Build()
+	svc := svcgrpc.NewDNSService(dnsstore.NewFQDNStore(), pstore)
+	svc.SetAgentIngester(agent.NewIngester(c, config.AgentIngestConfig{
+		TTL: config.Duration(time.Hour),
+		Agents: []config.IngestAgentConfig{
+			{Name: "edge-1", Portal: tPortalMain},
+			{Name: "edge-2", Portal: "nodns"},
+			{Name: "edge-3", Portal: "remote"},
+		},
+	}))
+	return svc, c
+}
+
+func TestPublishEndpoints_WritesDNSRecordOfAgent(t *testing.T) {
+	ctx := auth.WithIdentity(context.Background(), "token:edge-1")
+	svc, c := agentIngestService(t, domainportal.PortalView{
+		Name: tPortalMain, Namespace: tNsDefault,
+		Features: domainportal.PortalFeatures{DNS: true},
+	})
+
+	resp, err := svc.PublishEndpoints(ctx, connect.NewRequest(&dnsv1.PublishEndpointsRequest{
+		Agent:  "edge-1",
+		Portal: tPortalMain,
+		Fqdns: []*dnsv1.FQDN{
+			{Name: "web.example.com", Groups: []string{"Services"}, RecordType: "CNAME", Targets: []string{"lb.example.com"}},
+			{Name: tFQDNAPI, Groups: []string{"Services"}, RecordType: "A", Targets: []string{"10.1.0.1"},
+				OriginRef: &dnsv1.OriginResourceRef{Kind: "service", Namespace: "production", Name: "api-svc"}},
+			{Name: tFQDNAPI, Groups: []string{"Edge"}, RecordType: "A", Targets: []string{"10.1.0.1"}},
+		},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), resp.Msg.FqdnCount)
+
+	var dr sreportalv1alpha2.DNSRecord
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: tNsDefault, Name: agent.RecordName(tPortalMain, "edge-1")}, &dr))
+	assert.Equal(t, sreportalv1alpha2.AgentSourceType("edge-1"), dr.Spec.SourceType)
+	require.Len(t, dr.Spec.Entries, 2)
+	assert.Equal(t, tFQDNAPI, dr.Spec.Entries[0].FQDN)
+	assert.Equal(t, []string{"Edge", "Services"}, dr.Spec.Entries[0].Groups)
+	assert.Equal(t, "service/production/api-svc", dr.Spec.Entries[0].OriginRef)
+	assert.Equal(t, "web.example.com", dr.Spec.Entries[1].FQDN)
+
+	_, err = svc.PublishEndpoints(ctx, connect.NewRequest(&dnsv1.PublishEndpointsRequest{
+		Portal: tPortalMain,
+		Fqdns:  []*dnsv1.FQDN{{Name: "web.example.com", RecordType: "CNAME", Targets: []string{"lb.example.com"}}},
+	}))
+	require.NoError(t, err)
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: tNsDefault, Name: agent.RecordName(tPortalMain, "edge-1")}, &dr))
+	require.Len(t, dr.Spec.Entries, 1, "a push replaces the FQDNs of the agent")
+}
+
+func TestPublishEndpoints_RejectsInvalidRequests(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := agentIngestService(t,
+		domainportal.PortalView{Name: tPortalMain, Namespace: tNsDefault, Features: domainportal.PortalFeatures{DNS: true}},
+		domainportal.PortalView{Name: "nodns", Namespace: tNsDefault, Features: domainportal.PortalFeatures{DNS: true}},
+		domainportal.PortalView{Name: "remote", Namespace: tNsDefault, IsRemote: true, Features: domainportal.PortalFeatures{DNS: true}},
+	)
+
+	tests := []struct {
+		name     string
+		identity string
+		req      *dnsv1.PublishEndpointsRequest
+		code     connect.Code
+	}{
+		{"missing portal", "token:edge-1", &dnsv1.PublishEndpointsRequest{Agent: "edge-1"}, connect.CodeInvalidArgument},
+		{"unauthenticated", "", &dnsv1.PublishEndpointsRequest{Agent: "edge-1", Portal: tPortalMain}, connect.CodePermissionDenied},
+		{"undeclared identity", "token:edge-9", &dnsv1.PublishEndpointsRequest{Portal: tPortalMain}, connect.CodePermissionDenied},
+		{"agent of another identity", "token:edge-2", &dnsv1.PublishEndpointsRequest{Agent: "edge-1", Portal: tPortalMain}, connect.CodePermissionDenied},
+		{"portal not allowed", "token:edge-1", &dnsv1.PublishEndpointsRequest{Agent: "edge-1", Portal: "remote"}, connect.CodePermissionDenied},
+		{"remote portal", "token:edge-3", &dnsv1.PublishEndpointsRequest{Agent: "edge-3", Portal: "remote"}, connect.CodeFailedPrecondition},
+		{"portal without DNS", "token:edge-2", &dnsv1.PublishEndpointsRequest{Agent: "edge-2", Portal: "nodns"}, connect.CodeFailedPrecondition},
+		{"invalid fqdn", "token:edge-1", &dnsv1.PublishEndpointsRequest{Agent: "edge-1", Portal: tPortalMain,
+			Fqdns: []*dnsv1.FQDN{{Name: "not a domain"}}}, connect.CodeInvalidArgument},
+		{"unsupported record type", "token:edge-1", &dnsv1.PublishEndpointsRequest{Agent: "edge-1", Portal: tPortalMain,
+			Fqdns: []*dnsv1.FQDN{{Name: tFQDNAPI, RecordType: "MX"}}}, connect.CodeInvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.PublishEndpoints(auth.WithIdentity(ctx, tt.identity), connect.NewRequest(tt.req))
+			require.Error(t, err)
+			assert.Equal(t, tt.code, connect.CodeOf(err))
+		})
+	}
+}
+
+func TestPublishEndpoints_FromPublisherWithDefaultConfig(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	svc, central := agentIngestService(t, domainportal.PortalView{
+		Name: tPortalMain, Namespace: tNsDefault,
+		Features: domainportal.PortalFeatures{DNS: true},
+	})
+	tokens := auth.NewAPITokenAuthenticator()
+	tokens.SetTokens(map[string][]byte{"edge-1": []byte("edge-secret")})
+	mux := http.NewServeMux()
+	mux.Handle(sreportalv1connect.NewDNSServiceHandler(svc,
+		connect.WithInterceptors(auth.AuthInterceptor(auth.NewChain(auth.NewAPIKeyAuthenticator("default", "", "shared-key"), tokens)))))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	// The agent side: one local DNS resource and its collected service.
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha2.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+	local := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&sreportalv1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: "edge", Namespace: tNsDefault},
+		Spec: sreportalv1alpha2.DNSSpec{
+			PortalRef: tPortalMain,
+			Sources: sreportalv1alpha2.SourcesSpec{
+				Service: &sreportalv1alpha2.ServiceSourceSpec{CommonSourceSpec: sreportalv1alpha2.CommonSourceSpec{Enabled: true}},
+			},
+		},
+	}).Build()
+	sources := rsource.NewStore()
+	sources.ReplaceKind(externaldns.KindService, []domainsource.EnrichedEndpoint{{
+		Endpoint: endpoint.NewEndpoint(tFQDNAPI, "A", "10.0.0.1"), Kind: externaldns.KindService, Namespace: tNsDefault, Name: "api",
+	}})
+
+	cfg := config.DefaultConfig().Agent
+	cfg.Name, cfg.Portal, cfg.CentralURL = "edge-1", tPortalMain, srv.URL
+	cfg.Interval = config.Duration(10 * time.Millisecond)
+	publisher := agent.NewPublisher(local, sources, sreportalv1connect.NewDNSServiceClient(srv.Client(), srv.URL), cfg, "edge-secret")
+	go func() { _ = publisher.Start(ctx) }()
+
+	// The default identity of the agent is the API token named after it,
+	// which the publisher sends by default.
+	var dr sreportalv1alpha2.DNSRecord
+	require.Eventually(t, func() bool {
+		return central.Get(ctx, types.NamespacedName{Namespace: tNsDefault, Name: agent.RecordName(tPortalMain, "edge-1")}, &dr) == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Len(t, dr.Spec.Entries, 1)
+	assert.Equal(t, tFQDNAPI, dr.Spec.Entries[0].FQDN)
+}
+
+// stubStreamLimiter admits up to max concurrent streams.
+type stubStreamLimiter struct {
+	mu   sync.Mutex
+	open int
+	max  int
+}
+
+func (l *stubStreamLimiter) AcquireStream() (func(), bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.open >= l.max {
+		return nil, false
+	}
+	l.open++
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.open--
+	}, true
+}
+
+func (l *stubStreamLimiter) openStreams() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.open
+}
+
+func TestStreamFQDNs_StreamLimiter(t *testing.T) {
+	svc := svcgrpc.NewDNSService(seedFQDNStore(t), nil)
+	limiter := &stubStreamLimiter{max: 1}
+	svc.SetStreamLimiter(limiter)
+	mux := http.NewServeMux()
+	mux.Handle(sreportalv1connect.NewDNSServiceHandler(svc))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	client := sreportalv1connect.NewDNSServiceClient(srv.Client(), srv.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first, err := client.StreamFQDNs(ctx, connect.NewRequest(&dnsv1.StreamFQDNsRequest{}))
+	require.NoError(t, err)
+	require.True(t, first.Receive(), first.Err())
+	assert.Equal(t, 1, limiter.openStreams())
+
+	second, err := client.StreamFQDNs(context.Background(), connect.NewRequest(&dnsv1.StreamFQDNsRequest{}))
+	require.NoError(t, err)
+	assert.False(t, second.Receive())
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(second.Err()))
+
+	// Closing the first stream frees its slot.
+	cancel()
+	require.Eventually(t, func() bool { return limiter.openStreams() == 0 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestStreamFQDNs_SharedTopics(t *testing.T) {
+	store := seedFQDNStore(t)
+	svc := svcgrpc.NewDNSService(store, nil)
+	mux := http.NewServeMux()
+	mux.Handle(sreportalv1connect.NewDNSServiceHandler(svc))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	client := sreportalv1connect.NewDNSServiceClient(srv.Client(), srv.URL)
+
+	topics := func() float64 { return testutil.ToFloat64(metrics.StreamTopics) }
+	stateBytes := func() float64 { return testutil.ToFloat64(metrics.StreamTopicStateBytes) }
+	require.Eventually(t, func() bool { return topics() == 0 && stateBytes() == 0 }, 5*time.Second, 10*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// Two streams share the unfiltered topic, the third gets its own.
+	requests := []*dnsv1.StreamFQDNsRequest{{}, {}, {Namespace: tNsDefault}}
+	streams := make([]*connect.ServerStreamForClient[dnsv1.StreamFQDNsResponse], len(requests))
+	for i, msg := range requests {
+		stream, err := client.StreamFQDNs(ctx, connect.NewRequest(msg))
+		require.NoError(t, err)
+		require.True(t, stream.Receive(), stream.Err())
+		streams[i] = stream
+	}
+	assert.Equal(t, float64(2), topics())
+	assert.Positive(t, stateBytes())
+	assert.Positive(t, testutil.ToFloat64(metrics.StreamSnapshotBytes))
+
+	// A mutation reaches every stream of every topic.
+	require.NoError(t, store.Replace(context.Background(), "default/other", tPortalMain, []domaindns.FQDNView{{
+		Name: "new.example.com", Source: domaindns.SourceManual, RecordType: "A",
+		Portals: []string{tPortalMain}, Namespace: tNsDefault,
+	}}))
+	for _, stream := range streams {
+		for stream.Receive() {
+			if stream.Msg().GetFqdn().GetName() == "new.example.com" {
+				break
+			}
+		}
+		require.NoError(t, stream.Err())
+		assert.Equal(t, dnsv1.UpdateType_UPDATE_TYPE_ADDED, stream.Msg().Type)
+	}
+
+	// Topics stop with their last stream, and streams release their state.
+	cancel()
+	require.Eventually(t, func() bool {
+		return topics() == 0 && stateBytes() == 0 && testutil.ToFloat64(metrics.StreamSubscriberStateBytes) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestStreamFQDNs_SendsHeartbeatsWhenIdle(t *testing.T) {
+	svc := svcgrpc.NewDNSService(seedFQDNStore(t), nil)
+	svc.SetStreamHeartbeatInterval(50 * time.Millisecond)
+	mux := http.NewServeMux()
+	mux.Handle(sreportalv1connect.NewDNSServiceHandler(svc))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	client := sreportalv1connect.NewDNSServiceClient(srv.Client(), srv.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := client.StreamFQDNs(ctx, connect.NewRequest(&dnsv1.StreamFQDNsRequest{}))
+	require.NoError(t, err)
+	defer func() { _ = stream.Close() }()
+
+	// The initial state comes first, closed by a synced marker, then
+	// heartbeats without FQDN.
+	var added int
+	for stream.Receive() && stream.Msg().Type == dnsv1.UpdateType_UPDATE_TYPE_ADDED {
+		added++
+	}
+	require.NoError(t, stream.Err())
+	assert.Positive(t, added)
+	assert.Equal(t, dnsv1.UpdateType_UPDATE_TYPE_SYNCED, stream.Msg().Type)
+	assert.Nil(t, stream.Msg().Fqdn)
+	require.True(t, stream.Receive(), stream.Err())
+	assert.Equal(t, dnsv1.UpdateType_UPDATE_TYPE_HEARTBEAT, stream.Msg().Type)
+	assert.Nil(t, stream.Msg().Fqdn)
+	require.True(t, stream.Receive(), stream.Err())
+	assert.Equal(t, dnsv1.UpdateType_UPDATE_TYPE_HEARTBEAT, stream.Msg().Type)
+}
+
+func TestStreamFQDNs_EvictsSlowSubscriber(t *testing.T) {
+	store := dnsstore.NewFQDNStore()
+	// Random descriptions defeat the response compression.
+	rnd := rand.New(rand.NewPCG(1, 2))
+	views := make([]domaindns.FQDNView, 4000)
+	for i := range views {
+		raw := make([]byte, 8<<10)
+		for j := range raw {
+			raw[j] = byte(rnd.Uint32())
+		}
+		description := hex.EncodeToString(raw)
+		views[i] = domaindns.FQDNView{
+			Name: fmt.Sprintf("host-%d.example.com", i), Source: domaindns.SourceManual, RecordType: "A",
+			Description: description, Portals: []string{tPortalMain}, Namespace: tNsDefault,
+		}
+	}
+	require.NoError(t, store.Replace(context.Background(), "default/big", tPortalMain, views))
+
+	svc := svcgrpc.NewDNSService(store, nil)
+	svc.SetStreamSendTimeout(200 * time.Millisecond)
+	mux := http.NewServeMux()
+	path, handler := sreportalv1connect.NewDNSServiceHandler(svc)
+	mux.Handle(path, svcgrpc.WithSendDeadlines(handler))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	client := sreportalv1connect.NewDNSServiceClient(srv.Client(), srv.URL)
+
+	evictions := testutil.ToFloat64(metrics.StreamEvictionsTotal)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := client.StreamFQDNs(ctx, connect.NewRequest(&dnsv1.StreamFQDNsRequest{}))
+	require.NoError(t, err)
+	defer func() { _ = stream.Close() }()
+
+	// The subscriber never reads: the server gives up instead of blocking.
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(metrics.StreamEvictionsTotal) == evictions+1
+	}, 20*time.Second, 50*time.Millisecond)
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(metrics.StreamTopics) == 0 && testutil.ToFloat64(metrics.StreamSubscriberStateBytes) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestStreamFQDNs_RestrictedPortalForAllowedPrincipal(t *testing.T) {
+	ctx := context.Background()
+	store := dnsstore.NewFQDNStore()
+	require.NoError(t, store.Replace(ctx, "default/main-svc", tPortalMain, []domaindns.FQDNView{
+		{Name: tFQDNAPI, RecordType: "A", Source: domaindns.SourceManual, Targets: []string{"10.0.0.1"}},
+	}))
+	require.NoError(t, store.Replace(ctx, "team/team-svc", "team", []domaindns.FQDNView{
+		{Name: tFQDNAPI, RecordType: "A", Source: domaindns.SourceManual, Targets: []string{"10.0.0.1"}},
+		{Name: tFQDNInternal, RecordType: "A", Source: domaindns.SourceManual, Targets: []string{"10.0.0.2"}},
+	}))
+	pstore := portalstore.NewPortalStore()
+	require.NoError(t, pstore.Replace(ctx, tPortalMain, domainportal.PortalView{Name: tPortalMain}))
+	require.NoError(t, pstore.Replace(ctx, "team", domainportal.PortalView{Name: "team", AllowedGroups: []string{"sre"}}))
+	svc := svcgrpc.NewDNSService(auth.NewPortalVisibility(pstore).FQDNs(store), nil)
+
+	// The principal comes from the request, as set by the OIDC middleware.
+	path, handler := sreportalv1connect.NewDNSServiceHandler(svc)
+	mux := http.NewServeMux()
+	mux.Handle(path, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if groups := r.Header.Get("X-Test-Groups"); groups != "" {
+			r = r.WithContext(auth.WithPrincipal(r.Context(), &auth.Principal{Subject: "alice", Groups: strings.Split(groups, ",")}))
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	client := sreportalv1connect.NewDNSServiceClient(srv.Client(), srv.URL)
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	initial := func(groups string, msg *dnsv1.StreamFQDNsRequest) map[string][]string {
+		req := connect.NewRequest(msg)
+		if groups != "" {
+			req.Header().Set("X-Test-Groups", groups)
+		}
+		stream, err := client.StreamFQDNs(streamCtx, req)
+		require.NoError(t, err)
+		portals := map[string][]string{}
+		for stream.Receive() && stream.Msg().Type == dnsv1.UpdateType_UPDATE_TYPE_ADDED {
+			portals[stream.Msg().Fqdn.Name] = stream.Msg().Fqdn.Portals
+		}
+		require.NoError(t, stream.Err())
+		assert.Equal(t, dnsv1.UpdateType_UPDATE_TYPE_SYNCED, stream.Msg().Type)
+		return portals
+	}
+
+	assert.Equal(t, map[string][]string{
+		tFQDNAPI:      {tPortalMain, "team"},
+		tFQDNInternal: {"team"},
+	}, initial("sre", &dnsv1.StreamFQDNsRequest{Portal: "team"}))
+	assert.Empty(t, initial("", &dnsv1.StreamFQDNsRequest{Portal: "team"}), "anonymous streams see nothing of a restricted portal")
+
+	// Unfiltered streams of both callers do not share their topic nor the
+	// messages trimmed to their portals.
+	assert.Equal(t, map[string][]string{
+		tFQDNAPI:      {tPortalMain, "team"},
+		tFQDNInternal: {"team"},
+	}, initial("dev,sre", &dnsv1.StreamFQDNsRequest{}))
+	assert.Equal(t, map[string][]string{tFQDNAPI: {tPortalMain}}, initial("", &dnsv1.StreamFQDNsRequest{}))
+}
+
+func TestGetShareLink_BuildsStableLink(t *testing.T) {
+	ctx := context.Background()
+	pstore := portalstore.NewPortalStore()
+	require.NoError(t, pstore.Replace(ctx, tPortalMain, domainportal.PortalView{Name: tPortalMain, SubPath: "prod"}))
+	svc := svcgrpc.NewDNSService(seedFQDNStore(t), pstore)
+
+	req := connect.NewRequest(&dnsv1.GetShareLinkRequest{Portal: tPortalMain, Fqdn: "API.example.com", QrCode: true})
+	req.Header().Set("Origin", "https://portal.example.com")
+	resp, err := svc.GetShareLink(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, "/share/fqdn/main/api.example.com", resp.Msg.Path)
+	assert.Equal(t, "https://portal.example.com/share/fqdn/main/api.example.com", resp.Msg.Url)
+	assert.True(t, bytes.HasPrefix(resp.Msg.QrCodePng, []byte("\x89PNG")))
+
+	svc.SetPublicURL("https://sreportal.example.com")
+	resp, err = svc.GetShareLink(ctx, connect.NewRequest(&dnsv1.GetShareLinkRequest{Portal: tPortalMain, Fqdn: tFQDNAPI}))
+	require.NoError(t, err)
+	assert.Equal(t, "https://sreportal.example.com/share/fqdn/main/api.example.com", resp.Msg.Url)
+	assert.Empty(t, resp.Msg.QrCodePng)
+
+	_, err = svc.GetShareLink(ctx, connect.NewRequest(&dnsv1.GetShareLinkRequest{Portal: tPortalMain, Fqdn: "missing.example.com"}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	_, err = svc.GetShareLink(ctx, connect.NewRequest(&dnsv1.GetShareLinkRequest{Portal: tPortalMain}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestGetUniquenessReport(t *testing.T) {
+	ctx := context.Background()
+	store := dnsstore.NewFQDNStore()
+	require.NoError(t, store.Replace(ctx, "default/main-svc", tPortalMain, []domaindns.FQDNView{
+		{Name: tFQDNAPI, RecordType: "A", Source: domaindns.SourceExternalDNS, SourceType: "service", Targets: []string{"10.0.0.1"}},
+		{Name: tFQDNInternal, RecordType: "A", Source: domaindns.SourceExternalDNS, SourceType: "service", Targets: []string{"10.0.0.1"}},
+	}))
+	require.NoError(t, store.Replace(ctx, "team/team-ing", "team", []domaindns.FQDNView{
+		{Name: tFQDNAPI, RecordType: "A", Source: domaindns.SourceExternalDNS, SourceType: "ingress", Targets: []string{"10.0.0.2"}},
+		{Name: tFQDNInternal, RecordType: "A", Source: domaindns.SourceExternalDNS, SourceType: "ingress", Targets: []string{"10.0.0.1"}},
+	}))
+	svc := svcgrpc.NewDNSService(store, nil)
+
+	_, err := svc.GetUniquenessReport(ctx, connect.NewRequest(&dnsv1.GetUniquenessReportRequest{}))
+	assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+
+	svc.SetUniquenessReader(store)
+	resp, err := svc.GetUniquenessReport(ctx, connect.NewRequest(&dnsv1.GetUniquenessReportRequest{Portal: "team"}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Issues, 2)
+	api := resp.Msg.Issues[0]
+	assert.Equal(t, tFQDNAPI, api.Name)
+	assert.Equal(t, "high", api.Severity)
+	assert.Equal(t, []string{"main", "team"}, api.Portals)
+	require.Len(t, api.Contributions, 2)
+	assert.Equal(t, "main-svc", api.Contributions[0].DnsRecord.Name)
+	assert.Equal(t, []string{"10.0.0.2"}, api.Contributions[1].Targets)
+	assert.Equal(t, "low", resp.Msg.Issues[1].Severity)
+
+	svc.SetSensitivePolicy(domaindns.NewSensitivePolicy([]string{"internal."}, true), nil)
+	resp, err = svc.GetUniquenessReport(ctx, connect.NewRequest(&dnsv1.GetUniquenessReportRequest{Portal: "other"}))
+	require.NoError(t, err)
+	assert.Empty(t, resp.Msg.Issues)
+	resp, err = svc.GetUniquenessReport(ctx, connect.NewRequest(&dnsv1.GetUniquenessReportRequest{}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Issues, 1, "sensitive FQDNs are hidden from anonymous callers")
+	assert.Equal(t, tFQDNAPI, resp.Msg.Issues[0].Name)
+
+	pstore := portalstore.NewPortalStore()
+	require.NoError(t, pstore.Replace(ctx, tPortalMain, domainportal.PortalView{Name: tPortalMain}))
+	require.NoError(t, pstore.Replace(ctx, "team", domainportal.PortalView{Name: "team", AllowedGroups: []string{"sre"}}))
+	visible := svcgrpc.NewDNSService(store, auth.NewPortalVisibility(pstore).Portals())
+	visible.SetUniquenessReader(store)
+	resp, err = visible.GetUniquenessReport(ctx, connect.NewRequest(&dnsv1.GetUniquenessReportRequest{}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Issues, 2)
+	api = resp.Msg.Issues[0]
+	assert.Equal(t, []string{"main"}, api.Portals, "hidden portals are removed")
+	require.Len(t, api.Contributions, 1)
+	assert.Equal(t, "main-svc", api.Contributions[0].DnsRecord.Name)
+	assert.Equal(t, []string{"service"}, api.Sources)
+}
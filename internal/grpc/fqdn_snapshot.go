@@ -0,0 +1,87 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	dnsv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	"github.com/golgoth31/sreportal/internal/metrics"
+)
+
+// stateEntryOverhead estimates the memory of one entry of a topic state
+// besides its key: the key and value headers plus the map bookkeeping.
+const stateEntryOverhead = 48
+
+// fqdnSnapshot shares the proto FQDNs of one store generation between the
+// StreamFQDNs topics, so that each topic only holds pointers to them instead
+// of its own copy. The messages it returns are never mutated.
+type fqdnSnapshot struct {
+	mu      sync.Mutex
+	gen     <-chan struct{}
+	entries map[snapshotKey]*dnsv1.FQDN
+	bytes   int
+}
+
+// snapshotKey identifies a message by its FQDN, view and portals: readers
+// restricted by the portal visibility trim the portals per caller.
+type snapshotKey struct {
+	name, recordType string
+	basic            bool
+	portals          string
+}
+
+// proto returns the shared message of v trimmed to view, for the store
+// generation identified by gen (the channel returned by Subscribe before v
+// was listed). The first request of a new generation drops the messages of
+// the previous one; a request for an already superseded generation is
+// converted without being cached.
+func (c *fqdnSnapshot) proto(gen <-chan struct{}, v domaindns.FQDNView, view dnsv1.FQDNView, convert func(domaindns.FQDNView) *dnsv1.FQDN) *dnsv1.FQDN {
+	key := snapshotKey{
+		name: v.Name, recordType: v.RecordType, basic: view == dnsv1.FQDNView_FQDN_VIEW_BASIC,
+		portals: strings.Join(v.Portals, "\n"),
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if gen != c.gen {
+		select {
+		case <-gen:
+			return applyFQDNView(convert(v), view)
+		default:
+		}
+		c.gen = gen
+		c.entries = make(map[snapshotKey]*dnsv1.FQDN, len(c.entries))
+		c.bytes = 0
+	}
+	if f, ok := c.entries[key]; ok {
+		return f
+	}
+	f := applyFQDNView(convert(v), view)
+	c.entries[key] = f
+	c.bytes += proto.Size(f)
+	metrics.StreamSnapshotBytes.Set(float64(c.bytes))
+	return f
+}
+
+func fqdnKey(f *dnsv1.FQDN) string {
+	return f.Name + "/" + f.RecordType
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	dnsv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	"github.com/golgoth31/sreportal/internal/metrics"
+)
+
+// topicKey identifies the streams that receive the same updates: same
+// filters, same view, same visibility of the sensitive FQDNs and same groups,
+// which decide the visible portals.
+type topicKey struct {
+	filters       domaindns.FQDNFilters
+	basic         bool
+	authenticated bool
+	groups        string
+}
+
+// groupsKey returns a topicKey.groups value for groups, independent of their
+// order.
+func groupsKey(groups []string) string {
+	sorted := slices.Sorted(slices.Values(groups))
+	return strings.Join(slices.Compact(sorted), "\n")
+}
+
+// topicLister lists the FQDNs of a topic for the store generation gen.
+type topicLister func(ctx context.Context, gen <-chan struct{}) ([]*dnsv1.FQDN, error)
+
+// topicVersion is the immutable state of a topic after a store change.
+type topicVersion struct {
+	seq   uint64
+	fqdns []*dnsv1.FQDN
+	byKey map[string]*dnsv1.FQDN
+	// changes turns version seq-1 into this one.
+	changes []*dnsv1.StreamFQDNsResponse
+	// err ends the topic: its subscribers return it.
+	err   error
+	bytes int
+	// next is closed once a newer version is published.
+	next chan struct{}
+}
+
+func newTopicVersion(prev *topicVersion, fqdns []*dnsv1.FQDN, err error) *topicVersion {
+	v := &topicVersion{fqdns: fqdns, byKey: make(map[string]*dnsv1.FQDN, len(fqdns)), err: err, next: make(chan struct{})}
+	for _, f := range fqdns {
+		key := fqdnKey(f)
+		v.byKey[key] = f
+		v.bytes += len(key) + stateEntryOverhead
+	}
+	if prev != nil {
+		v.seq = prev.seq + 1
+		v.changes = diffVersions(prev, v)
+	}
+	v.bytes += len(v.changes) * stateEntryOverhead
+	return v
+}
+
+// diffVersions returns the updates turning from into to.
+func diffVersions(from, to *topicVersion) []*dnsv1.StreamFQDNsResponse {
+	var changes []*dnsv1.StreamFQDNsResponse
+	for _, f := range to.fqdns {
+		prev, exists := from.byKey[fqdnKey(f)]
+		switch {
+		case !exists:
+			changes = append(changes, &dnsv1.StreamFQDNsResponse{Type: dnsv1.UpdateType_UPDATE_TYPE_ADDED, Fqdn: f})
+		case prev != f && !fqdnEqual(prev, f):
+			changes = append(changes, &dnsv1.StreamFQDNsResponse{Type: dnsv1.UpdateType_UPDATE_TYPE_MODIFIED, Fqdn: f})
+		}
+	}
+	for _, f := range from.fqdns {
+		if _, exists := to.byKey[fqdnKey(f)]; !exists {
+			changes = append(changes, &dnsv1.StreamFQDNsResponse{Type: dnsv1.UpdateType_UPDATE_TYPE_DELETED, Fqdn: f})
+		}
+	}
+	return changes
+}
+
+// since returns the updates turning from into v: the precomputed changes
+// when from is the previous version, a diff when the subscriber lagged
+// behind several versions.
+func (v *topicVersion) since(from *topicVersion) []*dnsv1.StreamFQDNsResponse {
+	if v.seq == from.seq+1 {
+		return v.changes
+	}
+	return diffVersions(from, v)
+}
+
+// fqdnTopic lists and diffs the FQDNs of one topicKey once per store change,
+// for every stream attached to it.
+type fqdnTopic struct {
+	refs   int
+	cancel context.CancelFunc
+
+	mu     sync.Mutex
+	latest *topicVersion
+	ready  chan struct{}
+}
+
+// run publishes a version on every store change that alters the topic, until
+// ctx is cancelled or the lister fails.
+func (t *fqdnTopic) run(ctx context.Context, subscribe func() <-chan struct{}, list topicLister) {
+	defer t.retire()
+	var prev *topicVersion
+	for {
+		// Subscribing before listing guarantees that a mutation racing
+		// with the listing is notified.
+		gen := subscribe()
+		fqdns, err := list(ctx, gen)
+		if ctx.Err() != nil {
+			return
+		}
+		if v := newTopicVersion(prev, fqdns, err); prev == nil || err != nil || len(v.changes) > 0 {
+			t.publish(v)
+			prev = v
+		}
+		if err != nil {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-gen:
+		}
+	}
+}
+
+func (t *fqdnTopic) publish(v *topicVersion) {
+	t.mu.Lock()
+	old := t.latest
+	t.latest = v
+	t.mu.Unlock()
+
+	if old == nil {
+		metrics.StreamTopicStateBytes.Add(float64(v.bytes))
+		close(t.ready)
+		return
+	}
+	metrics.StreamTopicStateBytes.Add(float64(v.bytes - old.bytes))
+	close(old.next)
+}
+
+// retire releases the accounted state once the topic stops.
+func (t *fqdnTopic) retire() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.latest != nil {
+		metrics.StreamTopicStateBytes.Sub(float64(t.latest.bytes))
+	}
+}
+
+// first waits for the first version of the topic.
+func (t *fqdnTopic) first(ctx context.Context) (*topicVersion, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-t.ready:
+		return t.current(), nil
+	}
+}
+
+// current returns the latest version of the topic.
+func (t *fqdnTopic) current() *topicVersion {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.latest
+}
+
+// failed reports whether the topic ended on an error.
+func (t *fqdnTopic) failed() bool {
+	v := t.current()
+	return v != nil && v.err != nil
+}
+
+// subscriberState accounts for the memory a stream keeps alive on its own:
+// the topic version it last sent, once the topic published a newer one.
+type subscriberState struct {
+	bytes int
+}
+
+// hold records that the subscriber sent current while the topic is at
+// latest.
+func (st *subscriberState) hold(current, latest *topicVersion) {
+	bytes := 0
+	if current != latest {
+		bytes = current.bytes
+	}
+	metrics.StreamSubscriberStateBytes.Add(float64(bytes - st.bytes))
+	st.bytes = bytes
+}
+
+// release drops the state when the stream ends.
+func (st *subscriberState) release() {
+	metrics.StreamSubscriberStateBytes.Sub(float64(st.bytes))
+	st.bytes = 0
+}
+
+// fqdnTopics holds the topics of the open streams.
+type fqdnTopics struct {
+	mu     sync.Mutex
+	topics map[topicKey]*fqdnTopic
+}
+
+// attach returns the topic of key, starting it when no stream is attached to
+// it yet. detach must be called when the stream ends; the topic stops with
+// its last stream.
+func (ts *fqdnTopics) attach(key topicKey, subscribe func() <-chan struct{}, list topicLister) (topic *fqdnTopic, detach func()) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.topics == nil {
+		ts.topics = make(map[topicKey]*fqdnTopic)
+	}
+	t, ok := ts.topics[key]
+	if !ok || t.failed() {
+		ctx, cancel := context.WithCancel(context.Background())
+		t = &fqdnTopic{cancel: cancel, ready: make(chan struct{})}
+		ts.topics[key] = t
+		metrics.StreamTopics.Set(float64(len(ts.topics)))
+		go t.run(ctx, subscribe, list)
+	}
+	t.refs++
+
+	var once sync.Once
+	return t, func() {
+		once.Do(func() {
+			ts.mu.Lock()
+			defer ts.mu.Unlock()
+			if t.refs--; t.refs > 0 {
+				return
+			}
+			t.cancel()
+			if ts.topics[key] == t {
+				delete(ts.topics, key)
+				metrics.StreamTopics.Set(float64(len(ts.topics)))
+			}
+		})
+	}
+}
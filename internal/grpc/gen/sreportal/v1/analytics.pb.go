@@ -0,0 +1,541 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: sreportal/v1/analytics.proto
+
+package sreportalv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UsageEventType is the kind of a usage event
+type UsageEventType int32
+
+const (
+	UsageEventType_USAGE_EVENT_TYPE_UNSPECIFIED UsageEventType = 0
+	// USAGE_EVENT_TYPE_PAGE_VIEW is a view of a portal page
+	UsageEventType_USAGE_EVENT_TYPE_PAGE_VIEW UsageEventType = 1
+	// USAGE_EVENT_TYPE_SEARCH is a search in the FQDNs of a portal
+	UsageEventType_USAGE_EVENT_TYPE_SEARCH UsageEventType = 2
+	// USAGE_EVENT_TYPE_GROUP_CLICK is the opening of an FQDN group
+	UsageEventType_USAGE_EVENT_TYPE_GROUP_CLICK UsageEventType = 3
+)
+
+// Enum value maps for UsageEventType.
+var (
+	UsageEventType_name = map[int32]string{
+		0: "USAGE_EVENT_TYPE_UNSPECIFIED",
+		1: "USAGE_EVENT_TYPE_PAGE_VIEW",
+		2: "USAGE_EVENT_TYPE_SEARCH",
+		3: "USAGE_EVENT_TYPE_GROUP_CLICK",
+	}
+	UsageEventType_value = map[string]int32{
+		"USAGE_EVENT_TYPE_UNSPECIFIED": 0,
+		"USAGE_EVENT_TYPE_PAGE_VIEW":   1,
+		"USAGE_EVENT_TYPE_SEARCH":      2,
+		"USAGE_EVENT_TYPE_GROUP_CLICK": 3,
+	}
+)
+
+func (x UsageEventType) Enum() *UsageEventType {
+	p := new(UsageEventType)
+	*p = x
+	return p
+}
+
+func (x UsageEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UsageEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_sreportal_v1_analytics_proto_enumTypes[0].Descriptor()
+}
+
+func (UsageEventType) Type() protoreflect.EnumType {
+	return &file_sreportal_v1_analytics_proto_enumTypes[0]
+}
+
+func (x UsageEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UsageEventType.Descriptor instead.
+func (UsageEventType) EnumDescriptor() ([]byte, []int) {
+	return file_sreportal_v1_analytics_proto_rawDescGZIP(), []int{0}
+}
+
+// RecordUsageRequest is a usage event of a portal
+type RecordUsageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// portal is the name of the portal the event happened on
+	Portal string `protobuf:"bytes,1,opt,name=portal,proto3" json:"portal,omitempty"`
+	// type is the kind of event
+	Type UsageEventType `protobuf:"varint,2,opt,name=type,proto3,enum=sreportal.v1.UsageEventType" json:"type,omitempty"`
+	// value is the search term of a search and the group of a group click
+	Value         string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordUsageRequest) Reset() {
+	*x = RecordUsageRequest{}
+	mi := &file_sreportal_v1_analytics_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordUsageRequest) ProtoMessage() {}
+
+func (x *RecordUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_analytics_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordUsageRequest.ProtoReflect.Descriptor instead.
+func (*RecordUsageRequest) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_analytics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecordUsageRequest) GetPortal() string {
+	if x != nil {
+		return x.Portal
+	}
+	return ""
+}
+
+func (x *RecordUsageRequest) GetType() UsageEventType {
+	if x != nil {
+		return x.Type
+	}
+	return UsageEventType_USAGE_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *RecordUsageRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// RecordUsageResponse is the response for recording a usage event
+type RecordUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordUsageResponse) Reset() {
+	*x = RecordUsageResponse{}
+	mi := &file_sreportal_v1_analytics_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordUsageResponse) ProtoMessage() {}
+
+func (x *RecordUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_analytics_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordUsageResponse.ProtoReflect.Descriptor instead.
+func (*RecordUsageResponse) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_analytics_proto_rawDescGZIP(), []int{1}
+}
+
+// GetUsageStatsRequest is the request for getting usage counters
+type GetUsageStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// portal restricts the counters to one portal (empty = every portal)
+	Portal string `protobuf:"bytes,1,opt,name=portal,proto3" json:"portal,omitempty"`
+	// limit caps the top searches and top groups returned (0 = 10)
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageStatsRequest) Reset() {
+	*x = GetUsageStatsRequest{}
+	mi := &file_sreportal_v1_analytics_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageStatsRequest) ProtoMessage() {}
+
+func (x *GetUsageStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_analytics_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageStatsRequest) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_analytics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetUsageStatsRequest) GetPortal() string {
+	if x != nil {
+		return x.Portal
+	}
+	return ""
+}
+
+func (x *GetUsageStatsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// GetUsageStatsResponse lists the usage counters per portal
+type GetUsageStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// portals are the counters of each portal with recorded events, sorted by
+	// name
+	Portals []*PortalUsage `protobuf:"bytes,1,rep,name=portals,proto3" json:"portals,omitempty"`
+	// since is when counting started (counters restart with the operator)
+	Since         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageStatsResponse) Reset() {
+	*x = GetUsageStatsResponse{}
+	mi := &file_sreportal_v1_analytics_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageStatsResponse) ProtoMessage() {}
+
+func (x *GetUsageStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_analytics_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageStatsResponse) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_analytics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetUsageStatsResponse) GetPortals() []*PortalUsage {
+	if x != nil {
+		return x.Portals
+	}
+	return nil
+}
+
+func (x *GetUsageStatsResponse) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+// PortalUsage are the usage counters of a portal
+type PortalUsage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// portal is the name of the portal
+	Portal string `protobuf:"bytes,1,opt,name=portal,proto3" json:"portal,omitempty"`
+	// page_views is the number of portal page views
+	PageViews int64 `protobuf:"varint,2,opt,name=page_views,json=pageViews,proto3" json:"page_views,omitempty"`
+	// searches is the number of searches
+	Searches int64 `protobuf:"varint,3,opt,name=searches,proto3" json:"searches,omitempty"`
+	// group_clicks is the number of group clicks
+	GroupClicks int64 `protobuf:"varint,4,opt,name=group_clicks,json=groupClicks,proto3" json:"group_clicks,omitempty"`
+	// top_searches are the most searched terms, most searched first
+	TopSearches []*UsageCounter `protobuf:"bytes,5,rep,name=top_searches,json=topSearches,proto3" json:"top_searches,omitempty"`
+	// top_groups are the most clicked groups, most clicked first
+	TopGroups     []*UsageCounter `protobuf:"bytes,6,rep,name=top_groups,json=topGroups,proto3" json:"top_groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortalUsage) Reset() {
+	*x = PortalUsage{}
+	mi := &file_sreportal_v1_analytics_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortalUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortalUsage) ProtoMessage() {}
+
+func (x *PortalUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_analytics_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortalUsage.ProtoReflect.Descriptor instead.
+func (*PortalUsage) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_analytics_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PortalUsage) GetPortal() string {
+	if x != nil {
+		return x.Portal
+	}
+	return ""
+}
+
+func (x *PortalUsage) GetPageViews() int64 {
+	if x != nil {
+		return x.PageViews
+	}
+	return 0
+}
+
+func (x *PortalUsage) GetSearches() int64 {
+	if x != nil {
+		return x.Searches
+	}
+	return 0
+}
+
+func (x *PortalUsage) GetGroupClicks() int64 {
+	if x != nil {
+		return x.GroupClicks
+	}
+	return 0
+}
+
+func (x *PortalUsage) GetTopSearches() []*UsageCounter {
+	if x != nil {
+		return x.TopSearches
+	}
+	return nil
+}
+
+func (x *PortalUsage) GetTopGroups() []*UsageCounter {
+	if x != nil {
+		return x.TopGroups
+	}
+	return nil
+}
+
+// UsageCounter is a counted search term or group
+type UsageCounter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// value is the search term or the group name
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// count is the number of events
+	Count         int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageCounter) Reset() {
+	*x = UsageCounter{}
+	mi := &file_sreportal_v1_analytics_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageCounter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageCounter) ProtoMessage() {}
+
+func (x *UsageCounter) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_analytics_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageCounter.ProtoReflect.Descriptor instead.
+func (*UsageCounter) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_analytics_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UsageCounter) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *UsageCounter) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_sreportal_v1_analytics_proto protoreflect.FileDescriptor
+
+const file_sreportal_v1_analytics_proto_rawDesc = "" +
+	"\n" +
+	"\x1csreportal/v1/analytics.proto\x12\fsreportal.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"t\n" +
+	"\x12RecordUsageRequest\x12\x16\n" +
+	"\x06portal\x18\x01 \x01(\tR\x06portal\x120\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1c.sreportal.v1.UsageEventTypeR\x04type\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"\x15\n" +
+	"\x13RecordUsageResponse\"D\n" +
+	"\x14GetUsageStatsRequest\x12\x16\n" +
+	"\x06portal\x18\x01 \x01(\tR\x06portal\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"~\n" +
+	"\x15GetUsageStatsResponse\x123\n" +
+	"\aportals\x18\x01 \x03(\v2\x19.sreportal.v1.PortalUsageR\aportals\x120\n" +
+	"\x05since\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"\xfd\x01\n" +
+	"\vPortalUsage\x12\x16\n" +
+	"\x06portal\x18\x01 \x01(\tR\x06portal\x12\x1d\n" +
+	"\n" +
+	"page_views\x18\x02 \x01(\x03R\tpageViews\x12\x1a\n" +
+	"\bsearches\x18\x03 \x01(\x03R\bsearches\x12!\n" +
+	"\fgroup_clicks\x18\x04 \x01(\x03R\vgroupClicks\x12=\n" +
+	"\ftop_searches\x18\x05 \x03(\v2\x1a.sreportal.v1.UsageCounterR\vtopSearches\x129\n" +
+	"\n" +
+	"top_groups\x18\x06 \x03(\v2\x1a.sreportal.v1.UsageCounterR\ttopGroups\":\n" +
+	"\fUsageCounter\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count*\x91\x01\n" +
+	"\x0eUsageEventType\x12 \n" +
+	"\x1cUSAGE_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aUSAGE_EVENT_TYPE_PAGE_VIEW\x10\x01\x12\x1b\n" +
+	"\x17USAGE_EVENT_TYPE_SEARCH\x10\x02\x12 \n" +
+	"\x1cUSAGE_EVENT_TYPE_GROUP_CLICK\x10\x032\xc0\x01\n" +
+	"\x10AnalyticsService\x12R\n" +
+	"\vRecordUsage\x12 .sreportal.v1.RecordUsageRequest\x1a!.sreportal.v1.RecordUsageResponse\x12X\n" +
+	"\rGetUsageStats\x12\".sreportal.v1.GetUsageStatsRequest\x1a#.sreportal.v1.GetUsageStatsResponseB\xbe\x01\n" +
+	"\x10com.sreportal.v1B\x0eAnalyticsProtoP\x01ZIgithub.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1;sreportalv1\xa2\x02\x03SXX\xaa\x02\fSreportal.V1\xca\x02\fSreportal\\V1\xe2\x02\x18Sreportal\\V1\\GPBMetadata\xea\x02\rSreportal::V1b\x06proto3"
+
+var (
+	file_sreportal_v1_analytics_proto_rawDescOnce sync.Once
+	file_sreportal_v1_analytics_proto_rawDescData []byte
+)
+
+func file_sreportal_v1_analytics_proto_rawDescGZIP() []byte {
+	file_sreportal_v1_analytics_proto_rawDescOnce.Do(func() {
+		file_sreportal_v1_analytics_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sreportal_v1_analytics_proto_rawDesc), len(file_sreportal_v1_analytics_proto_rawDesc)))
+	})
+	return file_sreportal_v1_analytics_proto_rawDescData
+}
+
+var file_sreportal_v1_analytics_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_sreportal_v1_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_sreportal_v1_analytics_proto_goTypes = []any{
+	(UsageEventType)(0),           // 0: sreportal.v1.UsageEventType
+	(*RecordUsageRequest)(nil),    // 1: sreportal.v1.RecordUsageRequest
+	(*RecordUsageResponse)(nil),   // 2: sreportal.v1.RecordUsageResponse
+	(*GetUsageStatsRequest)(nil),  // 3: sreportal.v1.GetUsageStatsRequest
+	(*GetUsageStatsResponse)(nil), // 4: sreportal.v1.GetUsageStatsResponse
+	(*PortalUsage)(nil),           // 5: sreportal.v1.PortalUsage
+	(*UsageCounter)(nil),          // 6: sreportal.v1.UsageCounter
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+}
+var file_sreportal_v1_analytics_proto_depIdxs = []int32{
+	0, // 0: sreportal.v1.RecordUsageRequest.type:type_name -> sreportal.v1.UsageEventType
+	5, // 1: sreportal.v1.GetUsageStatsResponse.portals:type_name -> sreportal.v1.PortalUsage
+	7, // 2: sreportal.v1.GetUsageStatsResponse.since:type_name -> google.protobuf.Timestamp
+	6, // 3: sreportal.v1.PortalUsage.top_searches:type_name -> sreportal.v1.UsageCounter
+	6, // 4: sreportal.v1.PortalUsage.top_groups:type_name -> sreportal.v1.UsageCounter
+	1, // 5: sreportal.v1.AnalyticsService.RecordUsage:input_type -> sreportal.v1.RecordUsageRequest
+	3, // 6: sreportal.v1.AnalyticsService.GetUsageStats:input_type -> sreportal.v1.GetUsageStatsRequest
+	2, // 7: sreportal.v1.AnalyticsService.RecordUsage:output_type -> sreportal.v1.RecordUsageResponse
+	4, // 8: sreportal.v1.AnalyticsService.GetUsageStats:output_type -> sreportal.v1.GetUsageStatsResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_sreportal_v1_analytics_proto_init() }
+func file_sreportal_v1_analytics_proto_init() {
+	if File_sreportal_v1_analytics_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sreportal_v1_analytics_proto_rawDesc), len(file_sreportal_v1_analytics_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sreportal_v1_analytics_proto_goTypes,
+		DependencyIndexes: file_sreportal_v1_analytics_proto_depIdxs,
+		EnumInfos:         file_sreportal_v1_analytics_proto_enumTypes,
+		MessageInfos:      file_sreportal_v1_analytics_proto_msgTypes,
+	}.Build()
+	File_sreportal_v1_analytics_proto = out.File
+	file_sreportal_v1_analytics_proto_goTypes = nil
+	file_sreportal_v1_analytics_proto_depIdxs = nil
+}
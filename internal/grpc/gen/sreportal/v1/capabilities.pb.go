@@ -0,0 +1,316 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: sreportal/v1/capabilities.proto
+
+package sreportalv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GetCapabilitiesRequest is the request for getting the capabilities
+type GetCapabilitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCapabilitiesRequest) Reset() {
+	*x = GetCapabilitiesRequest{}
+	mi := &file_sreportal_v1_capabilities_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCapabilitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapabilitiesRequest) ProtoMessage() {}
+
+func (x *GetCapabilitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_capabilities_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCapabilitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetCapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_capabilities_proto_rawDescGZIP(), []int{0}
+}
+
+// GetCapabilitiesResponse lists the sources and features of the instance
+type GetCapabilitiesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sources are the discovery sources, enabled or not
+	Sources []*SourceCapability `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	// features are the optional features: probing, certificates, dnsCheck,
+	// auth and analytics
+	Features      []*FeatureCapability `protobuf:"bytes,2,rep,name=features,proto3" json:"features,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCapabilitiesResponse) Reset() {
+	*x = GetCapabilitiesResponse{}
+	mi := &file_sreportal_v1_capabilities_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCapabilitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapabilitiesResponse) ProtoMessage() {}
+
+func (x *GetCapabilitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_capabilities_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCapabilitiesResponse.ProtoReflect.Descriptor instead.
+func (*GetCapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_capabilities_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetCapabilitiesResponse) GetSources() []*SourceCapability {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *GetCapabilitiesResponse) GetFeatures() []*FeatureCapability {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+// SourceCapability reports whether a discovery source is enabled
+type SourceCapability struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the key of the source in the operator configuration
+	// (e.g. service, ingress, gatewayHTTPRoute)
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// enabled is true when the source discovers FQDNs
+	Enabled       bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SourceCapability) Reset() {
+	*x = SourceCapability{}
+	mi := &file_sreportal_v1_capabilities_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SourceCapability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceCapability) ProtoMessage() {}
+
+func (x *SourceCapability) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_capabilities_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceCapability.ProtoReflect.Descriptor instead.
+func (*SourceCapability) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_capabilities_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SourceCapability) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SourceCapability) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// FeatureCapability reports whether a feature is active
+type FeatureCapability struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name identifies the feature
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// enabled is true when the feature runs
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// config summarises the configuration of the feature (no addresses or
+	// credentials)
+	Config        map[string]string `protobuf:"bytes,3,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeatureCapability) Reset() {
+	*x = FeatureCapability{}
+	mi := &file_sreportal_v1_capabilities_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeatureCapability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureCapability) ProtoMessage() {}
+
+func (x *FeatureCapability) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_capabilities_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureCapability.ProtoReflect.Descriptor instead.
+func (*FeatureCapability) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_capabilities_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FeatureCapability) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FeatureCapability) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *FeatureCapability) GetConfig() map[string]string {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+var File_sreportal_v1_capabilities_proto protoreflect.FileDescriptor
+
+const file_sreportal_v1_capabilities_proto_rawDesc = "" +
+	"\n" +
+	"\x1fsreportal/v1/capabilities.proto\x12\fsreportal.v1\"\x18\n" +
+	"\x16GetCapabilitiesRequest\"\x90\x01\n" +
+	"\x17GetCapabilitiesResponse\x128\n" +
+	"\asources\x18\x01 \x03(\v2\x1e.sreportal.v1.SourceCapabilityR\asources\x12;\n" +
+	"\bfeatures\x18\x02 \x03(\v2\x1f.sreportal.v1.FeatureCapabilityR\bfeatures\"@\n" +
+	"\x10SourceCapability\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\"\xc1\x01\n" +
+	"\x11FeatureCapability\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12C\n" +
+	"\x06config\x18\x03 \x03(\v2+.sreportal.v1.FeatureCapability.ConfigEntryR\x06config\x1a9\n" +
+	"\vConfigEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012u\n" +
+	"\x13CapabilitiesService\x12^\n" +
+	"\x0fGetCapabilities\x12$.sreportal.v1.GetCapabilitiesRequest\x1a%.sreportal.v1.GetCapabilitiesResponseB\xc1\x01\n" +
+	"\x10com.sreportal.v1B\x11CapabilitiesProtoP\x01ZIgithub.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1;sreportalv1\xa2\x02\x03SXX\xaa\x02\fSreportal.V1\xca\x02\fSreportal\\V1\xe2\x02\x18Sreportal\\V1\\GPBMetadata\xea\x02\rSreportal::V1b\x06proto3"
+
+var (
+	file_sreportal_v1_capabilities_proto_rawDescOnce sync.Once
+	file_sreportal_v1_capabilities_proto_rawDescData []byte
+)
+
+func file_sreportal_v1_capabilities_proto_rawDescGZIP() []byte {
+	file_sreportal_v1_capabilities_proto_rawDescOnce.Do(func() {
+		file_sreportal_v1_capabilities_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sreportal_v1_capabilities_proto_rawDesc), len(file_sreportal_v1_capabilities_proto_rawDesc)))
+	})
+	return file_sreportal_v1_capabilities_proto_rawDescData
+}
+
+var file_sreportal_v1_capabilities_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_sreportal_v1_capabilities_proto_goTypes = []any{
+	(*GetCapabilitiesRequest)(nil),  // 0: sreportal.v1.GetCapabilitiesRequest
+	(*GetCapabilitiesResponse)(nil), // 1: sreportal.v1.GetCapabilitiesResponse
+	(*SourceCapability)(nil),        // 2: sreportal.v1.SourceCapability
+	(*FeatureCapability)(nil),       // 3: sreportal.v1.FeatureCapability
+	nil,                             // 4: sreportal.v1.FeatureCapability.ConfigEntry
+}
+var file_sreportal_v1_capabilities_proto_depIdxs = []int32{
+	2, // 0: sreportal.v1.GetCapabilitiesResponse.sources:type_name -> sreportal.v1.SourceCapability
+	3, // 1: sreportal.v1.GetCapabilitiesResponse.features:type_name -> sreportal.v1.FeatureCapability
+	4, // 2: sreportal.v1.FeatureCapability.config:type_name -> sreportal.v1.FeatureCapability.ConfigEntry
+	0, // 3: sreportal.v1.CapabilitiesService.GetCapabilities:input_type -> sreportal.v1.GetCapabilitiesRequest
+	1, // 4: sreportal.v1.CapabilitiesService.GetCapabilities:output_type -> sreportal.v1.GetCapabilitiesResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_sreportal_v1_capabilities_proto_init() }
+func file_sreportal_v1_capabilities_proto_init() {
+	if File_sreportal_v1_capabilities_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sreportal_v1_capabilities_proto_rawDesc), len(file_sreportal_v1_capabilities_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sreportal_v1_capabilities_proto_goTypes,
+		DependencyIndexes: file_sreportal_v1_capabilities_proto_depIdxs,
+		MessageInfos:      file_sreportal_v1_capabilities_proto_msgTypes,
+	}.Build()
+	File_sreportal_v1_capabilities_proto = out.File
+	file_sreportal_v1_capabilities_proto_goTypes = nil
+	file_sreportal_v1_capabilities_proto_depIdxs = nil
+}
@@ -0,0 +1,339 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: sreportal/v1/diagnostics.proto
+
+package sreportalv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DiagnosticStatus is the outcome of a diagnostic check
+type DiagnosticStatus int32
+
+const (
+	DiagnosticStatus_DIAGNOSTIC_STATUS_UNSPECIFIED DiagnosticStatus = 0
+	DiagnosticStatus_DIAGNOSTIC_STATUS_OK          DiagnosticStatus = 1
+	DiagnosticStatus_DIAGNOSTIC_STATUS_WARNING     DiagnosticStatus = 2
+	DiagnosticStatus_DIAGNOSTIC_STATUS_FAILED      DiagnosticStatus = 3
+	DiagnosticStatus_DIAGNOSTIC_STATUS_SKIPPED     DiagnosticStatus = 4
+)
+
+// Enum value maps for DiagnosticStatus.
+var (
+	DiagnosticStatus_name = map[int32]string{
+		0: "DIAGNOSTIC_STATUS_UNSPECIFIED",
+		1: "DIAGNOSTIC_STATUS_OK",
+		2: "DIAGNOSTIC_STATUS_WARNING",
+		3: "DIAGNOSTIC_STATUS_FAILED",
+		4: "DIAGNOSTIC_STATUS_SKIPPED",
+	}
+	DiagnosticStatus_value = map[string]int32{
+		"DIAGNOSTIC_STATUS_UNSPECIFIED": 0,
+		"DIAGNOSTIC_STATUS_OK":          1,
+		"DIAGNOSTIC_STATUS_WARNING":     2,
+		"DIAGNOSTIC_STATUS_FAILED":      3,
+		"DIAGNOSTIC_STATUS_SKIPPED":     4,
+	}
+)
+
+func (x DiagnosticStatus) Enum() *DiagnosticStatus {
+	p := new(DiagnosticStatus)
+	*p = x
+	return p
+}
+
+func (x DiagnosticStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiagnosticStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_sreportal_v1_diagnostics_proto_enumTypes[0].Descriptor()
+}
+
+func (DiagnosticStatus) Type() protoreflect.EnumType {
+	return &file_sreportal_v1_diagnostics_proto_enumTypes[0]
+}
+
+func (x DiagnosticStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiagnosticStatus.Descriptor instead.
+func (DiagnosticStatus) EnumDescriptor() ([]byte, []int) {
+	return file_sreportal_v1_diagnostics_proto_rawDescGZIP(), []int{0}
+}
+
+// RunDiagnosticsRequest is the request for running the diagnostics
+type RunDiagnosticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunDiagnosticsRequest) Reset() {
+	*x = RunDiagnosticsRequest{}
+	mi := &file_sreportal_v1_diagnostics_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunDiagnosticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunDiagnosticsRequest) ProtoMessage() {}
+
+func (x *RunDiagnosticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_diagnostics_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunDiagnosticsRequest.ProtoReflect.Descriptor instead.
+func (*RunDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_diagnostics_proto_rawDescGZIP(), []int{0}
+}
+
+// RunDiagnosticsResponse is the diagnostics report
+type RunDiagnosticsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// time is when the checks ran
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// status is the worst status of the checks
+	Status DiagnosticStatus `protobuf:"varint,2,opt,name=status,proto3,enum=sreportal.v1.DiagnosticStatus" json:"status,omitempty"`
+	// checks are the individual checks, ordered by category
+	Checks        []*DiagnosticCheck `protobuf:"bytes,3,rep,name=checks,proto3" json:"checks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunDiagnosticsResponse) Reset() {
+	*x = RunDiagnosticsResponse{}
+	mi := &file_sreportal_v1_diagnostics_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunDiagnosticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunDiagnosticsResponse) ProtoMessage() {}
+
+func (x *RunDiagnosticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_diagnostics_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunDiagnosticsResponse.ProtoReflect.Descriptor instead.
+func (*RunDiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_diagnostics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RunDiagnosticsResponse) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *RunDiagnosticsResponse) GetStatus() DiagnosticStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DiagnosticStatus_DIAGNOSTIC_STATUS_UNSPECIFIED
+}
+
+func (x *RunDiagnosticsResponse) GetChecks() []*DiagnosticCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+// DiagnosticCheck is the outcome of one diagnostic check
+type DiagnosticCheck struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// category groups the checks: connectivity, rbac, crd, source, webhook or
+	// config
+	Category string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	// name identifies the check within its category (e.g. "list services")
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// status is the outcome of the check
+	Status DiagnosticStatus `protobuf:"varint,3,opt,name=status,proto3,enum=sreportal.v1.DiagnosticStatus" json:"status,omitempty"`
+	// message details the outcome (e.g. the missing permission)
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiagnosticCheck) Reset() {
+	*x = DiagnosticCheck{}
+	mi := &file_sreportal_v1_diagnostics_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiagnosticCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosticCheck) ProtoMessage() {}
+
+func (x *DiagnosticCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_diagnostics_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosticCheck.ProtoReflect.Descriptor instead.
+func (*DiagnosticCheck) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_diagnostics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DiagnosticCheck) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *DiagnosticCheck) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiagnosticCheck) GetStatus() DiagnosticStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DiagnosticStatus_DIAGNOSTIC_STATUS_UNSPECIFIED
+}
+
+func (x *DiagnosticCheck) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_sreportal_v1_diagnostics_proto protoreflect.FileDescriptor
+
+const file_sreportal_v1_diagnostics_proto_rawDesc = "" +
+	"\n" +
+	"\x1esreportal/v1/diagnostics.proto\x12\fsreportal.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x17\n" +
+	"\x15RunDiagnosticsRequest\"\xb7\x01\n" +
+	"\x16RunDiagnosticsResponse\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x126\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1e.sreportal.v1.DiagnosticStatusR\x06status\x125\n" +
+	"\x06checks\x18\x03 \x03(\v2\x1d.sreportal.v1.DiagnosticCheckR\x06checks\"\x93\x01\n" +
+	"\x0fDiagnosticCheck\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x126\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1e.sreportal.v1.DiagnosticStatusR\x06status\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage*\xab\x01\n" +
+	"\x10DiagnosticStatus\x12!\n" +
+	"\x1dDIAGNOSTIC_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14DIAGNOSTIC_STATUS_OK\x10\x01\x12\x1d\n" +
+	"\x19DIAGNOSTIC_STATUS_WARNING\x10\x02\x12\x1c\n" +
+	"\x18DIAGNOSTIC_STATUS_FAILED\x10\x03\x12\x1d\n" +
+	"\x19DIAGNOSTIC_STATUS_SKIPPED\x10\x042q\n" +
+	"\x12DiagnosticsService\x12[\n" +
+	"\x0eRunDiagnostics\x12#.sreportal.v1.RunDiagnosticsRequest\x1a$.sreportal.v1.RunDiagnosticsResponseB\xc0\x01\n" +
+	"\x10com.sreportal.v1B\x10DiagnosticsProtoP\x01ZIgithub.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1;sreportalv1\xa2\x02\x03SXX\xaa\x02\fSreportal.V1\xca\x02\fSreportal\\V1\xe2\x02\x18Sreportal\\V1\\GPBMetadata\xea\x02\rSreportal::V1b\x06proto3"
+
+var (
+	file_sreportal_v1_diagnostics_proto_rawDescOnce sync.Once
+	file_sreportal_v1_diagnostics_proto_rawDescData []byte
+)
+
+func file_sreportal_v1_diagnostics_proto_rawDescGZIP() []byte {
+	file_sreportal_v1_diagnostics_proto_rawDescOnce.Do(func() {
+		file_sreportal_v1_diagnostics_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sreportal_v1_diagnostics_proto_rawDesc), len(file_sreportal_v1_diagnostics_proto_rawDesc)))
+	})
+	return file_sreportal_v1_diagnostics_proto_rawDescData
+}
+
+var file_sreportal_v1_diagnostics_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_sreportal_v1_diagnostics_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_sreportal_v1_diagnostics_proto_goTypes = []any{
+	(DiagnosticStatus)(0),          // 0: sreportal.v1.DiagnosticStatus
+	(*RunDiagnosticsRequest)(nil),  // 1: sreportal.v1.RunDiagnosticsRequest
+	(*RunDiagnosticsResponse)(nil), // 2: sreportal.v1.RunDiagnosticsResponse
+	(*DiagnosticCheck)(nil),        // 3: sreportal.v1.DiagnosticCheck
+	(*timestamppb.Timestamp)(nil),  // 4: google.protobuf.Timestamp
+}
+var file_sreportal_v1_diagnostics_proto_depIdxs = []int32{
+	4, // 0: sreportal.v1.RunDiagnosticsResponse.time:type_name -> google.protobuf.Timestamp
+	0, // 1: sreportal.v1.RunDiagnosticsResponse.status:type_name -> sreportal.v1.DiagnosticStatus
+	3, // 2: sreportal.v1.RunDiagnosticsResponse.checks:type_name -> sreportal.v1.DiagnosticCheck
+	0, // 3: sreportal.v1.DiagnosticCheck.status:type_name -> sreportal.v1.DiagnosticStatus
+	1, // 4: sreportal.v1.DiagnosticsService.RunDiagnostics:input_type -> sreportal.v1.RunDiagnosticsRequest
+	2, // 5: sreportal.v1.DiagnosticsService.RunDiagnostics:output_type -> sreportal.v1.RunDiagnosticsResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_sreportal_v1_diagnostics_proto_init() }
+func file_sreportal_v1_diagnostics_proto_init() {
+	if File_sreportal_v1_diagnostics_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sreportal_v1_diagnostics_proto_rawDesc), len(file_sreportal_v1_diagnostics_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sreportal_v1_diagnostics_proto_goTypes,
+		DependencyIndexes: file_sreportal_v1_diagnostics_proto_depIdxs,
+		EnumInfos:         file_sreportal_v1_diagnostics_proto_enumTypes,
+		MessageInfos:      file_sreportal_v1_diagnostics_proto_msgTypes,
+	}.Build()
+	File_sreportal_v1_diagnostics_proto = out.File
+	file_sreportal_v1_diagnostics_proto_goTypes = nil
+	file_sreportal_v1_diagnostics_proto_depIdxs = nil
+}
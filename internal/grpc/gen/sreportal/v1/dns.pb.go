@@ -22,6 +22,188 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// FQDNView selects the FQDN fields returned by ListFQDNs and StreamFQDNs
+type FQDNView int32
+
+const (
+	// FQDN_VIEW_UNSPECIFIED is FULL, for backward compatibility
+	FQDNView_FQDN_VIEW_UNSPECIFIED FQDNView = 0
+	// FQDN_VIEW_BASIC returns the fields a table view needs: name, source,
+	// groups, description, record_type, portals, sync_status, target_scope,
+	// sensitive, overall_status, removed_at and removal_reason. Targets,
+	// timestamps, origin, ports, paths and the other detail fields are left
+	// empty.
+	FQDNView_FQDN_VIEW_BASIC FQDNView = 1
+	// FQDN_VIEW_FULL returns every field
+	FQDNView_FQDN_VIEW_FULL FQDNView = 2
+)
+
+// Enum value maps for FQDNView.
+var (
+	FQDNView_name = map[int32]string{
+		0: "FQDN_VIEW_UNSPECIFIED",
+		1: "FQDN_VIEW_BASIC",
+		2: "FQDN_VIEW_FULL",
+	}
+	FQDNView_value = map[string]int32{
+		"FQDN_VIEW_UNSPECIFIED": 0,
+		"FQDN_VIEW_BASIC":       1,
+		"FQDN_VIEW_FULL":        2,
+	}
+)
+
+func (x FQDNView) Enum() *FQDNView {
+	p := new(FQDNView)
+	*p = x
+	return p
+}
+
+func (x FQDNView) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FQDNView) Descriptor() protoreflect.EnumDescriptor {
+	return file_sreportal_v1_dns_proto_enumTypes[0].Descriptor()
+}
+
+func (FQDNView) Type() protoreflect.EnumType {
+	return &file_sreportal_v1_dns_proto_enumTypes[0]
+}
+
+func (x FQDNView) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FQDNView.Descriptor instead.
+func (FQDNView) EnumDescriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{0}
+}
+
+// ManualEntryOperationType is the kind of edit of a ManualEntryOperation
+type ManualEntryOperationType int32
+
+const (
+	ManualEntryOperationType_MANUAL_ENTRY_OPERATION_TYPE_UNSPECIFIED ManualEntryOperationType = 0
+	// add a new entry; fails with ALREADY_EXISTS if it exists
+	ManualEntryOperationType_MANUAL_ENTRY_OPERATION_TYPE_ADD ManualEntryOperationType = 1
+	// replace an existing entry; fails with NOT_FOUND if it does not exist
+	ManualEntryOperationType_MANUAL_ENTRY_OPERATION_TYPE_UPDATE ManualEntryOperationType = 2
+	// remove an existing entry; fails with NOT_FOUND if it does not exist
+	ManualEntryOperationType_MANUAL_ENTRY_OPERATION_TYPE_DELETE ManualEntryOperationType = 3
+)
+
+// Enum value maps for ManualEntryOperationType.
+var (
+	ManualEntryOperationType_name = map[int32]string{
+		0: "MANUAL_ENTRY_OPERATION_TYPE_UNSPECIFIED",
+		1: "MANUAL_ENTRY_OPERATION_TYPE_ADD",
+		2: "MANUAL_ENTRY_OPERATION_TYPE_UPDATE",
+		3: "MANUAL_ENTRY_OPERATION_TYPE_DELETE",
+	}
+	ManualEntryOperationType_value = map[string]int32{
+		"MANUAL_ENTRY_OPERATION_TYPE_UNSPECIFIED": 0,
+		"MANUAL_ENTRY_OPERATION_TYPE_ADD":         1,
+		"MANUAL_ENTRY_OPERATION_TYPE_UPDATE":      2,
+		"MANUAL_ENTRY_OPERATION_TYPE_DELETE":      3,
+	}
+)
+
+func (x ManualEntryOperationType) Enum() *ManualEntryOperationType {
+	p := new(ManualEntryOperationType)
+	*p = x
+	return p
+}
+
+func (x ManualEntryOperationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ManualEntryOperationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_sreportal_v1_dns_proto_enumTypes[1].Descriptor()
+}
+
+func (ManualEntryOperationType) Type() protoreflect.EnumType {
+	return &file_sreportal_v1_dns_proto_enumTypes[1]
+}
+
+func (x ManualEntryOperationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ManualEntryOperationType.Descriptor instead.
+func (ManualEntryOperationType) EnumDescriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{1}
+}
+
+// IgnoreResultStatus is the outcome of an IgnoreResult
+type IgnoreResultStatus int32
+
+const (
+	IgnoreResultStatus_IGNORE_RESULT_STATUS_UNSPECIFIED IgnoreResultStatus = 0
+	// the annotation was updated (or would be, on a dry run)
+	IgnoreResultStatus_IGNORE_RESULT_STATUS_UPDATED IgnoreResultStatus = 1
+	// the annotation already had the requested state
+	IgnoreResultStatus_IGNORE_RESULT_STATUS_UNCHANGED IgnoreResultStatus = 2
+	// the caller or the operator may not patch the resource
+	IgnoreResultStatus_IGNORE_RESULT_STATUS_DENIED IgnoreResultStatus = 3
+	// the FQDN is not in the portal, or the resource does not exist
+	IgnoreResultStatus_IGNORE_RESULT_STATUS_NOT_FOUND IgnoreResultStatus = 4
+	// the FQDN has no origin resource (e.g. manual entries) or its kind
+	// cannot be annotated
+	IgnoreResultStatus_IGNORE_RESULT_STATUS_UNSUPPORTED IgnoreResultStatus = 5
+	// the API server rejected the change
+	IgnoreResultStatus_IGNORE_RESULT_STATUS_FAILED IgnoreResultStatus = 6
+)
+
+// Enum value maps for IgnoreResultStatus.
+var (
+	IgnoreResultStatus_name = map[int32]string{
+		0: "IGNORE_RESULT_STATUS_UNSPECIFIED",
+		1: "IGNORE_RESULT_STATUS_UPDATED",
+		2: "IGNORE_RESULT_STATUS_UNCHANGED",
+		3: "IGNORE_RESULT_STATUS_DENIED",
+		4: "IGNORE_RESULT_STATUS_NOT_FOUND",
+		5: "IGNORE_RESULT_STATUS_UNSUPPORTED",
+		6: "IGNORE_RESULT_STATUS_FAILED",
+	}
+	IgnoreResultStatus_value = map[string]int32{
+		"IGNORE_RESULT_STATUS_UNSPECIFIED": 0,
+		"IGNORE_RESULT_STATUS_UPDATED":     1,
+		"IGNORE_RESULT_STATUS_UNCHANGED":   2,
+		"IGNORE_RESULT_STATUS_DENIED":      3,
+		"IGNORE_RESULT_STATUS_NOT_FOUND":   4,
+		"IGNORE_RESULT_STATUS_UNSUPPORTED": 5,
+		"IGNORE_RESULT_STATUS_FAILED":      6,
+	}
+)
+
+func (x IgnoreResultStatus) Enum() *IgnoreResultStatus {
+	p := new(IgnoreResultStatus)
+	*p = x
+	return p
+}
+
+func (x IgnoreResultStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IgnoreResultStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_sreportal_v1_dns_proto_enumTypes[2].Descriptor()
+}
+
+func (IgnoreResultStatus) Type() protoreflect.EnumType {
+	return &file_sreportal_v1_dns_proto_enumTypes[2]
+}
+
+func (x IgnoreResultStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IgnoreResultStatus.Descriptor instead.
+func (IgnoreResultStatus) EnumDescriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{2}
+}
+
 // UpdateType represents the type of update
 type UpdateType int32
 
@@ -30,6 +212,11 @@ const (
 	UpdateType_UPDATE_TYPE_ADDED       UpdateType = 1
 	UpdateType_UPDATE_TYPE_MODIFIED    UpdateType = 2
 	UpdateType_UPDATE_TYPE_DELETED     UpdateType = 3
+	// UPDATE_TYPE_HEARTBEAT keeps an idle stream open; it carries no FQDN
+	UpdateType_UPDATE_TYPE_HEARTBEAT UpdateType = 4
+	// UPDATE_TYPE_SYNCED follows the initial state of a stream: every FQDN
+	// matching the filters was sent as ADDED before it. It carries no FQDN
+	UpdateType_UPDATE_TYPE_SYNCED UpdateType = 5
 )
 
 // Enum value maps for UpdateType.
@@ -39,12 +226,16 @@ var (
 		1: "UPDATE_TYPE_ADDED",
 		2: "UPDATE_TYPE_MODIFIED",
 		3: "UPDATE_TYPE_DELETED",
+		4: "UPDATE_TYPE_HEARTBEAT",
+		5: "UPDATE_TYPE_SYNCED",
 	}
 	UpdateType_value = map[string]int32{
 		"UPDATE_TYPE_UNSPECIFIED": 0,
 		"UPDATE_TYPE_ADDED":       1,
 		"UPDATE_TYPE_MODIFIED":    2,
 		"UPDATE_TYPE_DELETED":     3,
+		"UPDATE_TYPE_HEARTBEAT":   4,
+		"UPDATE_TYPE_SYNCED":      5,
 	}
 )
 
@@ -59,11 +250,11 @@ func (x UpdateType) String() string {
 }
 
 func (UpdateType) Descriptor() protoreflect.EnumDescriptor {
-	return file_sreportal_v1_dns_proto_enumTypes[0].Descriptor()
+	return file_sreportal_v1_dns_proto_enumTypes[3].Descriptor()
 }
 
 func (UpdateType) Type() protoreflect.EnumType {
-	return &file_sreportal_v1_dns_proto_enumTypes[0]
+	return &file_sreportal_v1_dns_proto_enumTypes[3]
 }
 
 func (x UpdateType) Number() protoreflect.EnumNumber {
@@ -72,7 +263,72 @@ func (x UpdateType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UpdateType.Descriptor instead.
 func (UpdateType) EnumDescriptor() ([]byte, []int) {
-	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{0}
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{3}
+}
+
+// OverallStatus is the health badge of an FQDN. The first matching rule wins:
+// CRITICAL when the probe failed, the FQDN does not resolve or the certificate
+// seen by the last HTTPS probe has expired; WARNING when it resolves to other
+// targets or differently inside and outside the cluster, when its targets
+// oscillate (ownership_conflict), or when the certificate expires within 14
+// days; HEALTHY when it is in sync or the probe succeeded; UNKNOWN otherwise.
+type OverallStatus int32
+
+const (
+	OverallStatus_OVERALL_STATUS_UNSPECIFIED OverallStatus = 0
+	OverallStatus_OVERALL_STATUS_UNKNOWN     OverallStatus = 1
+	OverallStatus_OVERALL_STATUS_HEALTHY     OverallStatus = 2
+	OverallStatus_OVERALL_STATUS_WARNING     OverallStatus = 3
+	OverallStatus_OVERALL_STATUS_CRITICAL    OverallStatus = 4
+	// the FQDN disappeared from its sources (tombstone)
+	OverallStatus_OVERALL_STATUS_REMOVED OverallStatus = 5
+)
+
+// Enum value maps for OverallStatus.
+var (
+	OverallStatus_name = map[int32]string{
+		0: "OVERALL_STATUS_UNSPECIFIED",
+		1: "OVERALL_STATUS_UNKNOWN",
+		2: "OVERALL_STATUS_HEALTHY",
+		3: "OVERALL_STATUS_WARNING",
+		4: "OVERALL_STATUS_CRITICAL",
+		5: "OVERALL_STATUS_REMOVED",
+	}
+	OverallStatus_value = map[string]int32{
+		"OVERALL_STATUS_UNSPECIFIED": 0,
+		"OVERALL_STATUS_UNKNOWN":     1,
+		"OVERALL_STATUS_HEALTHY":     2,
+		"OVERALL_STATUS_WARNING":     3,
+		"OVERALL_STATUS_CRITICAL":    4,
+		"OVERALL_STATUS_REMOVED":     5,
+	}
+)
+
+func (x OverallStatus) Enum() *OverallStatus {
+	p := new(OverallStatus)
+	*p = x
+	return p
+}
+
+func (x OverallStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OverallStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_sreportal_v1_dns_proto_enumTypes[4].Descriptor()
+}
+
+func (OverallStatus) Type() protoreflect.EnumType {
+	return &file_sreportal_v1_dns_proto_enumTypes[4]
+}
+
+func (x OverallStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OverallStatus.Descriptor instead.
+func (OverallStatus) EnumDescriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{4}
 }
 
 // ListFQDNsRequest is the request for listing FQDNs
@@ -90,9 +346,25 @@ type ListFQDNsRequest struct {
 	PageSize int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	// page_token is an opaque cursor returned by a previous ListFQDNs call.
 	// Empty string means start from the beginning.
-	PageToken     string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// target_scope filters FQDNs by the scope of their targets: "public",
+	// "private", "cgnat" or "link-local" (empty for all)
+	TargetScope string `protobuf:"bytes,7,opt,name=target_scope,json=targetScope,proto3" json:"target_scope,omitempty"`
+	// view selects the FQDN fields returned (default FULL)
+	View FQDNView `protobuf:"varint,8,opt,name=view,proto3,enum=sreportal.v1.FQDNView" json:"view,omitempty"`
+	// include_removed also returns the FQDNs that disappeared from their
+	// sources within the tombstone retention window, with the REMOVED overall
+	// status and removed_at set
+	IncludeRemoved bool `protobuf:"varint,9,opt,name=include_removed,json=includeRemoved,proto3" json:"include_removed,omitempty"`
+	// last_seen_after keeps only FQDNs last seen at or after this time, to get
+	// the recently active records (unset for no lower bound)
+	LastSeenAfter *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_seen_after,json=lastSeenAfter,proto3" json:"last_seen_after,omitempty"`
+	// last_seen_before keeps only FQDNs last seen before this time, to find
+	// stale records (unset for no upper bound). FQDNs with no last_seen are
+	// left out when either bound is set.
+	LastSeenBefore *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_seen_before,json=lastSeenBefore,proto3" json:"last_seen_before,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ListFQDNsRequest) Reset() {
@@ -167,6 +439,41 @@ func (x *ListFQDNsRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListFQDNsRequest) GetTargetScope() string {
+	if x != nil {
+		return x.TargetScope
+	}
+	return ""
+}
+
+func (x *ListFQDNsRequest) GetView() FQDNView {
+	if x != nil {
+		return x.View
+	}
+	return FQDNView_FQDN_VIEW_UNSPECIFIED
+}
+
+func (x *ListFQDNsRequest) GetIncludeRemoved() bool {
+	if x != nil {
+		return x.IncludeRemoved
+	}
+	return false
+}
+
+func (x *ListFQDNsRequest) GetLastSeenAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAfter
+	}
+	return nil
+}
+
+func (x *ListFQDNsRequest) GetLastSeenBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenBefore
+	}
+	return nil
+}
+
 // ListFQDNsResponse contains the list of FQDNs
 type ListFQDNsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -176,7 +483,12 @@ type ListFQDNsResponse struct {
 	// Empty when there are no more results.
 	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	// total_size is the total number of FQDNs matching the request filters, before pagination.
-	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	TotalSize int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// groups is the group hierarchy of the FQDNs matching the request filters,
+	// before pagination. Group names are split into levels on the configured
+	// separator ("/" by default), so "Platform/Networking" is a child of
+	// "Platform".
+	Groups        []*Group `protobuf:"bytes,4,rep,name=groups,proto3" json:"groups,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -232,6 +544,88 @@ func (x *ListFQDNsResponse) GetTotalSize() int32 {
 	return 0
 }
 
+func (x *ListFQDNsResponse) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// Group is a level of the group hierarchy
+type Group struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the last segment of the group name (e.g. "Networking")
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// path is the full group name (e.g. "Platform/Networking"), as listed in
+	// FQDN.groups
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// fqdn_count is the number of distinct FQDNs in this group or any of its
+	// descendants
+	FqdnCount int32 `protobuf:"varint,3,opt,name=fqdn_count,json=fqdnCount,proto3" json:"fqdn_count,omitempty"`
+	// children are the nested groups, sorted by name
+	Children      []*Group `protobuf:"bytes,4,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Group) Reset() {
+	*x = Group{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Group) ProtoMessage() {}
+
+func (x *Group) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Group) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Group) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Group) GetFqdnCount() int32 {
+	if x != nil {
+		return x.FqdnCount
+	}
+	return 0
+}
+
+func (x *Group) GetChildren() []*Group {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
 // StreamFQDNsRequest is the request for streaming FQDN updates
 type StreamFQDNsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -242,14 +636,28 @@ type StreamFQDNsRequest struct {
 	// source filters updates by source (empty for all sources)
 	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
 	// search filters updates by FQDN name substring (empty for all)
-	Search        string `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Search string `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"`
+	// target_scope filters updates by target scope (empty for all)
+	TargetScope string `protobuf:"bytes,5,opt,name=target_scope,json=targetScope,proto3" json:"target_scope,omitempty"`
+	// view selects the FQDN fields streamed (default FULL). With BASIC, changes
+	// limited to the omitted fields are not streamed.
+	View FQDNView `protobuf:"varint,6,opt,name=view,proto3,enum=sreportal.v1.FQDNView" json:"view,omitempty"`
+	// include_removed also streams the tombstones of FQDNs that disappeared
+	// from their sources (see ListFQDNsRequest.include_removed)
+	IncludeRemoved bool `protobuf:"varint,7,opt,name=include_removed,json=includeRemoved,proto3" json:"include_removed,omitempty"`
+	// last_seen_after keeps only FQDNs last seen at or after this time (see
+	// ListFQDNsRequest.last_seen_after)
+	LastSeenAfter *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_seen_after,json=lastSeenAfter,proto3" json:"last_seen_after,omitempty"`
+	// last_seen_before keeps only FQDNs last seen before this time (see
+	// ListFQDNsRequest.last_seen_before)
+	LastSeenBefore *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_seen_before,json=lastSeenBefore,proto3" json:"last_seen_before,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *StreamFQDNsRequest) Reset() {
 	*x = StreamFQDNsRequest{}
-	mi := &file_sreportal_v1_dns_proto_msgTypes[2]
+	mi := &file_sreportal_v1_dns_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -261,7 +669,7 @@ func (x *StreamFQDNsRequest) String() string {
 func (*StreamFQDNsRequest) ProtoMessage() {}
 
 func (x *StreamFQDNsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sreportal_v1_dns_proto_msgTypes[2]
+	mi := &file_sreportal_v1_dns_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -274,7 +682,7 @@ func (x *StreamFQDNsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamFQDNsRequest.ProtoReflect.Descriptor instead.
 func (*StreamFQDNsRequest) Descriptor() ([]byte, []int) {
-	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{2}
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *StreamFQDNsRequest) GetNamespace() string {
@@ -305,12 +713,47 @@ func (x *StreamFQDNsRequest) GetSearch() string {
 	return ""
 }
 
+func (x *StreamFQDNsRequest) GetTargetScope() string {
+	if x != nil {
+		return x.TargetScope
+	}
+	return ""
+}
+
+func (x *StreamFQDNsRequest) GetView() FQDNView {
+	if x != nil {
+		return x.View
+	}
+	return FQDNView_FQDN_VIEW_UNSPECIFIED
+}
+
+func (x *StreamFQDNsRequest) GetIncludeRemoved() bool {
+	if x != nil {
+		return x.IncludeRemoved
+	}
+	return false
+}
+
+func (x *StreamFQDNsRequest) GetLastSeenAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAfter
+	}
+	return nil
+}
+
+func (x *StreamFQDNsRequest) GetLastSeenBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenBefore
+	}
+	return nil
+}
+
 // StreamFQDNsResponse represents an update to an FQDN
 type StreamFQDNsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// type is the type of update
 	Type UpdateType `protobuf:"varint,1,opt,name=type,proto3,enum=sreportal.v1.UpdateType" json:"type,omitempty"`
-	// fqdn is the FQDN that was updated
+	// fqdn is the FQDN that was updated, unset for heartbeats
 	Fqdn          *FQDN `protobuf:"bytes,2,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -318,7 +761,7 @@ type StreamFQDNsResponse struct {
 
 func (x *StreamFQDNsResponse) Reset() {
 	*x = StreamFQDNsResponse{}
-	mi := &file_sreportal_v1_dns_proto_msgTypes[3]
+	mi := &file_sreportal_v1_dns_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -330,7 +773,7 @@ func (x *StreamFQDNsResponse) String() string {
 func (*StreamFQDNsResponse) ProtoMessage() {}
 
 func (x *StreamFQDNsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sreportal_v1_dns_proto_msgTypes[3]
+	mi := &file_sreportal_v1_dns_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -343,7 +786,7 @@ func (x *StreamFQDNsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamFQDNsResponse.ProtoReflect.Descriptor instead.
 func (*StreamFQDNsResponse) Descriptor() ([]byte, []int) {
-	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{3}
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *StreamFQDNsResponse) GetType() UpdateType {
@@ -360,35 +803,32 @@ func (x *StreamFQDNsResponse) GetFqdn() *FQDN {
 	return nil
 }
 
-// OriginResourceRef identifies the Kubernetes resource that produced an FQDN.
-// Only populated for FQDNs discovered via external-dns sources.
-type OriginResourceRef struct {
+// FederatedSearchRequest is the request for searching FQDNs across all sites
+type FederatedSearchRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// kind is the Kubernetes resource kind (e.g. Service, Ingress, DNSEndpoint)
-	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
-	// namespace is the Kubernetes namespace of the origin resource
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	// name is the name of the origin Kubernetes resource
-	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// search filters FQDNs by name substring (required)
+	Search string `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
+	// source filters FQDNs by source (empty for all sources)
+	Source        string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OriginResourceRef) Reset() {
-	*x = OriginResourceRef{}
-	mi := &file_sreportal_v1_dns_proto_msgTypes[4]
+func (x *FederatedSearchRequest) Reset() {
+	*x = FederatedSearchRequest{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OriginResourceRef) String() string {
+func (x *FederatedSearchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OriginResourceRef) ProtoMessage() {}
+func (*FederatedSearchRequest) ProtoMessage() {}
 
-func (x *OriginResourceRef) ProtoReflect() protoreflect.Message {
-	mi := &file_sreportal_v1_dns_proto_msgTypes[4]
+func (x *FederatedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -399,88 +839,2038 @@ func (x *OriginResourceRef) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OriginResourceRef.ProtoReflect.Descriptor instead.
-func (*OriginResourceRef) Descriptor() ([]byte, []int) {
-	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use FederatedSearchRequest.ProtoReflect.Descriptor instead.
+func (*FederatedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *OriginResourceRef) GetKind() string {
+func (x *FederatedSearchRequest) GetSearch() string {
 	if x != nil {
-		return x.Kind
+		return x.Search
 	}
 	return ""
 }
 
-func (x *OriginResourceRef) GetNamespace() string {
+func (x *FederatedSearchRequest) GetSource() string {
 	if x != nil {
-		return x.Namespace
+		return x.Source
+	}
+	return ""
+}
+
+// FederatedSearchResponse contains the merged results of a federated search
+type FederatedSearchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// results is the list of matching FQDNs, sorted by name then record type
+	Results []*FederatedFQDN `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// errors lists the sites that could not be searched (timeout, unreachable, ...).
+	// Results from the remaining sites are still returned.
+	Errors        []*FederatedSiteError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FederatedSearchResponse) Reset() {
+	*x = FederatedSearchResponse{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FederatedSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederatedSearchResponse) ProtoMessage() {}
+
+func (x *FederatedSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederatedSearchResponse.ProtoReflect.Descriptor instead.
+func (*FederatedSearchResponse) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FederatedSearchResponse) GetResults() []*FederatedFQDN {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *FederatedSearchResponse) GetErrors() []*FederatedSiteError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// FederatedFQDN is an FQDN together with the sites it was found on
+type FederatedFQDN struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// fqdn is the FQDN as reported by the first site it was found on
+	Fqdn *FQDN `protobuf:"bytes,1,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	// sites lists every site serving this FQDN ("local" for this instance,
+	// the remote portal name otherwise). Sorted and deduplicated.
+	Sites         []string `protobuf:"bytes,2,rep,name=sites,proto3" json:"sites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FederatedFQDN) Reset() {
+	*x = FederatedFQDN{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FederatedFQDN) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederatedFQDN) ProtoMessage() {}
+
+func (x *FederatedFQDN) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederatedFQDN.ProtoReflect.Descriptor instead.
+func (*FederatedFQDN) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FederatedFQDN) GetFqdn() *FQDN {
+	if x != nil {
+		return x.Fqdn
+	}
+	return nil
+}
+
+func (x *FederatedFQDN) GetSites() []string {
+	if x != nil {
+		return x.Sites
+	}
+	return nil
+}
+
+// FederatedSiteError reports a site that failed during a federated search
+type FederatedSiteError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// site is the remote portal name
+	Site string `protobuf:"bytes,1,opt,name=site,proto3" json:"site,omitempty"`
+	// error is a human-readable description of the failure
+	Error         string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FederatedSiteError) Reset() {
+	*x = FederatedSiteError{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FederatedSiteError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederatedSiteError) ProtoMessage() {}
+
+func (x *FederatedSiteError) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederatedSiteError.ProtoReflect.Descriptor instead.
+func (*FederatedSiteError) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FederatedSiteError) GetSite() string {
+	if x != nil {
+		return x.Site
+	}
+	return ""
+}
+
+func (x *FederatedSiteError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// BatchUpdateManualEntriesRequest edits the manual entries of a portal
+type BatchUpdateManualEntriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// portal is the local portal the entries belong to (required)
+	Portal string `protobuf:"bytes,1,opt,name=portal,proto3" json:"portal,omitempty"`
+	// dns_record is the name of the manual DNSRecord holding the entries, in
+	// the portal namespace (default "<portal>-manual"). It is created by the
+	// first batch adding an entry.
+	DnsRecord string `protobuf:"bytes,2,opt,name=dns_record,json=dnsRecord,proto3" json:"dns_record,omitempty"`
+	// resource_version is the DNSRecord resourceVersion the edits were made
+	// against, as returned by a previous call. When set, the batch fails with
+	// ABORTED if the DNSRecord changed since; when empty, the operations are
+	// applied to the latest version.
+	ResourceVersion string `protobuf:"bytes,3,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	// operations are applied in order (at least one)
+	Operations    []*ManualEntryOperation `protobuf:"bytes,4,rep,name=operations,proto3" json:"operations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateManualEntriesRequest) Reset() {
+	*x = BatchUpdateManualEntriesRequest{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateManualEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateManualEntriesRequest) ProtoMessage() {}
+
+func (x *BatchUpdateManualEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateManualEntriesRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateManualEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchUpdateManualEntriesRequest) GetPortal() string {
+	if x != nil {
+		return x.Portal
+	}
+	return ""
+}
+
+func (x *BatchUpdateManualEntriesRequest) GetDnsRecord() string {
+	if x != nil {
+		return x.DnsRecord
+	}
+	return ""
+}
+
+func (x *BatchUpdateManualEntriesRequest) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+func (x *BatchUpdateManualEntriesRequest) GetOperations() []*ManualEntryOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+// ManualEntryOperation is one edit of a BatchUpdateManualEntries batch
+type ManualEntryOperation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// type is the kind of edit (required)
+	Type ManualEntryOperationType `protobuf:"varint,1,opt,name=type,proto3,enum=sreportal.v1.ManualEntryOperationType" json:"type,omitempty"`
+	// entry is the entry to add or the new content of the entry to update.
+	// Entries are identified by fqdn and record_type; only these two fields
+	// are read for a delete.
+	Entry         *ManualEntry `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManualEntryOperation) Reset() {
+	*x = ManualEntryOperation{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManualEntryOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManualEntryOperation) ProtoMessage() {}
+
+func (x *ManualEntryOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManualEntryOperation.ProtoReflect.Descriptor instead.
+func (*ManualEntryOperation) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ManualEntryOperation) GetType() ManualEntryOperationType {
+	if x != nil {
+		return x.Type
+	}
+	return ManualEntryOperationType_MANUAL_ENTRY_OPERATION_TYPE_UNSPECIFIED
+}
+
+func (x *ManualEntryOperation) GetEntry() *ManualEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// ManualEntry is a manual DNS entry, as stored in a manual DNSRecord
+type ManualEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// fqdn is the fully qualified domain name (required)
+	Fqdn string `protobuf:"bytes,1,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	// record_type is the DNS record type (default "A")
+	RecordType string `protobuf:"bytes,2,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	// targets are the expected record values; empty only documents the FQDN
+	Targets []string `protobuf:"bytes,3,rep,name=targets,proto3" json:"targets,omitempty"`
+	// group is the group the entry is displayed in
+	Group string `protobuf:"bytes,4,opt,name=group,proto3" json:"group,omitempty"`
+	// groups lists additional groups the entry is displayed in
+	Groups []string `protobuf:"bytes,5,rep,name=groups,proto3" json:"groups,omitempty"`
+	// description is an optional human-readable description
+	Description   string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManualEntry) Reset() {
+	*x = ManualEntry{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManualEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManualEntry) ProtoMessage() {}
+
+func (x *ManualEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManualEntry.ProtoReflect.Descriptor instead.
+func (*ManualEntry) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ManualEntry) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+func (x *ManualEntry) GetRecordType() string {
+	if x != nil {
+		return x.RecordType
+	}
+	return ""
+}
+
+func (x *ManualEntry) GetTargets() []string {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *ManualEntry) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *ManualEntry) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *ManualEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// BatchUpdateManualEntriesResponse is returned once a batch is applied
+type BatchUpdateManualEntriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// dns_record is the name of the manual DNSRecord that was updated
+	DnsRecord string `protobuf:"bytes,1,opt,name=dns_record,json=dnsRecord,proto3" json:"dns_record,omitempty"`
+	// resource_version is the new DNSRecord resourceVersion, to send with the
+	// next batch
+	ResourceVersion string `protobuf:"bytes,2,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	// entry_count is the number of entries of the DNSRecord after the batch
+	EntryCount    int32 `protobuf:"varint,3,opt,name=entry_count,json=entryCount,proto3" json:"entry_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateManualEntriesResponse) Reset() {
+	*x = BatchUpdateManualEntriesResponse{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateManualEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateManualEntriesResponse) ProtoMessage() {}
+
+func (x *BatchUpdateManualEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateManualEntriesResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateManualEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BatchUpdateManualEntriesResponse) GetDnsRecord() string {
+	if x != nil {
+		return x.DnsRecord
+	}
+	return ""
+}
+
+func (x *BatchUpdateManualEntriesResponse) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+func (x *BatchUpdateManualEntriesResponse) GetEntryCount() int32 {
+	if x != nil {
+		return x.EntryCount
+	}
+	return 0
+}
+
+// SetFQDNsIgnoredRequest sets the ignore state of a list of FQDNs
+type SetFQDNsIgnoredRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// portal is the local portal the FQDNs are looked up in (required)
+	Portal string `protobuf:"bytes,1,opt,name=portal,proto3" json:"portal,omitempty"`
+	// targets are the FQDNs or resources to update (at least one)
+	Targets []*IgnoreTarget `protobuf:"bytes,2,rep,name=targets,proto3" json:"targets,omitempty"`
+	// ignored sets the annotation to "true" when true, and removes it when false
+	Ignored bool `protobuf:"varint,3,opt,name=ignored,proto3" json:"ignored,omitempty"`
+	// dry_run validates every change with the API server without persisting it
+	DryRun        bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFQDNsIgnoredRequest) Reset() {
+	*x = SetFQDNsIgnoredRequest{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFQDNsIgnoredRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFQDNsIgnoredRequest) ProtoMessage() {}
+
+func (x *SetFQDNsIgnoredRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFQDNsIgnoredRequest.ProtoReflect.Descriptor instead.
+func (*SetFQDNsIgnoredRequest) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetFQDNsIgnoredRequest) GetPortal() string {
+	if x != nil {
+		return x.Portal
+	}
+	return ""
+}
+
+func (x *SetFQDNsIgnoredRequest) GetTargets() []*IgnoreTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *SetFQDNsIgnoredRequest) GetIgnored() bool {
+	if x != nil {
+		return x.Ignored
+	}
+	return false
+}
+
+func (x *SetFQDNsIgnoredRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// IgnoreTarget names the resources to update, by FQDN or directly
+type IgnoreTarget struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// fqdn updates the resources the FQDN is discovered from in the portal,
+	// including the FQDNs removed recently
+	Fqdn string `protobuf:"bytes,1,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	// record_type restricts fqdn to one record type (empty for all)
+	RecordType string `protobuf:"bytes,2,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	// resource is a "kind/namespace/name" origin reference, as returned in
+	// IgnoreResult, used instead of fqdn. To be ignored, it must be the origin
+	// of an FQDN of the portal, including the FQDNs removed recently; any
+	// resource can be un-ignored, e.g. once its FQDNs are no longer retained.
+	Resource      string `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IgnoreTarget) Reset() {
+	*x = IgnoreTarget{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IgnoreTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IgnoreTarget) ProtoMessage() {}
+
+func (x *IgnoreTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IgnoreTarget.ProtoReflect.Descriptor instead.
+func (*IgnoreTarget) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *IgnoreTarget) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+func (x *IgnoreTarget) GetRecordType() string {
+	if x != nil {
+		return x.RecordType
+	}
+	return ""
+}
+
+func (x *IgnoreTarget) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+// SetFQDNsIgnoredResponse reports the outcome for every target
+type SetFQDNsIgnoredResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// results holds one result per target and origin resource, in target order
+	Results []*IgnoreResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// dry_run is true when nothing was persisted
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFQDNsIgnoredResponse) Reset() {
+	*x = SetFQDNsIgnoredResponse{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFQDNsIgnoredResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFQDNsIgnoredResponse) ProtoMessage() {}
+
+func (x *SetFQDNsIgnoredResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFQDNsIgnoredResponse.ProtoReflect.Descriptor instead.
+func (*SetFQDNsIgnoredResponse) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetFQDNsIgnoredResponse) GetResults() []*IgnoreResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SetFQDNsIgnoredResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// IgnoreResult is the outcome of a target on one origin resource
+type IgnoreResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// fqdn is the fqdn of the target, empty for resource targets
+	Fqdn string `protobuf:"bytes,1,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	// record_type is the record type of the target
+	RecordType string `protobuf:"bytes,2,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	// resource is the "kind/namespace/name" origin reference of the FQDN
+	Resource string `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	// status is the outcome of the change
+	Status IgnoreResultStatus `protobuf:"varint,4,opt,name=status,proto3,enum=sreportal.v1.IgnoreResultStatus" json:"status,omitempty"`
+	// error describes why the change was not applied
+	Error         string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IgnoreResult) Reset() {
+	*x = IgnoreResult{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IgnoreResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IgnoreResult) ProtoMessage() {}
+
+func (x *IgnoreResult) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IgnoreResult.ProtoReflect.Descriptor instead.
+func (*IgnoreResult) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *IgnoreResult) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+func (x *IgnoreResult) GetRecordType() string {
+	if x != nil {
+		return x.RecordType
+	}
+	return ""
+}
+
+func (x *IgnoreResult) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *IgnoreResult) GetStatus() IgnoreResultStatus {
+	if x != nil {
+		return x.Status
+	}
+	return IgnoreResultStatus_IGNORE_RESULT_STATUS_UNSPECIFIED
+}
+
+func (x *IgnoreResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// OriginResourceRef identifies the Kubernetes resource that produced an FQDN.
+// Only populated for FQDNs discovered via external-dns sources.
+type OriginResourceRef struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kind is the Kubernetes resource kind (e.g. Service, Ingress, DNSEndpoint)
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// namespace is the Kubernetes namespace of the origin resource
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// name is the name of the origin Kubernetes resource
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OriginResourceRef) Reset() {
+	*x = OriginResourceRef{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OriginResourceRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OriginResourceRef) ProtoMessage() {}
+
+func (x *OriginResourceRef) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OriginResourceRef.ProtoReflect.Descriptor instead.
+func (*OriginResourceRef) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *OriginResourceRef) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *OriginResourceRef) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *OriginResourceRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// DNSRecordRef identifies the DNSRecord an FQDN was taken from
+type DNSRecordRef struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// namespace is the namespace of the DNSRecord
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// name is the name of the DNSRecord
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DNSRecordRef) Reset() {
+	*x = DNSRecordRef{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DNSRecordRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DNSRecordRef) ProtoMessage() {}
+
+func (x *DNSRecordRef) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DNSRecordRef.ProtoReflect.Descriptor instead.
+func (*DNSRecordRef) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DNSRecordRef) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DNSRecordRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ServicePort is a port exposed by the Kubernetes Service an FQDN originates from
+type ServicePort struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the Service port name, or its appProtocol when unnamed (e.g. "https")
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// port is the port number exposed by the Service
+	Port int32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// protocol is the port protocol (TCP, UDP or SCTP)
+	Protocol      string `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServicePort) Reset() {
+	*x = ServicePort{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServicePort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServicePort) ProtoMessage() {}
+
+func (x *ServicePort) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServicePort.ProtoReflect.Descriptor instead.
+func (*ServicePort) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ServicePort) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServicePort) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ServicePort) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+// FQDN represents a fully qualified domain name with metadata
+type FQDN struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the fully qualified domain name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// source indicates where this FQDN came from (manual or external-dns)
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// groups is the list of groups this FQDN belongs to
+	Groups []string `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	// description is an optional human-readable description
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// record_type is the DNS record type (A, AAAA, CNAME, etc.)
+	RecordType string `protobuf:"bytes,5,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	// targets is the list of target addresses for this FQDN
+	Targets []string `protobuf:"bytes,6,rep,name=targets,proto3" json:"targets,omitempty"`
+	// last_seen is the timestamp when this FQDN was last observed
+	LastSeen *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	// dns_resource_name is the name of the DNS resource this FQDN belongs to.
+	// Deprecated: replaced by `portals` to support an FQDN belonging to several
+	// portals after inter-DNS dedup. Kept for back-compat with older clients.
+	//
+	// Deprecated: Marked as deprecated in sreportal/v1/dns.proto.
+	DnsResourceName string `protobuf:"bytes,8,opt,name=dns_resource_name,json=dnsResourceName,proto3" json:"dns_resource_name,omitempty"`
+	// dns_resource_namespace is the namespace of the DNS resource.
+	// Deprecated: see `dns_resource_name`. Kept for back-compat with older clients.
+	//
+	// Deprecated: Marked as deprecated in sreportal/v1/dns.proto.
+	DnsResourceNamespace string `protobuf:"bytes,9,opt,name=dns_resource_namespace,json=dnsResourceNamespace,proto3" json:"dns_resource_namespace,omitempty"`
+	// origin_ref identifies the Kubernetes resource (Service, Ingress, DNSEndpoint)
+	// that produced this FQDN via external-dns. Not set for manual entries.
+	OriginRef *OriginResourceRef `protobuf:"bytes,10,opt,name=origin_ref,json=originRef,proto3,oneof" json:"origin_ref,omitempty"`
+	// sync_status indicates whether the FQDN is correctly resolved in DNS.
+	// Values: "sync", "notavailable", "notsync", or empty.
+	SyncStatus string `protobuf:"bytes,11,opt,name=sync_status,json=syncStatus,proto3" json:"sync_status,omitempty"`
+	// portals lists every portal this FQDN belongs to (post inter-DNS dedup).
+	// Sorted and deduplicated.
+	Portals []string `protobuf:"bytes,12,rep,name=portals,proto3" json:"portals,omitempty"`
+	// target_scope is the most exposed scope among the targets: "public",
+	// "private" (RFC1918, unique-local, loopback), "cgnat" (100.64.0.0/10),
+	// "link-local", or empty when no target is an IP address (e.g. CNAME).
+	TargetScope string `protobuf:"bytes,13,opt,name=target_scope,json=targetScope,proto3" json:"target_scope,omitempty"`
+	// sensitive is true when the FQDN matches one of the operator's sensitive
+	// patterns (admin consoles, dashboards, ...).
+	Sensitive bool `protobuf:"varint,14,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+	// ports lists the ports exposed by the source Service. Only set for FQDNs
+	// originating from a Service.
+	Ports []*ServicePort `protobuf:"bytes,15,rep,name=ports,proto3" json:"ports,omitempty"`
+	// paths lists the HTTP route paths served under this FQDN. Only set for
+	// FQDNs originating from an Ingress or an Istio VirtualService.
+	Paths []string `protobuf:"bytes,16,rep,name=paths,proto3" json:"paths,omitempty"`
+	// internal_sync_status is the sync_status observed through the cluster
+	// resolver. Only set when split-horizon resolution is enabled.
+	InternalSyncStatus string `protobuf:"bytes,17,opt,name=internal_sync_status,json=internalSyncStatus,proto3" json:"internal_sync_status,omitempty"`
+	// external_sync_status is the sync_status observed through the configured
+	// external resolver. Only set when split-horizon resolution is enabled.
+	// Differs from internal_sync_status when the FQDN resolves differently
+	// inside and outside the cluster.
+	ExternalSyncStatus string `protobuf:"bytes,18,opt,name=external_sync_status,json=externalSyncStatus,proto3" json:"external_sync_status,omitempty"`
+	// availability is the outcome of the last connection probe of the FQDN:
+	// "up", "down", or empty when no probe is configured.
+	Availability string `protobuf:"bytes,19,opt,name=availability,proto3" json:"availability,omitempty"`
+	// source_type is the source kind (service, ingress, dnsendpoint, ...) of
+	// the DNSRecord this FQDN was taken from. Empty for manual entries.
+	SourceType string `protobuf:"bytes,20,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
+	// dns_record_ref identifies the DNSRecord this FQDN was taken from, after
+	// source priority resolution and dedup across DNS resources.
+	DnsRecordRef *DNSRecordRef `protobuf:"bytes,21,opt,name=dns_record_ref,json=dnsRecordRef,proto3,oneof" json:"dns_record_ref,omitempty"`
+	// last_reconciled is the last reconcile time of the DNSRecord this FQDN
+	// was taken from.
+	LastReconciled *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=last_reconciled,json=lastReconciled,proto3" json:"last_reconciled,omitempty"`
+	// overall_status is the single health badge of the FQDN, combining
+	// sync_status, the split-horizon statuses and availability. Clients should
+	// render it instead of recomputing it from the individual fields.
+	OverallStatus OverallStatus `protobuf:"varint,23,opt,name=overall_status,json=overallStatus,proto3,enum=sreportal.v1.OverallStatus" json:"overall_status,omitempty"`
+	// annotations holds the annotations of the origin resource whose keys are
+	// listed in the operator's dnsRecord.exposedAnnotations allowlist. Empty
+	// for manual entries and when no annotation is exposed.
+	Annotations map[string]string `protobuf:"bytes,24,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// id is a stable identifier of the entry, derived only from its portal,
+	// name and record type ("fqdn-" followed by 24 hex characters). It does not
+	// change when groups or DNS resources are renamed, so external
+	// reconciliation tools can use it as a key.
+	Id string `protobuf:"bytes,25,opt,name=id,proto3" json:"id,omitempty"`
+	// removed_at is when the FQDN disappeared from every source. It is only set
+	// on tombstones, returned with include_removed during the operator's
+	// dnsRecord.tombstoneRetention; their overall_status is REMOVED.
+	RemovedAt *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	// lookup_failure classifies why the last DNS check of the FQDN failed:
+	// "nxdomain" (the record is missing), "servfail", "timeout" or "refused"
+	// (the DNS servers did not answer properly), or "error". Empty when the
+	// lookup answered or the FQDN was not checked.
+	LookupFailure string `protobuf:"bytes,27,opt,name=lookup_failure,json=lookupFailure,proto3" json:"lookup_failure,omitempty"`
+	// shadowed_manual identifies the manual DNSRecord whose entry duplicates
+	// this discovered FQDN. The first writer stays authoritative: the discovered
+	// source (dns_record_ref, source_type, origin_ref) came first and the manual
+	// entry is ignored; it can be removed. Not set when no manual entry
+	// collides, or when the manual entry came first and is the one served.
+	ShadowedManual *DNSRecordRef `protobuf:"bytes,28,opt,name=shadowed_manual,json=shadowedManual,proto3,oneof" json:"shadowed_manual,omitempty"`
+	// ownership_conflict is set while the targets of the FQDN oscillate between
+	// sets across reconciles, a sign that several external-dns deployments
+	// manage the record. Not set otherwise.
+	OwnershipConflict *OwnershipConflict `protobuf:"bytes,29,opt,name=ownership_conflict,json=ownershipConflict,proto3,oneof" json:"ownership_conflict,omitempty"`
+	// origin_cause summarises why the FQDN does not resolve, from the state and
+	// recent Warning events of its origin Service or Ingress (e.g. "ingress has
+	// no load balancer address"). Only set while sync_status is notavailable
+	// and something explains it.
+	OriginCause string `protobuf:"bytes,30,opt,name=origin_cause,json=originCause,proto3" json:"origin_cause,omitempty"`
+	// health_status summarises the last probe of the FQDN: "healthy",
+	// "degraded" (HTTP status of 400 or more, or invalid TLS certificate),
+	// "unhealthy" (unreachable), or empty when no probe is configured.
+	HealthStatus string `protobuf:"bytes,31,opt,name=health_status,json=healthStatus,proto3" json:"health_status,omitempty"`
+	// last_probe_time is when the probe that last changed the outcome of the
+	// FQDN ran. It is not refreshed while the outcome stays the same.
+	LastProbeTime *timestamppb.Timestamp `protobuf:"bytes,32,opt,name=last_probe_time,json=lastProbeTime,proto3" json:"last_probe_time,omitempty"`
+	// http_status_code is the status code answered to the last HTTP(S) probe,
+	// 0 for other probes or when the FQDN was unreachable.
+	HttpStatusCode int32 `protobuf:"varint,33,opt,name=http_status_code,json=httpStatusCode,proto3" json:"http_status_code,omitempty"`
+	// probe_latency_ms is the duration of the successful probe that last
+	// changed the outcome, in milliseconds.
+	ProbeLatencyMs int64 `protobuf:"varint,34,opt,name=probe_latency_ms,json=probeLatencyMs,proto3" json:"probe_latency_ms,omitempty"`
+	// tls_valid reports whether the certificate presented to the last HTTPS
+	// probe was valid for the FQDN. Not set for other probes.
+	TlsValid *bool `protobuf:"varint,35,opt,name=tls_valid,json=tlsValid,proto3,oneof" json:"tls_valid,omitempty"`
+	// target_providers hints, for each public IP target, the cloud or hosting
+	// provider whose address ranges contain it. Only set when the operator's
+	// dnsRecord.targetProviders is enabled; targets outside the known ranges
+	// are not listed.
+	TargetProviders []*TargetProvider `protobuf:"bytes,36,rep,name=target_providers,json=targetProviders,proto3" json:"target_providers,omitempty"`
+	// removal_reason explains why a tombstone disappeared: "record_deleted"
+	// (its DNSRecord was deleted, e.g. with its DNS resource or portal) or
+	// "no_longer_reported" (its source stopped reporting it, e.g. the origin
+	// resource was deleted or filtered out). Only set with removed_at.
+	RemovalReason string `protobuf:"bytes,37,opt,name=removal_reason,json=removalReason,proto3" json:"removal_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FQDN) Reset() {
+	*x = FQDN{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FQDN) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FQDN) ProtoMessage() {}
+
+func (x *FQDN) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FQDN.ProtoReflect.Descriptor instead.
+func (*FQDN) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FQDN) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FQDN) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *FQDN) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *FQDN) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *FQDN) GetRecordType() string {
+	if x != nil {
+		return x.RecordType
+	}
+	return ""
+}
+
+func (x *FQDN) GetTargets() []string {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *FQDN) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in sreportal/v1/dns.proto.
+func (x *FQDN) GetDnsResourceName() string {
+	if x != nil {
+		return x.DnsResourceName
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in sreportal/v1/dns.proto.
+func (x *FQDN) GetDnsResourceNamespace() string {
+	if x != nil {
+		return x.DnsResourceNamespace
+	}
+	return ""
+}
+
+func (x *FQDN) GetOriginRef() *OriginResourceRef {
+	if x != nil {
+		return x.OriginRef
+	}
+	return nil
+}
+
+func (x *FQDN) GetSyncStatus() string {
+	if x != nil {
+		return x.SyncStatus
+	}
+	return ""
+}
+
+func (x *FQDN) GetPortals() []string {
+	if x != nil {
+		return x.Portals
+	}
+	return nil
+}
+
+func (x *FQDN) GetTargetScope() string {
+	if x != nil {
+		return x.TargetScope
+	}
+	return ""
+}
+
+func (x *FQDN) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+func (x *FQDN) GetPorts() []*ServicePort {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *FQDN) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *FQDN) GetInternalSyncStatus() string {
+	if x != nil {
+		return x.InternalSyncStatus
+	}
+	return ""
+}
+
+func (x *FQDN) GetExternalSyncStatus() string {
+	if x != nil {
+		return x.ExternalSyncStatus
+	}
+	return ""
+}
+
+func (x *FQDN) GetAvailability() string {
+	if x != nil {
+		return x.Availability
+	}
+	return ""
+}
+
+func (x *FQDN) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+func (x *FQDN) GetDnsRecordRef() *DNSRecordRef {
+	if x != nil {
+		return x.DnsRecordRef
+	}
+	return nil
+}
+
+func (x *FQDN) GetLastReconciled() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastReconciled
+	}
+	return nil
+}
+
+func (x *FQDN) GetOverallStatus() OverallStatus {
+	if x != nil {
+		return x.OverallStatus
+	}
+	return OverallStatus_OVERALL_STATUS_UNSPECIFIED
+}
+
+func (x *FQDN) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *FQDN) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FQDN) GetRemovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemovedAt
+	}
+	return nil
+}
+
+func (x *FQDN) GetLookupFailure() string {
+	if x != nil {
+		return x.LookupFailure
+	}
+	return ""
+}
+
+func (x *FQDN) GetShadowedManual() *DNSRecordRef {
+	if x != nil {
+		return x.ShadowedManual
+	}
+	return nil
+}
+
+func (x *FQDN) GetOwnershipConflict() *OwnershipConflict {
+	if x != nil {
+		return x.OwnershipConflict
+	}
+	return nil
+}
+
+func (x *FQDN) GetOriginCause() string {
+	if x != nil {
+		return x.OriginCause
+	}
+	return ""
+}
+
+func (x *FQDN) GetHealthStatus() string {
+	if x != nil {
+		return x.HealthStatus
+	}
+	return ""
+}
+
+func (x *FQDN) GetLastProbeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastProbeTime
+	}
+	return nil
+}
+
+func (x *FQDN) GetHttpStatusCode() int32 {
+	if x != nil {
+		return x.HttpStatusCode
+	}
+	return 0
+}
+
+func (x *FQDN) GetProbeLatencyMs() int64 {
+	if x != nil {
+		return x.ProbeLatencyMs
+	}
+	return 0
+}
+
+func (x *FQDN) GetTlsValid() bool {
+	if x != nil && x.TlsValid != nil {
+		return *x.TlsValid
+	}
+	return false
+}
+
+func (x *FQDN) GetTargetProviders() []*TargetProvider {
+	if x != nil {
+		return x.TargetProviders
+	}
+	return nil
+}
+
+func (x *FQDN) GetRemovalReason() string {
+	if x != nil {
+		return x.RemovalReason
+	}
+	return ""
+}
+
+// PublishEndpointsRequest is the full set of FQDNs an agent discovered
+type PublishEndpointsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// agent names the publishing agent (optional). The agent is derived from
+	// the authenticated identity of the caller; when set, agent must match it.
+	// Each push replaces the FQDNs previously published by the same agent.
+	Agent string `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	// portal is the local portal the FQDNs are shown in (required)
+	Portal string `protobuf:"bytes,2,opt,name=portal,proto3" json:"portal,omitempty"`
+	// fqdns are the discovered FQDNs. Only name, groups, description,
+	// record_type, targets and origin_ref are read.
+	Fqdns         []*FQDN `protobuf:"bytes,3,rep,name=fqdns,proto3" json:"fqdns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishEndpointsRequest) Reset() {
+	*x = PublishEndpointsRequest{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishEndpointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishEndpointsRequest) ProtoMessage() {}
+
+func (x *PublishEndpointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishEndpointsRequest.ProtoReflect.Descriptor instead.
+func (*PublishEndpointsRequest) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PublishEndpointsRequest) GetAgent() string {
+	if x != nil {
+		return x.Agent
+	}
+	return ""
+}
+
+func (x *PublishEndpointsRequest) GetPortal() string {
+	if x != nil {
+		return x.Portal
+	}
+	return ""
+}
+
+func (x *PublishEndpointsRequest) GetFqdns() []*FQDN {
+	if x != nil {
+		return x.Fqdns
+	}
+	return nil
+}
+
+// PublishEndpointsResponse is returned once the FQDNs of an agent are stored
+type PublishEndpointsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// fqdn_count is the number of FQDNs stored for the agent
+	FqdnCount     int32 `protobuf:"varint,1,opt,name=fqdn_count,json=fqdnCount,proto3" json:"fqdn_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishEndpointsResponse) Reset() {
+	*x = PublishEndpointsResponse{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishEndpointsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishEndpointsResponse) ProtoMessage() {}
+
+func (x *PublishEndpointsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishEndpointsResponse.ProtoReflect.Descriptor instead.
+func (*PublishEndpointsResponse) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PublishEndpointsResponse) GetFqdnCount() int32 {
+	if x != nil {
+		return x.FqdnCount
+	}
+	return 0
+}
+
+// OwnershipConflict describes targets alternating between sets
+type OwnershipConflict struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// target_sets are the distinct target sets the FQDN alternates between
+	TargetSets []*TargetSet `protobuf:"bytes,1,rep,name=target_sets,json=targetSets,proto3" json:"target_sets,omitempty"`
+	// detected_at is when the oscillation was first detected
+	DetectedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OwnershipConflict) Reset() {
+	*x = OwnershipConflict{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnershipConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnershipConflict) ProtoMessage() {}
+
+func (x *OwnershipConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnershipConflict.ProtoReflect.Descriptor instead.
+func (*OwnershipConflict) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *OwnershipConflict) GetTargetSets() []*TargetSet {
+	if x != nil {
+		return x.TargetSets
+	}
+	return nil
+}
+
+func (x *OwnershipConflict) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
+}
+
+// TargetSet is a sorted set of targets
+type TargetSet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// targets are the targets of the set
+	Targets       []string `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetSet) Reset() {
+	*x = TargetSet{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetSet) ProtoMessage() {}
+
+func (x *TargetSet) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetSet.ProtoReflect.Descriptor instead.
+func (*TargetSet) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TargetSet) GetTargets() []string {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+// TargetProvider is the provider hint of a public target
+type TargetProvider struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// target is the IP address
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// provider is the short provider name, e.g. "aws", "gcp" or "cloudflare"
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	// asn is the autonomous system announcing the provider range
+	Asn           uint32 `protobuf:"varint,3,opt,name=asn,proto3" json:"asn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetProvider) Reset() {
+	*x = TargetProvider{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetProvider) ProtoMessage() {}
+
+func (x *TargetProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetProvider.ProtoReflect.Descriptor instead.
+func (*TargetProvider) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TargetProvider) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *TargetProvider) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *TargetProvider) GetAsn() uint32 {
+	if x != nil {
+		return x.Asn
+	}
+	return 0
+}
+
+// AddNoteRequest is the request for adding a note to an FQDN
+type AddNoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// portal is the portal the FQDN belongs to (required)
+	Portal string `protobuf:"bytes,1,opt,name=portal,proto3" json:"portal,omitempty"`
+	// fqdn is the FQDN the note is attached to (required, case-insensitive)
+	Fqdn string `protobuf:"bytes,2,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	// author is who writes the note, required when authentication is disabled.
+	// Ignored otherwise: the note carries the authenticated identity
+	Author string `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	// text is the content of the note (required, up to 4096 characters)
+	Text          string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddNoteRequest) Reset() {
+	*x = AddNoteRequest{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNoteRequest) ProtoMessage() {}
+
+func (x *AddNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNoteRequest.ProtoReflect.Descriptor instead.
+func (*AddNoteRequest) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AddNoteRequest) GetPortal() string {
+	if x != nil {
+		return x.Portal
+	}
+	return ""
+}
+
+func (x *AddNoteRequest) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+func (x *AddNoteRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *AddNoteRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// AddNoteResponse contains the stored note
+type AddNoteResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// note is the stored note, with its creation time
+	Note *FQDNNote `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	// note_count is the number of notes of the FQDN after the append
+	NoteCount     int32 `protobuf:"varint,2,opt,name=note_count,json=noteCount,proto3" json:"note_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddNoteResponse) Reset() {
+	*x = AddNoteResponse{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNoteResponse) ProtoMessage() {}
+
+func (x *AddNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNoteResponse.ProtoReflect.Descriptor instead.
+func (*AddNoteResponse) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AddNoteResponse) GetNote() *FQDNNote {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+func (x *AddNoteResponse) GetNoteCount() int32 {
+	if x != nil {
+		return x.NoteCount
+	}
+	return 0
+}
+
+// ListNotesRequest is the request for listing the notes of an FQDN
+type ListNotesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// portal is the portal the FQDN belongs to (required)
+	Portal string `protobuf:"bytes,1,opt,name=portal,proto3" json:"portal,omitempty"`
+	// fqdn is the FQDN to list notes for (required, case-insensitive)
+	Fqdn          string `protobuf:"bytes,2,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotesRequest) Reset() {
+	*x = ListNotesRequest{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotesRequest) ProtoMessage() {}
+
+func (x *ListNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotesRequest.ProtoReflect.Descriptor instead.
+func (*ListNotesRequest) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListNotesRequest) GetPortal() string {
+	if x != nil {
+		return x.Portal
+	}
+	return ""
+}
+
+func (x *ListNotesRequest) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+// ListNotesResponse contains the notes of an FQDN
+type ListNotesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// notes are the notes of the FQDN, oldest first
+	Notes         []*FQDNNote `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotesResponse) Reset() {
+	*x = ListNotesResponse{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotesResponse) ProtoMessage() {}
+
+func (x *ListNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotesResponse.ProtoReflect.Descriptor instead.
+func (*ListNotesResponse) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListNotesResponse) GetNotes() []*FQDNNote {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+// FQDNNote is a free-text note attached to an FQDN
+type FQDNNote struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// author is who wrote the note
+	Author string `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	// text is the content of the note
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// created_at is when the note was added
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FQDNNote) Reset() {
+	*x = FQDNNote{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FQDNNote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FQDNNote) ProtoMessage() {}
+
+func (x *FQDNNote) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FQDNNote.ProtoReflect.Descriptor instead.
+func (*FQDNNote) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *FQDNNote) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *FQDNNote) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *FQDNNote) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// GetShareLinkRequest is the request for getting the share link of an FQDN
+type GetShareLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// portal is the portal the FQDN belongs to (required)
+	Portal string `protobuf:"bytes,1,opt,name=portal,proto3" json:"portal,omitempty"`
+	// fqdn is the FQDN to link to (required, case-insensitive)
+	Fqdn string `protobuf:"bytes,2,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	// qr_code also returns the link as a QR code PNG image
+	QrCode        bool `protobuf:"varint,3,opt,name=qr_code,json=qrCode,proto3" json:"qr_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShareLinkRequest) Reset() {
+	*x = GetShareLinkRequest{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShareLinkRequest) ProtoMessage() {}
+
+func (x *GetShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*GetShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetShareLinkRequest) GetPortal() string {
+	if x != nil {
+		return x.Portal
 	}
 	return ""
 }
 
-func (x *OriginResourceRef) GetName() string {
+func (x *GetShareLinkRequest) GetFqdn() string {
 	if x != nil {
-		return x.Name
+		return x.Fqdn
 	}
 	return ""
 }
 
-// FQDN represents a fully qualified domain name with metadata
-type FQDN struct {
+func (x *GetShareLinkRequest) GetQrCode() bool {
+	if x != nil {
+		return x.QrCode
+	}
+	return false
+}
+
+// GetShareLinkResponse contains the share link of an FQDN
+type GetShareLinkResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// name is the fully qualified domain name
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// source indicates where this FQDN came from (manual or external-dns)
-	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
-	// groups is the list of groups this FQDN belongs to
-	Groups []string `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
-	// description is an optional human-readable description
-	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	// record_type is the DNS record type (A, AAAA, CNAME, etc.)
-	RecordType string `protobuf:"bytes,5,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
-	// targets is the list of target addresses for this FQDN
-	Targets []string `protobuf:"bytes,6,rep,name=targets,proto3" json:"targets,omitempty"`
-	// last_seen is the timestamp when this FQDN was last observed
-	LastSeen *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
-	// dns_resource_name is the name of the DNS resource this FQDN belongs to.
-	// Deprecated: replaced by `portals` to support an FQDN belonging to several
-	// portals after inter-DNS dedup. Kept for back-compat with older clients.
-	//
-	// Deprecated: Marked as deprecated in sreportal/v1/dns.proto.
-	DnsResourceName string `protobuf:"bytes,8,opt,name=dns_resource_name,json=dnsResourceName,proto3" json:"dns_resource_name,omitempty"`
-	// dns_resource_namespace is the namespace of the DNS resource.
-	// Deprecated: see `dns_resource_name`. Kept for back-compat with older clients.
-	//
-	// Deprecated: Marked as deprecated in sreportal/v1/dns.proto.
-	DnsResourceNamespace string `protobuf:"bytes,9,opt,name=dns_resource_namespace,json=dnsResourceNamespace,proto3" json:"dns_resource_namespace,omitempty"`
-	// origin_ref identifies the Kubernetes resource (Service, Ingress, DNSEndpoint)
-	// that produced this FQDN via external-dns. Not set for manual entries.
-	OriginRef *OriginResourceRef `protobuf:"bytes,10,opt,name=origin_ref,json=originRef,proto3,oneof" json:"origin_ref,omitempty"`
-	// sync_status indicates whether the FQDN is correctly resolved in DNS.
-	// Values: "sync", "notavailable", "notsync", or empty.
-	SyncStatus string `protobuf:"bytes,11,opt,name=sync_status,json=syncStatus,proto3" json:"sync_status,omitempty"`
-	// portals lists every portal this FQDN belongs to (post inter-DNS dedup).
-	// Sorted and deduplicated.
-	Portals       []string `protobuf:"bytes,12,rep,name=portals,proto3" json:"portals,omitempty"`
+	// url is the absolute link, built from web.publicURL or the request
+	// origin; it is the path alone when neither is known
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// path is the link path on the web server (/share/fqdn/<portal>/<fqdn>)
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// qr_code_png is the QR code of url as a PNG image, set when requested
+	QrCodePng     []byte `protobuf:"bytes,3,opt,name=qr_code_png,json=qrCodePng,proto3" json:"qr_code_png,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FQDN) Reset() {
-	*x = FQDN{}
-	mi := &file_sreportal_v1_dns_proto_msgTypes[5]
+func (x *GetShareLinkResponse) Reset() {
+	*x = GetShareLinkResponse{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FQDN) String() string {
+func (x *GetShareLinkResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FQDN) ProtoMessage() {}
+func (*GetShareLinkResponse) ProtoMessage() {}
 
-func (x *FQDN) ProtoReflect() protoreflect.Message {
-	mi := &file_sreportal_v1_dns_proto_msgTypes[5]
+func (x *GetShareLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -491,93 +2881,295 @@ func (x *FQDN) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FQDN.ProtoReflect.Descriptor instead.
-func (*FQDN) Descriptor() ([]byte, []int) {
-	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetShareLinkResponse.ProtoReflect.Descriptor instead.
+func (*GetShareLinkResponse) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *FQDN) GetName() string {
+func (x *GetShareLinkResponse) GetUrl() string {
 	if x != nil {
-		return x.Name
+		return x.Url
 	}
 	return ""
 }
 
-func (x *FQDN) GetSource() string {
+func (x *GetShareLinkResponse) GetPath() string {
 	if x != nil {
-		return x.Source
+		return x.Path
 	}
 	return ""
 }
 
-func (x *FQDN) GetGroups() []string {
+func (x *GetShareLinkResponse) GetQrCodePng() []byte {
 	if x != nil {
-		return x.Groups
+		return x.QrCodePng
 	}
 	return nil
 }
 
-func (x *FQDN) GetDescription() string {
+// GetUniquenessReportRequest is the request for the FQDN uniqueness report
+type GetUniquenessReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// portal keeps the issues listing this portal (empty for all portals)
+	Portal        string `protobuf:"bytes,1,opt,name=portal,proto3" json:"portal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUniquenessReportRequest) Reset() {
+	*x = GetUniquenessReportRequest{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUniquenessReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUniquenessReportRequest) ProtoMessage() {}
+
+func (x *GetUniquenessReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[33]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUniquenessReportRequest.ProtoReflect.Descriptor instead.
+func (*GetUniquenessReportRequest) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetUniquenessReportRequest) GetPortal() string {
+	if x != nil {
+		return x.Portal
 	}
 	return ""
 }
 
-func (x *FQDN) GetRecordType() string {
+// GetUniquenessReportResponse contains the FQDN uniqueness issues
+type GetUniquenessReportResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// issues are sorted by severity, highest first, then by name and record type
+	Issues        []*UniquenessIssue `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUniquenessReportResponse) Reset() {
+	*x = GetUniquenessReportResponse{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUniquenessReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUniquenessReportResponse) ProtoMessage() {}
+
+func (x *GetUniquenessReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUniquenessReportResponse.ProtoReflect.Descriptor instead.
+func (*GetUniquenessReportResponse) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetUniquenessReportResponse) GetIssues() []*UniquenessIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+// UniquenessIssue is an FQDN listed in several portals, or whose contributing
+// DNSRecords disagree on the targets
+type UniquenessIssue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the fully qualified domain name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// record_type is the DNS record type
+	RecordType string `protobuf:"bytes,2,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	// severity is high when discovered sources disagree on the targets, medium
+	// when a manual entry disagrees, low when the targets agree across portals
+	Severity string `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
+	// portals are the portals listing the FQDN
+	Portals []string `protobuf:"bytes,4,rep,name=portals,proto3" json:"portals,omitempty"`
+	// sources are the source types of the contributions, or "manual"
+	Sources []string `protobuf:"bytes,5,rep,name=sources,proto3" json:"sources,omitempty"`
+	// contributions are the entries of each contributing DNSRecord
+	Contributions []*FQDNContribution `protobuf:"bytes,6,rep,name=contributions,proto3" json:"contributions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UniquenessIssue) Reset() {
+	*x = UniquenessIssue{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UniquenessIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UniquenessIssue) ProtoMessage() {}
+
+func (x *UniquenessIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UniquenessIssue.ProtoReflect.Descriptor instead.
+func (*UniquenessIssue) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UniquenessIssue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UniquenessIssue) GetRecordType() string {
 	if x != nil {
 		return x.RecordType
 	}
 	return ""
 }
 
-func (x *FQDN) GetTargets() []string {
+func (x *UniquenessIssue) GetSeverity() string {
 	if x != nil {
-		return x.Targets
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *UniquenessIssue) GetPortals() []string {
+	if x != nil {
+		return x.Portals
 	}
 	return nil
 }
 
-func (x *FQDN) GetLastSeen() *timestamppb.Timestamp {
+func (x *UniquenessIssue) GetSources() []string {
 	if x != nil {
-		return x.LastSeen
+		return x.Sources
 	}
 	return nil
 }
 
-// Deprecated: Marked as deprecated in sreportal/v1/dns.proto.
-func (x *FQDN) GetDnsResourceName() string {
+func (x *UniquenessIssue) GetContributions() []*FQDNContribution {
 	if x != nil {
-		return x.DnsResourceName
+		return x.Contributions
 	}
-	return ""
+	return nil
 }
 
-// Deprecated: Marked as deprecated in sreportal/v1/dns.proto.
-func (x *FQDN) GetDnsResourceNamespace() string {
+// FQDNContribution is the entry a single DNSRecord contributes for an FQDN
+type FQDNContribution struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// dns_record is the contributing DNSRecord
+	DnsRecord *DNSRecordRef `protobuf:"bytes,1,opt,name=dns_record,json=dnsRecord,proto3" json:"dns_record,omitempty"`
+	// portal is the portal the DNSRecord belongs to
+	Portal string `protobuf:"bytes,2,opt,name=portal,proto3" json:"portal,omitempty"`
+	// source indicates where the entry came from (manual or external-dns)
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// source_type is the external-dns source type (empty for manual entries)
+	SourceType string `protobuf:"bytes,4,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
+	// targets are the targets of the entry
+	Targets       []string `protobuf:"bytes,5,rep,name=targets,proto3" json:"targets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FQDNContribution) Reset() {
+	*x = FQDNContribution{}
+	mi := &file_sreportal_v1_dns_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FQDNContribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FQDNContribution) ProtoMessage() {}
+
+func (x *FQDNContribution) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_dns_proto_msgTypes[36]
 	if x != nil {
-		return x.DnsResourceNamespace
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FQDN) GetOriginRef() *OriginResourceRef {
+// Deprecated: Use FQDNContribution.ProtoReflect.Descriptor instead.
+func (*FQDNContribution) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_dns_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *FQDNContribution) GetDnsRecord() *DNSRecordRef {
 	if x != nil {
-		return x.OriginRef
+		return x.DnsRecord
 	}
 	return nil
 }
 
-func (x *FQDN) GetSyncStatus() string {
+func (x *FQDNContribution) GetPortal() string {
 	if x != nil {
-		return x.SyncStatus
+		return x.Portal
 	}
 	return ""
 }
 
-func (x *FQDN) GetPortals() []string {
+func (x *FQDNContribution) GetSource() string {
 	if x != nil {
-		return x.Portals
+		return x.Source
+	}
+	return ""
+}
+
+func (x *FQDNContribution) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+func (x *FQDNContribution) GetTargets() []string {
+	if x != nil {
+		return x.Targets
 	}
 	return nil
 }
@@ -586,7 +3178,7 @@ var File_sreportal_v1_dns_proto protoreflect.FileDescriptor
 
 const file_sreportal_v1_dns_proto_rawDesc = "" +
 	"\n" +
-	"\x16sreportal/v1/dns.proto\x12\fsreportal.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb4\x01\n" +
+	"\x16sreportal/v1/dns.proto\x12\fsreportal.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb6\x03\n" +
 	"\x10ListFQDNsRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x12\x16\n" +
@@ -594,24 +3186,106 @@ const file_sreportal_v1_dns_proto_rawDesc = "" +
 	"\x06portal\x18\x04 \x01(\tR\x06portal\x12\x1b\n" +
 	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x06 \x01(\tR\tpageToken\"\x84\x01\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x12!\n" +
+	"\ftarget_scope\x18\a \x01(\tR\vtargetScope\x12*\n" +
+	"\x04view\x18\b \x01(\x0e2\x16.sreportal.v1.FQDNViewR\x04view\x12'\n" +
+	"\x0finclude_removed\x18\t \x01(\bR\x0eincludeRemoved\x12B\n" +
+	"\x0flast_seen_after\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\rlastSeenAfter\x12D\n" +
+	"\x10last_seen_before\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x0elastSeenBefore\"\xb1\x01\n" +
 	"\x11ListFQDNsResponse\x12(\n" +
 	"\x05fqdns\x18\x01 \x03(\v2\x12.sreportal.v1.FQDNR\x05fqdns\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
 	"\n" +
-	"total_size\x18\x03 \x01(\x05R\ttotalSize\"z\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\x12+\n" +
+	"\x06groups\x18\x04 \x03(\v2\x13.sreportal.v1.GroupR\x06groups\"\x7f\n" +
+	"\x05Group\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1d\n" +
+	"\n" +
+	"fqdn_count\x18\x03 \x01(\x05R\tfqdnCount\x12/\n" +
+	"\bchildren\x18\x04 \x03(\v2\x13.sreportal.v1.GroupR\bchildren\"\xfc\x02\n" +
 	"\x12StreamFQDNsRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x16\n" +
 	"\x06portal\x18\x02 \x01(\tR\x06portal\x12\x16\n" +
 	"\x06source\x18\x03 \x01(\tR\x06source\x12\x16\n" +
-	"\x06search\x18\x04 \x01(\tR\x06search\"k\n" +
+	"\x06search\x18\x04 \x01(\tR\x06search\x12!\n" +
+	"\ftarget_scope\x18\x05 \x01(\tR\vtargetScope\x12*\n" +
+	"\x04view\x18\x06 \x01(\x0e2\x16.sreportal.v1.FQDNViewR\x04view\x12'\n" +
+	"\x0finclude_removed\x18\a \x01(\bR\x0eincludeRemoved\x12B\n" +
+	"\x0flast_seen_after\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rlastSeenAfter\x12D\n" +
+	"\x10last_seen_before\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0elastSeenBefore\"k\n" +
 	"\x13StreamFQDNsResponse\x12,\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x18.sreportal.v1.UpdateTypeR\x04type\x12&\n" +
-	"\x04fqdn\x18\x02 \x01(\v2\x12.sreportal.v1.FQDNR\x04fqdn\"Y\n" +
+	"\x04fqdn\x18\x02 \x01(\v2\x12.sreportal.v1.FQDNR\x04fqdn\"H\n" +
+	"\x16FederatedSearchRequest\x12\x16\n" +
+	"\x06search\x18\x01 \x01(\tR\x06search\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\"\x8a\x01\n" +
+	"\x17FederatedSearchResponse\x125\n" +
+	"\aresults\x18\x01 \x03(\v2\x1b.sreportal.v1.FederatedFQDNR\aresults\x128\n" +
+	"\x06errors\x18\x02 \x03(\v2 .sreportal.v1.FederatedSiteErrorR\x06errors\"M\n" +
+	"\rFederatedFQDN\x12&\n" +
+	"\x04fqdn\x18\x01 \x01(\v2\x12.sreportal.v1.FQDNR\x04fqdn\x12\x14\n" +
+	"\x05sites\x18\x02 \x03(\tR\x05sites\">\n" +
+	"\x12FederatedSiteError\x12\x12\n" +
+	"\x04site\x18\x01 \x01(\tR\x04site\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xc7\x01\n" +
+	"\x1fBatchUpdateManualEntriesRequest\x12\x16\n" +
+	"\x06portal\x18\x01 \x01(\tR\x06portal\x12\x1d\n" +
+	"\n" +
+	"dns_record\x18\x02 \x01(\tR\tdnsRecord\x12)\n" +
+	"\x10resource_version\x18\x03 \x01(\tR\x0fresourceVersion\x12B\n" +
+	"\n" +
+	"operations\x18\x04 \x03(\v2\".sreportal.v1.ManualEntryOperationR\n" +
+	"operations\"\x83\x01\n" +
+	"\x14ManualEntryOperation\x12:\n" +
+	"\x04type\x18\x01 \x01(\x0e2&.sreportal.v1.ManualEntryOperationTypeR\x04type\x12/\n" +
+	"\x05entry\x18\x02 \x01(\v2\x19.sreportal.v1.ManualEntryR\x05entry\"\xac\x01\n" +
+	"\vManualEntry\x12\x12\n" +
+	"\x04fqdn\x18\x01 \x01(\tR\x04fqdn\x12\x1f\n" +
+	"\vrecord_type\x18\x02 \x01(\tR\n" +
+	"recordType\x12\x18\n" +
+	"\atargets\x18\x03 \x03(\tR\atargets\x12\x14\n" +
+	"\x05group\x18\x04 \x01(\tR\x05group\x12\x16\n" +
+	"\x06groups\x18\x05 \x03(\tR\x06groups\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\"\x8d\x01\n" +
+	" BatchUpdateManualEntriesResponse\x12\x1d\n" +
+	"\n" +
+	"dns_record\x18\x01 \x01(\tR\tdnsRecord\x12)\n" +
+	"\x10resource_version\x18\x02 \x01(\tR\x0fresourceVersion\x12\x1f\n" +
+	"\ventry_count\x18\x03 \x01(\x05R\n" +
+	"entryCount\"\x99\x01\n" +
+	"\x16SetFQDNsIgnoredRequest\x12\x16\n" +
+	"\x06portal\x18\x01 \x01(\tR\x06portal\x124\n" +
+	"\atargets\x18\x02 \x03(\v2\x1a.sreportal.v1.IgnoreTargetR\atargets\x12\x18\n" +
+	"\aignored\x18\x03 \x01(\bR\aignored\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"_\n" +
+	"\fIgnoreTarget\x12\x12\n" +
+	"\x04fqdn\x18\x01 \x01(\tR\x04fqdn\x12\x1f\n" +
+	"\vrecord_type\x18\x02 \x01(\tR\n" +
+	"recordType\x12\x1a\n" +
+	"\bresource\x18\x03 \x01(\tR\bresource\"h\n" +
+	"\x17SetFQDNsIgnoredResponse\x124\n" +
+	"\aresults\x18\x01 \x03(\v2\x1a.sreportal.v1.IgnoreResultR\aresults\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\xaf\x01\n" +
+	"\fIgnoreResult\x12\x12\n" +
+	"\x04fqdn\x18\x01 \x01(\tR\x04fqdn\x12\x1f\n" +
+	"\vrecord_type\x18\x02 \x01(\tR\n" +
+	"recordType\x12\x1a\n" +
+	"\bresource\x18\x03 \x01(\tR\bresource\x128\n" +
+	"\x06status\x18\x04 \x01(\x0e2 .sreportal.v1.IgnoreResultStatusR\x06status\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"Y\n" +
 	"\x11OriginResourceRef\x12\x12\n" +
 	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1c\n" +
 	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\"\xd9\x03\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"@\n" +
+	"\fDNSRecordRef\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"Q\n" +
+	"\vServicePort\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x1a\n" +
+	"\bprotocol\x18\x03 \x01(\tR\bprotocol\"\xb0\x0e\n" +
 	"\x04FQDN\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x12\x16\n" +
@@ -628,18 +3302,152 @@ const file_sreportal_v1_dns_proto_rawDesc = "" +
 	" \x01(\v2\x1f.sreportal.v1.OriginResourceRefH\x00R\toriginRef\x88\x01\x01\x12\x1f\n" +
 	"\vsync_status\x18\v \x01(\tR\n" +
 	"syncStatus\x12\x18\n" +
-	"\aportals\x18\f \x03(\tR\aportalsB\r\n" +
-	"\v_origin_ref*s\n" +
+	"\aportals\x18\f \x03(\tR\aportals\x12!\n" +
+	"\ftarget_scope\x18\r \x01(\tR\vtargetScope\x12\x1c\n" +
+	"\tsensitive\x18\x0e \x01(\bR\tsensitive\x12/\n" +
+	"\x05ports\x18\x0f \x03(\v2\x19.sreportal.v1.ServicePortR\x05ports\x12\x14\n" +
+	"\x05paths\x18\x10 \x03(\tR\x05paths\x120\n" +
+	"\x14internal_sync_status\x18\x11 \x01(\tR\x12internalSyncStatus\x120\n" +
+	"\x14external_sync_status\x18\x12 \x01(\tR\x12externalSyncStatus\x12\"\n" +
+	"\favailability\x18\x13 \x01(\tR\favailability\x12\x1f\n" +
+	"\vsource_type\x18\x14 \x01(\tR\n" +
+	"sourceType\x12E\n" +
+	"\x0edns_record_ref\x18\x15 \x01(\v2\x1a.sreportal.v1.DNSRecordRefH\x01R\fdnsRecordRef\x88\x01\x01\x12C\n" +
+	"\x0flast_reconciled\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastReconciled\x12B\n" +
+	"\x0eoverall_status\x18\x17 \x01(\x0e2\x1b.sreportal.v1.OverallStatusR\roverallStatus\x12E\n" +
+	"\vannotations\x18\x18 \x03(\v2#.sreportal.v1.FQDN.AnnotationsEntryR\vannotations\x12\x0e\n" +
+	"\x02id\x18\x19 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"removed_at\x18\x1a \x01(\v2\x1a.google.protobuf.TimestampR\tremovedAt\x12%\n" +
+	"\x0elookup_failure\x18\x1b \x01(\tR\rlookupFailure\x12H\n" +
+	"\x0fshadowed_manual\x18\x1c \x01(\v2\x1a.sreportal.v1.DNSRecordRefH\x02R\x0eshadowedManual\x88\x01\x01\x12S\n" +
+	"\x12ownership_conflict\x18\x1d \x01(\v2\x1f.sreportal.v1.OwnershipConflictH\x03R\x11ownershipConflict\x88\x01\x01\x12!\n" +
+	"\forigin_cause\x18\x1e \x01(\tR\voriginCause\x12#\n" +
+	"\rhealth_status\x18\x1f \x01(\tR\fhealthStatus\x12B\n" +
+	"\x0flast_probe_time\x18  \x01(\v2\x1a.google.protobuf.TimestampR\rlastProbeTime\x12(\n" +
+	"\x10http_status_code\x18! \x01(\x05R\x0ehttpStatusCode\x12(\n" +
+	"\x10probe_latency_ms\x18\" \x01(\x03R\x0eprobeLatencyMs\x12 \n" +
+	"\ttls_valid\x18# \x01(\bH\x04R\btlsValid\x88\x01\x01\x12G\n" +
+	"\x10target_providers\x18$ \x03(\v2\x1c.sreportal.v1.TargetProviderR\x0ftargetProviders\x12%\n" +
+	"\x0eremoval_reason\x18% \x01(\tR\rremovalReason\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\r\n" +
+	"\v_origin_refB\x11\n" +
+	"\x0f_dns_record_refB\x12\n" +
+	"\x10_shadowed_manualB\x15\n" +
+	"\x13_ownership_conflictB\f\n" +
+	"\n" +
+	"_tls_valid\"q\n" +
+	"\x17PublishEndpointsRequest\x12\x14\n" +
+	"\x05agent\x18\x01 \x01(\tR\x05agent\x12\x16\n" +
+	"\x06portal\x18\x02 \x01(\tR\x06portal\x12(\n" +
+	"\x05fqdns\x18\x03 \x03(\v2\x12.sreportal.v1.FQDNR\x05fqdns\"9\n" +
+	"\x18PublishEndpointsResponse\x12\x1d\n" +
+	"\n" +
+	"fqdn_count\x18\x01 \x01(\x05R\tfqdnCount\"\x8a\x01\n" +
+	"\x11OwnershipConflict\x128\n" +
+	"\vtarget_sets\x18\x01 \x03(\v2\x17.sreportal.v1.TargetSetR\n" +
+	"targetSets\x12;\n" +
+	"\vdetected_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"detectedAt\"%\n" +
+	"\tTargetSet\x12\x18\n" +
+	"\atargets\x18\x01 \x03(\tR\atargets\"V\n" +
+	"\x0eTargetProvider\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12\x10\n" +
+	"\x03asn\x18\x03 \x01(\rR\x03asn\"h\n" +
+	"\x0eAddNoteRequest\x12\x16\n" +
+	"\x06portal\x18\x01 \x01(\tR\x06portal\x12\x12\n" +
+	"\x04fqdn\x18\x02 \x01(\tR\x04fqdn\x12\x16\n" +
+	"\x06author\x18\x03 \x01(\tR\x06author\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\"\\\n" +
+	"\x0fAddNoteResponse\x12*\n" +
+	"\x04note\x18\x01 \x01(\v2\x16.sreportal.v1.FQDNNoteR\x04note\x12\x1d\n" +
+	"\n" +
+	"note_count\x18\x02 \x01(\x05R\tnoteCount\">\n" +
+	"\x10ListNotesRequest\x12\x16\n" +
+	"\x06portal\x18\x01 \x01(\tR\x06portal\x12\x12\n" +
+	"\x04fqdn\x18\x02 \x01(\tR\x04fqdn\"A\n" +
+	"\x11ListNotesResponse\x12,\n" +
+	"\x05notes\x18\x01 \x03(\v2\x16.sreportal.v1.FQDNNoteR\x05notes\"q\n" +
+	"\bFQDNNote\x12\x16\n" +
+	"\x06author\x18\x01 \x01(\tR\x06author\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"Z\n" +
+	"\x13GetShareLinkRequest\x12\x16\n" +
+	"\x06portal\x18\x01 \x01(\tR\x06portal\x12\x12\n" +
+	"\x04fqdn\x18\x02 \x01(\tR\x04fqdn\x12\x17\n" +
+	"\aqr_code\x18\x03 \x01(\bR\x06qrCode\"\\\n" +
+	"\x14GetShareLinkResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1e\n" +
+	"\vqr_code_png\x18\x03 \x01(\fR\tqrCodePng\"4\n" +
+	"\x1aGetUniquenessReportRequest\x12\x16\n" +
+	"\x06portal\x18\x01 \x01(\tR\x06portal\"T\n" +
+	"\x1bGetUniquenessReportResponse\x125\n" +
+	"\x06issues\x18\x01 \x03(\v2\x1d.sreportal.v1.UniquenessIssueR\x06issues\"\xdc\x01\n" +
+	"\x0fUniquenessIssue\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vrecord_type\x18\x02 \x01(\tR\n" +
+	"recordType\x12\x1a\n" +
+	"\bseverity\x18\x03 \x01(\tR\bseverity\x12\x18\n" +
+	"\aportals\x18\x04 \x03(\tR\aportals\x12\x18\n" +
+	"\asources\x18\x05 \x03(\tR\asources\x12D\n" +
+	"\rcontributions\x18\x06 \x03(\v2\x1e.sreportal.v1.FQDNContributionR\rcontributions\"\xb8\x01\n" +
+	"\x10FQDNContribution\x129\n" +
+	"\n" +
+	"dns_record\x18\x01 \x01(\v2\x1a.sreportal.v1.DNSRecordRefR\tdnsRecord\x12\x16\n" +
+	"\x06portal\x18\x02 \x01(\tR\x06portal\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x1f\n" +
+	"\vsource_type\x18\x04 \x01(\tR\n" +
+	"sourceType\x12\x18\n" +
+	"\atargets\x18\x05 \x03(\tR\atargets*N\n" +
+	"\bFQDNView\x12\x19\n" +
+	"\x15FQDN_VIEW_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fFQDN_VIEW_BASIC\x10\x01\x12\x12\n" +
+	"\x0eFQDN_VIEW_FULL\x10\x02*\xbc\x01\n" +
+	"\x18ManualEntryOperationType\x12+\n" +
+	"'MANUAL_ENTRY_OPERATION_TYPE_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fMANUAL_ENTRY_OPERATION_TYPE_ADD\x10\x01\x12&\n" +
+	"\"MANUAL_ENTRY_OPERATION_TYPE_UPDATE\x10\x02\x12&\n" +
+	"\"MANUAL_ENTRY_OPERATION_TYPE_DELETE\x10\x03*\x8c\x02\n" +
+	"\x12IgnoreResultStatus\x12$\n" +
+	" IGNORE_RESULT_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cIGNORE_RESULT_STATUS_UPDATED\x10\x01\x12\"\n" +
+	"\x1eIGNORE_RESULT_STATUS_UNCHANGED\x10\x02\x12\x1f\n" +
+	"\x1bIGNORE_RESULT_STATUS_DENIED\x10\x03\x12\"\n" +
+	"\x1eIGNORE_RESULT_STATUS_NOT_FOUND\x10\x04\x12$\n" +
+	" IGNORE_RESULT_STATUS_UNSUPPORTED\x10\x05\x12\x1f\n" +
+	"\x1bIGNORE_RESULT_STATUS_FAILED\x10\x06*\xa6\x01\n" +
 	"\n" +
 	"UpdateType\x12\x1b\n" +
 	"\x17UPDATE_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11UPDATE_TYPE_ADDED\x10\x01\x12\x18\n" +
 	"\x14UPDATE_TYPE_MODIFIED\x10\x02\x12\x17\n" +
-	"\x13UPDATE_TYPE_DELETED\x10\x032\xb0\x01\n" +
+	"\x13UPDATE_TYPE_DELETED\x10\x03\x12\x19\n" +
+	"\x15UPDATE_TYPE_HEARTBEAT\x10\x04\x12\x16\n" +
+	"\x12UPDATE_TYPE_SYNCED\x10\x05*\xbc\x01\n" +
+	"\rOverallStatus\x12\x1e\n" +
+	"\x1aOVERALL_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16OVERALL_STATUS_UNKNOWN\x10\x01\x12\x1a\n" +
+	"\x16OVERALL_STATUS_HEALTHY\x10\x02\x12\x1a\n" +
+	"\x16OVERALL_STATUS_WARNING\x10\x03\x12\x1b\n" +
+	"\x17OVERALL_STATUS_CRITICAL\x10\x04\x12\x1a\n" +
+	"\x16OVERALL_STATUS_REMOVED\x10\x052\xa7\a\n" +
 	"\n" +
 	"DNSService\x12L\n" +
 	"\tListFQDNs\x12\x1e.sreportal.v1.ListFQDNsRequest\x1a\x1f.sreportal.v1.ListFQDNsResponse\x12T\n" +
-	"\vStreamFQDNs\x12 .sreportal.v1.StreamFQDNsRequest\x1a!.sreportal.v1.StreamFQDNsResponse0\x01B\xb8\x01\n" +
+	"\vStreamFQDNs\x12 .sreportal.v1.StreamFQDNsRequest\x1a!.sreportal.v1.StreamFQDNsResponse0\x01\x12^\n" +
+	"\x0fFederatedSearch\x12$.sreportal.v1.FederatedSearchRequest\x1a%.sreportal.v1.FederatedSearchResponse\x12y\n" +
+	"\x18BatchUpdateManualEntries\x12-.sreportal.v1.BatchUpdateManualEntriesRequest\x1a..sreportal.v1.BatchUpdateManualEntriesResponse\x12^\n" +
+	"\x0fSetFQDNsIgnored\x12$.sreportal.v1.SetFQDNsIgnoredRequest\x1a%.sreportal.v1.SetFQDNsIgnoredResponse\x12a\n" +
+	"\x10PublishEndpoints\x12%.sreportal.v1.PublishEndpointsRequest\x1a&.sreportal.v1.PublishEndpointsResponse\x12F\n" +
+	"\aAddNote\x12\x1c.sreportal.v1.AddNoteRequest\x1a\x1d.sreportal.v1.AddNoteResponse\x12L\n" +
+	"\tListNotes\x12\x1e.sreportal.v1.ListNotesRequest\x1a\x1f.sreportal.v1.ListNotesResponse\x12U\n" +
+	"\fGetShareLink\x12!.sreportal.v1.GetShareLinkRequest\x1a\".sreportal.v1.GetShareLinkResponse\x12j\n" +
+	"\x13GetUniquenessReport\x12(.sreportal.v1.GetUniquenessReportRequest\x1a).sreportal.v1.GetUniquenessReportResponseB\xb8\x01\n" +
 	"\x10com.sreportal.v1B\bDnsProtoP\x01ZIgithub.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1;sreportalv1\xa2\x02\x03SXX\xaa\x02\fSreportal.V1\xca\x02\fSreportal\\V1\xe2\x02\x18Sreportal\\V1\\GPBMetadata\xea\x02\rSreportal::V1b\x06proto3"
 
 var (
@@ -654,33 +3462,121 @@ func file_sreportal_v1_dns_proto_rawDescGZIP() []byte {
 	return file_sreportal_v1_dns_proto_rawDescData
 }
 
-var file_sreportal_v1_dns_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_sreportal_v1_dns_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_sreportal_v1_dns_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_sreportal_v1_dns_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_sreportal_v1_dns_proto_goTypes = []any{
-	(UpdateType)(0),               // 0: sreportal.v1.UpdateType
-	(*ListFQDNsRequest)(nil),      // 1: sreportal.v1.ListFQDNsRequest
-	(*ListFQDNsResponse)(nil),     // 2: sreportal.v1.ListFQDNsResponse
-	(*StreamFQDNsRequest)(nil),    // 3: sreportal.v1.StreamFQDNsRequest
-	(*StreamFQDNsResponse)(nil),   // 4: sreportal.v1.StreamFQDNsResponse
-	(*OriginResourceRef)(nil),     // 5: sreportal.v1.OriginResourceRef
-	(*FQDN)(nil),                  // 6: sreportal.v1.FQDN
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(FQDNView)(0),                            // 0: sreportal.v1.FQDNView
+	(ManualEntryOperationType)(0),            // 1: sreportal.v1.ManualEntryOperationType
+	(IgnoreResultStatus)(0),                  // 2: sreportal.v1.IgnoreResultStatus
+	(UpdateType)(0),                          // 3: sreportal.v1.UpdateType
+	(OverallStatus)(0),                       // 4: sreportal.v1.OverallStatus
+	(*ListFQDNsRequest)(nil),                 // 5: sreportal.v1.ListFQDNsRequest
+	(*ListFQDNsResponse)(nil),                // 6: sreportal.v1.ListFQDNsResponse
+	(*Group)(nil),                            // 7: sreportal.v1.Group
+	(*StreamFQDNsRequest)(nil),               // 8: sreportal.v1.StreamFQDNsRequest
+	(*StreamFQDNsResponse)(nil),              // 9: sreportal.v1.StreamFQDNsResponse
+	(*FederatedSearchRequest)(nil),           // 10: sreportal.v1.FederatedSearchRequest
+	(*FederatedSearchResponse)(nil),          // 11: sreportal.v1.FederatedSearchResponse
+	(*FederatedFQDN)(nil),                    // 12: sreportal.v1.FederatedFQDN
+	(*FederatedSiteError)(nil),               // 13: sreportal.v1.FederatedSiteError
+	(*BatchUpdateManualEntriesRequest)(nil),  // 14: sreportal.v1.BatchUpdateManualEntriesRequest
+	(*ManualEntryOperation)(nil),             // 15: sreportal.v1.ManualEntryOperation
+	(*ManualEntry)(nil),                      // 16: sreportal.v1.ManualEntry
+	(*BatchUpdateManualEntriesResponse)(nil), // 17: sreportal.v1.BatchUpdateManualEntriesResponse
+	(*SetFQDNsIgnoredRequest)(nil),           // 18: sreportal.v1.SetFQDNsIgnoredRequest
+	(*IgnoreTarget)(nil),                     // 19: sreportal.v1.IgnoreTarget
+	(*SetFQDNsIgnoredResponse)(nil),          // 20: sreportal.v1.SetFQDNsIgnoredResponse
+	(*IgnoreResult)(nil),                     // 21: sreportal.v1.IgnoreResult
+	(*OriginResourceRef)(nil),                // 22: sreportal.v1.OriginResourceRef
+	(*DNSRecordRef)(nil),                     // 23: sreportal.v1.DNSRecordRef
+	(*ServicePort)(nil),                      // 24: sreportal.v1.ServicePort
+	(*FQDN)(nil),                             // 25: sreportal.v1.FQDN
+	(*PublishEndpointsRequest)(nil),          // 26: sreportal.v1.PublishEndpointsRequest
+	(*PublishEndpointsResponse)(nil),         // 27: sreportal.v1.PublishEndpointsResponse
+	(*OwnershipConflict)(nil),                // 28: sreportal.v1.OwnershipConflict
+	(*TargetSet)(nil),                        // 29: sreportal.v1.TargetSet
+	(*TargetProvider)(nil),                   // 30: sreportal.v1.TargetProvider
+	(*AddNoteRequest)(nil),                   // 31: sreportal.v1.AddNoteRequest
+	(*AddNoteResponse)(nil),                  // 32: sreportal.v1.AddNoteResponse
+	(*ListNotesRequest)(nil),                 // 33: sreportal.v1.ListNotesRequest
+	(*ListNotesResponse)(nil),                // 34: sreportal.v1.ListNotesResponse
+	(*FQDNNote)(nil),                         // 35: sreportal.v1.FQDNNote
+	(*GetShareLinkRequest)(nil),              // 36: sreportal.v1.GetShareLinkRequest
+	(*GetShareLinkResponse)(nil),             // 37: sreportal.v1.GetShareLinkResponse
+	(*GetUniquenessReportRequest)(nil),       // 38: sreportal.v1.GetUniquenessReportRequest
+	(*GetUniquenessReportResponse)(nil),      // 39: sreportal.v1.GetUniquenessReportResponse
+	(*UniquenessIssue)(nil),                  // 40: sreportal.v1.UniquenessIssue
+	(*FQDNContribution)(nil),                 // 41: sreportal.v1.FQDNContribution
+	nil,                                      // 42: sreportal.v1.FQDN.AnnotationsEntry
+	(*timestamppb.Timestamp)(nil),            // 43: google.protobuf.Timestamp
 }
 var file_sreportal_v1_dns_proto_depIdxs = []int32{
-	6, // 0: sreportal.v1.ListFQDNsResponse.fqdns:type_name -> sreportal.v1.FQDN
-	0, // 1: sreportal.v1.StreamFQDNsResponse.type:type_name -> sreportal.v1.UpdateType
-	6, // 2: sreportal.v1.StreamFQDNsResponse.fqdn:type_name -> sreportal.v1.FQDN
-	7, // 3: sreportal.v1.FQDN.last_seen:type_name -> google.protobuf.Timestamp
-	5, // 4: sreportal.v1.FQDN.origin_ref:type_name -> sreportal.v1.OriginResourceRef
-	1, // 5: sreportal.v1.DNSService.ListFQDNs:input_type -> sreportal.v1.ListFQDNsRequest
-	3, // 6: sreportal.v1.DNSService.StreamFQDNs:input_type -> sreportal.v1.StreamFQDNsRequest
-	2, // 7: sreportal.v1.DNSService.ListFQDNs:output_type -> sreportal.v1.ListFQDNsResponse
-	4, // 8: sreportal.v1.DNSService.StreamFQDNs:output_type -> sreportal.v1.StreamFQDNsResponse
-	7, // [7:9] is the sub-list for method output_type
-	5, // [5:7] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	0,  // 0: sreportal.v1.ListFQDNsRequest.view:type_name -> sreportal.v1.FQDNView
+	43, // 1: sreportal.v1.ListFQDNsRequest.last_seen_after:type_name -> google.protobuf.Timestamp
+	43, // 2: sreportal.v1.ListFQDNsRequest.last_seen_before:type_name -> google.protobuf.Timestamp
+	25, // 3: sreportal.v1.ListFQDNsResponse.fqdns:type_name -> sreportal.v1.FQDN
+	7,  // 4: sreportal.v1.ListFQDNsResponse.groups:type_name -> sreportal.v1.Group
+	7,  // 5: sreportal.v1.Group.children:type_name -> sreportal.v1.Group
+	0,  // 6: sreportal.v1.StreamFQDNsRequest.view:type_name -> sreportal.v1.FQDNView
+	43, // 7: sreportal.v1.StreamFQDNsRequest.last_seen_after:type_name -> google.protobuf.Timestamp
+	43, // 8: sreportal.v1.StreamFQDNsRequest.last_seen_before:type_name -> google.protobuf.Timestamp
+	3,  // 9: sreportal.v1.StreamFQDNsResponse.type:type_name -> sreportal.v1.UpdateType
+	25, // 10: sreportal.v1.StreamFQDNsResponse.fqdn:type_name -> sreportal.v1.FQDN
+	12, // 11: sreportal.v1.FederatedSearchResponse.results:type_name -> sreportal.v1.FederatedFQDN
+	13, // 12: sreportal.v1.FederatedSearchResponse.errors:type_name -> sreportal.v1.FederatedSiteError
+	25, // 13: sreportal.v1.FederatedFQDN.fqdn:type_name -> sreportal.v1.FQDN
+	15, // 14: sreportal.v1.BatchUpdateManualEntriesRequest.operations:type_name -> sreportal.v1.ManualEntryOperation
+	1,  // 15: sreportal.v1.ManualEntryOperation.type:type_name -> sreportal.v1.ManualEntryOperationType
+	16, // 16: sreportal.v1.ManualEntryOperation.entry:type_name -> sreportal.v1.ManualEntry
+	19, // 17: sreportal.v1.SetFQDNsIgnoredRequest.targets:type_name -> sreportal.v1.IgnoreTarget
+	21, // 18: sreportal.v1.SetFQDNsIgnoredResponse.results:type_name -> sreportal.v1.IgnoreResult
+	2,  // 19: sreportal.v1.IgnoreResult.status:type_name -> sreportal.v1.IgnoreResultStatus
+	43, // 20: sreportal.v1.FQDN.last_seen:type_name -> google.protobuf.Timestamp
+	22, // 21: sreportal.v1.FQDN.origin_ref:type_name -> sreportal.v1.OriginResourceRef
+	24, // 22: sreportal.v1.FQDN.ports:type_name -> sreportal.v1.ServicePort
+	23, // 23: sreportal.v1.FQDN.dns_record_ref:type_name -> sreportal.v1.DNSRecordRef
+	43, // 24: sreportal.v1.FQDN.last_reconciled:type_name -> google.protobuf.Timestamp
+	4,  // 25: sreportal.v1.FQDN.overall_status:type_name -> sreportal.v1.OverallStatus
+	42, // 26: sreportal.v1.FQDN.annotations:type_name -> sreportal.v1.FQDN.AnnotationsEntry
+	43, // 27: sreportal.v1.FQDN.removed_at:type_name -> google.protobuf.Timestamp
+	23, // 28: sreportal.v1.FQDN.shadowed_manual:type_name -> sreportal.v1.DNSRecordRef
+	28, // 29: sreportal.v1.FQDN.ownership_conflict:type_name -> sreportal.v1.OwnershipConflict
+	43, // 30: sreportal.v1.FQDN.last_probe_time:type_name -> google.protobuf.Timestamp
+	30, // 31: sreportal.v1.FQDN.target_providers:type_name -> sreportal.v1.TargetProvider
+	25, // 32: sreportal.v1.PublishEndpointsRequest.fqdns:type_name -> sreportal.v1.FQDN
+	29, // 33: sreportal.v1.OwnershipConflict.target_sets:type_name -> sreportal.v1.TargetSet
+	43, // 34: sreportal.v1.OwnershipConflict.detected_at:type_name -> google.protobuf.Timestamp
+	35, // 35: sreportal.v1.AddNoteResponse.note:type_name -> sreportal.v1.FQDNNote
+	35, // 36: sreportal.v1.ListNotesResponse.notes:type_name -> sreportal.v1.FQDNNote
+	43, // 37: sreportal.v1.FQDNNote.created_at:type_name -> google.protobuf.Timestamp
+	40, // 38: sreportal.v1.GetUniquenessReportResponse.issues:type_name -> sreportal.v1.UniquenessIssue
+	41, // 39: sreportal.v1.UniquenessIssue.contributions:type_name -> sreportal.v1.FQDNContribution
+	23, // 40: sreportal.v1.FQDNContribution.dns_record:type_name -> sreportal.v1.DNSRecordRef
+	5,  // 41: sreportal.v1.DNSService.ListFQDNs:input_type -> sreportal.v1.ListFQDNsRequest
+	8,  // 42: sreportal.v1.DNSService.StreamFQDNs:input_type -> sreportal.v1.StreamFQDNsRequest
+	10, // 43: sreportal.v1.DNSService.FederatedSearch:input_type -> sreportal.v1.FederatedSearchRequest
+	14, // 44: sreportal.v1.DNSService.BatchUpdateManualEntries:input_type -> sreportal.v1.BatchUpdateManualEntriesRequest
+	18, // 45: sreportal.v1.DNSService.SetFQDNsIgnored:input_type -> sreportal.v1.SetFQDNsIgnoredRequest
+	26, // 46: sreportal.v1.DNSService.PublishEndpoints:input_type -> sreportal.v1.PublishEndpointsRequest
+	31, // 47: sreportal.v1.DNSService.AddNote:input_type -> sreportal.v1.AddNoteRequest
+	33, // 48: sreportal.v1.DNSService.ListNotes:input_type -> sreportal.v1.ListNotesRequest
+	36, // 49: sreportal.v1.DNSService.GetShareLink:input_type -> sreportal.v1.GetShareLinkRequest
+	38, // 50: sreportal.v1.DNSService.GetUniquenessReport:input_type -> sreportal.v1.GetUniquenessReportRequest
+	6,  // 51: sreportal.v1.DNSService.ListFQDNs:output_type -> sreportal.v1.ListFQDNsResponse
+	9,  // 52: sreportal.v1.DNSService.StreamFQDNs:output_type -> sreportal.v1.StreamFQDNsResponse
+	11, // 53: sreportal.v1.DNSService.FederatedSearch:output_type -> sreportal.v1.FederatedSearchResponse
+	17, // 54: sreportal.v1.DNSService.BatchUpdateManualEntries:output_type -> sreportal.v1.BatchUpdateManualEntriesResponse
+	20, // 55: sreportal.v1.DNSService.SetFQDNsIgnored:output_type -> sreportal.v1.SetFQDNsIgnoredResponse
+	27, // 56: sreportal.v1.DNSService.PublishEndpoints:output_type -> sreportal.v1.PublishEndpointsResponse
+	32, // 57: sreportal.v1.DNSService.AddNote:output_type -> sreportal.v1.AddNoteResponse
+	34, // 58: sreportal.v1.DNSService.ListNotes:output_type -> sreportal.v1.ListNotesResponse
+	37, // 59: sreportal.v1.DNSService.GetShareLink:output_type -> sreportal.v1.GetShareLinkResponse
+	39, // 60: sreportal.v1.DNSService.GetUniquenessReport:output_type -> sreportal.v1.GetUniquenessReportResponse
+	51, // [51:61] is the sub-list for method output_type
+	41, // [41:51] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_sreportal_v1_dns_proto_init() }
@@ -688,14 +3584,14 @@ func file_sreportal_v1_dns_proto_init() {
 	if File_sreportal_v1_dns_proto != nil {
 		return
 	}
-	file_sreportal_v1_dns_proto_msgTypes[5].OneofWrappers = []any{}
+	file_sreportal_v1_dns_proto_msgTypes[20].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sreportal_v1_dns_proto_rawDesc), len(file_sreportal_v1_dns_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   6,
+			NumEnums:      5,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,303 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: sreportal/v1/log.proto
+
+package sreportalv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// StreamLogsRequest filters the streamed log entries
+type StreamLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// controller keeps the entries of a controller (e.g. "dns"), matched against
+	// the controller attribute and the logger name (empty for all)
+	Controller string `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	// portal keeps the entries carrying this portal attribute (empty for all)
+	Portal string `protobuf:"bytes,2,opt,name=portal,proto3" json:"portal,omitempty"`
+	// min_level is the lowest level streamed: debug, info, warn or error
+	// (empty for info)
+	MinLevel      string `protobuf:"bytes,3,opt,name=min_level,json=minLevel,proto3" json:"min_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamLogsRequest) Reset() {
+	*x = StreamLogsRequest{}
+	mi := &file_sreportal_v1_log_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLogsRequest) ProtoMessage() {}
+
+func (x *StreamLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_log_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamLogsRequest) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_log_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StreamLogsRequest) GetController() string {
+	if x != nil {
+		return x.Controller
+	}
+	return ""
+}
+
+func (x *StreamLogsRequest) GetPortal() string {
+	if x != nil {
+		return x.Portal
+	}
+	return ""
+}
+
+func (x *StreamLogsRequest) GetMinLevel() string {
+	if x != nil {
+		return x.MinLevel
+	}
+	return ""
+}
+
+// StreamLogsResponse carries one log entry
+type StreamLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *LogEntry              `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamLogsResponse) Reset() {
+	*x = StreamLogsResponse{}
+	mi := &file_sreportal_v1_log_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLogsResponse) ProtoMessage() {}
+
+func (x *StreamLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_log_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamLogsResponse.ProtoReflect.Descriptor instead.
+func (*StreamLogsResponse) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_log_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StreamLogsResponse) GetEntry() *LogEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// LogEntry is one structured log record of the operator
+type LogEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// time is when the record was logged
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// level is the record level: trace, debug, info, warn or error
+	Level string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	// logger is the name of the logger, "/"-separated (e.g. "agent-ingester")
+	Logger string `protobuf:"bytes,3,opt,name=logger,proto3" json:"logger,omitempty"`
+	// message is the log message
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// attributes are the structured attributes of the record, as strings
+	Attributes    map[string]string `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogEntry) Reset() {
+	*x = LogEntry{}
+	mi := &file_sreportal_v1_log_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogEntry) ProtoMessage() {}
+
+func (x *LogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_log_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
+func (*LogEntry) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_log_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LogEntry) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *LogEntry) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *LogEntry) GetLogger() string {
+	if x != nil {
+		return x.Logger
+	}
+	return ""
+}
+
+func (x *LogEntry) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LogEntry) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+var File_sreportal_v1_log_proto protoreflect.FileDescriptor
+
+const file_sreportal_v1_log_proto_rawDesc = "" +
+	"\n" +
+	"\x16sreportal/v1/log.proto\x12\fsreportal.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"h\n" +
+	"\x11StreamLogsRequest\x12\x1e\n" +
+	"\n" +
+	"controller\x18\x01 \x01(\tR\n" +
+	"controller\x12\x16\n" +
+	"\x06portal\x18\x02 \x01(\tR\x06portal\x12\x1b\n" +
+	"\tmin_level\x18\x03 \x01(\tR\bminLevel\"B\n" +
+	"\x12StreamLogsResponse\x12,\n" +
+	"\x05entry\x18\x01 \x01(\v2\x16.sreportal.v1.LogEntryR\x05entry\"\x89\x02\n" +
+	"\bLogEntry\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\tR\x05level\x12\x16\n" +
+	"\x06logger\x18\x03 \x01(\tR\x06logger\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12F\n" +
+	"\n" +
+	"attributes\x18\x05 \x03(\v2&.sreportal.v1.LogEntry.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x012_\n" +
+	"\n" +
+	"LogService\x12Q\n" +
+	"\n" +
+	"StreamLogs\x12\x1f.sreportal.v1.StreamLogsRequest\x1a .sreportal.v1.StreamLogsResponse0\x01B\xb8\x01\n" +
+	"\x10com.sreportal.v1B\bLogProtoP\x01ZIgithub.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1;sreportalv1\xa2\x02\x03SXX\xaa\x02\fSreportal.V1\xca\x02\fSreportal\\V1\xe2\x02\x18Sreportal\\V1\\GPBMetadata\xea\x02\rSreportal::V1b\x06proto3"
+
+var (
+	file_sreportal_v1_log_proto_rawDescOnce sync.Once
+	file_sreportal_v1_log_proto_rawDescData []byte
+)
+
+func file_sreportal_v1_log_proto_rawDescGZIP() []byte {
+	file_sreportal_v1_log_proto_rawDescOnce.Do(func() {
+		file_sreportal_v1_log_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sreportal_v1_log_proto_rawDesc), len(file_sreportal_v1_log_proto_rawDesc)))
+	})
+	return file_sreportal_v1_log_proto_rawDescData
+}
+
+var file_sreportal_v1_log_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_sreportal_v1_log_proto_goTypes = []any{
+	(*StreamLogsRequest)(nil),     // 0: sreportal.v1.StreamLogsRequest
+	(*StreamLogsResponse)(nil),    // 1: sreportal.v1.StreamLogsResponse
+	(*LogEntry)(nil),              // 2: sreportal.v1.LogEntry
+	nil,                           // 3: sreportal.v1.LogEntry.AttributesEntry
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_sreportal_v1_log_proto_depIdxs = []int32{
+	2, // 0: sreportal.v1.StreamLogsResponse.entry:type_name -> sreportal.v1.LogEntry
+	4, // 1: sreportal.v1.LogEntry.time:type_name -> google.protobuf.Timestamp
+	3, // 2: sreportal.v1.LogEntry.attributes:type_name -> sreportal.v1.LogEntry.AttributesEntry
+	0, // 3: sreportal.v1.LogService.StreamLogs:input_type -> sreportal.v1.StreamLogsRequest
+	1, // 4: sreportal.v1.LogService.StreamLogs:output_type -> sreportal.v1.StreamLogsResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_sreportal_v1_log_proto_init() }
+func file_sreportal_v1_log_proto_init() {
+	if File_sreportal_v1_log_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sreportal_v1_log_proto_rawDesc), len(file_sreportal_v1_log_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sreportal_v1_log_proto_goTypes,
+		DependencyIndexes: file_sreportal_v1_log_proto_depIdxs,
+		MessageInfos:      file_sreportal_v1_log_proto_msgTypes,
+	}.Build()
+	File_sreportal_v1_log_proto = out.File
+	file_sreportal_v1_log_proto_goTypes = nil
+	file_sreportal_v1_log_proto_depIdxs = nil
+}
@@ -135,7 +135,11 @@ type Portal struct {
 	// remote_sync contains status information for remote portals
 	RemoteSync *RemoteSyncStatus `protobuf:"bytes,9,opt,name=remote_sync,json=remoteSync,proto3" json:"remote_sync,omitempty"`
 	// features contains the feature toggles for this portal
-	Features      *PortalFeatures `protobuf:"bytes,10,opt,name=features,proto3" json:"features,omitempty"`
+	Features *PortalFeatures `protobuf:"bytes,10,opt,name=features,proto3" json:"features,omitempty"`
+	// links are external links shown in the portal menu
+	Links []*PortalLink `protobuf:"bytes,11,rep,name=links,proto3" json:"links,omitempty"`
+	// branding customizes how the portal is displayed (unset when not configured)
+	Branding      *PortalBranding `protobuf:"bytes,12,opt,name=branding,proto3" json:"branding,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -240,6 +244,20 @@ func (x *Portal) GetFeatures() *PortalFeatures {
 	return nil
 }
 
+func (x *Portal) GetLinks() []*PortalLink {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *Portal) GetBranding() *PortalBranding {
+	if x != nil {
+		return x.Branding
+	}
+	return nil
+}
+
 // PortalFeatures controls which features are enabled for a portal
 type PortalFeatures struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -404,6 +422,374 @@ func (x *RemoteSyncStatus) GetFqdnCount() int32 {
 	return 0
 }
 
+// PortalLink is an external link shown in the portal menu
+type PortalLink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// title is the link label
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// url is the link target
+	Url           string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortalLink) Reset() {
+	*x = PortalLink{}
+	mi := &file_sreportal_v1_portal_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortalLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortalLink) ProtoMessage() {}
+
+func (x *PortalLink) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_portal_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortalLink.ProtoReflect.Descriptor instead.
+func (*PortalLink) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_portal_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PortalLink) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PortalLink) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// PortalBranding customizes how a portal is displayed
+type PortalBranding struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// logo_url is the URL of the logo shown in the portal menu
+	LogoUrl string `protobuf:"bytes,1,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	// color is the accent color of the portal, as "#rrggbb"
+	Color         string `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortalBranding) Reset() {
+	*x = PortalBranding{}
+	mi := &file_sreportal_v1_portal_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortalBranding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortalBranding) ProtoMessage() {}
+
+func (x *PortalBranding) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_portal_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortalBranding.ProtoReflect.Descriptor instead.
+func (*PortalBranding) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_portal_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PortalBranding) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *PortalBranding) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+// GetPortalContentRequest is the request for getting the content of a portal
+type GetPortalContentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// portal is the portal name (required)
+	Portal        string `protobuf:"bytes,1,opt,name=portal,proto3" json:"portal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortalContentRequest) Reset() {
+	*x = GetPortalContentRequest{}
+	mi := &file_sreportal_v1_portal_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortalContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortalContentRequest) ProtoMessage() {}
+
+func (x *GetPortalContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_portal_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortalContentRequest.ProtoReflect.Descriptor instead.
+func (*GetPortalContentRequest) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_portal_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPortalContentRequest) GetPortal() string {
+	if x != nil {
+		return x.Portal
+	}
+	return ""
+}
+
+// GetPortalContentResponse contains the content blocks of a portal
+type GetPortalContentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// blocks are the content blocks, in spec.contentRefs order
+	Blocks        []*ContentBlock `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortalContentResponse) Reset() {
+	*x = GetPortalContentResponse{}
+	mi := &file_sreportal_v1_portal_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortalContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortalContentResponse) ProtoMessage() {}
+
+func (x *GetPortalContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_portal_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortalContentResponse.ProtoReflect.Descriptor instead.
+func (*GetPortalContentResponse) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_portal_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetPortalContentResponse) GetBlocks() []*ContentBlock {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+// ContentBlock is a markdown document read from a ConfigMap key
+type ContentBlock struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// config_map is the name of the ConfigMap holding the block
+	ConfigMap string `protobuf:"bytes,1,opt,name=config_map,json=configMap,proto3" json:"config_map,omitempty"`
+	// key is the ConfigMap key of the block
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// markdown is the block content
+	Markdown      string `protobuf:"bytes,3,opt,name=markdown,proto3" json:"markdown,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContentBlock) Reset() {
+	*x = ContentBlock{}
+	mi := &file_sreportal_v1_portal_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentBlock) ProtoMessage() {}
+
+func (x *ContentBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_portal_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentBlock.ProtoReflect.Descriptor instead.
+func (*ContentBlock) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_portal_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ContentBlock) GetConfigMap() string {
+	if x != nil {
+		return x.ConfigMap
+	}
+	return ""
+}
+
+func (x *ContentBlock) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ContentBlock) GetMarkdown() string {
+	if x != nil {
+		return x.Markdown
+	}
+	return ""
+}
+
+// PromoteToMainRequest is the request for promoting a portal to main
+type PromoteToMainRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// portal is the portal name (required)
+	Portal string `protobuf:"bytes,1,opt,name=portal,proto3" json:"portal,omitempty"`
+	// namespace is the portal namespace, required when several portals share
+	// the name
+	Namespace     string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoteToMainRequest) Reset() {
+	*x = PromoteToMainRequest{}
+	mi := &file_sreportal_v1_portal_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteToMainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteToMainRequest) ProtoMessage() {}
+
+func (x *PromoteToMainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_portal_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteToMainRequest.ProtoReflect.Descriptor instead.
+func (*PromoteToMainRequest) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_portal_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PromoteToMainRequest) GetPortal() string {
+	if x != nil {
+		return x.Portal
+	}
+	return ""
+}
+
+func (x *PromoteToMainRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// PromoteToMainResponse is the result of a promotion
+type PromoteToMainResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// demoted lists the portals that were main before, as "namespace/name"
+	Demoted       []string `protobuf:"bytes,1,rep,name=demoted,proto3" json:"demoted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoteToMainResponse) Reset() {
+	*x = PromoteToMainResponse{}
+	mi := &file_sreportal_v1_portal_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteToMainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteToMainResponse) ProtoMessage() {}
+
+func (x *PromoteToMainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sreportal_v1_portal_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteToMainResponse.ProtoReflect.Descriptor instead.
+func (*PromoteToMainResponse) Descriptor() ([]byte, []int) {
+	return file_sreportal_v1_portal_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PromoteToMainResponse) GetDemoted() []string {
+	if x != nil {
+		return x.Demoted
+	}
+	return nil
+}
+
 var File_sreportal_v1_portal_proto protoreflect.FileDescriptor
 
 const file_sreportal_v1_portal_proto_rawDesc = "" +
@@ -412,7 +798,7 @@ const file_sreportal_v1_portal_proto_rawDesc = "" +
 	"\x12ListPortalsRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"E\n" +
 	"\x13ListPortalsResponse\x12.\n" +
-	"\aportals\x18\x01 \x03(\v2\x14.sreportal.v1.PortalR\aportals\"\xbf\x02\n" +
+	"\aportals\x18\x01 \x03(\v2\x14.sreportal.v1.PortalR\aportals\"\xa9\x03\n" +
 	"\x06Portal\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
@@ -425,7 +811,9 @@ const file_sreportal_v1_portal_proto_rawDesc = "" +
 	"\vremote_sync\x18\t \x01(\v2\x1e.sreportal.v1.RemoteSyncStatusR\n" +
 	"remoteSync\x128\n" +
 	"\bfeatures\x18\n" +
-	" \x01(\v2\x1c.sreportal.v1.PortalFeaturesR\bfeatures\"\xc7\x01\n" +
+	" \x01(\v2\x1c.sreportal.v1.PortalFeaturesR\bfeatures\x12.\n" +
+	"\x05links\x18\v \x03(\v2\x18.sreportal.v1.PortalLinkR\x05links\x128\n" +
+	"\bbranding\x18\f \x01(\v2\x1c.sreportal.v1.PortalBrandingR\bbranding\"\xc7\x01\n" +
 	"\x0ePortalFeatures\x12\x10\n" +
 	"\x03dns\x18\x01 \x01(\bR\x03dns\x12\x1a\n" +
 	"\breleases\x18\x02 \x01(\bR\breleases\x12%\n" +
@@ -439,9 +827,32 @@ const file_sreportal_v1_portal_proto_rawDesc = "" +
 	"\x0flast_sync_error\x18\x02 \x01(\tR\rlastSyncError\x12!\n" +
 	"\fremote_title\x18\x03 \x01(\tR\vremoteTitle\x12\x1d\n" +
 	"\n" +
-	"fqdn_count\x18\x04 \x01(\x05R\tfqdnCount2c\n" +
+	"fqdn_count\x18\x04 \x01(\x05R\tfqdnCount\"4\n" +
+	"\n" +
+	"PortalLink\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"A\n" +
+	"\x0ePortalBranding\x12\x19\n" +
+	"\blogo_url\x18\x01 \x01(\tR\alogoUrl\x12\x14\n" +
+	"\x05color\x18\x02 \x01(\tR\x05color\"1\n" +
+	"\x17GetPortalContentRequest\x12\x16\n" +
+	"\x06portal\x18\x01 \x01(\tR\x06portal\"N\n" +
+	"\x18GetPortalContentResponse\x122\n" +
+	"\x06blocks\x18\x01 \x03(\v2\x1a.sreportal.v1.ContentBlockR\x06blocks\"[\n" +
+	"\fContentBlock\x12\x1d\n" +
+	"\n" +
+	"config_map\x18\x01 \x01(\tR\tconfigMap\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1a\n" +
+	"\bmarkdown\x18\x03 \x01(\tR\bmarkdown\"L\n" +
+	"\x14PromoteToMainRequest\x12\x16\n" +
+	"\x06portal\x18\x01 \x01(\tR\x06portal\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"1\n" +
+	"\x15PromoteToMainResponse\x12\x18\n" +
+	"\ademoted\x18\x01 \x03(\tR\ademoted2\xa0\x02\n" +
 	"\rPortalService\x12R\n" +
-	"\vListPortals\x12 .sreportal.v1.ListPortalsRequest\x1a!.sreportal.v1.ListPortalsResponseB\xbb\x01\n" +
+	"\vListPortals\x12 .sreportal.v1.ListPortalsRequest\x1a!.sreportal.v1.ListPortalsResponse\x12a\n" +
+	"\x10GetPortalContent\x12%.sreportal.v1.GetPortalContentRequest\x1a&.sreportal.v1.GetPortalContentResponse\x12X\n" +
+	"\rPromoteToMain\x12\".sreportal.v1.PromoteToMainRequest\x1a#.sreportal.v1.PromoteToMainResponseB\xbb\x01\n" +
 	"\x10com.sreportal.v1B\vPortalProtoP\x01ZIgithub.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1;sreportalv1\xa2\x02\x03SXX\xaa\x02\fSreportal.V1\xca\x02\fSreportal\\V1\xe2\x02\x18Sreportal\\V1\\GPBMetadata\xea\x02\rSreportal::V1b\x06proto3"
 
 var (
@@ -456,25 +867,39 @@ func file_sreportal_v1_portal_proto_rawDescGZIP() []byte {
 	return file_sreportal_v1_portal_proto_rawDescData
 }
 
-var file_sreportal_v1_portal_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_sreportal_v1_portal_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_sreportal_v1_portal_proto_goTypes = []any{
-	(*ListPortalsRequest)(nil),  // 0: sreportal.v1.ListPortalsRequest
-	(*ListPortalsResponse)(nil), // 1: sreportal.v1.ListPortalsResponse
-	(*Portal)(nil),              // 2: sreportal.v1.Portal
-	(*PortalFeatures)(nil),      // 3: sreportal.v1.PortalFeatures
-	(*RemoteSyncStatus)(nil),    // 4: sreportal.v1.RemoteSyncStatus
+	(*ListPortalsRequest)(nil),       // 0: sreportal.v1.ListPortalsRequest
+	(*ListPortalsResponse)(nil),      // 1: sreportal.v1.ListPortalsResponse
+	(*Portal)(nil),                   // 2: sreportal.v1.Portal
+	(*PortalFeatures)(nil),           // 3: sreportal.v1.PortalFeatures
+	(*RemoteSyncStatus)(nil),         // 4: sreportal.v1.RemoteSyncStatus
+	(*PortalLink)(nil),               // 5: sreportal.v1.PortalLink
+	(*PortalBranding)(nil),           // 6: sreportal.v1.PortalBranding
+	(*GetPortalContentRequest)(nil),  // 7: sreportal.v1.GetPortalContentRequest
+	(*GetPortalContentResponse)(nil), // 8: sreportal.v1.GetPortalContentResponse
+	(*ContentBlock)(nil),             // 9: sreportal.v1.ContentBlock
+	(*PromoteToMainRequest)(nil),     // 10: sreportal.v1.PromoteToMainRequest
+	(*PromoteToMainResponse)(nil),    // 11: sreportal.v1.PromoteToMainResponse
 }
 var file_sreportal_v1_portal_proto_depIdxs = []int32{
-	2, // 0: sreportal.v1.ListPortalsResponse.portals:type_name -> sreportal.v1.Portal
-	4, // 1: sreportal.v1.Portal.remote_sync:type_name -> sreportal.v1.RemoteSyncStatus
-	3, // 2: sreportal.v1.Portal.features:type_name -> sreportal.v1.PortalFeatures
-	0, // 3: sreportal.v1.PortalService.ListPortals:input_type -> sreportal.v1.ListPortalsRequest
-	1, // 4: sreportal.v1.PortalService.ListPortals:output_type -> sreportal.v1.ListPortalsResponse
-	4, // [4:5] is the sub-list for method output_type
-	3, // [3:4] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	2,  // 0: sreportal.v1.ListPortalsResponse.portals:type_name -> sreportal.v1.Portal
+	4,  // 1: sreportal.v1.Portal.remote_sync:type_name -> sreportal.v1.RemoteSyncStatus
+	3,  // 2: sreportal.v1.Portal.features:type_name -> sreportal.v1.PortalFeatures
+	5,  // 3: sreportal.v1.Portal.links:type_name -> sreportal.v1.PortalLink
+	6,  // 4: sreportal.v1.Portal.branding:type_name -> sreportal.v1.PortalBranding
+	9,  // 5: sreportal.v1.GetPortalContentResponse.blocks:type_name -> sreportal.v1.ContentBlock
+	0,  // 6: sreportal.v1.PortalService.ListPortals:input_type -> sreportal.v1.ListPortalsRequest
+	7,  // 7: sreportal.v1.PortalService.GetPortalContent:input_type -> sreportal.v1.GetPortalContentRequest
+	10, // 8: sreportal.v1.PortalService.PromoteToMain:input_type -> sreportal.v1.PromoteToMainRequest
+	1,  // 9: sreportal.v1.PortalService.ListPortals:output_type -> sreportal.v1.ListPortalsResponse
+	8,  // 10: sreportal.v1.PortalService.GetPortalContent:output_type -> sreportal.v1.GetPortalContentResponse
+	11, // 11: sreportal.v1.PortalService.PromoteToMain:output_type -> sreportal.v1.PromoteToMainResponse
+	9,  // [9:12] is the sub-list for method output_type
+	6,  // [6:9] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_sreportal_v1_portal_proto_init() }
@@ -488,7 +913,7 @@ func file_sreportal_v1_portal_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sreportal_v1_portal_proto_rawDesc), len(file_sreportal_v1_portal_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
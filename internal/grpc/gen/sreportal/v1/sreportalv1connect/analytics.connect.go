@@ -0,0 +1,144 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: sreportal/v1/analytics.proto
+
+package sreportalv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AnalyticsServiceName is the fully-qualified name of the AnalyticsService service.
+	AnalyticsServiceName = "sreportal.v1.AnalyticsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AnalyticsServiceRecordUsageProcedure is the fully-qualified name of the AnalyticsService's
+	// RecordUsage RPC.
+	AnalyticsServiceRecordUsageProcedure = "/sreportal.v1.AnalyticsService/RecordUsage"
+	// AnalyticsServiceGetUsageStatsProcedure is the fully-qualified name of the AnalyticsService's
+	// GetUsageStats RPC.
+	AnalyticsServiceGetUsageStatsProcedure = "/sreportal.v1.AnalyticsService/GetUsageStats"
+)
+
+// AnalyticsServiceClient is a client for the sreportal.v1.AnalyticsService service.
+type AnalyticsServiceClient interface {
+	// RecordUsage counts a usage event. It is sent as a beacon by the web UI
+	// when analytics are enabled
+	RecordUsage(context.Context, *connect.Request[v1.RecordUsageRequest]) (*connect.Response[v1.RecordUsageResponse], error)
+	// GetUsageStats returns the usage counters of a portal, or of every portal
+	GetUsageStats(context.Context, *connect.Request[v1.GetUsageStatsRequest]) (*connect.Response[v1.GetUsageStatsResponse], error)
+}
+
+// NewAnalyticsServiceClient constructs a client for the sreportal.v1.AnalyticsService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAnalyticsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AnalyticsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	analyticsServiceMethods := v1.File_sreportal_v1_analytics_proto.Services().ByName("AnalyticsService").Methods()
+	return &analyticsServiceClient{
+		recordUsage: connect.NewClient[v1.RecordUsageRequest, v1.RecordUsageResponse](
+			httpClient,
+			baseURL+AnalyticsServiceRecordUsageProcedure,
+			connect.WithSchema(analyticsServiceMethods.ByName("RecordUsage")),
+			connect.WithClientOptions(opts...),
+		),
+		getUsageStats: connect.NewClient[v1.GetUsageStatsRequest, v1.GetUsageStatsResponse](
+			httpClient,
+			baseURL+AnalyticsServiceGetUsageStatsProcedure,
+			connect.WithSchema(analyticsServiceMethods.ByName("GetUsageStats")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// analyticsServiceClient implements AnalyticsServiceClient.
+type analyticsServiceClient struct {
+	recordUsage   *connect.Client[v1.RecordUsageRequest, v1.RecordUsageResponse]
+	getUsageStats *connect.Client[v1.GetUsageStatsRequest, v1.GetUsageStatsResponse]
+}
+
+// RecordUsage calls sreportal.v1.AnalyticsService.RecordUsage.
+func (c *analyticsServiceClient) RecordUsage(ctx context.Context, req *connect.Request[v1.RecordUsageRequest]) (*connect.Response[v1.RecordUsageResponse], error) {
+	return c.recordUsage.CallUnary(ctx, req)
+}
+
+// GetUsageStats calls sreportal.v1.AnalyticsService.GetUsageStats.
+func (c *analyticsServiceClient) GetUsageStats(ctx context.Context, req *connect.Request[v1.GetUsageStatsRequest]) (*connect.Response[v1.GetUsageStatsResponse], error) {
+	return c.getUsageStats.CallUnary(ctx, req)
+}
+
+// AnalyticsServiceHandler is an implementation of the sreportal.v1.AnalyticsService service.
+type AnalyticsServiceHandler interface {
+	// RecordUsage counts a usage event. It is sent as a beacon by the web UI
+	// when analytics are enabled
+	RecordUsage(context.Context, *connect.Request[v1.RecordUsageRequest]) (*connect.Response[v1.RecordUsageResponse], error)
+	// GetUsageStats returns the usage counters of a portal, or of every portal
+	GetUsageStats(context.Context, *connect.Request[v1.GetUsageStatsRequest]) (*connect.Response[v1.GetUsageStatsResponse], error)
+}
+
+// NewAnalyticsServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAnalyticsServiceHandler(svc AnalyticsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	analyticsServiceMethods := v1.File_sreportal_v1_analytics_proto.Services().ByName("AnalyticsService").Methods()
+	analyticsServiceRecordUsageHandler := connect.NewUnaryHandler(
+		AnalyticsServiceRecordUsageProcedure,
+		svc.RecordUsage,
+		connect.WithSchema(analyticsServiceMethods.ByName("RecordUsage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	analyticsServiceGetUsageStatsHandler := connect.NewUnaryHandler(
+		AnalyticsServiceGetUsageStatsProcedure,
+		svc.GetUsageStats,
+		connect.WithSchema(analyticsServiceMethods.ByName("GetUsageStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/sreportal.v1.AnalyticsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AnalyticsServiceRecordUsageProcedure:
+			analyticsServiceRecordUsageHandler.ServeHTTP(w, r)
+		case AnalyticsServiceGetUsageStatsProcedure:
+			analyticsServiceGetUsageStatsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAnalyticsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAnalyticsServiceHandler struct{}
+
+func (UnimplementedAnalyticsServiceHandler) RecordUsage(context.Context, *connect.Request[v1.RecordUsageRequest]) (*connect.Response[v1.RecordUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sreportal.v1.AnalyticsService.RecordUsage is not implemented"))
+}
+
+func (UnimplementedAnalyticsServiceHandler) GetUsageStats(context.Context, *connect.Request[v1.GetUsageStatsRequest]) (*connect.Response[v1.GetUsageStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sreportal.v1.AnalyticsService.GetUsageStats is not implemented"))
+}
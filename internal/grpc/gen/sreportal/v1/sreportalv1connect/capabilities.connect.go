@@ -0,0 +1,113 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: sreportal/v1/capabilities.proto
+
+package sreportalv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// CapabilitiesServiceName is the fully-qualified name of the CapabilitiesService service.
+	CapabilitiesServiceName = "sreportal.v1.CapabilitiesService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// CapabilitiesServiceGetCapabilitiesProcedure is the fully-qualified name of the
+	// CapabilitiesService's GetCapabilities RPC.
+	CapabilitiesServiceGetCapabilitiesProcedure = "/sreportal.v1.CapabilitiesService/GetCapabilities"
+)
+
+// CapabilitiesServiceClient is a client for the sreportal.v1.CapabilitiesService service.
+type CapabilitiesServiceClient interface {
+	// GetCapabilities returns the enabled sources and the active features, so
+	// clients can hide the views that would stay empty
+	GetCapabilities(context.Context, *connect.Request[v1.GetCapabilitiesRequest]) (*connect.Response[v1.GetCapabilitiesResponse], error)
+}
+
+// NewCapabilitiesServiceClient constructs a client for the sreportal.v1.CapabilitiesService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCapabilitiesServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CapabilitiesServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	capabilitiesServiceMethods := v1.File_sreportal_v1_capabilities_proto.Services().ByName("CapabilitiesService").Methods()
+	return &capabilitiesServiceClient{
+		getCapabilities: connect.NewClient[v1.GetCapabilitiesRequest, v1.GetCapabilitiesResponse](
+			httpClient,
+			baseURL+CapabilitiesServiceGetCapabilitiesProcedure,
+			connect.WithSchema(capabilitiesServiceMethods.ByName("GetCapabilities")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// capabilitiesServiceClient implements CapabilitiesServiceClient.
+type capabilitiesServiceClient struct {
+	getCapabilities *connect.Client[v1.GetCapabilitiesRequest, v1.GetCapabilitiesResponse]
+}
+
+// GetCapabilities calls sreportal.v1.CapabilitiesService.GetCapabilities.
+func (c *capabilitiesServiceClient) GetCapabilities(ctx context.Context, req *connect.Request[v1.GetCapabilitiesRequest]) (*connect.Response[v1.GetCapabilitiesResponse], error) {
+	return c.getCapabilities.CallUnary(ctx, req)
+}
+
+// CapabilitiesServiceHandler is an implementation of the sreportal.v1.CapabilitiesService service.
+type CapabilitiesServiceHandler interface {
+	// GetCapabilities returns the enabled sources and the active features, so
+	// clients can hide the views that would stay empty
+	GetCapabilities(context.Context, *connect.Request[v1.GetCapabilitiesRequest]) (*connect.Response[v1.GetCapabilitiesResponse], error)
+}
+
+// NewCapabilitiesServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCapabilitiesServiceHandler(svc CapabilitiesServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	capabilitiesServiceMethods := v1.File_sreportal_v1_capabilities_proto.Services().ByName("CapabilitiesService").Methods()
+	capabilitiesServiceGetCapabilitiesHandler := connect.NewUnaryHandler(
+		CapabilitiesServiceGetCapabilitiesProcedure,
+		svc.GetCapabilities,
+		connect.WithSchema(capabilitiesServiceMethods.ByName("GetCapabilities")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/sreportal.v1.CapabilitiesService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CapabilitiesServiceGetCapabilitiesProcedure:
+			capabilitiesServiceGetCapabilitiesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCapabilitiesServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCapabilitiesServiceHandler struct{}
+
+func (UnimplementedCapabilitiesServiceHandler) GetCapabilities(context.Context, *connect.Request[v1.GetCapabilitiesRequest]) (*connect.Response[v1.GetCapabilitiesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sreportal.v1.CapabilitiesService.GetCapabilities is not implemented"))
+}
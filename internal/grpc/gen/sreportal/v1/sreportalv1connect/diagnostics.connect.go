@@ -0,0 +1,115 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: sreportal/v1/diagnostics.proto
+
+package sreportalv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// DiagnosticsServiceName is the fully-qualified name of the DiagnosticsService service.
+	DiagnosticsServiceName = "sreportal.v1.DiagnosticsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// DiagnosticsServiceRunDiagnosticsProcedure is the fully-qualified name of the DiagnosticsService's
+	// RunDiagnostics RPC.
+	DiagnosticsServiceRunDiagnosticsProcedure = "/sreportal.v1.DiagnosticsService/RunDiagnostics"
+)
+
+// DiagnosticsServiceClient is a client for the sreportal.v1.DiagnosticsService service.
+type DiagnosticsServiceClient interface {
+	// RunDiagnostics checks the API server connectivity, the RBAC of the enabled
+	// sources, the CRD availability, the webhook reachability and the
+	// configuration validity. Requires authentication when auth is configured.
+	RunDiagnostics(context.Context, *connect.Request[v1.RunDiagnosticsRequest]) (*connect.Response[v1.RunDiagnosticsResponse], error)
+}
+
+// NewDiagnosticsServiceClient constructs a client for the sreportal.v1.DiagnosticsService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewDiagnosticsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) DiagnosticsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	diagnosticsServiceMethods := v1.File_sreportal_v1_diagnostics_proto.Services().ByName("DiagnosticsService").Methods()
+	return &diagnosticsServiceClient{
+		runDiagnostics: connect.NewClient[v1.RunDiagnosticsRequest, v1.RunDiagnosticsResponse](
+			httpClient,
+			baseURL+DiagnosticsServiceRunDiagnosticsProcedure,
+			connect.WithSchema(diagnosticsServiceMethods.ByName("RunDiagnostics")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// diagnosticsServiceClient implements DiagnosticsServiceClient.
+type diagnosticsServiceClient struct {
+	runDiagnostics *connect.Client[v1.RunDiagnosticsRequest, v1.RunDiagnosticsResponse]
+}
+
+// RunDiagnostics calls sreportal.v1.DiagnosticsService.RunDiagnostics.
+func (c *diagnosticsServiceClient) RunDiagnostics(ctx context.Context, req *connect.Request[v1.RunDiagnosticsRequest]) (*connect.Response[v1.RunDiagnosticsResponse], error) {
+	return c.runDiagnostics.CallUnary(ctx, req)
+}
+
+// DiagnosticsServiceHandler is an implementation of the sreportal.v1.DiagnosticsService service.
+type DiagnosticsServiceHandler interface {
+	// RunDiagnostics checks the API server connectivity, the RBAC of the enabled
+	// sources, the CRD availability, the webhook reachability and the
+	// configuration validity. Requires authentication when auth is configured.
+	RunDiagnostics(context.Context, *connect.Request[v1.RunDiagnosticsRequest]) (*connect.Response[v1.RunDiagnosticsResponse], error)
+}
+
+// NewDiagnosticsServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewDiagnosticsServiceHandler(svc DiagnosticsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	diagnosticsServiceMethods := v1.File_sreportal_v1_diagnostics_proto.Services().ByName("DiagnosticsService").Methods()
+	diagnosticsServiceRunDiagnosticsHandler := connect.NewUnaryHandler(
+		DiagnosticsServiceRunDiagnosticsProcedure,
+		svc.RunDiagnostics,
+		connect.WithSchema(diagnosticsServiceMethods.ByName("RunDiagnostics")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/sreportal.v1.DiagnosticsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case DiagnosticsServiceRunDiagnosticsProcedure:
+			diagnosticsServiceRunDiagnosticsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedDiagnosticsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedDiagnosticsServiceHandler struct{}
+
+func (UnimplementedDiagnosticsServiceHandler) RunDiagnostics(context.Context, *connect.Request[v1.RunDiagnosticsRequest]) (*connect.Response[v1.RunDiagnosticsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sreportal.v1.DiagnosticsService.RunDiagnostics is not implemented"))
+}
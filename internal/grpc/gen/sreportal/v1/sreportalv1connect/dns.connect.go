@@ -37,6 +37,27 @@ const (
 	DNSServiceListFQDNsProcedure = "/sreportal.v1.DNSService/ListFQDNs"
 	// DNSServiceStreamFQDNsProcedure is the fully-qualified name of the DNSService's StreamFQDNs RPC.
 	DNSServiceStreamFQDNsProcedure = "/sreportal.v1.DNSService/StreamFQDNs"
+	// DNSServiceFederatedSearchProcedure is the fully-qualified name of the DNSService's
+	// FederatedSearch RPC.
+	DNSServiceFederatedSearchProcedure = "/sreportal.v1.DNSService/FederatedSearch"
+	// DNSServiceBatchUpdateManualEntriesProcedure is the fully-qualified name of the DNSService's
+	// BatchUpdateManualEntries RPC.
+	DNSServiceBatchUpdateManualEntriesProcedure = "/sreportal.v1.DNSService/BatchUpdateManualEntries"
+	// DNSServiceSetFQDNsIgnoredProcedure is the fully-qualified name of the DNSService's
+	// SetFQDNsIgnored RPC.
+	DNSServiceSetFQDNsIgnoredProcedure = "/sreportal.v1.DNSService/SetFQDNsIgnored"
+	// DNSServicePublishEndpointsProcedure is the fully-qualified name of the DNSService's
+	// PublishEndpoints RPC.
+	DNSServicePublishEndpointsProcedure = "/sreportal.v1.DNSService/PublishEndpoints"
+	// DNSServiceAddNoteProcedure is the fully-qualified name of the DNSService's AddNote RPC.
+	DNSServiceAddNoteProcedure = "/sreportal.v1.DNSService/AddNote"
+	// DNSServiceListNotesProcedure is the fully-qualified name of the DNSService's ListNotes RPC.
+	DNSServiceListNotesProcedure = "/sreportal.v1.DNSService/ListNotes"
+	// DNSServiceGetShareLinkProcedure is the fully-qualified name of the DNSService's GetShareLink RPC.
+	DNSServiceGetShareLinkProcedure = "/sreportal.v1.DNSService/GetShareLink"
+	// DNSServiceGetUniquenessReportProcedure is the fully-qualified name of the DNSService's
+	// GetUniquenessReport RPC.
+	DNSServiceGetUniquenessReportProcedure = "/sreportal.v1.DNSService/GetUniquenessReport"
 )
 
 // DNSServiceClient is a client for the sreportal.v1.DNSService service.
@@ -45,6 +66,32 @@ type DNSServiceClient interface {
 	ListFQDNs(context.Context, *connect.Request[v1.ListFQDNsRequest]) (*connect.Response[v1.ListFQDNsResponse], error)
 	// StreamFQDNs streams FQDN updates in real-time
 	StreamFQDNs(context.Context, *connect.Request[v1.StreamFQDNsRequest]) (*connect.ServerStreamForClient[v1.StreamFQDNsResponse], error)
+	// FederatedSearch searches FQDNs on this instance and on every remote portal,
+	// merging results that exist on several sites
+	FederatedSearch(context.Context, *connect.Request[v1.FederatedSearchRequest]) (*connect.Response[v1.FederatedSearchResponse], error)
+	// BatchUpdateManualEntries applies a list of add/update/delete operations
+	// to the manual entries of a portal in a single DNSRecord update: either
+	// every operation is applied or none is
+	BatchUpdateManualEntries(context.Context, *connect.Request[v1.BatchUpdateManualEntriesRequest]) (*connect.Response[v1.BatchUpdateManualEntriesResponse], error)
+	// SetFQDNsIgnored sets or clears the sreportal.io/ignore annotation of the
+	// resources FQDNs are discovered from, which drops them from (or restores
+	// them to) every portal without editing their manifests
+	SetFQDNsIgnored(context.Context, *connect.Request[v1.SetFQDNsIgnoredRequest]) (*connect.Response[v1.SetFQDNsIgnoredResponse], error)
+	// PublishEndpoints replaces the FQDNs an agent discovered in its cluster
+	// for a local portal. Sent by sreportal instances running in agent mode
+	PublishEndpoints(context.Context, *connect.Request[v1.PublishEndpointsRequest]) (*connect.Response[v1.PublishEndpointsResponse], error)
+	// AddNote appends a free-text note to an FQDN of a portal, recording its
+	// author and creation time. Notes are never edited or deleted
+	AddNote(context.Context, *connect.Request[v1.AddNoteRequest]) (*connect.Response[v1.AddNoteResponse], error)
+	// ListNotes returns the notes of an FQDN of a portal, oldest first
+	ListNotes(context.Context, *connect.Request[v1.ListNotesRequest]) (*connect.Response[v1.ListNotesResponse], error)
+	// GetShareLink returns a stable link to an FQDN of a portal, and optionally
+	// its QR code. The link is resolved by the server, so it stays valid across
+	// UI releases and portal subPath changes
+	GetShareLink(context.Context, *connect.Request[v1.GetShareLinkRequest]) (*connect.Response[v1.GetShareLinkResponse], error)
+	// GetUniquenessReport lists the FQDNs listed in several portals, or whose
+	// contributing DNSRecords disagree on the targets, highest severity first
+	GetUniquenessReport(context.Context, *connect.Request[v1.GetUniquenessReportRequest]) (*connect.Response[v1.GetUniquenessReportResponse], error)
 }
 
 // NewDNSServiceClient constructs a client for the sreportal.v1.DNSService service. By default, it
@@ -70,13 +117,69 @@ func NewDNSServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(dNSServiceMethods.ByName("StreamFQDNs")),
 			connect.WithClientOptions(opts...),
 		),
+		federatedSearch: connect.NewClient[v1.FederatedSearchRequest, v1.FederatedSearchResponse](
+			httpClient,
+			baseURL+DNSServiceFederatedSearchProcedure,
+			connect.WithSchema(dNSServiceMethods.ByName("FederatedSearch")),
+			connect.WithClientOptions(opts...),
+		),
+		batchUpdateManualEntries: connect.NewClient[v1.BatchUpdateManualEntriesRequest, v1.BatchUpdateManualEntriesResponse](
+			httpClient,
+			baseURL+DNSServiceBatchUpdateManualEntriesProcedure,
+			connect.WithSchema(dNSServiceMethods.ByName("BatchUpdateManualEntries")),
+			connect.WithClientOptions(opts...),
+		),
+		setFQDNsIgnored: connect.NewClient[v1.SetFQDNsIgnoredRequest, v1.SetFQDNsIgnoredResponse](
+			httpClient,
+			baseURL+DNSServiceSetFQDNsIgnoredProcedure,
+			connect.WithSchema(dNSServiceMethods.ByName("SetFQDNsIgnored")),
+			connect.WithClientOptions(opts...),
+		),
+		publishEndpoints: connect.NewClient[v1.PublishEndpointsRequest, v1.PublishEndpointsResponse](
+			httpClient,
+			baseURL+DNSServicePublishEndpointsProcedure,
+			connect.WithSchema(dNSServiceMethods.ByName("PublishEndpoints")),
+			connect.WithClientOptions(opts...),
+		),
+		addNote: connect.NewClient[v1.AddNoteRequest, v1.AddNoteResponse](
+			httpClient,
+			baseURL+DNSServiceAddNoteProcedure,
+			connect.WithSchema(dNSServiceMethods.ByName("AddNote")),
+			connect.WithClientOptions(opts...),
+		),
+		listNotes: connect.NewClient[v1.ListNotesRequest, v1.ListNotesResponse](
+			httpClient,
+			baseURL+DNSServiceListNotesProcedure,
+			connect.WithSchema(dNSServiceMethods.ByName("ListNotes")),
+			connect.WithClientOptions(opts...),
+		),
+		getShareLink: connect.NewClient[v1.GetShareLinkRequest, v1.GetShareLinkResponse](
+			httpClient,
+			baseURL+DNSServiceGetShareLinkProcedure,
+			connect.WithSchema(dNSServiceMethods.ByName("GetShareLink")),
+			connect.WithClientOptions(opts...),
+		),
+		getUniquenessReport: connect.NewClient[v1.GetUniquenessReportRequest, v1.GetUniquenessReportResponse](
+			httpClient,
+			baseURL+DNSServiceGetUniquenessReportProcedure,
+			connect.WithSchema(dNSServiceMethods.ByName("GetUniquenessReport")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // dNSServiceClient implements DNSServiceClient.
 type dNSServiceClient struct {
-	listFQDNs   *connect.Client[v1.ListFQDNsRequest, v1.ListFQDNsResponse]
-	streamFQDNs *connect.Client[v1.StreamFQDNsRequest, v1.StreamFQDNsResponse]
+	listFQDNs                *connect.Client[v1.ListFQDNsRequest, v1.ListFQDNsResponse]
+	streamFQDNs              *connect.Client[v1.StreamFQDNsRequest, v1.StreamFQDNsResponse]
+	federatedSearch          *connect.Client[v1.FederatedSearchRequest, v1.FederatedSearchResponse]
+	batchUpdateManualEntries *connect.Client[v1.BatchUpdateManualEntriesRequest, v1.BatchUpdateManualEntriesResponse]
+	setFQDNsIgnored          *connect.Client[v1.SetFQDNsIgnoredRequest, v1.SetFQDNsIgnoredResponse]
+	publishEndpoints         *connect.Client[v1.PublishEndpointsRequest, v1.PublishEndpointsResponse]
+	addNote                  *connect.Client[v1.AddNoteRequest, v1.AddNoteResponse]
+	listNotes                *connect.Client[v1.ListNotesRequest, v1.ListNotesResponse]
+	getShareLink             *connect.Client[v1.GetShareLinkRequest, v1.GetShareLinkResponse]
+	getUniquenessReport      *connect.Client[v1.GetUniquenessReportRequest, v1.GetUniquenessReportResponse]
 }
 
 // ListFQDNs calls sreportal.v1.DNSService.ListFQDNs.
@@ -89,12 +192,78 @@ func (c *dNSServiceClient) StreamFQDNs(ctx context.Context, req *connect.Request
 	return c.streamFQDNs.CallServerStream(ctx, req)
 }
 
+// FederatedSearch calls sreportal.v1.DNSService.FederatedSearch.
+func (c *dNSServiceClient) FederatedSearch(ctx context.Context, req *connect.Request[v1.FederatedSearchRequest]) (*connect.Response[v1.FederatedSearchResponse], error) {
+	return c.federatedSearch.CallUnary(ctx, req)
+}
+
+// BatchUpdateManualEntries calls sreportal.v1.DNSService.BatchUpdateManualEntries.
+func (c *dNSServiceClient) BatchUpdateManualEntries(ctx context.Context, req *connect.Request[v1.BatchUpdateManualEntriesRequest]) (*connect.Response[v1.BatchUpdateManualEntriesResponse], error) {
+	return c.batchUpdateManualEntries.CallUnary(ctx, req)
+}
+
+// SetFQDNsIgnored calls sreportal.v1.DNSService.SetFQDNsIgnored.
+func (c *dNSServiceClient) SetFQDNsIgnored(ctx context.Context, req *connect.Request[v1.SetFQDNsIgnoredRequest]) (*connect.Response[v1.SetFQDNsIgnoredResponse], error) {
+	return c.setFQDNsIgnored.CallUnary(ctx, req)
+}
+
+// PublishEndpoints calls sreportal.v1.DNSService.PublishEndpoints.
+func (c *dNSServiceClient) PublishEndpoints(ctx context.Context, req *connect.Request[v1.PublishEndpointsRequest]) (*connect.Response[v1.PublishEndpointsResponse], error) {
+	return c.publishEndpoints.CallUnary(ctx, req)
+}
+
+// AddNote calls sreportal.v1.DNSService.AddNote.
+func (c *dNSServiceClient) AddNote(ctx context.Context, req *connect.Request[v1.AddNoteRequest]) (*connect.Response[v1.AddNoteResponse], error) {
+	return c.addNote.CallUnary(ctx, req)
+}
+
+// ListNotes calls sreportal.v1.DNSService.ListNotes.
+func (c *dNSServiceClient) ListNotes(ctx context.Context, req *connect.Request[v1.ListNotesRequest]) (*connect.Response[v1.ListNotesResponse], error) {
+	return c.listNotes.CallUnary(ctx, req)
+}
+
+// GetShareLink calls sreportal.v1.DNSService.GetShareLink.
+func (c *dNSServiceClient) GetShareLink(ctx context.Context, req *connect.Request[v1.GetShareLinkRequest]) (*connect.Response[v1.GetShareLinkResponse], error) {
+	return c.getShareLink.CallUnary(ctx, req)
+}
+
+// GetUniquenessReport calls sreportal.v1.DNSService.GetUniquenessReport.
+func (c *dNSServiceClient) GetUniquenessReport(ctx context.Context, req *connect.Request[v1.GetUniquenessReportRequest]) (*connect.Response[v1.GetUniquenessReportResponse], error) {
+	return c.getUniquenessReport.CallUnary(ctx, req)
+}
+
 // DNSServiceHandler is an implementation of the sreportal.v1.DNSService service.
 type DNSServiceHandler interface {
 	// ListFQDNs returns all aggregated FQDNs from DNS resources
 	ListFQDNs(context.Context, *connect.Request[v1.ListFQDNsRequest]) (*connect.Response[v1.ListFQDNsResponse], error)
 	// StreamFQDNs streams FQDN updates in real-time
 	StreamFQDNs(context.Context, *connect.Request[v1.StreamFQDNsRequest], *connect.ServerStream[v1.StreamFQDNsResponse]) error
+	// FederatedSearch searches FQDNs on this instance and on every remote portal,
+	// merging results that exist on several sites
+	FederatedSearch(context.Context, *connect.Request[v1.FederatedSearchRequest]) (*connect.Response[v1.FederatedSearchResponse], error)
+	// BatchUpdateManualEntries applies a list of add/update/delete operations
+	// to the manual entries of a portal in a single DNSRecord update: either
+	// every operation is applied or none is
+	BatchUpdateManualEntries(context.Context, *connect.Request[v1.BatchUpdateManualEntriesRequest]) (*connect.Response[v1.BatchUpdateManualEntriesResponse], error)
+	// SetFQDNsIgnored sets or clears the sreportal.io/ignore annotation of the
+	// resources FQDNs are discovered from, which drops them from (or restores
+	// them to) every portal without editing their manifests
+	SetFQDNsIgnored(context.Context, *connect.Request[v1.SetFQDNsIgnoredRequest]) (*connect.Response[v1.SetFQDNsIgnoredResponse], error)
+	// PublishEndpoints replaces the FQDNs an agent discovered in its cluster
+	// for a local portal. Sent by sreportal instances running in agent mode
+	PublishEndpoints(context.Context, *connect.Request[v1.PublishEndpointsRequest]) (*connect.Response[v1.PublishEndpointsResponse], error)
+	// AddNote appends a free-text note to an FQDN of a portal, recording its
+	// author and creation time. Notes are never edited or deleted
+	AddNote(context.Context, *connect.Request[v1.AddNoteRequest]) (*connect.Response[v1.AddNoteResponse], error)
+	// ListNotes returns the notes of an FQDN of a portal, oldest first
+	ListNotes(context.Context, *connect.Request[v1.ListNotesRequest]) (*connect.Response[v1.ListNotesResponse], error)
+	// GetShareLink returns a stable link to an FQDN of a portal, and optionally
+	// its QR code. The link is resolved by the server, so it stays valid across
+	// UI releases and portal subPath changes
+	GetShareLink(context.Context, *connect.Request[v1.GetShareLinkRequest]) (*connect.Response[v1.GetShareLinkResponse], error)
+	// GetUniquenessReport lists the FQDNs listed in several portals, or whose
+	// contributing DNSRecords disagree on the targets, highest severity first
+	GetUniquenessReport(context.Context, *connect.Request[v1.GetUniquenessReportRequest]) (*connect.Response[v1.GetUniquenessReportResponse], error)
 }
 
 // NewDNSServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -116,12 +285,76 @@ func NewDNSServiceHandler(svc DNSServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(dNSServiceMethods.ByName("StreamFQDNs")),
 		connect.WithHandlerOptions(opts...),
 	)
+	dNSServiceFederatedSearchHandler := connect.NewUnaryHandler(
+		DNSServiceFederatedSearchProcedure,
+		svc.FederatedSearch,
+		connect.WithSchema(dNSServiceMethods.ByName("FederatedSearch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dNSServiceBatchUpdateManualEntriesHandler := connect.NewUnaryHandler(
+		DNSServiceBatchUpdateManualEntriesProcedure,
+		svc.BatchUpdateManualEntries,
+		connect.WithSchema(dNSServiceMethods.ByName("BatchUpdateManualEntries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dNSServiceSetFQDNsIgnoredHandler := connect.NewUnaryHandler(
+		DNSServiceSetFQDNsIgnoredProcedure,
+		svc.SetFQDNsIgnored,
+		connect.WithSchema(dNSServiceMethods.ByName("SetFQDNsIgnored")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dNSServicePublishEndpointsHandler := connect.NewUnaryHandler(
+		DNSServicePublishEndpointsProcedure,
+		svc.PublishEndpoints,
+		connect.WithSchema(dNSServiceMethods.ByName("PublishEndpoints")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dNSServiceAddNoteHandler := connect.NewUnaryHandler(
+		DNSServiceAddNoteProcedure,
+		svc.AddNote,
+		connect.WithSchema(dNSServiceMethods.ByName("AddNote")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dNSServiceListNotesHandler := connect.NewUnaryHandler(
+		DNSServiceListNotesProcedure,
+		svc.ListNotes,
+		connect.WithSchema(dNSServiceMethods.ByName("ListNotes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dNSServiceGetShareLinkHandler := connect.NewUnaryHandler(
+		DNSServiceGetShareLinkProcedure,
+		svc.GetShareLink,
+		connect.WithSchema(dNSServiceMethods.ByName("GetShareLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dNSServiceGetUniquenessReportHandler := connect.NewUnaryHandler(
+		DNSServiceGetUniquenessReportProcedure,
+		svc.GetUniquenessReport,
+		connect.WithSchema(dNSServiceMethods.ByName("GetUniquenessReport")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/sreportal.v1.DNSService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case DNSServiceListFQDNsProcedure:
 			dNSServiceListFQDNsHandler.ServeHTTP(w, r)
 		case DNSServiceStreamFQDNsProcedure:
 			dNSServiceStreamFQDNsHandler.ServeHTTP(w, r)
+		case DNSServiceFederatedSearchProcedure:
+			dNSServiceFederatedSearchHandler.ServeHTTP(w, r)
+		case DNSServiceBatchUpdateManualEntriesProcedure:
+			dNSServiceBatchUpdateManualEntriesHandler.ServeHTTP(w, r)
+		case DNSServiceSetFQDNsIgnoredProcedure:
+			dNSServiceSetFQDNsIgnoredHandler.ServeHTTP(w, r)
+		case DNSServicePublishEndpointsProcedure:
+			dNSServicePublishEndpointsHandler.ServeHTTP(w, r)
+		case DNSServiceAddNoteProcedure:
+			dNSServiceAddNoteHandler.ServeHTTP(w, r)
+		case DNSServiceListNotesProcedure:
+			dNSServiceListNotesHandler.ServeHTTP(w, r)
+		case DNSServiceGetShareLinkProcedure:
+			dNSServiceGetShareLinkHandler.ServeHTTP(w, r)
+		case DNSServiceGetUniquenessReportProcedure:
+			dNSServiceGetUniquenessReportHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -138,3 +371,35 @@ func (UnimplementedDNSServiceHandler) ListFQDNs(context.Context, *connect.Reques
 func (UnimplementedDNSServiceHandler) StreamFQDNs(context.Context, *connect.Request[v1.StreamFQDNsRequest], *connect.ServerStream[v1.StreamFQDNsResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("sreportal.v1.DNSService.StreamFQDNs is not implemented"))
 }
+
+func (UnimplementedDNSServiceHandler) FederatedSearch(context.Context, *connect.Request[v1.FederatedSearchRequest]) (*connect.Response[v1.FederatedSearchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sreportal.v1.DNSService.FederatedSearch is not implemented"))
+}
+
+func (UnimplementedDNSServiceHandler) BatchUpdateManualEntries(context.Context, *connect.Request[v1.BatchUpdateManualEntriesRequest]) (*connect.Response[v1.BatchUpdateManualEntriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sreportal.v1.DNSService.BatchUpdateManualEntries is not implemented"))
+}
+
+func (UnimplementedDNSServiceHandler) SetFQDNsIgnored(context.Context, *connect.Request[v1.SetFQDNsIgnoredRequest]) (*connect.Response[v1.SetFQDNsIgnoredResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sreportal.v1.DNSService.SetFQDNsIgnored is not implemented"))
+}
+
+func (UnimplementedDNSServiceHandler) PublishEndpoints(context.Context, *connect.Request[v1.PublishEndpointsRequest]) (*connect.Response[v1.PublishEndpointsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sreportal.v1.DNSService.PublishEndpoints is not implemented"))
+}
+
+func (UnimplementedDNSServiceHandler) AddNote(context.Context, *connect.Request[v1.AddNoteRequest]) (*connect.Response[v1.AddNoteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sreportal.v1.DNSService.AddNote is not implemented"))
+}
+
+func (UnimplementedDNSServiceHandler) ListNotes(context.Context, *connect.Request[v1.ListNotesRequest]) (*connect.Response[v1.ListNotesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sreportal.v1.DNSService.ListNotes is not implemented"))
+}
+
+func (UnimplementedDNSServiceHandler) GetShareLink(context.Context, *connect.Request[v1.GetShareLinkRequest]) (*connect.Response[v1.GetShareLinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sreportal.v1.DNSService.GetShareLink is not implemented"))
+}
+
+func (UnimplementedDNSServiceHandler) GetUniquenessReport(context.Context, *connect.Request[v1.GetUniquenessReportRequest]) (*connect.Response[v1.GetUniquenessReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sreportal.v1.DNSService.GetUniquenessReport is not implemented"))
+}
@@ -0,0 +1,114 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: sreportal/v1/log.proto
+
+package sreportalv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// LogServiceName is the fully-qualified name of the LogService service.
+	LogServiceName = "sreportal.v1.LogService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// LogServiceStreamLogsProcedure is the fully-qualified name of the LogService's StreamLogs RPC.
+	LogServiceStreamLogsProcedure = "/sreportal.v1.LogService/StreamLogs"
+)
+
+// LogServiceClient is a client for the sreportal.v1.LogService service.
+type LogServiceClient interface {
+	// StreamLogs tails the structured logs of the operator instance serving the
+	// request, starting with the most recent entries it keeps. Requires
+	// authentication.
+	StreamLogs(context.Context, *connect.Request[v1.StreamLogsRequest]) (*connect.ServerStreamForClient[v1.StreamLogsResponse], error)
+}
+
+// NewLogServiceClient constructs a client for the sreportal.v1.LogService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewLogServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) LogServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	logServiceMethods := v1.File_sreportal_v1_log_proto.Services().ByName("LogService").Methods()
+	return &logServiceClient{
+		streamLogs: connect.NewClient[v1.StreamLogsRequest, v1.StreamLogsResponse](
+			httpClient,
+			baseURL+LogServiceStreamLogsProcedure,
+			connect.WithSchema(logServiceMethods.ByName("StreamLogs")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// logServiceClient implements LogServiceClient.
+type logServiceClient struct {
+	streamLogs *connect.Client[v1.StreamLogsRequest, v1.StreamLogsResponse]
+}
+
+// StreamLogs calls sreportal.v1.LogService.StreamLogs.
+func (c *logServiceClient) StreamLogs(ctx context.Context, req *connect.Request[v1.StreamLogsRequest]) (*connect.ServerStreamForClient[v1.StreamLogsResponse], error) {
+	return c.streamLogs.CallServerStream(ctx, req)
+}
+
+// LogServiceHandler is an implementation of the sreportal.v1.LogService service.
+type LogServiceHandler interface {
+	// StreamLogs tails the structured logs of the operator instance serving the
+	// request, starting with the most recent entries it keeps. Requires
+	// authentication.
+	StreamLogs(context.Context, *connect.Request[v1.StreamLogsRequest], *connect.ServerStream[v1.StreamLogsResponse]) error
+}
+
+// NewLogServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewLogServiceHandler(svc LogServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	logServiceMethods := v1.File_sreportal_v1_log_proto.Services().ByName("LogService").Methods()
+	logServiceStreamLogsHandler := connect.NewServerStreamHandler(
+		LogServiceStreamLogsProcedure,
+		svc.StreamLogs,
+		connect.WithSchema(logServiceMethods.ByName("StreamLogs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/sreportal.v1.LogService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case LogServiceStreamLogsProcedure:
+			logServiceStreamLogsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedLogServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedLogServiceHandler struct{}
+
+func (UnimplementedLogServiceHandler) StreamLogs(context.Context, *connect.Request[v1.StreamLogsRequest], *connect.ServerStream[v1.StreamLogsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("sreportal.v1.LogService.StreamLogs is not implemented"))
+}
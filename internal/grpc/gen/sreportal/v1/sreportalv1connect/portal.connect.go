@@ -36,12 +36,24 @@ const (
 	// PortalServiceListPortalsProcedure is the fully-qualified name of the PortalService's ListPortals
 	// RPC.
 	PortalServiceListPortalsProcedure = "/sreportal.v1.PortalService/ListPortals"
+	// PortalServiceGetPortalContentProcedure is the fully-qualified name of the PortalService's
+	// GetPortalContent RPC.
+	PortalServiceGetPortalContentProcedure = "/sreportal.v1.PortalService/GetPortalContent"
+	// PortalServicePromoteToMainProcedure is the fully-qualified name of the PortalService's
+	// PromoteToMain RPC.
+	PortalServicePromoteToMainProcedure = "/sreportal.v1.PortalService/PromoteToMain"
 )
 
 // PortalServiceClient is a client for the sreportal.v1.PortalService service.
 type PortalServiceClient interface {
 	// ListPortals returns all available portals
 	ListPortals(context.Context, *connect.Request[v1.ListPortalsRequest]) (*connect.Response[v1.ListPortalsResponse], error)
+	// GetPortalContent returns the markdown content blocks (announcements,
+	// onboarding docs) of a portal, read from the ConfigMaps of spec.contentRefs
+	GetPortalContent(context.Context, *connect.Request[v1.GetPortalContentRequest]) (*connect.Response[v1.GetPortalContentResponse], error)
+	// PromoteToMain makes a local portal the main portal, clearing spec.main on
+	// the previous one (requires authentication)
+	PromoteToMain(context.Context, *connect.Request[v1.PromoteToMainRequest]) (*connect.Response[v1.PromoteToMainResponse], error)
 }
 
 // NewPortalServiceClient constructs a client for the sreportal.v1.PortalService service. By
@@ -61,12 +73,26 @@ func NewPortalServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(portalServiceMethods.ByName("ListPortals")),
 			connect.WithClientOptions(opts...),
 		),
+		getPortalContent: connect.NewClient[v1.GetPortalContentRequest, v1.GetPortalContentResponse](
+			httpClient,
+			baseURL+PortalServiceGetPortalContentProcedure,
+			connect.WithSchema(portalServiceMethods.ByName("GetPortalContent")),
+			connect.WithClientOptions(opts...),
+		),
+		promoteToMain: connect.NewClient[v1.PromoteToMainRequest, v1.PromoteToMainResponse](
+			httpClient,
+			baseURL+PortalServicePromoteToMainProcedure,
+			connect.WithSchema(portalServiceMethods.ByName("PromoteToMain")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // portalServiceClient implements PortalServiceClient.
 type portalServiceClient struct {
-	listPortals *connect.Client[v1.ListPortalsRequest, v1.ListPortalsResponse]
+	listPortals      *connect.Client[v1.ListPortalsRequest, v1.ListPortalsResponse]
+	getPortalContent *connect.Client[v1.GetPortalContentRequest, v1.GetPortalContentResponse]
+	promoteToMain    *connect.Client[v1.PromoteToMainRequest, v1.PromoteToMainResponse]
 }
 
 // ListPortals calls sreportal.v1.PortalService.ListPortals.
@@ -74,10 +100,26 @@ func (c *portalServiceClient) ListPortals(ctx context.Context, req *connect.Requ
 	return c.listPortals.CallUnary(ctx, req)
 }
 
+// GetPortalContent calls sreportal.v1.PortalService.GetPortalContent.
+func (c *portalServiceClient) GetPortalContent(ctx context.Context, req *connect.Request[v1.GetPortalContentRequest]) (*connect.Response[v1.GetPortalContentResponse], error) {
+	return c.getPortalContent.CallUnary(ctx, req)
+}
+
+// PromoteToMain calls sreportal.v1.PortalService.PromoteToMain.
+func (c *portalServiceClient) PromoteToMain(ctx context.Context, req *connect.Request[v1.PromoteToMainRequest]) (*connect.Response[v1.PromoteToMainResponse], error) {
+	return c.promoteToMain.CallUnary(ctx, req)
+}
+
 // PortalServiceHandler is an implementation of the sreportal.v1.PortalService service.
 type PortalServiceHandler interface {
 	// ListPortals returns all available portals
 	ListPortals(context.Context, *connect.Request[v1.ListPortalsRequest]) (*connect.Response[v1.ListPortalsResponse], error)
+	// GetPortalContent returns the markdown content blocks (announcements,
+	// onboarding docs) of a portal, read from the ConfigMaps of spec.contentRefs
+	GetPortalContent(context.Context, *connect.Request[v1.GetPortalContentRequest]) (*connect.Response[v1.GetPortalContentResponse], error)
+	// PromoteToMain makes a local portal the main portal, clearing spec.main on
+	// the previous one (requires authentication)
+	PromoteToMain(context.Context, *connect.Request[v1.PromoteToMainRequest]) (*connect.Response[v1.PromoteToMainResponse], error)
 }
 
 // NewPortalServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -93,10 +135,26 @@ func NewPortalServiceHandler(svc PortalServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(portalServiceMethods.ByName("ListPortals")),
 		connect.WithHandlerOptions(opts...),
 	)
+	portalServiceGetPortalContentHandler := connect.NewUnaryHandler(
+		PortalServiceGetPortalContentProcedure,
+		svc.GetPortalContent,
+		connect.WithSchema(portalServiceMethods.ByName("GetPortalContent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServicePromoteToMainHandler := connect.NewUnaryHandler(
+		PortalServicePromoteToMainProcedure,
+		svc.PromoteToMain,
+		connect.WithSchema(portalServiceMethods.ByName("PromoteToMain")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/sreportal.v1.PortalService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case PortalServiceListPortalsProcedure:
 			portalServiceListPortalsHandler.ServeHTTP(w, r)
+		case PortalServiceGetPortalContentProcedure:
+			portalServiceGetPortalContentHandler.ServeHTTP(w, r)
+		case PortalServicePromoteToMainProcedure:
+			portalServicePromoteToMainHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -109,3 +167,11 @@ type UnimplementedPortalServiceHandler struct{}
 func (UnimplementedPortalServiceHandler) ListPortals(context.Context, *connect.Request[v1.ListPortalsRequest]) (*connect.Response[v1.ListPortalsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sreportal.v1.PortalService.ListPortals is not implemented"))
 }
+
+func (UnimplementedPortalServiceHandler) GetPortalContent(context.Context, *connect.Request[v1.GetPortalContentRequest]) (*connect.Response[v1.GetPortalContentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sreportal.v1.PortalService.GetPortalContent is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) PromoteToMain(context.Context, *connect.Request[v1.PromoteToMainRequest]) (*connect.Response[v1.PromoteToMainResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sreportal.v1.PortalService.PromoteToMain is not implemented"))
+}
@@ -18,9 +18,18 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"strings"
 
 	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
 
+	"github.com/golgoth31/sreportal/internal/config"
 	"github.com/golgoth31/sreportal/internal/log"
 )
 
@@ -45,3 +54,218 @@ func LoggingInterceptor() connect.UnaryInterceptorFunc {
 		}
 	}
 }
+
+// HandlerInterceptors returns the interceptor chain shared by every Connect
+// handler, outermost first: panic recovery, error logging, error translation,
+// per-RPC timeout and request validation.
+func HandlerInterceptors(cfg config.WebRPCConfig) []connect.Interceptor {
+	return []connect.Interceptor{
+		RecoveryInterceptor(),
+		LoggingInterceptor(),
+		ErrorTranslationInterceptor(),
+		TimeoutInterceptor(cfg),
+		ValidationInterceptor(),
+	}
+}
+
+// RecoveryInterceptor returns a Connect interceptor that turns a handler
+// panic into an Internal error, logging the panic value and stack instead of
+// letting it tear down the request goroutine.
+func RecoveryInterceptor() connect.Interceptor {
+	return &recoveryInterceptor{logger: log.Default().WithName("connect")}
+}
+
+type recoveryInterceptor struct {
+	logger *log.Logger
+}
+
+func (i *recoveryInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (resp connect.AnyResponse, err error) {
+		defer i.recover(req.Spec().Procedure, &err)
+		return next(ctx, req)
+	}
+}
+
+func (i *recoveryInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *recoveryInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) (err error) {
+		defer i.recover(conn.Spec().Procedure, &err)
+		return next(ctx, conn)
+	}
+}
+
+func (i *recoveryInterceptor) recover(procedure string, err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	i.logger.Error(fmt.Errorf("panic: %v", r), "handler panicked",
+		"procedure", procedure,
+		"stack", string(debug.Stack()),
+	)
+	*err = connect.NewError(connect.CodeInternal, errors.New("internal error"))
+}
+
+// ErrorTranslationInterceptor returns a Connect interceptor that gives
+// uncoded handler errors the code matching their cause: Kubernetes API
+// errors (NotFound, Conflict, Forbidden, ...) and context cancellation.
+// Errors already carrying a Connect code are left untouched.
+func ErrorTranslationInterceptor() connect.Interceptor {
+	return translationInterceptor{}
+}
+
+type translationInterceptor struct{}
+
+func (translationInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		resp, err := next(ctx, req)
+		return resp, TranslateError(err)
+	}
+}
+
+func (translationInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (translationInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return TranslateError(next(ctx, conn))
+	}
+}
+
+// TranslateError wraps err in a Connect error whose code matches its cause.
+// It returns err unchanged when it already carries a Connect code or its
+// cause is not recognised.
+func TranslateError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return err
+	}
+
+	var code connect.Code
+	switch {
+	case errors.Is(err, context.DeadlineExceeded), apierrors.IsTimeout(err), apierrors.IsServerTimeout(err):
+		code = connect.CodeDeadlineExceeded
+	case errors.Is(err, context.Canceled):
+		code = connect.CodeCanceled
+	case apierrors.IsNotFound(err):
+		code = connect.CodeNotFound
+	case apierrors.IsAlreadyExists(err):
+		code = connect.CodeAlreadyExists
+	case apierrors.IsConflict(err):
+		code = connect.CodeAborted
+	case apierrors.IsInvalid(err), apierrors.IsBadRequest(err):
+		code = connect.CodeInvalidArgument
+	case apierrors.IsForbidden(err):
+		code = connect.CodePermissionDenied
+	case apierrors.IsUnauthorized(err):
+		code = connect.CodeUnauthenticated
+	case apierrors.IsTooManyRequests(err):
+		code = connect.CodeResourceExhausted
+	case apierrors.IsServiceUnavailable(err):
+		code = connect.CodeUnavailable
+	default:
+		return err
+	}
+	return connect.NewError(code, err)
+}
+
+// TimeoutInterceptor returns a Connect interceptor bounding every unary call
+// by the timeout cfg gives its procedure. Streaming calls are long-lived and
+// left unbounded.
+func TimeoutInterceptor(cfg config.WebRPCConfig) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			timeout := cfg.TimeoutFor(req.Spec().Procedure)
+			if timeout <= 0 {
+				return next(ctx, req)
+			}
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			return next(ctx, req)
+		}
+	}
+}
+
+// requestValidator is implemented by request messages carrying their own
+// validation.
+type requestValidator interface {
+	Validate() error
+}
+
+// ValidationInterceptor returns a Connect interceptor rejecting malformed
+// requests with InvalidArgument before they reach the handler: a "portal" or
+// "portal_ref" field that is not a Kubernetes object name, a negative
+// "page_size", or a message whose Validate method fails. Streaming calls are
+// checked on every received message.
+func ValidationInterceptor() connect.Interceptor {
+	return validationInterceptor{}
+}
+
+type validationInterceptor struct{}
+
+func (validationInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := validateRequest(req.Any()); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (validationInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (validationInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(ctx, validatingConn{conn})
+	}
+}
+
+type validatingConn struct {
+	connect.StreamingHandlerConn
+}
+
+func (c validatingConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	return validateRequest(msg)
+}
+
+func validateRequest(msg any) error {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return nil
+	}
+	r := m.ProtoReflect()
+	fields := r.Descriptor().Fields()
+	for _, name := range []protoreflect.Name{"portal", "portal_ref"} {
+		fd := fields.ByName(name)
+		if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+			continue
+		}
+		if v := r.Get(fd).String(); v != "" {
+			if errs := validation.IsDNS1123Subdomain(v); len(errs) > 0 {
+				return connect.NewError(connect.CodeInvalidArgument,
+					fmt.Errorf("%s %q: %s", name, v, strings.Join(errs, "; ")))
+			}
+		}
+	}
+	if fd := fields.ByName("page_size"); fd != nil && fd.Kind() == protoreflect.Int32Kind && r.Get(fd).Int() < 0 {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("page_size must not be negative"))
+	}
+	if v, ok := msg.(requestValidator); ok {
+		if err := v.Validate(); err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+	return nil
+}
@@ -18,15 +18,21 @@ package grpc_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/golgoth31/sreportal/internal/config"
 	internalgrpc "github.com/golgoth31/sreportal/internal/grpc"
+	portalv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
 )
 
 func TestLoggingInterceptor_WhenHandlerReturnsError_LogsWarning(t *testing.T) {
@@ -66,6 +72,77 @@ func TestLoggingInterceptor_WhenHandlerSucceeds_DoesNotLog(t *testing.T) {
 	assert.Empty(t, handler.records)
 }
 
+func TestRecoveryInterceptor_WhenHandlerPanics_ReturnsInternal(t *testing.T) {
+	slog.SetDefault(slog.New(&logRecordHandler{}))
+
+	next := func(_ context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+		panic("boom")
+	}
+
+	_, err := internalgrpc.RecoveryInterceptor().WrapUnary(next)(context.Background(), connect.NewRequest[any](nil))
+
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+	assert.NotContains(t, err.Error(), "boom")
+}
+
+func TestTranslateError(t *testing.T) {
+	gr := schema.GroupResource{Group: "sreportal.io", Resource: "portals"}
+	coded := connect.NewError(connect.CodeFailedPrecondition, errors.New("coded"))
+
+	tests := []struct {
+		name string
+		err  error
+		want connect.Code
+	}{
+		{"not found", apierrors.NewNotFound(gr, "main"), connect.CodeNotFound},
+		{"wrapped conflict", fmt.Errorf("update: %w", apierrors.NewConflict(gr, "main", errors.New("stale"))), connect.CodeAborted},
+		{"forbidden", apierrors.NewForbidden(gr, "main", errors.New("rbac")), connect.CodePermissionDenied},
+		{"deadline", context.DeadlineExceeded, connect.CodeDeadlineExceeded},
+		{"already coded", coded, connect.CodeFailedPrecondition},
+		{"unknown", errors.New("other"), connect.CodeUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, connect.CodeOf(internalgrpc.TranslateError(tt.err)))
+		})
+	}
+	assert.NoError(t, internalgrpc.TranslateError(nil))
+}
+
+func TestTimeoutInterceptor_BoundsUnaryCalls(t *testing.T) {
+	cfg := config.WebRPCConfig{Timeout: config.Duration(time.Minute)}
+	var deadline time.Time
+	next := func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+		deadline, _ = ctx.Deadline()
+		return connect.NewResponse[any](nil), nil
+	}
+
+	_, err := internalgrpc.TimeoutInterceptor(cfg)(next)(context.Background(), connect.NewRequest[any](nil))
+
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, 5*time.Second)
+}
+
+func TestValidationInterceptor_RejectsMalformedRequests(t *testing.T) {
+	called := false
+	next := func(_ context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+		called = true
+		return connect.NewResponse(&portalv1.ListFQDNsResponse{}), nil
+	}
+	call := internalgrpc.ValidationInterceptor().WrapUnary(next)
+
+	_, err := call(context.Background(), connect.NewRequest(&portalv1.ListFQDNsRequest{Portal: "Not A Portal"}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = call(context.Background(), connect.NewRequest(&portalv1.ListFQDNsRequest{PageSize: -1}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	assert.False(t, called)
+
+	_, err = call(context.Background(), connect.NewRequest(&portalv1.ListFQDNsRequest{Portal: "main", PageSize: 10}))
+	require.NoError(t, err)
+	assert.True(t, called)
+}
+
 // logRecordHandler captures slog records for assertion.
 type logRecordHandler struct {
 	records []slog.Record
@@ -0,0 +1,139 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/golgoth31/sreportal/internal/auth"
+	logv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	"github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1/sreportalv1connect"
+	"github.com/golgoth31/sreportal/internal/log"
+)
+
+// LogService implements the LogServiceHandler interface.
+type LogService struct {
+	sreportalv1connect.UnimplementedLogServiceHandler
+	tap       *log.Tap
+	authChain *auth.Chain
+	// admins are the identities with the admin role.
+	admins map[string]bool
+}
+
+// NewLogService creates a new LogService streaming the records of tap to the
+// callers authenticated by authChain whose identity is one of admins. Without
+// an auth chain every call is rejected: the logs are for platform admins only.
+func NewLogService(tap *log.Tap, authChain *auth.Chain, admins []string) *LogService {
+	s := &LogService{tap: tap, authChain: authChain, admins: make(map[string]bool, len(admins))}
+	for _, id := range admins {
+		s.admins[id] = true
+	}
+	return s
+}
+
+// logFilter selects the streamed log entries.
+type logFilter struct {
+	controller string
+	portal     string
+	minLevel   slog.Level
+}
+
+func (f logFilter) match(e log.Entry) bool {
+	if log.SlogLevel(e.Level) < f.minLevel {
+		return false
+	}
+	if f.portal != "" && e.Attrs["portal"] != f.portal {
+		return false
+	}
+	if f.controller != "" && e.Attrs["controller"] != f.controller &&
+		!slices.Contains(strings.Split(e.Logger, "/"), f.controller) {
+		return false
+	}
+	return true
+}
+
+// StreamLogs sends the log entries kept by the tap, then the new ones as they
+// are logged, until the client disconnects. Streams bypass the auth
+// interceptor, so the caller is authenticated here, and must have the admin
+// role.
+func (s *LogService) StreamLogs(
+	ctx context.Context,
+	req *connect.Request[logv1.StreamLogsRequest],
+	stream *connect.ServerStream[logv1.StreamLogsResponse],
+) error {
+	if s.tap == nil {
+		return connect.NewError(connect.CodeUnimplemented, errors.New("log streaming is disabled"))
+	}
+	if s.authChain == nil {
+		return connect.NewError(connect.CodeUnauthenticated, errors.New("log streaming requires authentication to be configured"))
+	}
+	id, err := s.authChain.Identify(ctx, req.Header())
+	if err != nil {
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if !s.admins[id] {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%q does not have the admin role (auth.admins)", id))
+	}
+
+	filter := logFilter{controller: req.Msg.Controller, portal: req.Msg.Portal, minLevel: slog.LevelInfo}
+	if req.Msg.MinLevel != "" {
+		level, err := log.ParseLevel(req.Msg.MinLevel)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("min_level: %w", err))
+		}
+		filter.minLevel = log.SlogLevel(level)
+	}
+
+	backlog, entries, cancel := s.tap.Subscribe()
+	defer cancel()
+	for _, e := range backlog {
+		if err := s.send(stream, filter, e); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e := <-entries:
+			if err := s.send(stream, filter, e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *LogService) send(stream *connect.ServerStream[logv1.StreamLogsResponse], filter logFilter, e log.Entry) error {
+	if !filter.match(e) {
+		return nil
+	}
+	return stream.Send(&logv1.StreamLogsResponse{Entry: &logv1.LogEntry{
+		Time:       timestamppb.New(e.Time),
+		Level:      string(e.Level),
+		Logger:     e.Logger,
+		Message:    e.Message,
+		Attributes: e.Attrs,
+	}})
+}
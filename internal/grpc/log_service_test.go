@@ -0,0 +1,135 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golgoth31/sreportal/internal/auth"
+	svcgrpc "github.com/golgoth31/sreportal/internal/grpc"
+	logv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	"github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1/sreportalv1connect"
+	"github.com/golgoth31/sreportal/internal/log"
+)
+
+func newLogServiceClient(t *testing.T, tap *log.Tap, chain *auth.Chain, admins ...string) sreportalv1connect.LogServiceClient {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.Handle(sreportalv1connect.NewLogServiceHandler(svcgrpc.NewLogService(tap, chain, admins)))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return sreportalv1connect.NewLogServiceClient(srv.Client(), srv.URL)
+}
+
+func seedLogTap() *log.Tap {
+	tap := log.NewTap(10)
+	logger := log.FromSlog(slog.New(tap.Handler(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: log.LevelTrace}))))
+	logger.WithName("dns").With("controller", "dns", "portal", tPortalMain).Info("reconciled DNS")
+	logger.WithName("dns").With("controller", "dns", "portal", "other").Info("reconciled DNS of other portal")
+	logger.WithName("dns").With("controller", "dns", "portal", tPortalMain).Debug("cache hit")
+	logger.WithName("agent-ingester").With("portal", tPortalMain).Warn("agent stopped pushing")
+	logger.WithName("agent-ingester").With("portal", tPortalMain).Info("last entry")
+	return tap
+}
+
+// receiveLogs reads the entries streamed until the one with message last.
+func receiveLogs(t *testing.T, stream *connect.ServerStreamForClient[logv1.StreamLogsResponse], last string) []string {
+	t.Helper()
+	var messages []string
+	for stream.Receive() {
+		msg := stream.Msg().Entry.Message
+		messages = append(messages, msg)
+		if msg == last {
+			return messages
+		}
+	}
+	require.NoError(t, stream.Err())
+	return messages
+}
+
+func TestStreamLogs_FiltersEntries(t *testing.T) {
+	chain := auth.NewChain(auth.NewAPIKeyAuthenticator("ci", "X-API-Key", "secret"))
+	client := newLogServiceClient(t, seedLogTap(), chain, "apikey:ci")
+
+	tests := []struct {
+		name string
+		req  *logv1.StreamLogsRequest
+		last string
+		want []string
+	}{
+		{"all at info", &logv1.StreamLogsRequest{}, "last entry",
+			[]string{"reconciled DNS", "reconciled DNS of other portal", "agent stopped pushing", "last entry"}},
+		{"controller and portal", &logv1.StreamLogsRequest{Controller: "dns", Portal: tPortalMain, MinLevel: "debug"}, "cache hit",
+			[]string{"reconciled DNS", "cache hit"}},
+		{"logger name", &logv1.StreamLogsRequest{Controller: "agent-ingester", MinLevel: "warn"}, "agent stopped pushing",
+			[]string{"agent stopped pushing"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			req := connect.NewRequest(tt.req)
+			req.Header().Set("X-API-Key", "secret")
+			stream, err := client.StreamLogs(ctx, req)
+			require.NoError(t, err)
+			defer func() { _ = stream.Close() }()
+
+			assert.Equal(t, tt.want, receiveLogs(t, stream, tt.last))
+		})
+	}
+}
+
+func TestStreamLogs_RejectsCalls(t *testing.T) {
+	chain := auth.NewChain(auth.NewAPIKeyAuthenticator("ci", "X-API-Key", "secret"))
+
+	tests := []struct {
+		name   string
+		client sreportalv1connect.LogServiceClient
+		req    *logv1.StreamLogsRequest
+		apiKey string
+		code   connect.Code
+	}{
+		{"no tap", newLogServiceClient(t, nil, chain, "apikey:ci"), &logv1.StreamLogsRequest{}, "secret", connect.CodeUnimplemented},
+		{"no auth configured", newLogServiceClient(t, seedLogTap(), nil, "apikey:ci"), &logv1.StreamLogsRequest{}, "secret", connect.CodeUnauthenticated},
+		{"anonymous", newLogServiceClient(t, seedLogTap(), chain, "apikey:ci"), &logv1.StreamLogsRequest{}, "", connect.CodeUnauthenticated},
+		{"not an admin", newLogServiceClient(t, seedLogTap(), chain), &logv1.StreamLogsRequest{}, "secret", connect.CodePermissionDenied},
+		{"invalid level", newLogServiceClient(t, seedLogTap(), chain, "apikey:ci"), &logv1.StreamLogsRequest{MinLevel: "verbose"}, "secret", connect.CodeInvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := connect.NewRequest(tt.req)
+			if tt.apiKey != "" {
+				req.Header().Set("X-API-Key", tt.apiKey)
+			}
+			stream, err := tt.client.StreamLogs(context.Background(), req)
+			require.NoError(t, err)
+			defer func() { _ = stream.Close() }()
+
+			assert.False(t, stream.Receive())
+			assert.Equal(t, tt.code, connect.CodeOf(stream.Err()))
+		})
+	}
+}
@@ -18,18 +18,25 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
 
 	"connectrpc.com/connect"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 
 	domainportal "github.com/golgoth31/sreportal/internal/domain/portal"
 	portalv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
 	"github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1/sreportalv1connect"
+	"github.com/golgoth31/sreportal/internal/mainportal"
 )
 
 // PortalService implements the PortalServiceHandler interface
 type PortalService struct {
 	sreportalv1connect.UnimplementedPortalServiceHandler
-	reader domainportal.PortalReader
+	reader   domainportal.PortalReader
+	promoter *mainportal.Service
 }
 
 // NewPortalService creates a new PortalService
@@ -37,6 +44,12 @@ func NewPortalService(reader domainportal.PortalReader) *PortalService {
 	return &PortalService{reader: reader}
 }
 
+// SetMainPromoter enables PromoteToMain. Without it the RPC returns
+// CodeUnimplemented.
+func (s *PortalService) SetMainPromoter(p *mainportal.Service) {
+	s.promoter = p
+}
+
 // ListPortals returns all available portals
 func (s *PortalService) ListPortals(
 	ctx context.Context,
@@ -61,6 +74,85 @@ func (s *PortalService) ListPortals(
 	}), nil
 }
 
+// GetPortalContent returns the markdown content blocks of a portal
+func (s *PortalService) GetPortalContent(
+	ctx context.Context,
+	req *connect.Request[portalv1.GetPortalContentRequest],
+) (*connect.Response[portalv1.GetPortalContentResponse], error) {
+	if req.Msg.Portal == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("portal is required"))
+	}
+
+	views, err := s.reader.List(ctx, domainportal.PortalFilters{})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	idx := slices.IndexFunc(views, func(v domainportal.PortalView) bool { return v.Name == req.Msg.Portal })
+	if idx < 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("portal %q not found", req.Msg.Portal))
+	}
+
+	blocks := make([]*portalv1.ContentBlock, 0, len(views[idx].Content))
+	for _, b := range views[idx].Content {
+		blocks = append(blocks, &portalv1.ContentBlock{
+			ConfigMap: b.ConfigMap,
+			Key:       b.Key,
+			Markdown:  b.Markdown,
+		})
+	}
+
+	return connect.NewResponse(&portalv1.GetPortalContentResponse{Blocks: blocks}), nil
+}
+
+// PromoteToMain makes a local portal the main portal.
+func (s *PortalService) PromoteToMain(
+	ctx context.Context,
+	req *connect.Request[portalv1.PromoteToMainRequest],
+) (*connect.Response[portalv1.PromoteToMainResponse], error) {
+	if s.promoter == nil {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("main portal promotion is not enabled"))
+	}
+	if req.Msg.Portal == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("portal is required"))
+	}
+
+	namespace := req.Msg.Namespace
+	if namespace == "" {
+		views, err := s.reader.List(ctx, domainportal.PortalFilters{})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		var matches []string
+		for _, v := range views {
+			if v.Name == req.Msg.Portal {
+				matches = append(matches, v.Namespace)
+			}
+		}
+		switch len(matches) {
+		case 0:
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("portal %q not found", req.Msg.Portal))
+		case 1:
+			namespace = matches[0]
+		default:
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("portal %q exists in namespaces %v: namespace is required", req.Msg.Portal, matches))
+		}
+	}
+
+	demoted, err := s.promoter.Promote(ctx, types.NamespacedName{Namespace: namespace, Name: req.Msg.Portal})
+	switch {
+	case errors.Is(err, mainportal.ErrNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, mainportal.ErrRemotePortal), apierrors.IsForbidden(err), apierrors.IsInvalid(err):
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	case err != nil:
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&portalv1.PromoteToMainResponse{Demoted: demoted}), nil
+}
+
 func portalViewToProto(v domainportal.PortalView) *portalv1.Portal {
 	subPath := v.SubPath
 	if subPath == "" {
@@ -96,5 +188,12 @@ func portalViewToProto(v domainportal.PortalView) *portalv1.Portal {
 		ImageInventory: v.Features.ImageInventory,
 	}
 
+	for _, l := range v.Links {
+		portal.Links = append(portal.Links, &portalv1.PortalLink{Title: l.Title, Url: l.URL})
+	}
+	if v.Branding != nil {
+		portal.Branding = &portalv1.PortalBranding{LogoUrl: v.Branding.LogoURL, Color: v.Branding.Color}
+	}
+
 	return portal
 }
@@ -0,0 +1,114 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc_test
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	domainportal "github.com/golgoth31/sreportal/internal/domain/portal"
+	svcgrpc "github.com/golgoth31/sreportal/internal/grpc"
+	portalv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	"github.com/golgoth31/sreportal/internal/mainportal"
+	portalstore "github.com/golgoth31/sreportal/internal/readstore/portal"
+)
+
+func TestGetPortalContent(t *testing.T) {
+	ctx := context.Background()
+	store := portalstore.NewPortalStore()
+	require.NoError(t, store.Replace(ctx, "default/"+tPortalMyPortal, domainportal.PortalView{
+		Name:      tPortalMyPortal,
+		Namespace: "default",
+		Content: []domainportal.ContentBlock{
+			{ConfigMap: "docs", Key: "onboarding.md", Markdown: "# Welcome"},
+		},
+	}))
+	svc := svcgrpc.NewPortalService(store)
+
+	t.Run("returns the blocks of the portal", func(t *testing.T) {
+		resp, err := svc.GetPortalContent(ctx, connect.NewRequest(&portalv1.GetPortalContentRequest{Portal: tPortalMyPortal}))
+		require.NoError(t, err)
+		require.Len(t, resp.Msg.Blocks, 1)
+		assert.Equal(t, "docs", resp.Msg.Blocks[0].ConfigMap)
+		assert.Equal(t, "onboarding.md", resp.Msg.Blocks[0].Key)
+		assert.Equal(t, "# Welcome", resp.Msg.Blocks[0].Markdown)
+	})
+
+	t.Run("unknown portal returns NotFound", func(t *testing.T) {
+		_, err := svc.GetPortalContent(ctx, connect.NewRequest(&portalv1.GetPortalContentRequest{Portal: "unknown"}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	t.Run("empty portal returns InvalidArgument", func(t *testing.T) {
+		_, err := svc.GetPortalContent(ctx, connect.NewRequest(&portalv1.GetPortalContentRequest{}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+}
+
+func TestPromoteToMain(t *testing.T) {
+	ctx := context.Background()
+	store := portalstore.NewPortalStore()
+	for _, ns := range []string{"default", "team"} {
+		require.NoError(t, store.Replace(ctx, ns+"/"+tPortalMyPortal, domainportal.PortalView{Name: tPortalMyPortal, Namespace: ns}))
+	}
+	require.NoError(t, store.Replace(ctx, "default/main", domainportal.PortalView{Name: "main", Namespace: "default", Main: true}))
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&sreportalv1alpha1.Portal{ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default"}, Spec: sreportalv1alpha1.PortalSpec{Main: true}},
+		&sreportalv1alpha1.Portal{ObjectMeta: metav1.ObjectMeta{Name: tPortalMyPortal, Namespace: "default"}},
+		&sreportalv1alpha1.Portal{ObjectMeta: metav1.ObjectMeta{Name: tPortalMyPortal, Namespace: "team"}},
+	).Build()
+	svc := svcgrpc.NewPortalService(store)
+
+	t.Run("without promoter returns Unimplemented", func(t *testing.T) {
+		_, err := svc.PromoteToMain(ctx, connect.NewRequest(&portalv1.PromoteToMainRequest{Portal: tPortalMyPortal}))
+		assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+	})
+
+	svc.SetMainPromoter(mainportal.NewService(c))
+
+	t.Run("ambiguous name requires a namespace", func(t *testing.T) {
+		_, err := svc.PromoteToMain(ctx, connect.NewRequest(&portalv1.PromoteToMainRequest{Portal: tPortalMyPortal}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	t.Run("unknown portal returns NotFound", func(t *testing.T) {
+		_, err := svc.PromoteToMain(ctx, connect.NewRequest(&portalv1.PromoteToMainRequest{Portal: "unknown"}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	t.Run("promotes the portal and demotes the previous main", func(t *testing.T) {
+		resp, err := svc.PromoteToMain(ctx, connect.NewRequest(&portalv1.PromoteToMainRequest{Portal: tPortalMyPortal, Namespace: "team"}))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"default/main"}, resp.Msg.Demoted)
+
+		var got sreportalv1alpha1.Portal
+		require.NoError(t, c.Get(ctx, types.NamespacedName{Namespace: "team", Name: tPortalMyPortal}, &got))
+		assert.True(t, got.Spec.Main)
+	})
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+type responseControllerKey struct{}
+
+// WithSendDeadlines exposes the response writer of each request to the
+// streaming handlers of h, which need it to bound how long a send to a
+// subscriber that stopped reading may block.
+func WithSendDeadlines(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), responseControllerKey{}, http.NewResponseController(w))
+		h.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// sendDeadline bounds each send of a stream to timeout. Without
+// WithSendDeadlines, or when timeout is zero, it is nil and sends block for
+// as long as the transport lets them.
+type sendDeadline struct {
+	rc       *http.ResponseController
+	timeout  time.Duration
+	deadline time.Time
+}
+
+func newSendDeadline(ctx context.Context, timeout time.Duration) *sendDeadline {
+	rc, _ := ctx.Value(responseControllerKey{}).(*http.ResponseController)
+	if rc == nil || timeout <= 0 {
+		return nil
+	}
+	return &sendDeadline{rc: rc, timeout: timeout}
+}
+
+// arm starts the timeout of the next send.
+func (d *sendDeadline) arm() {
+	if d == nil || d.rc == nil {
+		return
+	}
+	d.deadline = time.Now().Add(d.timeout)
+	if err := d.rc.SetWriteDeadline(d.deadline); errors.Is(err, http.ErrNotSupported) {
+		d.rc, d.deadline = nil, time.Time{}
+	}
+}
+
+// disarm lifts the deadline while the stream waits for the next update.
+func (d *sendDeadline) disarm() {
+	if d == nil || d.deadline.IsZero() {
+		return
+	}
+	d.deadline = time.Time{}
+	_ = d.rc.SetWriteDeadline(time.Time{})
+}
+
+// expired reports whether err was caused by the deadline.
+func (d *sendDeadline) expired(err error) bool {
+	return d != nil && err != nil && !d.deadline.IsZero() && !time.Now().Before(d.deadline)
+}
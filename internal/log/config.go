@@ -95,6 +95,8 @@ type Config struct {
 	// StacktraceLevel sets the level at which stack traces are recorded (optional).
 	// When nil, behaviour is driven by DevMode: stack at Error in dev, disabled otherwise.
 	StacktraceLevel *Level
+	// Tap captures the records logged, for streaming them (optional).
+	Tap *Tap
 }
 
 // BindFlags registers --log-level and --log-format on the given flag set.
@@ -146,9 +148,12 @@ func Init(cfg Config) error {
 	zapLogger := zap.New(core, opts...)
 
 	// Wire into slog.Default via zapslog (same threshold: stack only in dev or when explicit).
-	slogHandler := zapslog.NewHandler(zapLogger.Core(),
+	var slogHandler slog.Handler = zapslog.NewHandler(zapLogger.Core(),
 		zapslog.AddStacktraceAt(slog.Level(stackLevel)),
 	)
+	if cfg.Tap != nil {
+		slogHandler = cfg.Tap.Handler(slogHandler)
+	}
 	slog.SetDefault(slog.New(slogHandler))
 
 	return nil
@@ -0,0 +1,210 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+// loggerKey is the attribute logr adds with the name of the logger.
+const loggerKey = "logger"
+
+// subscriberBuffer is the number of entries buffered per subscriber; entries
+// a slow subscriber cannot keep up with are dropped.
+const subscriberBuffer = 256
+
+// Entry is one log record captured by a Tap.
+type Entry struct {
+	Time  time.Time
+	Level Level
+	// Logger is the "/"-separated name of the logger: the logr name followed
+	// by the names added with WithName.
+	Logger  string
+	Message string
+	// Attrs are the attributes of the record, formatted as strings. Nested
+	// groups are flattened to dotted keys.
+	Attrs map[string]string
+}
+
+// Tap keeps the most recent log records in a ring buffer and fans out new
+// records to subscribers. Install it with Config.Tap: it sees the records
+// enabled by the configured level only.
+type Tap struct {
+	mu      sync.Mutex
+	entries []Entry
+	next    int
+	full    bool
+	subs    map[chan Entry]struct{}
+}
+
+// NewTap creates a Tap keeping the last size records.
+func NewTap(size int) *Tap {
+	return &Tap{entries: make([]Entry, size), subs: map[chan Entry]struct{}{}}
+}
+
+// Subscribe returns the records kept, oldest first, and a channel receiving
+// the records logged from then on. Call cancel to stop receiving.
+func (t *Tap) Subscribe() (backlog []Entry, entries <-chan Entry, cancel func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.full {
+		backlog = append(backlog, t.entries[t.next:]...)
+	}
+	backlog = append(backlog, t.entries[:t.next]...)
+
+	ch := make(chan Entry, subscriberBuffer)
+	t.subs[ch] = struct{}{}
+	var once sync.Once
+	return backlog, ch, func() {
+		once.Do(func() {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			delete(t.subs, ch)
+		})
+	}
+}
+
+func (t *Tap) publish(e Entry) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.entries) > 0 {
+		t.entries[t.next] = e
+		t.next = (t.next + 1) % len(t.entries)
+		if t.next == 0 {
+			t.full = true
+		}
+	}
+	for ch := range t.subs {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
+
+// Handler returns a slog.Handler passing the records to next and capturing
+// the records next enables.
+func (t *Tap) Handler(next slog.Handler) slog.Handler {
+	return &tapHandler{next: next, tap: t}
+}
+
+// tapHandler captures records for a Tap. Groups are logger names (see
+// Logger.WithName), so they extend the logger name instead of prefixing keys.
+type tapHandler struct {
+	next  slog.Handler
+	tap   *Tap
+	names []string
+	attrs map[string]string
+}
+
+func (h *tapHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *tapHandler) Handle(ctx context.Context, r slog.Record) error {
+	attrs := make(map[string]string, len(h.attrs)+r.NumAttrs())
+	for k, v := range h.attrs {
+		attrs[k] = v
+	}
+	r.Attrs(func(a slog.Attr) bool {
+		addAttr(attrs, "", a)
+		return true
+	})
+	names := h.names
+	if name, ok := attrs[loggerKey]; ok {
+		names = append([]string{name}, names...)
+		delete(attrs, loggerKey)
+	}
+	h.tap.publish(Entry{
+		Time:    r.Time,
+		Level:   levelOf(r.Level),
+		Logger:  strings.Join(names, "/"),
+		Message: r.Message,
+		Attrs:   attrs,
+	})
+	return h.next.Handle(ctx, r)
+}
+
+func (h *tapHandler) WithAttrs(as []slog.Attr) slog.Handler {
+	attrs := make(map[string]string, len(h.attrs)+len(as))
+	for k, v := range h.attrs {
+		attrs[k] = v
+	}
+	for _, a := range as {
+		addAttr(attrs, "", a)
+	}
+	return &tapHandler{next: h.next.WithAttrs(as), tap: h.tap, names: h.names, attrs: attrs}
+}
+
+func (h *tapHandler) WithGroup(name string) slog.Handler {
+	names := append(append([]string(nil), h.names...), name)
+	return &tapHandler{next: h.next.WithGroup(name), tap: h.tap, names: names, attrs: h.attrs}
+}
+
+// addAttr formats a into attrs, flattening groups to dotted keys.
+func addAttr(attrs map[string]string, prefix string, a slog.Attr) {
+	v := a.Value.Resolve()
+	if v.Kind() == slog.KindGroup {
+		if a.Key != "" {
+			prefix += a.Key + "."
+		}
+		for _, ga := range v.Group() {
+			addAttr(attrs, prefix, ga)
+		}
+		return
+	}
+	if a.Key == "" {
+		return
+	}
+	attrs[prefix+a.Key] = v.String()
+}
+
+// levelOf maps a slog level to the closest Level at or below it.
+func levelOf(l slog.Level) Level {
+	switch {
+	case l >= slog.LevelError:
+		return LevelErrorValue
+	case l >= slog.LevelWarn:
+		return LevelWarnValue
+	case l >= slog.LevelInfo:
+		return LevelInfoValue
+	case l >= slog.LevelDebug:
+		return LevelDebugValue
+	default:
+		return LevelTraceValue
+	}
+}
+
+// SlogLevel returns the lowest slog level of l.
+func SlogLevel(l Level) slog.Level {
+	switch l {
+	case LevelTraceValue:
+		return LevelTrace
+	case LevelDebugValue:
+		return slog.LevelDebug
+	case LevelWarnValue:
+		return slog.LevelWarn
+	case LevelErrorValue:
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
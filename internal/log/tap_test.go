@@ -0,0 +1,99 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log_test
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	applog "github.com/golgoth31/sreportal/internal/log"
+)
+
+func newTappedLogger(t *testing.T, tap *applog.Tap) (*applog.Logger, *bytes.Buffer) {
+	t.Helper()
+	var buf bytes.Buffer
+	handler := slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})
+	return applog.FromSlog(slog.New(tap.Handler(handler))), &buf
+}
+
+func TestTap_CapturesEnabledRecords(t *testing.T) {
+	// Arrange
+	tap := applog.NewTap(10)
+	logger, buf := newTappedLogger(t, tap)
+
+	// Act
+	logger.WithName("agent-ingester").With("portal", "main").
+		Error(errors.New("boom"), "failed", "agent", "edge-1")
+	logger.Trace("below the handler level")
+
+	// Assert — the record still reaches the wrapped handler
+	assert.Contains(t, buf.String(), "failed")
+	backlog, _, cancel := tap.Subscribe()
+	defer cancel()
+	require.Len(t, backlog, 1)
+	e := backlog[0]
+	assert.Equal(t, applog.LevelErrorValue, e.Level)
+	assert.Equal(t, "agent-ingester", e.Logger)
+	assert.Equal(t, "failed", e.Message)
+	assert.Equal(t, map[string]string{"portal": "main", "err": "boom", "agent": "edge-1"}, e.Attrs)
+}
+
+func TestTap_MergesLogrNameIntoLogger(t *testing.T) {
+	// Arrange — controller-runtime names loggers through logr
+	tap := applog.NewTap(10)
+	logger, _ := newTappedLogger(t, tap)
+	logrLogger := logr.FromSlogHandler(logger.Handler()).WithName("dns").WithValues("controller", "dns")
+
+	// Act
+	logrLogger.V(1).Info("reconciling")
+
+	// Assert
+	backlog, _, cancel := tap.Subscribe()
+	defer cancel()
+	require.Len(t, backlog, 1)
+	assert.Equal(t, applog.LevelDebugValue, backlog[0].Level)
+	assert.Equal(t, "dns", backlog[0].Logger)
+	assert.Equal(t, map[string]string{"controller": "dns"}, backlog[0].Attrs)
+}
+
+func TestTap_KeepsLastRecordsAndStreamsNewOnes(t *testing.T) {
+	// Arrange
+	tap := applog.NewTap(2)
+	logger, _ := newTappedLogger(t, tap)
+	logger.Info("one")
+	logger.Info("two")
+	logger.Info("three")
+
+	// Act
+	backlog, entries, cancel := tap.Subscribe()
+	logger.Info("four")
+	cancel()
+	logger.Info("five")
+
+	// Assert
+	require.Len(t, backlog, 2)
+	assert.Equal(t, "two", backlog[0].Message)
+	assert.Equal(t, "three", backlog[1].Message)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "four", (<-entries).Message)
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mainportal elects the main portal among the Portal CRs and promotes
+// a portal to main, clearing spec.main on the previous one.
+package mainportal
+
+import (
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+)
+
+// Elect returns the main portal among portals, or nil when none is local.
+//
+// Local portals with spec.main win over the others; ties, including the
+// fallback when no portal has spec.main, go to the oldest creationTimestamp
+// and then to the lowest namespace/name. The result therefore does not depend
+// on the list order and stays the same across restarts.
+func Elect(portals []*sreportalv1alpha1.Portal) *sreportalv1alpha1.Portal {
+	var elected *sreportalv1alpha1.Portal
+	for _, p := range portals {
+		if p == nil || p.Spec.Remote != nil {
+			continue
+		}
+		if elected == nil || before(p, elected) {
+			elected = p
+		}
+	}
+	return elected
+}
+
+// before reports whether a is elected over b.
+func before(a, b *sreportalv1alpha1.Portal) bool {
+	if a.Spec.Main != b.Spec.Main {
+		return a.Spec.Main
+	}
+	at, bt := a.CreationTimestamp.Time, b.CreationTimestamp.Time
+	if !at.Equal(bt) {
+		return at.Before(bt)
+	}
+	if a.Namespace != b.Namespace {
+		return a.Namespace < b.Namespace
+	}
+	return a.Name < b.Name
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mainportal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/mainportal"
+)
+
+var created = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func portal(name string, age time.Duration, main bool) *sreportalv1alpha1.Portal {
+	return &sreportalv1alpha1.Portal{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         tNamespace,
+			CreationTimestamp: metav1.NewTime(created.Add(-age)),
+		},
+		Spec: sreportalv1alpha1.PortalSpec{Title: name, Main: main},
+	}
+}
+
+func TestElect(t *testing.T) {
+	remote := portal("remote", 3*time.Hour, false)
+	remote.Spec.Remote = &sreportalv1alpha1.RemotePortalSpec{URL: "https://remote.example.com"}
+
+	tests := []struct {
+		name    string
+		portals []*sreportalv1alpha1.Portal
+		want    string
+	}{
+		{name: "none", portals: nil, want: ""},
+		{name: "only remote", portals: []*sreportalv1alpha1.Portal{remote}, want: ""},
+		{
+			name:    "main wins over older portals",
+			portals: []*sreportalv1alpha1.Portal{portal("old", 2*time.Hour, false), portal("main", time.Hour, true)},
+			want:    "main",
+		},
+		{
+			name:    "oldest of several mains",
+			portals: []*sreportalv1alpha1.Portal{portal("young", time.Hour, true), portal("old", 2*time.Hour, true)},
+			want:    "old",
+		},
+		{
+			name:    "oldest local portal without main",
+			portals: []*sreportalv1alpha1.Portal{remote, portal("young", time.Hour, false), portal("old", 2*time.Hour, false)},
+			want:    "old",
+		},
+		{
+			name:    "name breaks creation ties",
+			portals: []*sreportalv1alpha1.Portal{portal("b", time.Hour, false), portal("a", time.Hour, false)},
+			want:    "a",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mainportal.Elect(tt.portals)
+			if tt.want == "" {
+				assert.Nil(t, got)
+				return
+			}
+			if assert.NotNil(t, got) {
+				assert.Equal(t, tt.want, got.Name)
+			}
+		})
+	}
+}
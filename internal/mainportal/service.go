@@ -0,0 +1,135 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mainportal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/log"
+)
+
+// PromoteAnnotationKey, set to "true" on a Portal, asks the portal controller
+// to promote it to main. The annotation is removed by the promotion.
+const PromoteAnnotationKey = "sreportal.io/promote-to-main"
+
+const maxRetries = 5
+
+var (
+	ErrNotFound     = errors.New("portal not found")
+	ErrRemotePortal = errors.New("a remote portal cannot be the main portal")
+)
+
+// +kubebuilder:rbac:groups=sreportal.io,resources=portals,verbs=get;list;patch
+
+// Service promotes portals to main via the K8s API.
+type Service struct {
+	client client.Client
+}
+
+// NewService creates a new main portal promotion Service.
+func NewService(c client.Client) *Service {
+	return &Service{client: c}
+}
+
+// Promote makes the portal key the only main portal and returns the
+// namespace/name of the portals it demoted.
+//
+// Kubernetes cannot update several objects at once, so spec.main is first
+// cleared on the other portals and then set on key: two portals are never
+// main at the same time, and in between Elect falls back deterministically.
+// When key cannot be promoted, the demoted portals are restored.
+func (s *Service) Promote(ctx context.Context, key types.NamespacedName) ([]string, error) {
+	var target sreportalv1alpha1.Portal
+	if err := s.client.Get(ctx, key, &target); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, key)
+		}
+		return nil, fmt.Errorf("get portal %s: %w", key, err)
+	}
+	if target.Spec.Remote != nil {
+		return nil, ErrRemotePortal
+	}
+
+	var list sreportalv1alpha1.PortalList
+	if err := s.client.List(ctx, &list); err != nil {
+		return nil, fmt.Errorf("list portals: %w", err)
+	}
+
+	var demoted []*sreportalv1alpha1.Portal
+	for i := range list.Items {
+		p := &list.Items[i]
+		if !p.Spec.Main || client.ObjectKeyFromObject(p) == key {
+			continue
+		}
+		if err := s.setMain(ctx, p, false); err != nil {
+			s.restore(ctx, demoted)
+			return nil, fmt.Errorf("demote portal %s/%s: %w", p.Namespace, p.Name, err)
+		}
+		demoted = append(demoted, p)
+	}
+
+	if err := s.setMain(ctx, &target, true); err != nil {
+		s.restore(ctx, demoted)
+		return nil, fmt.Errorf("promote portal %s: %w", key, err)
+	}
+
+	names := make([]string, 0, len(demoted))
+	for _, p := range demoted {
+		names = append(names, p.Namespace+"/"+p.Name)
+	}
+	return names, nil
+}
+
+// setMain patches spec.main of p to main and removes PromoteAnnotationKey,
+// retrying on conflict. p is updated in place.
+func (s *Service) setMain(ctx context.Context, p *sreportalv1alpha1.Portal, main bool) error {
+	for attempt := range maxRetries {
+		if _, annotated := p.Annotations[PromoteAnnotationKey]; p.Spec.Main == main && !annotated {
+			return nil
+		}
+		base := p.DeepCopy()
+		p.Spec.Main = main
+		delete(p.Annotations, PromoteAnnotationKey)
+		err := s.client.Patch(ctx, p, client.MergeFromWithOptions(base, client.MergeFromWithOptimisticLock{}))
+		if err == nil {
+			return nil
+		}
+		if !apierrors.IsConflict(err) || attempt == maxRetries-1 {
+			return err
+		}
+		if err := s.client.Get(ctx, client.ObjectKeyFromObject(p), p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restore sets spec.main back on the demoted portals, best effort.
+func (s *Service) restore(ctx context.Context, demoted []*sreportalv1alpha1.Portal) {
+	for _, p := range demoted {
+		if err := s.setMain(ctx, p, true); err != nil {
+			log.FromContext(ctx).Error(err, "failed to restore main portal", "namespace", p.Namespace, "name", p.Name)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mainportal_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/mainportal"
+)
+
+const tNamespace = "sreportal-system"
+
+func newClient(t *testing.T, funcs interceptor.Funcs, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, sreportalv1alpha1.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).WithInterceptorFuncs(funcs).Build()
+}
+
+func isMain(t *testing.T, c client.Client, name string) bool {
+	t.Helper()
+	var p sreportalv1alpha1.Portal
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: tNamespace, Name: name}, &p))
+	return p.Spec.Main
+}
+
+func TestPromote_FlipsMain(t *testing.T) {
+	target := portal("team", time.Hour, false)
+	target.Annotations = map[string]string{mainportal.PromoteAnnotationKey: "true"}
+	c := newClient(t, interceptor.Funcs{}, portal("main", 2*time.Hour, true), target)
+
+	demoted, err := mainportal.NewService(c).Promote(context.Background(), types.NamespacedName{Namespace: tNamespace, Name: "team"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{tNamespace + "/main"}, demoted)
+	assert.True(t, isMain(t, c, "team"))
+	assert.False(t, isMain(t, c, "main"))
+
+	var got sreportalv1alpha1.Portal
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Namespace: tNamespace, Name: "team"}, &got))
+	assert.NotContains(t, got.Annotations, mainportal.PromoteAnnotationKey)
+
+	// Promoting the main portal again is a no-op.
+	demoted, err = mainportal.NewService(c).Promote(context.Background(), types.NamespacedName{Namespace: tNamespace, Name: "team"})
+	require.NoError(t, err)
+	assert.Empty(t, demoted)
+}
+
+func TestPromote_Rejects(t *testing.T) {
+	remote := portal("remote", time.Hour, false)
+	remote.Spec.Remote = &sreportalv1alpha1.RemotePortalSpec{URL: "https://remote.example.com"}
+	svc := mainportal.NewService(newClient(t, interceptor.Funcs{}, remote))
+
+	_, err := svc.Promote(context.Background(), types.NamespacedName{Namespace: tNamespace, Name: "remote"})
+	assert.ErrorIs(t, err, mainportal.ErrRemotePortal)
+
+	_, err = svc.Promote(context.Background(), types.NamespacedName{Namespace: tNamespace, Name: "missing"})
+	assert.ErrorIs(t, err, mainportal.ErrNotFound)
+}
+
+func TestPromote_RestoresPreviousMainOnFailure(t *testing.T) {
+	c := newClient(t, interceptor.Funcs{
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			if obj.GetName() == "team" {
+				return errors.New("admission denied")
+			}
+			return c.Patch(ctx, obj, patch, opts...)
+		},
+	}, portal("main", 2*time.Hour, true), portal("team", time.Hour, false))
+
+	_, err := mainportal.NewService(c).Promote(context.Background(), types.NamespacedName{Namespace: tNamespace, Name: "team"})
+	require.Error(t, err)
+	assert.True(t, isMain(t, c, "main"))
+	assert.False(t, isMain(t, c, "team"))
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package manualdns provides the write path for manual DNS entries: batches
+// of edits applied to the spec.entries of a manual DNSRecord in a single
+// update, with optimistic concurrency.
+package manualdns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
+)
+
+const (
+	maxRetries = 5
+
+	// recordNameSuffix is appended to the portal name to name the manual
+	// DNSRecord when the batch does not name one.
+	recordNameSuffix = "-manual"
+)
+
+var (
+	ErrPortalRequired     = errors.New("portal is required")
+	ErrNoOperations       = errors.New("at least one operation is required")
+	ErrInvalidOperation   = errors.New("invalid operation")
+	ErrFQDNRequired       = errors.New("fqdn is required")
+	ErrEntryExists        = errors.New("entry already exists")
+	ErrEntryNotFound      = errors.New("entry not found")
+	ErrNotManual          = errors.New("DNSRecord is not a manual record of the portal")
+	ErrConflict           = errors.New("DNSRecord changed since the given resource version")
+	ErrMaxRetriesExceeded = errors.New("max retries exceeded")
+)
+
+// OperationType is the kind of edit of an Operation.
+type OperationType int
+
+const (
+	// OperationAdd adds a new entry.
+	OperationAdd OperationType = iota + 1
+	// OperationUpdate replaces an existing entry.
+	OperationUpdate
+	// OperationDelete removes an existing entry.
+	OperationDelete
+	// OperationSetDescription sets the description of an existing entry,
+	// leaving its targets and groups untouched.
+	OperationSetDescription
+)
+
+// Operation is one edit of a batch. Entries are identified by FQDN
+// (case-insensitive) and record type ("A" when empty).
+type Operation struct {
+	Type  OperationType
+	Entry v1alpha2.DNSRecordEntry
+}
+
+// BatchInput holds a batch of edits to the manual entries of a portal.
+type BatchInput struct {
+	// Namespace is the portal namespace, where the DNSRecord lives.
+	Namespace string
+	// Portal is the portal the entries belong to.
+	Portal string
+	// Record names the manual DNSRecord; empty means "<portal>-manual".
+	Record string
+	// ResourceVersion, when set, is the DNSRecord version the edits were
+	// made against: the batch fails with ErrConflict if it changed since.
+	ResourceVersion string
+	// Operations are applied in order.
+	Operations []Operation
+}
+
+// BatchResult describes the DNSRecord after a batch.
+type BatchResult struct {
+	Record          string
+	ResourceVersion string
+	EntryCount      int
+}
+
+// Service applies manual entry edits via the K8s API (write path only).
+type Service struct {
+	client client.Client
+}
+
+// NewService creates a new manual DNS entries write Service.
+func NewService(c client.Client) *Service {
+	return &Service{client: c}
+}
+
+// BatchUpdate applies every operation of the batch to the entries of the
+// manual DNSRecord in a single create or update: either all operations
+// succeed or the DNSRecord is left untouched. Without a ResourceVersion,
+// the batch is re-applied to the latest version on write conflicts.
+func (s *Service) BatchUpdate(ctx context.Context, in BatchInput) (BatchResult, error) {
+	if in.Portal == "" {
+		return BatchResult{}, ErrPortalRequired
+	}
+	if len(in.Operations) == 0 {
+		return BatchResult{}, ErrNoOperations
+	}
+	name := in.Record
+	if name == "" {
+		name = in.Portal + recordNameSuffix
+	}
+	nn := types.NamespacedName{Name: name, Namespace: in.Namespace}
+
+	for attempt := range maxRetries {
+		var record v1alpha2.DNSRecord
+		exists := true
+		if err := s.client.Get(ctx, nn, &record); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return BatchResult{}, fmt.Errorf("get DNSRecord: %w", err)
+			}
+			if in.ResourceVersion != "" {
+				return BatchResult{}, fmt.Errorf("DNSRecord %q: %w", name, ErrConflict)
+			}
+			exists = false
+		}
+		if exists {
+			if record.Spec.Origin != v1alpha2.DNSRecordOriginManual || record.Spec.PortalRef != in.Portal {
+				return BatchResult{}, fmt.Errorf("DNSRecord %q: %w", name, ErrNotManual)
+			}
+			if in.ResourceVersion != "" && record.ResourceVersion != in.ResourceVersion {
+				return BatchResult{}, fmt.Errorf("DNSRecord %q: %w", name, ErrConflict)
+			}
+		}
+
+		entries, err := apply(record.Spec.Entries, in.Operations)
+		if err != nil {
+			return BatchResult{}, err
+		}
+
+		if !exists {
+			record = v1alpha2.DNSRecord{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: in.Namespace},
+				Spec: v1alpha2.DNSRecordSpec{
+					Origin:    v1alpha2.DNSRecordOriginManual,
+					PortalRef: in.Portal,
+					Entries:   entries,
+				},
+			}
+			adapter.SetStandardLabels(&record, in.Portal)
+			if err := s.client.Create(ctx, &record); err != nil {
+				if apierrors.IsAlreadyExists(err) && attempt < maxRetries-1 {
+					continue
+				}
+				return BatchResult{}, fmt.Errorf("create DNSRecord: %w", err)
+			}
+		} else {
+			record.Spec.Entries = entries
+			if err := s.client.Update(ctx, &record); err != nil {
+				if apierrors.IsConflict(err) {
+					if in.ResourceVersion != "" {
+						return BatchResult{}, fmt.Errorf("DNSRecord %q: %w", name, ErrConflict)
+					}
+					if attempt < maxRetries-1 {
+						continue
+					}
+				}
+				return BatchResult{}, fmt.Errorf("update DNSRecord: %w", err)
+			}
+		}
+		return BatchResult{Record: name, ResourceVersion: record.ResourceVersion, EntryCount: len(entries)}, nil
+	}
+
+	return BatchResult{}, fmt.Errorf("update DNSRecord %q: %w", name, ErrMaxRetriesExceeded)
+}
+
+// apply returns a copy of entries with the operations applied in order. The
+// first failing operation aborts the batch.
+func apply(entries []v1alpha2.DNSRecordEntry, ops []Operation) ([]v1alpha2.DNSRecordEntry, error) {
+	out := slices.Clone(entries)
+	for i, op := range ops {
+		if op.Entry.FQDN == "" {
+			return nil, fmt.Errorf("operation %d: %w", i, ErrFQDNRequired)
+		}
+		idx := slices.IndexFunc(out, func(e v1alpha2.DNSRecordEntry) bool { return sameEntry(e, op.Entry) })
+		switch op.Type {
+		case OperationAdd:
+			if idx >= 0 {
+				return nil, fmt.Errorf("operation %d: %s %s: %w", i, op.Entry.FQDN, recordType(op.Entry), ErrEntryExists)
+			}
+			out = append(out, op.Entry)
+		case OperationUpdate:
+			if idx < 0 {
+				return nil, fmt.Errorf("operation %d: %s %s: %w", i, op.Entry.FQDN, recordType(op.Entry), ErrEntryNotFound)
+			}
+			out[idx] = op.Entry
+		case OperationDelete:
+			if idx < 0 {
+				return nil, fmt.Errorf("operation %d: %s %s: %w", i, op.Entry.FQDN, recordType(op.Entry), ErrEntryNotFound)
+			}
+			out = slices.Delete(out, idx, idx+1)
+		case OperationSetDescription:
+			if idx < 0 {
+				return nil, fmt.Errorf("operation %d: %s %s: %w", i, op.Entry.FQDN, recordType(op.Entry), ErrEntryNotFound)
+			}
+			out[idx].Description = op.Entry.Description
+		default:
+			return nil, fmt.Errorf("operation %d: %w", i, ErrInvalidOperation)
+		}
+	}
+	return out, nil
+}
+
+// sameEntry reports whether a and b address the same FQDN and record type.
+func sameEntry(a, b v1alpha2.DNSRecordEntry) bool {
+	return strings.EqualFold(strings.TrimSuffix(a.FQDN, "."), strings.TrimSuffix(b.FQDN, ".")) &&
+		recordType(a) == recordType(b)
+}
+
+func recordType(e v1alpha2.DNSRecordEntry) string {
+	if e.RecordType == "" {
+		return "A"
+	}
+	return strings.ToUpper(e.RecordType)
+}
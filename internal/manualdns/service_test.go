@@ -0,0 +1,230 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualdns_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
+	"github.com/golgoth31/sreportal/internal/manualdns"
+)
+
+const (
+	tNamespace = "default"
+	tPortal    = "main"
+	tRecord    = "main-manual"
+	tFQDNA     = "a.example.com"
+	tFQDNB     = "b.example.com"
+	tIP1       = "10.0.0.1"
+	tIP2       = "10.0.0.2"
+)
+
+func newClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha2.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func existingRecord(entries ...v1alpha2.DNSRecordEntry) *v1alpha2.DNSRecord {
+	return &v1alpha2.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{Name: tRecord, Namespace: tNamespace},
+		Spec: v1alpha2.DNSRecordSpec{
+			Origin:    v1alpha2.DNSRecordOriginManual,
+			PortalRef: tPortal,
+			Entries:   entries,
+		},
+	}
+}
+
+func getRecord(t *testing.T, c client.Client) v1alpha2.DNSRecord {
+	t.Helper()
+	var record v1alpha2.DNSRecord
+	require.NoError(t, c.Get(context.Background(), types.NamespacedName{Name: tRecord, Namespace: tNamespace}, &record))
+	return record
+}
+
+func TestBatchUpdate_CreatesRecordOnFirstAdd(t *testing.T) {
+	c := newClient(t)
+	svc := manualdns.NewService(c)
+
+	res, err := svc.BatchUpdate(context.Background(), manualdns.BatchInput{
+		Namespace: tNamespace,
+		Portal:    tPortal,
+		Operations: []manualdns.Operation{
+			{Type: manualdns.OperationAdd, Entry: v1alpha2.DNSRecordEntry{FQDN: tFQDNA, Targets: []string{tIP1}}},
+			{Type: manualdns.OperationAdd, Entry: v1alpha2.DNSRecordEntry{FQDN: tFQDNB, RecordType: "CNAME", Targets: []string{tFQDNA}}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, tRecord, res.Record)
+	assert.Equal(t, 2, res.EntryCount)
+	assert.NotEmpty(t, res.ResourceVersion)
+
+	record := getRecord(t, c)
+	assert.Equal(t, v1alpha2.DNSRecordOriginManual, record.Spec.Origin)
+	assert.Equal(t, tPortal, record.Spec.PortalRef)
+	assert.True(t, adapter.HasStandardLabels(&record, tPortal))
+	require.Len(t, record.Spec.Entries, 2)
+	assert.Equal(t, tFQDNA, record.Spec.Entries[0].FQDN)
+}
+
+func TestBatchUpdate_AppliesAllOperationsInOneWrite(t *testing.T) {
+	c := newClient(t, existingRecord(
+		v1alpha2.DNSRecordEntry{FQDN: tFQDNA, Targets: []string{tIP1}},
+		v1alpha2.DNSRecordEntry{FQDN: tFQDNB, Targets: []string{tIP1}},
+	))
+	svc := manualdns.NewService(c)
+	before := getRecord(t, c)
+
+	res, err := svc.BatchUpdate(context.Background(), manualdns.BatchInput{
+		Namespace:       tNamespace,
+		Portal:          tPortal,
+		ResourceVersion: before.ResourceVersion,
+		Operations: []manualdns.Operation{
+			{Type: manualdns.OperationUpdate, Entry: v1alpha2.DNSRecordEntry{FQDN: "A.example.com.", Targets: []string{tIP2}, Description: "moved"}},
+			{Type: manualdns.OperationDelete, Entry: v1alpha2.DNSRecordEntry{FQDN: tFQDNB}},
+			{Type: manualdns.OperationAdd, Entry: v1alpha2.DNSRecordEntry{FQDN: tFQDNB, RecordType: "AAAA", Targets: []string{"fd00::1"}}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, res.EntryCount)
+	assert.NotEqual(t, before.ResourceVersion, res.ResourceVersion)
+
+	record := getRecord(t, c)
+	require.Len(t, record.Spec.Entries, 2)
+	assert.Equal(t, []string{tIP2}, record.Spec.Entries[0].Targets)
+	assert.Equal(t, "moved", record.Spec.Entries[0].Description)
+	assert.Equal(t, "AAAA", record.Spec.Entries[1].RecordType)
+}
+
+func TestBatchUpdate_SetDescriptionKeepsTargets(t *testing.T) {
+	c := newClient(t, existingRecord(v1alpha2.DNSRecordEntry{FQDN: tFQDNA, Targets: []string{tIP1}, Groups: []string{"infra"}}))
+	svc := manualdns.NewService(c)
+
+	_, err := svc.BatchUpdate(context.Background(), manualdns.BatchInput{
+		Namespace: tNamespace,
+		Portal:    tPortal,
+		Operations: []manualdns.Operation{
+			{Type: manualdns.OperationSetDescription, Entry: v1alpha2.DNSRecordEntry{FQDN: tFQDNA, Description: "load balancer"}},
+		},
+	})
+	require.NoError(t, err)
+
+	record := getRecord(t, c)
+	require.Len(t, record.Spec.Entries, 1)
+	assert.Equal(t, "load balancer", record.Spec.Entries[0].Description)
+	assert.Equal(t, []string{tIP1}, record.Spec.Entries[0].Targets)
+	assert.Equal(t, []string{"infra"}, record.Spec.Entries[0].Groups)
+
+	_, err = svc.BatchUpdate(context.Background(), manualdns.BatchInput{
+		Namespace: tNamespace,
+		Portal:    tPortal,
+		Operations: []manualdns.Operation{
+			{Type: manualdns.OperationSetDescription, Entry: v1alpha2.DNSRecordEntry{FQDN: tFQDNB, Description: "missing"}},
+		},
+	})
+	require.ErrorIs(t, err, manualdns.ErrEntryNotFound)
+}
+
+func TestBatchUpdate_FailingOperationLeavesRecordUntouched(t *testing.T) {
+	c := newClient(t, existingRecord(v1alpha2.DNSRecordEntry{FQDN: tFQDNA, Targets: []string{tIP1}}))
+	svc := manualdns.NewService(c)
+	before := getRecord(t, c)
+
+	_, err := svc.BatchUpdate(context.Background(), manualdns.BatchInput{
+		Namespace: tNamespace,
+		Portal:    tPortal,
+		Operations: []manualdns.Operation{
+			{Type: manualdns.OperationAdd, Entry: v1alpha2.DNSRecordEntry{FQDN: tFQDNB, Targets: []string{tIP1}}},
+			{Type: manualdns.OperationDelete, Entry: v1alpha2.DNSRecordEntry{FQDN: "missing.example.com"}},
+		},
+	})
+	require.ErrorIs(t, err, manualdns.ErrEntryNotFound)
+
+	after := getRecord(t, c)
+	assert.Equal(t, before.ResourceVersion, after.ResourceVersion)
+	assert.Len(t, after.Spec.Entries, 1)
+}
+
+func TestBatchUpdate_RejectsDuplicateAdd(t *testing.T) {
+	c := newClient(t, existingRecord(v1alpha2.DNSRecordEntry{FQDN: tFQDNA, Targets: []string{tIP1}}))
+	svc := manualdns.NewService(c)
+
+	_, err := svc.BatchUpdate(context.Background(), manualdns.BatchInput{
+		Namespace:  tNamespace,
+		Portal:     tPortal,
+		Operations: []manualdns.Operation{{Type: manualdns.OperationAdd, Entry: v1alpha2.DNSRecordEntry{FQDN: tFQDNA, RecordType: "a"}}},
+	})
+	require.ErrorIs(t, err, manualdns.ErrEntryExists)
+}
+
+func TestBatchUpdate_StaleResourceVersionConflicts(t *testing.T) {
+	c := newClient(t, existingRecord(v1alpha2.DNSRecordEntry{FQDN: tFQDNA, Targets: []string{tIP1}}))
+	svc := manualdns.NewService(c)
+
+	_, err := svc.BatchUpdate(context.Background(), manualdns.BatchInput{
+		Namespace:       tNamespace,
+		Portal:          tPortal,
+		ResourceVersion: "1",
+		Operations:      []manualdns.Operation{{Type: manualdns.OperationDelete, Entry: v1alpha2.DNSRecordEntry{FQDN: tFQDNA}}},
+	})
+	require.ErrorIs(t, err, manualdns.ErrConflict)
+	assert.Len(t, getRecord(t, c).Spec.Entries, 1)
+}
+
+func TestBatchUpdate_RefusesAutoRecord(t *testing.T) {
+	record := existingRecord()
+	record.Spec.Origin = v1alpha2.DNSRecordOriginAuto
+	c := newClient(t, record)
+	svc := manualdns.NewService(c)
+
+	_, err := svc.BatchUpdate(context.Background(), manualdns.BatchInput{
+		Namespace:  tNamespace,
+		Portal:     tPortal,
+		Operations: []manualdns.Operation{{Type: manualdns.OperationAdd, Entry: v1alpha2.DNSRecordEntry{FQDN: tFQDNA}}},
+	})
+	require.ErrorIs(t, err, manualdns.ErrNotManual)
+}
+
+func TestBatchUpdate_ValidatesInput(t *testing.T) {
+	svc := manualdns.NewService(newClient(t))
+	ctx := context.Background()
+
+	_, err := svc.BatchUpdate(ctx, manualdns.BatchInput{Namespace: tNamespace})
+	require.ErrorIs(t, err, manualdns.ErrPortalRequired)
+
+	_, err = svc.BatchUpdate(ctx, manualdns.BatchInput{Namespace: tNamespace, Portal: tPortal})
+	require.ErrorIs(t, err, manualdns.ErrNoOperations)
+
+	_, err = svc.BatchUpdate(ctx, manualdns.BatchInput{
+		Namespace:  tNamespace,
+		Portal:     tPortal,
+		Operations: []manualdns.Operation{{Type: manualdns.OperationAdd}},
+	})
+	require.ErrorIs(t, err, manualdns.ErrFQDNRequired)
+}
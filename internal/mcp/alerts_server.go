@@ -35,6 +35,8 @@ import (
 type AlertsServer struct {
 	mcpServer *server.MCPServer
 	reader    domainalertmanager.AlertmanagerReader
+
+	sessionHost
 }
 
 // NewAlertsServer creates a new MCP server instance for alerts.
@@ -67,6 +69,7 @@ func NewAlertsServer(reader domainalertmanager.AlertmanagerReader) *AlertsServer
 		server.WithToolCapabilities(true),
 		server.WithHooks(hooks),
 	)
+	s.initSessions("alerts", s.mcpServer)
 
 	s.registerAlertTools()
 
@@ -271,5 +274,5 @@ func matchesAlertSearch(a domainalertmanager.AlertView, searchLower string) bool
 // Handler returns an http.Handler for the MCP Streamable HTTP transport.
 // Mount at /mcp/alerts.
 func (s *AlertsServer) Handler() http.Handler {
-	return server.NewStreamableHTTPServer(s.mcpServer)
+	return s.streamableHandler()
 }
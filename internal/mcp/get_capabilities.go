@@ -0,0 +1,78 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/golgoth31/sreportal/internal/config"
+)
+
+// CapabilitiesResult lists the sources and features of the instance
+type CapabilitiesResult struct {
+	Sources  []SourceCapabilityResult  `json:"sources"`
+	Features []FeatureCapabilityResult `json:"features"`
+}
+
+// SourceCapabilityResult reports whether a discovery source is enabled
+type SourceCapabilityResult struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
+// FeatureCapabilityResult reports whether a feature is active
+type FeatureCapabilityResult struct {
+	Name    string            `json:"name"`
+	Enabled bool              `json:"enabled"`
+	Config  map[string]string `json:"config,omitempty"`
+}
+
+// SetCapabilities registers the get_capabilities tool, reporting the features
+// of caps and the sources returned by sources.
+func (s *DNSServer) SetCapabilities(caps config.Capabilities, sources config.SourceCapabilitiesFunc) {
+	features := make([]FeatureCapabilityResult, 0, len(caps.Features))
+	for _, f := range caps.Features {
+		features = append(features, FeatureCapabilityResult{Name: f.Name, Enabled: f.Enabled, Config: f.Config})
+	}
+
+	s.mcpServer.AddTool(
+		mcp.NewTool("get_capabilities",
+			mcp.WithDescription("List the discovery sources enabled on this SRE Portal and the optional features "+
+				"(probing, certificates, dnsCheck, auth) with a summary of their configuration. "+
+				"Use it before relying on availability, certificate or sync data that a disabled feature leaves empty."),
+		),
+		withToolMetrics("dns", "get_capabilities", func(ctx context.Context, _ mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			srcs, err := sources(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to list sources: %v", err)), nil
+			}
+			result := CapabilitiesResult{Sources: make([]SourceCapabilityResult, 0, len(srcs)), Features: features}
+			for _, src := range srcs {
+				result.Sources = append(result.Sources, SourceCapabilityResult{Name: src.Name, Enabled: src.Enabled})
+			}
+			jsonBytes, err := json.MarshalIndent(result, "", "  ")
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal capabilities: %v", err)), nil
+			}
+			return mcp.NewToolResultText(string(jsonBytes)), nil
+		}),
+	)
+}
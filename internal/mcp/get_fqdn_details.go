@@ -17,30 +17,65 @@ limitations under the License.
 package mcp
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
+	"sigs.k8s.io/external-dns/endpoint"
 
 	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
 )
 
 // FQDNDetails represents detailed information about a specific FQDN
 type FQDNDetails struct {
-	Name        string   `json:"name"`
-	Source      string   `json:"source"`
-	Group       string   `json:"group"`
-	Description string   `json:"description,omitempty"`
-	RecordType  string   `json:"record_type"`
-	Targets     []string `json:"targets"`
-	SyncStatus  string   `json:"sync_status,omitempty"`
-	Portal      string   `json:"portal,omitempty"`
-	Namespace   string   `json:"namespace,omitempty"`
-	LastSeen    string   `json:"last_seen,omitempty"`
-	DNSResource string   `json:"dns_resource,omitempty"`
+	ID                 string            `json:"id,omitempty"`
+	Name               string            `json:"name"`
+	Source             string            `json:"source"`
+	Group              string            `json:"group"`
+	Description        string            `json:"description,omitempty"`
+	RecordType         string            `json:"record_type"`
+	Targets            []string          `json:"targets"`
+	OverallStatus      string            `json:"overall_status,omitempty"`
+	SyncStatus         string            `json:"sync_status,omitempty"`
+	InternalSyncStatus string            `json:"internal_sync_status,omitempty"`
+	ExternalSyncStatus string            `json:"external_sync_status,omitempty"`
+	LookupFailure      string            `json:"lookup_failure,omitempty"`
+	OriginCause        string            `json:"origin_cause,omitempty"`
+	Availability       string            `json:"availability,omitempty"`
+	HealthStatus       string            `json:"health_status,omitempty"`
+	HTTPStatusCode     int               `json:"http_status_code,omitempty"`
+	ProbeLatencyMs     int64             `json:"probe_latency_ms,omitempty"`
+	TLSValid           *bool             `json:"tls_valid,omitempty"`
+	TargetProviders    []string          `json:"target_providers,omitempty"`
+	LastProbeTime      string            `json:"last_probe_time,omitempty"`
+	Sensitive          bool              `json:"sensitive,omitempty"`
+	Ports              []string          `json:"ports,omitempty"`
+	Paths              []string          `json:"paths,omitempty"`
+	Portal             string            `json:"portal,omitempty"`
+	Namespace          string            `json:"namespace,omitempty"`
+	LastSeen           string            `json:"last_seen,omitempty"`
+	DNSResource        string            `json:"dns_resource,omitempty"`
+	SourceType         string            `json:"source_type,omitempty"`
+	DNSRecord          string            `json:"dns_record,omitempty"`
+	ShadowedManual     string            `json:"shadowed_manual,omitempty"`
+	OwnershipConflict  [][]string        `json:"ownership_conflict,omitempty"`
+	LastReconciled     string            `json:"last_reconciled,omitempty"`
+	Annotations        map[string]string `json:"annotations,omitempty"`
+	FilteredRecords    []FilteredRecord  `json:"filtered_records,omitempty"`
+}
+
+// FilteredRecord is a record the sources discovered for an FQDN but no
+// portal lists, typically a type dropped by a DNS recordTypeFilter
+type FilteredRecord struct {
+	RecordType string   `json:"record_type"`
+	Targets    []string `json:"targets"`
+	SourceType string   `json:"source_type"`
+	OriginRef  string   `json:"origin_ref,omitempty"`
 }
 
 // handleGetFQDNDetails handles the get_fqdn_details tool call
@@ -53,13 +88,29 @@ func (s *DNSServer) handleGetFQDNDetails(ctx context.Context, request mcp.CallTo
 	// Normalize the FQDN for lookup
 	fqdnNormalized := strings.ToLower(strings.TrimSuffix(fqdn, "."))
 
+	var filtered []FilteredRecord
+	if request.GetBool("include_filtered_record_types", false) && s.sensitive.Visible(fqdnNormalized, false) {
+		if filtered, err = s.filteredRecords(ctx, fqdnNormalized); err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to get filtered records: %v", err)), nil
+		}
+	}
+
 	// Try to find via the reader (empty recordType matches first hit)
 	view, err := s.fqdnReader.Get(ctx, fqdnNormalized, "")
+	if err == nil && !s.sensitive.Visible(view.Name, false) {
+		err = domaindns.ErrFQDNNotFound
+	}
 	if err != nil {
 		if errors.Is(err, domaindns.ErrFQDNNotFound) {
-			return mcp.NewToolResultText(fmt.Sprintf("FQDN '%s' not found.", fqdn)), nil
+			if len(filtered) > 0 {
+				// Only filtered types exist: the records are the details.
+				view = domaindns.FQDNView{Name: fqdnNormalized}
+			} else {
+				return mcp.NewToolResultText(fmt.Sprintf("FQDN '%s' not found.", fqdn)), nil
+			}
+		} else {
+			return mcp.NewToolResultError(fmt.Sprintf("failed to get FQDN details: %v", err)), nil
 		}
-		return mcp.NewToolResultError(fmt.Sprintf("failed to get FQDN details: %v", err)), nil
 	}
 
 	groupName := ""
@@ -68,20 +119,57 @@ func (s *DNSServer) handleGetFQDNDetails(ctx context.Context, request mcp.CallTo
 	}
 
 	details := FQDNDetails{
-		Name:        view.Name,
-		Source:      string(view.Source),
-		Group:       groupName,
-		Description: view.Description,
-		RecordType:  view.RecordType,
-		Targets:     view.Targets,
-		SyncStatus:  view.SyncStatus,
-		Portal:      view.FirstPortal(),
-		Namespace:   view.Namespace,
-		DNSResource: fmt.Sprintf("%s/%s", view.Namespace, view.FirstPortal()),
+		ID:                 view.ID,
+		Name:               view.Name,
+		Source:             string(view.Source),
+		Group:              groupName,
+		Description:        view.Description,
+		RecordType:         view.RecordType,
+		Targets:            view.Targets,
+		OverallStatus:      string(view.OverallStatus),
+		SyncStatus:         view.SyncStatus,
+		InternalSyncStatus: view.InternalSyncStatus,
+		ExternalSyncStatus: view.ExternalSyncStatus,
+		LookupFailure:      view.LookupFailure,
+		OriginCause:        view.OriginCause,
+		Availability:       view.Availability,
+		HealthStatus:       view.HealthStatus,
+		HTTPStatusCode:     view.HTTPStatusCode,
+		ProbeLatencyMs:     view.ProbeLatency.Milliseconds(),
+		TLSValid:           view.TLSValid,
+		Sensitive:          s.sensitive.IsSensitive(view.Name),
+		Paths:              view.Paths,
+		Portal:             view.FirstPortal(),
+		Namespace:          view.Namespace,
+		DNSResource:        fmt.Sprintf("%s/%s", view.Namespace, view.FirstPortal()),
+		SourceType:         view.SourceType,
+		Annotations:        view.Annotations,
+		FilteredRecords:    filtered,
+	}
+	if view.DNSRecord != nil {
+		details.DNSRecord = view.DNSRecord.String()
+	}
+	if view.ShadowedManual != nil {
+		details.ShadowedManual = view.ShadowedManual.String()
+	}
+	if view.OwnershipConflict != nil {
+		details.OwnershipConflict = view.OwnershipConflict.TargetSets
+	}
+	if !view.LastReconciled.IsZero() {
+		details.LastReconciled = view.LastReconciled.Format("2006-01-02T15:04:05Z07:00")
+	}
+	for _, p := range view.Ports {
+		details.Ports = append(details.Ports, p.String())
+	}
+	for _, p := range view.TargetProviders {
+		details.TargetProviders = append(details.TargetProviders, fmt.Sprintf("%s: %s (AS%d)", p.Target, p.Provider, p.ASN))
 	}
 	if !view.LastSeen.IsZero() {
 		details.LastSeen = view.LastSeen.Format("2006-01-02T15:04:05Z07:00")
 	}
+	if !view.LastProbeTime.IsZero() {
+		details.LastProbeTime = view.LastProbeTime.Format("2006-01-02T15:04:05Z07:00")
+	}
 
 	jsonBytes, err := json.MarshalIndent(details, "", "  ")
 	if err != nil {
@@ -90,3 +178,51 @@ func (s *DNSServer) handleGetFQDNDetails(ctx context.Context, request mcp.CallTo
 
 	return mcp.NewToolResultText(fmt.Sprintf("FQDN details for '%s':\n\n%s", fqdn, string(jsonBytes))), nil
 }
+
+// filteredRecords returns the records of fqdn held by the source endpoint
+// store whose record type no portal lists, sorted by record type and source
+// type. Nil when no source store is wired.
+func (s *DNSServer) filteredRecords(ctx context.Context, fqdn string) ([]FilteredRecord, error) {
+	if s.sourceReader == nil {
+		return nil, nil
+	}
+	listed := map[string]bool{}
+	var out []FilteredRecord
+	for _, kind := range s.sourceReader.Kinds() {
+		entries, err := s.sourceReader.Lookup(kind, "", "")
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			ep := e.Endpoint
+			if strings.ToLower(strings.TrimSuffix(ep.DNSName, ".")) != fqdn {
+				continue
+			}
+			known, ok := listed[ep.RecordType]
+			if !ok {
+				_, err := s.fqdnReader.Get(ctx, fqdn, ep.RecordType)
+				if err != nil && !errors.Is(err, domaindns.ErrFQDNNotFound) {
+					return nil, err
+				}
+				known = err == nil
+				listed[ep.RecordType] = known
+			}
+			if known {
+				continue
+			}
+			out = append(out, FilteredRecord{
+				RecordType: ep.RecordType,
+				Targets:    ep.Targets,
+				SourceType: string(kind),
+				OriginRef:  ep.Labels[endpoint.ResourceLabelKey],
+			})
+		}
+	}
+	slices.SortFunc(out, func(a, b FilteredRecord) int {
+		if c := cmp.Compare(a.RecordType, b.RecordType); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.SourceType, b.SourceType)
+	})
+	return out, nil
+}
@@ -36,6 +36,8 @@ import (
 type ImageServer struct {
 	mcpServer *server.MCPServer
 	reader    domainimage.ImageReader
+
+	sessionHost
 }
 
 // NewImageServer creates a new MCP server instance for image inventory.
@@ -68,6 +70,7 @@ func NewImageServer(reader domainimage.ImageReader) *ImageServer {
 		server.WithToolCapabilities(true),
 		server.WithHooks(hooks),
 	)
+	s.initSessions("image", s.mcpServer)
 
 	s.registerImageTools()
 
@@ -367,5 +370,5 @@ func mcpWorkloads(refs []domainimage.WorkloadRef) []ImageWorkloadResult {
 // Handler returns an http.Handler for the MCP Streamable HTTP transport.
 // Mount at /mcp/image.
 func (s *ImageServer) Handler() http.Handler {
-	return server.NewStreamableHTTPServer(s.mcpServer)
+	return s.streamableHandler()
 }
@@ -0,0 +1,273 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/auth"
+	domainportal "github.com/golgoth31/sreportal/internal/domain/portal"
+	"github.com/golgoth31/sreportal/internal/manualdns"
+)
+
+// ManualEntriesWriter applies edits to the manual DNS entries of a portal.
+// Implemented by manualdns.Service.
+type ManualEntriesWriter interface {
+	BatchUpdate(ctx context.Context, in manualdns.BatchInput) (manualdns.BatchResult, error)
+}
+
+// ManualEntryResult is the JSON result of a manual entry write tool.
+type ManualEntryResult struct {
+	FQDN            string `json:"fqdn"`
+	RecordType      string `json:"record_type"`
+	Portal          string `json:"portal"`
+	DNSRecord       string `json:"dns_record"`
+	ResourceVersion string `json:"resource_version"`
+	EntryCount      int    `json:"entry_count"`
+}
+
+// SetManualEntries registers the add_manual_fqdn, update_fqdn_description and
+// delete_manual_fqdn tools, which write the manual DNSRecord of a portal
+// through writer. Without it the DNS server is read-only.
+func (s *DNSServer) SetManualEntries(writer ManualEntriesWriter) {
+	s.manualWriter = writer
+
+	s.mcpServer.AddTool(
+		mcp.NewTool("add_manual_fqdn",
+			mcp.WithDescription("Add a manual FQDN to a portal. The entry is stored in the manual DNSRecord "+
+				"of the portal and listed with source 'manual'. Fails if the FQDN already exists with the same record type."),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithString("portal",
+				mcp.Required(),
+				mcp.Description("Name of the portal the FQDN belongs to"),
+			),
+			mcp.WithString("namespace",
+				mcp.Description("Namespace of the portal, required when several portals have that name"),
+			),
+			mcp.WithString("fqdn",
+				mcp.Required(),
+				mcp.Description("The FQDN to add (e.g., 'db.example.com')"),
+			),
+			mcp.WithString("record_type",
+				mcp.Description("DNS record type: 'A' (default), 'AAAA', 'CNAME' or 'TXT'"),
+			),
+			mcp.WithArray("targets",
+				mcp.Required(),
+				mcp.WithStringItems(),
+				mcp.Description("Record targets: addresses for A and AAAA records, a host name for CNAME records"),
+			),
+			mcp.WithString("group",
+				mcp.Description("UI group of the entry"),
+			),
+			mcp.WithString("description",
+				mcp.Description("Description shown next to the FQDN"),
+			),
+		),
+		withToolMetrics("dns", "add_manual_fqdn", s.handleAddManualFQDN),
+	)
+
+	s.mcpServer.AddTool(
+		mcp.NewTool("update_fqdn_description",
+			mcp.WithDescription("Set the description of a manual FQDN of a portal. "+
+				"Targets and groups are left untouched. An empty description clears it."),
+			mcp.WithDestructiveHintAnnotation(false),
+			mcp.WithIdempotentHintAnnotation(true),
+			mcp.WithString("portal",
+				mcp.Required(),
+				mcp.Description("Name of the portal the FQDN belongs to"),
+			),
+			mcp.WithString("namespace",
+				mcp.Description("Namespace of the portal, required when several portals have that name"),
+			),
+			mcp.WithString("fqdn",
+				mcp.Required(),
+				mcp.Description("The manual FQDN to describe"),
+			),
+			mcp.WithString("record_type",
+				mcp.Description("DNS record type of the entry, 'A' when omitted"),
+			),
+			mcp.WithString("description",
+				mcp.Required(),
+				mcp.Description("The new description"),
+			),
+		),
+		withToolMetrics("dns", "update_fqdn_description", s.handleUpdateFQDNDescription),
+	)
+
+	s.mcpServer.AddTool(
+		mcp.NewTool("delete_manual_fqdn",
+			mcp.WithDescription("Delete a manual FQDN from a portal. FQDNs discovered from Kubernetes sources "+
+				"cannot be deleted this way."),
+			mcp.WithDestructiveHintAnnotation(true),
+			mcp.WithString("portal",
+				mcp.Required(),
+				mcp.Description("Name of the portal the FQDN belongs to"),
+			),
+			mcp.WithString("namespace",
+				mcp.Description("Namespace of the portal, required when several portals have that name"),
+			),
+			mcp.WithString("fqdn",
+				mcp.Required(),
+				mcp.Description("The manual FQDN to delete"),
+			),
+			mcp.WithString("record_type",
+				mcp.Description("DNS record type of the entry, 'A' when omitted"),
+			),
+		),
+		withToolMetrics("dns", "delete_manual_fqdn", s.handleDeleteManualFQDN),
+	)
+}
+
+// handleAddManualFQDN handles the add_manual_fqdn tool call
+func (s *DNSServer) handleAddManualFQDN(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	targets := request.GetStringSlice("targets", nil)
+	if len(targets) == 0 {
+		return mcp.NewToolResultError("targets parameter is required"), nil
+	}
+	entry := v1alpha2.DNSRecordEntry{
+		Targets:     targets,
+		Description: request.GetString("description", ""),
+	}
+	if group := request.GetString("group", ""); group != "" {
+		entry.Groups = []string{group}
+	}
+	return s.writeManualEntry(ctx, request, manualdns.OperationAdd, entry, "Added")
+}
+
+// handleUpdateFQDNDescription handles the update_fqdn_description tool call
+func (s *DNSServer) handleUpdateFQDNDescription(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	description, err := request.RequireString("description")
+	if err != nil {
+		return mcp.NewToolResultError("description parameter is required"), nil
+	}
+	entry := v1alpha2.DNSRecordEntry{Description: description}
+	return s.writeManualEntry(ctx, request, manualdns.OperationSetDescription, entry, "Updated the description of")
+}
+
+// handleDeleteManualFQDN handles the delete_manual_fqdn tool call
+func (s *DNSServer) handleDeleteManualFQDN(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return s.writeManualEntry(ctx, request, manualdns.OperationDelete, v1alpha2.DNSRecordEntry{}, "Deleted")
+}
+
+// SetAuthorizer submits every manual entry write to authz, as the caller
+// identity writing to the portal, like BatchUpdateManualEntries.
+func (s *DNSServer) SetAuthorizer(authz auth.Authorizer) {
+	s.authorizer = authz
+}
+
+// writeManualEntry completes entry with the fqdn and record_type parameters
+// and applies it as a single operation to the manual DNSRecord of the portal.
+func (s *DNSServer) writeManualEntry(
+	ctx context.Context, request mcp.CallToolRequest, op manualdns.OperationType, entry v1alpha2.DNSRecordEntry, verb string,
+) (*mcp.CallToolResult, error) {
+	portalName, err := request.RequireString("portal")
+	if err != nil {
+		return mcp.NewToolResultError("portal parameter is required"), nil
+	}
+	fqdn, err := request.RequireString("fqdn")
+	if err != nil {
+		return mcp.NewToolResultError("fqdn parameter is required"), nil
+	}
+	entry.FQDN = strings.ToLower(strings.TrimSuffix(fqdn, "."))
+	entry.RecordType = strings.ToUpper(request.GetString("record_type", "A"))
+
+	// Sensitive FQDNs are hidden from anonymous MCP clients: editing them
+	// would reveal they exist.
+	if !s.sensitive.Visible(entry.FQDN, false) {
+		return mcp.NewToolResultError(fmt.Sprintf("FQDN '%s' is sensitive and cannot be edited over MCP", fqdn)), nil
+	}
+
+	portal, errResult := s.writablePortal(ctx, request.GetString("namespace", ""), portalName)
+	if errResult != nil {
+		return errResult, nil
+	}
+	if s.authorizer != nil {
+		err := s.authorizer.Authorize(ctx, auth.AuthorizationRequest{
+			User: auth.IdentityFromContext(ctx), Portal: portal.Name, Verb: auth.VerbWrite,
+		})
+		switch {
+		case errors.Is(err, auth.ErrPermissionDenied):
+			return mcp.NewToolResultError(fmt.Sprintf("not allowed to write to portal '%s'", portal.Name)), nil
+		case err != nil:
+			return mcp.NewToolResultError(fmt.Sprintf("authorization failed: %v", err)), nil
+		}
+	}
+
+	res, err := s.manualWriter.BatchUpdate(ctx, manualdns.BatchInput{
+		Namespace:  portal.Namespace,
+		Portal:     portal.Name,
+		Operations: []manualdns.Operation{{Type: op, Entry: entry}},
+	})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to update manual entries: %v", err)), nil
+	}
+
+	result := ManualEntryResult{
+		FQDN:            entry.FQDN,
+		RecordType:      entry.RecordType,
+		Portal:          portal.Name,
+		DNSRecord:       res.Record,
+		ResourceVersion: res.ResourceVersion,
+		EntryCount:      res.EntryCount,
+	}
+	jsonBytes, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to marshal result: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("%s manual FQDN '%s' in portal '%s':\n\n%s",
+		verb, entry.FQDN, portal.Name, string(jsonBytes))), nil
+}
+
+// writablePortal returns the local portal namespace/name with the DNS feature
+// enabled, or an error result. An empty namespace matches the portal of that
+// name when it is the only one.
+func (s *DNSServer) writablePortal(ctx context.Context, namespace, name string) (domainportal.PortalView, *mcp.CallToolResult) {
+	views, err := s.portalReader.List(ctx, domainportal.PortalFilters{Namespace: namespace})
+	if err != nil {
+		return domainportal.PortalView{}, mcp.NewToolResultError(fmt.Sprintf("failed to list portals: %v", err))
+	}
+	var matches []domainportal.PortalView
+	for _, v := range views {
+		if v.Name == name {
+			matches = append(matches, v)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return domainportal.PortalView{}, mcp.NewToolResultError(fmt.Sprintf("portal '%s' not found", name))
+	case 1:
+	default:
+		return domainportal.PortalView{}, mcp.NewToolResultError(
+			fmt.Sprintf("several portals are named '%s': set the namespace parameter", name))
+	}
+	v := matches[0]
+	switch {
+	case v.IsRemote:
+		return domainportal.PortalView{}, mcp.NewToolResultError(fmt.Sprintf("portal '%s' is remote", name))
+	case !v.Features.DNS:
+		return domainportal.PortalView{}, mcp.NewToolResultError(fmt.Sprintf("dns feature is disabled for portal '%s'", name))
+	}
+	return v, nil
+}
@@ -28,17 +28,23 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/prometheus/client_golang/prometheus"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/external-dns/endpoint"
 
 	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	v1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/auth"
 	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
 	domainimage "github.com/golgoth31/sreportal/internal/domain/image"
 	domainmetrics "github.com/golgoth31/sreportal/internal/domain/metrics"
 	domainportal "github.com/golgoth31/sreportal/internal/domain/portal"
 	domainrelease "github.com/golgoth31/sreportal/internal/domain/release"
+	domainsource "github.com/golgoth31/sreportal/internal/domain/source"
+	"github.com/golgoth31/sreportal/internal/manualdns"
 	dnsstore "github.com/golgoth31/sreportal/internal/readstore/dns"
 	imagestore "github.com/golgoth31/sreportal/internal/readstore/image"
 	portalstore "github.com/golgoth31/sreportal/internal/readstore/portal"
 	releasestore "github.com/golgoth31/sreportal/internal/readstore/release"
+	sourcestore "github.com/golgoth31/sreportal/internal/readstore/source"
 )
 
 func TestMCP(t *testing.T) {
@@ -116,6 +122,30 @@ func seedDNSStore() *dnsstore.FQDNStore {
 	return store
 }
 
+// recordingWriter is a ManualEntriesWriter recording the batches it receives.
+type recordingWriter struct {
+	batches []manualdns.BatchInput
+}
+
+func (w *recordingWriter) BatchUpdate(_ context.Context, in manualdns.BatchInput) (manualdns.BatchResult, error) {
+	w.batches = append(w.batches, in)
+	return manualdns.BatchResult{Record: in.Portal + "-manual", ResourceVersion: "2", EntryCount: 1}, nil
+}
+
+// recordingAuthorizer allows the requests of allowed users and records them.
+type recordingAuthorizer struct {
+	allowed  map[string]bool
+	requests []auth.AuthorizationRequest
+}
+
+func (a *recordingAuthorizer) Authorize(_ context.Context, req auth.AuthorizationRequest) error {
+	a.requests = append(a.requests, req)
+	if a.allowed[req.User] {
+		return nil
+	}
+	return auth.ErrPermissionDenied
+}
+
 // emptyPortalStore returns an empty PortalStore for tests that don't need portal data.
 func emptyPortalStore() *portalstore.PortalStore {
 	return portalstore.NewPortalStore()
@@ -167,6 +197,38 @@ var _ = Describe("MCP Server", func() {
 			})
 		})
 
+		Context("with a sensitive policy", func() {
+			It("should flag sensitive FQDNs", func() {
+				store := seedDNSStore()
+				server := NewDNSServer(store, emptyPortalStore())
+				server.SetSensitivePolicy(domaindns.NewSensitivePolicy([]string{"internal."}, false))
+				request := newCallToolRequest("search_fqdns", map[string]any{
+					keyQuery: "internal",
+				})
+
+				result, err := server.handleSearchFQDNs(ctx, request)
+
+				Expect(err).NotTo(HaveOccurred())
+				text := extractTextContent(result)
+				Expect(text).To(ContainSubstring("Found 1 FQDN(s)"))
+				Expect(text).To(ContainSubstring(`"sensitive": true`))
+			})
+
+			It("should hide sensitive FQDNs when the policy hides them from anonymous callers", func() {
+				store := seedDNSStore()
+				server := NewDNSServer(store, emptyPortalStore())
+				server.SetSensitivePolicy(domaindns.NewSensitivePolicy([]string{"internal."}, true))
+				request := newCallToolRequest("search_fqdns", map[string]any{})
+
+				result, err := server.handleSearchFQDNs(ctx, request)
+
+				Expect(err).NotTo(HaveOccurred())
+				text := extractTextContent(result)
+				Expect(text).To(ContainSubstring("Found 3 FQDN(s)"))
+				Expect(text).NotTo(ContainSubstring("internal.example.com"))
+			})
+		})
+
 		Context("with query filter", func() {
 			It("should filter FQDNs by name substring", func() {
 				store := seedDNSStore()
@@ -292,6 +354,40 @@ var _ = Describe("MCP Server", func() {
 			})
 		})
 
+		Context("with a last seen window", func() {
+			It("should keep only FQDNs last seen within the window", func() {
+				store := dnsstore.NewFQDNStore()
+				Expect(store.Replace(ctx, "default/test-dns", portalMain, []domaindns.FQDNView{
+					{Name: fqdnAPI, RecordType: "A", LastSeen: time.Now(), Portals: []string{portalMain}},
+					{Name: "stale.example.com", RecordType: "A", LastSeen: time.Now().Add(-60 * 24 * time.Hour), Portals: []string{portalMain}},
+				})).To(Succeed())
+				server := NewDNSServer(store, emptyPortalStore())
+				request := newCallToolRequest("search_fqdns", map[string]any{
+					"last_seen_before": "720h",
+				})
+
+				result, err := server.handleSearchFQDNs(ctx, request)
+
+				Expect(err).NotTo(HaveOccurred())
+				text := extractTextContent(result)
+				Expect(text).To(ContainSubstring("Found 1 FQDN(s)"))
+				Expect(text).To(ContainSubstring("stale.example.com"))
+				Expect(text).To(ContainSubstring(`"last_seen"`))
+			})
+
+			It("should reject a malformed bound", func() {
+				server := NewDNSServer(seedDNSStore(), emptyPortalStore())
+				request := newCallToolRequest("search_fqdns", map[string]any{
+					"last_seen_after": "yesterday",
+				})
+
+				result, err := server.handleSearchFQDNs(ctx, request)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(isErrorResult(result)).To(BeTrue())
+			})
+		})
+
 		Context("with no results", func() {
 			It("should return appropriate message when no FQDNs match", func() {
 				store := seedDNSStore()
@@ -610,6 +706,50 @@ var _ = Describe("MCP Server", func() {
 			})
 		})
 
+		Context("with filtered record types", func() {
+			It("should return them only on demand", func() {
+				store := dnsstore.NewFQDNStore()
+				_ = store.Replace(ctx, "default/test-dns", portalMain, []domaindns.FQDNView{
+					{
+						Name: fqdnAPI, Source: domaindns.SourceExternalDNS,
+						Groups: []string{keyAPI}, RecordType: "A",
+						Targets: []string{ip192dot1},
+						Portals: []string{portalMain}, Namespace: nsDefault,
+					},
+				})
+				sources := sourcestore.NewStore()
+				sources.ReplaceKind("dnsendpoint", []domainsource.EnrichedEndpoint{
+					{Endpoint: endpoint.NewEndpoint(fqdnAPI, "A", ip192dot1), Kind: "dnsendpoint"},
+					{Endpoint: endpoint.NewEndpoint(fqdnAPI, "TXT", "owner=default"), Kind: "dnsendpoint"},
+					{Endpoint: endpoint.NewEndpoint("txt-only.example.com", "TXT", "v=1"), Kind: "dnsendpoint"},
+				})
+
+				server := NewDNSServer(store, emptyPortalStore())
+				server.SetSourceEndpoints(sources)
+				details := func(args map[string]any) FQDNDetails {
+					result, err := server.handleGetFQDNDetails(ctx, newCallToolRequest("get_fqdn_details", args))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(isErrorResult(result)).To(BeFalse())
+					text := extractTextContent(result)
+					var d FQDNDetails
+					Expect(json.Unmarshal([]byte(text[strings.Index(text, "{"):]), &d)).To(Succeed())
+					return d
+				}
+
+				Expect(details(map[string]any{keyFqdn: fqdnAPI}).FilteredRecords).To(BeEmpty())
+
+				d := details(map[string]any{keyFqdn: fqdnAPI, "include_filtered_record_types": true})
+				Expect(d.RecordType).To(Equal("A"))
+				Expect(d.FilteredRecords).To(Equal([]FilteredRecord{
+					{RecordType: "TXT", Targets: []string{"owner=default"}, SourceType: "dnsendpoint"},
+				}))
+
+				d = details(map[string]any{keyFqdn: "txt-only.example.com", "include_filtered_record_types": true})
+				Expect(d.Name).To(Equal("txt-only.example.com"))
+				Expect(d.FilteredRecords).To(HaveLen(1))
+			})
+		})
+
 		Context("with non-existing FQDN", func() {
 			It("should return not found message", func() {
 				store := seedDNSStore()
@@ -643,6 +783,244 @@ var _ = Describe("MCP Server", func() {
 		})
 	})
 
+	Describe("manual FQDN tools", func() {
+		var (
+			writer *recordingWriter
+			server *DNSServer
+		)
+
+		BeforeEach(func() {
+			pStore := portalstore.NewPortalStore()
+			_ = pStore.Replace(ctx, "sreportal-system/main", domainportal.PortalView{
+				Name: portalMain, Namespace: nsSystem, Features: domainportal.PortalFeatures{DNS: true},
+			})
+			_ = pStore.Replace(ctx, "sreportal-system/remote", domainportal.PortalView{
+				Name: "remote", Namespace: nsSystem, IsRemote: true, Features: domainportal.PortalFeatures{DNS: true},
+			})
+			writer = &recordingWriter{}
+			server = NewDNSServer(dnsstore.NewFQDNStore(), pStore)
+		})
+
+		It("should not register the tools until writes are enabled", func() {
+			Expect(server.mcpServer.GetTool("add_manual_fqdn")).To(BeNil())
+
+			server.SetManualEntries(writer)
+			Expect(server.mcpServer.GetTool("add_manual_fqdn")).NotTo(BeNil())
+			Expect(server.mcpServer.GetTool("update_fqdn_description")).NotTo(BeNil())
+			Expect(server.mcpServer.GetTool("delete_manual_fqdn")).NotTo(BeNil())
+		})
+
+		It("should add an entry to the manual record of the portal", func() {
+			server.SetManualEntries(writer)
+			request := newCallToolRequest("add_manual_fqdn", map[string]any{
+				"portal":      portalMain,
+				"fqdn":        "DB.example.com.",
+				"targets":     []any{ip10dot1},
+				"group":       "databases",
+				"description": "Primary database",
+			})
+
+			result, err := server.handleAddManualFQDN(ctx, request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(isErrorResult(result)).To(BeFalse())
+			Expect(extractTextContent(result)).To(ContainSubstring(`"dns_record": "main-manual"`))
+			Expect(writer.batches).To(HaveLen(1))
+			Expect(writer.batches[0].Namespace).To(Equal(nsSystem))
+			Expect(writer.batches[0].Operations).To(Equal([]manualdns.Operation{{
+				Type: manualdns.OperationAdd,
+				Entry: v1alpha2.DNSRecordEntry{
+					FQDN: "db.example.com", RecordType: "A", Targets: []string{ip10dot1},
+					Groups: []string{"databases"}, Description: "Primary database",
+				},
+			}}))
+		})
+
+		It("should only set the description on update", func() {
+			server.SetManualEntries(writer)
+			request := newCallToolRequest("update_fqdn_description", map[string]any{
+				"portal": portalMain, "fqdn": "db.example.com", "description": "Replica",
+			})
+
+			result, err := server.handleUpdateFQDNDescription(ctx, request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(isErrorResult(result)).To(BeFalse())
+			Expect(writer.batches[0].Operations[0].Type).To(Equal(manualdns.OperationSetDescription))
+			Expect(writer.batches[0].Operations[0].Entry.Description).To(Equal("Replica"))
+		})
+
+		It("should delete an entry by FQDN and record type", func() {
+			server.SetManualEntries(writer)
+			request := newCallToolRequest("delete_manual_fqdn", map[string]any{
+				"portal": portalMain, "fqdn": "db.example.com", "record_type": "aaaa",
+			})
+
+			result, err := server.handleDeleteManualFQDN(ctx, request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(isErrorResult(result)).To(BeFalse())
+			Expect(writer.batches[0].Operations[0].Type).To(Equal(manualdns.OperationDelete))
+			Expect(writer.batches[0].Operations[0].Entry.RecordType).To(Equal("AAAA"))
+		})
+
+		It("should refuse remote and unknown portals", func() {
+			server.SetManualEntries(writer)
+
+			for portal, msg := range map[string]string{"remote": "is remote", "missing": "not found"} {
+				request := newCallToolRequest("delete_manual_fqdn", map[string]any{"portal": portal, "fqdn": "db.example.com"})
+				result, err := server.handleDeleteManualFQDN(ctx, request)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(isErrorResult(result)).To(BeTrue())
+				Expect(extractTextContent(result)).To(ContainSubstring(msg))
+			}
+			Expect(writer.batches).To(BeEmpty())
+		})
+
+		It("should match the portal by namespace and name", func() {
+			pStore := portalstore.NewPortalStore()
+			for _, ns := range []string{nsSystem, "team"} {
+				_ = pStore.Replace(ctx, ns+"/main", domainportal.PortalView{
+					Name: portalMain, Namespace: ns, Features: domainportal.PortalFeatures{DNS: true},
+				})
+			}
+			server = NewDNSServer(dnsstore.NewFQDNStore(), pStore)
+			server.SetManualEntries(writer)
+
+			request := newCallToolRequest("delete_manual_fqdn", map[string]any{"portal": portalMain, "fqdn": "db.example.com"})
+			result, err := server.handleDeleteManualFQDN(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(isErrorResult(result)).To(BeTrue())
+			Expect(extractTextContent(result)).To(ContainSubstring("set the namespace parameter"))
+
+			request = newCallToolRequest("delete_manual_fqdn", map[string]any{
+				"portal": portalMain, "namespace": "team", "fqdn": "db.example.com",
+			})
+			result, err = server.handleDeleteManualFQDN(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(isErrorResult(result)).To(BeFalse())
+			Expect(writer.batches).To(HaveLen(1))
+			Expect(writer.batches[0].Namespace).To(Equal("team"))
+		})
+
+		It("should submit writes to the authorizer", func() {
+			authz := &recordingAuthorizer{allowed: map[string]bool{"token:ci": true}}
+			server.SetManualEntries(writer)
+			server.SetAuthorizer(authz)
+			request := newCallToolRequest("delete_manual_fqdn", map[string]any{"portal": portalMain, "fqdn": "db.example.com"})
+
+			result, err := server.handleDeleteManualFQDN(auth.WithIdentity(ctx, "token:dev"), request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(isErrorResult(result)).To(BeTrue())
+			Expect(extractTextContent(result)).To(ContainSubstring("not allowed"))
+			Expect(writer.batches).To(BeEmpty())
+
+			result, err = server.handleDeleteManualFQDN(auth.WithIdentity(ctx, "token:ci"), request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(isErrorResult(result)).To(BeFalse())
+			Expect(writer.batches).To(HaveLen(1))
+			Expect(authz.requests).To(Equal([]auth.AuthorizationRequest{
+				{User: "token:dev", Portal: portalMain, Verb: auth.VerbWrite},
+				{User: "token:ci", Portal: portalMain, Verb: auth.VerbWrite},
+			}))
+		})
+
+		It("should refuse sensitive FQDNs", func() {
+			server.SetSensitivePolicy(domaindns.NewSensitivePolicy([]string{"secret."}, true))
+			server.SetManualEntries(writer)
+			request := newCallToolRequest("delete_manual_fqdn", map[string]any{"portal": portalMain, "fqdn": "db.secret.example.com"})
+
+			result, err := server.handleDeleteManualFQDN(ctx, request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(isErrorResult(result)).To(BeTrue())
+			Expect(writer.batches).To(BeEmpty())
+		})
+	})
+
+	Describe("summarize_inventory tool", func() {
+		seedInventory := func() *dnsstore.FQDNStore {
+			store := dnsstore.NewFQDNStore()
+			_ = store.Replace(ctx, "default/main-dns", portalMain, []domaindns.FQDNView{
+				{
+					Name: fqdnAPI, Source: domaindns.SourceExternalDNS,
+					Groups: []string{fqdnWeb, keyAPI}, RecordType: "A",
+					Targets: []string{ip192dot1}, SyncStatus: "sync",
+					Portals: []string{portalMain}, Namespace: nsDefault,
+				},
+				{
+					Name: "manual.example.com", Source: domaindns.SourceManual,
+					Groups: []string{fqdnWeb}, RecordType: "CNAME",
+					Targets: []string{fqdnAPI},
+					Portals: []string{portalMain}, Namespace: nsDefault,
+				},
+			})
+			_ = store.Replace(ctx, "team/team-dns", "team", []domaindns.FQDNView{
+				{
+					Name: "team.example.com", Source: domaindns.SourceExternalDNS,
+					Groups: []string{fqdnWeb}, RecordType: "A",
+					Targets: []string{ip10dot1}, SyncStatus: "notsync",
+					Portals: []string{"team"}, Namespace: "team",
+				},
+			})
+			return store
+		}
+
+		decode := func(text string, into any) {
+			jsonStart := strings.IndexAny(text, "{[")
+			Expect(jsonStart).To(BeNumerically(">", 0))
+			Expect(json.Unmarshal([]byte(text[jsonStart:]), into)).To(Succeed())
+		}
+
+		It("should count FQDNs per dimension", func() {
+			server := NewDNSServer(seedInventory(), emptyPortalStore())
+			request := newCallToolRequest("summarize_inventory", map[string]any{})
+
+			result, err := server.handleSummarizeInventory(ctx, request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(isErrorResult(result)).To(BeFalse())
+			var summary InventorySummary
+			decode(extractTextContent(result), &summary)
+			Expect(summary.Total).To(Equal(3))
+			Expect(summary.BySource).To(Equal(map[string]int{"external-dns": 2, "manual": 1}))
+			Expect(summary.ByGroup).To(Equal(map[string]int{fqdnWeb: 3, keyAPI: 1}))
+			Expect(summary.ByRecordType).To(Equal(map[string]int{"A": 2, "CNAME": 1}))
+			Expect(summary.BySyncStatus).To(Equal(map[string]int{"sync": 1, "notsync": 1, "unknown": 1}))
+			Expect(summary.ByNamespace).To(Equal(map[string]int{nsDefault: 2, "team": 1}))
+		})
+
+		It("should return one summary per portal when requested", func() {
+			server := NewDNSServer(seedInventory(), emptyPortalStore())
+			request := newCallToolRequest("summarize_inventory", map[string]any{"per_portal": true})
+
+			result, err := server.handleSummarizeInventory(ctx, request)
+
+			Expect(err).NotTo(HaveOccurred())
+			var summaries []InventorySummary
+			decode(extractTextContent(result), &summaries)
+			Expect(summaries).To(HaveLen(2))
+			Expect(summaries[0].Portal).To(Equal(portalMain))
+			Expect(summaries[0].Total).To(Equal(2))
+			Expect(summaries[1].Portal).To(Equal("team"))
+			Expect(summaries[1].Total).To(Equal(1))
+		})
+
+		It("should restrict the summary to a portal", func() {
+			server := NewDNSServer(seedInventory(), emptyPortalStore())
+			request := newCallToolRequest("summarize_inventory", map[string]any{"portal": "team"})
+
+			result, err := server.handleSummarizeInventory(ctx, request)
+
+			Expect(err).NotTo(HaveOccurred())
+			var summary InventorySummary
+			decode(extractTextContent(result), &summary)
+			Expect(summary.Portal).To(Equal("team"))
+			Expect(summary.Total).To(Equal(1))
+		})
+	})
+
 	Describe("JSON output format", func() {
 		It("should produce valid JSON in search results", func() {
 			store := dnsstore.NewFQDNStore()
@@ -36,6 +36,8 @@ import (
 type MetricsServer struct {
 	mcpServer *server.MCPServer
 	gatherer  prometheus.Gatherer
+
+	sessionHost
 }
 
 // NewMetricsServer creates a new MCP server instance for metrics.
@@ -68,6 +70,7 @@ func NewMetricsServer(g prometheus.Gatherer) *MetricsServer {
 		server.WithToolCapabilities(true),
 		server.WithHooks(hooks),
 	)
+	s.initSessions("metrics", s.mcpServer)
 
 	s.registerTools()
 
@@ -116,5 +119,5 @@ func (s *MetricsServer) handleListMetrics(ctx context.Context, request mcp.CallT
 // Handler returns an http.Handler for the MCP Streamable HTTP transport.
 // Mount at /mcp/metrics.
 func (s *MetricsServer) Handler() http.Handler {
-	return server.NewStreamableHTTPServer(s.mcpServer)
+	return s.streamableHandler()
 }
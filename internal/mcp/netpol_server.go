@@ -38,6 +38,8 @@ import (
 type NetpolServer struct {
 	mcpServer *server.MCPServer
 	reader    domainnetpol.FlowGraphReader
+
+	sessionHost
 }
 
 // NewNetpolServer creates a new MCP server instance for network policies.
@@ -70,6 +72,7 @@ func NewNetpolServer(reader domainnetpol.FlowGraphReader) *NetpolServer {
 		server.WithToolCapabilities(true),
 		server.WithHooks(hooks),
 	)
+	s.initSessions("netpol", s.mcpServer)
 
 	s.registerNetpolTools()
 
@@ -320,5 +323,5 @@ func (s *NetpolServer) handleGetServiceFlows(ctx context.Context, request mcp.Ca
 // Handler returns an http.Handler for the MCP Streamable HTTP transport.
 // Mount at /mcp/netpol.
 func (s *NetpolServer) Handler() http.Handler {
-	return server.NewStreamableHTTPServer(s.mcpServer)
+	return s.streamableHandler()
 }
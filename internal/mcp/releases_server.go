@@ -36,6 +36,8 @@ import (
 type ReleasesServer struct {
 	mcpServer *server.MCPServer
 	reader    domainrelease.ReleaseReader
+
+	sessionHost
 }
 
 // NewReleasesServer creates a new MCP server instance for releases.
@@ -68,6 +70,7 @@ func NewReleasesServer(reader domainrelease.ReleaseReader) *ReleasesServer {
 		server.WithToolCapabilities(true),
 		server.WithHooks(hooks),
 	)
+	s.initSessions("releases", s.mcpServer)
 
 	s.registerTools()
 
@@ -183,5 +186,5 @@ func (s *ReleasesServer) handleListReleases(ctx context.Context, request mcp.Cal
 // Handler returns an http.Handler for the MCP Streamable HTTP transport.
 // Mount at /mcp/releases.
 func (s *ReleasesServer) Handler() http.Handler {
-	return server.NewStreamableHTTPServer(s.mcpServer)
+	return s.streamableHandler()
 }
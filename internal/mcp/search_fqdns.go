@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"slices"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 
@@ -29,15 +30,22 @@ import (
 
 // FQDNResult represents a single FQDN in the search results
 type FQDNResult struct {
-	Name        string   `json:"name"`
-	Source      string   `json:"source"`
-	Group       string   `json:"group"`
-	Description string   `json:"description,omitempty"`
-	RecordType  string   `json:"record_type"`
-	Targets     []string `json:"targets"`
-	SyncStatus  string   `json:"sync_status,omitempty"`
-	Portal      string   `json:"portal,omitempty"`
-	Namespace   string   `json:"namespace,omitempty"`
+	ID            string   `json:"id,omitempty"`
+	Name          string   `json:"name"`
+	Source        string   `json:"source"`
+	Group         string   `json:"group"`
+	Description   string   `json:"description,omitempty"`
+	RecordType    string   `json:"record_type"`
+	Targets       []string `json:"targets"`
+	SyncStatus    string   `json:"sync_status,omitempty"`
+	OverallStatus string   `json:"overall_status,omitempty"`
+	TargetScope   string   `json:"target_scope,omitempty"`
+	Sensitive     bool     `json:"sensitive,omitempty"`
+	Portal        string   `json:"portal,omitempty"`
+	Namespace     string   `json:"namespace,omitempty"`
+	LastSeen      string   `json:"last_seen,omitempty"`
+	RemovedAt     string   `json:"removed_at,omitempty"`
+	RemovalReason string   `json:"removal_reason,omitempty"`
 }
 
 // handleSearchFQDNs handles the search_fqdns tool call
@@ -46,12 +54,30 @@ func (s *DNSServer) handleSearchFQDNs(ctx context.Context, request mcp.CallToolR
 	source := request.GetString("source", "")
 	portal := request.GetString("portal", "")
 	namespace := request.GetString("namespace", "")
+	targetScope := request.GetString("target_scope", "")
+	includeRemoved := request.GetBool("include_removed", false)
+
+	now := time.Now()
+	var window domaindns.LastSeenWindow
+	var err error
+	if window.After, err = domaindns.ParseLastSeenBound(request.GetString("last_seen_after", ""), now); err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid last_seen_after: %v", err)), nil
+	}
+	if window.Before, err = domaindns.ParseLastSeenBound(request.GetString("last_seen_before", ""), now); err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid last_seen_before: %v", err)), nil
+	}
+	if err := window.Validate(); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
 
 	filters := domaindns.FQDNFilters{
-		Search:    query,
-		Source:    source,
-		Portal:    portal,
-		Namespace: namespace,
+		Search:         query,
+		Source:         source,
+		Portal:         portal,
+		Namespace:      namespace,
+		TargetScope:    domaindns.TargetScope(targetScope),
+		IncludeRemoved: includeRemoved,
+		LastSeen:       window,
 	}
 
 	views, err := s.fqdnReader.List(ctx, filters)
@@ -66,7 +92,7 @@ func (s *DNSServer) handleSearchFQDNs(ctx context.Context, request mcp.CallToolR
 	seen := make(map[string]bool)
 
 	for _, v := range views {
-		if seen[v.Name] {
+		if seen[v.Name] || !s.sensitive.Visible(v.Name, false) {
 			continue
 		}
 
@@ -81,17 +107,29 @@ func (s *DNSServer) handleSearchFQDNs(ctx context.Context, request mcp.CallToolR
 			groupName = v.Groups[0]
 		}
 
-		results = append(results, FQDNResult{
-			Name:        v.Name,
-			Source:      string(v.Source),
-			Group:       groupName,
-			Description: v.Description,
-			RecordType:  v.RecordType,
-			Targets:     v.Targets,
-			SyncStatus:  v.SyncStatus,
-			Portal:      v.FirstPortal(),
-			Namespace:   v.Namespace,
-		})
+		result := FQDNResult{
+			ID:            v.ID,
+			Name:          v.Name,
+			Source:        string(v.Source),
+			Group:         groupName,
+			Description:   v.Description,
+			RecordType:    v.RecordType,
+			Targets:       v.Targets,
+			SyncStatus:    v.SyncStatus,
+			OverallStatus: string(v.OverallStatus),
+			TargetScope:   string(v.TargetScope),
+			Sensitive:     s.sensitive.IsSensitive(v.Name),
+			Portal:        v.FirstPortal(),
+			Namespace:     v.Namespace,
+		}
+		if !v.LastSeen.IsZero() {
+			result.LastSeen = v.LastSeen.Format(time.RFC3339)
+		}
+		if !v.RemovedAt.IsZero() {
+			result.RemovedAt = v.RemovedAt.Format("2006-01-02T15:04:05Z07:00")
+			result.RemovalReason = string(v.RemovalReason)
+		}
+		results = append(results, result)
 	}
 
 	if len(results) == 0 {
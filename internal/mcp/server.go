@@ -21,8 +21,10 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/golgoth31/sreportal/internal/auth"
 	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
 	domainportal "github.com/golgoth31/sreportal/internal/domain/portal"
+	domainsource "github.com/golgoth31/sreportal/internal/domain/source"
 	"github.com/golgoth31/sreportal/internal/log"
 	"github.com/golgoth31/sreportal/internal/metrics"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -35,6 +37,26 @@ type DNSServer struct {
 	mcpServer    *server.MCPServer
 	fqdnReader   domaindns.FQDNReader
 	portalReader domainportal.PortalReader
+	sensitive    *domaindns.SensitivePolicy
+	sourceReader domainsource.SourceEndpointReader
+	manualWriter ManualEntriesWriter
+	authorizer   auth.Authorizer
+
+	sessionHost
+}
+
+// SetSensitivePolicy flags FQDNs matching the policy as sensitive. MCP clients
+// are anonymous: sensitive FQDNs are omitted when the policy hides them from
+// anonymous callers.
+func (s *DNSServer) SetSensitivePolicy(policy *domaindns.SensitivePolicy) {
+	s.sensitive = policy
+}
+
+// SetSourceEndpoints lets get_fqdn_details return, on demand, the records the
+// sources discovered but no portal lists, such as the record types dropped by
+// a DNS recordTypeFilter.
+func (s *DNSServer) SetSourceEndpoints(reader domainsource.SourceEndpointReader) {
+	s.sourceReader = reader
 }
 
 // NewDNSServer creates a new MCP server instance for DNS and portals.
@@ -70,6 +92,7 @@ func NewDNSServer(fqdnReader domaindns.FQDNReader, portalReader domainportal.Por
 		server.WithToolCapabilities(true),
 		server.WithHooks(hooks),
 	)
+	s.initSessions("dns", s.mcpServer)
 
 	s.registerDNSTools()
 
@@ -98,6 +121,22 @@ func (s *DNSServer) registerDNSTools() {
 			mcp.WithString("namespace",
 				mcp.Description("Filter by Kubernetes namespace"),
 			),
+			mcp.WithString("target_scope",
+				mcp.Description("Filter by target scope: 'public', 'private', 'cgnat' or 'link-local'. "+
+					"Use 'public' to spot endpoints reachable from the internet"),
+			),
+			mcp.WithBoolean("include_removed",
+				mcp.Description("Also return FQDNs that recently disappeared from their sources "+
+					"(overall_status 'removed', with the removal time). Useful to explain a broken dashboard"),
+			),
+			mcp.WithString("last_seen_after",
+				mcp.Description("Only FQDNs last seen at or after this time: an RFC 3339 timestamp, "+
+					"or a duration back from now such as '24h'. Use it to list recently active records"),
+			),
+			mcp.WithString("last_seen_before",
+				mcp.Description("Only FQDNs last seen before this time: an RFC 3339 timestamp, "+
+					"or a duration back from now such as '720h'. Use it to find stale records"),
+			),
 		),
 		withToolMetrics("dns", "search_fqdns", s.handleSearchFQDNs),
 	)
@@ -121,9 +160,29 @@ func (s *DNSServer) registerDNSTools() {
 				mcp.Required(),
 				mcp.Description("The exact FQDN to look up (e.g., 'api.example.com')"),
 			),
+			mcp.WithBoolean("include_filtered_record_types",
+				mcp.Description("Also return the records the sources discovered for the FQDN but no portal lists, "+
+					"such as the record types dropped by a DNS recordTypeFilter (e.g. TXT or NS)"),
+			),
 		),
 		withToolMetrics("dns", "get_fqdn_details", s.handleGetFQDNDetails),
 	)
+
+	// Register summarize_inventory tool
+	s.mcpServer.AddTool(
+		mcp.NewTool("summarize_inventory",
+			mcp.WithDescription("Summarize the FQDN inventory with counts by source, group, record type, "+
+				"sync status and namespace. Use it to answer high-level questions (how many FQDNs, "+
+				"how many out of sync, which namespaces expose the most) without paging through search_fqdns."),
+			mcp.WithString("portal",
+				mcp.Description("Only summarize FQDNs of this portal"),
+			),
+			mcp.WithBoolean("per_portal",
+				mcp.Description("Return one summary per portal instead of a single total"),
+			),
+		),
+		withToolMetrics("dns", "summarize_inventory", s.handleSummarizeInventory),
+	)
 }
 
 // withToolMetrics wraps an MCP tool handler with Prometheus instrumentation.
@@ -151,5 +210,5 @@ func (s *DNSServer) ServeStdio() error {
 // Handler returns an http.Handler for the MCP Streamable HTTP transport.
 // Mount at /mcp/dns.
 func (s *DNSServer) Handler() http.Handler {
-	return server.NewStreamableHTTPServer(s.mcpServer)
+	return s.streamableHandler()
 }
@@ -0,0 +1,185 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mcp
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golgoth31/sreportal/internal/metrics"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// sessionIDPrefix is the prefix of the session IDs handed out to clients.
+const sessionIDPrefix = "mcp-session-"
+
+// errUnknownSession is returned for session IDs this server did not hand out,
+// or already closed. The transport answers 404, which tells the client to
+// initialize a new session.
+var errUnknownSession = errors.New("unknown MCP session")
+
+// SessionLimits bounds the Streamable HTTP sessions of an MCP server. Zero
+// values disable the matching limit.
+type SessionLimits struct {
+	// MaxSessions caps the concurrent sessions. New sessions above it are
+	// refused with 503 Service Unavailable.
+	MaxSessions int
+	// IdleTimeout closes sessions that sent no request for that long.
+	IdleTimeout time.Duration
+}
+
+// sessionHost tracks the Streamable HTTP sessions of one MCP server: it hands
+// out session IDs, enforces SessionLimits and closes every session on
+// Shutdown. Sessions live in memory, so clients must stick to one replica.
+type sessionHost struct {
+	name      string
+	mcpServer *server.MCPServer
+
+	mu       sync.Mutex
+	limits   SessionLimits
+	active   map[string]time.Time // session ID -> start time
+	handlers []*server.StreamableHTTPServer
+	closed   bool
+
+	// done is cancelled on Shutdown to end in-flight requests, including the
+	// long-lived GET streams.
+	done   context.Context
+	cancel context.CancelFunc
+}
+
+func (h *sessionHost) initSessions(name string, mcpServer *server.MCPServer) {
+	h.name = name
+	h.mcpServer = mcpServer
+	h.active = map[string]time.Time{}
+	h.done, h.cancel = context.WithCancel(context.Background())
+}
+
+// SetSessionLimits sets the session limits applied by the handlers created
+// afterwards.
+func (h *sessionHost) SetSessionLimits(limits SessionLimits) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.limits = limits
+}
+
+// Shutdown closes every session: in-flight requests are cancelled, new
+// sessions are refused and each session is unregistered from the MCP server.
+func (h *sessionHost) Shutdown(ctx context.Context) error {
+	h.mu.Lock()
+	h.closed = true
+	active := h.active
+	h.active = map[string]time.Time{}
+	handlers := h.handlers
+	h.mu.Unlock()
+
+	h.cancel()
+	var errs []error
+	for _, handler := range handlers {
+		errs = append(errs, handler.Shutdown(ctx))
+	}
+	for id, start := range active {
+		h.mcpServer.UnregisterSession(ctx, id)
+		metrics.MCPSessionDuration.WithLabelValues(h.name).Observe(time.Since(start).Seconds())
+	}
+	return errors.Join(errs...)
+}
+
+// streamableHandler returns a Streamable HTTP handler backed by the tracked
+// sessions. Requests opening a new session are refused once MaxSessions is
+// reached or after Shutdown.
+func (h *sessionHost) streamableHandler() http.Handler {
+	h.mu.Lock()
+	limits := h.limits
+	h.mu.Unlock()
+
+	handler := server.NewStreamableHTTPServer(h.mcpServer,
+		server.WithSessionIdManager(sessionIDs{h}),
+		server.WithSessionIdleTTL(limits.IdleTimeout),
+	)
+	h.mu.Lock()
+	h.handlers = append(h.handlers, handler)
+	h.mu.Unlock()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		opening := r.Header.Get(server.HeaderKeySessionID) == "" &&
+			(r.Method == http.MethodPost || r.Method == http.MethodGet)
+		if opening && !h.admit() {
+			metrics.MCPSessionsRejectedTotal.WithLabelValues(h.name).Inc()
+			http.Error(w, "too many MCP sessions", http.StatusServiceUnavailable)
+			return
+		}
+
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		stop := context.AfterFunc(h.done, cancel)
+		defer stop()
+		handler.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// admit reports whether a new session may be opened. The check and the
+// session creation are not atomic, so concurrent initializations may exceed
+// MaxSessions by a few.
+func (h *sessionHost) admit() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		return false
+	}
+	return h.limits.MaxSessions <= 0 || len(h.active) < h.limits.MaxSessions
+}
+
+// sessionIDs implements server.SessionIdManager on top of a sessionHost.
+type sessionIDs struct {
+	h *sessionHost
+}
+
+// Generate implements server.SessionIdManager.
+func (s sessionIDs) Generate() string {
+	id := sessionIDPrefix + rand.Text()
+	s.h.mu.Lock()
+	s.h.active[id] = time.Now()
+	s.h.mu.Unlock()
+	return id
+}
+
+// Validate implements server.SessionIdManager.
+func (s sessionIDs) Validate(sessionID string) (bool, error) {
+	s.h.mu.Lock()
+	defer s.h.mu.Unlock()
+	if _, ok := s.h.active[sessionID]; !ok {
+		return false, errUnknownSession
+	}
+	return false, nil
+}
+
+// Terminate implements server.SessionIdManager. It is called when the client
+// deletes the session and when the session expires.
+func (s sessionIDs) Terminate(sessionID string) (bool, error) {
+	s.h.mu.Lock()
+	start, ok := s.h.active[sessionID]
+	delete(s.h.active, sessionID)
+	s.h.mu.Unlock()
+	if ok {
+		metrics.MCPSessionDuration.WithLabelValues(s.h.name).Observe(time.Since(start).Seconds())
+	}
+	return false, nil
+}
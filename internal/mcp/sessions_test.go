@@ -0,0 +1,115 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mcp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/server"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/golgoth31/sreportal/internal/metrics"
+	dnsstore "github.com/golgoth31/sreportal/internal/readstore/dns"
+	portalstore "github.com/golgoth31/sreportal/internal/readstore/portal"
+)
+
+const initializeBody = `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{` +
+	`"protocolVersion":"2025-03-26","capabilities":{},"clientInfo":{"name":"test","version":"1.0.0"}}}`
+
+// initialize opens a session and returns the response.
+func initialize(url string) *http.Response {
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(initializeBody))
+	Expect(err).NotTo(HaveOccurred())
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.Body.Close()).To(Succeed())
+	return resp
+}
+
+// sessionRequest sends a request carrying the given session ID.
+func sessionRequest(method, url, sessionID, body string) *http.Response {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	Expect(err).NotTo(HaveOccurred())
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(server.HeaderKeySessionID, sessionID)
+	resp, err := http.DefaultClient.Do(req)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.Body.Close()).To(Succeed())
+	return resp
+}
+
+var _ = Describe("MCP sessions", func() {
+	var (
+		dnsServer *DNSServer
+		ts        *httptest.Server
+	)
+
+	BeforeEach(func() {
+		dnsServer = NewDNSServer(dnsstore.NewFQDNStore(), portalstore.NewPortalStore())
+		dnsServer.SetSessionLimits(SessionLimits{MaxSessions: 1})
+		ts = httptest.NewServer(dnsServer.Handler())
+		DeferCleanup(ts.Close)
+	})
+
+	It("hands out a session ID and accepts requests carrying it", func() {
+		resp := initialize(ts.URL)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		id := resp.Header.Get(server.HeaderKeySessionID)
+		Expect(id).To(HavePrefix(sessionIDPrefix))
+
+		ping := sessionRequest(http.MethodPost, ts.URL, id, `{"jsonrpc":"2.0","id":2,"method":"ping"}`)
+		Expect(ping.StatusCode).To(Equal(http.StatusOK))
+	})
+
+	It("rejects unknown session IDs", func() {
+		resp := sessionRequest(http.MethodPost, ts.URL, sessionIDPrefix+"unknown", `{"jsonrpc":"2.0","id":2,"method":"ping"}`)
+		Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+	})
+
+	It("refuses sessions above the limit until one is deleted", func() {
+		rejected := testutil.ToFloat64(metrics.MCPSessionsRejectedTotal.WithLabelValues("dns"))
+
+		first := initialize(ts.URL)
+		Expect(first.StatusCode).To(Equal(http.StatusOK))
+		Expect(initialize(ts.URL).StatusCode).To(Equal(http.StatusServiceUnavailable))
+		Expect(testutil.ToFloat64(metrics.MCPSessionsRejectedTotal.WithLabelValues("dns"))).To(Equal(rejected + 1))
+
+		del := sessionRequest(http.MethodDelete, ts.URL, first.Header.Get(server.HeaderKeySessionID), "")
+		Expect(del.StatusCode).To(Equal(http.StatusOK))
+		Expect(initialize(ts.URL).StatusCode).To(Equal(http.StatusOK))
+	})
+
+	It("closes every session on shutdown", func() {
+		active := testutil.ToFloat64(metrics.MCPSessionsActive.WithLabelValues("dns"))
+		resp := initialize(ts.URL)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(testutil.ToFloat64(metrics.MCPSessionsActive.WithLabelValues("dns"))).To(Equal(active + 1))
+
+		Expect(dnsServer.Shutdown(context.Background())).To(Succeed())
+
+		Expect(testutil.ToFloat64(metrics.MCPSessionsActive.WithLabelValues("dns"))).To(Equal(active))
+		Expect(initialize(ts.URL).StatusCode).To(Equal(http.StatusServiceUnavailable))
+		ping := sessionRequest(http.MethodPost, ts.URL, resp.Header.Get(server.HeaderKeySessionID), `{"jsonrpc":"2.0","id":2,"method":"ping"}`)
+		Expect(ping.StatusCode).To(Equal(http.StatusNotFound))
+	})
+})
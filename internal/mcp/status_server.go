@@ -38,6 +38,8 @@ type StatusServer struct {
 	componentReader   domaincomponent.ComponentReader
 	maintenanceReader domainmaint.MaintenanceReader
 	incidentReader    domainincident.IncidentReader
+
+	sessionHost
 }
 
 // NewStatusServer creates a new MCP server instance for status page.
@@ -78,6 +80,7 @@ func NewStatusServer(
 		server.WithToolCapabilities(true),
 		server.WithHooks(hooks),
 	)
+	s.initSessions("status", s.mcpServer)
 
 	s.registerTools()
 
@@ -376,5 +379,5 @@ func (s *StatusServer) handleGetPlatformStatus(ctx context.Context, request mcp.
 
 // Handler returns an http.Handler for the MCP Streamable HTTP transport.
 func (s *StatusServer) Handler() http.Handler {
-	return server.NewStreamableHTTPServer(s.mcpServer)
+	return s.streamableHandler()
 }
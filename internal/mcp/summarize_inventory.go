@@ -0,0 +1,128 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	"github.com/golgoth31/sreportal/pkg/inventory"
+)
+
+// unknownBucket is the bucket of FQDNs with no value for a dimension (e.g. not
+// resolved yet, no namespace).
+const unknownBucket = "unknown"
+
+// InventorySummary holds FQDN counts bucketed by dimension. An FQDN in several
+// groups is counted once in each of them.
+type InventorySummary struct {
+	Portal          string         `json:"portal,omitempty"`
+	Total           int            `json:"total"`
+	BySource        map[string]int `json:"by_source"`
+	ByGroup         map[string]int `json:"by_group"`
+	ByRecordType    map[string]int `json:"by_record_type"`
+	BySyncStatus    map[string]int `json:"by_sync_status"`
+	ByOverallStatus map[string]int `json:"by_overall_status"`
+	ByNamespace     map[string]int `json:"by_namespace"`
+}
+
+func newInventorySummary(portal string) *InventorySummary {
+	return &InventorySummary{
+		Portal:          portal,
+		BySource:        map[string]int{},
+		ByGroup:         map[string]int{},
+		ByRecordType:    map[string]int{},
+		BySyncStatus:    map[string]int{},
+		ByOverallStatus: map[string]int{},
+		ByNamespace:     map[string]int{},
+	}
+}
+
+func (s *InventorySummary) add(v domaindns.FQDNView) {
+	s.Total++
+	s.BySource[bucket(string(v.Source))]++
+	s.ByRecordType[bucket(v.RecordType)]++
+	s.BySyncStatus[bucket(v.SyncStatus)]++
+	s.ByOverallStatus[bucket(string(v.OverallStatus))]++
+	s.ByNamespace[bucket(v.Namespace)]++
+	for _, g := range inventory.EffectiveGroups(v.Groups, unknownBucket) {
+		s.ByGroup[g]++
+	}
+}
+
+func bucket(v string) string {
+	if v == "" {
+		return unknownBucket
+	}
+	return v
+}
+
+// handleSummarizeInventory handles the summarize_inventory tool call
+func (s *DNSServer) handleSummarizeInventory(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	portal := request.GetString("portal", "")
+	perPortal := request.GetBool("per_portal", false)
+
+	views, err := s.fqdnReader.List(ctx, domaindns.FQDNFilters{Portal: portal})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list FQDNs: %v", err)), nil
+	}
+
+	total := newInventorySummary(portal)
+	byPortal := map[string]*InventorySummary{}
+	for _, v := range views {
+		if !s.sensitive.Visible(v.Name, false) {
+			continue
+		}
+		total.add(v)
+		if !perPortal {
+			continue
+		}
+		for _, p := range v.Portals {
+			if portal != "" && p != portal {
+				continue
+			}
+			ps := byPortal[p]
+			if ps == nil {
+				ps = newInventorySummary(p)
+				byPortal[p] = ps
+			}
+			ps.add(v)
+		}
+	}
+
+	var result any = total
+	if perPortal {
+		out := make([]*InventorySummary, 0, len(byPortal))
+		for _, ps := range byPortal {
+			out = append(out, ps)
+		}
+		// Deterministic order for diff-friendly tool output.
+		sort.Slice(out, func(i, j int) bool { return out[i].Portal < out[j].Portal })
+		result = out
+	}
+
+	jsonBytes, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to marshal results: %v", err)), nil
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("Inventory summary of %d FQDN(s):\n\n%s", total.Total, string(jsonBytes))), nil
+}
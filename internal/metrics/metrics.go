@@ -34,6 +34,9 @@ const (
 	subsystemSource        = "source"
 	subsystemImageRegistry = "imageregistry"
 	subsystemDNS           = "dns"
+	subsystemAgent         = "agent"
+	subsystemScaleGuard    = "scaleguard"
+	subsystemStream        = "stream"
 
 	labelKind       = "kind"
 	labelName       = "name"
@@ -46,6 +49,10 @@ const (
 	labelNamespace  = "namespace"
 	labelResult     = "result"
 	labelHandler    = "handler"
+	labelChange     = "change"
+	labelAgent      = "agent"
+	labelResource   = "resource"
+	labelLevel      = "level"
 )
 
 // --- Controller metrics ---
@@ -77,6 +84,31 @@ var (
 		[]string{subsystemController, labelHandler},
 	)
 
+	// ChainHandlerErrorsTotal counts the chain handlers that failed, i.e.
+	// returned an error other than a short circuit or a shutdown cancellation.
+	ChainHandlerErrorsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystemController,
+			Name:      "chain_handler_errors_total",
+			Help:      "Total number of chain handler failures per controller and handler.",
+		},
+		[]string{subsystemController, labelHandler},
+	)
+
+	// ChainHandlerSkippedTotal counts the chain handlers not run because an
+	// earlier handler stopped the chain. reason is the outcome of that handler
+	// (error, short_circuit, requeue, shutdown).
+	ChainHandlerSkippedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystemController,
+			Name:      "chain_handler_skipped_total",
+			Help:      "Total number of chain handlers skipped per controller, handler and reason (error, short_circuit, requeue, shutdown).",
+		},
+		[]string{subsystemController, labelHandler, "reason"},
+	)
+
 	// ReadstoreWriterErrors counts errors when projecting reconciled state into
 	// in-memory read stores. These errors do not fail the reconcile, but are
 	// recorded so operators can spot drift between CRD state and the read path
@@ -141,6 +173,20 @@ var (
 		[]string{labelNamespace, labelName, labelKind, "reason"},
 	)
 
+	// DNSSourcePriorityConflicts tracks the FQDNs produced by several source
+	// kinds on the last reconcile, per DNS resource, winning kind and losing
+	// kind. The losing kind's endpoints for those FQDNs are dropped. Stale
+	// series are reclaimed by SourcesStatusHandler and ResetDNSEntryMetrics.
+	DNSSourcePriorityConflicts = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystemDNS,
+			Name:      "source_priority_conflicts",
+			Help:      "Number of FQDNs whose endpoints from a lower-priority source kind were dropped on the last reconcile, per DNS resource (namespace, name), winning and losing kind.",
+		},
+		[]string{labelNamespace, labelName, "winner", "loser"},
+	)
+
 	// SourceEndpointsCollected tracks the number of endpoints collected per source type.
 	SourceEndpointsCollected = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -163,6 +209,18 @@ var (
 		[]string{labelSourceType},
 	)
 
+	// SourceFaultsInjected counts the source collections made to fail by
+	// fault injection. They are also counted in SourceErrorsTotal.
+	SourceFaultsInjected = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystemSource,
+			Name:      "faults_injected_total",
+			Help:      "Total number of source collections failed on purpose by fault injection, per source type.",
+		},
+		[]string{labelSourceType},
+	)
+
 	// SourceSkippedUpdates counts status updates skipped because the endpoints hash was unchanged.
 	SourceSkippedUpdates = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -226,6 +284,31 @@ var (
 		[]string{labelKind},
 	)
 
+	// SourceLastCollectionDuration is the time the last successful collection
+	// of a kind took, in seconds. It changes every cycle, so it is exported
+	// here rather than stamped on the DNSRecord status.
+	SourceLastCollectionDuration = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystemSource,
+			Name:      "last_collection_duration_seconds",
+			Help:      "Duration of the last successful endpoint collection applied to the store, per source kind.",
+		},
+		[]string{labelKind},
+	)
+
+	// SourceConsecutiveFailures is the number of collections that failed since
+	// the last success, per kind (0 for a healthy kind).
+	SourceConsecutiveFailures = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystemSource,
+			Name:      "consecutive_failures",
+			Help:      "Number of source collections that failed since the last success, per source kind.",
+		},
+		[]string{labelKind},
+	)
+
 	// SourceEnrichmentFailures counts endpoints that were kept WITHOUT their
 	// source-object metadata (labels/annotations, incl. sreportal.io/groups)
 	// because the re-fetch from the cache failed or the external-dns "resource"
@@ -256,6 +339,45 @@ var (
 		[]string{labelPortal},
 	)
 
+	// DNSFQDNsAddedTotal counts FQDNs that appeared in a portal, i.e. keys
+	// the portal did not list before a store write. Together with
+	// DNSFQDNsRemovedTotal and DNSPortalFQDNs it makes abnormal churn (such
+	// as a misdeployed ingress controller wiping hostnames) alertable.
+	DNSFQDNsAddedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystemDNS,
+			Name:      "fqdns_added_total",
+			Help:      "Total number of FQDNs added to a portal, per portal.",
+		},
+		[]string{labelPortal},
+	)
+
+	// DNSFQDNsRemovedTotal counts FQDNs that disappeared from a portal.
+	DNSFQDNsRemovedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystemDNS,
+			Name:      "fqdns_removed_total",
+			Help:      "Total number of FQDNs removed from a portal, per portal.",
+		},
+		[]string{labelPortal},
+	)
+
+	// DNSPortalFQDNs is the number of distinct (name, recordType) entries a
+	// portal exposes, after dedup across DNSRecords. Unlike DNSFQDNsTotal it
+	// counts what the portal lists, not what each source contributes. It
+	// drops to 0, rather than disappearing, when a portal loses every FQDN.
+	DNSPortalFQDNs = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystemDNS,
+			Name:      "portal_fqdns",
+			Help:      "Number of distinct FQDNs exposed per portal.",
+		},
+		[]string{labelPortal},
+	)
+
 	// DNSFQDNDedupRatio measures the dedup gain of the FQDN store, per portal:
 	// (raw_writes - unique_keys) / raw_writes, where raw_writes is the total
 	// number of contributions from DNSRecords assigned to that portal and
@@ -285,6 +407,100 @@ var (
 		},
 	)
 
+	// DNSConsistencyMismatches reports, per check, the number of
+	// discrepancies found by the last consistency check between DNSRecord
+	// spec.entries, DNSRecord status and the FQDN read store. Only
+	// discrepancies seen on two consecutive checks are counted, so an
+	// in-flight reconcile does not show up. A non-zero value points at an
+	// aggregation bug.
+	DNSConsistencyMismatches = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystemDNS,
+			Name:      "consistency_mismatches",
+			Help:      "Number of persistent discrepancies found by the last DNS consistency check, per check.",
+		},
+		[]string{"check"},
+	)
+
+	// DNSUniquenessIssues reports, per severity, the number of FQDNs listed
+	// in several portals or whose DNSRecords disagree on the targets, as
+	// found by the last uniqueness check.
+	DNSUniquenessIssues = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystemDNS,
+			Name:      "uniqueness_issues",
+			Help:      "Number of FQDNs not unique across portals and sources found by the last uniqueness check, per severity.",
+		},
+		[]string{"severity"},
+	)
+
+	// DNSProbeLatency observes the duration of the successful connection probes,
+	// per probe type. The DNSRecord status only keeps the latency of the probe
+	// that last changed an outcome.
+	DNSProbeLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystemDNS,
+			Name:      "probe_latency_seconds",
+			Help:      "Duration of the successful FQDN connection probes, per probe type.",
+			Buckets:   []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5},
+		},
+		[]string{"type"},
+	)
+
+	// DNSConsistencyLastCheck is the Unix time of the last completed
+	// consistency check.
+	DNSConsistencyLastCheck = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystemDNS,
+			Name:      "consistency_last_check_timestamp_seconds",
+			Help:      "Unix time of the last completed DNS consistency check.",
+		},
+	)
+
+	// DNSLookupFailuresTotal counts failed DNS checks by failure class
+	// (nxdomain, servfail, timeout, refused, error) and record type, after
+	// retries. A rise in servfail/timeout points at the DNS servers, a rise in
+	// nxdomain at missing records.
+	DNSLookupFailuresTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystemDNS,
+			Name:      "lookup_failures_total",
+			Help:      "Total number of failed DNS checks, per failure class and record type.",
+		},
+		[]string{"failure", "record_type"},
+	)
+
+	// DNSOwnershipConflictsTotal counts the endpoints whose targets started
+	// oscillating between sets across reconciles, by record type. It points at
+	// several external-dns deployments managing the same records.
+	DNSOwnershipConflictsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystemDNS,
+			Name:      "ownership_conflicts_total",
+			Help:      "Total number of endpoints detected with targets oscillating between sets, per record type.",
+		},
+		[]string{"record_type"},
+	)
+
+	// DNSTTLDriftsTotal counts the endpoints whose served TTL started
+	// exceeding their declared TTL, by record type. Such records fail over
+	// slower than expected.
+	DNSTTLDriftsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystemDNS,
+			Name:      "ttl_drifts_total",
+			Help:      "Total number of endpoints detected with a served TTL exceeding the declared TTL, per record type.",
+		},
+		[]string{"record_type"},
+	)
+
 	// AlertsActive tracks the number of active alerts per portal and alertmanager.
 	AlertsActive = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -367,6 +583,117 @@ var (
 		},
 		[]string{labelPortal},
 	)
+
+	// PortalRemoteSyncDuration tracks how long the last successful sync of each remote portal took.
+	PortalRemoteSyncDuration = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystemPortal,
+			Name:      "remote_sync_duration_seconds",
+			Help:      "Duration of the last successful sync with a remote portal.",
+		},
+		[]string{labelPortal},
+	)
+
+	// PortalSnapshotPublishTotal counts the OCI snapshot publications by
+	// result ("pushed", "unchanged", "error").
+	PortalSnapshotPublishTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystemPortal,
+			Name:      "snapshot_publish_total",
+			Help:      "Total number of portal snapshot publications to the OCI registry, by result.",
+		},
+		[]string{labelResult},
+	)
+
+	// PortalCMDBExportTotal counts the CMDB exports by result ("exported",
+	// "unchanged", "error").
+	PortalCMDBExportTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystemPortal,
+			Name:      "cmdb_export_total",
+			Help:      "Total number of FQDN inventory exports to the CMDB, by result.",
+		},
+		[]string{labelResult},
+	)
+
+	// PortalCMDBExportChangesTotal counts the FQDNs sent to the CMDB by
+	// change ("added", "updated", "removed").
+	PortalCMDBExportChangesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystemPortal,
+			Name:      "cmdb_export_changes_total",
+			Help:      "Total number of FQDN changes exported to the CMDB, by change.",
+		},
+		[]string{labelChange},
+	)
+
+	// PortalDigestTotal counts the scheduled portal digests by result
+	// ("delivered", "error").
+	PortalDigestTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystemPortal,
+			Name:      "digest_total",
+			Help:      "Total number of scheduled portal digests, by result.",
+		},
+		[]string{labelResult},
+	)
+
+	// PortalUsageEventsTotal counts the opt-in usage events of the web UI by
+	// portal and event ("page_view", "search", "group_click").
+	PortalUsageEventsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystemPortal,
+			Name:      "usage_events_total",
+			Help:      "Total number of web UI usage events recorded by the opt-in analytics, by portal and event.",
+		},
+		[]string{labelPortal, "event"},
+	)
+)
+
+// --- Agent metrics ---
+
+var (
+	// AgentPublishTotal counts the pushes of an agent to the central instance
+	// by result ("success", "error").
+	AgentPublishTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystemAgent,
+			Name:      "publish_total",
+			Help:      "Total number of FQDN pushes to the central instance, by result.",
+		},
+		[]string{labelResult},
+	)
+
+	// AgentLastPublishTimestamp is the Unix time of the last push received
+	// from each agent, on the central instance.
+	AgentLastPublishTimestamp = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystemAgent,
+			Name:      "last_publish_timestamp_seconds",
+			Help:      "Unix time of the last FQDN push received from each agent.",
+		},
+		[]string{labelAgent},
+	)
+
+	// AgentFQDNs is the number of FQDNs stored for each agent, on the central
+	// instance.
+	AgentFQDNs = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystemAgent,
+			Name:      "fqdns",
+			Help:      "Number of FQDNs stored for each agent.",
+		},
+		[]string{labelAgent},
+	)
 )
 
 // --- Release metrics ---
@@ -488,6 +815,75 @@ var (
 	)
 )
 
+// --- FQDN stream metrics ---
+
+var (
+	// StreamSnapshotBytes tracks the encoded size of the FQDN snapshot shared
+	// by the StreamFQDNs subscribers.
+	StreamSnapshotBytes = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystemStream,
+			Name:      "snapshot_bytes",
+			Help:      "Encoded size in bytes of the FQDN snapshot shared by the stream subscribers.",
+		},
+	)
+
+	// StreamsOpen tracks the open StreamFQDNs streams.
+	StreamsOpen = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystemStream,
+			Name:      "open",
+			Help:      "Number of open FQDN streams.",
+		},
+	)
+
+	// StreamTopics tracks the FQDN stream topics, each shared by the streams
+	// with the same filters.
+	StreamTopics = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystemStream,
+			Name:      "topics",
+			Help:      "Number of FQDN stream topics, each shared by the streams with the same filters.",
+		},
+	)
+
+	// StreamTopicStateBytes estimates the memory held by the state of the
+	// FQDN stream topics.
+	StreamTopicStateBytes = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystemStream,
+			Name:      "topic_state_bytes",
+			Help:      "Estimated memory in bytes held by the state of the FQDN stream topics.",
+		},
+	)
+
+	// StreamSubscriberStateBytes estimates the memory held by the per-subscriber
+	// state of the StreamFQDNs calls.
+	StreamSubscriberStateBytes = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystemStream,
+			Name:      "subscriber_state_bytes",
+			Help:      "Estimated memory in bytes held by the per-subscriber state of the FQDN streams.",
+		},
+	)
+
+	// StreamEvictionsTotal counts the subscribers disconnected because they
+	// stopped reading.
+	StreamEvictionsTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystemStream,
+			Name:      "evictions_total",
+			Help:      "Total number of FQDN stream subscribers disconnected for not reading their updates.",
+		},
+	)
+)
+
 // --- MCP server metrics ---
 
 var (
@@ -535,6 +931,30 @@ var (
 		},
 		[]string{labelServer},
 	)
+
+	// MCPSessionsRejectedTotal counts the MCP sessions refused because the
+	// server reached its session limit or was shutting down.
+	MCPSessionsRejectedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystemMCP,
+			Name:      "sessions_rejected_total",
+			Help:      "Total number of MCP sessions refused by the session limit, per server.",
+		},
+		[]string{labelServer},
+	)
+
+	// MCPSessionDuration tracks the lifetime of closed MCP sessions per server.
+	MCPSessionDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystemMCP,
+			Name:      "session_duration_seconds",
+			Help:      "Lifetime of closed MCP sessions, per server.",
+			Buckets:   []float64{1, 10, 60, 300, 900, 1800, 3600, 4 * 3600, 24 * 3600},
+		},
+		[]string{labelServer},
+	)
 )
 
 // --- Image registry metrics ---
@@ -617,13 +1037,83 @@ func ResetImageRegistryMetrics(portal, host, namespace string) {
 	ImageRegistryInjectedTotal.DeleteLabelValues(portal, host, namespace)
 }
 
-// ResetDNSEntryMetrics removes every entries_valid / entries_invalid_total
-// series for the given DNS resource (all kinds/reasons). Called from the DNS
-// reconcile when the DNS CR is gone (Get → NotFound) so a deleted resource does
-// not leave phantom series behind.
+// --- Scale guard metrics ---
+
+var (
+	// ScaleGuardCount is the last count of each guarded resource (dns,
+	// dnsrecords, fqdns, streams).
+	ScaleGuardCount = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystemScaleGuard,
+			Name:      "count",
+			Help:      "Last count of each resource guarded by the scale guard.",
+		},
+		[]string{labelResource},
+	)
+
+	// ScaleGuardLimit is the configured soft and hard limit of each guarded
+	// resource (0 when disabled).
+	ScaleGuardLimit = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystemScaleGuard,
+			Name:      "limit",
+			Help:      "Configured scale guard limit per resource and level (0 when disabled).",
+		},
+		[]string{labelResource, labelLevel},
+	)
+
+	// ScaleGuardLimitReached is 1 while the count of a resource is at or
+	// above its soft or hard limit.
+	ScaleGuardLimitReached = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystemScaleGuard,
+			Name:      "limit_reached",
+			Help:      "1 while the count of a resource is at or above its scale guard limit, per level.",
+		},
+		[]string{labelResource, labelLevel},
+	)
+
+	// ScaleGuardDiscoveryPaused is 1 while source discovery is paused because
+	// the DNS CRs, DNSRecords or FQDNs reached their hard limit.
+	ScaleGuardDiscoveryPaused = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystemScaleGuard,
+			Name:      "discovery_paused",
+			Help:      "1 while source discovery is paused by a scale guard hard limit.",
+		},
+	)
+
+	// ScaleGuardStreamsRejectedTotal counts the StreamFQDNs calls refused
+	// because the open streams reached their hard limit.
+	ScaleGuardStreamsRejectedTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystemScaleGuard,
+			Name:      "streams_rejected_total",
+			Help:      "Total number of FQDN streams refused by the scale guard hard limit.",
+		},
+	)
+)
+
+// ResetDNSEntryMetrics removes every entries_valid / entries_invalid_total /
+// source_priority_conflicts series for the given DNS resource (all
+// kinds/reasons). Called from the DNS reconcile when the DNS CR is gone (Get →
+// NotFound) so a deleted resource does not leave phantom series behind.
 func ResetDNSEntryMetrics(namespace, name string) {
 	DNSEntriesValid.DeletePartialMatch(prometheus.Labels{labelNamespace: namespace, labelName: name})
 	DNSEntriesInvalid.DeletePartialMatch(prometheus.Labels{labelNamespace: namespace, labelName: name})
+	DeleteDNSSourcePriorityConflictSeries(namespace, name)
+}
+
+// DeleteDNSSourcePriorityConflictSeries removes the source_priority_conflicts
+// gauge series (all kinds) for the given DNS resource. Called each reconcile
+// before re-setting so a resolved conflict does not leave a frozen gauge.
+func DeleteDNSSourcePriorityConflictSeries(namespace, name string) {
+	DNSSourcePriorityConflicts.DeletePartialMatch(prometheus.Labels{labelNamespace: namespace, labelName: name})
 }
 
 // DeleteDNSEntriesValidSeries removes the entries_valid gauge series (all kinds)
@@ -641,26 +1131,44 @@ func init() {
 		// Controller
 		ReconcileTotal,
 		ReconcileDuration,
+		ChainHandlerErrorsTotal,
+		ChainHandlerSkippedTotal,
 		ReadstoreWriterErrors,
 		// DNS
 		DNSFQDNsTotal,
 		DNSGroupsTotal,
 		DNSEntriesValid,
 		DNSEntriesInvalid,
+		DNSSourcePriorityConflicts,
 		// Source
 		SourceEndpointsCollected,
 		SourceErrorsTotal,
+		SourceFaultsInjected,
 		SourceSkippedUpdates,
 		SourceNotifyDropped,
 		SourceKindActive,
 		SourceDropGuardTriggered,
 		SourceLastSuccessfulSync,
+		SourceLastCollectionDuration,
+		SourceConsecutiveFailures,
 		SourceEnrichmentFailures,
 		// DNS conflicts
 		DNSTargetsConflictTotal,
 		// DNS readstore
+		DNSFQDNsAddedTotal,
+		DNSFQDNsRemovedTotal,
+		DNSPortalFQDNs,
 		DNSFQDNDedupRatio,
 		DNSFQDNRefCount,
+		// DNS consistency
+		DNSConsistencyMismatches,
+		DNSConsistencyLastCheck,
+		DNSUniquenessIssues,
+		DNSProbeLatency,
+		// DNS resolution
+		DNSLookupFailuresTotal,
+		DNSOwnershipConflictsTotal,
+		DNSTTLDriftsTotal,
 		// Alertmanager
 		AlertsActive,
 		AlertsFetchErrorsTotal,
@@ -671,6 +1179,16 @@ func init() {
 		PortalsTotal,
 		PortalRemoteSyncErrorsTotal,
 		PortalRemoteFQDNsSynced,
+		PortalRemoteSyncDuration,
+		PortalSnapshotPublishTotal,
+		PortalCMDBExportTotal,
+		PortalCMDBExportChangesTotal,
+		PortalDigestTotal,
+		PortalUsageEventsTotal,
+		// Agent
+		AgentPublishTotal,
+		AgentLastPublishTimestamp,
+		AgentFQDNs,
 		// Release
 		ReleaseEntriesTotal,
 		ReleaseAddTotal,
@@ -684,11 +1202,20 @@ func init() {
 		HTTPRequestsTotal,
 		HTTPRequestDuration,
 		HTTPRequestsInFlight,
+		// FQDN streams
+		StreamSnapshotBytes,
+		StreamsOpen,
+		StreamTopics,
+		StreamTopicStateBytes,
+		StreamSubscriberStateBytes,
+		StreamEvictionsTotal,
 		// MCP
 		MCPToolCallsTotal,
 		MCPToolCallDuration,
 		MCPToolCallErrorsTotal,
 		MCPSessionsActive,
+		MCPSessionsRejectedTotal,
+		MCPSessionDuration,
 		// Image registry
 		ImageRegistryEntriesTotal,
 		ImageRegistryUpgradesTotal,
@@ -696,5 +1223,11 @@ func init() {
 		ImageRegistryInjectedTotal,
 		RegistryLookupTotal,
 		RegistryLookupDuration,
+		// Scale guard
+		ScaleGuardCount,
+		ScaleGuardLimit,
+		ScaleGuardLimitReached,
+		ScaleGuardDiscoveryPaused,
+		ScaleGuardStreamsRejectedTotal,
 	)
 }
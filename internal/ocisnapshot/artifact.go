@@ -0,0 +1,170 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ocisnapshot moves FQDN snapshots (serialized ListFQDNsResponse)
+// through an OCI registry: the Publisher pushes the local snapshot, and remote
+// portals pull it with Pull, for federation between instances with no direct
+// connectivity.
+package ocisnapshot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ConfigMediaType identifies a snapshot artifact.
+	ConfigMediaType types.MediaType = "application/vnd.sreportal.snapshot.config.v1+json"
+	// LayerMediaType is the media type of the layer carrying the snapshot, a
+	// ListFQDNsResponse in protobuf JSON.
+	LayerMediaType types.MediaType = "application/vnd.sreportal.fqdns.v1+json"
+
+	// maxSnapshotSize bounds the snapshot read from a registry.
+	maxSnapshotSize = 64 << 20
+)
+
+// ErrNoSnapshotLayer is returned when the pulled artifact has no snapshot layer.
+var ErrNoSnapshotLayer = errors.New("artifact has no snapshot layer")
+
+// Push pushes data as a snapshot artifact to ref and returns its digest.
+func Push(ctx context.Context, ref string, data []byte, auth authn.Authenticator) (string, error) {
+	tag, err := name.ParseReference(ref)
+	if err != nil {
+		return "", fmt.Errorf("parse reference %q: %w", ref, err)
+	}
+	img, err := mutate.AppendLayers(empty.Image, static.NewLayer(data, LayerMediaType))
+	if err != nil {
+		return "", fmt.Errorf("build artifact: %w", err)
+	}
+	img = mutate.ConfigMediaType(mutate.MediaType(img, types.OCIManifestSchema1), ConfigMediaType)
+
+	if err := remote.Write(tag, img, remote.WithContext(ctx), remote.WithAuth(auth)); err != nil {
+		return "", fmt.Errorf("push %s: %w", ref, err)
+	}
+	digest, err := img.Digest()
+	if err != nil {
+		return "", fmt.Errorf("digest: %w", err)
+	}
+	return digest.String(), nil
+}
+
+// Pull returns the snapshot carried by the artifact at ref.
+func Pull(ctx context.Context, ref string, auth authn.Authenticator) ([]byte, error) {
+	r, err := name.ParseReference(ref)
+	if err != nil {
+		return nil, fmt.Errorf("parse reference %q: %w", ref, err)
+	}
+	img, err := remote.Image(r, remote.WithContext(ctx), remote.WithAuth(auth))
+	if err != nil {
+		return nil, fmt.Errorf("pull %s: %w", ref, err)
+	}
+	layers, err := img.Layers()
+	if err != nil {
+		return nil, fmt.Errorf("read layers of %s: %w", ref, err)
+	}
+	for _, l := range layers {
+		if mt, err := l.MediaType(); err != nil || mt != LayerMediaType {
+			continue
+		}
+		return readLayer(l)
+	}
+	return nil, fmt.Errorf("%s: %w", ref, ErrNoSnapshotLayer)
+}
+
+func readLayer(l v1.Layer) ([]byte, error) {
+	rc, err := l.Compressed()
+	if err != nil {
+		return nil, fmt.Errorf("open snapshot layer: %w", err)
+	}
+	defer func() { _ = rc.Close() }()
+	data, err := io.ReadAll(io.LimitReader(rc, maxSnapshotSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read snapshot layer: %w", err)
+	}
+	if len(data) > maxSnapshotSize {
+		return nil, fmt.Errorf("snapshot layer exceeds %d bytes", maxSnapshotSize)
+	}
+	return data, nil
+}
+
+// Auth returns the credentials for the registry of ref, read from the Secret
+// namespace/secretName. An empty secretName means anonymous access.
+func Auth(ctx context.Context, reader client.Reader, namespace, secretName, ref string) (authn.Authenticator, error) {
+	if secretName == "" {
+		return authn.Anonymous, nil
+	}
+	r, err := name.ParseReference(ref)
+	if err != nil {
+		return nil, fmt.Errorf("parse reference %q: %w", ref, err)
+	}
+	var secret corev1.Secret
+	if err := reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: secretName}, &secret); err != nil {
+		return nil, fmt.Errorf("get credentials secret %s/%s: %w", namespace, secretName, err)
+	}
+	return authFromSecret(&secret, r.Context().RegistryStr())
+}
+
+// authFromSecret reads the credentials of registry from a dockerconfigjson
+// Secret, or from its "username" and "password" keys.
+func authFromSecret(secret *corev1.Secret, registry string) (authn.Authenticator, error) {
+	if raw, ok := secret.Data[corev1.DockerConfigJsonKey]; ok {
+		var cfg struct {
+			Auths map[string]authn.AuthConfig `json:"auths"`
+		}
+		if err := json.Unmarshal(raw, &cfg); err != nil {
+			return nil, fmt.Errorf("decode %s of secret %s: %w", corev1.DockerConfigJsonKey, secret.Name, err)
+		}
+		for host, ac := range cfg.Auths {
+			if registryHost(host) == registry {
+				return authn.FromConfig(ac), nil
+			}
+		}
+		return nil, fmt.Errorf("secret %s has no credentials for registry %s", secret.Name, registry)
+	}
+	user, pass := secret.Data["username"], secret.Data["password"]
+	if len(user) == 0 || len(pass) == 0 {
+		return nil, fmt.Errorf("secret %s has neither %s nor username and password keys", secret.Name, corev1.DockerConfigJsonKey)
+	}
+	return &authn.Basic{Username: string(user), Password: string(pass)}, nil
+}
+
+// registryHost normalizes a docker config "auths" key ("https://host/v1/",
+// "host") to the registry name used by go-containerregistry.
+func registryHost(key string) string {
+	key = strings.TrimPrefix(strings.TrimPrefix(key, "https://"), "http://")
+	if i := strings.Index(key, "/"); i >= 0 {
+		key = key[:i]
+	}
+	if key == "docker.io" {
+		return name.DefaultRegistry
+	}
+	return key
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocisnapshot
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/golgoth31/sreportal/internal/config"
+	sreportalv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	"github.com/golgoth31/sreportal/internal/remoteclient"
+)
+
+const tNS = "sreportal-system"
+
+func newRegistry(t *testing.T) string {
+	t.Helper()
+	srv := httptest.NewServer(registry.New())
+	t.Cleanup(srv.Close)
+	return strings.TrimPrefix(srv.URL, "http://")
+}
+
+func TestPushPull(t *testing.T) {
+	ref := newRegistry(t) + "/sreportal/site-a:latest"
+	data := []byte(`{"fqdns":[{"name":"app.example.com"}]}`)
+
+	digest, err := Push(context.Background(), ref, data, authn.Anonymous)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(digest, "sha256:"))
+
+	got, err := Pull(context.Background(), ref, authn.Anonymous)
+	require.NoError(t, err)
+	assert.Equal(t, data, got)
+}
+
+func TestPull_MissingArtifact(t *testing.T) {
+	_, err := Pull(context.Background(), newRegistry(t)+"/sreportal/none:latest", authn.Anonymous)
+	assert.Error(t, err)
+}
+
+func TestAuthFromSecret(t *testing.T) {
+	docker := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "creds"},
+		Data: map[string][]byte{corev1.DockerConfigJsonKey: []byte(
+			`{"auths":{"https://registry.example.com/v1/":{"username":"u","password":"p"}}}`)},
+	}
+	auth, err := authFromSecret(docker, "registry.example.com")
+	require.NoError(t, err)
+	cfg, err := auth.Authorization()
+	require.NoError(t, err)
+	assert.Equal(t, "u", cfg.Username)
+	assert.Equal(t, "p", cfg.Password)
+
+	_, err = authFromSecret(docker, "other.example.com")
+	assert.Error(t, err)
+
+	basic := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "basic"},
+		Data:       map[string][]byte{"username": []byte("u"), "password": []byte("p")},
+	}
+	auth, err = authFromSecret(basic, "registry.example.com")
+	require.NoError(t, err)
+	cfg, err = auth.Authorization()
+	require.NoError(t, err)
+	assert.Equal(t, "u", cfg.Username)
+
+	_, err = authFromSecret(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "empty"}}, "registry.example.com")
+	assert.Error(t, err)
+}
+
+func TestAuth_NoSecretIsAnonymous(t *testing.T) {
+	auth, err := Auth(context.Background(), nil, tNS, "", "registry.example.com/s:latest")
+	require.NoError(t, err)
+	assert.Equal(t, authn.Anonymous, auth)
+}
+
+type stubLister struct {
+	resp  *sreportalv1.ListFQDNsResponse
+	calls int
+}
+
+func (s *stubLister) ListFQDNs(_ context.Context, _ *connect.Request[sreportalv1.ListFQDNsRequest]) (*connect.Response[sreportalv1.ListFQDNsResponse], error) {
+	s.calls++
+	return connect.NewResponse(s.resp), nil
+}
+
+func TestPublisher_PublishesAndSkipsUnchanged(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	reader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: tNS},
+		Data:       map[string][]byte{"username": []byte("u"), "password": []byte("p")},
+	}).Build()
+	lister := &stubLister{resp: &sreportalv1.ListFQDNsResponse{Fqdns: []*sreportalv1.FQDN{
+		{Name: "app.example.com", RecordType: "A", Groups: []string{"apps"}, Portals: []string{"main"}},
+	}}}
+	cfg := config.SnapshotPublisherConfig{
+		Enabled:           true,
+		Repository:        newRegistry(t) + "/sreportal/site-a",
+		Tag:               "latest",
+		CredentialsSecret: "creds",
+	}
+	p := NewPublisher(lister, reader, tNS, cfg)
+
+	require.NoError(t, p.publish(context.Background()))
+	first := p.last
+	require.NotEmpty(t, first)
+
+	data, err := Pull(context.Background(), p.Ref(), authn.Anonymous)
+	require.NoError(t, err)
+	result, err := remoteclient.DecodeSnapshot(data, "main")
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.FQDNCount)
+
+	require.NoError(t, p.publish(context.Background()))
+	assert.Equal(t, 2, lister.calls)
+	assert.Equal(t, first, p.last)
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocisnapshot
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/golgoth31/sreportal/internal/config"
+	sreportalv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	"github.com/golgoth31/sreportal/internal/log"
+	"github.com/golgoth31/sreportal/internal/metrics"
+)
+
+// FQDNLister lists FQDNs; it is satisfied by the Connect DNSService, so the
+// published snapshot is exactly what a live ListFQDNs call would return to an
+// anonymous client.
+type FQDNLister interface {
+	ListFQDNs(context.Context, *connect.Request[sreportalv1.ListFQDNsRequest]) (*connect.Response[sreportalv1.ListFQDNsResponse], error)
+}
+
+// Publisher periodically pushes the FQDN snapshot as an OCI artifact. It runs
+// on the leader only.
+type Publisher struct {
+	lister    FQDNLister
+	reader    client.Reader
+	namespace string
+	cfg       config.SnapshotPublisherConfig
+
+	last []byte
+}
+
+var _ manager.Runnable = (*Publisher)(nil)
+
+// NewPublisher creates a Publisher reading its registry credentials from the
+// cfg.CredentialsSecret Secret of namespace.
+func NewPublisher(lister FQDNLister, reader client.Reader, namespace string, cfg config.SnapshotPublisherConfig) *Publisher {
+	return &Publisher{lister: lister, reader: reader, namespace: namespace, cfg: cfg}
+}
+
+// Ref returns the reference the snapshot is pushed to.
+func (p *Publisher) Ref() string {
+	return p.cfg.Repository + ":" + p.cfg.Tag
+}
+
+// Start implements manager.Runnable. It publishes once right away, then every
+// interval.
+func (p *Publisher) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("snapshot-publisher")
+	ticker := time.NewTicker(p.cfg.Interval.Duration())
+	defer ticker.Stop()
+	for {
+		if err := p.publish(ctx); err != nil {
+			metrics.PortalSnapshotPublishTotal.WithLabelValues("error").Inc()
+			logger.Error(err, "failed to publish snapshot", "ref", p.Ref())
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// publish pushes the current snapshot, unless it is the one pushed last.
+func (p *Publisher) publish(ctx context.Context) error {
+	resp, err := p.lister.ListFQDNs(ctx, connect.NewRequest(&sreportalv1.ListFQDNsRequest{Portal: p.cfg.Portal}))
+	if err != nil {
+		return fmt.Errorf("list FQDNs: %w", err)
+	}
+	data, err := protojson.Marshal(resp.Msg)
+	if err != nil {
+		return fmt.Errorf("encode snapshot: %w", err)
+	}
+	if bytes.Equal(data, p.last) {
+		metrics.PortalSnapshotPublishTotal.WithLabelValues("unchanged").Inc()
+		return nil
+	}
+
+	auth, err := Auth(ctx, p.reader, p.namespace, p.cfg.CredentialsSecret, p.Ref())
+	if err != nil {
+		return err
+	}
+	digest, err := Push(ctx, p.Ref(), data, auth)
+	if err != nil {
+		return err
+	}
+	p.last = data
+	metrics.PortalSnapshotPublishTotal.WithLabelValues("pushed").Inc()
+	log.FromContext(ctx).WithName("snapshot-publisher").Info("published snapshot",
+		"ref", p.Ref(), "digest", digest, "fqdnCount", len(resp.Msg.Fqdns))
+	return nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package qrcode encodes short texts, such as share links, into QR codes
+// rendered as PNG images, at error correction level M. The encoding is done
+// by github.com/skip2/go-qrcode; the package caps the text length so that
+// codes stay small enough to be scanned from a screen.
+package qrcode
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+
+	goqrcode "github.com/skip2/go-qrcode"
+)
+
+// MaxLength is the maximum number of bytes a code can hold: the capacity of
+// a version 15 code in byte mode.
+const MaxLength = 412
+
+// ErrTooLong is returned when the text exceeds MaxLength bytes.
+var ErrTooLong = fmt.Errorf("text exceeds %d bytes", MaxLength)
+
+// Code is an encoded QR code.
+type Code struct {
+	size    int
+	modules [][]bool
+}
+
+// Size returns the number of modules per side.
+func (c *Code) Size() int {
+	return c.size
+}
+
+// Dark reports whether the module at (x, y) is dark. Out of range modules
+// are light.
+func (c *Code) Dark(x, y int) bool {
+	return x >= 0 && x < c.size && y >= 0 && y < c.size && c.modules[y][x]
+}
+
+// Encode encodes text in the smallest version that holds it.
+func Encode(text string) (*Code, error) {
+	if len(text) > MaxLength {
+		return nil, ErrTooLong
+	}
+	q, err := goqrcode.New(text, goqrcode.Medium)
+	if err != nil {
+		return nil, fmt.Errorf("encode qr code: %w", err)
+	}
+	q.DisableBorder = true
+	modules := q.Bitmap()
+	return &Code{size: len(modules), modules: modules}, nil
+}
+
+// PNG renders the code as a black on white PNG image with scale pixels per
+// module and the 4 module quiet zone required by the standard.
+func (c *Code) PNG(scale int) ([]byte, error) {
+	if scale < 1 {
+		return nil, errors.New("scale must be positive")
+	}
+	const border = 4
+	side := (c.size + 2*border) * scale
+	img := image.NewPaletted(image.Rect(0, 0, side, side), color.Palette{color.White, color.Black})
+	for y := range side {
+		for x := range side {
+			if c.Dark(x/scale-border, y/scale-border) {
+				img.SetColorIndex(x, y, 1)
+			}
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("encode png: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package qrcode
+
+import (
+	"bytes"
+	"errors"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func TestEncode_PicksSmallestVersion(t *testing.T) {
+	tests := []struct {
+		name string
+		len  int
+		size int
+	}{
+		{"version 1", 14, 21},
+		{"version 2", 15, 25},
+		{"version 10", 200, 57},
+		{"version 15", MaxLength, 77},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Encode(strings.Repeat("a", tt.len))
+			if err != nil {
+				t.Fatalf("Encode failed: %v", err)
+			}
+			if c.Size() != tt.size {
+				t.Errorf("Size() = %d, expected %d", c.Size(), tt.size)
+			}
+			// Finder pattern centres are dark, their separators light.
+			for _, p := range [][2]int{{3, 3}, {c.Size() - 4, 3}, {3, c.Size() - 4}} {
+				if !c.Dark(p[0], p[1]) {
+					t.Errorf("finder centre %v is light", p)
+				}
+			}
+			if c.Dark(7, 7) {
+				t.Errorf("separator (7, 7) is dark")
+			}
+		})
+	}
+}
+
+func TestEncode_TooLong(t *testing.T) {
+	if _, err := Encode(strings.Repeat("a", MaxLength+1)); !errors.Is(err, ErrTooLong) {
+		t.Errorf("Encode error = %v, expected %v", err, ErrTooLong)
+	}
+}
+
+func TestPNG(t *testing.T) {
+	c, err := Encode("https://sreportal.example.com/share/main/api.example.com")
+	if err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	data, err := c.PNG(4)
+	if err != nil {
+		t.Fatalf("PNG failed: %v", err)
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decode png: %v", err)
+	}
+	if side := (c.Size() + 8) * 4; img.Bounds().Dx() != side || img.Bounds().Dy() != side {
+		t.Errorf("bounds = %v, expected %dx%d", img.Bounds(), side, side)
+	}
+}
@@ -53,6 +53,14 @@ type FQDNStore struct {
 	seqCount  uint64
 	conflicts *conflictRing
 
+	// tombstones keeps, for retention, the last view of FQDNs that lost
+	// their last contributor. They are only listed with IncludeRemoved.
+	tombstones map[FQDNKey]*domaindns.FQDNView
+	retention  time.Duration
+
+	// targetProviders enables the provider hints of the public targets.
+	targetProviders bool
+
 	notifyMu sync.Mutex
 	notifyCh chan struct{}
 }
@@ -68,20 +76,42 @@ func NewFQDNStore() *FQDNStore {
 		winners:   map[FQDNKey]string{},
 		conflicts: newConflictRing(256),
 		notifyCh:  make(chan struct{}),
+
+		tombstones: map[FQDNKey]*domaindns.FQDNView{},
 	}
 }
 
+// SetTombstoneRetention sets how long FQDNs that disappear stay listed as
+// removed. 0, the default, drops them at once and clears the current
+// tombstones.
+func (s *FQDNStore) SetTombstoneRetention(retention time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.retention = retention
+	s.pruneTombstones(time.Now())
+}
+
+// SetTargetProviders enables the provider hints of the public targets of the
+// FQDNs aggregated from then on.
+func (s *FQDNStore) SetTargetProviders(enabled bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.targetProviders = enabled
+}
+
 // compile-time interface checks
 var (
-	_ domaindns.FQDNReader         = (*FQDNStore)(nil)
-	_ domaindns.FQDNWriter         = (*FQDNStore)(nil)
-	_ domaindns.FQDNConflictReader = (*FQDNStore)(nil)
+	_ domaindns.FQDNReader           = (*FQDNStore)(nil)
+	_ domaindns.FQDNWriter           = (*FQDNStore)(nil)
+	_ domaindns.FQDNConflictReader   = (*FQDNStore)(nil)
+	_ domaindns.FQDNUniquenessReader = (*FQDNStore)(nil)
 )
 
 // Replace atomically replaces all FQDNs contributed by a single DNSRecord.
 func (s *FQDNStore) Replace(ctx context.Context, recordKey, portalRef string, fqdns []domaindns.FQDNView) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	s.pruneTombstones(time.Now())
 
 	prev := s.byRecord[recordKey]
 	seq := prev.seq
@@ -104,6 +134,7 @@ func (s *FQDNStore) Replace(ctx context.Context, recordKey, portalRef string, fq
 		affected[k] = struct{}{}
 	}
 
+	before := s.portalsOf(affected)
 	s.byRecord[recordKey] = recordContribution{
 		seq:           seq,
 		contributions: newContributions,
@@ -113,9 +144,10 @@ func (s *FQDNStore) Replace(ctx context.Context, recordKey, portalRef string, fq
 	}
 
 	for k := range affected {
-		s.recomputeFQDN(k)
+		s.recomputeFQDN(k, domaindns.RemovalReasonNoLongerReported)
 	}
 
+	s.observeChurn(before, affected)
 	s.observeRefCounts(affected)
 	s.updateDedupRatio(portalRef)
 	if prev.portalRef != "" && prev.portalRef != portalRef {
@@ -123,8 +155,8 @@ func (s *FQDNStore) Replace(ctx context.Context, recordKey, portalRef string, fq
 	}
 
 	// Conflict detection: a contribution loses iff its targets disagree with
-	// the recomputed primary. Lowest-seq contributor is primary; any
-	// later-seq contributor with different targets is a loser.
+	// the recomputed primary (see recomputeFQDN); any other contributor with
+	// different targets is a loser.
 	//
 	// Emit an event/metric only on transition: a key newly losing, or losing
 	// with different targets than last time. Without this guard a stable
@@ -200,22 +232,71 @@ func (s *FQDNStore) Conflicts(dnsNamespace, dnsName string) []domaindns.Conflict
 	return out
 }
 
+// RecordFQDNs returns, per record key, the sorted "<name>/<recordType>" keys
+// the record contributes. It exposes the store's per-record bookkeeping to
+// the consistency checker.
+func (s *FQDNStore) RecordFQDNs() map[string][]string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	out := make(map[string][]string, len(s.byRecord))
+	for recordKey, contrib := range s.byRecord {
+		keys := make([]string, 0, len(contrib.contributions))
+		for k := range contrib.contributions {
+			keys = append(keys, k.Name+"/"+k.RecordType)
+		}
+		sort.Strings(keys)
+		out[recordKey] = keys
+	}
+	return out
+}
+
+// UniquenessReport returns the FQDNs listed in several portals or whose
+// contributing DNSRecords disagree on the targets (see
+// domaindns.FindUniquenessIssues).
+func (s *FQDNStore) UniquenessReport() []domaindns.UniquenessIssue {
+	s.mu.RLock()
+	var contributions []domaindns.FQDNContribution
+	for recordKey, rec := range s.byRecord {
+		record := recordRefFromKey(recordKey)
+		for k, v := range rec.contributions {
+			contributions = append(contributions, domaindns.FQDNContribution{
+				Name:       k.Name,
+				RecordType: k.RecordType,
+				Record:     *record,
+				Portal:     rec.portalRef,
+				Source:     v.Source,
+				SourceType: v.SourceType,
+				Targets:    slices.Clone(v.Targets),
+				Owner:      domaindns.RecordRef{Namespace: rec.dnsNamespace, Name: rec.dnsName},
+			})
+		}
+	}
+	s.mu.RUnlock()
+	return domaindns.FindUniquenessIssues(contributions)
+}
+
 // Delete removes all FQDNs contributed by a single DNSRecord.
 func (s *FQDNStore) Delete(ctx context.Context, recordKey string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	s.pruneTombstones(time.Now())
 
 	contrib, ok := s.byRecord[recordKey]
 	if !ok {
 		return nil
 	}
-	delete(s.byRecord, recordKey)
 	affected := make(map[FQDNKey]struct{}, len(contrib.contributions))
 	for k := range contrib.contributions {
 		affected[k] = struct{}{}
-		s.recomputeFQDN(k)
+	}
+	before := s.portalsOf(affected)
+	delete(s.byRecord, recordKey)
+	for k := range affected {
+		s.recomputeFQDN(k, domaindns.RemovalReasonRecordDeleted)
 	}
 
+	s.observeChurn(before, affected)
 	s.observeRefCounts(affected)
 	s.updateDedupRatio(contrib.portalRef)
 
@@ -223,6 +304,50 @@ func (s *FQDNStore) Delete(ctx context.Context, recordKey string) error {
 	return nil
 }
 
+// portalsOf returns, for each key, the portals currently listing it.
+func (s *FQDNStore) portalsOf(keys map[FQDNKey]struct{}) map[FQDNKey][]string {
+	out := make(map[FQDNKey][]string, len(keys))
+	for p, set := range s.byPortal {
+		for k := range keys {
+			if _, in := set[k]; in {
+				out[k] = append(out[k], p)
+			}
+		}
+	}
+	return out
+}
+
+// observeChurn compares the portals listing each key before a write with the
+// current ones, counts the keys added to and removed from each portal, and
+// refreshes the FQDN gauge of every portal that changed.
+func (s *FQDNStore) observeChurn(before map[FQDNKey][]string, keys map[FQDNKey]struct{}) {
+	after := s.portalsOf(keys)
+	added := map[string]int{}
+	removed := map[string]int{}
+	for k := range keys {
+		for _, p := range after[k] {
+			if !slices.Contains(before[k], p) {
+				added[p]++
+			}
+		}
+		for _, p := range before[k] {
+			if !slices.Contains(after[k], p) {
+				removed[p]++
+			}
+		}
+	}
+	delete(added, "")
+	delete(removed, "")
+	for p, n := range added {
+		metrics.DNSFQDNsAddedTotal.WithLabelValues(p).Add(float64(n))
+		metrics.DNSPortalFQDNs.WithLabelValues(p).Set(float64(len(s.byPortal[p])))
+	}
+	for p, n := range removed {
+		metrics.DNSFQDNsRemovedTotal.WithLabelValues(p).Add(float64(n))
+		metrics.DNSPortalFQDNs.WithLabelValues(p).Set(float64(len(s.byPortal[p])))
+	}
+}
+
 // observeRefCounts samples the contributor count of each surviving key into
 // the refcount histogram. Keys that no longer have contributors after
 // recompute are skipped (they were purged from s.fqdns).
@@ -317,6 +442,16 @@ func (s *FQDNStore) listLocked(f domaindns.FQDNFilters) []domaindns.FQDNView {
 		}
 	}
 
+	if f.IncludeRemoved {
+		now := time.Now()
+		for _, v := range s.tombstones {
+			if s.tombstoneExpired(v, now) || (f.Portal != "" && !slices.Contains(v.Portals, f.Portal)) {
+				continue
+			}
+			pool = append(pool, v)
+		}
+	}
+
 	searchLower := strings.ToLower(f.Search)
 	out := make([]domaindns.FQDNView, 0, len(pool))
 	for _, v := range pool {
@@ -329,6 +464,12 @@ func (s *FQDNStore) listLocked(f domaindns.FQDNFilters) []domaindns.FQDNView {
 		if f.Search != "" && !strings.Contains(strings.ToLower(v.Name), searchLower) {
 			continue
 		}
+		if f.TargetScope != "" && v.TargetScope != f.TargetScope {
+			continue
+		}
+		if !f.LastSeen.Contains(v.LastSeen) {
+			continue
+		}
 		out = append(out, cloneFQDNView(v))
 	}
 	slices.SortFunc(out, func(a, b domaindns.FQDNView) int {
@@ -340,6 +481,20 @@ func (s *FQDNStore) listLocked(f domaindns.FQDNFilters) []domaindns.FQDNView {
 	return out
 }
 
+// pruneTombstones drops the tombstones older than the retention. Caller must
+// hold s.mu for writing.
+func (s *FQDNStore) pruneTombstones(now time.Time) {
+	for k, v := range s.tombstones {
+		if s.tombstoneExpired(v, now) {
+			delete(s.tombstones, k)
+		}
+	}
+}
+
+func (s *FQDNStore) tombstoneExpired(v *domaindns.FQDNView, now time.Time) bool {
+	return now.Sub(v.RemovedAt) >= s.retention
+}
+
 // cloneFQDNView returns a value copy whose slice fields share no backing array
 // with the source. The store's writers rebuild Portals/Groups/Targets on every
 // recompute, so callers must hold their own copies to be safe across
@@ -378,11 +533,12 @@ func (s *FQDNStore) broadcast() {
 }
 
 // recomputeFQDN rebuilds s.fqdns[k] from all current contributors. The
-// primary (lowest seq) provides Targets/SyncStatus/OriginRef/Description and
-// every other scalar field; Groups and Portals are derived from the full
-// contributor set. If no contributors remain, the key is purged from fqdns
-// and every byPortal index.
-func (s *FQDNStore) recomputeFQDN(k FQDNKey) {
+// primary provides Targets/SyncStatus/OriginRef/Description and every other
+// scalar field; Groups and Portals are derived from the full contributor set.
+// The lowest seq (first writer) wins whatever its source; a manual entry
+// duplicating a discovered primary is recorded as ShadowedManual on the view. If no contributors remain, the key is purged
+// from fqdns and every byPortal index, and its tombstone records reason.
+func (s *FQDNStore) recomputeFQDN(k FQDNKey, reason domaindns.RemovalReason) {
 	type contrib struct {
 		seq       uint64
 		view      domaindns.FQDNView
@@ -399,6 +555,13 @@ func (s *FQDNStore) recomputeFQDN(k FQDNKey) {
 	}
 
 	if len(contributors) == 0 {
+		if prev := s.fqdns[k]; prev != nil && s.retention > 0 {
+			tombstone := *prev
+			tombstone.RemovedAt = time.Now()
+			tombstone.RemovalReason = reason
+			tombstone.OverallStatus = domaindns.OverallStatusRemoved
+			s.tombstones[k] = &tombstone
+		}
 		delete(s.fqdns, k)
 		delete(s.winners, k)
 		for p, set := range s.byPortal {
@@ -412,10 +575,20 @@ func (s *FQDNStore) recomputeFQDN(k FQDNKey) {
 		return
 	}
 
+	delete(s.tombstones, k)
 	sort.Slice(contributors, func(i, j int) bool { return contributors[i].seq < contributors[j].seq })
 
 	s.winners[k] = contributors[0].recordKey
 	primary := contributors[0].view
+	primary.ShadowedManual = nil
+	if primary.Source != domaindns.SourceManual {
+		for _, c := range contributors[1:] {
+			if c.view.Source == domaindns.SourceManual {
+				primary.ShadowedManual = recordRefFromKey(c.recordKey)
+				break
+			}
+		}
+	}
 	groupSet := map[string]struct{}{}
 	for _, c := range contributors {
 		for _, g := range c.view.Groups {
@@ -424,6 +597,16 @@ func (s *FQDNStore) recomputeFQDN(k FQDNKey) {
 	}
 	primary.Groups = sortedKeys(groupSet)
 	primary.Portals = sortedKeys(portalsForKey)
+	// Derive the ID from the sorted portals, not from the first contributor:
+	// the contributor order follows reconcile order, which changes across
+	// restarts.
+	primary.ID = domaindns.StableIDOf(primary.Portals, k.Name, k.RecordType)
+	primary.TargetScope = domaindns.ClassifyTargets(primary.Targets)
+	primary.TargetProviders = nil
+	if s.targetProviders {
+		primary.TargetProviders = domaindns.TargetProviders(primary.Targets)
+	}
+	primary.OverallStatus = domaindns.ComputeOverallStatus(&primary)
 	s.fqdns[k] = &primary
 
 	for p, set := range s.byPortal {
@@ -445,6 +628,12 @@ func (s *FQDNStore) recomputeFQDN(k FQDNKey) {
 	}
 }
 
+// recordRefFromKey parses a "namespace/name" record key.
+func recordRefFromKey(recordKey string) *domaindns.RecordRef {
+	ns, name, _ := strings.Cut(recordKey, "/")
+	return &domaindns.RecordRef{Namespace: ns, Name: name}
+}
+
 // targetsKey returns an order-sensitive fingerprint of a target set, matching
 // sameTargets semantics (targets are deterministic/sorted upstream). Used to
 // detect when a losing record's targets change between reconciles. The NUL
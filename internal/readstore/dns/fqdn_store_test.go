@@ -3,11 +3,14 @@ package dns_test
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	"github.com/golgoth31/sreportal/internal/metrics"
 	dnsstore "github.com/golgoth31/sreportal/internal/readstore/dns"
 )
 
@@ -162,6 +165,51 @@ func TestFQDNStore_ConflictKeepsFirstWriter(t *testing.T) {
 	assert.Equal(t, "ns/rec-a", conflicts[0].WinnerRecord)
 }
 
+func TestFQDNStore_DiscoveredShadowsManualEntry(t *testing.T) {
+	ctx := context.Background()
+	s := dnsstore.NewFQDNStore()
+
+	// The discovered record is written first: the later manual entry is shadowed.
+	require.NoError(t, s.Replace(ctx, "ns/main-service", tPortalX, []domaindns.FQDNView{
+		{Name: tFQDNC, RecordType: "A", Targets: []string{tIP2222}, Source: domaindns.SourceExternalDNS, SourceType: "service"},
+	}))
+	require.NoError(t, s.Replace(ctx, "ns/manual", tPortalX, []domaindns.FQDNView{
+		{Name: tFQDNC, RecordType: "A", Targets: []string{tIP1}, Source: domaindns.SourceManual, Groups: []string{"manual"}},
+	}))
+	got, err := s.Get(ctx, tFQDNC, "A")
+	require.NoError(t, err)
+	assert.Equal(t, domaindns.SourceExternalDNS, got.Source)
+	assert.Equal(t, []string{tIP2222}, got.Targets)
+	assert.Equal(t, []string{"manual"}, got.Groups)
+	require.NotNil(t, got.ShadowedManual)
+	assert.Equal(t, domaindns.RecordRef{Namespace: "ns", Name: "manual"}, *got.ShadowedManual)
+
+	require.NoError(t, s.Delete(ctx, "ns/main-service"))
+	got, err = s.Get(ctx, tFQDNC, "A")
+	require.NoError(t, err)
+	assert.Equal(t, domaindns.SourceManual, got.Source)
+	assert.Nil(t, got.ShadowedManual)
+}
+
+func TestFQDNStore_ManualFirstWriterKeepsPrecedence(t *testing.T) {
+	ctx := context.Background()
+	s := dnsstore.NewFQDNStore()
+
+	// First writer wins whatever the source: a manual entry written before the
+	// discovered record stays primary and is not shadowed.
+	require.NoError(t, s.Replace(ctx, "ns/manual", tPortalX, []domaindns.FQDNView{
+		{Name: tFQDNC, RecordType: "A", Targets: []string{tIP1}, Source: domaindns.SourceManual},
+	}))
+	require.NoError(t, s.Replace(ctx, "ns/main-service", tPortalX, []domaindns.FQDNView{
+		{Name: tFQDNC, RecordType: "A", Targets: []string{tIP2222}, Source: domaindns.SourceExternalDNS, SourceType: "service"},
+	}))
+	got, err := s.Get(ctx, tFQDNC, "A")
+	require.NoError(t, err)
+	assert.Equal(t, domaindns.SourceManual, got.Source)
+	assert.Equal(t, []string{tIP1}, got.Targets)
+	assert.Nil(t, got.ShadowedManual)
+}
+
 // TestFQDNStore_ConflictEmittedOnlyOnTransition verifies 5-A: a stable conflict
 // is reported once, not re-pushed on every idempotent Replace; a change in the
 // loser's targets re-emits.
@@ -245,6 +293,32 @@ func TestFQDNStore_ConflictsScopedToDNSOwner(t *testing.T) {
 	assert.Empty(t, s.Conflicts("ns", "dns-a"), "winner dns-a should not see itself in conflicts")
 }
 
+func TestFQDNStore_UniquenessReport(t *testing.T) {
+	ctx := context.Background()
+	s := dnsstore.NewFQDNStore()
+
+	require.NoError(t, s.Replace(ctx, "ns/a", tPortalX, []domaindns.FQDNView{
+		{Name: tFQDNX, RecordType: "A", Source: domaindns.SourceExternalDNS, SourceType: "service", Targets: []string{tIP1}},
+		{Name: tFQDNC, RecordType: "A", Source: domaindns.SourceExternalDNS, SourceType: "service", Targets: []string{tIP1}},
+	}))
+	s.AnnotateOwner("ns/a", "ns", "dns-a")
+	require.NoError(t, s.Replace(ctx, "ns/b", tPortalY, []domaindns.FQDNView{
+		{Name: tFQDNX, RecordType: "A", Source: domaindns.SourceExternalDNS, SourceType: "ingress", Targets: []string{tIP2222}},
+	}))
+
+	report := s.UniquenessReport()
+	require.Len(t, report, 1)
+	assert.Equal(t, tFQDNX, report[0].Name)
+	assert.Equal(t, domaindns.UniquenessSeverityHigh, report[0].Severity)
+	assert.Equal(t, []string{tPortalX, tPortalY}, report[0].Portals)
+	require.Len(t, report[0].Contributions, 2)
+	assert.Equal(t, domaindns.RecordRef{Namespace: "ns", Name: "dns-a"}, report[0].Contributions[0].Owner)
+	assert.Equal(t, domaindns.RecordRef{Namespace: "ns", Name: "b"}, report[0].Contributions[1].Record)
+
+	require.NoError(t, s.Delete(ctx, "ns/b"))
+	assert.Empty(t, s.UniquenessReport())
+}
+
 func TestFQDNStore_DeleteRemovesLastContributor(t *testing.T) {
 	ctx := context.Background()
 	s := dnsstore.NewFQDNStore()
@@ -339,6 +413,90 @@ func TestFQDNStore_ListFiltersByPortal(t *testing.T) {
 	assert.ElementsMatch(t, []string{"alpha.example.com", "beta.example.com"}, names)
 }
 
+func TestFQDNStore_ComputesOverallStatus(t *testing.T) {
+	ctx := context.Background()
+	s := dnsstore.NewFQDNStore()
+	require.NoError(t, s.Replace(ctx, "ns/a", "p1", []domaindns.FQDNView{
+		{Name: "ok.example.com", RecordType: "A", SyncStatus: "sync"},
+		{Name: "down.example.com", RecordType: "A", SyncStatus: "sync", Availability: "down"},
+	}))
+
+	up, err := s.Get(ctx, "ok.example.com", "A")
+	require.NoError(t, err)
+	assert.Equal(t, domaindns.OverallStatusHealthy, up.OverallStatus)
+	down, err := s.Get(ctx, "down.example.com", "A")
+	require.NoError(t, err)
+	assert.Equal(t, domaindns.OverallStatusCritical, down.OverallStatus)
+}
+
+func TestFQDNStore_ClassifiesAndFiltersByTargetScope(t *testing.T) {
+	ctx := context.Background()
+	s := dnsstore.NewFQDNStore()
+	require.NoError(t, s.Replace(ctx, "ns/a", "p1", []domaindns.FQDNView{
+		{Name: "public.example.com", RecordType: "A", Targets: []string{"10.0.0.1", "203.0.113.10"}},
+		{Name: "private.example.com", RecordType: "A", Targets: []string{"10.0.0.2"}},
+		{Name: "alias.example.com", RecordType: "CNAME", Targets: []string{"lb.example.com"}},
+	}))
+
+	all, err := s.List(ctx, domaindns.FQDNFilters{})
+	require.NoError(t, err)
+	scopes := make(map[string]domaindns.TargetScope, len(all))
+	for _, v := range all {
+		scopes[v.Name] = v.TargetScope
+	}
+	assert.Equal(t, domaindns.TargetScopePublic, scopes["public.example.com"])
+	assert.Equal(t, domaindns.TargetScopePrivate, scopes["private.example.com"])
+	assert.Equal(t, domaindns.TargetScopeUnknown, scopes["alias.example.com"])
+
+	out, err := s.List(ctx, domaindns.FQDNFilters{TargetScope: domaindns.TargetScopePublic})
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+	assert.Equal(t, "public.example.com", out[0].Name)
+}
+
+func TestFQDNStore_TargetProvidersWhenEnabled(t *testing.T) {
+	ctx := context.Background()
+	s := dnsstore.NewFQDNStore()
+	views := []domaindns.FQDNView{
+		{Name: "cdn.example.com", RecordType: "A", Targets: []string{"10.0.0.1", "104.16.0.1"}},
+	}
+	require.NoError(t, s.Replace(ctx, "ns/a", "p1", views))
+	v, err := s.Get(ctx, "cdn.example.com", "A")
+	require.NoError(t, err)
+	assert.Empty(t, v.TargetProviders, "disabled by default")
+
+	s.SetTargetProviders(true)
+	require.NoError(t, s.Replace(ctx, "ns/a", "p1", views))
+	v, err = s.Get(ctx, "cdn.example.com", "A")
+	require.NoError(t, err)
+	assert.Equal(t, []domaindns.TargetProvider{{Target: "104.16.0.1", Provider: "cloudflare", ASN: 13335}}, v.TargetProviders)
+}
+
+func TestFQDNStore_FiltersByLastSeenWindow(t *testing.T) {
+	ctx := context.Background()
+	s := dnsstore.NewFQDNStore()
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, s.Replace(ctx, "ns/a", "p1", []domaindns.FQDNView{
+		{Name: "fresh.example.com", RecordType: "A", LastSeen: now},
+		{Name: "stale.example.com", RecordType: "A", LastSeen: now.Add(-30 * 24 * time.Hour)},
+		{Name: "unknown.example.com", RecordType: "A"},
+	}))
+
+	names := func(w domaindns.LastSeenWindow) []string {
+		out, err := s.List(ctx, domaindns.FQDNFilters{LastSeen: w})
+		require.NoError(t, err)
+		var got []string
+		for _, v := range out {
+			got = append(got, v.Name)
+		}
+		return got
+	}
+	assert.Len(t, names(domaindns.LastSeenWindow{}), 3)
+	assert.Equal(t, []string{"fresh.example.com"}, names(domaindns.LastSeenWindow{After: now.Add(-time.Hour)}))
+	assert.Equal(t, []string{"stale.example.com"}, names(domaindns.LastSeenWindow{Before: now.Add(-7 * 24 * time.Hour)}))
+	assert.Equal(t, []string{"fresh.example.com"}, names(domaindns.LastSeenWindow{After: now, Before: now.Add(time.Second)}))
+}
+
 func TestFQDNStore_ListSortedByNameThenRecordType(t *testing.T) {
 	s, ctx := newPopulatedStore(t)
 
@@ -369,3 +527,180 @@ func TestFQDNStore_GetReturnsErrFQDNNotFound(t *testing.T) {
 	require.Error(t, err)
 	assert.ErrorIs(t, err, domaindns.ErrFQDNNotFound)
 }
+
+func TestFQDNStore_RecordFQDNs(t *testing.T) {
+	ctx := context.Background()
+	s := dnsstore.NewFQDNStore()
+
+	require.NoError(t, s.Replace(ctx, "ns/a", "p1", []domaindns.FQDNView{
+		{Name: "b.example.com", RecordType: "A", Targets: []string{tIP1}},
+		{Name: "a.example.com", RecordType: "CNAME", Targets: []string{"b.example.com"}},
+	}))
+	require.NoError(t, s.Replace(ctx, "ns/gone", "p1", []domaindns.FQDNView{
+		{Name: "c.example.com", RecordType: "A", Targets: []string{tIP1}},
+	}))
+	require.NoError(t, s.Delete(ctx, "ns/gone"))
+
+	require.Equal(t, map[string][]string{
+		"ns/a": {"a.example.com/CNAME", "b.example.com/A"},
+	}, s.RecordFQDNs())
+}
+
+func TestFQDNStore_AssignsStableIDs(t *testing.T) {
+	ctx := context.Background()
+	s := dnsstore.NewFQDNStore()
+
+	require.NoError(t, s.Replace(ctx, "ns/rec-a", tPortalX, []domaindns.FQDNView{
+		{Name: "id.example.com", RecordType: "A", Targets: []string{tIP1}, Groups: []string{"g1"}},
+	}))
+	out, err := s.List(ctx, domaindns.FQDNFilters{})
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+	assert.Equal(t, domaindns.StableID(tPortalX, "id.example.com", "A"), out[0].ID)
+
+	// Renaming the group and moving the entry to another DNSRecord of the same
+	// portal keeps the ID.
+	require.NoError(t, s.Delete(ctx, "ns/rec-a"))
+	require.NoError(t, s.Replace(ctx, "ns/rec-renamed", tPortalX, []domaindns.FQDNView{
+		{Name: "id.example.com", RecordType: "A", Targets: []string{tIP1}, Groups: []string{"g2"}},
+	}))
+	again, err := s.List(ctx, domaindns.FQDNFilters{})
+	require.NoError(t, err)
+	require.Len(t, again, 1)
+	assert.Equal(t, out[0].ID, again[0].ID)
+}
+
+func TestFQDNStore_StableIDIgnoresContributionOrder(t *testing.T) {
+	ctx := context.Background()
+	view := domaindns.FQDNView{Name: "id.example.com", RecordType: "A", Targets: []string{tIP1}}
+	ids := make([]string, 0, 2)
+	for _, order := range [][]string{{tPortalX, tPortalY}, {tPortalY, tPortalX}} {
+		s := dnsstore.NewFQDNStore()
+		for _, portal := range order {
+			require.NoError(t, s.Replace(ctx, "ns/"+portal, portal, []domaindns.FQDNView{view}))
+		}
+		out, err := s.List(ctx, domaindns.FQDNFilters{})
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+		ids = append(ids, out[0].ID)
+	}
+	assert.Equal(t, domaindns.StableID(tPortalX, "id.example.com", "A"), ids[0])
+	assert.Equal(t, ids[0], ids[1])
+}
+
+func TestFQDNStore_KeepsTombstonesOfRemovedFQDNs(t *testing.T) {
+	ctx := context.Background()
+	s := dnsstore.NewFQDNStore()
+	s.SetTombstoneRetention(time.Hour)
+
+	require.NoError(t, s.Replace(ctx, "ns/rec-a", tPortalX, []domaindns.FQDNView{
+		{Name: "gone.example.com", RecordType: "A", Targets: []string{tIP1}, Groups: []string{"g1"}},
+		{Name: "kept.example.com", RecordType: "A", Targets: []string{tIP1}},
+	}))
+	require.NoError(t, s.Replace(ctx, "ns/rec-a", tPortalX, []domaindns.FQDNView{
+		{Name: "kept.example.com", RecordType: "A", Targets: []string{tIP1}},
+	}))
+
+	live, err := s.List(ctx, domaindns.FQDNFilters{Portal: tPortalX})
+	require.NoError(t, err)
+	require.Len(t, live, 1, "tombstones are hidden by default")
+	assert.Equal(t, "kept.example.com", live[0].Name)
+
+	all, err := s.List(ctx, domaindns.FQDNFilters{Portal: tPortalX, IncludeRemoved: true})
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, "gone.example.com", all[0].Name)
+	assert.Equal(t, domaindns.OverallStatusRemoved, all[0].OverallStatus)
+	assert.False(t, all[0].RemovedAt.IsZero())
+	assert.Equal(t, []string{"g1"}, all[0].Groups, "the tombstone keeps the last known view")
+	assert.Equal(t, domaindns.RemovalReasonNoLongerReported, all[0].RemovalReason)
+	assert.True(t, all[1].RemovedAt.IsZero())
+
+	// The FQDN comes back: its tombstone is dropped.
+	require.NoError(t, s.Replace(ctx, "ns/rec-b", tPortalX, []domaindns.FQDNView{
+		{Name: "gone.example.com", RecordType: "A", Targets: []string{tIP1}},
+	}))
+	all, err = s.List(ctx, domaindns.FQDNFilters{IncludeRemoved: true})
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.NotEqual(t, domaindns.OverallStatusRemoved, all[0].OverallStatus)
+	assert.True(t, all[0].RemovedAt.IsZero())
+}
+
+func TestFQDNStore_TombstoneRecordsDeletedRecord(t *testing.T) {
+	ctx := context.Background()
+	s := dnsstore.NewFQDNStore()
+	s.SetTombstoneRetention(time.Hour)
+
+	require.NoError(t, s.Replace(ctx, "ns/rec-a", tPortalX, []domaindns.FQDNView{
+		{Name: "gone.example.com", RecordType: "A", Targets: []string{tIP1}},
+	}))
+	require.NoError(t, s.Delete(ctx, "ns/rec-a"))
+
+	all, err := s.List(ctx, domaindns.FQDNFilters{IncludeRemoved: true})
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, domaindns.OverallStatusRemoved, all[0].OverallStatus)
+	assert.Equal(t, domaindns.RemovalReasonRecordDeleted, all[0].RemovalReason)
+}
+
+func TestFQDNStore_TombstonesExpireAfterRetention(t *testing.T) {
+	ctx := context.Background()
+	s := dnsstore.NewFQDNStore()
+	s.SetTombstoneRetention(time.Millisecond)
+
+	require.NoError(t, s.Replace(ctx, "ns/rec-a", tPortalX, []domaindns.FQDNView{
+		{Name: "gone.example.com", RecordType: "A", Targets: []string{tIP1}},
+	}))
+	require.NoError(t, s.Delete(ctx, "ns/rec-a"))
+	time.Sleep(5 * time.Millisecond)
+
+	all, err := s.List(ctx, domaindns.FQDNFilters{IncludeRemoved: true})
+	require.NoError(t, err)
+	assert.Empty(t, all)
+}
+
+func TestFQDNStore_NoTombstoneWithoutRetention(t *testing.T) {
+	ctx := context.Background()
+	s := dnsstore.NewFQDNStore()
+
+	require.NoError(t, s.Replace(ctx, "ns/rec-a", tPortalX, []domaindns.FQDNView{
+		{Name: "gone.example.com", RecordType: "A", Targets: []string{tIP1}},
+	}))
+	require.NoError(t, s.Delete(ctx, "ns/rec-a"))
+
+	all, err := s.List(ctx, domaindns.FQDNFilters{IncludeRemoved: true})
+	require.NoError(t, err)
+	assert.Empty(t, all)
+}
+
+// TestFQDNStore_ChurnMetrics verifies the per-portal added/removed counters
+// and the FQDN gauge: a key shared by two records is only counted once, and
+// only when it leaves the portal.
+func TestFQDNStore_ChurnMetrics(t *testing.T) {
+	ctx := context.Background()
+	s := dnsstore.NewFQDNStore()
+	const portal = "churn-portal"
+	added := func() float64 { return testutil.ToFloat64(metrics.DNSFQDNsAddedTotal.WithLabelValues(portal)) }
+	removed := func() float64 { return testutil.ToFloat64(metrics.DNSFQDNsRemovedTotal.WithLabelValues(portal)) }
+	gauge := func() float64 { return testutil.ToFloat64(metrics.DNSPortalFQDNs.WithLabelValues(portal)) }
+	a := domaindns.FQDNView{Name: "a.churn.example.com", RecordType: "A", Targets: []string{tIP1}}
+	b := domaindns.FQDNView{Name: "b.churn.example.com", RecordType: "A", Targets: []string{tIP1}}
+
+	require.NoError(t, s.Replace(ctx, "ns/r1", portal, []domaindns.FQDNView{a, b}))
+	assert.Equal(t, float64(2), added())
+	assert.Equal(t, float64(2), gauge())
+
+	// Same key from a second record: no churn.
+	require.NoError(t, s.Replace(ctx, "ns/r2", portal, []domaindns.FQDNView{a}))
+	assert.Equal(t, float64(2), added())
+
+	// r1 drops both keys: only b leaves the portal, a is still served by r2.
+	require.NoError(t, s.Replace(ctx, "ns/r1", portal, nil))
+	assert.Equal(t, float64(1), removed())
+	assert.Equal(t, float64(1), gauge())
+
+	require.NoError(t, s.Delete(ctx, "ns/r2"))
+	assert.Equal(t, float64(2), removed())
+	assert.Equal(t, float64(0), gauge())
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	domainsource "github.com/golgoth31/sreportal/internal/domain/source"
+	"github.com/golgoth31/sreportal/internal/source/registry"
+)
+
+// HealthTracker tracks the collection health of each source kind. It is safe
+// for concurrent use.
+type HealthTracker struct {
+	mu     sync.RWMutex
+	byKind map[registry.SourceType]domainsource.SourceHealth
+}
+
+// NewHealthTracker returns an empty HealthTracker.
+func NewHealthTracker() *HealthTracker {
+	return &HealthTracker{byKind: map[registry.SourceType]domainsource.SourceHealth{}}
+}
+
+// compile-time interface checks
+var (
+	_ domainsource.SourceHealthReader = (*HealthTracker)(nil)
+	_ domainsource.SourceHealthWriter = (*HealthTracker)(nil)
+)
+
+// RecordSuccess resets the failures of kind and sets its last success.
+func (t *HealthTracker) RecordSuccess(kind registry.SourceType, at time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	h := t.byKind[kind]
+	h.Kind = kind
+	h.Failures = 0
+	h.LastSuccess = at
+	t.byKind[kind] = h
+}
+
+// RecordFailure counts a failed collection of kind and returns its updated
+// health.
+func (t *HealthTracker) RecordFailure(kind registry.SourceType, err error, at time.Time) domainsource.SourceHealth {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	h := t.byKind[kind]
+	h.Kind = kind
+	h.Failures++
+	h.LastFailure = at
+	if err != nil {
+		h.LastError = err.Error()
+	}
+	t.byKind[kind] = h
+	return h
+}
+
+// ForgetSourceHealth drops kind.
+func (t *HealthTracker) ForgetSourceHealth(kind registry.SourceType) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.byKind, kind)
+}
+
+// SourceHealth returns the health of kind.
+func (t *HealthTracker) SourceHealth(kind registry.SourceType) (domainsource.SourceHealth, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	h, ok := t.byKind[kind]
+	return h, ok
+}
+
+// ListSourceHealth returns the health of every tracked kind, sorted by kind.
+func (t *HealthTracker) ListSourceHealth() []domainsource.SourceHealth {
+	t.mu.RLock()
+	out := make([]domainsource.SourceHealth, 0, len(t.byKind))
+	for _, h := range t.byKind {
+		out = append(out, h)
+	}
+	t.mu.RUnlock()
+	slices.SortFunc(out, func(a, b domainsource.SourceHealth) int {
+		return strings.Compare(string(a.Kind), string(b.Kind))
+	})
+	return out
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rsource "github.com/golgoth31/sreportal/internal/readstore/source"
+)
+
+func TestHealthTracker_TracksFailuresUntilSuccess(t *testing.T) {
+	tr := rsource.NewHealthTracker()
+	t0 := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	_, ok := tr.SourceHealth(kindSvc)
+	assert.False(t, ok)
+
+	tr.RecordFailure(kindSvc, errors.New("forbidden"), t0)
+	h := tr.RecordFailure(kindSvc, errors.New("timeout"), t0.Add(time.Minute))
+	assert.Equal(t, 2, h.Failures)
+	assert.True(t, h.Failing())
+	assert.Equal(t, "timeout", h.LastError)
+	assert.Equal(t, t0.Add(time.Minute), h.LastFailure)
+	assert.True(t, h.LastSuccess.IsZero())
+
+	tr.RecordSuccess(kindSvc, t0.Add(2*time.Minute))
+	h, ok = tr.SourceHealth(kindSvc)
+	require.True(t, ok)
+	assert.False(t, h.Failing())
+	assert.Equal(t, "timeout", h.LastError, "the last cause stays visible")
+	assert.Equal(t, t0.Add(2*time.Minute), h.LastSuccess)
+
+	tr.ForgetSourceHealth(kindSvc)
+	_, ok = tr.SourceHealth(kindSvc)
+	assert.False(t, ok)
+}
+
+func TestHealthTracker_ConcurrentRecords(t *testing.T) {
+	tr := rsource.NewHealthTracker()
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			tr.RecordFailure(kindSvc, errors.New("boom"), time.Now())
+		}()
+		go func() {
+			defer wg.Done()
+			tr.RecordFailure(kindIng, errors.New("boom"), time.Now())
+			_ = tr.ListSourceHealth()
+		}()
+	}
+	wg.Wait()
+
+	list := tr.ListSourceHealth()
+	require.Len(t, list, 2)
+	assert.Equal(t, kindIng, list[0].Kind)
+	assert.Equal(t, 50, list[0].Failures)
+	assert.Equal(t, 50, list[1].Failures)
+}
@@ -19,11 +19,13 @@ package reconciler
 import (
 	"context"
 	"errors"
+	"log/slog"
 	"reflect"
 	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/golgoth31/sreportal/internal/log"
 	"github.com/golgoth31/sreportal/internal/metrics"
 )
 
@@ -54,6 +56,28 @@ type Handler[T any, D any] interface {
 	Handle(ctx context.Context, rc *ReconcileContext[T, D]) error
 }
 
+// DataAccessor is optionally implemented by handlers to declare the fields of
+// the chain data they read and write. The chain logs the declaration at debug
+// level, so a slow or failing step can be related to the state it depends on,
+// and a configurable pipeline (e.g. the DNS one) checks with it that a field
+// is written before it is read: the declaration must match what Handle does,
+// and must not depend on the handler's dependencies, as the check runs on
+// handlers built without them.
+type DataAccessor interface {
+	DataKeys() (reads, writes []string)
+}
+
+// Outcomes of a handler, used as the outcome of the debug log line and as the
+// reason label of metrics.ChainHandlerSkippedTotal for the handlers that did
+// not run after it.
+const (
+	outcomeSuccess      = "success"
+	outcomeError        = "error"
+	outcomeShortCircuit = "short_circuit"
+	outcomeShutdown     = "shutdown"
+	outcomeRequeue      = "requeue"
+)
+
 // Chain executes handlers in sequence
 type Chain[T any, D any] struct {
 	controller string
@@ -61,15 +85,19 @@ type Chain[T any, D any] struct {
 }
 
 // NewChain creates a new handler chain. controller is the controller name used
-// as the `controller` label in per-handler ReconcileDuration observations
-// (e.g. "imageinventory"). Use an empty string to skip per-handler timing.
+// as the `controller` label of the per-handler metrics (e.g. "imageinventory").
+// Use an empty string to skip per-handler metrics.
 func NewChain[T any, D any](controller string, handlers ...Handler[T, D]) *Chain[T, D] {
 	return &Chain[T, D]{controller: controller, handlers: handlers}
 }
 
 // Execute runs all handlers in sequence until one errors or requests requeue.
 // When the chain has a controller name set, each handler's duration is observed
-// on metrics.ReconcileDuration with handler=<TypeName>.
+// on metrics.ReconcileDuration with handler=<TypeName>, a failing handler
+// increments metrics.ChainHandlerErrorsTotal and every handler not reached
+// because an earlier one stopped the chain increments
+// metrics.ChainHandlerSkippedTotal. At debug level, each handler logs its
+// duration, its outcome and the data keys it declares through DataAccessor.
 //
 // Context error handling: a context.Canceled / context.DeadlineExceeded is
 // swallowed only when the parent ctx itself is done — that's a manager
@@ -79,22 +107,34 @@ func NewChain[T any, D any](controller string, handlers ...Handler[T, D]) *Chain
 // is propagated like any other reconciliation failure so controller-runtime
 // records it and re-queues with backoff.
 func (c *Chain[T, D]) Execute(ctx context.Context, rc *ReconcileContext[T, D]) error {
-	for _, h := range c.handlers {
+	logger := log.FromContext(ctx).WithName("chain")
+	debug := logger.Enabled(ctx, slog.LevelDebug)
+
+	for i, h := range c.handlers {
 		start := time.Now()
 		err := h.Handle(ctx, rc)
-		c.observe(h, start)
-		if err != nil {
-			if errors.Is(err, ErrShortCircuit) {
-				return nil
-			}
-			if isShutdownCtxErr(ctx, err) {
-				return nil
-			}
-			return err
+		elapsed := time.Since(start)
+
+		outcome := outcomeSuccess
+		switch {
+		case err != nil && errors.Is(err, ErrShortCircuit):
+			outcome, err = outcomeShortCircuit, nil
+		case err != nil && isShutdownCtxErr(ctx, err):
+			outcome, err = outcomeShutdown, nil
+		case err != nil:
+			outcome = outcomeError
+		case rc.Result.RequeueAfter > 0:
+			// Short-circuit if a handler requested a delayed requeue
+			outcome = outcomeRequeue
 		}
-		// Short-circuit if a handler requested a delayed requeue
-		if rc.Result.RequeueAfter > 0 {
-			return nil
+
+		c.observe(h, elapsed, outcome)
+		if debug {
+			c.logStep(logger, h, elapsed, outcome)
+		}
+		if outcome != outcomeSuccess {
+			c.skip(c.handlers[i+1:], outcome)
+			return err
 		}
 	}
 	return nil
@@ -111,15 +151,43 @@ func isShutdownCtxErr(ctx context.Context, err error) bool {
 	return ctx.Err() != nil
 }
 
-// observe records the duration of a single handler step. Skipped when the
-// chain was built without a controller name (e.g. unit tests of Execute).
-func (c *Chain[T, D]) observe(h Handler[T, D], start time.Time) {
+// observe records the duration and, on failure, the error of a single
+// handler step. Skipped when the chain was built without a controller name
+// (e.g. unit tests of Execute).
+func (c *Chain[T, D]) observe(h Handler[T, D], elapsed time.Duration, outcome string) {
 	if c.controller == "" {
 		return
 	}
+	name := handlerName(h)
 	metrics.ReconcileDuration.
-		WithLabelValues(c.controller, handlerName(h)).
-		Observe(time.Since(start).Seconds())
+		WithLabelValues(c.controller, name).
+		Observe(elapsed.Seconds())
+	if outcome == outcomeError {
+		metrics.ChainHandlerErrorsTotal.WithLabelValues(c.controller, name).Inc()
+	}
+}
+
+// skip records the handlers not run because an earlier one stopped the chain
+// with outcome.
+func (c *Chain[T, D]) skip(handlers []Handler[T, D], outcome string) {
+	if c.controller == "" {
+		return
+	}
+	for _, h := range handlers {
+		metrics.ChainHandlerSkippedTotal.WithLabelValues(c.controller, handlerName(h), outcome).Inc()
+	}
+}
+
+// logStep logs a handler step at debug level, with the data keys the handler
+// declares when it implements DataAccessor.
+func (c *Chain[T, D]) logStep(logger *log.Logger, h Handler[T, D], elapsed time.Duration, outcome string) {
+	args := []any{"controller", c.controller, "handler", handlerName(h),
+		"duration", elapsed.String(), "outcome", outcome}
+	if a, ok := h.(DataAccessor); ok {
+		reads, writes := a.DataKeys()
+		args = append(args, "reads", reads, "writes", writes)
+	}
+	logger.V(1).Info("chain handler done", args...)
 }
 
 // handlerName returns the Go type name of a handler (e.g. "*ScanWorkloadsHandler"
@@ -17,14 +17,19 @@ limitations under the License.
 package reconciler_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"log/slog"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/golgoth31/sreportal/internal/log"
+	"github.com/golgoth31/sreportal/internal/metrics"
 	"github.com/golgoth31/sreportal/internal/reconciler"
 )
 
@@ -144,3 +149,81 @@ func TestChain_Execute_SharesTypedData(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "hello world", rc.Data.Value)
 }
+
+type failingHandler struct{}
+
+func (failingHandler) Handle(_ context.Context, _ *reconciler.ReconcileContext[*struct{}, testData]) error {
+	return errors.New("boom")
+}
+
+type requeueHandler struct{}
+
+func (requeueHandler) Handle(_ context.Context, rc *reconciler.ReconcileContext[*struct{}, testData]) error {
+	rc.Result.RequeueAfter = time.Second
+	return nil
+}
+
+type laterHandler struct{}
+
+func (laterHandler) Handle(_ context.Context, _ *reconciler.ReconcileContext[*struct{}, testData]) error {
+	return nil
+}
+
+type declaringHandler struct{}
+
+func (declaringHandler) Handle(_ context.Context, rc *reconciler.ReconcileContext[*struct{}, testData]) error {
+	rc.Data.Value = "set"
+	return nil
+}
+
+func (declaringHandler) DataKeys() (reads, writes []string) {
+	return nil, []string{"Value"}
+}
+
+func TestChain_Execute_CountsErrorsAndSkips(t *testing.T) {
+	const controller = "test-errors"
+	chain := reconciler.NewChain[*struct{}, testData](controller, failingHandler{}, laterHandler{})
+
+	err := chain.Execute(context.Background(), &reconciler.ReconcileContext[*struct{}, testData]{})
+
+	require.Error(t, err)
+	assert.InDelta(t, 1, testutil.ToFloat64(metrics.ChainHandlerErrorsTotal.WithLabelValues(controller, "failingHandler")), 0)
+	assert.InDelta(t, 0, testutil.ToFloat64(metrics.ChainHandlerErrorsTotal.WithLabelValues(controller, "laterHandler")), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(metrics.ChainHandlerSkippedTotal.WithLabelValues(controller, "laterHandler", "error")), 0)
+}
+
+func TestChain_Execute_CountsSkipsOnRequeue(t *testing.T) {
+	const controller = "test-requeue"
+	chain := reconciler.NewChain[*struct{}, testData](controller, requeueHandler{}, laterHandler{})
+
+	require.NoError(t, chain.Execute(context.Background(), &reconciler.ReconcileContext[*struct{}, testData]{}))
+
+	assert.InDelta(t, 0, testutil.ToFloat64(metrics.ChainHandlerErrorsTotal.WithLabelValues(controller, "requeueHandler")), 0)
+	assert.InDelta(t, 1, testutil.ToFloat64(metrics.ChainHandlerSkippedTotal.WithLabelValues(controller, "laterHandler", "requeue")), 0)
+}
+
+func TestChain_Execute_LogsDataKeysAtDebugLevel(t *testing.T) {
+	var buf bytes.Buffer
+	logger := log.FromSlog(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	ctx := log.IntoContext(context.Background(), logger)
+	chain := reconciler.NewChain[*struct{}, testData]("test-debug", declaringHandler{}, laterHandler{})
+
+	require.NoError(t, chain.Execute(ctx, &reconciler.ReconcileContext[*struct{}, testData]{}))
+
+	out := buf.String()
+	assert.Contains(t, out, "handler=declaringHandler")
+	assert.Contains(t, out, "outcome=success")
+	assert.Contains(t, out, "writes=[Value]")
+	assert.Contains(t, out, "handler=laterHandler")
+}
+
+func TestChain_Execute_NoDebugLogAtInfoLevel(t *testing.T) {
+	var buf bytes.Buffer
+	logger := log.FromSlog(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo})))
+	ctx := log.IntoContext(context.Background(), logger)
+	chain := reconciler.NewChain[*struct{}, testData]("test-info", declaringHandler{})
+
+	require.NoError(t, chain.Execute(ctx, &reconciler.ReconcileContext[*struct{}, testData]{}))
+
+	assert.Empty(t, buf.String())
+}
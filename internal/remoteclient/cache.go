@@ -65,6 +65,20 @@ func (c *Cache) Get(key string, secretVersions map[string]string) *Client {
 	return entry.client
 }
 
+// Lookup returns the cached client for key regardless of secret versions, or
+// the fallback client when none is cached. It is meant for read paths outside
+// the portal reconciler (e.g. federated search) that cannot resolve secrets.
+func (c *Cache) Lookup(key string) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if entry, ok := c.entries[key]; ok {
+		return entry.client
+	}
+
+	return c.fallback
+}
+
 // Put stores a client in the cache with the associated secret versions.
 // The versions map is cloned to prevent caller mutations from affecting the cache.
 func (c *Cache) Put(key string, secretVersions map[string]string, client *Client) {
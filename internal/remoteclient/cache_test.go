@@ -110,3 +110,22 @@ func TestCache_Put_OverwritesExistingEntry(t *testing.T) {
 		t.Fatal("expected new client for updated versions")
 	}
 }
+
+func TestCache_Lookup_IgnoresSecretVersions(t *testing.T) {
+	cache := NewCache()
+	client := NewClient()
+
+	cache.Put("ns/portal", map[string]string{tSecretA: "v1"}, client)
+
+	if got := cache.Lookup("ns/portal"); got != client {
+		t.Fatal("expected cached client regardless of versions")
+	}
+}
+
+func TestCache_Lookup_ReturnsFallbackOnMiss(t *testing.T) {
+	cache := NewCache()
+
+	if got := cache.Lookup("ns/unknown"); got != cache.Fallback() {
+		t.Fatal("expected fallback client on cache miss")
+	}
+}
@@ -20,17 +20,19 @@ package remoteclient
 import (
 	"context"
 	"crypto/tls"
+	"encoding/base64"
 	"fmt"
 	"net/http"
-	"sort"
 	"time"
 
 	"connectrpc.com/connect"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
 	sreportalv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
 	"github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1/sreportalv1connect"
+	"github.com/golgoth31/sreportal/pkg/inventory"
 )
 
 // DefaultTimeout is the default timeout for remote portal requests.
@@ -47,10 +49,16 @@ const defaultGroupName = "default"
 
 // Client provides methods to communicate with remote SRE Portal instances.
 type Client struct {
-	httpClient    *http.Client
+	httpClient *http.Client
+	// streamClient shares the transport of httpClient without its timeout,
+	// which would end streams after it.
+	streamClient  *http.Client
 	timeout       time.Duration
 	retryAttempts int
 	retryDelay    time.Duration
+	// authorization is the Authorization header sent with every request,
+	// empty when the remote portal needs no credentials.
+	authorization string
 }
 
 // Option is a function that configures the Client.
@@ -93,6 +101,22 @@ func WithTLSConfig(tlsConfig *tls.Config) Option {
 	}
 }
 
+// WithBearerToken authenticates every request with a bearer token, e.g. an API
+// token of the remote instance.
+func WithBearerToken(token string) Option {
+	return func(c *Client) {
+		c.authorization = "Bearer " + token
+	}
+}
+
+// WithBasicAuth authenticates every request with basic auth credentials, e.g.
+// for a proxy in front of the remote instance.
+func WithBasicAuth(username, password string) Option {
+	return func(c *Client) {
+		c.authorization = "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+	}
+}
+
 // NewClient creates a new remote portal client with the given options.
 func NewClient(opts ...Option) *Client {
 	c := &Client{
@@ -111,9 +135,34 @@ func NewClient(opts ...Option) *Client {
 	// Ensure HTTP client timeout matches configured timeout
 	c.httpClient.Timeout = c.timeout
 
+	if c.authorization != "" {
+		base := c.httpClient.Transport
+		if base == nil {
+			base = http.DefaultTransport
+		}
+		c.httpClient.Transport = &authTransport{base: base, authorization: c.authorization}
+	}
+
+	streamClient := *c.httpClient
+	streamClient.Timeout = 0
+	c.streamClient = &streamClient
+
 	return c
 }
 
+// authTransport sets the Authorization header of every request.
+type authTransport struct {
+	base          http.RoundTripper
+	authorization string
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", t.authorization)
+	return t.base.RoundTrip(req)
+}
+
 // FetchResult contains the result of fetching data from a remote portal.
 type FetchResult struct {
 	// Groups contains the FQDN groups fetched from the remote portal.
@@ -159,42 +208,8 @@ func (c *Client) doFetchFQDNs(ctx context.Context, baseURL string, portalName st
 		baseURL,
 	)
 
-	// Create portal service client to get portal info
-	portalClient := sreportalv1connect.NewPortalServiceClient(
-		c.httpClient,
-		baseURL,
-	)
-
 	// Fetch portal info to get title and features
-	var remoteTitle string
-	var remoteFeatures *sreportalv1alpha1.PortalFeaturesStatus
-	portalResp, err := portalClient.ListPortals(ctx, connect.NewRequest(&sreportalv1.ListPortalsRequest{}))
-	if err == nil {
-		// Find the portal with the given name, or use the main portal
-		var matched *sreportalv1.Portal
-		for _, p := range portalResp.Msg.Portals {
-			if portalName != "" && p.Name == portalName {
-				matched = p
-				break
-			}
-			if p.Main {
-				matched = p
-			}
-		}
-		if matched != nil {
-			remoteTitle = matched.Title
-			if matched.Features != nil {
-				remoteFeatures = &sreportalv1alpha1.PortalFeaturesStatus{
-					DNS:            matched.Features.Dns,
-					Releases:       matched.Features.Releases,
-					NetworkPolicy:  matched.Features.NetworkPolicy,
-					Alerts:         matched.Features.Alerts,
-					StatusPage:     matched.Features.StatusPage,
-					ImageInventory: matched.Features.ImageInventory,
-				}
-			}
-		}
-	}
+	remoteTitle, remoteFeatures, _ := c.doFetchPortalInfo(ctx, baseURL, portalName)
 
 	// Fetch FQDNs
 	req := connect.NewRequest(&sreportalv1.ListFQDNsRequest{
@@ -217,6 +232,138 @@ func (c *Client) doFetchFQDNs(ctx context.Context, baseURL string, portalName st
 	}, nil
 }
 
+// FetchPortalInfo fetches the title and the features of a remote portal,
+// without its FQDNs.
+func (c *Client) FetchPortalInfo(ctx context.Context, baseURL string, portalName string) (*FetchResult, error) {
+	var lastErr error
+
+	for attempt := 0; attempt < c.retryAttempts; attempt++ {
+		if attempt > 0 {
+			delay := c.retryDelay * time.Duration(1<<(attempt-1))
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+
+		title, features, err := c.doFetchPortalInfo(ctx, baseURL, portalName)
+		if err == nil {
+			return &FetchResult{RemoteTitle: title, RemoteFeatures: features}, nil
+		}
+		lastErr = err
+	}
+
+	return nil, fmt.Errorf("fetch portal info failed after %d attempts: %w", c.retryAttempts, lastErr)
+}
+
+// doFetchPortalInfo returns the title and the features of the portal named
+// portalName on the remote instance, or of its main portal.
+func (c *Client) doFetchPortalInfo(ctx context.Context, baseURL string, portalName string) (string, *sreportalv1alpha1.PortalFeaturesStatus, error) {
+	portalClient := sreportalv1connect.NewPortalServiceClient(
+		c.httpClient,
+		baseURL,
+	)
+
+	portalResp, err := portalClient.ListPortals(ctx, connect.NewRequest(&sreportalv1.ListPortalsRequest{}))
+	if err != nil {
+		return "", nil, fmt.Errorf("list portals from remote portal: %w", err)
+	}
+
+	// Find the portal with the given name, or use the main portal
+	var matched *sreportalv1.Portal
+	for _, p := range portalResp.Msg.Portals {
+		if portalName != "" && p.Name == portalName {
+			matched = p
+			break
+		}
+		if p.Main {
+			matched = p
+		}
+	}
+	if matched == nil {
+		return "", nil, nil
+	}
+	var features *sreportalv1alpha1.PortalFeaturesStatus
+	if matched.Features != nil {
+		features = &sreportalv1alpha1.PortalFeaturesStatus{
+			DNS:            matched.Features.Dns,
+			Releases:       matched.Features.Releases,
+			NetworkPolicy:  matched.Features.NetworkPolicy,
+			Alerts:         matched.Features.Alerts,
+			StatusPage:     matched.Features.StatusPage,
+			ImageInventory: matched.Features.ImageInventory,
+		}
+	}
+	return matched.Title, features, nil
+}
+
+// SearchFQDNs searches FQDNs by name substring on a remote portal.
+// Unlike the Fetch* methods it performs a single attempt: it serves interactive
+// federated searches, where the caller bounds the call with its own deadline.
+func (c *Client) SearchFQDNs(ctx context.Context, baseURL, portalName, search, source string) ([]domaindns.FQDNView, error) {
+	dnsClient := sreportalv1connect.NewDNSServiceClient(
+		c.httpClient,
+		baseURL,
+	)
+
+	resp, err := dnsClient.ListFQDNs(ctx, connect.NewRequest(&sreportalv1.ListFQDNsRequest{
+		Portal: portalName,
+		Search: search,
+		Source: source,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to search FQDNs on remote portal: %w", err)
+	}
+
+	views := make([]domaindns.FQDNView, 0, len(resp.Msg.Fqdns))
+	for _, f := range resp.Msg.Fqdns {
+		v := domaindns.FQDNView{
+			ID:                 f.Id,
+			Name:               f.Name,
+			Source:             domaindns.Source(f.Source),
+			Groups:             f.Groups,
+			Description:        f.Description,
+			RecordType:         f.RecordType,
+			Targets:            f.Targets,
+			Portals:            f.Portals,
+			SyncStatus:         f.SyncStatus,
+			TargetScope:        domaindns.TargetScope(f.TargetScope),
+			Paths:              f.Paths,
+			Annotations:        f.Annotations,
+			InternalSyncStatus: f.InternalSyncStatus,
+			ExternalSyncStatus: f.ExternalSyncStatus,
+			LookupFailure:      f.LookupFailure,
+			Availability:       f.Availability,
+			HealthStatus:       f.HealthStatus,
+			HTTPStatusCode:     int(f.HttpStatusCode),
+			ProbeLatency:       time.Duration(f.ProbeLatencyMs) * time.Millisecond,
+			TLSValid:           f.TlsValid,
+		}
+		for _, p := range f.Ports {
+			v.Ports = append(v.Ports, domaindns.ServicePort{Name: p.Name, Port: p.Port, Protocol: p.Protocol})
+		}
+		for _, p := range f.TargetProviders {
+			v.TargetProviders = append(v.TargetProviders, domaindns.TargetProvider{Target: p.Target, Provider: p.Provider, ASN: p.Asn})
+		}
+		if f.LastSeen != nil {
+			v.LastSeen = f.LastSeen.AsTime()
+		}
+		if f.LastProbeTime != nil {
+			v.LastProbeTime = f.LastProbeTime.AsTime()
+		}
+		if c := f.GetOwnershipConflict(); c != nil {
+			v.OwnershipConflict = &domaindns.OwnershipConflict{DetectedAt: c.GetDetectedAt().AsTime()}
+			for _, set := range c.GetTargetSets() {
+				v.OwnershipConflict.TargetSets = append(v.OwnershipConflict.TargetSets, set.GetTargets())
+			}
+		}
+		views = append(views, v)
+	}
+
+	return views, nil
+}
+
 // AlertsFetchResult contains the result of fetching alerts from a remote portal.
 type AlertsFetchResult struct {
 	// Alerts contains the active alerts fetched from the remote portal.
@@ -607,54 +754,38 @@ func (c *Client) HealthCheck(ctx context.Context, baseURL string) error {
 
 // convertToGroups converts a list of FQDNs from the proto format to the CRD status format.
 func convertToGroups(fqdns []*sreportalv1.FQDN) []sreportalv1alpha1.FQDNGroupStatus {
-	// Group FQDNs by group name
-	groupMap := make(map[string]*sreportalv1alpha1.FQDNGroupStatus)
-
-	for _, fqdn := range fqdns {
-		groupNames := fqdn.Groups
-		if len(groupNames) == 0 {
-			groupNames = []string{defaultGroupName}
-		}
-
-		var lastSeen time.Time
-		if fqdn.LastSeen != nil {
-			lastSeen = fqdn.LastSeen.AsTime()
-		} else {
-			lastSeen = time.Now()
+	byGroup := inventory.GroupBy(fqdns, (*sreportalv1.FQDN).GetGroups, defaultGroupName)
+
+	groups := make([]sreportalv1alpha1.FQDNGroupStatus, 0, len(byGroup))
+	for _, g := range byGroup {
+		group := sreportalv1alpha1.FQDNGroupStatus{
+			Name:   g.Name,
+			Source: "remote",
+			FQDNs:  make([]sreportalv1alpha1.FQDNStatus, 0, len(g.Members)),
 		}
-
-		fqdnStatus := sreportalv1alpha1.FQDNStatus{
-			FQDN:        fqdn.Name,
-			Description: fqdn.Description,
-			RecordType:  fqdn.RecordType,
-			Targets:     fqdn.Targets,
-			LastSeen:    metav1.Time{Time: lastSeen},
-			SyncStatus:  fqdn.SyncStatus,
+		for _, fqdn := range g.Members {
+			group.FQDNs = append(group.FQDNs, toFQDNStatus(fqdn))
 		}
+		groups = append(groups, group)
+	}
 
-		for _, groupName := range groupNames {
-			group, exists := groupMap[groupName]
-			if !exists {
-				group = &sreportalv1alpha1.FQDNGroupStatus{
-					Name:   groupName,
-					Source: "remote",
-					FQDNs:  []sreportalv1alpha1.FQDNStatus{},
-				}
-				groupMap[groupName] = group
-			}
+	return groups
+}
 
-			group.FQDNs = append(group.FQDNs, fqdnStatus)
-		}
+// toFQDNStatus converts an FQDN from the proto format to the CRD status
+// format. An FQDN without lastSeen is considered seen now.
+func toFQDNStatus(fqdn *sreportalv1.FQDN) sreportalv1alpha1.FQDNStatus {
+	lastSeen := time.Now()
+	if fqdn.LastSeen != nil {
+		lastSeen = fqdn.LastSeen.AsTime()
 	}
 
-	// Convert map to sorted slice (map iteration is non-deterministic)
-	groups := make([]sreportalv1alpha1.FQDNGroupStatus, 0, len(groupMap))
-	for _, group := range groupMap {
-		groups = append(groups, *group)
+	return sreportalv1alpha1.FQDNStatus{
+		FQDN:        fqdn.Name,
+		Description: fqdn.Description,
+		RecordType:  fqdn.RecordType,
+		Targets:     fqdn.Targets,
+		LastSeen:    metav1.Time{Time: lastSeen},
+		SyncStatus:  fqdn.SyncStatus,
 	}
-	sort.Slice(groups, func(i, j int) bool {
-		return groups[i].Name < groups[j].Name
-	})
-
-	return groups
 }
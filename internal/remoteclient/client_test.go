@@ -309,6 +309,60 @@ func TestFetchFQDNs(t *testing.T) {
 	})
 }
 
+func TestSearchFQDNs(t *testing.T) {
+	t.Run("converts remote FQDNs to views", func(t *testing.T) {
+		now := time.Now().Truncate(time.Second)
+		dnsHandler := &mockDNSServiceHandler{
+			fqdns: []*sreportalv1.FQDN{
+				{
+					Name:       tFQDNApp,
+					Source:     "manual",
+					RecordType: "A",
+					Targets:    []string{tIP19216811},
+					Groups:     []string{tEnvProd},
+					Portals:    []string{tPortalMain},
+					LastSeen:   timestamppb.New(now),
+				},
+			},
+		}
+
+		mux := http.NewServeMux()
+		mux.Handle(sreportalv1connect.NewDNSServiceHandler(dnsHandler))
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		client := NewClient()
+		views, err := client.SearchFQDNs(context.Background(), server.URL, "", "app", "")
+
+		require.NoError(t, err)
+		require.Len(t, views, 1)
+		assert.Equal(t, tFQDNApp, views[0].Name)
+		assert.Equal(t, "manual", string(views[0].Source))
+		assert.Equal(t, []string{tIP19216811}, views[0].Targets)
+		assert.Equal(t, []string{tPortalMain}, views[0].Portals)
+		assert.True(t, now.Equal(views[0].LastSeen))
+	})
+
+	t.Run("does not retry on error", func(t *testing.T) {
+		dnsHandler := &mockDNSServiceHandler{
+			err: connect.NewError(connect.CodeUnavailable, assert.AnError),
+		}
+
+		mux := http.NewServeMux()
+		mux.Handle(sreportalv1connect.NewDNSServiceHandler(dnsHandler))
+
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		client := NewClient(WithRetryAttempts(3), WithRetryDelay(time.Hour))
+		_, err := client.SearchFQDNs(context.Background(), server.URL, "", "app", "")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to search FQDNs")
+	})
+}
+
 func TestHealthCheck(t *testing.T) {
 	t.Run("successful health check", func(t *testing.T) {
 		portalHandler := &mockPortalServiceHandler{
@@ -333,6 +387,21 @@ func TestHealthCheck(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("sends the credentials", func(t *testing.T) {
+		var authorization []string
+		mux := http.NewServeMux()
+		mux.Handle(sreportalv1connect.NewPortalServiceHandler(&mockPortalServiceHandler{}))
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization = append(authorization, r.Header.Get("Authorization"))
+			mux.ServeHTTP(w, r)
+		}))
+		defer server.Close()
+
+		require.NoError(t, NewClient(WithBearerToken("s3cr3t")).HealthCheck(context.Background(), server.URL))
+		require.NoError(t, NewClient(WithBasicAuth("user", "pass")).HealthCheck(context.Background(), server.URL))
+		assert.Equal(t, []string{"Bearer s3cr3t", "Basic dXNlcjpwYXNz"}, authorization)
+	})
+
 	t.Run("failed health check - server unavailable", func(t *testing.T) {
 		client := NewClient(WithTimeout(100 * time.Millisecond))
 		err := client.HealthCheck(context.Background(), "http://localhost:59999")
@@ -0,0 +1,127 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remoteclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/tlsutil"
+)
+
+// ForPortal returns the client of a remote portal, configured with the TLS
+// settings and credentials of its spec and cached until one of the referenced
+// Secrets changes. Portals with neither share the fallback client.
+func ForPortal(ctx context.Context, reader client.Reader, cache *Cache, portal *sreportalv1alpha1.Portal) (*Client, error) {
+	remote := portal.Spec.Remote
+	if remote.TLS == nil && remote.Auth == nil {
+		return cache.Fallback(), nil
+	}
+
+	key := portal.Namespace + "/" + portal.Name
+	versions, err := tlsutil.SecretVersions(ctx, reader, portal.Namespace, remote.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("read TLS secret versions: %w", err)
+	}
+	authSecrets, err := authSecrets(ctx, reader, portal.Namespace, remote.Auth)
+	if err != nil {
+		return nil, fmt.Errorf("read auth secrets: %w", err)
+	}
+	if versions == nil {
+		versions = make(map[string]string, len(authSecrets))
+	}
+	for _, secret := range authSecrets {
+		versions[secret.Name] = secret.ResourceVersion
+	}
+
+	if cached := cache.Get(key, versions); cached != nil {
+		return cached, nil
+	}
+
+	var opts []Option
+	if remote.TLS != nil {
+		tlsConfig, err := tlsutil.BuildTLSConfig(ctx, reader, portal.Namespace, remote.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("build TLS config: %w", err)
+		}
+		opts = append(opts, WithTLSConfig(tlsConfig))
+	}
+	if remote.Auth != nil {
+		opt, err := authOption(remote.Auth, authSecrets)
+		if err != nil {
+			return nil, fmt.Errorf("build credentials: %w", err)
+		}
+		opts = append(opts, opt)
+	}
+
+	c := NewClient(opts...)
+	cache.Put(key, versions, c)
+
+	return c, nil
+}
+
+// authSecrets reads the Secrets referenced by the auth config, keyed by name.
+func authSecrets(ctx context.Context, reader client.Reader, namespace string, auth *sreportalv1alpha1.RemoteAuthConfig) (map[string]*corev1.Secret, error) {
+	if auth == nil {
+		return nil, nil
+	}
+	secrets := make(map[string]*corev1.Secret, 1)
+	for _, ref := range []*sreportalv1alpha1.SecretRef{auth.BearerTokenSecretRef, auth.BasicAuthSecretRef} {
+		if ref == nil {
+			continue
+		}
+		secret := &corev1.Secret{}
+		if err := reader.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, secret); err != nil {
+			return nil, fmt.Errorf("get secret %s/%s: %w", namespace, ref.Name, err)
+		}
+		secrets[ref.Name] = secret
+	}
+	return secrets, nil
+}
+
+// authOption returns the option sending the credentials of the auth config.
+func authOption(auth *sreportalv1alpha1.RemoteAuthConfig, secrets map[string]*corev1.Secret) (Option, error) {
+	switch {
+	case auth.BearerTokenSecretRef != nil:
+		name := auth.BearerTokenSecretRef.Name
+		// Trimmed: tokens written with kubectl --from-file often end with a newline.
+		token := strings.TrimSpace(string(secrets[name].Data["token"]))
+		if token == "" {
+			return nil, fmt.Errorf("bearer token secret %q does not contain key \"token\"", name)
+		}
+		return WithBearerToken(token), nil
+	case auth.BasicAuthSecretRef != nil:
+		name := auth.BasicAuthSecretRef.Name
+		username, ok := secrets[name].Data["username"]
+		if !ok {
+			return nil, fmt.Errorf("basic auth secret %q does not contain key \"username\"", name)
+		}
+		password, ok := secrets[name].Data["password"]
+		if !ok {
+			return nil, fmt.Errorf("basic auth secret %q does not contain key \"password\"", name)
+		}
+		return WithBasicAuth(string(username), string(password)), nil
+	default:
+		return func(*Client) {}, nil
+	}
+}
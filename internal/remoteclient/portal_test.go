@@ -0,0 +1,86 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remoteclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+)
+
+func remotePortal(auth *sreportalv1alpha1.RemoteAuthConfig) *sreportalv1alpha1.Portal {
+	return &sreportalv1alpha1.Portal{
+		ObjectMeta: metav1.ObjectMeta{Name: "remote", Namespace: tNsDefault},
+		Spec: sreportalv1alpha1.PortalSpec{
+			Remote: &sreportalv1alpha1.RemotePortalSpec{URL: "https://remote.example.com", Auth: auth},
+		},
+	}
+}
+
+func TestForPortal(t *testing.T) {
+	ctx := context.Background()
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: tSecretA, Namespace: tNsDefault},
+		Data:       map[string][]byte{"token": []byte("s3cr3t\n")},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(secret).Build()
+	cache := NewCache()
+
+	t.Run("no TLS nor auth uses the fallback client", func(t *testing.T) {
+		got, err := ForPortal(ctx, c, cache, remotePortal(nil))
+		require.NoError(t, err)
+		assert.Same(t, cache.Fallback(), got)
+	})
+
+	auth := &sreportalv1alpha1.RemoteAuthConfig{BearerTokenSecretRef: &sreportalv1alpha1.SecretRef{Name: tSecretA}}
+
+	t.Run("bearer token is cached until the secret changes", func(t *testing.T) {
+		first, err := ForPortal(ctx, c, cache, remotePortal(auth))
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer s3cr3t", first.authorization)
+
+		again, err := ForPortal(ctx, c, cache, remotePortal(auth))
+		require.NoError(t, err)
+		assert.Same(t, first, again)
+
+		secret.Data["token"] = []byte("rotated")
+		require.NoError(t, c.Update(ctx, secret))
+		rotated, err := ForPortal(ctx, c, cache, remotePortal(auth))
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer rotated", rotated.authorization)
+	})
+
+	t.Run("missing key fails", func(t *testing.T) {
+		basic := &sreportalv1alpha1.RemoteAuthConfig{BasicAuthSecretRef: &sreportalv1alpha1.SecretRef{Name: tSecretA}}
+		_, err := ForPortal(ctx, c, NewCache(), remotePortal(basic))
+		require.ErrorContains(t, err, `does not contain key "username"`)
+	})
+
+	t.Run("missing secret fails", func(t *testing.T) {
+		missing := &sreportalv1alpha1.RemoteAuthConfig{BearerTokenSecretRef: &sreportalv1alpha1.SecretRef{Name: "missing"}}
+		_, err := ForPortal(ctx, c, NewCache(), remotePortal(missing))
+		require.ErrorContains(t, err, "read auth secrets")
+	})
+}
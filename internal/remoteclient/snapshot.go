@@ -0,0 +1,66 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remoteclient
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"slices"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	sreportalv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+)
+
+// ReadSnapshot reads a ListFQDNsResponse snapshot from path. See
+// DecodeSnapshot for the accepted encodings and portal filtering.
+func ReadSnapshot(path, portalName string) (*FetchResult, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read snapshot: %w", err)
+	}
+	return DecodeSnapshot(data, portalName)
+}
+
+// DecodeSnapshot decodes a serialized ListFQDNsResponse, in protobuf JSON
+// (the body of a ListFQDNs call made with the Connect JSON codec) or binary
+// form. When portalName is set, only the FQDNs exposed by that portal are
+// kept, mirroring the portal filter of a live ListFQDNs call.
+func DecodeSnapshot(data []byte, portalName string) (*FetchResult, error) {
+	var resp sreportalv1.ListFQDNsResponse
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(trimmed, &resp); err != nil {
+			return nil, fmt.Errorf("decode JSON snapshot: %w", err)
+		}
+	} else if err := proto.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("decode binary snapshot: %w", err)
+	}
+
+	fqdns := resp.Fqdns
+	if portalName != "" {
+		fqdns = slices.DeleteFunc(slices.Clone(fqdns), func(f *sreportalv1.FQDN) bool {
+			return !slices.Contains(f.Portals, portalName)
+		})
+	}
+
+	return &FetchResult{
+		Groups:    convertToGroups(fqdns),
+		FQDNCount: len(fqdns),
+	}, nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remoteclient
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	sreportalv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+)
+
+func snapshotResponse() *sreportalv1.ListFQDNsResponse {
+	return &sreportalv1.ListFQDNsResponse{Fqdns: []*sreportalv1.FQDN{
+		{Name: tFQDNApp, RecordType: "A", Targets: []string{tIP10001}, Groups: []string{"apps"}, Portals: []string{tPortalMain}},
+		{Name: "db.example.com", RecordType: "A", Targets: []string{tIP19216811}, Portals: []string{"data"}},
+	}}
+}
+
+func TestDecodeSnapshot_JSON(t *testing.T) {
+	data, err := protojson.Marshal(snapshotResponse())
+	require.NoError(t, err)
+
+	result, err := DecodeSnapshot(data, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.FQDNCount)
+	require.Len(t, result.Groups, 2)
+	assert.Equal(t, "apps", result.Groups[0].Name)
+	assert.Equal(t, defaultGroupName, result.Groups[1].Name)
+}
+
+func TestDecodeSnapshot_BinaryFilteredByPortal(t *testing.T) {
+	data, err := proto.Marshal(snapshotResponse())
+	require.NoError(t, err)
+
+	result, err := DecodeSnapshot(data, tPortalMain)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.FQDNCount)
+	require.Len(t, result.Groups, 1)
+	assert.Equal(t, tFQDNApp, result.Groups[0].FQDNs[0].FQDN)
+}
+
+func TestDecodeSnapshot_Invalid(t *testing.T) {
+	_, err := DecodeSnapshot([]byte(`{"fqdns": 42}`), "")
+	assert.Error(t, err)
+}
+
+func TestReadSnapshot(t *testing.T) {
+	data, err := protojson.Marshal(snapshotResponse())
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	result, err := ReadSnapshot(path, "data")
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.FQDNCount)
+
+	_, err = ReadSnapshot(filepath.Join(t.TempDir(), "missing.json"), "")
+	assert.Error(t, err)
+}
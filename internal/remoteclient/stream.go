@@ -0,0 +1,131 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remoteclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	sreportalv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	"github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1/sreportalv1connect"
+)
+
+// FQDNEventType is the kind of change carried by an FQDNEvent.
+type FQDNEventType int
+
+const (
+	// FQDNAdded is an FQDN of the initial state of the stream, or one that
+	// appeared afterwards.
+	FQDNAdded FQDNEventType = iota
+	// FQDNModified is an FQDN whose content changed.
+	FQDNModified
+	// FQDNDeleted is an FQDN that disappeared from the remote portal.
+	FQDNDeleted
+	// FQDNsSynced follows the initial state of the stream. It carries no FQDN.
+	FQDNsSynced
+)
+
+// FQDNEvent is a change of the FQDNs of a remote portal.
+type FQDNEvent struct {
+	Type FQDNEventType
+	// View is the FQDN as reported by the remote portal, in the same subset
+	// as FetchFQDNs: its groups (default when it has none), record type,
+	// targets, description, sync status and last seen time.
+	View domaindns.FQDNView
+}
+
+// errStreamClosed is returned when the remote ends a stream.
+var errStreamClosed = errors.New("stream closed by remote portal")
+
+// StreamFQDNs follows the FQDNs of a remote portal through its StreamFQDNs
+// RPC and calls fn with each change, heartbeats aside. The stream starts with
+// the full state as FQDNAdded events, closed by an FQDNsSynced event.
+// StreamFQDNs performs a single session: it returns when ctx is done, when fn
+// returns an error or when the stream ends, which it always reports as an
+// error. Reconnection is left to a StreamSupervisor.
+func (c *Client) StreamFQDNs(ctx context.Context, baseURL string, portalName string, fn func(FQDNEvent) error) error {
+	dnsClient := sreportalv1connect.NewDNSServiceClient(
+		c.streamClient,
+		baseURL,
+	)
+
+	stream, err := dnsClient.StreamFQDNs(ctx, connect.NewRequest(&sreportalv1.StreamFQDNsRequest{
+		Portal: portalName,
+	}))
+	if err != nil {
+		return fmt.Errorf("stream FQDNs from remote portal: %w", err)
+	}
+	defer func() { _ = stream.Close() }()
+
+	for stream.Receive() {
+		msg := stream.Msg()
+		var event FQDNEvent
+		switch msg.Type {
+		case sreportalv1.UpdateType_UPDATE_TYPE_ADDED:
+			event.Type = FQDNAdded
+		case sreportalv1.UpdateType_UPDATE_TYPE_MODIFIED:
+			event.Type = FQDNModified
+		case sreportalv1.UpdateType_UPDATE_TYPE_DELETED:
+			event.Type = FQDNDeleted
+		case sreportalv1.UpdateType_UPDATE_TYPE_SYNCED:
+			event.Type = FQDNsSynced
+		default:
+			continue
+		}
+		if event.Type != FQDNsSynced {
+			if msg.Fqdn == nil {
+				continue
+			}
+			event.View = remoteFQDNView(msg.Fqdn)
+		}
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+	if err := stream.Err(); err != nil {
+		return fmt.Errorf("stream FQDNs from remote portal: %w", err)
+	}
+	return errStreamClosed
+}
+
+// remoteFQDNView converts a streamed FQDN to the view synced from remote
+// portals, the subset convertToGroups keeps from ListFQDNs.
+func remoteFQDNView(f *sreportalv1.FQDN) domaindns.FQDNView {
+	groups := f.Groups
+	if len(groups) == 0 {
+		groups = []string{defaultGroupName}
+	}
+	lastSeen := time.Now()
+	if f.LastSeen != nil {
+		lastSeen = f.LastSeen.AsTime()
+	}
+	return domaindns.FQDNView{
+		Name:        f.Name,
+		Source:      domaindns.Source("remote"),
+		Groups:      groups,
+		Description: f.Description,
+		RecordType:  f.RecordType,
+		Targets:     f.Targets,
+		LastSeen:    lastSeen,
+		SyncStatus:  f.SyncStatus,
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remoteclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sreportalv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	"github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1/sreportalv1connect"
+)
+
+// mockStreamDNSServiceHandler streams a fixed sequence of updates, pausing
+// for delay before each of them.
+type mockStreamDNSServiceHandler struct {
+	sreportalv1connect.UnimplementedDNSServiceHandler
+	updates []*sreportalv1.StreamFQDNsResponse
+	delay   time.Duration
+	portal  string
+}
+
+func (m *mockStreamDNSServiceHandler) StreamFQDNs(
+	ctx context.Context,
+	req *connect.Request[sreportalv1.StreamFQDNsRequest],
+	stream *connect.ServerStream[sreportalv1.StreamFQDNsResponse],
+) error {
+	m.portal = req.Msg.Portal
+	for _, u := range m.updates {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(m.delay):
+		}
+		if err := stream.Send(u); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newStreamServer(t *testing.T, h *mockStreamDNSServiceHandler) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.Handle(sreportalv1connect.NewDNSServiceHandler(h))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestStreamFQDNs(t *testing.T) {
+	t.Run("relays changes and skips heartbeats", func(t *testing.T) {
+		h := &mockStreamDNSServiceHandler{
+			// Longer than the client timeout: streams must outlive it.
+			delay: 30 * time.Millisecond,
+			updates: []*sreportalv1.StreamFQDNsResponse{
+				{Type: sreportalv1.UpdateType_UPDATE_TYPE_ADDED, Fqdn: &sreportalv1.FQDN{Name: tFQDNApp, RecordType: "A", Targets: []string{tIP19216811}}},
+				{Type: sreportalv1.UpdateType_UPDATE_TYPE_SYNCED},
+				{Type: sreportalv1.UpdateType_UPDATE_TYPE_HEARTBEAT},
+				{Type: sreportalv1.UpdateType_UPDATE_TYPE_MODIFIED, Fqdn: &sreportalv1.FQDN{Name: tFQDNApp, RecordType: "A", Groups: []string{tEnvProd}}},
+				{Type: sreportalv1.UpdateType_UPDATE_TYPE_DELETED, Fqdn: &sreportalv1.FQDN{Name: tFQDNApp, RecordType: "A"}},
+			},
+		}
+		server := newStreamServer(t, h)
+
+		var events []FQDNEvent
+		client := NewClient(WithTimeout(20 * time.Millisecond))
+		err := client.StreamFQDNs(context.Background(), server.URL, tPortalMain, func(e FQDNEvent) error {
+			events = append(events, e)
+			return nil
+		})
+
+		require.ErrorIs(t, err, errStreamClosed)
+		assert.Equal(t, tPortalMain, h.portal)
+		require.Len(t, events, 4)
+		assert.Equal(t, FQDNAdded, events[0].Type)
+		assert.Equal(t, tFQDNApp, events[0].View.Name)
+		assert.Equal(t, []string{defaultGroupName}, events[0].View.Groups)
+		assert.Equal(t, "remote", string(events[0].View.Source))
+		assert.False(t, events[0].View.LastSeen.IsZero())
+		assert.Equal(t, FQDNsSynced, events[1].Type)
+		assert.Equal(t, FQDNModified, events[2].Type)
+		assert.Equal(t, []string{tEnvProd}, events[2].View.Groups)
+		assert.Equal(t, FQDNDeleted, events[3].Type)
+	})
+
+	t.Run("stops on callback error", func(t *testing.T) {
+		server := newStreamServer(t, &mockStreamDNSServiceHandler{
+			updates: []*sreportalv1.StreamFQDNsResponse{
+				{Type: sreportalv1.UpdateType_UPDATE_TYPE_SYNCED},
+				{Type: sreportalv1.UpdateType_UPDATE_TYPE_ADDED, Fqdn: &sreportalv1.FQDN{Name: tFQDNApp}},
+			},
+		})
+
+		calls := 0
+		err := NewClient().StreamFQDNs(context.Background(), server.URL, "", func(FQDNEvent) error {
+			calls++
+			return assert.AnError
+		})
+
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("reports the code of a failed call", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.Handle(sreportalv1connect.NewDNSServiceHandler(&mockDNSServiceHandler{}))
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		err := NewClient().StreamFQDNs(context.Background(), server.URL, "", func(FQDNEvent) error { return nil })
+
+		require.Error(t, err)
+		assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+		assert.False(t, errors.Is(err, errStreamClosed))
+	})
+}
+
+func TestFetchPortalInfo(t *testing.T) {
+	t.Run("returns the named portal", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.Handle(sreportalv1connect.NewPortalServiceHandler(&mockPortalServiceHandler{
+			portals: []*sreportalv1.Portal{
+				{Name: tPortalMain, Title: tTitleMain, Main: true},
+				{Name: "team", Title: "Team", Features: &sreportalv1.PortalFeatures{Dns: true}},
+			},
+		}))
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		result, err := NewClient().FetchPortalInfo(context.Background(), server.URL, "team")
+
+		require.NoError(t, err)
+		assert.Equal(t, "Team", result.RemoteTitle)
+		require.NotNil(t, result.RemoteFeatures)
+		assert.True(t, result.RemoteFeatures.DNS)
+		assert.Empty(t, result.Groups)
+	})
+
+	t.Run("retries then fails", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.Handle(sreportalv1connect.NewPortalServiceHandler(&mockPortalServiceHandler{
+			err: connect.NewError(connect.CodeUnavailable, assert.AnError),
+		}))
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		_, err := NewClient(WithRetryAttempts(2), WithRetryDelay(time.Millisecond)).FetchPortalInfo(context.Background(), server.URL, "")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed after 2 attempts")
+	})
+}
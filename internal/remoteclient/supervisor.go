@@ -0,0 +1,214 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remoteclient
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	sreportalclient "github.com/golgoth31/sreportal/pkg/client"
+)
+
+// DefaultStreamMinBackoff is the delay before the first reconnection of a
+// supervised stream.
+const DefaultStreamMinBackoff = 1 * time.Second
+
+// DefaultStreamMaxBackoff caps the delay between two reconnections.
+const DefaultStreamMaxBackoff = 2 * time.Minute
+
+// DefaultStreamJitter is the fraction of the backoff randomized on each
+// reconnection, so that portals following the same remote do not reconnect
+// in lockstep after an outage.
+const DefaultStreamJitter = 0.2
+
+// StreamFunc runs one session of a stream until it ends. It resumes after
+// cursor (empty for the first session) and reports the cursor of each
+// message it processed through progress, so that the next session resumes
+// after it. The first progress call marks the stream as connected.
+type StreamFunc func(ctx context.Context, cursor string, progress func(cursor string)) error
+
+// StreamHealth is a snapshot of the health of a supervised stream.
+type StreamHealth struct {
+	State sreportalv1alpha1.RemoteStreamState
+	// Since is when the stream entered State.
+	Since time.Time
+	// Attempts is the number of failed sessions since the stream was last
+	// connected.
+	Attempts int
+	// LastError is the error that ended the last session.
+	LastError string
+	// Cursor is the position the next session resumes from.
+	Cursor string
+}
+
+// Status converts the health to its RemoteSyncStatus representation.
+func (h StreamHealth) Status() *sreportalv1alpha1.RemoteStreamStatus {
+	return &sreportalv1alpha1.RemoteStreamStatus{
+		State:              h.State,
+		LastTransitionTime: metav1.NewTime(h.Since),
+		ReconnectAttempts:  int32(h.Attempts),
+		LastError:          h.LastError,
+	}
+}
+
+// StreamSupervisor keeps a stream to a remote portal open: it reopens every
+// session that ends with exponential backoff and jitter, resumes it from the
+// last processed cursor and reports each health transition.
+type StreamSupervisor struct {
+	minBackoff   time.Duration
+	maxBackoff   time.Duration
+	jitter       float64
+	onTransition func(StreamHealth)
+
+	mu     sync.Mutex
+	health StreamHealth
+}
+
+// SupervisorOption is a function that configures the StreamSupervisor.
+type SupervisorOption func(*StreamSupervisor)
+
+// WithStreamBackoff sets the delay before the first reconnection, doubled
+// on each failed session up to maxDelay.
+func WithStreamBackoff(minDelay, maxDelay time.Duration) SupervisorOption {
+	return func(s *StreamSupervisor) {
+		s.minBackoff = minDelay
+		s.maxBackoff = maxDelay
+	}
+}
+
+// WithStreamJitter sets the fraction (0 to 1) of the backoff randomized on
+// each reconnection.
+func WithStreamJitter(jitter float64) SupervisorOption {
+	return func(s *StreamSupervisor) {
+		s.jitter = jitter
+	}
+}
+
+// WithOnTransition sets a function called on every state change of the
+// stream, e.g. to record it in the Portal RemoteSync status. It is called
+// synchronously from Run and must not block.
+func WithOnTransition(fn func(StreamHealth)) SupervisorOption {
+	return func(s *StreamSupervisor) {
+		s.onTransition = fn
+	}
+}
+
+// NewStreamSupervisor creates a new stream supervisor with the given options.
+func NewStreamSupervisor(opts ...SupervisorOption) *StreamSupervisor {
+	s := &StreamSupervisor{
+		minBackoff: DefaultStreamMinBackoff,
+		maxBackoff: DefaultStreamMaxBackoff,
+		jitter:     DefaultStreamJitter,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Health returns the current health of the stream.
+func (s *StreamSupervisor) Health() StreamHealth {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.health
+}
+
+// Run runs fn until ctx is done, reopening each session that ends. A
+// session that ends without error is reopened too: the remote closes
+// streams when it shuts down. Run returns nil when ctx is done, and the
+// session error when reconnecting cannot help (authentication, permission,
+// invalid request or a remote without the streaming RPC).
+func (s *StreamSupervisor) Run(ctx context.Context, fn StreamFunc) error {
+	s.transition(sreportalv1alpha1.RemoteStreamConnecting, nil)
+	backoff := s.minBackoff
+	for {
+		connected := false
+		err := fn(ctx, s.Health().Cursor, func(cursor string) {
+			s.mu.Lock()
+			s.health.Cursor = cursor
+			s.mu.Unlock()
+			if !connected {
+				connected = true
+				s.transition(sreportalv1alpha1.RemoteStreamConnected, nil)
+			}
+		})
+
+		if ctx.Err() != nil {
+			s.transition(sreportalv1alpha1.RemoteStreamStopped, nil)
+			return nil
+		}
+		if !sreportalclient.Retryable(err) {
+			s.transition(sreportalv1alpha1.RemoteStreamStopped, err)
+			return err
+		}
+
+		if connected {
+			backoff = s.minBackoff
+		}
+		s.transition(sreportalv1alpha1.RemoteStreamReconnecting, err)
+
+		select {
+		case <-ctx.Done():
+			s.transition(sreportalv1alpha1.RemoteStreamStopped, nil)
+			return nil
+		case <-time.After(s.withJitter(backoff)):
+		}
+		backoff = min(backoff*2, s.maxBackoff)
+	}
+}
+
+// transition moves the stream to state, recording err as the last error,
+// and notifies the transition callback.
+func (s *StreamSupervisor) transition(state sreportalv1alpha1.RemoteStreamState, err error) {
+	s.mu.Lock()
+	switch state {
+	case sreportalv1alpha1.RemoteStreamConnected:
+		s.health.Attempts = 0
+		s.health.LastError = ""
+	case sreportalv1alpha1.RemoteStreamReconnecting:
+		s.health.Attempts++
+	}
+	if err != nil {
+		s.health.LastError = err.Error()
+	}
+	changed := s.health.State != state
+	if changed {
+		s.health.State = state
+		s.health.Since = time.Now()
+	}
+	health := s.health
+	s.mu.Unlock()
+
+	// Successive failed attempts stay in Reconnecting but still update the
+	// attempt count and the last error.
+	if s.onTransition != nil && (changed || err != nil) {
+		s.onTransition(health)
+	}
+}
+
+// withJitter spreads d by ±jitter.
+func (s *StreamSupervisor) withJitter(d time.Duration) time.Duration {
+	if s.jitter <= 0 {
+		return d
+	}
+	return time.Duration(float64(d) * (1 + s.jitter*(2*rand.Float64()-1)))
+}
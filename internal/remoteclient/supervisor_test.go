@@ -0,0 +1,109 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remoteclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+)
+
+func TestStreamSupervisor_ResumesAndRecordsTransitions(t *testing.T) {
+	var transitions []StreamHealth
+	s := NewStreamSupervisor(
+		WithStreamBackoff(time.Millisecond, 5*time.Millisecond),
+		WithOnTransition(func(h StreamHealth) { transitions = append(transitions, h) }),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var cursors []string
+	session := 0
+	err := s.Run(ctx, func(_ context.Context, cursor string, progress func(string)) error {
+		cursors = append(cursors, cursor)
+		session++
+		switch session {
+		case 1:
+			progress("1")
+			progress("2")
+			return connect.NewError(connect.CodeUnavailable, errors.New("remote restarting"))
+		case 2:
+			return errors.New("connection refused")
+		default:
+			progress("3")
+			cancel()
+			return ctx.Err()
+		}
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"", "2", "2"}, cursors)
+
+	var states []sreportalv1alpha1.RemoteStreamState
+	for _, h := range transitions {
+		states = append(states, h.State)
+	}
+	assert.Equal(t, []sreportalv1alpha1.RemoteStreamState{
+		sreportalv1alpha1.RemoteStreamConnecting,
+		sreportalv1alpha1.RemoteStreamConnected,
+		sreportalv1alpha1.RemoteStreamReconnecting,
+		sreportalv1alpha1.RemoteStreamReconnecting,
+		sreportalv1alpha1.RemoteStreamConnected,
+		sreportalv1alpha1.RemoteStreamStopped,
+	}, states)
+	assert.Equal(t, 2, transitions[3].Attempts)
+	assert.Equal(t, "connection refused", transitions[3].LastError)
+	assert.Equal(t, 0, transitions[4].Attempts)
+
+	health := s.Health()
+	assert.Equal(t, sreportalv1alpha1.RemoteStreamStopped, health.State)
+	assert.Equal(t, "3", health.Cursor)
+	assert.Equal(t, sreportalv1alpha1.RemoteStreamStopped, health.Status().State)
+}
+
+func TestStreamSupervisor_StopsOnNonRetryableError(t *testing.T) {
+	s := NewStreamSupervisor(WithStreamBackoff(time.Millisecond, time.Millisecond))
+	calls := 0
+
+	err := s.Run(context.Background(), func(context.Context, string, func(string)) error {
+		calls++
+		return connect.NewError(connect.CodePermissionDenied, errors.New("forbidden"))
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, 1, calls)
+	health := s.Health()
+	assert.Equal(t, sreportalv1alpha1.RemoteStreamStopped, health.State)
+	assert.Contains(t, health.LastError, "forbidden")
+}
+
+func TestStreamSupervisor_BackoffJitter(t *testing.T) {
+	s := NewStreamSupervisor(WithStreamJitter(0.5))
+	for range 100 {
+		d := s.withJitter(time.Second)
+		assert.GreaterOrEqual(t, d, 500*time.Millisecond)
+		assert.LessOrEqual(t, d, 1500*time.Millisecond)
+	}
+	assert.Equal(t, time.Second, NewStreamSupervisor(WithStreamJitter(0)).withJitter(time.Second))
+}
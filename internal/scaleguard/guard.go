@@ -0,0 +1,191 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package scaleguard tracks the number of DNS CRs, DNSRecords, FQDNs and
+// FQDN streams served by the operator against soft and hard limits, so that
+// an oversized installation degrades explicitly (discovery paused, new
+// streams rejected) instead of running out of memory or timing out.
+package scaleguard
+
+import (
+	"sync"
+
+	"github.com/golgoth31/sreportal/internal/metrics"
+)
+
+// Resource is a guarded count.
+type Resource string
+
+const (
+	ResourceDNS        Resource = "dns"
+	ResourceDNSRecords Resource = "dnsrecords"
+	ResourceFQDNs      Resource = "fqdns"
+	ResourceStreams    Resource = "streams"
+)
+
+// Resources lists the guarded resources.
+var Resources = []Resource{ResourceDNS, ResourceDNSRecords, ResourceFQDNs, ResourceStreams}
+
+// discoveryResources are the resources whose hard limit pauses discovery.
+var discoveryResources = []Resource{ResourceDNS, ResourceDNSRecords, ResourceFQDNs}
+
+// Level is the position of a count relative to its limits.
+type Level string
+
+const (
+	LevelOK   Level = "ok"
+	LevelSoft Level = "soft"
+	LevelHard Level = "hard"
+)
+
+// Limit is a pair of thresholds on a count. Zero disables a threshold.
+type Limit struct {
+	Soft int
+	Hard int
+}
+
+// Level returns the level of count: hard once it reaches the hard limit, soft
+// once it reaches the soft limit.
+func (l Limit) Level(count int) Level {
+	switch {
+	case l.Hard > 0 && count >= l.Hard:
+		return LevelHard
+	case l.Soft > 0 && count >= l.Soft:
+		return LevelSoft
+	default:
+		return LevelOK
+	}
+}
+
+// Usage is the count of a resource and its limits.
+type Usage struct {
+	Resource Resource
+	Count    int
+	Limit    Limit
+}
+
+// Level returns the level of the usage.
+func (u Usage) Level() Level {
+	return u.Limit.Level(u.Count)
+}
+
+// Guard holds the last observed counts. A nil Guard enforces nothing. It is
+// safe for concurrent use.
+type Guard struct {
+	limits map[Resource]Limit
+
+	mu     sync.Mutex
+	counts map[Resource]int
+}
+
+// New creates a Guard enforcing limits. Resources without a limit are only
+// counted.
+func New(limits map[Resource]Limit) *Guard {
+	g := &Guard{limits: limits, counts: make(map[Resource]int, len(Resources))}
+	for _, r := range Resources {
+		l := limits[r]
+		metrics.ScaleGuardLimit.WithLabelValues(string(r), string(LevelSoft)).Set(float64(l.Soft))
+		metrics.ScaleGuardLimit.WithLabelValues(string(r), string(LevelHard)).Set(float64(l.Hard))
+	}
+	return g
+}
+
+// Observe records the current count of r and returns its level.
+func (g *Guard) Observe(r Resource, count int) Level {
+	if g == nil {
+		return LevelOK
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.observeLocked(r, count)
+}
+
+// observeLocked records count and publishes the metrics of r.
+func (g *Guard) observeLocked(r Resource, count int) Level {
+	g.counts[r] = count
+	level := g.limits[r].Level(count)
+	metrics.ScaleGuardCount.WithLabelValues(string(r)).Set(float64(count))
+	metrics.ScaleGuardLimitReached.WithLabelValues(string(r), string(LevelSoft)).Set(boolGauge(level != LevelOK))
+	metrics.ScaleGuardLimitReached.WithLabelValues(string(r), string(LevelHard)).Set(boolGauge(level == LevelHard))
+	metrics.ScaleGuardDiscoveryPaused.Set(boolGauge(g.discoveryPausedLocked()))
+	return level
+}
+
+// Usage returns the last observed count of every resource.
+func (g *Guard) Usage() []Usage {
+	if g == nil {
+		return nil
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	out := make([]Usage, 0, len(Resources))
+	for _, r := range Resources {
+		out = append(out, Usage{Resource: r, Count: g.counts[r], Limit: g.limits[r]})
+	}
+	return out
+}
+
+// DiscoveryPaused reports whether the DNS CRs, DNSRecords or FQDNs reached
+// their hard limit, in which case source discovery must not run.
+func (g *Guard) DiscoveryPaused() bool {
+	if g == nil {
+		return false
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.discoveryPausedLocked()
+}
+
+func (g *Guard) discoveryPausedLocked() bool {
+	for _, r := range discoveryResources {
+		if g.limits[r].Level(g.counts[r]) == LevelHard {
+			return true
+		}
+	}
+	return false
+}
+
+// AcquireStream admits a new stream unless the open streams reached their
+// hard limit. The caller must call release when the stream ends.
+func (g *Guard) AcquireStream() (release func(), ok bool) {
+	if g == nil {
+		return func() {}, true
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	open := g.counts[ResourceStreams]
+	if hard := g.limits[ResourceStreams].Hard; hard > 0 && open >= hard {
+		metrics.ScaleGuardStreamsRejectedTotal.Inc()
+		return nil, false
+	}
+	g.observeLocked(ResourceStreams, open+1)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			g.mu.Lock()
+			defer g.mu.Unlock()
+			g.observeLocked(ResourceStreams, g.counts[ResourceStreams]-1)
+		})
+	}, true
+}
+
+func boolGauge(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaleguard
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golgoth31/sreportal/internal/metrics"
+)
+
+func TestLimit_Level(t *testing.T) {
+	l := Limit{Soft: 80, Hard: 100}
+	assert.Equal(t, LevelOK, l.Level(79))
+	assert.Equal(t, LevelSoft, l.Level(80))
+	assert.Equal(t, LevelHard, l.Level(100))
+	assert.Equal(t, LevelOK, Limit{}.Level(1_000_000))
+	assert.Equal(t, LevelHard, Limit{Hard: 10}.Level(10))
+}
+
+func TestGuard_DiscoveryPaused(t *testing.T) {
+	g := New(map[Resource]Limit{ResourceFQDNs: {Soft: 8, Hard: 10}, ResourceStreams: {Hard: 1}})
+
+	assert.Equal(t, LevelSoft, g.Observe(ResourceFQDNs, 9))
+	assert.False(t, g.DiscoveryPaused())
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.ScaleGuardLimitReached.WithLabelValues("fqdns", "soft")))
+
+	// The streams hard limit never pauses discovery.
+	g.Observe(ResourceStreams, 5)
+	assert.False(t, g.DiscoveryPaused())
+
+	assert.Equal(t, LevelHard, g.Observe(ResourceFQDNs, 10))
+	assert.True(t, g.DiscoveryPaused())
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.ScaleGuardDiscoveryPaused))
+
+	g.Observe(ResourceFQDNs, 3)
+	assert.False(t, g.DiscoveryPaused())
+	assert.Zero(t, testutil.ToFloat64(metrics.ScaleGuardDiscoveryPaused))
+}
+
+func TestGuard_AcquireStream(t *testing.T) {
+	g := New(map[Resource]Limit{ResourceStreams: {Hard: 10}})
+	rejected := testutil.ToFloat64(metrics.ScaleGuardStreamsRejectedTotal)
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		releases []func()
+	)
+	for range 25 {
+		wg.Go(func() {
+			if release, ok := g.AcquireStream(); ok {
+				mu.Lock()
+				releases = append(releases, release)
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+
+	require.Len(t, releases, 10)
+	assert.Equal(t, rejected+15, testutil.ToFloat64(metrics.ScaleGuardStreamsRejectedTotal))
+
+	releases[0]()
+	releases[0]() // releasing twice frees a single slot
+	_, ok := g.AcquireStream()
+	assert.True(t, ok)
+	_, ok = g.AcquireStream()
+	assert.False(t, ok)
+}
+
+func TestGuard_Nil(t *testing.T) {
+	var g *Guard
+	release, ok := g.AcquireStream()
+	require.True(t, ok)
+	release()
+	assert.False(t, g.DiscoveryPaused())
+	assert.Equal(t, LevelOK, g.Observe(ResourceFQDNs, 1))
+}
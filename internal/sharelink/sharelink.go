@@ -0,0 +1,86 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sharelink builds and resolves stable links to an FQDN of a portal.
+//
+// A share link, /share/fqdn/<portal>/<fqdn>, names the portal by its
+// metadata.name. The web server redirects it to the current UI route of the
+// FQDN, so links pasted in incident channels survive UI releases and portal
+// subPath changes.
+package sharelink
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	domainportal "github.com/golgoth31/sreportal/internal/domain/portal"
+)
+
+// PathPrefix is the path prefix of FQDN share links.
+const PathPrefix = "/share/fqdn/"
+
+// Path returns the share link path of fqdn in portal.
+func Path(portal, fqdn string) string {
+	return PathPrefix + url.PathEscape(portal) + "/" + url.PathEscape(strings.ToLower(fqdn))
+}
+
+// URL returns the absolute share link of fqdn in portal under base, or the
+// path alone when base is empty.
+func URL(base, portal, fqdn string) string {
+	return strings.TrimSuffix(base, "/") + Path(portal, fqdn)
+}
+
+// Target returns the UI route showing fqdn in portal.
+func Target(portal domainportal.PortalView, fqdn string) string {
+	subPath := portal.SubPath
+	if subPath == "" {
+		subPath = portal.Name
+	}
+	return "/" + url.PathEscape(subPath) + "/links?fqdn=" + url.QueryEscape(fqdn)
+}
+
+// Handler redirects share links to the UI route of their FQDN. Links to an
+// unknown portal get 404 Not Found.
+func Handler(portals domainportal.PortalReader) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rest, ok := strings.CutPrefix(r.URL.EscapedPath(), PathPrefix)
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		rawPortal, rawFQDN, ok := strings.Cut(rest, "/")
+		portalName, perr := url.PathUnescape(rawPortal)
+		fqdn, ferr := url.PathUnescape(rawFQDN)
+		if !ok || perr != nil || ferr != nil || portalName == "" || fqdn == "" {
+			http.NotFound(w, r)
+			return
+		}
+
+		views, err := portals.List(r.Context(), domainportal.PortalFilters{})
+		if err != nil {
+			http.Error(w, "list portals", http.StatusInternalServerError)
+			return
+		}
+		for _, p := range views {
+			if p.Name == portalName {
+				http.Redirect(w, r, Target(p, strings.ToLower(fqdn)), http.StatusFound)
+				return
+			}
+		}
+		http.NotFound(w, r)
+	})
+}
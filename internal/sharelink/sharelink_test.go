@@ -0,0 +1,63 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sharelink_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainportal "github.com/golgoth31/sreportal/internal/domain/portal"
+	portalstore "github.com/golgoth31/sreportal/internal/readstore/portal"
+	"github.com/golgoth31/sreportal/internal/sharelink"
+)
+
+func TestURL(t *testing.T) {
+	assert.Equal(t, "/share/fqdn/main/api.example.com", sharelink.URL("", "main", "API.example.com"))
+	assert.Equal(t, "https://sreportal.example.com/share/fqdn/main/api.example.com",
+		sharelink.URL("https://sreportal.example.com/", "main", "api.example.com"))
+}
+
+func TestHandler_RedirectsToPortalSubPath(t *testing.T) {
+	store := portalstore.NewPortalStore()
+	require.NoError(t, store.Replace(context.Background(), "team-a",
+		domainportal.PortalView{Name: "team-a", SubPath: "payments"}))
+	handler := sharelink.Handler(store)
+
+	tests := []struct {
+		name     string
+		path     string
+		code     int
+		location string
+	}{
+		{"known portal", "/share/fqdn/team-a/API.example.com", http.StatusFound, "/payments/links?fqdn=api.example.com"},
+		{"unknown portal", "/share/fqdn/team-b/api.example.com", http.StatusNotFound, ""},
+		{"missing fqdn", "/share/fqdn/team-a", http.StatusNotFound, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			assert.Equal(t, tt.code, rec.Code)
+			assert.Equal(t, tt.location, rec.Header().Get("Location"))
+		})
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package demo generates synthetic endpoints for the demo source, so the
+// portal can be evaluated and load tested without a populated cluster.
+package demo
+
+import (
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/external-dns/endpoint"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+	"github.com/golgoth31/sreportal/internal/source/registry"
+)
+
+// SourceTypeDemo identifies the synthetic demo source.
+const SourceTypeDemo registry.SourceType = "demo"
+
+const (
+	defaultCount  = 50
+	defaultDomain = "demo.example.com"
+	// cnameEvery makes one generated FQDN in cnameEvery a CNAME.
+	cnameEvery = 5
+)
+
+var (
+	defaultGroups = []string{"Frontend", "Backend", "Data"}
+	// services provides the first label of the generated FQDNs.
+	services = []string{"api", "web", "grafana", "auth", "billing", "search", "cache", "queue", "reports", "admin"}
+)
+
+// Endpoints returns the endpoints generated for spec at the given generation.
+// Generated FQDNs are numbered: generation g publishes numbers
+// [g*churn, g*churn+count), where churn is churnPercent of count. Every new
+// generation thus retires the churn oldest FQDNs and adds as many new ones,
+// while the output for a given generation stays deterministic. owner
+// ("namespace/name" of the DNS) is recorded as the endpoints' origin.
+func Endpoints(spec *sreportalv1alpha2.DemoSourceSpec, owner string, generation int64) []*endpoint.Endpoint {
+	count := int64(spec.Count)
+	if count <= 0 {
+		count = defaultCount
+	}
+	domain := strings.TrimSuffix(spec.Domain, ".")
+	if domain == "" {
+		domain = defaultDomain
+	}
+	groups := spec.Groups
+	if len(groups) == 0 {
+		groups = defaultGroups
+	}
+	churn := count * int64(spec.ChurnPercent) / 100
+
+	first := max(generation, 0) * churn
+	eps := make([]*endpoint.Endpoint, 0, count)
+	for id := first; id < first+count; id++ {
+		eps = append(eps, generate(id, domain, groups, owner))
+	}
+	return eps
+}
+
+// generate returns the endpoint numbered id.
+func generate(id int64, domain string, groups []string, owner string) *endpoint.Endpoint {
+	name := fmt.Sprintf("%s-%d.%s", services[id%int64(len(services))], id, domain)
+
+	var ep *endpoint.Endpoint
+	if id%cnameEvery == cnameEvery-1 {
+		ep = endpoint.NewEndpoint(name, endpoint.RecordTypeCNAME, fmt.Sprintf("lb-%d.%s", id%3, domain))
+	} else {
+		ep = endpoint.NewEndpoint(name, endpoint.RecordTypeA,
+			fmt.Sprintf("10.%d.%d.%d", (id>>16)&0xff, (id>>8)&0xff, id&0xff))
+	}
+	ep.Labels[endpoint.ResourceLabelKey] = fmt.Sprintf("%s/%s", SourceTypeDemo, owner)
+	ep.Labels[domaindns.GroupsAnnotationKey] = groups[id%int64(len(groups))]
+	return ep
+}
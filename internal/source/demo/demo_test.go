@@ -0,0 +1,70 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package demo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/external-dns/endpoint"
+
+	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	domaindns "github.com/golgoth31/sreportal/internal/domain/dns"
+)
+
+func names(eps []*endpoint.Endpoint) []string {
+	out := make([]string, 0, len(eps))
+	for _, ep := range eps {
+		out = append(out, ep.DNSName)
+	}
+	return out
+}
+
+func TestEndpoints_Defaults(t *testing.T) {
+	eps := Endpoints(&sreportalv1alpha2.DemoSourceSpec{Enabled: true}, "ns/d", 7)
+
+	require.Len(t, eps, defaultCount)
+	for _, ep := range eps {
+		require.True(t, domaindns.ValidFQDN(ep.DNSName), ep.DNSName)
+		require.True(t, domaindns.ValidRecordType(ep.RecordType), ep.RecordType)
+		require.Contains(t, defaultGroups, ep.Labels[domaindns.GroupsAnnotationKey])
+		require.Equal(t, "demo/ns/d", ep.Labels[endpoint.ResourceLabelKey])
+	}
+	// Without churn every generation publishes the same FQDNs.
+	require.Equal(t, names(eps), names(Endpoints(&sreportalv1alpha2.DemoSourceSpec{Enabled: true}, "ns/d", 8)))
+}
+
+func TestEndpoints_Churn(t *testing.T) {
+	spec := &sreportalv1alpha2.DemoSourceSpec{Enabled: true, Count: 10, Domain: "lab.example.org", Groups: []string{"Lab"}, ChurnPercent: 20}
+
+	gen1 := names(Endpoints(spec, "ns/d", 1))
+	gen2 := names(Endpoints(spec, "ns/d", 2))
+
+	require.Equal(t, "grafana-2.lab.example.org", gen1[0])
+	require.Equal(t, gen1[2:], gen2[:8], "the 2 oldest FQDNs are retired, the others kept")
+	require.Equal(t, []string{"grafana-12.lab.example.org", "auth-13.lab.example.org"}, gen2[8:])
+	require.Equal(t, gen1, names(Endpoints(spec, "ns/d", 1)), "a generation is deterministic")
+}
+
+func TestEndpoints_RecordTypes(t *testing.T) {
+	eps := Endpoints(&sreportalv1alpha2.DemoSourceSpec{Enabled: true, Count: 5}, "ns/d", 0)
+
+	require.Equal(t, endpoint.RecordTypeA, eps[0].RecordType)
+	require.Equal(t, endpoint.Targets{"10.0.0.0"}, eps[0].Targets)
+	require.Equal(t, endpoint.RecordTypeCNAME, eps[4].RecordType)
+	require.Equal(t, endpoint.Targets{"lb-1.demo.example.com"}, eps[4].Targets)
+}
@@ -17,8 +17,14 @@ limitations under the License.
 package source
 
 import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
 	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/config"
 	"github.com/golgoth31/sreportal/internal/source/crossplanescalewayrecord"
+	"github.com/golgoth31/sreportal/internal/source/demo"
 	"github.com/golgoth31/sreportal/internal/source/externaldns"
 	"github.com/golgoth31/sreportal/internal/source/registry"
 )
@@ -60,8 +66,34 @@ func EnabledKindsFromSpec(s *sreportalv1alpha2.SourcesSpec) map[registry.SourceT
 	if s.GatewayUDPRoute != nil && s.GatewayUDPRoute.Enabled {
 		out[externaldns.KindGatewayUDPRoute] = true
 	}
+	if s.ContourHTTPProxy != nil && s.ContourHTTPProxy.Enabled {
+		out[externaldns.KindContourHTTPProxy] = true
+	}
+	if s.AmbassadorHost != nil && s.AmbassadorHost.Enabled {
+		out[externaldns.KindAmbassadorHost] = true
+	}
 	if s.CrossplaneScalewayRecord != nil && s.CrossplaneScalewayRecord.Enabled {
 		out[crossplanescalewayrecord.SourceTypeCrossplaneScalewayRecord] = true
 	}
+	if s.Demo != nil && s.Demo.Enabled {
+		out[demo.SourceTypeDemo] = true
+	}
 	return out
 }
+
+// Capabilities returns a config.SourceCapabilitiesFunc reporting the sources
+// enabled by the DNS resources listed through c, so capabilities follow the
+// DNS resources as they are created and edited.
+func Capabilities(c client.Reader) config.SourceCapabilitiesFunc {
+	return func(ctx context.Context) ([]config.SourceCapability, error) {
+		var list sreportalv1alpha2.DNSList
+		if err := c.List(ctx, &list); err != nil {
+			return nil, err
+		}
+		specs := make([]*sreportalv1alpha2.SourcesSpec, 0, len(list.Items))
+		for i := range list.Items {
+			specs = append(specs, &list.Items[i].Spec.Sources)
+		}
+		return config.SourceCapabilities(specs), nil
+	}
+}
@@ -28,10 +28,12 @@ import (
 	"strings"
 
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 	externaldnssource "sigs.k8s.io/external-dns/source"
 	"sigs.k8s.io/external-dns/source/template"
 
 	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
 	"github.com/golgoth31/sreportal/internal/source/registry"
 )
 
@@ -48,6 +50,8 @@ const (
 	KindGatewayTCPRoute     registry.SourceType = "gateway-tcproute"
 	KindGatewayTLSRoute     registry.SourceType = "gateway-tlsroute"
 	KindGatewayUDPRoute     registry.SourceType = "gateway-udproute"
+	KindContourHTTPProxy    registry.SourceType = "contour-httpproxy"
+	KindAmbassadorHost      registry.SourceType = "ambassador-host"
 	KindDNSEndpoint         registry.SourceType = "dnsendpoint"
 )
 
@@ -59,6 +63,7 @@ func Handles(kind registry.SourceType) bool {
 		KindIstioVirtualService,
 		KindGatewayHTTPRoute, KindGatewayGRPCRoute, KindGatewayTCPRoute,
 		KindGatewayTLSRoute, KindGatewayUDPRoute,
+		KindContourHTTPProxy, KindAmbassadorHost,
 		KindDNSEndpoint:
 		return true
 	}
@@ -136,6 +141,27 @@ func (c *EffectiveConfig) namespace() string {
 	return ""
 }
 
+// Selects reports whether an object with the given namespace, labels and
+// annotations passes the namespace, label and annotation filters external-dns
+// applies for this config. An unparsable filter selects nothing.
+func (c *EffectiveConfig) Selects(namespace string, objLabels, objAnnotations map[string]string) bool {
+	if ns := c.namespace(); ns != "" && ns != namespace {
+		return false
+	}
+	return matchesFilter(single(c.labelFilters), objLabels) &&
+		matchesFilter(single(c.annotationFilters), objAnnotations)
+}
+
+// matchesFilter reports whether set matches the label selector filter. An
+// empty filter matches everything; an unparsable one matches nothing.
+func matchesFilter(filter string, set map[string]string) bool {
+	if filter == "" {
+		return true
+	}
+	sel, err := labels.Parse(filter)
+	return err == nil && sel.Matches(labels.Set(set))
+}
+
 // single returns the value when exactly one distinct non-empty value was seen,
 // else "" (most permissive: no filter).
 func single(m map[string]struct{}) string {
@@ -199,12 +225,22 @@ func (c *EffectiveConfig) toConfig(kind registry.SourceType) (*externaldnssource
 		cfg.IgnoreHostnameAnnotation = c.ignoreHostnameSet && c.ignoreHostnameAll
 		cfg.IgnoreIngressTLSSpec = c.ignoreIngressTLSSet && c.ignoreIngressTLSAll
 		cfg.IgnoreIngressRulesSpec = c.ignoreIngressRSet && c.ignoreIngressRAll
-	case KindIstioGateway, KindIstioVirtualService:
+	case KindIstioGateway, KindIstioVirtualService, KindContourHTTPProxy:
 		cfg.IgnoreHostnameAnnotation = c.ignoreHostnameSet && c.ignoreHostnameAll
+	case KindAmbassadorHost:
+		// external-dns' NewAmbassadorHostSource (v0.21) consumes only
+		// Namespace, AnnotationFilter and LabelFilter from cfg.
 	case KindDNSEndpoint:
 		// external-dns' NewCRDSource (v0.21) hardwires the DNSEndpoint type
 		// (externaldns.k8s.io/v1alpha1) via its scheme and consumes only
-		// Namespace + LabelFilter from cfg — nothing kind-specific to set here.
+		// Namespace + LabelFilter from cfg. The DNSEndpoints sreportal
+		// publishes from manual DNSRecords are left out: their FQDNs are
+		// already listed by the record they come from.
+		published, err := labels.NewRequirement(adapter.DNSRecordLabelKey, selection.DoesNotExist, nil)
+		if err != nil {
+			return nil, fmt.Errorf("build published DNSEndpoint filter: %w", err)
+		}
+		cfg.LabelFilter = cfg.LabelFilter.Add(*published)
 	}
 	return cfg, nil
 }
@@ -282,6 +318,19 @@ func BuildEffectiveConfigs(dnsList []sreportalv1alpha2.DNS) map[registry.SourceT
 		if s.GatewayUDPRoute != nil && s.GatewayUDPRoute.Enabled {
 			get(KindGatewayUDPRoute).addCommon(s.GatewayUDPRoute.CommonSourceSpec)
 		}
+		if s.ContourHTTPProxy != nil && s.ContourHTTPProxy.Enabled {
+			get(KindContourHTTPProxy).addCommon(s.ContourHTTPProxy.CommonSourceSpec)
+		}
+		if s.AmbassadorHost != nil && s.AmbassadorHost.Enabled {
+			// AmbassadorHostSourceSpec doesn't embed CommonSourceSpec either
+			// (Hosts have no fqdnTemplate support).
+			get(KindAmbassadorHost).addCommon(sreportalv1alpha2.CommonSourceSpec{
+				Enabled:          s.AmbassadorHost.Enabled,
+				Namespace:        s.AmbassadorHost.Namespace,
+				AnnotationFilter: s.AmbassadorHost.AnnotationFilter,
+				LabelFilter:      s.AmbassadorHost.LabelFilter,
+			})
+		}
 		if s.DNSEndpoint != nil && s.DNSEndpoint.Enabled {
 			// DNSEndpointSpec doesn't embed CommonSourceSpec — synthesise the
 			// subset it exposes (no fqdnTemplate / annotationFilter for CRDs).
@@ -64,6 +64,8 @@ type builtSource struct {
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch
 // +kubebuilder:rbac:groups=networking.istio.io,resources=gateways;virtualservices,verbs=get;list;watch
 // +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways;httproutes;grpcroutes;tcproutes;tlsroutes;udproutes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=projectcontour.io,resources=httpproxies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=getambassador.io,resources=hosts,verbs=get;list;watch
 // +kubebuilder:rbac:groups=externaldns.k8s.io,resources=dnsendpoints,verbs=get;list;watch
 // +kubebuilder:rbac:groups=externaldns.k8s.io,resources=dnsendpoints/status,verbs=get;update;patch
 
@@ -91,9 +93,9 @@ type Provider struct {
 }
 
 // NewProvider returns a Provider. istio may be nil if no istio source is
-// requested; restConfig may be nil if no gateway-api route or DNSEndpoint
-// (CRD) source is requested — those builds then fail (preserved + retried),
-// they don't panic.
+// requested; restConfig may be nil if no gateway-api route, Contour HTTPProxy,
+// Ambassador Host or DNSEndpoint (CRD) source is requested — those builds
+// then fail (preserved + retried), they don't panic.
 func NewProvider(kube kubernetes.Interface, istio istioclient.Interface, restConfig *rest.Config) *Provider {
 	return &Provider{
 		kube:       kube,
@@ -250,6 +252,18 @@ func (p *Provider) build(ctx context.Context, kind registry.SourceType, cfg *ext
 		return externaldnssource.NewGatewayTLSRouteSource(ctx, p.clientGen, cfg)
 	case KindGatewayUDPRoute:
 		return externaldnssource.NewGatewayUDPRouteSource(ctx, p.clientGen, cfg)
+	case KindContourHTTPProxy:
+		dyn, err := p.clientGen.DynamicKubernetesClient()
+		if err != nil {
+			return nil, err
+		}
+		return externaldnssource.NewContourHTTPProxySource(ctx, dyn, cfg)
+	case KindAmbassadorHost:
+		dyn, err := p.clientGen.DynamicKubernetesClient()
+		if err != nil {
+			return nil, err
+		}
+		return externaldnssource.NewAmbassadorHostSource(ctx, dyn, p.kube, cfg)
 	case KindDNSEndpoint:
 		if p.restConfig == nil {
 			return nil, fmt.Errorf("rest config not configured")
@@ -22,9 +22,11 @@ import (
 	"testing"
 	"time"
 
+	"k8s.io/apimachinery/pkg/labels"
 	kubefake "k8s.io/client-go/kubernetes/fake"
 
 	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/adapter"
 	"github.com/golgoth31/sreportal/internal/source/registry"
 )
 
@@ -52,7 +54,8 @@ func TestEndpoints_BoundedWaitReturnsNotReady(t *testing.T) {
 
 // TestToConfig_DNSEndpoint verifies the DNSEndpoint kind builds an effective
 // config (the CRD type is hardwired by external-dns' NewCRDSource, so toConfig
-// only needs to pass through namespace/labelFilter without error).
+// passes through namespace/labelFilter) that leaves out the DNSEndpoints
+// published from manual DNSRecords.
 func TestToConfig_DNSEndpoint(t *testing.T) {
 	cfgs := BuildEffectiveConfigs([]sreportalv1alpha2.DNS{{Spec: sreportalv1alpha2.DNSSpec{
 		Sources: sreportalv1alpha2.SourcesSpec{
@@ -69,7 +72,42 @@ func TestToConfig_DNSEndpoint(t *testing.T) {
 	if cfg.Namespace != "team-a" {
 		t.Fatalf("expected namespace passthrough, got %q", cfg.Namespace)
 	}
-	if cfg.LabelFilter.String() != "team=a" {
+	if cfg.LabelFilter.String() != "!sreportal.io/dnsrecord,team=a" {
+		t.Fatalf("expected labelFilter passthrough, got %q", cfg.LabelFilter.String())
+	}
+	published := labels.Set{"team": "a", adapter.DNSRecordLabelKey: "manual"}
+	if cfg.LabelFilter.Matches(published) {
+		t.Fatal("published DNSEndpoints must not be discovered")
+	}
+}
+
+// TestToConfig_AmbassadorHost verifies the Ambassador Host spec, which
+// doesn't embed CommonSourceSpec, carries its namespace and filters through.
+func TestToConfig_AmbassadorHost(t *testing.T) {
+	cfgs := BuildEffectiveConfigs([]sreportalv1alpha2.DNS{{Spec: sreportalv1alpha2.DNSSpec{
+		Sources: sreportalv1alpha2.SourcesSpec{
+			AmbassadorHost: &sreportalv1alpha2.AmbassadorHostSourceSpec{
+				Enabled:          true,
+				Namespace:        "emissary",
+				AnnotationFilter: "team=a",
+				LabelFilter:      "env=prod",
+			},
+		},
+	}}})
+	if cfgs[KindAmbassadorHost] == nil {
+		t.Fatal("AmbassadorHost must yield an effective config when enabled")
+	}
+	cfg, err := cfgs[KindAmbassadorHost].toConfig(KindAmbassadorHost)
+	if err != nil {
+		t.Fatalf("toConfig: %v", err)
+	}
+	if cfg.Namespace != "emissary" {
+		t.Fatalf("expected namespace passthrough, got %q", cfg.Namespace)
+	}
+	if cfg.AnnotationFilter != "team=a" {
+		t.Fatalf("expected annotationFilter passthrough, got %q", cfg.AnnotationFilter)
+	}
+	if cfg.LabelFilter.String() != "env=prod" {
 		t.Fatalf("expected labelFilter passthrough, got %q", cfg.LabelFilter.String())
 	}
 }
@@ -108,7 +146,8 @@ func TestHandles_AllNativeKinds(t *testing.T) {
 	for _, k := range []registry.SourceType{
 		KindService, KindIngress, KindIstioGateway, KindIstioVirtualService,
 		KindGatewayHTTPRoute, KindGatewayGRPCRoute, KindGatewayTCPRoute,
-		KindGatewayTLSRoute, KindGatewayUDPRoute, KindDNSEndpoint,
+		KindGatewayTLSRoute, KindGatewayUDPRoute, KindContourHTTPProxy,
+		KindAmbassadorHost, KindDNSEndpoint,
 	} {
 		if !Handles(k) {
 			t.Errorf("Handles(%q) = false, want true", k)
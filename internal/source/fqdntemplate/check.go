@@ -0,0 +1,244 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fqdntemplate checks external-dns fqdnTemplate values before they
+// reach a source: the template is compiled, then rendered against a synthetic
+// object of the source kind, so that a broken template is rejected with a
+// clear error instead of producing garbage hostnames at runtime. The labels
+// and annotations the template reads are set on the synthetic object, since
+// the objects it renders against at runtime carry their own.
+package fqdntemplate
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/template/parse"
+
+	contourv1 "github.com/projectcontour/contour/apis/projectcontour/v1"
+	istionetworkingv1 "istio.io/client-go/pkg/apis/networking/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/external-dns/source/template"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// ErrInvalidTemplate is returned when a template fails to compile, to render
+// or renders an invalid hostname.
+var ErrInvalidTemplate = errors.New("invalid fqdnTemplate")
+
+// Object is a Kubernetes object a template is rendered against.
+type Object interface {
+	runtime.Object
+	metav1.Object
+}
+
+// Check compiles tmpl and renders it against a synthetic object of kind (the
+// source key, e.g. "service" or "gatewayHTTPRoute"; an unknown kind renders
+// against a Service). An empty template is valid.
+func Check(kind, tmpl string) error {
+	if strings.TrimSpace(tmpl) == "" {
+		return nil
+	}
+	engine, err := template.NewEngine(tmpl, "", "", false)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidTemplate, err)
+	}
+	sample := Sample(kind)
+	labels, annotations := referencedKeys(tmpl)
+	for _, key := range labels {
+		sample.GetLabels()[key] = sampleValue
+	}
+	for _, key := range annotations {
+		sample.GetAnnotations()[key] = sampleValue
+	}
+	hosts, err := engine.ExecFQDN(sample)
+	if err != nil {
+		return fmt.Errorf("%w: rendering a sample %s: %w", ErrInvalidTemplate, sample.GetObjectKind().GroupVersionKind().Kind, err)
+	}
+	for _, host := range hosts {
+		if errs := validation.IsDNS1123Subdomain(strings.TrimPrefix(host, "*.")); len(errs) > 0 {
+			return fmt.Errorf("%w: renders %q on a sample %s: %s", ErrInvalidTemplate, host,
+				sample.GetObjectKind().GroupVersionKind().Kind, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+// sampleValue is the value of the labels and annotations a template reads on
+// the synthetic object.
+const sampleValue = "sample"
+
+// referencedKeys returns the label and annotation keys tmpl reads, as
+// ".Labels.key" fields or "index .Labels "key"" calls (and the same on
+// .Annotations or .ObjectMeta).
+func referencedKeys(tmpl string) (labels, annotations []string) {
+	t := parse.New("fqdnTemplate")
+	t.Mode = parse.SkipFuncCheck
+	trees := map[string]*parse.Tree{}
+	if _, err := t.Parse(tmpl, "", "", trees); err != nil {
+		return nil, nil
+	}
+	add := func(field []string, key string) {
+		switch field[len(field)-1] {
+		case "Labels":
+			labels = append(labels, key)
+		case "Annotations":
+			annotations = append(annotations, key)
+		}
+	}
+	var walk func(parse.Node)
+	walk = func(n parse.Node) {
+		switch n := n.(type) {
+		case *parse.ListNode:
+			if n == nil {
+				return
+			}
+			for _, c := range n.Nodes {
+				walk(c)
+			}
+		case *parse.ActionNode:
+			walk(n.Pipe)
+		case *parse.PipeNode:
+			if n == nil {
+				return
+			}
+			for _, c := range n.Cmds {
+				walk(c)
+			}
+		case *parse.CommandNode:
+			if len(n.Args) >= 3 {
+				fn, isIdent := n.Args[0].(*parse.IdentifierNode)
+				key, isString := n.Args[2].(*parse.StringNode)
+				if isIdent && fn.Ident == "index" && isString {
+					switch m := n.Args[1].(type) {
+					case *parse.FieldNode:
+						add(m.Ident, key.Text)
+					case *parse.VariableNode:
+						add(m.Ident, key.Text)
+					}
+				}
+			}
+			for _, c := range n.Args {
+				walk(c)
+			}
+		case *parse.FieldNode:
+			if len(n.Ident) >= 2 {
+				add(n.Ident[:len(n.Ident)-1], n.Ident[len(n.Ident)-1])
+			}
+		case *parse.VariableNode:
+			if len(n.Ident) >= 3 {
+				add(n.Ident[:len(n.Ident)-1], n.Ident[len(n.Ident)-1])
+			}
+		case *parse.IfNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.RangeNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.WithNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.TemplateNode:
+			walk(n.Pipe)
+		}
+	}
+	for _, tree := range trees {
+		walk(tree.Root)
+	}
+	return labels, annotations
+}
+
+// Sample returns the synthetic object templates of kind are rendered
+// against: named "sample" in the "sample-ns" namespace, with a label, an
+// annotation and a minimal spec.
+func Sample(kind string) Object {
+	meta := metav1.ObjectMeta{
+		Name:        "sample",
+		Namespace:   "sample-ns",
+		Labels:      map[string]string{"app.kubernetes.io/name": "sample"},
+		Annotations: map[string]string{"sreportal.io/sample": "true"},
+	}
+	hostname := gwapiv1.Hostname("sample.example.com")
+	switch kind {
+	case "ingress":
+		return &networkingv1.Ingress{
+			TypeMeta:   metav1.TypeMeta{Kind: "Ingress", APIVersion: "networking.k8s.io/v1"},
+			ObjectMeta: meta,
+			Spec:       networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{Host: string(hostname)}}},
+		}
+	case "istioGateway":
+		gw := &istionetworkingv1.Gateway{ObjectMeta: meta}
+		gw.Kind, gw.APIVersion = "Gateway", "networking.istio.io/v1"
+		return gw
+	case "istioVirtualService":
+		vs := &istionetworkingv1.VirtualService{ObjectMeta: meta}
+		vs.Kind, vs.APIVersion = "VirtualService", "networking.istio.io/v1"
+		vs.Spec.Hosts = []string{string(hostname)}
+		return vs
+	case "gatewayHTTPRoute":
+		return &gwapiv1.HTTPRoute{
+			TypeMeta:   metav1.TypeMeta{Kind: "HTTPRoute", APIVersion: "gateway.networking.k8s.io/v1"},
+			ObjectMeta: meta,
+			Spec:       gwapiv1.HTTPRouteSpec{Hostnames: []gwapiv1.Hostname{hostname}},
+		}
+	case "gatewayGRPCRoute":
+		return &gwapiv1.GRPCRoute{
+			TypeMeta:   metav1.TypeMeta{Kind: "GRPCRoute", APIVersion: "gateway.networking.k8s.io/v1"},
+			ObjectMeta: meta,
+			Spec:       gwapiv1.GRPCRouteSpec{Hostnames: []gwapiv1.Hostname{hostname}},
+		}
+	case "gatewayTLSRoute":
+		return &gwapiv1alpha2.TLSRoute{
+			TypeMeta:   metav1.TypeMeta{Kind: "TLSRoute", APIVersion: "gateway.networking.k8s.io/v1alpha2"},
+			ObjectMeta: meta,
+			Spec:       gwapiv1alpha2.TLSRouteSpec{Hostnames: []gwapiv1.Hostname{hostname}},
+		}
+	case "gatewayTCPRoute":
+		return &gwapiv1alpha2.TCPRoute{
+			TypeMeta:   metav1.TypeMeta{Kind: "TCPRoute", APIVersion: "gateway.networking.k8s.io/v1alpha2"},
+			ObjectMeta: meta,
+		}
+	case "gatewayUDPRoute":
+		return &gwapiv1alpha2.UDPRoute{
+			TypeMeta:   metav1.TypeMeta{Kind: "UDPRoute", APIVersion: "gateway.networking.k8s.io/v1alpha2"},
+			ObjectMeta: meta,
+		}
+	case "contourHTTPProxy":
+		return &contourv1.HTTPProxy{
+			TypeMeta:   metav1.TypeMeta{Kind: "HTTPProxy", APIVersion: "projectcontour.io/v1"},
+			ObjectMeta: meta,
+			Spec:       contourv1.HTTPProxySpec{VirtualHost: &contourv1.VirtualHost{Fqdn: string(hostname)}},
+		}
+	default:
+		return &corev1.Service{
+			TypeMeta:   metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
+			ObjectMeta: meta,
+			Spec: corev1.ServiceSpec{
+				Type:      corev1.ServiceTypeLoadBalancer,
+				ClusterIP: "10.0.0.1",
+				Ports:     []corev1.ServicePort{{Name: "http", Port: 80, Protocol: corev1.ProtocolTCP}},
+			},
+		}
+	}
+}
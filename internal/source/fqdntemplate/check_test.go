@@ -0,0 +1,80 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fqdntemplate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		kind    string
+		tmpl    string
+		wantErr string
+	}{
+		{name: "empty", kind: "service", tmpl: ""},
+		{name: "metadata", kind: "service", tmpl: "{{.Name}}.{{.Namespace}}.example.com"},
+		{name: "several hostnames", kind: "ingress", tmpl: "{{.Name}}.example.com, {{.Name}}.example.org."},
+		{name: "conditional empty output", kind: "service", tmpl: `{{if index .Annotations "missing"}}{{.Name}}.example.com{{end}}`},
+		{name: "wildcard", kind: "gatewayHTTPRoute", tmpl: "*.{{.Namespace}}.example.com"},
+		{name: "spec field of the kind", kind: "istioVirtualService", tmpl: "{{index .Spec.Hosts 0}}"},
+		{name: "parse error", kind: "service", tmpl: "{{.Name", wantErr: "invalid fqdnTemplate"},
+		{name: "unknown field", kind: "ingress", tmpl: "{{.Nme}}.example.com", wantErr: "rendering a sample Ingress"},
+		{name: "label field", kind: "service", tmpl: "{{.Labels.team}}.example.com"},
+		{name: "label index", kind: "ingress", tmpl: `{{.Name}}.{{index .Labels "app.kubernetes.io/part-of"}}.example.com`},
+		{name: "annotation index", kind: "gatewayHTTPRoute", tmpl: `{{index .Annotations "sreportal.io/hostname"}}`},
+		{name: "object meta annotation", kind: "service", tmpl: `{{with index .ObjectMeta.Annotations "dns/zone"}}{{$.Name}}.{{.}}{{end}}`},
+		{name: "root variable label", kind: "service", tmpl: `{{with .Spec}}{{$.Labels.team}}.example.com{{end}}`},
+		{name: "conditional label", kind: "contourHTTPProxy", tmpl: `{{if .Labels.public}}{{.Name}}.example.com{{end}}`},
+		{name: "label with invalid value", kind: "service", tmpl: "{{.Labels.team}}_x.example.com", wantErr: `renders "sample_x.example.com"`},
+		{name: "invalid characters", kind: "service", tmpl: "{{.Name}} {{.Namespace}}.example.com", wantErr: "on a sample Service"},
+		{name: "uppercase", kind: "gatewayTCPRoute", tmpl: "{{.Kind}}.example.com", wantErr: `renders "TCPRoute.example.com"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Check(tt.kind, tt.tmpl)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrInvalidTemplate)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestSample_Kinds(t *testing.T) {
+	for kind, want := range map[string]string{
+		"service":             "Service",
+		"ingress":             "Ingress",
+		"istioGateway":        "Gateway",
+		"istioVirtualService": "VirtualService",
+		"gatewayHTTPRoute":    "HTTPRoute",
+		"gatewayGRPCRoute":    "GRPCRoute",
+		"gatewayTLSRoute":     "TLSRoute",
+		"gatewayTCPRoute":     "TCPRoute",
+		"gatewayUDPRoute":     "UDPRoute",
+		"contourHTTPProxy":    "HTTPProxy",
+		"unknown":             "Service",
+	} {
+		assert.Equal(t, want, Sample(kind).GetObjectKind().GroupVersionKind().Kind, kind)
+	}
+}
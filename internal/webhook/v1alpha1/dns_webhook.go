@@ -28,6 +28,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/config"
 )
 
 // nolint:unused
@@ -35,9 +36,9 @@ import (
 var dnslog = log.Default().WithName("dns-resource")
 
 // SetupDNSWebhookWithManager registers the webhook for DNS in the manager.
-func SetupDNSWebhookWithManager(mgr ctrl.Manager) error {
+func SetupDNSWebhookWithManager(mgr ctrl.Manager, quota config.QuotaConfig) error {
 	return ctrl.NewWebhookManagedBy(mgr, &sreportalv1alpha1.DNS{}).
-		WithValidator(&DNSCustomValidator{client: mgr.GetClient()}).
+		WithValidator(&DNSCustomValidator{client: mgr.GetClient(), quota: quota}).
 		WithDefaulter(&DNSCustomDefaulter{}).
 		Complete()
 }
@@ -67,19 +68,27 @@ func (d *DNSCustomDefaulter) Default(_ context.Context, obj *sreportalv1alpha1.D
 // as this struct is used only for temporary operations and does not need to be deeply copied.
 type DNSCustomValidator struct {
 	client client.Client
+	// quota bounds the manual groups and entries of spec.groups.
+	quota config.QuotaConfig
 }
 
 // ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type DNS.
 func (v *DNSCustomValidator) ValidateCreate(ctx context.Context, obj *sreportalv1alpha1.DNS) (admission.Warnings, error) {
 	dnslog.Info("Validation for DNS upon creation", "name", obj.GetName())
 
+	if err := v.validateQuota(obj, nil); err != nil {
+		return nil, err
+	}
 	return v.validatePortalRef(ctx, obj)
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type DNS.
-func (v *DNSCustomValidator) ValidateUpdate(ctx context.Context, _, newObj *sreportalv1alpha1.DNS) (admission.Warnings, error) {
+func (v *DNSCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj *sreportalv1alpha1.DNS) (admission.Warnings, error) {
 	dnslog.Info("Validation for DNS upon update", "name", newObj.GetName())
 
+	if err := v.validateQuota(newObj, oldObj); err != nil {
+		return nil, err
+	}
 	return v.validatePortalRef(ctx, newObj)
 }
 
@@ -111,3 +120,30 @@ func (v *DNSCustomValidator) validatePortalRef(ctx context.Context, obj *sreport
 
 	return nil, nil
 }
+
+// validateQuota rejects spec.groups above the manual groups or entries quota.
+// On update only a count that grows is checked, so that a DNS above a lowered
+// quota can still be trimmed.
+func (v *DNSCustomValidator) validateQuota(obj, old *sreportalv1alpha1.DNS) error {
+	groups, entries := len(obj.Spec.Groups), countEntries(obj.Spec.Groups)
+	var oldGroups, oldEntries int
+	if old != nil {
+		oldGroups, oldEntries = len(old.Spec.Groups), countEntries(old.Spec.Groups)
+	}
+	if limit := v.quota.MaxManualGroups; limit > 0 && groups > limit && groups > oldGroups {
+		return fmt.Errorf("spec.groups: %d groups, above the quota of %d manual groups (quota.maxManualGroups)", groups, limit)
+	}
+	if limit := v.quota.MaxManualEntries; limit > 0 && entries > limit && entries > oldEntries {
+		return fmt.Errorf("spec.groups: %d entries, above the quota of %d manual entries (quota.maxManualEntries)", entries, limit)
+	}
+	return nil
+}
+
+// countEntries returns the number of entries of groups.
+func countEntries(groups []sreportalv1alpha1.DNSGroup) int {
+	n := 0
+	for _, g := range groups {
+		n += len(g.Entries)
+	}
+	return n
+}
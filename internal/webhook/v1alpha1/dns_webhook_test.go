@@ -17,10 +17,13 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/config"
 	// TODO (user): Add any additional imports if needed
 )
 
@@ -83,4 +86,41 @@ var _ = Describe("DNS Webhook", func() {
 		// })
 	})
 
+	Context("When a manual entries quota is set", func() {
+		BeforeEach(func() {
+			validator.quota = config.QuotaConfig{MaxManualGroups: 1, MaxManualEntries: 2}
+			oldObj.Spec.Groups = []sreportalv1alpha1.DNSGroup{{
+				Name:    "web",
+				Entries: []sreportalv1alpha1.DNSEntry{{FQDN: "a.example.com"}, {FQDN: "b.example.com"}, {FQDN: "c.example.com"}},
+			}}
+		})
+
+		It("Should deny groups above the quota", func() {
+			obj.Spec.Groups = []sreportalv1alpha1.DNSGroup{{Name: "web"}, {Name: "apis"}}
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("quota.maxManualGroups"))
+		})
+
+		It("Should deny entries above the quota", func() {
+			obj.Spec.Groups = oldObj.Spec.Groups
+
+			_, err := validator.ValidateCreate(context.Background(), obj)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("quota.maxManualEntries"))
+		})
+
+		It("Should allow trimming a DNS above the quota", func() {
+			obj.Spec.Groups = []sreportalv1alpha1.DNSGroup{{
+				Name:    "web",
+				Entries: oldObj.Spec.Groups[0].Entries[:2],
+			}}
+
+			Expect(validator.validateQuota(obj, oldObj)).To(Succeed())
+		})
+	})
+
 })
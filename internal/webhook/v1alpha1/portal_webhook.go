@@ -19,9 +19,20 @@ package v1alpha1
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"slices"
+	"strings"
 
+	"github.com/golgoth31/sreportal/internal/config"
 	"github.com/golgoth31/sreportal/internal/log"
+	"github.com/golgoth31/sreportal/internal/mainportal"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
@@ -32,10 +43,13 @@ import (
 var portallog = log.Default().WithName("portal-resource")
 
 // SetupPortalWebhookWithManager registers the webhook for Portal in the manager.
-func SetupPortalWebhookWithManager(mgr ctrl.Manager) error {
+// cfg holds the portal templates a Portal can reference with spec.templateRef.
+func SetupPortalWebhookWithManager(mgr ctrl.Manager, cfg config.PortalConfig, quota config.QuotaConfig) error {
 	return ctrl.NewWebhookManagedBy(mgr, &sreportalv1alpha1.Portal{}).
-		WithValidator(&PortalCustomValidator{}).
-		WithDefaulter(&PortalCustomDefaulter{}).
+		// The uncached reader sees a main portal demoted just before the
+		// promotion of another one.
+		WithValidator(&PortalCustomValidator{cfg: cfg, quota: quota, reader: mgr.GetAPIReader()}).
+		WithDefaulter(&PortalCustomDefaulter{cfg: cfg}).
 		Complete()
 }
 
@@ -46,12 +60,22 @@ func SetupPortalWebhookWithManager(mgr ctrl.Manager) error {
 //
 // NOTE: The +kubebuilder:object:generate=false marker prevents controller-gen from generating DeepCopy methods,
 // as it is used only for temporary operations and does not need to be deeply copied.
-type PortalCustomDefaulter struct{}
+type PortalCustomDefaulter struct {
+	cfg config.PortalConfig
+}
 
 // Default implements webhook.CustomDefaulter so a webhook will be registered for the Kind Portal.
-func (d *PortalCustomDefaulter) Default(_ context.Context, obj *sreportalv1alpha1.Portal) error {
+func (d *PortalCustomDefaulter) Default(ctx context.Context, obj *sreportalv1alpha1.Portal) error {
 	portallog.Info("Defaulting for Portal", "name", obj.GetName())
 
+	// Stamp the template on creation only: fields later cleared on purpose
+	// must not come back on every update.
+	if req, err := admission.RequestFromContext(ctx); err == nil && req.Operation == admissionv1.Create && obj.Spec.TemplateRef != "" {
+		if tmpl, ok := d.cfg.Template(obj.Spec.TemplateRef); ok {
+			applyPortalTemplate(obj, tmpl)
+		}
+	}
+
 	// Set subPath to name if not specified
 	if obj.Spec.SubPath == "" {
 		obj.Spec.SubPath = obj.Name
@@ -87,6 +111,52 @@ func (d *PortalCustomDefaulter) Default(_ context.Context, obj *sreportalv1alpha
 	return nil
 }
 
+// applyPortalTemplate copies the template values into the spec fields of
+// obj that are left unset.
+func applyPortalTemplate(obj *sreportalv1alpha1.Portal, tmpl config.PortalTemplateConfig) {
+	spec := &obj.Spec
+
+	if spec.Features == nil {
+		spec.Features = &sreportalv1alpha1.PortalFeatures{}
+	}
+	for _, f := range []struct{ dst, src **bool }{
+		{&spec.Features.DNS, &tmpl.Features.DNS},
+		{&spec.Features.Releases, &tmpl.Features.Releases},
+		{&spec.Features.NetworkPolicy, &tmpl.Features.NetworkPolicy},
+		{&spec.Features.Alerts, &tmpl.Features.Alerts},
+		{&spec.Features.StatusPage, &tmpl.Features.StatusPage},
+		{&spec.Features.ImageInventory, &tmpl.Features.ImageInventory},
+	} {
+		if *f.dst == nil && *f.src != nil {
+			v := **f.src
+			*f.dst = &v
+		}
+	}
+
+	if len(spec.SourcePriority) == 0 {
+		spec.SourcePriority = slices.Clone(tmpl.SourcePriority)
+	}
+
+	if len(spec.Links) == 0 && len(tmpl.Links) > 0 {
+		spec.Links = make([]sreportalv1alpha1.PortalLink, len(tmpl.Links))
+		for i, l := range tmpl.Links {
+			spec.Links[i] = sreportalv1alpha1.PortalLink{Title: l.Title, URL: l.URL}
+		}
+	}
+
+	if tmpl.Branding != (config.PortalBrandingConfig{}) {
+		if spec.Branding == nil {
+			spec.Branding = &sreportalv1alpha1.PortalBranding{}
+		}
+		if spec.Branding.LogoURL == "" {
+			spec.Branding.LogoURL = tmpl.Branding.LogoURL
+		}
+		if spec.Branding.Color == "" {
+			spec.Branding.Color = tmpl.Branding.Color
+		}
+	}
+}
+
 // +kubebuilder:webhook:path=/validate-sreportal-io-v1alpha1-portal,mutating=false,failurePolicy=fail,sideEffects=None,groups=sreportal.io,resources=portals,verbs=create;update,versions=v1alpha1,name=vportal-v1alpha1.kb.io,admissionReviewVersions=v1
 
 // PortalCustomValidator struct is responsible for validating the Portal resource
@@ -94,20 +164,67 @@ func (d *PortalCustomDefaulter) Default(_ context.Context, obj *sreportalv1alpha
 //
 // NOTE: The +kubebuilder:object:generate=false marker prevents controller-gen from generating DeepCopy methods,
 // as this struct is used only for temporary operations and does not need to be deeply copied.
-type PortalCustomValidator struct{}
+type PortalCustomValidator struct {
+	cfg config.PortalConfig
+	// quota bounds the portals of a namespace.
+	quota config.QuotaConfig
+	// reader lists the other portals to keep a single main portal, counts
+	// the portals of a namespace and reads the namespace labels of the
+	// insecureSkipVerify policy; nil skips these checks.
+	reader client.Reader
+}
 
 // ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type Portal.
-func (v *PortalCustomValidator) ValidateCreate(_ context.Context, obj *sreportalv1alpha1.Portal) (admission.Warnings, error) {
+func (v *PortalCustomValidator) ValidateCreate(ctx context.Context, obj *sreportalv1alpha1.Portal) (admission.Warnings, error) {
 	portallog.Info("Validation for Portal upon creation", "name", obj.GetName())
 
-	return v.validatePortal(obj)
+	if err := v.validateTemplateRef(obj); err != nil {
+		return nil, err
+	}
+	if err := v.validateNamespaceQuota(ctx, obj); err != nil {
+		return nil, err
+	}
+	if obj.Spec.Main {
+		if err := v.validateSingleMain(ctx, obj); err != nil {
+			return nil, err
+		}
+	}
+	if insecureSkipVerify(obj) {
+		if err := v.validateInsecureSkipVerify(ctx, obj); err != nil {
+			return nil, err
+		}
+	}
+	return v.validatePortal(obj, true)
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type Portal.
-func (v *PortalCustomValidator) ValidateUpdate(_ context.Context, _, newObj *sreportalv1alpha1.Portal) (admission.Warnings, error) {
+func (v *PortalCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj *sreportalv1alpha1.Portal) (admission.Warnings, error) {
 	portallog.Info("Validation for Portal upon update", "name", newObj.GetName())
 
-	return v.validatePortal(newObj)
+	// A template removed from the configuration must not block updates of
+	// the portals created from it: only a changed templateRef is checked.
+	if newObj.Spec.TemplateRef != oldObj.Spec.TemplateRef {
+		if err := v.validateTemplateRef(newObj); err != nil {
+			return nil, err
+		}
+	}
+	// Only a portal becoming main is checked, so that updates of clusters
+	// that already have several main portals are not blocked.
+	if newObj.Spec.Main && !oldObj.Spec.Main {
+		if err := v.validateSingleMain(ctx, newObj); err != nil {
+			return nil, err
+		}
+	}
+	// Likewise only a portal turning insecureSkipVerify on is checked, so
+	// that labelling a namespace does not block its existing portals.
+	if insecureSkipVerify(newObj) && !insecureSkipVerify(oldObj) {
+		if err := v.validateInsecureSkipVerify(ctx, newObj); err != nil {
+			return nil, err
+		}
+	}
+	// And spec.remote only when it changes, so that the portals created
+	// before a rule was added (e.g. credentials in the URL) stay updatable.
+	return v.validatePortal(newObj, !equality.Semantic.DeepEqual(oldObj.Spec.Remote, newObj.Spec.Remote))
 }
 
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type Portal.
@@ -117,12 +234,165 @@ func (v *PortalCustomValidator) ValidateDelete(_ context.Context, obj *sreportal
 	return nil, nil
 }
 
-// validatePortal validates the Portal spec.
-func (v *PortalCustomValidator) validatePortal(obj *sreportalv1alpha1.Portal) (admission.Warnings, error) {
+// validateTemplateRef rejects a templateRef naming no configured template.
+func (v *PortalCustomValidator) validateTemplateRef(obj *sreportalv1alpha1.Portal) error {
+	if obj.Spec.TemplateRef == "" {
+		return nil
+	}
+	if _, ok := v.cfg.Template(obj.Spec.TemplateRef); !ok {
+		return fmt.Errorf("spec.templateRef: portal template %q is not defined in the operator configuration", obj.Spec.TemplateRef)
+	}
+	return nil
+}
+
+// validateNamespaceQuota rejects obj when its namespace already holds
+// quota.maxPortalsPerNamespace portals.
+func (v *PortalCustomValidator) validateNamespaceQuota(ctx context.Context, obj *sreportalv1alpha1.Portal) error {
+	limit := v.quota.MaxPortalsPerNamespace
+	if v.reader == nil || limit == 0 {
+		return nil
+	}
+	var list sreportalv1alpha1.PortalList
+	if err := v.reader.List(ctx, &list, client.InNamespace(obj.Namespace)); err != nil {
+		return fmt.Errorf("list portals to check the namespace quota: %w", err)
+	}
+	if len(list.Items) >= limit {
+		return fmt.Errorf("namespace %q already has %d portals, the quota is %d portals per namespace (quota.maxPortalsPerNamespace)",
+			obj.Namespace, len(list.Items), limit)
+	}
+	return nil
+}
+
+// validateSingleMain rejects obj as main portal while another portal is main:
+// the main portal is changed by promotion, which demotes the current one first.
+func (v *PortalCustomValidator) validateSingleMain(ctx context.Context, obj *sreportalv1alpha1.Portal) error {
+	if v.reader == nil {
+		return nil
+	}
+	var list sreportalv1alpha1.PortalList
+	if err := v.reader.List(ctx, &list); err != nil {
+		return fmt.Errorf("list portals to check spec.main: %w", err)
+	}
+	for i := range list.Items {
+		p := &list.Items[i]
+		if p.Spec.Main && (p.Namespace != obj.Namespace || p.Name != obj.Name) {
+			return fmt.Errorf("spec.main: portal %s/%s is already the main portal; "+
+				"promote this portal with the PromoteToMain RPC or the %s annotation instead",
+				p.Namespace, p.Name, mainportal.PromoteAnnotationKey)
+		}
+	}
+	return nil
+}
+
+// insecureSkipVerify reports whether obj disables TLS verification of its
+// remote portal.
+func insecureSkipVerify(obj *sreportalv1alpha1.Portal) bool {
+	r := obj.Spec.Remote
+	return r != nil && r.TLS != nil && r.TLS.InsecureSkipVerify
+}
+
+// validateInsecureSkipVerify rejects obj when its namespace matches the
+// portal.insecureSkipVerifyDenySelector of the operator configuration.
+func (v *PortalCustomValidator) validateInsecureSkipVerify(ctx context.Context, obj *sreportalv1alpha1.Portal) error {
+	if v.reader == nil || v.cfg.InsecureSkipVerifyDenySelector == "" {
+		return nil
+	}
+	selector, err := labels.Parse(v.cfg.InsecureSkipVerifyDenySelector)
+	if err != nil {
+		return fmt.Errorf("parse portal.insecureSkipVerifyDenySelector: %w", err)
+	}
+	var ns corev1.Namespace
+	if err := v.reader.Get(ctx, client.ObjectKey{Name: obj.Namespace}, &ns); err != nil {
+		return fmt.Errorf("get namespace %q to check spec.remote.tls.insecureSkipVerify: %w", obj.Namespace, err)
+	}
+	if selector.Matches(labels.Set(ns.Labels)) {
+		return fmt.Errorf("spec.remote.tls.insecureSkipVerify is not allowed in namespace %q (matches %q): "+
+			"trust the remote certificate with spec.remote.tls.caSecretRef instead",
+			obj.Namespace, v.cfg.InsecureSkipVerifyDenySelector)
+	}
+	return nil
+}
+
+// validateRemote validates the remote portal URL and name.
+func validateRemote(remote *sreportalv1alpha1.RemotePortalSpec) error {
+	if remote.URL != "" {
+		u, err := url.Parse(remote.URL)
+		if err != nil {
+			return fmt.Errorf("spec.remote.url: %w", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("spec.remote.url: scheme %q is not supported, use http or https", u.Scheme)
+		}
+		if u.Host == "" {
+			return fmt.Errorf("spec.remote.url: %q has no host", remote.URL)
+		}
+		if u.User != nil {
+			return fmt.Errorf("spec.remote.url: credentials must not be embedded in the URL, use spec.remote.auth")
+		}
+	}
+	if remote.Portal != "" {
+		if errs := validation.IsDNS1123Subdomain(remote.Portal); len(errs) > 0 {
+			return fmt.Errorf("spec.remote.portal: %q is not a valid portal name: %s", remote.Portal, errs[0])
+		}
+	}
+	return nil
+}
+
+// validateLinks validates the URLs of the portal links and logo, whether set
+// on the Portal or stamped from its template: they are rendered as links in
+// the portal menu.
+func validateLinks(spec sreportalv1alpha1.PortalSpec) error {
+	for i, l := range spec.Links {
+		if err := validateLinkURL(l.URL); err != nil {
+			return fmt.Errorf("spec.links[%d].url: %w", i, err)
+		}
+	}
+	if spec.Branding != nil && spec.Branding.LogoURL != "" && !strings.HasPrefix(spec.Branding.LogoURL, "/") {
+		if err := validateLinkURL(spec.Branding.LogoURL); err != nil {
+			return fmt.Errorf("spec.branding.logoURL: %w", err)
+		}
+	}
+	return nil
+}
+
+// validateLinkURL rejects raw unless it is an absolute http(s) URL.
+func validateLinkURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("scheme %q is not supported, use http or https", u.Scheme)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("%q has no host", raw)
+	}
+	return nil
+}
+
+// validatePortal validates the Portal spec, spec.remote included when
+// checkRemote is set.
+func (v *PortalCustomValidator) validatePortal(obj *sreportalv1alpha1.Portal, checkRemote bool) (admission.Warnings, error) {
 	// Rule: Remote cannot be set when Main is true
 	if obj.Spec.Main && obj.Spec.Remote != nil {
 		return nil, fmt.Errorf("spec.remote cannot be set when spec.main is true: the main portal must be local")
 	}
 
+	if obj.Spec.Remote != nil && checkRemote {
+		if err := validateRemote(obj.Spec.Remote); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := validateLinks(obj.Spec); err != nil {
+		return nil, err
+	}
+
+	// Remote portals mirror the remote DNS view as-is: there is no local source
+	// aggregation for sourcePriority to influence.
+	if obj.Spec.Remote != nil && len(obj.Spec.SourcePriority) > 0 {
+		return admission.Warnings{"spec.sourcePriority has no effect on a remote portal"}, nil
+	}
+
 	return nil, nil
 }
@@ -38,6 +38,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
+	"github.com/golgoth31/sreportal/internal/config"
 	"github.com/golgoth31/sreportal/internal/log"
 	// +kubebuilder:scaffold:imports
 )
@@ -113,7 +114,7 @@ var _ = BeforeSuite(func() {
 	})
 	Expect(err).NotTo(HaveOccurred())
 
-	err = SetupDNSWebhookWithManager(mgr)
+	err = SetupDNSWebhookWithManager(mgr, config.QuotaConfig{})
 	Expect(err).NotTo(HaveOccurred())
 
 	err = SetupImageInventoryWebhookWithManager(mgr)
@@ -19,6 +19,8 @@ package v1alpha2
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/labels"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -26,8 +28,13 @@ import (
 
 	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
 	"github.com/golgoth31/sreportal/internal/log"
+	"github.com/golgoth31/sreportal/internal/source/fqdntemplate"
 )
 
+// minResolutionInterval is the lowest accepted spec.resolution.interval: a
+// shorter one would only hammer the resolvers.
+const minResolutionInterval = time.Minute
+
 // nolint:unused
 // dnsv2log is for logging in this package.
 var dnsv2log = log.Default().WithName("dns-v1alpha2-resource")
@@ -91,12 +98,25 @@ func (v *DNSCustomValidator) validate(obj *sreportalv1alpha2.DNS) error {
 			return fmt.Errorf("spec.sources.%s.labelFilter: %w", kind, err)
 		}
 	}
+	for kind, tmpl := range collectFQDNTemplates(&obj.Spec.Sources) {
+		if err := fqdntemplate.Check(kind, tmpl); err != nil {
+			return fmt.Errorf("spec.sources.%s.fqdnTemplate: %w", kind, err)
+		}
+	}
 	enabled := enabledSourceTypes(&obj.Spec.Sources)
 	for _, p := range obj.Spec.Sources.Priority {
 		if _, ok := enabled[p]; !ok {
 			return fmt.Errorf("spec.sources.priority entry %q is not an enabled source in this DNS", p)
 		}
 	}
+	if iv := obj.Spec.Resolution.Interval; iv != nil && iv.Duration < minResolutionInterval {
+		return fmt.Errorf("spec.resolution.interval %s is below the minimum of %s", iv.Duration, minResolutionInterval)
+	}
+	for i, g := range obj.Spec.Resolution.DisabledGroups {
+		if strings.TrimSpace(g) == "" {
+			return fmt.Errorf("spec.resolution.disabledGroups[%d]: group name must not be empty", i)
+		}
+	}
 	return nil
 }
 
@@ -136,12 +156,57 @@ func collectLabelFilters(s *sreportalv1alpha2.SourcesSpec) map[string]string {
 	if s.GatewayUDPRoute != nil {
 		m["gatewayUDPRoute"] = s.GatewayUDPRoute.LabelFilter
 	}
+	if s.ContourHTTPProxy != nil {
+		m["contourHTTPProxy"] = s.ContourHTTPProxy.LabelFilter
+	}
+	if s.AmbassadorHost != nil {
+		m["ambassadorHost"] = s.AmbassadorHost.LabelFilter
+	}
 	if s.CrossplaneScalewayRecord != nil {
 		m["crossplaneScalewayRecord"] = s.CrossplaneScalewayRecord.LabelFilter
 	}
 	return m
 }
 
+// collectFQDNTemplates returns a map of source JSON key → FQDNTemplate for
+// every non-nil source pointer in SourcesSpec whose kind supports templating.
+//
+// Keep in sync with collectLabelFilters when adding new source kinds.
+func collectFQDNTemplates(s *sreportalv1alpha2.SourcesSpec) map[string]string {
+	m := make(map[string]string)
+	if s.Service != nil {
+		m["service"] = s.Service.FQDNTemplate
+	}
+	if s.Ingress != nil {
+		m["ingress"] = s.Ingress.FQDNTemplate
+	}
+	if s.IstioGateway != nil {
+		m["istioGateway"] = s.IstioGateway.FQDNTemplate
+	}
+	if s.IstioVirtualService != nil {
+		m["istioVirtualService"] = s.IstioVirtualService.FQDNTemplate
+	}
+	if s.GatewayHTTPRoute != nil {
+		m["gatewayHTTPRoute"] = s.GatewayHTTPRoute.FQDNTemplate
+	}
+	if s.GatewayGRPCRoute != nil {
+		m["gatewayGRPCRoute"] = s.GatewayGRPCRoute.FQDNTemplate
+	}
+	if s.GatewayTLSRoute != nil {
+		m["gatewayTLSRoute"] = s.GatewayTLSRoute.FQDNTemplate
+	}
+	if s.GatewayTCPRoute != nil {
+		m["gatewayTCPRoute"] = s.GatewayTCPRoute.FQDNTemplate
+	}
+	if s.GatewayUDPRoute != nil {
+		m["gatewayUDPRoute"] = s.GatewayUDPRoute.FQDNTemplate
+	}
+	if s.ContourHTTPProxy != nil {
+		m["contourHTTPProxy"] = s.ContourHTTPProxy.FQDNTemplate
+	}
+	return m
+}
+
 // enabledSourceTypes returns the set of SourceType values whose corresponding
 // source pointer is non-nil AND whose Enabled field is true. No reflection.
 //
@@ -178,8 +243,17 @@ func enabledSourceTypes(s *sreportalv1alpha2.SourcesSpec) map[sreportalv1alpha2.
 	if s.GatewayUDPRoute != nil && s.GatewayUDPRoute.Enabled {
 		m[sreportalv1alpha2.SourceTypeGatewayUDPRoute] = struct{}{}
 	}
+	if s.ContourHTTPProxy != nil && s.ContourHTTPProxy.Enabled {
+		m[sreportalv1alpha2.SourceTypeContourHTTPProxy] = struct{}{}
+	}
+	if s.AmbassadorHost != nil && s.AmbassadorHost.Enabled {
+		m[sreportalv1alpha2.SourceTypeAmbassadorHost] = struct{}{}
+	}
 	if s.CrossplaneScalewayRecord != nil && s.CrossplaneScalewayRecord.Enabled {
 		m[sreportalv1alpha2.SourceTypeCrossplaneScalewayRecord] = struct{}{}
 	}
+	if s.Demo != nil && s.Demo.Enabled {
+		m[sreportalv1alpha2.SourceTypeDemo] = struct{}{}
+	}
 	return m
 }
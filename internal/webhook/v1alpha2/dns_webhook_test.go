@@ -19,6 +19,7 @@ package v1alpha2_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -198,6 +199,55 @@ func TestDNSWebhook_SourceServiceLabelFilterInvalid(t *testing.T) {
 	g.Expect(err.Error()).To(ContainSubstring("spec.sources.service.labelFilter"))
 }
 
+// --- fqdnTemplate validation ---
+
+// TestDNSWebhook_SourceFQDNTemplateInvalid asserts that a template rendering a
+// garbage hostname on a sample object is rejected with the field path.
+func TestDNSWebhook_SourceFQDNTemplateInvalid(t *testing.T) {
+	g := NewWithT(t)
+	v := webhookv1alpha2.NewDNSCustomValidator()
+	dns := &sreportalv1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: tPortalMain},
+		Spec: sreportalv1alpha2.DNSSpec{
+			PortalRef: tPortalMain,
+			Sources: sreportalv1alpha2.SourcesSpec{
+				Ingress: &sreportalv1alpha2.IngressSourceSpec{
+					CommonSourceSpec: sreportalv1alpha2.CommonSourceSpec{
+						Enabled:      true,
+						FQDNTemplate: "{{.Name}}_{{.Labels.team}}.example.com",
+					},
+				},
+			},
+		},
+	}
+	_, err := v.ValidateCreate(context.Background(), dns)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring("spec.sources.ingress.fqdnTemplate"))
+}
+
+// TestDNSWebhook_SourceFQDNTemplateValid asserts that a template rendering
+// valid hostnames is accepted.
+func TestDNSWebhook_SourceFQDNTemplateValid(t *testing.T) {
+	g := NewWithT(t)
+	v := webhookv1alpha2.NewDNSCustomValidator()
+	dns := &sreportalv1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: tPortalMain},
+		Spec: sreportalv1alpha2.DNSSpec{
+			PortalRef: tPortalMain,
+			Sources: sreportalv1alpha2.SourcesSpec{
+				Service: &sreportalv1alpha2.ServiceSourceSpec{
+					CommonSourceSpec: sreportalv1alpha2.CommonSourceSpec{
+						Enabled:      true,
+						FQDNTemplate: "{{.Name}}.{{.Namespace}}.example.com",
+					},
+				},
+			},
+		},
+	}
+	_, err := v.ValidateCreate(context.Background(), dns)
+	g.Expect(err).NotTo(HaveOccurred())
+}
+
 // --- priority validation ---
 
 // TestDNSWebhook_PriorityRefersNotEnabledSource asserts that a priority entry
@@ -272,3 +322,56 @@ func TestDNSWebhook_PriorityValidSubset(t *testing.T) {
 	_, err := v.ValidateCreate(context.Background(), dns)
 	g.Expect(err).NotTo(HaveOccurred())
 }
+
+// TestDNSWebhook_ResolutionIntervalTooShort asserts that spec.resolution.interval
+// below one minute is rejected and error mentions the field path.
+func TestDNSWebhook_ResolutionIntervalTooShort(t *testing.T) {
+	g := NewWithT(t)
+	v := webhookv1alpha2.NewDNSCustomValidator()
+	dns := &sreportalv1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: tPortalMain},
+		Spec: sreportalv1alpha2.DNSSpec{
+			PortalRef:  tPortalMain,
+			Resolution: sreportalv1alpha2.ResolutionSpec{Interval: &metav1.Duration{Duration: 30 * time.Second}},
+		},
+	}
+	_, err := v.ValidateCreate(context.Background(), dns)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring("spec.resolution.interval"))
+}
+
+// TestDNSWebhook_ResolutionEmptyDisabledGroup asserts that an empty entry in
+// spec.resolution.disabledGroups is rejected.
+func TestDNSWebhook_ResolutionEmptyDisabledGroup(t *testing.T) {
+	g := NewWithT(t)
+	v := webhookv1alpha2.NewDNSCustomValidator()
+	dns := &sreportalv1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: tPortalMain},
+		Spec: sreportalv1alpha2.DNSSpec{
+			PortalRef:  tPortalMain,
+			Resolution: sreportalv1alpha2.ResolutionSpec{DisabledGroups: []string{"airgap", " "}},
+		},
+	}
+	_, err := v.ValidateCreate(context.Background(), dns)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring("spec.resolution.disabledGroups[1]"))
+}
+
+// TestDNSWebhook_ResolutionValid asserts that a custom interval and disabled
+// groups are accepted.
+func TestDNSWebhook_ResolutionValid(t *testing.T) {
+	g := NewWithT(t)
+	v := webhookv1alpha2.NewDNSCustomValidator()
+	dns := &sreportalv1alpha2.DNS{
+		ObjectMeta: metav1.ObjectMeta{Name: tPortalMain},
+		Spec: sreportalv1alpha2.DNSSpec{
+			PortalRef: tPortalMain,
+			Resolution: sreportalv1alpha2.ResolutionSpec{
+				Interval:       &metav1.Duration{Duration: time.Hour},
+				DisabledGroups: []string{"airgap"},
+			},
+		},
+	}
+	_, err := v.ValidateCreate(context.Background(), dns)
+	g.Expect(err).NotTo(HaveOccurred())
+}
@@ -29,6 +29,7 @@ import (
 
 	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
 	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/config"
 	"github.com/golgoth31/sreportal/internal/log"
 )
 
@@ -50,6 +51,8 @@ var dnsrecordv2log = log.Default().WithName("dnsrecord-v1alpha2-resource")
 type DNSRecordCustomValidator struct {
 	client       client.Client
 	controllerSA string
+	// quota bounds the manual entries and groups of a portal.
+	quota config.QuotaConfig
 }
 
 // NewDNSRecordCustomValidator constructs a DNSRecordCustomValidator. Exported for unit tests.
@@ -57,10 +60,17 @@ func NewDNSRecordCustomValidator(c client.Client, controllerSA string) *DNSRecor
 	return &DNSRecordCustomValidator{client: c, controllerSA: controllerSA}
 }
 
+// SetQuota sets the limits on the manual entries and groups of a portal.
+func (v *DNSRecordCustomValidator) SetQuota(quota config.QuotaConfig) {
+	v.quota = quota
+}
+
 // SetupDNSRecordWebhookWithManager registers the v1alpha2 DNSRecord validating webhook with the manager.
-func SetupDNSRecordWebhookWithManager(mgr ctrl.Manager, controllerSA string) error {
+func SetupDNSRecordWebhookWithManager(mgr ctrl.Manager, controllerSA string, quota config.QuotaConfig) error {
+	validator := NewDNSRecordCustomValidator(mgr.GetClient(), controllerSA)
+	validator.SetQuota(quota)
 	return ctrl.NewWebhookManagedBy(mgr, &sreportalv1alpha2.DNSRecord{}).
-		WithValidator(&DNSRecordCustomValidator{client: mgr.GetClient(), controllerSA: controllerSA}).
+		WithValidator(validator).
 		Complete()
 }
 
@@ -109,6 +119,9 @@ func (v *DNSRecordCustomValidator) validate(ctx context.Context, r *sreportalv1a
 		if len(r.Spec.Entries) == 0 {
 			return fmt.Errorf("spec.entries must have at least one entry when spec.origin=manual")
 		}
+		if err := v.validateQuota(ctx, r, old); err != nil {
+			return err
+		}
 	default:
 		// Defense-in-depth: the CRD enum + CEL already reject unknown origins,
 		// but the webhook must not fall open if those markers ever regress —
@@ -218,6 +231,62 @@ func (v *DNSRecordCustomValidator) validatePortalRefExists(ctx context.Context,
 	return nil
 }
 
+// validateQuota rejects a manual record taking the manual entries or groups
+// of its portal over the quota. The other manual records of the portal are
+// listed; on update the previous version of r is replaced by r, and only a
+// count that grows is checked.
+func (v *DNSRecordCustomValidator) validateQuota(ctx context.Context, r, old *sreportalv1alpha2.DNSRecord) error {
+	if v.quota.MaxManualEntries == 0 && v.quota.MaxManualGroups == 0 {
+		return nil
+	}
+	var list sreportalv1alpha2.DNSRecordList
+	if err := v.client.List(ctx, &list, client.InNamespace(r.Namespace)); err != nil {
+		return fmt.Errorf("list DNSRecords to check the manual entries quota: %w", err)
+	}
+	var others []sreportalv1alpha2.DNSRecordEntry
+	for i := range list.Items {
+		item := &list.Items[i]
+		if item.Name == r.Name || item.Spec.Origin != sreportalv1alpha2.DNSRecordOriginManual ||
+			item.Spec.PortalRef != r.Spec.PortalRef {
+			continue
+		}
+		others = append(others, item.Spec.Entries...)
+	}
+
+	entries, groups := manualCounts(others, r.Spec.Entries)
+	var oldEntries, oldGroups int
+	if old != nil {
+		oldEntries, oldGroups = manualCounts(others, old.Spec.Entries)
+	}
+	if limit := v.quota.MaxManualEntries; limit > 0 && entries > limit && entries > oldEntries {
+		return fmt.Errorf("spec.entries: portal %q would have %d manual entries, above the quota of %d (quota.maxManualEntries)",
+			r.Spec.PortalRef, entries, limit)
+	}
+	if limit := v.quota.MaxManualGroups; limit > 0 && groups > limit && groups > oldGroups {
+		return fmt.Errorf("spec.entries: portal %q would have %d manual groups, above the quota of %d (quota.maxManualGroups)",
+			r.Spec.PortalRef, groups, limit)
+	}
+	return nil
+}
+
+// manualCounts returns the number of entries and of distinct groups of the
+// given entry lists.
+func manualCounts(lists ...[]sreportalv1alpha2.DNSRecordEntry) (entries, groups int) {
+	seen := map[string]struct{}{}
+	for _, list := range lists {
+		entries += len(list)
+		for _, e := range list {
+			if e.Group != "" {
+				seen[e.Group] = struct{}{}
+			}
+			for _, g := range e.Groups {
+				seen[g] = struct{}{}
+			}
+		}
+	}
+	return entries, len(seen)
+}
+
 // dnsControllerOwnerRefs filters ownerReferences down to those that point to a
 // sreportal DNS CR as controller. The webhook tolerates non-controller refs
 // of any kind; only controller refs of kind=DNS, apiVersion=sreportal.io/v1alpha2
@@ -32,6 +32,7 @@ import (
 
 	sreportalv1alpha1 "github.com/golgoth31/sreportal/api/v1alpha1"
 	sreportalv1alpha2 "github.com/golgoth31/sreportal/api/v1alpha2"
+	"github.com/golgoth31/sreportal/internal/config"
 	webhookv1alpha2 "github.com/golgoth31/sreportal/internal/webhook/v1alpha2"
 )
 
@@ -720,3 +721,64 @@ func TestDNSRecordWebhook_AutoUpdateAllowedForControllerSA(t *testing.T) {
 	_, err := v.ValidateUpdate(ctxWithUser(testControllerSA), old, newR)
 	g.Expect(err).NotTo(HaveOccurred())
 }
+
+// manualRecord returns a manual record of the main portal with one entry per
+// fqdn, each in group.
+func manualRecord(name, group string, fqdns ...string) *sreportalv1alpha2.DNSRecord {
+	entries := make([]sreportalv1alpha2.DNSRecordEntry, 0, len(fqdns))
+	for _, f := range fqdns {
+		entries = append(entries, sreportalv1alpha2.DNSRecordEntry{FQDN: f, Groups: []string{group}})
+	}
+	return &sreportalv1alpha2.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: tNamespace},
+		Spec: sreportalv1alpha2.DNSRecordSpec{
+			Origin:    sreportalv1alpha2.DNSRecordOriginManual,
+			PortalRef: tPortalMain,
+			Entries:   entries,
+		},
+	}
+}
+
+func TestDNSRecordWebhook_ManualEntriesQuota(t *testing.T) {
+	g := NewWithT(t)
+	existing := manualRecord("main-manual-web", "web", "a.example.com", "b.example.com")
+	v := webhookv1alpha2.NewDNSRecordCustomValidator(newFakeClient(t, newPortal(), existing), "")
+	v.SetQuota(config.QuotaConfig{MaxManualEntries: 3})
+
+	_, err := v.ValidateCreate(context.Background(), manualRecord(tRecordManual, "web", tFQDNAPIExamp))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = v.ValidateCreate(context.Background(), manualRecord(tRecordManual, "web", tFQDNAPIExamp, "c.example.com"))
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring(`portal "main" would have 4 manual entries, above the quota of 3 (quota.maxManualEntries)`))
+}
+
+func TestDNSRecordWebhook_ManualGroupsQuota(t *testing.T) {
+	g := NewWithT(t)
+	existing := manualRecord("main-manual-web", "web", "a.example.com")
+	v := webhookv1alpha2.NewDNSRecordCustomValidator(newFakeClient(t, newPortal(), existing), "")
+	v.SetQuota(config.QuotaConfig{MaxManualGroups: 1})
+
+	_, err := v.ValidateCreate(context.Background(), manualRecord(tRecordManual, "web", tFQDNAPIExamp))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = v.ValidateCreate(context.Background(), manualRecord(tRecordManual, "apis", tFQDNAPIExamp))
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring("(quota.maxManualGroups)"))
+}
+
+// TestDNSRecordWebhook_QuotaAllowsShrinkingUpdates verifies that a portal
+// above a lowered quota can still be trimmed.
+func TestDNSRecordWebhook_QuotaAllowsShrinkingUpdates(t *testing.T) {
+	g := NewWithT(t)
+	old := manualRecord(tRecordManual, "web", "a.example.com", "b.example.com", "c.example.com")
+	v := webhookv1alpha2.NewDNSRecordCustomValidator(newFakeClient(t, newPortal(), old), "")
+	v.SetQuota(config.QuotaConfig{MaxManualEntries: 1})
+
+	_, err := v.ValidateUpdate(context.Background(), old, manualRecord(tRecordManual, "web", "a.example.com", "b.example.com"))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = v.ValidateUpdate(context.Background(), old,
+		manualRecord(tRecordManual, "web", "a.example.com", "b.example.com", "c.example.com", "d.example.com"))
+	g.Expect(err).To(HaveOccurred())
+}
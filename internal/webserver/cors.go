@@ -0,0 +1,80 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webserver
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/labstack/echo/v5"
+	"github.com/labstack/echo/v5/middleware"
+
+	"github.com/golgoth31/sreportal/internal/config"
+)
+
+// defaultCORSMethods are the methods used by the Connect, gRPC-Web and
+// static UI endpoints.
+var defaultCORSMethods = []string{http.MethodGet, http.MethodPost, http.MethodOptions}
+
+// defaultCORSAllowedHeaders are the request headers sent by Connect and
+// gRPC-Web clients, plus the ones carrying write-endpoint credentials.
+var defaultCORSAllowedHeaders = []string{
+	"Content-Type",
+	"Connect-Protocol-Version",
+	"Connect-Timeout-Ms",
+	"Connect-Accept-Encoding",
+	"Connect-Content-Encoding",
+	"Grpc-Timeout",
+	"X-Grpc-Web",
+	"X-User-Agent",
+	"Authorization",
+	"X-API-Key",
+}
+
+// defaultCORSExposedHeaders are the response headers a Connect or gRPC-Web
+// client must read to decode errors.
+var defaultCORSExposedHeaders = []string{
+	"Grpc-Status",
+	"Grpc-Message",
+	"Grpc-Status-Details-Bin",
+}
+
+// corsMiddleware returns the CORS middleware for cfg, with extraOrigins
+// appended to the configured origins. It returns nil when no origin is
+// allowed, leaving cross-origin access disabled.
+func corsMiddleware(cfg config.CORSConfig, extraOrigins []string) (echo.MiddlewareFunc, error) {
+	origins := slices.Compact(slices.Sorted(slices.Values(append(slices.Clone(cfg.AllowedOrigins), extraOrigins...))))
+	if len(origins) == 0 {
+		return nil, nil
+	}
+
+	return middleware.CORSConfig{
+		AllowOrigins:     origins,
+		AllowMethods:     orDefault(cfg.AllowedMethods, defaultCORSMethods),
+		AllowHeaders:     orDefault(cfg.AllowedHeaders, defaultCORSAllowedHeaders),
+		ExposeHeaders:    orDefault(cfg.ExposedHeaders, defaultCORSExposedHeaders),
+		AllowCredentials: cfg.AllowCredentials,
+		MaxAge:           int(cfg.MaxAge.Duration().Seconds()),
+	}.ToMiddleware()
+}
+
+func orDefault(values, def []string) []string {
+	if len(values) == 0 {
+		return def
+	}
+	return values
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webserver
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"strings"
+)
+
+// UnixAddressPrefix marks an address naming a unix domain socket, e.g.
+// "unix:///var/run/sreportal.sock".
+const UnixAddressPrefix = "unix://"
+
+// unixSocketMode lets the containers of the pod sharing the socket directory
+// and group connect, e.g. an authenticating proxy sidecar.
+const unixSocketMode = 0o660
+
+// Listen listens on address: a unix domain socket when it starts with
+// UnixAddressPrefix, a TCP address otherwise. A socket left behind by a
+// previous process is removed first; the socket file is removed when the
+// listener is closed.
+func Listen(address string) (net.Listener, error) {
+	path, ok := strings.CutPrefix(address, UnixAddressPrefix)
+	if !ok {
+		return net.Listen("tcp", address)
+	}
+	if path == "" {
+		return nil, fmt.Errorf("listen %s: empty socket path", address)
+	}
+	if err := removeStaleSocket(path); err != nil {
+		return nil, err
+	}
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, unixSocketMode); err != nil {
+		_ = ln.Close()
+		return nil, fmt.Errorf("chmod %s: %w", path, err)
+	}
+	return ln, nil
+}
+
+// ListenAll listens on every address. When one of them fails, the listeners
+// already opened are closed.
+func ListenAll(addresses []string) ([]net.Listener, error) {
+	if len(addresses) == 0 {
+		return nil, errors.New("no listen address")
+	}
+	listeners := make([]net.Listener, 0, len(addresses))
+	for _, address := range addresses {
+		ln, err := Listen(address)
+		if err != nil {
+			for _, opened := range listeners {
+				_ = opened.Close()
+			}
+			return nil, err
+		}
+		listeners = append(listeners, ln)
+	}
+	return listeners, nil
+}
+
+// ParseAddresses splits a comma-separated list of listen addresses, e.g.
+// "0.0.0.0:8090,[::]:8090", dropping the empty items.
+func ParseAddresses(list string) []string {
+	var out []string
+	for address := range strings.SplitSeq(list, ",") {
+		if address = strings.TrimSpace(address); address != "" {
+			out = append(out, address)
+		}
+	}
+	return out
+}
+
+// removeStaleSocket removes the socket at path, refusing to remove any other
+// kind of file.
+func removeStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.Mode().Type() != fs.ModeSocket {
+		return fmt.Errorf("listen unix %s: file exists and is not a socket", path)
+	}
+	return os.Remove(path)
+}
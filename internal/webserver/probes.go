@@ -0,0 +1,90 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webserver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	// probeReadHeaderTimeout bounds reading the headers of a probe request.
+	probeReadHeaderTimeout = 10 * time.Second
+	// probeShutdownTimeout bounds the graceful shutdown of the probe listeners.
+	probeShutdownTimeout = 5 * time.Second
+)
+
+// ProbeServer serves the /healthz and /readyz endpoints of the manager on
+// additional addresses, the manager binding a single one.
+type ProbeServer struct {
+	Addresses []string
+	Healthz   map[string]healthz.Checker
+	Readyz    map[string]healthz.Checker
+}
+
+// NewProbeServer creates a ProbeServer running the given checks.
+func NewProbeServer(addresses []string, healthChecks, readyChecks map[string]healthz.Checker) *ProbeServer {
+	return &ProbeServer{Addresses: addresses, Healthz: healthChecks, Readyz: readyChecks}
+}
+
+// Start implements manager.Runnable. It serves until ctx is done or one of
+// the listeners fails.
+func (p *ProbeServer) Start(ctx context.Context) error {
+	listeners, err := ListenAll(p.Addresses)
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	for path, checks := range map[string]map[string]healthz.Checker{"/healthz": p.Healthz, "/readyz": p.Readyz} {
+		handler := http.StripPrefix(path, &healthz.Handler{Checks: checks})
+		mux.Handle(path, handler)
+		mux.Handle(path+"/", handler)
+	}
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: probeReadHeaderTimeout}
+
+	errs := make(chan error, len(listeners))
+	for _, ln := range listeners {
+		go func() { errs <- srv.Serve(ln) }()
+	}
+	select {
+	case <-ctx.Done():
+	case err = <-errs:
+	}
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), probeShutdownTimeout)
+	defer cancel()
+	_ = srv.Shutdown(shutdownCtx)
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable: every
+// replica answers its probes.
+func (p *ProbeServer) NeedLeaderElection() bool {
+	return false
+}
+
+var (
+	_ manager.Runnable               = (*ProbeServer)(nil)
+	_ manager.LeaderElectionRunnable = (*ProbeServer)(nil)
+)
@@ -0,0 +1,40 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webserver
+
+import (
+	"github.com/labstack/echo/v5"
+	"github.com/labstack/echo/v5/middleware"
+
+	"github.com/golgoth31/sreportal/internal/config"
+)
+
+// securityHeadersMiddleware returns the middleware adding the security
+// headers of cfg to every response. X-Content-Type-Options: nosniff is
+// always set.
+func securityHeadersMiddleware(cfg config.SecurityHeadersConfig) (echo.MiddlewareFunc, error) {
+	return middleware.SecureConfig{
+		ContentTypeNosniff:    "nosniff",
+		XFrameOptions:         cfg.FrameOptions,
+		ContentSecurityPolicy: cfg.ContentSecurityPolicy,
+		CSPReportOnly:         cfg.CSPReportOnly,
+		ReferrerPolicy:        cfg.ReferrerPolicy,
+		HSTSMaxAge:            int(cfg.HSTS.MaxAge.Duration().Seconds()),
+		HSTSExcludeSubdomains: !cfg.HSTS.IncludeSubDomains,
+		HSTSPreloadEnabled:    cfg.HSTS.Preload,
+	}.ToMiddleware()
+}
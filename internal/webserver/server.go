@@ -19,11 +19,14 @@ package webserver
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io/fs"
 	"net/http"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"connectrpc.com/connect"
@@ -32,6 +35,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/golgoth31/sreportal/internal/agent"
+	"github.com/golgoth31/sreportal/internal/analytics"
 	"github.com/golgoth31/sreportal/internal/auth"
 	"github.com/golgoth31/sreportal/internal/log"
 
@@ -46,18 +51,27 @@ import (
 	domainnetpol "github.com/golgoth31/sreportal/internal/domain/netpol"
 	domainportal "github.com/golgoth31/sreportal/internal/domain/portal"
 	domainrelease "github.com/golgoth31/sreportal/internal/domain/release"
+	"github.com/golgoth31/sreportal/internal/federation"
+	"github.com/golgoth31/sreportal/internal/fqdnignore"
+	"github.com/golgoth31/sreportal/internal/fqdnnote"
 	"github.com/golgoth31/sreportal/internal/grpc"
 	"github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1/sreportalv1connect"
+	"github.com/golgoth31/sreportal/internal/mainportal"
+	"github.com/golgoth31/sreportal/internal/manualdns"
 	"github.com/golgoth31/sreportal/internal/metrics"
 	"github.com/golgoth31/sreportal/internal/openapi"
 	releaseservice "github.com/golgoth31/sreportal/internal/release"
+	"github.com/golgoth31/sreportal/internal/sharelink"
+	"github.com/golgoth31/sreportal/internal/source"
 	statuspagesvc "github.com/golgoth31/sreportal/internal/statuspage"
 )
 
 // Config holds the web server configuration
 type Config struct {
-	// Address is the address to listen on (e.g., ":8080")
-	Address string
+	// Addresses are the addresses to listen on (e.g., ":8080", or "0.0.0.0:8080"
+	// and "[::]:8080" on a dual-stack cluster), or unix domain sockets (e.g.,
+	// "unix:///var/run/sreportal.sock")
+	Addresses []string
 
 	// WebRoot is the path to the Angular dist directory
 	WebRoot string
@@ -83,9 +97,33 @@ type Config struct {
 	// FQDNReader is the read-side interface for DNS data (provided by the ReadStore)
 	FQDNReader domaindns.FQDNReader
 
+	// FQDNUniquenessReader reports the FQDNs that are not unique across portals and sources (nil = GetUniquenessReport disabled)
+	FQDNUniquenessReader domaindns.FQDNUniquenessReader
+
 	// PortalReader is the read-side interface for Portal data (provided by the ReadStore)
 	PortalReader domainportal.PortalReader
 
+	// FederatedSearcher fans FQDN searches out to remote portals (nil = FederatedSearch disabled)
+	FederatedSearcher *federation.Searcher
+
+	// ManualDNSService is the write-path service for manual DNS entries (nil = BatchUpdateManualEntries disabled)
+	ManualDNSService *manualdns.Service
+
+	// IgnoreService writes the ignore annotation of the FQDN origin resources (nil = SetFQDNsIgnored disabled)
+	IgnoreService *fqdnignore.Service
+
+	// NotesService stores the notes attached to FQDNs (nil = AddNote and ListNotes disabled)
+	NotesService *fqdnnote.Service
+
+	// MainPortalPromoter promotes portals to main (nil = PromoteToMain disabled)
+	MainPortalPromoter *mainportal.Service
+
+	// AgentIngester stores the FQDNs pushed by agents (nil = PublishEndpoints disabled)
+	AgentIngester *agent.Ingester
+
+	// SensitivePolicy flags sensitive FQDNs (nil = no FQDN is sensitive)
+	SensitivePolicy *domaindns.SensitivePolicy
+
 	// AlertmanagerReader is the read-side interface for Alertmanager data (provided by the ReadStore)
 	AlertmanagerReader domainalertmanager.AlertmanagerReader
 
@@ -112,6 +150,33 @@ type Config struct {
 
 	// AuthChain is the authentication chain for write endpoints (nil = no auth)
 	AuthChain *auth.Chain
+
+	// Admins are the identities with the admin role, allowed to call StreamLogs
+	Admins []string
+
+	// RequireAuthForReads extends AuthChain to every DNSService and PortalService call, reads included, and to the share links
+	RequireAuthForReads bool
+
+	// Authorizer decides every Connect call from its user, portal and verb (nil = no authorization)
+	Authorizer auth.Authorizer
+
+	// OIDC serves the login of the web UI and hides the portals whose allowed groups the user is not in (nil = every portal is public)
+	OIDC *auth.OIDCLogin
+
+	// LogTap holds the operator logs streamed by StreamLogs (nil = StreamLogs disabled)
+	LogTap *log.Tap
+
+	// Diagnostics runs the self-diagnostics of RunDiagnostics (nil = disabled)
+	Diagnostics grpc.DiagnosticsRunner
+
+	// StreamLimiter bounds the concurrent StreamFQDNs calls (nil = unbounded)
+	StreamLimiter grpc.StreamLimiter
+
+	// Capabilities are the active features reported by GetCapabilities (the sources come from the DNS resources)
+	Capabilities config.Capabilities
+
+	// UsageRecorder counts the usage events of the web UI (nil = analytics disabled)
+	UsageRecorder *analytics.Recorder
 }
 
 // Server is the web server for the SRE Portal
@@ -121,12 +186,20 @@ type Server struct {
 	client         client.Client
 	operatorConfig *config.OperatorConfig
 	httpServer     *http.Server
+	groupSeparator string
+	streamTimeout  time.Duration
+	heartbeat      time.Duration
+	rpc            config.WebRPCConfig
+	publicURL      string
+	// serving tracks, per address, whether its listener accepts connections.
+	serving []atomic.Bool
 }
 
 // New creates a new web server.
-// allowedOrigins lists the origins permitted for CORS requests.
-// Pass an empty slice to disable cross-origin access (production default).
-func New(cfg Config, c client.Client, operatorConfig *config.OperatorConfig, allowedOrigins []string) *Server {
+// allowedOrigins lists origins permitted for CORS requests in addition to
+// operatorConfig.Web.CORS.AllowedOrigins. Cross-origin access stays disabled
+// when neither lists an origin (production default).
+func New(cfg Config, c client.Client, operatorConfig *config.OperatorConfig, allowedOrigins []string) (*Server, error) {
 	e := echo.New()
 
 	// Request logging middleware — wraps the response writer to capture the
@@ -134,10 +207,25 @@ func New(cfg Config, c client.Client, operatorConfig *config.OperatorConfig, all
 	e.Use(requestLoggerMiddleware)
 	e.Use(middleware.Recover())
 	e.Use(metricsMiddleware)
-	if len(allowedOrigins) > 0 {
-		e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
-			AllowOrigins: allowedOrigins,
-		}))
+
+	webCfg := config.DefaultConfig().Web
+	if operatorConfig != nil {
+		webCfg = operatorConfig.Web
+	}
+	secure, err := securityHeadersMiddleware(webCfg.SecurityHeaders)
+	if err != nil {
+		return nil, fmt.Errorf("configure security headers: %w", err)
+	}
+	e.Use(secure)
+	cors, err := corsMiddleware(webCfg.CORS, allowedOrigins)
+	if err != nil {
+		return nil, fmt.Errorf("configure CORS: %w", err)
+	}
+	if cors != nil {
+		e.Use(cors)
+	}
+	if cfg.OIDC != nil {
+		e.Use(echo.WrapMiddleware(cfg.OIDC.Middleware))
 	}
 
 	s := &Server{
@@ -145,33 +233,129 @@ func New(cfg Config, c client.Client, operatorConfig *config.OperatorConfig, all
 		echo:           e,
 		client:         c,
 		operatorConfig: operatorConfig,
+		groupSeparator: webCfg.GroupSeparator,
+		streamTimeout:  webCfg.Streams.SendTimeout.Duration(),
+		heartbeat:      webCfg.Streams.HeartbeatInterval.Duration(),
+		rpc:            webCfg.RPC,
+		publicURL:      webCfg.PublicURL,
+		serving:        make([]atomic.Bool, len(cfg.Addresses)),
 	}
 
 	s.setupRoutes()
-	return s
+	return s, nil
 }
 
 // setupRoutes configures all routes
 func (s *Server) setupRoutes() {
-	// Shared Connect interceptor — logs handler errors at WARN level since
-	// Connect returns HTTP 200 even on coded errors, making them invisible
-	// to the Echo request logger middleware.
-	connectOpts := connect.WithInterceptors(grpc.LoggingInterceptor())
+	// Shared Connect interceptors — recovers handler panics, logs handler
+	// errors at WARN level since Connect returns HTTP 200 even on coded
+	// errors, making them invisible to the Echo request logger middleware,
+	// bounds and validates calls, and submits every call to the authorizer
+	// when one is configured.
+	portalReader, fqdnReader, authorizer := s.config.PortalReader, s.config.FQDNReader, s.config.Authorizer
+	alertmanagerReader, flowGraphReader, imageReader := s.config.AlertmanagerReader, s.config.FlowGraphReader, s.config.ImageReader
+	releaseReader, componentReader := s.config.ReleaseReader, s.config.ComponentReader
+	maintenanceReader, incidentReader := s.config.MaintenanceReader, s.config.IncidentReader
+	if s.config.OIDC != nil && portalReader != nil {
+		// Every service reads through the visibility filter, and calls
+		// naming a hidden portal are denied.
+		visibility := auth.NewPortalVisibility(portalReader)
+		portalReader = visibility.Portals()
+		if fqdnReader != nil {
+			fqdnReader = visibility.FQDNs(fqdnReader)
+		}
+		if alertmanagerReader != nil {
+			alertmanagerReader = visibility.Alertmanagers(alertmanagerReader)
+		}
+		if flowGraphReader != nil {
+			flowGraphReader = visibility.FlowGraphs(flowGraphReader)
+		}
+		if imageReader != nil {
+			imageReader = visibility.Images(imageReader)
+		}
+		if releaseReader != nil {
+			releaseReader = visibility.Releases(releaseReader)
+		}
+		if componentReader != nil {
+			componentReader = visibility.Components(componentReader)
+		}
+		if maintenanceReader != nil {
+			maintenanceReader = visibility.Maintenances(maintenanceReader)
+		}
+		if incidentReader != nil {
+			incidentReader = visibility.Incidents(incidentReader)
+		}
+		if authorizer != nil {
+			authorizer = auth.AllOf(visibility, authorizer)
+		} else {
+			authorizer = visibility
+		}
+	}
+	interceptors := grpc.HandlerInterceptors(s.rpc)
+	if authorizer != nil {
+		interceptors = append(interceptors, auth.AuthzInterceptor(s.config.AuthChain, authorizer, s.config.PortalReader))
+	}
+	connectOpts := connect.WithInterceptors(interceptors...)
 
 	// Mount Connect handlers for gRPC/Connect protocol
-	dnsService := grpc.NewDNSService(s.config.FQDNReader, s.config.PortalReader)
-	dnsPath, dnsHandler := sreportalv1connect.NewDNSServiceHandler(dnsService, connectOpts)
-	s.echo.Any(dnsPath+"*", echo.WrapHandler(dnsHandler))
+	dnsService := grpc.NewDNSService(fqdnReader, portalReader)
+	if s.config.FederatedSearcher != nil {
+		dnsService.SetFederatedSearcher(s.config.FederatedSearcher)
+	}
+	if s.config.SensitivePolicy != nil {
+		dnsService.SetSensitivePolicy(s.config.SensitivePolicy, s.config.AuthChain)
+	}
+	dnsService.SetGroupSeparator(s.groupSeparator)
+	dnsService.SetStreamSendTimeout(s.streamTimeout)
+	dnsService.SetStreamHeartbeatInterval(s.heartbeat)
+	dnsService.SetPublicURL(s.publicURL)
+	if s.config.StreamLimiter != nil {
+		dnsService.SetStreamLimiter(s.config.StreamLimiter)
+	}
+	dnsOpts := []connect.HandlerOption{connectOpts}
+	if s.config.ManualDNSService != nil {
+		dnsService.SetManualEntriesWriter(s.config.ManualDNSService)
+	}
+	if s.config.IgnoreService != nil {
+		dnsService.SetIgnoreWriter(s.config.IgnoreService)
+	}
+	if s.config.NotesService != nil {
+		dnsService.SetNotesService(s.config.NotesService)
+	}
+	if s.config.AgentIngester != nil {
+		dnsService.SetAgentIngester(s.config.AgentIngester)
+	}
+	if s.config.FQDNUniquenessReader != nil {
+		dnsService.SetUniquenessReader(s.config.FQDNUniquenessReader)
+	}
+	if (s.config.ManualDNSService != nil || s.config.IgnoreService != nil || s.config.NotesService != nil || s.config.AgentIngester != nil) && s.config.AuthChain != nil {
+		dnsOpts = append(dnsOpts, connect.WithInterceptors(auth.AuthInterceptor(s.config.AuthChain)))
+	}
+	if s.config.RequireAuthForReads && s.config.AuthChain != nil {
+		dnsOpts = append(dnsOpts, connect.WithInterceptors(auth.RequireAuthInterceptor(s.config.AuthChain)))
+	}
+	dnsPath, dnsHandler := sreportalv1connect.NewDNSServiceHandler(dnsService, dnsOpts...)
+	s.echo.Any(dnsPath+"*", echo.WrapHandler(grpc.WithSendDeadlines(dnsHandler)))
 
-	portalService := grpc.NewPortalService(s.config.PortalReader)
-	portalPath, portalHandler := sreportalv1connect.NewPortalServiceHandler(portalService, connectOpts)
+	portalService := grpc.NewPortalService(portalReader)
+	portalOpts := []connect.HandlerOption{connectOpts}
+	if s.config.MainPortalPromoter != nil {
+		portalService.SetMainPromoter(s.config.MainPortalPromoter)
+		if s.config.AuthChain != nil {
+			portalOpts = append(portalOpts, connect.WithInterceptors(auth.AuthInterceptor(s.config.AuthChain)))
+		}
+	}
+	if s.config.RequireAuthForReads && s.config.AuthChain != nil {
+		portalOpts = append(portalOpts, connect.WithInterceptors(auth.RequireAuthInterceptor(s.config.AuthChain)))
+	}
+	portalPath, portalHandler := sreportalv1connect.NewPortalServiceHandler(portalService, portalOpts...)
 	s.echo.Any(portalPath+"*", echo.WrapHandler(portalHandler))
 
-	alertmanagerService := grpc.NewAlertmanagerService(s.config.AlertmanagerReader, s.config.PortalReader)
+	alertmanagerService := grpc.NewAlertmanagerService(alertmanagerReader, portalReader)
 	amPath, amHandler := sreportalv1connect.NewAlertmanagerServiceHandler(alertmanagerService, connectOpts)
 	s.echo.Any(amPath+"*", echo.WrapHandler(amHandler))
 
-	netpolService := grpc.NewNetworkPolicyService(s.config.FlowGraphReader, s.config.PortalReader)
+	netpolService := grpc.NewNetworkPolicyService(flowGraphReader, portalReader)
 	netpolPath, netpolHandler := sreportalv1connect.NewNetworkPolicyServiceHandler(netpolService, connectOpts)
 	s.echo.Any(netpolPath+"*", echo.WrapHandler(netpolHandler))
 
@@ -179,12 +363,23 @@ func (s *Server) setupRoutes() {
 	versionPath, versionHandler := sreportalv1connect.NewVersionServiceHandler(versionService, connectOpts)
 	s.echo.Any(versionPath+"*", echo.WrapHandler(versionHandler))
 
+	capabilitiesService := grpc.NewCapabilitiesService(s.config.Capabilities, source.Capabilities(s.client))
+	capabilitiesPath, capabilitiesHandler := sreportalv1connect.NewCapabilitiesServiceHandler(capabilitiesService, connectOpts)
+	s.echo.Any(capabilitiesPath+"*", echo.WrapHandler(capabilitiesHandler))
+
+	// Beacons are checked against every portal and FQDN: the authorizer
+	// rejects the ones naming a hidden portal.
+	analyticsService := grpc.NewAnalyticsService(s.config.UsageRecorder, s.config.PortalReader, s.config.FQDNReader)
+	analyticsService.SetGroupSeparator(s.groupSeparator)
+	analyticsPath, analyticsHandler := sreportalv1connect.NewAnalyticsServiceHandler(analyticsService, connectOpts)
+	s.echo.Any(analyticsPath+"*", echo.WrapHandler(analyticsHandler))
+
 	emojiService := grpc.NewEmojiService(s.config.EmojiReader)
 	emojiPath, emojiHandler := sreportalv1connect.NewEmojiServiceHandler(emojiService, connectOpts)
 	s.echo.Any(emojiPath+"*", echo.WrapHandler(emojiHandler))
 
-	if s.config.ImageReader != nil {
-		imageService := grpc.NewImageService(s.config.ImageReader, s.config.PortalReader)
+	if imageReader != nil {
+		imageService := grpc.NewImageService(imageReader, portalReader)
 		imagePath, imageHandler := sreportalv1connect.NewImageServiceHandler(imageService, connectOpts)
 		s.echo.Any(imagePath+"*", echo.WrapHandler(imageHandler))
 	}
@@ -195,8 +390,8 @@ func (s *Server) setupRoutes() {
 		s.echo.Any(metricsPath+"*", echo.WrapHandler(metricsHandler))
 	}
 
-	if s.config.ReleaseReader != nil {
-		releaseGRPC := grpc.NewReleaseService(s.config.ReleaseReader, s.config.ReleaseService, s.config.ReleaseTTL, s.config.ReleaseAllowedTypes, s.config.PortalReader)
+	if releaseReader != nil {
+		releaseGRPC := grpc.NewReleaseService(releaseReader, s.config.ReleaseService, s.config.ReleaseTTL, s.config.ReleaseAllowedTypes, portalReader)
 		releaseOpts := []connect.HandlerOption{connectOpts}
 		if s.config.AuthChain != nil {
 			releaseOpts = append(releaseOpts, connect.WithInterceptors(auth.AuthInterceptor(s.config.AuthChain)))
@@ -206,13 +401,13 @@ func (s *Server) setupRoutes() {
 	}
 
 	// Status page service (read + write, write endpoints are auth-protected)
-	if s.config.ComponentReader != nil {
+	if componentReader != nil {
 		statusService := grpc.NewStatusService(
-			s.config.ComponentReader,
-			s.config.MaintenanceReader,
-			s.config.IncidentReader,
+			componentReader,
+			maintenanceReader,
+			incidentReader,
 			s.config.StatusPageService,
-			s.config.PortalReader,
+			portalReader,
 		)
 		statusOpts := []connect.HandlerOption{connectOpts}
 		if s.config.AuthChain != nil {
@@ -222,6 +417,22 @@ func (s *Server) setupRoutes() {
 		s.echo.Any(statusPath+"*", echo.WrapHandler(statusHandler))
 	}
 
+	// Log service (streams authenticate in the handler: the interceptor is unary only)
+	logPath, logHandler := sreportalv1connect.NewLogServiceHandler(
+		grpc.NewLogService(s.config.LogTap, s.config.AuthChain, s.config.Admins), connectOpts)
+	s.echo.Any(logPath+"*", echo.WrapHandler(logHandler))
+
+	// Diagnostics service (auth-protected when an auth chain is configured)
+	if s.config.Diagnostics != nil {
+		diagOpts := []connect.HandlerOption{connectOpts}
+		if s.config.AuthChain != nil {
+			diagOpts = append(diagOpts, connect.WithInterceptors(auth.AuthInterceptor(s.config.AuthChain)))
+		}
+		diagPath, diagHandler := sreportalv1connect.NewDiagnosticsServiceHandler(
+			grpc.NewDiagnosticsService(s.config.Diagnostics), diagOpts...)
+		s.echo.Any(diagPath+"*", echo.WrapHandler(diagHandler))
+	}
+
 	// Swagger UI — serve embedded OpenAPI files at /swagger
 	swaggerFS, _ := fs.Sub(openapi.Swagger, "swagger")
 	swaggerHandler := http.StripPrefix("/swagger", http.FileServer(http.FS(swaggerFS)))
@@ -230,9 +441,27 @@ func (s *Server) setupRoutes() {
 		return c.Redirect(http.StatusMovedPermanently, "/swagger/index.html")
 	})
 
+	// OIDC login of the web UI
+	if s.config.OIDC != nil {
+		s.echo.GET(auth.OIDCLoginPath, echo.WrapHandler(http.HandlerFunc(s.config.OIDC.Login)))
+		s.echo.GET(auth.OIDCCallbackPath, echo.WrapHandler(http.HandlerFunc(s.config.OIDC.Callback)))
+		s.echo.Any(auth.OIDCLogoutPath, echo.WrapHandler(http.HandlerFunc(s.config.OIDC.Logout)))
+		s.echo.GET(auth.OIDCMePath, echo.WrapHandler(http.HandlerFunc(s.config.OIDC.Me)))
+	}
+
 	// API health check
 	s.echo.GET("/api/health", s.healthHandler)
 
+	// Share links resolve to the current UI route of their FQDN, and name
+	// it: they are guarded like the DNSService reads.
+	if portalReader != nil {
+		shareLinks := sharelink.Handler(portalReader)
+		if s.config.RequireAuthForReads && s.config.AuthChain != nil {
+			shareLinks = auth.RequireAuth(s.config.AuthChain, shareLinks)
+		}
+		s.echo.GET(sharelink.PathPrefix+"*", echo.WrapHandler(shareLinks))
+	}
+
 	// Serve static files for Angular SPA
 	s.setupStaticFiles()
 }
@@ -254,7 +483,7 @@ func (s *Server) spaHandler(fileSystem http.FileSystem) echo.HandlerFunc {
 		path := c.Request().URL.Path
 
 		// Skip API, Connect, and Swagger paths
-		if strings.HasPrefix(path, "/api/") || strings.HasPrefix(path, "/sreportal.") || strings.HasPrefix(path, "/swagger") {
+		if strings.HasPrefix(path, "/api/") || strings.HasPrefix(path, "/auth/") || strings.HasPrefix(path, "/sreportal.") || strings.HasPrefix(path, "/swagger") {
 			return echo.ErrNotFound
 		}
 
@@ -300,19 +529,55 @@ func (s *Server) healthHandler(c *echo.Context) error {
 	})
 }
 
-// Start starts the web server
+// Start starts the web server on every configured address. It fails when one
+// of them cannot be bound, and otherwise returns once every listener stopped:
+// the first listener failing stops the others.
 func (s *Server) Start() error {
 	protos := new(http.Protocols)
 	protos.SetHTTP1(true)
 	protos.SetUnencryptedHTTP2(true)
 
+	listeners, err := ListenAll(s.config.Addresses)
+	if err != nil {
+		return err
+	}
 	s.httpServer = &http.Server{
-		Addr:      s.config.Address,
 		Handler:   s.echo,
 		Protocols: protos,
 	}
 
-	return s.httpServer.ListenAndServe()
+	// Shutdown closes the listeners, which removes the unix socket files.
+	errs := make(chan error, len(listeners))
+	for i, ln := range listeners {
+		go func() {
+			s.serving[i].Store(true)
+			err := s.httpServer.Serve(ln)
+			s.serving[i].Store(false)
+			errs <- err
+		}()
+	}
+	var first error
+	for range listeners {
+		err := <-errs
+		if first == nil {
+			first = err
+			if !errors.Is(err, http.ErrServerClosed) {
+				_ = s.httpServer.Close()
+			}
+		}
+	}
+	return first
+}
+
+// ListenerCheck returns a readiness check failing while the listener of the
+// i-th configured address does not accept connections.
+func (s *Server) ListenerCheck(i int) func(*http.Request) error {
+	return func(*http.Request) error {
+		if !s.serving[i].Load() {
+			return fmt.Errorf("web server is not serving on %s", s.config.Addresses[i])
+		}
+		return nil
+	}
 }
 
 // Shutdown gracefully shuts down the server
@@ -344,6 +609,16 @@ func (w *statusCaptureWriter) WriteHeader(code int) {
 	w.ResponseWriter.WriteHeader(code)
 }
 
+// Flush lets the Connect server streams flush their messages.
+func (w *statusCaptureWriter) Flush() {
+	_ = http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+// Unwrap exposes the wrapped writer to http.ResponseController.
+func (w *statusCaptureWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
 func (w *statusCaptureWriter) Write(b []byte) (int, error) {
 	if w.code >= http.StatusBadRequest {
 		w.errBody = append(w.errBody, b...)
@@ -452,6 +727,8 @@ func routeHandler(c *echo.Context) string {
 		return "swagger"
 	case strings.HasPrefix(path, "/api/"):
 		return "api"
+	case strings.HasPrefix(path, "/auth/"):
+		return "auth"
 	default:
 		return "static"
 	}
@@ -0,0 +1,183 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package client is a typed Go client for the SRE Portal Connect API. It
+// wraps the generated Connect clients with helpers for the common
+// integrations: listing every FQDN across pages, watching FQDN updates
+// across reconnections and looking up portals.
+//
+//	c := client.New("https://sreportal.example.com", client.WithAPIKey("X-API-Key", key))
+//	fqdns, err := c.ListAllFQDNs(ctx, client.FQDNFilter{Portal: "main"})
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+
+	sreportalv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	"github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1/sreportalv1connect"
+)
+
+// DefaultTimeout is the default timeout of unary requests.
+const DefaultTimeout = 30 * time.Second
+
+// DefaultPageSize is the default number of FQDNs fetched per ListFQDNs page.
+const DefaultPageSize = 500
+
+// DefaultMinBackoff and DefaultMaxBackoff bound the delay between two
+// WatchFQDNs reconnection attempts.
+const (
+	DefaultMinBackoff = 500 * time.Millisecond
+	DefaultMaxBackoff = 30 * time.Second
+)
+
+// API types, aliased so callers can name them without importing the
+// generated code.
+type (
+	FQDN       = sreportalv1.FQDN
+	Portal     = sreportalv1.Portal
+	UpdateType = sreportalv1.UpdateType
+)
+
+// Update types of an FQDNEvent.
+const (
+	UpdateAdded    = sreportalv1.UpdateType_UPDATE_TYPE_ADDED
+	UpdateModified = sreportalv1.UpdateType_UPDATE_TYPE_MODIFIED
+	UpdateDeleted  = sreportalv1.UpdateType_UPDATE_TYPE_DELETED
+)
+
+// Client is a typed client for an SRE Portal instance. It is safe for
+// concurrent use.
+type Client struct {
+	httpClient *http.Client
+	timeout    time.Duration
+	pageSize   int32
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	headers    http.Header
+
+	dns    sreportalv1connect.DNSServiceClient
+	portal sreportalv1connect.PortalServiceClient
+}
+
+// Option is a function that configures the Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client, e.g. for a custom transport. Its
+// Timeout must stay zero: it would cut the WatchFQDNs streams.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithTimeout sets the timeout of unary requests (0 disables it).
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithPageSize sets the number of FQDNs fetched per page by ListAllFQDNs.
+func WithPageSize(size int32) Option {
+	return func(c *Client) {
+		c.pageSize = size
+	}
+}
+
+// WithReconnectBackoff sets the delay before the first WatchFQDNs
+// reconnection attempt, doubled on each failed attempt up to maxDelay.
+func WithReconnectBackoff(minDelay, maxDelay time.Duration) Option {
+	return func(c *Client) {
+		c.minBackoff = minDelay
+		c.maxBackoff = maxDelay
+	}
+}
+
+// WithAPIKey sends key in the headerName header of every request
+// (auth.apiKey of the portal).
+func WithAPIKey(headerName, key string) Option {
+	return func(c *Client) {
+		c.headers.Set(headerName, key)
+	}
+}
+
+// WithBearerToken sends token as a bearer token in the Authorization header
+// of every request (auth.jwt of the portal).
+func WithBearerToken(token string) Option {
+	return func(c *Client) {
+		c.headers.Set("Authorization", "Bearer "+token)
+	}
+}
+
+// New creates a Client for the SRE Portal instance at baseURL.
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		httpClient: http.DefaultClient,
+		timeout:    DefaultTimeout,
+		pageSize:   DefaultPageSize,
+		minBackoff: DefaultMinBackoff,
+		maxBackoff: DefaultMaxBackoff,
+		headers:    http.Header{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	connectOpts := connect.WithInterceptors(headerInterceptor(c.headers))
+	c.dns = sreportalv1connect.NewDNSServiceClient(c.httpClient, baseURL, connectOpts)
+	c.portal = sreportalv1connect.NewPortalServiceClient(c.httpClient, baseURL, connectOpts)
+	return c
+}
+
+// unaryContext bounds a unary request with the configured timeout.
+func (c *Client) unaryContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, c.timeout)
+}
+
+// headerInterceptor adds its headers to every outgoing request.
+type headerInterceptor http.Header
+
+func (h headerInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		h.apply(req.Header())
+		return next(ctx, req)
+	}
+}
+
+func (h headerInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		h.apply(conn.RequestHeader())
+		return conn
+	}
+}
+
+func (h headerInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+func (h headerInterceptor) apply(headers http.Header) {
+	for k, v := range h {
+		headers[k] = v
+	}
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sreportalv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+	"github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1/sreportalv1connect"
+)
+
+// streamSession is the scripted outcome of one StreamFQDNs call.
+type streamSession struct {
+	events []*sreportalv1.StreamFQDNsResponse
+	err    error
+}
+
+// mockDNSServiceHandler pages through fqdns and replays one scripted session
+// per StreamFQDNs call, blocking once they are exhausted.
+type mockDNSServiceHandler struct {
+	sreportalv1connect.UnimplementedDNSServiceHandler
+
+	mu        sync.Mutex
+	fqdns     []*sreportalv1.FQDN
+	sessions  []streamSession
+	listCalls int
+	headers   []http.Header
+}
+
+func (m *mockDNSServiceHandler) setFQDNs(fqdns ...*sreportalv1.FQDN) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.fqdns = fqdns
+}
+
+func (m *mockDNSServiceHandler) ListFQDNs(
+	_ context.Context,
+	req *connect.Request[sreportalv1.ListFQDNsRequest],
+) (*connect.Response[sreportalv1.ListFQDNsResponse], error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listCalls++
+	m.headers = append(m.headers, req.Header().Clone())
+
+	start := 0
+	if req.Msg.PageToken != "" {
+		start, _ = strconv.Atoi(req.Msg.PageToken)
+	}
+	end := min(start+int(req.Msg.PageSize), len(m.fqdns))
+	resp := &sreportalv1.ListFQDNsResponse{Fqdns: m.fqdns[start:end], TotalSize: int32(len(m.fqdns))}
+	if end < len(m.fqdns) {
+		resp.NextPageToken = strconv.Itoa(end)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (m *mockDNSServiceHandler) StreamFQDNs(
+	ctx context.Context,
+	req *connect.Request[sreportalv1.StreamFQDNsRequest],
+	stream *connect.ServerStream[sreportalv1.StreamFQDNsResponse],
+) error {
+	m.mu.Lock()
+	m.headers = append(m.headers, req.Header().Clone())
+	if len(m.sessions) == 0 {
+		m.mu.Unlock()
+		<-ctx.Done()
+		return nil
+	}
+	session := m.sessions[0]
+	m.sessions = m.sessions[1:]
+	m.mu.Unlock()
+
+	for _, ev := range session.events {
+		if err := stream.Send(ev); err != nil {
+			return err
+		}
+	}
+	return session.err
+}
+
+type mockPortalServiceHandler struct {
+	sreportalv1connect.UnimplementedPortalServiceHandler
+	portals []*sreportalv1.Portal
+}
+
+func (m *mockPortalServiceHandler) ListPortals(
+	_ context.Context,
+	_ *connect.Request[sreportalv1.ListPortalsRequest],
+) (*connect.Response[sreportalv1.ListPortalsResponse], error) {
+	return connect.NewResponse(&sreportalv1.ListPortalsResponse{Portals: m.portals}), nil
+}
+
+func newServer(t *testing.T, dns *mockDNSServiceHandler, portal *mockPortalServiceHandler) string {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.Handle(sreportalv1connect.NewDNSServiceHandler(dns))
+	mux.Handle(sreportalv1connect.NewPortalServiceHandler(portal))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func fqdn(name, target string) *sreportalv1.FQDN {
+	return &sreportalv1.FQDN{Name: name, RecordType: "A", Targets: []string{target}}
+}
+
+func event(typ UpdateType, f *sreportalv1.FQDN) *sreportalv1.StreamFQDNsResponse {
+	return &sreportalv1.StreamFQDNsResponse{Type: typ, Fqdn: f}
+}
+
+func TestNew(t *testing.T) {
+	c := New("http://localhost")
+	assert.Equal(t, DefaultTimeout, c.timeout)
+	assert.Equal(t, int32(DefaultPageSize), c.pageSize)
+	assert.Equal(t, DefaultMinBackoff, c.minBackoff)
+	assert.Equal(t, DefaultMaxBackoff, c.maxBackoff)
+
+	c = New("http://localhost", WithPageSize(10), WithReconnectBackoff(time.Second, time.Minute),
+		WithBearerToken("tok"))
+	assert.Equal(t, int32(10), c.pageSize)
+	assert.Equal(t, time.Second, c.minBackoff)
+	assert.Equal(t, time.Minute, c.maxBackoff)
+	assert.Equal(t, "Bearer tok", c.headers.Get("Authorization"))
+}
+
+func TestListAllFQDNs_FollowsPages(t *testing.T) {
+	dns := &mockDNSServiceHandler{}
+	dns.setFQDNs(fqdn("a.example.com", "10.0.0.1"), fqdn("b.example.com", "10.0.0.2"),
+		fqdn("c.example.com", "10.0.0.3"), fqdn("d.example.com", "10.0.0.4"), fqdn("e.example.com", "10.0.0.5"))
+	c := New(newServer(t, dns, &mockPortalServiceHandler{}), WithPageSize(2), WithAPIKey("X-API-Key", "secret"))
+
+	fqdns, err := c.ListAllFQDNs(context.Background(), FQDNFilter{Portal: "main"})
+
+	require.NoError(t, err)
+	require.Len(t, fqdns, 5)
+	assert.Equal(t, "e.example.com", fqdns[4].Name)
+	assert.Equal(t, 3, dns.listCalls)
+	assert.Equal(t, "secret", dns.headers[0].Get("X-API-Key"))
+}
+
+func TestWatchFQDNs_ReconnectsAndReportsMissedChanges(t *testing.T) {
+	a, b, c := fqdn("a.example.com", "10.0.0.1"), fqdn("b.example.com", "10.0.0.2"), fqdn("c.example.com", "10.0.0.3")
+	a2 := fqdn("a.example.com", "10.0.0.9")
+	dns := &mockDNSServiceHandler{sessions: []streamSession{
+		{events: []*sreportalv1.StreamFQDNsResponse{event(UpdateAdded, a), event(UpdateAdded, b)},
+			err: connect.NewError(connect.CodeUnavailable, errors.New("shutting down"))},
+		// The second stream replays the snapshot: only c is new.
+		{events: []*sreportalv1.StreamFQDNsResponse{event(UpdateAdded, a2), event(UpdateAdded, c)}},
+	}}
+	// While disconnected, a changed and b disappeared.
+	dns.setFQDNs(a2)
+	client := New(newServer(t, dns, &mockPortalServiceHandler{}), WithReconnectBackoff(time.Millisecond, 10*time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var events []FQDNEvent
+	err := client.WatchFQDNs(ctx, FQDNFilter{}, func(ev FQDNEvent) error {
+		events = append(events, ev)
+		if len(events) == 5 {
+			cancel()
+		}
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Len(t, events, 5)
+	assert.Equal(t, UpdateAdded, events[0].Type)
+	assert.Equal(t, UpdateAdded, events[1].Type)
+	assert.Equal(t, UpdateModified, events[2].Type)
+	assert.Equal(t, []string{"10.0.0.9"}, events[2].FQDN.Targets)
+	assert.Equal(t, UpdateDeleted, events[3].Type)
+	assert.Equal(t, "b.example.com", events[3].FQDN.Name)
+	assert.Equal(t, UpdateAdded, events[4].Type)
+	assert.Equal(t, "c.example.com", events[4].FQDN.Name)
+}
+
+func TestWatchFQDNs_StopsOnNonRetryableError(t *testing.T) {
+	dns := &mockDNSServiceHandler{sessions: []streamSession{
+		{err: connect.NewError(connect.CodeUnauthenticated, errors.New("missing credentials"))},
+	}}
+	c := New(newServer(t, dns, &mockPortalServiceHandler{}), WithReconnectBackoff(time.Millisecond, time.Millisecond))
+
+	err := c.WatchFQDNs(context.Background(), FQDNFilter{}, func(FQDNEvent) error { return nil })
+
+	require.Error(t, err)
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+}
+
+func TestWatchFQDNs_ReturnsCallbackError(t *testing.T) {
+	dns := &mockDNSServiceHandler{sessions: []streamSession{
+		{events: []*sreportalv1.StreamFQDNsResponse{event(UpdateAdded, fqdn("a.example.com", "10.0.0.1"))}},
+	}}
+	c := New(newServer(t, dns, &mockPortalServiceHandler{}))
+	stop := errors.New("stop")
+
+	err := c.WatchFQDNs(context.Background(), FQDNFilter{}, func(FQDNEvent) error { return stop })
+
+	assert.ErrorIs(t, err, stop)
+}
+
+func TestPortalHelpers(t *testing.T) {
+	portal := &mockPortalServiceHandler{portals: []*sreportalv1.Portal{
+		{Name: "team-a", Title: "Team A"},
+		{Name: "main", Title: "Main", Main: true},
+	}}
+	c := New(newServer(t, &mockDNSServiceHandler{}, portal))
+	ctx := context.Background()
+
+	portals, err := c.ListPortals(ctx)
+	require.NoError(t, err)
+	assert.Len(t, portals, 2)
+
+	p, err := c.GetPortal(ctx, "team-a")
+	require.NoError(t, err)
+	assert.Equal(t, "Team A", p.Title)
+
+	_, err = c.GetPortal(ctx, "unknown")
+	assert.ErrorIs(t, err, ErrPortalNotFound)
+
+	p, err = c.MainPortal(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "main", p.Name)
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+
+	sreportalv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+)
+
+// FQDNFilter restricts the FQDNs listed or watched. Empty fields match
+// everything.
+type FQDNFilter struct {
+	Portal    string
+	Namespace string
+	Source    string
+	// Search matches a substring of the FQDN name.
+	Search string
+	// TargetScope is "public", "private", "cgnat" or "link-local".
+	TargetScope string
+	// IncludeRemoved also returns the tombstones of the FQDNs that
+	// disappeared from their sources.
+	IncludeRemoved bool
+}
+
+// FQDNEvent is a change of an FQDN delivered by WatchFQDNs.
+type FQDNEvent struct {
+	Type UpdateType
+	FQDN *FQDN
+}
+
+// ListAllFQDNs returns every FQDN matching filter, following the ListFQDNs
+// pages until the last one.
+func (c *Client) ListAllFQDNs(ctx context.Context, filter FQDNFilter) ([]*FQDN, error) {
+	var fqdns []*FQDN
+	pageToken := ""
+	for {
+		resp, err := c.listFQDNsPage(ctx, filter, pageToken)
+		if err != nil {
+			return nil, err
+		}
+		fqdns = append(fqdns, resp.Fqdns...)
+		if resp.NextPageToken == "" {
+			return fqdns, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+func (c *Client) listFQDNsPage(ctx context.Context, filter FQDNFilter, pageToken string) (*sreportalv1.ListFQDNsResponse, error) {
+	ctx, cancel := c.unaryContext(ctx)
+	defer cancel()
+
+	resp, err := c.dns.ListFQDNs(ctx, connect.NewRequest(&sreportalv1.ListFQDNsRequest{
+		Namespace:      filter.Namespace,
+		Source:         filter.Source,
+		Search:         filter.Search,
+		Portal:         filter.Portal,
+		PageSize:       c.pageSize,
+		PageToken:      pageToken,
+		TargetScope:    filter.TargetScope,
+		IncludeRemoved: filter.IncludeRemoved,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("list fqdns: %w", err)
+	}
+	return resp.Msg, nil
+}
+
+// WatchFQDNs calls fn for every change of the FQDNs matching filter until
+// ctx is done, starting with an ADDED event for each existing FQDN.
+//
+// A broken stream is reopened with exponential backoff. After a
+// reconnection the FQDNs are listed again so that fn only sees the changes
+// it missed: unchanged FQDNs are not re-announced and the FQDNs removed in
+// the meantime are reported as DELETED.
+//
+// WatchFQDNs returns nil when ctx is done, the error of fn when it fails and
+// the stream error when retrying cannot help (authentication, permission,
+// invalid filter or an API without streaming).
+func (c *Client) WatchFQDNs(ctx context.Context, filter FQDNFilter, fn func(FQDNEvent) error) error {
+	w := &fqdnWatch{fn: fn, known: make(map[string]*FQDN)}
+	backoff := c.minBackoff
+	for attempt := 0; ; attempt++ {
+		var err error
+		if attempt > 0 {
+			err = c.resync(ctx, filter, w)
+		}
+		received := false
+		if err == nil {
+			received, err = c.watchOnce(ctx, filter, w)
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+		var cbErr callbackError
+		if errors.As(err, &cbErr) {
+			return cbErr.err
+		}
+		if !Retryable(err) {
+			return err
+		}
+
+		if received {
+			backoff = c.minBackoff
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, c.maxBackoff)
+	}
+}
+
+// watchOnce relays the events of one StreamFQDNs stream until it ends, and
+// reports whether it received any.
+func (c *Client) watchOnce(ctx context.Context, filter FQDNFilter, w *fqdnWatch) (bool, error) {
+	stream, err := c.dns.StreamFQDNs(ctx, connect.NewRequest(&sreportalv1.StreamFQDNsRequest{
+		Namespace:      filter.Namespace,
+		Portal:         filter.Portal,
+		Source:         filter.Source,
+		Search:         filter.Search,
+		TargetScope:    filter.TargetScope,
+		IncludeRemoved: filter.IncludeRemoved,
+	}))
+	if err != nil {
+		return false, fmt.Errorf("stream fqdns: %w", err)
+	}
+	defer func() { _ = stream.Close() }()
+
+	received := false
+	for stream.Receive() {
+		received = true
+		msg := stream.Msg()
+		if err := w.apply(msg.Type, msg.Fqdn); err != nil {
+			return received, err
+		}
+	}
+	if err := stream.Err(); err != nil {
+		return received, fmt.Errorf("stream fqdns: %w", err)
+	}
+	return received, nil
+}
+
+// resync reconciles the FQDNs known to w with the current ones, reporting
+// what changed while the stream was down.
+func (c *Client) resync(ctx context.Context, filter FQDNFilter, w *fqdnWatch) error {
+	fqdns, err := c.ListAllFQDNs(ctx, filter)
+	if err != nil {
+		return err
+	}
+	current := make(map[string]bool, len(fqdns))
+	for _, f := range fqdns {
+		current[fqdnKey(f)] = true
+		if err := w.apply(UpdateAdded, f); err != nil {
+			return err
+		}
+	}
+	for key, f := range w.known {
+		if !current[key] {
+			if err := w.apply(UpdateDeleted, f); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// fqdnWatch tracks the FQDNs reported to fn so that the snapshot replayed
+// by each new stream only yields actual changes.
+type fqdnWatch struct {
+	fn    func(FQDNEvent) error
+	known map[string]*FQDN
+}
+
+func (w *fqdnWatch) apply(typ UpdateType, f *FQDN) error {
+	if f == nil {
+		return nil
+	}
+	key := fqdnKey(f)
+	prev, exists := w.known[key]
+	switch typ {
+	case UpdateDeleted:
+		if !exists {
+			return nil
+		}
+		delete(w.known, key)
+	default:
+		if exists {
+			if proto.Equal(prev, f) {
+				return nil
+			}
+			typ = UpdateModified
+		} else {
+			typ = UpdateAdded
+		}
+		w.known[key] = f
+	}
+	if err := w.fn(FQDNEvent{Type: typ, FQDN: f}); err != nil {
+		return callbackError{err: err}
+	}
+	return nil
+}
+
+// fqdnKey identifies an FQDN the way StreamFQDNs does.
+func fqdnKey(f *FQDN) string {
+	return f.Name + "/" + f.RecordType
+}
+
+// callbackError carries an error returned by the WatchFQDNs callback, which
+// ends the watch.
+type callbackError struct {
+	err error
+}
+
+func (e callbackError) Error() string { return e.err.Error() }
+
+// Retryable reports whether reopening a stream may succeed after err: errors
+// caused by the credentials or the request itself are final.
+func Retryable(err error) bool {
+	switch connect.CodeOf(err) {
+	case connect.CodeUnauthenticated, connect.CodePermissionDenied,
+		connect.CodeInvalidArgument, connect.CodeUnimplemented:
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	sreportalv1 "github.com/golgoth31/sreportal/internal/grpc/gen/sreportal/v1"
+)
+
+// ErrPortalNotFound is returned when the requested portal does not exist.
+var ErrPortalNotFound = errors.New("portal not found")
+
+// ListPortals returns the portals of every namespace.
+func (c *Client) ListPortals(ctx context.Context) ([]*Portal, error) {
+	ctx, cancel := c.unaryContext(ctx)
+	defer cancel()
+
+	resp, err := c.portal.ListPortals(ctx, connect.NewRequest(&sreportalv1.ListPortalsRequest{}))
+	if err != nil {
+		return nil, fmt.Errorf("list portals: %w", err)
+	}
+	return resp.Msg.Portals, nil
+}
+
+// GetPortal returns the portal named name, or ErrPortalNotFound.
+func (c *Client) GetPortal(ctx context.Context, name string) (*Portal, error) {
+	portals, err := c.ListPortals(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range portals {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrPortalNotFound, name)
+}
+
+// MainPortal returns the main portal, or ErrPortalNotFound.
+func (c *Client) MainPortal(ctx context.Context) (*Portal, error) {
+	portals, err := c.ListPortals(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range portals {
+		if p.Main {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: no main portal", ErrPortalNotFound)
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package inventory is the FQDN inventory model shared by the operator, its
+// API layers and its exporters: the endpoints discovered for each FQDN,
+// their resolution status, the groups they are listed in and the source
+// priority electing the source that owns an FQDN. It depends on the
+// standard library only, so tools outside the operator can use the same
+// merging and grouping rules.
+package inventory
+
+import (
+	"cmp"
+	"time"
+)
+
+// ResolutionStatus is the outcome of the DNS check of an FQDN.
+type ResolutionStatus string
+
+const (
+	// ResolutionUnknown means the FQDN was not checked.
+	ResolutionUnknown ResolutionStatus = ""
+	// ResolutionSync means the FQDN resolves to its expected targets.
+	ResolutionSync ResolutionStatus = "sync"
+	// ResolutionNotAvailable means the FQDN does not exist in DNS.
+	ResolutionNotAvailable ResolutionStatus = "notavailable"
+	// ResolutionNotSync means the FQDN resolves to other targets.
+	ResolutionNotSync ResolutionStatus = "notsync"
+)
+
+// Valid reports whether s is one of the known resolution statuses.
+func (s ResolutionStatus) Valid() bool {
+	switch s {
+	case ResolutionUnknown, ResolutionSync, ResolutionNotAvailable, ResolutionNotSync:
+		return true
+	}
+	return false
+}
+
+// Endpoint is an FQDN record of the inventory.
+type Endpoint struct {
+	Name        string
+	RecordType  string
+	Description string
+	// Source is the source type that discovered the endpoint (ingress,
+	// service, ...).
+	Source  string
+	Targets []string
+	Groups  []string
+	Status  ResolutionStatus
+	// LastSeen is when a source last reported the endpoint.
+	LastSeen time.Time
+}
+
+// Key identifies the endpoint: its FQDN and record type.
+func (e Endpoint) Key() string {
+	return e.Name + "/" + e.RecordType
+}
+
+// Compare orders endpoints by FQDN, then by record type.
+func Compare(a, b Endpoint) int {
+	return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.RecordType, b.RecordType))
+}
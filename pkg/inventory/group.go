@@ -0,0 +1,59 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inventory
+
+import (
+	"slices"
+	"strings"
+)
+
+// Group is a named group of the inventory and its members.
+type Group[T any] struct {
+	Name    string
+	Members []T
+}
+
+// EffectiveGroups returns groups, or defaultGroup alone when groups is
+// empty. An empty defaultGroup leaves ungrouped members out.
+func EffectiveGroups(groups []string, defaultGroup string) []string {
+	if len(groups) > 0 || defaultGroup == "" {
+		return groups
+	}
+	return []string{defaultGroup}
+}
+
+// GroupBy buckets items by the groups groupsOf returns for them, items
+// without group going to defaultGroup (see EffectiveGroups). An item of
+// several groups is listed in each of them. Groups are sorted by name and
+// keep their members in the order of items.
+func GroupBy[T any](items []T, groupsOf func(T) []string, defaultGroup string) []Group[T] {
+	index := make(map[string]int)
+	var groups []Group[T]
+	for _, item := range items {
+		for _, name := range EffectiveGroups(groupsOf(item), defaultGroup) {
+			i, ok := index[name]
+			if !ok {
+				i = len(groups)
+				index[name] = i
+				groups = append(groups, Group[T]{Name: name})
+			}
+			groups[i].Members = append(groups[i].Members, item)
+		}
+	}
+	slices.SortFunc(groups, func(a, b Group[T]) int { return strings.Compare(a.Name, b.Name) })
+	return groups
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inventory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	tSrcIngress = "ingress"
+	tSrcService = "service"
+	tAppFQDN    = "app.example.com"
+	tAPIFQDN    = "api.example.com"
+)
+
+func TestMerge(t *testing.T) {
+	merged := Merge([]Endpoint{
+		{Name: tAppFQDN, RecordType: "A", Description: "first", Targets: []string{"10.0.0.2"}, Groups: []string{"web"}},
+		{Name: tAPIFQDN, RecordType: "A"},
+		{Name: tAppFQDN, RecordType: "A", Description: "second", Targets: []string{"10.0.0.1", "10.0.0.2"}, Groups: []string{"apps"}},
+		{Name: tAppFQDN, RecordType: "AAAA", Targets: []string{"::1"}},
+	})
+
+	assert.Equal(t, []Endpoint{
+		{Name: tAPIFQDN, RecordType: "A"},
+		{Name: tAppFQDN, RecordType: "A", Description: "first", Targets: []string{"10.0.0.1", "10.0.0.2"}, Groups: []string{"apps", "web"}},
+		{Name: tAppFQDN, RecordType: "AAAA", Targets: []string{"::1"}},
+	}, merged)
+}
+
+func TestUnion(t *testing.T) {
+	a := []string{"b", "a"}
+	assert.Equal(t, []string{"a", "b", "c"}, Union(a, []string{"c", "a"}))
+	assert.Equal(t, []string{"b", "a"}, a, "inputs are not modified")
+	assert.Nil(t, Union(nil, []string{}))
+}
+
+func TestGroupBy(t *testing.T) {
+	endpoints := []Endpoint{
+		{Name: tAppFQDN, Groups: []string{"web", "apps"}},
+		{Name: tAPIFQDN},
+		{Name: "db.example.com", Groups: []string{"apps"}},
+	}
+	groupsOf := func(e Endpoint) []string { return e.Groups }
+
+	t.Run("default group", func(t *testing.T) {
+		groups := GroupBy(endpoints, groupsOf, "other")
+
+		names := make(map[string][]string)
+		order := make([]string, 0, len(groups))
+		for _, g := range groups {
+			order = append(order, g.Name)
+			for _, m := range g.Members {
+				names[g.Name] = append(names[g.Name], m.Name)
+			}
+		}
+		assert.Equal(t, []string{"apps", "other", "web"}, order)
+		assert.Equal(t, []string{tAppFQDN, "db.example.com"}, names["apps"])
+		assert.Equal(t, []string{tAPIFQDN}, names["other"])
+		assert.Equal(t, []string{tAppFQDN}, names["web"])
+	})
+
+	t.Run("ungrouped members left out", func(t *testing.T) {
+		groups := GroupBy(endpoints, groupsOf, "")
+
+		assert.Len(t, groups, 2)
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		assert.Empty(t, GroupBy(nil, groupsOf, "other"))
+	})
+}
+
+func TestElectSources(t *testing.T) {
+	names := map[string][]string{
+		tSrcService: {tAppFQDN, tAPIFQDN},
+		tSrcIngress: {tAppFQDN},
+		"gateway":   {tAPIFQDN, "db.example.com"},
+	}
+
+	t.Run("listed source wins", func(t *testing.T) {
+		winners := ElectSources(names, []string{tSrcIngress, tSrcService})
+
+		assert.Equal(t, map[string]string{
+			tAppFQDN:         tSrcIngress,
+			tAPIFQDN:         tSrcService,
+			"db.example.com": "gateway",
+		}, winners)
+	})
+
+	t.Run("equal rank is alphabetical", func(t *testing.T) {
+		winners := ElectSources(names, nil)
+
+		assert.Equal(t, "gateway", winners[tAPIFQDN])
+		assert.Equal(t, tSrcIngress, winners[tAppFQDN])
+	})
+}
+
+func TestSelectByPriority(t *testing.T) {
+	selected := SelectByPriority(map[string][]Endpoint{
+		tSrcService: {
+			{Name: tAppFQDN, RecordType: "A", Source: tSrcService, Targets: []string{"10.0.0.1"}},
+			{Name: tAPIFQDN, RecordType: "A", Source: tSrcService, Targets: []string{"10.0.0.3"}},
+		},
+		tSrcIngress: {
+			{Name: tAppFQDN, RecordType: "CNAME", Source: tSrcIngress, Targets: []string{"lb.example.com"}},
+			{Name: tAppFQDN, RecordType: "CNAME", Source: tSrcIngress, Targets: []string{"lb2.example.com"}},
+		},
+	}, []string{tSrcIngress, tSrcService})
+
+	assert.Equal(t, []Endpoint{
+		{Name: tAPIFQDN, RecordType: "A", Source: tSrcService, Targets: []string{"10.0.0.3"}},
+		{Name: tAppFQDN, RecordType: "CNAME", Source: tSrcIngress, Targets: []string{"lb.example.com", "lb2.example.com"}},
+	}, selected)
+}
+
+func TestResolutionStatusValid(t *testing.T) {
+	for _, s := range []ResolutionStatus{ResolutionUnknown, ResolutionSync, ResolutionNotAvailable, ResolutionNotSync} {
+		assert.True(t, s.Valid(), s)
+	}
+	assert.False(t, ResolutionStatus("pending").Valid())
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inventory
+
+import (
+	"slices"
+)
+
+// Merge deduplicates endpoints by Key. The groups and targets of an
+// endpoint listed several times are merged; its other fields come from its
+// first occurrence. The result is sorted with Compare, and the groups and
+// targets of each endpoint are sorted.
+func Merge(endpoints []Endpoint) []Endpoint {
+	index := make(map[string]int, len(endpoints))
+	merged := make([]Endpoint, 0, len(endpoints))
+	for _, e := range endpoints {
+		i, ok := index[e.Key()]
+		if !ok {
+			index[e.Key()] = len(merged)
+			e.Groups = Union(nil, e.Groups)
+			e.Targets = Union(nil, e.Targets)
+			merged = append(merged, e)
+			continue
+		}
+		merged[i].Groups = Union(merged[i].Groups, e.Groups)
+		merged[i].Targets = Union(merged[i].Targets, e.Targets)
+	}
+	slices.SortFunc(merged, Compare)
+	return merged
+}
+
+// Union returns the sorted, deduplicated values of a and b, nil when both
+// are empty. It never aliases a or b.
+func Union(a, b []string) []string {
+	if len(a)+len(b) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(a)+len(b))
+	out = append(out, a...)
+	out = append(out, b...)
+	slices.Sort(out)
+	return slices.Compact(out)
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inventory
+
+import (
+	"slices"
+)
+
+// ElectSources returns the source owning each FQDN name, given the names
+// each source discovered. The source ranked first in priority wins; sources
+// missing from priority rank after every listed one, and sources of equal
+// rank are ordered alphabetically.
+func ElectSources(namesBySource map[string][]string, priority []string) map[string]string {
+	rankOf := make(map[string]int, len(priority))
+	for i, src := range priority {
+		rankOf[src] = i
+	}
+	rank := func(src string) int {
+		if r, ok := rankOf[src]; ok {
+			return r
+		}
+		return len(priority)
+	}
+
+	sources := make([]string, 0, len(namesBySource))
+	for src := range namesBySource {
+		sources = append(sources, src)
+	}
+	slices.Sort(sources)
+
+	winners := make(map[string]string)
+	for _, src := range sources {
+		for _, name := range namesBySource[src] {
+			if current, ok := winners[name]; !ok || rank(src) < rank(current) {
+				winners[name] = src
+			}
+		}
+	}
+	return winners
+}
+
+// SelectByPriority keeps, for each FQDN name, the endpoints of the source
+// elected by ElectSources: every record type of the winning source is kept
+// and every record of the other sources is dropped, so a lower-priority
+// source cannot leak a record type for an FQDN a higher-priority source
+// owns. Duplicates within the winning source are merged (see Merge).
+func SelectByPriority(bySource map[string][]Endpoint, priority []string) []Endpoint {
+	names := make(map[string][]string, len(bySource))
+	for src, endpoints := range bySource {
+		for _, e := range endpoints {
+			names[src] = append(names[src], e.Name)
+		}
+	}
+	winners := ElectSources(names, priority)
+
+	var kept []Endpoint
+	for src, endpoints := range bySource {
+		for _, e := range endpoints {
+			if winners[e.Name] == src {
+				kept = append(kept, e)
+			}
+		}
+	}
+	return Merge(kept)
+}
@@ -1,9 +1,10 @@
-import { ActivityIcon, AlertTriangleIcon, BarChart3Icon, ContainerIcon, LinkIcon, RocketIcon, ShieldIcon } from "lucide-react";
+import { ActivityIcon, AlertTriangleIcon, BarChart3Icon, ContainerIcon, ExternalLinkIcon, FileTextIcon, LinkIcon, RocketIcon, ShieldIcon } from "lucide-react";
 import { NavLink } from "react-router";
 
 import { Badge } from "@/components/ui/badge";
 
 import type { Portal } from "@/features/portal/domain/portal.types";
+import { usePortalContent } from "@/features/portal/hooks/usePortalContent";
 import { cn } from "@/lib/utils";
 
 interface PortalSidebarProps {
@@ -27,6 +28,10 @@ export function PortalSidebar({
   const showAlerts = currentPortal?.features.alerts === true;
   const showStatusPage = currentPortal?.features.statusPage !== false;
   const showImageInventory = currentPortal?.features.imageInventory === true;
+  const { blocks } = usePortalContent(currentPortal?.name);
+  const showDocs = blocks.length > 0;
+  const links = currentPortal?.links ?? [];
+  const branding = currentPortal?.branding;
 
   const linkClass = ({ isActive }: { isActive: boolean }) =>
     cn(
@@ -42,7 +47,17 @@ export function PortalSidebar({
       className="w-48 shrink-0 border-r border-border/60 bg-sidebar/40 flex flex-col py-4 overflow-y-auto"
       aria-label="Portal menu"
     >
-      <div className="px-3 pb-3 mb-2 border-b border-border/60">
+      <div
+        className="px-3 pb-3 mb-2 border-b border-border/60"
+        style={branding?.color ? { borderBottomColor: branding.color } : undefined}
+      >
+        {branding?.logoUrl && (
+          <img
+            src={branding.logoUrl}
+            alt={`${currentPortal?.title ?? portalName} logo`}
+            className="mb-3 max-h-8 max-w-full object-contain"
+          />
+        )}
         <p className="text-[10px] font-mono uppercase tracking-[0.16em] text-muted-foreground">
           Resources
         </p>
@@ -85,7 +100,36 @@ export function PortalSidebar({
             <span>Images</span>
           </NavLink>
         )}
+        {showDocs && (
+          <NavLink to={`${basePath}/docs`} className={linkClass}>
+            <FileTextIcon className="size-4 shrink-0" aria-hidden="true" />
+            <span>Docs</span>
+          </NavLink>
+        )}
       </nav>
+      {links.length > 0 && (
+        <>
+          <div className="px-3 pt-3 mt-2 mb-2 border-t border-border/60">
+            <p className="text-[10px] font-mono uppercase tracking-[0.16em] text-muted-foreground">
+              Team links
+            </p>
+          </div>
+          <nav className="flex flex-col gap-0.5 px-2" aria-label="Team links">
+            {links.map((link) => (
+              <a
+                key={link.url}
+                href={link.url}
+                target="_blank"
+                rel="noopener noreferrer"
+                className={linkClass({ isActive: false })}
+              >
+                <ExternalLinkIcon className="size-4 shrink-0" aria-hidden="true" />
+                <span className="truncate">{link.title}</span>
+              </a>
+            ))}
+          </nav>
+        </>
+      )}
       <div className="mt-auto px-3 pt-3 mb-2 border-t border-border/60">
         <p className="text-[10px] font-mono uppercase tracking-[0.16em] text-muted-foreground">
           System
@@ -6,6 +6,7 @@ import { TooltipProvider } from "@/components/ui/tooltip";
 import { hasRemoteSyncError } from "@/features/portal/domain/portal.types";
 import { usePortals } from "@/features/portal/hooks/usePortals";
 import { RemoteSyncStaleBanner } from "@/features/portal/ui/RemoteSyncStaleBanner";
+import { SessionButton } from "@/features/session/ui/SessionButton";
 import { useVersion } from "@/features/version/hooks/useVersion";
 import { cn } from "@/lib/utils";
 import { PortalNav } from "./PortalNav";
@@ -60,6 +61,7 @@ export function RootLayout() {
               >
                 <HelpCircleIcon className="size-4" />
               </NavLink>
+              <SessionButton />
               <ThemeToggle />
             </div>
           </div>
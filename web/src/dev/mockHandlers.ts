@@ -10,6 +10,8 @@ import {
   ListFQDNsResponseSchema,
 } from "@/gen/sreportal/v1/dns_pb";
 import {
+  ContentBlockSchema,
+  GetPortalContentResponseSchema,
   ListPortalsResponseSchema,
   PortalSchema,
 } from "@/gen/sreportal/v1/portal_pb";
@@ -63,6 +65,11 @@ const PORTALS = [
     namespace: "platform",
     ready: true,
     features: { dns: true, networkPolicy: true, statusPage: true, alerts: true, imageInventory: true, releases: true },
+    links: [
+      { title: "Runbooks", url: "https://runbooks.example.com/platform" },
+      { title: "On-call", url: "https://oncall.example.com/platform" },
+    ],
+    branding: { color: "#2563eb" },
   }),
   create(PortalSchema, {
     name: "prod-eu",
@@ -263,6 +270,21 @@ export const devHandlers = [
   http.post(re("ListPortals"), () =>
     frame(ListPortalsResponseSchema, create(ListPortalsResponseSchema, { portals: PORTALS })),
   ),
+  http.post(re("GetPortalContent"), () =>
+    frame(
+      GetPortalContentResponseSchema,
+      create(GetPortalContentResponseSchema, {
+        blocks: [
+          create(ContentBlockSchema, {
+            configMap: "portal-docs",
+            key: "onboarding.md",
+            markdown:
+              "# Onboarding\n\nAsk for access in `#platform`, then read the [runbooks](https://runbooks.example.com).\n\n- Register your services\n- Subscribe to **alerts**",
+          }),
+        ],
+      }),
+    ),
+  ),
   http.post(re("ListFQDNs"), () =>
     frame(
       ListFQDNsResponseSchema,
@@ -0,0 +1,32 @@
+import { describe, expect, it } from "vitest";
+
+import type { Capabilities } from "@/features/capabilities/domain/capabilities.types";
+import { isAnalyticsEnabled, searchTermToReport } from "./analytics.types";
+
+describe("isAnalyticsEnabled", () => {
+  const withAnalytics = (enabled: boolean): Capabilities => ({
+    sources: {},
+    features: [{ name: "analytics", enabled, config: {} }],
+  });
+
+  it("returns the reported state", () => {
+    expect(isAnalyticsEnabled(withAnalytics(true))).toBe(true);
+    expect(isAnalyticsEnabled(withAnalytics(false))).toBe(false);
+  });
+
+  it("treats unknown capabilities as disabled", () => {
+    expect(isAnalyticsEnabled(null)).toBe(false);
+    expect(isAnalyticsEnabled({ sources: {}, features: [] })).toBe(false);
+  });
+});
+
+describe("searchTermToReport", () => {
+  it("trims and lowercases the term", () => {
+    expect(searchTermToReport("  API.Example.com ")).toBe("api.example.com");
+  });
+
+  it("skips short terms", () => {
+    expect(searchTermToReport("ap")).toBeNull();
+    expect(searchTermToReport("   ")).toBeNull();
+  });
+});
@@ -0,0 +1,33 @@
+import type { Capabilities } from "@/features/capabilities/domain/capabilities.types";
+
+/** Kinds of usage events counted by the opt-in analytics. */
+export type UsageEventType = "pageView" | "search" | "groupClick";
+
+export interface UsageEvent {
+  readonly portal: string;
+  readonly type: UsageEventType;
+  /** Search term of a search, group name of a group click. */
+  readonly value?: string;
+}
+
+/** Search terms shorter than this are not reported. */
+export const MIN_SEARCH_LENGTH = 3;
+
+/**
+ * Reports whether usage events may be sent. Unlike the other features,
+ * analytics stay off until the backend reports them enabled, so nothing is
+ * sent to a backend that did not opt in.
+ */
+export function isAnalyticsEnabled(capabilities: Capabilities | null): boolean {
+  const feature = capabilities?.features.find((f) => f.name === "analytics");
+  return feature?.enabled ?? false;
+}
+
+/**
+ * Returns the search term to report, or null when it is too short to be
+ * meaningful.
+ */
+export function searchTermToReport(term: string): string | null {
+  const trimmed = term.trim().toLowerCase();
+  return trimmed.length >= MIN_SEARCH_LENGTH ? trimmed : null;
+}
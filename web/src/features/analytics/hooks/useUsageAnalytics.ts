@@ -0,0 +1,59 @@
+import { useCallback, useEffect } from "react";
+import { useParams } from "react-router";
+
+import { useCapabilities } from "@/features/capabilities/hooks/useCapabilities";
+import {
+  isAnalyticsEnabled,
+  searchTermToReport,
+  type UsageEventType,
+} from "../domain/analytics.types";
+import { sendUsageBeacon } from "../infrastructure/analyticsApi";
+
+/** Delay after the last keystroke before a search term is reported. */
+const SEARCH_SETTLE_MS = 1500;
+
+/**
+ * Returns a recorder for the usage events of the current portal. It does
+ * nothing unless the backend reports analytics enabled.
+ */
+export function useUsageAnalytics() {
+  const { portalName = "main" } = useParams<{ portalName: string }>();
+  const { capabilities } = useCapabilities();
+  const enabled = isAnalyticsEnabled(capabilities);
+
+  const record = useCallback(
+    (type: UsageEventType, value?: string) => {
+      if (enabled) {
+        sendUsageBeacon({ portal: portalName, type, value });
+      }
+    },
+    [enabled, portalName]
+  );
+
+  return { enabled, portalName, record };
+}
+
+/** Records a page view of the current portal. */
+export function usePageViewBeacon() {
+  const { enabled, portalName, record } = useUsageAnalytics();
+
+  useEffect(() => {
+    if (enabled) {
+      record("pageView");
+    }
+  }, [enabled, portalName, record]);
+}
+
+/** Records searchTerm once the user stopped typing. */
+export function useSearchBeacon(searchTerm: string) {
+  const { enabled, record } = useUsageAnalytics();
+
+  useEffect(() => {
+    const term = enabled ? searchTermToReport(searchTerm) : null;
+    if (term === null) {
+      return;
+    }
+    const timer = setTimeout(() => record("search", term), SEARCH_SETTLE_MS);
+    return () => clearTimeout(timer);
+  }, [enabled, searchTerm, record]);
+}
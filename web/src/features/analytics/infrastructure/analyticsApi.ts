@@ -0,0 +1,40 @@
+import { create, toJsonString } from "@bufbuild/protobuf";
+
+import {
+  RecordUsageRequestSchema,
+  UsageEventType as ProtoUsageEventType,
+} from "@/gen/sreportal/v1/analytics_pb";
+import type { UsageEvent, UsageEventType } from "../domain/analytics.types";
+
+const RECORD_USAGE_URL = `${window.location.origin}/sreportal.v1.AnalyticsService/RecordUsage`;
+
+const eventTypes: Record<UsageEventType, ProtoUsageEventType> = {
+  pageView: ProtoUsageEventType.PAGE_VIEW,
+  search: ProtoUsageEventType.SEARCH,
+  groupClick: ProtoUsageEventType.GROUP_CLICK,
+};
+
+/**
+ * Sends a usage event as a Connect JSON beacon. Beacons are fire-and-forget:
+ * they do not delay navigation and their failures are ignored.
+ */
+export function sendUsageBeacon(event: UsageEvent): void {
+  const body = toJsonString(
+    RecordUsageRequestSchema,
+    create(RecordUsageRequestSchema, {
+      portal: event.portal,
+      type: eventTypes[event.type],
+      value: event.value ?? "",
+    })
+  );
+  const blob = new Blob([body], { type: "application/json" });
+  if (navigator.sendBeacon?.(RECORD_USAGE_URL, blob)) {
+    return;
+  }
+  void fetch(RECORD_USAGE_URL, {
+    method: "POST",
+    body,
+    headers: { "Content-Type": "application/json" },
+    keepalive: true,
+  }).catch(() => undefined);
+}
@@ -0,0 +1,23 @@
+import { describe, expect, it } from "vitest";
+
+import { isFeatureEnabled, type Capabilities } from "./capabilities.types";
+
+describe("isFeatureEnabled", () => {
+  const capabilities: Capabilities = {
+    sources: { service: true },
+    features: [
+      { name: "probing", enabled: false, config: {} },
+      { name: "dnsCheck", enabled: true, config: { resolver: "system" } },
+    ],
+  };
+
+  it("returns the reported state", () => {
+    expect(isFeatureEnabled(capabilities, "probing")).toBe(false);
+    expect(isFeatureEnabled(capabilities, "dnsCheck")).toBe(true);
+  });
+
+  it("treats unknown features as enabled", () => {
+    expect(isFeatureEnabled(capabilities, "auth")).toBe(true);
+    expect(isFeatureEnabled(null, "probing")).toBe(true);
+  });
+});
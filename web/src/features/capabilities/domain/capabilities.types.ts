@@ -0,0 +1,31 @@
+/** Optional features reported by GetCapabilities. */
+export type FeatureName =
+  | "probing"
+  | "certificates"
+  | "dnsCheck"
+  | "auth"
+  | "analytics";
+
+export interface FeatureCapability {
+  readonly name: string;
+  readonly enabled: boolean;
+  readonly config: Readonly<Record<string, string>>;
+}
+
+export interface Capabilities {
+  /** Source key (e.g. "service", "gatewayHTTPRoute") to enabled. */
+  readonly sources: Readonly<Record<string, boolean>>;
+  readonly features: readonly FeatureCapability[];
+}
+
+/**
+ * Reports whether a feature is active. Unknown capabilities (not loaded yet,
+ * or an older backend) count as enabled so views are not hidden by mistake.
+ */
+export function isFeatureEnabled(
+  capabilities: Capabilities | null,
+  name: FeatureName
+): boolean {
+  const feature = capabilities?.features.find((f) => f.name === name);
+  return feature?.enabled ?? true;
+}
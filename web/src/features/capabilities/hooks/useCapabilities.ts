@@ -0,0 +1,20 @@
+import { useQuery } from "@tanstack/react-query";
+
+import { isFeatureEnabled } from "../domain/capabilities.types";
+import type { FeatureName } from "../domain/capabilities.types";
+import { getCapabilities } from "../infrastructure/capabilitiesApi";
+
+export function useCapabilities() {
+  const query = useQuery({
+    queryKey: ["capabilities"],
+    queryFn: getCapabilities,
+    staleTime: Infinity,
+  });
+
+  const capabilities = query.data ?? null;
+  return {
+    capabilities,
+    isFeatureEnabled: (name: FeatureName) => isFeatureEnabled(capabilities, name),
+    isLoading: query.isLoading,
+  };
+}
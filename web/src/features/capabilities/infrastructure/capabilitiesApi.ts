@@ -0,0 +1,25 @@
+import { create } from "@bufbuild/protobuf";
+import { createClient } from "@connectrpc/connect";
+import { createGrpcWebTransport } from "@connectrpc/connect-web";
+
+import {
+  CapabilitiesService,
+  GetCapabilitiesRequestSchema,
+} from "@/gen/sreportal/v1/capabilities_pb";
+import type { Capabilities } from "../domain/capabilities.types";
+
+const transport = createGrpcWebTransport({ baseUrl: window.location.origin });
+const client = createClient(CapabilitiesService, transport);
+
+export async function getCapabilities(): Promise<Capabilities> {
+  const request = create(GetCapabilitiesRequestSchema, {});
+  const response = await client.getCapabilities(request);
+  return {
+    sources: Object.fromEntries(response.sources.map((s) => [s.name, s.enabled])),
+    features: response.features.map((f) => ({
+      name: f.name,
+      enabled: f.enabled,
+      config: { ...f.config },
+    })),
+  };
+}
@@ -1,12 +1,17 @@
 import { describe, expect, it } from "vitest";
 
 import {
+  arrangeGroupsByTree,
   extractGroupNames,
   filterFqdns,
+  distinctProviders,
+  formatPort,
+  formatTargetProvider,
   groupFqdnsByGroup,
   hasSyncStatus,
   isSynced,
   type Fqdn,
+  type GroupNode,
 } from "./dns.types";
 
 function fqdn(overrides: Partial<Fqdn> & Pick<Fqdn, "name">): Fqdn {
@@ -17,11 +22,18 @@ function fqdn(overrides: Partial<Fqdn> & Pick<Fqdn, "name">): Fqdn {
     description: overrides.description ?? "",
     recordType: overrides.recordType ?? "A",
     targets: overrides.targets ?? [],
+    targetProviders: overrides.targetProviders ?? [],
     dnsResourceName: overrides.dnsResourceName ?? "dns",
     dnsResourceNamespace: overrides.dnsResourceNamespace ?? "default",
     originRef: overrides.originRef,
     syncStatus: overrides.syncStatus ?? "",
     portals: overrides.portals ?? [],
+    ports: overrides.ports ?? [],
+    paths: overrides.paths ?? [],
+    annotations: overrides.annotations ?? {},
+    id: overrides.id ?? "",
+    sourceType: overrides.sourceType ?? "",
+    overallStatus: overrides.overallStatus ?? "unknown",
   };
 }
 
@@ -34,6 +46,34 @@ describe("isSynced", () => {
   });
 });
 
+describe("formatPort", () => {
+  it("renders the port name before the number", () => {
+    expect(formatPort({ name: "https", port: 443, protocol: "TCP" })).toBe("https 443");
+  });
+
+  it("falls back to number/protocol for unnamed ports", () => {
+    expect(formatPort({ name: "", port: 53, protocol: "UDP" })).toBe("53/UDP");
+    expect(formatPort({ name: "", port: 8080, protocol: "" })).toBe("8080");
+  });
+});
+
+describe("target providers", () => {
+  const providers = [
+    { target: "52.0.0.1", provider: "aws", asn: 16509 },
+    { target: "104.16.0.1", provider: "cloudflare", asn: 13335 },
+    { target: "52.0.0.2", provider: "aws", asn: 16509 },
+  ];
+
+  it("renders the target, provider and ASN", () => {
+    expect(formatTargetProvider(providers[0])).toBe("52.0.0.1: aws (AS16509)");
+  });
+
+  it("lists each provider once, in order", () => {
+    expect(distinctProviders(providers)).toEqual(["aws", "cloudflare"]);
+    expect(distinctProviders([])).toEqual([]);
+  });
+});
+
 describe("hasSyncStatus", () => {
   it("returns false for empty string and true otherwise", () => {
     expect(hasSyncStatus("")).toBe(false);
@@ -110,3 +150,55 @@ describe("groupFqdnsByGroup", () => {
     expect(groups[0]?.fqdns).toHaveLength(1);
   });
 });
+
+describe("arrangeGroupsByTree", () => {
+  const tree: GroupNode[] = [
+    { name: "Apps", path: "Apps", fqdnCount: 1, children: [] },
+    {
+      name: "Platform",
+      path: "Platform",
+      fqdnCount: 2,
+      children: [
+        { name: "Networking", path: "Platform/Networking", fqdnCount: 2, children: [] },
+      ],
+    },
+  ];
+
+  it("nests groups along the hierarchy and counts distinct FQDNs per level", () => {
+    const groups = groupFqdnsByGroup(
+      [
+        fqdn({ name: "lb.example.com", groups: ["Platform/Networking"] }),
+        fqdn({ name: "dns.example.com", groups: ["Platform", "Platform/Networking"] }),
+        fqdn({ name: "shop.example.com", groups: ["Apps"] }),
+      ],
+      ""
+    );
+
+    const { nodes, rest } = arrangeGroupsByTree(groups, tree);
+
+    expect(rest).toEqual([]);
+    expect(nodes.map((n) => n.path)).toEqual(["Apps", "Platform"]);
+    const platform = nodes[1];
+    expect(platform.fqdnCount).toBe(2);
+    expect(platform.group?.fqdns.map((f) => f.name)).toEqual(["dns.example.com"]);
+    expect(platform.children.map((c) => [c.path, c.fqdnCount])).toEqual([
+      ["Platform/Networking", 2],
+    ]);
+  });
+
+  it("drops empty levels and keeps unknown groups flat", () => {
+    const groups = groupFqdnsByGroup(
+      [
+        fqdn({ name: "lb.example.com", groups: ["Platform/Networking"] }),
+        fqdn({ name: "odd.example.com", groups: ["Platform / Odd"] }),
+      ],
+      ""
+    );
+
+    const { nodes, rest } = arrangeGroupsByTree(groups, tree);
+
+    expect(nodes.map((n) => n.path)).toEqual(["Platform"]);
+    expect(nodes[0].group).toBeUndefined();
+    expect(rest.map((g) => g.name)).toEqual(["Platform / Odd"]);
+  });
+});
@@ -4,8 +4,37 @@ export interface OriginRef {
   readonly name: string;
 }
 
+export interface DnsRecordRef {
+  readonly namespace: string;
+  readonly name: string;
+}
+
 export type SyncStatus = "sync" | "notavailable" | "notsync" | "";
 
+/**
+ * Health badge computed server-side from sync status and probe outcome.
+ * "removed" marks the tombstone of an FQDN that disappeared from its sources.
+ */
+export type OverallStatus =
+  | "healthy"
+  | "warning"
+  | "critical"
+  | "unknown"
+  | "removed";
+
+export interface ServicePort {
+  readonly name: string;
+  readonly port: number;
+  readonly protocol: string;
+}
+
+/** Cloud or hosting provider whose address ranges contain a public target. */
+export interface TargetProvider {
+  readonly target: string;
+  readonly provider: string;
+  readonly asn: number;
+}
+
 export interface Fqdn {
   readonly name: string;
   readonly source: string;
@@ -13,11 +42,71 @@ export interface Fqdn {
   readonly description: string;
   readonly recordType: string;
   readonly targets: readonly string[];
+  /** Provider hints of the public targets; empty unless dnsRecord.targetProviders is enabled. */
+  readonly targetProviders: readonly TargetProvider[];
   readonly dnsResourceName: string;
   readonly dnsResourceNamespace: string;
   readonly originRef?: OriginRef;
   readonly syncStatus: SyncStatus;
   readonly portals: readonly string[];
+  readonly ports: readonly ServicePort[];
+  readonly paths: readonly string[];
+  readonly annotations: Readonly<Record<string, string>>;
+  readonly id: string;
+  readonly sourceType: string;
+  readonly dnsRecordRef?: DnsRecordRef;
+  /** Manual DNSRecord whose entry duplicates this discovered FQDN and is ignored. */
+  readonly shadowedManual?: DnsRecordRef;
+  /** Target sets the FQDN alternates between when several external-dns deployments own it. */
+  readonly ownershipConflict?: readonly (readonly string[])[];
+  readonly overallStatus: OverallStatus;
+  /** Why the FQDN does not resolve, from its origin Service or Ingress; notavailable FQDNs only. */
+  readonly originCause?: string;
+  /** ISO time the FQDN disappeared from its sources; tombstones only. */
+  readonly removedAt?: string;
+  /** Why the FQDN disappeared; tombstones only. */
+  readonly removalReason?: RemovalReason;
+}
+
+/** Why a tombstone disappeared from its sources. */
+export type RemovalReason = "record_deleted" | "no_longer_reported";
+
+/** Renders a removal reason for display. */
+export function formatRemovalReason(reason: RemovalReason): string {
+  return reason === "record_deleted" ? "its DNSRecord was deleted" : "no longer reported by its source";
+}
+
+/** Renders a Service port for display: "https 443", or "53/UDP" when unnamed. */
+export function formatPort(p: ServicePort): string {
+  if (p.name) return `${p.name} ${p.port}`;
+  if (p.protocol) return `${p.port}/${p.protocol}`;
+  return String(p.port);
+}
+
+/** Renders a provider hint for display: "52.0.0.1: aws (AS16509)". */
+export function formatTargetProvider(p: TargetProvider): string {
+  return `${p.target}: ${p.provider} (AS${p.asn})`;
+}
+
+/** Distinct providers of the targets, in order of first appearance. */
+export function distinctProviders(providers: readonly TargetProvider[]): string[] {
+  return [...new Set(providers.map((p) => p.provider))];
+}
+
+/** Tooltip describing an overall status badge. */
+export function overallStatusLabel(status: OverallStatus): string {
+  switch (status) {
+    case "healthy":
+      return "Healthy";
+    case "warning":
+      return "Served, but not as expected";
+    case "critical":
+      return "Not served";
+    case "removed":
+      return "Removed from its source";
+    default:
+      return "No health information";
+  }
 }
 
 /** Returns true only when DNS resolution is confirmed in sync. */
@@ -36,6 +125,71 @@ export interface FqdnGroup {
   readonly fqdns: readonly Fqdn[];
 }
 
+/** A level of the group hierarchy, as served by the API. */
+export interface GroupNode {
+  readonly name: string;
+  readonly path: string;
+  readonly fqdnCount: number;
+  readonly children: readonly GroupNode[];
+}
+
+/** The FQDNs of a portal together with their group hierarchy. */
+export interface FqdnListing {
+  readonly fqdns: readonly Fqdn[];
+  readonly groupTree: readonly GroupNode[];
+}
+
+/** A level of the group hierarchy holding the FQDN groups to display. */
+export interface FqdnGroupTreeNode {
+  readonly name: string;
+  readonly path: string;
+  /** Distinct FQDNs in this group or any of its descendants. */
+  readonly fqdnCount: number;
+  /** FQDNs listed directly in this group, if any. */
+  readonly group?: FqdnGroup;
+  readonly children: readonly FqdnGroupTreeNode[];
+}
+
+/** FQDN groups arranged along the group hierarchy. */
+export interface FqdnGroupTree {
+  readonly nodes: readonly FqdnGroupTreeNode[];
+  /** Groups the hierarchy does not list, displayed flat. */
+  readonly rest: readonly FqdnGroup[];
+}
+
+/**
+ * Arrange FQDN groups along the group hierarchy. Levels without any group
+ * to display are dropped; groups the hierarchy does not list end up in
+ * `rest`.
+ */
+export function arrangeGroupsByTree(
+  groups: readonly FqdnGroup[],
+  tree: readonly GroupNode[]
+): FqdnGroupTree {
+  const byPath = new Map(groups.map((g) => [g.name, g]));
+  const placed = new Set<string>();
+
+  const arrange = (nodes: readonly GroupNode[]): FqdnGroupTreeNode[] =>
+    nodes.flatMap((node) => {
+      const children = arrange(node.children);
+      const group = byPath.get(node.path);
+      if (!group && children.length === 0) return [];
+      if (group) placed.add(node.path);
+
+      const names = new Set(group?.fqdns.map((f) => f.name));
+      const collect = (n: FqdnGroupTreeNode) => {
+        n.group?.fqdns.forEach((f) => names.add(f.name));
+        n.children.forEach(collect);
+      };
+      children.forEach(collect);
+
+      return [{ name: node.name, path: node.path, fqdnCount: names.size, group, children }];
+    });
+
+  const nodes = arrange(tree);
+  return { nodes, rest: groups.filter((g) => !placed.has(g.name)) };
+}
+
 /** Extract unique group names from a list of FQDNs, sorted alphabetically. */
 export function extractGroupNames(fqdns: readonly Fqdn[]): string[] {
   return [...new Set(fqdns.flatMap((f) => [...f.groups]))].sort();
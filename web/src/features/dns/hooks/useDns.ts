@@ -1,18 +1,20 @@
 import { useCallback, useMemo, useState } from "react";
 
 import {
+  arrangeGroupsByTree,
   extractGroupNames,
   filterFqdns,
   groupFqdnsByGroup,
 } from "../domain/dns.types";
 import { useDnsQuery } from "./useDnsQuery";
 
-export function useDns(portal: string) {
-  const [searchTerm, setSearchTerm] = useState("");
+export function useDns(portal: string, initialSearchTerm = "") {
+  const [searchTerm, setSearchTerm] = useState(initialSearchTerm);
   const [groupFilter, setGroupFilter] = useState("");
+  const [showRemoved, setShowRemoved] = useState(false);
 
-  const { fqdns, isLoading, isFetching, error, refetch } =
-    useDnsQuery(portal);
+  const { fqdns, groupTree, isLoading, isFetching, error, refetch } =
+    useDnsQuery(portal, showRemoved);
 
   const filtered = useMemo(
     () => filterFqdns(fqdns, searchTerm, groupFilter),
@@ -24,17 +26,25 @@ export function useDns(portal: string) {
     [filtered, groupFilter]
   );
 
+  const groupedByTree = useMemo(
+    () => arrangeGroupsByTree(groupedByGroup, groupTree),
+    [groupedByGroup, groupTree]
+  );
+
   const groups = useMemo(() => extractGroupNames(fqdns), [fqdns]);
 
   const clearFilters = useCallback(() => {
     setSearchTerm("");
     setGroupFilter("");
+    setShowRemoved(false);
   }, []);
 
   return {
     fqdns,
     filtered,
     groupedByGroup,
+    groupedByTree,
+    groupTree,
     groups,
     totalCount: fqdns.length,
     filteredCount: filtered.length,
@@ -43,8 +53,10 @@ export function useDns(portal: string) {
     error,
     searchTerm,
     groupFilter,
+    showRemoved,
     setSearchTerm,
     setGroupFilter,
+    setShowRemoved,
     clearFilters,
     refetch,
   };
@@ -1,18 +1,20 @@
 import { useQuery } from "@tanstack/react-query";
 
 import { listFqdns } from "../infrastructure/dnsApi";
-import type { Fqdn } from "../domain/dns.types";
+import type { Fqdn, GroupNode } from "../domain/dns.types";
 
 const EMPTY_FQDNS: Fqdn[] = [];
+const EMPTY_GROUP_TREE: GroupNode[] = [];
 
-export function useDnsQuery(portal: string) {
+export function useDnsQuery(portal: string, includeRemoved = false) {
   const query = useQuery({
-    queryKey: ["fqdns", portal],
-    queryFn: () => listFqdns(portal),
+    queryKey: ["fqdns", portal, includeRemoved],
+    queryFn: () => listFqdns(portal, includeRemoved),
   });
 
   return {
-    fqdns: query.data ?? EMPTY_FQDNS,
+    fqdns: query.data?.fqdns ?? EMPTY_FQDNS,
+    groupTree: query.data?.groupTree ?? EMPTY_GROUP_TREE,
     isLoading: query.isLoading,
     isFetching: query.isFetching,
     error: query.error,
@@ -1,7 +1,15 @@
+import { create } from "@bufbuild/protobuf";
+import { timestampFromDate } from "@bufbuild/protobuf/wkt";
 import { http } from "msw";
 import { describe, expect, it } from "vitest";
 
 import { listFqdns } from "./dnsApi";
+import {
+  DNSRecordRefSchema,
+  GroupSchema,
+  OverallStatus,
+  ServicePortSchema,
+} from "@/gen/sreportal/v1/dns_pb";
 import {
   listFqdnsResponseJson,
   sampleFqdn,
@@ -26,13 +34,27 @@ describe("listFqdns", () => {
               dnsResourceNamespace: "kube-system",
               syncStatus: "sync",
               portals: ["main", "staging"],
+              ports: [create(ServicePortSchema, { name: "https", port: 443, protocol: "TCP" })],
+              paths: ["/", "/api"],
+              annotations: { "example.com/owner": "team-a" },
+              id: "fqdn-0123456789abcdef01234567",
+              sourceType: "service",
+              dnsRecordRef: create(DNSRecordRefSchema, { namespace: "kube-system", name: "dns-1-service" }),
+              overallStatus: OverallStatus.WARNING,
+            }),
+          ], [
+            create(GroupSchema, {
+              name: "Platform",
+              path: "Platform",
+              fqdnCount: 1,
+              children: [create(GroupSchema, { name: "Networking", path: "Platform/Networking", fqdnCount: 1 })],
             }),
           ]),
         ),
       ),
     );
 
-    const rows = await listFqdns("main");
+    const { fqdns: rows, groupTree } = await listFqdns("main");
 
     expect(rows).toHaveLength(1);
     expect(rows[0]).toMatchObject({
@@ -46,9 +68,71 @@ describe("listFqdns", () => {
       dnsResourceNamespace: "kube-system",
       syncStatus: "sync",
       portals: ["main", "staging"],
+      ports: [{ name: "https", port: 443, protocol: "TCP" }],
+      paths: ["/", "/api"],
+      annotations: { "example.com/owner": "team-a" },
+      id: "fqdn-0123456789abcdef01234567",
+      sourceType: "service",
+      dnsRecordRef: { namespace: "kube-system", name: "dns-1-service" },
+      overallStatus: "warning",
+    });
+    expect(groupTree).toEqual([
+      {
+        name: "Platform",
+        path: "Platform",
+        fqdnCount: 1,
+        children: [{ name: "Networking", path: "Platform/Networking", fqdnCount: 1, children: [] }],
+      },
+    ]);
+  });
+
+  it("maps tombstones to the removed status with their removal time", async () => {
+    server.use(
+      http.post(listFqdnsPath, () =>
+        grpcWebResponse(
+          listFqdnsResponseJson([
+            sampleFqdn({
+              name: "gone.example.com",
+              overallStatus: OverallStatus.REMOVED,
+              removedAt: timestampFromDate(new Date("2026-01-02T03:04:05Z")),
+              removalReason: "record_deleted",
+            }),
+          ]),
+        ),
+      ),
+    );
+
+    const { fqdns: rows } = await listFqdns("main", true);
+
+    expect(rows[0]).toMatchObject({
+      overallStatus: "removed",
+      removedAt: "2026-01-02T03:04:05.000Z",
+      removalReason: "record_deleted",
     });
   });
 
+  it("maps the origin cause of unresolved FQDNs", async () => {
+    server.use(
+      http.post(listFqdnsPath, () =>
+        grpcWebResponse(
+          listFqdnsResponseJson([
+            sampleFqdn({
+              name: "web.example.com",
+              syncStatus: "notavailable",
+              originCause: "ingress has no load balancer address",
+            }),
+            sampleFqdn({ name: "ok.example.com" }),
+          ]),
+        ),
+      ),
+    );
+
+    const { fqdns: rows } = await listFqdns("main");
+
+    expect(rows[0].originCause).toBe("ingress has no load balancer address");
+    expect(rows[1].originCause).toBeUndefined();
+  });
+
   it("sends portal name in the ListFQDNs request", async () => {
     let receivedRequest = false;
     server.use(
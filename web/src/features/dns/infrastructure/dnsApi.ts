@@ -5,10 +5,20 @@ import { createGrpcWebTransport } from "@connectrpc/connect-web";
 import {
   DNSService,
   type FQDN,
+  type Group,
   ListFQDNsRequestSchema,
+  OverallStatus as ProtoOverallStatus,
   type OriginResourceRef,
 } from "@/gen/sreportal/v1/dns_pb";
-import type { Fqdn, OriginRef, SyncStatus } from "../domain/dns.types";
+import type {
+  Fqdn,
+  FqdnListing,
+  GroupNode,
+  OriginRef,
+  OverallStatus,
+  RemovalReason,
+  SyncStatus,
+} from "../domain/dns.types";
 
 const transport = createGrpcWebTransport({ baseUrl: window.location.origin });
 const client = createClient(DNSService, transport);
@@ -17,6 +27,29 @@ function toDomainOriginRef(ref: OriginResourceRef): OriginRef {
   return { kind: ref.kind, namespace: ref.namespace, name: ref.name };
 }
 
+function timestampToIso(
+  ts: { seconds?: bigint; nanos?: number } | undefined,
+): string | undefined {
+  if (ts == null || ts.seconds == null) return undefined;
+  const ms = Number(ts.seconds) * 1000 + (ts.nanos ?? 0) / 1e6;
+  return new Date(ms).toISOString();
+}
+
+function toDomainOverallStatus(s: ProtoOverallStatus): OverallStatus {
+  switch (s) {
+    case ProtoOverallStatus.HEALTHY:
+      return "healthy";
+    case ProtoOverallStatus.WARNING:
+      return "warning";
+    case ProtoOverallStatus.CRITICAL:
+      return "critical";
+    case ProtoOverallStatus.REMOVED:
+      return "removed";
+    default:
+      return "unknown";
+  }
+}
+
 function toDomainFqdn(f: FQDN): Fqdn {
   return {
     name: f.name,
@@ -25,21 +58,54 @@ function toDomainFqdn(f: FQDN): Fqdn {
     description: f.description,
     recordType: f.recordType,
     targets: [...f.targets],
+    targetProviders: f.targetProviders.map((p) => ({ target: p.target, provider: p.provider, asn: p.asn })),
     dnsResourceName: f.dnsResourceName,
     dnsResourceNamespace: f.dnsResourceNamespace,
     originRef: f.originRef ? toDomainOriginRef(f.originRef) : undefined,
     syncStatus: f.syncStatus as SyncStatus,
     portals: [...f.portals],
+    ports: f.ports.map((p) => ({ name: p.name, port: p.port, protocol: p.protocol })),
+    paths: [...f.paths],
+    annotations: { ...f.annotations },
+    id: f.id,
+    sourceType: f.sourceType,
+    dnsRecordRef: f.dnsRecordRef
+      ? { namespace: f.dnsRecordRef.namespace, name: f.dnsRecordRef.name }
+      : undefined,
+    shadowedManual: f.shadowedManual
+      ? { namespace: f.shadowedManual.namespace, name: f.shadowedManual.name }
+      : undefined,
+    ownershipConflict: f.ownershipConflict?.targetSets.map((s) => [...s.targets]),
+    overallStatus: toDomainOverallStatus(f.overallStatus),
+    originCause: f.originCause || undefined,
+    removedAt: timestampToIso(f.removedAt),
+    removalReason: (f.removalReason || undefined) as RemovalReason | undefined,
+  };
+}
+
+function toDomainGroupNode(g: Group): GroupNode {
+  return {
+    name: g.name,
+    path: g.path,
+    fqdnCount: g.fqdnCount,
+    children: g.children.map(toDomainGroupNode),
   };
 }
 
-export async function listFqdns(portal: string): Promise<Fqdn[]> {
+export async function listFqdns(
+  portal: string,
+  includeRemoved = false,
+): Promise<FqdnListing> {
   const request = create(ListFQDNsRequestSchema, {
     portal,
     namespace: "",
     source: "",
     search: "",
+    includeRemoved,
   });
   const response = await client.listFQDNs(request);
-  return response.fqdns.map(toDomainFqdn);
+  return {
+    fqdns: response.fqdns.map(toDomainFqdn),
+    groupTree: response.groups.map(toDomainGroupNode),
+  };
 }
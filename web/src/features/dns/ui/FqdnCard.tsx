@@ -1,4 +1,4 @@
-import { CheckIcon, CopyIcon, NetworkIcon, ServerIcon } from "lucide-react";
+import { CheckIcon, CloudIcon, CopyIcon, FileTextIcon, NetworkIcon, RepeatIcon, RouteIcon, ServerIcon, TriangleAlertIcon } from "lucide-react";
 
 import { Badge } from "@/components/ui/badge";
 import { Button } from "@/components/ui/button";
@@ -7,9 +7,16 @@ import {
   TooltipContent,
   TooltipTrigger,
 } from "@/components/ui/tooltip";
+import { useCapabilities } from "@/features/capabilities/hooks/useCapabilities";
 import { useCopyToClipboard } from "@/hooks/useCopyToClipboard";
 import { cn } from "@/lib/utils";
-import { hasSyncStatus, isSynced } from "../domain/dns.types";
+import {
+  distinctProviders,
+  formatPort,
+  formatRemovalReason,
+  formatTargetProvider,
+  overallStatusLabel,
+} from "../domain/dns.types";
 import type { Fqdn } from "../domain/dns.types";
 
 interface FqdnCardProps {
@@ -20,32 +27,35 @@ export function FqdnCard({ fqdn }: FqdnCardProps) {
   const { copied, copy } = useCopyToClipboard(fqdn.name);
 
   const sourceLabel = fqdn.source === "manual" ? "Manual" : "External DNS";
-  const synced = isSynced(fqdn.syncStatus);
-  const syncTooltip = synced
-    ? "DNS in sync"
-    : fqdn.syncStatus === "notavailable"
-      ? "DNS resolution not available"
-      : "DNS not in sync";
+  const statusTooltip = overallStatusLabel(fqdn.overallStatus);
+  // Without DNS checks nor probes the badge carries no health information.
+  const { isFeatureEnabled } = useCapabilities();
+  const showStatus = isFeatureEnabled("dnsCheck") || isFeatureEnabled("probing");
 
   return (
     <div className="group rounded-lg border border-border/70 bg-card/60 backdrop-blur-sm p-4 flex flex-col gap-3 transition-all hover:border-primary/40 hover:bg-card hover:shadow-md hover:shadow-primary/5">
-      {/* FQDN name + sync dot + copy */}
+      {/* FQDN name + status dot + copy */}
       <div className="flex items-start justify-between gap-2">
         <div className="flex items-center gap-2 min-w-0">
-          {hasSyncStatus(fqdn.syncStatus) && (
+          {showStatus && fqdn.overallStatus !== "unknown" && (
             <Tooltip>
               <TooltipTrigger asChild>
                 <span
-                  aria-label={syncTooltip}
+                  aria-label={statusTooltip}
                   className={cn(
                     "size-2 rounded-full shrink-0 inline-block",
-                    synced
-                      ? "bg-emerald-500 shadow-[0_0_6px_oklch(0.7_0.18_152/0.6)]"
-                      : "bg-rose-500 shadow-[0_0_6px_oklch(0.65_0.22_22/0.7)]"
+                    fqdn.overallStatus === "healthy" &&
+                      "bg-emerald-500 shadow-[0_0_6px_oklch(0.7_0.18_152/0.6)]",
+                    fqdn.overallStatus === "warning" &&
+                      "bg-amber-500 shadow-[0_0_6px_oklch(0.8_0.16_80/0.6)]",
+                    fqdn.overallStatus === "critical" &&
+                      "bg-rose-500 shadow-[0_0_6px_oklch(0.65_0.22_22/0.7)]",
+                    fqdn.overallStatus === "removed" &&
+                      "bg-muted-foreground/50"
                   )}
                 />
               </TooltipTrigger>
-              <TooltipContent>{syncTooltip}</TooltipContent>
+              <TooltipContent>{statusTooltip}</TooltipContent>
             </Tooltip>
           )}
           <a
@@ -77,6 +87,22 @@ export function FqdnCard({ fqdn }: FqdnCardProps) {
         </Tooltip>
       </div>
 
+      {/* Tombstone */}
+      {fqdn.removedAt && (
+        <p className="text-muted-foreground text-xs">
+          Removed {new Date(fqdn.removedAt).toLocaleString()}
+          {fqdn.removalReason && `: ${formatRemovalReason(fqdn.removalReason)}`}
+        </p>
+      )}
+
+      {/* Cause reported by the origin resource of an unresolved FQDN */}
+      {fqdn.originCause && (
+        <div className="flex items-center gap-1.5 text-xs text-rose-700 dark:text-rose-400">
+          <TriangleAlertIcon className="size-3.5 shrink-0" />
+          <span className="text-[11px]">{fqdn.originCause}</span>
+        </div>
+      )}
+
       {/* Description */}
       {fqdn.description && (
         <p className="text-muted-foreground text-xs leading-relaxed">{fqdn.description}</p>
@@ -113,6 +139,28 @@ export function FqdnCard({ fqdn }: FqdnCardProps) {
             </TooltipContent>
           </Tooltip>
         )}
+        {/* Cloud/hosting providers of the public targets */}
+        {fqdn.targetProviders.length > 0 && (
+          <Tooltip>
+            <TooltipTrigger asChild>
+              <button
+                type="button"
+                className="inline-flex items-center gap-1 rounded-md border border-transparent px-1.5 py-0.5 text-xs text-muted-foreground transition-colors hover:border-border hover:bg-muted/60 hover:text-foreground focus-visible:outline-none focus-visible:ring-2 focus-visible:ring-ring"
+                aria-label={`Target providers: ${distinctProviders(fqdn.targetProviders).join(", ")}`}
+              >
+                <CloudIcon className="size-3 shrink-0" />
+                <span className="font-mono">{distinctProviders(fqdn.targetProviders).join(", ")}</span>
+              </button>
+            </TooltipTrigger>
+            <TooltipContent side="top" align="start">
+              <div className="flex flex-col gap-1">
+                {fqdn.targetProviders.map((p) => (
+                  <span key={p.target} className="font-mono text-[11px]">{formatTargetProvider(p)}</span>
+                ))}
+              </div>
+            </TooltipContent>
+          </Tooltip>
+        )}
         <Badge
           variant="secondary"
           className={cn(
@@ -132,6 +180,56 @@ export function FqdnCard({ fqdn }: FqdnCardProps) {
           <span className="font-mono text-[11px]">
             {fqdn.originRef.kind}/{fqdn.originRef.namespace}/{fqdn.originRef.name}
           </span>
+          {fqdn.ports.length > 0 && (
+            <span className="font-mono text-[11px]">
+              · {fqdn.ports.map(formatPort).join(", ")}
+            </span>
+          )}
+        </div>
+      )}
+
+      {/* DNSRecord the FQDN was taken from, after priority resolution */}
+      {fqdn.dnsRecordRef && (
+        <div className="flex items-center gap-1.5 text-xs text-muted-foreground">
+          <FileTextIcon className="size-3.5 shrink-0" />
+          <span className="font-mono text-[11px]">
+            dnsrecord/{fqdn.dnsRecordRef.namespace}/{fqdn.dnsRecordRef.name}
+          </span>
+          {fqdn.sourceType && (
+            <span className="font-mono text-[11px]">· {fqdn.sourceType}</span>
+          )}
+        </div>
+      )}
+
+      {/* Manual entry overridden by the discovered record */}
+      {fqdn.shadowedManual && (
+        <div className="flex items-center gap-1.5 text-xs text-muted-foreground">
+          <FileTextIcon className="size-3.5 shrink-0" />
+          <span className="font-mono text-[11px]">
+            shadows manual dnsrecord/{fqdn.shadowedManual.namespace}/{fqdn.shadowedManual.name}
+          </span>
+        </div>
+      )}
+
+      {/* Targets flapping between external-dns deployments */}
+      {fqdn.ownershipConflict && (
+        <div className="flex items-center gap-1.5 text-xs text-amber-700 dark:text-amber-400">
+          <RepeatIcon className="size-3.5 shrink-0" />
+          <span className="font-mono text-[11px]">
+            targets flap: {fqdn.ownershipConflict.map((set) => set.join(", ")).join(" ⇄ ")}
+          </span>
+        </div>
+      )}
+
+      {/* HTTP route paths served under the hostname */}
+      {fqdn.paths.length > 0 && (
+        <div className="flex flex-wrap items-center gap-1 text-xs text-muted-foreground">
+          <RouteIcon className="size-3.5 shrink-0" />
+          {fqdn.paths.map((path) => (
+            <span key={path} className="font-mono text-[11px] rounded bg-muted/60 px-1">
+              {path}
+            </span>
+          ))}
         </div>
       )}
     </div>
@@ -7,6 +7,7 @@ import {
   CollapsibleContent,
   CollapsibleTrigger,
 } from "@/components/ui/collapsible";
+import { useUsageAnalytics } from "@/features/analytics/hooks/useUsageAnalytics";
 import { cn } from "@/lib/utils";
 import type { FqdnGroup } from "../domain/dns.types";
 import { FqdnCard } from "./FqdnCard";
@@ -17,12 +18,18 @@ interface FqdnGroupCardProps {
 
 export function FqdnGroupCard({ group }: FqdnGroupCardProps) {
   const [open, setOpen] = useState(true);
+  const { record } = useUsageAnalytics();
+
+  const handleOpenChange = (next: boolean) => {
+    setOpen(next);
+    record("groupClick", group.name);
+  };
 
   const isManual = group.source === "manual";
   const SourceIcon = isManual ? PencilIcon : DatabaseIcon;
 
   return (
-    <Collapsible open={open} onOpenChange={setOpen} className="w-full">
+    <Collapsible open={open} onOpenChange={handleOpenChange} className="w-full">
       <div className="rounded-lg border border-border/70 bg-card/40 backdrop-blur-sm overflow-hidden">
         {/* Header */}
         <CollapsibleTrigger asChild>
@@ -2,11 +2,14 @@ import { SearchXIcon } from "lucide-react";
 
 import { Button } from "@/components/ui/button";
 import { Skeleton } from "@/components/ui/skeleton";
-import type { FqdnGroup } from "../domain/dns.types";
+import type { FqdnGroup, FqdnGroupTree } from "../domain/dns.types";
 import { FqdnGroupCard } from "./FqdnGroupCard";
+import { FqdnGroupTreeSection } from "./FqdnGroupTreeSection";
 
 interface FqdnGroupListProps {
   groups: FqdnGroup[];
+  /** When set and nested, groups are rendered as collapsible trees. */
+  tree?: FqdnGroupTree;
   isLoading: boolean;
   hasFilters: boolean;
   onClearFilters: () => void;
@@ -14,6 +17,7 @@ interface FqdnGroupListProps {
 
 export function FqdnGroupList({
   groups,
+  tree,
   isLoading,
   hasFilters,
   onClearFilters,
@@ -42,6 +46,19 @@ export function FqdnGroupList({
     );
   }
 
+  if (tree && tree.nodes.some((n) => n.children.length > 0)) {
+    return (
+      <div className="space-y-3">
+        {tree.nodes.map((node) => (
+          <FqdnGroupTreeSection key={node.path} node={node} />
+        ))}
+        {tree.rest.map((group) => (
+          <FqdnGroupCard key={group.name} group={group} />
+        ))}
+      </div>
+    );
+  }
+
   return (
     <div className="space-y-3">
       {groups.map((group) => (
@@ -0,0 +1,64 @@
+import { ChevronDownIcon, FolderTreeIcon } from "lucide-react";
+import { useState } from "react";
+
+import { Button } from "@/components/ui/button";
+import {
+  Collapsible,
+  CollapsibleContent,
+  CollapsibleTrigger,
+} from "@/components/ui/collapsible";
+import { cn } from "@/lib/utils";
+import type { FqdnGroupTreeNode } from "../domain/dns.types";
+import { FqdnGroupCard } from "./FqdnGroupCard";
+
+interface FqdnGroupTreeSectionProps {
+  node: FqdnGroupTreeNode;
+}
+
+/**
+ * Renders a level of the group hierarchy: the FQDNs listed directly in the
+ * group, then its nested groups. A leaf level renders as a plain group card.
+ */
+export function FqdnGroupTreeSection({ node }: FqdnGroupTreeSectionProps) {
+  const [open, setOpen] = useState(true);
+
+  if (node.children.length === 0 && node.group) {
+    return <FqdnGroupCard group={{ ...node.group, name: node.name }} />;
+  }
+
+  return (
+    <Collapsible open={open} onOpenChange={setOpen} className="w-full">
+      <CollapsibleTrigger asChild>
+        <Button
+          variant="ghost"
+          className="w-full flex items-center justify-between px-2 py-2 h-auto hover:bg-muted/40"
+        >
+          <div className="flex items-center gap-3">
+            <FolderTreeIcon className="size-4 text-primary/70 shrink-0" />
+            <span className="font-mono text-sm font-semibold text-foreground tracking-tight">
+              {node.name}
+            </span>
+            <span className="text-muted-foreground text-[11px] font-mono uppercase tracking-wider px-2 py-0.5 rounded-full bg-muted/60">
+              {node.fqdnCount} {node.fqdnCount === 1 ? "entry" : "entries"}
+            </span>
+          </div>
+          <ChevronDownIcon
+            className={cn(
+              "size-4 text-muted-foreground transition-transform duration-200",
+              open && "rotate-180"
+            )}
+          />
+        </Button>
+      </CollapsibleTrigger>
+
+      <CollapsibleContent>
+        <div className="mt-2 ml-3 pl-3 border-l border-border/60 space-y-3">
+          {node.group && <FqdnGroupCard group={node.group} />}
+          {node.children.map((child) => (
+            <FqdnGroupTreeSection key={child.path} node={child} />
+          ))}
+        </div>
+      </CollapsibleContent>
+    </Collapsible>
+  );
+}
@@ -40,6 +40,12 @@ const MCP_DNS_TOOLS: McpTool[] = [
       "Get detailed information about a specific FQDN. Returns the full DNS record details including targets, record type, and metadata.",
     filters: ["fqdn"],
   },
+  {
+    name: "get_capabilities",
+    description:
+      "List the discovery sources enabled on this SRE Portal and the optional features (probing, certificates, dnsCheck, auth) with a summary of their configuration.",
+    filters: [],
+  },
 ];
 
 const MCP_ALERTS_TOOLS: McpTool[] = [
@@ -15,6 +15,7 @@ function portal(p: Partial<Portal> & Pick<Portal, "name" | "title">): Portal {
     url: "",
     isRemote: false,
     remoteSync: undefined,
+    links: [],
     features: {
       dns: true,
       releases: true,
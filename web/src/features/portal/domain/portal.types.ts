@@ -14,6 +14,16 @@ export interface PortalFeatures {
   readonly imageInventory: boolean;
 }
 
+export interface PortalLink {
+  readonly title: string;
+  readonly url: string;
+}
+
+export interface PortalBranding {
+  readonly logoUrl: string;
+  readonly color: string;
+}
+
 export interface Portal {
   readonly name: string;
   readonly title: string;
@@ -25,6 +35,8 @@ export interface Portal {
   readonly isRemote: boolean;
   readonly remoteSync?: RemoteSyncStatus;
   readonly features: PortalFeatures;
+  readonly links: readonly PortalLink[];
+  readonly branding?: PortalBranding;
 }
 
 /** True when the controller reported a non-empty last sync error (stale remote data). */
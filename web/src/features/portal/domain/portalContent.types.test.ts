@@ -0,0 +1,44 @@
+import { describe, expect, it } from "vitest";
+
+import { parseInlines, parseMarkdown } from "./portalContent.types";
+
+describe("parseMarkdown", () => {
+  it("parses headings, paragraphs, lists and code", () => {
+    const nodes = parseMarkdown(
+      "# Welcome\n\nRead the\nrunbook.\n\n- first\n- second\n\n```\nkubectl get portals\n```",
+    );
+    expect(nodes).toEqual([
+      { kind: "heading", level: 1, inlines: [{ kind: "text", text: "Welcome" }] },
+      { kind: "paragraph", inlines: [{ kind: "text", text: "Read the runbook." }] },
+      {
+        kind: "list",
+        items: [[{ kind: "text", text: "first" }], [{ kind: "text", text: "second" }]],
+      },
+      { kind: "code", text: "kubectl get portals" },
+    ]);
+  });
+
+  it("returns nothing for an empty document", () => {
+    expect(parseMarkdown("")).toEqual([]);
+  });
+});
+
+describe("parseInlines", () => {
+  it("parses code, bold and links", () => {
+    expect(parseInlines("run `make` **now**, see [docs](https://example.com)")).toEqual([
+      { kind: "text", text: "run " },
+      { kind: "code", text: "make" },
+      { kind: "text", text: " " },
+      { kind: "strong", text: "now" },
+      { kind: "text", text: ", see " },
+      { kind: "link", text: "docs", url: "https://example.com" },
+    ]);
+  });
+
+  it("keeps links with an unsafe scheme as text", () => {
+    expect(parseInlines("[click](javascript:alert(1))")).toEqual([
+      { kind: "text", text: "click" },
+      { kind: "text", text: ")" },
+    ]);
+  });
+});
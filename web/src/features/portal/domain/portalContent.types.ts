@@ -0,0 +1,100 @@
+/** A markdown document read from a key of a ConfigMap of spec.contentRefs. */
+export interface ContentBlock {
+  readonly configMap: string;
+  readonly key: string;
+  readonly markdown: string;
+}
+
+export type MarkdownInline =
+  | { readonly kind: "text"; readonly text: string }
+  | { readonly kind: "code"; readonly text: string }
+  | { readonly kind: "strong"; readonly text: string }
+  | { readonly kind: "link"; readonly text: string; readonly url: string };
+
+export type MarkdownNode =
+  | { readonly kind: "heading"; readonly level: 1 | 2 | 3; readonly inlines: MarkdownInline[] }
+  | { readonly kind: "paragraph"; readonly inlines: MarkdownInline[] }
+  | { readonly kind: "list"; readonly items: MarkdownInline[][] }
+  | { readonly kind: "code"; readonly text: string };
+
+const INLINE_RE = /`([^`]+)`|\*\*([^*]+)\*\*|\[([^\]]+)\]\(([^)\s]+)\)/g;
+
+/** Only http(s) and relative links are rendered as anchors. */
+function isSafeUrl(url: string): boolean {
+  return /^(https?:\/\/|\/|#)/.test(url);
+}
+
+export function parseInlines(text: string): MarkdownInline[] {
+  const inlines: MarkdownInline[] = [];
+  let last = 0;
+  for (const m of text.matchAll(INLINE_RE)) {
+    const start = m.index ?? 0;
+    if (start > last) inlines.push({ kind: "text", text: text.slice(last, start) });
+    if (m[1] != null) inlines.push({ kind: "code", text: m[1] });
+    else if (m[2] != null) inlines.push({ kind: "strong", text: m[2] });
+    else if (m[4] != null && isSafeUrl(m[4]))
+      inlines.push({ kind: "link", text: m[3], url: m[4] });
+    else inlines.push({ kind: "text", text: m[3] });
+    last = start + m[0].length;
+  }
+  if (last < text.length) inlines.push({ kind: "text", text: text.slice(last) });
+  return inlines;
+}
+
+/**
+ * Parses the subset of markdown used by portal content blocks: headings
+ * (#, ##, ###), bullet lists, fenced code, paragraphs, and inline code,
+ * bold and links. Anything else is kept as paragraph text.
+ */
+export function parseMarkdown(markdown: string): MarkdownNode[] {
+  const nodes: MarkdownNode[] = [];
+  const lines = markdown.replace(/\r\n/g, "\n").split("\n");
+  let paragraph: string[] = [];
+  let list: MarkdownInline[][] = [];
+
+  const flush = () => {
+    if (paragraph.length > 0) {
+      nodes.push({ kind: "paragraph", inlines: parseInlines(paragraph.join(" ")) });
+      paragraph = [];
+    }
+    if (list.length > 0) {
+      nodes.push({ kind: "list", items: list });
+      list = [];
+    }
+  };
+
+  for (let i = 0; i < lines.length; i++) {
+    const line = lines[i];
+    if (line.startsWith("```")) {
+      flush();
+      const code: string[] = [];
+      for (i++; i < lines.length && !lines[i].startsWith("```"); i++) code.push(lines[i]);
+      nodes.push({ kind: "code", text: code.join("\n") });
+      continue;
+    }
+    const heading = /^(#{1,3})\s+(.*)$/.exec(line);
+    if (heading) {
+      flush();
+      nodes.push({
+        kind: "heading",
+        level: heading[1].length as 1 | 2 | 3,
+        inlines: parseInlines(heading[2].trim()),
+      });
+      continue;
+    }
+    const item = /^\s*[-*]\s+(.*)$/.exec(line);
+    if (item) {
+      if (paragraph.length > 0) flush();
+      list.push(parseInlines(item[1].trim()));
+      continue;
+    }
+    if (line.trim() === "") {
+      flush();
+      continue;
+    }
+    if (list.length > 0) flush();
+    paragraph.push(line.trim());
+  }
+  flush();
+  return nodes;
+}
@@ -0,0 +1,21 @@
+import { useQuery } from "@tanstack/react-query";
+
+import { getPortalContent } from "../infrastructure/portalApi";
+
+/** Loads the markdown content blocks of a portal (metadata.name). */
+export function usePortalContent(portal: string | undefined) {
+  const query = useQuery({
+    queryKey: ["portal-content", portal],
+    queryFn: () => getPortalContent(portal ?? ""),
+    enabled: Boolean(portal),
+    staleTime: 30_000,
+  });
+
+  return {
+    blocks: query.data ?? [],
+    isLoading: query.isLoading,
+    isFetching: query.isFetching,
+    error: query.error,
+    refetch: query.refetch,
+  };
+}
@@ -3,11 +3,13 @@ import { createClient } from "@connectrpc/connect";
 import { createGrpcWebTransport } from "@connectrpc/connect-web";
 
 import {
+  GetPortalContentRequestSchema,
   ListPortalsRequestSchema,
   type Portal as ProtoPortal,
   PortalService,
 } from "@/gen/sreportal/v1/portal_pb";
 import type { Portal } from "../domain/portal.types";
+import type { ContentBlock } from "../domain/portalContent.types";
 
 const transport = createGrpcWebTransport({ baseUrl: window.location.origin });
 const client = createClient(PortalService, transport);
@@ -38,6 +40,10 @@ function toDomainPortal(p: ProtoPortal): Portal {
       statusPage: p.features?.statusPage ?? true,
       imageInventory: p.features?.imageInventory ?? true,
     },
+    links: p.links.map((l) => ({ title: l.title, url: l.url })),
+    branding: p.branding
+      ? { logoUrl: p.branding.logoUrl, color: p.branding.color }
+      : undefined,
   };
 }
 
@@ -46,3 +52,13 @@ export async function listPortals(): Promise<Portal[]> {
   const response = await client.listPortals(request);
   return response.portals.map(toDomainPortal);
 }
+
+export async function getPortalContent(portal: string): Promise<ContentBlock[]> {
+  const request = create(GetPortalContentRequestSchema, { portal });
+  const response = await client.getPortalContent(request);
+  return response.blocks.map((b) => ({
+    configMap: b.configMap,
+    key: b.key,
+    markdown: b.markdown,
+  }));
+}
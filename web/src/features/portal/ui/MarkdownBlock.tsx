@@ -0,0 +1,92 @@
+import { useMemo } from "react";
+
+import {
+  parseMarkdown,
+  type MarkdownInline,
+} from "../domain/portalContent.types";
+
+function Inlines({ inlines }: { inlines: readonly MarkdownInline[] }) {
+  return (
+    <>
+      {inlines.map((inline, i) => {
+        switch (inline.kind) {
+          case "code":
+            return (
+              <code key={i} className="rounded bg-muted px-1 py-0.5 font-mono text-[0.85em]">
+                {inline.text}
+              </code>
+            );
+          case "strong":
+            return <strong key={i}>{inline.text}</strong>;
+          case "link":
+            return (
+              <a
+                key={i}
+                href={inline.url}
+                target="_blank"
+                rel="noopener noreferrer"
+                className="text-primary underline-offset-4 hover:underline"
+              >
+                {inline.text}
+              </a>
+            );
+          default:
+            return <span key={i}>{inline.text}</span>;
+        }
+      })}
+    </>
+  );
+}
+
+const headingClass = {
+  1: "font-display text-2xl tracking-tight",
+  2: "text-lg font-semibold",
+  3: "text-base font-semibold",
+} as const;
+
+/**
+ * Renders a portal content block. Markdown is parsed into React elements
+ * (never injected as HTML), so ConfigMap content cannot run scripts.
+ */
+export function MarkdownBlock({ markdown }: { markdown: string }) {
+  const nodes = useMemo(() => parseMarkdown(markdown), [markdown]);
+
+  return (
+    <div className="space-y-3 text-sm leading-relaxed">
+      {nodes.map((node, i) => {
+        switch (node.kind) {
+          case "heading": {
+            const Tag = `h${node.level}` as const;
+            return (
+              <Tag key={i} className={headingClass[node.level]}>
+                <Inlines inlines={node.inlines} />
+              </Tag>
+            );
+          }
+          case "list":
+            return (
+              <ul key={i} className="list-disc space-y-1 pl-5">
+                {node.items.map((item, j) => (
+                  <li key={j}>
+                    <Inlines inlines={item} />
+                  </li>
+                ))}
+              </ul>
+            );
+          case "code":
+            return (
+              <pre key={i} className="overflow-x-auto rounded-md bg-muted p-3 font-mono text-xs">
+                {node.text}
+              </pre>
+            );
+          default:
+            return (
+              <p key={i}>
+                <Inlines inlines={node.inlines} />
+              </p>
+            );
+        }
+      })}
+    </div>
+  );
+}
@@ -0,0 +1,11 @@
+import { describe, expect, it } from "vitest";
+
+import { loginUrl } from "./session.types";
+
+describe("loginUrl", () => {
+  it("encodes the return path", () => {
+    expect(loginUrl("/main/links?q=a b")).toBe(
+      "/auth/login?redirect=%2Fmain%2Flinks%3Fq%3Da%20b"
+    );
+  });
+});
@@ -0,0 +1,13 @@
+export interface Session {
+  readonly authenticated: boolean;
+  readonly name: string;
+  readonly groups: readonly string[];
+}
+
+export const LOGIN_PATH = "/auth/login";
+export const LOGOUT_PATH = "/auth/logout";
+
+/** Returns the login URL bringing the user back to returnTo once signed in. */
+export function loginUrl(returnTo: string): string {
+  return `${LOGIN_PATH}?redirect=${encodeURIComponent(returnTo)}`;
+}
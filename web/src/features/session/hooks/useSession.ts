@@ -0,0 +1,17 @@
+import { useQuery } from "@tanstack/react-query";
+
+import { getSession } from "../infrastructure/sessionApi";
+
+export function useSession() {
+  const query = useQuery({
+    queryKey: ["session"],
+    queryFn: getSession,
+    staleTime: 5 * 60 * 1000,
+    retry: false,
+  });
+
+  return {
+    session: query.data ?? null,
+    isLoading: query.isLoading,
+  };
+}
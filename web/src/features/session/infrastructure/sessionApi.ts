@@ -0,0 +1,23 @@
+import type { Session } from "../domain/session.types";
+
+const SESSION_URL = "/auth/me";
+
+/**
+ * Returns the current session, or null when the web server does not serve
+ * OIDC login.
+ */
+export async function getSession(): Promise<Session | null> {
+  const response = await fetch(SESSION_URL, { credentials: "same-origin" });
+  if (response.status === 404) {
+    return null;
+  }
+  if (!response.ok) {
+    throw new Error(`session: ${response.status} ${response.statusText}`);
+  }
+  const body = (await response.json()) as Partial<Session>;
+  return {
+    authenticated: body.authenticated ?? false,
+    name: body.name ?? "",
+    groups: body.groups ?? [],
+  };
+}
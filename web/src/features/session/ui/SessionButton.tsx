@@ -0,0 +1,45 @@
+import { LogInIcon, LogOutIcon } from "lucide-react";
+import { useLocation } from "react-router";
+
+import { Button } from "@/components/ui/button";
+import {
+  Tooltip,
+  TooltipContent,
+  TooltipTrigger,
+} from "@/components/ui/tooltip";
+import { LOGOUT_PATH, loginUrl } from "../domain/session.types";
+import { useSession } from "../hooks/useSession";
+
+/** Sign in/out control, hidden when OIDC login is not configured. */
+export function SessionButton() {
+  const { session } = useSession();
+  const location = useLocation();
+
+  if (!session) {
+    return null;
+  }
+
+  const label = session.authenticated
+    ? `Signed in as ${session.name} (click to sign out)`
+    : "Sign in";
+  const href = session.authenticated
+    ? LOGOUT_PATH
+    : loginUrl(location.pathname + location.search);
+
+  return (
+    <Tooltip>
+      <TooltipTrigger asChild>
+        <Button variant="ghost" size="icon" asChild>
+          <a href={href} aria-label={label}>
+            {session.authenticated ? (
+              <LogOutIcon className="size-4" />
+            ) : (
+              <LogInIcon className="size-4" />
+            )}
+          </a>
+        </Button>
+      </TooltipTrigger>
+      <TooltipContent>{label}</TooltipContent>
+    </Tooltip>
+  );
+}
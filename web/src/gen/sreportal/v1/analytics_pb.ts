@@ -0,0 +1,277 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file sreportal/v1/analytics.proto (package sreportal.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file sreportal/v1/analytics.proto.
+ */
+export const file_sreportal_v1_analytics: GenFile = /*@__PURE__*/
+  fileDesc("ChxzcmVwb3J0YWwvdjEvYW5hbHl0aWNzLnByb3RvEgxzcmVwb3J0YWwudjEiXwoSUmVjb3JkVXNhZ2VSZXF1ZXN0Eg4KBnBvcnRhbBgBIAEoCRIqCgR0eXBlGAIgASgOMhwuc3JlcG9ydGFsLnYxLlVzYWdlRXZlbnRUeXBlEg0KBXZhbHVlGAMgASgJIhUKE1JlY29yZFVzYWdlUmVzcG9uc2UiNQoUR2V0VXNhZ2VTdGF0c1JlcXVlc3QSDgoGcG9ydGFsGAEgASgJEg0KBWxpbWl0GAIgASgFIm4KFUdldFVzYWdlU3RhdHNSZXNwb25zZRIqCgdwb3J0YWxzGAEgAygLMhkuc3JlcG9ydGFsLnYxLlBvcnRhbFVzYWdlEikKBXNpbmNlGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCK7AQoLUG9ydGFsVXNhZ2USDgoGcG9ydGFsGAEgASgJEhIKCnBhZ2Vfdmlld3MYAiABKAMSEAoIc2VhcmNoZXMYAyABKAMSFAoMZ3JvdXBfY2xpY2tzGAQgASgDEjAKDHRvcF9zZWFyY2hlcxgFIAMoCzIaLnNyZXBvcnRhbC52MS5Vc2FnZUNvdW50ZXISLgoKdG9wX2dyb3VwcxgGIAMoCzIaLnNyZXBvcnRhbC52MS5Vc2FnZUNvdW50ZXIiLAoMVXNhZ2VDb3VudGVyEg0KBXZhbHVlGAEgASgJEg0KBWNvdW50GAIgASgDKpEBCg5Vc2FnZUV2ZW50VHlwZRIgChxVU0FHRV9FVkVOVF9UWVBFX1VOU1BFQ0lGSUVEEAASHgoaVVNBR0VfRVZFTlRfVFlQRV9QQUdFX1ZJRVcQARIbChdVU0FHRV9FVkVOVF9UWVBFX1NFQVJDSBACEiAKHFVTQUdFX0VWRU5UX1RZUEVfR1JPVVBfQ0xJQ0sQAzLAAQoQQW5hbHl0aWNzU2VydmljZRJSCgtSZWNvcmRVc2FnZRIgLnNyZXBvcnRhbC52MS5SZWNvcmRVc2FnZVJlcXVlc3QaIS5zcmVwb3J0YWwudjEuUmVjb3JkVXNhZ2VSZXNwb25zZRJYCg1HZXRVc2FnZVN0YXRzEiIuc3JlcG9ydGFsLnYxLkdldFVzYWdlU3RhdHNSZXF1ZXN0GiMuc3JlcG9ydGFsLnYxLkdldFVzYWdlU3RhdHNSZXNwb25zZUK+AQoQY29tLnNyZXBvcnRhbC52MUIOQW5hbHl0aWNzUHJvdG9QAVpJZ2l0aHViLmNvbS9nb2xnb3RoMzEvc3JlcG9ydGFsL2ludGVybmFsL2dycGMvZ2VuL3NyZXBvcnRhbC92MTtzcmVwb3J0YWx2MaICA1NYWKoCDFNyZXBvcnRhbC5WMcoCDFNyZXBvcnRhbFxWMeICGFNyZXBvcnRhbFxWMVxHUEJNZXRhZGF0YeoCDVNyZXBvcnRhbDo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
+
+/**
+ * RecordUsageRequest is a usage event of a portal
+ *
+ * @generated from message sreportal.v1.RecordUsageRequest
+ */
+export type RecordUsageRequest = Message<"sreportal.v1.RecordUsageRequest"> & {
+  /**
+   * portal is the name of the portal the event happened on
+   *
+   * @generated from field: string portal = 1;
+   */
+  portal: string;
+
+  /**
+   * type is the kind of event
+   *
+   * @generated from field: sreportal.v1.UsageEventType type = 2;
+   */
+  type: UsageEventType;
+
+  /**
+   * value is the search term of a search and the group of a group click
+   *
+   * @generated from field: string value = 3;
+   */
+  value: string;
+};
+
+/**
+ * Describes the message sreportal.v1.RecordUsageRequest.
+ * Use `create(RecordUsageRequestSchema)` to create a new message.
+ */
+export const RecordUsageRequestSchema: GenMessage<RecordUsageRequest> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_analytics, 0);
+
+/**
+ * RecordUsageResponse is the response for recording a usage event
+ *
+ * @generated from message sreportal.v1.RecordUsageResponse
+ */
+export type RecordUsageResponse = Message<"sreportal.v1.RecordUsageResponse"> & {
+};
+
+/**
+ * Describes the message sreportal.v1.RecordUsageResponse.
+ * Use `create(RecordUsageResponseSchema)` to create a new message.
+ */
+export const RecordUsageResponseSchema: GenMessage<RecordUsageResponse> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_analytics, 1);
+
+/**
+ * GetUsageStatsRequest is the request for getting usage counters
+ *
+ * @generated from message sreportal.v1.GetUsageStatsRequest
+ */
+export type GetUsageStatsRequest = Message<"sreportal.v1.GetUsageStatsRequest"> & {
+  /**
+   * portal restricts the counters to one portal (empty = every portal)
+   *
+   * @generated from field: string portal = 1;
+   */
+  portal: string;
+
+  /**
+   * limit caps the top searches and top groups returned (0 = 10)
+   *
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message sreportal.v1.GetUsageStatsRequest.
+ * Use `create(GetUsageStatsRequestSchema)` to create a new message.
+ */
+export const GetUsageStatsRequestSchema: GenMessage<GetUsageStatsRequest> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_analytics, 2);
+
+/**
+ * GetUsageStatsResponse lists the usage counters per portal
+ *
+ * @generated from message sreportal.v1.GetUsageStatsResponse
+ */
+export type GetUsageStatsResponse = Message<"sreportal.v1.GetUsageStatsResponse"> & {
+  /**
+   * portals are the counters of each portal with recorded events, sorted by
+   * name
+   *
+   * @generated from field: repeated sreportal.v1.PortalUsage portals = 1;
+   */
+  portals: PortalUsage[];
+
+  /**
+   * since is when counting started (counters restart with the operator)
+   *
+   * @generated from field: google.protobuf.Timestamp since = 2;
+   */
+  since?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message sreportal.v1.GetUsageStatsResponse.
+ * Use `create(GetUsageStatsResponseSchema)` to create a new message.
+ */
+export const GetUsageStatsResponseSchema: GenMessage<GetUsageStatsResponse> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_analytics, 3);
+
+/**
+ * PortalUsage are the usage counters of a portal
+ *
+ * @generated from message sreportal.v1.PortalUsage
+ */
+export type PortalUsage = Message<"sreportal.v1.PortalUsage"> & {
+  /**
+   * portal is the name of the portal
+   *
+   * @generated from field: string portal = 1;
+   */
+  portal: string;
+
+  /**
+   * page_views is the number of portal page views
+   *
+   * @generated from field: int64 page_views = 2;
+   */
+  pageViews: bigint;
+
+  /**
+   * searches is the number of searches
+   *
+   * @generated from field: int64 searches = 3;
+   */
+  searches: bigint;
+
+  /**
+   * group_clicks is the number of group clicks
+   *
+   * @generated from field: int64 group_clicks = 4;
+   */
+  groupClicks: bigint;
+
+  /**
+   * top_searches are the most searched terms, most searched first
+   *
+   * @generated from field: repeated sreportal.v1.UsageCounter top_searches = 5;
+   */
+  topSearches: UsageCounter[];
+
+  /**
+   * top_groups are the most clicked groups, most clicked first
+   *
+   * @generated from field: repeated sreportal.v1.UsageCounter top_groups = 6;
+   */
+  topGroups: UsageCounter[];
+};
+
+/**
+ * Describes the message sreportal.v1.PortalUsage.
+ * Use `create(PortalUsageSchema)` to create a new message.
+ */
+export const PortalUsageSchema: GenMessage<PortalUsage> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_analytics, 4);
+
+/**
+ * UsageCounter is a counted search term or group
+ *
+ * @generated from message sreportal.v1.UsageCounter
+ */
+export type UsageCounter = Message<"sreportal.v1.UsageCounter"> & {
+  /**
+   * value is the search term or the group name
+   *
+   * @generated from field: string value = 1;
+   */
+  value: string;
+
+  /**
+   * count is the number of events
+   *
+   * @generated from field: int64 count = 2;
+   */
+  count: bigint;
+};
+
+/**
+ * Describes the message sreportal.v1.UsageCounter.
+ * Use `create(UsageCounterSchema)` to create a new message.
+ */
+export const UsageCounterSchema: GenMessage<UsageCounter> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_analytics, 5);
+
+/**
+ * UsageEventType is the kind of a usage event
+ *
+ * @generated from enum sreportal.v1.UsageEventType
+ */
+export enum UsageEventType {
+  /**
+   * @generated from enum value: USAGE_EVENT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * USAGE_EVENT_TYPE_PAGE_VIEW is a view of a portal page
+   *
+   * @generated from enum value: USAGE_EVENT_TYPE_PAGE_VIEW = 1;
+   */
+  PAGE_VIEW = 1,
+
+  /**
+   * USAGE_EVENT_TYPE_SEARCH is a search in the FQDNs of a portal
+   *
+   * @generated from enum value: USAGE_EVENT_TYPE_SEARCH = 2;
+   */
+  SEARCH = 2,
+
+  /**
+   * USAGE_EVENT_TYPE_GROUP_CLICK is the opening of an FQDN group
+   *
+   * @generated from enum value: USAGE_EVENT_TYPE_GROUP_CLICK = 3;
+   */
+  GROUP_CLICK = 3,
+}
+
+/**
+ * Describes the enum sreportal.v1.UsageEventType.
+ */
+export const UsageEventTypeSchema: GenEnum<UsageEventType> = /*@__PURE__*/
+  enumDesc(file_sreportal_v1_analytics, 0);
+
+/**
+ * AnalyticsService counts the opt-in usage events of the web UI. Counters are
+ * kept in memory and are not tied to a user, address or session
+ *
+ * @generated from service sreportal.v1.AnalyticsService
+ */
+export const AnalyticsService: GenService<{
+  /**
+   * RecordUsage counts a usage event. It is sent as a beacon by the web UI
+   * when analytics are enabled
+   *
+   * @generated from rpc sreportal.v1.AnalyticsService.RecordUsage
+   */
+  recordUsage: {
+    methodKind: "unary";
+    input: typeof RecordUsageRequestSchema;
+    output: typeof RecordUsageResponseSchema;
+  },
+  /**
+   * GetUsageStats returns the usage counters of a portal, or of every portal
+   *
+   * @generated from rpc sreportal.v1.AnalyticsService.GetUsageStats
+   */
+  getUsageStats: {
+    methodKind: "unary";
+    input: typeof GetUsageStatsRequestSchema;
+    output: typeof GetUsageStatsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_sreportal_v1_analytics, 0);
+
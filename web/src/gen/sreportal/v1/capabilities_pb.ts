@@ -0,0 +1,143 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file sreportal/v1/capabilities.proto (package sreportal.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file sreportal/v1/capabilities.proto.
+ */
+export const file_sreportal_v1_capabilities: GenFile = /*@__PURE__*/
+  fileDesc("Ch9zcmVwb3J0YWwvdjEvY2FwYWJpbGl0aWVzLnByb3RvEgxzcmVwb3J0YWwudjEiGAoWR2V0Q2FwYWJpbGl0aWVzUmVxdWVzdCJ9ChdHZXRDYXBhYmlsaXRpZXNSZXNwb25zZRIvCgdzb3VyY2VzGAEgAygLMh4uc3JlcG9ydGFsLnYxLlNvdXJjZUNhcGFiaWxpdHkSMQoIZmVhdHVyZXMYAiADKAsyHy5zcmVwb3J0YWwudjEuRmVhdHVyZUNhcGFiaWxpdHkiMQoQU291cmNlQ2FwYWJpbGl0eRIMCgRuYW1lGAEgASgJEg8KB2VuYWJsZWQYAiABKAgingEKEUZlYXR1cmVDYXBhYmlsaXR5EgwKBG5hbWUYASABKAkSDwoHZW5hYmxlZBgCIAEoCBI7CgZjb25maWcYAyADKAsyKy5zcmVwb3J0YWwudjEuRmVhdHVyZUNhcGFiaWxpdHkuQ29uZmlnRW50cnkaLQoLQ29uZmlnRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ATJ1ChNDYXBhYmlsaXRpZXNTZXJ2aWNlEl4KD0dldENhcGFiaWxpdGllcxIkLnNyZXBvcnRhbC52MS5HZXRDYXBhYmlsaXRpZXNSZXF1ZXN0GiUuc3JlcG9ydGFsLnYxLkdldENhcGFiaWxpdGllc1Jlc3BvbnNlQsEBChBjb20uc3JlcG9ydGFsLnYxQhFDYXBhYmlsaXRpZXNQcm90b1ABWklnaXRodWIuY29tL2dvbGdvdGgzMS9zcmVwb3J0YWwvaW50ZXJuYWwvZ3JwYy9nZW4vc3JlcG9ydGFsL3YxO3NyZXBvcnRhbHYxogIDU1hYqgIMU3JlcG9ydGFsLlYxygIMU3JlcG9ydGFsXFYx4gIYU3JlcG9ydGFsXFYxXEdQQk1ldGFkYXRh6gINU3JlcG9ydGFsOjpWMWIGcHJvdG8z");
+
+/**
+ * GetCapabilitiesRequest is the request for getting the capabilities
+ *
+ * @generated from message sreportal.v1.GetCapabilitiesRequest
+ */
+export type GetCapabilitiesRequest = Message<"sreportal.v1.GetCapabilitiesRequest"> & {
+};
+
+/**
+ * Describes the message sreportal.v1.GetCapabilitiesRequest.
+ * Use `create(GetCapabilitiesRequestSchema)` to create a new message.
+ */
+export const GetCapabilitiesRequestSchema: GenMessage<GetCapabilitiesRequest> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_capabilities, 0);
+
+/**
+ * GetCapabilitiesResponse lists the sources and features of the instance
+ *
+ * @generated from message sreportal.v1.GetCapabilitiesResponse
+ */
+export type GetCapabilitiesResponse = Message<"sreportal.v1.GetCapabilitiesResponse"> & {
+  /**
+   * sources are the discovery sources, enabled or not
+   *
+   * @generated from field: repeated sreportal.v1.SourceCapability sources = 1;
+   */
+  sources: SourceCapability[];
+
+  /**
+   * features are the optional features: probing, certificates, dnsCheck,
+   * auth and analytics
+   *
+   * @generated from field: repeated sreportal.v1.FeatureCapability features = 2;
+   */
+  features: FeatureCapability[];
+};
+
+/**
+ * Describes the message sreportal.v1.GetCapabilitiesResponse.
+ * Use `create(GetCapabilitiesResponseSchema)` to create a new message.
+ */
+export const GetCapabilitiesResponseSchema: GenMessage<GetCapabilitiesResponse> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_capabilities, 1);
+
+/**
+ * SourceCapability reports whether a discovery source is enabled
+ *
+ * @generated from message sreportal.v1.SourceCapability
+ */
+export type SourceCapability = Message<"sreportal.v1.SourceCapability"> & {
+  /**
+   * name is the key of the source in the operator configuration
+   * (e.g. service, ingress, gatewayHTTPRoute)
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * enabled is true when the source discovers FQDNs
+   *
+   * @generated from field: bool enabled = 2;
+   */
+  enabled: boolean;
+};
+
+/**
+ * Describes the message sreportal.v1.SourceCapability.
+ * Use `create(SourceCapabilitySchema)` to create a new message.
+ */
+export const SourceCapabilitySchema: GenMessage<SourceCapability> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_capabilities, 2);
+
+/**
+ * FeatureCapability reports whether a feature is active
+ *
+ * @generated from message sreportal.v1.FeatureCapability
+ */
+export type FeatureCapability = Message<"sreportal.v1.FeatureCapability"> & {
+  /**
+   * name identifies the feature
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * enabled is true when the feature runs
+   *
+   * @generated from field: bool enabled = 2;
+   */
+  enabled: boolean;
+
+  /**
+   * config summarises the configuration of the feature (no addresses or
+   * credentials)
+   *
+   * @generated from field: map<string, string> config = 3;
+   */
+  config: { [key: string]: string };
+};
+
+/**
+ * Describes the message sreportal.v1.FeatureCapability.
+ * Use `create(FeatureCapabilitySchema)` to create a new message.
+ */
+export const FeatureCapabilitySchema: GenMessage<FeatureCapability> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_capabilities, 3);
+
+/**
+ * CapabilitiesService tells clients which sources and features are active
+ *
+ * @generated from service sreportal.v1.CapabilitiesService
+ */
+export const CapabilitiesService: GenService<{
+  /**
+   * GetCapabilities returns the enabled sources and the active features, so
+   * clients can hide the views that would stay empty
+   *
+   * @generated from rpc sreportal.v1.CapabilitiesService.GetCapabilities
+   */
+  getCapabilities: {
+    methodKind: "unary";
+    input: typeof GetCapabilitiesRequestSchema;
+    output: typeof GetCapabilitiesResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_sreportal_v1_capabilities, 0);
+
@@ -0,0 +1,32 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file sreportal/v1/diagnostics.proto (package sreportal.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { RunDiagnosticsRequest, RunDiagnosticsResponse } from "./diagnostics_pb.js";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * DiagnosticsService reports the health of the operator installation
+ *
+ * @generated from service sreportal.v1.DiagnosticsService
+ */
+export const DiagnosticsService = {
+  typeName: "sreportal.v1.DiagnosticsService",
+  methods: {
+    /**
+     * RunDiagnostics checks the API server connectivity, the RBAC of the enabled
+     * sources, the CRD availability, the webhook reachability and the
+     * configuration validity. Requires authentication when auth is configured.
+     *
+     * @generated from rpc sreportal.v1.DiagnosticsService.RunDiagnostics
+     */
+    runDiagnostics: {
+      name: "RunDiagnostics",
+      I: RunDiagnosticsRequest,
+      O: RunDiagnosticsResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,168 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file sreportal/v1/diagnostics.proto (package sreportal.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file sreportal/v1/diagnostics.proto.
+ */
+export const file_sreportal_v1_diagnostics: GenFile = /*@__PURE__*/
+  fileDesc("Ch5zcmVwb3J0YWwvdjEvZGlhZ25vc3RpY3MucHJvdG8SDHNyZXBvcnRhbC52MSIXChVSdW5EaWFnbm9zdGljc1JlcXVlc3QioQEKFlJ1bkRpYWdub3N0aWNzUmVzcG9uc2USKAoEdGltZRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoGc3RhdHVzGAIgASgOMh4uc3JlcG9ydGFsLnYxLkRpYWdub3N0aWNTdGF0dXMSLQoGY2hlY2tzGAMgAygLMh0uc3JlcG9ydGFsLnYxLkRpYWdub3N0aWNDaGVjayJyCg9EaWFnbm9zdGljQ2hlY2sSEAoIY2F0ZWdvcnkYASABKAkSDAoEbmFtZRgCIAEoCRIuCgZzdGF0dXMYAyABKA4yHi5zcmVwb3J0YWwudjEuRGlhZ25vc3RpY1N0YXR1cxIPCgdtZXNzYWdlGAQgASgJKqsBChBEaWFnbm9zdGljU3RhdHVzEiEKHURJQUdOT1NUSUNfU1RBVFVTX1VOU1BFQ0lGSUVEEAASGAoURElBR05PU1RJQ19TVEFUVVNfT0sQARIdChlESUFHTk9TVElDX1NUQVRVU19XQVJOSU5HEAISHAoYRElBR05PU1RJQ19TVEFUVVNfRkFJTEVEEAMSHQoZRElBR05PU1RJQ19TVEFUVVNfU0tJUFBFRBAEMnEKEkRpYWdub3N0aWNzU2VydmljZRJbCg5SdW5EaWFnbm9zdGljcxIjLnNyZXBvcnRhbC52MS5SdW5EaWFnbm9zdGljc1JlcXVlc3QaJC5zcmVwb3J0YWwudjEuUnVuRGlhZ25vc3RpY3NSZXNwb25zZULAAQoQY29tLnNyZXBvcnRhbC52MUIQRGlhZ25vc3RpY3NQcm90b1ABWklnaXRodWIuY29tL2dvbGdvdGgzMS9zcmVwb3J0YWwvaW50ZXJuYWwvZ3JwYy9nZW4vc3JlcG9ydGFsL3YxO3NyZXBvcnRhbHYxogIDU1hYqgIMU3JlcG9ydGFsLlYxygIMU3JlcG9ydGFsXFYx4gIYU3JlcG9ydGFsXFYxXEdQQk1ldGFkYXRh6gINU3JlcG9ydGFsOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp]);
+
+/**
+ * RunDiagnosticsRequest is the request for running the diagnostics
+ *
+ * @generated from message sreportal.v1.RunDiagnosticsRequest
+ */
+export type RunDiagnosticsRequest = Message<"sreportal.v1.RunDiagnosticsRequest"> & {
+};
+
+/**
+ * Describes the message sreportal.v1.RunDiagnosticsRequest.
+ * Use `create(RunDiagnosticsRequestSchema)` to create a new message.
+ */
+export const RunDiagnosticsRequestSchema: GenMessage<RunDiagnosticsRequest> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_diagnostics, 0);
+
+/**
+ * RunDiagnosticsResponse is the diagnostics report
+ *
+ * @generated from message sreportal.v1.RunDiagnosticsResponse
+ */
+export type RunDiagnosticsResponse = Message<"sreportal.v1.RunDiagnosticsResponse"> & {
+  /**
+   * time is when the checks ran
+   *
+   * @generated from field: google.protobuf.Timestamp time = 1;
+   */
+  time?: Timestamp | undefined;
+
+  /**
+   * status is the worst status of the checks
+   *
+   * @generated from field: sreportal.v1.DiagnosticStatus status = 2;
+   */
+  status: DiagnosticStatus;
+
+  /**
+   * checks are the individual checks, ordered by category
+   *
+   * @generated from field: repeated sreportal.v1.DiagnosticCheck checks = 3;
+   */
+  checks: DiagnosticCheck[];
+};
+
+/**
+ * Describes the message sreportal.v1.RunDiagnosticsResponse.
+ * Use `create(RunDiagnosticsResponseSchema)` to create a new message.
+ */
+export const RunDiagnosticsResponseSchema: GenMessage<RunDiagnosticsResponse> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_diagnostics, 1);
+
+/**
+ * DiagnosticCheck is the outcome of one diagnostic check
+ *
+ * @generated from message sreportal.v1.DiagnosticCheck
+ */
+export type DiagnosticCheck = Message<"sreportal.v1.DiagnosticCheck"> & {
+  /**
+   * category groups the checks: connectivity, rbac, crd, source, webhook or
+   * config
+   *
+   * @generated from field: string category = 1;
+   */
+  category: string;
+
+  /**
+   * name identifies the check within its category (e.g. "list services")
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * status is the outcome of the check
+   *
+   * @generated from field: sreportal.v1.DiagnosticStatus status = 3;
+   */
+  status: DiagnosticStatus;
+
+  /**
+   * message details the outcome (e.g. the missing permission)
+   *
+   * @generated from field: string message = 4;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message sreportal.v1.DiagnosticCheck.
+ * Use `create(DiagnosticCheckSchema)` to create a new message.
+ */
+export const DiagnosticCheckSchema: GenMessage<DiagnosticCheck> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_diagnostics, 2);
+
+/**
+ * DiagnosticStatus is the outcome of a diagnostic check
+ *
+ * @generated from enum sreportal.v1.DiagnosticStatus
+ */
+export enum DiagnosticStatus {
+  /**
+   * @generated from enum value: DIAGNOSTIC_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: DIAGNOSTIC_STATUS_OK = 1;
+   */
+  OK = 1,
+
+  /**
+   * @generated from enum value: DIAGNOSTIC_STATUS_WARNING = 2;
+   */
+  WARNING = 2,
+
+  /**
+   * @generated from enum value: DIAGNOSTIC_STATUS_FAILED = 3;
+   */
+  FAILED = 3,
+
+  /**
+   * @generated from enum value: DIAGNOSTIC_STATUS_SKIPPED = 4;
+   */
+  SKIPPED = 4,
+}
+
+/**
+ * Describes the enum sreportal.v1.DiagnosticStatus.
+ */
+export const DiagnosticStatusSchema: GenEnum<DiagnosticStatus> = /*@__PURE__*/
+  enumDesc(file_sreportal_v1_diagnostics, 0);
+
+/**
+ * DiagnosticsService reports the health of the operator installation
+ *
+ * @generated from service sreportal.v1.DiagnosticsService
+ */
+export const DiagnosticsService: GenService<{
+  /**
+   * RunDiagnostics checks the API server connectivity, the RBAC of the enabled
+   * sources, the CRD availability, the webhook reachability and the
+   * configuration validity. Requires authentication when auth is configured.
+   *
+   * @generated from rpc sreportal.v1.DiagnosticsService.RunDiagnostics
+   */
+  runDiagnostics: {
+    methodKind: "unary";
+    input: typeof RunDiagnosticsRequestSchema;
+    output: typeof RunDiagnosticsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_sreportal_v1_diagnostics, 0);
+
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { ListFQDNsRequest, ListFQDNsResponse, StreamFQDNsRequest, StreamFQDNsResponse } from "./dns_pb.js";
+import { BatchUpdateManualEntriesRequest, BatchUpdateManualEntriesResponse, FederatedSearchRequest, FederatedSearchResponse, ListFQDNsRequest, ListFQDNsResponse, PublishEndpointsRequest, PublishEndpointsResponse, StreamFQDNsRequest, StreamFQDNsResponse } from "./dns_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -36,6 +36,43 @@ export const DNSService = {
       O: StreamFQDNsResponse,
       kind: MethodKind.ServerStreaming,
     },
+    /**
+     * FederatedSearch searches FQDNs on this instance and on every remote portal,
+     * merging results that exist on several sites
+     *
+     * @generated from rpc sreportal.v1.DNSService.FederatedSearch
+     */
+    federatedSearch: {
+      name: "FederatedSearch",
+      I: FederatedSearchRequest,
+      O: FederatedSearchResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * BatchUpdateManualEntries applies a list of add/update/delete operations
+     * to the manual entries of a portal in a single DNSRecord update: either
+     * every operation is applied or none is
+     *
+     * @generated from rpc sreportal.v1.DNSService.BatchUpdateManualEntries
+     */
+    batchUpdateManualEntries: {
+      name: "BatchUpdateManualEntries",
+      I: BatchUpdateManualEntriesRequest,
+      O: BatchUpdateManualEntriesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * PublishEndpoints replaces the FQDNs an agent discovered in its cluster
+     * for a local portal. Sent by sreportal instances running in agent mode
+     *
+     * @generated from rpc sreportal.v1.DNSService.PublishEndpoints
+     */
+    publishEndpoints: {
+      name: "PublishEndpoints",
+      I: PublishEndpointsRequest,
+      O: PublishEndpointsResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
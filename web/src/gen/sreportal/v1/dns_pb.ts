@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file sreportal/v1/dns.proto.
  */
 export const file_sreportal_v1_dns: GenFile = /*@__PURE__*/
-  fileDesc("ChZzcmVwb3J0YWwvdjEvZG5zLnByb3RvEgxzcmVwb3J0YWwudjEifAoQTGlzdEZRRE5zUmVxdWVzdBIRCgluYW1lc3BhY2UYASABKAkSDgoGc291cmNlGAIgASgJEg4KBnNlYXJjaBgDIAEoCRIOCgZwb3J0YWwYBCABKAkSEQoJcGFnZV9zaXplGAUgASgFEhIKCnBhZ2VfdG9rZW4YBiABKAkiYwoRTGlzdEZRRE5zUmVzcG9uc2USIQoFZnFkbnMYASADKAsyEi5zcmVwb3J0YWwudjEuRlFEThIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkSEgoKdG90YWxfc2l6ZRgDIAEoBSJXChJTdHJlYW1GUUROc1JlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEg4KBnBvcnRhbBgCIAEoCRIOCgZzb3VyY2UYAyABKAkSDgoGc2VhcmNoGAQgASgJIl8KE1N0cmVhbUZRRE5zUmVzcG9uc2USJgoEdHlwZRgBIAEoDjIYLnNyZXBvcnRhbC52MS5VcGRhdGVUeXBlEiAKBGZxZG4YAiABKAsyEi5zcmVwb3J0YWwudjEuRlFETiJCChFPcmlnaW5SZXNvdXJjZVJlZhIMCgRraW5kGAEgASgJEhEKCW5hbWVzcGFjZRgCIAEoCRIMCgRuYW1lGAMgASgJItACCgRGUUROEgwKBG5hbWUYASABKAkSDgoGc291cmNlGAIgASgJEg4KBmdyb3VwcxgDIAMoCRITCgtkZXNjcmlwdGlvbhgEIAEoCRITCgtyZWNvcmRfdHlwZRgFIAEoCRIPCgd0YXJnZXRzGAYgAygJEi0KCWxhc3Rfc2VlbhgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASHQoRZG5zX3Jlc291cmNlX25hbWUYCCABKAlCAhgBEiIKFmRuc19yZXNvdXJjZV9uYW1lc3BhY2UYCSABKAlCAhgBEjgKCm9yaWdpbl9yZWYYCiABKAsyHy5zcmVwb3J0YWwudjEuT3JpZ2luUmVzb3VyY2VSZWZIAIgBARITCgtzeW5jX3N0YXR1cxgLIAEoCRIPCgdwb3J0YWxzGAwgAygJQg0KC19vcmlnaW5fcmVmKnMKClVwZGF0ZVR5cGUSGwoXVVBEQVRFX1RZUEVfVU5TUEVDSUZJRUQQABIVChFVUERBVEVfVFlQRV9BRERFRBABEhgKFFVQREFURV9UWVBFX01PRElGSUVEEAISFwoTVVBEQVRFX1RZUEVfREVMRVRFRBADMrABCgpETlNTZXJ2aWNlEkwKCUxpc3RGUUROcxIeLnNyZXBvcnRhbC52MS5MaXN0RlFETnNSZXF1ZXN0Gh8uc3JlcG9ydGFsLnYxLkxpc3RGUUROc1Jlc3BvbnNlElQKC1N0cmVhbUZRRE5zEiAuc3JlcG9ydGFsLnYxLlN0cmVhbUZRRE5zUmVxdWVzdBohLnNyZXBvcnRhbC52MS5TdHJlYW1GUUROc1Jlc3BvbnNlMAFCuAEKEGNvbS5zcmVwb3J0YWwudjFCCERuc1Byb3RvUAFaSWdpdGh1Yi5jb20vZ29sZ290aDMxL3NyZXBvcnRhbC9pbnRlcm5hbC9ncnBjL2dlbi9zcmVwb3J0YWwvdjE7c3JlcG9ydGFsdjGiAgNTWFiqAgxTcmVwb3J0YWwuVjHKAgxTcmVwb3J0YWxcVjHiAhhTcmVwb3J0YWxcVjFcR1BCTWV0YWRhdGHqAg1TcmVwb3J0YWw6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
+  fileDesc("ChZzcmVwb3J0YWwvdjEvZG5zLnByb3RvEgxzcmVwb3J0YWwudjEivAIKEExpc3RGUUROc1JlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEg4KBnNvdXJjZRgCIAEoCRIOCgZzZWFyY2gYAyABKAkSDgoGcG9ydGFsGAQgASgJEhEKCXBhZ2Vfc2l6ZRgFIAEoBRISCgpwYWdlX3Rva2VuGAYgASgJEhQKDHRhcmdldF9zY29wZRgHIAEoCRIkCgR2aWV3GAggASgOMhYuc3JlcG9ydGFsLnYxLkZRRE5WaWV3EhcKD2luY2x1ZGVfcmVtb3ZlZBgJIAEoCBIzCg9sYXN0X3NlZW5fYWZ0ZXIYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjQKEGxhc3Rfc2Vlbl9iZWZvcmUYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIogBChFMaXN0RlFETnNSZXNwb25zZRIhCgVmcWRucxgBIAMoCzISLnNyZXBvcnRhbC52MS5GUUROEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRISCgp0b3RhbF9zaXplGAMgASgFEiMKBmdyb3VwcxgEIAMoCzITLnNyZXBvcnRhbC52MS5Hcm91cCJeCgVHcm91cBIMCgRuYW1lGAEgASgJEgwKBHBhdGgYAiABKAkSEgoKZnFkbl9jb3VudBgDIAEoBRIlCghjaGlsZHJlbhgEIAMoCzITLnNyZXBvcnRhbC52MS5Hcm91cCKXAgoSU3RyZWFtRlFETnNSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCRIOCgZwb3J0YWwYAiABKAkSDgoGc291cmNlGAMgASgJEg4KBnNlYXJjaBgEIAEoCRIUCgx0YXJnZXRfc2NvcGUYBSABKAkSJAoEdmlldxgGIAEoDjIWLnNyZXBvcnRhbC52MS5GUUROVmlldxIXCg9pbmNsdWRlX3JlbW92ZWQYByABKAgSMwoPbGFzdF9zZWVuX2FmdGVyGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI0ChBsYXN0X3NlZW5fYmVmb3JlGAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJfChNTdHJlYW1GUUROc1Jlc3BvbnNlEiYKBHR5cGUYASABKA4yGC5zcmVwb3J0YWwudjEuVXBkYXRlVHlwZRIgCgRmcWRuGAIgASgLMhIuc3JlcG9ydGFsLnYxLkZRRE4iOAoWRmVkZXJhdGVkU2VhcmNoUmVxdWVzdBIOCgZzZWFyY2gYASABKAkSDgoGc291cmNlGAIgASgJInkKF0ZlZGVyYXRlZFNlYXJjaFJlc3BvbnNlEiwKB3Jlc3VsdHMYASADKAsyGy5zcmVwb3J0YWwudjEuRmVkZXJhdGVkRlFEThIwCgZlcnJvcnMYAiADKAsyIC5zcmVwb3J0YWwudjEuRmVkZXJhdGVkU2l0ZUVycm9yIkAKDUZlZGVyYXRlZEZRRE4SIAoEZnFkbhgBIAEoCzISLnNyZXBvcnRhbC52MS5GUUROEg0KBXNpdGVzGAIgAygJIjEKEkZlZGVyYXRlZFNpdGVFcnJvchIMCgRzaXRlGAEgASgJEg0KBWVycm9yGAIgASgJIpcBCh9CYXRjaFVwZGF0ZU1hbnVhbEVudHJpZXNSZXF1ZXN0Eg4KBnBvcnRhbBgBIAEoCRISCgpkbnNfcmVjb3JkGAIgASgJEhgKEHJlc291cmNlX3ZlcnNpb24YAyABKAkSNgoKb3BlcmF0aW9ucxgEIAMoCzIiLnNyZXBvcnRhbC52MS5NYW51YWxFbnRyeU9wZXJhdGlvbiJ2ChRNYW51YWxFbnRyeU9wZXJhdGlvbhI0CgR0eXBlGAEgASgOMiYuc3JlcG9ydGFsLnYxLk1hbnVhbEVudHJ5T3BlcmF0aW9uVHlwZRIoCgVlbnRyeRgCIAEoCzIZLnNyZXBvcnRhbC52MS5NYW51YWxFbnRyeSJ1CgtNYW51YWxFbnRyeRIMCgRmcWRuGAEgASgJEhMKC3JlY29yZF90eXBlGAIgASgJEg8KB3RhcmdldHMYAyADKAkSDQoFZ3JvdXAYBCABKAkSDgoGZ3JvdXBzGAUgAygJEhMKC2Rlc2NyaXB0aW9uGAYgASgJImUKIEJhdGNoVXBkYXRlTWFudWFsRW50cmllc1Jlc3BvbnNlEhIKCmRuc19yZWNvcmQYASABKAkSGAoQcmVzb3VyY2VfdmVyc2lvbhgCIAEoCRITCgtlbnRyeV9jb3VudBgDIAEoBSJ3ChZTZXRGUUROc0lnbm9yZWRSZXF1ZXN0Eg4KBnBvcnRhbBgBIAEoCRIrCgd0YXJnZXRzGAIgAygLMhouc3JlcG9ydGFsLnYxLklnbm9yZVRhcmdldBIPCgdpZ25vcmVkGAMgASgIEg8KB2RyeV9ydW4YBCABKAgiQwoMSWdub3JlVGFyZ2V0EgwKBGZxZG4YASABKAkSEwoLcmVjb3JkX3R5cGUYAiABKAkSEAoIcmVzb3VyY2UYAyABKAkiVwoXU2V0RlFETnNJZ25vcmVkUmVzcG9uc2USKwoHcmVzdWx0cxgBIAMoCzIaLnNyZXBvcnRhbC52MS5JZ25vcmVSZXN1bHQSDwoHZHJ5X3J1bhgCIAEoCCKEAQoMSWdub3JlUmVzdWx0EgwKBGZxZG4YASABKAkSEwoLcmVjb3JkX3R5cGUYAiABKAkSEAoIcmVzb3VyY2UYAyABKAkSMAoGc3RhdHVzGAQgASgOMiAuc3JlcG9ydGFsLnYxLklnbm9yZVJlc3VsdFN0YXR1cxINCgVlcnJvchgFIAEoCSJCChFPcmlnaW5SZXNvdXJjZVJlZhIMCgRraW5kGAEgASgJEhEKCW5hbWVzcGFjZRgCIAEoCRIMCgRuYW1lGAMgASgJIi8KDEROU1JlY29yZFJlZhIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCSI7CgtTZXJ2aWNlUG9ydBIMCgRuYW1lGAEgASgJEgwKBHBvcnQYAiABKAUSEAoIcHJvdG9jb2wYAyABKAkixAoKBEZRRE4SDAoEbmFtZRgBIAEoCRIOCgZzb3VyY2UYAiABKAkSDgoGZ3JvdXBzGAMgAygJEhMKC2Rlc2NyaXB0aW9uGAQgASgJEhMKC3JlY29yZF90eXBlGAUgASgJEg8KB3RhcmdldHMYBiADKAkSLQoJbGFzdF9zZWVuGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIdChFkbnNfcmVzb3VyY2VfbmFtZRgIIAEoCUICGAESIgoWZG5zX3Jlc291cmNlX25hbWVzcGFjZRgJIAEoCUICGAESOAoKb3JpZ2luX3JlZhgKIAEoCzIfLnNyZXBvcnRhbC52MS5PcmlnaW5SZXNvdXJjZVJlZkgAiAEBEhMKC3N5bmNfc3RhdHVzGAsgASgJEg8KB3BvcnRhbHMYDCADKAkSFAoMdGFyZ2V0X3Njb3BlGA0gASgJEhEKCXNlbnNpdGl2ZRgOIAEoCBIoCgVwb3J0cxgPIAMoCzIZLnNyZXBvcnRhbC52MS5TZXJ2aWNlUG9ydBINCgVwYXRocxgQIAMoCRIcChRpbnRlcm5hbF9zeW5jX3N0YXR1cxgRIAEoCRIcChRleHRlcm5hbF9zeW5jX3N0YXR1cxgSIAEoCRIUCgxhdmFpbGFiaWxpdHkYEyABKAkSEwoLc291cmNlX3R5cGUYFCABKAkSNwoOZG5zX3JlY29yZF9yZWYYFSABKAsyGi5zcmVwb3J0YWwudjEuRE5TUmVjb3JkUmVmSAGIAQESMwoPbGFzdF9yZWNvbmNpbGVkGBYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIzCg5vdmVyYWxsX3N0YXR1cxgXIAEoDjIbLnNyZXBvcnRhbC52MS5PdmVyYWxsU3RhdHVzEjgKC2Fubm90YXRpb25zGBggAygLMiMuc3JlcG9ydGFsLnYxLkZRRE4uQW5ub3RhdGlvbnNFbnRyeRIKCgJpZBgZIAEoCRIuCgpyZW1vdmVkX2F0GBogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIWCg5sb29rdXBfZmFpbHVyZRgbIAEoCRI4Cg9zaGFkb3dlZF9tYW51YWwYHCABKAsyGi5zcmVwb3J0YWwudjEuRE5TUmVjb3JkUmVmSAKIAQESQAoSb3duZXJzaGlwX2NvbmZsaWN0GB0gASgLMh8uc3JlcG9ydGFsLnYxLk93bmVyc2hpcENvbmZsaWN0SAOIAQESFAoMb3JpZ2luX2NhdXNlGB4gASgJEhUKDWhlYWx0aF9zdGF0dXMYHyABKAkSMwoPbGFzdF9wcm9iZV90aW1lGCAgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIYChBodHRwX3N0YXR1c19jb2RlGCEgASgFEhgKEHByb2JlX2xhdGVuY3lfbXMYIiABKAMSFgoJdGxzX3ZhbGlkGCMgASgISASIAQESNgoQdGFyZ2V0X3Byb3ZpZGVycxgkIAMoCzIcLnNyZXBvcnRhbC52MS5UYXJnZXRQcm92aWRlchIWCg5yZW1vdmFsX3JlYXNvbhglIAEoCRoyChBBbm5vdGF0aW9uc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFCDQoLX29yaWdpbl9yZWZCEQoPX2Ruc19yZWNvcmRfcmVmQhIKEF9zaGFkb3dlZF9tYW51YWxCFQoTX293bmVyc2hpcF9jb25mbGljdEIMCgpfdGxzX3ZhbGlkIlsKF1B1Ymxpc2hFbmRwb2ludHNSZXF1ZXN0Eg0KBWFnZW50GAEgASgJEg4KBnBvcnRhbBgCIAEoCRIhCgVmcWRucxgDIAMoCzISLnNyZXBvcnRhbC52MS5GUUROIi4KGFB1Ymxpc2hFbmRwb2ludHNSZXNwb25zZRISCgpmcWRuX2NvdW50GAEgASgFInIKEU93bmVyc2hpcENvbmZsaWN0EiwKC3RhcmdldF9zZXRzGAEgAygLMhcuc3JlcG9ydGFsLnYxLlRhcmdldFNldBIvCgtkZXRlY3RlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiHAoJVGFyZ2V0U2V0Eg8KB3RhcmdldHMYASADKAkiPwoOVGFyZ2V0UHJvdmlkZXISDgoGdGFyZ2V0GAEgASgJEhAKCHByb3ZpZGVyGAIgASgJEgsKA2FzbhgDIAEoDSJMCg5BZGROb3RlUmVxdWVzdBIOCgZwb3J0YWwYASABKAkSDAoEZnFkbhgCIAEoCRIOCgZhdXRob3IYAyABKAkSDAoEdGV4dBgEIAEoCSJLCg9BZGROb3RlUmVzcG9uc2USJAoEbm90ZRgBIAEoCzIWLnNyZXBvcnRhbC52MS5GUUROTm90ZRISCgpub3RlX2NvdW50GAIgASgFIjAKEExpc3ROb3Rlc1JlcXVlc3QSDgoGcG9ydGFsGAEgASgJEgwKBGZxZG4YAiABKAkiOgoRTGlzdE5vdGVzUmVzcG9uc2USJQoFbm90ZXMYASADKAsyFi5zcmVwb3J0YWwudjEuRlFETk5vdGUiWAoIRlFETk5vdGUSDgoGYXV0aG9yGAEgASgJEgwKBHRleHQYAiABKAkSLgoKY3JlYXRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiRAoTR2V0U2hhcmVMaW5rUmVxdWVzdBIOCgZwb3J0YWwYASABKAkSDAoEZnFkbhgCIAEoCRIPCgdxcl9jb2RlGAMgASgIIkYKFEdldFNoYXJlTGlua1Jlc3BvbnNlEgsKA3VybBgBIAEoCRIMCgRwYXRoGAIgASgJEhMKC3FyX2NvZGVfcG5nGAMgASgMIiwKGkdldFVuaXF1ZW5lc3NSZXBvcnRSZXF1ZXN0Eg4KBnBvcnRhbBgBIAEoCSJMChtHZXRVbmlxdWVuZXNzUmVwb3J0UmVzcG9uc2USLQoGaXNzdWVzGAEgAygLMh0uc3JlcG9ydGFsLnYxLlVuaXF1ZW5lc3NJc3N1ZSKfAQoPVW5pcXVlbmVzc0lzc3VlEgwKBG5hbWUYASABKAkSEwoLcmVjb3JkX3R5cGUYAiABKAkSEAoIc2V2ZXJpdHkYAyABKAkSDwoHcG9ydGFscxgEIAMoCRIPCgdzb3VyY2VzGAUgAygJEjUKDWNvbnRyaWJ1dGlvbnMYBiADKAsyHi5zcmVwb3J0YWwudjEuRlFETkNvbnRyaWJ1dGlvbiKIAQoQRlFETkNvbnRyaWJ1dGlvbhIuCgpkbnNfcmVjb3JkGAEgASgLMhouc3JlcG9ydGFsLnYxLkROU1JlY29yZFJlZhIOCgZwb3J0YWwYAiABKAkSDgoGc291cmNlGAMgASgJEhMKC3NvdXJjZV90eXBlGAQgASgJEg8KB3RhcmdldHMYBSADKAkqTgoIRlFETlZpZXcSGQoVRlFETl9WSUVXX1VOU1BFQ0lGSUVEEAASEwoPRlFETl9WSUVXX0JBU0lDEAESEgoORlFETl9WSUVXX0ZVTEwQAiq8AQoYTWFudWFsRW50cnlPcGVyYXRpb25UeXBlEisKJ01BTlVBTF9FTlRSWV9PUEVSQVRJT05fVFlQRV9VTlNQRUNJRklFRBAAEiMKH01BTlVBTF9FTlRSWV9PUEVSQVRJT05fVFlQRV9BREQQARImCiJNQU5VQUxfRU5UUllfT1BFUkFUSU9OX1RZUEVfVVBEQVRFEAISJgoiTUFOVUFMX0VOVFJZX09QRVJBVElPTl9UWVBFX0RFTEVURRADKowCChJJZ25vcmVSZXN1bHRTdGF0dXMSJAogSUdOT1JFX1JFU1VMVF9TVEFUVVNfVU5TUEVDSUZJRUQQABIgChxJR05PUkVfUkVTVUxUX1NUQVRVU19VUERBVEVEEAESIgoeSUdOT1JFX1JFU1VMVF9TVEFUVVNfVU5DSEFOR0VEEAISHwobSUdOT1JFX1JFU1VMVF9TVEFUVVNfREVOSUVEEAMSIgoeSUdOT1JFX1JFU1VMVF9TVEFUVVNfTk9UX0ZPVU5EEAQSJAogSUdOT1JFX1JFU1VMVF9TVEFUVVNfVU5TVVBQT1JURUQQBRIfChtJR05PUkVfUkVTVUxUX1NUQVRVU19GQUlMRUQQBiqmAQoKVXBkYXRlVHlwZRIbChdVUERBVEVfVFlQRV9VTlNQRUNJRklFRBAAEhUKEVVQREFURV9UWVBFX0FEREVEEAESGAoUVVBEQVRFX1RZUEVfTU9ESUZJRUQQAhIXChNVUERBVEVfVFlQRV9ERUxFVEVEEAMSGQoVVVBEQVRFX1RZUEVfSEVBUlRCRUFUEAQSFgoSVVBEQVRFX1RZUEVfU1lOQ0VEEAUqvAEKDU92ZXJhbGxTdGF0dXMSHgoaT1ZFUkFMTF9TVEFUVVNfVU5TUEVDSUZJRUQQABIaChZPVkVSQUxMX1NUQVRVU19VTktOT1dOEAESGgoWT1ZFUkFMTF9TVEFUVVNfSEVBTFRIWRACEhoKFk9WRVJBTExfU1RBVFVTX1dBUk5JTkcQAxIbChdPVkVSQUxMX1NUQVRVU19DUklUSUNBTBAEEhoKFk9WRVJBTExfU1RBVFVTX1JFTU9WRUQQBTKnBwoKRE5TU2VydmljZRJMCglMaXN0RlFETnMSHi5zcmVwb3J0YWwudjEuTGlzdEZRRE5zUmVxdWVzdBofLnNyZXBvcnRhbC52MS5MaXN0RlFETnNSZXNwb25zZRJUCgtTdHJlYW1GUUROcxIgLnNyZXBvcnRhbC52MS5TdHJlYW1GUUROc1JlcXVlc3QaIS5zcmVwb3J0YWwudjEuU3RyZWFtRlFETnNSZXNwb25zZTABEl4KD0ZlZGVyYXRlZFNlYXJjaBIkLnNyZXBvcnRhbC52MS5GZWRlcmF0ZWRTZWFyY2hSZXF1ZXN0GiUuc3JlcG9ydGFsLnYxLkZlZGVyYXRlZFNlYXJjaFJlc3BvbnNlEnkKGEJhdGNoVXBkYXRlTWFudWFsRW50cmllcxItLnNyZXBvcnRhbC52MS5CYXRjaFVwZGF0ZU1hbnVhbEVudHJpZXNSZXF1ZXN0Gi4uc3JlcG9ydGFsLnYxLkJhdGNoVXBkYXRlTWFudWFsRW50cmllc1Jlc3BvbnNlEl4KD1NldEZRRE5zSWdub3JlZBIkLnNyZXBvcnRhbC52MS5TZXRGUUROc0lnbm9yZWRSZXF1ZXN0GiUuc3JlcG9ydGFsLnYxLlNldEZRRE5zSWdub3JlZFJlc3BvbnNlEmEKEFB1Ymxpc2hFbmRwb2ludHMSJS5zcmVwb3J0YWwudjEuUHVibGlzaEVuZHBvaW50c1JlcXVlc3QaJi5zcmVwb3J0YWwudjEuUHVibGlzaEVuZHBvaW50c1Jlc3BvbnNlEkYKB0FkZE5vdGUSHC5zcmVwb3J0YWwudjEuQWRkTm90ZVJlcXVlc3QaHS5zcmVwb3J0YWwudjEuQWRkTm90ZVJlc3BvbnNlEkwKCUxpc3ROb3RlcxIeLnNyZXBvcnRhbC52MS5MaXN0Tm90ZXNSZXF1ZXN0Gh8uc3JlcG9ydGFsLnYxLkxpc3ROb3Rlc1Jlc3BvbnNlElUKDEdldFNoYXJlTGluaxIhLnNyZXBvcnRhbC52MS5HZXRTaGFyZUxpbmtSZXF1ZXN0GiIuc3JlcG9ydGFsLnYxLkdldFNoYXJlTGlua1Jlc3BvbnNlEmoKE0dldFVuaXF1ZW5lc3NSZXBvcnQSKC5zcmVwb3J0YWwudjEuR2V0VW5pcXVlbmVzc1JlcG9ydFJlcXVlc3QaKS5zcmVwb3J0YWwudjEuR2V0VW5pcXVlbmVzc1JlcG9ydFJlc3BvbnNlQrgBChBjb20uc3JlcG9ydGFsLnYxQghEbnNQcm90b1ABWklnaXRodWIuY29tL2dvbGdvdGgzMS9zcmVwb3J0YWwvaW50ZXJuYWwvZ3JwYy9nZW4vc3JlcG9ydGFsL3YxO3NyZXBvcnRhbHYxogIDU1hYqgIMU3JlcG9ydGFsLlYxygIMU3JlcG9ydGFsXFYx4gIYU3JlcG9ydGFsXFYxXEdQQk1ldGFkYXRh6gINU3JlcG9ydGFsOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp]);
 
 /**
  * ListFQDNsRequest is the request for listing FQDNs
@@ -62,6 +62,47 @@ export type ListFQDNsRequest = Message<"sreportal.v1.ListFQDNsRequest"> & {
    * @generated from field: string page_token = 6;
    */
   pageToken: string;
+
+  /**
+   * target_scope filters FQDNs by the scope of their targets: "public",
+   * "private", "cgnat" or "link-local" (empty for all)
+   *
+   * @generated from field: string target_scope = 7;
+   */
+  targetScope: string;
+
+  /**
+   * view selects the FQDN fields returned (default FULL)
+   *
+   * @generated from field: sreportal.v1.FQDNView view = 8;
+   */
+  view: FQDNView;
+
+  /**
+   * include_removed also returns the FQDNs that disappeared from their
+   * sources within the tombstone retention window, with the REMOVED overall
+   * status and removed_at set
+   *
+   * @generated from field: bool include_removed = 9;
+   */
+  includeRemoved: boolean;
+
+  /**
+   * last_seen_after keeps only FQDNs last seen at or after this time, to get
+   * the recently active records (unset for no lower bound)
+   *
+   * @generated from field: google.protobuf.Timestamp last_seen_after = 10;
+   */
+  lastSeenAfter?: Timestamp;
+
+  /**
+   * last_seen_before keeps only FQDNs last seen before this time, to find
+   * stale records (unset for no upper bound). FQDNs with no last_seen are
+   * left out when either bound is set.
+   *
+   * @generated from field: google.protobuf.Timestamp last_seen_before = 11;
+   */
+  lastSeenBefore?: Timestamp;
 };
 
 /**
@@ -98,6 +139,16 @@ export type ListFQDNsResponse = Message<"sreportal.v1.ListFQDNsResponse"> & {
    * @generated from field: int32 total_size = 3;
    */
   totalSize: number;
+
+  /**
+   * groups is the group hierarchy of the FQDNs matching the request filters,
+   * before pagination. Group names are split into levels on the configured
+   * separator ("/" by default), so "Platform/Networking" is a child of
+   * "Platform".
+   *
+   * @generated from field: repeated sreportal.v1.Group groups = 4;
+   */
+  groups: Group[];
 };
 
 /**
@@ -107,6 +158,50 @@ export type ListFQDNsResponse = Message<"sreportal.v1.ListFQDNsResponse"> & {
 export const ListFQDNsResponseSchema: GenMessage<ListFQDNsResponse> = /*@__PURE__*/
   messageDesc(file_sreportal_v1_dns, 1);
 
+/**
+ * Group is a level of the group hierarchy
+ *
+ * @generated from message sreportal.v1.Group
+ */
+export type Group = Message<"sreportal.v1.Group"> & {
+  /**
+   * name is the last segment of the group name (e.g. "Networking")
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * path is the full group name (e.g. "Platform/Networking"), as listed in
+   * FQDN.groups
+   *
+   * @generated from field: string path = 2;
+   */
+  path: string;
+
+  /**
+   * fqdn_count is the number of distinct FQDNs in this group or any of its
+   * descendants
+   *
+   * @generated from field: int32 fqdn_count = 3;
+   */
+  fqdnCount: number;
+
+  /**
+   * children are the nested groups, sorted by name
+   *
+   * @generated from field: repeated sreportal.v1.Group children = 4;
+   */
+  children: Group[];
+};
+
+/**
+ * Describes the message sreportal.v1.Group.
+ * Use `create(GroupSchema)` to create a new message.
+ */
+export const GroupSchema: GenMessage<Group> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 2);
+
 /**
  * StreamFQDNsRequest is the request for streaming FQDN updates
  *
@@ -140,6 +235,45 @@ export type StreamFQDNsRequest = Message<"sreportal.v1.StreamFQDNsRequest"> & {
    * @generated from field: string search = 4;
    */
   search: string;
+
+  /**
+   * target_scope filters updates by target scope (empty for all)
+   *
+   * @generated from field: string target_scope = 5;
+   */
+  targetScope: string;
+
+  /**
+   * view selects the FQDN fields streamed (default FULL). With BASIC, changes
+   * limited to the omitted fields are not streamed.
+   *
+   * @generated from field: sreportal.v1.FQDNView view = 6;
+   */
+  view: FQDNView;
+
+  /**
+   * include_removed also streams the tombstones of FQDNs that disappeared
+   * from their sources (see ListFQDNsRequest.include_removed)
+   *
+   * @generated from field: bool include_removed = 7;
+   */
+  includeRemoved: boolean;
+
+  /**
+   * last_seen_after keeps only FQDNs last seen at or after this time (see
+   * ListFQDNsRequest.last_seen_after)
+   *
+   * @generated from field: google.protobuf.Timestamp last_seen_after = 8;
+   */
+  lastSeenAfter?: Timestamp;
+
+  /**
+   * last_seen_before keeps only FQDNs last seen before this time (see
+   * ListFQDNsRequest.last_seen_before)
+   *
+   * @generated from field: google.protobuf.Timestamp last_seen_before = 9;
+   */
+  lastSeenBefore?: Timestamp;
 };
 
 /**
@@ -147,7 +281,7 @@ export type StreamFQDNsRequest = Message<"sreportal.v1.StreamFQDNsRequest"> & {
  * Use `create(StreamFQDNsRequestSchema)` to create a new message.
  */
 export const StreamFQDNsRequestSchema: GenMessage<StreamFQDNsRequest> = /*@__PURE__*/
-  messageDesc(file_sreportal_v1_dns, 2);
+  messageDesc(file_sreportal_v1_dns, 3);
 
 /**
  * StreamFQDNsResponse represents an update to an FQDN
@@ -163,7 +297,7 @@ export type StreamFQDNsResponse = Message<"sreportal.v1.StreamFQDNsResponse"> &
   type: UpdateType;
 
   /**
-   * fqdn is the FQDN that was updated
+   * fqdn is the FQDN that was updated, unset for heartbeats
    *
    * @generated from field: sreportal.v1.FQDN fqdn = 2;
    */
@@ -175,199 +309,1645 @@ export type StreamFQDNsResponse = Message<"sreportal.v1.StreamFQDNsResponse"> &
  * Use `create(StreamFQDNsResponseSchema)` to create a new message.
  */
 export const StreamFQDNsResponseSchema: GenMessage<StreamFQDNsResponse> = /*@__PURE__*/
-  messageDesc(file_sreportal_v1_dns, 3);
+  messageDesc(file_sreportal_v1_dns, 4);
 
 /**
- * OriginResourceRef identifies the Kubernetes resource that produced an FQDN.
- * Only populated for FQDNs discovered via external-dns sources.
+ * FederatedSearchRequest is the request for searching FQDNs across all sites
  *
- * @generated from message sreportal.v1.OriginResourceRef
+ * @generated from message sreportal.v1.FederatedSearchRequest
  */
-export type OriginResourceRef = Message<"sreportal.v1.OriginResourceRef"> & {
+export type FederatedSearchRequest = Message<"sreportal.v1.FederatedSearchRequest"> & {
   /**
-   * kind is the Kubernetes resource kind (e.g. Service, Ingress, DNSEndpoint)
+   * search filters FQDNs by name substring (required)
    *
-   * @generated from field: string kind = 1;
+   * @generated from field: string search = 1;
    */
-  kind: string;
+  search: string;
 
   /**
-   * namespace is the Kubernetes namespace of the origin resource
+   * source filters FQDNs by source (empty for all sources)
    *
-   * @generated from field: string namespace = 2;
+   * @generated from field: string source = 2;
    */
-  namespace: string;
+  source: string;
+};
+
+/**
+ * Describes the message sreportal.v1.FederatedSearchRequest.
+ * Use `create(FederatedSearchRequestSchema)` to create a new message.
+ */
+export const FederatedSearchRequestSchema: GenMessage<FederatedSearchRequest> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 5);
+
+/**
+ * FederatedSearchResponse contains the merged results of a federated search
+ *
+ * @generated from message sreportal.v1.FederatedSearchResponse
+ */
+export type FederatedSearchResponse = Message<"sreportal.v1.FederatedSearchResponse"> & {
+  /**
+   * results is the list of matching FQDNs, sorted by name then record type
+   *
+   * @generated from field: repeated sreportal.v1.FederatedFQDN results = 1;
+   */
+  results: FederatedFQDN[];
 
   /**
-   * name is the name of the origin Kubernetes resource
+   * errors lists the sites that could not be searched (timeout, unreachable, ...).
+   * Results from the remaining sites are still returned.
    *
-   * @generated from field: string name = 3;
+   * @generated from field: repeated sreportal.v1.FederatedSiteError errors = 2;
    */
-  name: string;
+  errors: FederatedSiteError[];
 };
 
 /**
- * Describes the message sreportal.v1.OriginResourceRef.
- * Use `create(OriginResourceRefSchema)` to create a new message.
+ * Describes the message sreportal.v1.FederatedSearchResponse.
+ * Use `create(FederatedSearchResponseSchema)` to create a new message.
  */
-export const OriginResourceRefSchema: GenMessage<OriginResourceRef> = /*@__PURE__*/
-  messageDesc(file_sreportal_v1_dns, 4);
+export const FederatedSearchResponseSchema: GenMessage<FederatedSearchResponse> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 6);
 
 /**
- * FQDN represents a fully qualified domain name with metadata
+ * FederatedFQDN is an FQDN together with the sites it was found on
  *
- * @generated from message sreportal.v1.FQDN
+ * @generated from message sreportal.v1.FederatedFQDN
  */
-export type FQDN = Message<"sreportal.v1.FQDN"> & {
+export type FederatedFQDN = Message<"sreportal.v1.FederatedFQDN"> & {
   /**
-   * name is the fully qualified domain name
+   * fqdn is the FQDN as reported by the first site it was found on
    *
-   * @generated from field: string name = 1;
+   * @generated from field: sreportal.v1.FQDN fqdn = 1;
    */
-  name: string;
+  fqdn?: FQDN | undefined;
 
   /**
-   * source indicates where this FQDN came from (manual or external-dns)
+   * sites lists every site serving this FQDN ("local" for this instance,
+   * the remote portal name otherwise). Sorted and deduplicated.
    *
-   * @generated from field: string source = 2;
+   * @generated from field: repeated string sites = 2;
    */
-  source: string;
+  sites: string[];
+};
+
+/**
+ * Describes the message sreportal.v1.FederatedFQDN.
+ * Use `create(FederatedFQDNSchema)` to create a new message.
+ */
+export const FederatedFQDNSchema: GenMessage<FederatedFQDN> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 7);
 
+/**
+ * FederatedSiteError reports a site that failed during a federated search
+ *
+ * @generated from message sreportal.v1.FederatedSiteError
+ */
+export type FederatedSiteError = Message<"sreportal.v1.FederatedSiteError"> & {
   /**
-   * groups is the list of groups this FQDN belongs to
+   * site is the remote portal name
    *
-   * @generated from field: repeated string groups = 3;
+   * @generated from field: string site = 1;
    */
-  groups: string[];
+  site: string;
 
   /**
-   * description is an optional human-readable description
+   * error is a human-readable description of the failure
    *
-   * @generated from field: string description = 4;
+   * @generated from field: string error = 2;
    */
-  description: string;
+  error: string;
+};
+
+/**
+ * Describes the message sreportal.v1.FederatedSiteError.
+ * Use `create(FederatedSiteErrorSchema)` to create a new message.
+ */
+export const FederatedSiteErrorSchema: GenMessage<FederatedSiteError> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 8);
+
+/**
+ * BatchUpdateManualEntriesRequest edits the manual entries of a portal
+ *
+ * @generated from message sreportal.v1.BatchUpdateManualEntriesRequest
+ */
+export type BatchUpdateManualEntriesRequest = Message<"sreportal.v1.BatchUpdateManualEntriesRequest"> & {
+  /**
+   * portal is the local portal the entries belong to (required)
+   *
+   * @generated from field: string portal = 1;
+   */
+  portal: string;
 
   /**
-   * record_type is the DNS record type (A, AAAA, CNAME, etc.)
+   * dns_record is the name of the manual DNSRecord holding the entries, in
+   * the portal namespace (default "<portal>-manual"). It is created by the
+   * first batch adding an entry.
    *
-   * @generated from field: string record_type = 5;
+   * @generated from field: string dns_record = 2;
+   */
+  dnsRecord: string;
+
+  /**
+   * resource_version is the DNSRecord resourceVersion the edits were made
+   * against, as returned by a previous call. When set, the batch fails with
+   * ABORTED if the DNSRecord changed since; when empty, the operations are
+   * applied to the latest version.
+   *
+   * @generated from field: string resource_version = 3;
+   */
+  resourceVersion: string;
+
+  /**
+   * operations are applied in order (at least one)
+   *
+   * @generated from field: repeated sreportal.v1.ManualEntryOperation operations = 4;
+   */
+  operations: ManualEntryOperation[];
+};
+
+/**
+ * Describes the message sreportal.v1.BatchUpdateManualEntriesRequest.
+ * Use `create(BatchUpdateManualEntriesRequestSchema)` to create a new message.
+ */
+export const BatchUpdateManualEntriesRequestSchema: GenMessage<BatchUpdateManualEntriesRequest> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 9);
+
+/**
+ * ManualEntryOperation is one edit of a BatchUpdateManualEntries batch
+ *
+ * @generated from message sreportal.v1.ManualEntryOperation
+ */
+export type ManualEntryOperation = Message<"sreportal.v1.ManualEntryOperation"> & {
+  /**
+   * type is the kind of edit (required)
+   *
+   * @generated from field: sreportal.v1.ManualEntryOperationType type = 1;
+   */
+  type: ManualEntryOperationType;
+
+  /**
+   * entry is the entry to add or the new content of the entry to update.
+   * Entries are identified by fqdn and record_type; only these two fields
+   * are read for a delete.
+   *
+   * @generated from field: sreportal.v1.ManualEntry entry = 2;
+   */
+  entry?: ManualEntry | undefined;
+};
+
+/**
+ * Describes the message sreportal.v1.ManualEntryOperation.
+ * Use `create(ManualEntryOperationSchema)` to create a new message.
+ */
+export const ManualEntryOperationSchema: GenMessage<ManualEntryOperation> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 10);
+
+/**
+ * ManualEntry is a manual DNS entry, as stored in a manual DNSRecord
+ *
+ * @generated from message sreportal.v1.ManualEntry
+ */
+export type ManualEntry = Message<"sreportal.v1.ManualEntry"> & {
+  /**
+   * fqdn is the fully qualified domain name (required)
+   *
+   * @generated from field: string fqdn = 1;
+   */
+  fqdn: string;
+
+  /**
+   * record_type is the DNS record type (default "A")
+   *
+   * @generated from field: string record_type = 2;
    */
   recordType: string;
 
   /**
-   * targets is the list of target addresses for this FQDN
+   * targets are the expected record values; empty only documents the FQDN
    *
-   * @generated from field: repeated string targets = 6;
+   * @generated from field: repeated string targets = 3;
    */
   targets: string[];
 
   /**
-   * last_seen is the timestamp when this FQDN was last observed
+   * group is the group the entry is displayed in
    *
-   * @generated from field: google.protobuf.Timestamp last_seen = 7;
+   * @generated from field: string group = 4;
    */
-  lastSeen?: Timestamp | undefined;
+  group: string;
 
   /**
-   * dns_resource_name is the name of the DNS resource this FQDN belongs to.
-   * Deprecated: replaced by `portals` to support an FQDN belonging to several
-   * portals after inter-DNS dedup. Kept for back-compat with older clients.
+   * groups lists additional groups the entry is displayed in
    *
-   * @generated from field: string dns_resource_name = 8 [deprecated = true];
-   * @deprecated
+   * @generated from field: repeated string groups = 5;
    */
-  dnsResourceName: string;
+  groups: string[];
 
   /**
-   * dns_resource_namespace is the namespace of the DNS resource.
-   * Deprecated: see `dns_resource_name`. Kept for back-compat with older clients.
+   * description is an optional human-readable description
    *
-   * @generated from field: string dns_resource_namespace = 9 [deprecated = true];
-   * @deprecated
+   * @generated from field: string description = 6;
    */
-  dnsResourceNamespace: string;
+  description: string;
+};
+
+/**
+ * Describes the message sreportal.v1.ManualEntry.
+ * Use `create(ManualEntrySchema)` to create a new message.
+ */
+export const ManualEntrySchema: GenMessage<ManualEntry> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 11);
 
+/**
+ * BatchUpdateManualEntriesResponse is returned once a batch is applied
+ *
+ * @generated from message sreportal.v1.BatchUpdateManualEntriesResponse
+ */
+export type BatchUpdateManualEntriesResponse = Message<"sreportal.v1.BatchUpdateManualEntriesResponse"> & {
   /**
-   * origin_ref identifies the Kubernetes resource (Service, Ingress, DNSEndpoint)
-   * that produced this FQDN via external-dns. Not set for manual entries.
+   * dns_record is the name of the manual DNSRecord that was updated
    *
-   * @generated from field: optional sreportal.v1.OriginResourceRef origin_ref = 10;
+   * @generated from field: string dns_record = 1;
    */
-  originRef?: OriginResourceRef | undefined;
+  dnsRecord: string;
 
   /**
-   * sync_status indicates whether the FQDN is correctly resolved in DNS.
-   * Values: "sync", "notavailable", "notsync", or empty.
+   * resource_version is the new DNSRecord resourceVersion, to send with the
+   * next batch
    *
-   * @generated from field: string sync_status = 11;
+   * @generated from field: string resource_version = 2;
    */
-  syncStatus: string;
+  resourceVersion: string;
 
   /**
-   * portals lists every portal this FQDN belongs to (post inter-DNS dedup).
-   * Sorted and deduplicated.
+   * entry_count is the number of entries of the DNSRecord after the batch
    *
-   * @generated from field: repeated string portals = 12;
+   * @generated from field: int32 entry_count = 3;
    */
-  portals: string[];
+  entryCount: number;
 };
 
 /**
- * Describes the message sreportal.v1.FQDN.
- * Use `create(FQDNSchema)` to create a new message.
+ * Describes the message sreportal.v1.BatchUpdateManualEntriesResponse.
+ * Use `create(BatchUpdateManualEntriesResponseSchema)` to create a new message.
  */
-export const FQDNSchema: GenMessage<FQDN> = /*@__PURE__*/
-  messageDesc(file_sreportal_v1_dns, 5);
+export const BatchUpdateManualEntriesResponseSchema: GenMessage<BatchUpdateManualEntriesResponse> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 12);
 
 /**
- * UpdateType represents the type of update
+ * SetFQDNsIgnoredRequest sets the ignore state of a list of FQDNs
  *
- * @generated from enum sreportal.v1.UpdateType
+ * @generated from message sreportal.v1.SetFQDNsIgnoredRequest
  */
-export enum UpdateType {
+export type SetFQDNsIgnoredRequest = Message<"sreportal.v1.SetFQDNsIgnoredRequest"> & {
   /**
-   * @generated from enum value: UPDATE_TYPE_UNSPECIFIED = 0;
+   * portal is the local portal the FQDNs are looked up in (required)
+   *
+   * @generated from field: string portal = 1;
    */
-  UNSPECIFIED = 0,
+  portal: string;
 
   /**
-   * @generated from enum value: UPDATE_TYPE_ADDED = 1;
+   * targets are the FQDNs or resources to update (at least one)
+   *
+   * @generated from field: repeated sreportal.v1.IgnoreTarget targets = 2;
    */
-  ADDED = 1,
+  targets: IgnoreTarget[];
 
   /**
-   * @generated from enum value: UPDATE_TYPE_MODIFIED = 2;
+   * ignored sets the annotation to "true" when true, and removes it when false
+   *
+   * @generated from field: bool ignored = 3;
    */
-  MODIFIED = 2,
+  ignored: boolean;
 
   /**
-   * @generated from enum value: UPDATE_TYPE_DELETED = 3;
+   * dry_run validates every change with the API server without persisting it
+   *
+   * @generated from field: bool dry_run = 4;
    */
-  DELETED = 3,
-}
+  dryRun: boolean;
+};
 
 /**
- * Describes the enum sreportal.v1.UpdateType.
+ * Describes the message sreportal.v1.SetFQDNsIgnoredRequest.
+ * Use `create(SetFQDNsIgnoredRequestSchema)` to create a new message.
  */
-export const UpdateTypeSchema: GenEnum<UpdateType> = /*@__PURE__*/
-  enumDesc(file_sreportal_v1_dns, 0);
+export const SetFQDNsIgnoredRequestSchema: GenMessage<SetFQDNsIgnoredRequest> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 13);
 
 /**
- * DNSService provides DNS record management and discovery
+ * IgnoreTarget names the resources to update, by FQDN or directly
  *
- * @generated from service sreportal.v1.DNSService
+ * @generated from message sreportal.v1.IgnoreTarget
  */
-export const DNSService: GenService<{
+export type IgnoreTarget = Message<"sreportal.v1.IgnoreTarget"> & {
   /**
-   * ListFQDNs returns all aggregated FQDNs from DNS resources
+   * fqdn updates the resources the FQDN is discovered from in the portal,
+   * including the FQDNs removed recently
    *
-   * @generated from rpc sreportal.v1.DNSService.ListFQDNs
+   * @generated from field: string fqdn = 1;
    */
-  listFQDNs: {
-    methodKind: "unary";
-    input: typeof ListFQDNsRequestSchema;
-    output: typeof ListFQDNsResponseSchema;
-  },
+  fqdn: string;
+
+  /**
+   * record_type restricts fqdn to one record type (empty for all)
+   *
+   * @generated from field: string record_type = 2;
+   */
+  recordType: string;
+
+  /**
+   * resource is a "kind/namespace/name" origin reference, as returned in
+   * IgnoreResult, used instead of fqdn. To be ignored, it must be the origin
+   * of an FQDN of the portal, including the FQDNs removed recently; any
+   * resource can be un-ignored, e.g. once its FQDNs are no longer retained.
+   *
+   * @generated from field: string resource = 3;
+   */
+  resource: string;
+};
+
+/**
+ * Describes the message sreportal.v1.IgnoreTarget.
+ * Use `create(IgnoreTargetSchema)` to create a new message.
+ */
+export const IgnoreTargetSchema: GenMessage<IgnoreTarget> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 14);
+
+/**
+ * SetFQDNsIgnoredResponse reports the outcome for every target
+ *
+ * @generated from message sreportal.v1.SetFQDNsIgnoredResponse
+ */
+export type SetFQDNsIgnoredResponse = Message<"sreportal.v1.SetFQDNsIgnoredResponse"> & {
+  /**
+   * results holds one result per target and origin resource, in target order
+   *
+   * @generated from field: repeated sreportal.v1.IgnoreResult results = 1;
+   */
+  results: IgnoreResult[];
+
+  /**
+   * dry_run is true when nothing was persisted
+   *
+   * @generated from field: bool dry_run = 2;
+   */
+  dryRun: boolean;
+};
+
+/**
+ * Describes the message sreportal.v1.SetFQDNsIgnoredResponse.
+ * Use `create(SetFQDNsIgnoredResponseSchema)` to create a new message.
+ */
+export const SetFQDNsIgnoredResponseSchema: GenMessage<SetFQDNsIgnoredResponse> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 15);
+
+/**
+ * IgnoreResult is the outcome of a target on one origin resource
+ *
+ * @generated from message sreportal.v1.IgnoreResult
+ */
+export type IgnoreResult = Message<"sreportal.v1.IgnoreResult"> & {
+  /**
+   * fqdn is the fqdn of the target, empty for resource targets
+   *
+   * @generated from field: string fqdn = 1;
+   */
+  fqdn: string;
+
+  /**
+   * record_type is the record type of the target
+   *
+   * @generated from field: string record_type = 2;
+   */
+  recordType: string;
+
+  /**
+   * resource is the "kind/namespace/name" origin reference of the FQDN
+   *
+   * @generated from field: string resource = 3;
+   */
+  resource: string;
+
+  /**
+   * status is the outcome of the change
+   *
+   * @generated from field: sreportal.v1.IgnoreResultStatus status = 4;
+   */
+  status: IgnoreResultStatus;
+
+  /**
+   * error describes why the change was not applied
+   *
+   * @generated from field: string error = 5;
+   */
+  error: string;
+};
+
+/**
+ * Describes the message sreportal.v1.IgnoreResult.
+ * Use `create(IgnoreResultSchema)` to create a new message.
+ */
+export const IgnoreResultSchema: GenMessage<IgnoreResult> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 16);
+
+/**
+ * OriginResourceRef identifies the Kubernetes resource that produced an FQDN.
+ * Only populated for FQDNs discovered via external-dns sources.
+ *
+ * @generated from message sreportal.v1.OriginResourceRef
+ */
+export type OriginResourceRef = Message<"sreportal.v1.OriginResourceRef"> & {
+  /**
+   * kind is the Kubernetes resource kind (e.g. Service, Ingress, DNSEndpoint)
+   *
+   * @generated from field: string kind = 1;
+   */
+  kind: string;
+
+  /**
+   * namespace is the Kubernetes namespace of the origin resource
+   *
+   * @generated from field: string namespace = 2;
+   */
+  namespace: string;
+
+  /**
+   * name is the name of the origin Kubernetes resource
+   *
+   * @generated from field: string name = 3;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message sreportal.v1.OriginResourceRef.
+ * Use `create(OriginResourceRefSchema)` to create a new message.
+ */
+export const OriginResourceRefSchema: GenMessage<OriginResourceRef> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 17);
+
+/**
+ * DNSRecordRef identifies the DNSRecord an FQDN was taken from
+ *
+ * @generated from message sreportal.v1.DNSRecordRef
+ */
+export type DNSRecordRef = Message<"sreportal.v1.DNSRecordRef"> & {
+  /**
+   * namespace is the namespace of the DNSRecord
+   *
+   * @generated from field: string namespace = 1;
+   */
+  namespace: string;
+
+  /**
+   * name is the name of the DNSRecord
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message sreportal.v1.DNSRecordRef.
+ * Use `create(DNSRecordRefSchema)` to create a new message.
+ */
+export const DNSRecordRefSchema: GenMessage<DNSRecordRef> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 18);
+
+/**
+ * ServicePort is a port exposed by the Kubernetes Service an FQDN originates from
+ *
+ * @generated from message sreportal.v1.ServicePort
+ */
+export type ServicePort = Message<"sreportal.v1.ServicePort"> & {
+  /**
+   * name is the Service port name, or its appProtocol when unnamed (e.g. "https")
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * port is the port number exposed by the Service
+   *
+   * @generated from field: int32 port = 2;
+   */
+  port: number;
+
+  /**
+   * protocol is the port protocol (TCP, UDP or SCTP)
+   *
+   * @generated from field: string protocol = 3;
+   */
+  protocol: string;
+};
+
+/**
+ * Describes the message sreportal.v1.ServicePort.
+ * Use `create(ServicePortSchema)` to create a new message.
+ */
+export const ServicePortSchema: GenMessage<ServicePort> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 19);
+
+/**
+ * FQDN represents a fully qualified domain name with metadata
+ *
+ * @generated from message sreportal.v1.FQDN
+ */
+export type FQDN = Message<"sreportal.v1.FQDN"> & {
+  /**
+   * name is the fully qualified domain name
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * source indicates where this FQDN came from (manual or external-dns)
+   *
+   * @generated from field: string source = 2;
+   */
+  source: string;
+
+  /**
+   * groups is the list of groups this FQDN belongs to
+   *
+   * @generated from field: repeated string groups = 3;
+   */
+  groups: string[];
+
+  /**
+   * description is an optional human-readable description
+   *
+   * @generated from field: string description = 4;
+   */
+  description: string;
+
+  /**
+   * record_type is the DNS record type (A, AAAA, CNAME, etc.)
+   *
+   * @generated from field: string record_type = 5;
+   */
+  recordType: string;
+
+  /**
+   * targets is the list of target addresses for this FQDN
+   *
+   * @generated from field: repeated string targets = 6;
+   */
+  targets: string[];
+
+  /**
+   * last_seen is the timestamp when this FQDN was last observed
+   *
+   * @generated from field: google.protobuf.Timestamp last_seen = 7;
+   */
+  lastSeen?: Timestamp | undefined;
+
+  /**
+   * dns_resource_name is the name of the DNS resource this FQDN belongs to.
+   * Deprecated: replaced by `portals` to support an FQDN belonging to several
+   * portals after inter-DNS dedup. Kept for back-compat with older clients.
+   *
+   * @generated from field: string dns_resource_name = 8 [deprecated = true];
+   * @deprecated
+   */
+  dnsResourceName: string;
+
+  /**
+   * dns_resource_namespace is the namespace of the DNS resource.
+   * Deprecated: see `dns_resource_name`. Kept for back-compat with older clients.
+   *
+   * @generated from field: string dns_resource_namespace = 9 [deprecated = true];
+   * @deprecated
+   */
+  dnsResourceNamespace: string;
+
+  /**
+   * origin_ref identifies the Kubernetes resource (Service, Ingress, DNSEndpoint)
+   * that produced this FQDN via external-dns. Not set for manual entries.
+   *
+   * @generated from field: optional sreportal.v1.OriginResourceRef origin_ref = 10;
+   */
+  originRef?: OriginResourceRef | undefined;
+
+  /**
+   * sync_status indicates whether the FQDN is correctly resolved in DNS.
+   * Values: "sync", "notavailable", "notsync", or empty.
+   *
+   * @generated from field: string sync_status = 11;
+   */
+  syncStatus: string;
+
+  /**
+   * portals lists every portal this FQDN belongs to (post inter-DNS dedup).
+   * Sorted and deduplicated.
+   *
+   * @generated from field: repeated string portals = 12;
+   */
+  portals: string[];
+
+  /**
+   * target_scope is the most exposed scope among the targets: "public",
+   * "private" (RFC1918, unique-local, loopback), "cgnat" (100.64.0.0/10),
+   * "link-local", or empty when no target is an IP address (e.g. CNAME).
+   *
+   * @generated from field: string target_scope = 13;
+   */
+  targetScope: string;
+
+  /**
+   * sensitive is true when the FQDN matches one of the operator's sensitive
+   * patterns (admin consoles, dashboards, ...).
+   *
+   * @generated from field: bool sensitive = 14;
+   */
+  sensitive: boolean;
+
+  /**
+   * ports lists the ports exposed by the source Service. Only set for FQDNs
+   * originating from a Service.
+   *
+   * @generated from field: repeated sreportal.v1.ServicePort ports = 15;
+   */
+  ports: ServicePort[];
+
+  /**
+   * paths lists the HTTP route paths served under this FQDN. Only set for
+   * FQDNs originating from an Ingress or an Istio VirtualService.
+   *
+   * @generated from field: repeated string paths = 16;
+   */
+  paths: string[];
+
+  /**
+   * internal_sync_status is the sync_status observed through the cluster
+   * resolver. Only set when split-horizon resolution is enabled.
+   *
+   * @generated from field: string internal_sync_status = 17;
+   */
+  internalSyncStatus: string;
+
+  /**
+   * external_sync_status is the sync_status observed through the configured
+   * external resolver. Only set when split-horizon resolution is enabled.
+   * Differs from internal_sync_status when the FQDN resolves differently
+   * inside and outside the cluster.
+   *
+   * @generated from field: string external_sync_status = 18;
+   */
+  externalSyncStatus: string;
+
+  /**
+   * availability is the outcome of the last connection probe of the FQDN:
+   * "up", "down", or empty when no probe is configured.
+   *
+   * @generated from field: string availability = 19;
+   */
+  availability: string;
+
+  /**
+   * source_type is the source kind (service, ingress, dnsendpoint, ...) of
+   * the DNSRecord this FQDN was taken from. Empty for manual entries.
+   *
+   * @generated from field: string source_type = 20;
+   */
+  sourceType: string;
+
+  /**
+   * dns_record_ref identifies the DNSRecord this FQDN was taken from, after
+   * source priority resolution and dedup across DNS resources.
+   *
+   * @generated from field: optional sreportal.v1.DNSRecordRef dns_record_ref = 21;
+   */
+  dnsRecordRef?: DNSRecordRef | undefined;
+
+  /**
+   * last_reconciled is the last reconcile time of the DNSRecord this FQDN
+   * was taken from.
+   *
+   * @generated from field: google.protobuf.Timestamp last_reconciled = 22;
+   */
+  lastReconciled?: Timestamp | undefined;
+
+  /**
+   * overall_status is the single health badge of the FQDN, combining
+   * sync_status, the split-horizon statuses and availability. Clients should
+   * render it instead of recomputing it from the individual fields.
+   *
+   * @generated from field: sreportal.v1.OverallStatus overall_status = 23;
+   */
+  overallStatus: OverallStatus;
+
+  /**
+   * annotations holds the annotations of the origin resource whose keys are
+   * listed in the operator's dnsRecord.exposedAnnotations allowlist. Empty
+   * for manual entries and when no annotation is exposed.
+   *
+   * @generated from field: map<string, string> annotations = 24;
+   */
+  annotations: { [key: string]: string };
+
+  /**
+   * id is a stable identifier of the entry, derived only from its portal,
+   * name and record type ("fqdn-" followed by 24 hex characters). It does not
+   * change when groups or DNS resources are renamed, so external
+   * reconciliation tools can use it as a key.
+   *
+   * @generated from field: string id = 25;
+   */
+  id: string;
+
+  /**
+   * removed_at is when the FQDN disappeared from every source. It is only set
+   * on tombstones, returned with include_removed during the operator's
+   * dnsRecord.tombstoneRetention; their overall_status is REMOVED.
+   *
+   * @generated from field: google.protobuf.Timestamp removed_at = 26;
+   */
+  removedAt?: Timestamp | undefined;
+
+  /**
+   * lookup_failure classifies why the last DNS check of the FQDN failed:
+   * "nxdomain" (the record is missing), "servfail", "timeout" or "refused"
+   * (the DNS servers did not answer properly), or "error". Empty when the
+   * lookup answered or the FQDN was not checked.
+   *
+   * @generated from field: string lookup_failure = 27;
+   */
+  lookupFailure: string;
+
+  /**
+   * shadowed_manual identifies the manual DNSRecord whose entry duplicates
+   * this discovered FQDN. The first writer stays authoritative: the discovered
+   * source (dns_record_ref, source_type, origin_ref) came first and the manual
+   * entry is ignored; it can be removed. Not set when no manual entry
+   * collides, or when the manual entry came first and is the one served.
+   *
+   * @generated from field: optional sreportal.v1.DNSRecordRef shadowed_manual = 28;
+   */
+  shadowedManual?: DNSRecordRef | undefined;
+
+  /**
+   * ownership_conflict is set while the targets of the FQDN oscillate between
+   * sets across reconciles, a sign that several external-dns deployments
+   * manage the record. Not set otherwise.
+   *
+   * @generated from field: optional sreportal.v1.OwnershipConflict ownership_conflict = 29;
+   */
+  ownershipConflict?: OwnershipConflict | undefined;
+
+  /**
+   * origin_cause summarises why the FQDN does not resolve, from the state and
+   * recent Warning events of its origin Service or Ingress (e.g. "ingress has
+   * no load balancer address"). Only set while sync_status is notavailable
+   * and something explains it.
+   *
+   * @generated from field: string origin_cause = 30;
+   */
+  originCause: string;
+
+  /**
+   * health_status summarises the last probe of the FQDN: "healthy",
+   * "degraded" (HTTP status of 400 or more, or invalid TLS certificate),
+   * "unhealthy" (unreachable), or empty when no probe is configured.
+   *
+   * @generated from field: string health_status = 31;
+   */
+  healthStatus: string;
+
+  /**
+   * last_probe_time is when the probe that last changed the outcome of the
+   * FQDN ran. It is not refreshed while the outcome stays the same.
+   *
+   * @generated from field: google.protobuf.Timestamp last_probe_time = 32;
+   */
+  lastProbeTime?: Timestamp;
+
+  /**
+   * http_status_code is the status code answered to the last HTTP(S) probe,
+   * 0 for other probes or when the FQDN was unreachable.
+   *
+   * @generated from field: int32 http_status_code = 33;
+   */
+  httpStatusCode: number;
+
+  /**
+   * probe_latency_ms is the duration of the successful probe that last
+   * changed the outcome, in milliseconds.
+   *
+   * @generated from field: int64 probe_latency_ms = 34;
+   */
+  probeLatencyMs: bigint;
+
+  /**
+   * tls_valid reports whether the certificate presented to the last HTTPS
+   * probe was valid for the FQDN. Not set for other probes.
+   *
+   * @generated from field: optional bool tls_valid = 35;
+   */
+  tlsValid?: boolean;
+
+  /**
+   * target_providers hints, for each public IP target, the cloud or hosting
+   * provider whose address ranges contain it. Only set when the operator's
+   * dnsRecord.targetProviders is enabled; targets outside the known ranges
+   * are not listed.
+   *
+   * @generated from field: repeated sreportal.v1.TargetProvider target_providers = 36;
+   */
+  targetProviders: TargetProvider[];
+
+  /**
+   * removal_reason explains why a tombstone disappeared: "record_deleted"
+   * (its DNSRecord was deleted, e.g. with its DNS resource or portal) or
+   * "no_longer_reported" (its source stopped reporting it, e.g. the origin
+   * resource was deleted or filtered out). Only set with removed_at.
+   *
+   * @generated from field: string removal_reason = 37;
+   */
+  removalReason: string;
+};
+
+/**
+ * Describes the message sreportal.v1.FQDN.
+ * Use `create(FQDNSchema)` to create a new message.
+ */
+export const FQDNSchema: GenMessage<FQDN> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 20);
+
+/**
+ * PublishEndpointsRequest is the full set of FQDNs an agent discovered
+ *
+ * @generated from message sreportal.v1.PublishEndpointsRequest
+ */
+export type PublishEndpointsRequest = Message<"sreportal.v1.PublishEndpointsRequest"> & {
+  /**
+   * agent names the publishing agent (optional). The agent is derived from
+   * the authenticated identity of the caller; when set, agent must match it.
+   * Each push replaces the FQDNs previously published by the same agent.
+   *
+   * @generated from field: string agent = 1;
+   */
+  agent: string;
+
+  /**
+   * portal is the local portal the FQDNs are shown in (required)
+   *
+   * @generated from field: string portal = 2;
+   */
+  portal: string;
+
+  /**
+   * fqdns are the discovered FQDNs. Only name, groups, description,
+   * record_type, targets and origin_ref are read.
+   *
+   * @generated from field: repeated sreportal.v1.FQDN fqdns = 3;
+   */
+  fqdns: FQDN[];
+};
+
+/**
+ * Describes the message sreportal.v1.PublishEndpointsRequest.
+ * Use `create(PublishEndpointsRequestSchema)` to create a new message.
+ */
+export const PublishEndpointsRequestSchema: GenMessage<PublishEndpointsRequest> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 21);
+
+/**
+ * PublishEndpointsResponse is returned once the FQDNs of an agent are stored
+ *
+ * @generated from message sreportal.v1.PublishEndpointsResponse
+ */
+export type PublishEndpointsResponse = Message<"sreportal.v1.PublishEndpointsResponse"> & {
+  /**
+   * fqdn_count is the number of FQDNs stored for the agent
+   *
+   * @generated from field: int32 fqdn_count = 1;
+   */
+  fqdnCount: number;
+};
+
+/**
+ * Describes the message sreportal.v1.PublishEndpointsResponse.
+ * Use `create(PublishEndpointsResponseSchema)` to create a new message.
+ */
+export const PublishEndpointsResponseSchema: GenMessage<PublishEndpointsResponse> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 22);
+
+/**
+ * OwnershipConflict describes targets alternating between sets
+ *
+ * @generated from message sreportal.v1.OwnershipConflict
+ */
+export type OwnershipConflict = Message<"sreportal.v1.OwnershipConflict"> & {
+  /**
+   * target_sets are the distinct target sets the FQDN alternates between
+   *
+   * @generated from field: repeated sreportal.v1.TargetSet target_sets = 1;
+   */
+  targetSets: TargetSet[];
+
+  /**
+   * detected_at is when the oscillation was first detected
+   *
+   * @generated from field: google.protobuf.Timestamp detected_at = 2;
+   */
+  detectedAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message sreportal.v1.OwnershipConflict.
+ * Use `create(OwnershipConflictSchema)` to create a new message.
+ */
+export const OwnershipConflictSchema: GenMessage<OwnershipConflict> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 23);
+
+/**
+ * TargetSet is a sorted set of targets
+ *
+ * @generated from message sreportal.v1.TargetSet
+ */
+export type TargetSet = Message<"sreportal.v1.TargetSet"> & {
+  /**
+   * targets are the targets of the set
+   *
+   * @generated from field: repeated string targets = 1;
+   */
+  targets: string[];
+};
+
+/**
+ * Describes the message sreportal.v1.TargetSet.
+ * Use `create(TargetSetSchema)` to create a new message.
+ */
+export const TargetSetSchema: GenMessage<TargetSet> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 24);
+
+/**
+ * TargetProvider is the provider hint of a public target
+ *
+ * @generated from message sreportal.v1.TargetProvider
+ */
+export type TargetProvider = Message<"sreportal.v1.TargetProvider"> & {
+  /**
+   * target is the IP address
+   *
+   * @generated from field: string target = 1;
+   */
+  target: string;
+
+  /**
+   * provider is the short provider name, e.g. "aws", "gcp" or "cloudflare"
+   *
+   * @generated from field: string provider = 2;
+   */
+  provider: string;
+
+  /**
+   * asn is the autonomous system announcing the provider range
+   *
+   * @generated from field: uint32 asn = 3;
+   */
+  asn: number;
+};
+
+/**
+ * Describes the message sreportal.v1.TargetProvider.
+ * Use `create(TargetProviderSchema)` to create a new message.
+ */
+export const TargetProviderSchema: GenMessage<TargetProvider> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 25);
+
+/**
+ * AddNoteRequest is the request for adding a note to an FQDN
+ *
+ * @generated from message sreportal.v1.AddNoteRequest
+ */
+export type AddNoteRequest = Message<"sreportal.v1.AddNoteRequest"> & {
+  /**
+   * portal is the portal the FQDN belongs to (required)
+   *
+   * @generated from field: string portal = 1;
+   */
+  portal: string;
+
+  /**
+   * fqdn is the FQDN the note is attached to (required, case-insensitive)
+   *
+   * @generated from field: string fqdn = 2;
+   */
+  fqdn: string;
+
+  /**
+   * author is who writes the note, required when authentication is disabled.
+   * Ignored otherwise: the note carries the authenticated identity
+   *
+   * @generated from field: string author = 3;
+   */
+  author: string;
+
+  /**
+   * text is the content of the note (required, up to 4096 characters)
+   *
+   * @generated from field: string text = 4;
+   */
+  text: string;
+};
+
+/**
+ * Describes the message sreportal.v1.AddNoteRequest.
+ * Use `create(AddNoteRequestSchema)` to create a new message.
+ */
+export const AddNoteRequestSchema: GenMessage<AddNoteRequest> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 26);
+
+/**
+ * AddNoteResponse contains the stored note
+ *
+ * @generated from message sreportal.v1.AddNoteResponse
+ */
+export type AddNoteResponse = Message<"sreportal.v1.AddNoteResponse"> & {
+  /**
+   * note is the stored note, with its creation time
+   *
+   * @generated from field: sreportal.v1.FQDNNote note = 1;
+   */
+  note?: FQDNNote | undefined;
+
+  /**
+   * note_count is the number of notes of the FQDN after the append
+   *
+   * @generated from field: int32 note_count = 2;
+   */
+  noteCount: number;
+};
+
+/**
+ * Describes the message sreportal.v1.AddNoteResponse.
+ * Use `create(AddNoteResponseSchema)` to create a new message.
+ */
+export const AddNoteResponseSchema: GenMessage<AddNoteResponse> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 27);
+
+/**
+ * ListNotesRequest is the request for listing the notes of an FQDN
+ *
+ * @generated from message sreportal.v1.ListNotesRequest
+ */
+export type ListNotesRequest = Message<"sreportal.v1.ListNotesRequest"> & {
+  /**
+   * portal is the portal the FQDN belongs to (required)
+   *
+   * @generated from field: string portal = 1;
+   */
+  portal: string;
+
+  /**
+   * fqdn is the FQDN to list notes for (required, case-insensitive)
+   *
+   * @generated from field: string fqdn = 2;
+   */
+  fqdn: string;
+};
+
+/**
+ * Describes the message sreportal.v1.ListNotesRequest.
+ * Use `create(ListNotesRequestSchema)` to create a new message.
+ */
+export const ListNotesRequestSchema: GenMessage<ListNotesRequest> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 28);
+
+/**
+ * ListNotesResponse contains the notes of an FQDN
+ *
+ * @generated from message sreportal.v1.ListNotesResponse
+ */
+export type ListNotesResponse = Message<"sreportal.v1.ListNotesResponse"> & {
+  /**
+   * notes are the notes of the FQDN, oldest first
+   *
+   * @generated from field: repeated sreportal.v1.FQDNNote notes = 1;
+   */
+  notes: FQDNNote[];
+};
+
+/**
+ * Describes the message sreportal.v1.ListNotesResponse.
+ * Use `create(ListNotesResponseSchema)` to create a new message.
+ */
+export const ListNotesResponseSchema: GenMessage<ListNotesResponse> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 29);
+
+/**
+ * FQDNNote is a free-text note attached to an FQDN
+ *
+ * @generated from message sreportal.v1.FQDNNote
+ */
+export type FQDNNote = Message<"sreportal.v1.FQDNNote"> & {
+  /**
+   * author is who wrote the note
+   *
+   * @generated from field: string author = 1;
+   */
+  author: string;
+
+  /**
+   * text is the content of the note
+   *
+   * @generated from field: string text = 2;
+   */
+  text: string;
+
+  /**
+   * created_at is when the note was added
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 3;
+   */
+  createdAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message sreportal.v1.FQDNNote.
+ * Use `create(FQDNNoteSchema)` to create a new message.
+ */
+export const FQDNNoteSchema: GenMessage<FQDNNote> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 30);
+
+/**
+ * GetShareLinkRequest is the request for getting the share link of an FQDN
+ *
+ * @generated from message sreportal.v1.GetShareLinkRequest
+ */
+export type GetShareLinkRequest = Message<"sreportal.v1.GetShareLinkRequest"> & {
+  /**
+   * portal is the portal the FQDN belongs to (required)
+   *
+   * @generated from field: string portal = 1;
+   */
+  portal: string;
+
+  /**
+   * fqdn is the FQDN to link to (required, case-insensitive)
+   *
+   * @generated from field: string fqdn = 2;
+   */
+  fqdn: string;
+
+  /**
+   * qr_code also returns the link as a QR code PNG image
+   *
+   * @generated from field: bool qr_code = 3;
+   */
+  qrCode: boolean;
+};
+
+/**
+ * Describes the message sreportal.v1.GetShareLinkRequest.
+ * Use `create(GetShareLinkRequestSchema)` to create a new message.
+ */
+export const GetShareLinkRequestSchema: GenMessage<GetShareLinkRequest> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 31);
+
+/**
+ * GetShareLinkResponse contains the share link of an FQDN
+ *
+ * @generated from message sreportal.v1.GetShareLinkResponse
+ */
+export type GetShareLinkResponse = Message<"sreportal.v1.GetShareLinkResponse"> & {
+  /**
+   * url is the absolute link, built from web.publicURL or the request
+   * origin; it is the path alone when neither is known
+   *
+   * @generated from field: string url = 1;
+   */
+  url: string;
+
+  /**
+   * path is the link path on the web server (/share/fqdn/<portal>/<fqdn>)
+   *
+   * @generated from field: string path = 2;
+   */
+  path: string;
+
+  /**
+   * qr_code_png is the QR code of url as a PNG image, set when requested
+   *
+   * @generated from field: bytes qr_code_png = 3;
+   */
+  qrCodePng: Uint8Array;
+};
+
+/**
+ * Describes the message sreportal.v1.GetShareLinkResponse.
+ * Use `create(GetShareLinkResponseSchema)` to create a new message.
+ */
+export const GetShareLinkResponseSchema: GenMessage<GetShareLinkResponse> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 32);
+
+/**
+ * GetUniquenessReportRequest is the request for the FQDN uniqueness report
+ *
+ * @generated from message sreportal.v1.GetUniquenessReportRequest
+ */
+export type GetUniquenessReportRequest = Message<"sreportal.v1.GetUniquenessReportRequest"> & {
+  /**
+   * portal keeps the issues listing this portal (empty for all portals)
+   *
+   * @generated from field: string portal = 1;
+   */
+  portal: string;
+};
+
+/**
+ * Describes the message sreportal.v1.GetUniquenessReportRequest.
+ * Use `create(GetUniquenessReportRequestSchema)` to create a new message.
+ */
+export const GetUniquenessReportRequestSchema: GenMessage<GetUniquenessReportRequest> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 33);
+
+/**
+ * GetUniquenessReportResponse contains the FQDN uniqueness issues
+ *
+ * @generated from message sreportal.v1.GetUniquenessReportResponse
+ */
+export type GetUniquenessReportResponse = Message<"sreportal.v1.GetUniquenessReportResponse"> & {
+  /**
+   * issues are sorted by severity, highest first, then by name and record type
+   *
+   * @generated from field: repeated sreportal.v1.UniquenessIssue issues = 1;
+   */
+  issues: UniquenessIssue[];
+};
+
+/**
+ * Describes the message sreportal.v1.GetUniquenessReportResponse.
+ * Use `create(GetUniquenessReportResponseSchema)` to create a new message.
+ */
+export const GetUniquenessReportResponseSchema: GenMessage<GetUniquenessReportResponse> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 34);
+
+/**
+ * UniquenessIssue is an FQDN listed in several portals, or whose contributing
+ * DNSRecords disagree on the targets
+ *
+ * @generated from message sreportal.v1.UniquenessIssue
+ */
+export type UniquenessIssue = Message<"sreportal.v1.UniquenessIssue"> & {
+  /**
+   * name is the fully qualified domain name
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * record_type is the DNS record type
+   *
+   * @generated from field: string record_type = 2;
+   */
+  recordType: string;
+
+  /**
+   * severity is high when discovered sources disagree on the targets, medium
+   * when a manual entry disagrees, low when the targets agree across portals
+   *
+   * @generated from field: string severity = 3;
+   */
+  severity: string;
+
+  /**
+   * portals are the portals listing the FQDN
+   *
+   * @generated from field: repeated string portals = 4;
+   */
+  portals: string[];
+
+  /**
+   * sources are the source types of the contributions, or "manual"
+   *
+   * @generated from field: repeated string sources = 5;
+   */
+  sources: string[];
+
+  /**
+   * contributions are the entries of each contributing DNSRecord
+   *
+   * @generated from field: repeated sreportal.v1.FQDNContribution contributions = 6;
+   */
+  contributions: FQDNContribution[];
+};
+
+/**
+ * Describes the message sreportal.v1.UniquenessIssue.
+ * Use `create(UniquenessIssueSchema)` to create a new message.
+ */
+export const UniquenessIssueSchema: GenMessage<UniquenessIssue> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 35);
+
+/**
+ * FQDNContribution is the entry a single DNSRecord contributes for an FQDN
+ *
+ * @generated from message sreportal.v1.FQDNContribution
+ */
+export type FQDNContribution = Message<"sreportal.v1.FQDNContribution"> & {
+  /**
+   * dns_record is the contributing DNSRecord
+   *
+   * @generated from field: sreportal.v1.DNSRecordRef dns_record = 1;
+   */
+  dnsRecord?: DNSRecordRef;
+
+  /**
+   * portal is the portal the DNSRecord belongs to
+   *
+   * @generated from field: string portal = 2;
+   */
+  portal: string;
+
+  /**
+   * source indicates where the entry came from (manual or external-dns)
+   *
+   * @generated from field: string source = 3;
+   */
+  source: string;
+
+  /**
+   * source_type is the external-dns source type (empty for manual entries)
+   *
+   * @generated from field: string source_type = 4;
+   */
+  sourceType: string;
+
+  /**
+   * targets are the targets of the entry
+   *
+   * @generated from field: repeated string targets = 5;
+   */
+  targets: string[];
+};
+
+/**
+ * Describes the message sreportal.v1.FQDNContribution.
+ * Use `create(FQDNContributionSchema)` to create a new message.
+ */
+export const FQDNContributionSchema: GenMessage<FQDNContribution> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_dns, 36);
+
+/**
+ * FQDNView selects the FQDN fields returned by ListFQDNs and StreamFQDNs
+ *
+ * @generated from enum sreportal.v1.FQDNView
+ */
+export enum FQDNView {
+  /**
+   * FQDN_VIEW_UNSPECIFIED is FULL, for backward compatibility
+   *
+   * @generated from enum value: FQDN_VIEW_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * FQDN_VIEW_BASIC returns the fields a table view needs: name, source,
+   * groups, description, record_type, portals, sync_status, target_scope,
+   * sensitive, overall_status, removed_at and removal_reason. Targets,
+   * timestamps, origin, ports, paths and the other detail fields are left
+   * empty.
+   *
+   * @generated from enum value: FQDN_VIEW_BASIC = 1;
+   */
+  BASIC = 1,
+
+  /**
+   * FQDN_VIEW_FULL returns every field
+   *
+   * @generated from enum value: FQDN_VIEW_FULL = 2;
+   */
+  FULL = 2,
+}
+
+/**
+ * Describes the enum sreportal.v1.FQDNView.
+ */
+export const FQDNViewSchema: GenEnum<FQDNView> = /*@__PURE__*/
+  enumDesc(file_sreportal_v1_dns, 0);
+
+/**
+ * ManualEntryOperationType is the kind of edit of a ManualEntryOperation
+ *
+ * @generated from enum sreportal.v1.ManualEntryOperationType
+ */
+export enum ManualEntryOperationType {
+  /**
+   * @generated from enum value: MANUAL_ENTRY_OPERATION_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * add a new entry; fails with ALREADY_EXISTS if it exists
+   *
+   * @generated from enum value: MANUAL_ENTRY_OPERATION_TYPE_ADD = 1;
+   */
+  ADD = 1,
+
+  /**
+   * replace an existing entry; fails with NOT_FOUND if it does not exist
+   *
+   * @generated from enum value: MANUAL_ENTRY_OPERATION_TYPE_UPDATE = 2;
+   */
+  UPDATE = 2,
+
+  /**
+   * remove an existing entry; fails with NOT_FOUND if it does not exist
+   *
+   * @generated from enum value: MANUAL_ENTRY_OPERATION_TYPE_DELETE = 3;
+   */
+  DELETE = 3,
+}
+
+/**
+ * Describes the enum sreportal.v1.ManualEntryOperationType.
+ */
+export const ManualEntryOperationTypeSchema: GenEnum<ManualEntryOperationType> = /*@__PURE__*/
+  enumDesc(file_sreportal_v1_dns, 1);
+
+/**
+ * IgnoreResultStatus is the outcome of an IgnoreResult
+ *
+ * @generated from enum sreportal.v1.IgnoreResultStatus
+ */
+export enum IgnoreResultStatus {
+  /**
+   * @generated from enum value: IGNORE_RESULT_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * the annotation was updated (or would be, on a dry run)
+   *
+   * @generated from enum value: IGNORE_RESULT_STATUS_UPDATED = 1;
+   */
+  UPDATED = 1,
+
+  /**
+   * the annotation already had the requested state
+   *
+   * @generated from enum value: IGNORE_RESULT_STATUS_UNCHANGED = 2;
+   */
+  UNCHANGED = 2,
+
+  /**
+   * the caller or the operator may not patch the resource
+   *
+   * @generated from enum value: IGNORE_RESULT_STATUS_DENIED = 3;
+   */
+  DENIED = 3,
+
+  /**
+   * the FQDN is not in the portal, or the resource does not exist
+   *
+   * @generated from enum value: IGNORE_RESULT_STATUS_NOT_FOUND = 4;
+   */
+  NOT_FOUND = 4,
+
+  /**
+   * the FQDN has no origin resource (e.g. manual entries) or its kind
+   * cannot be annotated
+   *
+   * @generated from enum value: IGNORE_RESULT_STATUS_UNSUPPORTED = 5;
+   */
+  UNSUPPORTED = 5,
+
+  /**
+   * the API server rejected the change
+   *
+   * @generated from enum value: IGNORE_RESULT_STATUS_FAILED = 6;
+   */
+  FAILED = 6,
+}
+
+/**
+ * Describes the enum sreportal.v1.IgnoreResultStatus.
+ */
+export const IgnoreResultStatusSchema: GenEnum<IgnoreResultStatus> = /*@__PURE__*/
+  enumDesc(file_sreportal_v1_dns, 2);
+
+/**
+ * UpdateType represents the type of update
+ *
+ * @generated from enum sreportal.v1.UpdateType
+ */
+export enum UpdateType {
+  /**
+   * @generated from enum value: UPDATE_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: UPDATE_TYPE_ADDED = 1;
+   */
+  ADDED = 1,
+
+  /**
+   * @generated from enum value: UPDATE_TYPE_MODIFIED = 2;
+   */
+  MODIFIED = 2,
+
+  /**
+   * @generated from enum value: UPDATE_TYPE_DELETED = 3;
+   */
+  DELETED = 3,
+
+  /**
+   * UPDATE_TYPE_HEARTBEAT keeps an idle stream open; it carries no FQDN
+   *
+   * @generated from enum value: UPDATE_TYPE_HEARTBEAT = 4;
+   */
+  HEARTBEAT = 4,
+
+  /**
+   * UPDATE_TYPE_SYNCED follows the initial state of a stream: every FQDN
+   * matching the filters was sent as ADDED before it. It carries no FQDN
+   *
+   * @generated from enum value: UPDATE_TYPE_SYNCED = 5;
+   */
+  SYNCED = 5,
+}
+
+/**
+ * Describes the enum sreportal.v1.UpdateType.
+ */
+export const UpdateTypeSchema: GenEnum<UpdateType> = /*@__PURE__*/
+  enumDesc(file_sreportal_v1_dns, 3);
+
+/**
+ * OverallStatus is the health badge of an FQDN. The first matching rule wins:
+ * CRITICAL when the probe failed, the FQDN does not resolve or the certificate
+ * seen by the last HTTPS probe has expired; WARNING when it resolves to other
+ * targets or differently inside and outside the cluster, when its targets
+ * oscillate (ownership_conflict), or when the certificate expires within 14
+ * days; HEALTHY when it is in sync or the probe succeeded; UNKNOWN otherwise.
+ *
+ * @generated from enum sreportal.v1.OverallStatus
+ */
+export enum OverallStatus {
+  /**
+   * @generated from enum value: OVERALL_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: OVERALL_STATUS_UNKNOWN = 1;
+   */
+  UNKNOWN = 1,
+
+  /**
+   * @generated from enum value: OVERALL_STATUS_HEALTHY = 2;
+   */
+  HEALTHY = 2,
+
+  /**
+   * @generated from enum value: OVERALL_STATUS_WARNING = 3;
+   */
+  WARNING = 3,
+
+  /**
+   * @generated from enum value: OVERALL_STATUS_CRITICAL = 4;
+   */
+  CRITICAL = 4,
+
+  /**
+   * the FQDN disappeared from its sources (tombstone)
+   *
+   * @generated from enum value: OVERALL_STATUS_REMOVED = 5;
+   */
+  REMOVED = 5,
+}
+
+/**
+ * Describes the enum sreportal.v1.OverallStatus.
+ */
+export const OverallStatusSchema: GenEnum<OverallStatus> = /*@__PURE__*/
+  enumDesc(file_sreportal_v1_dns, 4);
+
+/**
+ * DNSService provides DNS record management and discovery
+ *
+ * @generated from service sreportal.v1.DNSService
+ */
+export const DNSService: GenService<{
+  /**
+   * ListFQDNs returns all aggregated FQDNs from DNS resources
+   *
+   * @generated from rpc sreportal.v1.DNSService.ListFQDNs
+   */
+  listFQDNs: {
+    methodKind: "unary";
+    input: typeof ListFQDNsRequestSchema;
+    output: typeof ListFQDNsResponseSchema;
+  },
   /**
    * StreamFQDNs streams FQDN updates in real-time
    *
@@ -378,6 +1958,96 @@ export const DNSService: GenService<{
     input: typeof StreamFQDNsRequestSchema;
     output: typeof StreamFQDNsResponseSchema;
   },
+  /**
+   * FederatedSearch searches FQDNs on this instance and on every remote portal,
+   * merging results that exist on several sites
+   *
+   * @generated from rpc sreportal.v1.DNSService.FederatedSearch
+   */
+  federatedSearch: {
+    methodKind: "unary";
+    input: typeof FederatedSearchRequestSchema;
+    output: typeof FederatedSearchResponseSchema;
+  },
+  /**
+   * BatchUpdateManualEntries applies a list of add/update/delete operations
+   * to the manual entries of a portal in a single DNSRecord update: either
+   * every operation is applied or none is
+   *
+   * @generated from rpc sreportal.v1.DNSService.BatchUpdateManualEntries
+   */
+  batchUpdateManualEntries: {
+    methodKind: "unary";
+    input: typeof BatchUpdateManualEntriesRequestSchema;
+    output: typeof BatchUpdateManualEntriesResponseSchema;
+  },
+  /**
+   * SetFQDNsIgnored sets or clears the sreportal.io/ignore annotation of the
+   * resources FQDNs are discovered from, which drops them from (or restores
+   * them to) every portal without editing their manifests
+   *
+   * @generated from rpc sreportal.v1.DNSService.SetFQDNsIgnored
+   */
+  setFQDNsIgnored: {
+    methodKind: "unary";
+    input: typeof SetFQDNsIgnoredRequestSchema;
+    output: typeof SetFQDNsIgnoredResponseSchema;
+  },
+  /**
+   * PublishEndpoints replaces the FQDNs an agent discovered in its cluster
+   * for a local portal. Sent by sreportal instances running in agent mode
+   *
+   * @generated from rpc sreportal.v1.DNSService.PublishEndpoints
+   */
+  publishEndpoints: {
+    methodKind: "unary";
+    input: typeof PublishEndpointsRequestSchema;
+    output: typeof PublishEndpointsResponseSchema;
+  },
+  /**
+   * AddNote appends a free-text note to an FQDN of a portal, recording its
+   * author and creation time. Notes are never edited or deleted
+   *
+   * @generated from rpc sreportal.v1.DNSService.AddNote
+   */
+  addNote: {
+    methodKind: "unary";
+    input: typeof AddNoteRequestSchema;
+    output: typeof AddNoteResponseSchema;
+  },
+  /**
+   * ListNotes returns the notes of an FQDN of a portal, oldest first
+   *
+   * @generated from rpc sreportal.v1.DNSService.ListNotes
+   */
+  listNotes: {
+    methodKind: "unary";
+    input: typeof ListNotesRequestSchema;
+    output: typeof ListNotesResponseSchema;
+  },
+  /**
+   * GetShareLink returns a stable link to an FQDN of a portal, and optionally
+   * its QR code. The link is resolved by the server, so it stays valid across
+   * UI releases and portal subPath changes
+   *
+   * @generated from rpc sreportal.v1.DNSService.GetShareLink
+   */
+  getShareLink: {
+    methodKind: "unary";
+    input: typeof GetShareLinkRequestSchema;
+    output: typeof GetShareLinkResponseSchema;
+  },
+  /**
+   * GetUniquenessReport lists the FQDNs listed in several portals, or whose
+   * contributing DNSRecords disagree on the targets, highest severity first
+   *
+   * @generated from rpc sreportal.v1.DNSService.GetUniquenessReport
+   */
+  getUniquenessReport: {
+    methodKind: "unary";
+    input: typeof GetUniquenessReportRequestSchema;
+    output: typeof GetUniquenessReportResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_sreportal_v1_dns, 0);
 
@@ -0,0 +1,32 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file sreportal/v1/log.proto (package sreportal.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { StreamLogsRequest, StreamLogsResponse } from "./log_pb.js";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * LogService streams the operator logs to platform admins
+ *
+ * @generated from service sreportal.v1.LogService
+ */
+export const LogService = {
+  typeName: "sreportal.v1.LogService",
+  methods: {
+    /**
+     * StreamLogs tails the structured logs of the operator instance serving the
+     * request, starting with the most recent entries it keeps. Requires
+     * authentication.
+     *
+     * @generated from rpc sreportal.v1.LogService.StreamLogs
+     */
+    streamLogs: {
+      name: "StreamLogs",
+      I: StreamLogsRequest,
+      O: StreamLogsResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+  }
+} as const;
+
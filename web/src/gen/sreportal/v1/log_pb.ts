@@ -0,0 +1,142 @@
+// @generated by protoc-gen-es v2.12.0 with parameter "target=ts"
+// @generated from file sreportal/v1/log.proto (package sreportal.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file sreportal/v1/log.proto.
+ */
+export const file_sreportal_v1_log: GenFile = /*@__PURE__*/
+  fileDesc("ChZzcmVwb3J0YWwvdjEvbG9nLnByb3RvEgxzcmVwb3J0YWwudjEiSgoRU3RyZWFtTG9nc1JlcXVlc3QSEgoKY29udHJvbGxlchgBIAEoCRIOCgZwb3J0YWwYAiABKAkSEQoJbWluX2xldmVsGAMgASgJIjsKElN0cmVhbUxvZ3NSZXNwb25zZRIlCgVlbnRyeRgBIAEoCzIWLnNyZXBvcnRhbC52MS5Mb2dFbnRyeSLTAQoITG9nRW50cnkSKAoEdGltZRgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDQoFbGV2ZWwYAiABKAkSDgoGbG9nZ2VyGAMgASgJEg8KB21lc3NhZ2UYBCABKAkSOgoKYXR0cmlidXRlcxgFIAMoCzImLnNyZXBvcnRhbC52MS5Mb2dFbnRyeS5BdHRyaWJ1dGVzRW50cnkaMQoPQXR0cmlidXRlc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEyXwoKTG9nU2VydmljZRJRCgpTdHJlYW1Mb2dzEh8uc3JlcG9ydGFsLnYxLlN0cmVhbUxvZ3NSZXF1ZXN0GiAuc3JlcG9ydGFsLnYxLlN0cmVhbUxvZ3NSZXNwb25zZTABQrgBChBjb20uc3JlcG9ydGFsLnYxQghMb2dQcm90b1ABWklnaXRodWIuY29tL2dvbGdvdGgzMS9zcmVwb3J0YWwvaW50ZXJuYWwvZ3JwYy9nZW4vc3JlcG9ydGFsL3YxO3NyZXBvcnRhbHYxogIDU1hYqgIMU3JlcG9ydGFsLlYxygIMU3JlcG9ydGFsXFYx4gIYU3JlcG9ydGFsXFYxXEdQQk1ldGFkYXRh6gINU3JlcG9ydGFsOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp]);
+
+/**
+ * StreamLogsRequest filters the streamed log entries
+ *
+ * @generated from message sreportal.v1.StreamLogsRequest
+ */
+export type StreamLogsRequest = Message<"sreportal.v1.StreamLogsRequest"> & {
+  /**
+   * controller keeps the entries of a controller (e.g. "dns"), matched against
+   * the controller attribute and the logger name (empty for all)
+   *
+   * @generated from field: string controller = 1;
+   */
+  controller: string;
+
+  /**
+   * portal keeps the entries carrying this portal attribute (empty for all)
+   *
+   * @generated from field: string portal = 2;
+   */
+  portal: string;
+
+  /**
+   * min_level is the lowest level streamed: debug, info, warn or error
+   * (empty for info)
+   *
+   * @generated from field: string min_level = 3;
+   */
+  minLevel: string;
+};
+
+/**
+ * Describes the message sreportal.v1.StreamLogsRequest.
+ * Use `create(StreamLogsRequestSchema)` to create a new message.
+ */
+export const StreamLogsRequestSchema: GenMessage<StreamLogsRequest> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_log, 0);
+
+/**
+ * StreamLogsResponse carries one log entry
+ *
+ * @generated from message sreportal.v1.StreamLogsResponse
+ */
+export type StreamLogsResponse = Message<"sreportal.v1.StreamLogsResponse"> & {
+  /**
+   * @generated from field: sreportal.v1.LogEntry entry = 1;
+   */
+  entry?: LogEntry | undefined;
+};
+
+/**
+ * Describes the message sreportal.v1.StreamLogsResponse.
+ * Use `create(StreamLogsResponseSchema)` to create a new message.
+ */
+export const StreamLogsResponseSchema: GenMessage<StreamLogsResponse> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_log, 1);
+
+/**
+ * LogEntry is one structured log record of the operator
+ *
+ * @generated from message sreportal.v1.LogEntry
+ */
+export type LogEntry = Message<"sreportal.v1.LogEntry"> & {
+  /**
+   * time is when the record was logged
+   *
+   * @generated from field: google.protobuf.Timestamp time = 1;
+   */
+  time?: Timestamp | undefined;
+
+  /**
+   * level is the record level: trace, debug, info, warn or error
+   *
+   * @generated from field: string level = 2;
+   */
+  level: string;
+
+  /**
+   * logger is the name of the logger, "/"-separated (e.g. "agent-ingester")
+   *
+   * @generated from field: string logger = 3;
+   */
+  logger: string;
+
+  /**
+   * message is the log message
+   *
+   * @generated from field: string message = 4;
+   */
+  message: string;
+
+  /**
+   * attributes are the structured attributes of the record, as strings
+   *
+   * @generated from field: map<string, string> attributes = 5;
+   */
+  attributes: { [key: string]: string };
+};
+
+/**
+ * Describes the message sreportal.v1.LogEntry.
+ * Use `create(LogEntrySchema)` to create a new message.
+ */
+export const LogEntrySchema: GenMessage<LogEntry> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_log, 2);
+
+/**
+ * LogService streams the operator logs to platform admins
+ *
+ * @generated from service sreportal.v1.LogService
+ */
+export const LogService: GenService<{
+  /**
+   * StreamLogs tails the structured logs of the operator instance serving the
+   * request, starting with the most recent entries it keeps. Requires
+   * authentication.
+   *
+   * @generated from rpc sreportal.v1.LogService.StreamLogs
+   */
+  streamLogs: {
+    methodKind: "server_streaming";
+    input: typeof StreamLogsRequestSchema;
+    output: typeof StreamLogsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_sreportal_v1_log, 0);
+
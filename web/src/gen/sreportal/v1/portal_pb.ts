@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file sreportal/v1/portal.proto.
  */
 export const file_sreportal_v1_portal: GenFile = /*@__PURE__*/
-  fileDesc("ChlzcmVwb3J0YWwvdjEvcG9ydGFsLnByb3RvEgxzcmVwb3J0YWwudjEiJwoSTGlzdFBvcnRhbHNSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCSI8ChNMaXN0UG9ydGFsc1Jlc3BvbnNlEiUKB3BvcnRhbHMYASADKAsyFC5zcmVwb3J0YWwudjEuUG9ydGFsIuwBCgZQb3J0YWwSDAoEbmFtZRgBIAEoCRINCgV0aXRsZRgCIAEoCRIMCgRtYWluGAMgASgIEhAKCHN1Yl9wYXRoGAQgASgJEhEKCW5hbWVzcGFjZRgFIAEoCRINCgVyZWFkeRgGIAEoCBILCgN1cmwYByABKAkSEQoJaXNfcmVtb3RlGAggASgIEjMKC3JlbW90ZV9zeW5jGAkgASgLMh4uc3JlcG9ydGFsLnYxLlJlbW90ZVN5bmNTdGF0dXMSLgoIZmVhdHVyZXMYCiABKAsyHC5zcmVwb3J0YWwudjEuUG9ydGFsRmVhdHVyZXMihQEKDlBvcnRhbEZlYXR1cmVzEgsKA2RucxgBIAEoCBIQCghyZWxlYXNlcxgCIAEoCBIWCg5uZXR3b3JrX3BvbGljeRgDIAEoCBIOCgZhbGVydHMYBCABKAgSEwoLc3RhdHVzX3BhZ2UYBSABKAgSFwoPaW1hZ2VfaW52ZW50b3J5GAYgASgIIm0KEFJlbW90ZVN5bmNTdGF0dXMSFgoObGFzdF9zeW5jX3RpbWUYASABKAkSFwoPbGFzdF9zeW5jX2Vycm9yGAIgASgJEhQKDHJlbW90ZV90aXRsZRgDIAEoCRISCgpmcWRuX2NvdW50GAQgASgFMmMKDVBvcnRhbFNlcnZpY2USUgoLTGlzdFBvcnRhbHMSIC5zcmVwb3J0YWwudjEuTGlzdFBvcnRhbHNSZXF1ZXN0GiEuc3JlcG9ydGFsLnYxLkxpc3RQb3J0YWxzUmVzcG9uc2VCuwEKEGNvbS5zcmVwb3J0YWwudjFCC1BvcnRhbFByb3RvUAFaSWdpdGh1Yi5jb20vZ29sZ290aDMxL3NyZXBvcnRhbC9pbnRlcm5hbC9ncnBjL2dlbi9zcmVwb3J0YWwvdjE7c3JlcG9ydGFsdjGiAgNTWFiqAgxTcmVwb3J0YWwuVjHKAgxTcmVwb3J0YWxcVjHiAhhTcmVwb3J0YWxcVjFcR1BCTWV0YWRhdGHqAg1TcmVwb3J0YWw6OlYxYgZwcm90bzM");
+  fileDesc("ChlzcmVwb3J0YWwvdjEvcG9ydGFsLnByb3RvEgxzcmVwb3J0YWwudjEiJwoSTGlzdFBvcnRhbHNSZXF1ZXN0EhEKCW5hbWVzcGFjZRgBIAEoCSI8ChNMaXN0UG9ydGFsc1Jlc3BvbnNlEiUKB3BvcnRhbHMYASADKAsyFC5zcmVwb3J0YWwudjEuUG9ydGFsIsUCCgZQb3J0YWwSDAoEbmFtZRgBIAEoCRINCgV0aXRsZRgCIAEoCRIMCgRtYWluGAMgASgIEhAKCHN1Yl9wYXRoGAQgASgJEhEKCW5hbWVzcGFjZRgFIAEoCRINCgVyZWFkeRgGIAEoCBILCgN1cmwYByABKAkSEQoJaXNfcmVtb3RlGAggASgIEjMKC3JlbW90ZV9zeW5jGAkgASgLMh4uc3JlcG9ydGFsLnYxLlJlbW90ZVN5bmNTdGF0dXMSLgoIZmVhdHVyZXMYCiABKAsyHC5zcmVwb3J0YWwudjEuUG9ydGFsRmVhdHVyZXMSJwoFbGlua3MYCyADKAsyGC5zcmVwb3J0YWwudjEuUG9ydGFsTGluaxIuCghicmFuZGluZxgMIAEoCzIcLnNyZXBvcnRhbC52MS5Qb3J0YWxCcmFuZGluZyKFAQoOUG9ydGFsRmVhdHVyZXMSCwoDZG5zGAEgASgIEhAKCHJlbGVhc2VzGAIgASgIEhYKDm5ldHdvcmtfcG9saWN5GAMgASgIEg4KBmFsZXJ0cxgEIAEoCBITCgtzdGF0dXNfcGFnZRgFIAEoCBIXCg9pbWFnZV9pbnZlbnRvcnkYBiABKAgibQoQUmVtb3RlU3luY1N0YXR1cxIWCg5sYXN0X3N5bmNfdGltZRgBIAEoCRIXCg9sYXN0X3N5bmNfZXJyb3IYAiABKAkSFAoMcmVtb3RlX3RpdGxlGAMgASgJEhIKCmZxZG5fY291bnQYBCABKAUiKAoKUG9ydGFsTGluaxINCgV0aXRsZRgBIAEoCRILCgN1cmwYAiABKAkiMQoOUG9ydGFsQnJhbmRpbmcSEAoIbG9nb191cmwYASABKAkSDQoFY29sb3IYAiABKAkiKQoXR2V0UG9ydGFsQ29udGVudFJlcXVlc3QSDgoGcG9ydGFsGAEgASgJIkYKGEdldFBvcnRhbENvbnRlbnRSZXNwb25zZRIqCgZibG9ja3MYASADKAsyGi5zcmVwb3J0YWwudjEuQ29udGVudEJsb2NrIkEKDENvbnRlbnRCbG9jaxISCgpjb25maWdfbWFwGAEgASgJEgsKA2tleRgCIAEoCRIQCghtYXJrZG93bhgDIAEoCSI5ChRQcm9tb3RlVG9NYWluUmVxdWVzdBIOCgZwb3J0YWwYASABKAkSEQoJbmFtZXNwYWNlGAIgASgJIigKFVByb21vdGVUb01haW5SZXNwb25zZRIPCgdkZW1vdGVkGAEgAygJMqACCg1Qb3J0YWxTZXJ2aWNlElIKC0xpc3RQb3J0YWxzEiAuc3JlcG9ydGFsLnYxLkxpc3RQb3J0YWxzUmVxdWVzdBohLnNyZXBvcnRhbC52MS5MaXN0UG9ydGFsc1Jlc3BvbnNlEmEKEEdldFBvcnRhbENvbnRlbnQSJS5zcmVwb3J0YWwudjEuR2V0UG9ydGFsQ29udGVudFJlcXVlc3QaJi5zcmVwb3J0YWwudjEuR2V0UG9ydGFsQ29udGVudFJlc3BvbnNlElgKDVByb21vdGVUb01haW4SIi5zcmVwb3J0YWwudjEuUHJvbW90ZVRvTWFpblJlcXVlc3QaIy5zcmVwb3J0YWwudjEuUHJvbW90ZVRvTWFpblJlc3BvbnNlQrsBChBjb20uc3JlcG9ydGFsLnYxQgtQb3J0YWxQcm90b1ABWklnaXRodWIuY29tL2dvbGdvdGgzMS9zcmVwb3J0YWwvaW50ZXJuYWwvZ3JwYy9nZW4vc3JlcG9ydGFsL3YxO3NyZXBvcnRhbHYxogIDU1hYqgIMU3JlcG9ydGFsLlYxygIMU3JlcG9ydGFsXFYx4gIYU3JlcG9ydGFsXFYxXEdQQk1ldGFkYXRh6gINU3JlcG9ydGFsOjpWMWIGcHJvdG8z");
 
 /**
  * ListPortalsRequest is the request for listing portals
@@ -129,6 +129,20 @@ export type Portal = Message<"sreportal.v1.Portal"> & {
    * @generated from field: sreportal.v1.PortalFeatures features = 10;
    */
   features?: PortalFeatures | undefined;
+
+  /**
+   * links are external links shown in the portal menu
+   *
+   * @generated from field: repeated sreportal.v1.PortalLink links = 11;
+   */
+  links: PortalLink[];
+
+  /**
+   * branding customizes how the portal is displayed (unset when not configured)
+   *
+   * @generated from field: sreportal.v1.PortalBranding branding = 12;
+   */
+  branding?: PortalBranding | undefined;
 };
 
 /**
@@ -236,6 +250,189 @@ export type RemoteSyncStatus = Message<"sreportal.v1.RemoteSyncStatus"> & {
 export const RemoteSyncStatusSchema: GenMessage<RemoteSyncStatus> = /*@__PURE__*/
   messageDesc(file_sreportal_v1_portal, 4);
 
+/**
+ * PortalLink is an external link shown in the portal menu
+ *
+ * @generated from message sreportal.v1.PortalLink
+ */
+export type PortalLink = Message<"sreportal.v1.PortalLink"> & {
+  /**
+   * title is the link label
+   *
+   * @generated from field: string title = 1;
+   */
+  title: string;
+
+  /**
+   * url is the link target
+   *
+   * @generated from field: string url = 2;
+   */
+  url: string;
+};
+
+/**
+ * Describes the message sreportal.v1.PortalLink.
+ * Use `create(PortalLinkSchema)` to create a new message.
+ */
+export const PortalLinkSchema: GenMessage<PortalLink> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_portal, 5);
+
+/**
+ * PortalBranding customizes how a portal is displayed
+ *
+ * @generated from message sreportal.v1.PortalBranding
+ */
+export type PortalBranding = Message<"sreportal.v1.PortalBranding"> & {
+  /**
+   * logo_url is the URL of the logo shown in the portal menu
+   *
+   * @generated from field: string logo_url = 1;
+   */
+  logoUrl: string;
+
+  /**
+   * color is the accent color of the portal, as "#rrggbb"
+   *
+   * @generated from field: string color = 2;
+   */
+  color: string;
+};
+
+/**
+ * Describes the message sreportal.v1.PortalBranding.
+ * Use `create(PortalBrandingSchema)` to create a new message.
+ */
+export const PortalBrandingSchema: GenMessage<PortalBranding> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_portal, 6);
+
+/**
+ * GetPortalContentRequest is the request for getting the content of a portal
+ *
+ * @generated from message sreportal.v1.GetPortalContentRequest
+ */
+export type GetPortalContentRequest = Message<"sreportal.v1.GetPortalContentRequest"> & {
+  /**
+   * portal is the portal name (required)
+   *
+   * @generated from field: string portal = 1;
+   */
+  portal: string;
+};
+
+/**
+ * Describes the message sreportal.v1.GetPortalContentRequest.
+ * Use `create(GetPortalContentRequestSchema)` to create a new message.
+ */
+export const GetPortalContentRequestSchema: GenMessage<GetPortalContentRequest> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_portal, 7);
+
+/**
+ * GetPortalContentResponse contains the content blocks of a portal
+ *
+ * @generated from message sreportal.v1.GetPortalContentResponse
+ */
+export type GetPortalContentResponse = Message<"sreportal.v1.GetPortalContentResponse"> & {
+  /**
+   * blocks are the content blocks, in spec.contentRefs order
+   *
+   * @generated from field: repeated sreportal.v1.ContentBlock blocks = 1;
+   */
+  blocks: ContentBlock[];
+};
+
+/**
+ * Describes the message sreportal.v1.GetPortalContentResponse.
+ * Use `create(GetPortalContentResponseSchema)` to create a new message.
+ */
+export const GetPortalContentResponseSchema: GenMessage<GetPortalContentResponse> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_portal, 8);
+
+/**
+ * ContentBlock is a markdown document read from a ConfigMap key
+ *
+ * @generated from message sreportal.v1.ContentBlock
+ */
+export type ContentBlock = Message<"sreportal.v1.ContentBlock"> & {
+  /**
+   * config_map is the name of the ConfigMap holding the block
+   *
+   * @generated from field: string config_map = 1;
+   */
+  configMap: string;
+
+  /**
+   * key is the ConfigMap key of the block
+   *
+   * @generated from field: string key = 2;
+   */
+  key: string;
+
+  /**
+   * markdown is the block content
+   *
+   * @generated from field: string markdown = 3;
+   */
+  markdown: string;
+};
+
+/**
+ * Describes the message sreportal.v1.ContentBlock.
+ * Use `create(ContentBlockSchema)` to create a new message.
+ */
+export const ContentBlockSchema: GenMessage<ContentBlock> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_portal, 9);
+
+/**
+ * PromoteToMainRequest is the request for promoting a portal to main
+ *
+ * @generated from message sreportal.v1.PromoteToMainRequest
+ */
+export type PromoteToMainRequest = Message<"sreportal.v1.PromoteToMainRequest"> & {
+  /**
+   * portal is the portal name (required)
+   *
+   * @generated from field: string portal = 1;
+   */
+  portal: string;
+
+  /**
+   * namespace is the portal namespace, required when several portals share
+   * the name
+   *
+   * @generated from field: string namespace = 2;
+   */
+  namespace: string;
+};
+
+/**
+ * Describes the message sreportal.v1.PromoteToMainRequest.
+ * Use `create(PromoteToMainRequestSchema)` to create a new message.
+ */
+export const PromoteToMainRequestSchema: GenMessage<PromoteToMainRequest> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_portal, 10);
+
+/**
+ * PromoteToMainResponse is the result of a promotion
+ *
+ * @generated from message sreportal.v1.PromoteToMainResponse
+ */
+export type PromoteToMainResponse = Message<"sreportal.v1.PromoteToMainResponse"> & {
+  /**
+   * demoted lists the portals that were main before, as "namespace/name"
+   *
+   * @generated from field: repeated string demoted = 1;
+   */
+  demoted: string[];
+};
+
+/**
+ * Describes the message sreportal.v1.PromoteToMainResponse.
+ * Use `create(PromoteToMainResponseSchema)` to create a new message.
+ */
+export const PromoteToMainResponseSchema: GenMessage<PromoteToMainResponse> = /*@__PURE__*/
+  messageDesc(file_sreportal_v1_portal, 11);
+
 /**
  * PortalService provides portal management
  *
@@ -252,6 +449,28 @@ export const PortalService: GenService<{
     input: typeof ListPortalsRequestSchema;
     output: typeof ListPortalsResponseSchema;
   },
+  /**
+   * GetPortalContent returns the markdown content blocks (announcements,
+   * onboarding docs) of a portal, read from the ConfigMaps of spec.contentRefs
+   *
+   * @generated from rpc sreportal.v1.PortalService.GetPortalContent
+   */
+  getPortalContent: {
+    methodKind: "unary";
+    input: typeof GetPortalContentRequestSchema;
+    output: typeof GetPortalContentResponseSchema;
+  },
+  /**
+   * PromoteToMain makes a local portal the main portal, clearing spec.main on
+   * the previous one (requires authentication)
+   *
+   * @generated from rpc sreportal.v1.PortalService.PromoteToMain
+   */
+  promoteToMain: {
+    methodKind: "unary";
+    input: typeof PromoteToMainRequestSchema;
+    output: typeof PromoteToMainResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_sreportal_v1_portal, 0);
 
@@ -0,0 +1,57 @@
+import { FileTextIcon } from "lucide-react";
+import { useParams } from "react-router";
+
+import { ErrorAlert } from "@/components/ErrorAlert";
+import { PageRefreshButton } from "@/components/PageRefreshButton";
+import { Card, CardContent, CardHeader, CardTitle } from "@/components/ui/card";
+import { Skeleton } from "@/components/ui/skeleton";
+import { portalRefForRoute } from "@/features/portal/domain/portal.types";
+import { usePortalContent } from "@/features/portal/hooks/usePortalContent";
+import { usePortals } from "@/features/portal/hooks/usePortals";
+import { MarkdownBlock } from "@/features/portal/ui/MarkdownBlock";
+
+export function DocsPage() {
+  const { portalName = "main" } = useParams<{ portalName: string }>();
+  const { portals } = usePortals();
+  const portalRef = portalRefForRoute(portals, portalName);
+  const { blocks, isLoading, isFetching, error, refetch } =
+    usePortalContent(portalRef);
+
+  return (
+    <div className="max-w-screen-xl mx-auto px-4 py-6 space-y-6">
+      <div className="flex items-center justify-between gap-4 flex-wrap">
+        <h1 className="font-display text-3xl tracking-tight">
+          Portal <span className="italic text-primary">Docs</span>
+        </h1>
+        <PageRefreshButton onRefresh={refetch} isFetching={isFetching} />
+      </div>
+
+      {error && <ErrorAlert title="Failed to load portal content" error={error} />}
+
+      {isLoading && <Skeleton className="h-40 w-full rounded-lg" />}
+
+      {!isLoading && !error && blocks.length === 0 && (
+        <div className="flex flex-col items-center gap-2 py-16 text-center text-muted-foreground">
+          <FileTextIcon className="size-8" aria-hidden="true" />
+          <p className="text-sm">
+            No content. Reference ConfigMaps in the Portal spec.contentRefs to
+            publish announcements and onboarding docs here.
+          </p>
+        </div>
+      )}
+
+      {blocks.map((block) => (
+        <Card key={`${block.configMap}/${block.key}`} className="border-border/70">
+          <CardHeader>
+            <CardTitle className="text-[10px] font-mono uppercase tracking-[0.16em] text-muted-foreground">
+              {block.configMap} · {block.key}
+            </CardTitle>
+          </CardHeader>
+          <CardContent>
+            <MarkdownBlock markdown={block.markdown} />
+          </CardContent>
+        </Card>
+      ))}
+    </div>
+  );
+}
@@ -1,7 +1,8 @@
 import { useCallback, useMemo } from "react";
-import { useParams } from "react-router";
+import { useParams, useSearchParams } from "react-router";
 
 import { PageRefreshButton } from "@/components/PageRefreshButton";
+import { Badge } from "@/components/ui/badge";
 import {
   Select,
   SelectContent,
@@ -11,16 +12,24 @@ import {
 } from "@/components/ui/select";
 import { ErrorAlert } from "@/components/ErrorAlert";
 import { FilterBar, type ActiveFilter } from "@/components/FilterBar";
+import {
+  usePageViewBeacon,
+  useSearchBeacon,
+} from "@/features/analytics/hooks/useUsageAnalytics";
 import { useDns } from "@/features/dns/hooks/useDns";
 import { FqdnGroupList } from "@/features/dns/ui/FqdnGroupList";
 import { usePortals } from "@/features/portal/hooks/usePortals";
+import { cn } from "@/lib/utils";
 
 const ALL_GROUPS_VALUE = "__all__";
 
 export function LinksPage() {
   const { portalName = "main" } = useParams<{ portalName: string }>();
+  // Share links (/share/fqdn/...) redirect here with ?fqdn=<name>.
+  const [searchParams] = useSearchParams();
   const {
     groupedByGroup,
+    groupedByTree,
     groups,
     totalCount,
     filteredCount,
@@ -29,11 +38,16 @@ export function LinksPage() {
     error,
     searchTerm,
     groupFilter,
+    showRemoved,
     setSearchTerm,
     setGroupFilter,
+    setShowRemoved,
     clearFilters,
     refetch: refetchDns,
-  } = useDns(portalName);
+  } = useDns(portalName, searchParams.get("fqdn") ?? "");
+
+  usePageViewBeacon();
+  useSearchBeacon(searchTerm);
 
   const {
     refetch: refetchPortals,
@@ -43,7 +57,7 @@ export function LinksPage() {
   const handleRefresh = useCallback(() => {
     void Promise.all([refetchDns(), refetchPortals()]);
   }, [refetchDns, refetchPortals]);
-  const hasFilters = searchTerm !== "" || groupFilter !== "";
+  const hasFilters = searchTerm !== "" || groupFilter !== "" || showRemoved;
 
   const activeFilters = useMemo((): ActiveFilter[] => {
     const filters: ActiveFilter[] = [];
@@ -61,8 +75,22 @@ export function LinksPage() {
         onRemove: () => setGroupFilter(""),
       });
     }
+    if (showRemoved) {
+      filters.push({
+        label: "show",
+        value: "removed",
+        onRemove: () => setShowRemoved(false),
+      });
+    }
     return filters;
-  }, [searchTerm, groupFilter, setSearchTerm, setGroupFilter]);
+  }, [
+    searchTerm,
+    groupFilter,
+    showRemoved,
+    setSearchTerm,
+    setGroupFilter,
+    setShowRemoved,
+  ]);
 
   return (
     <div className="max-w-screen-xl mx-auto px-4 py-6 space-y-6">
@@ -116,6 +144,25 @@ export function LinksPage() {
             ))}
           </SelectContent>
         </Select>
+        <Badge
+          variant="outline"
+          role="button"
+          aria-pressed={showRemoved}
+          tabIndex={0}
+          title="Also show FQDNs that recently disappeared from their sources"
+          className={cn(
+            "cursor-pointer transition-colors",
+            showRemoved
+              ? "bg-muted text-foreground border-foreground/30"
+              : "text-muted-foreground"
+          )}
+          onClick={() => setShowRemoved(!showRemoved)}
+          onKeyDown={(e) => {
+            if (e.key === "Enter" || e.key === " ") setShowRemoved(!showRemoved);
+          }}
+        >
+          removed
+        </Badge>
       </FilterBar>
 
       {/* Error state */}
@@ -125,6 +172,7 @@ export function LinksPage() {
       {!error && (
         <FqdnGroupList
           groups={groupedByGroup}
+          tree={groupedByTree}
           isLoading={isLoading}
           hasFilters={hasFilters}
           onClearFilters={clearFilters}
@@ -50,6 +50,9 @@ const NetpolPage = lazy(() =>
 const StatusPage = lazy(() =>
   import("@/pages/StatusPage").then((m) => ({ default: m.StatusPage }))
 );
+const DocsPage = lazy(() =>
+  import("@/pages/DocsPage").then((m) => ({ default: m.DocsPage }))
+);
 const ImagesPage = lazy(() =>
   import("@/pages/ImagesPage").then((m) => ({ default: m.ImagesPage }))
 );
@@ -127,6 +130,15 @@ export const router = createBrowserRouter([
           </Suspense>
         ),
       },
+      {
+        path: ":portalName/docs",
+        errorElement: <ErrorPage />,
+        element: (
+          <Suspense fallback={<PageSkeleton />}>
+            <DocsPage />
+          </Suspense>
+        ),
+      },
       {
         path: "help",
         errorElement: <ErrorPage />,
@@ -5,6 +5,7 @@ import {
   FQDNSchema,
   ListFQDNsResponseSchema,
   type FQDN,
+  type Group,
 } from "@/gen/sreportal/v1/dns_pb";
 import {
   ListPortalsResponseSchema,
@@ -63,11 +64,12 @@ function grpcWebFrame<T extends DescMessage>(
 // Response builders — return gRPC-Web binary bodies
 // ---------------------------------------------------------------------------
 
-export function listFqdnsResponseJson(fqdns: FQDN[]) {
+export function listFqdnsResponseJson(fqdns: FQDN[], groups: Group[] = []) {
   const message = create(ListFQDNsResponseSchema, {
     fqdns,
     nextPageToken: "",
     totalSize: fqdns.length,
+    groups,
   });
   return grpcWebFrame(ListFQDNsResponseSchema, message);
 }